@@ -14,15 +14,171 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*SubjectTokenInstance
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubjectTokenInstance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubjectTokenInstance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(SubjectTokenInstance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(SubjectTokenInstance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field AuthorizedContracts as it is not of Message kind"))
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*TokenSupply
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenSupply)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenSupply)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(TokenSupply)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(TokenSupply)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                          protoreflect.MessageDescriptor
+	fd_GenesisState_params                   protoreflect.FieldDescriptor
+	fd_GenesisState_subjectTokenInstanceList protoreflect.FieldDescriptor
+	fd_GenesisState_tokenInstanceCount       protoreflect.FieldDescriptor
+	fd_GenesisState_authorizedContracts      protoreflect.FieldDescriptor
+	fd_GenesisState_tokenSupplies            protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_academictoken_academicnft_genesis_proto_init()
 	md_GenesisState = File_academictoken_academicnft_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_subjectTokenInstanceList = md_GenesisState.Fields().ByName("subjectTokenInstanceList")
+	fd_GenesisState_tokenInstanceCount = md_GenesisState.Fields().ByName("tokenInstanceCount")
+	fd_GenesisState_authorizedContracts = md_GenesisState.Fields().ByName("authorizedContracts")
+	fd_GenesisState_tokenSupplies = md_GenesisState.Fields().ByName("tokenSupplies")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,6 +252,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.SubjectTokenInstanceList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.SubjectTokenInstanceList})
+		if !f(fd_GenesisState_subjectTokenInstanceList, value) {
+			return
+		}
+	}
+	if x.TokenInstanceCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TokenInstanceCount)
+		if !f(fd_GenesisState_tokenInstanceCount, value) {
+			return
+		}
+	}
+	if len(x.AuthorizedContracts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.AuthorizedContracts})
+		if !f(fd_GenesisState_authorizedContracts, value) {
+			return
+		}
+	}
+	if len(x.TokenSupplies) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.TokenSupplies})
+		if !f(fd_GenesisState_tokenSupplies, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +293,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "academictoken.academicnft.GenesisState.params":
 		return x.Params != nil
+	case "academictoken.academicnft.GenesisState.subjectTokenInstanceList":
+		return len(x.SubjectTokenInstanceList) != 0
+	case "academictoken.academicnft.GenesisState.tokenInstanceCount":
+		return x.TokenInstanceCount != uint64(0)
+	case "academictoken.academicnft.GenesisState.authorizedContracts":
+		return len(x.AuthorizedContracts) != 0
+	case "academictoken.academicnft.GenesisState.tokenSupplies":
+		return len(x.TokenSupplies) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GenesisState"))
@@ -131,6 +319,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "academictoken.academicnft.GenesisState.params":
 		x.Params = nil
+	case "academictoken.academicnft.GenesisState.subjectTokenInstanceList":
+		x.SubjectTokenInstanceList = nil
+	case "academictoken.academicnft.GenesisState.tokenInstanceCount":
+		x.TokenInstanceCount = uint64(0)
+	case "academictoken.academicnft.GenesisState.authorizedContracts":
+		x.AuthorizedContracts = nil
+	case "academictoken.academicnft.GenesisState.tokenSupplies":
+		x.TokenSupplies = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GenesisState"))
@@ -150,6 +346,27 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "academictoken.academicnft.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "academictoken.academicnft.GenesisState.subjectTokenInstanceList":
+		if len(x.SubjectTokenInstanceList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.SubjectTokenInstanceList}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.academicnft.GenesisState.tokenInstanceCount":
+		value := x.TokenInstanceCount
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.academicnft.GenesisState.authorizedContracts":
+		if len(x.AuthorizedContracts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.AuthorizedContracts}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.academicnft.GenesisState.tokenSupplies":
+		if len(x.TokenSupplies) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.TokenSupplies}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GenesisState"))
@@ -172,6 +389,20 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "academictoken.academicnft.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "academictoken.academicnft.GenesisState.subjectTokenInstanceList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.SubjectTokenInstanceList = *clv.list
+	case "academictoken.academicnft.GenesisState.tokenInstanceCount":
+		x.TokenInstanceCount = value.Uint()
+	case "academictoken.academicnft.GenesisState.authorizedContracts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.AuthorizedContracts = *clv.list
+	case "academictoken.academicnft.GenesisState.tokenSupplies":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.TokenSupplies = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GenesisState"))
@@ -197,6 +428,26 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "academictoken.academicnft.GenesisState.subjectTokenInstanceList":
+		if x.SubjectTokenInstanceList == nil {
+			x.SubjectTokenInstanceList = []*SubjectTokenInstance{}
+		}
+		value := &_GenesisState_2_list{list: &x.SubjectTokenInstanceList}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.academicnft.GenesisState.authorizedContracts":
+		if x.AuthorizedContracts == nil {
+			x.AuthorizedContracts = []string{}
+		}
+		value := &_GenesisState_4_list{list: &x.AuthorizedContracts}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.academicnft.GenesisState.tokenSupplies":
+		if x.TokenSupplies == nil {
+			x.TokenSupplies = []*TokenSupply{}
+		}
+		value := &_GenesisState_5_list{list: &x.TokenSupplies}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.academicnft.GenesisState.tokenInstanceCount":
+		panic(fmt.Errorf("field tokenInstanceCount of message academictoken.academicnft.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GenesisState"))
@@ -213,6 +464,17 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "academictoken.academicnft.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "academictoken.academicnft.GenesisState.subjectTokenInstanceList":
+		list := []*SubjectTokenInstance{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "academictoken.academicnft.GenesisState.tokenInstanceCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.academicnft.GenesisState.authorizedContracts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "academictoken.academicnft.GenesisState.tokenSupplies":
+		list := []*TokenSupply{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GenesisState"))
@@ -286,6 +548,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.SubjectTokenInstanceList) > 0 {
+			for _, e := range x.SubjectTokenInstanceList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TokenInstanceCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TokenInstanceCount))
+		}
+		if len(x.AuthorizedContracts) > 0 {
+			for _, s := range x.AuthorizedContracts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TokenSupplies) > 0 {
+			for _, e := range x.TokenSupplies {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,6 +598,52 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.TokenSupplies) > 0 {
+			for iNdEx := len(x.TokenSupplies) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TokenSupplies[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AuthorizedContracts) > 0 {
+			for iNdEx := len(x.AuthorizedContracts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AuthorizedContracts[iNdEx])
+				copy(dAtA[i:], x.AuthorizedContracts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthorizedContracts[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.TokenInstanceCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TokenInstanceCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SubjectTokenInstanceList) > 0 {
+			for iNdEx := len(x.SubjectTokenInstanceList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SubjectTokenInstanceList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -414,6 +743,125 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectTokenInstanceList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubjectTokenInstanceList = append(x.SubjectTokenInstanceList, &SubjectTokenInstance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubjectTokenInstanceList[len(x.SubjectTokenInstanceList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceCount", wireType)
+				}
+				x.TokenInstanceCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TokenInstanceCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorizedContracts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuthorizedContracts = append(x.AuthorizedContracts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenSupplies", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenSupplies = append(x.TokenSupplies, &TokenSupply{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenSupplies[len(x.TokenSupplies)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -469,7 +917,11 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Params                   *Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	SubjectTokenInstanceList []*SubjectTokenInstance `protobuf:"bytes,2,rep,name=subjectTokenInstanceList,proto3" json:"subjectTokenInstanceList,omitempty"`
+	TokenInstanceCount       uint64                  `protobuf:"varint,3,opt,name=tokenInstanceCount,proto3" json:"tokenInstanceCount,omitempty"`
+	AuthorizedContracts      []string                `protobuf:"bytes,4,rep,name=authorizedContracts,proto3" json:"authorizedContracts,omitempty"`
+	TokenSupplies            []*TokenSupply          `protobuf:"bytes,5,rep,name=tokenSupplies,proto3" json:"tokenSupplies,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -499,6 +951,34 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetSubjectTokenInstanceList() []*SubjectTokenInstance {
+	if x != nil {
+		return x.SubjectTokenInstanceList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTokenInstanceCount() uint64 {
+	if x != nil {
+		return x.TokenInstanceCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAuthorizedContracts() []string {
+	if x != nil {
+		return x.AuthorizedContracts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTokenSupplies() []*TokenSupply {
+	if x != nil {
+		return x.TokenSupplies
+	}
+	return nil
+}
+
 var File_academictoken_academicnft_genesis_proto protoreflect.FileDescriptor
 
 var file_academictoken_academicnft_genesis_proto_rawDesc = []byte{
@@ -511,12 +991,37 @@ var file_academictoken_academicnft_genesis_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x61,
 	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61,
 	0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x54, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66,
-	0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xdf, 0x01, 0x0a, 0x1d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfd, 0x02, 0x0a, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x71, 0x0a, 0x18, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x18, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x42, 0xdf, 0x01, 0x0a, 0x1d,
 	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
 	0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x42, 0x0c, 0x47,
 	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x61,
@@ -548,16 +1053,20 @@ func file_academictoken_academicnft_genesis_proto_rawDescGZIP() []byte {
 
 var file_academictoken_academicnft_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_academictoken_academicnft_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: academictoken.academicnft.GenesisState
-	(*Params)(nil),       // 1: academictoken.academicnft.Params
+	(*GenesisState)(nil),         // 0: academictoken.academicnft.GenesisState
+	(*Params)(nil),               // 1: academictoken.academicnft.Params
+	(*SubjectTokenInstance)(nil), // 2: academictoken.academicnft.SubjectTokenInstance
+	(*TokenSupply)(nil),          // 3: academictoken.academicnft.TokenSupply
 }
 var file_academictoken_academicnft_genesis_proto_depIdxs = []int32{
 	1, // 0: academictoken.academicnft.GenesisState.params:type_name -> academictoken.academicnft.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: academictoken.academicnft.GenesisState.subjectTokenInstanceList:type_name -> academictoken.academicnft.SubjectTokenInstance
+	3, // 2: academictoken.academicnft.GenesisState.tokenSupplies:type_name -> academictoken.academicnft.TokenSupply
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_academictoken_academicnft_genesis_proto_init() }
@@ -566,6 +1075,8 @@ func file_academictoken_academicnft_genesis_proto_init() {
 		return
 	}
 	file_academictoken_academicnft_params_proto_init()
+	file_academictoken_academicnft_subject_token_instance_proto_init()
+	file_academictoken_academicnft_token_supply_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_academictoken_academicnft_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1666,6 +1666,7 @@ var (
 	md_QueryGetStudentTokensRequest                protoreflect.MessageDescriptor
 	fd_QueryGetStudentTokensRequest_studentAddress protoreflect.FieldDescriptor
 	fd_QueryGetStudentTokensRequest_pagination     protoreflect.FieldDescriptor
+	fd_QueryGetStudentTokensRequest_term           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1673,6 +1674,7 @@ func init() {
 	md_QueryGetStudentTokensRequest = File_academictoken_academicnft_query_proto.Messages().ByName("QueryGetStudentTokensRequest")
 	fd_QueryGetStudentTokensRequest_studentAddress = md_QueryGetStudentTokensRequest.Fields().ByName("studentAddress")
 	fd_QueryGetStudentTokensRequest_pagination = md_QueryGetStudentTokensRequest.Fields().ByName("pagination")
+	fd_QueryGetStudentTokensRequest_term = md_QueryGetStudentTokensRequest.Fields().ByName("term")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryGetStudentTokensRequest)(nil)
@@ -1752,6 +1754,12 @@ func (x *fastReflection_QueryGetStudentTokensRequest) Range(f func(protoreflect.
 			return
 		}
 	}
+	if x.Term != "" {
+		value := protoreflect.ValueOfString(x.Term)
+		if !f(fd_QueryGetStudentTokensRequest_term, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1771,6 +1779,8 @@ func (x *fastReflection_QueryGetStudentTokensRequest) Has(fd protoreflect.FieldD
 		return x.StudentAddress != ""
 	case "academictoken.academicnft.QueryGetStudentTokensRequest.pagination":
 		return x.Pagination != nil
+	case "academictoken.academicnft.QueryGetStudentTokensRequest.term":
+		return x.Term != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetStudentTokensRequest"))
@@ -1791,6 +1801,8 @@ func (x *fastReflection_QueryGetStudentTokensRequest) Clear(fd protoreflect.Fiel
 		x.StudentAddress = ""
 	case "academictoken.academicnft.QueryGetStudentTokensRequest.pagination":
 		x.Pagination = nil
+	case "academictoken.academicnft.QueryGetStudentTokensRequest.term":
+		x.Term = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetStudentTokensRequest"))
@@ -1813,6 +1825,9 @@ func (x *fastReflection_QueryGetStudentTokensRequest) Get(descriptor protoreflec
 	case "academictoken.academicnft.QueryGetStudentTokensRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "academictoken.academicnft.QueryGetStudentTokensRequest.term":
+		value := x.Term
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetStudentTokensRequest"))
@@ -1837,6 +1852,8 @@ func (x *fastReflection_QueryGetStudentTokensRequest) Set(fd protoreflect.FieldD
 		x.StudentAddress = value.Interface().(string)
 	case "academictoken.academicnft.QueryGetStudentTokensRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "academictoken.academicnft.QueryGetStudentTokensRequest.term":
+		x.Term = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetStudentTokensRequest"))
@@ -1864,6 +1881,8 @@ func (x *fastReflection_QueryGetStudentTokensRequest) Mutable(fd protoreflect.Fi
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	case "academictoken.academicnft.QueryGetStudentTokensRequest.studentAddress":
 		panic(fmt.Errorf("field studentAddress of message academictoken.academicnft.QueryGetStudentTokensRequest is not mutable"))
+	case "academictoken.academicnft.QueryGetStudentTokensRequest.term":
+		panic(fmt.Errorf("field term of message academictoken.academicnft.QueryGetStudentTokensRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetStudentTokensRequest"))
@@ -1882,6 +1901,8 @@ func (x *fastReflection_QueryGetStudentTokensRequest) NewField(fd protoreflect.F
 	case "academictoken.academicnft.QueryGetStudentTokensRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "academictoken.academicnft.QueryGetStudentTokensRequest.term":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetStudentTokensRequest"))
@@ -1959,6 +1980,10 @@ func (x *fastReflection_QueryGetStudentTokensRequest) ProtoMethods() *protoiface
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Term)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1988,6 +2013,13 @@ func (x *fastReflection_QueryGetStudentTokensRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Term) > 0 {
+			i -= len(x.Term)
+			copy(dAtA[i:], x.Term)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Term)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -2126,6 +2158,38 @@ func (x *fastReflection_QueryGetStudentTokensRequest) ProtoMethods() *protoiface
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Term = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2738,6 +2802,7 @@ var (
 	md_QueryGetTokenDefInstancesRequest            protoreflect.MessageDescriptor
 	fd_QueryGetTokenDefInstancesRequest_tokenDefId protoreflect.FieldDescriptor
 	fd_QueryGetTokenDefInstancesRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryGetTokenDefInstancesRequest_term       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2745,6 +2810,7 @@ func init() {
 	md_QueryGetTokenDefInstancesRequest = File_academictoken_academicnft_query_proto.Messages().ByName("QueryGetTokenDefInstancesRequest")
 	fd_QueryGetTokenDefInstancesRequest_tokenDefId = md_QueryGetTokenDefInstancesRequest.Fields().ByName("tokenDefId")
 	fd_QueryGetTokenDefInstancesRequest_pagination = md_QueryGetTokenDefInstancesRequest.Fields().ByName("pagination")
+	fd_QueryGetTokenDefInstancesRequest_term = md_QueryGetTokenDefInstancesRequest.Fields().ByName("term")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryGetTokenDefInstancesRequest)(nil)
@@ -2824,6 +2890,12 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) Range(f func(protorefl
 			return
 		}
 	}
+	if x.Term != "" {
+		value := protoreflect.ValueOfString(x.Term)
+		if !f(fd_QueryGetTokenDefInstancesRequest_term, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2843,6 +2915,8 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) Has(fd protoreflect.Fi
 		return x.TokenDefId != ""
 	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.pagination":
 		return x.Pagination != nil
+	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.term":
+		return x.Term != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetTokenDefInstancesRequest"))
@@ -2863,6 +2937,8 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) Clear(fd protoreflect.
 		x.TokenDefId = ""
 	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.pagination":
 		x.Pagination = nil
+	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.term":
+		x.Term = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetTokenDefInstancesRequest"))
@@ -2885,6 +2961,9 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) Get(descriptor protore
 	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.term":
+		value := x.Term
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetTokenDefInstancesRequest"))
@@ -2909,6 +2988,8 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) Set(fd protoreflect.Fi
 		x.TokenDefId = value.Interface().(string)
 	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.term":
+		x.Term = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetTokenDefInstancesRequest"))
@@ -2936,6 +3017,8 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) Mutable(fd protoreflec
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.tokenDefId":
 		panic(fmt.Errorf("field tokenDefId of message academictoken.academicnft.QueryGetTokenDefInstancesRequest is not mutable"))
+	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.term":
+		panic(fmt.Errorf("field term of message academictoken.academicnft.QueryGetTokenDefInstancesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetTokenDefInstancesRequest"))
@@ -2954,6 +3037,8 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) NewField(fd protorefle
 	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "academictoken.academicnft.QueryGetTokenDefInstancesRequest.term":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryGetTokenDefInstancesRequest"))
@@ -3031,6 +3116,10 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) ProtoMethods() *protoi
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Term)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3060,6 +3149,13 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Term) > 0 {
+			i -= len(x.Term)
+			copy(dAtA[i:], x.Term)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Term)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -3198,6 +3294,38 @@ func (x *fastReflection_QueryGetTokenDefInstancesRequest) ProtoMethods() *protoi
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Term = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4227,10 +4355,14 @@ func (x *fastReflection_QueryVerifyTokenInstanceRequest) ProtoMethods() *protoif
 }
 
 var (
-	md_QueryVerifyTokenInstanceResponse               protoreflect.MessageDescriptor
-	fd_QueryVerifyTokenInstanceResponse_exists        protoreflect.FieldDescriptor
-	fd_QueryVerifyTokenInstanceResponse_isValid       protoreflect.FieldDescriptor
-	fd_QueryVerifyTokenInstanceResponse_tokenInstance protoreflect.FieldDescriptor
+	md_QueryVerifyTokenInstanceResponse                    protoreflect.MessageDescriptor
+	fd_QueryVerifyTokenInstanceResponse_exists             protoreflect.FieldDescriptor
+	fd_QueryVerifyTokenInstanceResponse_isValid            protoreflect.FieldDescriptor
+	fd_QueryVerifyTokenInstanceResponse_tokenInstance      protoreflect.FieldDescriptor
+	fd_QueryVerifyTokenInstanceResponse_signingKeyId       protoreflect.FieldDescriptor
+	fd_QueryVerifyTokenInstanceResponse_signer             protoreflect.FieldDescriptor
+	fd_QueryVerifyTokenInstanceResponse_signatureValid     protoreflect.FieldDescriptor
+	fd_QueryVerifyTokenInstanceResponse_keyValidAtIssuance protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4239,6 +4371,10 @@ func init() {
 	fd_QueryVerifyTokenInstanceResponse_exists = md_QueryVerifyTokenInstanceResponse.Fields().ByName("exists")
 	fd_QueryVerifyTokenInstanceResponse_isValid = md_QueryVerifyTokenInstanceResponse.Fields().ByName("isValid")
 	fd_QueryVerifyTokenInstanceResponse_tokenInstance = md_QueryVerifyTokenInstanceResponse.Fields().ByName("tokenInstance")
+	fd_QueryVerifyTokenInstanceResponse_signingKeyId = md_QueryVerifyTokenInstanceResponse.Fields().ByName("signingKeyId")
+	fd_QueryVerifyTokenInstanceResponse_signer = md_QueryVerifyTokenInstanceResponse.Fields().ByName("signer")
+	fd_QueryVerifyTokenInstanceResponse_signatureValid = md_QueryVerifyTokenInstanceResponse.Fields().ByName("signatureValid")
+	fd_QueryVerifyTokenInstanceResponse_keyValidAtIssuance = md_QueryVerifyTokenInstanceResponse.Fields().ByName("keyValidAtIssuance")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryVerifyTokenInstanceResponse)(nil)
@@ -4324,6 +4460,30 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) Range(f func(protorefl
 			return
 		}
 	}
+	if x.SigningKeyId != "" {
+		value := protoreflect.ValueOfString(x.SigningKeyId)
+		if !f(fd_QueryVerifyTokenInstanceResponse_signingKeyId, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_QueryVerifyTokenInstanceResponse_signer, value) {
+			return
+		}
+	}
+	if x.SignatureValid != false {
+		value := protoreflect.ValueOfBool(x.SignatureValid)
+		if !f(fd_QueryVerifyTokenInstanceResponse_signatureValid, value) {
+			return
+		}
+	}
+	if x.KeyValidAtIssuance != false {
+		value := protoreflect.ValueOfBool(x.KeyValidAtIssuance)
+		if !f(fd_QueryVerifyTokenInstanceResponse_keyValidAtIssuance, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4345,6 +4505,14 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) Has(fd protoreflect.Fi
 		return x.IsValid_ != false
 	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.tokenInstance":
 		return x.TokenInstance != nil
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signingKeyId":
+		return x.SigningKeyId != ""
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signer":
+		return x.Signer != ""
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signatureValid":
+		return x.SignatureValid != false
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.keyValidAtIssuance":
+		return x.KeyValidAtIssuance != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryVerifyTokenInstanceResponse"))
@@ -4367,6 +4535,14 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) Clear(fd protoreflect.
 		x.IsValid_ = false
 	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.tokenInstance":
 		x.TokenInstance = nil
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signingKeyId":
+		x.SigningKeyId = ""
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signer":
+		x.Signer = ""
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signatureValid":
+		x.SignatureValid = false
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.keyValidAtIssuance":
+		x.KeyValidAtIssuance = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryVerifyTokenInstanceResponse"))
@@ -4392,6 +4568,18 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) Get(descriptor protore
 	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.tokenInstance":
 		value := x.TokenInstance
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signingKeyId":
+		value := x.SigningKeyId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signatureValid":
+		value := x.SignatureValid
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.keyValidAtIssuance":
+		value := x.KeyValidAtIssuance
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryVerifyTokenInstanceResponse"))
@@ -4418,6 +4606,14 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) Set(fd protoreflect.Fi
 		x.IsValid_ = value.Bool()
 	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.tokenInstance":
 		x.TokenInstance = value.Message().Interface().(*SubjectTokenInstance)
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signingKeyId":
+		x.SigningKeyId = value.Interface().(string)
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signer":
+		x.Signer = value.Interface().(string)
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signatureValid":
+		x.SignatureValid = value.Bool()
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.keyValidAtIssuance":
+		x.KeyValidAtIssuance = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryVerifyTokenInstanceResponse"))
@@ -4447,6 +4643,14 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) Mutable(fd protoreflec
 		panic(fmt.Errorf("field exists of message academictoken.academicnft.QueryVerifyTokenInstanceResponse is not mutable"))
 	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.isValid":
 		panic(fmt.Errorf("field isValid of message academictoken.academicnft.QueryVerifyTokenInstanceResponse is not mutable"))
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signingKeyId":
+		panic(fmt.Errorf("field signingKeyId of message academictoken.academicnft.QueryVerifyTokenInstanceResponse is not mutable"))
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signer":
+		panic(fmt.Errorf("field signer of message academictoken.academicnft.QueryVerifyTokenInstanceResponse is not mutable"))
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signatureValid":
+		panic(fmt.Errorf("field signatureValid of message academictoken.academicnft.QueryVerifyTokenInstanceResponse is not mutable"))
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.keyValidAtIssuance":
+		panic(fmt.Errorf("field keyValidAtIssuance of message academictoken.academicnft.QueryVerifyTokenInstanceResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryVerifyTokenInstanceResponse"))
@@ -4467,6 +4671,14 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) NewField(fd protorefle
 	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.tokenInstance":
 		m := new(SubjectTokenInstance)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signingKeyId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signer":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.signatureValid":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.academicnft.QueryVerifyTokenInstanceResponse.keyValidAtIssuance":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryVerifyTokenInstanceResponse"))
@@ -4546,6 +4758,20 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) ProtoMethods() *protoi
 			l = options.Size(x.TokenInstance)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.SigningKeyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SignatureValid {
+			n += 2
+		}
+		if x.KeyValidAtIssuance {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4575,6 +4801,40 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.KeyValidAtIssuance {
+			i--
+			if x.KeyValidAtIssuance {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SignatureValid {
+			i--
+			if x.SignatureValid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SigningKeyId) > 0 {
+			i -= len(x.SigningKeyId)
+			copy(dAtA[i:], x.SigningKeyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningKeyId)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if x.TokenInstance != nil {
 			encoded, err := options.Marshal(x.TokenInstance)
 			if err != nil {
@@ -4734,6 +4994,110 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) ProtoMethods() *protoi
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningKeyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignatureValid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SignatureValid = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyValidAtIssuance", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.KeyValidAtIssuance = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4769,32 +5133,1925 @@ func (x *fastReflection_QueryVerifyTokenInstanceResponse) ProtoMethods() *protoi
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: academictoken/academicnft/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryTokenSupplyRequest            protoreflect.MessageDescriptor
+	fd_QueryTokenSupplyRequest_tokenDefId protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_academictoken_academicnft_query_proto_init()
+	md_QueryTokenSupplyRequest = File_academictoken_academicnft_query_proto.Messages().ByName("QueryTokenSupplyRequest")
+	fd_QueryTokenSupplyRequest_tokenDefId = md_QueryTokenSupplyRequest.Fields().ByName("tokenDefId")
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_academicnft_query_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_QueryTokenSupplyRequest)(nil)
+
+type fastReflection_QueryTokenSupplyRequest QueryTokenSupplyRequest
+
+func (x *QueryTokenSupplyRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTokenSupplyRequest)(x)
+}
+
+func (x *QueryTokenSupplyRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTokenSupplyRequest_messageType fastReflection_QueryTokenSupplyRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTokenSupplyRequest_messageType{}
+
+type fastReflection_QueryTokenSupplyRequest_messageType struct{}
+
+func (x fastReflection_QueryTokenSupplyRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTokenSupplyRequest)(nil)
+}
+func (x fastReflection_QueryTokenSupplyRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTokenSupplyRequest)
+}
+func (x fastReflection_QueryTokenSupplyRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTokenSupplyRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTokenSupplyRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTokenSupplyRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTokenSupplyRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTokenSupplyRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTokenSupplyRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTokenSupplyRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTokenSupplyRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTokenSupplyRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTokenSupplyRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TokenDefId != "" {
+		value := protoreflect.ValueOfString(x.TokenDefId)
+		if !f(fd_QueryTokenSupplyRequest_tokenDefId, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTokenSupplyRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyRequest.tokenDefId":
+		return x.TokenDefId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTokenSupplyRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyRequest.tokenDefId":
+		x.TokenDefId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTokenSupplyRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyRequest.tokenDefId":
+		value := x.TokenDefId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTokenSupplyRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyRequest.tokenDefId":
+		x.TokenDefId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTokenSupplyRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyRequest.tokenDefId":
+		panic(fmt.Errorf("field tokenDefId of message academictoken.academicnft.QueryTokenSupplyRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTokenSupplyRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyRequest.tokenDefId":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTokenSupplyRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.QueryTokenSupplyRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTokenSupplyRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTokenSupplyRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTokenSupplyRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTokenSupplyRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTokenSupplyRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TokenDefId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTokenSupplyRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TokenDefId) > 0 {
+			i -= len(x.TokenDefId)
+			copy(dAtA[i:], x.TokenDefId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenDefId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTokenSupplyRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTokenSupplyRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTokenSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenDefId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenDefId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTokenSupplyResponse             protoreflect.MessageDescriptor
+	fd_QueryTokenSupplyResponse_supply      protoreflect.FieldDescriptor
+	fd_QueryTokenSupplyResponse_maxSupply   protoreflect.FieldDescriptor
+	fd_QueryTokenSupplyResponse_circulating protoreflect.FieldDescriptor
+	fd_QueryTokenSupplyResponse_remaining   protoreflect.FieldDescriptor
+	fd_QueryTokenSupplyResponse_unlimited   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_query_proto_init()
+	md_QueryTokenSupplyResponse = File_academictoken_academicnft_query_proto.Messages().ByName("QueryTokenSupplyResponse")
+	fd_QueryTokenSupplyResponse_supply = md_QueryTokenSupplyResponse.Fields().ByName("supply")
+	fd_QueryTokenSupplyResponse_maxSupply = md_QueryTokenSupplyResponse.Fields().ByName("maxSupply")
+	fd_QueryTokenSupplyResponse_circulating = md_QueryTokenSupplyResponse.Fields().ByName("circulating")
+	fd_QueryTokenSupplyResponse_remaining = md_QueryTokenSupplyResponse.Fields().ByName("remaining")
+	fd_QueryTokenSupplyResponse_unlimited = md_QueryTokenSupplyResponse.Fields().ByName("unlimited")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTokenSupplyResponse)(nil)
+
+type fastReflection_QueryTokenSupplyResponse QueryTokenSupplyResponse
+
+func (x *QueryTokenSupplyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTokenSupplyResponse)(x)
+}
+
+func (x *QueryTokenSupplyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTokenSupplyResponse_messageType fastReflection_QueryTokenSupplyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTokenSupplyResponse_messageType{}
+
+type fastReflection_QueryTokenSupplyResponse_messageType struct{}
+
+func (x fastReflection_QueryTokenSupplyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTokenSupplyResponse)(nil)
+}
+func (x fastReflection_QueryTokenSupplyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTokenSupplyResponse)
+}
+func (x fastReflection_QueryTokenSupplyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTokenSupplyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTokenSupplyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTokenSupplyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTokenSupplyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTokenSupplyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTokenSupplyResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTokenSupplyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTokenSupplyResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTokenSupplyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTokenSupplyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Supply != nil {
+		value := protoreflect.ValueOfMessage(x.Supply.ProtoReflect())
+		if !f(fd_QueryTokenSupplyResponse_supply, value) {
+			return
+		}
+	}
+	if x.MaxSupply != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSupply)
+		if !f(fd_QueryTokenSupplyResponse_maxSupply, value) {
+			return
+		}
+	}
+	if x.Circulating != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Circulating)
+		if !f(fd_QueryTokenSupplyResponse_circulating, value) {
+			return
+		}
+	}
+	if x.Remaining != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Remaining)
+		if !f(fd_QueryTokenSupplyResponse_remaining, value) {
+			return
+		}
+	}
+	if x.Unlimited != false {
+		value := protoreflect.ValueOfBool(x.Unlimited)
+		if !f(fd_QueryTokenSupplyResponse_unlimited, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTokenSupplyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyResponse.supply":
+		return x.Supply != nil
+	case "academictoken.academicnft.QueryTokenSupplyResponse.maxSupply":
+		return x.MaxSupply != uint64(0)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.circulating":
+		return x.Circulating != uint64(0)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.remaining":
+		return x.Remaining != uint64(0)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.unlimited":
+		return x.Unlimited != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTokenSupplyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyResponse.supply":
+		x.Supply = nil
+	case "academictoken.academicnft.QueryTokenSupplyResponse.maxSupply":
+		x.MaxSupply = uint64(0)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.circulating":
+		x.Circulating = uint64(0)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.remaining":
+		x.Remaining = uint64(0)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.unlimited":
+		x.Unlimited = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTokenSupplyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyResponse.supply":
+		value := x.Supply
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "academictoken.academicnft.QueryTokenSupplyResponse.maxSupply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.circulating":
+		value := x.Circulating
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.remaining":
+		value := x.Remaining
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.unlimited":
+		value := x.Unlimited
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTokenSupplyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyResponse.supply":
+		x.Supply = value.Message().Interface().(*TokenSupply)
+	case "academictoken.academicnft.QueryTokenSupplyResponse.maxSupply":
+		x.MaxSupply = value.Uint()
+	case "academictoken.academicnft.QueryTokenSupplyResponse.circulating":
+		x.Circulating = value.Uint()
+	case "academictoken.academicnft.QueryTokenSupplyResponse.remaining":
+		x.Remaining = value.Uint()
+	case "academictoken.academicnft.QueryTokenSupplyResponse.unlimited":
+		x.Unlimited = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTokenSupplyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyResponse.supply":
+		if x.Supply == nil {
+			x.Supply = new(TokenSupply)
+		}
+		return protoreflect.ValueOfMessage(x.Supply.ProtoReflect())
+	case "academictoken.academicnft.QueryTokenSupplyResponse.maxSupply":
+		panic(fmt.Errorf("field maxSupply of message academictoken.academicnft.QueryTokenSupplyResponse is not mutable"))
+	case "academictoken.academicnft.QueryTokenSupplyResponse.circulating":
+		panic(fmt.Errorf("field circulating of message academictoken.academicnft.QueryTokenSupplyResponse is not mutable"))
+	case "academictoken.academicnft.QueryTokenSupplyResponse.remaining":
+		panic(fmt.Errorf("field remaining of message academictoken.academicnft.QueryTokenSupplyResponse is not mutable"))
+	case "academictoken.academicnft.QueryTokenSupplyResponse.unlimited":
+		panic(fmt.Errorf("field unlimited of message academictoken.academicnft.QueryTokenSupplyResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTokenSupplyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QueryTokenSupplyResponse.supply":
+		m := new(TokenSupply)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "academictoken.academicnft.QueryTokenSupplyResponse.maxSupply":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.academicnft.QueryTokenSupplyResponse.circulating":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.academicnft.QueryTokenSupplyResponse.remaining":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.academicnft.QueryTokenSupplyResponse.unlimited":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QueryTokenSupplyResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QueryTokenSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTokenSupplyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.QueryTokenSupplyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTokenSupplyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTokenSupplyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTokenSupplyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTokenSupplyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTokenSupplyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Supply != nil {
+			l = options.Size(x.Supply)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxSupply != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSupply))
+		}
+		if x.Circulating != 0 {
+			n += 1 + runtime.Sov(uint64(x.Circulating))
+		}
+		if x.Remaining != 0 {
+			n += 1 + runtime.Sov(uint64(x.Remaining))
+		}
+		if x.Unlimited {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTokenSupplyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Unlimited {
+			i--
+			if x.Unlimited {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Remaining != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Remaining))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Circulating != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Circulating))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxSupply != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSupply))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Supply != nil {
+			encoded, err := options.Marshal(x.Supply)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTokenSupplyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTokenSupplyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTokenSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Supply == nil {
+					x.Supply = &TokenSupply{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Supply); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				}
+				x.MaxSupply = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSupply |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Circulating", wireType)
+				}
+				x.Circulating = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Circulating |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+				}
+				x.Remaining = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Remaining |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Unlimited = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySubjectTokenCredentialRequest                 protoreflect.MessageDescriptor
+	fd_QuerySubjectTokenCredentialRequest_tokenInstanceId protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_query_proto_init()
+	md_QuerySubjectTokenCredentialRequest = File_academictoken_academicnft_query_proto.Messages().ByName("QuerySubjectTokenCredentialRequest")
+	fd_QuerySubjectTokenCredentialRequest_tokenInstanceId = md_QuerySubjectTokenCredentialRequest.Fields().ByName("tokenInstanceId")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySubjectTokenCredentialRequest)(nil)
+
+type fastReflection_QuerySubjectTokenCredentialRequest QuerySubjectTokenCredentialRequest
+
+func (x *QuerySubjectTokenCredentialRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySubjectTokenCredentialRequest)(x)
+}
+
+func (x *QuerySubjectTokenCredentialRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySubjectTokenCredentialRequest_messageType fastReflection_QuerySubjectTokenCredentialRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySubjectTokenCredentialRequest_messageType{}
+
+type fastReflection_QuerySubjectTokenCredentialRequest_messageType struct{}
+
+func (x fastReflection_QuerySubjectTokenCredentialRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySubjectTokenCredentialRequest)(nil)
+}
+func (x fastReflection_QuerySubjectTokenCredentialRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySubjectTokenCredentialRequest)
+}
+func (x fastReflection_QuerySubjectTokenCredentialRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubjectTokenCredentialRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubjectTokenCredentialRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySubjectTokenCredentialRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySubjectTokenCredentialRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySubjectTokenCredentialRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TokenInstanceId != "" {
+		value := protoreflect.ValueOfString(x.TokenInstanceId)
+		if !f(fd_QuerySubjectTokenCredentialRequest_tokenInstanceId, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialRequest.tokenInstanceId":
+		return x.TokenInstanceId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialRequest.tokenInstanceId":
+		x.TokenInstanceId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialRequest.tokenInstanceId":
+		value := x.TokenInstanceId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialRequest.tokenInstanceId":
+		x.TokenInstanceId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialRequest.tokenInstanceId":
+		panic(fmt.Errorf("field tokenInstanceId of message academictoken.academicnft.QuerySubjectTokenCredentialRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialRequest.tokenInstanceId":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.QuerySubjectTokenCredentialRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySubjectTokenCredentialRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySubjectTokenCredentialRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TokenInstanceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySubjectTokenCredentialRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TokenInstanceId) > 0 {
+			i -= len(x.TokenInstanceId)
+			copy(dAtA[i:], x.TokenInstanceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenInstanceId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySubjectTokenCredentialRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubjectTokenCredentialRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubjectTokenCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySubjectTokenCredentialResponse            protoreflect.MessageDescriptor
+	fd_QuerySubjectTokenCredentialResponse_credential protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_query_proto_init()
+	md_QuerySubjectTokenCredentialResponse = File_academictoken_academicnft_query_proto.Messages().ByName("QuerySubjectTokenCredentialResponse")
+	fd_QuerySubjectTokenCredentialResponse_credential = md_QuerySubjectTokenCredentialResponse.Fields().ByName("credential")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySubjectTokenCredentialResponse)(nil)
+
+type fastReflection_QuerySubjectTokenCredentialResponse QuerySubjectTokenCredentialResponse
+
+func (x *QuerySubjectTokenCredentialResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySubjectTokenCredentialResponse)(x)
+}
+
+func (x *QuerySubjectTokenCredentialResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySubjectTokenCredentialResponse_messageType fastReflection_QuerySubjectTokenCredentialResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySubjectTokenCredentialResponse_messageType{}
+
+type fastReflection_QuerySubjectTokenCredentialResponse_messageType struct{}
+
+func (x fastReflection_QuerySubjectTokenCredentialResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySubjectTokenCredentialResponse)(nil)
+}
+func (x fastReflection_QuerySubjectTokenCredentialResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySubjectTokenCredentialResponse)
+}
+func (x fastReflection_QuerySubjectTokenCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubjectTokenCredentialResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubjectTokenCredentialResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySubjectTokenCredentialResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySubjectTokenCredentialResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySubjectTokenCredentialResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Credential != "" {
+		value := protoreflect.ValueOfString(x.Credential)
+		if !f(fd_QuerySubjectTokenCredentialResponse_credential, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialResponse.credential":
+		return x.Credential != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialResponse.credential":
+		x.Credential = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialResponse.credential":
+		value := x.Credential
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialResponse.credential":
+		x.Credential = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialResponse.credential":
+		panic(fmt.Errorf("field credential of message academictoken.academicnft.QuerySubjectTokenCredentialResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.QuerySubjectTokenCredentialResponse.credential":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.QuerySubjectTokenCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.QuerySubjectTokenCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.QuerySubjectTokenCredentialResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySubjectTokenCredentialResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySubjectTokenCredentialResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Credential)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySubjectTokenCredentialResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Credential) > 0 {
+			i -= len(x.Credential)
+			copy(dAtA[i:], x.Credential)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Credential)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySubjectTokenCredentialResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubjectTokenCredentialResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubjectTokenCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Credential = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/academicnft/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
@@ -4923,6 +7180,7 @@ type QueryGetStudentTokensRequest struct {
 
 	StudentAddress string               `protobuf:"bytes,1,opt,name=studentAddress,proto3" json:"studentAddress,omitempty"`
 	Pagination     *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Term           string               `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"` // only tokens of this academic term, when set
 }
 
 func (x *QueryGetStudentTokensRequest) Reset() {
@@ -4959,6 +7217,13 @@ func (x *QueryGetStudentTokensRequest) GetPagination() *v1beta1.PageRequest {
 	return nil
 }
 
+func (x *QueryGetStudentTokensRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
 type QueryGetStudentTokensResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5009,6 +7274,7 @@ type QueryGetTokenDefInstancesRequest struct {
 
 	TokenDefId string               `protobuf:"bytes,1,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Term       string               `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"` // only tokens of this academic term, when set
 }
 
 func (x *QueryGetTokenDefInstancesRequest) Reset() {
@@ -5045,6 +7311,13 @@ func (x *QueryGetTokenDefInstancesRequest) GetPagination() *v1beta1.PageRequest
 	return nil
 }
 
+func (x *QueryGetTokenDefInstancesRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
 type QueryGetTokenDefInstancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5128,9 +7401,13 @@ type QueryVerifyTokenInstanceResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Exists        bool                  `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
-	IsValid_      bool                  `protobuf:"varint,2,opt,name=isValid,proto3" json:"isValid,omitempty"`
-	TokenInstance *SubjectTokenInstance `protobuf:"bytes,3,opt,name=tokenInstance,proto3" json:"tokenInstance,omitempty"`
+	Exists             bool                  `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	IsValid_           bool                  `protobuf:"varint,2,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	TokenInstance      *SubjectTokenInstance `protobuf:"bytes,3,opt,name=tokenInstance,proto3" json:"tokenInstance,omitempty"`
+	SigningKeyId       string                `protobuf:"bytes,4,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"` // key that signed the token instance
+	Signer             string                `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`             // owner of the signing key
+	SignatureValid     bool                  `protobuf:"varint,6,opt,name=signatureValid,proto3" json:"signatureValid,omitempty"`
+	KeyValidAtIssuance bool                  `protobuf:"varint,7,opt,name=keyValidAtIssuance,proto3" json:"keyValidAtIssuance,omitempty"` // the key was registered and not revoked when the token was issued
 }
 
 func (x *QueryVerifyTokenInstanceResponse) Reset() {
@@ -5174,6 +7451,206 @@ func (x *QueryVerifyTokenInstanceResponse) GetTokenInstance() *SubjectTokenInsta
 	return nil
 }
 
+func (x *QueryVerifyTokenInstanceResponse) GetSigningKeyId() string {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return ""
+}
+
+func (x *QueryVerifyTokenInstanceResponse) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *QueryVerifyTokenInstanceResponse) GetSignatureValid() bool {
+	if x != nil {
+		return x.SignatureValid
+	}
+	return false
+}
+
+func (x *QueryVerifyTokenInstanceResponse) GetKeyValidAtIssuance() bool {
+	if x != nil {
+		return x.KeyValidAtIssuance
+	}
+	return false
+}
+
+type QueryTokenSupplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenDefId string `protobuf:"bytes,1,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
+}
+
+func (x *QueryTokenSupplyRequest) Reset() {
+	*x = QueryTokenSupplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTokenSupplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenSupplyRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTokenSupplyRequest.ProtoReflect.Descriptor instead.
+func (*QueryTokenSupplyRequest) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryTokenSupplyRequest) GetTokenDefId() string {
+	if x != nil {
+		return x.TokenDefId
+	}
+	return ""
+}
+
+type QueryTokenSupplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Supply      *TokenSupply `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply,omitempty"`
+	MaxSupply   uint64       `protobuf:"varint,2,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`     // 0 means unlimited
+	Circulating uint64       `protobuf:"varint,3,opt,name=circulating,proto3" json:"circulating,omitempty"` // minted instances that were not burned
+	Remaining   uint64       `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`     // instances that can still be minted
+	Unlimited   bool         `protobuf:"varint,5,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+}
+
+func (x *QueryTokenSupplyResponse) Reset() {
+	*x = QueryTokenSupplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTokenSupplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenSupplyResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTokenSupplyResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenSupplyResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryTokenSupplyResponse) GetSupply() *TokenSupply {
+	if x != nil {
+		return x.Supply
+	}
+	return nil
+}
+
+func (x *QueryTokenSupplyResponse) GetMaxSupply() uint64 {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return 0
+}
+
+func (x *QueryTokenSupplyResponse) GetCirculating() uint64 {
+	if x != nil {
+		return x.Circulating
+	}
+	return 0
+}
+
+func (x *QueryTokenSupplyResponse) GetRemaining() uint64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *QueryTokenSupplyResponse) GetUnlimited() bool {
+	if x != nil {
+		return x.Unlimited
+	}
+	return false
+}
+
+type QuerySubjectTokenCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenInstanceId string `protobuf:"bytes,1,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+}
+
+func (x *QuerySubjectTokenCredentialRequest) Reset() {
+	*x = QuerySubjectTokenCredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubjectTokenCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubjectTokenCredentialRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySubjectTokenCredentialRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubjectTokenCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QuerySubjectTokenCredentialRequest) GetTokenInstanceId() string {
+	if x != nil {
+		return x.TokenInstanceId
+	}
+	return ""
+}
+
+type QuerySubjectTokenCredentialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credential string `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"` // JSON-LD verifiable credential
+}
+
+func (x *QuerySubjectTokenCredentialResponse) Reset() {
+	*x = QuerySubjectTokenCredentialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubjectTokenCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubjectTokenCredentialResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySubjectTokenCredentialResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubjectTokenCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuerySubjectTokenCredentialResponse) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
 var File_academictoken_academicnft_query_proto protoreflect.FileDescriptor
 
 var file_academictoken_academicnft_query_proto_rawDesc = []byte{
@@ -5194,145 +7671,212 @@ var file_academictoken_academicnft_query_proto_rawDesc = []byte{
 	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61,
 	0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
 	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x48, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x22, 0x76, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1c, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x1d,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
-	0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x13, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x48, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0x76, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x6e, 0x66, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x22, 0xc1, 0x01,
+	0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x57, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x6e, 0x66, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x9e, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
+	0x65, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x65, 0x66, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x72, 0x6d, 0x22, 0xc5, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x1f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xbf, 0x02, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x55,
+	0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66,
 	0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x8a, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x6b, 0x65, 0x79,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x41,
+	0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x39, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66,
 	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
-	0x65, 0x66, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a,
-	0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65,
-	0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x22, 0xab, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x65, 0x66, 0x49, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x69, 0x72, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x32, 0xe7, 0x0a, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x92, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x2d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e,
+	0x66, 0x74, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xc1, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x37,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x61, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x7b, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x7d, 0x12, 0xc9, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x37, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x2f, 0x7b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x7d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xd5, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x3b, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3c, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66,
+	0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x64, 0x65, 0x66, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x65, 0x66, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0xcb, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x2e, 0x61, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66,
 	0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x55, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
-	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x32,
-	0xd2, 0x07, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x92, 0x01, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66,
+	0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x2f, 0x7b, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x7d, 0x12,
+	0xb7, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x32, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39,
+	0x12, 0x37, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x64, 0x65, 0x66, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49,
+	0x64, 0x7d, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0xd8, 0x01, 0x0a, 0x16, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x3d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x63,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x61, 0x63,
 	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xc1,
-	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x37, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x61,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32,
-	0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x7d, 0x12, 0xc9, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x37, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x38, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xd5,
-	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3b, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65,
-	0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x64, 0x65, 0x66, 0x2f,
-	0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xcb, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3a,
-	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12,
-	0x33, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
-	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x49, 0x64, 0x7d, 0x42, 0xdd, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61,
 	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
 	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
@@ -5362,48 +7906,58 @@ func file_academictoken_academicnft_query_proto_rawDescGZIP() []byte {
 	return file_academictoken_academicnft_query_proto_rawDescData
 }
 
-var file_academictoken_academicnft_query_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_academictoken_academicnft_query_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_academictoken_academicnft_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),                // 0: academictoken.academicnft.QueryParamsRequest
-	(*QueryParamsResponse)(nil),               // 1: academictoken.academicnft.QueryParamsResponse
-	(*QueryGetTokenInstanceRequest)(nil),      // 2: academictoken.academicnft.QueryGetTokenInstanceRequest
-	(*QueryGetTokenInstanceResponse)(nil),     // 3: academictoken.academicnft.QueryGetTokenInstanceResponse
-	(*QueryGetStudentTokensRequest)(nil),      // 4: academictoken.academicnft.QueryGetStudentTokensRequest
-	(*QueryGetStudentTokensResponse)(nil),     // 5: academictoken.academicnft.QueryGetStudentTokensResponse
-	(*QueryGetTokenDefInstancesRequest)(nil),  // 6: academictoken.academicnft.QueryGetTokenDefInstancesRequest
-	(*QueryGetTokenDefInstancesResponse)(nil), // 7: academictoken.academicnft.QueryGetTokenDefInstancesResponse
-	(*QueryVerifyTokenInstanceRequest)(nil),   // 8: academictoken.academicnft.QueryVerifyTokenInstanceRequest
-	(*QueryVerifyTokenInstanceResponse)(nil),  // 9: academictoken.academicnft.QueryVerifyTokenInstanceResponse
-	(*Params)(nil),                            // 10: academictoken.academicnft.Params
-	(*SubjectTokenInstance)(nil),              // 11: academictoken.academicnft.SubjectTokenInstance
-	(*v1beta1.PageRequest)(nil),               // 12: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),              // 13: cosmos.base.query.v1beta1.PageResponse
+	(*QueryParamsRequest)(nil),                  // 0: academictoken.academicnft.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                 // 1: academictoken.academicnft.QueryParamsResponse
+	(*QueryGetTokenInstanceRequest)(nil),        // 2: academictoken.academicnft.QueryGetTokenInstanceRequest
+	(*QueryGetTokenInstanceResponse)(nil),       // 3: academictoken.academicnft.QueryGetTokenInstanceResponse
+	(*QueryGetStudentTokensRequest)(nil),        // 4: academictoken.academicnft.QueryGetStudentTokensRequest
+	(*QueryGetStudentTokensResponse)(nil),       // 5: academictoken.academicnft.QueryGetStudentTokensResponse
+	(*QueryGetTokenDefInstancesRequest)(nil),    // 6: academictoken.academicnft.QueryGetTokenDefInstancesRequest
+	(*QueryGetTokenDefInstancesResponse)(nil),   // 7: academictoken.academicnft.QueryGetTokenDefInstancesResponse
+	(*QueryVerifyTokenInstanceRequest)(nil),     // 8: academictoken.academicnft.QueryVerifyTokenInstanceRequest
+	(*QueryVerifyTokenInstanceResponse)(nil),    // 9: academictoken.academicnft.QueryVerifyTokenInstanceResponse
+	(*QueryTokenSupplyRequest)(nil),             // 10: academictoken.academicnft.QueryTokenSupplyRequest
+	(*QueryTokenSupplyResponse)(nil),            // 11: academictoken.academicnft.QueryTokenSupplyResponse
+	(*QuerySubjectTokenCredentialRequest)(nil),  // 12: academictoken.academicnft.QuerySubjectTokenCredentialRequest
+	(*QuerySubjectTokenCredentialResponse)(nil), // 13: academictoken.academicnft.QuerySubjectTokenCredentialResponse
+	(*Params)(nil),               // 14: academictoken.academicnft.Params
+	(*SubjectTokenInstance)(nil), // 15: academictoken.academicnft.SubjectTokenInstance
+	(*v1beta1.PageRequest)(nil),  // 16: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil), // 17: cosmos.base.query.v1beta1.PageResponse
+	(*TokenSupply)(nil),          // 18: academictoken.academicnft.TokenSupply
 }
 var file_academictoken_academicnft_query_proto_depIdxs = []int32{
-	10, // 0: academictoken.academicnft.QueryParamsResponse.params:type_name -> academictoken.academicnft.Params
-	11, // 1: academictoken.academicnft.QueryGetTokenInstanceResponse.tokenInstance:type_name -> academictoken.academicnft.SubjectTokenInstance
-	12, // 2: academictoken.academicnft.QueryGetStudentTokensRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	11, // 3: academictoken.academicnft.QueryGetStudentTokensResponse.tokenInstances:type_name -> academictoken.academicnft.SubjectTokenInstance
-	13, // 4: academictoken.academicnft.QueryGetStudentTokensResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	12, // 5: academictoken.academicnft.QueryGetTokenDefInstancesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	11, // 6: academictoken.academicnft.QueryGetTokenDefInstancesResponse.tokenInstances:type_name -> academictoken.academicnft.SubjectTokenInstance
-	13, // 7: academictoken.academicnft.QueryGetTokenDefInstancesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	11, // 8: academictoken.academicnft.QueryVerifyTokenInstanceResponse.tokenInstance:type_name -> academictoken.academicnft.SubjectTokenInstance
-	0,  // 9: academictoken.academicnft.Query.Params:input_type -> academictoken.academicnft.QueryParamsRequest
-	2,  // 10: academictoken.academicnft.Query.GetTokenInstance:input_type -> academictoken.academicnft.QueryGetTokenInstanceRequest
-	4,  // 11: academictoken.academicnft.Query.GetStudentTokens:input_type -> academictoken.academicnft.QueryGetStudentTokensRequest
-	6,  // 12: academictoken.academicnft.Query.GetTokenDefInstances:input_type -> academictoken.academicnft.QueryGetTokenDefInstancesRequest
-	8,  // 13: academictoken.academicnft.Query.VerifyTokenInstance:input_type -> academictoken.academicnft.QueryVerifyTokenInstanceRequest
-	1,  // 14: academictoken.academicnft.Query.Params:output_type -> academictoken.academicnft.QueryParamsResponse
-	3,  // 15: academictoken.academicnft.Query.GetTokenInstance:output_type -> academictoken.academicnft.QueryGetTokenInstanceResponse
-	5,  // 16: academictoken.academicnft.Query.GetStudentTokens:output_type -> academictoken.academicnft.QueryGetStudentTokensResponse
-	7,  // 17: academictoken.academicnft.Query.GetTokenDefInstances:output_type -> academictoken.academicnft.QueryGetTokenDefInstancesResponse
-	9,  // 18: academictoken.academicnft.Query.VerifyTokenInstance:output_type -> academictoken.academicnft.QueryVerifyTokenInstanceResponse
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	14, // 0: academictoken.academicnft.QueryParamsResponse.params:type_name -> academictoken.academicnft.Params
+	15, // 1: academictoken.academicnft.QueryGetTokenInstanceResponse.tokenInstance:type_name -> academictoken.academicnft.SubjectTokenInstance
+	16, // 2: academictoken.academicnft.QueryGetStudentTokensRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	15, // 3: academictoken.academicnft.QueryGetStudentTokensResponse.tokenInstances:type_name -> academictoken.academicnft.SubjectTokenInstance
+	17, // 4: academictoken.academicnft.QueryGetStudentTokensResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	16, // 5: academictoken.academicnft.QueryGetTokenDefInstancesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	15, // 6: academictoken.academicnft.QueryGetTokenDefInstancesResponse.tokenInstances:type_name -> academictoken.academicnft.SubjectTokenInstance
+	17, // 7: academictoken.academicnft.QueryGetTokenDefInstancesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	15, // 8: academictoken.academicnft.QueryVerifyTokenInstanceResponse.tokenInstance:type_name -> academictoken.academicnft.SubjectTokenInstance
+	18, // 9: academictoken.academicnft.QueryTokenSupplyResponse.supply:type_name -> academictoken.academicnft.TokenSupply
+	0,  // 10: academictoken.academicnft.Query.Params:input_type -> academictoken.academicnft.QueryParamsRequest
+	2,  // 11: academictoken.academicnft.Query.GetTokenInstance:input_type -> academictoken.academicnft.QueryGetTokenInstanceRequest
+	4,  // 12: academictoken.academicnft.Query.GetStudentTokens:input_type -> academictoken.academicnft.QueryGetStudentTokensRequest
+	6,  // 13: academictoken.academicnft.Query.GetTokenDefInstances:input_type -> academictoken.academicnft.QueryGetTokenDefInstancesRequest
+	8,  // 14: academictoken.academicnft.Query.VerifyTokenInstance:input_type -> academictoken.academicnft.QueryVerifyTokenInstanceRequest
+	10, // 15: academictoken.academicnft.Query.TokenSupply:input_type -> academictoken.academicnft.QueryTokenSupplyRequest
+	12, // 16: academictoken.academicnft.Query.SubjectTokenCredential:input_type -> academictoken.academicnft.QuerySubjectTokenCredentialRequest
+	1,  // 17: academictoken.academicnft.Query.Params:output_type -> academictoken.academicnft.QueryParamsResponse
+	3,  // 18: academictoken.academicnft.Query.GetTokenInstance:output_type -> academictoken.academicnft.QueryGetTokenInstanceResponse
+	5,  // 19: academictoken.academicnft.Query.GetStudentTokens:output_type -> academictoken.academicnft.QueryGetStudentTokensResponse
+	7,  // 20: academictoken.academicnft.Query.GetTokenDefInstances:output_type -> academictoken.academicnft.QueryGetTokenDefInstancesResponse
+	9,  // 21: academictoken.academicnft.Query.VerifyTokenInstance:output_type -> academictoken.academicnft.QueryVerifyTokenInstanceResponse
+	11, // 22: academictoken.academicnft.Query.TokenSupply:output_type -> academictoken.academicnft.QueryTokenSupplyResponse
+	13, // 23: academictoken.academicnft.Query.SubjectTokenCredential:output_type -> academictoken.academicnft.QuerySubjectTokenCredentialResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_academictoken_academicnft_query_proto_init() }
@@ -5413,6 +7967,7 @@ func file_academictoken_academicnft_query_proto_init() {
 	}
 	file_academictoken_academicnft_params_proto_init()
 	file_academictoken_academicnft_subject_token_instance_proto_init()
+	file_academictoken_academicnft_token_supply_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_academictoken_academicnft_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsRequest); i {
@@ -5534,6 +8089,54 @@ func file_academictoken_academicnft_query_proto_init() {
 				return nil
 			}
 		}
+		file_academictoken_academicnft_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTokenSupplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTokenSupplyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySubjectTokenCredentialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySubjectTokenCredentialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5541,7 +8144,7 @@ func file_academictoken_academicnft_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_academictoken_academicnft_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName               = "/academictoken.academicnft.Query/Params"
-	Query_GetTokenInstance_FullMethodName     = "/academictoken.academicnft.Query/GetTokenInstance"
-	Query_GetStudentTokens_FullMethodName     = "/academictoken.academicnft.Query/GetStudentTokens"
-	Query_GetTokenDefInstances_FullMethodName = "/academictoken.academicnft.Query/GetTokenDefInstances"
-	Query_VerifyTokenInstance_FullMethodName  = "/academictoken.academicnft.Query/VerifyTokenInstance"
+	Query_Params_FullMethodName                 = "/academictoken.academicnft.Query/Params"
+	Query_GetTokenInstance_FullMethodName       = "/academictoken.academicnft.Query/GetTokenInstance"
+	Query_GetStudentTokens_FullMethodName       = "/academictoken.academicnft.Query/GetStudentTokens"
+	Query_GetTokenDefInstances_FullMethodName   = "/academictoken.academicnft.Query/GetTokenDefInstances"
+	Query_VerifyTokenInstance_FullMethodName    = "/academictoken.academicnft.Query/VerifyTokenInstance"
+	Query_TokenSupply_FullMethodName            = "/academictoken.academicnft.Query/TokenSupply"
+	Query_SubjectTokenCredential_FullMethodName = "/academictoken.academicnft.Query/SubjectTokenCredential"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +42,10 @@ type QueryClient interface {
 	GetTokenDefInstances(ctx context.Context, in *QueryGetTokenDefInstancesRequest, opts ...grpc.CallOption) (*QueryGetTokenDefInstancesResponse, error)
 	// Verify if a token instance exists and is valid
 	VerifyTokenInstance(ctx context.Context, in *QueryVerifyTokenInstanceRequest, opts ...grpc.CallOption) (*QueryVerifyTokenInstanceResponse, error)
+	// Get the supply of a TokenDef and how many instances can still be minted
+	TokenSupply(ctx context.Context, in *QueryTokenSupplyRequest, opts ...grpc.CallOption) (*QueryTokenSupplyResponse, error)
+	// Render a SubjectTokenInstance as a W3C Verifiable Credential anchored to the queried height
+	SubjectTokenCredential(ctx context.Context, in *QuerySubjectTokenCredentialRequest, opts ...grpc.CallOption) (*QuerySubjectTokenCredentialResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +101,24 @@ func (c *queryClient) VerifyTokenInstance(ctx context.Context, in *QueryVerifyTo
 	return out, nil
 }
 
+func (c *queryClient) TokenSupply(ctx context.Context, in *QueryTokenSupplyRequest, opts ...grpc.CallOption) (*QueryTokenSupplyResponse, error) {
+	out := new(QueryTokenSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_TokenSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubjectTokenCredential(ctx context.Context, in *QuerySubjectTokenCredentialRequest, opts ...grpc.CallOption) (*QuerySubjectTokenCredentialResponse, error) {
+	out := new(QuerySubjectTokenCredentialResponse)
+	err := c.cc.Invoke(ctx, Query_SubjectTokenCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +133,10 @@ type QueryServer interface {
 	GetTokenDefInstances(context.Context, *QueryGetTokenDefInstancesRequest) (*QueryGetTokenDefInstancesResponse, error)
 	// Verify if a token instance exists and is valid
 	VerifyTokenInstance(context.Context, *QueryVerifyTokenInstanceRequest) (*QueryVerifyTokenInstanceResponse, error)
+	// Get the supply of a TokenDef and how many instances can still be minted
+	TokenSupply(context.Context, *QueryTokenSupplyRequest) (*QueryTokenSupplyResponse, error)
+	// Render a SubjectTokenInstance as a W3C Verifiable Credential anchored to the queried height
+	SubjectTokenCredential(context.Context, *QuerySubjectTokenCredentialRequest) (*QuerySubjectTokenCredentialResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +159,12 @@ func (UnimplementedQueryServer) GetTokenDefInstances(context.Context, *QueryGetT
 func (UnimplementedQueryServer) VerifyTokenInstance(context.Context, *QueryVerifyTokenInstanceRequest) (*QueryVerifyTokenInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyTokenInstance not implemented")
 }
+func (UnimplementedQueryServer) TokenSupply(context.Context, *QueryTokenSupplyRequest) (*QueryTokenSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenSupply not implemented")
+}
+func (UnimplementedQueryServer) SubjectTokenCredential(context.Context, *QuerySubjectTokenCredentialRequest) (*QuerySubjectTokenCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubjectTokenCredential not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +268,42 @@ func _Query_VerifyTokenInstance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenSupply(ctx, req.(*QueryTokenSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubjectTokenCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubjectTokenCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubjectTokenCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SubjectTokenCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubjectTokenCredential(ctx, req.(*QuerySubjectTokenCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +331,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyTokenInstance",
 			Handler:    _Query_VerifyTokenInstance_Handler,
 		},
+		{
+			MethodName: "TokenSupply",
+			Handler:    _Query_TokenSupply_Handler,
+		},
+		{
+			MethodName: "SubjectTokenCredential",
+			Handler:    _Query_SubjectTokenCredential_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/academicnft/query.proto",
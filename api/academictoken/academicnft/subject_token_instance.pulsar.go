@@ -4,6 +4,7 @@ package academicnft
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -12,6 +13,57 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_SubjectTokenInstance_10_list)(nil)
+
+type _SubjectTokenInstance_10_list struct {
+	list *[]*GradeAmendment
+}
+
+func (x *_SubjectTokenInstance_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SubjectTokenInstance_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SubjectTokenInstance_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GradeAmendment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SubjectTokenInstance_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GradeAmendment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SubjectTokenInstance_10_list) AppendMutable() protoreflect.Value {
+	v := new(GradeAmendment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SubjectTokenInstance_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SubjectTokenInstance_10_list) NewElement() protoreflect.Value {
+	v := new(GradeAmendment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SubjectTokenInstance_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_SubjectTokenInstance                    protoreflect.MessageDescriptor
 	fd_SubjectTokenInstance_index              protoreflect.FieldDescriptor
@@ -22,6 +74,16 @@ var (
 	fd_SubjectTokenInstance_issuerInstitution  protoreflect.FieldDescriptor
 	fd_SubjectTokenInstance_semester           protoreflect.FieldDescriptor
 	fd_SubjectTokenInstance_professorSignature protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_normalizedGrade    protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_amendments         protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_revoked            protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_revocationReason   protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_revokedBy          protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_revokedAt          protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_signingKeyId       protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_issuedAt           protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_transferCredit     protoreflect.FieldDescriptor
+	fd_SubjectTokenInstance_equivalenceId      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +97,16 @@ func init() {
 	fd_SubjectTokenInstance_issuerInstitution = md_SubjectTokenInstance.Fields().ByName("issuerInstitution")
 	fd_SubjectTokenInstance_semester = md_SubjectTokenInstance.Fields().ByName("semester")
 	fd_SubjectTokenInstance_professorSignature = md_SubjectTokenInstance.Fields().ByName("professorSignature")
+	fd_SubjectTokenInstance_normalizedGrade = md_SubjectTokenInstance.Fields().ByName("normalizedGrade")
+	fd_SubjectTokenInstance_amendments = md_SubjectTokenInstance.Fields().ByName("amendments")
+	fd_SubjectTokenInstance_revoked = md_SubjectTokenInstance.Fields().ByName("revoked")
+	fd_SubjectTokenInstance_revocationReason = md_SubjectTokenInstance.Fields().ByName("revocationReason")
+	fd_SubjectTokenInstance_revokedBy = md_SubjectTokenInstance.Fields().ByName("revokedBy")
+	fd_SubjectTokenInstance_revokedAt = md_SubjectTokenInstance.Fields().ByName("revokedAt")
+	fd_SubjectTokenInstance_signingKeyId = md_SubjectTokenInstance.Fields().ByName("signingKeyId")
+	fd_SubjectTokenInstance_issuedAt = md_SubjectTokenInstance.Fields().ByName("issuedAt")
+	fd_SubjectTokenInstance_transferCredit = md_SubjectTokenInstance.Fields().ByName("transferCredit")
+	fd_SubjectTokenInstance_equivalenceId = md_SubjectTokenInstance.Fields().ByName("equivalenceId")
 }
 
 var _ protoreflect.Message = (*fastReflection_SubjectTokenInstance)(nil)
@@ -150,6 +222,66 @@ func (x *fastReflection_SubjectTokenInstance) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.NormalizedGrade != "" {
+		value := protoreflect.ValueOfString(x.NormalizedGrade)
+		if !f(fd_SubjectTokenInstance_normalizedGrade, value) {
+			return
+		}
+	}
+	if len(x.Amendments) != 0 {
+		value := protoreflect.ValueOfList(&_SubjectTokenInstance_10_list{list: &x.Amendments})
+		if !f(fd_SubjectTokenInstance_amendments, value) {
+			return
+		}
+	}
+	if x.Revoked != false {
+		value := protoreflect.ValueOfBool(x.Revoked)
+		if !f(fd_SubjectTokenInstance_revoked, value) {
+			return
+		}
+	}
+	if x.RevocationReason != "" {
+		value := protoreflect.ValueOfString(x.RevocationReason)
+		if !f(fd_SubjectTokenInstance_revocationReason, value) {
+			return
+		}
+	}
+	if x.RevokedBy != "" {
+		value := protoreflect.ValueOfString(x.RevokedBy)
+		if !f(fd_SubjectTokenInstance_revokedBy, value) {
+			return
+		}
+	}
+	if x.RevokedAt != "" {
+		value := protoreflect.ValueOfString(x.RevokedAt)
+		if !f(fd_SubjectTokenInstance_revokedAt, value) {
+			return
+		}
+	}
+	if x.SigningKeyId != "" {
+		value := protoreflect.ValueOfString(x.SigningKeyId)
+		if !f(fd_SubjectTokenInstance_signingKeyId, value) {
+			return
+		}
+	}
+	if x.IssuedAt != "" {
+		value := protoreflect.ValueOfString(x.IssuedAt)
+		if !f(fd_SubjectTokenInstance_issuedAt, value) {
+			return
+		}
+	}
+	if x.TransferCredit != false {
+		value := protoreflect.ValueOfBool(x.TransferCredit)
+		if !f(fd_SubjectTokenInstance_transferCredit, value) {
+			return
+		}
+	}
+	if x.EquivalenceId != "" {
+		value := protoreflect.ValueOfString(x.EquivalenceId)
+		if !f(fd_SubjectTokenInstance_equivalenceId, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -181,6 +313,26 @@ func (x *fastReflection_SubjectTokenInstance) Has(fd protoreflect.FieldDescripto
 		return x.Semester != ""
 	case "academictoken.academicnft.SubjectTokenInstance.professorSignature":
 		return x.ProfessorSignature != ""
+	case "academictoken.academicnft.SubjectTokenInstance.normalizedGrade":
+		return x.NormalizedGrade != ""
+	case "academictoken.academicnft.SubjectTokenInstance.amendments":
+		return len(x.Amendments) != 0
+	case "academictoken.academicnft.SubjectTokenInstance.revoked":
+		return x.Revoked != false
+	case "academictoken.academicnft.SubjectTokenInstance.revocationReason":
+		return x.RevocationReason != ""
+	case "academictoken.academicnft.SubjectTokenInstance.revokedBy":
+		return x.RevokedBy != ""
+	case "academictoken.academicnft.SubjectTokenInstance.revokedAt":
+		return x.RevokedAt != ""
+	case "academictoken.academicnft.SubjectTokenInstance.signingKeyId":
+		return x.SigningKeyId != ""
+	case "academictoken.academicnft.SubjectTokenInstance.issuedAt":
+		return x.IssuedAt != ""
+	case "academictoken.academicnft.SubjectTokenInstance.transferCredit":
+		return x.TransferCredit != false
+	case "academictoken.academicnft.SubjectTokenInstance.equivalenceId":
+		return x.EquivalenceId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.SubjectTokenInstance"))
@@ -213,6 +365,26 @@ func (x *fastReflection_SubjectTokenInstance) Clear(fd protoreflect.FieldDescrip
 		x.Semester = ""
 	case "academictoken.academicnft.SubjectTokenInstance.professorSignature":
 		x.ProfessorSignature = ""
+	case "academictoken.academicnft.SubjectTokenInstance.normalizedGrade":
+		x.NormalizedGrade = ""
+	case "academictoken.academicnft.SubjectTokenInstance.amendments":
+		x.Amendments = nil
+	case "academictoken.academicnft.SubjectTokenInstance.revoked":
+		x.Revoked = false
+	case "academictoken.academicnft.SubjectTokenInstance.revocationReason":
+		x.RevocationReason = ""
+	case "academictoken.academicnft.SubjectTokenInstance.revokedBy":
+		x.RevokedBy = ""
+	case "academictoken.academicnft.SubjectTokenInstance.revokedAt":
+		x.RevokedAt = ""
+	case "academictoken.academicnft.SubjectTokenInstance.signingKeyId":
+		x.SigningKeyId = ""
+	case "academictoken.academicnft.SubjectTokenInstance.issuedAt":
+		x.IssuedAt = ""
+	case "academictoken.academicnft.SubjectTokenInstance.transferCredit":
+		x.TransferCredit = false
+	case "academictoken.academicnft.SubjectTokenInstance.equivalenceId":
+		x.EquivalenceId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.SubjectTokenInstance"))
@@ -253,6 +425,39 @@ func (x *fastReflection_SubjectTokenInstance) Get(descriptor protoreflect.FieldD
 	case "academictoken.academicnft.SubjectTokenInstance.professorSignature":
 		value := x.ProfessorSignature
 		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.SubjectTokenInstance.normalizedGrade":
+		value := x.NormalizedGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.SubjectTokenInstance.amendments":
+		if len(x.Amendments) == 0 {
+			return protoreflect.ValueOfList(&_SubjectTokenInstance_10_list{})
+		}
+		listValue := &_SubjectTokenInstance_10_list{list: &x.Amendments}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.academicnft.SubjectTokenInstance.revoked":
+		value := x.Revoked
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.academicnft.SubjectTokenInstance.revocationReason":
+		value := x.RevocationReason
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.SubjectTokenInstance.revokedBy":
+		value := x.RevokedBy
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.SubjectTokenInstance.revokedAt":
+		value := x.RevokedAt
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.SubjectTokenInstance.signingKeyId":
+		value := x.SigningKeyId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.SubjectTokenInstance.issuedAt":
+		value := x.IssuedAt
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.SubjectTokenInstance.transferCredit":
+		value := x.TransferCredit
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.academicnft.SubjectTokenInstance.equivalenceId":
+		value := x.EquivalenceId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.SubjectTokenInstance"))
@@ -289,6 +494,28 @@ func (x *fastReflection_SubjectTokenInstance) Set(fd protoreflect.FieldDescripto
 		x.Semester = value.Interface().(string)
 	case "academictoken.academicnft.SubjectTokenInstance.professorSignature":
 		x.ProfessorSignature = value.Interface().(string)
+	case "academictoken.academicnft.SubjectTokenInstance.normalizedGrade":
+		x.NormalizedGrade = value.Interface().(string)
+	case "academictoken.academicnft.SubjectTokenInstance.amendments":
+		lv := value.List()
+		clv := lv.(*_SubjectTokenInstance_10_list)
+		x.Amendments = *clv.list
+	case "academictoken.academicnft.SubjectTokenInstance.revoked":
+		x.Revoked = value.Bool()
+	case "academictoken.academicnft.SubjectTokenInstance.revocationReason":
+		x.RevocationReason = value.Interface().(string)
+	case "academictoken.academicnft.SubjectTokenInstance.revokedBy":
+		x.RevokedBy = value.Interface().(string)
+	case "academictoken.academicnft.SubjectTokenInstance.revokedAt":
+		x.RevokedAt = value.Interface().(string)
+	case "academictoken.academicnft.SubjectTokenInstance.signingKeyId":
+		x.SigningKeyId = value.Interface().(string)
+	case "academictoken.academicnft.SubjectTokenInstance.issuedAt":
+		x.IssuedAt = value.Interface().(string)
+	case "academictoken.academicnft.SubjectTokenInstance.transferCredit":
+		x.TransferCredit = value.Bool()
+	case "academictoken.academicnft.SubjectTokenInstance.equivalenceId":
+		x.EquivalenceId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.SubjectTokenInstance"))
@@ -309,6 +536,12 @@ func (x *fastReflection_SubjectTokenInstance) Set(fd protoreflect.FieldDescripto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_SubjectTokenInstance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "academictoken.academicnft.SubjectTokenInstance.amendments":
+		if x.Amendments == nil {
+			x.Amendments = []*GradeAmendment{}
+		}
+		value := &_SubjectTokenInstance_10_list{list: &x.Amendments}
+		return protoreflect.ValueOfList(value)
 	case "academictoken.academicnft.SubjectTokenInstance.index":
 		panic(fmt.Errorf("field index of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
 	case "academictoken.academicnft.SubjectTokenInstance.tokenDefId":
@@ -325,6 +558,24 @@ func (x *fastReflection_SubjectTokenInstance) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field semester of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
 	case "academictoken.academicnft.SubjectTokenInstance.professorSignature":
 		panic(fmt.Errorf("field professorSignature of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.normalizedGrade":
+		panic(fmt.Errorf("field normalizedGrade of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.revoked":
+		panic(fmt.Errorf("field revoked of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.revocationReason":
+		panic(fmt.Errorf("field revocationReason of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.revokedBy":
+		panic(fmt.Errorf("field revokedBy of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.revokedAt":
+		panic(fmt.Errorf("field revokedAt of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.signingKeyId":
+		panic(fmt.Errorf("field signingKeyId of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.issuedAt":
+		panic(fmt.Errorf("field issuedAt of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.transferCredit":
+		panic(fmt.Errorf("field transferCredit of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
+	case "academictoken.academicnft.SubjectTokenInstance.equivalenceId":
+		panic(fmt.Errorf("field equivalenceId of message academictoken.academicnft.SubjectTokenInstance is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.SubjectTokenInstance"))
@@ -354,6 +605,27 @@ func (x *fastReflection_SubjectTokenInstance) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfString("")
 	case "academictoken.academicnft.SubjectTokenInstance.professorSignature":
 		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.SubjectTokenInstance.normalizedGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.SubjectTokenInstance.amendments":
+		list := []*GradeAmendment{}
+		return protoreflect.ValueOfList(&_SubjectTokenInstance_10_list{list: &list})
+	case "academictoken.academicnft.SubjectTokenInstance.revoked":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.academicnft.SubjectTokenInstance.revocationReason":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.SubjectTokenInstance.revokedBy":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.SubjectTokenInstance.revokedAt":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.SubjectTokenInstance.signingKeyId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.SubjectTokenInstance.issuedAt":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.SubjectTokenInstance.transferCredit":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.academicnft.SubjectTokenInstance.equivalenceId":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.SubjectTokenInstance"))
@@ -455,6 +727,46 @@ func (x *fastReflection_SubjectTokenInstance) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.NormalizedGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amendments) > 0 {
+			for _, e := range x.Amendments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Revoked {
+			n += 2
+		}
+		l = len(x.RevocationReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RevokedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RevokedAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SigningKeyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IssuedAt)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.TransferCredit {
+			n += 3
+		}
+		l = len(x.EquivalenceId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -484,6 +796,97 @@ func (x *fastReflection_SubjectTokenInstance) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.EquivalenceId) > 0 {
+			i -= len(x.EquivalenceId)
+			copy(dAtA[i:], x.EquivalenceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EquivalenceId)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.TransferCredit {
+			i--
+			if x.TransferCredit {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.IssuedAt) > 0 {
+			i -= len(x.IssuedAt)
+			copy(dAtA[i:], x.IssuedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IssuedAt)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.SigningKeyId) > 0 {
+			i -= len(x.SigningKeyId)
+			copy(dAtA[i:], x.SigningKeyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningKeyId)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.RevokedAt) > 0 {
+			i -= len(x.RevokedAt)
+			copy(dAtA[i:], x.RevokedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevokedAt)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.RevokedBy) > 0 {
+			i -= len(x.RevokedBy)
+			copy(dAtA[i:], x.RevokedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevokedBy)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.RevocationReason) > 0 {
+			i -= len(x.RevocationReason)
+			copy(dAtA[i:], x.RevocationReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevocationReason)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Revoked {
+			i--
+			if x.Revoked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Amendments) > 0 {
+			for iNdEx := len(x.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amendments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.NormalizedGrade) > 0 {
+			i -= len(x.NormalizedGrade)
+			copy(dAtA[i:], x.NormalizedGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NormalizedGrade)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.ProfessorSignature) > 0 {
 			i -= len(x.ProfessorSignature)
 			copy(dAtA[i:], x.ProfessorSignature)
@@ -845,67 +1248,1179 @@ func (x *fastReflection_SubjectTokenInstance) ProtoMethods() *protoiface.Methods
 				}
 				x.ProfessorSignature = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizedGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NormalizedGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amendments = append(x.Amendments, &GradeAmendment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amendments[len(x.Amendments)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revoked = bool(v != 0)
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.RevocationReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: academictoken/academicnft/subject_token_instance.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type SubjectTokenInstance struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevokedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevokedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningKeyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IssuedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferCredit", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.TransferCredit = bool(v != 0)
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EquivalenceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EquivalenceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GradeAmendment                         protoreflect.MessageDescriptor
+	fd_GradeAmendment_previousGrade           protoreflect.FieldDescriptor
+	fd_GradeAmendment_newGrade                protoreflect.FieldDescriptor
+	fd_GradeAmendment_previousNormalizedGrade protoreflect.FieldDescriptor
+	fd_GradeAmendment_newNormalizedGrade      protoreflect.FieldDescriptor
+	fd_GradeAmendment_reason                  protoreflect.FieldDescriptor
+	fd_GradeAmendment_amendedBy               protoreflect.FieldDescriptor
+	fd_GradeAmendment_amendedAt               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_subject_token_instance_proto_init()
+	md_GradeAmendment = File_academictoken_academicnft_subject_token_instance_proto.Messages().ByName("GradeAmendment")
+	fd_GradeAmendment_previousGrade = md_GradeAmendment.Fields().ByName("previousGrade")
+	fd_GradeAmendment_newGrade = md_GradeAmendment.Fields().ByName("newGrade")
+	fd_GradeAmendment_previousNormalizedGrade = md_GradeAmendment.Fields().ByName("previousNormalizedGrade")
+	fd_GradeAmendment_newNormalizedGrade = md_GradeAmendment.Fields().ByName("newNormalizedGrade")
+	fd_GradeAmendment_reason = md_GradeAmendment.Fields().ByName("reason")
+	fd_GradeAmendment_amendedBy = md_GradeAmendment.Fields().ByName("amendedBy")
+	fd_GradeAmendment_amendedAt = md_GradeAmendment.Fields().ByName("amendedAt")
+}
+
+var _ protoreflect.Message = (*fastReflection_GradeAmendment)(nil)
+
+type fastReflection_GradeAmendment GradeAmendment
+
+func (x *GradeAmendment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GradeAmendment)(x)
+}
+
+func (x *GradeAmendment) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_subject_token_instance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GradeAmendment_messageType fastReflection_GradeAmendment_messageType
+var _ protoreflect.MessageType = fastReflection_GradeAmendment_messageType{}
+
+type fastReflection_GradeAmendment_messageType struct{}
+
+func (x fastReflection_GradeAmendment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GradeAmendment)(nil)
+}
+func (x fastReflection_GradeAmendment_messageType) New() protoreflect.Message {
+	return new(fastReflection_GradeAmendment)
+}
+func (x fastReflection_GradeAmendment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GradeAmendment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GradeAmendment) Descriptor() protoreflect.MessageDescriptor {
+	return md_GradeAmendment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GradeAmendment) Type() protoreflect.MessageType {
+	return _fastReflection_GradeAmendment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GradeAmendment) New() protoreflect.Message {
+	return new(fastReflection_GradeAmendment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GradeAmendment) Interface() protoreflect.ProtoMessage {
+	return (*GradeAmendment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GradeAmendment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PreviousGrade != "" {
+		value := protoreflect.ValueOfString(x.PreviousGrade)
+		if !f(fd_GradeAmendment_previousGrade, value) {
+			return
+		}
+	}
+	if x.NewGrade != "" {
+		value := protoreflect.ValueOfString(x.NewGrade)
+		if !f(fd_GradeAmendment_newGrade, value) {
+			return
+		}
+	}
+	if x.PreviousNormalizedGrade != "" {
+		value := protoreflect.ValueOfString(x.PreviousNormalizedGrade)
+		if !f(fd_GradeAmendment_previousNormalizedGrade, value) {
+			return
+		}
+	}
+	if x.NewNormalizedGrade != "" {
+		value := protoreflect.ValueOfString(x.NewNormalizedGrade)
+		if !f(fd_GradeAmendment_newNormalizedGrade, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_GradeAmendment_reason, value) {
+			return
+		}
+	}
+	if x.AmendedBy != "" {
+		value := protoreflect.ValueOfString(x.AmendedBy)
+		if !f(fd_GradeAmendment_amendedBy, value) {
+			return
+		}
+	}
+	if x.AmendedAt != "" {
+		value := protoreflect.ValueOfString(x.AmendedAt)
+		if !f(fd_GradeAmendment_amendedAt, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GradeAmendment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.GradeAmendment.previousGrade":
+		return x.PreviousGrade != ""
+	case "academictoken.academicnft.GradeAmendment.newGrade":
+		return x.NewGrade != ""
+	case "academictoken.academicnft.GradeAmendment.previousNormalizedGrade":
+		return x.PreviousNormalizedGrade != ""
+	case "academictoken.academicnft.GradeAmendment.newNormalizedGrade":
+		return x.NewNormalizedGrade != ""
+	case "academictoken.academicnft.GradeAmendment.reason":
+		return x.Reason != ""
+	case "academictoken.academicnft.GradeAmendment.amendedBy":
+		return x.AmendedBy != ""
+	case "academictoken.academicnft.GradeAmendment.amendedAt":
+		return x.AmendedAt != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GradeAmendment"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.GradeAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GradeAmendment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.GradeAmendment.previousGrade":
+		x.PreviousGrade = ""
+	case "academictoken.academicnft.GradeAmendment.newGrade":
+		x.NewGrade = ""
+	case "academictoken.academicnft.GradeAmendment.previousNormalizedGrade":
+		x.PreviousNormalizedGrade = ""
+	case "academictoken.academicnft.GradeAmendment.newNormalizedGrade":
+		x.NewNormalizedGrade = ""
+	case "academictoken.academicnft.GradeAmendment.reason":
+		x.Reason = ""
+	case "academictoken.academicnft.GradeAmendment.amendedBy":
+		x.AmendedBy = ""
+	case "academictoken.academicnft.GradeAmendment.amendedAt":
+		x.AmendedAt = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GradeAmendment"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.GradeAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GradeAmendment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.GradeAmendment.previousGrade":
+		value := x.PreviousGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.GradeAmendment.newGrade":
+		value := x.NewGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.GradeAmendment.previousNormalizedGrade":
+		value := x.PreviousNormalizedGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.GradeAmendment.newNormalizedGrade":
+		value := x.NewNormalizedGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.GradeAmendment.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.GradeAmendment.amendedBy":
+		value := x.AmendedBy
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.GradeAmendment.amendedAt":
+		value := x.AmendedAt
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GradeAmendment"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.GradeAmendment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GradeAmendment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.GradeAmendment.previousGrade":
+		x.PreviousGrade = value.Interface().(string)
+	case "academictoken.academicnft.GradeAmendment.newGrade":
+		x.NewGrade = value.Interface().(string)
+	case "academictoken.academicnft.GradeAmendment.previousNormalizedGrade":
+		x.PreviousNormalizedGrade = value.Interface().(string)
+	case "academictoken.academicnft.GradeAmendment.newNormalizedGrade":
+		x.NewNormalizedGrade = value.Interface().(string)
+	case "academictoken.academicnft.GradeAmendment.reason":
+		x.Reason = value.Interface().(string)
+	case "academictoken.academicnft.GradeAmendment.amendedBy":
+		x.AmendedBy = value.Interface().(string)
+	case "academictoken.academicnft.GradeAmendment.amendedAt":
+		x.AmendedAt = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GradeAmendment"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.GradeAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GradeAmendment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.GradeAmendment.previousGrade":
+		panic(fmt.Errorf("field previousGrade of message academictoken.academicnft.GradeAmendment is not mutable"))
+	case "academictoken.academicnft.GradeAmendment.newGrade":
+		panic(fmt.Errorf("field newGrade of message academictoken.academicnft.GradeAmendment is not mutable"))
+	case "academictoken.academicnft.GradeAmendment.previousNormalizedGrade":
+		panic(fmt.Errorf("field previousNormalizedGrade of message academictoken.academicnft.GradeAmendment is not mutable"))
+	case "academictoken.academicnft.GradeAmendment.newNormalizedGrade":
+		panic(fmt.Errorf("field newNormalizedGrade of message academictoken.academicnft.GradeAmendment is not mutable"))
+	case "academictoken.academicnft.GradeAmendment.reason":
+		panic(fmt.Errorf("field reason of message academictoken.academicnft.GradeAmendment is not mutable"))
+	case "academictoken.academicnft.GradeAmendment.amendedBy":
+		panic(fmt.Errorf("field amendedBy of message academictoken.academicnft.GradeAmendment is not mutable"))
+	case "academictoken.academicnft.GradeAmendment.amendedAt":
+		panic(fmt.Errorf("field amendedAt of message academictoken.academicnft.GradeAmendment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GradeAmendment"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.GradeAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GradeAmendment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.GradeAmendment.previousGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.GradeAmendment.newGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.GradeAmendment.previousNormalizedGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.GradeAmendment.newNormalizedGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.GradeAmendment.reason":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.GradeAmendment.amendedBy":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.GradeAmendment.amendedAt":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.GradeAmendment"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.GradeAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GradeAmendment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.GradeAmendment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GradeAmendment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GradeAmendment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GradeAmendment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GradeAmendment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GradeAmendment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PreviousGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousNormalizedGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewNormalizedGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AmendedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AmendedAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GradeAmendment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AmendedAt) > 0 {
+			i -= len(x.AmendedAt)
+			copy(dAtA[i:], x.AmendedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmendedAt)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.AmendedBy) > 0 {
+			i -= len(x.AmendedBy)
+			copy(dAtA[i:], x.AmendedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmendedBy)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.NewNormalizedGrade) > 0 {
+			i -= len(x.NewNormalizedGrade)
+			copy(dAtA[i:], x.NewNormalizedGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewNormalizedGrade)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PreviousNormalizedGrade) > 0 {
+			i -= len(x.PreviousNormalizedGrade)
+			copy(dAtA[i:], x.PreviousNormalizedGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousNormalizedGrade)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.NewGrade) > 0 {
+			i -= len(x.NewGrade)
+			copy(dAtA[i:], x.NewGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewGrade)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PreviousGrade) > 0 {
+			i -= len(x.PreviousGrade)
+			copy(dAtA[i:], x.PreviousGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousGrade)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GradeAmendment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GradeAmendment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GradeAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousNormalizedGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousNormalizedGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewNormalizedGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewNormalizedGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmendedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmendedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmendedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmendedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/academicnft/subject_token_instance.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubjectTokenInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index              string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	TokenDefId         string `protobuf:"bytes,2,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
-	Student            string `protobuf:"bytes,3,opt,name=student,proto3" json:"student,omitempty"`
-	CompletionDate     string `protobuf:"bytes,4,opt,name=completionDate,proto3" json:"completionDate,omitempty"`
-	Grade              string `protobuf:"bytes,5,opt,name=grade,proto3" json:"grade,omitempty"`
-	IssuerInstitution  string `protobuf:"bytes,6,opt,name=issuerInstitution,proto3" json:"issuerInstitution,omitempty"`
-	Semester           string `protobuf:"bytes,7,opt,name=semester,proto3" json:"semester,omitempty"`
-	ProfessorSignature string `protobuf:"bytes,8,opt,name=professorSignature,proto3" json:"professorSignature,omitempty"`
+	Index              string            `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	TokenDefId         string            `protobuf:"bytes,2,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
+	Student            string            `protobuf:"bytes,3,opt,name=student,proto3" json:"student,omitempty"`
+	CompletionDate     string            `protobuf:"bytes,4,opt,name=completionDate,proto3" json:"completionDate,omitempty"`
+	Grade              string            `protobuf:"bytes,5,opt,name=grade,proto3" json:"grade,omitempty"`
+	IssuerInstitution  string            `protobuf:"bytes,6,opt,name=issuerInstitution,proto3" json:"issuerInstitution,omitempty"`
+	Semester           string            `protobuf:"bytes,7,opt,name=semester,proto3" json:"semester,omitempty"`
+	ProfessorSignature string            `protobuf:"bytes,8,opt,name=professorSignature,proto3" json:"professorSignature,omitempty"`
+	NormalizedGrade    string            `protobuf:"bytes,9,opt,name=normalizedGrade,proto3" json:"normalizedGrade,omitempty"`
+	Amendments         []*GradeAmendment `protobuf:"bytes,10,rep,name=amendments,proto3" json:"amendments,omitempty"` // full history of grade corrections
+	Revoked            bool              `protobuf:"varint,11,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevocationReason   string            `protobuf:"bytes,12,opt,name=revocationReason,proto3" json:"revocationReason,omitempty"`
+	RevokedBy          string            `protobuf:"bytes,13,opt,name=revokedBy,proto3" json:"revokedBy,omitempty"`
+	RevokedAt          string            `protobuf:"bytes,14,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
+	SigningKeyId       string            `protobuf:"bytes,15,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"` // institution signing key that produced professorSignature
+	IssuedAt           string            `protobuf:"bytes,16,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	TransferCredit     bool              `protobuf:"varint,17,opt,name=transferCredit,proto3" json:"transferCredit,omitempty"` // credited through an approved equivalence, not graded by the institution
+	EquivalenceId      string            `protobuf:"bytes,18,opt,name=equivalenceId,proto3" json:"equivalenceId,omitempty"`    // equivalence a transfer-credit token was issued for
 }
 
 func (x *SubjectTokenInstance) Reset() {
@@ -984,6 +2499,160 @@ func (x *SubjectTokenInstance) GetProfessorSignature() string {
 	return ""
 }
 
+func (x *SubjectTokenInstance) GetNormalizedGrade() string {
+	if x != nil {
+		return x.NormalizedGrade
+	}
+	return ""
+}
+
+func (x *SubjectTokenInstance) GetAmendments() []*GradeAmendment {
+	if x != nil {
+		return x.Amendments
+	}
+	return nil
+}
+
+func (x *SubjectTokenInstance) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *SubjectTokenInstance) GetRevocationReason() string {
+	if x != nil {
+		return x.RevocationReason
+	}
+	return ""
+}
+
+func (x *SubjectTokenInstance) GetRevokedBy() string {
+	if x != nil {
+		return x.RevokedBy
+	}
+	return ""
+}
+
+func (x *SubjectTokenInstance) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+func (x *SubjectTokenInstance) GetSigningKeyId() string {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return ""
+}
+
+func (x *SubjectTokenInstance) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *SubjectTokenInstance) GetTransferCredit() bool {
+	if x != nil {
+		return x.TransferCredit
+	}
+	return false
+}
+
+func (x *SubjectTokenInstance) GetEquivalenceId() string {
+	if x != nil {
+		return x.EquivalenceId
+	}
+	return ""
+}
+
+// GradeAmendment records one correction of the grade of a token instance
+type GradeAmendment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousGrade           string `protobuf:"bytes,1,opt,name=previousGrade,proto3" json:"previousGrade,omitempty"`
+	NewGrade                string `protobuf:"bytes,2,opt,name=newGrade,proto3" json:"newGrade,omitempty"`
+	PreviousNormalizedGrade string `protobuf:"bytes,3,opt,name=previousNormalizedGrade,proto3" json:"previousNormalizedGrade,omitempty"`
+	NewNormalizedGrade      string `protobuf:"bytes,4,opt,name=newNormalizedGrade,proto3" json:"newNormalizedGrade,omitempty"`
+	Reason                  string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	AmendedBy               string `protobuf:"bytes,6,opt,name=amendedBy,proto3" json:"amendedBy,omitempty"`
+	AmendedAt               string `protobuf:"bytes,7,opt,name=amendedAt,proto3" json:"amendedAt,omitempty"`
+}
+
+func (x *GradeAmendment) Reset() {
+	*x = GradeAmendment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_subject_token_instance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeAmendment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeAmendment) ProtoMessage() {}
+
+// Deprecated: Use GradeAmendment.ProtoReflect.Descriptor instead.
+func (*GradeAmendment) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_subject_token_instance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GradeAmendment) GetPreviousGrade() string {
+	if x != nil {
+		return x.PreviousGrade
+	}
+	return ""
+}
+
+func (x *GradeAmendment) GetNewGrade() string {
+	if x != nil {
+		return x.NewGrade
+	}
+	return ""
+}
+
+func (x *GradeAmendment) GetPreviousNormalizedGrade() string {
+	if x != nil {
+		return x.PreviousNormalizedGrade
+	}
+	return ""
+}
+
+func (x *GradeAmendment) GetNewNormalizedGrade() string {
+	if x != nil {
+		return x.NewNormalizedGrade
+	}
+	return ""
+}
+
+func (x *GradeAmendment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GradeAmendment) GetAmendedBy() string {
+	if x != nil {
+		return x.AmendedBy
+	}
+	return ""
+}
+
+func (x *GradeAmendment) GetAmendedAt() string {
+	if x != nil {
+		return x.AmendedAt
+	}
+	return ""
+}
+
 var File_academictoken_academicnft_subject_token_instance_proto protoreflect.FileDescriptor
 
 var file_academictoken_academicnft_subject_token_instance_proto_rawDesc = []byte{
@@ -992,40 +2661,83 @@ var file_academictoken_academicnft_subject_token_instance_proto_rawDesc = []byte
 	0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
 	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
 	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x6e, 0x66, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73,
-	0x73, 0x75, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x49, 0x6e, 0x73,
-	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65,
-	0x73, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x6f,
-	0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x42, 0xec, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x42, 0x19, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
-	0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
-	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e,
-	0x66, 0x74, 0xca, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5c, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xe2, 0x02,
-	0x25, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x41,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
-	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x6e, 0x66, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x66, 0x74, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x05, 0x0a, 0x14, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x65, 0x66, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x72,
+	0x6f, 0x66, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x6e, 0x66, 0x74, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x61, 0x6d, 0x65, 0x6e, 0x64,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x90, 0x02, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41,
+	0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x42, 0xec, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x42, 0x19, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x6e, 0x66, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xca, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e,
+	0x66, 0x74, 0xe2, 0x02, 0x25, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5c, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x41, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1040,16 +2752,18 @@ func file_academictoken_academicnft_subject_token_instance_proto_rawDescGZIP() [
 	return file_academictoken_academicnft_subject_token_instance_proto_rawDescData
 }
 
-var file_academictoken_academicnft_subject_token_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_academictoken_academicnft_subject_token_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_academictoken_academicnft_subject_token_instance_proto_goTypes = []interface{}{
 	(*SubjectTokenInstance)(nil), // 0: academictoken.academicnft.SubjectTokenInstance
+	(*GradeAmendment)(nil),       // 1: academictoken.academicnft.GradeAmendment
 }
 var file_academictoken_academicnft_subject_token_instance_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: academictoken.academicnft.SubjectTokenInstance.amendments:type_name -> academictoken.academicnft.GradeAmendment
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_academictoken_academicnft_subject_token_instance_proto_init() }
@@ -1070,6 +2784,18 @@ func file_academictoken_academicnft_subject_token_instance_proto_init() {
 				return nil
 			}
 		}
+		file_academictoken_academicnft_subject_token_instance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeAmendment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1077,7 +2803,7 @@ func file_academictoken_academicnft_subject_token_instance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_academictoken_academicnft_subject_token_instance_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
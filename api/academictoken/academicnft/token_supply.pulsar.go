@@ -0,0 +1,690 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package academicnft
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_TokenSupply            protoreflect.MessageDescriptor
+	fd_TokenSupply_tokenDefId protoreflect.FieldDescriptor
+	fd_TokenSupply_minted     protoreflect.FieldDescriptor
+	fd_TokenSupply_burned     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_token_supply_proto_init()
+	md_TokenSupply = File_academictoken_academicnft_token_supply_proto.Messages().ByName("TokenSupply")
+	fd_TokenSupply_tokenDefId = md_TokenSupply.Fields().ByName("tokenDefId")
+	fd_TokenSupply_minted = md_TokenSupply.Fields().ByName("minted")
+	fd_TokenSupply_burned = md_TokenSupply.Fields().ByName("burned")
+}
+
+var _ protoreflect.Message = (*fastReflection_TokenSupply)(nil)
+
+type fastReflection_TokenSupply TokenSupply
+
+func (x *TokenSupply) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenSupply)(x)
+}
+
+func (x *TokenSupply) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_token_supply_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TokenSupply_messageType fastReflection_TokenSupply_messageType
+var _ protoreflect.MessageType = fastReflection_TokenSupply_messageType{}
+
+type fastReflection_TokenSupply_messageType struct{}
+
+func (x fastReflection_TokenSupply_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenSupply)(nil)
+}
+func (x fastReflection_TokenSupply_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenSupply)
+}
+func (x fastReflection_TokenSupply_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenSupply
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TokenSupply) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenSupply
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TokenSupply) Type() protoreflect.MessageType {
+	return _fastReflection_TokenSupply_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TokenSupply) New() protoreflect.Message {
+	return new(fastReflection_TokenSupply)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TokenSupply) Interface() protoreflect.ProtoMessage {
+	return (*TokenSupply)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TokenSupply) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TokenDefId != "" {
+		value := protoreflect.ValueOfString(x.TokenDefId)
+		if !f(fd_TokenSupply_tokenDefId, value) {
+			return
+		}
+	}
+	if x.Minted != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Minted)
+		if !f(fd_TokenSupply_minted, value) {
+			return
+		}
+	}
+	if x.Burned != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Burned)
+		if !f(fd_TokenSupply_burned, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TokenSupply) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.TokenSupply.tokenDefId":
+		return x.TokenDefId != ""
+	case "academictoken.academicnft.TokenSupply.minted":
+		return x.Minted != uint64(0)
+	case "academictoken.academicnft.TokenSupply.burned":
+		return x.Burned != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.TokenSupply"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.TokenSupply does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenSupply) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.TokenSupply.tokenDefId":
+		x.TokenDefId = ""
+	case "academictoken.academicnft.TokenSupply.minted":
+		x.Minted = uint64(0)
+	case "academictoken.academicnft.TokenSupply.burned":
+		x.Burned = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.TokenSupply"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.TokenSupply does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TokenSupply) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.TokenSupply.tokenDefId":
+		value := x.TokenDefId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.TokenSupply.minted":
+		value := x.Minted
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.academicnft.TokenSupply.burned":
+		value := x.Burned
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.TokenSupply"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.TokenSupply does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenSupply) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.TokenSupply.tokenDefId":
+		x.TokenDefId = value.Interface().(string)
+	case "academictoken.academicnft.TokenSupply.minted":
+		x.Minted = value.Uint()
+	case "academictoken.academicnft.TokenSupply.burned":
+		x.Burned = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.TokenSupply"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.TokenSupply does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenSupply) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.TokenSupply.tokenDefId":
+		panic(fmt.Errorf("field tokenDefId of message academictoken.academicnft.TokenSupply is not mutable"))
+	case "academictoken.academicnft.TokenSupply.minted":
+		panic(fmt.Errorf("field minted of message academictoken.academicnft.TokenSupply is not mutable"))
+	case "academictoken.academicnft.TokenSupply.burned":
+		panic(fmt.Errorf("field burned of message academictoken.academicnft.TokenSupply is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.TokenSupply"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.TokenSupply does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TokenSupply) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.TokenSupply.tokenDefId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.TokenSupply.minted":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.academicnft.TokenSupply.burned":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.TokenSupply"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.TokenSupply does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TokenSupply) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.TokenSupply", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TokenSupply) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenSupply) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TokenSupply) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TokenSupply) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TokenSupply)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TokenDefId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Minted != 0 {
+			n += 1 + runtime.Sov(uint64(x.Minted))
+		}
+		if x.Burned != 0 {
+			n += 1 + runtime.Sov(uint64(x.Burned))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TokenSupply)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Burned != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Burned))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Minted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Minted))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.TokenDefId) > 0 {
+			i -= len(x.TokenDefId)
+			copy(dAtA[i:], x.TokenDefId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenDefId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TokenSupply)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenSupply: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenDefId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenDefId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				}
+				x.Minted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Minted |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+				}
+				x.Burned = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Burned |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/academicnft/token_supply.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TokenSupply accounts for the token instances issued under a token
+// definition. Burned instances do not free supply: maxSupply caps the
+// number of instances ever minted.
+type TokenSupply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenDefId string `protobuf:"bytes,1,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
+	Minted     uint64 `protobuf:"varint,2,opt,name=minted,proto3" json:"minted,omitempty"`
+	Burned     uint64 `protobuf:"varint,3,opt,name=burned,proto3" json:"burned,omitempty"`
+}
+
+func (x *TokenSupply) Reset() {
+	*x = TokenSupply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_token_supply_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenSupply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenSupply) ProtoMessage() {}
+
+// Deprecated: Use TokenSupply.ProtoReflect.Descriptor instead.
+func (*TokenSupply) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_token_supply_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TokenSupply) GetTokenDefId() string {
+	if x != nil {
+		return x.TokenDefId
+	}
+	return ""
+}
+
+func (x *TokenSupply) GetMinted() uint64 {
+	if x != nil {
+		return x.Minted
+	}
+	return 0
+}
+
+func (x *TokenSupply) GetBurned() uint64 {
+	if x != nil {
+		return x.Burned
+	}
+	return 0
+}
+
+var File_academictoken_academicnft_token_supply_proto protoreflect.FileDescriptor
+
+var file_academictoken_academicnft_token_supply_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x22, 0x5d, 0x0a, 0x0b, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x65, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x42, 0xe3, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x42, 0x10, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x41,
+	0x58, 0xaa, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xca, 0x02, 0x19,
+	0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x41, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xe2, 0x02, 0x25, 0x41, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x6e, 0x66, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_academictoken_academicnft_token_supply_proto_rawDescOnce sync.Once
+	file_academictoken_academicnft_token_supply_proto_rawDescData = file_academictoken_academicnft_token_supply_proto_rawDesc
+)
+
+func file_academictoken_academicnft_token_supply_proto_rawDescGZIP() []byte {
+	file_academictoken_academicnft_token_supply_proto_rawDescOnce.Do(func() {
+		file_academictoken_academicnft_token_supply_proto_rawDescData = protoimpl.X.CompressGZIP(file_academictoken_academicnft_token_supply_proto_rawDescData)
+	})
+	return file_academictoken_academicnft_token_supply_proto_rawDescData
+}
+
+var file_academictoken_academicnft_token_supply_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_academictoken_academicnft_token_supply_proto_goTypes = []interface{}{
+	(*TokenSupply)(nil), // 0: academictoken.academicnft.TokenSupply
+}
+var file_academictoken_academicnft_token_supply_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_academictoken_academicnft_token_supply_proto_init() }
+func file_academictoken_academicnft_token_supply_proto_init() {
+	if File_academictoken_academicnft_token_supply_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_academictoken_academicnft_token_supply_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenSupply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_academictoken_academicnft_token_supply_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_academictoken_academicnft_token_supply_proto_goTypes,
+		DependencyIndexes: file_academictoken_academicnft_token_supply_proto_depIdxs,
+		MessageInfos:      file_academictoken_academicnft_token_supply_proto_msgTypes,
+	}.Build()
+	File_academictoken_academicnft_token_supply_proto = out.File
+	file_academictoken_academicnft_token_supply_proto_rawDesc = nil
+	file_academictoken_academicnft_token_supply_proto_goTypes = nil
+	file_academictoken_academicnft_token_supply_proto_depIdxs = nil
+}
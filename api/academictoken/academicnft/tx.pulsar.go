@@ -881,6 +881,7 @@ var (
 	fd_MsgMintSubjectToken_issuerInstitution  protoreflect.FieldDescriptor
 	fd_MsgMintSubjectToken_semester           protoreflect.FieldDescriptor
 	fd_MsgMintSubjectToken_professorSignature protoreflect.FieldDescriptor
+	fd_MsgMintSubjectToken_signingKeyId       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -894,6 +895,7 @@ func init() {
 	fd_MsgMintSubjectToken_issuerInstitution = md_MsgMintSubjectToken.Fields().ByName("issuerInstitution")
 	fd_MsgMintSubjectToken_semester = md_MsgMintSubjectToken.Fields().ByName("semester")
 	fd_MsgMintSubjectToken_professorSignature = md_MsgMintSubjectToken.Fields().ByName("professorSignature")
+	fd_MsgMintSubjectToken_signingKeyId = md_MsgMintSubjectToken.Fields().ByName("signingKeyId")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgMintSubjectToken)(nil)
@@ -1009,6 +1011,12 @@ func (x *fastReflection_MsgMintSubjectToken) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.SigningKeyId != "" {
+		value := protoreflect.ValueOfString(x.SigningKeyId)
+		if !f(fd_MsgMintSubjectToken_signingKeyId, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1040,6 +1048,8 @@ func (x *fastReflection_MsgMintSubjectToken) Has(fd protoreflect.FieldDescriptor
 		return x.Semester != ""
 	case "academictoken.academicnft.MsgMintSubjectToken.professorSignature":
 		return x.ProfessorSignature != ""
+	case "academictoken.academicnft.MsgMintSubjectToken.signingKeyId":
+		return x.SigningKeyId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgMintSubjectToken"))
@@ -1072,6 +1082,8 @@ func (x *fastReflection_MsgMintSubjectToken) Clear(fd protoreflect.FieldDescript
 		x.Semester = ""
 	case "academictoken.academicnft.MsgMintSubjectToken.professorSignature":
 		x.ProfessorSignature = ""
+	case "academictoken.academicnft.MsgMintSubjectToken.signingKeyId":
+		x.SigningKeyId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgMintSubjectToken"))
@@ -1112,6 +1124,9 @@ func (x *fastReflection_MsgMintSubjectToken) Get(descriptor protoreflect.FieldDe
 	case "academictoken.academicnft.MsgMintSubjectToken.professorSignature":
 		value := x.ProfessorSignature
 		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgMintSubjectToken.signingKeyId":
+		value := x.SigningKeyId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgMintSubjectToken"))
@@ -1148,6 +1163,8 @@ func (x *fastReflection_MsgMintSubjectToken) Set(fd protoreflect.FieldDescriptor
 		x.Semester = value.Interface().(string)
 	case "academictoken.academicnft.MsgMintSubjectToken.professorSignature":
 		x.ProfessorSignature = value.Interface().(string)
+	case "academictoken.academicnft.MsgMintSubjectToken.signingKeyId":
+		x.SigningKeyId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgMintSubjectToken"))
@@ -1184,6 +1201,8 @@ func (x *fastReflection_MsgMintSubjectToken) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field semester of message academictoken.academicnft.MsgMintSubjectToken is not mutable"))
 	case "academictoken.academicnft.MsgMintSubjectToken.professorSignature":
 		panic(fmt.Errorf("field professorSignature of message academictoken.academicnft.MsgMintSubjectToken is not mutable"))
+	case "academictoken.academicnft.MsgMintSubjectToken.signingKeyId":
+		panic(fmt.Errorf("field signingKeyId of message academictoken.academicnft.MsgMintSubjectToken is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgMintSubjectToken"))
@@ -1213,6 +1232,8 @@ func (x *fastReflection_MsgMintSubjectToken) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfString("")
 	case "academictoken.academicnft.MsgMintSubjectToken.professorSignature":
 		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgMintSubjectToken.signingKeyId":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgMintSubjectToken"))
@@ -1314,6 +1335,10 @@ func (x *fastReflection_MsgMintSubjectToken) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.SigningKeyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1343,6 +1368,13 @@ func (x *fastReflection_MsgMintSubjectToken) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.SigningKeyId) > 0 {
+			i -= len(x.SigningKeyId)
+			copy(dAtA[i:], x.SigningKeyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningKeyId)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.ProfessorSignature) > 0 {
 			i -= len(x.ProfessorSignature)
 			copy(dAtA[i:], x.ProfessorSignature)
@@ -1704,6 +1736,38 @@ func (x *fastReflection_MsgMintSubjectToken) ProtoMethods() *protoiface.Methods
 				}
 				x.ProfessorSignature = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningKeyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2644,14 +2708,22 @@ func (x *fastReflection_MsgVerifyTokenInstance) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_MsgVerifyTokenInstanceResponse         protoreflect.MessageDescriptor
-	fd_MsgVerifyTokenInstanceResponse_isValid protoreflect.FieldDescriptor
+	md_MsgVerifyTokenInstanceResponse                    protoreflect.MessageDescriptor
+	fd_MsgVerifyTokenInstanceResponse_isValid            protoreflect.FieldDescriptor
+	fd_MsgVerifyTokenInstanceResponse_signingKeyId       protoreflect.FieldDescriptor
+	fd_MsgVerifyTokenInstanceResponse_signer             protoreflect.FieldDescriptor
+	fd_MsgVerifyTokenInstanceResponse_signatureValid     protoreflect.FieldDescriptor
+	fd_MsgVerifyTokenInstanceResponse_keyValidAtIssuance protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_academictoken_academicnft_tx_proto_init()
 	md_MsgVerifyTokenInstanceResponse = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgVerifyTokenInstanceResponse")
 	fd_MsgVerifyTokenInstanceResponse_isValid = md_MsgVerifyTokenInstanceResponse.Fields().ByName("isValid")
+	fd_MsgVerifyTokenInstanceResponse_signingKeyId = md_MsgVerifyTokenInstanceResponse.Fields().ByName("signingKeyId")
+	fd_MsgVerifyTokenInstanceResponse_signer = md_MsgVerifyTokenInstanceResponse.Fields().ByName("signer")
+	fd_MsgVerifyTokenInstanceResponse_signatureValid = md_MsgVerifyTokenInstanceResponse.Fields().ByName("signatureValid")
+	fd_MsgVerifyTokenInstanceResponse_keyValidAtIssuance = md_MsgVerifyTokenInstanceResponse.Fields().ByName("keyValidAtIssuance")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgVerifyTokenInstanceResponse)(nil)
@@ -2725,6 +2797,30 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) Range(f func(protoreflec
 			return
 		}
 	}
+	if x.SigningKeyId != "" {
+		value := protoreflect.ValueOfString(x.SigningKeyId)
+		if !f(fd_MsgVerifyTokenInstanceResponse_signingKeyId, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgVerifyTokenInstanceResponse_signer, value) {
+			return
+		}
+	}
+	if x.SignatureValid != false {
+		value := protoreflect.ValueOfBool(x.SignatureValid)
+		if !f(fd_MsgVerifyTokenInstanceResponse_signatureValid, value) {
+			return
+		}
+	}
+	if x.KeyValidAtIssuance != false {
+		value := protoreflect.ValueOfBool(x.KeyValidAtIssuance)
+		if !f(fd_MsgVerifyTokenInstanceResponse_keyValidAtIssuance, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2742,6 +2838,14 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) Has(fd protoreflect.Fiel
 	switch fd.FullName() {
 	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.isValid":
 		return x.IsValid_ != false
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signingKeyId":
+		return x.SigningKeyId != ""
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signer":
+		return x.Signer != ""
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signatureValid":
+		return x.SignatureValid != false
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.keyValidAtIssuance":
+		return x.KeyValidAtIssuance != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgVerifyTokenInstanceResponse"))
@@ -2760,6 +2864,14 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) Clear(fd protoreflect.Fi
 	switch fd.FullName() {
 	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.isValid":
 		x.IsValid_ = false
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signingKeyId":
+		x.SigningKeyId = ""
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signer":
+		x.Signer = ""
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signatureValid":
+		x.SignatureValid = false
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.keyValidAtIssuance":
+		x.KeyValidAtIssuance = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgVerifyTokenInstanceResponse"))
@@ -2779,6 +2891,18 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) Get(descriptor protorefl
 	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.isValid":
 		value := x.IsValid_
 		return protoreflect.ValueOfBool(value)
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signingKeyId":
+		value := x.SigningKeyId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signatureValid":
+		value := x.SignatureValid
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.keyValidAtIssuance":
+		value := x.KeyValidAtIssuance
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgVerifyTokenInstanceResponse"))
@@ -2801,6 +2925,14 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) Set(fd protoreflect.Fiel
 	switch fd.FullName() {
 	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.isValid":
 		x.IsValid_ = value.Bool()
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signingKeyId":
+		x.SigningKeyId = value.Interface().(string)
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signer":
+		x.Signer = value.Interface().(string)
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signatureValid":
+		x.SignatureValid = value.Bool()
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.keyValidAtIssuance":
+		x.KeyValidAtIssuance = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgVerifyTokenInstanceResponse"))
@@ -2823,6 +2955,14 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) Mutable(fd protoreflect.
 	switch fd.FullName() {
 	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.isValid":
 		panic(fmt.Errorf("field isValid of message academictoken.academicnft.MsgVerifyTokenInstanceResponse is not mutable"))
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signingKeyId":
+		panic(fmt.Errorf("field signingKeyId of message academictoken.academicnft.MsgVerifyTokenInstanceResponse is not mutable"))
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signer":
+		panic(fmt.Errorf("field signer of message academictoken.academicnft.MsgVerifyTokenInstanceResponse is not mutable"))
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signatureValid":
+		panic(fmt.Errorf("field signatureValid of message academictoken.academicnft.MsgVerifyTokenInstanceResponse is not mutable"))
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.keyValidAtIssuance":
+		panic(fmt.Errorf("field keyValidAtIssuance of message academictoken.academicnft.MsgVerifyTokenInstanceResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgVerifyTokenInstanceResponse"))
@@ -2838,6 +2978,14 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) NewField(fd protoreflect
 	switch fd.FullName() {
 	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.isValid":
 		return protoreflect.ValueOfBool(false)
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signingKeyId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signer":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.signatureValid":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.academicnft.MsgVerifyTokenInstanceResponse.keyValidAtIssuance":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgVerifyTokenInstanceResponse"))
@@ -2910,6 +3058,20 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) ProtoMethods() *protoifa
 		if x.IsValid_ {
 			n += 2
 		}
+		l = len(x.SigningKeyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SignatureValid {
+			n += 2
+		}
+		if x.KeyValidAtIssuance {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2939,6 +3101,40 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.KeyValidAtIssuance {
+			i--
+			if x.KeyValidAtIssuance {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SignatureValid {
+			i--
+			if x.SignatureValid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SigningKeyId) > 0 {
+			i -= len(x.SigningKeyId)
+			copy(dAtA[i:], x.SigningKeyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningKeyId)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.IsValid_ {
 			i--
 			if x.IsValid_ {
@@ -3018,6 +3214,110 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) ProtoMethods() *protoifa
 					}
 				}
 				x.IsValid_ = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningKeyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignatureValid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SignatureValid = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyValidAtIssuance", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.KeyValidAtIssuance = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3053,76 +3353,3880 @@ func (x *fastReflection_MsgVerifyTokenInstanceResponse) ProtoMethods() *protoifa
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: academictoken/academicnft/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgAmendGrade                 protoreflect.MessageDescriptor
+	fd_MsgAmendGrade_creator         protoreflect.FieldDescriptor
+	fd_MsgAmendGrade_tokenInstanceId protoreflect.FieldDescriptor
+	fd_MsgAmendGrade_newGrade        protoreflect.FieldDescriptor
+	fd_MsgAmendGrade_reason          protoreflect.FieldDescriptor
 )
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_academictoken_academicnft_tx_proto_init()
+	md_MsgAmendGrade = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgAmendGrade")
+	fd_MsgAmendGrade_creator = md_MsgAmendGrade.Fields().ByName("creator")
+	fd_MsgAmendGrade_tokenInstanceId = md_MsgAmendGrade.Fields().ByName("tokenInstanceId")
+	fd_MsgAmendGrade_newGrade = md_MsgAmendGrade.Fields().ByName("newGrade")
+	fd_MsgAmendGrade_reason = md_MsgAmendGrade.Fields().ByName("reason")
+}
 
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the module parameters to update.
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgAmendGrade)(nil)
+
+type fastReflection_MsgAmendGrade MsgAmendGrade
+
+func (x *MsgAmendGrade) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAmendGrade)(x)
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_academicnft_tx_proto_msgTypes[0]
+func (x *MsgAmendGrade) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgUpdateParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgAmendGrade_messageType fastReflection_MsgAmendGrade_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAmendGrade_messageType{}
 
-func (*MsgUpdateParams) ProtoMessage() {}
+type fastReflection_MsgAmendGrade_messageType struct{}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MsgAmendGrade_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAmendGrade)(nil)
+}
+func (x fastReflection_MsgAmendGrade_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAmendGrade)
+}
+func (x fastReflection_MsgAmendGrade_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAmendGrade
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
-	if x != nil {
-		return x.Authority
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAmendGrade) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAmendGrade
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAmendGrade) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAmendGrade_messageType
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAmendGrade) New() protoreflect.Message {
+	return new(fastReflection_MsgAmendGrade)
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAmendGrade) Interface() protoreflect.ProtoMessage {
+	return (*MsgAmendGrade)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAmendGrade) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgAmendGrade_creator, value) {
+			return
+		}
+	}
+	if x.TokenInstanceId != "" {
+		value := protoreflect.ValueOfString(x.TokenInstanceId)
+		if !f(fd_MsgAmendGrade_tokenInstanceId, value) {
+			return
+		}
+	}
+	if x.NewGrade != "" {
+		value := protoreflect.ValueOfString(x.NewGrade)
+		if !f(fd_MsgAmendGrade_newGrade, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgAmendGrade_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAmendGrade) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGrade.creator":
+		return x.Creator != ""
+	case "academictoken.academicnft.MsgAmendGrade.tokenInstanceId":
+		return x.TokenInstanceId != ""
+	case "academictoken.academicnft.MsgAmendGrade.newGrade":
+		return x.NewGrade != ""
+	case "academictoken.academicnft.MsgAmendGrade.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAmendGrade) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGrade.creator":
+		x.Creator = ""
+	case "academictoken.academicnft.MsgAmendGrade.tokenInstanceId":
+		x.TokenInstanceId = ""
+	case "academictoken.academicnft.MsgAmendGrade.newGrade":
+		x.NewGrade = ""
+	case "academictoken.academicnft.MsgAmendGrade.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAmendGrade) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.MsgAmendGrade.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgAmendGrade.tokenInstanceId":
+		value := x.TokenInstanceId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgAmendGrade.newGrade":
+		value := x.NewGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgAmendGrade.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGrade does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAmendGrade) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGrade.creator":
+		x.Creator = value.Interface().(string)
+	case "academictoken.academicnft.MsgAmendGrade.tokenInstanceId":
+		x.TokenInstanceId = value.Interface().(string)
+	case "academictoken.academicnft.MsgAmendGrade.newGrade":
+		x.NewGrade = value.Interface().(string)
+	case "academictoken.academicnft.MsgAmendGrade.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAmendGrade) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGrade.creator":
+		panic(fmt.Errorf("field creator of message academictoken.academicnft.MsgAmendGrade is not mutable"))
+	case "academictoken.academicnft.MsgAmendGrade.tokenInstanceId":
+		panic(fmt.Errorf("field tokenInstanceId of message academictoken.academicnft.MsgAmendGrade is not mutable"))
+	case "academictoken.academicnft.MsgAmendGrade.newGrade":
+		panic(fmt.Errorf("field newGrade of message academictoken.academicnft.MsgAmendGrade is not mutable"))
+	case "academictoken.academicnft.MsgAmendGrade.reason":
+		panic(fmt.Errorf("field reason of message academictoken.academicnft.MsgAmendGrade is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAmendGrade) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGrade.creator":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgAmendGrade.tokenInstanceId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgAmendGrade.newGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgAmendGrade.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAmendGrade) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.MsgAmendGrade", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAmendGrade) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAmendGrade) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAmendGrade) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAmendGrade) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAmendGrade)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenInstanceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAmendGrade)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NewGrade) > 0 {
+			i -= len(x.NewGrade)
+			copy(dAtA[i:], x.NewGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewGrade)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TokenInstanceId) > 0 {
+			i -= len(x.TokenInstanceId)
+			copy(dAtA[i:], x.TokenInstanceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenInstanceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAmendGrade)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAmendGrade: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAmendGrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAmendGradeResponse                 protoreflect.MessageDescriptor
+	fd_MsgAmendGradeResponse_normalizedGrade protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_tx_proto_init()
+	md_MsgAmendGradeResponse = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgAmendGradeResponse")
+	fd_MsgAmendGradeResponse_normalizedGrade = md_MsgAmendGradeResponse.Fields().ByName("normalizedGrade")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAmendGradeResponse)(nil)
+
+type fastReflection_MsgAmendGradeResponse MsgAmendGradeResponse
+
+func (x *MsgAmendGradeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAmendGradeResponse)(x)
+}
+
+func (x *MsgAmendGradeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAmendGradeResponse_messageType fastReflection_MsgAmendGradeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAmendGradeResponse_messageType{}
+
+type fastReflection_MsgAmendGradeResponse_messageType struct{}
+
+func (x fastReflection_MsgAmendGradeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAmendGradeResponse)(nil)
+}
+func (x fastReflection_MsgAmendGradeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAmendGradeResponse)
+}
+func (x fastReflection_MsgAmendGradeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAmendGradeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAmendGradeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAmendGradeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAmendGradeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAmendGradeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAmendGradeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAmendGradeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAmendGradeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAmendGradeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAmendGradeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NormalizedGrade != "" {
+		value := protoreflect.ValueOfString(x.NormalizedGrade)
+		if !f(fd_MsgAmendGradeResponse_normalizedGrade, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAmendGradeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGradeResponse.normalizedGrade":
+		return x.NormalizedGrade != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGradeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGradeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAmendGradeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGradeResponse.normalizedGrade":
+		x.NormalizedGrade = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGradeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGradeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAmendGradeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.MsgAmendGradeResponse.normalizedGrade":
+		value := x.NormalizedGrade
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGradeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGradeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAmendGradeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGradeResponse.normalizedGrade":
+		x.NormalizedGrade = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGradeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGradeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAmendGradeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGradeResponse.normalizedGrade":
+		panic(fmt.Errorf("field normalizedGrade of message academictoken.academicnft.MsgAmendGradeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGradeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGradeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAmendGradeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgAmendGradeResponse.normalizedGrade":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgAmendGradeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgAmendGradeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAmendGradeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.MsgAmendGradeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAmendGradeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAmendGradeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAmendGradeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAmendGradeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAmendGradeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.NormalizedGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAmendGradeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NormalizedGrade) > 0 {
+			i -= len(x.NormalizedGrade)
+			copy(dAtA[i:], x.NormalizedGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NormalizedGrade)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAmendGradeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAmendGradeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAmendGradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizedGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NormalizedGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRevokeTokenInstance                 protoreflect.MessageDescriptor
+	fd_MsgRevokeTokenInstance_creator         protoreflect.FieldDescriptor
+	fd_MsgRevokeTokenInstance_tokenInstanceId protoreflect.FieldDescriptor
+	fd_MsgRevokeTokenInstance_reason          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_tx_proto_init()
+	md_MsgRevokeTokenInstance = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgRevokeTokenInstance")
+	fd_MsgRevokeTokenInstance_creator = md_MsgRevokeTokenInstance.Fields().ByName("creator")
+	fd_MsgRevokeTokenInstance_tokenInstanceId = md_MsgRevokeTokenInstance.Fields().ByName("tokenInstanceId")
+	fd_MsgRevokeTokenInstance_reason = md_MsgRevokeTokenInstance.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevokeTokenInstance)(nil)
+
+type fastReflection_MsgRevokeTokenInstance MsgRevokeTokenInstance
+
+func (x *MsgRevokeTokenInstance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeTokenInstance)(x)
+}
+
+func (x *MsgRevokeTokenInstance) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevokeTokenInstance_messageType fastReflection_MsgRevokeTokenInstance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeTokenInstance_messageType{}
+
+type fastReflection_MsgRevokeTokenInstance_messageType struct{}
+
+func (x fastReflection_MsgRevokeTokenInstance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeTokenInstance)(nil)
+}
+func (x fastReflection_MsgRevokeTokenInstance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeTokenInstance)
+}
+func (x fastReflection_MsgRevokeTokenInstance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeTokenInstance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevokeTokenInstance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeTokenInstance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevokeTokenInstance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeTokenInstance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevokeTokenInstance) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeTokenInstance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevokeTokenInstance) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeTokenInstance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevokeTokenInstance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRevokeTokenInstance_creator, value) {
+			return
+		}
+	}
+	if x.TokenInstanceId != "" {
+		value := protoreflect.ValueOfString(x.TokenInstanceId)
+		if !f(fd_MsgRevokeTokenInstance_tokenInstanceId, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgRevokeTokenInstance_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevokeTokenInstance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstance.creator":
+		return x.Creator != ""
+	case "academictoken.academicnft.MsgRevokeTokenInstance.tokenInstanceId":
+		return x.TokenInstanceId != ""
+	case "academictoken.academicnft.MsgRevokeTokenInstance.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeTokenInstance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstance.creator":
+		x.Creator = ""
+	case "academictoken.academicnft.MsgRevokeTokenInstance.tokenInstanceId":
+		x.TokenInstanceId = ""
+	case "academictoken.academicnft.MsgRevokeTokenInstance.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevokeTokenInstance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstance.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgRevokeTokenInstance.tokenInstanceId":
+		value := x.TokenInstanceId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgRevokeTokenInstance.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeTokenInstance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstance.creator":
+		x.Creator = value.Interface().(string)
+	case "academictoken.academicnft.MsgRevokeTokenInstance.tokenInstanceId":
+		x.TokenInstanceId = value.Interface().(string)
+	case "academictoken.academicnft.MsgRevokeTokenInstance.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeTokenInstance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstance.creator":
+		panic(fmt.Errorf("field creator of message academictoken.academicnft.MsgRevokeTokenInstance is not mutable"))
+	case "academictoken.academicnft.MsgRevokeTokenInstance.tokenInstanceId":
+		panic(fmt.Errorf("field tokenInstanceId of message academictoken.academicnft.MsgRevokeTokenInstance is not mutable"))
+	case "academictoken.academicnft.MsgRevokeTokenInstance.reason":
+		panic(fmt.Errorf("field reason of message academictoken.academicnft.MsgRevokeTokenInstance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevokeTokenInstance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstance.creator":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgRevokeTokenInstance.tokenInstanceId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgRevokeTokenInstance.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevokeTokenInstance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.MsgRevokeTokenInstance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevokeTokenInstance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeTokenInstance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevokeTokenInstance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevokeTokenInstance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevokeTokenInstance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenInstanceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeTokenInstance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TokenInstanceId) > 0 {
+			i -= len(x.TokenInstanceId)
+			copy(dAtA[i:], x.TokenInstanceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenInstanceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeTokenInstance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeTokenInstance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeTokenInstance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRevokeTokenInstanceResponse_1_list)(nil)
+
+type _MsgRevokeTokenInstanceResponse_1_list struct {
+	list *[]string
+}
+
+func (x *_MsgRevokeTokenInstanceResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRevokeTokenInstanceResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRevokeTokenInstanceResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRevokeTokenInstanceResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRevokeTokenInstanceResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRevokeTokenInstanceResponse at list field InvalidatedDegreeRequests as it is not of Message kind"))
+}
+
+func (x *_MsgRevokeTokenInstanceResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRevokeTokenInstanceResponse_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRevokeTokenInstanceResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRevokeTokenInstanceResponse                           protoreflect.MessageDescriptor
+	fd_MsgRevokeTokenInstanceResponse_invalidatedDegreeRequests protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_tx_proto_init()
+	md_MsgRevokeTokenInstanceResponse = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgRevokeTokenInstanceResponse")
+	fd_MsgRevokeTokenInstanceResponse_invalidatedDegreeRequests = md_MsgRevokeTokenInstanceResponse.Fields().ByName("invalidatedDegreeRequests")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevokeTokenInstanceResponse)(nil)
+
+type fastReflection_MsgRevokeTokenInstanceResponse MsgRevokeTokenInstanceResponse
+
+func (x *MsgRevokeTokenInstanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeTokenInstanceResponse)(x)
+}
+
+func (x *MsgRevokeTokenInstanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevokeTokenInstanceResponse_messageType fastReflection_MsgRevokeTokenInstanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeTokenInstanceResponse_messageType{}
+
+type fastReflection_MsgRevokeTokenInstanceResponse_messageType struct{}
+
+func (x fastReflection_MsgRevokeTokenInstanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeTokenInstanceResponse)(nil)
+}
+func (x fastReflection_MsgRevokeTokenInstanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeTokenInstanceResponse)
+}
+func (x fastReflection_MsgRevokeTokenInstanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeTokenInstanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeTokenInstanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeTokenInstanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeTokenInstanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeTokenInstanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.InvalidatedDegreeRequests) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRevokeTokenInstanceResponse_1_list{list: &x.InvalidatedDegreeRequests})
+		if !f(fd_MsgRevokeTokenInstanceResponse_invalidatedDegreeRequests, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstanceResponse.invalidatedDegreeRequests":
+		return len(x.InvalidatedDegreeRequests) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstanceResponse.invalidatedDegreeRequests":
+		x.InvalidatedDegreeRequests = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstanceResponse.invalidatedDegreeRequests":
+		if len(x.InvalidatedDegreeRequests) == 0 {
+			return protoreflect.ValueOfList(&_MsgRevokeTokenInstanceResponse_1_list{})
+		}
+		listValue := &_MsgRevokeTokenInstanceResponse_1_list{list: &x.InvalidatedDegreeRequests}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstanceResponse.invalidatedDegreeRequests":
+		lv := value.List()
+		clv := lv.(*_MsgRevokeTokenInstanceResponse_1_list)
+		x.InvalidatedDegreeRequests = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstanceResponse.invalidatedDegreeRequests":
+		if x.InvalidatedDegreeRequests == nil {
+			x.InvalidatedDegreeRequests = []string{}
+		}
+		value := &_MsgRevokeTokenInstanceResponse_1_list{list: &x.InvalidatedDegreeRequests}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgRevokeTokenInstanceResponse.invalidatedDegreeRequests":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRevokeTokenInstanceResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgRevokeTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgRevokeTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.MsgRevokeTokenInstanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevokeTokenInstanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevokeTokenInstanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.InvalidatedDegreeRequests) > 0 {
+			for _, s := range x.InvalidatedDegreeRequests {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeTokenInstanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.InvalidatedDegreeRequests) > 0 {
+			for iNdEx := len(x.InvalidatedDegreeRequests) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.InvalidatedDegreeRequests[iNdEx])
+				copy(dAtA[i:], x.InvalidatedDegreeRequests[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InvalidatedDegreeRequests[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeTokenInstanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeTokenInstanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeTokenInstanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidatedDegreeRequests", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InvalidatedDegreeRequests = append(x.InvalidatedDegreeRequests, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTransferTokenInstance                 protoreflect.MessageDescriptor
+	fd_MsgTransferTokenInstance_creator         protoreflect.FieldDescriptor
+	fd_MsgTransferTokenInstance_tokenInstanceId protoreflect.FieldDescriptor
+	fd_MsgTransferTokenInstance_recipient       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_tx_proto_init()
+	md_MsgTransferTokenInstance = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgTransferTokenInstance")
+	fd_MsgTransferTokenInstance_creator = md_MsgTransferTokenInstance.Fields().ByName("creator")
+	fd_MsgTransferTokenInstance_tokenInstanceId = md_MsgTransferTokenInstance.Fields().ByName("tokenInstanceId")
+	fd_MsgTransferTokenInstance_recipient = md_MsgTransferTokenInstance.Fields().ByName("recipient")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferTokenInstance)(nil)
+
+type fastReflection_MsgTransferTokenInstance MsgTransferTokenInstance
+
+func (x *MsgTransferTokenInstance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferTokenInstance)(x)
+}
+
+func (x *MsgTransferTokenInstance) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferTokenInstance_messageType fastReflection_MsgTransferTokenInstance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferTokenInstance_messageType{}
+
+type fastReflection_MsgTransferTokenInstance_messageType struct{}
+
+func (x fastReflection_MsgTransferTokenInstance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferTokenInstance)(nil)
+}
+func (x fastReflection_MsgTransferTokenInstance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTokenInstance)
+}
+func (x fastReflection_MsgTransferTokenInstance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTokenInstance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferTokenInstance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTokenInstance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferTokenInstance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferTokenInstance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferTokenInstance) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTokenInstance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferTokenInstance) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferTokenInstance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferTokenInstance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgTransferTokenInstance_creator, value) {
+			return
+		}
+	}
+	if x.TokenInstanceId != "" {
+		value := protoreflect.ValueOfString(x.TokenInstanceId)
+		if !f(fd_MsgTransferTokenInstance_tokenInstanceId, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_MsgTransferTokenInstance_recipient, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferTokenInstance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgTransferTokenInstance.creator":
+		return x.Creator != ""
+	case "academictoken.academicnft.MsgTransferTokenInstance.tokenInstanceId":
+		return x.TokenInstanceId != ""
+	case "academictoken.academicnft.MsgTransferTokenInstance.recipient":
+		return x.Recipient != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferTokenInstance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgTransferTokenInstance.creator":
+		x.Creator = ""
+	case "academictoken.academicnft.MsgTransferTokenInstance.tokenInstanceId":
+		x.TokenInstanceId = ""
+	case "academictoken.academicnft.MsgTransferTokenInstance.recipient":
+		x.Recipient = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferTokenInstance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.MsgTransferTokenInstance.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgTransferTokenInstance.tokenInstanceId":
+		value := x.TokenInstanceId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgTransferTokenInstance.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferTokenInstance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgTransferTokenInstance.creator":
+		x.Creator = value.Interface().(string)
+	case "academictoken.academicnft.MsgTransferTokenInstance.tokenInstanceId":
+		x.TokenInstanceId = value.Interface().(string)
+	case "academictoken.academicnft.MsgTransferTokenInstance.recipient":
+		x.Recipient = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferTokenInstance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgTransferTokenInstance.creator":
+		panic(fmt.Errorf("field creator of message academictoken.academicnft.MsgTransferTokenInstance is not mutable"))
+	case "academictoken.academicnft.MsgTransferTokenInstance.tokenInstanceId":
+		panic(fmt.Errorf("field tokenInstanceId of message academictoken.academicnft.MsgTransferTokenInstance is not mutable"))
+	case "academictoken.academicnft.MsgTransferTokenInstance.recipient":
+		panic(fmt.Errorf("field recipient of message academictoken.academicnft.MsgTransferTokenInstance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferTokenInstance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgTransferTokenInstance.creator":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgTransferTokenInstance.tokenInstanceId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgTransferTokenInstance.recipient":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferTokenInstance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.MsgTransferTokenInstance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferTokenInstance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferTokenInstance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferTokenInstance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferTokenInstance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferTokenInstance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenInstanceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferTokenInstance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TokenInstanceId) > 0 {
+			i -= len(x.TokenInstanceId)
+			copy(dAtA[i:], x.TokenInstanceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenInstanceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferTokenInstance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTokenInstance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTokenInstance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTransferTokenInstanceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_tx_proto_init()
+	md_MsgTransferTokenInstanceResponse = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgTransferTokenInstanceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferTokenInstanceResponse)(nil)
+
+type fastReflection_MsgTransferTokenInstanceResponse MsgTransferTokenInstanceResponse
+
+func (x *MsgTransferTokenInstanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferTokenInstanceResponse)(x)
+}
+
+func (x *MsgTransferTokenInstanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferTokenInstanceResponse_messageType fastReflection_MsgTransferTokenInstanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferTokenInstanceResponse_messageType{}
+
+type fastReflection_MsgTransferTokenInstanceResponse_messageType struct{}
+
+func (x fastReflection_MsgTransferTokenInstanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferTokenInstanceResponse)(nil)
+}
+func (x fastReflection_MsgTransferTokenInstanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTokenInstanceResponse)
+}
+func (x fastReflection_MsgTransferTokenInstanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTokenInstanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTokenInstanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferTokenInstanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTokenInstanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferTokenInstanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgTransferTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgTransferTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.MsgTransferTokenInstanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferTokenInstanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferTokenInstanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferTokenInstanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferTokenInstanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTokenInstanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTokenInstanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBurnTokenInstance                 protoreflect.MessageDescriptor
+	fd_MsgBurnTokenInstance_creator         protoreflect.FieldDescriptor
+	fd_MsgBurnTokenInstance_tokenInstanceId protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_tx_proto_init()
+	md_MsgBurnTokenInstance = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgBurnTokenInstance")
+	fd_MsgBurnTokenInstance_creator = md_MsgBurnTokenInstance.Fields().ByName("creator")
+	fd_MsgBurnTokenInstance_tokenInstanceId = md_MsgBurnTokenInstance.Fields().ByName("tokenInstanceId")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBurnTokenInstance)(nil)
+
+type fastReflection_MsgBurnTokenInstance MsgBurnTokenInstance
+
+func (x *MsgBurnTokenInstance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBurnTokenInstance)(x)
+}
+
+func (x *MsgBurnTokenInstance) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBurnTokenInstance_messageType fastReflection_MsgBurnTokenInstance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBurnTokenInstance_messageType{}
+
+type fastReflection_MsgBurnTokenInstance_messageType struct{}
+
+func (x fastReflection_MsgBurnTokenInstance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBurnTokenInstance)(nil)
+}
+func (x fastReflection_MsgBurnTokenInstance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBurnTokenInstance)
+}
+func (x fastReflection_MsgBurnTokenInstance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBurnTokenInstance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBurnTokenInstance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBurnTokenInstance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBurnTokenInstance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBurnTokenInstance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBurnTokenInstance) New() protoreflect.Message {
+	return new(fastReflection_MsgBurnTokenInstance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBurnTokenInstance) Interface() protoreflect.ProtoMessage {
+	return (*MsgBurnTokenInstance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBurnTokenInstance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgBurnTokenInstance_creator, value) {
+			return
+		}
+	}
+	if x.TokenInstanceId != "" {
+		value := protoreflect.ValueOfString(x.TokenInstanceId)
+		if !f(fd_MsgBurnTokenInstance_tokenInstanceId, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBurnTokenInstance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgBurnTokenInstance.creator":
+		return x.Creator != ""
+	case "academictoken.academicnft.MsgBurnTokenInstance.tokenInstanceId":
+		return x.TokenInstanceId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurnTokenInstance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgBurnTokenInstance.creator":
+		x.Creator = ""
+	case "academictoken.academicnft.MsgBurnTokenInstance.tokenInstanceId":
+		x.TokenInstanceId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBurnTokenInstance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.academicnft.MsgBurnTokenInstance.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "academictoken.academicnft.MsgBurnTokenInstance.tokenInstanceId":
+		value := x.TokenInstanceId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurnTokenInstance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgBurnTokenInstance.creator":
+		x.Creator = value.Interface().(string)
+	case "academictoken.academicnft.MsgBurnTokenInstance.tokenInstanceId":
+		x.TokenInstanceId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurnTokenInstance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgBurnTokenInstance.creator":
+		panic(fmt.Errorf("field creator of message academictoken.academicnft.MsgBurnTokenInstance is not mutable"))
+	case "academictoken.academicnft.MsgBurnTokenInstance.tokenInstanceId":
+		panic(fmt.Errorf("field tokenInstanceId of message academictoken.academicnft.MsgBurnTokenInstance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBurnTokenInstance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.academicnft.MsgBurnTokenInstance.creator":
+		return protoreflect.ValueOfString("")
+	case "academictoken.academicnft.MsgBurnTokenInstance.tokenInstanceId":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstance"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBurnTokenInstance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.MsgBurnTokenInstance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBurnTokenInstance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurnTokenInstance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBurnTokenInstance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBurnTokenInstance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBurnTokenInstance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenInstanceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBurnTokenInstance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TokenInstanceId) > 0 {
+			i -= len(x.TokenInstanceId)
+			copy(dAtA[i:], x.TokenInstanceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenInstanceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBurnTokenInstance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurnTokenInstance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurnTokenInstance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBurnTokenInstanceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_academictoken_academicnft_tx_proto_init()
+	md_MsgBurnTokenInstanceResponse = File_academictoken_academicnft_tx_proto.Messages().ByName("MsgBurnTokenInstanceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBurnTokenInstanceResponse)(nil)
+
+type fastReflection_MsgBurnTokenInstanceResponse MsgBurnTokenInstanceResponse
+
+func (x *MsgBurnTokenInstanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBurnTokenInstanceResponse)(x)
+}
+
+func (x *MsgBurnTokenInstanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_academicnft_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBurnTokenInstanceResponse_messageType fastReflection_MsgBurnTokenInstanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBurnTokenInstanceResponse_messageType{}
+
+type fastReflection_MsgBurnTokenInstanceResponse_messageType struct{}
+
+func (x fastReflection_MsgBurnTokenInstanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBurnTokenInstanceResponse)(nil)
+}
+func (x fastReflection_MsgBurnTokenInstanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBurnTokenInstanceResponse)
+}
+func (x fastReflection_MsgBurnTokenInstanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBurnTokenInstanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBurnTokenInstanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBurnTokenInstanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBurnTokenInstanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBurnTokenInstanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.academicnft.MsgBurnTokenInstanceResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.academicnft.MsgBurnTokenInstanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.academicnft.MsgBurnTokenInstanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBurnTokenInstanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBurnTokenInstanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBurnTokenInstanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBurnTokenInstanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurnTokenInstanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBurnTokenInstanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/academicnft/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_academictoken_academicnft_tx_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3134,215 +7238,589 @@ func (x *MsgUpdateParamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{1}
+}
+
+type MsgMintSubjectToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenDefId         string `protobuf:"bytes,2,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"` // MUDANÇA: era tokenId
+	Student            string `protobuf:"bytes,3,opt,name=student,proto3" json:"student,omitempty"`
+	CompletionDate     string `protobuf:"bytes,4,opt,name=completionDate,proto3" json:"completionDate,omitempty"` // ADIÇÃO: estava faltando
+	Grade              string `protobuf:"bytes,5,opt,name=grade,proto3" json:"grade,omitempty"`
+	IssuerInstitution  string `protobuf:"bytes,6,opt,name=issuerInstitution,proto3" json:"issuerInstitution,omitempty"` // ADIÇÃO: estava faltando
+	Semester           string `protobuf:"bytes,7,opt,name=semester,proto3" json:"semester,omitempty"`
+	ProfessorSignature string `protobuf:"bytes,8,opt,name=professorSignature,proto3" json:"professorSignature,omitempty"` // base64 signature of the canonical token fields
+	SigningKeyId       string `protobuf:"bytes,9,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"`
+}
+
+func (x *MsgMintSubjectToken) Reset() {
+	*x = MsgMintSubjectToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgMintSubjectToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgMintSubjectToken) ProtoMessage() {}
+
+// Deprecated: Use MsgMintSubjectToken.ProtoReflect.Descriptor instead.
+func (*MsgMintSubjectToken) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgMintSubjectToken) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgMintSubjectToken) GetTokenDefId() string {
+	if x != nil {
+		return x.TokenDefId
+	}
+	return ""
+}
+
+func (x *MsgMintSubjectToken) GetStudent() string {
+	if x != nil {
+		return x.Student
+	}
+	return ""
+}
+
+func (x *MsgMintSubjectToken) GetCompletionDate() string {
+	if x != nil {
+		return x.CompletionDate
+	}
+	return ""
+}
+
+func (x *MsgMintSubjectToken) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *MsgMintSubjectToken) GetIssuerInstitution() string {
+	if x != nil {
+		return x.IssuerInstitution
+	}
+	return ""
+}
+
+func (x *MsgMintSubjectToken) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *MsgMintSubjectToken) GetProfessorSignature() string {
+	if x != nil {
+		return x.ProfessorSignature
+	}
+	return ""
+}
+
+func (x *MsgMintSubjectToken) GetSigningKeyId() string {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return ""
+}
+
+type MsgMintSubjectTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenInstanceId string `protobuf:"bytes,1,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"` // ADIÇÃO: retorna o ID do token criado
+}
+
+func (x *MsgMintSubjectTokenResponse) Reset() {
+	*x = MsgMintSubjectTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgMintSubjectTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgMintSubjectTokenResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgMintSubjectTokenResponse.ProtoReflect.Descriptor instead.
+func (*MsgMintSubjectTokenResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgMintSubjectTokenResponse) GetTokenInstanceId() string {
+	if x != nil {
+		return x.TokenInstanceId
+	}
+	return ""
+}
+
+type MsgVerifyTokenInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+}
+
+func (x *MsgVerifyTokenInstance) Reset() {
+	*x = MsgVerifyTokenInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVerifyTokenInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVerifyTokenInstance) ProtoMessage() {}
+
+// Deprecated: Use MsgVerifyTokenInstance.ProtoReflect.Descriptor instead.
+func (*MsgVerifyTokenInstance) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgVerifyTokenInstance) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgVerifyTokenInstance) GetTokenInstanceId() string {
+	if x != nil {
+		return x.TokenInstanceId
+	}
+	return ""
+}
+
+type MsgVerifyTokenInstanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsValid_           bool   `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"` // ADIÇÃO: retorna se é válido
+	SigningKeyId       string `protobuf:"bytes,2,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"`
+	Signer             string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	SignatureValid     bool   `protobuf:"varint,4,opt,name=signatureValid,proto3" json:"signatureValid,omitempty"`
+	KeyValidAtIssuance bool   `protobuf:"varint,5,opt,name=keyValidAtIssuance,proto3" json:"keyValidAtIssuance,omitempty"`
+}
+
+func (x *MsgVerifyTokenInstanceResponse) Reset() {
+	*x = MsgVerifyTokenInstanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVerifyTokenInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVerifyTokenInstanceResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgVerifyTokenInstanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgVerifyTokenInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgVerifyTokenInstanceResponse) GetIsValid_() bool {
+	if x != nil {
+		return x.IsValid_
+	}
+	return false
+}
+
+func (x *MsgVerifyTokenInstanceResponse) GetSigningKeyId() string {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return ""
+}
+
+func (x *MsgVerifyTokenInstanceResponse) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgVerifyTokenInstanceResponse) GetSignatureValid() bool {
+	if x != nil {
+		return x.SignatureValid
+	}
+	return false
+}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{1}
+func (x *MsgVerifyTokenInstanceResponse) GetKeyValidAtIssuance() bool {
+	if x != nil {
+		return x.KeyValidAtIssuance
+	}
+	return false
 }
 
-type MsgMintSubjectToken struct {
+// MsgAmendGrade corrects the grade of a token instance, keeping the previous
+// grade in its amendment history.
+type MsgAmendGrade struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TokenDefId         string `protobuf:"bytes,2,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"` // MUDANÇA: era tokenId
-	Student            string `protobuf:"bytes,3,opt,name=student,proto3" json:"student,omitempty"`
-	CompletionDate     string `protobuf:"bytes,4,opt,name=completionDate,proto3" json:"completionDate,omitempty"` // ADIÇÃO: estava faltando
-	Grade              string `protobuf:"bytes,5,opt,name=grade,proto3" json:"grade,omitempty"`
-	IssuerInstitution  string `protobuf:"bytes,6,opt,name=issuerInstitution,proto3" json:"issuerInstitution,omitempty"` // ADIÇÃO: estava faltando
-	Semester           string `protobuf:"bytes,7,opt,name=semester,proto3" json:"semester,omitempty"`
-	ProfessorSignature string `protobuf:"bytes,8,opt,name=professorSignature,proto3" json:"professorSignature,omitempty"`
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+	NewGrade        string `protobuf:"bytes,3,opt,name=newGrade,proto3" json:"newGrade,omitempty"`
+	Reason          string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *MsgMintSubjectToken) Reset() {
-	*x = MsgMintSubjectToken{}
+func (x *MsgAmendGrade) Reset() {
+	*x = MsgAmendGrade{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_academicnft_tx_proto_msgTypes[2]
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgMintSubjectToken) String() string {
+func (x *MsgAmendGrade) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgMintSubjectToken) ProtoMessage() {}
+func (*MsgAmendGrade) ProtoMessage() {}
 
-// Deprecated: Use MsgMintSubjectToken.ProtoReflect.Descriptor instead.
-func (*MsgMintSubjectToken) Descriptor() ([]byte, []int) {
-	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgAmendGrade.ProtoReflect.Descriptor instead.
+func (*MsgAmendGrade) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MsgMintSubjectToken) GetCreator() string {
+func (x *MsgAmendGrade) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgMintSubjectToken) GetTokenDefId() string {
+func (x *MsgAmendGrade) GetTokenInstanceId() string {
 	if x != nil {
-		return x.TokenDefId
+		return x.TokenInstanceId
 	}
 	return ""
 }
 
-func (x *MsgMintSubjectToken) GetStudent() string {
+func (x *MsgAmendGrade) GetNewGrade() string {
 	if x != nil {
-		return x.Student
+		return x.NewGrade
 	}
 	return ""
 }
 
-func (x *MsgMintSubjectToken) GetCompletionDate() string {
+func (x *MsgAmendGrade) GetReason() string {
 	if x != nil {
-		return x.CompletionDate
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *MsgMintSubjectToken) GetGrade() string {
+type MsgAmendGradeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NormalizedGrade string `protobuf:"bytes,1,opt,name=normalizedGrade,proto3" json:"normalizedGrade,omitempty"`
+}
+
+func (x *MsgAmendGradeResponse) Reset() {
+	*x = MsgAmendGradeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAmendGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAmendGradeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAmendGradeResponse.ProtoReflect.Descriptor instead.
+func (*MsgAmendGradeResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgAmendGradeResponse) GetNormalizedGrade() string {
 	if x != nil {
-		return x.Grade
+		return x.NormalizedGrade
 	}
 	return ""
 }
 
-func (x *MsgMintSubjectToken) GetIssuerInstitution() string {
+// MsgRevokeTokenInstance revokes a token instance, removing the subject from
+// the student academic tree and invalidating dependent degree requests.
+type MsgRevokeTokenInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+	Reason          string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *MsgRevokeTokenInstance) Reset() {
+	*x = MsgRevokeTokenInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevokeTokenInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevokeTokenInstance) ProtoMessage() {}
+
+// Deprecated: Use MsgRevokeTokenInstance.ProtoReflect.Descriptor instead.
+func (*MsgRevokeTokenInstance) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgRevokeTokenInstance) GetCreator() string {
 	if x != nil {
-		return x.IssuerInstitution
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgMintSubjectToken) GetSemester() string {
+func (x *MsgRevokeTokenInstance) GetTokenInstanceId() string {
 	if x != nil {
-		return x.Semester
+		return x.TokenInstanceId
 	}
 	return ""
 }
 
-func (x *MsgMintSubjectToken) GetProfessorSignature() string {
+func (x *MsgRevokeTokenInstance) GetReason() string {
 	if x != nil {
-		return x.ProfessorSignature
+		return x.Reason
 	}
 	return ""
 }
 
-type MsgMintSubjectTokenResponse struct {
+type MsgRevokeTokenInstanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenInstanceId string `protobuf:"bytes,1,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"` // ADIÇÃO: retorna o ID do token criado
+	InvalidatedDegreeRequests []string `protobuf:"bytes,1,rep,name=invalidatedDegreeRequests,proto3" json:"invalidatedDegreeRequests,omitempty"`
 }
 
-func (x *MsgMintSubjectTokenResponse) Reset() {
-	*x = MsgMintSubjectTokenResponse{}
+func (x *MsgRevokeTokenInstanceResponse) Reset() {
+	*x = MsgRevokeTokenInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_academicnft_tx_proto_msgTypes[3]
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgMintSubjectTokenResponse) String() string {
+func (x *MsgRevokeTokenInstanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgMintSubjectTokenResponse) ProtoMessage() {}
+func (*MsgRevokeTokenInstanceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgMintSubjectTokenResponse.ProtoReflect.Descriptor instead.
-func (*MsgMintSubjectTokenResponse) Descriptor() ([]byte, []int) {
-	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgRevokeTokenInstanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevokeTokenInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *MsgMintSubjectTokenResponse) GetTokenInstanceId() string {
+func (x *MsgRevokeTokenInstanceResponse) GetInvalidatedDegreeRequests() []string {
 	if x != nil {
-		return x.TokenInstanceId
+		return x.InvalidatedDegreeRequests
 	}
-	return ""
+	return nil
 }
 
-type MsgVerifyTokenInstance struct {
+// MsgTransferTokenInstance moves a token instance to another student. Only
+// the owner may transfer, and only when the token definition is transferable.
+type MsgTransferTokenInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+	Recipient       string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (x *MsgVerifyTokenInstance) Reset() {
-	*x = MsgVerifyTokenInstance{}
+func (x *MsgTransferTokenInstance) Reset() {
+	*x = MsgTransferTokenInstance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_academicnft_tx_proto_msgTypes[4]
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgVerifyTokenInstance) String() string {
+func (x *MsgTransferTokenInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgVerifyTokenInstance) ProtoMessage() {}
+func (*MsgTransferTokenInstance) ProtoMessage() {}
 
-// Deprecated: Use MsgVerifyTokenInstance.ProtoReflect.Descriptor instead.
-func (*MsgVerifyTokenInstance) Descriptor() ([]byte, []int) {
-	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgTransferTokenInstance.ProtoReflect.Descriptor instead.
+func (*MsgTransferTokenInstance) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgVerifyTokenInstance) GetCreator() string {
+func (x *MsgTransferTokenInstance) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgVerifyTokenInstance) GetTokenInstanceId() string {
+func (x *MsgTransferTokenInstance) GetTokenInstanceId() string {
 	if x != nil {
 		return x.TokenInstanceId
 	}
 	return ""
 }
 
-type MsgVerifyTokenInstanceResponse struct {
+func (x *MsgTransferTokenInstance) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+type MsgTransferTokenInstanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgTransferTokenInstanceResponse) Reset() {
+	*x = MsgTransferTokenInstanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-	IsValid_ bool `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"` // ADIÇÃO: retorna se é válido
+func (x *MsgTransferTokenInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgVerifyTokenInstanceResponse) Reset() {
-	*x = MsgVerifyTokenInstanceResponse{}
+func (*MsgTransferTokenInstanceResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferTokenInstanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgTransferTokenInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgBurnTokenInstance destroys a token instance. The owner or an admin of the
+// issuing institution may burn, and only when the token definition is burnable.
+type MsgBurnTokenInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+}
+
+func (x *MsgBurnTokenInstance) Reset() {
+	*x = MsgBurnTokenInstance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_academicnft_tx_proto_msgTypes[5]
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgVerifyTokenInstanceResponse) String() string {
+func (x *MsgBurnTokenInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgVerifyTokenInstanceResponse) ProtoMessage() {}
+func (*MsgBurnTokenInstance) ProtoMessage() {}
 
-// Deprecated: Use MsgVerifyTokenInstanceResponse.ProtoReflect.Descriptor instead.
-func (*MsgVerifyTokenInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgBurnTokenInstance.ProtoReflect.Descriptor instead.
+func (*MsgBurnTokenInstance) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgVerifyTokenInstanceResponse) GetIsValid_() bool {
+func (x *MsgBurnTokenInstance) GetCreator() string {
 	if x != nil {
-		return x.IsValid_
+		return x.Creator
 	}
-	return false
+	return ""
+}
+
+func (x *MsgBurnTokenInstance) GetTokenInstanceId() string {
+	if x != nil {
+		return x.TokenInstanceId
+	}
+	return ""
+}
+
+type MsgBurnTokenInstanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgBurnTokenInstanceResponse) Reset() {
+	*x = MsgBurnTokenInstanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_academicnft_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBurnTokenInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBurnTokenInstanceResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgBurnTokenInstanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgBurnTokenInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_academicnft_tx_proto_rawDescGZIP(), []int{13}
 }
 
 var File_academictoken_academicnft_tx_proto protoreflect.FileDescriptor
@@ -3375,7 +7853,7 @@ var file_academictoken_academicnft_tx_proto_rawDesc = []byte{
 	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xaf, 0x02, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x62,
+	0x65, 0x22, 0xd3, 0x02, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x62,
 	0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x66, 0x49,
@@ -3393,61 +7871,152 @@ var file_academictoken_academicnft_tx_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73,
 	0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x22, 0x47, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x16,
-	0x4d, 0x73, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x47, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x4d, 0x69,
+	0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0x6a, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xce, 0x01, 0x0a,
+	0x1e, 0x4d, 0x73, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x2e, 0x0a,
+	0x12, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6b, 0x65, 0x79, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x41, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x95, 0x01,
+	0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x41, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x41, 0x6d, 0x65, 0x6e,
+	0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x5e, 0x0a,
+	0x1e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x19, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x19, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x8a, 0x01,
+	0x0a, 0x18, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68,
+	0x0a, 0x14, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
 	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x3a, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x32, 0xfe, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x6e, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2a, 0x2e, 0x61,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x42,
+	0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf9, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67,
+	0x12, 0x6e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x2a, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x32, 0x2e, 0x61,
 	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61,
 	0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x32, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
-	0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x10,
-	0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x2e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67,
-	0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x1a, 0x36, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7a, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x36, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a,
+	0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0x2e, 0x4d, 0x73, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x39, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x68, 0x0a, 0x0a, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x28, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67,
-	0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x31, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x1a, 0x39, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x30, 0x2e, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a,
+	0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x39, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0x3b, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e, 0x4d, 0x73,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d,
+	0x0a, 0x11, 0x42, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x2e,
-	0x4d, 0x73, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05,
-	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xda, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63,
-	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x2b, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xa2,
-	0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66,
-	0x74, 0xca, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5c, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xe2, 0x02, 0x25,
-	0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x41, 0x63,
-	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e,
-	0x66, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x1a, 0x37, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0x2e, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80,
+	0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xda, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2b, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xa2, 0x02,
+	0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74,
+	0xca, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5c, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0xe2, 0x02, 0x25, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x41, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x6e, 0x66,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3462,29 +8031,45 @@ func file_academictoken_academicnft_tx_proto_rawDescGZIP() []byte {
 	return file_academictoken_academicnft_tx_proto_rawDescData
 }
 
-var file_academictoken_academicnft_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_academictoken_academicnft_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_academictoken_academicnft_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateParams)(nil),                // 0: academictoken.academicnft.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),        // 1: academictoken.academicnft.MsgUpdateParamsResponse
-	(*MsgMintSubjectToken)(nil),            // 2: academictoken.academicnft.MsgMintSubjectToken
-	(*MsgMintSubjectTokenResponse)(nil),    // 3: academictoken.academicnft.MsgMintSubjectTokenResponse
-	(*MsgVerifyTokenInstance)(nil),         // 4: academictoken.academicnft.MsgVerifyTokenInstance
-	(*MsgVerifyTokenInstanceResponse)(nil), // 5: academictoken.academicnft.MsgVerifyTokenInstanceResponse
-	(*Params)(nil),                         // 6: academictoken.academicnft.Params
+	(*MsgUpdateParams)(nil),                  // 0: academictoken.academicnft.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),          // 1: academictoken.academicnft.MsgUpdateParamsResponse
+	(*MsgMintSubjectToken)(nil),              // 2: academictoken.academicnft.MsgMintSubjectToken
+	(*MsgMintSubjectTokenResponse)(nil),      // 3: academictoken.academicnft.MsgMintSubjectTokenResponse
+	(*MsgVerifyTokenInstance)(nil),           // 4: academictoken.academicnft.MsgVerifyTokenInstance
+	(*MsgVerifyTokenInstanceResponse)(nil),   // 5: academictoken.academicnft.MsgVerifyTokenInstanceResponse
+	(*MsgAmendGrade)(nil),                    // 6: academictoken.academicnft.MsgAmendGrade
+	(*MsgAmendGradeResponse)(nil),            // 7: academictoken.academicnft.MsgAmendGradeResponse
+	(*MsgRevokeTokenInstance)(nil),           // 8: academictoken.academicnft.MsgRevokeTokenInstance
+	(*MsgRevokeTokenInstanceResponse)(nil),   // 9: academictoken.academicnft.MsgRevokeTokenInstanceResponse
+	(*MsgTransferTokenInstance)(nil),         // 10: academictoken.academicnft.MsgTransferTokenInstance
+	(*MsgTransferTokenInstanceResponse)(nil), // 11: academictoken.academicnft.MsgTransferTokenInstanceResponse
+	(*MsgBurnTokenInstance)(nil),             // 12: academictoken.academicnft.MsgBurnTokenInstance
+	(*MsgBurnTokenInstanceResponse)(nil),     // 13: academictoken.academicnft.MsgBurnTokenInstanceResponse
+	(*Params)(nil),                           // 14: academictoken.academicnft.Params
 }
 var file_academictoken_academicnft_tx_proto_depIdxs = []int32{
-	6, // 0: academictoken.academicnft.MsgUpdateParams.params:type_name -> academictoken.academicnft.Params
-	0, // 1: academictoken.academicnft.Msg.UpdateParams:input_type -> academictoken.academicnft.MsgUpdateParams
-	2, // 2: academictoken.academicnft.Msg.MintSubjectToken:input_type -> academictoken.academicnft.MsgMintSubjectToken
-	4, // 3: academictoken.academicnft.Msg.VerifyTokenInstance:input_type -> academictoken.academicnft.MsgVerifyTokenInstance
-	1, // 4: academictoken.academicnft.Msg.UpdateParams:output_type -> academictoken.academicnft.MsgUpdateParamsResponse
-	3, // 5: academictoken.academicnft.Msg.MintSubjectToken:output_type -> academictoken.academicnft.MsgMintSubjectTokenResponse
-	5, // 6: academictoken.academicnft.Msg.VerifyTokenInstance:output_type -> academictoken.academicnft.MsgVerifyTokenInstanceResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	14, // 0: academictoken.academicnft.MsgUpdateParams.params:type_name -> academictoken.academicnft.Params
+	0,  // 1: academictoken.academicnft.Msg.UpdateParams:input_type -> academictoken.academicnft.MsgUpdateParams
+	2,  // 2: academictoken.academicnft.Msg.MintSubjectToken:input_type -> academictoken.academicnft.MsgMintSubjectToken
+	4,  // 3: academictoken.academicnft.Msg.VerifyTokenInstance:input_type -> academictoken.academicnft.MsgVerifyTokenInstance
+	6,  // 4: academictoken.academicnft.Msg.AmendGrade:input_type -> academictoken.academicnft.MsgAmendGrade
+	8,  // 5: academictoken.academicnft.Msg.RevokeTokenInstance:input_type -> academictoken.academicnft.MsgRevokeTokenInstance
+	10, // 6: academictoken.academicnft.Msg.TransferTokenInstance:input_type -> academictoken.academicnft.MsgTransferTokenInstance
+	12, // 7: academictoken.academicnft.Msg.BurnTokenInstance:input_type -> academictoken.academicnft.MsgBurnTokenInstance
+	1,  // 8: academictoken.academicnft.Msg.UpdateParams:output_type -> academictoken.academicnft.MsgUpdateParamsResponse
+	3,  // 9: academictoken.academicnft.Msg.MintSubjectToken:output_type -> academictoken.academicnft.MsgMintSubjectTokenResponse
+	5,  // 10: academictoken.academicnft.Msg.VerifyTokenInstance:output_type -> academictoken.academicnft.MsgVerifyTokenInstanceResponse
+	7,  // 11: academictoken.academicnft.Msg.AmendGrade:output_type -> academictoken.academicnft.MsgAmendGradeResponse
+	9,  // 12: academictoken.academicnft.Msg.RevokeTokenInstance:output_type -> academictoken.academicnft.MsgRevokeTokenInstanceResponse
+	11, // 13: academictoken.academicnft.Msg.TransferTokenInstance:output_type -> academictoken.academicnft.MsgTransferTokenInstanceResponse
+	13, // 14: academictoken.academicnft.Msg.BurnTokenInstance:output_type -> academictoken.academicnft.MsgBurnTokenInstanceResponse
+	8,  // [8:15] is the sub-list for method output_type
+	1,  // [1:8] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_academictoken_academicnft_tx_proto_init() }
@@ -3566,6 +8151,102 @@ func file_academictoken_academicnft_tx_proto_init() {
 				return nil
 			}
 		}
+		file_academictoken_academicnft_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAmendGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAmendGradeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevokeTokenInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevokeTokenInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferTokenInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferTokenInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBurnTokenInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_academicnft_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBurnTokenInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3573,7 +8254,7 @@ func file_academictoken_academicnft_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_academictoken_academicnft_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName        = "/academictoken.academicnft.Msg/UpdateParams"
-	Msg_MintSubjectToken_FullMethodName    = "/academictoken.academicnft.Msg/MintSubjectToken"
-	Msg_VerifyTokenInstance_FullMethodName = "/academictoken.academicnft.Msg/VerifyTokenInstance"
+	Msg_UpdateParams_FullMethodName          = "/academictoken.academicnft.Msg/UpdateParams"
+	Msg_MintSubjectToken_FullMethodName      = "/academictoken.academicnft.Msg/MintSubjectToken"
+	Msg_VerifyTokenInstance_FullMethodName   = "/academictoken.academicnft.Msg/VerifyTokenInstance"
+	Msg_AmendGrade_FullMethodName            = "/academictoken.academicnft.Msg/AmendGrade"
+	Msg_RevokeTokenInstance_FullMethodName   = "/academictoken.academicnft.Msg/RevokeTokenInstance"
+	Msg_TransferTokenInstance_FullMethodName = "/academictoken.academicnft.Msg/TransferTokenInstance"
+	Msg_BurnTokenInstance_FullMethodName     = "/academictoken.academicnft.Msg/BurnTokenInstance"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +37,10 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	MintSubjectToken(ctx context.Context, in *MsgMintSubjectToken, opts ...grpc.CallOption) (*MsgMintSubjectTokenResponse, error)
 	VerifyTokenInstance(ctx context.Context, in *MsgVerifyTokenInstance, opts ...grpc.CallOption) (*MsgVerifyTokenInstanceResponse, error)
+	AmendGrade(ctx context.Context, in *MsgAmendGrade, opts ...grpc.CallOption) (*MsgAmendGradeResponse, error)
+	RevokeTokenInstance(ctx context.Context, in *MsgRevokeTokenInstance, opts ...grpc.CallOption) (*MsgRevokeTokenInstanceResponse, error)
+	TransferTokenInstance(ctx context.Context, in *MsgTransferTokenInstance, opts ...grpc.CallOption) (*MsgTransferTokenInstanceResponse, error)
+	BurnTokenInstance(ctx context.Context, in *MsgBurnTokenInstance, opts ...grpc.CallOption) (*MsgBurnTokenInstanceResponse, error)
 }
 
 type msgClient struct {
@@ -70,6 +78,42 @@ func (c *msgClient) VerifyTokenInstance(ctx context.Context, in *MsgVerifyTokenI
 	return out, nil
 }
 
+func (c *msgClient) AmendGrade(ctx context.Context, in *MsgAmendGrade, opts ...grpc.CallOption) (*MsgAmendGradeResponse, error) {
+	out := new(MsgAmendGradeResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendGrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeTokenInstance(ctx context.Context, in *MsgRevokeTokenInstance, opts ...grpc.CallOption) (*MsgRevokeTokenInstanceResponse, error) {
+	out := new(MsgRevokeTokenInstanceResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeTokenInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTokenInstance(ctx context.Context, in *MsgTransferTokenInstance, opts ...grpc.CallOption) (*MsgTransferTokenInstanceResponse, error) {
+	out := new(MsgTransferTokenInstanceResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferTokenInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnTokenInstance(ctx context.Context, in *MsgBurnTokenInstance, opts ...grpc.CallOption) (*MsgBurnTokenInstanceResponse, error) {
+	out := new(MsgBurnTokenInstanceResponse)
+	err := c.cc.Invoke(ctx, Msg_BurnTokenInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -79,6 +123,10 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	MintSubjectToken(context.Context, *MsgMintSubjectToken) (*MsgMintSubjectTokenResponse, error)
 	VerifyTokenInstance(context.Context, *MsgVerifyTokenInstance) (*MsgVerifyTokenInstanceResponse, error)
+	AmendGrade(context.Context, *MsgAmendGrade) (*MsgAmendGradeResponse, error)
+	RevokeTokenInstance(context.Context, *MsgRevokeTokenInstance) (*MsgRevokeTokenInstanceResponse, error)
+	TransferTokenInstance(context.Context, *MsgTransferTokenInstance) (*MsgTransferTokenInstanceResponse, error)
+	BurnTokenInstance(context.Context, *MsgBurnTokenInstance) (*MsgBurnTokenInstanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -95,6 +143,18 @@ func (UnimplementedMsgServer) MintSubjectToken(context.Context, *MsgMintSubjectT
 func (UnimplementedMsgServer) VerifyTokenInstance(context.Context, *MsgVerifyTokenInstance) (*MsgVerifyTokenInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyTokenInstance not implemented")
 }
+func (UnimplementedMsgServer) AmendGrade(context.Context, *MsgAmendGrade) (*MsgAmendGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendGrade not implemented")
+}
+func (UnimplementedMsgServer) RevokeTokenInstance(context.Context, *MsgRevokeTokenInstance) (*MsgRevokeTokenInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTokenInstance not implemented")
+}
+func (UnimplementedMsgServer) TransferTokenInstance(context.Context, *MsgTransferTokenInstance) (*MsgTransferTokenInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTokenInstance not implemented")
+}
+func (UnimplementedMsgServer) BurnTokenInstance(context.Context, *MsgBurnTokenInstance) (*MsgBurnTokenInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnTokenInstance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +222,78 @@ func _Msg_VerifyTokenInstance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AmendGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendGrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendGrade(ctx, req.(*MsgAmendGrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeTokenInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeTokenInstance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeTokenInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeTokenInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeTokenInstance(ctx, req.(*MsgRevokeTokenInstance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTokenInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTokenInstance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTokenInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferTokenInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTokenInstance(ctx, req.(*MsgTransferTokenInstance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnTokenInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnTokenInstance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnTokenInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BurnTokenInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnTokenInstance(ctx, req.(*MsgBurnTokenInstance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +313,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyTokenInstance",
 			Handler:    _Msg_VerifyTokenInstance_Handler,
 		},
+		{
+			MethodName: "AmendGrade",
+			Handler:    _Msg_AmendGrade_Handler,
+		},
+		{
+			MethodName: "RevokeTokenInstance",
+			Handler:    _Msg_RevokeTokenInstance_Handler,
+		},
+		{
+			MethodName: "TransferTokenInstance",
+			Handler:    _Msg_TransferTokenInstance_Handler,
+		},
+		{
+			MethodName: "BurnTokenInstance",
+			Handler:    _Msg_BurnTokenInstance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/academicnft/tx.proto",
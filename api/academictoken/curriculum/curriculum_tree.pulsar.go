@@ -218,6 +218,9 @@ var (
 	fd_CurriculumTree_semesterStructure      protoreflect.FieldDescriptor
 	fd_CurriculumTree_graduationRequirements protoreflect.FieldDescriptor
 	fd_CurriculumTree_electiveGroups         protoreflect.FieldDescriptor
+	fd_CurriculumTree_status                 protoreflect.FieldDescriptor
+	fd_CurriculumTree_publishedAt            protoreflect.FieldDescriptor
+	fd_CurriculumTree_derivedFrom            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -233,6 +236,9 @@ func init() {
 	fd_CurriculumTree_semesterStructure = md_CurriculumTree.Fields().ByName("semesterStructure")
 	fd_CurriculumTree_graduationRequirements = md_CurriculumTree.Fields().ByName("graduationRequirements")
 	fd_CurriculumTree_electiveGroups = md_CurriculumTree.Fields().ByName("electiveGroups")
+	fd_CurriculumTree_status = md_CurriculumTree.Fields().ByName("status")
+	fd_CurriculumTree_publishedAt = md_CurriculumTree.Fields().ByName("publishedAt")
+	fd_CurriculumTree_derivedFrom = md_CurriculumTree.Fields().ByName("derivedFrom")
 }
 
 var _ protoreflect.Message = (*fastReflection_CurriculumTree)(nil)
@@ -360,6 +366,24 @@ func (x *fastReflection_CurriculumTree) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_CurriculumTree_status, value) {
+			return
+		}
+	}
+	if x.PublishedAt != "" {
+		value := protoreflect.ValueOfString(x.PublishedAt)
+		if !f(fd_CurriculumTree_publishedAt, value) {
+			return
+		}
+	}
+	if x.DerivedFrom != "" {
+		value := protoreflect.ValueOfString(x.DerivedFrom)
+		if !f(fd_CurriculumTree_derivedFrom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -395,6 +419,12 @@ func (x *fastReflection_CurriculumTree) Has(fd protoreflect.FieldDescriptor) boo
 		return x.GraduationRequirements != nil
 	case "academictoken.curriculum.CurriculumTree.electiveGroups":
 		return len(x.ElectiveGroups) != 0
+	case "academictoken.curriculum.CurriculumTree.status":
+		return x.Status != ""
+	case "academictoken.curriculum.CurriculumTree.publishedAt":
+		return x.PublishedAt != ""
+	case "academictoken.curriculum.CurriculumTree.derivedFrom":
+		return x.DerivedFrom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.curriculum.CurriculumTree"))
@@ -431,6 +461,12 @@ func (x *fastReflection_CurriculumTree) Clear(fd protoreflect.FieldDescriptor) {
 		x.GraduationRequirements = nil
 	case "academictoken.curriculum.CurriculumTree.electiveGroups":
 		x.ElectiveGroups = nil
+	case "academictoken.curriculum.CurriculumTree.status":
+		x.Status = ""
+	case "academictoken.curriculum.CurriculumTree.publishedAt":
+		x.PublishedAt = ""
+	case "academictoken.curriculum.CurriculumTree.derivedFrom":
+		x.DerivedFrom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.curriculum.CurriculumTree"))
@@ -489,6 +525,15 @@ func (x *fastReflection_CurriculumTree) Get(descriptor protoreflect.FieldDescrip
 		}
 		listValue := &_CurriculumTree_10_list{list: &x.ElectiveGroups}
 		return protoreflect.ValueOfList(listValue)
+	case "academictoken.curriculum.CurriculumTree.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "academictoken.curriculum.CurriculumTree.publishedAt":
+		value := x.PublishedAt
+		return protoreflect.ValueOfString(value)
+	case "academictoken.curriculum.CurriculumTree.derivedFrom":
+		value := x.DerivedFrom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.curriculum.CurriculumTree"))
@@ -537,6 +582,12 @@ func (x *fastReflection_CurriculumTree) Set(fd protoreflect.FieldDescriptor, val
 		lv := value.List()
 		clv := lv.(*_CurriculumTree_10_list)
 		x.ElectiveGroups = *clv.list
+	case "academictoken.curriculum.CurriculumTree.status":
+		x.Status = value.Interface().(string)
+	case "academictoken.curriculum.CurriculumTree.publishedAt":
+		x.PublishedAt = value.Interface().(string)
+	case "academictoken.curriculum.CurriculumTree.derivedFrom":
+		x.DerivedFrom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.curriculum.CurriculumTree"))
@@ -596,6 +647,12 @@ func (x *fastReflection_CurriculumTree) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field totalWorkloadHours of message academictoken.curriculum.CurriculumTree is not mutable"))
 	case "academictoken.curriculum.CurriculumTree.electiveMin":
 		panic(fmt.Errorf("field electiveMin of message academictoken.curriculum.CurriculumTree is not mutable"))
+	case "academictoken.curriculum.CurriculumTree.status":
+		panic(fmt.Errorf("field status of message academictoken.curriculum.CurriculumTree is not mutable"))
+	case "academictoken.curriculum.CurriculumTree.publishedAt":
+		panic(fmt.Errorf("field publishedAt of message academictoken.curriculum.CurriculumTree is not mutable"))
+	case "academictoken.curriculum.CurriculumTree.derivedFrom":
+		panic(fmt.Errorf("field derivedFrom of message academictoken.curriculum.CurriculumTree is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.curriculum.CurriculumTree"))
@@ -634,6 +691,12 @@ func (x *fastReflection_CurriculumTree) NewField(fd protoreflect.FieldDescriptor
 	case "academictoken.curriculum.CurriculumTree.electiveGroups":
 		list := []*ElectiveGroup{}
 		return protoreflect.ValueOfList(&_CurriculumTree_10_list{list: &list})
+	case "academictoken.curriculum.CurriculumTree.status":
+		return protoreflect.ValueOfString("")
+	case "academictoken.curriculum.CurriculumTree.publishedAt":
+		return protoreflect.ValueOfString("")
+	case "academictoken.curriculum.CurriculumTree.derivedFrom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.curriculum.CurriculumTree"))
@@ -750,6 +813,18 @@ func (x *fastReflection_CurriculumTree) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublishedAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DerivedFrom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -779,6 +854,27 @@ func (x *fastReflection_CurriculumTree) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DerivedFrom) > 0 {
+			i -= len(x.DerivedFrom)
+			copy(dAtA[i:], x.DerivedFrom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DerivedFrom)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.PublishedAt) > 0 {
+			i -= len(x.PublishedAt)
+			copy(dAtA[i:], x.PublishedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublishedAt)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if len(x.ElectiveGroups) > 0 {
 			for iNdEx := len(x.ElectiveGroups) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.ElectiveGroups[iNdEx])
@@ -1240,6 +1336,102 @@ func (x *fastReflection_CurriculumTree) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublishedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DerivedFrom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DerivedFrom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1303,6 +1495,12 @@ type CurriculumTree struct {
 	SemesterStructure      []*CurriculumSemester   `protobuf:"bytes,8,rep,name=semesterStructure,proto3" json:"semesterStructure,omitempty"`
 	GraduationRequirements *GraduationRequirements `protobuf:"bytes,9,opt,name=graduationRequirements,proto3" json:"graduationRequirements,omitempty"`
 	ElectiveGroups         []*ElectiveGroup        `protobuf:"bytes,10,rep,name=electiveGroups,proto3" json:"electiveGroups,omitempty"`
+	// status is draft while the tree can be edited and published once frozen;
+	// trees stored without a status predate versioning and count as published
+	Status      string `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	PublishedAt string `protobuf:"bytes,12,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	// derivedFrom is the index of the tree a published version was copied from
+	DerivedFrom string `protobuf:"bytes,13,opt,name=derivedFrom,proto3" json:"derivedFrom,omitempty"`
 }
 
 func (x *CurriculumTree) Reset() {
@@ -1395,6 +1593,27 @@ func (x *CurriculumTree) GetElectiveGroups() []*ElectiveGroup {
 	return nil
 }
 
+func (x *CurriculumTree) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CurriculumTree) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *CurriculumTree) GetDerivedFrom() string {
+	if x != nil {
+		return x.DerivedFrom
+	}
+	return ""
+}
+
 var File_academictoken_curriculum_curriculum_tree_proto protoreflect.FileDescriptor
 
 var file_academictoken_curriculum_curriculum_tree_proto_rawDesc = []byte{
@@ -1412,7 +1631,7 @@ var file_academictoken_curriculum_curriculum_tree_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2d, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d,
 	0x2f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x04, 0x0a, 0x0e, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x04, 0x0a, 0x0e, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63,
 	0x75, 0x6c, 0x75, 0x6d, 0x54, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
 	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a,
 	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -1446,21 +1665,27 @@ var file_academictoken_curriculum_curriculum_tree_proto_rawDesc = []byte{
 	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x63, 0x75, 0x72,
 	0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
 	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0e, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0xe0, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63,
-	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x63, 0x75, 0x72, 0x72,
-	0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x42, 0x13, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c,
-	0x75, 0x6d, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x61,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x63,
-	0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0xa2, 0x02, 0x03, 0x41, 0x43, 0x58, 0xaa,
-	0x02, 0x18, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
-	0x43, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0xca, 0x02, 0x18, 0x41, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x43, 0x75, 0x72, 0x72, 0x69,
-	0x63, 0x75, 0x6c, 0x75, 0x6d, 0xe2, 0x02, 0x24, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x41,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x43, 0x75,
-	0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x42, 0xe0, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c,
+	0x75, 0x6d, 0x42, 0x13, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x54, 0x72,
+	0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x63, 0x75, 0x6c, 0x75, 0x6d, 0xa2, 0x02, 0x03, 0x41, 0x43, 0x58, 0xaa, 0x02, 0x18, 0x41, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0xca, 0x02, 0x18, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75,
+	0x6d, 0xe2, 0x02, 0x24, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5c, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63,
+	0x75, 0x6c, 0x75, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -24,6 +24,7 @@ const (
 	Msg_AddSemesterToCurriculum_FullMethodName   = "/academictoken.curriculum.Msg/AddSemesterToCurriculum"
 	Msg_AddElectiveGroup_FullMethodName          = "/academictoken.curriculum.Msg/AddElectiveGroup"
 	Msg_SetGraduationRequirements_FullMethodName = "/academictoken.curriculum.Msg/SetGraduationRequirements"
+	Msg_PublishCurriculumVersion_FullMethodName  = "/academictoken.curriculum.Msg/PublishCurriculumVersion"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +38,7 @@ type MsgClient interface {
 	AddSemesterToCurriculum(ctx context.Context, in *MsgAddSemesterToCurriculum, opts ...grpc.CallOption) (*MsgAddSemesterToCurriculumResponse, error)
 	AddElectiveGroup(ctx context.Context, in *MsgAddElectiveGroup, opts ...grpc.CallOption) (*MsgAddElectiveGroupResponse, error)
 	SetGraduationRequirements(ctx context.Context, in *MsgSetGraduationRequirements, opts ...grpc.CallOption) (*MsgSetGraduationRequirementsResponse, error)
+	PublishCurriculumVersion(ctx context.Context, in *MsgPublishCurriculumVersion, opts ...grpc.CallOption) (*MsgPublishCurriculumVersionResponse, error)
 }
 
 type msgClient struct {
@@ -92,6 +94,15 @@ func (c *msgClient) SetGraduationRequirements(ctx context.Context, in *MsgSetGra
 	return out, nil
 }
 
+func (c *msgClient) PublishCurriculumVersion(ctx context.Context, in *MsgPublishCurriculumVersion, opts ...grpc.CallOption) (*MsgPublishCurriculumVersionResponse, error) {
+	out := new(MsgPublishCurriculumVersionResponse)
+	err := c.cc.Invoke(ctx, Msg_PublishCurriculumVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -103,6 +114,7 @@ type MsgServer interface {
 	AddSemesterToCurriculum(context.Context, *MsgAddSemesterToCurriculum) (*MsgAddSemesterToCurriculumResponse, error)
 	AddElectiveGroup(context.Context, *MsgAddElectiveGroup) (*MsgAddElectiveGroupResponse, error)
 	SetGraduationRequirements(context.Context, *MsgSetGraduationRequirements) (*MsgSetGraduationRequirementsResponse, error)
+	PublishCurriculumVersion(context.Context, *MsgPublishCurriculumVersion) (*MsgPublishCurriculumVersionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -125,6 +137,9 @@ func (UnimplementedMsgServer) AddElectiveGroup(context.Context, *MsgAddElectiveG
 func (UnimplementedMsgServer) SetGraduationRequirements(context.Context, *MsgSetGraduationRequirements) (*MsgSetGraduationRequirementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetGraduationRequirements not implemented")
 }
+func (UnimplementedMsgServer) PublishCurriculumVersion(context.Context, *MsgPublishCurriculumVersion) (*MsgPublishCurriculumVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishCurriculumVersion not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -228,6 +243,24 @@ func _Msg_SetGraduationRequirements_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PublishCurriculumVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPublishCurriculumVersion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PublishCurriculumVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PublishCurriculumVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PublishCurriculumVersion(ctx, req.(*MsgPublishCurriculumVersion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,6 +288,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetGraduationRequirements",
 			Handler:    _Msg_SetGraduationRequirements_Handler,
 		},
+		{
+			MethodName: "PublishCurriculumVersion",
+			Handler:    _Msg_PublishCurriculumVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/curriculum/tx.proto",
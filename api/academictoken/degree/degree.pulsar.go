@@ -104,6 +104,52 @@ func (x *_Degree_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Degree_18_list)(nil)
+
+type _Degree_18_list struct {
+	list *[]string
+}
+
+func (x *_Degree_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Degree_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Degree_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Degree_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Degree_18_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Degree at list field SigningKeyIds as it is not of Message kind"))
+}
+
+func (x *_Degree_18_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Degree_18_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Degree_18_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Degree                   protoreflect.MessageDescriptor
 	fd_Degree_index             protoreflect.FieldDescriptor
@@ -123,6 +169,7 @@ var (
 	fd_Degree_totalCredits      protoreflect.FieldDescriptor
 	fd_Degree_validationScore   protoreflect.FieldDescriptor
 	fd_Degree_contractAddress   protoreflect.FieldDescriptor
+	fd_Degree_signingKeyIds     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -145,6 +192,7 @@ func init() {
 	fd_Degree_totalCredits = md_Degree.Fields().ByName("totalCredits")
 	fd_Degree_validationScore = md_Degree.Fields().ByName("validationScore")
 	fd_Degree_contractAddress = md_Degree.Fields().ByName("contractAddress")
+	fd_Degree_signingKeyIds = md_Degree.Fields().ByName("signingKeyIds")
 }
 
 var _ protoreflect.Message = (*fastReflection_Degree)(nil)
@@ -314,6 +362,12 @@ func (x *fastReflection_Degree) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.SigningKeyIds) != 0 {
+		value := protoreflect.ValueOfList(&_Degree_18_list{list: &x.SigningKeyIds})
+		if !f(fd_Degree_signingKeyIds, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -363,6 +417,8 @@ func (x *fastReflection_Degree) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ValidationScore != ""
 	case "academictoken.degree.Degree.contractAddress":
 		return x.ContractAddress != ""
+	case "academictoken.degree.Degree.signingKeyIds":
+		return len(x.SigningKeyIds) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.Degree"))
@@ -413,6 +469,8 @@ func (x *fastReflection_Degree) Clear(fd protoreflect.FieldDescriptor) {
 		x.ValidationScore = ""
 	case "academictoken.degree.Degree.contractAddress":
 		x.ContractAddress = ""
+	case "academictoken.degree.Degree.signingKeyIds":
+		x.SigningKeyIds = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.Degree"))
@@ -486,6 +544,12 @@ func (x *fastReflection_Degree) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "academictoken.degree.Degree.contractAddress":
 		value := x.ContractAddress
 		return protoreflect.ValueOfString(value)
+	case "academictoken.degree.Degree.signingKeyIds":
+		if len(x.SigningKeyIds) == 0 {
+			return protoreflect.ValueOfList(&_Degree_18_list{})
+		}
+		listValue := &_Degree_18_list{list: &x.SigningKeyIds}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.Degree"))
@@ -544,6 +608,10 @@ func (x *fastReflection_Degree) Set(fd protoreflect.FieldDescriptor, value proto
 		x.ValidationScore = value.Interface().(string)
 	case "academictoken.degree.Degree.contractAddress":
 		x.ContractAddress = value.Interface().(string)
+	case "academictoken.degree.Degree.signingKeyIds":
+		lv := value.List()
+		clv := lv.(*_Degree_18_list)
+		x.SigningKeyIds = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.Degree"))
@@ -576,6 +644,12 @@ func (x *fastReflection_Degree) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Degree_10_list{list: &x.Signatures}
 		return protoreflect.ValueOfList(value)
+	case "academictoken.degree.Degree.signingKeyIds":
+		if x.SigningKeyIds == nil {
+			x.SigningKeyIds = []string{}
+		}
+		value := &_Degree_18_list{list: &x.SigningKeyIds}
+		return protoreflect.ValueOfList(value)
 	case "academictoken.degree.Degree.index":
 		panic(fmt.Errorf("field index of message academictoken.degree.Degree is not mutable"))
 	case "academictoken.degree.Degree.degreeId":
@@ -655,6 +729,9 @@ func (x *fastReflection_Degree) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "academictoken.degree.Degree.contractAddress":
 		return protoreflect.ValueOfString("")
+	case "academictoken.degree.Degree.signingKeyIds":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Degree_18_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.Degree"))
@@ -795,6 +872,12 @@ func (x *fastReflection_Degree) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.SigningKeyIds) > 0 {
+			for _, s := range x.SigningKeyIds {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -824,6 +907,17 @@ func (x *fastReflection_Degree) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.SigningKeyIds) > 0 {
+			for iNdEx := len(x.SigningKeyIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SigningKeyIds[iNdEx])
+				copy(dAtA[i:], x.SigningKeyIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningKeyIds[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
 		if len(x.ContractAddress) > 0 {
 			i -= len(x.ContractAddress)
 			copy(dAtA[i:], x.ContractAddress)
@@ -1529,6 +1623,38 @@ func (x *fastReflection_Degree) ProtoMethods() *protoiface.Methods {
 				}
 				x.ContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningKeyIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningKeyIds = append(x.SigningKeyIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1595,11 +1721,12 @@ type Degree struct {
 	ValidationHash    string   `protobuf:"bytes,11,opt,name=validationHash,proto3" json:"validationHash,omitempty"`
 	IpfsLink          string   `protobuf:"bytes,12,opt,name=ipfsLink,proto3" json:"ipfsLink,omitempty"`
 	// Additional fields for enhanced functionality
-	Status          string `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
-	NftTokenId      string `protobuf:"bytes,14,opt,name=nftTokenId,proto3" json:"nftTokenId,omitempty"`
-	TotalCredits    uint64 `protobuf:"varint,15,opt,name=totalCredits,proto3" json:"totalCredits,omitempty"`
-	ValidationScore string `protobuf:"bytes,16,opt,name=validationScore,proto3" json:"validationScore,omitempty"`
-	ContractAddress string `protobuf:"bytes,17,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"` // Contract used for validation
+	Status          string   `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	NftTokenId      string   `protobuf:"bytes,14,opt,name=nftTokenId,proto3" json:"nftTokenId,omitempty"`
+	TotalCredits    uint64   `protobuf:"varint,15,opt,name=totalCredits,proto3" json:"totalCredits,omitempty"`
+	ValidationScore string   `protobuf:"bytes,16,opt,name=validationScore,proto3" json:"validationScore,omitempty"`
+	ContractAddress string   `protobuf:"bytes,17,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"` // Contract used for validation
+	SigningKeyIds   []string `protobuf:"bytes,18,rep,name=signingKeyIds,proto3" json:"signingKeyIds,omitempty"`     // institution signing key that produced each signature
 }
 
 func (x *Degree) Reset() {
@@ -1741,13 +1868,20 @@ func (x *Degree) GetContractAddress() string {
 	return ""
 }
 
+func (x *Degree) GetSigningKeyIds() []string {
+	if x != nil {
+		return x.SigningKeyIds
+	}
+	return nil
+}
+
 var File_academictoken_degree_degree_proto protoreflect.FileDescriptor
 
 var file_academictoken_degree_degree_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
 	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x22, 0xba, 0x04, 0x0a, 0x06, 0x44, 0x65,
+	0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x22, 0xe0, 0x04, 0x0a, 0x06, 0x44, 0x65,
 	0x67, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
 	0x67, 0x72, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
@@ -1783,20 +1917,22 @@ var file_academictoken_degree_degree_proto_rawDesc = []byte{
 	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0f,
 	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
 	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0xc0, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67,
-	0x72, 0x65, 0x65, 0x42, 0x0b, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x26, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x2f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0xa2, 0x02, 0x03, 0x41, 0x44, 0x58,
-	0xaa, 0x02, 0x14, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0xca, 0x02, 0x14, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0xe2, 0x02,
-	0x20, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x44,
-	0x65, 0x67, 0x72, 0x65, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x3a, 0x3a, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x73, 0x42, 0xc0, 0x01, 0x0a,
+	0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x42, 0x0b, 0x44, 0x65, 0x67, 0x72, 0x65,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65,
+	0xa2, 0x02, 0x03, 0x41, 0x44, 0x58, 0xaa, 0x02, 0x14, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0xca, 0x02, 0x14,
+	0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x44, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0xe2, 0x02, 0x20, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
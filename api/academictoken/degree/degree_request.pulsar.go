@@ -12,49 +12,54 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.List = (*_DegreeRequest_11_list)(nil)
+var _ protoreflect.List = (*_DegreeRequest_12_list)(nil)
 
-type _DegreeRequest_11_list struct {
-	list *[]string
+type _DegreeRequest_12_list struct {
+	list *[]*MissingRequirement
 }
 
-func (x *_DegreeRequest_11_list) Len() int {
+func (x *_DegreeRequest_12_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_DegreeRequest_11_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_DegreeRequest_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_DegreeRequest_11_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_DegreeRequest_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MissingRequirement)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_DegreeRequest_11_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_DegreeRequest_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MissingRequirement)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_DegreeRequest_11_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message DegreeRequest at list field MissingRequirements as it is not of Message kind"))
+func (x *_DegreeRequest_12_list) AppendMutable() protoreflect.Value {
+	v := new(MissingRequirement)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DegreeRequest_11_list) Truncate(n int) {
+func (x *_DegreeRequest_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_DegreeRequest_11_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_DegreeRequest_12_list) NewElement() protoreflect.Value {
+	v := new(MissingRequirement)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DegreeRequest_11_list) IsValid() bool {
+func (x *_DegreeRequest_12_list) IsValid() bool {
 	return x.list != nil
 }
 
@@ -215,7 +220,7 @@ func (x *fastReflection_DegreeRequest) Range(f func(protoreflect.FieldDescriptor
 		}
 	}
 	if len(x.MissingRequirements) != 0 {
-		value := protoreflect.ValueOfList(&_DegreeRequest_11_list{list: &x.MissingRequirements})
+		value := protoreflect.ValueOfList(&_DegreeRequest_12_list{list: &x.MissingRequirements})
 		if !f(fd_DegreeRequest_missing_requirements, value) {
 			return
 		}
@@ -343,9 +348,9 @@ func (x *fastReflection_DegreeRequest) Get(descriptor protoreflect.FieldDescript
 		return protoreflect.ValueOfString(value)
 	case "academictoken.degree.DegreeRequest.missing_requirements":
 		if len(x.MissingRequirements) == 0 {
-			return protoreflect.ValueOfList(&_DegreeRequest_11_list{})
+			return protoreflect.ValueOfList(&_DegreeRequest_12_list{})
 		}
-		listValue := &_DegreeRequest_11_list{list: &x.MissingRequirements}
+		listValue := &_DegreeRequest_12_list{list: &x.MissingRequirements}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
@@ -389,7 +394,7 @@ func (x *fastReflection_DegreeRequest) Set(fd protoreflect.FieldDescriptor, valu
 		x.ValidationDetails = value.Interface().(string)
 	case "academictoken.degree.DegreeRequest.missing_requirements":
 		lv := value.List()
-		clv := lv.(*_DegreeRequest_11_list)
+		clv := lv.(*_DegreeRequest_12_list)
 		x.MissingRequirements = *clv.list
 	default:
 		if fd.IsExtension() {
@@ -413,9 +418,9 @@ func (x *fastReflection_DegreeRequest) Mutable(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "academictoken.degree.DegreeRequest.missing_requirements":
 		if x.MissingRequirements == nil {
-			x.MissingRequirements = []string{}
+			x.MissingRequirements = []*MissingRequirement{}
 		}
-		value := &_DegreeRequest_11_list{list: &x.MissingRequirements}
+		value := &_DegreeRequest_12_list{list: &x.MissingRequirements}
 		return protoreflect.ValueOfList(value)
 	case "academictoken.degree.DegreeRequest.id":
 		panic(fmt.Errorf("field id of message academictoken.degree.DegreeRequest is not mutable"))
@@ -471,8 +476,8 @@ func (x *fastReflection_DegreeRequest) NewField(fd protoreflect.FieldDescriptor)
 	case "academictoken.degree.DegreeRequest.validation_details":
 		return protoreflect.ValueOfString("")
 	case "academictoken.degree.DegreeRequest.missing_requirements":
-		list := []string{}
-		return protoreflect.ValueOfList(&_DegreeRequest_11_list{list: &list})
+		list := []*MissingRequirement{}
+		return protoreflect.ValueOfList(&_DegreeRequest_12_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.DegreeRequest"))
@@ -583,8 +588,8 @@ func (x *fastReflection_DegreeRequest) ProtoMethods() *protoiface.Methods {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if len(x.MissingRequirements) > 0 {
-			for _, s := range x.MissingRequirements {
-				l = len(s)
+			for _, e := range x.MissingRequirements {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -619,11 +624,18 @@ func (x *fastReflection_DegreeRequest) ProtoMethods() *protoiface.Methods {
 		}
 		if len(x.MissingRequirements) > 0 {
 			for iNdEx := len(x.MissingRequirements) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.MissingRequirements[iNdEx])
-				copy(dAtA[i:], x.MissingRequirements[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MissingRequirements[iNdEx])))
+				encoded, err := options.Marshal(x.MissingRequirements[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x5a
+				dAtA[i] = 0x62
 			}
 		}
 		if len(x.ValidationDetails) > 0 {
@@ -1065,11 +1077,11 @@ func (x *fastReflection_DegreeRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.ValidationDetails = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 11:
+			case 12:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissingRequirements", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1079,23 +1091,25 @@ func (x *fastReflection_DegreeRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MissingRequirements = append(x.MissingRequirements, string(dAtA[iNdEx:postIndex]))
+				x.MissingRequirements = append(x.MissingRequirements, &MissingRequirement{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MissingRequirements[len(x.MissingRequirements)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1132,142 +1146,950 @@ func (x *fastReflection_DegreeRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: academictoken/degree/degree_request.proto
+var _ protoreflect.List = (*_MissingRequirement_5_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+type _MissingRequirement_5_list struct {
+	list *[]string
+}
+
+func (x *_MissingRequirement_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MissingRequirement_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MissingRequirement_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MissingRequirement_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MissingRequirement_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MissingRequirement at list field SubjectIds as it is not of Message kind"))
+}
+
+func (x *_MissingRequirement_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MissingRequirement_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MissingRequirement_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MissingRequirement             protoreflect.MessageDescriptor
+	fd_MissingRequirement_requirement protoreflect.FieldDescriptor
+	fd_MissingRequirement_description protoreflect.FieldDescriptor
+	fd_MissingRequirement_required    protoreflect.FieldDescriptor
+	fd_MissingRequirement_completed   protoreflect.FieldDescriptor
+	fd_MissingRequirement_subject_ids protoreflect.FieldDescriptor
 )
 
-type DegreeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_academictoken_degree_degree_request_proto_init()
+	md_MissingRequirement = File_academictoken_degree_degree_request_proto.Messages().ByName("MissingRequirement")
+	fd_MissingRequirement_requirement = md_MissingRequirement.Fields().ByName("requirement")
+	fd_MissingRequirement_description = md_MissingRequirement.Fields().ByName("description")
+	fd_MissingRequirement_required = md_MissingRequirement.Fields().ByName("required")
+	fd_MissingRequirement_completed = md_MissingRequirement.Fields().ByName("completed")
+	fd_MissingRequirement_subject_ids = md_MissingRequirement.Fields().ByName("subject_ids")
+}
 
-	Id                     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator                string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	StudentId              string   `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	InstitutionId          string   `protobuf:"bytes,4,opt,name=institution_id,json=institutionId,proto3" json:"institution_id,omitempty"`
-	CurriculumId           string   `protobuf:"bytes,5,opt,name=curriculum_id,json=curriculumId,proto3" json:"curriculum_id,omitempty"`
-	ExpectedGraduationDate string   `protobuf:"bytes,6,opt,name=expected_graduation_date,json=expectedGraduationDate,proto3" json:"expected_graduation_date,omitempty"`
-	RequestDate            string   `protobuf:"bytes,7,opt,name=request_date,json=requestDate,proto3" json:"request_date,omitempty"`
-	Status                 string   `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	ValidationScore        string   `protobuf:"bytes,9,opt,name=validation_score,json=validationScore,proto3" json:"validation_score,omitempty"`
-	ValidationDetails      string   `protobuf:"bytes,10,opt,name=validation_details,json=validationDetails,proto3" json:"validation_details,omitempty"`
-	MissingRequirements    []string `protobuf:"bytes,11,rep,name=missing_requirements,json=missingRequirements,proto3" json:"missing_requirements,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MissingRequirement)(nil)
+
+type fastReflection_MissingRequirement MissingRequirement
+
+func (x *MissingRequirement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MissingRequirement)(x)
 }
 
-func (x *DegreeRequest) Reset() {
-	*x = DegreeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_degree_degree_request_proto_msgTypes[0]
+func (x *MissingRequirement) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_degree_degree_request_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *DegreeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MissingRequirement_messageType fastReflection_MissingRequirement_messageType
+var _ protoreflect.MessageType = fastReflection_MissingRequirement_messageType{}
 
-func (*DegreeRequest) ProtoMessage() {}
+type fastReflection_MissingRequirement_messageType struct{}
 
-// Deprecated: Use DegreeRequest.ProtoReflect.Descriptor instead.
-func (*DegreeRequest) Descriptor() ([]byte, []int) {
-	return file_academictoken_degree_degree_request_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MissingRequirement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MissingRequirement)(nil)
+}
+func (x fastReflection_MissingRequirement_messageType) New() protoreflect.Message {
+	return new(fastReflection_MissingRequirement)
+}
+func (x fastReflection_MissingRequirement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MissingRequirement
 }
 
-func (x *DegreeRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MissingRequirement) Descriptor() protoreflect.MessageDescriptor {
+	return md_MissingRequirement
 }
 
-func (x *DegreeRequest) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MissingRequirement) Type() protoreflect.MessageType {
+	return _fastReflection_MissingRequirement_messageType
 }
 
-func (x *DegreeRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MissingRequirement) New() protoreflect.Message {
+	return new(fastReflection_MissingRequirement)
 }
 
-func (x *DegreeRequest) GetInstitutionId() string {
-	if x != nil {
-		return x.InstitutionId
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MissingRequirement) Interface() protoreflect.ProtoMessage {
+	return (*MissingRequirement)(x)
 }
 
-func (x *DegreeRequest) GetCurriculumId() string {
-	if x != nil {
-		return x.CurriculumId
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MissingRequirement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Requirement != "" {
+		value := protoreflect.ValueOfString(x.Requirement)
+		if !f(fd_MissingRequirement_requirement, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_MissingRequirement_description, value) {
+			return
+		}
+	}
+	if x.Required != "" {
+		value := protoreflect.ValueOfString(x.Required)
+		if !f(fd_MissingRequirement_required, value) {
+			return
+		}
+	}
+	if x.Completed != "" {
+		value := protoreflect.ValueOfString(x.Completed)
+		if !f(fd_MissingRequirement_completed, value) {
+			return
+		}
+	}
+	if len(x.SubjectIds) != 0 {
+		value := protoreflect.ValueOfList(&_MissingRequirement_5_list{list: &x.SubjectIds})
+		if !f(fd_MissingRequirement_subject_ids, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *DegreeRequest) GetExpectedGraduationDate() string {
-	if x != nil {
-		return x.ExpectedGraduationDate
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MissingRequirement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.degree.MissingRequirement.requirement":
+		return x.Requirement != ""
+	case "academictoken.degree.MissingRequirement.description":
+		return x.Description != ""
+	case "academictoken.degree.MissingRequirement.required":
+		return x.Required != ""
+	case "academictoken.degree.MissingRequirement.completed":
+		return x.Completed != ""
+	case "academictoken.degree.MissingRequirement.subject_ids":
+		return len(x.SubjectIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.MissingRequirement"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.MissingRequirement does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *DegreeRequest) GetRequestDate() string {
-	if x != nil {
-		return x.RequestDate
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MissingRequirement) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.degree.MissingRequirement.requirement":
+		x.Requirement = ""
+	case "academictoken.degree.MissingRequirement.description":
+		x.Description = ""
+	case "academictoken.degree.MissingRequirement.required":
+		x.Required = ""
+	case "academictoken.degree.MissingRequirement.completed":
+		x.Completed = ""
+	case "academictoken.degree.MissingRequirement.subject_ids":
+		x.SubjectIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.MissingRequirement"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.MissingRequirement does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *DegreeRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MissingRequirement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.degree.MissingRequirement.requirement":
+		value := x.Requirement
+		return protoreflect.ValueOfString(value)
+	case "academictoken.degree.MissingRequirement.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "academictoken.degree.MissingRequirement.required":
+		value := x.Required
+		return protoreflect.ValueOfString(value)
+	case "academictoken.degree.MissingRequirement.completed":
+		value := x.Completed
+		return protoreflect.ValueOfString(value)
+	case "academictoken.degree.MissingRequirement.subject_ids":
+		if len(x.SubjectIds) == 0 {
+			return protoreflect.ValueOfList(&_MissingRequirement_5_list{})
+		}
+		listValue := &_MissingRequirement_5_list{list: &x.SubjectIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.MissingRequirement"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.MissingRequirement does not contain field %s", descriptor.FullName()))
 	}
-	return ""
 }
 
-func (x *DegreeRequest) GetValidationScore() string {
-	if x != nil {
-		return x.ValidationScore
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MissingRequirement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.degree.MissingRequirement.requirement":
+		x.Requirement = value.Interface().(string)
+	case "academictoken.degree.MissingRequirement.description":
+		x.Description = value.Interface().(string)
+	case "academictoken.degree.MissingRequirement.required":
+		x.Required = value.Interface().(string)
+	case "academictoken.degree.MissingRequirement.completed":
+		x.Completed = value.Interface().(string)
+	case "academictoken.degree.MissingRequirement.subject_ids":
+		lv := value.List()
+		clv := lv.(*_MissingRequirement_5_list)
+		x.SubjectIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.MissingRequirement"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.MissingRequirement does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *DegreeRequest) GetValidationDetails() string {
-	if x != nil {
-		return x.ValidationDetails
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MissingRequirement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.MissingRequirement.subject_ids":
+		if x.SubjectIds == nil {
+			x.SubjectIds = []string{}
+		}
+		value := &_MissingRequirement_5_list{list: &x.SubjectIds}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.degree.MissingRequirement.requirement":
+		panic(fmt.Errorf("field requirement of message academictoken.degree.MissingRequirement is not mutable"))
+	case "academictoken.degree.MissingRequirement.description":
+		panic(fmt.Errorf("field description of message academictoken.degree.MissingRequirement is not mutable"))
+	case "academictoken.degree.MissingRequirement.required":
+		panic(fmt.Errorf("field required of message academictoken.degree.MissingRequirement is not mutable"))
+	case "academictoken.degree.MissingRequirement.completed":
+		panic(fmt.Errorf("field completed of message academictoken.degree.MissingRequirement is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.MissingRequirement"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.MissingRequirement does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *DegreeRequest) GetMissingRequirements() []string {
-	if x != nil {
-		return x.MissingRequirements
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MissingRequirement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.MissingRequirement.requirement":
+		return protoreflect.ValueOfString("")
+	case "academictoken.degree.MissingRequirement.description":
+		return protoreflect.ValueOfString("")
+	case "academictoken.degree.MissingRequirement.required":
+		return protoreflect.ValueOfString("")
+	case "academictoken.degree.MissingRequirement.completed":
+		return protoreflect.ValueOfString("")
+	case "academictoken.degree.MissingRequirement.subject_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MissingRequirement_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.MissingRequirement"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.MissingRequirement does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-var File_academictoken_degree_degree_request_proto protoreflect.FileDescriptor
-
-var file_academictoken_degree_degree_request_proto_rawDesc = []byte{
-	0x0a, 0x29, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MissingRequirement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.degree.MissingRequirement", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MissingRequirement) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MissingRequirement) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MissingRequirement) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MissingRequirement) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MissingRequirement)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Requirement)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Required)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Completed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SubjectIds) > 0 {
+			for _, s := range x.SubjectIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MissingRequirement)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SubjectIds) > 0 {
+			for iNdEx := len(x.SubjectIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SubjectIds[iNdEx])
+				copy(dAtA[i:], x.SubjectIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubjectIds[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Completed) > 0 {
+			i -= len(x.Completed)
+			copy(dAtA[i:], x.Completed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Completed)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Required) > 0 {
+			i -= len(x.Required)
+			copy(dAtA[i:], x.Required)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Required)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Requirement) > 0 {
+			i -= len(x.Requirement)
+			copy(dAtA[i:], x.Requirement)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requirement)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MissingRequirement)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MissingRequirement: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MissingRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requirement", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Requirement = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Required = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Completed = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubjectIds = append(x.SubjectIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/degree/degree_request.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DegreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator                string                `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	StudentId              string                `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	InstitutionId          string                `protobuf:"bytes,4,opt,name=institution_id,json=institutionId,proto3" json:"institution_id,omitempty"`
+	CurriculumId           string                `protobuf:"bytes,5,opt,name=curriculum_id,json=curriculumId,proto3" json:"curriculum_id,omitempty"`
+	ExpectedGraduationDate string                `protobuf:"bytes,6,opt,name=expected_graduation_date,json=expectedGraduationDate,proto3" json:"expected_graduation_date,omitempty"`
+	RequestDate            string                `protobuf:"bytes,7,opt,name=request_date,json=requestDate,proto3" json:"request_date,omitempty"`
+	Status                 string                `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ValidationScore        string                `protobuf:"bytes,9,opt,name=validation_score,json=validationScore,proto3" json:"validation_score,omitempty"`
+	ValidationDetails      string                `protobuf:"bytes,10,opt,name=validation_details,json=validationDetails,proto3" json:"validation_details,omitempty"`
+	MissingRequirements    []*MissingRequirement `protobuf:"bytes,12,rep,name=missing_requirements,json=missingRequirements,proto3" json:"missing_requirements,omitempty"`
+}
+
+func (x *DegreeRequest) Reset() {
+	*x = DegreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_degree_degree_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DegreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DegreeRequest) ProtoMessage() {}
+
+// Deprecated: Use DegreeRequest.ProtoReflect.Descriptor instead.
+func (*DegreeRequest) Descriptor() ([]byte, []int) {
+	return file_academictoken_degree_degree_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DegreeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetInstitutionId() string {
+	if x != nil {
+		return x.InstitutionId
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetCurriculumId() string {
+	if x != nil {
+		return x.CurriculumId
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetExpectedGraduationDate() string {
+	if x != nil {
+		return x.ExpectedGraduationDate
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetRequestDate() string {
+	if x != nil {
+		return x.RequestDate
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetValidationScore() string {
+	if x != nil {
+		return x.ValidationScore
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetValidationDetails() string {
+	if x != nil {
+		return x.ValidationDetails
+	}
+	return ""
+}
+
+func (x *DegreeRequest) GetMissingRequirements() []*MissingRequirement {
+	if x != nil {
+		return x.MissingRequirements
+	}
+	return nil
+}
+
+// MissingRequirement is a graduation requirement a degree request does not meet
+type MissingRequirement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// requirement names the requirement, e.g. required_subjects or min_gpa
+	Requirement string `protobuf:"bytes,1,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// required and completed are the amounts the requirement compares, in its
+	// own unit: subjects, credits, grade points or years
+	Required  string `protobuf:"bytes,3,opt,name=required,proto3" json:"required,omitempty"`
+	Completed string `protobuf:"bytes,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	// subject_ids lists the subjects or activities still to be completed
+	SubjectIds []string `protobuf:"bytes,5,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
+}
+
+func (x *MissingRequirement) Reset() {
+	*x = MissingRequirement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_degree_degree_request_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissingRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingRequirement) ProtoMessage() {}
+
+// Deprecated: Use MissingRequirement.ProtoReflect.Descriptor instead.
+func (*MissingRequirement) Descriptor() ([]byte, []int) {
+	return file_academictoken_degree_degree_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MissingRequirement) GetRequirement() string {
+	if x != nil {
+		return x.Requirement
+	}
+	return ""
+}
+
+func (x *MissingRequirement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MissingRequirement) GetRequired() string {
+	if x != nil {
+		return x.Required
+	}
+	return ""
+}
+
+func (x *MissingRequirement) GetCompleted() string {
+	if x != nil {
+		return x.Completed
+	}
+	return ""
+}
+
+func (x *MissingRequirement) GetSubjectIds() []string {
+	if x != nil {
+		return x.SubjectIds
+	}
+	return nil
+}
+
+var File_academictoken_degree_degree_request_proto protoreflect.FileDescriptor
+
+var file_academictoken_degree_degree_request_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
 	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x61, 0x63, 0x61,
 	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65,
-	0x65, 0x22, 0xa6, 0x03, 0x0a, 0x0d, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x22, 0xd6, 0x03, 0x0a, 0x0d, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
@@ -1290,23 +2112,38 @@ var file_academictoken_degree_degree_request_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69,
 	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0a,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x5b, 0x0a, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x69,
 	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0xc7, 0x01, 0x0a, 0x18, 0x63,
-	0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x42, 0x12, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x61,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x64,
-	0x65, 0x67, 0x72, 0x65, 0x65, 0xa2, 0x02, 0x03, 0x41, 0x44, 0x58, 0xaa, 0x02, 0x14, 0x41, 0x63,
-	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x44, 0x65, 0x67, 0x72,
-	0x65, 0x65, 0xca, 0x02, 0x14, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5c, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0xe2, 0x02, 0x20, 0x41, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x41,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x44, 0x65,
-	0x67, 0x72, 0x65, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2e, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x22, 0xb3, 0x01, 0x0a, 0x12, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73,
+	0x42, 0xc7, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x42, 0x12, 0x44,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x26, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0xa2, 0x02, 0x03, 0x41, 0x44,
+	0x58, 0xaa, 0x02, 0x14, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0xca, 0x02, 0x14, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0xe2,
+	0x02, 0x20, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c,
+	0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x3a, 0x3a, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1321,16 +2158,18 @@ func file_academictoken_degree_degree_request_proto_rawDescGZIP() []byte {
 	return file_academictoken_degree_degree_request_proto_rawDescData
 }
 
-var file_academictoken_degree_degree_request_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_academictoken_degree_degree_request_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_academictoken_degree_degree_request_proto_goTypes = []interface{}{
-	(*DegreeRequest)(nil), // 0: academictoken.degree.DegreeRequest
+	(*DegreeRequest)(nil),      // 0: academictoken.degree.DegreeRequest
+	(*MissingRequirement)(nil), // 1: academictoken.degree.MissingRequirement
 }
 var file_academictoken_degree_degree_request_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: academictoken.degree.DegreeRequest.missing_requirements:type_name -> academictoken.degree.MissingRequirement
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_academictoken_degree_degree_request_proto_init() }
@@ -1351,6 +2190,18 @@ func file_academictoken_degree_degree_request_proto_init() {
 				return nil
 			}
 		}
+		file_academictoken_degree_degree_request_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingRequirement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1358,7 +2209,7 @@ func file_academictoken_degree_degree_request_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_academictoken_degree_degree_request_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -6917,6 +6917,2539 @@ func (x *fastReflection_QueryDegreeValidationStatusResponse) ProtoMethods() *pro
 	}
 }
 
+var (
+	md_QueryDegreeCredentialRequest       protoreflect.MessageDescriptor
+	fd_QueryDegreeCredentialRequest_index protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_degree_query_proto_init()
+	md_QueryDegreeCredentialRequest = File_academictoken_degree_query_proto.Messages().ByName("QueryDegreeCredentialRequest")
+	fd_QueryDegreeCredentialRequest_index = md_QueryDegreeCredentialRequest.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDegreeCredentialRequest)(nil)
+
+type fastReflection_QueryDegreeCredentialRequest QueryDegreeCredentialRequest
+
+func (x *QueryDegreeCredentialRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDegreeCredentialRequest)(x)
+}
+
+func (x *QueryDegreeCredentialRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_degree_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDegreeCredentialRequest_messageType fastReflection_QueryDegreeCredentialRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDegreeCredentialRequest_messageType{}
+
+type fastReflection_QueryDegreeCredentialRequest_messageType struct{}
+
+func (x fastReflection_QueryDegreeCredentialRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDegreeCredentialRequest)(nil)
+}
+func (x fastReflection_QueryDegreeCredentialRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDegreeCredentialRequest)
+}
+func (x fastReflection_QueryDegreeCredentialRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDegreeCredentialRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDegreeCredentialRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDegreeCredentialRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDegreeCredentialRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDegreeCredentialRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDegreeCredentialRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDegreeCredentialRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDegreeCredentialRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDegreeCredentialRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDegreeCredentialRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_QueryDegreeCredentialRequest_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDegreeCredentialRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialRequest.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDegreeCredentialRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialRequest.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDegreeCredentialRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialRequest.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDegreeCredentialRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialRequest.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDegreeCredentialRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialRequest.index":
+		panic(fmt.Errorf("field index of message academictoken.degree.QueryDegreeCredentialRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDegreeCredentialRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialRequest.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDegreeCredentialRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.degree.QueryDegreeCredentialRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDegreeCredentialRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDegreeCredentialRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDegreeCredentialRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDegreeCredentialRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDegreeCredentialRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDegreeCredentialRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDegreeCredentialRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDegreeCredentialRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDegreeCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDegreeCredentialResponse            protoreflect.MessageDescriptor
+	fd_QueryDegreeCredentialResponse_credential protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_degree_query_proto_init()
+	md_QueryDegreeCredentialResponse = File_academictoken_degree_query_proto.Messages().ByName("QueryDegreeCredentialResponse")
+	fd_QueryDegreeCredentialResponse_credential = md_QueryDegreeCredentialResponse.Fields().ByName("credential")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDegreeCredentialResponse)(nil)
+
+type fastReflection_QueryDegreeCredentialResponse QueryDegreeCredentialResponse
+
+func (x *QueryDegreeCredentialResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDegreeCredentialResponse)(x)
+}
+
+func (x *QueryDegreeCredentialResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_degree_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDegreeCredentialResponse_messageType fastReflection_QueryDegreeCredentialResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDegreeCredentialResponse_messageType{}
+
+type fastReflection_QueryDegreeCredentialResponse_messageType struct{}
+
+func (x fastReflection_QueryDegreeCredentialResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDegreeCredentialResponse)(nil)
+}
+func (x fastReflection_QueryDegreeCredentialResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDegreeCredentialResponse)
+}
+func (x fastReflection_QueryDegreeCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDegreeCredentialResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDegreeCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDegreeCredentialResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDegreeCredentialResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDegreeCredentialResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDegreeCredentialResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDegreeCredentialResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDegreeCredentialResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDegreeCredentialResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDegreeCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Credential != "" {
+		value := protoreflect.ValueOfString(x.Credential)
+		if !f(fd_QueryDegreeCredentialResponse_credential, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDegreeCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialResponse.credential":
+		return x.Credential != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDegreeCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialResponse.credential":
+		x.Credential = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDegreeCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialResponse.credential":
+		value := x.Credential
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDegreeCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialResponse.credential":
+		x.Credential = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDegreeCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialResponse.credential":
+		panic(fmt.Errorf("field credential of message academictoken.degree.QueryDegreeCredentialResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDegreeCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryDegreeCredentialResponse.credential":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryDegreeCredentialResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryDegreeCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDegreeCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.degree.QueryDegreeCredentialResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDegreeCredentialResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDegreeCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDegreeCredentialResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDegreeCredentialResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDegreeCredentialResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Credential)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDegreeCredentialResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Credential) > 0 {
+			i -= len(x.Credential)
+			copy(dAtA[i:], x.Credential)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Credential)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDegreeCredentialResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDegreeCredentialResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDegreeCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Credential = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVerifyDegreeRequest       protoreflect.MessageDescriptor
+	fd_QueryVerifyDegreeRequest_index protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_degree_query_proto_init()
+	md_QueryVerifyDegreeRequest = File_academictoken_degree_query_proto.Messages().ByName("QueryVerifyDegreeRequest")
+	fd_QueryVerifyDegreeRequest_index = md_QueryVerifyDegreeRequest.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVerifyDegreeRequest)(nil)
+
+type fastReflection_QueryVerifyDegreeRequest QueryVerifyDegreeRequest
+
+func (x *QueryVerifyDegreeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVerifyDegreeRequest)(x)
+}
+
+func (x *QueryVerifyDegreeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_degree_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVerifyDegreeRequest_messageType fastReflection_QueryVerifyDegreeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVerifyDegreeRequest_messageType{}
+
+type fastReflection_QueryVerifyDegreeRequest_messageType struct{}
+
+func (x fastReflection_QueryVerifyDegreeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVerifyDegreeRequest)(nil)
+}
+func (x fastReflection_QueryVerifyDegreeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVerifyDegreeRequest)
+}
+func (x fastReflection_QueryVerifyDegreeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVerifyDegreeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVerifyDegreeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVerifyDegreeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVerifyDegreeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVerifyDegreeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVerifyDegreeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVerifyDegreeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVerifyDegreeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVerifyDegreeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVerifyDegreeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_QueryVerifyDegreeRequest_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVerifyDegreeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeRequest.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyDegreeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeRequest.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVerifyDegreeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeRequest.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyDegreeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeRequest.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyDegreeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeRequest.index":
+		panic(fmt.Errorf("field index of message academictoken.degree.QueryVerifyDegreeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVerifyDegreeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeRequest.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeRequest"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVerifyDegreeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.degree.QueryVerifyDegreeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVerifyDegreeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyDegreeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVerifyDegreeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVerifyDegreeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVerifyDegreeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVerifyDegreeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVerifyDegreeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVerifyDegreeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVerifyDegreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryVerifyDegreeResponse_3_list)(nil)
+
+type _QueryVerifyDegreeResponse_3_list struct {
+	list *[]*DegreeSignatureStatus
+}
+
+func (x *_QueryVerifyDegreeResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVerifyDegreeResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVerifyDegreeResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DegreeSignatureStatus)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVerifyDegreeResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DegreeSignatureStatus)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVerifyDegreeResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(DegreeSignatureStatus)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVerifyDegreeResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVerifyDegreeResponse_3_list) NewElement() protoreflect.Value {
+	v := new(DegreeSignatureStatus)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVerifyDegreeResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryVerifyDegreeResponse                 protoreflect.MessageDescriptor
+	fd_QueryVerifyDegreeResponse_exists          protoreflect.FieldDescriptor
+	fd_QueryVerifyDegreeResponse_degree          protoreflect.FieldDescriptor
+	fd_QueryVerifyDegreeResponse_signatures      protoreflect.FieldDescriptor
+	fd_QueryVerifyDegreeResponse_signaturesValid protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_degree_query_proto_init()
+	md_QueryVerifyDegreeResponse = File_academictoken_degree_query_proto.Messages().ByName("QueryVerifyDegreeResponse")
+	fd_QueryVerifyDegreeResponse_exists = md_QueryVerifyDegreeResponse.Fields().ByName("exists")
+	fd_QueryVerifyDegreeResponse_degree = md_QueryVerifyDegreeResponse.Fields().ByName("degree")
+	fd_QueryVerifyDegreeResponse_signatures = md_QueryVerifyDegreeResponse.Fields().ByName("signatures")
+	fd_QueryVerifyDegreeResponse_signaturesValid = md_QueryVerifyDegreeResponse.Fields().ByName("signaturesValid")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVerifyDegreeResponse)(nil)
+
+type fastReflection_QueryVerifyDegreeResponse QueryVerifyDegreeResponse
+
+func (x *QueryVerifyDegreeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVerifyDegreeResponse)(x)
+}
+
+func (x *QueryVerifyDegreeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_degree_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVerifyDegreeResponse_messageType fastReflection_QueryVerifyDegreeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVerifyDegreeResponse_messageType{}
+
+type fastReflection_QueryVerifyDegreeResponse_messageType struct{}
+
+func (x fastReflection_QueryVerifyDegreeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVerifyDegreeResponse)(nil)
+}
+func (x fastReflection_QueryVerifyDegreeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVerifyDegreeResponse)
+}
+func (x fastReflection_QueryVerifyDegreeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVerifyDegreeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVerifyDegreeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVerifyDegreeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVerifyDegreeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVerifyDegreeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVerifyDegreeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVerifyDegreeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVerifyDegreeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVerifyDegreeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVerifyDegreeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Exists != false {
+		value := protoreflect.ValueOfBool(x.Exists)
+		if !f(fd_QueryVerifyDegreeResponse_exists, value) {
+			return
+		}
+	}
+	if x.Degree != nil {
+		value := protoreflect.ValueOfMessage(x.Degree.ProtoReflect())
+		if !f(fd_QueryVerifyDegreeResponse_degree, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVerifyDegreeResponse_3_list{list: &x.Signatures})
+		if !f(fd_QueryVerifyDegreeResponse_signatures, value) {
+			return
+		}
+	}
+	if x.SignaturesValid != false {
+		value := protoreflect.ValueOfBool(x.SignaturesValid)
+		if !f(fd_QueryVerifyDegreeResponse_signaturesValid, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVerifyDegreeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeResponse.exists":
+		return x.Exists != false
+	case "academictoken.degree.QueryVerifyDegreeResponse.degree":
+		return x.Degree != nil
+	case "academictoken.degree.QueryVerifyDegreeResponse.signatures":
+		return len(x.Signatures) != 0
+	case "academictoken.degree.QueryVerifyDegreeResponse.signaturesValid":
+		return x.SignaturesValid != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyDegreeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeResponse.exists":
+		x.Exists = false
+	case "academictoken.degree.QueryVerifyDegreeResponse.degree":
+		x.Degree = nil
+	case "academictoken.degree.QueryVerifyDegreeResponse.signatures":
+		x.Signatures = nil
+	case "academictoken.degree.QueryVerifyDegreeResponse.signaturesValid":
+		x.SignaturesValid = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVerifyDegreeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeResponse.exists":
+		value := x.Exists
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.degree.QueryVerifyDegreeResponse.degree":
+		value := x.Degree
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "academictoken.degree.QueryVerifyDegreeResponse.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_QueryVerifyDegreeResponse_3_list{})
+		}
+		listValue := &_QueryVerifyDegreeResponse_3_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.degree.QueryVerifyDegreeResponse.signaturesValid":
+		value := x.SignaturesValid
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyDegreeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeResponse.exists":
+		x.Exists = value.Bool()
+	case "academictoken.degree.QueryVerifyDegreeResponse.degree":
+		x.Degree = value.Message().Interface().(*Degree)
+	case "academictoken.degree.QueryVerifyDegreeResponse.signatures":
+		lv := value.List()
+		clv := lv.(*_QueryVerifyDegreeResponse_3_list)
+		x.Signatures = *clv.list
+	case "academictoken.degree.QueryVerifyDegreeResponse.signaturesValid":
+		x.SignaturesValid = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyDegreeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeResponse.degree":
+		if x.Degree == nil {
+			x.Degree = new(Degree)
+		}
+		return protoreflect.ValueOfMessage(x.Degree.ProtoReflect())
+	case "academictoken.degree.QueryVerifyDegreeResponse.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*DegreeSignatureStatus{}
+		}
+		value := &_QueryVerifyDegreeResponse_3_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.degree.QueryVerifyDegreeResponse.exists":
+		panic(fmt.Errorf("field exists of message academictoken.degree.QueryVerifyDegreeResponse is not mutable"))
+	case "academictoken.degree.QueryVerifyDegreeResponse.signaturesValid":
+		panic(fmt.Errorf("field signaturesValid of message academictoken.degree.QueryVerifyDegreeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVerifyDegreeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.QueryVerifyDegreeResponse.exists":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.degree.QueryVerifyDegreeResponse.degree":
+		m := new(Degree)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "academictoken.degree.QueryVerifyDegreeResponse.signatures":
+		list := []*DegreeSignatureStatus{}
+		return protoreflect.ValueOfList(&_QueryVerifyDegreeResponse_3_list{list: &list})
+	case "academictoken.degree.QueryVerifyDegreeResponse.signaturesValid":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.QueryVerifyDegreeResponse"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.QueryVerifyDegreeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVerifyDegreeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.degree.QueryVerifyDegreeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVerifyDegreeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyDegreeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVerifyDegreeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVerifyDegreeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVerifyDegreeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Exists {
+			n += 2
+		}
+		if x.Degree != nil {
+			l = options.Size(x.Degree)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SignaturesValid {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVerifyDegreeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SignaturesValid {
+			i--
+			if x.SignaturesValid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Degree != nil {
+			encoded, err := options.Marshal(x.Degree)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Exists {
+			i--
+			if x.Exists {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVerifyDegreeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVerifyDegreeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVerifyDegreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Exists = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Degree", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Degree == nil {
+					x.Degree = &Degree{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Degree); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &DegreeSignatureStatus{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignaturesValid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SignaturesValid = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DegreeSignatureStatus                    protoreflect.MessageDescriptor
+	fd_DegreeSignatureStatus_signingKeyId       protoreflect.FieldDescriptor
+	fd_DegreeSignatureStatus_signer             protoreflect.FieldDescriptor
+	fd_DegreeSignatureStatus_signatureValid     protoreflect.FieldDescriptor
+	fd_DegreeSignatureStatus_keyValidAtIssuance protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_degree_query_proto_init()
+	md_DegreeSignatureStatus = File_academictoken_degree_query_proto.Messages().ByName("DegreeSignatureStatus")
+	fd_DegreeSignatureStatus_signingKeyId = md_DegreeSignatureStatus.Fields().ByName("signingKeyId")
+	fd_DegreeSignatureStatus_signer = md_DegreeSignatureStatus.Fields().ByName("signer")
+	fd_DegreeSignatureStatus_signatureValid = md_DegreeSignatureStatus.Fields().ByName("signatureValid")
+	fd_DegreeSignatureStatus_keyValidAtIssuance = md_DegreeSignatureStatus.Fields().ByName("keyValidAtIssuance")
+}
+
+var _ protoreflect.Message = (*fastReflection_DegreeSignatureStatus)(nil)
+
+type fastReflection_DegreeSignatureStatus DegreeSignatureStatus
+
+func (x *DegreeSignatureStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DegreeSignatureStatus)(x)
+}
+
+func (x *DegreeSignatureStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_degree_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DegreeSignatureStatus_messageType fastReflection_DegreeSignatureStatus_messageType
+var _ protoreflect.MessageType = fastReflection_DegreeSignatureStatus_messageType{}
+
+type fastReflection_DegreeSignatureStatus_messageType struct{}
+
+func (x fastReflection_DegreeSignatureStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DegreeSignatureStatus)(nil)
+}
+func (x fastReflection_DegreeSignatureStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_DegreeSignatureStatus)
+}
+func (x fastReflection_DegreeSignatureStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DegreeSignatureStatus
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DegreeSignatureStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_DegreeSignatureStatus
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DegreeSignatureStatus) Type() protoreflect.MessageType {
+	return _fastReflection_DegreeSignatureStatus_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DegreeSignatureStatus) New() protoreflect.Message {
+	return new(fastReflection_DegreeSignatureStatus)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DegreeSignatureStatus) Interface() protoreflect.ProtoMessage {
+	return (*DegreeSignatureStatus)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DegreeSignatureStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SigningKeyId != "" {
+		value := protoreflect.ValueOfString(x.SigningKeyId)
+		if !f(fd_DegreeSignatureStatus_signingKeyId, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_DegreeSignatureStatus_signer, value) {
+			return
+		}
+	}
+	if x.SignatureValid != false {
+		value := protoreflect.ValueOfBool(x.SignatureValid)
+		if !f(fd_DegreeSignatureStatus_signatureValid, value) {
+			return
+		}
+	}
+	if x.KeyValidAtIssuance != false {
+		value := protoreflect.ValueOfBool(x.KeyValidAtIssuance)
+		if !f(fd_DegreeSignatureStatus_keyValidAtIssuance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DegreeSignatureStatus) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.degree.DegreeSignatureStatus.signingKeyId":
+		return x.SigningKeyId != ""
+	case "academictoken.degree.DegreeSignatureStatus.signer":
+		return x.Signer != ""
+	case "academictoken.degree.DegreeSignatureStatus.signatureValid":
+		return x.SignatureValid != false
+	case "academictoken.degree.DegreeSignatureStatus.keyValidAtIssuance":
+		return x.KeyValidAtIssuance != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.DegreeSignatureStatus"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.DegreeSignatureStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DegreeSignatureStatus) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.degree.DegreeSignatureStatus.signingKeyId":
+		x.SigningKeyId = ""
+	case "academictoken.degree.DegreeSignatureStatus.signer":
+		x.Signer = ""
+	case "academictoken.degree.DegreeSignatureStatus.signatureValid":
+		x.SignatureValid = false
+	case "academictoken.degree.DegreeSignatureStatus.keyValidAtIssuance":
+		x.KeyValidAtIssuance = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.DegreeSignatureStatus"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.DegreeSignatureStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DegreeSignatureStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.degree.DegreeSignatureStatus.signingKeyId":
+		value := x.SigningKeyId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.degree.DegreeSignatureStatus.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "academictoken.degree.DegreeSignatureStatus.signatureValid":
+		value := x.SignatureValid
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.degree.DegreeSignatureStatus.keyValidAtIssuance":
+		value := x.KeyValidAtIssuance
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.DegreeSignatureStatus"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.DegreeSignatureStatus does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DegreeSignatureStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.degree.DegreeSignatureStatus.signingKeyId":
+		x.SigningKeyId = value.Interface().(string)
+	case "academictoken.degree.DegreeSignatureStatus.signer":
+		x.Signer = value.Interface().(string)
+	case "academictoken.degree.DegreeSignatureStatus.signatureValid":
+		x.SignatureValid = value.Bool()
+	case "academictoken.degree.DegreeSignatureStatus.keyValidAtIssuance":
+		x.KeyValidAtIssuance = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.DegreeSignatureStatus"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.DegreeSignatureStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DegreeSignatureStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.DegreeSignatureStatus.signingKeyId":
+		panic(fmt.Errorf("field signingKeyId of message academictoken.degree.DegreeSignatureStatus is not mutable"))
+	case "academictoken.degree.DegreeSignatureStatus.signer":
+		panic(fmt.Errorf("field signer of message academictoken.degree.DegreeSignatureStatus is not mutable"))
+	case "academictoken.degree.DegreeSignatureStatus.signatureValid":
+		panic(fmt.Errorf("field signatureValid of message academictoken.degree.DegreeSignatureStatus is not mutable"))
+	case "academictoken.degree.DegreeSignatureStatus.keyValidAtIssuance":
+		panic(fmt.Errorf("field keyValidAtIssuance of message academictoken.degree.DegreeSignatureStatus is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.DegreeSignatureStatus"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.DegreeSignatureStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DegreeSignatureStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.degree.DegreeSignatureStatus.signingKeyId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.degree.DegreeSignatureStatus.signer":
+		return protoreflect.ValueOfString("")
+	case "academictoken.degree.DegreeSignatureStatus.signatureValid":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.degree.DegreeSignatureStatus.keyValidAtIssuance":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.degree.DegreeSignatureStatus"))
+		}
+		panic(fmt.Errorf("message academictoken.degree.DegreeSignatureStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DegreeSignatureStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.degree.DegreeSignatureStatus", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DegreeSignatureStatus) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DegreeSignatureStatus) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DegreeSignatureStatus) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DegreeSignatureStatus) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DegreeSignatureStatus)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SigningKeyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SignatureValid {
+			n += 2
+		}
+		if x.KeyValidAtIssuance {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DegreeSignatureStatus)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.KeyValidAtIssuance {
+			i--
+			if x.KeyValidAtIssuance {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SignatureValid {
+			i--
+			if x.SignatureValid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SigningKeyId) > 0 {
+			i -= len(x.SigningKeyId)
+			copy(dAtA[i:], x.SigningKeyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningKeyId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DegreeSignatureStatus)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DegreeSignatureStatus: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DegreeSignatureStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningKeyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignatureValid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SignatureValid = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyValidAtIssuance", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.KeyValidAtIssuance = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -7494,6 +10027,230 @@ func (x *QueryDegreeValidationStatusResponse) GetValidationDetails() string {
 	return ""
 }
 
+type QueryDegreeCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *QueryDegreeCredentialRequest) Reset() {
+	*x = QueryDegreeCredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_degree_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDegreeCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDegreeCredentialRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDegreeCredentialRequest.ProtoReflect.Descriptor instead.
+func (*QueryDegreeCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_academictoken_degree_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryDegreeCredentialRequest) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type QueryDegreeCredentialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credential string `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"` // JSON-LD verifiable credential
+}
+
+func (x *QueryDegreeCredentialResponse) Reset() {
+	*x = QueryDegreeCredentialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_degree_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDegreeCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDegreeCredentialResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDegreeCredentialResponse.ProtoReflect.Descriptor instead.
+func (*QueryDegreeCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_degree_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryDegreeCredentialResponse) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+type QueryVerifyDegreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *QueryVerifyDegreeRequest) Reset() {
+	*x = QueryVerifyDegreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_degree_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVerifyDegreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVerifyDegreeRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryVerifyDegreeRequest.ProtoReflect.Descriptor instead.
+func (*QueryVerifyDegreeRequest) Descriptor() ([]byte, []int) {
+	return file_academictoken_degree_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryVerifyDegreeRequest) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type QueryVerifyDegreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists          bool                     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Degree          *Degree                  `protobuf:"bytes,2,opt,name=degree,proto3" json:"degree,omitempty"`
+	Signatures      []*DegreeSignatureStatus `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	SignaturesValid bool                     `protobuf:"varint,4,opt,name=signaturesValid,proto3" json:"signaturesValid,omitempty"` // every signature matches the degree with a key valid at issuance
+}
+
+func (x *QueryVerifyDegreeResponse) Reset() {
+	*x = QueryVerifyDegreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_degree_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVerifyDegreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVerifyDegreeResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVerifyDegreeResponse.ProtoReflect.Descriptor instead.
+func (*QueryVerifyDegreeResponse) Descriptor() ([]byte, []int) {
+	return file_academictoken_degree_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryVerifyDegreeResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *QueryVerifyDegreeResponse) GetDegree() *Degree {
+	if x != nil {
+		return x.Degree
+	}
+	return nil
+}
+
+func (x *QueryVerifyDegreeResponse) GetSignatures() []*DegreeSignatureStatus {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *QueryVerifyDegreeResponse) GetSignaturesValid() bool {
+	if x != nil {
+		return x.SignaturesValid
+	}
+	return false
+}
+
+// DegreeSignatureStatus describes one signature of a degree
+type DegreeSignatureStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SigningKeyId       string `protobuf:"bytes,1,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"` // key that produced the signature
+	Signer             string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`             // owner of the signing key
+	SignatureValid     bool   `protobuf:"varint,3,opt,name=signatureValid,proto3" json:"signatureValid,omitempty"`
+	KeyValidAtIssuance bool   `protobuf:"varint,4,opt,name=keyValidAtIssuance,proto3" json:"keyValidAtIssuance,omitempty"` // the key was registered and not revoked when the degree was issued
+}
+
+func (x *DegreeSignatureStatus) Reset() {
+	*x = DegreeSignatureStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_degree_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DegreeSignatureStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DegreeSignatureStatus) ProtoMessage() {}
+
+// Deprecated: Use DegreeSignatureStatus.ProtoReflect.Descriptor instead.
+func (*DegreeSignatureStatus) Descriptor() ([]byte, []int) {
+	return file_academictoken_degree_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DegreeSignatureStatus) GetSigningKeyId() string {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return ""
+}
+
+func (x *DegreeSignatureStatus) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *DegreeSignatureStatus) GetSignatureValid() bool {
+	if x != nil {
+		return x.SignatureValid
+	}
+	return false
+}
+
+func (x *DegreeSignatureStatus) GetKeyValidAtIssuance() bool {
+	if x != nil {
+		return x.KeyValidAtIssuance
+	}
+	return false
+}
+
 var File_academictoken_degree_query_proto protoreflect.FileDescriptor
 
 var file_academictoken_degree_query_proto_rawDesc = []byte{
@@ -7618,8 +10375,44 @@ var file_academictoken_degree_query_proto_rawDesc = []byte{
 	0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12,
 	0x2d, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
 	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x32, 0xab,
-	0x09, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x34,
+	0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x3f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x30, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe6, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x34, 0x0a,
+	0x06, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x06, 0x64, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2e, 0x44,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x22, 0xab, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x2e,
+	0x0a, 0x12, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x74, 0x49, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6b, 0x65, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x41, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x32, 0xfb,
+	0x0b, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x12, 0x28, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
@@ -7693,7 +10486,28 @@ var file_academictoken_degree_query_proto_rawDesc = []byte{
 	0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
 	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2f, 0x76,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2f, 0x7b, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x42, 0xbf, 0x01, 0x0a,
+	0x2f, 0x7b, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xad, 0x01, 0x0a,
+	0x10, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x32, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2a, 0x12, 0x28, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0x9d, 0x01, 0x0a,
+	0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x2e, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x64, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2f, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x42, 0xbf, 0x01, 0x0a,
 	0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x2e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
@@ -7721,7 +10535,7 @@ func file_academictoken_degree_query_proto_rawDescGZIP() []byte {
 	return file_academictoken_degree_query_proto_rawDescData
 }
 
-var file_academictoken_degree_query_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_academictoken_degree_query_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_academictoken_degree_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                  // 0: academictoken.degree.QueryParamsRequest
 	(*QueryParamsResponse)(nil),                 // 1: academictoken.degree.QueryParamsResponse
@@ -7737,46 +10551,57 @@ var file_academictoken_degree_query_proto_goTypes = []interface{}{
 	(*QueryDegreeRequestsResponse)(nil),         // 11: academictoken.degree.QueryDegreeRequestsResponse
 	(*QueryDegreeValidationStatusRequest)(nil),  // 12: academictoken.degree.QueryDegreeValidationStatusRequest
 	(*QueryDegreeValidationStatusResponse)(nil), // 13: academictoken.degree.QueryDegreeValidationStatusResponse
-	(*Params)(nil),                              // 14: academictoken.degree.Params
-	(*Degree)(nil),                              // 15: academictoken.degree.Degree
-	(*v1beta1.PageRequest)(nil),                 // 16: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),                // 17: cosmos.base.query.v1beta1.PageResponse
-	(*DegreeRequest)(nil),                       // 18: academictoken.degree.DegreeRequest
+	(*QueryDegreeCredentialRequest)(nil),        // 14: academictoken.degree.QueryDegreeCredentialRequest
+	(*QueryDegreeCredentialResponse)(nil),       // 15: academictoken.degree.QueryDegreeCredentialResponse
+	(*QueryVerifyDegreeRequest)(nil),            // 16: academictoken.degree.QueryVerifyDegreeRequest
+	(*QueryVerifyDegreeResponse)(nil),           // 17: academictoken.degree.QueryVerifyDegreeResponse
+	(*DegreeSignatureStatus)(nil),               // 18: academictoken.degree.DegreeSignatureStatus
+	(*Params)(nil),                              // 19: academictoken.degree.Params
+	(*Degree)(nil),                              // 20: academictoken.degree.Degree
+	(*v1beta1.PageRequest)(nil),                 // 21: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),                // 22: cosmos.base.query.v1beta1.PageResponse
+	(*DegreeRequest)(nil),                       // 23: academictoken.degree.DegreeRequest
 }
 var file_academictoken_degree_query_proto_depIdxs = []int32{
-	14, // 0: academictoken.degree.QueryParamsResponse.params:type_name -> academictoken.degree.Params
-	15, // 1: academictoken.degree.QueryGetDegreeResponse.degree:type_name -> academictoken.degree.Degree
-	16, // 2: academictoken.degree.QueryAllDegreeRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	15, // 3: academictoken.degree.QueryAllDegreeResponse.degree:type_name -> academictoken.degree.Degree
-	17, // 4: academictoken.degree.QueryAllDegreeResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	16, // 5: academictoken.degree.QueryDegreesByStudentRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	15, // 6: academictoken.degree.QueryDegreesByStudentResponse.degrees:type_name -> academictoken.degree.Degree
-	17, // 7: academictoken.degree.QueryDegreesByStudentResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	16, // 8: academictoken.degree.QueryDegreesByInstitutionRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	15, // 9: academictoken.degree.QueryDegreesByInstitutionResponse.degrees:type_name -> academictoken.degree.Degree
-	17, // 10: academictoken.degree.QueryDegreesByInstitutionResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	16, // 11: academictoken.degree.QueryDegreeRequestsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 12: academictoken.degree.QueryDegreeRequestsResponse.requests:type_name -> academictoken.degree.DegreeRequest
-	17, // 13: academictoken.degree.QueryDegreeRequestsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	0,  // 14: academictoken.degree.Query.Params:input_type -> academictoken.degree.QueryParamsRequest
-	2,  // 15: academictoken.degree.Query.Degree:input_type -> academictoken.degree.QueryGetDegreeRequest
-	4,  // 16: academictoken.degree.Query.DegreeAll:input_type -> academictoken.degree.QueryAllDegreeRequest
-	6,  // 17: academictoken.degree.Query.DegreesByStudent:input_type -> academictoken.degree.QueryDegreesByStudentRequest
-	8,  // 18: academictoken.degree.Query.DegreesByInstitution:input_type -> academictoken.degree.QueryDegreesByInstitutionRequest
-	10, // 19: academictoken.degree.Query.DegreeRequests:input_type -> academictoken.degree.QueryDegreeRequestsRequest
-	12, // 20: academictoken.degree.Query.DegreeValidationStatus:input_type -> academictoken.degree.QueryDegreeValidationStatusRequest
-	1,  // 21: academictoken.degree.Query.Params:output_type -> academictoken.degree.QueryParamsResponse
-	3,  // 22: academictoken.degree.Query.Degree:output_type -> academictoken.degree.QueryGetDegreeResponse
-	5,  // 23: academictoken.degree.Query.DegreeAll:output_type -> academictoken.degree.QueryAllDegreeResponse
-	7,  // 24: academictoken.degree.Query.DegreesByStudent:output_type -> academictoken.degree.QueryDegreesByStudentResponse
-	9,  // 25: academictoken.degree.Query.DegreesByInstitution:output_type -> academictoken.degree.QueryDegreesByInstitutionResponse
-	11, // 26: academictoken.degree.Query.DegreeRequests:output_type -> academictoken.degree.QueryDegreeRequestsResponse
-	13, // 27: academictoken.degree.Query.DegreeValidationStatus:output_type -> academictoken.degree.QueryDegreeValidationStatusResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	19, // 0: academictoken.degree.QueryParamsResponse.params:type_name -> academictoken.degree.Params
+	20, // 1: academictoken.degree.QueryGetDegreeResponse.degree:type_name -> academictoken.degree.Degree
+	21, // 2: academictoken.degree.QueryAllDegreeRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	20, // 3: academictoken.degree.QueryAllDegreeResponse.degree:type_name -> academictoken.degree.Degree
+	22, // 4: academictoken.degree.QueryAllDegreeResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	21, // 5: academictoken.degree.QueryDegreesByStudentRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	20, // 6: academictoken.degree.QueryDegreesByStudentResponse.degrees:type_name -> academictoken.degree.Degree
+	22, // 7: academictoken.degree.QueryDegreesByStudentResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	21, // 8: academictoken.degree.QueryDegreesByInstitutionRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	20, // 9: academictoken.degree.QueryDegreesByInstitutionResponse.degrees:type_name -> academictoken.degree.Degree
+	22, // 10: academictoken.degree.QueryDegreesByInstitutionResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	21, // 11: academictoken.degree.QueryDegreeRequestsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	23, // 12: academictoken.degree.QueryDegreeRequestsResponse.requests:type_name -> academictoken.degree.DegreeRequest
+	22, // 13: academictoken.degree.QueryDegreeRequestsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	20, // 14: academictoken.degree.QueryVerifyDegreeResponse.degree:type_name -> academictoken.degree.Degree
+	18, // 15: academictoken.degree.QueryVerifyDegreeResponse.signatures:type_name -> academictoken.degree.DegreeSignatureStatus
+	0,  // 16: academictoken.degree.Query.Params:input_type -> academictoken.degree.QueryParamsRequest
+	2,  // 17: academictoken.degree.Query.Degree:input_type -> academictoken.degree.QueryGetDegreeRequest
+	4,  // 18: academictoken.degree.Query.DegreeAll:input_type -> academictoken.degree.QueryAllDegreeRequest
+	6,  // 19: academictoken.degree.Query.DegreesByStudent:input_type -> academictoken.degree.QueryDegreesByStudentRequest
+	8,  // 20: academictoken.degree.Query.DegreesByInstitution:input_type -> academictoken.degree.QueryDegreesByInstitutionRequest
+	10, // 21: academictoken.degree.Query.DegreeRequests:input_type -> academictoken.degree.QueryDegreeRequestsRequest
+	12, // 22: academictoken.degree.Query.DegreeValidationStatus:input_type -> academictoken.degree.QueryDegreeValidationStatusRequest
+	14, // 23: academictoken.degree.Query.DegreeCredential:input_type -> academictoken.degree.QueryDegreeCredentialRequest
+	16, // 24: academictoken.degree.Query.VerifyDegree:input_type -> academictoken.degree.QueryVerifyDegreeRequest
+	1,  // 25: academictoken.degree.Query.Params:output_type -> academictoken.degree.QueryParamsResponse
+	3,  // 26: academictoken.degree.Query.Degree:output_type -> academictoken.degree.QueryGetDegreeResponse
+	5,  // 27: academictoken.degree.Query.DegreeAll:output_type -> academictoken.degree.QueryAllDegreeResponse
+	7,  // 28: academictoken.degree.Query.DegreesByStudent:output_type -> academictoken.degree.QueryDegreesByStudentResponse
+	9,  // 29: academictoken.degree.Query.DegreesByInstitution:output_type -> academictoken.degree.QueryDegreesByInstitutionResponse
+	11, // 30: academictoken.degree.Query.DegreeRequests:output_type -> academictoken.degree.QueryDegreeRequestsResponse
+	13, // 31: academictoken.degree.Query.DegreeValidationStatus:output_type -> academictoken.degree.QueryDegreeValidationStatusResponse
+	15, // 32: academictoken.degree.Query.DegreeCredential:output_type -> academictoken.degree.QueryDegreeCredentialResponse
+	17, // 33: academictoken.degree.Query.VerifyDegree:output_type -> academictoken.degree.QueryVerifyDegreeResponse
+	25, // [25:34] is the sub-list for method output_type
+	16, // [16:25] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_academictoken_degree_query_proto_init() }
@@ -7956,6 +10781,66 @@ func file_academictoken_degree_query_proto_init() {
 				return nil
 			}
 		}
+		file_academictoken_degree_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDegreeCredentialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_degree_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDegreeCredentialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_degree_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVerifyDegreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_degree_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVerifyDegreeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_degree_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DegreeSignatureStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7963,7 +10848,7 @@ func file_academictoken_degree_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_academictoken_degree_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
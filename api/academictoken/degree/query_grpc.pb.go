@@ -26,6 +26,8 @@ const (
 	Query_DegreesByInstitution_FullMethodName   = "/academictoken.degree.Query/DegreesByInstitution"
 	Query_DegreeRequests_FullMethodName         = "/academictoken.degree.Query/DegreeRequests"
 	Query_DegreeValidationStatus_FullMethodName = "/academictoken.degree.Query/DegreeValidationStatus"
+	Query_DegreeCredential_FullMethodName       = "/academictoken.degree.Query/DegreeCredential"
+	Query_VerifyDegree_FullMethodName           = "/academictoken.degree.Query/VerifyDegree"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +48,10 @@ type QueryClient interface {
 	DegreeRequests(ctx context.Context, in *QueryDegreeRequestsRequest, opts ...grpc.CallOption) (*QueryDegreeRequestsResponse, error)
 	// Queries degree validation status
 	DegreeValidationStatus(ctx context.Context, in *QueryDegreeValidationStatusRequest, opts ...grpc.CallOption) (*QueryDegreeValidationStatusResponse, error)
+	// Renders a Degree as a W3C Verifiable Credential anchored to the queried height
+	DegreeCredential(ctx context.Context, in *QueryDegreeCredentialRequest, opts ...grpc.CallOption) (*QueryDegreeCredentialResponse, error)
+	// Verifies the signatures of a Degree against the signing keys of its institution
+	VerifyDegree(ctx context.Context, in *QueryVerifyDegreeRequest, opts ...grpc.CallOption) (*QueryVerifyDegreeResponse, error)
 }
 
 type queryClient struct {
@@ -119,6 +125,24 @@ func (c *queryClient) DegreeValidationStatus(ctx context.Context, in *QueryDegre
 	return out, nil
 }
 
+func (c *queryClient) DegreeCredential(ctx context.Context, in *QueryDegreeCredentialRequest, opts ...grpc.CallOption) (*QueryDegreeCredentialResponse, error) {
+	out := new(QueryDegreeCredentialResponse)
+	err := c.cc.Invoke(ctx, Query_DegreeCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyDegree(ctx context.Context, in *QueryVerifyDegreeRequest, opts ...grpc.CallOption) (*QueryVerifyDegreeResponse, error) {
+	out := new(QueryVerifyDegreeResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyDegree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -137,6 +161,10 @@ type QueryServer interface {
 	DegreeRequests(context.Context, *QueryDegreeRequestsRequest) (*QueryDegreeRequestsResponse, error)
 	// Queries degree validation status
 	DegreeValidationStatus(context.Context, *QueryDegreeValidationStatusRequest) (*QueryDegreeValidationStatusResponse, error)
+	// Renders a Degree as a W3C Verifiable Credential anchored to the queried height
+	DegreeCredential(context.Context, *QueryDegreeCredentialRequest) (*QueryDegreeCredentialResponse, error)
+	// Verifies the signatures of a Degree against the signing keys of its institution
+	VerifyDegree(context.Context, *QueryVerifyDegreeRequest) (*QueryVerifyDegreeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -165,6 +193,12 @@ func (UnimplementedQueryServer) DegreeRequests(context.Context, *QueryDegreeRequ
 func (UnimplementedQueryServer) DegreeValidationStatus(context.Context, *QueryDegreeValidationStatusRequest) (*QueryDegreeValidationStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DegreeValidationStatus not implemented")
 }
+func (UnimplementedQueryServer) DegreeCredential(context.Context, *QueryDegreeCredentialRequest) (*QueryDegreeCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DegreeCredential not implemented")
+}
+func (UnimplementedQueryServer) VerifyDegree(context.Context, *QueryVerifyDegreeRequest) (*QueryVerifyDegreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDegree not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +338,42 @@ func _Query_DegreeValidationStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DegreeCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDegreeCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DegreeCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DegreeCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DegreeCredential(ctx, req.(*QueryDegreeCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyDegree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyDegreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyDegree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyDegree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyDegree(ctx, req.(*QueryVerifyDegreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -339,6 +409,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DegreeValidationStatus",
 			Handler:    _Query_DegreeValidationStatus_Handler,
 		},
+		{
+			MethodName: "DegreeCredential",
+			Handler:    _Query_DegreeCredential_Handler,
+		},
+		{
+			MethodName: "VerifyDegree",
+			Handler:    _Query_VerifyDegree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/degree/query.proto",
@@ -15,12 +15,28 @@ import (
 )
 
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_equivalence_contract_address protoreflect.FieldDescriptor
+	fd_Params_ipfs_gateway                 protoreflect.FieldDescriptor
+	fd_Params_ipfs_enabled                 protoreflect.FieldDescriptor
+	fd_Params_min_approval_threshold       protoreflect.FieldDescriptor
+	fd_Params_max_analysis_retries         protoreflect.FieldDescriptor
+	fd_Params_analysis_timeout_seconds     protoreflect.FieldDescriptor
+	fd_Params_require_contract_auth        protoreflect.FieldDescriptor
+	fd_Params_admin                        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_academictoken_equivalence_params_proto_init()
 	md_Params = File_academictoken_equivalence_params_proto.Messages().ByName("Params")
+	fd_Params_equivalence_contract_address = md_Params.Fields().ByName("equivalence_contract_address")
+	fd_Params_ipfs_gateway = md_Params.Fields().ByName("ipfs_gateway")
+	fd_Params_ipfs_enabled = md_Params.Fields().ByName("ipfs_enabled")
+	fd_Params_min_approval_threshold = md_Params.Fields().ByName("min_approval_threshold")
+	fd_Params_max_analysis_retries = md_Params.Fields().ByName("max_analysis_retries")
+	fd_Params_analysis_timeout_seconds = md_Params.Fields().ByName("analysis_timeout_seconds")
+	fd_Params_require_contract_auth = md_Params.Fields().ByName("require_contract_auth")
+	fd_Params_admin = md_Params.Fields().ByName("admin")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +104,54 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EquivalenceContractAddress != "" {
+		value := protoreflect.ValueOfString(x.EquivalenceContractAddress)
+		if !f(fd_Params_equivalence_contract_address, value) {
+			return
+		}
+	}
+	if x.IpfsGateway != "" {
+		value := protoreflect.ValueOfString(x.IpfsGateway)
+		if !f(fd_Params_ipfs_gateway, value) {
+			return
+		}
+	}
+	if x.IpfsEnabled != false {
+		value := protoreflect.ValueOfBool(x.IpfsEnabled)
+		if !f(fd_Params_ipfs_enabled, value) {
+			return
+		}
+	}
+	if x.MinApprovalThreshold != "" {
+		value := protoreflect.ValueOfString(x.MinApprovalThreshold)
+		if !f(fd_Params_min_approval_threshold, value) {
+			return
+		}
+	}
+	if x.MaxAnalysisRetries != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAnalysisRetries)
+		if !f(fd_Params_max_analysis_retries, value) {
+			return
+		}
+	}
+	if x.AnalysisTimeoutSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AnalysisTimeoutSeconds)
+		if !f(fd_Params_analysis_timeout_seconds, value) {
+			return
+		}
+	}
+	if x.RequireContractAuth != false {
+		value := protoreflect.ValueOfBool(x.RequireContractAuth)
+		if !f(fd_Params_require_contract_auth, value) {
+			return
+		}
+	}
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_Params_admin, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +167,22 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "academictoken.equivalence.Params.equivalence_contract_address":
+		return x.EquivalenceContractAddress != ""
+	case "academictoken.equivalence.Params.ipfs_gateway":
+		return x.IpfsGateway != ""
+	case "academictoken.equivalence.Params.ipfs_enabled":
+		return x.IpfsEnabled != false
+	case "academictoken.equivalence.Params.min_approval_threshold":
+		return x.MinApprovalThreshold != ""
+	case "academictoken.equivalence.Params.max_analysis_retries":
+		return x.MaxAnalysisRetries != uint64(0)
+	case "academictoken.equivalence.Params.analysis_timeout_seconds":
+		return x.AnalysisTimeoutSeconds != uint64(0)
+	case "academictoken.equivalence.Params.require_contract_auth":
+		return x.RequireContractAuth != false
+	case "academictoken.equivalence.Params.admin":
+		return x.Admin != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.equivalence.Params"))
@@ -119,6 +199,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "academictoken.equivalence.Params.equivalence_contract_address":
+		x.EquivalenceContractAddress = ""
+	case "academictoken.equivalence.Params.ipfs_gateway":
+		x.IpfsGateway = ""
+	case "academictoken.equivalence.Params.ipfs_enabled":
+		x.IpfsEnabled = false
+	case "academictoken.equivalence.Params.min_approval_threshold":
+		x.MinApprovalThreshold = ""
+	case "academictoken.equivalence.Params.max_analysis_retries":
+		x.MaxAnalysisRetries = uint64(0)
+	case "academictoken.equivalence.Params.analysis_timeout_seconds":
+		x.AnalysisTimeoutSeconds = uint64(0)
+	case "academictoken.equivalence.Params.require_contract_auth":
+		x.RequireContractAuth = false
+	case "academictoken.equivalence.Params.admin":
+		x.Admin = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.equivalence.Params"))
@@ -135,6 +231,30 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "academictoken.equivalence.Params.equivalence_contract_address":
+		value := x.EquivalenceContractAddress
+		return protoreflect.ValueOfString(value)
+	case "academictoken.equivalence.Params.ipfs_gateway":
+		value := x.IpfsGateway
+		return protoreflect.ValueOfString(value)
+	case "academictoken.equivalence.Params.ipfs_enabled":
+		value := x.IpfsEnabled
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.equivalence.Params.min_approval_threshold":
+		value := x.MinApprovalThreshold
+		return protoreflect.ValueOfString(value)
+	case "academictoken.equivalence.Params.max_analysis_retries":
+		value := x.MaxAnalysisRetries
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.equivalence.Params.analysis_timeout_seconds":
+		value := x.AnalysisTimeoutSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.equivalence.Params.require_contract_auth":
+		value := x.RequireContractAuth
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.equivalence.Params.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.equivalence.Params"))
@@ -155,6 +275,22 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "academictoken.equivalence.Params.equivalence_contract_address":
+		x.EquivalenceContractAddress = value.Interface().(string)
+	case "academictoken.equivalence.Params.ipfs_gateway":
+		x.IpfsGateway = value.Interface().(string)
+	case "academictoken.equivalence.Params.ipfs_enabled":
+		x.IpfsEnabled = value.Bool()
+	case "academictoken.equivalence.Params.min_approval_threshold":
+		x.MinApprovalThreshold = value.Interface().(string)
+	case "academictoken.equivalence.Params.max_analysis_retries":
+		x.MaxAnalysisRetries = value.Uint()
+	case "academictoken.equivalence.Params.analysis_timeout_seconds":
+		x.AnalysisTimeoutSeconds = value.Uint()
+	case "academictoken.equivalence.Params.require_contract_auth":
+		x.RequireContractAuth = value.Bool()
+	case "academictoken.equivalence.Params.admin":
+		x.Admin = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.equivalence.Params"))
@@ -175,6 +311,22 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "academictoken.equivalence.Params.equivalence_contract_address":
+		panic(fmt.Errorf("field equivalence_contract_address of message academictoken.equivalence.Params is not mutable"))
+	case "academictoken.equivalence.Params.ipfs_gateway":
+		panic(fmt.Errorf("field ipfs_gateway of message academictoken.equivalence.Params is not mutable"))
+	case "academictoken.equivalence.Params.ipfs_enabled":
+		panic(fmt.Errorf("field ipfs_enabled of message academictoken.equivalence.Params is not mutable"))
+	case "academictoken.equivalence.Params.min_approval_threshold":
+		panic(fmt.Errorf("field min_approval_threshold of message academictoken.equivalence.Params is not mutable"))
+	case "academictoken.equivalence.Params.max_analysis_retries":
+		panic(fmt.Errorf("field max_analysis_retries of message academictoken.equivalence.Params is not mutable"))
+	case "academictoken.equivalence.Params.analysis_timeout_seconds":
+		panic(fmt.Errorf("field analysis_timeout_seconds of message academictoken.equivalence.Params is not mutable"))
+	case "academictoken.equivalence.Params.require_contract_auth":
+		panic(fmt.Errorf("field require_contract_auth of message academictoken.equivalence.Params is not mutable"))
+	case "academictoken.equivalence.Params.admin":
+		panic(fmt.Errorf("field admin of message academictoken.equivalence.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.equivalence.Params"))
@@ -188,6 +340,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "academictoken.equivalence.Params.equivalence_contract_address":
+		return protoreflect.ValueOfString("")
+	case "academictoken.equivalence.Params.ipfs_gateway":
+		return protoreflect.ValueOfString("")
+	case "academictoken.equivalence.Params.ipfs_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.equivalence.Params.min_approval_threshold":
+		return protoreflect.ValueOfString("")
+	case "academictoken.equivalence.Params.max_analysis_retries":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.equivalence.Params.analysis_timeout_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.equivalence.Params.require_contract_auth":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.equivalence.Params.admin":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.equivalence.Params"))
@@ -257,6 +425,34 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.EquivalenceContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IpfsGateway)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IpfsEnabled {
+			n += 2
+		}
+		l = len(x.MinApprovalThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxAnalysisRetries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAnalysisRetries))
+		}
+		if x.AnalysisTimeoutSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.AnalysisTimeoutSeconds))
+		}
+		if x.RequireContractAuth {
+			n += 2
+		}
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +482,64 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.RequireContractAuth {
+			i--
+			if x.RequireContractAuth {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.AnalysisTimeoutSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnalysisTimeoutSeconds))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxAnalysisRetries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAnalysisRetries))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.MinApprovalThreshold) > 0 {
+			i -= len(x.MinApprovalThreshold)
+			copy(dAtA[i:], x.MinApprovalThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinApprovalThreshold)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.IpfsEnabled {
+			i--
+			if x.IpfsEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.IpfsGateway) > 0 {
+			i -= len(x.IpfsGateway)
+			copy(dAtA[i:], x.IpfsGateway)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IpfsGateway)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.EquivalenceContractAddress) > 0 {
+			i -= len(x.EquivalenceContractAddress)
+			copy(dAtA[i:], x.EquivalenceContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EquivalenceContractAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +589,212 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EquivalenceContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EquivalenceContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IpfsGateway", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IpfsGateway = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IpfsEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IpfsEnabled = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinApprovalThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinApprovalThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAnalysisRetries", wireType)
+				}
+				x.MaxAnalysisRetries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAnalysisRetries |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnalysisTimeoutSeconds", wireType)
+				}
+				x.AnalysisTimeoutSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AnalysisTimeoutSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequireContractAuth", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequireContractAuth = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +848,15 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	EquivalenceContractAddress string `protobuf:"bytes,1,opt,name=equivalence_contract_address,json=equivalenceContractAddress,proto3" json:"equivalence_contract_address,omitempty"`
+	IpfsGateway                string `protobuf:"bytes,2,opt,name=ipfs_gateway,json=ipfsGateway,proto3" json:"ipfs_gateway,omitempty"`
+	IpfsEnabled                bool   `protobuf:"varint,3,opt,name=ipfs_enabled,json=ipfsEnabled,proto3" json:"ipfs_enabled,omitempty"`
+	MinApprovalThreshold       string `protobuf:"bytes,4,opt,name=min_approval_threshold,json=minApprovalThreshold,proto3" json:"min_approval_threshold,omitempty"`
+	MaxAnalysisRetries         uint64 `protobuf:"varint,5,opt,name=max_analysis_retries,json=maxAnalysisRetries,proto3" json:"max_analysis_retries,omitempty"`
+	AnalysisTimeoutSeconds     uint64 `protobuf:"varint,6,opt,name=analysis_timeout_seconds,json=analysisTimeoutSeconds,proto3" json:"analysis_timeout_seconds,omitempty"`
+	RequireContractAuth        bool   `protobuf:"varint,7,opt,name=require_contract_auth,json=requireContractAuth,proto3" json:"require_contract_auth,omitempty"`
+	Admin                      string `protobuf:"bytes,8,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +879,62 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_academictoken_equivalence_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetEquivalenceContractAddress() string {
+	if x != nil {
+		return x.EquivalenceContractAddress
+	}
+	return ""
+}
+
+func (x *Params) GetIpfsGateway() string {
+	if x != nil {
+		return x.IpfsGateway
+	}
+	return ""
+}
+
+func (x *Params) GetIpfsEnabled() bool {
+	if x != nil {
+		return x.IpfsEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMinApprovalThreshold() string {
+	if x != nil {
+		return x.MinApprovalThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetMaxAnalysisRetries() uint64 {
+	if x != nil {
+		return x.MaxAnalysisRetries
+	}
+	return 0
+}
+
+func (x *Params) GetAnalysisTimeoutSeconds() uint64 {
+	if x != nil {
+		return x.AnalysisTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetRequireContractAuth() bool {
+	if x != nil {
+		return x.RequireContractAuth
+	}
+	return false
+}
+
+func (x *Params) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
 var File_academictoken_equivalence_params_proto protoreflect.FileDescriptor
 
 var file_academictoken_equivalence_params_proto_rawDesc = []byte{
@@ -419,25 +944,64 @@ var file_academictoken_equivalence_params_proto_rawDesc = []byte{
 	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65,
 	0x6e, 0x63, 0x65, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x35, 0x0a, 0x06,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x22, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x78,
-	0x2f, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0xde, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61,
-	0x6c, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63,
-	0x65, 0xa2, 0x02, 0x03, 0x41, 0x45, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65,
-	0x6e, 0x63, 0x65, 0xca, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5c, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0xe2,
-	0x02, 0x25, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c,
-	0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c,
-	0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x05, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x69, 0x0a, 0x1c, 0x65, 0x71, 0x75, 0x69, 0x76,
+	0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xf2,
+	0xde, 0x1f, 0x23, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x1a, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65,
+	0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x22, 0x52, 0x0b, 0x69, 0x70, 0x66, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x3a,
+	0x0a, 0x0c, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x69, 0x70, 0x66, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x52, 0x0b, 0x69,
+	0x70, 0x66, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x16, 0x6d, 0x69,
+	0x6e, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x14, 0x6d,
+	0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x51, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x18, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x52, 0x16, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x22, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x52, 0x05, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x22, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x71,
+	0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0xde, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e,
+	0x63, 0x65, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2b, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2f, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02,
+	0x03, 0x41, 0x45, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65,
+	0xca, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5c, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x25, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x45, 0x71, 0x75,
+	0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
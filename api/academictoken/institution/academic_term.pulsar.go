@@ -0,0 +1,1168 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package institution
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_AcademicTerm                         protoreflect.MessageDescriptor
+	fd_AcademicTerm_institution             protoreflect.FieldDescriptor
+	fd_AcademicTerm_termId                  protoreflect.FieldDescriptor
+	fd_AcademicTerm_name                    protoreflect.FieldDescriptor
+	fd_AcademicTerm_startDate               protoreflect.FieldDescriptor
+	fd_AcademicTerm_endDate                 protoreflect.FieldDescriptor
+	fd_AcademicTerm_enrollmentStart         protoreflect.FieldDescriptor
+	fd_AcademicTerm_enrollmentEnd           protoreflect.FieldDescriptor
+	fd_AcademicTerm_gradeSubmissionDeadline protoreflect.FieldDescriptor
+	fd_AcademicTerm_creator                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_institution_academic_term_proto_init()
+	md_AcademicTerm = File_academictoken_institution_academic_term_proto.Messages().ByName("AcademicTerm")
+	fd_AcademicTerm_institution = md_AcademicTerm.Fields().ByName("institution")
+	fd_AcademicTerm_termId = md_AcademicTerm.Fields().ByName("termId")
+	fd_AcademicTerm_name = md_AcademicTerm.Fields().ByName("name")
+	fd_AcademicTerm_startDate = md_AcademicTerm.Fields().ByName("startDate")
+	fd_AcademicTerm_endDate = md_AcademicTerm.Fields().ByName("endDate")
+	fd_AcademicTerm_enrollmentStart = md_AcademicTerm.Fields().ByName("enrollmentStart")
+	fd_AcademicTerm_enrollmentEnd = md_AcademicTerm.Fields().ByName("enrollmentEnd")
+	fd_AcademicTerm_gradeSubmissionDeadline = md_AcademicTerm.Fields().ByName("gradeSubmissionDeadline")
+	fd_AcademicTerm_creator = md_AcademicTerm.Fields().ByName("creator")
+}
+
+var _ protoreflect.Message = (*fastReflection_AcademicTerm)(nil)
+
+type fastReflection_AcademicTerm AcademicTerm
+
+func (x *AcademicTerm) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AcademicTerm)(x)
+}
+
+func (x *AcademicTerm) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_institution_academic_term_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AcademicTerm_messageType fastReflection_AcademicTerm_messageType
+var _ protoreflect.MessageType = fastReflection_AcademicTerm_messageType{}
+
+type fastReflection_AcademicTerm_messageType struct{}
+
+func (x fastReflection_AcademicTerm_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AcademicTerm)(nil)
+}
+func (x fastReflection_AcademicTerm_messageType) New() protoreflect.Message {
+	return new(fastReflection_AcademicTerm)
+}
+func (x fastReflection_AcademicTerm_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AcademicTerm
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AcademicTerm) Descriptor() protoreflect.MessageDescriptor {
+	return md_AcademicTerm
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AcademicTerm) Type() protoreflect.MessageType {
+	return _fastReflection_AcademicTerm_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AcademicTerm) New() protoreflect.Message {
+	return new(fastReflection_AcademicTerm)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AcademicTerm) Interface() protoreflect.ProtoMessage {
+	return (*AcademicTerm)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AcademicTerm) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Institution != "" {
+		value := protoreflect.ValueOfString(x.Institution)
+		if !f(fd_AcademicTerm_institution, value) {
+			return
+		}
+	}
+	if x.TermId != "" {
+		value := protoreflect.ValueOfString(x.TermId)
+		if !f(fd_AcademicTerm_termId, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_AcademicTerm_name, value) {
+			return
+		}
+	}
+	if x.StartDate != "" {
+		value := protoreflect.ValueOfString(x.StartDate)
+		if !f(fd_AcademicTerm_startDate, value) {
+			return
+		}
+	}
+	if x.EndDate != "" {
+		value := protoreflect.ValueOfString(x.EndDate)
+		if !f(fd_AcademicTerm_endDate, value) {
+			return
+		}
+	}
+	if x.EnrollmentStart != "" {
+		value := protoreflect.ValueOfString(x.EnrollmentStart)
+		if !f(fd_AcademicTerm_enrollmentStart, value) {
+			return
+		}
+	}
+	if x.EnrollmentEnd != "" {
+		value := protoreflect.ValueOfString(x.EnrollmentEnd)
+		if !f(fd_AcademicTerm_enrollmentEnd, value) {
+			return
+		}
+	}
+	if x.GradeSubmissionDeadline != "" {
+		value := protoreflect.ValueOfString(x.GradeSubmissionDeadline)
+		if !f(fd_AcademicTerm_gradeSubmissionDeadline, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_AcademicTerm_creator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AcademicTerm) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.institution.AcademicTerm.institution":
+		return x.Institution != ""
+	case "academictoken.institution.AcademicTerm.termId":
+		return x.TermId != ""
+	case "academictoken.institution.AcademicTerm.name":
+		return x.Name != ""
+	case "academictoken.institution.AcademicTerm.startDate":
+		return x.StartDate != ""
+	case "academictoken.institution.AcademicTerm.endDate":
+		return x.EndDate != ""
+	case "academictoken.institution.AcademicTerm.enrollmentStart":
+		return x.EnrollmentStart != ""
+	case "academictoken.institution.AcademicTerm.enrollmentEnd":
+		return x.EnrollmentEnd != ""
+	case "academictoken.institution.AcademicTerm.gradeSubmissionDeadline":
+		return x.GradeSubmissionDeadline != ""
+	case "academictoken.institution.AcademicTerm.creator":
+		return x.Creator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.AcademicTerm"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.AcademicTerm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcademicTerm) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.institution.AcademicTerm.institution":
+		x.Institution = ""
+	case "academictoken.institution.AcademicTerm.termId":
+		x.TermId = ""
+	case "academictoken.institution.AcademicTerm.name":
+		x.Name = ""
+	case "academictoken.institution.AcademicTerm.startDate":
+		x.StartDate = ""
+	case "academictoken.institution.AcademicTerm.endDate":
+		x.EndDate = ""
+	case "academictoken.institution.AcademicTerm.enrollmentStart":
+		x.EnrollmentStart = ""
+	case "academictoken.institution.AcademicTerm.enrollmentEnd":
+		x.EnrollmentEnd = ""
+	case "academictoken.institution.AcademicTerm.gradeSubmissionDeadline":
+		x.GradeSubmissionDeadline = ""
+	case "academictoken.institution.AcademicTerm.creator":
+		x.Creator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.AcademicTerm"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.AcademicTerm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AcademicTerm) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.institution.AcademicTerm.institution":
+		value := x.Institution
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.AcademicTerm.termId":
+		value := x.TermId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.AcademicTerm.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.AcademicTerm.startDate":
+		value := x.StartDate
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.AcademicTerm.endDate":
+		value := x.EndDate
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.AcademicTerm.enrollmentStart":
+		value := x.EnrollmentStart
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.AcademicTerm.enrollmentEnd":
+		value := x.EnrollmentEnd
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.AcademicTerm.gradeSubmissionDeadline":
+		value := x.GradeSubmissionDeadline
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.AcademicTerm.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.AcademicTerm"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.AcademicTerm does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcademicTerm) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.institution.AcademicTerm.institution":
+		x.Institution = value.Interface().(string)
+	case "academictoken.institution.AcademicTerm.termId":
+		x.TermId = value.Interface().(string)
+	case "academictoken.institution.AcademicTerm.name":
+		x.Name = value.Interface().(string)
+	case "academictoken.institution.AcademicTerm.startDate":
+		x.StartDate = value.Interface().(string)
+	case "academictoken.institution.AcademicTerm.endDate":
+		x.EndDate = value.Interface().(string)
+	case "academictoken.institution.AcademicTerm.enrollmentStart":
+		x.EnrollmentStart = value.Interface().(string)
+	case "academictoken.institution.AcademicTerm.enrollmentEnd":
+		x.EnrollmentEnd = value.Interface().(string)
+	case "academictoken.institution.AcademicTerm.gradeSubmissionDeadline":
+		x.GradeSubmissionDeadline = value.Interface().(string)
+	case "academictoken.institution.AcademicTerm.creator":
+		x.Creator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.AcademicTerm"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.AcademicTerm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcademicTerm) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.institution.AcademicTerm.institution":
+		panic(fmt.Errorf("field institution of message academictoken.institution.AcademicTerm is not mutable"))
+	case "academictoken.institution.AcademicTerm.termId":
+		panic(fmt.Errorf("field termId of message academictoken.institution.AcademicTerm is not mutable"))
+	case "academictoken.institution.AcademicTerm.name":
+		panic(fmt.Errorf("field name of message academictoken.institution.AcademicTerm is not mutable"))
+	case "academictoken.institution.AcademicTerm.startDate":
+		panic(fmt.Errorf("field startDate of message academictoken.institution.AcademicTerm is not mutable"))
+	case "academictoken.institution.AcademicTerm.endDate":
+		panic(fmt.Errorf("field endDate of message academictoken.institution.AcademicTerm is not mutable"))
+	case "academictoken.institution.AcademicTerm.enrollmentStart":
+		panic(fmt.Errorf("field enrollmentStart of message academictoken.institution.AcademicTerm is not mutable"))
+	case "academictoken.institution.AcademicTerm.enrollmentEnd":
+		panic(fmt.Errorf("field enrollmentEnd of message academictoken.institution.AcademicTerm is not mutable"))
+	case "academictoken.institution.AcademicTerm.gradeSubmissionDeadline":
+		panic(fmt.Errorf("field gradeSubmissionDeadline of message academictoken.institution.AcademicTerm is not mutable"))
+	case "academictoken.institution.AcademicTerm.creator":
+		panic(fmt.Errorf("field creator of message academictoken.institution.AcademicTerm is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.AcademicTerm"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.AcademicTerm does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AcademicTerm) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.institution.AcademicTerm.institution":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.AcademicTerm.termId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.AcademicTerm.name":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.AcademicTerm.startDate":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.AcademicTerm.endDate":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.AcademicTerm.enrollmentStart":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.AcademicTerm.enrollmentEnd":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.AcademicTerm.gradeSubmissionDeadline":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.AcademicTerm.creator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.AcademicTerm"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.AcademicTerm does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AcademicTerm) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.institution.AcademicTerm", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AcademicTerm) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcademicTerm) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AcademicTerm) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AcademicTerm) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AcademicTerm)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Institution)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TermId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StartDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EndDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EnrollmentStart)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EnrollmentEnd)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GradeSubmissionDeadline)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AcademicTerm)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.GradeSubmissionDeadline) > 0 {
+			i -= len(x.GradeSubmissionDeadline)
+			copy(dAtA[i:], x.GradeSubmissionDeadline)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GradeSubmissionDeadline)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.EnrollmentEnd) > 0 {
+			i -= len(x.EnrollmentEnd)
+			copy(dAtA[i:], x.EnrollmentEnd)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EnrollmentEnd)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.EnrollmentStart) > 0 {
+			i -= len(x.EnrollmentStart)
+			copy(dAtA[i:], x.EnrollmentStart)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EnrollmentStart)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.EndDate) > 0 {
+			i -= len(x.EndDate)
+			copy(dAtA[i:], x.EndDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EndDate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.StartDate) > 0 {
+			i -= len(x.StartDate)
+			copy(dAtA[i:], x.StartDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartDate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TermId) > 0 {
+			i -= len(x.TermId)
+			copy(dAtA[i:], x.TermId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TermId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Institution) > 0 {
+			i -= len(x.Institution)
+			copy(dAtA[i:], x.Institution)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Institution)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AcademicTerm)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AcademicTerm: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AcademicTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Institution = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TermId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TermId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EndDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnrollmentStart", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EnrollmentStart = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnrollmentEnd", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EnrollmentEnd = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GradeSubmissionDeadline", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GradeSubmissionDeadline = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/institution/academic_term.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AcademicTerm is an entry of an institution's academic calendar. Records
+// that happen in a term (completed subjects, tokens, study plans) refer to it
+// by termId. All dates are RFC3339.
+type AcademicTerm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Institution             string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	TermId                  string `protobuf:"bytes,2,opt,name=termId,proto3" json:"termId,omitempty"` // e.g. "2024-1", unique within the institution
+	Name                    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate               string `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate                 string `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	EnrollmentStart         string `protobuf:"bytes,6,opt,name=enrollmentStart,proto3" json:"enrollmentStart,omitempty"`
+	EnrollmentEnd           string `protobuf:"bytes,7,opt,name=enrollmentEnd,proto3" json:"enrollmentEnd,omitempty"`
+	GradeSubmissionDeadline string `protobuf:"bytes,8,opt,name=gradeSubmissionDeadline,proto3" json:"gradeSubmissionDeadline,omitempty"`
+	Creator                 string `protobuf:"bytes,9,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *AcademicTerm) Reset() {
+	*x = AcademicTerm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_institution_academic_term_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcademicTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicTerm) ProtoMessage() {}
+
+// Deprecated: Use AcademicTerm.ProtoReflect.Descriptor instead.
+func (*AcademicTerm) Descriptor() ([]byte, []int) {
+	return file_academictoken_institution_academic_term_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcademicTerm) GetInstitution() string {
+	if x != nil {
+		return x.Institution
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetEnrollmentStart() string {
+	if x != nil {
+		return x.EnrollmentStart
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetEnrollmentEnd() string {
+	if x != nil {
+		return x.EnrollmentEnd
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetGradeSubmissionDeadline() string {
+	if x != nil {
+		return x.GradeSubmissionDeadline
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+var File_academictoken_institution_academic_term_proto protoreflect.FileDescriptor
+
+var file_academictoken_institution_academic_term_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x19, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x69,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb8, 0x02, 0x0a, 0x0c, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e,
+	0x64, 0x12, 0x38, 0x0a, 0x17, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0xe4, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x69, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x54, 0x65, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x41, 0x49, 0x58, 0xaa,
+	0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x19, 0x41, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x49, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x25, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a,
+	0x3a, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_academictoken_institution_academic_term_proto_rawDescOnce sync.Once
+	file_academictoken_institution_academic_term_proto_rawDescData = file_academictoken_institution_academic_term_proto_rawDesc
+)
+
+func file_academictoken_institution_academic_term_proto_rawDescGZIP() []byte {
+	file_academictoken_institution_academic_term_proto_rawDescOnce.Do(func() {
+		file_academictoken_institution_academic_term_proto_rawDescData = protoimpl.X.CompressGZIP(file_academictoken_institution_academic_term_proto_rawDescData)
+	})
+	return file_academictoken_institution_academic_term_proto_rawDescData
+}
+
+var file_academictoken_institution_academic_term_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_academictoken_institution_academic_term_proto_goTypes = []interface{}{
+	(*AcademicTerm)(nil), // 0: academictoken.institution.AcademicTerm
+}
+var file_academictoken_institution_academic_term_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_academictoken_institution_academic_term_proto_init() }
+func file_academictoken_institution_academic_term_proto_init() {
+	if File_academictoken_institution_academic_term_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_academictoken_institution_academic_term_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcademicTerm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_academictoken_institution_academic_term_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_academictoken_institution_academic_term_proto_goTypes,
+		DependencyIndexes: file_academictoken_institution_academic_term_proto_depIdxs,
+		MessageInfos:      file_academictoken_institution_academic_term_proto_msgTypes,
+	}.Build()
+	File_academictoken_institution_academic_term_proto = out.File
+	file_academictoken_institution_academic_term_proto_rawDesc = nil
+	file_academictoken_institution_academic_term_proto_goTypes = nil
+	file_academictoken_institution_academic_term_proto_depIdxs = nil
+}
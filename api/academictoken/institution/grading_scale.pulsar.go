@@ -0,0 +1,1793 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package institution
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_LetterGrade           protoreflect.MessageDescriptor
+	fd_LetterGrade_letter    protoreflect.FieldDescriptor
+	fd_LetterGrade_minValue  protoreflect.FieldDescriptor
+	fd_LetterGrade_gpaPoints protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_institution_grading_scale_proto_init()
+	md_LetterGrade = File_academictoken_institution_grading_scale_proto.Messages().ByName("LetterGrade")
+	fd_LetterGrade_letter = md_LetterGrade.Fields().ByName("letter")
+	fd_LetterGrade_minValue = md_LetterGrade.Fields().ByName("minValue")
+	fd_LetterGrade_gpaPoints = md_LetterGrade.Fields().ByName("gpaPoints")
+}
+
+var _ protoreflect.Message = (*fastReflection_LetterGrade)(nil)
+
+type fastReflection_LetterGrade LetterGrade
+
+func (x *LetterGrade) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LetterGrade)(x)
+}
+
+func (x *LetterGrade) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_institution_grading_scale_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LetterGrade_messageType fastReflection_LetterGrade_messageType
+var _ protoreflect.MessageType = fastReflection_LetterGrade_messageType{}
+
+type fastReflection_LetterGrade_messageType struct{}
+
+func (x fastReflection_LetterGrade_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LetterGrade)(nil)
+}
+func (x fastReflection_LetterGrade_messageType) New() protoreflect.Message {
+	return new(fastReflection_LetterGrade)
+}
+func (x fastReflection_LetterGrade_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LetterGrade
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LetterGrade) Descriptor() protoreflect.MessageDescriptor {
+	return md_LetterGrade
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LetterGrade) Type() protoreflect.MessageType {
+	return _fastReflection_LetterGrade_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LetterGrade) New() protoreflect.Message {
+	return new(fastReflection_LetterGrade)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LetterGrade) Interface() protoreflect.ProtoMessage {
+	return (*LetterGrade)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LetterGrade) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Letter != "" {
+		value := protoreflect.ValueOfString(x.Letter)
+		if !f(fd_LetterGrade_letter, value) {
+			return
+		}
+	}
+	if x.MinValue != "" {
+		value := protoreflect.ValueOfString(x.MinValue)
+		if !f(fd_LetterGrade_minValue, value) {
+			return
+		}
+	}
+	if x.GpaPoints != "" {
+		value := protoreflect.ValueOfString(x.GpaPoints)
+		if !f(fd_LetterGrade_gpaPoints, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LetterGrade) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.institution.LetterGrade.letter":
+		return x.Letter != ""
+	case "academictoken.institution.LetterGrade.minValue":
+		return x.MinValue != ""
+	case "academictoken.institution.LetterGrade.gpaPoints":
+		return x.GpaPoints != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.LetterGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.LetterGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LetterGrade) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.institution.LetterGrade.letter":
+		x.Letter = ""
+	case "academictoken.institution.LetterGrade.minValue":
+		x.MinValue = ""
+	case "academictoken.institution.LetterGrade.gpaPoints":
+		x.GpaPoints = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.LetterGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.LetterGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LetterGrade) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.institution.LetterGrade.letter":
+		value := x.Letter
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.LetterGrade.minValue":
+		value := x.MinValue
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.LetterGrade.gpaPoints":
+		value := x.GpaPoints
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.LetterGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.LetterGrade does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LetterGrade) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.institution.LetterGrade.letter":
+		x.Letter = value.Interface().(string)
+	case "academictoken.institution.LetterGrade.minValue":
+		x.MinValue = value.Interface().(string)
+	case "academictoken.institution.LetterGrade.gpaPoints":
+		x.GpaPoints = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.LetterGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.LetterGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LetterGrade) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.institution.LetterGrade.letter":
+		panic(fmt.Errorf("field letter of message academictoken.institution.LetterGrade is not mutable"))
+	case "academictoken.institution.LetterGrade.minValue":
+		panic(fmt.Errorf("field minValue of message academictoken.institution.LetterGrade is not mutable"))
+	case "academictoken.institution.LetterGrade.gpaPoints":
+		panic(fmt.Errorf("field gpaPoints of message academictoken.institution.LetterGrade is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.LetterGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.LetterGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LetterGrade) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.institution.LetterGrade.letter":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.LetterGrade.minValue":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.LetterGrade.gpaPoints":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.LetterGrade"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.LetterGrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LetterGrade) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.institution.LetterGrade", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LetterGrade) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LetterGrade) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LetterGrade) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LetterGrade) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LetterGrade)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Letter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GpaPoints)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LetterGrade)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.GpaPoints) > 0 {
+			i -= len(x.GpaPoints)
+			copy(dAtA[i:], x.GpaPoints)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GpaPoints)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MinValue) > 0 {
+			i -= len(x.MinValue)
+			copy(dAtA[i:], x.MinValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinValue)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Letter) > 0 {
+			i -= len(x.Letter)
+			copy(dAtA[i:], x.Letter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Letter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LetterGrade)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LetterGrade: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LetterGrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Letter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Letter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GpaPoints", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GpaPoints = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GradingScale_6_list)(nil)
+
+type _GradingScale_6_list struct {
+	list *[]*LetterGrade
+}
+
+func (x *_GradingScale_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GradingScale_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GradingScale_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LetterGrade)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GradingScale_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LetterGrade)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GradingScale_6_list) AppendMutable() protoreflect.Value {
+	v := new(LetterGrade)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GradingScale_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GradingScale_6_list) NewElement() protoreflect.Value {
+	v := new(LetterGrade)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GradingScale_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GradingScale              protoreflect.MessageDescriptor
+	fd_GradingScale_institution  protoreflect.FieldDescriptor
+	fd_GradingScale_scaleType    protoreflect.FieldDescriptor
+	fd_GradingScale_minGrade     protoreflect.FieldDescriptor
+	fd_GradingScale_maxGrade     protoreflect.FieldDescriptor
+	fd_GradingScale_passingGrade protoreflect.FieldDescriptor
+	fd_GradingScale_letterGrades protoreflect.FieldDescriptor
+	fd_GradingScale_maxGpa       protoreflect.FieldDescriptor
+	fd_GradingScale_updatedBy    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_institution_grading_scale_proto_init()
+	md_GradingScale = File_academictoken_institution_grading_scale_proto.Messages().ByName("GradingScale")
+	fd_GradingScale_institution = md_GradingScale.Fields().ByName("institution")
+	fd_GradingScale_scaleType = md_GradingScale.Fields().ByName("scaleType")
+	fd_GradingScale_minGrade = md_GradingScale.Fields().ByName("minGrade")
+	fd_GradingScale_maxGrade = md_GradingScale.Fields().ByName("maxGrade")
+	fd_GradingScale_passingGrade = md_GradingScale.Fields().ByName("passingGrade")
+	fd_GradingScale_letterGrades = md_GradingScale.Fields().ByName("letterGrades")
+	fd_GradingScale_maxGpa = md_GradingScale.Fields().ByName("maxGpa")
+	fd_GradingScale_updatedBy = md_GradingScale.Fields().ByName("updatedBy")
+}
+
+var _ protoreflect.Message = (*fastReflection_GradingScale)(nil)
+
+type fastReflection_GradingScale GradingScale
+
+func (x *GradingScale) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GradingScale)(x)
+}
+
+func (x *GradingScale) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_institution_grading_scale_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GradingScale_messageType fastReflection_GradingScale_messageType
+var _ protoreflect.MessageType = fastReflection_GradingScale_messageType{}
+
+type fastReflection_GradingScale_messageType struct{}
+
+func (x fastReflection_GradingScale_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GradingScale)(nil)
+}
+func (x fastReflection_GradingScale_messageType) New() protoreflect.Message {
+	return new(fastReflection_GradingScale)
+}
+func (x fastReflection_GradingScale_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GradingScale
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GradingScale) Descriptor() protoreflect.MessageDescriptor {
+	return md_GradingScale
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GradingScale) Type() protoreflect.MessageType {
+	return _fastReflection_GradingScale_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GradingScale) New() protoreflect.Message {
+	return new(fastReflection_GradingScale)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GradingScale) Interface() protoreflect.ProtoMessage {
+	return (*GradingScale)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GradingScale) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Institution != "" {
+		value := protoreflect.ValueOfString(x.Institution)
+		if !f(fd_GradingScale_institution, value) {
+			return
+		}
+	}
+	if x.ScaleType != "" {
+		value := protoreflect.ValueOfString(x.ScaleType)
+		if !f(fd_GradingScale_scaleType, value) {
+			return
+		}
+	}
+	if x.MinGrade != "" {
+		value := protoreflect.ValueOfString(x.MinGrade)
+		if !f(fd_GradingScale_minGrade, value) {
+			return
+		}
+	}
+	if x.MaxGrade != "" {
+		value := protoreflect.ValueOfString(x.MaxGrade)
+		if !f(fd_GradingScale_maxGrade, value) {
+			return
+		}
+	}
+	if x.PassingGrade != "" {
+		value := protoreflect.ValueOfString(x.PassingGrade)
+		if !f(fd_GradingScale_passingGrade, value) {
+			return
+		}
+	}
+	if len(x.LetterGrades) != 0 {
+		value := protoreflect.ValueOfList(&_GradingScale_6_list{list: &x.LetterGrades})
+		if !f(fd_GradingScale_letterGrades, value) {
+			return
+		}
+	}
+	if x.MaxGpa != "" {
+		value := protoreflect.ValueOfString(x.MaxGpa)
+		if !f(fd_GradingScale_maxGpa, value) {
+			return
+		}
+	}
+	if x.UpdatedBy != "" {
+		value := protoreflect.ValueOfString(x.UpdatedBy)
+		if !f(fd_GradingScale_updatedBy, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GradingScale) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.institution.GradingScale.institution":
+		return x.Institution != ""
+	case "academictoken.institution.GradingScale.scaleType":
+		return x.ScaleType != ""
+	case "academictoken.institution.GradingScale.minGrade":
+		return x.MinGrade != ""
+	case "academictoken.institution.GradingScale.maxGrade":
+		return x.MaxGrade != ""
+	case "academictoken.institution.GradingScale.passingGrade":
+		return x.PassingGrade != ""
+	case "academictoken.institution.GradingScale.letterGrades":
+		return len(x.LetterGrades) != 0
+	case "academictoken.institution.GradingScale.maxGpa":
+		return x.MaxGpa != ""
+	case "academictoken.institution.GradingScale.updatedBy":
+		return x.UpdatedBy != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.GradingScale"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.GradingScale does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GradingScale) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.institution.GradingScale.institution":
+		x.Institution = ""
+	case "academictoken.institution.GradingScale.scaleType":
+		x.ScaleType = ""
+	case "academictoken.institution.GradingScale.minGrade":
+		x.MinGrade = ""
+	case "academictoken.institution.GradingScale.maxGrade":
+		x.MaxGrade = ""
+	case "academictoken.institution.GradingScale.passingGrade":
+		x.PassingGrade = ""
+	case "academictoken.institution.GradingScale.letterGrades":
+		x.LetterGrades = nil
+	case "academictoken.institution.GradingScale.maxGpa":
+		x.MaxGpa = ""
+	case "academictoken.institution.GradingScale.updatedBy":
+		x.UpdatedBy = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.GradingScale"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.GradingScale does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GradingScale) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.institution.GradingScale.institution":
+		value := x.Institution
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.GradingScale.scaleType":
+		value := x.ScaleType
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.GradingScale.minGrade":
+		value := x.MinGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.GradingScale.maxGrade":
+		value := x.MaxGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.GradingScale.passingGrade":
+		value := x.PassingGrade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.GradingScale.letterGrades":
+		if len(x.LetterGrades) == 0 {
+			return protoreflect.ValueOfList(&_GradingScale_6_list{})
+		}
+		listValue := &_GradingScale_6_list{list: &x.LetterGrades}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.institution.GradingScale.maxGpa":
+		value := x.MaxGpa
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.GradingScale.updatedBy":
+		value := x.UpdatedBy
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.GradingScale"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.GradingScale does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GradingScale) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.institution.GradingScale.institution":
+		x.Institution = value.Interface().(string)
+	case "academictoken.institution.GradingScale.scaleType":
+		x.ScaleType = value.Interface().(string)
+	case "academictoken.institution.GradingScale.minGrade":
+		x.MinGrade = value.Interface().(string)
+	case "academictoken.institution.GradingScale.maxGrade":
+		x.MaxGrade = value.Interface().(string)
+	case "academictoken.institution.GradingScale.passingGrade":
+		x.PassingGrade = value.Interface().(string)
+	case "academictoken.institution.GradingScale.letterGrades":
+		lv := value.List()
+		clv := lv.(*_GradingScale_6_list)
+		x.LetterGrades = *clv.list
+	case "academictoken.institution.GradingScale.maxGpa":
+		x.MaxGpa = value.Interface().(string)
+	case "academictoken.institution.GradingScale.updatedBy":
+		x.UpdatedBy = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.GradingScale"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.GradingScale does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GradingScale) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.institution.GradingScale.letterGrades":
+		if x.LetterGrades == nil {
+			x.LetterGrades = []*LetterGrade{}
+		}
+		value := &_GradingScale_6_list{list: &x.LetterGrades}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.institution.GradingScale.institution":
+		panic(fmt.Errorf("field institution of message academictoken.institution.GradingScale is not mutable"))
+	case "academictoken.institution.GradingScale.scaleType":
+		panic(fmt.Errorf("field scaleType of message academictoken.institution.GradingScale is not mutable"))
+	case "academictoken.institution.GradingScale.minGrade":
+		panic(fmt.Errorf("field minGrade of message academictoken.institution.GradingScale is not mutable"))
+	case "academictoken.institution.GradingScale.maxGrade":
+		panic(fmt.Errorf("field maxGrade of message academictoken.institution.GradingScale is not mutable"))
+	case "academictoken.institution.GradingScale.passingGrade":
+		panic(fmt.Errorf("field passingGrade of message academictoken.institution.GradingScale is not mutable"))
+	case "academictoken.institution.GradingScale.maxGpa":
+		panic(fmt.Errorf("field maxGpa of message academictoken.institution.GradingScale is not mutable"))
+	case "academictoken.institution.GradingScale.updatedBy":
+		panic(fmt.Errorf("field updatedBy of message academictoken.institution.GradingScale is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.GradingScale"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.GradingScale does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GradingScale) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.institution.GradingScale.institution":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.GradingScale.scaleType":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.GradingScale.minGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.GradingScale.maxGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.GradingScale.passingGrade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.GradingScale.letterGrades":
+		list := []*LetterGrade{}
+		return protoreflect.ValueOfList(&_GradingScale_6_list{list: &list})
+	case "academictoken.institution.GradingScale.maxGpa":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.GradingScale.updatedBy":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.GradingScale"))
+		}
+		panic(fmt.Errorf("message academictoken.institution.GradingScale does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GradingScale) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.institution.GradingScale", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GradingScale) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GradingScale) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GradingScale) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GradingScale) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GradingScale)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Institution)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ScaleType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PassingGrade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LetterGrades) > 0 {
+			for _, e := range x.LetterGrades {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaxGpa)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UpdatedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GradingScale)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UpdatedBy) > 0 {
+			i -= len(x.UpdatedBy)
+			copy(dAtA[i:], x.UpdatedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpdatedBy)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.MaxGpa) > 0 {
+			i -= len(x.MaxGpa)
+			copy(dAtA[i:], x.MaxGpa)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxGpa)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.LetterGrades) > 0 {
+			for iNdEx := len(x.LetterGrades) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LetterGrades[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.PassingGrade) > 0 {
+			i -= len(x.PassingGrade)
+			copy(dAtA[i:], x.PassingGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PassingGrade)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MaxGrade) > 0 {
+			i -= len(x.MaxGrade)
+			copy(dAtA[i:], x.MaxGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxGrade)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MinGrade) > 0 {
+			i -= len(x.MinGrade)
+			copy(dAtA[i:], x.MinGrade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGrade)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ScaleType) > 0 {
+			i -= len(x.ScaleType)
+			copy(dAtA[i:], x.ScaleType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScaleType)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Institution) > 0 {
+			i -= len(x.Institution)
+			copy(dAtA[i:], x.Institution)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Institution)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GradingScale)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GradingScale: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GradingScale: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Institution = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScaleType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScaleType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PassingGrade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PassingGrade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LetterGrades", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LetterGrades = append(x.LetterGrades, &LetterGrade{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LetterGrades[len(x.LetterGrades)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxGpa", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxGpa = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpdatedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/institution/grading_scale.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LetterGrade maps a letter to a value of the grading scale and to GPA points.
+// On numeric scales minValue is the lowest grade that earns the letter; on
+// letter scales it is the value the letter stands for.
+type LetterGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Letter    string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	MinValue  string `protobuf:"bytes,2,opt,name=minValue,proto3" json:"minValue,omitempty"`
+	GpaPoints string `protobuf:"bytes,3,opt,name=gpaPoints,proto3" json:"gpaPoints,omitempty"`
+}
+
+func (x *LetterGrade) Reset() {
+	*x = LetterGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_institution_grading_scale_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LetterGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LetterGrade) ProtoMessage() {}
+
+// Deprecated: Use LetterGrade.ProtoReflect.Descriptor instead.
+func (*LetterGrade) Descriptor() ([]byte, []int) {
+	return file_academictoken_institution_grading_scale_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LetterGrade) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *LetterGrade) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+func (x *LetterGrade) GetGpaPoints() string {
+	if x != nil {
+		return x.GpaPoints
+	}
+	return ""
+}
+
+// GradingScale describes how an institution expresses grades. Decimal values
+// are stored as strings.
+type GradingScale struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Institution  string         `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	ScaleType    string         `protobuf:"bytes,2,opt,name=scaleType,proto3" json:"scaleType,omitempty"` // "numeric", "letter" or "pass_fail"
+	MinGrade     string         `protobuf:"bytes,3,opt,name=minGrade,proto3" json:"minGrade,omitempty"`
+	MaxGrade     string         `protobuf:"bytes,4,opt,name=maxGrade,proto3" json:"maxGrade,omitempty"`
+	PassingGrade string         `protobuf:"bytes,5,opt,name=passingGrade,proto3" json:"passingGrade,omitempty"`
+	LetterGrades []*LetterGrade `protobuf:"bytes,6,rep,name=letterGrades,proto3" json:"letterGrades,omitempty"`
+	MaxGpa       string         `protobuf:"bytes,7,opt,name=maxGpa,proto3" json:"maxGpa,omitempty"`
+	UpdatedBy    string         `protobuf:"bytes,8,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+}
+
+func (x *GradingScale) Reset() {
+	*x = GradingScale{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_institution_grading_scale_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingScale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingScale) ProtoMessage() {}
+
+// Deprecated: Use GradingScale.ProtoReflect.Descriptor instead.
+func (*GradingScale) Descriptor() ([]byte, []int) {
+	return file_academictoken_institution_grading_scale_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GradingScale) GetInstitution() string {
+	if x != nil {
+		return x.Institution
+	}
+	return ""
+}
+
+func (x *GradingScale) GetScaleType() string {
+	if x != nil {
+		return x.ScaleType
+	}
+	return ""
+}
+
+func (x *GradingScale) GetMinGrade() string {
+	if x != nil {
+		return x.MinGrade
+	}
+	return ""
+}
+
+func (x *GradingScale) GetMaxGrade() string {
+	if x != nil {
+		return x.MaxGrade
+	}
+	return ""
+}
+
+func (x *GradingScale) GetPassingGrade() string {
+	if x != nil {
+		return x.PassingGrade
+	}
+	return ""
+}
+
+func (x *GradingScale) GetLetterGrades() []*LetterGrade {
+	if x != nil {
+		return x.LetterGrades
+	}
+	return nil
+}
+
+func (x *GradingScale) GetMaxGpa() string {
+	if x != nil {
+		return x.MaxGpa
+	}
+	return ""
+}
+
+func (x *GradingScale) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+var File_academictoken_institution_grading_scale_proto protoreflect.FileDescriptor
+
+var file_academictoken_institution_grading_scale_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x19, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x69,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x5f, 0x0a, 0x0b, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x70, 0x61, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x70, 0x61, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0xb2, 0x02, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x50,
+	0x0a, 0x0c, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0c, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x47, 0x70, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x47, 0x70, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x42, 0xe4, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x69, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x69,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x41, 0x49, 0x58,
+	0xaa, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x19, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x25, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x3a, 0x3a, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_academictoken_institution_grading_scale_proto_rawDescOnce sync.Once
+	file_academictoken_institution_grading_scale_proto_rawDescData = file_academictoken_institution_grading_scale_proto_rawDesc
+)
+
+func file_academictoken_institution_grading_scale_proto_rawDescGZIP() []byte {
+	file_academictoken_institution_grading_scale_proto_rawDescOnce.Do(func() {
+		file_academictoken_institution_grading_scale_proto_rawDescData = protoimpl.X.CompressGZIP(file_academictoken_institution_grading_scale_proto_rawDescData)
+	})
+	return file_academictoken_institution_grading_scale_proto_rawDescData
+}
+
+var file_academictoken_institution_grading_scale_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_academictoken_institution_grading_scale_proto_goTypes = []interface{}{
+	(*LetterGrade)(nil),  // 0: academictoken.institution.LetterGrade
+	(*GradingScale)(nil), // 1: academictoken.institution.GradingScale
+}
+var file_academictoken_institution_grading_scale_proto_depIdxs = []int32{
+	0, // 0: academictoken.institution.GradingScale.letterGrades:type_name -> academictoken.institution.LetterGrade
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_academictoken_institution_grading_scale_proto_init() }
+func file_academictoken_institution_grading_scale_proto_init() {
+	if File_academictoken_institution_grading_scale_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_academictoken_institution_grading_scale_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LetterGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_institution_grading_scale_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingScale); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_academictoken_institution_grading_scale_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_academictoken_institution_grading_scale_proto_goTypes,
+		DependencyIndexes: file_academictoken_institution_grading_scale_proto_depIdxs,
+		MessageInfos:      file_academictoken_institution_grading_scale_proto_msgTypes,
+	}.Build()
+	File_academictoken_institution_grading_scale_proto = out.File
+	file_academictoken_institution_grading_scale_proto_rawDesc = nil
+	file_academictoken_institution_grading_scale_proto_goTypes = nil
+	file_academictoken_institution_grading_scale_proto_depIdxs = nil
+}
@@ -12,13 +12,63 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Institution_9_list)(nil)
+
+type _Institution_9_list struct {
+	list *[]string
+}
+
+func (x *_Institution_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Institution_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Institution_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Institution_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Institution_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Institution at list field AccreditationEvidence as it is not of Message kind"))
+}
+
+func (x *_Institution_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Institution_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Institution_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Institution              protoreflect.MessageDescriptor
-	fd_Institution_index        protoreflect.FieldDescriptor
-	fd_Institution_address      protoreflect.FieldDescriptor
-	fd_Institution_name         protoreflect.FieldDescriptor
-	fd_Institution_isAuthorized protoreflect.FieldDescriptor
-	fd_Institution_creator      protoreflect.FieldDescriptor
+	md_Institution                       protoreflect.MessageDescriptor
+	fd_Institution_index                 protoreflect.FieldDescriptor
+	fd_Institution_address               protoreflect.FieldDescriptor
+	fd_Institution_name                  protoreflect.FieldDescriptor
+	fd_Institution_isAuthorized          protoreflect.FieldDescriptor
+	fd_Institution_creator               protoreflect.FieldDescriptor
+	fd_Institution_accreditedUntil       protoreflect.FieldDescriptor
+	fd_Institution_accreditedAt          protoreflect.FieldDescriptor
+	fd_Institution_accreditedBy          protoreflect.FieldDescriptor
+	fd_Institution_accreditationEvidence protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -29,6 +79,10 @@ func init() {
 	fd_Institution_name = md_Institution.Fields().ByName("name")
 	fd_Institution_isAuthorized = md_Institution.Fields().ByName("isAuthorized")
 	fd_Institution_creator = md_Institution.Fields().ByName("creator")
+	fd_Institution_accreditedUntil = md_Institution.Fields().ByName("accreditedUntil")
+	fd_Institution_accreditedAt = md_Institution.Fields().ByName("accreditedAt")
+	fd_Institution_accreditedBy = md_Institution.Fields().ByName("accreditedBy")
+	fd_Institution_accreditationEvidence = md_Institution.Fields().ByName("accreditationEvidence")
 }
 
 var _ protoreflect.Message = (*fastReflection_Institution)(nil)
@@ -126,6 +180,30 @@ func (x *fastReflection_Institution) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.AccreditedUntil != "" {
+		value := protoreflect.ValueOfString(x.AccreditedUntil)
+		if !f(fd_Institution_accreditedUntil, value) {
+			return
+		}
+	}
+	if x.AccreditedAt != "" {
+		value := protoreflect.ValueOfString(x.AccreditedAt)
+		if !f(fd_Institution_accreditedAt, value) {
+			return
+		}
+	}
+	if x.AccreditedBy != "" {
+		value := protoreflect.ValueOfString(x.AccreditedBy)
+		if !f(fd_Institution_accreditedBy, value) {
+			return
+		}
+	}
+	if len(x.AccreditationEvidence) != 0 {
+		value := protoreflect.ValueOfList(&_Institution_9_list{list: &x.AccreditationEvidence})
+		if !f(fd_Institution_accreditationEvidence, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -151,6 +229,14 @@ func (x *fastReflection_Institution) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.IsAuthorized != ""
 	case "academictoken.institution.Institution.creator":
 		return x.Creator != ""
+	case "academictoken.institution.Institution.accreditedUntil":
+		return x.AccreditedUntil != ""
+	case "academictoken.institution.Institution.accreditedAt":
+		return x.AccreditedAt != ""
+	case "academictoken.institution.Institution.accreditedBy":
+		return x.AccreditedBy != ""
+	case "academictoken.institution.Institution.accreditationEvidence":
+		return len(x.AccreditationEvidence) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.Institution"))
@@ -177,6 +263,14 @@ func (x *fastReflection_Institution) Clear(fd protoreflect.FieldDescriptor) {
 		x.IsAuthorized = ""
 	case "academictoken.institution.Institution.creator":
 		x.Creator = ""
+	case "academictoken.institution.Institution.accreditedUntil":
+		x.AccreditedUntil = ""
+	case "academictoken.institution.Institution.accreditedAt":
+		x.AccreditedAt = ""
+	case "academictoken.institution.Institution.accreditedBy":
+		x.AccreditedBy = ""
+	case "academictoken.institution.Institution.accreditationEvidence":
+		x.AccreditationEvidence = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.Institution"))
@@ -208,6 +302,21 @@ func (x *fastReflection_Institution) Get(descriptor protoreflect.FieldDescriptor
 	case "academictoken.institution.Institution.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.Institution.accreditedUntil":
+		value := x.AccreditedUntil
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.Institution.accreditedAt":
+		value := x.AccreditedAt
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.Institution.accreditedBy":
+		value := x.AccreditedBy
+		return protoreflect.ValueOfString(value)
+	case "academictoken.institution.Institution.accreditationEvidence":
+		if len(x.AccreditationEvidence) == 0 {
+			return protoreflect.ValueOfList(&_Institution_9_list{})
+		}
+		listValue := &_Institution_9_list{list: &x.AccreditationEvidence}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.Institution"))
@@ -238,6 +347,16 @@ func (x *fastReflection_Institution) Set(fd protoreflect.FieldDescriptor, value
 		x.IsAuthorized = value.Interface().(string)
 	case "academictoken.institution.Institution.creator":
 		x.Creator = value.Interface().(string)
+	case "academictoken.institution.Institution.accreditedUntil":
+		x.AccreditedUntil = value.Interface().(string)
+	case "academictoken.institution.Institution.accreditedAt":
+		x.AccreditedAt = value.Interface().(string)
+	case "academictoken.institution.Institution.accreditedBy":
+		x.AccreditedBy = value.Interface().(string)
+	case "academictoken.institution.Institution.accreditationEvidence":
+		lv := value.List()
+		clv := lv.(*_Institution_9_list)
+		x.AccreditationEvidence = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.Institution"))
@@ -258,6 +377,12 @@ func (x *fastReflection_Institution) Set(fd protoreflect.FieldDescriptor, value
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Institution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "academictoken.institution.Institution.accreditationEvidence":
+		if x.AccreditationEvidence == nil {
+			x.AccreditationEvidence = []string{}
+		}
+		value := &_Institution_9_list{list: &x.AccreditationEvidence}
+		return protoreflect.ValueOfList(value)
 	case "academictoken.institution.Institution.index":
 		panic(fmt.Errorf("field index of message academictoken.institution.Institution is not mutable"))
 	case "academictoken.institution.Institution.address":
@@ -268,6 +393,12 @@ func (x *fastReflection_Institution) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field isAuthorized of message academictoken.institution.Institution is not mutable"))
 	case "academictoken.institution.Institution.creator":
 		panic(fmt.Errorf("field creator of message academictoken.institution.Institution is not mutable"))
+	case "academictoken.institution.Institution.accreditedUntil":
+		panic(fmt.Errorf("field accreditedUntil of message academictoken.institution.Institution is not mutable"))
+	case "academictoken.institution.Institution.accreditedAt":
+		panic(fmt.Errorf("field accreditedAt of message academictoken.institution.Institution is not mutable"))
+	case "academictoken.institution.Institution.accreditedBy":
+		panic(fmt.Errorf("field accreditedBy of message academictoken.institution.Institution is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.Institution"))
@@ -291,6 +422,15 @@ func (x *fastReflection_Institution) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfString("")
 	case "academictoken.institution.Institution.creator":
 		return protoreflect.ValueOfString("")
+	case "academictoken.institution.Institution.accreditedUntil":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.Institution.accreditedAt":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.Institution.accreditedBy":
+		return protoreflect.ValueOfString("")
+	case "academictoken.institution.Institution.accreditationEvidence":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Institution_9_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.institution.Institution"))
@@ -380,6 +520,24 @@ func (x *fastReflection_Institution) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.AccreditedUntil)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AccreditedAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AccreditedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AccreditationEvidence) > 0 {
+			for _, s := range x.AccreditationEvidence {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -409,6 +567,36 @@ func (x *fastReflection_Institution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AccreditationEvidence) > 0 {
+			for iNdEx := len(x.AccreditationEvidence) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AccreditationEvidence[iNdEx])
+				copy(dAtA[i:], x.AccreditationEvidence[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccreditationEvidence[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.AccreditedBy) > 0 {
+			i -= len(x.AccreditedBy)
+			copy(dAtA[i:], x.AccreditedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccreditedBy)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.AccreditedAt) > 0 {
+			i -= len(x.AccreditedAt)
+			copy(dAtA[i:], x.AccreditedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccreditedAt)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.AccreditedUntil) > 0 {
+			i -= len(x.AccreditedUntil)
+			copy(dAtA[i:], x.AccreditedUntil)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccreditedUntil)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -653,6 +841,134 @@ func (x *fastReflection_Institution) ProtoMethods() *protoiface.Methods {
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccreditedUntil", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccreditedUntil = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccreditedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccreditedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccreditedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccreditedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccreditationEvidence", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccreditationEvidence = append(x.AccreditationEvidence, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -711,6 +1027,12 @@ type Institution struct {
 	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	IsAuthorized string `protobuf:"bytes,4,opt,name=isAuthorized,proto3" json:"isAuthorized,omitempty"`
 	Creator      string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Accreditation granted through governance or an accreditation body.
+	// Institutions stop being authorized once accreditedUntil has passed.
+	AccreditedUntil       string   `protobuf:"bytes,6,opt,name=accreditedUntil,proto3" json:"accreditedUntil,omitempty"` // RFC3339, empty for accreditations without expiry
+	AccreditedAt          string   `protobuf:"bytes,7,opt,name=accreditedAt,proto3" json:"accreditedAt,omitempty"`       // RFC3339
+	AccreditedBy          string   `protobuf:"bytes,8,opt,name=accreditedBy,proto3" json:"accreditedBy,omitempty"`
+	AccreditationEvidence []string `protobuf:"bytes,9,rep,name=accreditationEvidence,proto3" json:"accreditationEvidence,omitempty"`
 }
 
 func (x *Institution) Reset() {
@@ -768,6 +1090,34 @@ func (x *Institution) GetCreator() string {
 	return ""
 }
 
+func (x *Institution) GetAccreditedUntil() string {
+	if x != nil {
+		return x.AccreditedUntil
+	}
+	return ""
+}
+
+func (x *Institution) GetAccreditedAt() string {
+	if x != nil {
+		return x.AccreditedAt
+	}
+	return ""
+}
+
+func (x *Institution) GetAccreditedBy() string {
+	if x != nil {
+		return x.AccreditedBy
+	}
+	return ""
+}
+
+func (x *Institution) GetAccreditationEvidence() []string {
+	if x != nil {
+		return x.AccreditationEvidence
+	}
+	return nil
+}
+
 var File_academictoken_institution_institution_proto protoreflect.FileDescriptor
 
 var file_academictoken_institution_institution_proto_rawDesc = []byte{
@@ -775,7 +1125,7 @@ var file_academictoken_institution_institution_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x73, 0x74,
 	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x61,
 	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x69, 0x6e, 0x73,
-	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x73,
 	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
 	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18,
 	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -784,22 +1134,32 @@ var file_academictoken_institution_institution_proto_rawDesc = []byte{
 	0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0c, 0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
 	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0xe3, 0x01, 0x0a, 0x1d, 0x63,
-	0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x49, 0x6e,
-	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x2b, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03,
-	0x41, 0x49, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xca,
-	0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c,
-	0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x25, 0x41, 0x63,
-	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x49, 0x6e, 0x73, 0x74,
-	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x34, 0x0a, 0x15,
+	0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x61, 0x63, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x42, 0xe3, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x41, 0x49, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x19, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x25, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x41, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -24,6 +24,13 @@ const (
 	Query_Institution_FullMethodName            = "/academictoken.institution.Query/Institution"
 	Query_AuthorizedInstitutions_FullMethodName = "/academictoken.institution.Query/AuthorizedInstitutions"
 	Query_InstitutionCount_FullMethodName       = "/academictoken.institution.Query/InstitutionCount"
+	Query_InstitutionRoles_FullMethodName       = "/academictoken.institution.Query/InstitutionRoles"
+	Query_AccountRoles_FullMethodName           = "/academictoken.institution.Query/AccountRoles"
+	Query_GradingScale_FullMethodName           = "/academictoken.institution.Query/GradingScale"
+	Query_SigningKeys_FullMethodName            = "/academictoken.institution.Query/SigningKeys"
+	Query_Accreditation_FullMethodName          = "/academictoken.institution.Query/Accreditation"
+	Query_AcademicTerm_FullMethodName           = "/academictoken.institution.Query/AcademicTerm"
+	Query_AcademicTerms_FullMethodName          = "/academictoken.institution.Query/AcademicTerms"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +47,21 @@ type QueryClient interface {
 	AuthorizedInstitutions(ctx context.Context, in *QueryAuthorizedInstitutionsRequest, opts ...grpc.CallOption) (*QueryAuthorizedInstitutionsResponse, error)
 	// Queries institution count.
 	InstitutionCount(ctx context.Context, in *QueryInstitutionCountRequest, opts ...grpc.CallOption) (*QueryInstitutionCountResponse, error)
+	// Queries the role assignments of an institution.
+	InstitutionRoles(ctx context.Context, in *QueryInstitutionRolesRequest, opts ...grpc.CallOption) (*QueryInstitutionRolesResponse, error)
+	// Queries the role assignments held by an account in an institution.
+	AccountRoles(ctx context.Context, in *QueryAccountRolesRequest, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error)
+	// Queries the grading scale of an institution. Institutions without a
+	// configured scale report the default 0-100 scale.
+	GradingScale(ctx context.Context, in *QueryGradingScaleRequest, opts ...grpc.CallOption) (*QueryGradingScaleResponse, error)
+	// Queries the signing keys registered by an institution, revoked ones included.
+	SigningKeys(ctx context.Context, in *QuerySigningKeysRequest, opts ...grpc.CallOption) (*QuerySigningKeysResponse, error)
+	// Queries the accreditation status and latest accreditation proposal of an institution.
+	Accreditation(ctx context.Context, in *QueryAccreditationRequest, opts ...grpc.CallOption) (*QueryAccreditationResponse, error)
+	// Queries a term of an institution's academic calendar.
+	AcademicTerm(ctx context.Context, in *QueryAcademicTermRequest, opts ...grpc.CallOption) (*QueryAcademicTermResponse, error)
+	// Queries the academic calendar of an institution.
+	AcademicTerms(ctx context.Context, in *QueryAcademicTermsRequest, opts ...grpc.CallOption) (*QueryAcademicTermsResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +117,69 @@ func (c *queryClient) InstitutionCount(ctx context.Context, in *QueryInstitution
 	return out, nil
 }
 
+func (c *queryClient) InstitutionRoles(ctx context.Context, in *QueryInstitutionRolesRequest, opts ...grpc.CallOption) (*QueryInstitutionRolesResponse, error) {
+	out := new(QueryInstitutionRolesResponse)
+	err := c.cc.Invoke(ctx, Query_InstitutionRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountRoles(ctx context.Context, in *QueryAccountRolesRequest, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error) {
+	out := new(QueryAccountRolesResponse)
+	err := c.cc.Invoke(ctx, Query_AccountRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GradingScale(ctx context.Context, in *QueryGradingScaleRequest, opts ...grpc.CallOption) (*QueryGradingScaleResponse, error) {
+	out := new(QueryGradingScaleResponse)
+	err := c.cc.Invoke(ctx, Query_GradingScale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningKeys(ctx context.Context, in *QuerySigningKeysRequest, opts ...grpc.CallOption) (*QuerySigningKeysResponse, error) {
+	out := new(QuerySigningKeysResponse)
+	err := c.cc.Invoke(ctx, Query_SigningKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Accreditation(ctx context.Context, in *QueryAccreditationRequest, opts ...grpc.CallOption) (*QueryAccreditationResponse, error) {
+	out := new(QueryAccreditationResponse)
+	err := c.cc.Invoke(ctx, Query_Accreditation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AcademicTerm(ctx context.Context, in *QueryAcademicTermRequest, opts ...grpc.CallOption) (*QueryAcademicTermResponse, error) {
+	out := new(QueryAcademicTermResponse)
+	err := c.cc.Invoke(ctx, Query_AcademicTerm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AcademicTerms(ctx context.Context, in *QueryAcademicTermsRequest, opts ...grpc.CallOption) (*QueryAcademicTermsResponse, error) {
+	out := new(QueryAcademicTermsResponse)
+	err := c.cc.Invoke(ctx, Query_AcademicTerms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +194,21 @@ type QueryServer interface {
 	AuthorizedInstitutions(context.Context, *QueryAuthorizedInstitutionsRequest) (*QueryAuthorizedInstitutionsResponse, error)
 	// Queries institution count.
 	InstitutionCount(context.Context, *QueryInstitutionCountRequest) (*QueryInstitutionCountResponse, error)
+	// Queries the role assignments of an institution.
+	InstitutionRoles(context.Context, *QueryInstitutionRolesRequest) (*QueryInstitutionRolesResponse, error)
+	// Queries the role assignments held by an account in an institution.
+	AccountRoles(context.Context, *QueryAccountRolesRequest) (*QueryAccountRolesResponse, error)
+	// Queries the grading scale of an institution. Institutions without a
+	// configured scale report the default 0-100 scale.
+	GradingScale(context.Context, *QueryGradingScaleRequest) (*QueryGradingScaleResponse, error)
+	// Queries the signing keys registered by an institution, revoked ones included.
+	SigningKeys(context.Context, *QuerySigningKeysRequest) (*QuerySigningKeysResponse, error)
+	// Queries the accreditation status and latest accreditation proposal of an institution.
+	Accreditation(context.Context, *QueryAccreditationRequest) (*QueryAccreditationResponse, error)
+	// Queries a term of an institution's academic calendar.
+	AcademicTerm(context.Context, *QueryAcademicTermRequest) (*QueryAcademicTermResponse, error)
+	// Queries the academic calendar of an institution.
+	AcademicTerms(context.Context, *QueryAcademicTermsRequest) (*QueryAcademicTermsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +231,27 @@ func (UnimplementedQueryServer) AuthorizedInstitutions(context.Context, *QueryAu
 func (UnimplementedQueryServer) InstitutionCount(context.Context, *QueryInstitutionCountRequest) (*QueryInstitutionCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstitutionCount not implemented")
 }
+func (UnimplementedQueryServer) InstitutionRoles(context.Context, *QueryInstitutionRolesRequest) (*QueryInstitutionRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstitutionRoles not implemented")
+}
+func (UnimplementedQueryServer) AccountRoles(context.Context, *QueryAccountRolesRequest) (*QueryAccountRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRoles not implemented")
+}
+func (UnimplementedQueryServer) GradingScale(context.Context, *QueryGradingScaleRequest) (*QueryGradingScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradingScale not implemented")
+}
+func (UnimplementedQueryServer) SigningKeys(context.Context, *QuerySigningKeysRequest) (*QuerySigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningKeys not implemented")
+}
+func (UnimplementedQueryServer) Accreditation(context.Context, *QueryAccreditationRequest) (*QueryAccreditationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accreditation not implemented")
+}
+func (UnimplementedQueryServer) AcademicTerm(context.Context, *QueryAcademicTermRequest) (*QueryAcademicTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcademicTerm not implemented")
+}
+func (UnimplementedQueryServer) AcademicTerms(context.Context, *QueryAcademicTermsRequest) (*QueryAcademicTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcademicTerms not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +355,132 @@ func _Query_InstitutionCount_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InstitutionRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInstitutionRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InstitutionRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InstitutionRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InstitutionRoles(ctx, req.(*QueryInstitutionRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRoles(ctx, req.(*QueryAccountRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGradingScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GradingScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GradingScale(ctx, req.(*QueryGradingScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningKeys(ctx, req.(*QuerySigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Accreditation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccreditationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accreditation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Accreditation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accreditation(ctx, req.(*QueryAccreditationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AcademicTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcademicTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcademicTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AcademicTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcademicTerm(ctx, req.(*QueryAcademicTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AcademicTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcademicTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcademicTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AcademicTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcademicTerms(ctx, req.(*QueryAcademicTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +508,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InstitutionCount",
 			Handler:    _Query_InstitutionCount_Handler,
 		},
+		{
+			MethodName: "InstitutionRoles",
+			Handler:    _Query_InstitutionRoles_Handler,
+		},
+		{
+			MethodName: "AccountRoles",
+			Handler:    _Query_AccountRoles_Handler,
+		},
+		{
+			MethodName: "GradingScale",
+			Handler:    _Query_GradingScale_Handler,
+		},
+		{
+			MethodName: "SigningKeys",
+			Handler:    _Query_SigningKeys_Handler,
+		},
+		{
+			MethodName: "Accreditation",
+			Handler:    _Query_Accreditation_Handler,
+		},
+		{
+			MethodName: "AcademicTerm",
+			Handler:    _Query_AcademicTerm_Handler,
+		},
+		{
+			MethodName: "AcademicTerms",
+			Handler:    _Query_AcademicTerms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/institution/query.proto",
@@ -19,9 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName        = "/academictoken.institution.Msg/UpdateParams"
-	Msg_RegisterInstitution_FullMethodName = "/academictoken.institution.Msg/RegisterInstitution"
-	Msg_UpdateInstitution_FullMethodName   = "/academictoken.institution.Msg/UpdateInstitution"
+	Msg_UpdateParams_FullMethodName          = "/academictoken.institution.Msg/UpdateParams"
+	Msg_RegisterInstitution_FullMethodName   = "/academictoken.institution.Msg/RegisterInstitution"
+	Msg_UpdateInstitution_FullMethodName     = "/academictoken.institution.Msg/UpdateInstitution"
+	Msg_GrantRole_FullMethodName             = "/academictoken.institution.Msg/GrantRole"
+	Msg_RevokeRole_FullMethodName            = "/academictoken.institution.Msg/RevokeRole"
+	Msg_ConfigureGradingScale_FullMethodName = "/academictoken.institution.Msg/ConfigureGradingScale"
+	Msg_RegisterSigningKey_FullMethodName    = "/academictoken.institution.Msg/RegisterSigningKey"
+	Msg_RevokeSigningKey_FullMethodName      = "/academictoken.institution.Msg/RevokeSigningKey"
+	Msg_ProposeAccreditation_FullMethodName  = "/academictoken.institution.Msg/ProposeAccreditation"
+	Msg_SetAccreditation_FullMethodName      = "/academictoken.institution.Msg/SetAccreditation"
+	Msg_SetAcademicTerm_FullMethodName       = "/academictoken.institution.Msg/SetAcademicTerm"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +41,14 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	RegisterInstitution(ctx context.Context, in *MsgRegisterInstitution, opts ...grpc.CallOption) (*MsgRegisterInstitutionResponse, error)
 	UpdateInstitution(ctx context.Context, in *MsgUpdateInstitution, opts ...grpc.CallOption) (*MsgUpdateInstitutionResponse, error)
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	ConfigureGradingScale(ctx context.Context, in *MsgConfigureGradingScale, opts ...grpc.CallOption) (*MsgConfigureGradingScaleResponse, error)
+	RegisterSigningKey(ctx context.Context, in *MsgRegisterSigningKey, opts ...grpc.CallOption) (*MsgRegisterSigningKeyResponse, error)
+	RevokeSigningKey(ctx context.Context, in *MsgRevokeSigningKey, opts ...grpc.CallOption) (*MsgRevokeSigningKeyResponse, error)
+	ProposeAccreditation(ctx context.Context, in *MsgProposeAccreditation, opts ...grpc.CallOption) (*MsgProposeAccreditationResponse, error)
+	SetAccreditation(ctx context.Context, in *MsgSetAccreditation, opts ...grpc.CallOption) (*MsgSetAccreditationResponse, error)
+	SetAcademicTerm(ctx context.Context, in *MsgSetAcademicTerm, opts ...grpc.CallOption) (*MsgSetAcademicTermResponse, error)
 }
 
 type msgClient struct {
@@ -70,6 +86,78 @@ func (c *msgClient) UpdateInstitution(ctx context.Context, in *MsgUpdateInstitut
 	return out, nil
 }
 
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfigureGradingScale(ctx context.Context, in *MsgConfigureGradingScale, opts ...grpc.CallOption) (*MsgConfigureGradingScaleResponse, error) {
+	out := new(MsgConfigureGradingScaleResponse)
+	err := c.cc.Invoke(ctx, Msg_ConfigureGradingScale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSigningKey(ctx context.Context, in *MsgRegisterSigningKey, opts ...grpc.CallOption) (*MsgRegisterSigningKeyResponse, error) {
+	out := new(MsgRegisterSigningKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSigningKey(ctx context.Context, in *MsgRevokeSigningKey, opts ...grpc.CallOption) (*MsgRevokeSigningKeyResponse, error) {
+	out := new(MsgRevokeSigningKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeAccreditation(ctx context.Context, in *MsgProposeAccreditation, opts ...grpc.CallOption) (*MsgProposeAccreditationResponse, error) {
+	out := new(MsgProposeAccreditationResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeAccreditation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAccreditation(ctx context.Context, in *MsgSetAccreditation, opts ...grpc.CallOption) (*MsgSetAccreditationResponse, error) {
+	out := new(MsgSetAccreditationResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAccreditation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAcademicTerm(ctx context.Context, in *MsgSetAcademicTerm, opts ...grpc.CallOption) (*MsgSetAcademicTermResponse, error) {
+	out := new(MsgSetAcademicTermResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAcademicTerm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -79,6 +167,14 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	RegisterInstitution(context.Context, *MsgRegisterInstitution) (*MsgRegisterInstitutionResponse, error)
 	UpdateInstitution(context.Context, *MsgUpdateInstitution) (*MsgUpdateInstitutionResponse, error)
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	ConfigureGradingScale(context.Context, *MsgConfigureGradingScale) (*MsgConfigureGradingScaleResponse, error)
+	RegisterSigningKey(context.Context, *MsgRegisterSigningKey) (*MsgRegisterSigningKeyResponse, error)
+	RevokeSigningKey(context.Context, *MsgRevokeSigningKey) (*MsgRevokeSigningKeyResponse, error)
+	ProposeAccreditation(context.Context, *MsgProposeAccreditation) (*MsgProposeAccreditationResponse, error)
+	SetAccreditation(context.Context, *MsgSetAccreditation) (*MsgSetAccreditationResponse, error)
+	SetAcademicTerm(context.Context, *MsgSetAcademicTerm) (*MsgSetAcademicTermResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -95,6 +191,30 @@ func (UnimplementedMsgServer) RegisterInstitution(context.Context, *MsgRegisterI
 func (UnimplementedMsgServer) UpdateInstitution(context.Context, *MsgUpdateInstitution) (*MsgUpdateInstitutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstitution not implemented")
 }
+func (UnimplementedMsgServer) GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedMsgServer) ConfigureGradingScale(context.Context, *MsgConfigureGradingScale) (*MsgConfigureGradingScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureGradingScale not implemented")
+}
+func (UnimplementedMsgServer) RegisterSigningKey(context.Context, *MsgRegisterSigningKey) (*MsgRegisterSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSigningKey not implemented")
+}
+func (UnimplementedMsgServer) RevokeSigningKey(context.Context, *MsgRevokeSigningKey) (*MsgRevokeSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSigningKey not implemented")
+}
+func (UnimplementedMsgServer) ProposeAccreditation(context.Context, *MsgProposeAccreditation) (*MsgProposeAccreditationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAccreditation not implemented")
+}
+func (UnimplementedMsgServer) SetAccreditation(context.Context, *MsgSetAccreditation) (*MsgSetAccreditationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccreditation not implemented")
+}
+func (UnimplementedMsgServer) SetAcademicTerm(context.Context, *MsgSetAcademicTerm) (*MsgSetAcademicTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAcademicTerm not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +282,150 @@ func _Msg_UpdateInstitution_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfigureGradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfigureGradingScale)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfigureGradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConfigureGradingScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfigureGradingScale(ctx, req.(*MsgConfigureGradingScale))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSigningKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSigningKey(ctx, req.(*MsgRegisterSigningKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSigningKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSigningKey(ctx, req.(*MsgRevokeSigningKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAccreditation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAccreditation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAccreditation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeAccreditation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAccreditation(ctx, req.(*MsgProposeAccreditation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAccreditation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccreditation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccreditation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAccreditation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccreditation(ctx, req.(*MsgSetAccreditation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAcademicTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAcademicTerm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAcademicTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAcademicTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAcademicTerm(ctx, req.(*MsgSetAcademicTerm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +445,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstitution",
 			Handler:    _Msg_UpdateInstitution_Handler,
 		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ConfigureGradingScale",
+			Handler:    _Msg_ConfigureGradingScale_Handler,
+		},
+		{
+			MethodName: "RegisterSigningKey",
+			Handler:    _Msg_RegisterSigningKey_Handler,
+		},
+		{
+			MethodName: "RevokeSigningKey",
+			Handler:    _Msg_RevokeSigningKey_Handler,
+		},
+		{
+			MethodName: "ProposeAccreditation",
+			Handler:    _Msg_ProposeAccreditation_Handler,
+		},
+		{
+			MethodName: "SetAccreditation",
+			Handler:    _Msg_SetAccreditation_Handler,
+		},
+		{
+			MethodName: "SetAcademicTerm",
+			Handler:    _Msg_SetAcademicTerm_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/institution/tx.proto",
@@ -26,6 +26,7 @@ var (
 	fd_EquivalenceRequest_equivalenceType      protoreflect.FieldDescriptor
 	fd_EquivalenceRequest_similarityPercentage protoreflect.FieldDescriptor
 	fd_EquivalenceRequest_notes                protoreflect.FieldDescriptor
+	fd_EquivalenceRequest_transferTokenId      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -43,6 +44,7 @@ func init() {
 	fd_EquivalenceRequest_equivalenceType = md_EquivalenceRequest.Fields().ByName("equivalenceType")
 	fd_EquivalenceRequest_similarityPercentage = md_EquivalenceRequest.Fields().ByName("similarityPercentage")
 	fd_EquivalenceRequest_notes = md_EquivalenceRequest.Fields().ByName("notes")
+	fd_EquivalenceRequest_transferTokenId = md_EquivalenceRequest.Fields().ByName("transferTokenId")
 }
 
 var _ protoreflect.Message = (*fastReflection_EquivalenceRequest)(nil)
@@ -182,6 +184,12 @@ func (x *fastReflection_EquivalenceRequest) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.TransferTokenId != "" {
+		value := protoreflect.ValueOfString(x.TransferTokenId)
+		if !f(fd_EquivalenceRequest_transferTokenId, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -221,6 +229,8 @@ func (x *fastReflection_EquivalenceRequest) Has(fd protoreflect.FieldDescriptor)
 		return x.SimilarityPercentage != uint32(0)
 	case "academictoken.student.EquivalenceRequest.notes":
 		return x.Notes != ""
+	case "academictoken.student.EquivalenceRequest.transferTokenId":
+		return x.TransferTokenId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.EquivalenceRequest"))
@@ -261,6 +271,8 @@ func (x *fastReflection_EquivalenceRequest) Clear(fd protoreflect.FieldDescripto
 		x.SimilarityPercentage = uint32(0)
 	case "academictoken.student.EquivalenceRequest.notes":
 		x.Notes = ""
+	case "academictoken.student.EquivalenceRequest.transferTokenId":
+		x.TransferTokenId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.EquivalenceRequest"))
@@ -313,6 +325,9 @@ func (x *fastReflection_EquivalenceRequest) Get(descriptor protoreflect.FieldDes
 	case "academictoken.student.EquivalenceRequest.notes":
 		value := x.Notes
 		return protoreflect.ValueOfString(value)
+	case "academictoken.student.EquivalenceRequest.transferTokenId":
+		value := x.TransferTokenId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.EquivalenceRequest"))
@@ -357,6 +372,8 @@ func (x *fastReflection_EquivalenceRequest) Set(fd protoreflect.FieldDescriptor,
 		x.SimilarityPercentage = uint32(value.Uint())
 	case "academictoken.student.EquivalenceRequest.notes":
 		x.Notes = value.Interface().(string)
+	case "academictoken.student.EquivalenceRequest.transferTokenId":
+		x.TransferTokenId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.EquivalenceRequest"))
@@ -401,6 +418,8 @@ func (x *fastReflection_EquivalenceRequest) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field similarityPercentage of message academictoken.student.EquivalenceRequest is not mutable"))
 	case "academictoken.student.EquivalenceRequest.notes":
 		panic(fmt.Errorf("field notes of message academictoken.student.EquivalenceRequest is not mutable"))
+	case "academictoken.student.EquivalenceRequest.transferTokenId":
+		panic(fmt.Errorf("field transferTokenId of message academictoken.student.EquivalenceRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.EquivalenceRequest"))
@@ -438,6 +457,8 @@ func (x *fastReflection_EquivalenceRequest) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "academictoken.student.EquivalenceRequest.notes":
 		return protoreflect.ValueOfString("")
+	case "academictoken.student.EquivalenceRequest.transferTokenId":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.EquivalenceRequest"))
@@ -554,6 +575,10 @@ func (x *fastReflection_EquivalenceRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.TransferTokenId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -583,6 +608,13 @@ func (x *fastReflection_EquivalenceRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.TransferTokenId) > 0 {
+			i -= len(x.TransferTokenId)
+			copy(dAtA[i:], x.TransferTokenId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TransferTokenId)))
+			i--
+			dAtA[i] = 0x6a
+		}
 		if len(x.Notes) > 0 {
 			i -= len(x.Notes)
 			copy(dAtA[i:], x.Notes)
@@ -1085,6 +1117,38 @@ func (x *fastReflection_EquivalenceRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.Notes = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferTokenId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferTokenId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1150,6 +1214,7 @@ type EquivalenceRequest struct {
 	EquivalenceType      string `protobuf:"bytes,10,opt,name=equivalenceType,proto3" json:"equivalenceType,omitempty"` // "full", "partial", "conditional", "none"
 	SimilarityPercentage uint32 `protobuf:"varint,11,opt,name=similarityPercentage,proto3" json:"similarityPercentage,omitempty"`
 	Notes                string `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"`
+	TransferTokenId      string `protobuf:"bytes,13,opt,name=transferTokenId,proto3" json:"transferTokenId,omitempty"` // transfer-credit token minted on approval, if any
 }
 
 func (x *EquivalenceRequest) Reset() {
@@ -1256,6 +1321,13 @@ func (x *EquivalenceRequest) GetNotes() string {
 	return ""
 }
 
+func (x *EquivalenceRequest) GetTransferTokenId() string {
+	if x != nil {
+		return x.TransferTokenId
+	}
+	return ""
+}
+
 var File_academictoken_student_equivalence_request_proto protoreflect.FileDescriptor
 
 var file_academictoken_student_equivalence_request_proto_rawDesc = []byte{
@@ -1263,7 +1335,7 @@ var file_academictoken_student_equivalence_request_proto_rawDesc = []byte{
 	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65,
 	0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x15, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x12, 0x45, 0x71, 0x75,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0xca, 0x03, 0x0a, 0x12, 0x45, 0x71, 0x75,
 	0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
@@ -1289,21 +1361,24 @@ var file_academictoken_student_equivalence_request_proto_rawDesc = []byte{
 	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
 	0x14, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65,
 	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x42, 0xd2, 0x01, 0x0a, 0x19,
-	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x17, 0x45, 0x71, 0x75, 0x69, 0x76,
-	0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02, 0x03,
-	0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x41, 0x63,
-	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x42, 0x17, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15,
+	0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21,
+	0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -14,15 +14,283 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*Student
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Student)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Student)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(Student)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(Student)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*StudentEnrollment
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StudentEnrollment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StudentEnrollment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(StudentEnrollment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(StudentEnrollment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*StudentAcademicTree
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StudentAcademicTree)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StudentAcademicTree)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(StudentAcademicTree)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(StudentAcademicTree)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*SubjectOffering
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubjectOffering)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubjectOffering)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(SubjectOffering)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(SubjectOffering)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field PendingPromotions as it is not of Message kind"))
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                          protoreflect.MessageDescriptor
+	fd_GenesisState_params                   protoreflect.FieldDescriptor
+	fd_GenesisState_studentList              protoreflect.FieldDescriptor
+	fd_GenesisState_studentCount             protoreflect.FieldDescriptor
+	fd_GenesisState_studentEnrollmentList    protoreflect.FieldDescriptor
+	fd_GenesisState_studentEnrollmentCount   protoreflect.FieldDescriptor
+	fd_GenesisState_studentAcademicTreeList  protoreflect.FieldDescriptor
+	fd_GenesisState_studentAcademicTreeCount protoreflect.FieldDescriptor
+	fd_GenesisState_subjectOfferingList      protoreflect.FieldDescriptor
+	fd_GenesisState_subjectOfferingCount     protoreflect.FieldDescriptor
+	fd_GenesisState_pendingPromotions        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_academictoken_student_genesis_proto_init()
 	md_GenesisState = File_academictoken_student_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_studentList = md_GenesisState.Fields().ByName("studentList")
+	fd_GenesisState_studentCount = md_GenesisState.Fields().ByName("studentCount")
+	fd_GenesisState_studentEnrollmentList = md_GenesisState.Fields().ByName("studentEnrollmentList")
+	fd_GenesisState_studentEnrollmentCount = md_GenesisState.Fields().ByName("studentEnrollmentCount")
+	fd_GenesisState_studentAcademicTreeList = md_GenesisState.Fields().ByName("studentAcademicTreeList")
+	fd_GenesisState_studentAcademicTreeCount = md_GenesisState.Fields().ByName("studentAcademicTreeCount")
+	fd_GenesisState_subjectOfferingList = md_GenesisState.Fields().ByName("subjectOfferingList")
+	fd_GenesisState_subjectOfferingCount = md_GenesisState.Fields().ByName("subjectOfferingCount")
+	fd_GenesisState_pendingPromotions = md_GenesisState.Fields().ByName("pendingPromotions")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,6 +364,60 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.StudentList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.StudentList})
+		if !f(fd_GenesisState_studentList, value) {
+			return
+		}
+	}
+	if x.StudentCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StudentCount)
+		if !f(fd_GenesisState_studentCount, value) {
+			return
+		}
+	}
+	if len(x.StudentEnrollmentList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.StudentEnrollmentList})
+		if !f(fd_GenesisState_studentEnrollmentList, value) {
+			return
+		}
+	}
+	if x.StudentEnrollmentCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StudentEnrollmentCount)
+		if !f(fd_GenesisState_studentEnrollmentCount, value) {
+			return
+		}
+	}
+	if len(x.StudentAcademicTreeList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.StudentAcademicTreeList})
+		if !f(fd_GenesisState_studentAcademicTreeList, value) {
+			return
+		}
+	}
+	if x.StudentAcademicTreeCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StudentAcademicTreeCount)
+		if !f(fd_GenesisState_studentAcademicTreeCount, value) {
+			return
+		}
+	}
+	if len(x.SubjectOfferingList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.SubjectOfferingList})
+		if !f(fd_GenesisState_subjectOfferingList, value) {
+			return
+		}
+	}
+	if x.SubjectOfferingCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubjectOfferingCount)
+		if !f(fd_GenesisState_subjectOfferingCount, value) {
+			return
+		}
+	}
+	if len(x.PendingPromotions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.PendingPromotions})
+		if !f(fd_GenesisState_pendingPromotions, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +435,24 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "academictoken.student.GenesisState.params":
 		return x.Params != nil
+	case "academictoken.student.GenesisState.studentList":
+		return len(x.StudentList) != 0
+	case "academictoken.student.GenesisState.studentCount":
+		return x.StudentCount != uint64(0)
+	case "academictoken.student.GenesisState.studentEnrollmentList":
+		return len(x.StudentEnrollmentList) != 0
+	case "academictoken.student.GenesisState.studentEnrollmentCount":
+		return x.StudentEnrollmentCount != uint64(0)
+	case "academictoken.student.GenesisState.studentAcademicTreeList":
+		return len(x.StudentAcademicTreeList) != 0
+	case "academictoken.student.GenesisState.studentAcademicTreeCount":
+		return x.StudentAcademicTreeCount != uint64(0)
+	case "academictoken.student.GenesisState.subjectOfferingList":
+		return len(x.SubjectOfferingList) != 0
+	case "academictoken.student.GenesisState.subjectOfferingCount":
+		return x.SubjectOfferingCount != uint64(0)
+	case "academictoken.student.GenesisState.pendingPromotions":
+		return len(x.PendingPromotions) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.GenesisState"))
@@ -131,6 +471,24 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "academictoken.student.GenesisState.params":
 		x.Params = nil
+	case "academictoken.student.GenesisState.studentList":
+		x.StudentList = nil
+	case "academictoken.student.GenesisState.studentCount":
+		x.StudentCount = uint64(0)
+	case "academictoken.student.GenesisState.studentEnrollmentList":
+		x.StudentEnrollmentList = nil
+	case "academictoken.student.GenesisState.studentEnrollmentCount":
+		x.StudentEnrollmentCount = uint64(0)
+	case "academictoken.student.GenesisState.studentAcademicTreeList":
+		x.StudentAcademicTreeList = nil
+	case "academictoken.student.GenesisState.studentAcademicTreeCount":
+		x.StudentAcademicTreeCount = uint64(0)
+	case "academictoken.student.GenesisState.subjectOfferingList":
+		x.SubjectOfferingList = nil
+	case "academictoken.student.GenesisState.subjectOfferingCount":
+		x.SubjectOfferingCount = uint64(0)
+	case "academictoken.student.GenesisState.pendingPromotions":
+		x.PendingPromotions = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.GenesisState"))
@@ -150,6 +508,48 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "academictoken.student.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "academictoken.student.GenesisState.studentList":
+		if len(x.StudentList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.StudentList}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.GenesisState.studentCount":
+		value := x.StudentCount
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.GenesisState.studentEnrollmentList":
+		if len(x.StudentEnrollmentList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.StudentEnrollmentList}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.GenesisState.studentEnrollmentCount":
+		value := x.StudentEnrollmentCount
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.GenesisState.studentAcademicTreeList":
+		if len(x.StudentAcademicTreeList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.StudentAcademicTreeList}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.GenesisState.studentAcademicTreeCount":
+		value := x.StudentAcademicTreeCount
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.GenesisState.subjectOfferingList":
+		if len(x.SubjectOfferingList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.SubjectOfferingList}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.GenesisState.subjectOfferingCount":
+		value := x.SubjectOfferingCount
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.GenesisState.pendingPromotions":
+		if len(x.PendingPromotions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.PendingPromotions}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.GenesisState"))
@@ -172,6 +572,34 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "academictoken.student.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "academictoken.student.GenesisState.studentList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.StudentList = *clv.list
+	case "academictoken.student.GenesisState.studentCount":
+		x.StudentCount = value.Uint()
+	case "academictoken.student.GenesisState.studentEnrollmentList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.StudentEnrollmentList = *clv.list
+	case "academictoken.student.GenesisState.studentEnrollmentCount":
+		x.StudentEnrollmentCount = value.Uint()
+	case "academictoken.student.GenesisState.studentAcademicTreeList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.StudentAcademicTreeList = *clv.list
+	case "academictoken.student.GenesisState.studentAcademicTreeCount":
+		x.StudentAcademicTreeCount = value.Uint()
+	case "academictoken.student.GenesisState.subjectOfferingList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.SubjectOfferingList = *clv.list
+	case "academictoken.student.GenesisState.subjectOfferingCount":
+		x.SubjectOfferingCount = value.Uint()
+	case "academictoken.student.GenesisState.pendingPromotions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.PendingPromotions = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.GenesisState"))
@@ -197,6 +625,44 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "academictoken.student.GenesisState.studentList":
+		if x.StudentList == nil {
+			x.StudentList = []*Student{}
+		}
+		value := &_GenesisState_2_list{list: &x.StudentList}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.GenesisState.studentEnrollmentList":
+		if x.StudentEnrollmentList == nil {
+			x.StudentEnrollmentList = []*StudentEnrollment{}
+		}
+		value := &_GenesisState_4_list{list: &x.StudentEnrollmentList}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.GenesisState.studentAcademicTreeList":
+		if x.StudentAcademicTreeList == nil {
+			x.StudentAcademicTreeList = []*StudentAcademicTree{}
+		}
+		value := &_GenesisState_6_list{list: &x.StudentAcademicTreeList}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.GenesisState.subjectOfferingList":
+		if x.SubjectOfferingList == nil {
+			x.SubjectOfferingList = []*SubjectOffering{}
+		}
+		value := &_GenesisState_8_list{list: &x.SubjectOfferingList}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.GenesisState.pendingPromotions":
+		if x.PendingPromotions == nil {
+			x.PendingPromotions = []string{}
+		}
+		value := &_GenesisState_10_list{list: &x.PendingPromotions}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.GenesisState.studentCount":
+		panic(fmt.Errorf("field studentCount of message academictoken.student.GenesisState is not mutable"))
+	case "academictoken.student.GenesisState.studentEnrollmentCount":
+		panic(fmt.Errorf("field studentEnrollmentCount of message academictoken.student.GenesisState is not mutable"))
+	case "academictoken.student.GenesisState.studentAcademicTreeCount":
+		panic(fmt.Errorf("field studentAcademicTreeCount of message academictoken.student.GenesisState is not mutable"))
+	case "academictoken.student.GenesisState.subjectOfferingCount":
+		panic(fmt.Errorf("field subjectOfferingCount of message academictoken.student.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.GenesisState"))
@@ -213,6 +679,29 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "academictoken.student.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "academictoken.student.GenesisState.studentList":
+		list := []*Student{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "academictoken.student.GenesisState.studentCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.GenesisState.studentEnrollmentList":
+		list := []*StudentEnrollment{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "academictoken.student.GenesisState.studentEnrollmentCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.GenesisState.studentAcademicTreeList":
+		list := []*StudentAcademicTree{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "academictoken.student.GenesisState.studentAcademicTreeCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.GenesisState.subjectOfferingList":
+		list := []*SubjectOffering{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "academictoken.student.GenesisState.subjectOfferingCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.GenesisState.pendingPromotions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.GenesisState"))
@@ -286,6 +775,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.StudentList) > 0 {
+			for _, e := range x.StudentList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StudentCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.StudentCount))
+		}
+		if len(x.StudentEnrollmentList) > 0 {
+			for _, e := range x.StudentEnrollmentList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StudentEnrollmentCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.StudentEnrollmentCount))
+		}
+		if len(x.StudentAcademicTreeList) > 0 {
+			for _, e := range x.StudentAcademicTreeList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StudentAcademicTreeCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.StudentAcademicTreeCount))
+		}
+		if len(x.SubjectOfferingList) > 0 {
+			for _, e := range x.SubjectOfferingList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SubjectOfferingCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubjectOfferingCount))
+		}
+		if len(x.PendingPromotions) > 0 {
+			for _, s := range x.PendingPromotions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,6 +846,99 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PendingPromotions) > 0 {
+			for iNdEx := len(x.PendingPromotions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PendingPromotions[iNdEx])
+				copy(dAtA[i:], x.PendingPromotions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingPromotions[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.SubjectOfferingCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubjectOfferingCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.SubjectOfferingList) > 0 {
+			for iNdEx := len(x.SubjectOfferingList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SubjectOfferingList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.StudentAcademicTreeCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StudentAcademicTreeCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.StudentAcademicTreeList) > 0 {
+			for iNdEx := len(x.StudentAcademicTreeList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StudentAcademicTreeList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.StudentEnrollmentCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StudentEnrollmentCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.StudentEnrollmentList) > 0 {
+			for iNdEx := len(x.StudentEnrollmentList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StudentEnrollmentList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.StudentCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StudentCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.StudentList) > 0 {
+			for iNdEx := len(x.StudentList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StudentList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -414,6 +1038,250 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StudentList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StudentList = append(x.StudentList, &Student{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StudentList[len(x.StudentList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StudentCount", wireType)
+				}
+				x.StudentCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StudentCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StudentEnrollmentList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StudentEnrollmentList = append(x.StudentEnrollmentList, &StudentEnrollment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StudentEnrollmentList[len(x.StudentEnrollmentList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StudentEnrollmentCount", wireType)
+				}
+				x.StudentEnrollmentCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StudentEnrollmentCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StudentAcademicTreeList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StudentAcademicTreeList = append(x.StudentAcademicTreeList, &StudentAcademicTree{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StudentAcademicTreeList[len(x.StudentAcademicTreeList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StudentAcademicTreeCount", wireType)
+				}
+				x.StudentAcademicTreeCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StudentAcademicTreeCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectOfferingList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubjectOfferingList = append(x.SubjectOfferingList, &SubjectOffering{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubjectOfferingList[len(x.SubjectOfferingList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectOfferingCount", wireType)
+				}
+				x.SubjectOfferingCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubjectOfferingCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingPromotions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingPromotions = append(x.PendingPromotions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -469,7 +1337,16 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Params                   *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	StudentList              []*Student             `protobuf:"bytes,2,rep,name=studentList,proto3" json:"studentList,omitempty"`
+	StudentCount             uint64                 `protobuf:"varint,3,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	StudentEnrollmentList    []*StudentEnrollment   `protobuf:"bytes,4,rep,name=studentEnrollmentList,proto3" json:"studentEnrollmentList,omitempty"`
+	StudentEnrollmentCount   uint64                 `protobuf:"varint,5,opt,name=studentEnrollmentCount,proto3" json:"studentEnrollmentCount,omitempty"`
+	StudentAcademicTreeList  []*StudentAcademicTree `protobuf:"bytes,6,rep,name=studentAcademicTreeList,proto3" json:"studentAcademicTreeList,omitempty"`
+	StudentAcademicTreeCount uint64                 `protobuf:"varint,7,opt,name=studentAcademicTreeCount,proto3" json:"studentAcademicTreeCount,omitempty"`
+	SubjectOfferingList      []*SubjectOffering     `protobuf:"bytes,8,rep,name=subjectOfferingList,proto3" json:"subjectOfferingList,omitempty"`
+	SubjectOfferingCount     uint64                 `protobuf:"varint,9,opt,name=subjectOfferingCount,proto3" json:"subjectOfferingCount,omitempty"`
+	PendingPromotions        []string               `protobuf:"bytes,10,rep,name=pendingPromotions,proto3" json:"pendingPromotions,omitempty"` // offerings with seats freed since the last block
 }
 
 func (x *GenesisState) Reset() {
@@ -499,6 +1376,69 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetStudentList() []*Student {
+	if x != nil {
+		return x.StudentList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStudentCount() uint64 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetStudentEnrollmentList() []*StudentEnrollment {
+	if x != nil {
+		return x.StudentEnrollmentList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStudentEnrollmentCount() uint64 {
+	if x != nil {
+		return x.StudentEnrollmentCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetStudentAcademicTreeList() []*StudentAcademicTree {
+	if x != nil {
+		return x.StudentAcademicTreeList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStudentAcademicTreeCount() uint64 {
+	if x != nil {
+		return x.StudentAcademicTreeCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSubjectOfferingList() []*SubjectOffering {
+	if x != nil {
+		return x.SubjectOfferingList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSubjectOfferingCount() uint64 {
+	if x != nil {
+		return x.SubjectOfferingCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetPendingPromotions() []string {
+	if x != nil {
+		return x.PendingPromotions
+	}
+	return nil
+}
+
 var File_academictoken_student_genesis_proto protoreflect.FileDescriptor
 
 var file_academictoken_student_genesis_proto_rawDesc = []byte{
@@ -510,25 +1450,76 @@ var file_academictoken_student_genesis_proto_rawDesc = []byte{
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc7, 0x01, 0x0a, 0x19,
-	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0xca, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x2c, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xc4, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x40, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x64,
+	0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x6a, 0x0a, 0x17,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x54,
+	0x72, 0x65, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x54, 0x72, 0x65, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x17, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x54, 0x72, 0x65, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x18, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x54, 0x72, 0x65, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x54, 0x72, 0x65, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x13, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x14, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0xc7, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02, 0x03,
+	0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x41, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -545,16 +1536,24 @@ func file_academictoken_student_genesis_proto_rawDescGZIP() []byte {
 
 var file_academictoken_student_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_academictoken_student_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: academictoken.student.GenesisState
-	(*Params)(nil),       // 1: academictoken.student.Params
+	(*GenesisState)(nil),        // 0: academictoken.student.GenesisState
+	(*Params)(nil),              // 1: academictoken.student.Params
+	(*Student)(nil),             // 2: academictoken.student.Student
+	(*StudentEnrollment)(nil),   // 3: academictoken.student.StudentEnrollment
+	(*StudentAcademicTree)(nil), // 4: academictoken.student.StudentAcademicTree
+	(*SubjectOffering)(nil),     // 5: academictoken.student.SubjectOffering
 }
 var file_academictoken_student_genesis_proto_depIdxs = []int32{
 	1, // 0: academictoken.student.GenesisState.params:type_name -> academictoken.student.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: academictoken.student.GenesisState.studentList:type_name -> academictoken.student.Student
+	3, // 2: academictoken.student.GenesisState.studentEnrollmentList:type_name -> academictoken.student.StudentEnrollment
+	4, // 3: academictoken.student.GenesisState.studentAcademicTreeList:type_name -> academictoken.student.StudentAcademicTree
+	5, // 4: academictoken.student.GenesisState.subjectOfferingList:type_name -> academictoken.student.SubjectOffering
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_academictoken_student_genesis_proto_init() }
@@ -563,6 +1562,10 @@ func file_academictoken_student_genesis_proto_init() {
 		return
 	}
 	file_academictoken_student_params_proto_init()
+	file_academictoken_student_student_proto_init()
+	file_academictoken_student_student_enrollment_proto_init()
+	file_academictoken_student_student_academic_tree_proto_init()
+	file_academictoken_student_subject_offering_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_academictoken_student_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -24,6 +24,8 @@ var (
 	fd_Params_academic_progress_contract_addr protoreflect.FieldDescriptor
 	fd_Params_degree_contract_addr            protoreflect.FieldDescriptor
 	fd_Params_nft_minting_contract_addr       protoreflect.FieldDescriptor
+	fd_Params_integration_mode                protoreflect.FieldDescriptor
+	fd_Params_mint_transfer_credit_tokens     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -37,6 +39,8 @@ func init() {
 	fd_Params_academic_progress_contract_addr = md_Params.Fields().ByName("academic_progress_contract_addr")
 	fd_Params_degree_contract_addr = md_Params.Fields().ByName("degree_contract_addr")
 	fd_Params_nft_minting_contract_addr = md_Params.Fields().ByName("nft_minting_contract_addr")
+	fd_Params_integration_mode = md_Params.Fields().ByName("integration_mode")
+	fd_Params_mint_transfer_credit_tokens = md_Params.Fields().ByName("mint_transfer_credit_tokens")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -152,6 +156,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.IntegrationMode != "" {
+		value := protoreflect.ValueOfString(x.IntegrationMode)
+		if !f(fd_Params_integration_mode, value) {
+			return
+		}
+	}
+	if x.MintTransferCreditTokens != false {
+		value := protoreflect.ValueOfBool(x.MintTransferCreditTokens)
+		if !f(fd_Params_mint_transfer_credit_tokens, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -183,6 +199,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DegreeContractAddr != ""
 	case "academictoken.student.Params.nft_minting_contract_addr":
 		return x.NftMintingContractAddr != ""
+	case "academictoken.student.Params.integration_mode":
+		return x.IntegrationMode != ""
+	case "academictoken.student.Params.mint_transfer_credit_tokens":
+		return x.MintTransferCreditTokens != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.Params"))
@@ -215,6 +235,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.DegreeContractAddr = ""
 	case "academictoken.student.Params.nft_minting_contract_addr":
 		x.NftMintingContractAddr = ""
+	case "academictoken.student.Params.integration_mode":
+		x.IntegrationMode = ""
+	case "academictoken.student.Params.mint_transfer_credit_tokens":
+		x.MintTransferCreditTokens = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.Params"))
@@ -255,6 +279,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "academictoken.student.Params.nft_minting_contract_addr":
 		value := x.NftMintingContractAddr
 		return protoreflect.ValueOfString(value)
+	case "academictoken.student.Params.integration_mode":
+		value := x.IntegrationMode
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.Params.mint_transfer_credit_tokens":
+		value := x.MintTransferCreditTokens
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.Params"))
@@ -291,6 +321,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.DegreeContractAddr = value.Interface().(string)
 	case "academictoken.student.Params.nft_minting_contract_addr":
 		x.NftMintingContractAddr = value.Interface().(string)
+	case "academictoken.student.Params.integration_mode":
+		x.IntegrationMode = value.Interface().(string)
+	case "academictoken.student.Params.mint_transfer_credit_tokens":
+		x.MintTransferCreditTokens = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.Params"))
@@ -327,6 +361,10 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field degree_contract_addr of message academictoken.student.Params is not mutable"))
 	case "academictoken.student.Params.nft_minting_contract_addr":
 		panic(fmt.Errorf("field nft_minting_contract_addr of message academictoken.student.Params is not mutable"))
+	case "academictoken.student.Params.integration_mode":
+		panic(fmt.Errorf("field integration_mode of message academictoken.student.Params is not mutable"))
+	case "academictoken.student.Params.mint_transfer_credit_tokens":
+		panic(fmt.Errorf("field mint_transfer_credit_tokens of message academictoken.student.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.Params"))
@@ -356,6 +394,10 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "academictoken.student.Params.nft_minting_contract_addr":
 		return protoreflect.ValueOfString("")
+	case "academictoken.student.Params.integration_mode":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.Params.mint_transfer_credit_tokens":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.Params"))
@@ -456,6 +498,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.IntegrationMode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MintTransferCreditTokens {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -485,6 +534,23 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MintTransferCreditTokens {
+			i--
+			if x.MintTransferCreditTokens {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.IntegrationMode) > 0 {
+			i -= len(x.IntegrationMode)
+			copy(dAtA[i:], x.IntegrationMode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IntegrationMode)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.NftMintingContractAddr) > 0 {
 			i -= len(x.NftMintingContractAddr)
 			copy(dAtA[i:], x.NftMintingContractAddr)
@@ -837,6 +903,58 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.NftMintingContractAddr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntegrationMode", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IntegrationMode = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintTransferCreditTokens", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.MintTransferCreditTokens = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -899,6 +1017,12 @@ type Params struct {
 	AcademicProgressContractAddr string `protobuf:"bytes,6,opt,name=academic_progress_contract_addr,json=academicProgressContractAddr,proto3" json:"academic_progress_contract_addr,omitempty"`
 	DegreeContractAddr           string `protobuf:"bytes,7,opt,name=degree_contract_addr,json=degreeContractAddr,proto3" json:"degree_contract_addr,omitempty"`
 	NftMintingContractAddr       string `protobuf:"bytes,8,opt,name=nft_minting_contract_addr,json=nftMintingContractAddr,proto3" json:"nft_minting_contract_addr,omitempty"`
+	// integration_mode selects how academic progress, graduation and NFT
+	// authorization are evaluated: "contract", "native" or "disabled"
+	IntegrationMode string `protobuf:"bytes,9,opt,name=integration_mode,json=integrationMode,proto3" json:"integration_mode,omitempty"`
+	// mint_transfer_credit_tokens issues a token marked as transfer credit for
+	// each subject credited through an approved equivalence
+	MintTransferCreditTokens bool `protobuf:"varint,10,opt,name=mint_transfer_credit_tokens,json=mintTransferCreditTokens,proto3" json:"mint_transfer_credit_tokens,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -977,6 +1101,20 @@ func (x *Params) GetNftMintingContractAddr() string {
 	return ""
 }
 
+func (x *Params) GetIntegrationMode() string {
+	if x != nil {
+		return x.IntegrationMode
+	}
+	return ""
+}
+
+func (x *Params) GetMintTransferCreditTokens() bool {
+	if x != nil {
+		return x.MintTransferCreditTokens
+	}
+	return false
+}
+
 var File_academictoken_student_params_proto protoreflect.FileDescriptor
 
 var file_academictoken_student_params_proto_rawDesc = []byte{
@@ -986,7 +1124,7 @@ var file_academictoken_student_params_proto_rawDesc = []byte{
 	0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x61, 0x6d, 0x69,
 	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
 	0x3a, 0x0a, 0x0c, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x22, 0x52, 0x0b,
@@ -1028,22 +1166,33 @@ var file_academictoken_student_params_proto_rawDesc = []byte{
 	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
 	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x22, 0x52, 0x16, 0x6e, 0x66, 0x74, 0x4d, 0x69, 0x6e,
 	0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x3a, 0x27, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x78, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc6, 0x01, 0x0a, 0x19, 0x63, 0x6f,
-	0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2,
-	0x02, 0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15,
-	0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x46, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x65, 0x0a, 0x1b, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x26, 0xf2,
+	0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x22, 0x52, 0x18, 0x6d, 0x69, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x3a,
+	0x27, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x78, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc6, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02,
+	0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
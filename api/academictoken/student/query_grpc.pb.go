@@ -30,6 +30,10 @@ const (
 	Query_GetStudentsByCourse_FullMethodName        = "/academictoken.student.Query/GetStudentsByCourse"
 	Query_GetStudentAcademicTree_FullMethodName     = "/academictoken.student.Query/GetStudentAcademicTree"
 	Query_CheckGraduationEligibility_FullMethodName = "/academictoken.student.Query/CheckGraduationEligibility"
+	Query_IntegrationMode_FullMethodName            = "/academictoken.student.Query/IntegrationMode"
+	Query_Transcript_FullMethodName                 = "/academictoken.student.Query/Transcript"
+	Query_SubjectOffering_FullMethodName            = "/academictoken.student.Query/SubjectOffering"
+	Query_SubjectOfferings_FullMethodName           = "/academictoken.student.Query/SubjectOfferings"
 )
 
 // QueryClient is the client API for Query service.
@@ -58,6 +62,16 @@ type QueryClient interface {
 	GetStudentAcademicTree(ctx context.Context, in *QueryGetStudentAcademicTreeRequest, opts ...grpc.CallOption) (*QueryGetStudentAcademicTreeResponse, error)
 	// CheckGraduationEligibility checks if a student is eligible for graduation
 	CheckGraduationEligibility(ctx context.Context, in *QueryCheckGraduationEligibilityRequest, opts ...grpc.CallOption) (*QueryCheckGraduationEligibilityResponse, error)
+	// IntegrationMode reports how the module evaluates academic progress and
+	// which contracts it is configured to call
+	IntegrationMode(ctx context.Context, in *QueryIntegrationModeRequest, opts ...grpc.CallOption) (*QueryIntegrationModeResponse, error)
+	// Transcript aggregates the subjects completed by a student per semester
+	// with their grades, credits and GPA
+	Transcript(ctx context.Context, in *QueryTranscriptRequest, opts ...grpc.CallOption) (*QueryTranscriptResponse, error)
+	// SubjectOffering returns an offering with its enrolled students and waitlist
+	SubjectOffering(ctx context.Context, in *QuerySubjectOfferingRequest, opts ...grpc.CallOption) (*QuerySubjectOfferingResponse, error)
+	// SubjectOfferings lists the offerings, optionally of a subject or a term
+	SubjectOfferings(ctx context.Context, in *QuerySubjectOfferingsRequest, opts ...grpc.CallOption) (*QuerySubjectOfferingsResponse, error)
 }
 
 type queryClient struct {
@@ -167,6 +181,42 @@ func (c *queryClient) CheckGraduationEligibility(ctx context.Context, in *QueryC
 	return out, nil
 }
 
+func (c *queryClient) IntegrationMode(ctx context.Context, in *QueryIntegrationModeRequest, opts ...grpc.CallOption) (*QueryIntegrationModeResponse, error) {
+	out := new(QueryIntegrationModeResponse)
+	err := c.cc.Invoke(ctx, Query_IntegrationMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Transcript(ctx context.Context, in *QueryTranscriptRequest, opts ...grpc.CallOption) (*QueryTranscriptResponse, error) {
+	out := new(QueryTranscriptResponse)
+	err := c.cc.Invoke(ctx, Query_Transcript_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubjectOffering(ctx context.Context, in *QuerySubjectOfferingRequest, opts ...grpc.CallOption) (*QuerySubjectOfferingResponse, error) {
+	out := new(QuerySubjectOfferingResponse)
+	err := c.cc.Invoke(ctx, Query_SubjectOffering_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubjectOfferings(ctx context.Context, in *QuerySubjectOfferingsRequest, opts ...grpc.CallOption) (*QuerySubjectOfferingsResponse, error) {
+	out := new(QuerySubjectOfferingsResponse)
+	err := c.cc.Invoke(ctx, Query_SubjectOfferings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -193,6 +243,16 @@ type QueryServer interface {
 	GetStudentAcademicTree(context.Context, *QueryGetStudentAcademicTreeRequest) (*QueryGetStudentAcademicTreeResponse, error)
 	// CheckGraduationEligibility checks if a student is eligible for graduation
 	CheckGraduationEligibility(context.Context, *QueryCheckGraduationEligibilityRequest) (*QueryCheckGraduationEligibilityResponse, error)
+	// IntegrationMode reports how the module evaluates academic progress and
+	// which contracts it is configured to call
+	IntegrationMode(context.Context, *QueryIntegrationModeRequest) (*QueryIntegrationModeResponse, error)
+	// Transcript aggregates the subjects completed by a student per semester
+	// with their grades, credits and GPA
+	Transcript(context.Context, *QueryTranscriptRequest) (*QueryTranscriptResponse, error)
+	// SubjectOffering returns an offering with its enrolled students and waitlist
+	SubjectOffering(context.Context, *QuerySubjectOfferingRequest) (*QuerySubjectOfferingResponse, error)
+	// SubjectOfferings lists the offerings, optionally of a subject or a term
+	SubjectOfferings(context.Context, *QuerySubjectOfferingsRequest) (*QuerySubjectOfferingsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -233,6 +293,18 @@ func (UnimplementedQueryServer) GetStudentAcademicTree(context.Context, *QueryGe
 func (UnimplementedQueryServer) CheckGraduationEligibility(context.Context, *QueryCheckGraduationEligibilityRequest) (*QueryCheckGraduationEligibilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckGraduationEligibility not implemented")
 }
+func (UnimplementedQueryServer) IntegrationMode(context.Context, *QueryIntegrationModeRequest) (*QueryIntegrationModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrationMode not implemented")
+}
+func (UnimplementedQueryServer) Transcript(context.Context, *QueryTranscriptRequest) (*QueryTranscriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transcript not implemented")
+}
+func (UnimplementedQueryServer) SubjectOffering(context.Context, *QuerySubjectOfferingRequest) (*QuerySubjectOfferingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubjectOffering not implemented")
+}
+func (UnimplementedQueryServer) SubjectOfferings(context.Context, *QuerySubjectOfferingsRequest) (*QuerySubjectOfferingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubjectOfferings not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -444,6 +516,78 @@ func _Query_CheckGraduationEligibility_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IntegrationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegrationModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegrationMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IntegrationMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegrationMode(ctx, req.(*QueryIntegrationModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Transcript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Transcript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Transcript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Transcript(ctx, req.(*QueryTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubjectOffering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubjectOfferingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubjectOffering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SubjectOffering_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubjectOffering(ctx, req.(*QuerySubjectOfferingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubjectOfferings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubjectOfferingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubjectOfferings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SubjectOfferings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubjectOfferings(ctx, req.(*QuerySubjectOfferingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -495,6 +639,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckGraduationEligibility",
 			Handler:    _Query_CheckGraduationEligibility_Handler,
 		},
+		{
+			MethodName: "IntegrationMode",
+			Handler:    _Query_IntegrationMode_Handler,
+		},
+		{
+			MethodName: "Transcript",
+			Handler:    _Query_Transcript_Handler,
+		},
+		{
+			MethodName: "SubjectOffering",
+			Handler:    _Query_SubjectOffering_Handler,
+		},
+		{
+			MethodName: "SubjectOfferings",
+			Handler:    _Query_SubjectOfferings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/student/query.proto",
@@ -558,6 +558,2418 @@ func (x *fastReflection_ElectiveCompletionGroup) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var (
+	md_CurriculumMigration             protoreflect.MessageDescriptor
+	fd_CurriculumMigration_id          protoreflect.FieldDescriptor
+	fd_CurriculumMigration_fromVersion protoreflect.FieldDescriptor
+	fd_CurriculumMigration_toVersion   protoreflect.FieldDescriptor
+	fd_CurriculumMigration_reason      protoreflect.FieldDescriptor
+	fd_CurriculumMigration_requestedBy protoreflect.FieldDescriptor
+	fd_CurriculumMigration_requestedAt protoreflect.FieldDescriptor
+	fd_CurriculumMigration_status      protoreflect.FieldDescriptor
+	fd_CurriculumMigration_reviewedBy  protoreflect.FieldDescriptor
+	fd_CurriculumMigration_reviewedAt  protoreflect.FieldDescriptor
+	fd_CurriculumMigration_reviewNote  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_student_student_academic_tree_proto_init()
+	md_CurriculumMigration = File_academictoken_student_student_academic_tree_proto.Messages().ByName("CurriculumMigration")
+	fd_CurriculumMigration_id = md_CurriculumMigration.Fields().ByName("id")
+	fd_CurriculumMigration_fromVersion = md_CurriculumMigration.Fields().ByName("fromVersion")
+	fd_CurriculumMigration_toVersion = md_CurriculumMigration.Fields().ByName("toVersion")
+	fd_CurriculumMigration_reason = md_CurriculumMigration.Fields().ByName("reason")
+	fd_CurriculumMigration_requestedBy = md_CurriculumMigration.Fields().ByName("requestedBy")
+	fd_CurriculumMigration_requestedAt = md_CurriculumMigration.Fields().ByName("requestedAt")
+	fd_CurriculumMigration_status = md_CurriculumMigration.Fields().ByName("status")
+	fd_CurriculumMigration_reviewedBy = md_CurriculumMigration.Fields().ByName("reviewedBy")
+	fd_CurriculumMigration_reviewedAt = md_CurriculumMigration.Fields().ByName("reviewedAt")
+	fd_CurriculumMigration_reviewNote = md_CurriculumMigration.Fields().ByName("reviewNote")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurriculumMigration)(nil)
+
+type fastReflection_CurriculumMigration CurriculumMigration
+
+func (x *CurriculumMigration) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurriculumMigration)(x)
+}
+
+func (x *CurriculumMigration) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurriculumMigration_messageType fastReflection_CurriculumMigration_messageType
+var _ protoreflect.MessageType = fastReflection_CurriculumMigration_messageType{}
+
+type fastReflection_CurriculumMigration_messageType struct{}
+
+func (x fastReflection_CurriculumMigration_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurriculumMigration)(nil)
+}
+func (x fastReflection_CurriculumMigration_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurriculumMigration)
+}
+func (x fastReflection_CurriculumMigration_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurriculumMigration
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurriculumMigration) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurriculumMigration
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurriculumMigration) Type() protoreflect.MessageType {
+	return _fastReflection_CurriculumMigration_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurriculumMigration) New() protoreflect.Message {
+	return new(fastReflection_CurriculumMigration)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurriculumMigration) Interface() protoreflect.ProtoMessage {
+	return (*CurriculumMigration)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurriculumMigration) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_CurriculumMigration_id, value) {
+			return
+		}
+	}
+	if x.FromVersion != "" {
+		value := protoreflect.ValueOfString(x.FromVersion)
+		if !f(fd_CurriculumMigration_fromVersion, value) {
+			return
+		}
+	}
+	if x.ToVersion != "" {
+		value := protoreflect.ValueOfString(x.ToVersion)
+		if !f(fd_CurriculumMigration_toVersion, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_CurriculumMigration_reason, value) {
+			return
+		}
+	}
+	if x.RequestedBy != "" {
+		value := protoreflect.ValueOfString(x.RequestedBy)
+		if !f(fd_CurriculumMigration_requestedBy, value) {
+			return
+		}
+	}
+	if x.RequestedAt != "" {
+		value := protoreflect.ValueOfString(x.RequestedAt)
+		if !f(fd_CurriculumMigration_requestedAt, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_CurriculumMigration_status, value) {
+			return
+		}
+	}
+	if x.ReviewedBy != "" {
+		value := protoreflect.ValueOfString(x.ReviewedBy)
+		if !f(fd_CurriculumMigration_reviewedBy, value) {
+			return
+		}
+	}
+	if x.ReviewedAt != "" {
+		value := protoreflect.ValueOfString(x.ReviewedAt)
+		if !f(fd_CurriculumMigration_reviewedAt, value) {
+			return
+		}
+	}
+	if x.ReviewNote != "" {
+		value := protoreflect.ValueOfString(x.ReviewNote)
+		if !f(fd_CurriculumMigration_reviewNote, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurriculumMigration) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.student.CurriculumMigration.id":
+		return x.Id != ""
+	case "academictoken.student.CurriculumMigration.fromVersion":
+		return x.FromVersion != ""
+	case "academictoken.student.CurriculumMigration.toVersion":
+		return x.ToVersion != ""
+	case "academictoken.student.CurriculumMigration.reason":
+		return x.Reason != ""
+	case "academictoken.student.CurriculumMigration.requestedBy":
+		return x.RequestedBy != ""
+	case "academictoken.student.CurriculumMigration.requestedAt":
+		return x.RequestedAt != ""
+	case "academictoken.student.CurriculumMigration.status":
+		return x.Status != ""
+	case "academictoken.student.CurriculumMigration.reviewedBy":
+		return x.ReviewedBy != ""
+	case "academictoken.student.CurriculumMigration.reviewedAt":
+		return x.ReviewedAt != ""
+	case "academictoken.student.CurriculumMigration.reviewNote":
+		return x.ReviewNote != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.CurriculumMigration"))
+		}
+		panic(fmt.Errorf("message academictoken.student.CurriculumMigration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurriculumMigration) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.student.CurriculumMigration.id":
+		x.Id = ""
+	case "academictoken.student.CurriculumMigration.fromVersion":
+		x.FromVersion = ""
+	case "academictoken.student.CurriculumMigration.toVersion":
+		x.ToVersion = ""
+	case "academictoken.student.CurriculumMigration.reason":
+		x.Reason = ""
+	case "academictoken.student.CurriculumMigration.requestedBy":
+		x.RequestedBy = ""
+	case "academictoken.student.CurriculumMigration.requestedAt":
+		x.RequestedAt = ""
+	case "academictoken.student.CurriculumMigration.status":
+		x.Status = ""
+	case "academictoken.student.CurriculumMigration.reviewedBy":
+		x.ReviewedBy = ""
+	case "academictoken.student.CurriculumMigration.reviewedAt":
+		x.ReviewedAt = ""
+	case "academictoken.student.CurriculumMigration.reviewNote":
+		x.ReviewNote = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.CurriculumMigration"))
+		}
+		panic(fmt.Errorf("message academictoken.student.CurriculumMigration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurriculumMigration) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.student.CurriculumMigration.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.fromVersion":
+		value := x.FromVersion
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.toVersion":
+		value := x.ToVersion
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.requestedBy":
+		value := x.RequestedBy
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.requestedAt":
+		value := x.RequestedAt
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.reviewedBy":
+		value := x.ReviewedBy
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.reviewedAt":
+		value := x.ReviewedAt
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.CurriculumMigration.reviewNote":
+		value := x.ReviewNote
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.CurriculumMigration"))
+		}
+		panic(fmt.Errorf("message academictoken.student.CurriculumMigration does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurriculumMigration) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.student.CurriculumMigration.id":
+		x.Id = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.fromVersion":
+		x.FromVersion = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.toVersion":
+		x.ToVersion = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.reason":
+		x.Reason = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.requestedBy":
+		x.RequestedBy = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.requestedAt":
+		x.RequestedAt = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.status":
+		x.Status = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.reviewedBy":
+		x.ReviewedBy = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.reviewedAt":
+		x.ReviewedAt = value.Interface().(string)
+	case "academictoken.student.CurriculumMigration.reviewNote":
+		x.ReviewNote = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.CurriculumMigration"))
+		}
+		panic(fmt.Errorf("message academictoken.student.CurriculumMigration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurriculumMigration) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.CurriculumMigration.id":
+		panic(fmt.Errorf("field id of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.fromVersion":
+		panic(fmt.Errorf("field fromVersion of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.toVersion":
+		panic(fmt.Errorf("field toVersion of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.reason":
+		panic(fmt.Errorf("field reason of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.requestedBy":
+		panic(fmt.Errorf("field requestedBy of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.requestedAt":
+		panic(fmt.Errorf("field requestedAt of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.status":
+		panic(fmt.Errorf("field status of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.reviewedBy":
+		panic(fmt.Errorf("field reviewedBy of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.reviewedAt":
+		panic(fmt.Errorf("field reviewedAt of message academictoken.student.CurriculumMigration is not mutable"))
+	case "academictoken.student.CurriculumMigration.reviewNote":
+		panic(fmt.Errorf("field reviewNote of message academictoken.student.CurriculumMigration is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.CurriculumMigration"))
+		}
+		panic(fmt.Errorf("message academictoken.student.CurriculumMigration does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurriculumMigration) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.CurriculumMigration.id":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.fromVersion":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.toVersion":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.reason":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.requestedBy":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.requestedAt":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.status":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.reviewedBy":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.reviewedAt":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.CurriculumMigration.reviewNote":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.CurriculumMigration"))
+		}
+		panic(fmt.Errorf("message academictoken.student.CurriculumMigration does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurriculumMigration) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.student.CurriculumMigration", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurriculumMigration) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurriculumMigration) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurriculumMigration) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurriculumMigration) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurriculumMigration)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FromVersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToVersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RequestedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RequestedAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReviewedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReviewedAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReviewNote)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurriculumMigration)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReviewNote) > 0 {
+			i -= len(x.ReviewNote)
+			copy(dAtA[i:], x.ReviewNote)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReviewNote)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ReviewedAt) > 0 {
+			i -= len(x.ReviewedAt)
+			copy(dAtA[i:], x.ReviewedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReviewedAt)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ReviewedBy) > 0 {
+			i -= len(x.ReviewedBy)
+			copy(dAtA[i:], x.ReviewedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReviewedBy)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.RequestedAt) > 0 {
+			i -= len(x.RequestedAt)
+			copy(dAtA[i:], x.RequestedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestedAt)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.RequestedBy) > 0 {
+			i -= len(x.RequestedBy)
+			copy(dAtA[i:], x.RequestedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestedBy)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ToVersion) > 0 {
+			i -= len(x.ToVersion)
+			copy(dAtA[i:], x.ToVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToVersion)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.FromVersion) > 0 {
+			i -= len(x.FromVersion)
+			copy(dAtA[i:], x.FromVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromVersion)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurriculumMigration)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurriculumMigration: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurriculumMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FromVersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToVersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReviewedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReviewedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReviewedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReviewedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReviewNote", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReviewNote = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SubjectWithdrawal             protoreflect.MessageDescriptor
+	fd_SubjectWithdrawal_subjectId   protoreflect.FieldDescriptor
+	fd_SubjectWithdrawal_offeringId  protoreflect.FieldDescriptor
+	fd_SubjectWithdrawal_term        protoreflect.FieldDescriptor
+	fd_SubjectWithdrawal_reason      protoreflect.FieldDescriptor
+	fd_SubjectWithdrawal_withdrawnBy protoreflect.FieldDescriptor
+	fd_SubjectWithdrawal_withdrawnAt protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_student_student_academic_tree_proto_init()
+	md_SubjectWithdrawal = File_academictoken_student_student_academic_tree_proto.Messages().ByName("SubjectWithdrawal")
+	fd_SubjectWithdrawal_subjectId = md_SubjectWithdrawal.Fields().ByName("subjectId")
+	fd_SubjectWithdrawal_offeringId = md_SubjectWithdrawal.Fields().ByName("offeringId")
+	fd_SubjectWithdrawal_term = md_SubjectWithdrawal.Fields().ByName("term")
+	fd_SubjectWithdrawal_reason = md_SubjectWithdrawal.Fields().ByName("reason")
+	fd_SubjectWithdrawal_withdrawnBy = md_SubjectWithdrawal.Fields().ByName("withdrawnBy")
+	fd_SubjectWithdrawal_withdrawnAt = md_SubjectWithdrawal.Fields().ByName("withdrawnAt")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubjectWithdrawal)(nil)
+
+type fastReflection_SubjectWithdrawal SubjectWithdrawal
+
+func (x *SubjectWithdrawal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubjectWithdrawal)(x)
+}
+
+func (x *SubjectWithdrawal) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubjectWithdrawal_messageType fastReflection_SubjectWithdrawal_messageType
+var _ protoreflect.MessageType = fastReflection_SubjectWithdrawal_messageType{}
+
+type fastReflection_SubjectWithdrawal_messageType struct{}
+
+func (x fastReflection_SubjectWithdrawal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubjectWithdrawal)(nil)
+}
+func (x fastReflection_SubjectWithdrawal_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubjectWithdrawal)
+}
+func (x fastReflection_SubjectWithdrawal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubjectWithdrawal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubjectWithdrawal) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubjectWithdrawal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubjectWithdrawal) Type() protoreflect.MessageType {
+	return _fastReflection_SubjectWithdrawal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubjectWithdrawal) New() protoreflect.Message {
+	return new(fastReflection_SubjectWithdrawal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubjectWithdrawal) Interface() protoreflect.ProtoMessage {
+	return (*SubjectWithdrawal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubjectWithdrawal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubjectId != "" {
+		value := protoreflect.ValueOfString(x.SubjectId)
+		if !f(fd_SubjectWithdrawal_subjectId, value) {
+			return
+		}
+	}
+	if x.OfferingId != "" {
+		value := protoreflect.ValueOfString(x.OfferingId)
+		if !f(fd_SubjectWithdrawal_offeringId, value) {
+			return
+		}
+	}
+	if x.Term != "" {
+		value := protoreflect.ValueOfString(x.Term)
+		if !f(fd_SubjectWithdrawal_term, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_SubjectWithdrawal_reason, value) {
+			return
+		}
+	}
+	if x.WithdrawnBy != "" {
+		value := protoreflect.ValueOfString(x.WithdrawnBy)
+		if !f(fd_SubjectWithdrawal_withdrawnBy, value) {
+			return
+		}
+	}
+	if x.WithdrawnAt != "" {
+		value := protoreflect.ValueOfString(x.WithdrawnAt)
+		if !f(fd_SubjectWithdrawal_withdrawnAt, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubjectWithdrawal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectWithdrawal.subjectId":
+		return x.SubjectId != ""
+	case "academictoken.student.SubjectWithdrawal.offeringId":
+		return x.OfferingId != ""
+	case "academictoken.student.SubjectWithdrawal.term":
+		return x.Term != ""
+	case "academictoken.student.SubjectWithdrawal.reason":
+		return x.Reason != ""
+	case "academictoken.student.SubjectWithdrawal.withdrawnBy":
+		return x.WithdrawnBy != ""
+	case "academictoken.student.SubjectWithdrawal.withdrawnAt":
+		return x.WithdrawnAt != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectWithdrawal"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubjectWithdrawal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectWithdrawal.subjectId":
+		x.SubjectId = ""
+	case "academictoken.student.SubjectWithdrawal.offeringId":
+		x.OfferingId = ""
+	case "academictoken.student.SubjectWithdrawal.term":
+		x.Term = ""
+	case "academictoken.student.SubjectWithdrawal.reason":
+		x.Reason = ""
+	case "academictoken.student.SubjectWithdrawal.withdrawnBy":
+		x.WithdrawnBy = ""
+	case "academictoken.student.SubjectWithdrawal.withdrawnAt":
+		x.WithdrawnAt = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectWithdrawal"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubjectWithdrawal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.student.SubjectWithdrawal.subjectId":
+		value := x.SubjectId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectWithdrawal.offeringId":
+		value := x.OfferingId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectWithdrawal.term":
+		value := x.Term
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectWithdrawal.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectWithdrawal.withdrawnBy":
+		value := x.WithdrawnBy
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectWithdrawal.withdrawnAt":
+		value := x.WithdrawnAt
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectWithdrawal"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectWithdrawal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubjectWithdrawal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectWithdrawal.subjectId":
+		x.SubjectId = value.Interface().(string)
+	case "academictoken.student.SubjectWithdrawal.offeringId":
+		x.OfferingId = value.Interface().(string)
+	case "academictoken.student.SubjectWithdrawal.term":
+		x.Term = value.Interface().(string)
+	case "academictoken.student.SubjectWithdrawal.reason":
+		x.Reason = value.Interface().(string)
+	case "academictoken.student.SubjectWithdrawal.withdrawnBy":
+		x.WithdrawnBy = value.Interface().(string)
+	case "academictoken.student.SubjectWithdrawal.withdrawnAt":
+		x.WithdrawnAt = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectWithdrawal"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubjectWithdrawal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectWithdrawal.subjectId":
+		panic(fmt.Errorf("field subjectId of message academictoken.student.SubjectWithdrawal is not mutable"))
+	case "academictoken.student.SubjectWithdrawal.offeringId":
+		panic(fmt.Errorf("field offeringId of message academictoken.student.SubjectWithdrawal is not mutable"))
+	case "academictoken.student.SubjectWithdrawal.term":
+		panic(fmt.Errorf("field term of message academictoken.student.SubjectWithdrawal is not mutable"))
+	case "academictoken.student.SubjectWithdrawal.reason":
+		panic(fmt.Errorf("field reason of message academictoken.student.SubjectWithdrawal is not mutable"))
+	case "academictoken.student.SubjectWithdrawal.withdrawnBy":
+		panic(fmt.Errorf("field withdrawnBy of message academictoken.student.SubjectWithdrawal is not mutable"))
+	case "academictoken.student.SubjectWithdrawal.withdrawnAt":
+		panic(fmt.Errorf("field withdrawnAt of message academictoken.student.SubjectWithdrawal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectWithdrawal"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubjectWithdrawal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectWithdrawal.subjectId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectWithdrawal.offeringId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectWithdrawal.term":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectWithdrawal.reason":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectWithdrawal.withdrawnBy":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectWithdrawal.withdrawnAt":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectWithdrawal"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubjectWithdrawal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.student.SubjectWithdrawal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubjectWithdrawal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubjectWithdrawal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubjectWithdrawal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubjectWithdrawal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubjectWithdrawal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SubjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OfferingId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Term)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WithdrawnBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WithdrawnAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubjectWithdrawal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.WithdrawnAt) > 0 {
+			i -= len(x.WithdrawnAt)
+			copy(dAtA[i:], x.WithdrawnAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WithdrawnAt)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.WithdrawnBy) > 0 {
+			i -= len(x.WithdrawnBy)
+			copy(dAtA[i:], x.WithdrawnBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WithdrawnBy)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Term) > 0 {
+			i -= len(x.Term)
+			copy(dAtA[i:], x.Term)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Term)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OfferingId) > 0 {
+			i -= len(x.OfferingId)
+			copy(dAtA[i:], x.OfferingId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OfferingId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SubjectId) > 0 {
+			i -= len(x.SubjectId)
+			copy(dAtA[i:], x.SubjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubjectWithdrawal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubjectWithdrawal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubjectWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferingId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OfferingId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Term = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawnBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WithdrawnBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawnAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WithdrawnAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PrerequisiteWaiver           protoreflect.MessageDescriptor
+	fd_PrerequisiteWaiver_subjectId protoreflect.FieldDescriptor
+	fd_PrerequisiteWaiver_reason    protoreflect.FieldDescriptor
+	fd_PrerequisiteWaiver_grantedBy protoreflect.FieldDescriptor
+	fd_PrerequisiteWaiver_grantedAt protoreflect.FieldDescriptor
+	fd_PrerequisiteWaiver_expiresAt protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_student_student_academic_tree_proto_init()
+	md_PrerequisiteWaiver = File_academictoken_student_student_academic_tree_proto.Messages().ByName("PrerequisiteWaiver")
+	fd_PrerequisiteWaiver_subjectId = md_PrerequisiteWaiver.Fields().ByName("subjectId")
+	fd_PrerequisiteWaiver_reason = md_PrerequisiteWaiver.Fields().ByName("reason")
+	fd_PrerequisiteWaiver_grantedBy = md_PrerequisiteWaiver.Fields().ByName("grantedBy")
+	fd_PrerequisiteWaiver_grantedAt = md_PrerequisiteWaiver.Fields().ByName("grantedAt")
+	fd_PrerequisiteWaiver_expiresAt = md_PrerequisiteWaiver.Fields().ByName("expiresAt")
+}
+
+var _ protoreflect.Message = (*fastReflection_PrerequisiteWaiver)(nil)
+
+type fastReflection_PrerequisiteWaiver PrerequisiteWaiver
+
+func (x *PrerequisiteWaiver) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PrerequisiteWaiver)(x)
+}
+
+func (x *PrerequisiteWaiver) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PrerequisiteWaiver_messageType fastReflection_PrerequisiteWaiver_messageType
+var _ protoreflect.MessageType = fastReflection_PrerequisiteWaiver_messageType{}
+
+type fastReflection_PrerequisiteWaiver_messageType struct{}
+
+func (x fastReflection_PrerequisiteWaiver_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PrerequisiteWaiver)(nil)
+}
+func (x fastReflection_PrerequisiteWaiver_messageType) New() protoreflect.Message {
+	return new(fastReflection_PrerequisiteWaiver)
+}
+func (x fastReflection_PrerequisiteWaiver_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrerequisiteWaiver
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PrerequisiteWaiver) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrerequisiteWaiver
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PrerequisiteWaiver) Type() protoreflect.MessageType {
+	return _fastReflection_PrerequisiteWaiver_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PrerequisiteWaiver) New() protoreflect.Message {
+	return new(fastReflection_PrerequisiteWaiver)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PrerequisiteWaiver) Interface() protoreflect.ProtoMessage {
+	return (*PrerequisiteWaiver)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PrerequisiteWaiver) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubjectId != "" {
+		value := protoreflect.ValueOfString(x.SubjectId)
+		if !f(fd_PrerequisiteWaiver_subjectId, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_PrerequisiteWaiver_reason, value) {
+			return
+		}
+	}
+	if x.GrantedBy != "" {
+		value := protoreflect.ValueOfString(x.GrantedBy)
+		if !f(fd_PrerequisiteWaiver_grantedBy, value) {
+			return
+		}
+	}
+	if x.GrantedAt != "" {
+		value := protoreflect.ValueOfString(x.GrantedAt)
+		if !f(fd_PrerequisiteWaiver_grantedAt, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != "" {
+		value := protoreflect.ValueOfString(x.ExpiresAt)
+		if !f(fd_PrerequisiteWaiver_expiresAt, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PrerequisiteWaiver) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.student.PrerequisiteWaiver.subjectId":
+		return x.SubjectId != ""
+	case "academictoken.student.PrerequisiteWaiver.reason":
+		return x.Reason != ""
+	case "academictoken.student.PrerequisiteWaiver.grantedBy":
+		return x.GrantedBy != ""
+	case "academictoken.student.PrerequisiteWaiver.grantedAt":
+		return x.GrantedAt != ""
+	case "academictoken.student.PrerequisiteWaiver.expiresAt":
+		return x.ExpiresAt != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.PrerequisiteWaiver"))
+		}
+		panic(fmt.Errorf("message academictoken.student.PrerequisiteWaiver does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrerequisiteWaiver) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.student.PrerequisiteWaiver.subjectId":
+		x.SubjectId = ""
+	case "academictoken.student.PrerequisiteWaiver.reason":
+		x.Reason = ""
+	case "academictoken.student.PrerequisiteWaiver.grantedBy":
+		x.GrantedBy = ""
+	case "academictoken.student.PrerequisiteWaiver.grantedAt":
+		x.GrantedAt = ""
+	case "academictoken.student.PrerequisiteWaiver.expiresAt":
+		x.ExpiresAt = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.PrerequisiteWaiver"))
+		}
+		panic(fmt.Errorf("message academictoken.student.PrerequisiteWaiver does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PrerequisiteWaiver) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.student.PrerequisiteWaiver.subjectId":
+		value := x.SubjectId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.PrerequisiteWaiver.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.PrerequisiteWaiver.grantedBy":
+		value := x.GrantedBy
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.PrerequisiteWaiver.grantedAt":
+		value := x.GrantedAt
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.PrerequisiteWaiver.expiresAt":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.PrerequisiteWaiver"))
+		}
+		panic(fmt.Errorf("message academictoken.student.PrerequisiteWaiver does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrerequisiteWaiver) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.student.PrerequisiteWaiver.subjectId":
+		x.SubjectId = value.Interface().(string)
+	case "academictoken.student.PrerequisiteWaiver.reason":
+		x.Reason = value.Interface().(string)
+	case "academictoken.student.PrerequisiteWaiver.grantedBy":
+		x.GrantedBy = value.Interface().(string)
+	case "academictoken.student.PrerequisiteWaiver.grantedAt":
+		x.GrantedAt = value.Interface().(string)
+	case "academictoken.student.PrerequisiteWaiver.expiresAt":
+		x.ExpiresAt = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.PrerequisiteWaiver"))
+		}
+		panic(fmt.Errorf("message academictoken.student.PrerequisiteWaiver does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrerequisiteWaiver) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.PrerequisiteWaiver.subjectId":
+		panic(fmt.Errorf("field subjectId of message academictoken.student.PrerequisiteWaiver is not mutable"))
+	case "academictoken.student.PrerequisiteWaiver.reason":
+		panic(fmt.Errorf("field reason of message academictoken.student.PrerequisiteWaiver is not mutable"))
+	case "academictoken.student.PrerequisiteWaiver.grantedBy":
+		panic(fmt.Errorf("field grantedBy of message academictoken.student.PrerequisiteWaiver is not mutable"))
+	case "academictoken.student.PrerequisiteWaiver.grantedAt":
+		panic(fmt.Errorf("field grantedAt of message academictoken.student.PrerequisiteWaiver is not mutable"))
+	case "academictoken.student.PrerequisiteWaiver.expiresAt":
+		panic(fmt.Errorf("field expiresAt of message academictoken.student.PrerequisiteWaiver is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.PrerequisiteWaiver"))
+		}
+		panic(fmt.Errorf("message academictoken.student.PrerequisiteWaiver does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PrerequisiteWaiver) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.PrerequisiteWaiver.subjectId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.PrerequisiteWaiver.reason":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.PrerequisiteWaiver.grantedBy":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.PrerequisiteWaiver.grantedAt":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.PrerequisiteWaiver.expiresAt":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.PrerequisiteWaiver"))
+		}
+		panic(fmt.Errorf("message academictoken.student.PrerequisiteWaiver does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PrerequisiteWaiver) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.student.PrerequisiteWaiver", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PrerequisiteWaiver) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrerequisiteWaiver) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PrerequisiteWaiver) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PrerequisiteWaiver) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PrerequisiteWaiver)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SubjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrantedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrantedAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpiresAt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PrerequisiteWaiver)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExpiresAt) > 0 {
+			i -= len(x.ExpiresAt)
+			copy(dAtA[i:], x.ExpiresAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpiresAt)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.GrantedAt) > 0 {
+			i -= len(x.GrantedAt)
+			copy(dAtA[i:], x.GrantedAt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrantedAt)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.GrantedBy) > 0 {
+			i -= len(x.GrantedBy)
+			copy(dAtA[i:], x.GrantedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrantedBy)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SubjectId) > 0 {
+			i -= len(x.SubjectId)
+			copy(dAtA[i:], x.SubjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PrerequisiteWaiver)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrerequisiteWaiver: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrerequisiteWaiver: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GrantedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantedAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GrantedAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpiresAt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var _ protoreflect.List = (*_StudentAcademicTree_9_list)(nil)
 
 type _StudentAcademicTree_9_list struct {
@@ -759,109 +3171,267 @@ func (x *_StudentAcademicTree_15_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_StudentAcademicTree_15_list) Set(i int, value protoreflect.Value) {
+func (x *_StudentAcademicTree_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ElectiveCompletionGroup)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StudentAcademicTree_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ElectiveCompletionGroup)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StudentAcademicTree_15_list) AppendMutable() protoreflect.Value {
+	v := new(ElectiveCompletionGroup)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StudentAcademicTree_15_list) NewElement() protoreflect.Value {
+	v := new(ElectiveCompletionGroup)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StudentAcademicTree_16_list)(nil)
+
+type _StudentAcademicTree_16_list struct {
+	list *[]*EquivalenceRequest
+}
+
+func (x *_StudentAcademicTree_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StudentAcademicTree_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EquivalenceRequest)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StudentAcademicTree_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EquivalenceRequest)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StudentAcademicTree_16_list) AppendMutable() protoreflect.Value {
+	v := new(EquivalenceRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StudentAcademicTree_16_list) NewElement() protoreflect.Value {
+	v := new(EquivalenceRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StudentAcademicTree_19_list)(nil)
+
+type _StudentAcademicTree_19_list struct {
+	list *[]*CurriculumMigration
+}
+
+func (x *_StudentAcademicTree_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StudentAcademicTree_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurriculumMigration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StudentAcademicTree_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurriculumMigration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StudentAcademicTree_19_list) AppendMutable() protoreflect.Value {
+	v := new(CurriculumMigration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StudentAcademicTree_19_list) NewElement() protoreflect.Value {
+	v := new(CurriculumMigration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StudentAcademicTree_20_list)(nil)
+
+type _StudentAcademicTree_20_list struct {
+	list *[]*SubjectWithdrawal
+}
+
+func (x *_StudentAcademicTree_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StudentAcademicTree_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StudentAcademicTree_20_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ElectiveCompletionGroup)
+	concreteValue := valueUnwrapped.Interface().(*SubjectWithdrawal)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_StudentAcademicTree_15_list) Append(value protoreflect.Value) {
+func (x *_StudentAcademicTree_20_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ElectiveCompletionGroup)
+	concreteValue := valueUnwrapped.Interface().(*SubjectWithdrawal)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_StudentAcademicTree_15_list) AppendMutable() protoreflect.Value {
-	v := new(ElectiveCompletionGroup)
+func (x *_StudentAcademicTree_20_list) AppendMutable() protoreflect.Value {
+	v := new(SubjectWithdrawal)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_StudentAcademicTree_15_list) Truncate(n int) {
+func (x *_StudentAcademicTree_20_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_StudentAcademicTree_15_list) NewElement() protoreflect.Value {
-	v := new(ElectiveCompletionGroup)
+func (x *_StudentAcademicTree_20_list) NewElement() protoreflect.Value {
+	v := new(SubjectWithdrawal)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_StudentAcademicTree_15_list) IsValid() bool {
+func (x *_StudentAcademicTree_20_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_StudentAcademicTree_16_list)(nil)
+var _ protoreflect.List = (*_StudentAcademicTree_21_list)(nil)
 
-type _StudentAcademicTree_16_list struct {
-	list *[]*EquivalenceRequest
+type _StudentAcademicTree_21_list struct {
+	list *[]*PrerequisiteWaiver
 }
 
-func (x *_StudentAcademicTree_16_list) Len() int {
+func (x *_StudentAcademicTree_21_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_StudentAcademicTree_16_list) Get(i int) protoreflect.Value {
+func (x *_StudentAcademicTree_21_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_StudentAcademicTree_16_list) Set(i int, value protoreflect.Value) {
+func (x *_StudentAcademicTree_21_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*EquivalenceRequest)
+	concreteValue := valueUnwrapped.Interface().(*PrerequisiteWaiver)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_StudentAcademicTree_16_list) Append(value protoreflect.Value) {
+func (x *_StudentAcademicTree_21_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*EquivalenceRequest)
+	concreteValue := valueUnwrapped.Interface().(*PrerequisiteWaiver)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_StudentAcademicTree_16_list) AppendMutable() protoreflect.Value {
-	v := new(EquivalenceRequest)
+func (x *_StudentAcademicTree_21_list) AppendMutable() protoreflect.Value {
+	v := new(PrerequisiteWaiver)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_StudentAcademicTree_16_list) Truncate(n int) {
+func (x *_StudentAcademicTree_21_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_StudentAcademicTree_16_list) NewElement() protoreflect.Value {
-	v := new(EquivalenceRequest)
+func (x *_StudentAcademicTree_21_list) NewElement() protoreflect.Value {
+	v := new(PrerequisiteWaiver)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_StudentAcademicTree_16_list) IsValid() bool {
+func (x *_StudentAcademicTree_21_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_StudentAcademicTree                     protoreflect.MessageDescriptor
-	fd_StudentAcademicTree_index               protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_student             protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_institution         protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_courseId            protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_curriculumVersion   protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_totalCredits        protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_totalCompletedHours protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_coefficientGpa      protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_completedTokens     protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_inProgressTokens    protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_availableTokens     protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_academicProgress    protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_graduationStatus    protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_transferredSubjects protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_completedElectives  protoreflect.FieldDescriptor
-	fd_StudentAcademicTree_equivalenceRequests protoreflect.FieldDescriptor
+	md_StudentAcademicTree                      protoreflect.MessageDescriptor
+	fd_StudentAcademicTree_index                protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_student              protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_institution          protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_courseId             protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_curriculumVersion    protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_totalCredits         protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_totalCompletedHours  protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_coefficientGpa       protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_completedTokens      protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_inProgressTokens     protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_availableTokens      protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_academicProgress     protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_graduationStatus     protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_transferredSubjects  protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_completedElectives   protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_equivalenceRequests  protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_gradePointsTotal     protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_gpaCredits           protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_curriculumMigrations protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_withdrawals          protoreflect.FieldDescriptor
+	fd_StudentAcademicTree_prerequisiteWaivers  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -883,6 +3453,11 @@ func init() {
 	fd_StudentAcademicTree_transferredSubjects = md_StudentAcademicTree.Fields().ByName("transferredSubjects")
 	fd_StudentAcademicTree_completedElectives = md_StudentAcademicTree.Fields().ByName("completedElectives")
 	fd_StudentAcademicTree_equivalenceRequests = md_StudentAcademicTree.Fields().ByName("equivalenceRequests")
+	fd_StudentAcademicTree_gradePointsTotal = md_StudentAcademicTree.Fields().ByName("gradePointsTotal")
+	fd_StudentAcademicTree_gpaCredits = md_StudentAcademicTree.Fields().ByName("gpaCredits")
+	fd_StudentAcademicTree_curriculumMigrations = md_StudentAcademicTree.Fields().ByName("curriculumMigrations")
+	fd_StudentAcademicTree_withdrawals = md_StudentAcademicTree.Fields().ByName("withdrawals")
+	fd_StudentAcademicTree_prerequisiteWaivers = md_StudentAcademicTree.Fields().ByName("prerequisiteWaivers")
 }
 
 var _ protoreflect.Message = (*fastReflection_StudentAcademicTree)(nil)
@@ -894,7 +3469,7 @@ func (x *StudentAcademicTree) ProtoReflect() protoreflect.Message {
 }
 
 func (x *StudentAcademicTree) slowProtoReflect() protoreflect.Message {
-	mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[1]
+	mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,6 +3621,36 @@ func (x *fastReflection_StudentAcademicTree) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.GradePointsTotal != "" {
+		value := protoreflect.ValueOfString(x.GradePointsTotal)
+		if !f(fd_StudentAcademicTree_gradePointsTotal, value) {
+			return
+		}
+	}
+	if x.GpaCredits != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GpaCredits)
+		if !f(fd_StudentAcademicTree_gpaCredits, value) {
+			return
+		}
+	}
+	if len(x.CurriculumMigrations) != 0 {
+		value := protoreflect.ValueOfList(&_StudentAcademicTree_19_list{list: &x.CurriculumMigrations})
+		if !f(fd_StudentAcademicTree_curriculumMigrations, value) {
+			return
+		}
+	}
+	if len(x.Withdrawals) != 0 {
+		value := protoreflect.ValueOfList(&_StudentAcademicTree_20_list{list: &x.Withdrawals})
+		if !f(fd_StudentAcademicTree_withdrawals, value) {
+			return
+		}
+	}
+	if len(x.PrerequisiteWaivers) != 0 {
+		value := protoreflect.ValueOfList(&_StudentAcademicTree_21_list{list: &x.PrerequisiteWaivers})
+		if !f(fd_StudentAcademicTree_prerequisiteWaivers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1093,6 +3698,16 @@ func (x *fastReflection_StudentAcademicTree) Has(fd protoreflect.FieldDescriptor
 		return len(x.CompletedElectives) != 0
 	case "academictoken.student.StudentAcademicTree.equivalenceRequests":
 		return len(x.EquivalenceRequests) != 0
+	case "academictoken.student.StudentAcademicTree.gradePointsTotal":
+		return x.GradePointsTotal != ""
+	case "academictoken.student.StudentAcademicTree.gpaCredits":
+		return x.GpaCredits != uint64(0)
+	case "academictoken.student.StudentAcademicTree.curriculumMigrations":
+		return len(x.CurriculumMigrations) != 0
+	case "academictoken.student.StudentAcademicTree.withdrawals":
+		return len(x.Withdrawals) != 0
+	case "academictoken.student.StudentAcademicTree.prerequisiteWaivers":
+		return len(x.PrerequisiteWaivers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentAcademicTree"))
@@ -1141,6 +3756,16 @@ func (x *fastReflection_StudentAcademicTree) Clear(fd protoreflect.FieldDescript
 		x.CompletedElectives = nil
 	case "academictoken.student.StudentAcademicTree.equivalenceRequests":
 		x.EquivalenceRequests = nil
+	case "academictoken.student.StudentAcademicTree.gradePointsTotal":
+		x.GradePointsTotal = ""
+	case "academictoken.student.StudentAcademicTree.gpaCredits":
+		x.GpaCredits = uint64(0)
+	case "academictoken.student.StudentAcademicTree.curriculumMigrations":
+		x.CurriculumMigrations = nil
+	case "academictoken.student.StudentAcademicTree.withdrawals":
+		x.Withdrawals = nil
+	case "academictoken.student.StudentAcademicTree.prerequisiteWaivers":
+		x.PrerequisiteWaivers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentAcademicTree"))
@@ -1223,6 +3848,30 @@ func (x *fastReflection_StudentAcademicTree) Get(descriptor protoreflect.FieldDe
 		}
 		listValue := &_StudentAcademicTree_16_list{list: &x.EquivalenceRequests}
 		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.StudentAcademicTree.gradePointsTotal":
+		value := x.GradePointsTotal
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.StudentAcademicTree.gpaCredits":
+		value := x.GpaCredits
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.StudentAcademicTree.curriculumMigrations":
+		if len(x.CurriculumMigrations) == 0 {
+			return protoreflect.ValueOfList(&_StudentAcademicTree_19_list{})
+		}
+		listValue := &_StudentAcademicTree_19_list{list: &x.CurriculumMigrations}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.StudentAcademicTree.withdrawals":
+		if len(x.Withdrawals) == 0 {
+			return protoreflect.ValueOfList(&_StudentAcademicTree_20_list{})
+		}
+		listValue := &_StudentAcademicTree_20_list{list: &x.Withdrawals}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.StudentAcademicTree.prerequisiteWaivers":
+		if len(x.PrerequisiteWaivers) == 0 {
+			return protoreflect.ValueOfList(&_StudentAcademicTree_21_list{})
+		}
+		listValue := &_StudentAcademicTree_21_list{list: &x.PrerequisiteWaivers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentAcademicTree"))
@@ -1287,6 +3936,22 @@ func (x *fastReflection_StudentAcademicTree) Set(fd protoreflect.FieldDescriptor
 		lv := value.List()
 		clv := lv.(*_StudentAcademicTree_16_list)
 		x.EquivalenceRequests = *clv.list
+	case "academictoken.student.StudentAcademicTree.gradePointsTotal":
+		x.GradePointsTotal = value.Interface().(string)
+	case "academictoken.student.StudentAcademicTree.gpaCredits":
+		x.GpaCredits = value.Uint()
+	case "academictoken.student.StudentAcademicTree.curriculumMigrations":
+		lv := value.List()
+		clv := lv.(*_StudentAcademicTree_19_list)
+		x.CurriculumMigrations = *clv.list
+	case "academictoken.student.StudentAcademicTree.withdrawals":
+		lv := value.List()
+		clv := lv.(*_StudentAcademicTree_20_list)
+		x.Withdrawals = *clv.list
+	case "academictoken.student.StudentAcademicTree.prerequisiteWaivers":
+		lv := value.List()
+		clv := lv.(*_StudentAcademicTree_21_list)
+		x.PrerequisiteWaivers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentAcademicTree"))
@@ -1353,6 +4018,24 @@ func (x *fastReflection_StudentAcademicTree) Mutable(fd protoreflect.FieldDescri
 		}
 		value := &_StudentAcademicTree_16_list{list: &x.EquivalenceRequests}
 		return protoreflect.ValueOfList(value)
+	case "academictoken.student.StudentAcademicTree.curriculumMigrations":
+		if x.CurriculumMigrations == nil {
+			x.CurriculumMigrations = []*CurriculumMigration{}
+		}
+		value := &_StudentAcademicTree_19_list{list: &x.CurriculumMigrations}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.StudentAcademicTree.withdrawals":
+		if x.Withdrawals == nil {
+			x.Withdrawals = []*SubjectWithdrawal{}
+		}
+		value := &_StudentAcademicTree_20_list{list: &x.Withdrawals}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.StudentAcademicTree.prerequisiteWaivers":
+		if x.PrerequisiteWaivers == nil {
+			x.PrerequisiteWaivers = []*PrerequisiteWaiver{}
+		}
+		value := &_StudentAcademicTree_21_list{list: &x.PrerequisiteWaivers}
+		return protoreflect.ValueOfList(value)
 	case "academictoken.student.StudentAcademicTree.index":
 		panic(fmt.Errorf("field index of message academictoken.student.StudentAcademicTree is not mutable"))
 	case "academictoken.student.StudentAcademicTree.student":
@@ -1369,6 +4052,10 @@ func (x *fastReflection_StudentAcademicTree) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field totalCompletedHours of message academictoken.student.StudentAcademicTree is not mutable"))
 	case "academictoken.student.StudentAcademicTree.coefficientGpa":
 		panic(fmt.Errorf("field coefficientGpa of message academictoken.student.StudentAcademicTree is not mutable"))
+	case "academictoken.student.StudentAcademicTree.gradePointsTotal":
+		panic(fmt.Errorf("field gradePointsTotal of message academictoken.student.StudentAcademicTree is not mutable"))
+	case "academictoken.student.StudentAcademicTree.gpaCredits":
+		panic(fmt.Errorf("field gpaCredits of message academictoken.student.StudentAcademicTree is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentAcademicTree"))
@@ -1422,6 +4109,19 @@ func (x *fastReflection_StudentAcademicTree) NewField(fd protoreflect.FieldDescr
 	case "academictoken.student.StudentAcademicTree.equivalenceRequests":
 		list := []*EquivalenceRequest{}
 		return protoreflect.ValueOfList(&_StudentAcademicTree_16_list{list: &list})
+	case "academictoken.student.StudentAcademicTree.gradePointsTotal":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.StudentAcademicTree.gpaCredits":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.StudentAcademicTree.curriculumMigrations":
+		list := []*CurriculumMigration{}
+		return protoreflect.ValueOfList(&_StudentAcademicTree_19_list{list: &list})
+	case "academictoken.student.StudentAcademicTree.withdrawals":
+		list := []*SubjectWithdrawal{}
+		return protoreflect.ValueOfList(&_StudentAcademicTree_20_list{list: &list})
+	case "academictoken.student.StudentAcademicTree.prerequisiteWaivers":
+		list := []*PrerequisiteWaiver{}
+		return protoreflect.ValueOfList(&_StudentAcademicTree_21_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentAcademicTree"))
@@ -1564,6 +4264,31 @@ func (x *fastReflection_StudentAcademicTree) ProtoMethods() *protoiface.Methods
 				n += 2 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.GradePointsTotal)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.GpaCredits != 0 {
+			n += 2 + runtime.Sov(uint64(x.GpaCredits))
+		}
+		if len(x.CurriculumMigrations) > 0 {
+			for _, e := range x.CurriculumMigrations {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Withdrawals) > 0 {
+			for _, e := range x.Withdrawals {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PrerequisiteWaivers) > 0 {
+			for _, e := range x.PrerequisiteWaivers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1593,6 +4318,76 @@ func (x *fastReflection_StudentAcademicTree) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PrerequisiteWaivers) > 0 {
+			for iNdEx := len(x.PrerequisiteWaivers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PrerequisiteWaivers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.Withdrawals) > 0 {
+			for iNdEx := len(x.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Withdrawals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.CurriculumMigrations) > 0 {
+			for iNdEx := len(x.CurriculumMigrations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CurriculumMigrations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if x.GpaCredits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GpaCredits))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.GradePointsTotal) > 0 {
+			i -= len(x.GradePointsTotal)
+			copy(dAtA[i:], x.GradePointsTotal)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GradePointsTotal)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 		if len(x.EquivalenceRequests) > 0 {
 			for iNdEx := len(x.EquivalenceRequests) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.EquivalenceRequests[iNdEx])
@@ -2167,12 +4962,163 @@ func (x *fastReflection_StudentAcademicTree) ProtoMethods() *protoiface.Methods
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GraduationStatus); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 14:
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferredSubjects", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferredSubjects = append(x.TransferredSubjects, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletedElectives", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CompletedElectives = append(x.CompletedElectives, &ElectiveCompletionGroup{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompletedElectives[len(x.CompletedElectives)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EquivalenceRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EquivalenceRequests = append(x.EquivalenceRequests, &EquivalenceRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EquivalenceRequests[len(x.EquivalenceRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GradePointsTotal", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GradePointsTotal = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GpaCredits", wireType)
+				}
+				x.GpaCredits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GpaCredits |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferredSubjects", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurriculumMigrations", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2182,27 +5128,29 @@ func (x *fastReflection_StudentAcademicTree) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TransferredSubjects = append(x.TransferredSubjects, string(dAtA[iNdEx:postIndex]))
+				x.CurriculumMigrations = append(x.CurriculumMigrations, &CurriculumMigration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurriculumMigrations[len(x.CurriculumMigrations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 15:
+			case 20:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletedElectives", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2229,14 +5177,14 @@ func (x *fastReflection_StudentAcademicTree) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CompletedElectives = append(x.CompletedElectives, &ElectiveCompletionGroup{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompletedElectives[len(x.CompletedElectives)-1]); err != nil {
+				x.Withdrawals = append(x.Withdrawals, &SubjectWithdrawal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Withdrawals[len(x.Withdrawals)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 16:
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EquivalenceRequests", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrerequisiteWaivers", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2263,8 +5211,8 @@ func (x *fastReflection_StudentAcademicTree) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.EquivalenceRequests = append(x.EquivalenceRequests, &EquivalenceRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EquivalenceRequests[len(x.EquivalenceRequests)-1]); err != nil {
+				x.PrerequisiteWaivers = append(x.PrerequisiteWaivers, &PrerequisiteWaiver{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrerequisiteWaivers[len(x.PrerequisiteWaivers)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2359,33 +5307,293 @@ func (x *ElectiveCompletionGroup) GetCompletedSubjects() []string {
 	return nil
 }
 
+// CurriculumMigration records a request to move a student to a newer
+// curriculum version of their course and the review of the institution
+type CurriculumMigration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromVersion string `protobuf:"bytes,2,opt,name=fromVersion,proto3" json:"fromVersion,omitempty"`
+	ToVersion   string `protobuf:"bytes,3,opt,name=toVersion,proto3" json:"toVersion,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy string `protobuf:"bytes,5,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"`
+	RequestedAt string `protobuf:"bytes,6,opt,name=requestedAt,proto3" json:"requestedAt,omitempty"`
+	Status      string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending, approved or rejected
+	ReviewedBy  string `protobuf:"bytes,8,opt,name=reviewedBy,proto3" json:"reviewedBy,omitempty"`
+	ReviewedAt  string `protobuf:"bytes,9,opt,name=reviewedAt,proto3" json:"reviewedAt,omitempty"`
+	ReviewNote  string `protobuf:"bytes,10,opt,name=reviewNote,proto3" json:"reviewNote,omitempty"`
+}
+
+func (x *CurriculumMigration) Reset() {
+	*x = CurriculumMigration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurriculumMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurriculumMigration) ProtoMessage() {}
+
+// Deprecated: Use CurriculumMigration.ProtoReflect.Descriptor instead.
+func (*CurriculumMigration) Descriptor() ([]byte, []int) {
+	return file_academictoken_student_student_academic_tree_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CurriculumMigration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetFromVersion() string {
+	if x != nil {
+		return x.FromVersion
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetToVersion() string {
+	if x != nil {
+		return x.ToVersion
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *CurriculumMigration) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+// SubjectWithdrawal records a subject the student withdrew from after the
+// enrollment window of its term closed
+type SubjectWithdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId   string `protobuf:"bytes,1,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	OfferingId  string `protobuf:"bytes,2,opt,name=offeringId,proto3" json:"offeringId,omitempty"`
+	Term        string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	WithdrawnBy string `protobuf:"bytes,5,opt,name=withdrawnBy,proto3" json:"withdrawnBy,omitempty"`
+	WithdrawnAt string `protobuf:"bytes,6,opt,name=withdrawnAt,proto3" json:"withdrawnAt,omitempty"`
+}
+
+func (x *SubjectWithdrawal) Reset() {
+	*x = SubjectWithdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubjectWithdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectWithdrawal) ProtoMessage() {}
+
+// Deprecated: Use SubjectWithdrawal.ProtoReflect.Descriptor instead.
+func (*SubjectWithdrawal) Descriptor() ([]byte, []int) {
+	return file_academictoken_student_student_academic_tree_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubjectWithdrawal) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *SubjectWithdrawal) GetOfferingId() string {
+	if x != nil {
+		return x.OfferingId
+	}
+	return ""
+}
+
+func (x *SubjectWithdrawal) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *SubjectWithdrawal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubjectWithdrawal) GetWithdrawnBy() string {
+	if x != nil {
+		return x.WithdrawnBy
+	}
+	return ""
+}
+
+func (x *SubjectWithdrawal) GetWithdrawnAt() string {
+	if x != nil {
+		return x.WithdrawnAt
+	}
+	return ""
+}
+
+// PrerequisiteWaiver lets a student enroll in a subject without meeting its
+// prerequisites until the waiver expires
+type PrerequisiteWaiver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId string `protobuf:"bytes,1,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedBy string `protobuf:"bytes,3,opt,name=grantedBy,proto3" json:"grantedBy,omitempty"`
+	GrantedAt string `protobuf:"bytes,4,opt,name=grantedAt,proto3" json:"grantedAt,omitempty"`
+	ExpiresAt string `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"` // RFC3339
+}
+
+func (x *PrerequisiteWaiver) Reset() {
+	*x = PrerequisiteWaiver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrerequisiteWaiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrerequisiteWaiver) ProtoMessage() {}
+
+// Deprecated: Use PrerequisiteWaiver.ProtoReflect.Descriptor instead.
+func (*PrerequisiteWaiver) Descriptor() ([]byte, []int) {
+	return file_academictoken_student_student_academic_tree_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PrerequisiteWaiver) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *PrerequisiteWaiver) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PrerequisiteWaiver) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *PrerequisiteWaiver) GetGrantedAt() string {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return ""
+}
+
+func (x *PrerequisiteWaiver) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
 type StudentAcademicTree struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index               string                     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Student             string                     `protobuf:"bytes,2,opt,name=student,proto3" json:"student,omitempty"`
-	Institution         string                     `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
-	CourseId            string                     `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId,omitempty"`
-	CurriculumVersion   string                     `protobuf:"bytes,5,opt,name=curriculumVersion,proto3" json:"curriculumVersion,omitempty"`
-	TotalCredits        uint64                     `protobuf:"varint,6,opt,name=totalCredits,proto3" json:"totalCredits,omitempty"`
-	TotalCompletedHours uint64                     `protobuf:"varint,7,opt,name=totalCompletedHours,proto3" json:"totalCompletedHours,omitempty"`
-	CoefficientGpa      float32                    `protobuf:"fixed32,8,opt,name=coefficientGpa,proto3" json:"coefficientGpa,omitempty"`
-	CompletedTokens     []string                   `protobuf:"bytes,9,rep,name=completedTokens,proto3" json:"completedTokens,omitempty"`
-	InProgressTokens    []string                   `protobuf:"bytes,10,rep,name=inProgressTokens,proto3" json:"inProgressTokens,omitempty"`
-	AvailableTokens     []string                   `protobuf:"bytes,11,rep,name=availableTokens,proto3" json:"availableTokens,omitempty"`
-	AcademicProgress    *AcademicProgress          `protobuf:"bytes,12,opt,name=academicProgress,proto3" json:"academicProgress,omitempty"`
-	GraduationStatus    *GraduationStatus          `protobuf:"bytes,13,opt,name=graduationStatus,proto3" json:"graduationStatus,omitempty"`
-	TransferredSubjects []string                   `protobuf:"bytes,14,rep,name=transferredSubjects,proto3" json:"transferredSubjects,omitempty"`
-	CompletedElectives  []*ElectiveCompletionGroup `protobuf:"bytes,15,rep,name=completedElectives,proto3" json:"completedElectives,omitempty"`
-	EquivalenceRequests []*EquivalenceRequest      `protobuf:"bytes,16,rep,name=equivalenceRequests,proto3" json:"equivalenceRequests,omitempty"`
+	Index                string                     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Student              string                     `protobuf:"bytes,2,opt,name=student,proto3" json:"student,omitempty"`
+	Institution          string                     `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
+	CourseId             string                     `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	CurriculumVersion    string                     `protobuf:"bytes,5,opt,name=curriculumVersion,proto3" json:"curriculumVersion,omitempty"`
+	TotalCredits         uint64                     `protobuf:"varint,6,opt,name=totalCredits,proto3" json:"totalCredits,omitempty"`
+	TotalCompletedHours  uint64                     `protobuf:"varint,7,opt,name=totalCompletedHours,proto3" json:"totalCompletedHours,omitempty"`
+	CoefficientGpa       float32                    `protobuf:"fixed32,8,opt,name=coefficientGpa,proto3" json:"coefficientGpa,omitempty"`
+	CompletedTokens      []string                   `protobuf:"bytes,9,rep,name=completedTokens,proto3" json:"completedTokens,omitempty"`
+	InProgressTokens     []string                   `protobuf:"bytes,10,rep,name=inProgressTokens,proto3" json:"inProgressTokens,omitempty"`
+	AvailableTokens      []string                   `protobuf:"bytes,11,rep,name=availableTokens,proto3" json:"availableTokens,omitempty"`
+	AcademicProgress     *AcademicProgress          `protobuf:"bytes,12,opt,name=academicProgress,proto3" json:"academicProgress,omitempty"`
+	GraduationStatus     *GraduationStatus          `protobuf:"bytes,13,opt,name=graduationStatus,proto3" json:"graduationStatus,omitempty"`
+	TransferredSubjects  []string                   `protobuf:"bytes,14,rep,name=transferredSubjects,proto3" json:"transferredSubjects,omitempty"`
+	CompletedElectives   []*ElectiveCompletionGroup `protobuf:"bytes,15,rep,name=completedElectives,proto3" json:"completedElectives,omitempty"`
+	EquivalenceRequests  []*EquivalenceRequest      `protobuf:"bytes,16,rep,name=equivalenceRequests,proto3" json:"equivalenceRequests,omitempty"`
+	GradePointsTotal     string                     `protobuf:"bytes,17,opt,name=gradePointsTotal,proto3" json:"gradePointsTotal,omitempty"` // sum of GPA points weighted by credits
+	GpaCredits           uint64                     `protobuf:"varint,18,opt,name=gpaCredits,proto3" json:"gpaCredits,omitempty"`            // credits of the grades counted in the GPA
+	CurriculumMigrations []*CurriculumMigration     `protobuf:"bytes,19,rep,name=curriculumMigrations,proto3" json:"curriculumMigrations,omitempty"`
+	Withdrawals          []*SubjectWithdrawal       `protobuf:"bytes,20,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	PrerequisiteWaivers  []*PrerequisiteWaiver      `protobuf:"bytes,21,rep,name=prerequisiteWaivers,proto3" json:"prerequisiteWaivers,omitempty"`
 }
 
 func (x *StudentAcademicTree) Reset() {
 	*x = StudentAcademicTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[1]
+		mi := &file_academictoken_student_student_academic_tree_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2399,7 +5607,7 @@ func (*StudentAcademicTree) ProtoMessage() {}
 
 // Deprecated: Use StudentAcademicTree.ProtoReflect.Descriptor instead.
 func (*StudentAcademicTree) Descriptor() ([]byte, []int) {
-	return file_academictoken_student_student_academic_tree_proto_rawDescGZIP(), []int{1}
+	return file_academictoken_student_student_academic_tree_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *StudentAcademicTree) GetIndex() string {
@@ -2514,6 +5722,41 @@ func (x *StudentAcademicTree) GetEquivalenceRequests() []*EquivalenceRequest {
 	return nil
 }
 
+func (x *StudentAcademicTree) GetGradePointsTotal() string {
+	if x != nil {
+		return x.GradePointsTotal
+	}
+	return ""
+}
+
+func (x *StudentAcademicTree) GetGpaCredits() uint64 {
+	if x != nil {
+		return x.GpaCredits
+	}
+	return 0
+}
+
+func (x *StudentAcademicTree) GetCurriculumMigrations() []*CurriculumMigration {
+	if x != nil {
+		return x.CurriculumMigrations
+	}
+	return nil
+}
+
+func (x *StudentAcademicTree) GetWithdrawals() []*SubjectWithdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+func (x *StudentAcademicTree) GetPrerequisiteWaivers() []*PrerequisiteWaiver {
+	if x != nil {
+		return x.PrerequisiteWaivers
+	}
+	return nil
+}
+
 var File_academictoken_student_student_academic_tree_proto protoreflect.FileDescriptor
 
 var file_academictoken_student_student_academic_tree_proto_rawDesc = []byte{
@@ -2536,74 +5779,138 @@ var file_academictoken_student_student_academic_tree_proto_rawDesc = []byte{
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2c,
 	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65,
 	0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0xc8, 0x06, 0x0a,
-	0x13, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x54, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69,
-	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
-	0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69,
-	0x63, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x70, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e,
-	0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x70, 0x61, 0x12, 0x28,
-	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x53,
-	0x0a, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x67, 0x72, 0x61, 0x64, 0x75, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0xb9, 0x02, 0x0a,
+	0x13, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4e, 0x6f, 0x74, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x6e, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x42, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x41, 0x74, 0x22, 0xa4, 0x01, 0x0a,
+	0x12, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x57, 0x61, 0x69,
+	0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x9d, 0x09, 0x0a, 0x13, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x54, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x70, 0x61, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65,
+	0x6e, 0x74, 0x47, 0x70, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x67, 0x72,
+	0x61, 0x64, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x67,
+	0x72, 0x61, 0x64, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x30, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x5e, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
 	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x67, 0x72, 0x61, 0x64, 0x75, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
-	0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x12, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73,
-	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
-	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x45,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
-	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x13, 0x65, 0x71,
-	0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e,
-	0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x13, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x42, 0xd3, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e,
-	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x18, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x63,
-	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x53, 0x58,
-	0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x12, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x12, 0x5b, 0x0a, 0x13, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x13, 0x65, 0x71, 0x75, 0x69, 0x76,
+	0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x70,
+	0x61, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x67, 0x70, 0x61, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x14, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x63, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x5b, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x15, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x52, 0x13,
+	0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x57, 0x61, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x42, 0xd3, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x42, 0x18, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2618,24 +5925,30 @@ func file_academictoken_student_student_academic_tree_proto_rawDescGZIP() []byte
 	return file_academictoken_student_student_academic_tree_proto_rawDescData
 }
 
-var file_academictoken_student_student_academic_tree_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_academictoken_student_student_academic_tree_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_academictoken_student_student_academic_tree_proto_goTypes = []interface{}{
 	(*ElectiveCompletionGroup)(nil), // 0: academictoken.student.ElectiveCompletionGroup
-	(*StudentAcademicTree)(nil),     // 1: academictoken.student.StudentAcademicTree
-	(*AcademicProgress)(nil),        // 2: academictoken.student.AcademicProgress
-	(*GraduationStatus)(nil),        // 3: academictoken.student.GraduationStatus
-	(*EquivalenceRequest)(nil),      // 4: academictoken.student.EquivalenceRequest
+	(*CurriculumMigration)(nil),     // 1: academictoken.student.CurriculumMigration
+	(*SubjectWithdrawal)(nil),       // 2: academictoken.student.SubjectWithdrawal
+	(*PrerequisiteWaiver)(nil),      // 3: academictoken.student.PrerequisiteWaiver
+	(*StudentAcademicTree)(nil),     // 4: academictoken.student.StudentAcademicTree
+	(*AcademicProgress)(nil),        // 5: academictoken.student.AcademicProgress
+	(*GraduationStatus)(nil),        // 6: academictoken.student.GraduationStatus
+	(*EquivalenceRequest)(nil),      // 7: academictoken.student.EquivalenceRequest
 }
 var file_academictoken_student_student_academic_tree_proto_depIdxs = []int32{
-	2, // 0: academictoken.student.StudentAcademicTree.academicProgress:type_name -> academictoken.student.AcademicProgress
-	3, // 1: academictoken.student.StudentAcademicTree.graduationStatus:type_name -> academictoken.student.GraduationStatus
+	5, // 0: academictoken.student.StudentAcademicTree.academicProgress:type_name -> academictoken.student.AcademicProgress
+	6, // 1: academictoken.student.StudentAcademicTree.graduationStatus:type_name -> academictoken.student.GraduationStatus
 	0, // 2: academictoken.student.StudentAcademicTree.completedElectives:type_name -> academictoken.student.ElectiveCompletionGroup
-	4, // 3: academictoken.student.StudentAcademicTree.equivalenceRequests:type_name -> academictoken.student.EquivalenceRequest
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	7, // 3: academictoken.student.StudentAcademicTree.equivalenceRequests:type_name -> academictoken.student.EquivalenceRequest
+	1, // 4: academictoken.student.StudentAcademicTree.curriculumMigrations:type_name -> academictoken.student.CurriculumMigration
+	2, // 5: academictoken.student.StudentAcademicTree.withdrawals:type_name -> academictoken.student.SubjectWithdrawal
+	3, // 6: academictoken.student.StudentAcademicTree.prerequisiteWaivers:type_name -> academictoken.student.PrerequisiteWaiver
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_academictoken_student_student_academic_tree_proto_init() }
@@ -2660,6 +5973,42 @@ func file_academictoken_student_student_academic_tree_proto_init() {
 			}
 		}
 		file_academictoken_student_student_academic_tree_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurriculumMigration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_student_student_academic_tree_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubjectWithdrawal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_student_student_academic_tree_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrerequisiteWaiver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_student_student_academic_tree_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StudentAcademicTree); i {
 			case 0:
 				return &v.state
@@ -2678,7 +6027,7 @@ func file_academictoken_student_student_academic_tree_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_academictoken_student_student_academic_tree_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	md_StudentEnrollment                protoreflect.MessageDescriptor
-	fd_StudentEnrollment_index          protoreflect.FieldDescriptor
-	fd_StudentEnrollment_student        protoreflect.FieldDescriptor
-	fd_StudentEnrollment_institution    protoreflect.FieldDescriptor
-	fd_StudentEnrollment_courseId       protoreflect.FieldDescriptor
-	fd_StudentEnrollment_enrollmentDate protoreflect.FieldDescriptor
-	fd_StudentEnrollment_status         protoreflect.FieldDescriptor
-	fd_StudentEnrollment_academicTreeId protoreflect.FieldDescriptor
+	md_StudentEnrollment                   protoreflect.MessageDescriptor
+	fd_StudentEnrollment_index             protoreflect.FieldDescriptor
+	fd_StudentEnrollment_student           protoreflect.FieldDescriptor
+	fd_StudentEnrollment_institution       protoreflect.FieldDescriptor
+	fd_StudentEnrollment_courseId          protoreflect.FieldDescriptor
+	fd_StudentEnrollment_enrollmentDate    protoreflect.FieldDescriptor
+	fd_StudentEnrollment_status            protoreflect.FieldDescriptor
+	fd_StudentEnrollment_academicTreeId    protoreflect.FieldDescriptor
+	fd_StudentEnrollment_curriculumVersion protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -33,6 +34,7 @@ func init() {
 	fd_StudentEnrollment_enrollmentDate = md_StudentEnrollment.Fields().ByName("enrollmentDate")
 	fd_StudentEnrollment_status = md_StudentEnrollment.Fields().ByName("status")
 	fd_StudentEnrollment_academicTreeId = md_StudentEnrollment.Fields().ByName("academicTreeId")
+	fd_StudentEnrollment_curriculumVersion = md_StudentEnrollment.Fields().ByName("curriculumVersion")
 }
 
 var _ protoreflect.Message = (*fastReflection_StudentEnrollment)(nil)
@@ -142,6 +144,12 @@ func (x *fastReflection_StudentEnrollment) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.CurriculumVersion != "" {
+		value := protoreflect.ValueOfString(x.CurriculumVersion)
+		if !f(fd_StudentEnrollment_curriculumVersion, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -171,6 +179,8 @@ func (x *fastReflection_StudentEnrollment) Has(fd protoreflect.FieldDescriptor)
 		return x.Status != ""
 	case "academictoken.student.StudentEnrollment.academicTreeId":
 		return x.AcademicTreeId != ""
+	case "academictoken.student.StudentEnrollment.curriculumVersion":
+		return x.CurriculumVersion != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentEnrollment"))
@@ -201,6 +211,8 @@ func (x *fastReflection_StudentEnrollment) Clear(fd protoreflect.FieldDescriptor
 		x.Status = ""
 	case "academictoken.student.StudentEnrollment.academicTreeId":
 		x.AcademicTreeId = ""
+	case "academictoken.student.StudentEnrollment.curriculumVersion":
+		x.CurriculumVersion = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentEnrollment"))
@@ -238,6 +250,9 @@ func (x *fastReflection_StudentEnrollment) Get(descriptor protoreflect.FieldDesc
 	case "academictoken.student.StudentEnrollment.academicTreeId":
 		value := x.AcademicTreeId
 		return protoreflect.ValueOfString(value)
+	case "academictoken.student.StudentEnrollment.curriculumVersion":
+		value := x.CurriculumVersion
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentEnrollment"))
@@ -272,6 +287,8 @@ func (x *fastReflection_StudentEnrollment) Set(fd protoreflect.FieldDescriptor,
 		x.Status = value.Interface().(string)
 	case "academictoken.student.StudentEnrollment.academicTreeId":
 		x.AcademicTreeId = value.Interface().(string)
+	case "academictoken.student.StudentEnrollment.curriculumVersion":
+		x.CurriculumVersion = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentEnrollment"))
@@ -306,6 +323,8 @@ func (x *fastReflection_StudentEnrollment) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field status of message academictoken.student.StudentEnrollment is not mutable"))
 	case "academictoken.student.StudentEnrollment.academicTreeId":
 		panic(fmt.Errorf("field academicTreeId of message academictoken.student.StudentEnrollment is not mutable"))
+	case "academictoken.student.StudentEnrollment.curriculumVersion":
+		panic(fmt.Errorf("field curriculumVersion of message academictoken.student.StudentEnrollment is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentEnrollment"))
@@ -333,6 +352,8 @@ func (x *fastReflection_StudentEnrollment) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfString("")
 	case "academictoken.student.StudentEnrollment.academicTreeId":
 		return protoreflect.ValueOfString("")
+	case "academictoken.student.StudentEnrollment.curriculumVersion":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.StudentEnrollment"))
@@ -430,6 +451,10 @@ func (x *fastReflection_StudentEnrollment) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.CurriculumVersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -459,6 +484,13 @@ func (x *fastReflection_StudentEnrollment) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CurriculumVersion) > 0 {
+			i -= len(x.CurriculumVersion)
+			copy(dAtA[i:], x.CurriculumVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurriculumVersion)))
+			i--
+			dAtA[i] = 0x42
+		}
 		if len(x.AcademicTreeId) > 0 {
 			i -= len(x.AcademicTreeId)
 			copy(dAtA[i:], x.AcademicTreeId)
@@ -781,6 +813,38 @@ func (x *fastReflection_StudentEnrollment) ProtoMethods() *protoiface.Methods {
 				}
 				x.AcademicTreeId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurriculumVersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurriculumVersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -841,6 +905,8 @@ type StudentEnrollment struct {
 	EnrollmentDate string `protobuf:"bytes,5,opt,name=enrollmentDate,proto3" json:"enrollmentDate,omitempty"`
 	Status         string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	AcademicTreeId string `protobuf:"bytes,7,opt,name=academicTreeId,proto3" json:"academicTreeId,omitempty"`
+	// curriculumVersion is the published curriculum version the enrollment is pinned to
+	CurriculumVersion string `protobuf:"bytes,8,opt,name=curriculumVersion,proto3" json:"curriculumVersion,omitempty"`
 }
 
 func (x *StudentEnrollment) Reset() {
@@ -912,6 +978,13 @@ func (x *StudentEnrollment) GetAcademicTreeId() string {
 	return ""
 }
 
+func (x *StudentEnrollment) GetCurriculumVersion() string {
+	if x != nil {
+		return x.CurriculumVersion
+	}
+	return ""
+}
+
 var File_academictoken_student_student_enrollment_proto protoreflect.FileDescriptor
 
 var file_academictoken_student_student_enrollment_proto_rawDesc = []byte{
@@ -919,7 +992,7 @@ var file_academictoken_student_student_enrollment_proto_rawDesc = []byte{
 	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
 	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x15, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x75, 0x64,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0x97, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x75, 0x64,
 	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
 	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e,
 	0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x02,
@@ -934,20 +1007,23 @@ var file_academictoken_student_student_enrollment_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x61,
 	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x54, 0x72, 0x65, 0x65, 0x49, 0x64, 0x18, 0x07, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x54, 0x72, 0x65,
-	0x65, 0x49, 0x64, 0x42, 0xd1, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x42, 0x16, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0xca, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x16, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75,
+	0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x63, 0x75, 0x6c, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0xd1, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42,
+	0x16, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0xca, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
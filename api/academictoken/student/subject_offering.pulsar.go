@@ -0,0 +1,1416 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package student
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_SubjectOffering_9_list)(nil)
+
+type _SubjectOffering_9_list struct {
+	list *[]string
+}
+
+func (x *_SubjectOffering_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SubjectOffering_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SubjectOffering_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SubjectOffering_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SubjectOffering_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SubjectOffering at list field EnrolledStudents as it is not of Message kind"))
+}
+
+func (x *_SubjectOffering_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SubjectOffering_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SubjectOffering_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SubjectOffering_10_list)(nil)
+
+type _SubjectOffering_10_list struct {
+	list *[]string
+}
+
+func (x *_SubjectOffering_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SubjectOffering_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SubjectOffering_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SubjectOffering_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SubjectOffering_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SubjectOffering at list field Waitlist as it is not of Message kind"))
+}
+
+func (x *_SubjectOffering_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SubjectOffering_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SubjectOffering_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SubjectOffering                  protoreflect.MessageDescriptor
+	fd_SubjectOffering_index            protoreflect.FieldDescriptor
+	fd_SubjectOffering_subjectId        protoreflect.FieldDescriptor
+	fd_SubjectOffering_institution      protoreflect.FieldDescriptor
+	fd_SubjectOffering_courseId         protoreflect.FieldDescriptor
+	fd_SubjectOffering_term             protoreflect.FieldDescriptor
+	fd_SubjectOffering_section          protoreflect.FieldDescriptor
+	fd_SubjectOffering_capacity         protoreflect.FieldDescriptor
+	fd_SubjectOffering_instructor       protoreflect.FieldDescriptor
+	fd_SubjectOffering_enrolledStudents protoreflect.FieldDescriptor
+	fd_SubjectOffering_waitlist         protoreflect.FieldDescriptor
+	fd_SubjectOffering_creator          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_student_subject_offering_proto_init()
+	md_SubjectOffering = File_academictoken_student_subject_offering_proto.Messages().ByName("SubjectOffering")
+	fd_SubjectOffering_index = md_SubjectOffering.Fields().ByName("index")
+	fd_SubjectOffering_subjectId = md_SubjectOffering.Fields().ByName("subjectId")
+	fd_SubjectOffering_institution = md_SubjectOffering.Fields().ByName("institution")
+	fd_SubjectOffering_courseId = md_SubjectOffering.Fields().ByName("courseId")
+	fd_SubjectOffering_term = md_SubjectOffering.Fields().ByName("term")
+	fd_SubjectOffering_section = md_SubjectOffering.Fields().ByName("section")
+	fd_SubjectOffering_capacity = md_SubjectOffering.Fields().ByName("capacity")
+	fd_SubjectOffering_instructor = md_SubjectOffering.Fields().ByName("instructor")
+	fd_SubjectOffering_enrolledStudents = md_SubjectOffering.Fields().ByName("enrolledStudents")
+	fd_SubjectOffering_waitlist = md_SubjectOffering.Fields().ByName("waitlist")
+	fd_SubjectOffering_creator = md_SubjectOffering.Fields().ByName("creator")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubjectOffering)(nil)
+
+type fastReflection_SubjectOffering SubjectOffering
+
+func (x *SubjectOffering) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubjectOffering)(x)
+}
+
+func (x *SubjectOffering) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_student_subject_offering_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubjectOffering_messageType fastReflection_SubjectOffering_messageType
+var _ protoreflect.MessageType = fastReflection_SubjectOffering_messageType{}
+
+type fastReflection_SubjectOffering_messageType struct{}
+
+func (x fastReflection_SubjectOffering_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubjectOffering)(nil)
+}
+func (x fastReflection_SubjectOffering_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubjectOffering)
+}
+func (x fastReflection_SubjectOffering_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubjectOffering
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubjectOffering) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubjectOffering
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubjectOffering) Type() protoreflect.MessageType {
+	return _fastReflection_SubjectOffering_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubjectOffering) New() protoreflect.Message {
+	return new(fastReflection_SubjectOffering)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubjectOffering) Interface() protoreflect.ProtoMessage {
+	return (*SubjectOffering)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubjectOffering) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_SubjectOffering_index, value) {
+			return
+		}
+	}
+	if x.SubjectId != "" {
+		value := protoreflect.ValueOfString(x.SubjectId)
+		if !f(fd_SubjectOffering_subjectId, value) {
+			return
+		}
+	}
+	if x.Institution != "" {
+		value := protoreflect.ValueOfString(x.Institution)
+		if !f(fd_SubjectOffering_institution, value) {
+			return
+		}
+	}
+	if x.CourseId != "" {
+		value := protoreflect.ValueOfString(x.CourseId)
+		if !f(fd_SubjectOffering_courseId, value) {
+			return
+		}
+	}
+	if x.Term != "" {
+		value := protoreflect.ValueOfString(x.Term)
+		if !f(fd_SubjectOffering_term, value) {
+			return
+		}
+	}
+	if x.Section != "" {
+		value := protoreflect.ValueOfString(x.Section)
+		if !f(fd_SubjectOffering_section, value) {
+			return
+		}
+	}
+	if x.Capacity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Capacity)
+		if !f(fd_SubjectOffering_capacity, value) {
+			return
+		}
+	}
+	if x.Instructor != "" {
+		value := protoreflect.ValueOfString(x.Instructor)
+		if !f(fd_SubjectOffering_instructor, value) {
+			return
+		}
+	}
+	if len(x.EnrolledStudents) != 0 {
+		value := protoreflect.ValueOfList(&_SubjectOffering_9_list{list: &x.EnrolledStudents})
+		if !f(fd_SubjectOffering_enrolledStudents, value) {
+			return
+		}
+	}
+	if len(x.Waitlist) != 0 {
+		value := protoreflect.ValueOfList(&_SubjectOffering_10_list{list: &x.Waitlist})
+		if !f(fd_SubjectOffering_waitlist, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_SubjectOffering_creator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubjectOffering) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectOffering.index":
+		return x.Index != ""
+	case "academictoken.student.SubjectOffering.subjectId":
+		return x.SubjectId != ""
+	case "academictoken.student.SubjectOffering.institution":
+		return x.Institution != ""
+	case "academictoken.student.SubjectOffering.courseId":
+		return x.CourseId != ""
+	case "academictoken.student.SubjectOffering.term":
+		return x.Term != ""
+	case "academictoken.student.SubjectOffering.section":
+		return x.Section != ""
+	case "academictoken.student.SubjectOffering.capacity":
+		return x.Capacity != uint64(0)
+	case "academictoken.student.SubjectOffering.instructor":
+		return x.Instructor != ""
+	case "academictoken.student.SubjectOffering.enrolledStudents":
+		return len(x.EnrolledStudents) != 0
+	case "academictoken.student.SubjectOffering.waitlist":
+		return len(x.Waitlist) != 0
+	case "academictoken.student.SubjectOffering.creator":
+		return x.Creator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectOffering"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectOffering does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubjectOffering) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectOffering.index":
+		x.Index = ""
+	case "academictoken.student.SubjectOffering.subjectId":
+		x.SubjectId = ""
+	case "academictoken.student.SubjectOffering.institution":
+		x.Institution = ""
+	case "academictoken.student.SubjectOffering.courseId":
+		x.CourseId = ""
+	case "academictoken.student.SubjectOffering.term":
+		x.Term = ""
+	case "academictoken.student.SubjectOffering.section":
+		x.Section = ""
+	case "academictoken.student.SubjectOffering.capacity":
+		x.Capacity = uint64(0)
+	case "academictoken.student.SubjectOffering.instructor":
+		x.Instructor = ""
+	case "academictoken.student.SubjectOffering.enrolledStudents":
+		x.EnrolledStudents = nil
+	case "academictoken.student.SubjectOffering.waitlist":
+		x.Waitlist = nil
+	case "academictoken.student.SubjectOffering.creator":
+		x.Creator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectOffering"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectOffering does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubjectOffering) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.student.SubjectOffering.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectOffering.subjectId":
+		value := x.SubjectId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectOffering.institution":
+		value := x.Institution
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectOffering.courseId":
+		value := x.CourseId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectOffering.term":
+		value := x.Term
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectOffering.section":
+		value := x.Section
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectOffering.capacity":
+		value := x.Capacity
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.SubjectOffering.instructor":
+		value := x.Instructor
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.SubjectOffering.enrolledStudents":
+		if len(x.EnrolledStudents) == 0 {
+			return protoreflect.ValueOfList(&_SubjectOffering_9_list{})
+		}
+		listValue := &_SubjectOffering_9_list{list: &x.EnrolledStudents}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.SubjectOffering.waitlist":
+		if len(x.Waitlist) == 0 {
+			return protoreflect.ValueOfList(&_SubjectOffering_10_list{})
+		}
+		listValue := &_SubjectOffering_10_list{list: &x.Waitlist}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.SubjectOffering.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectOffering"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectOffering does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubjectOffering) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectOffering.index":
+		x.Index = value.Interface().(string)
+	case "academictoken.student.SubjectOffering.subjectId":
+		x.SubjectId = value.Interface().(string)
+	case "academictoken.student.SubjectOffering.institution":
+		x.Institution = value.Interface().(string)
+	case "academictoken.student.SubjectOffering.courseId":
+		x.CourseId = value.Interface().(string)
+	case "academictoken.student.SubjectOffering.term":
+		x.Term = value.Interface().(string)
+	case "academictoken.student.SubjectOffering.section":
+		x.Section = value.Interface().(string)
+	case "academictoken.student.SubjectOffering.capacity":
+		x.Capacity = value.Uint()
+	case "academictoken.student.SubjectOffering.instructor":
+		x.Instructor = value.Interface().(string)
+	case "academictoken.student.SubjectOffering.enrolledStudents":
+		lv := value.List()
+		clv := lv.(*_SubjectOffering_9_list)
+		x.EnrolledStudents = *clv.list
+	case "academictoken.student.SubjectOffering.waitlist":
+		lv := value.List()
+		clv := lv.(*_SubjectOffering_10_list)
+		x.Waitlist = *clv.list
+	case "academictoken.student.SubjectOffering.creator":
+		x.Creator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectOffering"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectOffering does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubjectOffering) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectOffering.enrolledStudents":
+		if x.EnrolledStudents == nil {
+			x.EnrolledStudents = []string{}
+		}
+		value := &_SubjectOffering_9_list{list: &x.EnrolledStudents}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.SubjectOffering.waitlist":
+		if x.Waitlist == nil {
+			x.Waitlist = []string{}
+		}
+		value := &_SubjectOffering_10_list{list: &x.Waitlist}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.SubjectOffering.index":
+		panic(fmt.Errorf("field index of message academictoken.student.SubjectOffering is not mutable"))
+	case "academictoken.student.SubjectOffering.subjectId":
+		panic(fmt.Errorf("field subjectId of message academictoken.student.SubjectOffering is not mutable"))
+	case "academictoken.student.SubjectOffering.institution":
+		panic(fmt.Errorf("field institution of message academictoken.student.SubjectOffering is not mutable"))
+	case "academictoken.student.SubjectOffering.courseId":
+		panic(fmt.Errorf("field courseId of message academictoken.student.SubjectOffering is not mutable"))
+	case "academictoken.student.SubjectOffering.term":
+		panic(fmt.Errorf("field term of message academictoken.student.SubjectOffering is not mutable"))
+	case "academictoken.student.SubjectOffering.section":
+		panic(fmt.Errorf("field section of message academictoken.student.SubjectOffering is not mutable"))
+	case "academictoken.student.SubjectOffering.capacity":
+		panic(fmt.Errorf("field capacity of message academictoken.student.SubjectOffering is not mutable"))
+	case "academictoken.student.SubjectOffering.instructor":
+		panic(fmt.Errorf("field instructor of message academictoken.student.SubjectOffering is not mutable"))
+	case "academictoken.student.SubjectOffering.creator":
+		panic(fmt.Errorf("field creator of message academictoken.student.SubjectOffering is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectOffering"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectOffering does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubjectOffering) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.SubjectOffering.index":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectOffering.subjectId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectOffering.institution":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectOffering.courseId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectOffering.term":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectOffering.section":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectOffering.capacity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.SubjectOffering.instructor":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.SubjectOffering.enrolledStudents":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SubjectOffering_9_list{list: &list})
+	case "academictoken.student.SubjectOffering.waitlist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SubjectOffering_10_list{list: &list})
+	case "academictoken.student.SubjectOffering.creator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.SubjectOffering"))
+		}
+		panic(fmt.Errorf("message academictoken.student.SubjectOffering does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubjectOffering) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.student.SubjectOffering", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubjectOffering) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubjectOffering) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubjectOffering) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubjectOffering) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubjectOffering)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Institution)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CourseId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Term)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Section)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Capacity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Capacity))
+		}
+		l = len(x.Instructor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.EnrolledStudents) > 0 {
+			for _, s := range x.EnrolledStudents {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Waitlist) > 0 {
+			for _, s := range x.Waitlist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubjectOffering)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Waitlist) > 0 {
+			for iNdEx := len(x.Waitlist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Waitlist[iNdEx])
+				copy(dAtA[i:], x.Waitlist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Waitlist[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.EnrolledStudents) > 0 {
+			for iNdEx := len(x.EnrolledStudents) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.EnrolledStudents[iNdEx])
+				copy(dAtA[i:], x.EnrolledStudents[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EnrolledStudents[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.Instructor) > 0 {
+			i -= len(x.Instructor)
+			copy(dAtA[i:], x.Instructor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Instructor)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Capacity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Capacity))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Section) > 0 {
+			i -= len(x.Section)
+			copy(dAtA[i:], x.Section)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Section)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Term) > 0 {
+			i -= len(x.Term)
+			copy(dAtA[i:], x.Term)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Term)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.CourseId) > 0 {
+			i -= len(x.CourseId)
+			copy(dAtA[i:], x.CourseId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CourseId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Institution) > 0 {
+			i -= len(x.Institution)
+			copy(dAtA[i:], x.Institution)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Institution)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SubjectId) > 0 {
+			i -= len(x.SubjectId)
+			copy(dAtA[i:], x.SubjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubjectId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubjectOffering)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubjectOffering: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubjectOffering: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Institution = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CourseId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Term = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Section", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Section = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				}
+				x.Capacity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Capacity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Instructor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Instructor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnrolledStudents", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EnrolledStudents = append(x.EnrolledStudents, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Waitlist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Waitlist = append(x.Waitlist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/student/subject_offering.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SubjectOffering is a section of a subject taught in an academic term with a
+// limited number of seats. Students requesting a full offering wait in line
+// and are promoted in order as seats free up.
+type SubjectOffering struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index            string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	SubjectId        string   `protobuf:"bytes,2,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	Institution      string   `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
+	CourseId         string   `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	Term             string   `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+	Section          string   `protobuf:"bytes,6,opt,name=section,proto3" json:"section,omitempty"`
+	Capacity         uint64   `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Instructor       string   `protobuf:"bytes,8,opt,name=instructor,proto3" json:"instructor,omitempty"`
+	EnrolledStudents []string `protobuf:"bytes,9,rep,name=enrolledStudents,proto3" json:"enrolledStudents,omitempty"`
+	Waitlist         []string `protobuf:"bytes,10,rep,name=waitlist,proto3" json:"waitlist,omitempty"` // student IDs in promotion order
+	Creator          string   `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *SubjectOffering) Reset() {
+	*x = SubjectOffering{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_student_subject_offering_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubjectOffering) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectOffering) ProtoMessage() {}
+
+// Deprecated: Use SubjectOffering.ProtoReflect.Descriptor instead.
+func (*SubjectOffering) Descriptor() ([]byte, []int) {
+	return file_academictoken_student_subject_offering_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubjectOffering) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *SubjectOffering) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *SubjectOffering) GetInstitution() string {
+	if x != nil {
+		return x.Institution
+	}
+	return ""
+}
+
+func (x *SubjectOffering) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *SubjectOffering) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *SubjectOffering) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SubjectOffering) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *SubjectOffering) GetInstructor() string {
+	if x != nil {
+		return x.Instructor
+	}
+	return ""
+}
+
+func (x *SubjectOffering) GetEnrolledStudents() []string {
+	if x != nil {
+		return x.EnrolledStudents
+	}
+	return nil
+}
+
+func (x *SubjectOffering) GetWaitlist() []string {
+	if x != nil {
+		return x.Waitlist
+	}
+	return nil
+}
+
+func (x *SubjectOffering) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+var File_academictoken_student_subject_offering_proto protoreflect.FileDescriptor
+
+var file_academictoken_student_subject_offering_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0xcf, 0x02, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0xcf, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x14, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xe2, 0x02, 0x21, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_academictoken_student_subject_offering_proto_rawDescOnce sync.Once
+	file_academictoken_student_subject_offering_proto_rawDescData = file_academictoken_student_subject_offering_proto_rawDesc
+)
+
+func file_academictoken_student_subject_offering_proto_rawDescGZIP() []byte {
+	file_academictoken_student_subject_offering_proto_rawDescOnce.Do(func() {
+		file_academictoken_student_subject_offering_proto_rawDescData = protoimpl.X.CompressGZIP(file_academictoken_student_subject_offering_proto_rawDescData)
+	})
+	return file_academictoken_student_subject_offering_proto_rawDescData
+}
+
+var file_academictoken_student_subject_offering_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_academictoken_student_subject_offering_proto_goTypes = []interface{}{
+	(*SubjectOffering)(nil), // 0: academictoken.student.SubjectOffering
+}
+var file_academictoken_student_subject_offering_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_academictoken_student_subject_offering_proto_init() }
+func file_academictoken_student_subject_offering_proto_init() {
+	if File_academictoken_student_subject_offering_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_academictoken_student_subject_offering_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubjectOffering); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_academictoken_student_subject_offering_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_academictoken_student_subject_offering_proto_goTypes,
+		DependencyIndexes: file_academictoken_student_subject_offering_proto_depIdxs,
+		MessageInfos:      file_academictoken_student_subject_offering_proto_msgTypes,
+	}.Build()
+	File_academictoken_student_subject_offering_proto = out.File
+	file_academictoken_student_subject_offering_proto_rawDesc = nil
+	file_academictoken_student_subject_offering_proto_goTypes = nil
+	file_academictoken_student_subject_offering_proto_depIdxs = nil
+}
@@ -0,0 +1,3183 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package student
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_TranscriptEntry                   protoreflect.MessageDescriptor
+	fd_TranscriptEntry_subject_id        protoreflect.FieldDescriptor
+	fd_TranscriptEntry_code              protoreflect.FieldDescriptor
+	fd_TranscriptEntry_title             protoreflect.FieldDescriptor
+	fd_TranscriptEntry_grade             protoreflect.FieldDescriptor
+	fd_TranscriptEntry_credits           protoreflect.FieldDescriptor
+	fd_TranscriptEntry_workload_hours    protoreflect.FieldDescriptor
+	fd_TranscriptEntry_knowledge_area    protoreflect.FieldDescriptor
+	fd_TranscriptEntry_token_instance_id protoreflect.FieldDescriptor
+	fd_TranscriptEntry_completion_date   protoreflect.FieldDescriptor
+	fd_TranscriptEntry_institution       protoreflect.FieldDescriptor
+	fd_TranscriptEntry_passed            protoreflect.FieldDescriptor
+	fd_TranscriptEntry_counts_toward_gpa protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_student_transcript_proto_init()
+	md_TranscriptEntry = File_academictoken_student_transcript_proto.Messages().ByName("TranscriptEntry")
+	fd_TranscriptEntry_subject_id = md_TranscriptEntry.Fields().ByName("subject_id")
+	fd_TranscriptEntry_code = md_TranscriptEntry.Fields().ByName("code")
+	fd_TranscriptEntry_title = md_TranscriptEntry.Fields().ByName("title")
+	fd_TranscriptEntry_grade = md_TranscriptEntry.Fields().ByName("grade")
+	fd_TranscriptEntry_credits = md_TranscriptEntry.Fields().ByName("credits")
+	fd_TranscriptEntry_workload_hours = md_TranscriptEntry.Fields().ByName("workload_hours")
+	fd_TranscriptEntry_knowledge_area = md_TranscriptEntry.Fields().ByName("knowledge_area")
+	fd_TranscriptEntry_token_instance_id = md_TranscriptEntry.Fields().ByName("token_instance_id")
+	fd_TranscriptEntry_completion_date = md_TranscriptEntry.Fields().ByName("completion_date")
+	fd_TranscriptEntry_institution = md_TranscriptEntry.Fields().ByName("institution")
+	fd_TranscriptEntry_passed = md_TranscriptEntry.Fields().ByName("passed")
+	fd_TranscriptEntry_counts_toward_gpa = md_TranscriptEntry.Fields().ByName("counts_toward_gpa")
+}
+
+var _ protoreflect.Message = (*fastReflection_TranscriptEntry)(nil)
+
+type fastReflection_TranscriptEntry TranscriptEntry
+
+func (x *TranscriptEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TranscriptEntry)(x)
+}
+
+func (x *TranscriptEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_student_transcript_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TranscriptEntry_messageType fastReflection_TranscriptEntry_messageType
+var _ protoreflect.MessageType = fastReflection_TranscriptEntry_messageType{}
+
+type fastReflection_TranscriptEntry_messageType struct{}
+
+func (x fastReflection_TranscriptEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TranscriptEntry)(nil)
+}
+func (x fastReflection_TranscriptEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_TranscriptEntry)
+}
+func (x fastReflection_TranscriptEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TranscriptEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TranscriptEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_TranscriptEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TranscriptEntry) Type() protoreflect.MessageType {
+	return _fastReflection_TranscriptEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TranscriptEntry) New() protoreflect.Message {
+	return new(fastReflection_TranscriptEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TranscriptEntry) Interface() protoreflect.ProtoMessage {
+	return (*TranscriptEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TranscriptEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubjectId != "" {
+		value := protoreflect.ValueOfString(x.SubjectId)
+		if !f(fd_TranscriptEntry_subject_id, value) {
+			return
+		}
+	}
+	if x.Code != "" {
+		value := protoreflect.ValueOfString(x.Code)
+		if !f(fd_TranscriptEntry_code, value) {
+			return
+		}
+	}
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_TranscriptEntry_title, value) {
+			return
+		}
+	}
+	if x.Grade != "" {
+		value := protoreflect.ValueOfString(x.Grade)
+		if !f(fd_TranscriptEntry_grade, value) {
+			return
+		}
+	}
+	if x.Credits != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Credits)
+		if !f(fd_TranscriptEntry_credits, value) {
+			return
+		}
+	}
+	if x.WorkloadHours != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WorkloadHours)
+		if !f(fd_TranscriptEntry_workload_hours, value) {
+			return
+		}
+	}
+	if x.KnowledgeArea != "" {
+		value := protoreflect.ValueOfString(x.KnowledgeArea)
+		if !f(fd_TranscriptEntry_knowledge_area, value) {
+			return
+		}
+	}
+	if x.TokenInstanceId != "" {
+		value := protoreflect.ValueOfString(x.TokenInstanceId)
+		if !f(fd_TranscriptEntry_token_instance_id, value) {
+			return
+		}
+	}
+	if x.CompletionDate != "" {
+		value := protoreflect.ValueOfString(x.CompletionDate)
+		if !f(fd_TranscriptEntry_completion_date, value) {
+			return
+		}
+	}
+	if x.Institution != "" {
+		value := protoreflect.ValueOfString(x.Institution)
+		if !f(fd_TranscriptEntry_institution, value) {
+			return
+		}
+	}
+	if x.Passed != false {
+		value := protoreflect.ValueOfBool(x.Passed)
+		if !f(fd_TranscriptEntry_passed, value) {
+			return
+		}
+	}
+	if x.CountsTowardGpa != false {
+		value := protoreflect.ValueOfBool(x.CountsTowardGpa)
+		if !f(fd_TranscriptEntry_counts_toward_gpa, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TranscriptEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptEntry.subject_id":
+		return x.SubjectId != ""
+	case "academictoken.student.TranscriptEntry.code":
+		return x.Code != ""
+	case "academictoken.student.TranscriptEntry.title":
+		return x.Title != ""
+	case "academictoken.student.TranscriptEntry.grade":
+		return x.Grade != ""
+	case "academictoken.student.TranscriptEntry.credits":
+		return x.Credits != uint64(0)
+	case "academictoken.student.TranscriptEntry.workload_hours":
+		return x.WorkloadHours != uint64(0)
+	case "academictoken.student.TranscriptEntry.knowledge_area":
+		return x.KnowledgeArea != ""
+	case "academictoken.student.TranscriptEntry.token_instance_id":
+		return x.TokenInstanceId != ""
+	case "academictoken.student.TranscriptEntry.completion_date":
+		return x.CompletionDate != ""
+	case "academictoken.student.TranscriptEntry.institution":
+		return x.Institution != ""
+	case "academictoken.student.TranscriptEntry.passed":
+		return x.Passed != false
+	case "academictoken.student.TranscriptEntry.counts_toward_gpa":
+		return x.CountsTowardGpa != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptEntry"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TranscriptEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptEntry.subject_id":
+		x.SubjectId = ""
+	case "academictoken.student.TranscriptEntry.code":
+		x.Code = ""
+	case "academictoken.student.TranscriptEntry.title":
+		x.Title = ""
+	case "academictoken.student.TranscriptEntry.grade":
+		x.Grade = ""
+	case "academictoken.student.TranscriptEntry.credits":
+		x.Credits = uint64(0)
+	case "academictoken.student.TranscriptEntry.workload_hours":
+		x.WorkloadHours = uint64(0)
+	case "academictoken.student.TranscriptEntry.knowledge_area":
+		x.KnowledgeArea = ""
+	case "academictoken.student.TranscriptEntry.token_instance_id":
+		x.TokenInstanceId = ""
+	case "academictoken.student.TranscriptEntry.completion_date":
+		x.CompletionDate = ""
+	case "academictoken.student.TranscriptEntry.institution":
+		x.Institution = ""
+	case "academictoken.student.TranscriptEntry.passed":
+		x.Passed = false
+	case "academictoken.student.TranscriptEntry.counts_toward_gpa":
+		x.CountsTowardGpa = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptEntry"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TranscriptEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.student.TranscriptEntry.subject_id":
+		value := x.SubjectId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptEntry.code":
+		value := x.Code
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptEntry.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptEntry.grade":
+		value := x.Grade
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptEntry.credits":
+		value := x.Credits
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.TranscriptEntry.workload_hours":
+		value := x.WorkloadHours
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.TranscriptEntry.knowledge_area":
+		value := x.KnowledgeArea
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptEntry.token_instance_id":
+		value := x.TokenInstanceId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptEntry.completion_date":
+		value := x.CompletionDate
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptEntry.institution":
+		value := x.Institution
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptEntry.passed":
+		value := x.Passed
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.student.TranscriptEntry.counts_toward_gpa":
+		value := x.CountsTowardGpa
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptEntry"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TranscriptEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptEntry.subject_id":
+		x.SubjectId = value.Interface().(string)
+	case "academictoken.student.TranscriptEntry.code":
+		x.Code = value.Interface().(string)
+	case "academictoken.student.TranscriptEntry.title":
+		x.Title = value.Interface().(string)
+	case "academictoken.student.TranscriptEntry.grade":
+		x.Grade = value.Interface().(string)
+	case "academictoken.student.TranscriptEntry.credits":
+		x.Credits = value.Uint()
+	case "academictoken.student.TranscriptEntry.workload_hours":
+		x.WorkloadHours = value.Uint()
+	case "academictoken.student.TranscriptEntry.knowledge_area":
+		x.KnowledgeArea = value.Interface().(string)
+	case "academictoken.student.TranscriptEntry.token_instance_id":
+		x.TokenInstanceId = value.Interface().(string)
+	case "academictoken.student.TranscriptEntry.completion_date":
+		x.CompletionDate = value.Interface().(string)
+	case "academictoken.student.TranscriptEntry.institution":
+		x.Institution = value.Interface().(string)
+	case "academictoken.student.TranscriptEntry.passed":
+		x.Passed = value.Bool()
+	case "academictoken.student.TranscriptEntry.counts_toward_gpa":
+		x.CountsTowardGpa = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptEntry"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TranscriptEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptEntry.subject_id":
+		panic(fmt.Errorf("field subject_id of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.code":
+		panic(fmt.Errorf("field code of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.title":
+		panic(fmt.Errorf("field title of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.grade":
+		panic(fmt.Errorf("field grade of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.credits":
+		panic(fmt.Errorf("field credits of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.workload_hours":
+		panic(fmt.Errorf("field workload_hours of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.knowledge_area":
+		panic(fmt.Errorf("field knowledge_area of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.token_instance_id":
+		panic(fmt.Errorf("field token_instance_id of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.completion_date":
+		panic(fmt.Errorf("field completion_date of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.institution":
+		panic(fmt.Errorf("field institution of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.passed":
+		panic(fmt.Errorf("field passed of message academictoken.student.TranscriptEntry is not mutable"))
+	case "academictoken.student.TranscriptEntry.counts_toward_gpa":
+		panic(fmt.Errorf("field counts_toward_gpa of message academictoken.student.TranscriptEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptEntry"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TranscriptEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptEntry.subject_id":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptEntry.code":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptEntry.title":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptEntry.grade":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptEntry.credits":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.TranscriptEntry.workload_hours":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.TranscriptEntry.knowledge_area":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptEntry.token_instance_id":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptEntry.completion_date":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptEntry.institution":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptEntry.passed":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.student.TranscriptEntry.counts_toward_gpa":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptEntry"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TranscriptEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.student.TranscriptEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TranscriptEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TranscriptEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TranscriptEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TranscriptEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TranscriptEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SubjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Code)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grade)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Credits != 0 {
+			n += 1 + runtime.Sov(uint64(x.Credits))
+		}
+		if x.WorkloadHours != 0 {
+			n += 1 + runtime.Sov(uint64(x.WorkloadHours))
+		}
+		l = len(x.KnowledgeArea)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenInstanceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CompletionDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Institution)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Passed {
+			n += 2
+		}
+		if x.CountsTowardGpa {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TranscriptEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CountsTowardGpa {
+			i--
+			if x.CountsTowardGpa {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.Passed {
+			i--
+			if x.Passed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Institution) > 0 {
+			i -= len(x.Institution)
+			copy(dAtA[i:], x.Institution)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Institution)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.CompletionDate) > 0 {
+			i -= len(x.CompletionDate)
+			copy(dAtA[i:], x.CompletionDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CompletionDate)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.TokenInstanceId) > 0 {
+			i -= len(x.TokenInstanceId)
+			copy(dAtA[i:], x.TokenInstanceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenInstanceId)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.KnowledgeArea) > 0 {
+			i -= len(x.KnowledgeArea)
+			copy(dAtA[i:], x.KnowledgeArea)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KnowledgeArea)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.WorkloadHours != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkloadHours))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Credits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Credits))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Grade) > 0 {
+			i -= len(x.Grade)
+			copy(dAtA[i:], x.Grade)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grade)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SubjectId) > 0 {
+			i -= len(x.SubjectId)
+			copy(dAtA[i:], x.SubjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TranscriptEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TranscriptEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TranscriptEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Code = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grade = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+				}
+				x.Credits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Credits |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkloadHours", wireType)
+				}
+				x.WorkloadHours = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkloadHours |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KnowledgeArea", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KnowledgeArea = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CompletionDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Institution = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Passed = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountsTowardGpa", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CountsTowardGpa = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TranscriptSemester_2_list)(nil)
+
+type _TranscriptSemester_2_list struct {
+	list *[]*TranscriptEntry
+}
+
+func (x *_TranscriptSemester_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TranscriptSemester_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TranscriptSemester_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TranscriptEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TranscriptSemester_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TranscriptEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TranscriptSemester_2_list) AppendMutable() protoreflect.Value {
+	v := new(TranscriptEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TranscriptSemester_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TranscriptSemester_2_list) NewElement() protoreflect.Value {
+	v := new(TranscriptEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TranscriptSemester_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TranscriptSemester                protoreflect.MessageDescriptor
+	fd_TranscriptSemester_semester       protoreflect.FieldDescriptor
+	fd_TranscriptSemester_subjects       protoreflect.FieldDescriptor
+	fd_TranscriptSemester_credits        protoreflect.FieldDescriptor
+	fd_TranscriptSemester_workload_hours protoreflect.FieldDescriptor
+	fd_TranscriptSemester_gpa            protoreflect.FieldDescriptor
+	fd_TranscriptSemester_cumulative_gpa protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_student_transcript_proto_init()
+	md_TranscriptSemester = File_academictoken_student_transcript_proto.Messages().ByName("TranscriptSemester")
+	fd_TranscriptSemester_semester = md_TranscriptSemester.Fields().ByName("semester")
+	fd_TranscriptSemester_subjects = md_TranscriptSemester.Fields().ByName("subjects")
+	fd_TranscriptSemester_credits = md_TranscriptSemester.Fields().ByName("credits")
+	fd_TranscriptSemester_workload_hours = md_TranscriptSemester.Fields().ByName("workload_hours")
+	fd_TranscriptSemester_gpa = md_TranscriptSemester.Fields().ByName("gpa")
+	fd_TranscriptSemester_cumulative_gpa = md_TranscriptSemester.Fields().ByName("cumulative_gpa")
+}
+
+var _ protoreflect.Message = (*fastReflection_TranscriptSemester)(nil)
+
+type fastReflection_TranscriptSemester TranscriptSemester
+
+func (x *TranscriptSemester) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TranscriptSemester)(x)
+}
+
+func (x *TranscriptSemester) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_student_transcript_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TranscriptSemester_messageType fastReflection_TranscriptSemester_messageType
+var _ protoreflect.MessageType = fastReflection_TranscriptSemester_messageType{}
+
+type fastReflection_TranscriptSemester_messageType struct{}
+
+func (x fastReflection_TranscriptSemester_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TranscriptSemester)(nil)
+}
+func (x fastReflection_TranscriptSemester_messageType) New() protoreflect.Message {
+	return new(fastReflection_TranscriptSemester)
+}
+func (x fastReflection_TranscriptSemester_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TranscriptSemester
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TranscriptSemester) Descriptor() protoreflect.MessageDescriptor {
+	return md_TranscriptSemester
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TranscriptSemester) Type() protoreflect.MessageType {
+	return _fastReflection_TranscriptSemester_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TranscriptSemester) New() protoreflect.Message {
+	return new(fastReflection_TranscriptSemester)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TranscriptSemester) Interface() protoreflect.ProtoMessage {
+	return (*TranscriptSemester)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TranscriptSemester) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Semester != "" {
+		value := protoreflect.ValueOfString(x.Semester)
+		if !f(fd_TranscriptSemester_semester, value) {
+			return
+		}
+	}
+	if len(x.Subjects) != 0 {
+		value := protoreflect.ValueOfList(&_TranscriptSemester_2_list{list: &x.Subjects})
+		if !f(fd_TranscriptSemester_subjects, value) {
+			return
+		}
+	}
+	if x.Credits != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Credits)
+		if !f(fd_TranscriptSemester_credits, value) {
+			return
+		}
+	}
+	if x.WorkloadHours != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WorkloadHours)
+		if !f(fd_TranscriptSemester_workload_hours, value) {
+			return
+		}
+	}
+	if x.Gpa != "" {
+		value := protoreflect.ValueOfString(x.Gpa)
+		if !f(fd_TranscriptSemester_gpa, value) {
+			return
+		}
+	}
+	if x.CumulativeGpa != "" {
+		value := protoreflect.ValueOfString(x.CumulativeGpa)
+		if !f(fd_TranscriptSemester_cumulative_gpa, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TranscriptSemester) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptSemester.semester":
+		return x.Semester != ""
+	case "academictoken.student.TranscriptSemester.subjects":
+		return len(x.Subjects) != 0
+	case "academictoken.student.TranscriptSemester.credits":
+		return x.Credits != uint64(0)
+	case "academictoken.student.TranscriptSemester.workload_hours":
+		return x.WorkloadHours != uint64(0)
+	case "academictoken.student.TranscriptSemester.gpa":
+		return x.Gpa != ""
+	case "academictoken.student.TranscriptSemester.cumulative_gpa":
+		return x.CumulativeGpa != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptSemester"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptSemester does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TranscriptSemester) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptSemester.semester":
+		x.Semester = ""
+	case "academictoken.student.TranscriptSemester.subjects":
+		x.Subjects = nil
+	case "academictoken.student.TranscriptSemester.credits":
+		x.Credits = uint64(0)
+	case "academictoken.student.TranscriptSemester.workload_hours":
+		x.WorkloadHours = uint64(0)
+	case "academictoken.student.TranscriptSemester.gpa":
+		x.Gpa = ""
+	case "academictoken.student.TranscriptSemester.cumulative_gpa":
+		x.CumulativeGpa = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptSemester"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptSemester does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TranscriptSemester) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.student.TranscriptSemester.semester":
+		value := x.Semester
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptSemester.subjects":
+		if len(x.Subjects) == 0 {
+			return protoreflect.ValueOfList(&_TranscriptSemester_2_list{})
+		}
+		listValue := &_TranscriptSemester_2_list{list: &x.Subjects}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.student.TranscriptSemester.credits":
+		value := x.Credits
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.TranscriptSemester.workload_hours":
+		value := x.WorkloadHours
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.TranscriptSemester.gpa":
+		value := x.Gpa
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TranscriptSemester.cumulative_gpa":
+		value := x.CumulativeGpa
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptSemester"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptSemester does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TranscriptSemester) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptSemester.semester":
+		x.Semester = value.Interface().(string)
+	case "academictoken.student.TranscriptSemester.subjects":
+		lv := value.List()
+		clv := lv.(*_TranscriptSemester_2_list)
+		x.Subjects = *clv.list
+	case "academictoken.student.TranscriptSemester.credits":
+		x.Credits = value.Uint()
+	case "academictoken.student.TranscriptSemester.workload_hours":
+		x.WorkloadHours = value.Uint()
+	case "academictoken.student.TranscriptSemester.gpa":
+		x.Gpa = value.Interface().(string)
+	case "academictoken.student.TranscriptSemester.cumulative_gpa":
+		x.CumulativeGpa = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptSemester"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptSemester does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TranscriptSemester) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptSemester.subjects":
+		if x.Subjects == nil {
+			x.Subjects = []*TranscriptEntry{}
+		}
+		value := &_TranscriptSemester_2_list{list: &x.Subjects}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.student.TranscriptSemester.semester":
+		panic(fmt.Errorf("field semester of message academictoken.student.TranscriptSemester is not mutable"))
+	case "academictoken.student.TranscriptSemester.credits":
+		panic(fmt.Errorf("field credits of message academictoken.student.TranscriptSemester is not mutable"))
+	case "academictoken.student.TranscriptSemester.workload_hours":
+		panic(fmt.Errorf("field workload_hours of message academictoken.student.TranscriptSemester is not mutable"))
+	case "academictoken.student.TranscriptSemester.gpa":
+		panic(fmt.Errorf("field gpa of message academictoken.student.TranscriptSemester is not mutable"))
+	case "academictoken.student.TranscriptSemester.cumulative_gpa":
+		panic(fmt.Errorf("field cumulative_gpa of message academictoken.student.TranscriptSemester is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptSemester"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptSemester does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TranscriptSemester) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.TranscriptSemester.semester":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptSemester.subjects":
+		list := []*TranscriptEntry{}
+		return protoreflect.ValueOfList(&_TranscriptSemester_2_list{list: &list})
+	case "academictoken.student.TranscriptSemester.credits":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.TranscriptSemester.workload_hours":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.TranscriptSemester.gpa":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TranscriptSemester.cumulative_gpa":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TranscriptSemester"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TranscriptSemester does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TranscriptSemester) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.student.TranscriptSemester", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TranscriptSemester) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TranscriptSemester) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TranscriptSemester) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TranscriptSemester) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TranscriptSemester)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Semester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Subjects) > 0 {
+			for _, e := range x.Subjects {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Credits != 0 {
+			n += 1 + runtime.Sov(uint64(x.Credits))
+		}
+		if x.WorkloadHours != 0 {
+			n += 1 + runtime.Sov(uint64(x.WorkloadHours))
+		}
+		l = len(x.Gpa)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CumulativeGpa)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TranscriptSemester)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CumulativeGpa) > 0 {
+			i -= len(x.CumulativeGpa)
+			copy(dAtA[i:], x.CumulativeGpa)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativeGpa)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Gpa) > 0 {
+			i -= len(x.Gpa)
+			copy(dAtA[i:], x.Gpa)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Gpa)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.WorkloadHours != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkloadHours))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Credits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Credits))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Subjects) > 0 {
+			for iNdEx := len(x.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Subjects[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Semester) > 0 {
+			i -= len(x.Semester)
+			copy(dAtA[i:], x.Semester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Semester)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TranscriptSemester)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TranscriptSemester: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TranscriptSemester: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Semester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Semester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Subjects = append(x.Subjects, &TranscriptEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Subjects[len(x.Subjects)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+				}
+				x.Credits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Credits |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkloadHours", wireType)
+				}
+				x.WorkloadHours = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkloadHours |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gpa", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Gpa = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeGpa", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativeGpa = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TransferredSubject                   protoreflect.MessageDescriptor
+	fd_TransferredSubject_subject_id        protoreflect.FieldDescriptor
+	fd_TransferredSubject_code              protoreflect.FieldDescriptor
+	fd_TransferredSubject_title             protoreflect.FieldDescriptor
+	fd_TransferredSubject_credits           protoreflect.FieldDescriptor
+	fd_TransferredSubject_workload_hours    protoreflect.FieldDescriptor
+	fd_TransferredSubject_knowledge_area    protoreflect.FieldDescriptor
+	fd_TransferredSubject_source_subject_id protoreflect.FieldDescriptor
+	fd_TransferredSubject_equivalence_type  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_academictoken_student_transcript_proto_init()
+	md_TransferredSubject = File_academictoken_student_transcript_proto.Messages().ByName("TransferredSubject")
+	fd_TransferredSubject_subject_id = md_TransferredSubject.Fields().ByName("subject_id")
+	fd_TransferredSubject_code = md_TransferredSubject.Fields().ByName("code")
+	fd_TransferredSubject_title = md_TransferredSubject.Fields().ByName("title")
+	fd_TransferredSubject_credits = md_TransferredSubject.Fields().ByName("credits")
+	fd_TransferredSubject_workload_hours = md_TransferredSubject.Fields().ByName("workload_hours")
+	fd_TransferredSubject_knowledge_area = md_TransferredSubject.Fields().ByName("knowledge_area")
+	fd_TransferredSubject_source_subject_id = md_TransferredSubject.Fields().ByName("source_subject_id")
+	fd_TransferredSubject_equivalence_type = md_TransferredSubject.Fields().ByName("equivalence_type")
+}
+
+var _ protoreflect.Message = (*fastReflection_TransferredSubject)(nil)
+
+type fastReflection_TransferredSubject TransferredSubject
+
+func (x *TransferredSubject) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransferredSubject)(x)
+}
+
+func (x *TransferredSubject) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_student_transcript_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TransferredSubject_messageType fastReflection_TransferredSubject_messageType
+var _ protoreflect.MessageType = fastReflection_TransferredSubject_messageType{}
+
+type fastReflection_TransferredSubject_messageType struct{}
+
+func (x fastReflection_TransferredSubject_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransferredSubject)(nil)
+}
+func (x fastReflection_TransferredSubject_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransferredSubject)
+}
+func (x fastReflection_TransferredSubject_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferredSubject
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TransferredSubject) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferredSubject
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TransferredSubject) Type() protoreflect.MessageType {
+	return _fastReflection_TransferredSubject_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TransferredSubject) New() protoreflect.Message {
+	return new(fastReflection_TransferredSubject)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TransferredSubject) Interface() protoreflect.ProtoMessage {
+	return (*TransferredSubject)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TransferredSubject) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubjectId != "" {
+		value := protoreflect.ValueOfString(x.SubjectId)
+		if !f(fd_TransferredSubject_subject_id, value) {
+			return
+		}
+	}
+	if x.Code != "" {
+		value := protoreflect.ValueOfString(x.Code)
+		if !f(fd_TransferredSubject_code, value) {
+			return
+		}
+	}
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_TransferredSubject_title, value) {
+			return
+		}
+	}
+	if x.Credits != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Credits)
+		if !f(fd_TransferredSubject_credits, value) {
+			return
+		}
+	}
+	if x.WorkloadHours != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WorkloadHours)
+		if !f(fd_TransferredSubject_workload_hours, value) {
+			return
+		}
+	}
+	if x.KnowledgeArea != "" {
+		value := protoreflect.ValueOfString(x.KnowledgeArea)
+		if !f(fd_TransferredSubject_knowledge_area, value) {
+			return
+		}
+	}
+	if x.SourceSubjectId != "" {
+		value := protoreflect.ValueOfString(x.SourceSubjectId)
+		if !f(fd_TransferredSubject_source_subject_id, value) {
+			return
+		}
+	}
+	if x.EquivalenceType != "" {
+		value := protoreflect.ValueOfString(x.EquivalenceType)
+		if !f(fd_TransferredSubject_equivalence_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TransferredSubject) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.student.TransferredSubject.subject_id":
+		return x.SubjectId != ""
+	case "academictoken.student.TransferredSubject.code":
+		return x.Code != ""
+	case "academictoken.student.TransferredSubject.title":
+		return x.Title != ""
+	case "academictoken.student.TransferredSubject.credits":
+		return x.Credits != uint64(0)
+	case "academictoken.student.TransferredSubject.workload_hours":
+		return x.WorkloadHours != uint64(0)
+	case "academictoken.student.TransferredSubject.knowledge_area":
+		return x.KnowledgeArea != ""
+	case "academictoken.student.TransferredSubject.source_subject_id":
+		return x.SourceSubjectId != ""
+	case "academictoken.student.TransferredSubject.equivalence_type":
+		return x.EquivalenceType != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TransferredSubject"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TransferredSubject does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferredSubject) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.student.TransferredSubject.subject_id":
+		x.SubjectId = ""
+	case "academictoken.student.TransferredSubject.code":
+		x.Code = ""
+	case "academictoken.student.TransferredSubject.title":
+		x.Title = ""
+	case "academictoken.student.TransferredSubject.credits":
+		x.Credits = uint64(0)
+	case "academictoken.student.TransferredSubject.workload_hours":
+		x.WorkloadHours = uint64(0)
+	case "academictoken.student.TransferredSubject.knowledge_area":
+		x.KnowledgeArea = ""
+	case "academictoken.student.TransferredSubject.source_subject_id":
+		x.SourceSubjectId = ""
+	case "academictoken.student.TransferredSubject.equivalence_type":
+		x.EquivalenceType = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TransferredSubject"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TransferredSubject does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TransferredSubject) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.student.TransferredSubject.subject_id":
+		value := x.SubjectId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TransferredSubject.code":
+		value := x.Code
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TransferredSubject.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TransferredSubject.credits":
+		value := x.Credits
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.TransferredSubject.workload_hours":
+		value := x.WorkloadHours
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.student.TransferredSubject.knowledge_area":
+		value := x.KnowledgeArea
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TransferredSubject.source_subject_id":
+		value := x.SourceSubjectId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.student.TransferredSubject.equivalence_type":
+		value := x.EquivalenceType
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TransferredSubject"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TransferredSubject does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferredSubject) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.student.TransferredSubject.subject_id":
+		x.SubjectId = value.Interface().(string)
+	case "academictoken.student.TransferredSubject.code":
+		x.Code = value.Interface().(string)
+	case "academictoken.student.TransferredSubject.title":
+		x.Title = value.Interface().(string)
+	case "academictoken.student.TransferredSubject.credits":
+		x.Credits = value.Uint()
+	case "academictoken.student.TransferredSubject.workload_hours":
+		x.WorkloadHours = value.Uint()
+	case "academictoken.student.TransferredSubject.knowledge_area":
+		x.KnowledgeArea = value.Interface().(string)
+	case "academictoken.student.TransferredSubject.source_subject_id":
+		x.SourceSubjectId = value.Interface().(string)
+	case "academictoken.student.TransferredSubject.equivalence_type":
+		x.EquivalenceType = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TransferredSubject"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TransferredSubject does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferredSubject) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.TransferredSubject.subject_id":
+		panic(fmt.Errorf("field subject_id of message academictoken.student.TransferredSubject is not mutable"))
+	case "academictoken.student.TransferredSubject.code":
+		panic(fmt.Errorf("field code of message academictoken.student.TransferredSubject is not mutable"))
+	case "academictoken.student.TransferredSubject.title":
+		panic(fmt.Errorf("field title of message academictoken.student.TransferredSubject is not mutable"))
+	case "academictoken.student.TransferredSubject.credits":
+		panic(fmt.Errorf("field credits of message academictoken.student.TransferredSubject is not mutable"))
+	case "academictoken.student.TransferredSubject.workload_hours":
+		panic(fmt.Errorf("field workload_hours of message academictoken.student.TransferredSubject is not mutable"))
+	case "academictoken.student.TransferredSubject.knowledge_area":
+		panic(fmt.Errorf("field knowledge_area of message academictoken.student.TransferredSubject is not mutable"))
+	case "academictoken.student.TransferredSubject.source_subject_id":
+		panic(fmt.Errorf("field source_subject_id of message academictoken.student.TransferredSubject is not mutable"))
+	case "academictoken.student.TransferredSubject.equivalence_type":
+		panic(fmt.Errorf("field equivalence_type of message academictoken.student.TransferredSubject is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TransferredSubject"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TransferredSubject does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TransferredSubject) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.student.TransferredSubject.subject_id":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TransferredSubject.code":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TransferredSubject.title":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TransferredSubject.credits":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.TransferredSubject.workload_hours":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.student.TransferredSubject.knowledge_area":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TransferredSubject.source_subject_id":
+		return protoreflect.ValueOfString("")
+	case "academictoken.student.TransferredSubject.equivalence_type":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.student.TransferredSubject"))
+		}
+		panic(fmt.Errorf("message academictoken.student.TransferredSubject does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TransferredSubject) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.student.TransferredSubject", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TransferredSubject) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferredSubject) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TransferredSubject) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TransferredSubject) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TransferredSubject)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SubjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Code)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Credits != 0 {
+			n += 1 + runtime.Sov(uint64(x.Credits))
+		}
+		if x.WorkloadHours != 0 {
+			n += 1 + runtime.Sov(uint64(x.WorkloadHours))
+		}
+		l = len(x.KnowledgeArea)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceSubjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EquivalenceType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TransferredSubject)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EquivalenceType) > 0 {
+			i -= len(x.EquivalenceType)
+			copy(dAtA[i:], x.EquivalenceType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EquivalenceType)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.SourceSubjectId) > 0 {
+			i -= len(x.SourceSubjectId)
+			copy(dAtA[i:], x.SourceSubjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceSubjectId)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.KnowledgeArea) > 0 {
+			i -= len(x.KnowledgeArea)
+			copy(dAtA[i:], x.KnowledgeArea)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KnowledgeArea)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.WorkloadHours != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkloadHours))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Credits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Credits))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SubjectId) > 0 {
+			i -= len(x.SubjectId)
+			copy(dAtA[i:], x.SubjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TransferredSubject)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferredSubject: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferredSubject: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Code = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+				}
+				x.Credits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Credits |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkloadHours", wireType)
+				}
+				x.WorkloadHours = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkloadHours |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KnowledgeArea", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KnowledgeArea = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceSubjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceSubjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EquivalenceType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EquivalenceType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/student/transcript.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TranscriptEntry is a subject completed by the student, as recorded by its token instance
+type TranscriptEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId       string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Code            string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Title           string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Grade           string `protobuf:"bytes,4,opt,name=grade,proto3" json:"grade,omitempty"`
+	Credits         uint64 `protobuf:"varint,5,opt,name=credits,proto3" json:"credits,omitempty"`
+	WorkloadHours   uint64 `protobuf:"varint,6,opt,name=workload_hours,json=workloadHours,proto3" json:"workload_hours,omitempty"`
+	KnowledgeArea   string `protobuf:"bytes,7,opt,name=knowledge_area,json=knowledgeArea,proto3" json:"knowledge_area,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,8,opt,name=token_instance_id,json=tokenInstanceId,proto3" json:"token_instance_id,omitempty"`
+	CompletionDate  string `protobuf:"bytes,9,opt,name=completion_date,json=completionDate,proto3" json:"completion_date,omitempty"`
+	Institution     string `protobuf:"bytes,10,opt,name=institution,proto3" json:"institution,omitempty"`
+	Passed          bool   `protobuf:"varint,11,opt,name=passed,proto3" json:"passed,omitempty"`
+	CountsTowardGpa bool   `protobuf:"varint,12,opt,name=counts_toward_gpa,json=countsTowardGpa,proto3" json:"counts_toward_gpa,omitempty"` // pass/fail grades carry no GPA points
+}
+
+func (x *TranscriptEntry) Reset() {
+	*x = TranscriptEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_student_transcript_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranscriptEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptEntry) ProtoMessage() {}
+
+// Deprecated: Use TranscriptEntry.ProtoReflect.Descriptor instead.
+func (*TranscriptEntry) Descriptor() ([]byte, []int) {
+	return file_academictoken_student_transcript_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TranscriptEntry) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *TranscriptEntry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TranscriptEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TranscriptEntry) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *TranscriptEntry) GetCredits() uint64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *TranscriptEntry) GetWorkloadHours() uint64 {
+	if x != nil {
+		return x.WorkloadHours
+	}
+	return 0
+}
+
+func (x *TranscriptEntry) GetKnowledgeArea() string {
+	if x != nil {
+		return x.KnowledgeArea
+	}
+	return ""
+}
+
+func (x *TranscriptEntry) GetTokenInstanceId() string {
+	if x != nil {
+		return x.TokenInstanceId
+	}
+	return ""
+}
+
+func (x *TranscriptEntry) GetCompletionDate() string {
+	if x != nil {
+		return x.CompletionDate
+	}
+	return ""
+}
+
+func (x *TranscriptEntry) GetInstitution() string {
+	if x != nil {
+		return x.Institution
+	}
+	return ""
+}
+
+func (x *TranscriptEntry) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *TranscriptEntry) GetCountsTowardGpa() bool {
+	if x != nil {
+		return x.CountsTowardGpa
+	}
+	return false
+}
+
+// TranscriptSemester groups the subjects completed in a semester
+type TranscriptSemester struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Semester      string             `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	Subjects      []*TranscriptEntry `protobuf:"bytes,2,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Credits       uint64             `protobuf:"varint,3,opt,name=credits,proto3" json:"credits,omitempty"` // credits of the passed subjects
+	WorkloadHours uint64             `protobuf:"varint,4,opt,name=workload_hours,json=workloadHours,proto3" json:"workload_hours,omitempty"`
+	Gpa           string             `protobuf:"bytes,5,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	CumulativeGpa string             `protobuf:"bytes,6,opt,name=cumulative_gpa,json=cumulativeGpa,proto3" json:"cumulative_gpa,omitempty"` // GPA of this and all earlier semesters
+}
+
+func (x *TranscriptSemester) Reset() {
+	*x = TranscriptSemester{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_student_transcript_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranscriptSemester) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptSemester) ProtoMessage() {}
+
+// Deprecated: Use TranscriptSemester.ProtoReflect.Descriptor instead.
+func (*TranscriptSemester) Descriptor() ([]byte, []int) {
+	return file_academictoken_student_transcript_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TranscriptSemester) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *TranscriptSemester) GetSubjects() []*TranscriptEntry {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *TranscriptSemester) GetCredits() uint64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *TranscriptSemester) GetWorkloadHours() uint64 {
+	if x != nil {
+		return x.WorkloadHours
+	}
+	return 0
+}
+
+func (x *TranscriptSemester) GetGpa() string {
+	if x != nil {
+		return x.Gpa
+	}
+	return ""
+}
+
+func (x *TranscriptSemester) GetCumulativeGpa() string {
+	if x != nil {
+		return x.CumulativeGpa
+	}
+	return ""
+}
+
+// TransferredSubject is a subject credited to the student without a token of
+// its own, e.g. through an approved equivalence
+type TransferredSubject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId       string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Code            string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Title           string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Credits         uint64 `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	WorkloadHours   uint64 `protobuf:"varint,5,opt,name=workload_hours,json=workloadHours,proto3" json:"workload_hours,omitempty"`
+	KnowledgeArea   string `protobuf:"bytes,6,opt,name=knowledge_area,json=knowledgeArea,proto3" json:"knowledge_area,omitempty"`
+	SourceSubjectId string `protobuf:"bytes,7,opt,name=source_subject_id,json=sourceSubjectId,proto3" json:"source_subject_id,omitempty"`
+	EquivalenceType string `protobuf:"bytes,8,opt,name=equivalence_type,json=equivalenceType,proto3" json:"equivalence_type,omitempty"`
+}
+
+func (x *TransferredSubject) Reset() {
+	*x = TransferredSubject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_student_transcript_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferredSubject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferredSubject) ProtoMessage() {}
+
+// Deprecated: Use TransferredSubject.ProtoReflect.Descriptor instead.
+func (*TransferredSubject) Descriptor() ([]byte, []int) {
+	return file_academictoken_student_transcript_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferredSubject) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *TransferredSubject) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TransferredSubject) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TransferredSubject) GetCredits() uint64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *TransferredSubject) GetWorkloadHours() uint64 {
+	if x != nil {
+		return x.WorkloadHours
+	}
+	return 0
+}
+
+func (x *TransferredSubject) GetKnowledgeArea() string {
+	if x != nil {
+		return x.KnowledgeArea
+	}
+	return ""
+}
+
+func (x *TransferredSubject) GetSourceSubjectId() string {
+	if x != nil {
+		return x.SourceSubjectId
+	}
+	return ""
+}
+
+func (x *TransferredSubject) GetEquivalenceType() string {
+	if x != nil {
+		return x.EquivalenceType
+	}
+	return ""
+}
+
+var File_academictoken_student_transcript_proto protoreflect.FileDescriptor
+
+var file_academictoken_student_transcript_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x03, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x72, 0x65, 0x61,
+	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x67, 0x70, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x54, 0x6f, 0x77, 0x61, 0x72, 0x64, 0x47, 0x70, 0x61, 0x22, 0xf4, 0x01, 0x0a, 0x12,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x48,
+	0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x61,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x70, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x70, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x47,
+	0x70, 0x61, 0x22, 0x9c, 0x02, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61,
+	0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0xca, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42,
+	0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x53,
+	0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0xca, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_academictoken_student_transcript_proto_rawDescOnce sync.Once
+	file_academictoken_student_transcript_proto_rawDescData = file_academictoken_student_transcript_proto_rawDesc
+)
+
+func file_academictoken_student_transcript_proto_rawDescGZIP() []byte {
+	file_academictoken_student_transcript_proto_rawDescOnce.Do(func() {
+		file_academictoken_student_transcript_proto_rawDescData = protoimpl.X.CompressGZIP(file_academictoken_student_transcript_proto_rawDescData)
+	})
+	return file_academictoken_student_transcript_proto_rawDescData
+}
+
+var file_academictoken_student_transcript_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_academictoken_student_transcript_proto_goTypes = []interface{}{
+	(*TranscriptEntry)(nil),    // 0: academictoken.student.TranscriptEntry
+	(*TranscriptSemester)(nil), // 1: academictoken.student.TranscriptSemester
+	(*TransferredSubject)(nil), // 2: academictoken.student.TransferredSubject
+}
+var file_academictoken_student_transcript_proto_depIdxs = []int32{
+	0, // 0: academictoken.student.TranscriptSemester.subjects:type_name -> academictoken.student.TranscriptEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_academictoken_student_transcript_proto_init() }
+func file_academictoken_student_transcript_proto_init() {
+	if File_academictoken_student_transcript_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_academictoken_student_transcript_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranscriptEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_student_transcript_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranscriptSemester); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_student_transcript_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferredSubject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_academictoken_student_transcript_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_academictoken_student_transcript_proto_goTypes,
+		DependencyIndexes: file_academictoken_student_transcript_proto_depIdxs,
+		MessageInfos:      file_academictoken_student_transcript_proto_msgTypes,
+	}.Build()
+	File_academictoken_student_transcript_proto = out.File
+	file_academictoken_student_transcript_proto_rawDesc = nil
+	file_academictoken_student_transcript_proto_goTypes = nil
+	file_academictoken_student_transcript_proto_depIdxs = nil
+}
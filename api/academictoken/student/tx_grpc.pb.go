@@ -19,14 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName             = "/academictoken.student.Msg/UpdateParams"
-	Msg_RegisterStudent_FullMethodName          = "/academictoken.student.Msg/RegisterStudent"
-	Msg_CreateEnrollment_FullMethodName         = "/academictoken.student.Msg/CreateEnrollment"
-	Msg_UpdateEnrollmentStatus_FullMethodName   = "/academictoken.student.Msg/UpdateEnrollmentStatus"
-	Msg_RequestSubjectEnrollment_FullMethodName = "/academictoken.student.Msg/RequestSubjectEnrollment"
-	Msg_UpdateAcademicTree_FullMethodName       = "/academictoken.student.Msg/UpdateAcademicTree"
-	Msg_CompleteSubject_FullMethodName          = "/academictoken.student.Msg/CompleteSubject"
-	Msg_RequestEquivalence_FullMethodName       = "/academictoken.student.Msg/RequestEquivalence"
+	Msg_UpdateParams_FullMethodName               = "/academictoken.student.Msg/UpdateParams"
+	Msg_RegisterStudent_FullMethodName            = "/academictoken.student.Msg/RegisterStudent"
+	Msg_CreateEnrollment_FullMethodName           = "/academictoken.student.Msg/CreateEnrollment"
+	Msg_UpdateEnrollmentStatus_FullMethodName     = "/academictoken.student.Msg/UpdateEnrollmentStatus"
+	Msg_RequestSubjectEnrollment_FullMethodName   = "/academictoken.student.Msg/RequestSubjectEnrollment"
+	Msg_UpdateAcademicTree_FullMethodName         = "/academictoken.student.Msg/UpdateAcademicTree"
+	Msg_CompleteSubject_FullMethodName            = "/academictoken.student.Msg/CompleteSubject"
+	Msg_RequestEquivalence_FullMethodName         = "/academictoken.student.Msg/RequestEquivalence"
+	Msg_RequestCurriculumMigration_FullMethodName = "/academictoken.student.Msg/RequestCurriculumMigration"
+	Msg_ReviewCurriculumMigration_FullMethodName  = "/academictoken.student.Msg/ReviewCurriculumMigration"
+	Msg_CreateSubjectOffering_FullMethodName      = "/academictoken.student.Msg/CreateSubjectOffering"
+	Msg_DropSubjectOffering_FullMethodName        = "/academictoken.student.Msg/DropSubjectOffering"
+	Msg_WithdrawSubjectOffering_FullMethodName    = "/academictoken.student.Msg/WithdrawSubjectOffering"
+	Msg_GrantPrerequisiteWaiver_FullMethodName    = "/academictoken.student.Msg/GrantPrerequisiteWaiver"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,6 +49,12 @@ type MsgClient interface {
 	UpdateAcademicTree(ctx context.Context, in *MsgUpdateAcademicTree, opts ...grpc.CallOption) (*MsgUpdateAcademicTreeResponse, error)
 	CompleteSubject(ctx context.Context, in *MsgCompleteSubject, opts ...grpc.CallOption) (*MsgCompleteSubjectResponse, error)
 	RequestEquivalence(ctx context.Context, in *MsgRequestEquivalence, opts ...grpc.CallOption) (*MsgRequestEquivalenceResponse, error)
+	RequestCurriculumMigration(ctx context.Context, in *MsgRequestCurriculumMigration, opts ...grpc.CallOption) (*MsgRequestCurriculumMigrationResponse, error)
+	ReviewCurriculumMigration(ctx context.Context, in *MsgReviewCurriculumMigration, opts ...grpc.CallOption) (*MsgReviewCurriculumMigrationResponse, error)
+	CreateSubjectOffering(ctx context.Context, in *MsgCreateSubjectOffering, opts ...grpc.CallOption) (*MsgCreateSubjectOfferingResponse, error)
+	DropSubjectOffering(ctx context.Context, in *MsgDropSubjectOffering, opts ...grpc.CallOption) (*MsgDropSubjectOfferingResponse, error)
+	WithdrawSubjectOffering(ctx context.Context, in *MsgWithdrawSubjectOffering, opts ...grpc.CallOption) (*MsgWithdrawSubjectOfferingResponse, error)
+	GrantPrerequisiteWaiver(ctx context.Context, in *MsgGrantPrerequisiteWaiver, opts ...grpc.CallOption) (*MsgGrantPrerequisiteWaiverResponse, error)
 }
 
 type msgClient struct {
@@ -125,6 +137,60 @@ func (c *msgClient) RequestEquivalence(ctx context.Context, in *MsgRequestEquiva
 	return out, nil
 }
 
+func (c *msgClient) RequestCurriculumMigration(ctx context.Context, in *MsgRequestCurriculumMigration, opts ...grpc.CallOption) (*MsgRequestCurriculumMigrationResponse, error) {
+	out := new(MsgRequestCurriculumMigrationResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestCurriculumMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReviewCurriculumMigration(ctx context.Context, in *MsgReviewCurriculumMigration, opts ...grpc.CallOption) (*MsgReviewCurriculumMigrationResponse, error) {
+	out := new(MsgReviewCurriculumMigrationResponse)
+	err := c.cc.Invoke(ctx, Msg_ReviewCurriculumMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSubjectOffering(ctx context.Context, in *MsgCreateSubjectOffering, opts ...grpc.CallOption) (*MsgCreateSubjectOfferingResponse, error) {
+	out := new(MsgCreateSubjectOfferingResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSubjectOffering_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DropSubjectOffering(ctx context.Context, in *MsgDropSubjectOffering, opts ...grpc.CallOption) (*MsgDropSubjectOfferingResponse, error) {
+	out := new(MsgDropSubjectOfferingResponse)
+	err := c.cc.Invoke(ctx, Msg_DropSubjectOffering_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSubjectOffering(ctx context.Context, in *MsgWithdrawSubjectOffering, opts ...grpc.CallOption) (*MsgWithdrawSubjectOfferingResponse, error) {
+	out := new(MsgWithdrawSubjectOfferingResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawSubjectOffering_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantPrerequisiteWaiver(ctx context.Context, in *MsgGrantPrerequisiteWaiver, opts ...grpc.CallOption) (*MsgGrantPrerequisiteWaiverResponse, error) {
+	out := new(MsgGrantPrerequisiteWaiverResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantPrerequisiteWaiver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -139,6 +205,12 @@ type MsgServer interface {
 	UpdateAcademicTree(context.Context, *MsgUpdateAcademicTree) (*MsgUpdateAcademicTreeResponse, error)
 	CompleteSubject(context.Context, *MsgCompleteSubject) (*MsgCompleteSubjectResponse, error)
 	RequestEquivalence(context.Context, *MsgRequestEquivalence) (*MsgRequestEquivalenceResponse, error)
+	RequestCurriculumMigration(context.Context, *MsgRequestCurriculumMigration) (*MsgRequestCurriculumMigrationResponse, error)
+	ReviewCurriculumMigration(context.Context, *MsgReviewCurriculumMigration) (*MsgReviewCurriculumMigrationResponse, error)
+	CreateSubjectOffering(context.Context, *MsgCreateSubjectOffering) (*MsgCreateSubjectOfferingResponse, error)
+	DropSubjectOffering(context.Context, *MsgDropSubjectOffering) (*MsgDropSubjectOfferingResponse, error)
+	WithdrawSubjectOffering(context.Context, *MsgWithdrawSubjectOffering) (*MsgWithdrawSubjectOfferingResponse, error)
+	GrantPrerequisiteWaiver(context.Context, *MsgGrantPrerequisiteWaiver) (*MsgGrantPrerequisiteWaiverResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -170,6 +242,24 @@ func (UnimplementedMsgServer) CompleteSubject(context.Context, *MsgCompleteSubje
 func (UnimplementedMsgServer) RequestEquivalence(context.Context, *MsgRequestEquivalence) (*MsgRequestEquivalenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestEquivalence not implemented")
 }
+func (UnimplementedMsgServer) RequestCurriculumMigration(context.Context, *MsgRequestCurriculumMigration) (*MsgRequestCurriculumMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCurriculumMigration not implemented")
+}
+func (UnimplementedMsgServer) ReviewCurriculumMigration(context.Context, *MsgReviewCurriculumMigration) (*MsgReviewCurriculumMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewCurriculumMigration not implemented")
+}
+func (UnimplementedMsgServer) CreateSubjectOffering(context.Context, *MsgCreateSubjectOffering) (*MsgCreateSubjectOfferingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubjectOffering not implemented")
+}
+func (UnimplementedMsgServer) DropSubjectOffering(context.Context, *MsgDropSubjectOffering) (*MsgDropSubjectOfferingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSubjectOffering not implemented")
+}
+func (UnimplementedMsgServer) WithdrawSubjectOffering(context.Context, *MsgWithdrawSubjectOffering) (*MsgWithdrawSubjectOfferingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSubjectOffering not implemented")
+}
+func (UnimplementedMsgServer) GrantPrerequisiteWaiver(context.Context, *MsgGrantPrerequisiteWaiver) (*MsgGrantPrerequisiteWaiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPrerequisiteWaiver not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -327,6 +417,114 @@ func _Msg_RequestEquivalence_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RequestCurriculumMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestCurriculumMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestCurriculumMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestCurriculumMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestCurriculumMigration(ctx, req.(*MsgRequestCurriculumMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReviewCurriculumMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReviewCurriculumMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReviewCurriculumMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReviewCurriculumMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReviewCurriculumMigration(ctx, req.(*MsgReviewCurriculumMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSubjectOffering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubjectOffering)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubjectOffering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSubjectOffering_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubjectOffering(ctx, req.(*MsgCreateSubjectOffering))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DropSubjectOffering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDropSubjectOffering)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DropSubjectOffering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DropSubjectOffering_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DropSubjectOffering(ctx, req.(*MsgDropSubjectOffering))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSubjectOffering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSubjectOffering)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSubjectOffering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawSubjectOffering_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSubjectOffering(ctx, req.(*MsgWithdrawSubjectOffering))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantPrerequisiteWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantPrerequisiteWaiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantPrerequisiteWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantPrerequisiteWaiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantPrerequisiteWaiver(ctx, req.(*MsgGrantPrerequisiteWaiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -366,6 +564,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestEquivalence",
 			Handler:    _Msg_RequestEquivalence_Handler,
 		},
+		{
+			MethodName: "RequestCurriculumMigration",
+			Handler:    _Msg_RequestCurriculumMigration_Handler,
+		},
+		{
+			MethodName: "ReviewCurriculumMigration",
+			Handler:    _Msg_ReviewCurriculumMigration_Handler,
+		},
+		{
+			MethodName: "CreateSubjectOffering",
+			Handler:    _Msg_CreateSubjectOffering_Handler,
+		},
+		{
+			MethodName: "DropSubjectOffering",
+			Handler:    _Msg_DropSubjectOffering_Handler,
+		},
+		{
+			MethodName: "WithdrawSubjectOffering",
+			Handler:    _Msg_WithdrawSubjectOffering_Handler,
+		},
+		{
+			MethodName: "GrantPrerequisiteWaiver",
+			Handler:    _Msg_GrantPrerequisiteWaiver_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/student/tx.proto",
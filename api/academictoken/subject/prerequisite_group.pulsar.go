@@ -66,6 +66,8 @@ var (
 	fd_PrerequisiteGroup_minimumCredits           protoreflect.FieldDescriptor
 	fd_PrerequisiteGroup_minimumCompletedSubjects protoreflect.FieldDescriptor
 	fd_PrerequisiteGroup_subjectIds               protoreflect.FieldDescriptor
+	fd_PrerequisiteGroup_parentGroupId            protoreflect.FieldDescriptor
+	fd_PrerequisiteGroup_corequisite              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -77,6 +79,8 @@ func init() {
 	fd_PrerequisiteGroup_minimumCredits = md_PrerequisiteGroup.Fields().ByName("minimumCredits")
 	fd_PrerequisiteGroup_minimumCompletedSubjects = md_PrerequisiteGroup.Fields().ByName("minimumCompletedSubjects")
 	fd_PrerequisiteGroup_subjectIds = md_PrerequisiteGroup.Fields().ByName("subjectIds")
+	fd_PrerequisiteGroup_parentGroupId = md_PrerequisiteGroup.Fields().ByName("parentGroupId")
+	fd_PrerequisiteGroup_corequisite = md_PrerequisiteGroup.Fields().ByName("corequisite")
 }
 
 var _ protoreflect.Message = (*fastReflection_PrerequisiteGroup)(nil)
@@ -180,6 +184,18 @@ func (x *fastReflection_PrerequisiteGroup) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.ParentGroupId != "" {
+		value := protoreflect.ValueOfString(x.ParentGroupId)
+		if !f(fd_PrerequisiteGroup_parentGroupId, value) {
+			return
+		}
+	}
+	if x.Corequisite != false {
+		value := protoreflect.ValueOfBool(x.Corequisite)
+		if !f(fd_PrerequisiteGroup_corequisite, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -207,6 +223,10 @@ func (x *fastReflection_PrerequisiteGroup) Has(fd protoreflect.FieldDescriptor)
 		return x.MinimumCompletedSubjects != uint64(0)
 	case "academictoken.subject.PrerequisiteGroup.subjectIds":
 		return len(x.SubjectIds) != 0
+	case "academictoken.subject.PrerequisiteGroup.parentGroupId":
+		return x.ParentGroupId != ""
+	case "academictoken.subject.PrerequisiteGroup.corequisite":
+		return x.Corequisite != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroup"))
@@ -235,6 +255,10 @@ func (x *fastReflection_PrerequisiteGroup) Clear(fd protoreflect.FieldDescriptor
 		x.MinimumCompletedSubjects = uint64(0)
 	case "academictoken.subject.PrerequisiteGroup.subjectIds":
 		x.SubjectIds = nil
+	case "academictoken.subject.PrerequisiteGroup.parentGroupId":
+		x.ParentGroupId = ""
+	case "academictoken.subject.PrerequisiteGroup.corequisite":
+		x.Corequisite = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroup"))
@@ -272,6 +296,12 @@ func (x *fastReflection_PrerequisiteGroup) Get(descriptor protoreflect.FieldDesc
 		}
 		listValue := &_PrerequisiteGroup_6_list{list: &x.SubjectIds}
 		return protoreflect.ValueOfList(listValue)
+	case "academictoken.subject.PrerequisiteGroup.parentGroupId":
+		value := x.ParentGroupId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.subject.PrerequisiteGroup.corequisite":
+		value := x.Corequisite
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroup"))
@@ -306,6 +336,10 @@ func (x *fastReflection_PrerequisiteGroup) Set(fd protoreflect.FieldDescriptor,
 		lv := value.List()
 		clv := lv.(*_PrerequisiteGroup_6_list)
 		x.SubjectIds = *clv.list
+	case "academictoken.subject.PrerequisiteGroup.parentGroupId":
+		x.ParentGroupId = value.Interface().(string)
+	case "academictoken.subject.PrerequisiteGroup.corequisite":
+		x.Corequisite = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroup"))
@@ -342,6 +376,10 @@ func (x *fastReflection_PrerequisiteGroup) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field minimumCredits of message academictoken.subject.PrerequisiteGroup is not mutable"))
 	case "academictoken.subject.PrerequisiteGroup.minimumCompletedSubjects":
 		panic(fmt.Errorf("field minimumCompletedSubjects of message academictoken.subject.PrerequisiteGroup is not mutable"))
+	case "academictoken.subject.PrerequisiteGroup.parentGroupId":
+		panic(fmt.Errorf("field parentGroupId of message academictoken.subject.PrerequisiteGroup is not mutable"))
+	case "academictoken.subject.PrerequisiteGroup.corequisite":
+		panic(fmt.Errorf("field corequisite of message academictoken.subject.PrerequisiteGroup is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroup"))
@@ -368,6 +406,10 @@ func (x *fastReflection_PrerequisiteGroup) NewField(fd protoreflect.FieldDescrip
 	case "academictoken.subject.PrerequisiteGroup.subjectIds":
 		list := []string{}
 		return protoreflect.ValueOfList(&_PrerequisiteGroup_6_list{list: &list})
+	case "academictoken.subject.PrerequisiteGroup.parentGroupId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.subject.PrerequisiteGroup.corequisite":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroup"))
@@ -461,6 +503,13 @@ func (x *fastReflection_PrerequisiteGroup) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.ParentGroupId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Corequisite {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -490,6 +539,23 @@ func (x *fastReflection_PrerequisiteGroup) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Corequisite {
+			i--
+			if x.Corequisite {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.ParentGroupId) > 0 {
+			i -= len(x.ParentGroupId)
+			copy(dAtA[i:], x.ParentGroupId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParentGroupId)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.SubjectIds) > 0 {
 			for iNdEx := len(x.SubjectIds) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.SubjectIds[iNdEx])
@@ -745,6 +811,58 @@ func (x *fastReflection_PrerequisiteGroup) ProtoMethods() *protoiface.Methods {
 				}
 				x.SubjectIds = append(x.SubjectIds, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParentGroupId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParentGroupId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corequisite", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Corequisite = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -780,164 +898,1590 @@ func (x *fastReflection_PrerequisiteGroup) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: academictoken/subject/prerequisite_group.proto
+var _ protoreflect.List = (*_PrerequisiteGroupResult_5_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _PrerequisiteGroupResult_5_list struct {
+	list *[]string
+}
 
-type PrerequisiteGroup struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_PrerequisiteGroupResult_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-	Id                       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubjectId                string   `protobuf:"bytes,2,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
-	GroupType                string   `protobuf:"bytes,3,opt,name=groupType,proto3" json:"groupType,omitempty"`
-	MinimumCredits           uint64   `protobuf:"varint,4,opt,name=minimumCredits,proto3" json:"minimumCredits,omitempty"`
-	MinimumCompletedSubjects uint64   `protobuf:"varint,5,opt,name=minimumCompletedSubjects,proto3" json:"minimumCompletedSubjects,omitempty"`
-	SubjectIds               []string `protobuf:"bytes,6,rep,name=subjectIds,proto3" json:"subjectIds,omitempty"`
+func (x *_PrerequisiteGroupResult_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *PrerequisiteGroup) Reset() {
-	*x = PrerequisiteGroup{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_academictoken_subject_prerequisite_group_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_PrerequisiteGroupResult_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *PrerequisiteGroup) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_PrerequisiteGroupResult_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (*PrerequisiteGroup) ProtoMessage() {}
+func (x *_PrerequisiteGroupResult_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PrerequisiteGroupResult at list field SatisfiedSubjects as it is not of Message kind"))
+}
 
-// Deprecated: Use PrerequisiteGroup.ProtoReflect.Descriptor instead.
-func (*PrerequisiteGroup) Descriptor() ([]byte, []int) {
-	return file_academictoken_subject_prerequisite_group_proto_rawDescGZIP(), []int{0}
+func (x *_PrerequisiteGroupResult_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *PrerequisiteGroup) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (x *_PrerequisiteGroupResult_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *PrerequisiteGroup) GetSubjectId() string {
-	if x != nil {
-		return x.SubjectId
-	}
-	return ""
+func (x *_PrerequisiteGroupResult_5_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *PrerequisiteGroup) GetGroupType() string {
-	if x != nil {
-		return x.GroupType
-	}
-	return ""
+var _ protoreflect.List = (*_PrerequisiteGroupResult_6_list)(nil)
+
+type _PrerequisiteGroupResult_6_list struct {
+	list *[]string
 }
 
-func (x *PrerequisiteGroup) GetMinimumCredits() uint64 {
-	if x != nil {
-		return x.MinimumCredits
+func (x *_PrerequisiteGroupResult_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return 0
+	return len(*x.list)
 }
 
-func (x *PrerequisiteGroup) GetMinimumCompletedSubjects() uint64 {
-	if x != nil {
-		return x.MinimumCompletedSubjects
+func (x *_PrerequisiteGroupResult_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PrerequisiteGroupResult_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PrerequisiteGroupResult_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PrerequisiteGroupResult_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PrerequisiteGroupResult at list field MissingSubjects as it is not of Message kind"))
+}
+
+func (x *_PrerequisiteGroupResult_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PrerequisiteGroupResult_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PrerequisiteGroupResult_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PrerequisiteGroupResult_12_list)(nil)
+
+type _PrerequisiteGroupResult_12_list struct {
+	list *[]*PrerequisiteGroupResult
+}
+
+func (x *_PrerequisiteGroupResult_12_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return 0
+	return len(*x.list)
 }
 
-func (x *PrerequisiteGroup) GetSubjectIds() []string {
-	if x != nil {
-		return x.SubjectIds
+func (x *_PrerequisiteGroupResult_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PrerequisiteGroupResult_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrerequisiteGroupResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PrerequisiteGroupResult_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrerequisiteGroupResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PrerequisiteGroupResult_12_list) AppendMutable() protoreflect.Value {
+	v := new(PrerequisiteGroupResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PrerequisiteGroupResult_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return nil
+	*x.list = (*x.list)[:n]
 }
 
-var File_academictoken_subject_prerequisite_group_proto protoreflect.FileDescriptor
+func (x *_PrerequisiteGroupResult_12_list) NewElement() protoreflect.Value {
+	v := new(PrerequisiteGroupResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-var file_academictoken_subject_prerequisite_group_proto_rawDesc = []byte{
-	0x0a, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x73, 0x69, 0x74, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x15, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x69, 0x6e,
-	0x69, 0x6d, 0x75, 0x6d, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x73, 0x12, 0x3a, 0x0a, 0x18, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x42, 0xd1, 0x01,
-	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x16, 0x50, 0x72, 0x65,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
-	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0xa2, 0x02,
-	0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0xca, 0x02, 0x15, 0x41,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65,
-	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *_PrerequisiteGroupResult_12_list) IsValid() bool {
+	return x.list != nil
 }
 
 var (
-	file_academictoken_subject_prerequisite_group_proto_rawDescOnce sync.Once
-	file_academictoken_subject_prerequisite_group_proto_rawDescData = file_academictoken_subject_prerequisite_group_proto_rawDesc
+	md_PrerequisiteGroupResult                   protoreflect.MessageDescriptor
+	fd_PrerequisiteGroupResult_groupId           protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_groupType         protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_satisfied         protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_corequisite       protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_satisfiedSubjects protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_missingSubjects   protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_requiredCredits   protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_earnedCredits     protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_requiredCount     protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_satisfiedCount    protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_explanation       protoreflect.FieldDescriptor
+	fd_PrerequisiteGroupResult_children          protoreflect.FieldDescriptor
 )
 
-func file_academictoken_subject_prerequisite_group_proto_rawDescGZIP() []byte {
-	file_academictoken_subject_prerequisite_group_proto_rawDescOnce.Do(func() {
-		file_academictoken_subject_prerequisite_group_proto_rawDescData = protoimpl.X.CompressGZIP(file_academictoken_subject_prerequisite_group_proto_rawDescData)
-	})
-	return file_academictoken_subject_prerequisite_group_proto_rawDescData
+func init() {
+	file_academictoken_subject_prerequisite_group_proto_init()
+	md_PrerequisiteGroupResult = File_academictoken_subject_prerequisite_group_proto.Messages().ByName("PrerequisiteGroupResult")
+	fd_PrerequisiteGroupResult_groupId = md_PrerequisiteGroupResult.Fields().ByName("groupId")
+	fd_PrerequisiteGroupResult_groupType = md_PrerequisiteGroupResult.Fields().ByName("groupType")
+	fd_PrerequisiteGroupResult_satisfied = md_PrerequisiteGroupResult.Fields().ByName("satisfied")
+	fd_PrerequisiteGroupResult_corequisite = md_PrerequisiteGroupResult.Fields().ByName("corequisite")
+	fd_PrerequisiteGroupResult_satisfiedSubjects = md_PrerequisiteGroupResult.Fields().ByName("satisfiedSubjects")
+	fd_PrerequisiteGroupResult_missingSubjects = md_PrerequisiteGroupResult.Fields().ByName("missingSubjects")
+	fd_PrerequisiteGroupResult_requiredCredits = md_PrerequisiteGroupResult.Fields().ByName("requiredCredits")
+	fd_PrerequisiteGroupResult_earnedCredits = md_PrerequisiteGroupResult.Fields().ByName("earnedCredits")
+	fd_PrerequisiteGroupResult_requiredCount = md_PrerequisiteGroupResult.Fields().ByName("requiredCount")
+	fd_PrerequisiteGroupResult_satisfiedCount = md_PrerequisiteGroupResult.Fields().ByName("satisfiedCount")
+	fd_PrerequisiteGroupResult_explanation = md_PrerequisiteGroupResult.Fields().ByName("explanation")
+	fd_PrerequisiteGroupResult_children = md_PrerequisiteGroupResult.Fields().ByName("children")
 }
 
-var file_academictoken_subject_prerequisite_group_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_academictoken_subject_prerequisite_group_proto_goTypes = []interface{}{
-	(*PrerequisiteGroup)(nil), // 0: academictoken.subject.PrerequisiteGroup
-}
-var file_academictoken_subject_prerequisite_group_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+var _ protoreflect.Message = (*fastReflection_PrerequisiteGroupResult)(nil)
+
+type fastReflection_PrerequisiteGroupResult PrerequisiteGroupResult
+
+func (x *PrerequisiteGroupResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PrerequisiteGroupResult)(x)
 }
 
-func init() { file_academictoken_subject_prerequisite_group_proto_init() }
-func file_academictoken_subject_prerequisite_group_proto_init() {
-	if File_academictoken_subject_prerequisite_group_proto != nil {
-		return
+func (x *PrerequisiteGroupResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_academictoken_subject_prerequisite_group_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_academictoken_subject_prerequisite_group_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrerequisiteGroup); i {
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PrerequisiteGroupResult_messageType fastReflection_PrerequisiteGroupResult_messageType
+var _ protoreflect.MessageType = fastReflection_PrerequisiteGroupResult_messageType{}
+
+type fastReflection_PrerequisiteGroupResult_messageType struct{}
+
+func (x fastReflection_PrerequisiteGroupResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PrerequisiteGroupResult)(nil)
+}
+func (x fastReflection_PrerequisiteGroupResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_PrerequisiteGroupResult)
+}
+func (x fastReflection_PrerequisiteGroupResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrerequisiteGroupResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PrerequisiteGroupResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrerequisiteGroupResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PrerequisiteGroupResult) Type() protoreflect.MessageType {
+	return _fastReflection_PrerequisiteGroupResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PrerequisiteGroupResult) New() protoreflect.Message {
+	return new(fastReflection_PrerequisiteGroupResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PrerequisiteGroupResult) Interface() protoreflect.ProtoMessage {
+	return (*PrerequisiteGroupResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PrerequisiteGroupResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != "" {
+		value := protoreflect.ValueOfString(x.GroupId)
+		if !f(fd_PrerequisiteGroupResult_groupId, value) {
+			return
+		}
+	}
+	if x.GroupType != "" {
+		value := protoreflect.ValueOfString(x.GroupType)
+		if !f(fd_PrerequisiteGroupResult_groupType, value) {
+			return
+		}
+	}
+	if x.Satisfied != false {
+		value := protoreflect.ValueOfBool(x.Satisfied)
+		if !f(fd_PrerequisiteGroupResult_satisfied, value) {
+			return
+		}
+	}
+	if x.Corequisite != false {
+		value := protoreflect.ValueOfBool(x.Corequisite)
+		if !f(fd_PrerequisiteGroupResult_corequisite, value) {
+			return
+		}
+	}
+	if len(x.SatisfiedSubjects) != 0 {
+		value := protoreflect.ValueOfList(&_PrerequisiteGroupResult_5_list{list: &x.SatisfiedSubjects})
+		if !f(fd_PrerequisiteGroupResult_satisfiedSubjects, value) {
+			return
+		}
+	}
+	if len(x.MissingSubjects) != 0 {
+		value := protoreflect.ValueOfList(&_PrerequisiteGroupResult_6_list{list: &x.MissingSubjects})
+		if !f(fd_PrerequisiteGroupResult_missingSubjects, value) {
+			return
+		}
+	}
+	if x.RequiredCredits != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequiredCredits)
+		if !f(fd_PrerequisiteGroupResult_requiredCredits, value) {
+			return
+		}
+	}
+	if x.EarnedCredits != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EarnedCredits)
+		if !f(fd_PrerequisiteGroupResult_earnedCredits, value) {
+			return
+		}
+	}
+	if x.RequiredCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequiredCount)
+		if !f(fd_PrerequisiteGroupResult_requiredCount, value) {
+			return
+		}
+	}
+	if x.SatisfiedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SatisfiedCount)
+		if !f(fd_PrerequisiteGroupResult_satisfiedCount, value) {
+			return
+		}
+	}
+	if x.Explanation != "" {
+		value := protoreflect.ValueOfString(x.Explanation)
+		if !f(fd_PrerequisiteGroupResult_explanation, value) {
+			return
+		}
+	}
+	if len(x.Children) != 0 {
+		value := protoreflect.ValueOfList(&_PrerequisiteGroupResult_12_list{list: &x.Children})
+		if !f(fd_PrerequisiteGroupResult_children, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PrerequisiteGroupResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "academictoken.subject.PrerequisiteGroupResult.groupId":
+		return x.GroupId != ""
+	case "academictoken.subject.PrerequisiteGroupResult.groupType":
+		return x.GroupType != ""
+	case "academictoken.subject.PrerequisiteGroupResult.satisfied":
+		return x.Satisfied != false
+	case "academictoken.subject.PrerequisiteGroupResult.corequisite":
+		return x.Corequisite != false
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedSubjects":
+		return len(x.SatisfiedSubjects) != 0
+	case "academictoken.subject.PrerequisiteGroupResult.missingSubjects":
+		return len(x.MissingSubjects) != 0
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCredits":
+		return x.RequiredCredits != uint64(0)
+	case "academictoken.subject.PrerequisiteGroupResult.earnedCredits":
+		return x.EarnedCredits != uint64(0)
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCount":
+		return x.RequiredCount != uint64(0)
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedCount":
+		return x.SatisfiedCount != uint64(0)
+	case "academictoken.subject.PrerequisiteGroupResult.explanation":
+		return x.Explanation != ""
+	case "academictoken.subject.PrerequisiteGroupResult.children":
+		return len(x.Children) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroupResult"))
+		}
+		panic(fmt.Errorf("message academictoken.subject.PrerequisiteGroupResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrerequisiteGroupResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "academictoken.subject.PrerequisiteGroupResult.groupId":
+		x.GroupId = ""
+	case "academictoken.subject.PrerequisiteGroupResult.groupType":
+		x.GroupType = ""
+	case "academictoken.subject.PrerequisiteGroupResult.satisfied":
+		x.Satisfied = false
+	case "academictoken.subject.PrerequisiteGroupResult.corequisite":
+		x.Corequisite = false
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedSubjects":
+		x.SatisfiedSubjects = nil
+	case "academictoken.subject.PrerequisiteGroupResult.missingSubjects":
+		x.MissingSubjects = nil
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCredits":
+		x.RequiredCredits = uint64(0)
+	case "academictoken.subject.PrerequisiteGroupResult.earnedCredits":
+		x.EarnedCredits = uint64(0)
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCount":
+		x.RequiredCount = uint64(0)
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedCount":
+		x.SatisfiedCount = uint64(0)
+	case "academictoken.subject.PrerequisiteGroupResult.explanation":
+		x.Explanation = ""
+	case "academictoken.subject.PrerequisiteGroupResult.children":
+		x.Children = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroupResult"))
+		}
+		panic(fmt.Errorf("message academictoken.subject.PrerequisiteGroupResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PrerequisiteGroupResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "academictoken.subject.PrerequisiteGroupResult.groupId":
+		value := x.GroupId
+		return protoreflect.ValueOfString(value)
+	case "academictoken.subject.PrerequisiteGroupResult.groupType":
+		value := x.GroupType
+		return protoreflect.ValueOfString(value)
+	case "academictoken.subject.PrerequisiteGroupResult.satisfied":
+		value := x.Satisfied
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.subject.PrerequisiteGroupResult.corequisite":
+		value := x.Corequisite
+		return protoreflect.ValueOfBool(value)
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedSubjects":
+		if len(x.SatisfiedSubjects) == 0 {
+			return protoreflect.ValueOfList(&_PrerequisiteGroupResult_5_list{})
+		}
+		listValue := &_PrerequisiteGroupResult_5_list{list: &x.SatisfiedSubjects}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.subject.PrerequisiteGroupResult.missingSubjects":
+		if len(x.MissingSubjects) == 0 {
+			return protoreflect.ValueOfList(&_PrerequisiteGroupResult_6_list{})
+		}
+		listValue := &_PrerequisiteGroupResult_6_list{list: &x.MissingSubjects}
+		return protoreflect.ValueOfList(listValue)
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCredits":
+		value := x.RequiredCredits
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.subject.PrerequisiteGroupResult.earnedCredits":
+		value := x.EarnedCredits
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCount":
+		value := x.RequiredCount
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedCount":
+		value := x.SatisfiedCount
+		return protoreflect.ValueOfUint64(value)
+	case "academictoken.subject.PrerequisiteGroupResult.explanation":
+		value := x.Explanation
+		return protoreflect.ValueOfString(value)
+	case "academictoken.subject.PrerequisiteGroupResult.children":
+		if len(x.Children) == 0 {
+			return protoreflect.ValueOfList(&_PrerequisiteGroupResult_12_list{})
+		}
+		listValue := &_PrerequisiteGroupResult_12_list{list: &x.Children}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroupResult"))
+		}
+		panic(fmt.Errorf("message academictoken.subject.PrerequisiteGroupResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrerequisiteGroupResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "academictoken.subject.PrerequisiteGroupResult.groupId":
+		x.GroupId = value.Interface().(string)
+	case "academictoken.subject.PrerequisiteGroupResult.groupType":
+		x.GroupType = value.Interface().(string)
+	case "academictoken.subject.PrerequisiteGroupResult.satisfied":
+		x.Satisfied = value.Bool()
+	case "academictoken.subject.PrerequisiteGroupResult.corequisite":
+		x.Corequisite = value.Bool()
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedSubjects":
+		lv := value.List()
+		clv := lv.(*_PrerequisiteGroupResult_5_list)
+		x.SatisfiedSubjects = *clv.list
+	case "academictoken.subject.PrerequisiteGroupResult.missingSubjects":
+		lv := value.List()
+		clv := lv.(*_PrerequisiteGroupResult_6_list)
+		x.MissingSubjects = *clv.list
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCredits":
+		x.RequiredCredits = value.Uint()
+	case "academictoken.subject.PrerequisiteGroupResult.earnedCredits":
+		x.EarnedCredits = value.Uint()
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCount":
+		x.RequiredCount = value.Uint()
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedCount":
+		x.SatisfiedCount = value.Uint()
+	case "academictoken.subject.PrerequisiteGroupResult.explanation":
+		x.Explanation = value.Interface().(string)
+	case "academictoken.subject.PrerequisiteGroupResult.children":
+		lv := value.List()
+		clv := lv.(*_PrerequisiteGroupResult_12_list)
+		x.Children = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroupResult"))
+		}
+		panic(fmt.Errorf("message academictoken.subject.PrerequisiteGroupResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrerequisiteGroupResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedSubjects":
+		if x.SatisfiedSubjects == nil {
+			x.SatisfiedSubjects = []string{}
+		}
+		value := &_PrerequisiteGroupResult_5_list{list: &x.SatisfiedSubjects}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.subject.PrerequisiteGroupResult.missingSubjects":
+		if x.MissingSubjects == nil {
+			x.MissingSubjects = []string{}
+		}
+		value := &_PrerequisiteGroupResult_6_list{list: &x.MissingSubjects}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.subject.PrerequisiteGroupResult.children":
+		if x.Children == nil {
+			x.Children = []*PrerequisiteGroupResult{}
+		}
+		value := &_PrerequisiteGroupResult_12_list{list: &x.Children}
+		return protoreflect.ValueOfList(value)
+	case "academictoken.subject.PrerequisiteGroupResult.groupId":
+		panic(fmt.Errorf("field groupId of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	case "academictoken.subject.PrerequisiteGroupResult.groupType":
+		panic(fmt.Errorf("field groupType of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	case "academictoken.subject.PrerequisiteGroupResult.satisfied":
+		panic(fmt.Errorf("field satisfied of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	case "academictoken.subject.PrerequisiteGroupResult.corequisite":
+		panic(fmt.Errorf("field corequisite of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCredits":
+		panic(fmt.Errorf("field requiredCredits of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	case "academictoken.subject.PrerequisiteGroupResult.earnedCredits":
+		panic(fmt.Errorf("field earnedCredits of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCount":
+		panic(fmt.Errorf("field requiredCount of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedCount":
+		panic(fmt.Errorf("field satisfiedCount of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	case "academictoken.subject.PrerequisiteGroupResult.explanation":
+		panic(fmt.Errorf("field explanation of message academictoken.subject.PrerequisiteGroupResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroupResult"))
+		}
+		panic(fmt.Errorf("message academictoken.subject.PrerequisiteGroupResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PrerequisiteGroupResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "academictoken.subject.PrerequisiteGroupResult.groupId":
+		return protoreflect.ValueOfString("")
+	case "academictoken.subject.PrerequisiteGroupResult.groupType":
+		return protoreflect.ValueOfString("")
+	case "academictoken.subject.PrerequisiteGroupResult.satisfied":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.subject.PrerequisiteGroupResult.corequisite":
+		return protoreflect.ValueOfBool(false)
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedSubjects":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PrerequisiteGroupResult_5_list{list: &list})
+	case "academictoken.subject.PrerequisiteGroupResult.missingSubjects":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PrerequisiteGroupResult_6_list{list: &list})
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCredits":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.subject.PrerequisiteGroupResult.earnedCredits":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.subject.PrerequisiteGroupResult.requiredCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.subject.PrerequisiteGroupResult.satisfiedCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "academictoken.subject.PrerequisiteGroupResult.explanation":
+		return protoreflect.ValueOfString("")
+	case "academictoken.subject.PrerequisiteGroupResult.children":
+		list := []*PrerequisiteGroupResult{}
+		return protoreflect.ValueOfList(&_PrerequisiteGroupResult_12_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: academictoken.subject.PrerequisiteGroupResult"))
+		}
+		panic(fmt.Errorf("message academictoken.subject.PrerequisiteGroupResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PrerequisiteGroupResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in academictoken.subject.PrerequisiteGroupResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PrerequisiteGroupResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrerequisiteGroupResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PrerequisiteGroupResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PrerequisiteGroupResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PrerequisiteGroupResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.GroupId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GroupType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Satisfied {
+			n += 2
+		}
+		if x.Corequisite {
+			n += 2
+		}
+		if len(x.SatisfiedSubjects) > 0 {
+			for _, s := range x.SatisfiedSubjects {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MissingSubjects) > 0 {
+			for _, s := range x.MissingSubjects {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RequiredCredits != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequiredCredits))
+		}
+		if x.EarnedCredits != 0 {
+			n += 1 + runtime.Sov(uint64(x.EarnedCredits))
+		}
+		if x.RequiredCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequiredCount))
+		}
+		if x.SatisfiedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SatisfiedCount))
+		}
+		l = len(x.Explanation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Children) > 0 {
+			for _, e := range x.Children {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PrerequisiteGroupResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Children) > 0 {
+			for iNdEx := len(x.Children) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Children[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.Explanation) > 0 {
+			i -= len(x.Explanation)
+			copy(dAtA[i:], x.Explanation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Explanation)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.SatisfiedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SatisfiedCount))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.RequiredCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequiredCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.EarnedCredits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EarnedCredits))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.RequiredCredits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequiredCredits))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.MissingSubjects) > 0 {
+			for iNdEx := len(x.MissingSubjects) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MissingSubjects[iNdEx])
+				copy(dAtA[i:], x.MissingSubjects[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MissingSubjects[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.SatisfiedSubjects) > 0 {
+			for iNdEx := len(x.SatisfiedSubjects) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SatisfiedSubjects[iNdEx])
+				copy(dAtA[i:], x.SatisfiedSubjects[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SatisfiedSubjects[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Corequisite {
+			i--
+			if x.Corequisite {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Satisfied {
+			i--
+			if x.Satisfied {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.GroupType) > 0 {
+			i -= len(x.GroupType)
+			copy(dAtA[i:], x.GroupType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupType)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.GroupId) > 0 {
+			i -= len(x.GroupId)
+			copy(dAtA[i:], x.GroupId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PrerequisiteGroupResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrerequisiteGroupResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrerequisiteGroupResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Satisfied = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corequisite", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Corequisite = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SatisfiedSubjects", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SatisfiedSubjects = append(x.SatisfiedSubjects, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissingSubjects", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MissingSubjects = append(x.MissingSubjects, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredCredits", wireType)
+				}
+				x.RequiredCredits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequiredCredits |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarnedCredits", wireType)
+				}
+				x.EarnedCredits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EarnedCredits |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredCount", wireType)
+				}
+				x.RequiredCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequiredCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SatisfiedCount", wireType)
+				}
+				x.SatisfiedCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SatisfiedCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Explanation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Explanation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Children = append(x.Children, &PrerequisiteGroupResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Children[len(x.Children)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: academictoken/subject/prerequisite_group.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PrerequisiteGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectId                string   `protobuf:"bytes,2,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	GroupType                string   `protobuf:"bytes,3,opt,name=groupType,proto3" json:"groupType,omitempty"`
+	MinimumCredits           uint64   `protobuf:"varint,4,opt,name=minimumCredits,proto3" json:"minimumCredits,omitempty"`
+	MinimumCompletedSubjects uint64   `protobuf:"varint,5,opt,name=minimumCompletedSubjects,proto3" json:"minimumCompletedSubjects,omitempty"`
+	SubjectIds               []string `protobuf:"bytes,6,rep,name=subjectIds,proto3" json:"subjectIds,omitempty"`
+	// parentGroupId nests the group inside another group of the same subject
+	ParentGroupId string `protobuf:"bytes,7,opt,name=parentGroupId,proto3" json:"parentGroupId,omitempty"`
+	// corequisite groups are also met by subjects the student is taking
+	Corequisite bool `protobuf:"varint,8,opt,name=corequisite,proto3" json:"corequisite,omitempty"`
+}
+
+func (x *PrerequisiteGroup) Reset() {
+	*x = PrerequisiteGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_subject_prerequisite_group_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrerequisiteGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrerequisiteGroup) ProtoMessage() {}
+
+// Deprecated: Use PrerequisiteGroup.ProtoReflect.Descriptor instead.
+func (*PrerequisiteGroup) Descriptor() ([]byte, []int) {
+	return file_academictoken_subject_prerequisite_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PrerequisiteGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PrerequisiteGroup) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *PrerequisiteGroup) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+func (x *PrerequisiteGroup) GetMinimumCredits() uint64 {
+	if x != nil {
+		return x.MinimumCredits
+	}
+	return 0
+}
+
+func (x *PrerequisiteGroup) GetMinimumCompletedSubjects() uint64 {
+	if x != nil {
+		return x.MinimumCompletedSubjects
+	}
+	return 0
+}
+
+func (x *PrerequisiteGroup) GetSubjectIds() []string {
+	if x != nil {
+		return x.SubjectIds
+	}
+	return nil
+}
+
+func (x *PrerequisiteGroup) GetParentGroupId() string {
+	if x != nil {
+		return x.ParentGroupId
+	}
+	return ""
+}
+
+func (x *PrerequisiteGroup) GetCorequisite() bool {
+	if x != nil {
+		return x.Corequisite
+	}
+	return false
+}
+
+// PrerequisiteGroupResult explains how a student fares against a prerequisite group
+type PrerequisiteGroupResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId           string                     `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupType         string                     `protobuf:"bytes,2,opt,name=groupType,proto3" json:"groupType,omitempty"`
+	Satisfied         bool                       `protobuf:"varint,3,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	Corequisite       bool                       `protobuf:"varint,4,opt,name=corequisite,proto3" json:"corequisite,omitempty"`
+	SatisfiedSubjects []string                   `protobuf:"bytes,5,rep,name=satisfiedSubjects,proto3" json:"satisfiedSubjects,omitempty"`
+	MissingSubjects   []string                   `protobuf:"bytes,6,rep,name=missingSubjects,proto3" json:"missingSubjects,omitempty"`
+	RequiredCredits   uint64                     `protobuf:"varint,7,opt,name=requiredCredits,proto3" json:"requiredCredits,omitempty"`
+	EarnedCredits     uint64                     `protobuf:"varint,8,opt,name=earnedCredits,proto3" json:"earnedCredits,omitempty"`
+	RequiredCount     uint64                     `protobuf:"varint,9,opt,name=requiredCount,proto3" json:"requiredCount,omitempty"`
+	SatisfiedCount    uint64                     `protobuf:"varint,10,opt,name=satisfiedCount,proto3" json:"satisfiedCount,omitempty"`
+	Explanation       string                     `protobuf:"bytes,11,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Children          []*PrerequisiteGroupResult `protobuf:"bytes,12,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *PrerequisiteGroupResult) Reset() {
+	*x = PrerequisiteGroupResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academictoken_subject_prerequisite_group_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrerequisiteGroupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrerequisiteGroupResult) ProtoMessage() {}
+
+// Deprecated: Use PrerequisiteGroupResult.ProtoReflect.Descriptor instead.
+func (*PrerequisiteGroupResult) Descriptor() ([]byte, []int) {
+	return file_academictoken_subject_prerequisite_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PrerequisiteGroupResult) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *PrerequisiteGroupResult) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+func (x *PrerequisiteGroupResult) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+func (x *PrerequisiteGroupResult) GetCorequisite() bool {
+	if x != nil {
+		return x.Corequisite
+	}
+	return false
+}
+
+func (x *PrerequisiteGroupResult) GetSatisfiedSubjects() []string {
+	if x != nil {
+		return x.SatisfiedSubjects
+	}
+	return nil
+}
+
+func (x *PrerequisiteGroupResult) GetMissingSubjects() []string {
+	if x != nil {
+		return x.MissingSubjects
+	}
+	return nil
+}
+
+func (x *PrerequisiteGroupResult) GetRequiredCredits() uint64 {
+	if x != nil {
+		return x.RequiredCredits
+	}
+	return 0
+}
+
+func (x *PrerequisiteGroupResult) GetEarnedCredits() uint64 {
+	if x != nil {
+		return x.EarnedCredits
+	}
+	return 0
+}
+
+func (x *PrerequisiteGroupResult) GetRequiredCount() uint64 {
+	if x != nil {
+		return x.RequiredCount
+	}
+	return 0
+}
+
+func (x *PrerequisiteGroupResult) GetSatisfiedCount() uint64 {
+	if x != nil {
+		return x.SatisfiedCount
+	}
+	return 0
+}
+
+func (x *PrerequisiteGroupResult) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *PrerequisiteGroupResult) GetChildren() []*PrerequisiteGroupResult {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+var File_academictoken_subject_prerequisite_group_proto protoreflect.FileDescriptor
+
+var file_academictoken_subject_prerequisite_group_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x73, 0x69, 0x74, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x15, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xab, 0x02, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x12, 0x3a, 0x0a, 0x18, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x65, 0x22, 0xf5, 0x03, 0x0a, 0x17, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x61, 0x74,
+	0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x61,
+	0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x61, 0x74,
+	0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x61, 0x72, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x61, 0x74, 0x69, 0x73,
+	0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0xd1, 0x01,
+	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x16, 0x50, 0x72, 0x65,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0xa2, 0x02,
+	0x03, 0x41, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0xca, 0x02, 0x15, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0xe2, 0x02, 0x21, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_academictoken_subject_prerequisite_group_proto_rawDescOnce sync.Once
+	file_academictoken_subject_prerequisite_group_proto_rawDescData = file_academictoken_subject_prerequisite_group_proto_rawDesc
+)
+
+func file_academictoken_subject_prerequisite_group_proto_rawDescGZIP() []byte {
+	file_academictoken_subject_prerequisite_group_proto_rawDescOnce.Do(func() {
+		file_academictoken_subject_prerequisite_group_proto_rawDescData = protoimpl.X.CompressGZIP(file_academictoken_subject_prerequisite_group_proto_rawDescData)
+	})
+	return file_academictoken_subject_prerequisite_group_proto_rawDescData
+}
+
+var file_academictoken_subject_prerequisite_group_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_academictoken_subject_prerequisite_group_proto_goTypes = []interface{}{
+	(*PrerequisiteGroup)(nil),       // 0: academictoken.subject.PrerequisiteGroup
+	(*PrerequisiteGroupResult)(nil), // 1: academictoken.subject.PrerequisiteGroupResult
+}
+var file_academictoken_subject_prerequisite_group_proto_depIdxs = []int32{
+	1, // 0: academictoken.subject.PrerequisiteGroupResult.children:type_name -> academictoken.subject.PrerequisiteGroupResult
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_academictoken_subject_prerequisite_group_proto_init() }
+func file_academictoken_subject_prerequisite_group_proto_init() {
+	if File_academictoken_subject_prerequisite_group_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_academictoken_subject_prerequisite_group_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrerequisiteGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academictoken_subject_prerequisite_group_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrerequisiteGroupResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -955,7 +2499,7 @@ func file_academictoken_subject_prerequisite_group_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_academictoken_subject_prerequisite_group_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -28,6 +28,7 @@ const (
 	Query_SubjectsByInstitution_FullMethodName       = "/academictoken.subject.Query/SubjectsByInstitution"
 	Query_CheckPrerequisites_FullMethodName          = "/academictoken.subject.Query/CheckPrerequisites"
 	Query_CheckEquivalence_FullMethodName            = "/academictoken.subject.Query/CheckEquivalence"
+	Query_ContentAttestations_FullMethodName         = "/academictoken.subject.Query/ContentAttestations"
 )
 
 // QueryClient is the client API for Query service.
@@ -48,10 +49,12 @@ type QueryClient interface {
 	SubjectsByCourse(ctx context.Context, in *QuerySubjectsByCourseRequest, opts ...grpc.CallOption) (*QuerySubjectsByCourseResponse, error)
 	// SubjectsByInstitution lists all subjects for a specific institution
 	SubjectsByInstitution(ctx context.Context, in *QuerySubjectsByInstitutionRequest, opts ...grpc.CallOption) (*QuerySubjectsByInstitutionResponse, error)
-	// CheckPrerequisites checks if a student meets the prerequisites for a subject via CosmWasm contract
+	// CheckPrerequisites evaluates the prerequisite groups of a subject against the academic tree of a student
 	CheckPrerequisites(ctx context.Context, in *QueryCheckPrerequisitesRequest, opts ...grpc.CallOption) (*QueryCheckPrerequisitesResponse, error)
 	// CheckEquivalence checks equivalence between two subjects via CosmWasm contract
 	CheckEquivalence(ctx context.Context, in *QueryCheckEquivalenceRequest, opts ...grpc.CallOption) (*QueryCheckEquivalenceResponse, error)
+	// ContentAttestations lists the availability attestations of the content of a subject
+	ContentAttestations(ctx context.Context, in *QueryContentAttestationsRequest, opts ...grpc.CallOption) (*QueryContentAttestationsResponse, error)
 }
 
 type queryClient struct {
@@ -143,6 +146,15 @@ func (c *queryClient) CheckEquivalence(ctx context.Context, in *QueryCheckEquiva
 	return out, nil
 }
 
+func (c *queryClient) ContentAttestations(ctx context.Context, in *QueryContentAttestationsRequest, opts ...grpc.CallOption) (*QueryContentAttestationsResponse, error) {
+	out := new(QueryContentAttestationsResponse)
+	err := c.cc.Invoke(ctx, Query_ContentAttestations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -161,10 +173,12 @@ type QueryServer interface {
 	SubjectsByCourse(context.Context, *QuerySubjectsByCourseRequest) (*QuerySubjectsByCourseResponse, error)
 	// SubjectsByInstitution lists all subjects for a specific institution
 	SubjectsByInstitution(context.Context, *QuerySubjectsByInstitutionRequest) (*QuerySubjectsByInstitutionResponse, error)
-	// CheckPrerequisites checks if a student meets the prerequisites for a subject via CosmWasm contract
+	// CheckPrerequisites evaluates the prerequisite groups of a subject against the academic tree of a student
 	CheckPrerequisites(context.Context, *QueryCheckPrerequisitesRequest) (*QueryCheckPrerequisitesResponse, error)
 	// CheckEquivalence checks equivalence between two subjects via CosmWasm contract
 	CheckEquivalence(context.Context, *QueryCheckEquivalenceRequest) (*QueryCheckEquivalenceResponse, error)
+	// ContentAttestations lists the availability attestations of the content of a subject
+	ContentAttestations(context.Context, *QueryContentAttestationsRequest) (*QueryContentAttestationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -199,6 +213,9 @@ func (UnimplementedQueryServer) CheckPrerequisites(context.Context, *QueryCheckP
 func (UnimplementedQueryServer) CheckEquivalence(context.Context, *QueryCheckEquivalenceRequest) (*QueryCheckEquivalenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckEquivalence not implemented")
 }
+func (UnimplementedQueryServer) ContentAttestations(context.Context, *QueryContentAttestationsRequest) (*QueryContentAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContentAttestations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -374,6 +391,24 @@ func _Query_CheckEquivalence_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContentAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContentAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContentAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContentAttestations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContentAttestations(ctx, req.(*QueryContentAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -417,6 +452,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckEquivalence",
 			Handler:    _Query_CheckEquivalence_Handler,
 		},
+		{
+			MethodName: "ContentAttestations",
+			Handler:    _Query_ContentAttestations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/subject/query.proto",
@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName         = "/academictoken.subject.Msg/UpdateParams"
-	Msg_CreateSubject_FullMethodName        = "/academictoken.subject.Msg/CreateSubject"
-	Msg_CreateSubjectContent_FullMethodName = "/academictoken.subject.Msg/CreateSubjectContent"
-	Msg_AddPrerequisiteGroup_FullMethodName = "/academictoken.subject.Msg/AddPrerequisiteGroup"
-	Msg_UpdateSubjectContent_FullMethodName = "/academictoken.subject.Msg/UpdateSubjectContent"
+	Msg_UpdateParams_FullMethodName              = "/academictoken.subject.Msg/UpdateParams"
+	Msg_CreateSubject_FullMethodName             = "/academictoken.subject.Msg/CreateSubject"
+	Msg_CreateSubjectContent_FullMethodName      = "/academictoken.subject.Msg/CreateSubjectContent"
+	Msg_AddPrerequisiteGroup_FullMethodName      = "/academictoken.subject.Msg/AddPrerequisiteGroup"
+	Msg_UpdateSubjectContent_FullMethodName      = "/academictoken.subject.Msg/UpdateSubjectContent"
+	Msg_AttestContentAvailability_FullMethodName = "/academictoken.subject.Msg/AttestContentAvailability"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +38,7 @@ type MsgClient interface {
 	CreateSubjectContent(ctx context.Context, in *MsgCreateSubjectContent, opts ...grpc.CallOption) (*MsgCreateSubjectContentResponse, error)
 	AddPrerequisiteGroup(ctx context.Context, in *MsgAddPrerequisiteGroup, opts ...grpc.CallOption) (*MsgAddPrerequisiteGroupResponse, error)
 	UpdateSubjectContent(ctx context.Context, in *MsgUpdateSubjectContent, opts ...grpc.CallOption) (*MsgUpdateSubjectContentResponse, error)
+	AttestContentAvailability(ctx context.Context, in *MsgAttestContentAvailability, opts ...grpc.CallOption) (*MsgAttestContentAvailabilityResponse, error)
 }
 
 type msgClient struct {
@@ -92,6 +94,15 @@ func (c *msgClient) UpdateSubjectContent(ctx context.Context, in *MsgUpdateSubje
 	return out, nil
 }
 
+func (c *msgClient) AttestContentAvailability(ctx context.Context, in *MsgAttestContentAvailability, opts ...grpc.CallOption) (*MsgAttestContentAvailabilityResponse, error) {
+	out := new(MsgAttestContentAvailabilityResponse)
+	err := c.cc.Invoke(ctx, Msg_AttestContentAvailability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -103,6 +114,7 @@ type MsgServer interface {
 	CreateSubjectContent(context.Context, *MsgCreateSubjectContent) (*MsgCreateSubjectContentResponse, error)
 	AddPrerequisiteGroup(context.Context, *MsgAddPrerequisiteGroup) (*MsgAddPrerequisiteGroupResponse, error)
 	UpdateSubjectContent(context.Context, *MsgUpdateSubjectContent) (*MsgUpdateSubjectContentResponse, error)
+	AttestContentAvailability(context.Context, *MsgAttestContentAvailability) (*MsgAttestContentAvailabilityResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -125,6 +137,9 @@ func (UnimplementedMsgServer) AddPrerequisiteGroup(context.Context, *MsgAddPrere
 func (UnimplementedMsgServer) UpdateSubjectContent(context.Context, *MsgUpdateSubjectContent) (*MsgUpdateSubjectContentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubjectContent not implemented")
 }
+func (UnimplementedMsgServer) AttestContentAvailability(context.Context, *MsgAttestContentAvailability) (*MsgAttestContentAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestContentAvailability not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -228,6 +243,24 @@ func _Msg_UpdateSubjectContent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AttestContentAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestContentAvailability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestContentAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttestContentAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestContentAvailability(ctx, req.(*MsgAttestContentAvailability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,6 +288,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSubjectContent",
 			Handler:    _Msg_UpdateSubjectContent_Handler,
 		},
+		{
+			MethodName: "AttestContentAvailability",
+			Handler:    _Msg_AttestContentAvailability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/subject/tx.proto",
@@ -6,6 +6,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	_ "cosmossdk.io/api/cosmos/tx/config/v1" // import for side-effects
 	clienthelpers "cosmossdk.io/client/v2/helpers"
@@ -539,6 +540,72 @@ func (a InstitutionKeeperAdapterForStudent) IsInstitutionAuthorized(ctx sdk.Cont
 	return a.keeper.IsInstitutionAuthorized(ctx, institutionIndex)
 }
 
+func (a InstitutionKeeperAdapterForStudent) CanIssueGrade(ctx sdk.Context, institutionIndex string, address string, courseId string, subjectId string) bool {
+	return a.keeper.CanIssueGrade(ctx, institutionIndex, address, courseId, subjectId)
+}
+
+func (a InstitutionKeeperAdapterForStudent) CanManageEnrollment(ctx sdk.Context, institutionIndex string, address string, courseId string) bool {
+	return a.keeper.CanManageEnrollment(ctx, institutionIndex, address, courseId)
+}
+
+func (a InstitutionKeeperAdapterForStudent) CanGrantPrerequisiteWaiver(ctx sdk.Context, institutionIndex string, address string, courseId string) bool {
+	return a.keeper.CanGrantPrerequisiteWaiver(ctx, institutionIndex, address, courseId)
+}
+
+func (a InstitutionKeeperAdapterForStudent) ValidateAcademicTerm(ctx sdk.Context, institutionIndex string, termId string) error {
+	return a.keeper.ValidateAcademicTerm(ctx, institutionIndex, termId)
+}
+
+func (a InstitutionKeeperAdapterForStudent) ValidateGradeSubmission(ctx sdk.Context, institutionIndex string, termId string) error {
+	return a.keeper.ValidateGradeSubmission(ctx, institutionIndex, termId)
+}
+
+func (a InstitutionKeeperAdapterForStudent) GetAcademicTerm(ctx sdk.Context, institutionIndex string, termId string) (studentmoduletypes.AcademicTerm, bool) {
+	term, found := a.keeper.GetAcademicTerm(ctx, institutionIndex, termId)
+	if !found {
+		return studentmoduletypes.AcademicTerm{}, false
+	}
+
+	// Terms are validated when set, so their dates always parse
+	dates := make([]time.Time, 4)
+	for i, value := range []string{term.StartDate, term.EndDate, term.EnrollmentStart, term.EnrollmentEnd} {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return studentmoduletypes.AcademicTerm{}, false
+		}
+		dates[i] = parsed
+	}
+	return studentmoduletypes.AcademicTerm{
+		Institution:     term.Institution,
+		TermId:          term.TermId,
+		StartDate:       dates[0],
+		EndDate:         dates[1],
+		EnrollmentStart: dates[2],
+		EnrollmentEnd:   dates[3],
+	}, true
+}
+
+func (a InstitutionKeeperAdapterForStudent) NormalizeGrade(ctx sdk.Context, institutionIndex string, grade string) (studentmoduletypes.NormalizedGrade, error) {
+	normalized, err := a.keeper.NormalizeGrade(ctx, institutionIndex, grade)
+	if err != nil {
+		return studentmoduletypes.NormalizedGrade{}, err
+	}
+
+	return toStudentNormalizedGrade(normalized), nil
+}
+
+// toStudentNormalizedGrade converts a grade from institution.types to student.types
+func toStudentNormalizedGrade(normalized institutionmoduletypes.NormalizedGrade) studentmoduletypes.NormalizedGrade {
+	return studentmoduletypes.NormalizedGrade{
+		Value:           normalized.Value,
+		Score:           normalized.Score,
+		Letter:          normalized.Letter,
+		GpaPoints:       normalized.GpaPoints,
+		Passing:         normalized.Passing,
+		CountsTowardGpa: normalized.CountsTowardGpa,
+	}
+}
+
 // CourseKeeperAdapterForStudent adapts course keeper to student interface
 type CourseKeeperAdapterForStudent struct {
 	keeper *coursemodulekeeper.Keeper
@@ -612,6 +679,8 @@ func (a CurriculumKeeperAdapterForStudent) GetCurriculumTree(ctx context.Context
 			MinimumTimeYears:        convertStringToFloat64(curriculum.GraduationRequirements.MinimumTimeYears),
 			MaximumTimeYears:        convertStringToFloat64(curriculum.GraduationRequirements.MaximumTimeYears),
 		},
+		Published:   curriculum.IsPublished(),
+		PublishedAt: curriculum.PublishedAt,
 	}, true
 }
 
@@ -655,12 +724,29 @@ func (a CurriculumKeeperAdapterForStudent) GetCurriculumTreesByCourse(ctx contex
 				MinimumTimeYears:        convertStringToFloat64(curriculum.GraduationRequirements.MinimumTimeYears),
 				MaximumTimeYears:        convertStringToFloat64(curriculum.GraduationRequirements.MaximumTimeYears),
 			},
+			Published:   curriculum.IsPublished(),
+			PublishedAt: curriculum.PublishedAt,
 		})
 	}
 
 	return result
 }
 
+func (a CurriculumKeeperAdapterForStudent) GetLatestPublishedCurriculum(ctx context.Context, courseId string) (studentmoduletypes.CurriculumTree, bool) {
+	latest, found := a.keeper.GetLatestPublishedCurriculum(ctx, courseId)
+	if !found {
+		return studentmoduletypes.CurriculumTree{}, false
+	}
+	return a.GetCurriculumTree(ctx, latest.Index)
+}
+
+func (a CurriculumKeeperAdapterForStudent) PublishedAfter(x, y studentmoduletypes.CurriculumTree) bool {
+	return curriculummodulekeeper.PublishedAfter(
+		curriculummoduletypes.CurriculumTree{Index: x.Index, PublishedAt: x.PublishedAt},
+		curriculummoduletypes.CurriculumTree{Index: y.Index, PublishedAt: y.PublishedAt},
+	)
+}
+
 // SubjectKeeperAdapterForStudent adapts subject keeper to student interface
 type SubjectKeeperAdapterForStudent struct {
 	keeper *subjectmodulekeeper.Keeper
@@ -677,6 +763,7 @@ func (a SubjectKeeperAdapterForStudent) GetSubject(ctx sdk.Context, subjectId st
 		Index:         subject.Index,
 		SubjectId:     subject.SubjectId,
 		Institution:   subject.Institution,
+		CourseId:      subject.CourseId,
 		Title:         subject.Title,
 		Code:          subject.Code,
 		WorkloadHours: subject.WorkloadHours,
@@ -710,6 +797,7 @@ func (a SubjectKeeperAdapterForStudent) GetSubjectsByCourse(ctx sdk.Context, cou
 			Index:         subject.Index,
 			SubjectId:     subject.SubjectId,
 			Institution:   subject.Institution,
+			CourseId:      subject.CourseId,
 			Title:         subject.Title,
 			Code:          subject.Code,
 			WorkloadHours: subject.WorkloadHours,
@@ -737,10 +825,38 @@ func (a SubjectKeeperAdapterForStudent) CheckPrerequisitesViaContract(ctx sdk.Co
 	return a.keeper.CheckPrerequisitesViaContract(ctx, studentID, subjectID)
 }
 
+func (a SubjectKeeperAdapterForStudent) EvaluatePrerequisites(ctx sdk.Context, studentId string, subjectId string) (bool, []string, error) {
+	evaluation, err := a.keeper.EvaluatePrerequisites(ctx, studentId, subjectId)
+	if err != nil {
+		return false, nil, err
+	}
+	return evaluation.Eligible, evaluation.Missing, nil
+}
+
 func (a SubjectKeeperAdapterForStudent) CheckEquivalenceViaContract(ctx sdk.Context, sourceSubjectID string, targetSubjectID string, forceRecalculate bool) (uint64, string, error) {
 	return a.keeper.CheckEquivalenceViaContract(ctx, sourceSubjectID, targetSubjectID, forceRecalculate)
 }
 
+// StudentKeeperAdapterForSubject adapts student keeper to subject interface
+type StudentKeeperAdapterForSubject struct {
+	keeper *studentmodulekeeper.Keeper
+}
+
+func (a StudentKeeperAdapterForSubject) GetAcademicTree(ctx sdk.Context, studentId string) (subjectmoduletypes.StudentAcademicTree, bool) {
+	academicTree, found := a.keeper.GetAcademicTreeByStudentTyped(ctx, studentId)
+	if !found {
+		return subjectmoduletypes.StudentAcademicTree{}, false
+	}
+
+	return subjectmoduletypes.StudentAcademicTree{
+		Index:            academicTree.Index,
+		Student:          academicTree.Student,
+		CompletedTokens:  academicTree.CompletedTokens,
+		InProgressTokens: academicTree.InProgressTokens,
+		AvailableTokens:  academicTree.AvailableTokens,
+	}, true
+}
+
 // TokenDefKeeperAdapterForStudent adapts tokendef keeper to student interface
 type TokenDefKeeperAdapterForStudent struct {
 	keeper *tokendefmodulekeeper.Keeper
@@ -882,21 +998,45 @@ func (a StudentKeeperAdapterForAcademicNFT) ValidateStudentEligibility(ctx sdk.C
 	return fmt.Errorf("student %s is not eligible to receive tokens from institution %s", studentAddress, institutionID)
 }
 
+func (a StudentKeeperAdapterForAcademicNFT) AmendSubjectGrade(ctx sdk.Context, studentAddress string, subjectId string, tokenInstanceID string, previous institutionmoduletypes.NormalizedGrade, amended institutionmoduletypes.NormalizedGrade) error {
+	return a.keeper.AmendSubjectGrade(ctx, studentAddress, subjectId, tokenInstanceID, toStudentNormalizedGrade(previous), toStudentNormalizedGrade(amended))
+}
+
+func (a StudentKeeperAdapterForAcademicNFT) RevokeSubjectCompletion(ctx sdk.Context, studentAddress string, subjectId string, tokenInstanceID string, grade institutionmoduletypes.NormalizedGrade) error {
+	return a.keeper.RevokeSubjectCompletion(ctx, studentAddress, subjectId, tokenInstanceID, toStudentNormalizedGrade(grade))
+}
+
 // AcademicNFTKeeperAdapterForStudent adapts AcademicNFT keeper for Student interface
 type AcademicNFTKeeperAdapterForStudent struct {
 	keeper *academicnftmodulekeeper.Keeper
 }
 
-func (a AcademicNFTKeeperAdapterForStudent) GetTokenInstance(ctx sdk.Context, tokenInstanceId string) (studentmoduletypes.SubjectTokenInstance, bool) {
+func (a AcademicNFTKeeperAdapterForStudent) GetSubjectTokenInstance(ctx sdk.Context, tokenInstanceId string) (studentmoduletypes.SubjectTokenInstance, bool) {
 	tokenInstance, found := a.keeper.GetSubjectTokenInstance(ctx, tokenInstanceId)
 	if !found {
 		return studentmoduletypes.SubjectTokenInstance{}, false
 	}
+	return toStudentTokenInstance(tokenInstance), true
+}
 
-	// Convert from academicnft.types to student.types
-	// Based on proto definition: index, tokenDefId, student, completionDate, grade, issuerInstitution, semester, professorSignature
+func (a AcademicNFTKeeperAdapterForStudent) GetStudentTokenInstances(ctx sdk.Context, studentAddress string) ([]studentmoduletypes.SubjectTokenInstance, error) {
+	tokens, err := a.keeper.GetStudentTokenInstances(ctx, studentAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]studentmoduletypes.SubjectTokenInstance, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, toStudentTokenInstance(token))
+	}
+	return result, nil
+}
+
+// toStudentTokenInstance converts a token instance to the student module type;
+// revoked tokens are reported as invalid
+func toStudentTokenInstance(tokenInstance academicnftmoduletypes.SubjectTokenInstance) studentmoduletypes.SubjectTokenInstance {
 	return studentmoduletypes.SubjectTokenInstance{
-		TokenInstanceId:    tokenInstance.Index, // Use Index as TokenInstanceId
+		TokenInstanceId:    tokenInstance.Index,
 		TokenDefId:         tokenInstance.TokenDefId,
 		Student:            tokenInstance.Student,
 		CompletionDate:     tokenInstance.CompletionDate,
@@ -904,35 +1044,32 @@ func (a AcademicNFTKeeperAdapterForStudent) GetTokenInstance(ctx sdk.Context, to
 		IssuerInstitution:  tokenInstance.IssuerInstitution,
 		Semester:           tokenInstance.Semester,
 		ProfessorSignature: tokenInstance.ProfessorSignature,
-		MintedAt:           "",   // Set default empty string since CreatedAt doesn't exist
-		IsValid:            true, // Default value
-	}, true
+		MintedAt:           tokenInstance.IssuedAt,
+		IsValid:            !tokenInstance.Revoked,
+		TransferCredit:     tokenInstance.TransferCredit,
+	}
 }
 
-func (a AcademicNFTKeeperAdapterForStudent) GetStudentTokens(ctx sdk.Context, studentAddress string) []studentmoduletypes.SubjectTokenInstance {
-	tokens, err := a.keeper.GetStudentTokenInstances(ctx, studentAddress)
-	if err != nil {
-		return []studentmoduletypes.SubjectTokenInstance{}
+// MintTransferCreditToken issues a token marked as transfer credit for a
+// subject credited through an approved equivalence
+func (a AcademicNFTKeeperAdapterForStudent) MintTransferCreditToken(ctx sdk.Context, tokenDefId string, studentAddress string, issuerInstitution string, equivalenceId string) (string, error) {
+	tokenInstance := academicnftmoduletypes.SubjectTokenInstance{
+		Index:             a.keeper.GenerateTokenInstanceID(ctx),
+		TokenDefId:        tokenDefId,
+		Student:           studentAddress,
+		CompletionDate:    ctx.BlockTime().UTC().Format("2006-01-02"),
+		IssuerInstitution: issuerInstitution,
+		TransferCredit:    true,
+		EquivalenceId:     equivalenceId,
 	}
-
-	// Convert to student types
-	var result []studentmoduletypes.SubjectTokenInstance
-	for _, token := range tokens {
-		result = append(result, studentmoduletypes.SubjectTokenInstance{
-			TokenInstanceId:    token.Index, // Use Index as TokenInstanceId
-			TokenDefId:         token.TokenDefId,
-			Student:            token.Student,
-			CompletionDate:     token.CompletionDate,
-			Grade:              token.Grade,
-			IssuerInstitution:  token.IssuerInstitution,
-			Semester:           token.Semester,
-			ProfessorSignature: token.ProfessorSignature,
-			MintedAt:           "",   // Set default empty string since CreatedAt doesn't exist
-			IsValid:            true, // Default value
-		})
+	if err := a.keeper.IssueSubjectTokenInstance(ctx, tokenInstance); err != nil {
+		return "", err
 	}
+	return tokenInstance.Index, nil
+}
 
-	return result
+func (a AcademicNFTKeeperAdapterForStudent) GetStudentTokenForTokenDef(ctx sdk.Context, studentAddress string, tokenDefId string) (string, bool) {
+	return a.keeper.GetStudentTokenForTokenDef(ctx, studentAddress, tokenDefId)
 }
 
 func (a AcademicNFTKeeperAdapterForStudent) MintSubjectToken(ctx sdk.Context, tokenDefId string, student string, completionDate string, grade string, issuerInstitution string, semester string, professorSignature string) (string, error) {
@@ -952,16 +1089,76 @@ func (a AcademicNFTKeeperAdapterForStudent) MintSubjectToken(ctx sdk.Context, to
 		// No CreatedAt, Creator, MintedAt, IsValid fields in proto
 	}
 
-	// Store the token instance using the existing keeper function
-	a.keeper.SetSubjectTokenInstance(ctx, tokenInstance)
+	// Store the token instance within the supply of its token definition
+	if err := a.keeper.IssueSubjectTokenInstance(ctx, tokenInstance); err != nil {
+		return "", err
+	}
 
 	return tokenInstanceId, nil
 }
 
+// DegreeKeeperAdapterForStudent adapts Degree keeper for Student interface
+type DegreeKeeperAdapterForStudent struct {
+	keeper *degreemodulekeeper.Keeper
+}
+
+func (a DegreeKeeperAdapterForStudent) EvaluateGraduation(ctx sdk.Context, studentId string, curriculumId string) (studentmoduletypes.GraduationEvaluation, error) {
+	validation, err := a.keeper.ValidateDegreeRequest(ctx, degreemoduletypes.DegreeRequest{StudentId: studentId, CurriculumId: curriculumId})
+	if err != nil {
+		return studentmoduletypes.GraduationEvaluation{}, err
+	}
+
+	evaluation := studentmoduletypes.GraduationEvaluation{
+		Passed:          validation.Passed,
+		CurriculumId:    validation.CurriculumId,
+		RequirementsMet: validation.RequirementsMet,
+	}
+	for _, missing := range validation.Missing {
+		evaluation.Missing = append(evaluation.Missing, studentmoduletypes.MissingGraduationRequirement{
+			Requirement: missing.Requirement,
+			Description: missing.Description,
+			Required:    missing.Required,
+			Completed:   missing.Completed,
+			SubjectIds:  missing.SubjectIds,
+		})
+	}
+	return evaluation, nil
+}
+
 // ============================================================================
 // ADAPTERS FOR EQUIVALENCE MODULE INTERFACES
 // ============================================================================
 
+// StudentHooksForEquivalence credits approved equivalences to the academic
+// trees of the students who requested them
+type StudentHooksForEquivalence struct {
+	keeper *studentmodulekeeper.Keeper
+}
+
+func (h StudentHooksForEquivalence) AfterEquivalenceApproved(ctx context.Context, equivalence equivalencemoduletypes.SubjectEquivalence) error {
+	return h.keeper.ApplyApprovedEquivalence(sdk.UnwrapSDKContext(ctx), equivalence.Index, equivalence.SourceSubjectId, equivalence.TargetSubjectId, equivalence.EquivalencePercent)
+}
+
+// EquivalenceKeeperAdapterForStudent adapts equivalence keeper to student interface
+type EquivalenceKeeperAdapterForStudent struct {
+	keeper *equivalencemodulekeeper.Keeper
+}
+
+func (a EquivalenceKeeperAdapterForStudent) GetSubjectEquivalence(ctx sdk.Context, sourceSubjectId string, targetSubjectId string) (studentmoduletypes.SubjectEquivalence, bool) {
+	found, _, _, equivalence := a.keeper.CheckEquivalenceStatusInternal(ctx, sourceSubjectId, targetSubjectId)
+	if !found {
+		return studentmoduletypes.SubjectEquivalence{}, false
+	}
+
+	return studentmoduletypes.SubjectEquivalence{
+		Index:              equivalence.Index,
+		SourceSubjectId:    equivalence.SourceSubjectId,
+		TargetSubjectId:    equivalence.TargetSubjectId,
+		Status:             equivalence.EquivalenceStatus,
+		EquivalencePercent: equivalence.EquivalencePercent,
+	}, true
+}
+
 // SubjectKeeperAdapterForEquivalence adapts subject keeper to equivalence interface
 type SubjectKeeperAdapterForEquivalence struct {
 	keeper *subjectmodulekeeper.Keeper
@@ -1124,6 +1321,56 @@ func (a StudentKeeperAdapterForDegree) GetStudentByIndex(ctx sdk.Context, index
 	}, true
 }
 
+// GetStudentRecord returns the subjects a student passed or was credited
+// with, as listed in the transcript, with the date of the enrollment in the
+// course of the academic tree
+func (a StudentKeeperAdapterForDegree) GetStudentRecord(ctx sdk.Context, studentId string) (degreemoduletypes.StudentRecord, bool) {
+	student, found := a.keeper.GetStudentByIndex(ctx, studentId)
+	if !found {
+		return degreemoduletypes.StudentRecord{}, false
+	}
+	academicTree, found := a.keeper.GetAcademicTreeByStudentTyped(ctx, studentId)
+	if !found {
+		return degreemoduletypes.StudentRecord{}, false
+	}
+	transcript, err := a.keeper.BuildTranscript(ctx, student)
+	if err != nil {
+		return degreemoduletypes.StudentRecord{}, false
+	}
+
+	record := degreemoduletypes.StudentRecord{
+		StudentId:         studentId,
+		CourseId:          academicTree.CourseId,
+		CurriculumVersion: academicTree.CurriculumVersion,
+		GPA:               transcript.CumulativeGpa,
+	}
+	enrollments, _ := a.keeper.GetStudentEnrollments(ctx, studentId)
+	for _, enrollment := range enrollments {
+		if enrollment.CourseId != academicTree.CourseId || enrollment.EnrollmentDate == "" {
+			continue
+		}
+		if record.EnrollmentDate == "" || enrollment.EnrollmentDate < record.EnrollmentDate {
+			record.EnrollmentDate = enrollment.EnrollmentDate
+		}
+	}
+	for _, semester := range transcript.Semesters {
+		for _, entry := range semester.Subjects {
+			if entry.Passed {
+				record.Subjects = append(record.Subjects, degreemoduletypes.CompletedSubject{SubjectId: entry.SubjectId, Credits: entry.Credits})
+			}
+		}
+	}
+	for _, transferred := range transcript.TransferredSubjects {
+		record.Subjects = append(record.Subjects, degreemoduletypes.CompletedSubject{SubjectId: transferred.SubjectId, Credits: transferred.Credits, Transferred: true})
+	}
+	return record, true
+}
+
+// GetIntegrationMode returns the integration mode of the student module
+func (a StudentKeeperAdapterForDegree) GetIntegrationMode(ctx sdk.Context) string {
+	return a.keeper.GetParams(ctx).Mode()
+}
+
 // CurriculumKeeperAdapterForDegree adapts curriculum keeper to degree interface
 type CurriculumKeeperAdapterForDegree struct {
 	keeper *curriculummodulekeeper.Keeper
@@ -1143,6 +1390,7 @@ func (a CurriculumKeeperAdapterForDegree) GetCurriculum(ctx sdk.Context, id stri
 	return degreemoduletypes.Curriculum{
 		Id:               curriculum.Index,
 		InstitutionId:    "", // Get from course
+		CourseId:         curriculum.CourseId,
 		RequiredCredits:  totalCredits,
 		RequiredSubjects: curriculum.RequiredSubjects,
 	}, true
@@ -1205,6 +1453,65 @@ func (a CurriculumKeeperAdapterForDegree) GetCurriculumTree(ctx sdk.Context, id
 	return a.keeper.GetCurriculumTreeSDK(ctx, id)
 }
 
+func (a CurriculumKeeperAdapterForDegree) GetCurriculumRequirements(ctx sdk.Context, id string) (degreemoduletypes.CurriculumRequirements, bool) {
+	curriculum, found := a.keeper.GetCurriculumTreeSDK(ctx, id)
+	if !found {
+		return degreemoduletypes.CurriculumRequirements{}, false
+	}
+	return toDegreeCurriculumRequirements(curriculum), true
+}
+
+// GetCurriculumVersion returns the curriculum of a course with the given
+// version. Without a version it only resolves when the course has a single
+// curriculum.
+func (a CurriculumKeeperAdapterForDegree) GetCurriculumVersion(ctx sdk.Context, courseId string, version string) (degreemoduletypes.CurriculumRequirements, bool) {
+	curricula := a.keeper.GetCurriculumTreesByCourse(ctx, courseId)
+	if version == "" {
+		if len(curricula) == 1 {
+			return toDegreeCurriculumRequirements(curricula[0]), true
+		}
+		return degreemoduletypes.CurriculumRequirements{}, false
+	}
+	for _, curriculum := range curricula {
+		if curriculum.Version == version {
+			return toDegreeCurriculumRequirements(curriculum), true
+		}
+	}
+	return degreemoduletypes.CurriculumRequirements{}, false
+}
+
+func toDegreeCurriculumRequirements(curriculum curriculummoduletypes.CurriculumTree) degreemoduletypes.CurriculumRequirements {
+	requirements := degreemoduletypes.CurriculumRequirements{
+		Id:               curriculum.Index,
+		CourseId:         curriculum.CourseId,
+		Version:          curriculum.Version,
+		RequiredSubjects: curriculum.RequiredSubjects,
+		ElectiveSubjects: curriculum.ElectiveSubjects,
+		ElectiveMin:      curriculum.ElectiveMin,
+	}
+	for _, group := range curriculum.ElectiveGroups {
+		if group == nil {
+			continue
+		}
+		requirements.ElectiveGroups = append(requirements.ElectiveGroups, degreemoduletypes.ElectiveGroupRequirement{
+			GroupId:         group.GroupId,
+			Name:            group.Name,
+			SubjectIds:      group.SubjectIds,
+			MinSubjects:     convertStringToUint64(group.MinSubjectsRequired),
+			CreditsRequired: convertStringToUint64(group.CreditsRequired),
+		})
+	}
+	if graduation := curriculum.GraduationRequirements; graduation != nil {
+		requirements.TotalCredits = convertStringToUint64(graduation.TotalCreditsRequired)
+		requirements.ElectiveCredits = convertStringToUint64(graduation.RequiredElectiveCredits)
+		requirements.RequiredActivities = graduation.RequiredActivities
+		requirements.MinGPA = strings.TrimSpace(graduation.MinGpa)
+		requirements.MinimumYears = strings.TrimSpace(graduation.MinimumTimeYears)
+		requirements.MaximumYears = strings.TrimSpace(graduation.MaximumTimeYears)
+	}
+	return requirements
+}
+
 // AcademicNFTKeeperAdapterForDegree adapts academic NFT keeper to degree interface
 type AcademicNFTKeeperAdapterForDegree struct {
 	keeper *academicnftmodulekeeper.Keeper
@@ -1304,6 +1611,18 @@ func (a InstitutionKeeperAdapterForDegree) IsAuthorizedToIssueDegrees(ctx sdk.Co
 	return a.keeper.IsInstitutionAuthorized(ctx, institutionId)
 }
 
+func (a InstitutionKeeperAdapterForDegree) CanIssueDegree(ctx sdk.Context, institutionId string, address string, courseId string) bool {
+	return a.keeper.CanIssueDegree(ctx, institutionId, address, courseId)
+}
+
+func (a InstitutionKeeperAdapterForDegree) GetInstitutionSigningKeys(ctx sdk.Context, institution string) []institutionmoduletypes.SigningKey {
+	return a.keeper.GetInstitutionSigningKeys(ctx, institution)
+}
+
+func (a InstitutionKeeperAdapterForDegree) GetSigningKey(ctx sdk.Context, institution string, keyId string) (institutionmoduletypes.SigningKey, bool) {
+	return a.keeper.GetSigningKey(ctx, institution, keyId)
+}
+
 // ============================================================================
 // ADAPTERS FOR SCHEDULE MODULE INTERFACES
 // ============================================================================
@@ -1330,11 +1649,25 @@ func (a SubjectKeeperAdapterForSchedule) GetSubject(ctx sdk.Context, subjectID s
 		Credits:            subject.Credits,
 		SubjectType:        subject.SubjectType,
 		KnowledgeArea:      subject.KnowledgeArea,
-		PrerequisiteGroups: []schedulemoduletypes.PrerequisiteGroup{}, // Initialize empty
-		DifficultyLevel:    "medium",                                  // Default difficulty
+		PrerequisiteGroups: a.prerequisiteGroups(ctx, subject.Index),
+		DifficultyLevel:    "medium", // Default difficulty
 	}, true
 }
 
+// prerequisiteGroups returns the prerequisite groups of a subject in schedule types
+func (a SubjectKeeperAdapterForSchedule) prerequisiteGroups(ctx sdk.Context, subjectID string) []schedulemoduletypes.PrerequisiteGroup {
+	groups := []schedulemoduletypes.PrerequisiteGroup{}
+	for _, group := range a.keeper.GetPrerequisiteGroupsBySubject(ctx, subjectID) {
+		groups = append(groups, schedulemoduletypes.PrerequisiteGroup{
+			GroupType:                group.GroupType,
+			SubjectIds:               group.SubjectIds,
+			MinimumCredits:           group.MinimumCredits,
+			MinimumCompletedSubjects: group.MinimumCompletedSubjects,
+		})
+	}
+	return groups
+}
+
 func (a SubjectKeeperAdapterForSchedule) CheckPrerequisites(ctx sdk.Context, studentID string, subjectID string) (bool, []string, error) {
 	return a.keeper.CheckPrerequisitesViaContract(ctx, studentID, subjectID)
 }
@@ -1359,7 +1692,7 @@ func (a SubjectKeeperAdapterForSchedule) GetSubjectsByArea(ctx sdk.Context, area
 				Credits:            subject.Credits,
 				SubjectType:        subject.SubjectType,
 				KnowledgeArea:      subject.KnowledgeArea,
-				PrerequisiteGroups: []schedulemoduletypes.PrerequisiteGroup{},
+				PrerequisiteGroups: a.prerequisiteGroups(ctx, subject.Index),
 				DifficultyLevel:    "medium",
 			})
 		}
@@ -1388,9 +1721,9 @@ func (a StudentKeeperAdapterForSchedule) GetAcademicTree(ctx sdk.Context, studen
 		CompletedTokens:     academicTree.CompletedTokens,
 		InProgressTokens:    academicTree.InProgressTokens,
 		AvailableTokens:     academicTree.AvailableTokens,
-		TotalCredits:        uint64(len(academicTree.CompletedTokens) * 4),  // Estimate
+		TotalCredits:        academicTree.TotalCredits,
 		TotalCompletedHours: uint64(len(academicTree.CompletedTokens) * 60), // Estimate
-		CoefficientGPA:      8.0,                                            // Default GPA
+		CoefficientGPA:      float64(academicTree.CoefficientGpa),
 	}, true
 }
 
@@ -1410,6 +1743,10 @@ func (a StudentKeeperAdapterForSchedule) GetInProgressSubjects(ctx sdk.Context,
 	return academicTree.InProgressTokens
 }
 
+func (a StudentKeeperAdapterForSchedule) ValidateStudentTerm(ctx sdk.Context, studentID string, termID string) error {
+	return a.keeper.ValidateStudentTerm(ctx, studentID, termID)
+}
+
 // CurriculumKeeperAdapterForSchedule adapts curriculum keeper to schedule interface
 type CurriculumKeeperAdapterForSchedule struct {
 	keeper *curriculummodulekeeper.Keeper
@@ -1428,11 +1765,53 @@ func (a CurriculumKeeperAdapterForSchedule) GetCurriculumTree(ctx sdk.Context, c
 		Version:           curriculum.Version,
 		RequiredSubjects:  curriculum.RequiredSubjects,
 		ElectiveSubjects:  curriculum.ElectiveSubjects,
-		SemesterStructure: []schedulemoduletypes.CurriculumSemester{}, // Initialize empty
-		ElectiveGroups:    []schedulemoduletypes.ElectiveGroup{},      // Initialize empty
+		SemesterStructure: toScheduleSemesters(curriculum.SemesterStructure),
+		ElectiveGroups:    toScheduleElectiveGroups(curriculum.ElectiveGroups),
 	}, true
 }
 
+// toScheduleSemesters converts the curriculum semester structure, skipping
+// semesters whose number is not numeric
+func toScheduleSemesters(semesters []*curriculummoduletypes.CurriculumSemester) []schedulemoduletypes.CurriculumSemester {
+	result := []schedulemoduletypes.CurriculumSemester{}
+	for _, semester := range semesters {
+		if semester == nil {
+			continue
+		}
+		number, err := strconv.ParseUint(semester.SemesterNumber, 10, 64)
+		if err != nil {
+			continue
+		}
+		result = append(result, schedulemoduletypes.CurriculumSemester{
+			SemesterNumber: number,
+			SubjectIds:     semester.SubjectIds,
+		})
+	}
+	return result
+}
+
+// toScheduleElectiveGroups converts the curriculum elective groups; non-numeric
+// requirements are treated as zero
+func toScheduleElectiveGroups(groups []*curriculummoduletypes.ElectiveGroup) []schedulemoduletypes.ElectiveGroup {
+	result := []schedulemoduletypes.ElectiveGroup{}
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		minSubjects, _ := strconv.ParseUint(group.MinSubjectsRequired, 10, 64)
+		credits, _ := strconv.ParseUint(group.CreditsRequired, 10, 64)
+		result = append(result, schedulemoduletypes.ElectiveGroup{
+			GroupId:             group.GroupId,
+			Name:                group.Name,
+			SubjectIds:          group.SubjectIds,
+			MinSubjectsRequired: minSubjects,
+			CreditsRequired:     credits,
+			KnowledgeArea:       group.KnowledgeArea,
+		})
+	}
+	return result
+}
+
 func (a CurriculumKeeperAdapterForSchedule) GetCurrentCurriculumVersion(ctx sdk.Context, courseID string) string {
 	curriculum, found := a.keeper.GetCurriculumTree(ctx, courseID)
 	if !found {
@@ -1748,6 +2127,7 @@ func (app *App) initializeCustomKeepers() {
 		curriculumParamSpace,
 		&app.CourseKeeper,
 		&app.SubjectKeeper,
+		&app.InstitutionKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -1794,6 +2174,9 @@ func (app *App) initializeCustomKeepers() {
 		wasmQuerierForStudent,   // NEW: WasmQuerier for contract integration
 	)
 
+	// Subject evaluates prerequisites against the student's academic tree
+	app.SubjectKeeper.SetStudentKeeper(StudentKeeperAdapterForSubject{keeper: &app.StudentKeeper})
+
 	// 7. AcademicNFT (depends on TokenDef, Student, Institution) - USING ADAPTERS
 	tokendefAdapterForAcademicNFT := &app.TokendefKeeper // Direct reference since TokenDef uses interface
 	studentAdapterForAcademicNFT := StudentKeeperAdapterForAcademicNFT{keeper: &app.StudentKeeper}
@@ -1810,6 +2193,9 @@ func (app *App) initializeCustomKeepers() {
 		app.BankKeeper,                // BankKeeper
 	)
 
+	// Student reads the token instances of its students, e.g. for transcripts
+	app.StudentKeeper.SetAcademicNFTKeeper(AcademicNFTKeeperAdapterForStudent{keeper: &app.AcademicnftKeeper})
+
 	// 8. Equivalence (depends on Subject)
 	subjectAdapterForEquivalence := SubjectKeeperAdapterForEquivalence{keeper: &app.SubjectKeeper}
 
@@ -1823,6 +2209,10 @@ func (app *App) initializeCustomKeepers() {
 	// Set the subject keeper using the adapter
 	app.EquivalenceKeeper.SetSubjectKeeper(subjectAdapterForEquivalence)
 
+	// Student credits approved equivalences to academic trees
+	app.EquivalenceKeeper.SetHooks(StudentHooksForEquivalence{keeper: &app.StudentKeeper})
+	app.StudentKeeper.SetEquivalenceKeeper(EquivalenceKeeperAdapterForStudent{keeper: &app.EquivalenceKeeper})
+
 	// 9. Degree (depends on Student, Curriculum, AcademicNFT, Wasm, Institution)
 	// Create adapters for Degree module
 	studentAdapterForDegree := StudentKeeperAdapterForDegree{keeper: &app.StudentKeeper}
@@ -1854,6 +2244,12 @@ func (app *App) initializeCustomKeepers() {
 	// Dereference the pointer to get the value
 	app.DegreeKeeper = *keeperPtr
 
+	// Revoked subject tokens flag the degree requests relying on them
+	app.AcademicnftKeeper.SetDegreeKeeper(&app.DegreeKeeper)
+
+	// Student evaluates graduation eligibility with the degree requirements
+	app.StudentKeeper.SetDegreeKeeper(DegreeKeeperAdapterForStudent{keeper: &app.DegreeKeeper})
+
 	// 10. Schedule (depends on Subject, Student, Curriculum) - USING ADAPTERS
 	subjectAdapterForSchedule := SubjectKeeperAdapterForSchedule{keeper: &app.SubjectKeeper}
 	studentAdapterForSchedule := StudentKeeperAdapterForSchedule{keeper: &app.StudentKeeper}
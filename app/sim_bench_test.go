@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	storetypes "cosmossdk.io/store/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -15,6 +16,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"academictoken/app"
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	academicnftkeeper "academictoken/x/academicnft/keeper"
+	academicnfttypes "academictoken/x/academicnft/types"
 )
 
 // Profile with:
@@ -148,3 +153,47 @@ func BenchmarkInvariants(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkMintSubjectTokenDuplicateCheck reports the gas used to mint a
+// subject token as the number of tokens already issued grows. The duplicate
+// check reads the (student, tokenDefId) index, so the cost stays flat.
+// Run with:
+// `go test -run=^$ -bench ^BenchmarkMintSubjectTokenDuplicateCheck ./app`
+func BenchmarkMintSubjectTokenDuplicateCheck(b *testing.B) {
+	for _, existing := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("existing=%d", existing), func(b *testing.B) {
+			k, ctx := keepertest.AcademicnftKeeper(b)
+			for i := 0; i < existing; i++ {
+				k.SetSubjectTokenInstance(ctx, academicnfttypes.SubjectTokenInstance{
+					Index:      fmt.Sprintf("token-%d", i),
+					TokenDefId: fmt.Sprintf("tokendef-%d", i%100),
+					Student:    fmt.Sprintf("student-%d", i),
+					Grade:      "80",
+				})
+			}
+			ms := academicnftkeeper.NewMsgServerImpl(k)
+			msg := &academicnfttypes.MsgMintSubjectToken{
+				Creator:           sample.AccAddress(),
+				TokenDefId:        "tokendef-0",
+				Student:           sample.AccAddress(),
+				CompletionDate:    "2025-06-30",
+				Grade:             "80",
+				IssuerInstitution: "institution-1",
+				Semester:          "2025-1",
+			}
+
+			var gasUsed uint64
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+				if _, err := ms.MintSubjectToken(cacheCtx, msg); err != nil {
+					b.Fatal(err)
+				}
+				gasUsed = cacheCtx.GasMeter().GasConsumed()
+			}
+			b.ReportMetric(float64(gasUsed), "gas/op")
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -19,6 +20,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	ibcfee "github.com/cosmos/ibc-go/v8/modules/apps/29-fee"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/spf13/cast"
 )
 
 // registerWasmModules register CosmWasm keepers and non dependency inject modules.
@@ -40,6 +42,13 @@ func (app *App) registerWasmModules(
 		return nil, fmt.Errorf("error while reading wasm config: %s", err)
 	}
 
+	// use the node home from the app options so that several apps can run in
+	// the same process (e.g. in-process test networks)
+	homePath := DefaultNodeHome
+	if home := cast.ToString(appOpts.Get(flags.FlagHome)); home != "" {
+		homePath = home
+	}
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	app.WasmKeeper = wasmkeeper.NewKeeper(
@@ -56,7 +65,7 @@ func (app *App) registerWasmModules(
 		app.TransferKeeper,
 		app.MsgServiceRouter(),
 		app.GRPCQueryRouter(),
-		DefaultNodeHome,
+		homePath,
 		wasmConfig,
 		wasmtypes.VMConfig{},
 		wasmkeeper.BuiltInCapabilities(),
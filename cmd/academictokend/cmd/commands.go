@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"io"
 	"strconv"
 
@@ -13,6 +15,7 @@ import (
 	equivalencetypes "academictoken/x/equivalence/types"
 	scheduletypes "academictoken/x/schedule/types"
 	studenttypes "academictoken/x/student/types"
+	subjectipfs "academictoken/x/subject/ipfs"
 	subjecttypes "academictoken/x/subject/types"
 	tokendefmoduletypes "academictoken/x/tokendef/types"
 
@@ -31,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -69,6 +73,7 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		cmdVerifyCredential(),
 	)
 	wasmcli.ExtendUnsafeResetAllCmd(rootCmd)
 }
@@ -184,6 +189,10 @@ func getInstitutionQueryCmd() *cobra.Command {
 		cmdQueryGetInstitution(),             // Renamed from cmdQueryInstitution
 		cmdQueryListAuthorizedInstitutions(), // Renamed from cmdQueryAuthorizedInstitutions
 		cmdQueryGetInstitutionCount(),        // Renamed from cmdQueryInstitutionCount
+		cmdQuerySigningKeys(),
+		cmdQueryAccreditation(),
+		cmdQueryAcademicTerm(),
+		cmdQueryAcademicTerms(),
 	)
 
 	return cmd
@@ -281,6 +290,127 @@ func cmdQueryGetInstitution() *cobra.Command {
 	return cmd
 }
 
+// cmdQuerySigningKeys implements the signing-keys query command
+func cmdQuerySigningKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-keys [institution]",
+		Short: "List the signing keys registered by an institution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QuerySigningKeysRequest{
+				Institution: args[0],
+			}
+
+			res, err := queryClient.SigningKeys(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// cmdQueryAccreditation implements the accreditation query command
+func cmdQueryAccreditation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accreditation [institution]",
+		Short: "Show the accreditation status, expiry date and latest proposal of an institution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryAccreditationRequest{
+				Institution: args[0],
+			}
+
+			res, err := queryClient.Accreditation(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// cmdQueryAcademicTerm implements the academic-term query command
+func cmdQueryAcademicTerm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "academic-term [institution] [term-id]",
+		Short: "Show a term of the academic calendar of an institution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryAcademicTermRequest{
+				Institution: args[0],
+				TermId:      args[1],
+			}
+
+			res, err := queryClient.AcademicTerm(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// cmdQueryAcademicTerms implements the academic-terms query command
+func cmdQueryAcademicTerms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "academic-terms [institution]",
+		Short: "List the academic calendar of an institution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryAcademicTermsRequest{
+				Institution: args[0],
+			}
+
+			res, err := queryClient.AcademicTerms(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // cmdQueryListAuthorizedInstitutions implements the list-authorized-institutions query command
 func cmdQueryListAuthorizedInstitutions() *cobra.Command {
 	cmd := &cobra.Command{
@@ -729,6 +859,7 @@ func getSubjectQueryCmd() *cobra.Command {
 		cmdQueryListSubjectsByInstitution(), // Renamed from cmdQuerySubjectsByInstitution
 		cmdQueryCheckPrerequisites(),
 		cmdQueryCheckEquivalence(),
+		cmdQueryContentAttestations(),
 	)
 
 	return cmd
@@ -1021,6 +1152,35 @@ func cmdQueryCheckEquivalence() *cobra.Command {
 }
 
 // Subject Transaction Commands
+func cmdQueryContentAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "content-attestations [subject-id]",
+		Short: "List the IPFS availability attestations of a subject",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := subjecttypes.NewQueryClient(clientCtx)
+			params := &subjecttypes.QueryContentAttestationsRequest{
+				SubjectId: args[0],
+			}
+
+			res, err := queryClient.ContentAttestations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func getSubjectTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "subject",
@@ -1035,6 +1195,7 @@ func getSubjectTxCmd() *cobra.Command {
 		cmdTxAddPrerequisiteGroup(),
 		cmdTxUpdateSubjectContent(),
 		CmdCreateSubjectContent(),
+		cmdTxVerifySubjectContent(),
 	)
 
 	return cmd
@@ -1132,6 +1293,15 @@ func CmdCreateSubjectContent() *cobra.Command {
 			objectives, _ := cmd.Flags().GetStringSlice("objectives")
 			topicUnits, _ := cmd.Flags().GetStringSlice("topic-units")
 
+			// Upload the extended content before submitting its references
+			contentHash, ipfsLink, err := uploadSubjectContent(cmd, subjecttypes.ExtendedContent{
+				Objectives: objectives,
+				TopicUnits: topicUnits,
+			})
+			if err != nil {
+				return err
+			}
+
 			msg := subjecttypes.NewMsgCreateSubjectContent(
 				clientCtx.GetFromAddress().String(),
 				args[0], // institution
@@ -1145,6 +1315,8 @@ func CmdCreateSubjectContent() *cobra.Command {
 				args[8], // knowledge_area
 				objectives,
 				topicUnits,
+				contentHash,
+				ipfsLink,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -1153,6 +1325,7 @@ func CmdCreateSubjectContent() *cobra.Command {
 
 	cmd.Flags().StringSlice("objectives", []string{}, "Subject objectives")
 	cmd.Flags().StringSlice("topic-units", []string{}, "Subject topic units")
+	addSubjectContentFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1162,7 +1335,10 @@ func cmdTxAddPrerequisiteGroup() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-prerequisite-group [subject-id] [group-type] [minimum-credits] [minimum-completed-subjects] [subject-ids...]",
 		Short: "Add a prerequisite group to a subject",
-		Args:  cobra.MinimumNArgs(5),
+		Long: `Add a prerequisite group to a subject. Group types are ALL, ANY, CREDITS and COMBINATION.
+Use --parent-group to nest the group inside another group of the same subject and
+--corequisite to let subjects taken in the same term satisfy it.`,
+		Args: cobra.MinimumNArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -1183,6 +1359,8 @@ func cmdTxAddPrerequisiteGroup() *cobra.Command {
 			}
 
 			subjectIds := args[4:]
+			parentGroupId, _ := cmd.Flags().GetString("parent-group")
+			corequisite, _ := cmd.Flags().GetBool("corequisite")
 
 			msg := &subjecttypes.MsgAddPrerequisiteGroup{
 				Creator:                  clientCtx.GetFromAddress().String(),
@@ -1191,6 +1369,8 @@ func cmdTxAddPrerequisiteGroup() *cobra.Command {
 				MinimumCredits:           minimumCredits,
 				MinimumCompletedSubjects: minimumCompletedSubjects,
 				SubjectIds:               subjectIds,
+				ParentGroupId:            parentGroupId,
+				Corequisite:              corequisite,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -1201,6 +1381,8 @@ func cmdTxAddPrerequisiteGroup() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String("parent-group", "", "ID of the group this group is nested in")
+	cmd.Flags().Bool("corequisite", false, "Count subjects in progress as satisfying the group")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1226,8 +1408,21 @@ func cmdTxUpdateSubjectContent() *cobra.Command {
 			bibliographyBasic, _ := cmd.Flags().GetStringSlice("bibliography-basic")
 			bibliographyComplementary, _ := cmd.Flags().GetStringSlice("bibliography-complementary")
 			keywords, _ := cmd.Flags().GetStringSlice("keywords")
-			contentHash, _ := cmd.Flags().GetString("content-hash")
-			ipfsLink, _ := cmd.Flags().GetString("ipfs-link")
+
+			// The flags replace the whole extended content, which is uploaded
+			// before submitting its references
+			contentHash, ipfsLink, err := uploadSubjectContent(cmd, subjecttypes.ExtendedContent{
+				BibliographyBasic:         bibliographyBasic,
+				BibliographyComplementary: bibliographyComplementary,
+				EvaluationMethods:         evaluationMethods,
+				Keywords:                  keywords,
+				Methodologies:             methodologies,
+				Objectives:                objectives,
+				TopicUnits:                topicUnits,
+			})
+			if err != nil {
+				return err
+			}
 
 			msg := &subjecttypes.MsgUpdateSubjectContent{
 				Creator:                   clientCtx.GetFromAddress().String(),
@@ -1258,8 +1453,85 @@ func cmdTxUpdateSubjectContent() *cobra.Command {
 	cmd.Flags().StringSlice("bibliography-basic", []string{}, "Basic bibliography")
 	cmd.Flags().StringSlice("bibliography-complementary", []string{}, "Complementary bibliography")
 	cmd.Flags().StringSlice("keywords", []string{}, "Keywords")
-	cmd.Flags().String("content-hash", "", "Content hash (optional)")
-	cmd.Flags().String("ipfs-link", "", "IPFS link (optional)")
+	addSubjectContentFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addSubjectContentFlags adds the flags referencing the IPFS content of a subject
+func addSubjectContentFlags(cmd *cobra.Command) {
+	cmd.Flags().String("content-hash", "", "Content hash of content already uploaded to IPFS")
+	cmd.Flags().String("ipfs-link", "", "IPFS link of content already uploaded to IPFS")
+	cmd.Flags().String("ipfs-api", "http://localhost:5001", "IPFS API used to upload the content when no link is given")
+}
+
+// uploadSubjectContent returns the IPFS references of the extended content of
+// a subject, uploading it unless the references are given as flags. Uploads
+// happen here because the chain only validates references
+func uploadSubjectContent(cmd *cobra.Command, content subjecttypes.ExtendedContent) (string, string, error) {
+	contentHash, _ := cmd.Flags().GetString("content-hash")
+	ipfsLink, _ := cmd.Flags().GetString("ipfs-link")
+	if contentHash != "" || ipfsLink != "" || content.IsEmpty() {
+		return contentHash, ipfsLink, nil
+	}
+
+	ipfsApi, _ := cmd.Flags().GetString("ipfs-api")
+	contentHash, ipfsLink, err := subjectipfs.NewIPFSClient(ipfsApi, "", true).Add(content.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to upload subject content to IPFS: %w", err)
+	}
+	return contentHash, ipfsLink, nil
+}
+
+// cmdTxVerifySubjectContent is the pinning verifier: it fetches the content of
+// a subject from IPFS, checks it against the on-chain hash and attests the result
+func cmdTxVerifySubjectContent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-content [subject-id]",
+		Short: "Check that the IPFS content of a subject is available and attest the result",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := subjecttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.GetSubject(context.Background(), &subjecttypes.QueryGetSubjectRequest{Index: args[0]})
+			if err != nil {
+				return err
+			}
+			subject := res.Subject
+			if subject.IpfsLink == "" {
+				return fmt.Errorf("subject '%s' has no IPFS content", args[0])
+			}
+
+			available, details := true, "content matches the on-chain hash"
+			ipfsApi, _ := cmd.Flags().GetString("ipfs-api")
+			content, err := subjectipfs.NewIPFSClient(ipfsApi, "", true).Get(subject.IpfsLink)
+			if err == nil {
+				err = subjectipfs.VerifyContent(content, subject.ContentHash, subject.IpfsLink)
+			}
+			if err != nil {
+				available, details = false, err.Error()
+			}
+
+			msg := subjecttypes.NewMsgAttestContentAvailability(
+				clientCtx.GetFromAddress().String(),
+				subject.Index,
+				subject.IpfsLink,
+				available,
+				details,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String("ipfs-api", "http://localhost:5001", "IPFS API used to fetch the content")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -2041,6 +2313,8 @@ func getAcademicNftQueryCmd() *cobra.Command {
 		cmdQueryGetStudentTokens(),
 		cmdQueryGetTokenDefInstances(),
 		cmdQueryVerifyTokenInstance(),
+		cmdQueryTokenSupply(),
+		cmdQuerySubjectTokenCredential(),
 	)
 
 	return cmd
@@ -2119,10 +2393,13 @@ func cmdQueryGetStudentTokens() *cobra.Command {
 				return err
 			}
 
+			term, _ := cmd.Flags().GetString("term")
+
 			queryClient := academicnfttypes.NewQueryClient(clientCtx)
 			params := &academicnfttypes.QueryGetStudentTokensRequest{
 				StudentAddress: args[0],
 				Pagination:     pageReq,
+				Term:           term,
 			}
 
 			res, err := queryClient.GetStudentTokens(context.Background(), params)
@@ -2134,6 +2411,7 @@ func cmdQueryGetStudentTokens() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String("term", "", "Only return tokens of this academic term")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
@@ -2156,10 +2434,13 @@ func cmdQueryGetTokenDefInstances() *cobra.Command {
 				return err
 			}
 
+			term, _ := cmd.Flags().GetString("term")
+
 			queryClient := academicnfttypes.NewQueryClient(clientCtx)
 			params := &academicnfttypes.QueryGetTokenDefInstancesRequest{
 				TokenDefId: args[0],
 				Pagination: pageReq,
+				Term:       term,
 			}
 
 			res, err := queryClient.GetTokenDefInstances(context.Background(), params)
@@ -2171,6 +2452,7 @@ func cmdQueryGetTokenDefInstances() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String("term", "", "Only return tokens of this academic term")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
@@ -2206,6 +2488,36 @@ func cmdQueryVerifyTokenInstance() *cobra.Command {
 	return cmd
 }
 
+// cmdQueryTokenSupply implements the token-supply query command
+func cmdQueryTokenSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-supply [token-def-id]",
+		Short: "Show the supply of a TokenDef and how many instances can still be minted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := academicnfttypes.NewQueryClient(clientCtx)
+			params := &academicnfttypes.QueryTokenSupplyRequest{
+				TokenDefId: args[0],
+			}
+
+			res, err := queryClient.TokenSupply(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // getAcademicNftTxCmd creates the academicnft transaction commands
 func getAcademicNftTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2218,6 +2530,7 @@ func getAcademicNftTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		cmdTxMintSubjectToken(),
+		cmdTxSignSubjectToken(),
 		cmdTxVerifyTokenInstance(),
 		cmdTxUpdateAcademicNftParams(),
 	)
@@ -2244,8 +2557,9 @@ func cmdTxMintSubjectToken() *cobra.Command {
 			issuerInstitution := args[4]
 			semester := args[5]
 
-			// Parse optional professor signature from flag
+			// Parse optional professor signature and signing key from flags
 			professorSignature, _ := cmd.Flags().GetString("professor-signature")
+			signingKeyId, _ := cmd.Flags().GetString("signing-key-id")
 
 			msg := &academicnfttypes.MsgMintSubjectToken{
 				Creator:            clientCtx.GetFromAddress().String(),
@@ -2256,6 +2570,7 @@ func cmdTxMintSubjectToken() *cobra.Command {
 				IssuerInstitution:  issuerInstitution,
 				Semester:           semester,
 				ProfessorSignature: professorSignature,
+				SigningKeyId:       signingKeyId,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -2266,7 +2581,38 @@ func cmdTxMintSubjectToken() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().String("professor-signature", "", "Professor signature for the token")
+	cmd.Flags().String("professor-signature", "", "Base64 issuer signature, see sign-subject-token")
+	cmd.Flags().String("signing-key-id", "", "Institution signing key that produced the signature")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// cmdTxSignSubjectToken implements the sign-subject-token command, which signs
+// the canonical fields of a subject token offline with a keyring key
+func cmdTxSignSubjectToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-subject-token [token-def-id] [student] [completion-date] [grade] [semester]",
+		Short: "Sign the fields of a subject token with the --from key and print the base64 signature",
+		Long: `Sign the fields of a subject token with the --from key, which must be registered
+as a signing key of the issuing institution. The grade must be written as the
+institution grading scale records it (upper-case letters, PASS/FAIL).`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signBytes := academicnfttypes.SubjectTokenSignBytes(args[1], args[0], args[3], args[4], args[2])
+			sig, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(base64.StdEncoding.EncodeToString(sig) + "\n")
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -2363,6 +2709,10 @@ func getStudentQueryCmd() *cobra.Command {
 		cmdQueryGetStudentsByCourse(),
 		cmdQueryGetStudentAcademicTree(),
 		cmdQueryCheckGraduationEligibility(),
+		cmdQueryStudentIntegrationMode(),
+		cmdQueryTranscript(),
+		cmdQuerySubjectOffering(),
+		cmdQuerySubjectOfferings(),
 	)
 
 	return cmd
@@ -2394,6 +2744,86 @@ func cmdQueryStudentParams() *cobra.Command {
 	return cmd
 }
 
+func cmdQueryStudentIntegrationMode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "integration-mode",
+		Short: "Shows how the student module evaluates progress and which contracts it calls",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := studenttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.IntegrationMode(context.Background(), &studenttypes.QueryIntegrationModeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdQuerySubjectOffering() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subject-offering [offering-id]",
+		Short: "Shows a subject offering with its seats and waitlist",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := studenttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.SubjectOffering(context.Background(), &studenttypes.QuerySubjectOfferingRequest{OfferingId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdQuerySubjectOfferings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subject-offerings",
+		Short: "Lists subject offerings, optionally of a subject and a term",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subjectId, _ := cmd.Flags().GetString("subject")
+			term, _ := cmd.Flags().GetString("term")
+
+			queryClient := studenttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.SubjectOfferings(context.Background(), &studenttypes.QuerySubjectOfferingsRequest{SubjectId: subjectId, Term: term})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("subject", "", "Only list offerings of this subject")
+	cmd.Flags().String("term", "", "Only list offerings of this academic term")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func cmdQueryListStudents() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-students",
@@ -2927,7 +3357,9 @@ func cmdTxUpdateStudentParams() *cobra.Command {
 			ipfsEnabled := args[1] == "true"
 			admin := args[2]
 
-			params := studenttypes.NewParams(ipfsGateway, ipfsEnabled, admin, "", "", "", "", "")
+			integrationMode, _ := cmd.Flags().GetString("integration-mode")
+
+			params := studenttypes.NewParams(ipfsGateway, ipfsEnabled, admin, "", "", "", "", "", integrationMode)
 
 			msg := &studenttypes.MsgUpdateParams{
 				Authority: clientCtx.GetFromAddress().String(),
@@ -2942,6 +3374,7 @@ func cmdTxUpdateStudentParams() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String("integration-mode", studenttypes.IntegrationModeNative, "Integration mode: contract, native or disabled")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -2968,6 +3401,7 @@ func getDegreeQueryCmd() *cobra.Command {
 		cmdQueryListDegreesByInstitution(),
 		cmdQueryListDegreeRequests(),
 		cmdQueryGetDegreeValidationStatus(),
+		cmdQueryDegreeCredential(),
 	)
 
 	return cmd
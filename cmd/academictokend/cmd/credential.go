@@ -0,0 +1,323 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/x/academicnft/credential"
+	academicnfttypes "academictoken/x/academicnft/types"
+	degreetypes "academictoken/x/degree/types"
+)
+
+// cmdQuerySubjectTokenCredential implements the subject-token-credential query command
+func cmdQuerySubjectTokenCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subject-token-credential [token-instance-id]",
+		Short: "Export a subject token instance as a W3C Verifiable Credential with its store proof",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vc, err := fetchCredential(clientCtx, credential.TypeSubjectCredential, args[0])
+			if err != nil {
+				return err
+			}
+			return printCredential(clientCtx, vc)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// cmdQueryDegreeCredential implements the degree-credential query command
+func cmdQueryDegreeCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "degree-credential [index]",
+		Short: "Export a degree as a W3C Verifiable Credential with its store proof",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vc, err := fetchCredential(clientCtx, credential.TypeDegreeCredential, args[0])
+			if err != nil {
+				return err
+			}
+			return printCredential(clientCtx, vc)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CredentialVerification is the result of the verify-credential command
+type CredentialVerification struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	ChainID     string `json:"chainId"`
+	BlockHeight int64  `json:"blockHeight"`
+	// StoreProofValid reports whether the anchored record is committed by the
+	// app hash of the chain at the anchored height
+	StoreProofValid bool `json:"storeProofValid"`
+	// ContentValid reports whether the credential matches the anchored record
+	// and the node rendering of it at the anchored height
+	ContentValid bool `json:"contentValid"`
+	// Status is the status stated by the credential, CurrentStatus the one of
+	// the record at the latest height
+	Status        string   `json:"status"`
+	CurrentStatus string   `json:"currentStatus"`
+	Valid         bool     `json:"valid"`
+	Errors        []string `json:"errors,omitempty"`
+}
+
+// cmdVerifyCredential implements the verify-credential command
+func cmdVerifyCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-credential [credential-file]",
+		Short: "Verify an exported academic credential against a node",
+		Long: `Verify a subject token or degree credential exported by this chain.
+
+The store proof of the credential is checked against the app hash of the block
+committing the anchored height, and the credential is rendered again from the
+proven record. The node is trusted for block headers and for the metadata it
+renders at the anchored height. The current status of the record is reported
+alongside the status stated by the credential. Use "-" to read from stdin.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var bz []byte
+			if args[0] == "-" {
+				bz, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				bz, err = os.ReadFile(args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			vc, err := credential.Parse(bz)
+			if err != nil {
+				return err
+			}
+
+			result := verifyCredential(clientCtx, vc)
+			out, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.PrintString(string(out) + "\n"); err != nil {
+				return err
+			}
+			if !result.Valid {
+				return errors.New("credential is not valid")
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func verifyCredential(clientCtx client.Context, vc credential.Credential) CredentialVerification {
+	result := CredentialVerification{
+		ID:          vc.ID,
+		Type:        vc.CredentialType(),
+		ChainID:     vc.Proof.ChainID,
+		BlockHeight: vc.Proof.BlockHeight,
+		Status:      vc.CredentialStatus.Status,
+	}
+	fail := func(err error) {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	if err := verifyStoreProof(clientCtx, &vc); err != nil {
+		fail(err)
+	} else {
+		result.StoreProofValid = true
+	}
+
+	if err := verifyCredentialContent(clientCtx, vc); err != nil {
+		fail(err)
+	} else {
+		result.ContentValid = true
+	}
+
+	current, err := fetchCredential(clientCtx.WithHeight(0), result.Type, credentialRecordID(vc))
+	switch {
+	case status.Code(err) == codes.NotFound:
+		result.CurrentStatus = "deleted"
+	case err != nil:
+		fail(fmt.Errorf("cannot query the current status: %w", err))
+	default:
+		result.CurrentStatus = current.CredentialStatus.Status
+	}
+
+	result.Valid = result.StoreProofValid && result.ContentValid
+	return result
+}
+
+// verifyStoreProof checks the store proof of a credential, fetching it from
+// the node when the credential carries none
+func verifyStoreProof(clientCtx client.Context, vc *credential.Credential) error {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	// The app hash committing the state at a height is in the next header
+	height := vc.Proof.BlockHeight + 1
+	commit, err := node.Commit(context.Background(), &height)
+	if err != nil {
+		return fmt.Errorf("cannot fetch the header of block %d: %w", height, err)
+	}
+	if commit.ChainID != vc.Proof.ChainID {
+		return fmt.Errorf("credential was anchored on chain '%s', node serves '%s'", vc.Proof.ChainID, commit.ChainID)
+	}
+
+	if vc.Proof.StoreProof == "" {
+		if err := attachStoreProof(clientCtx, &vc.Proof); err != nil {
+			return err
+		}
+	}
+	return vc.Proof.VerifyStoreProof(commit.AppHash)
+}
+
+// verifyCredentialContent renders the credential again from the anchored
+// record, and compares it with the node rendering at the anchored height,
+// which also covers the metadata read from other records
+func verifyCredentialContent(clientCtx client.Context, vc credential.Credential) error {
+	record, err := vc.Proof.RecordBytes()
+	if err != nil {
+		return err
+	}
+
+	var rendered credential.Credential
+	switch vc.CredentialType() {
+	case credential.TypeSubjectCredential:
+		var tokenInstance academicnfttypes.SubjectTokenInstance
+		var subject academicnfttypes.SubjectCredentialSubject
+		if err := tokenInstance.Unmarshal(record); err != nil {
+			return fmt.Errorf("anchored record is not a subject token instance: %w", err)
+		}
+		if err := json.Unmarshal(vc.CredentialSubject, &subject); err != nil {
+			return fmt.Errorf("invalid credential subject: %w", err)
+		}
+		rendered, err = academicnfttypes.NewSubjectTokenCredential(tokenInstance, subject.TokenDefinition, vc.Issuer.Name, vc.Proof)
+	case credential.TypeDegreeCredential:
+		var degree degreetypes.Degree
+		if err := degree.Unmarshal(record); err != nil {
+			return fmt.Errorf("anchored record is not a degree: %w", err)
+		}
+		rendered, err = degreetypes.NewDegreeCredential(degree, vc.Issuer.Name, vc.Proof)
+	default:
+		return fmt.Errorf("unsupported credential type '%s'", vc.CredentialType())
+	}
+	if err != nil {
+		return err
+	}
+	if !credential.Equal(vc, rendered) {
+		return errors.New("credential does not match the anchored record")
+	}
+
+	anchored, err := fetchCredential(clientCtx.WithHeight(vc.Proof.BlockHeight), vc.CredentialType(), credentialRecordID(vc))
+	if err != nil {
+		return fmt.Errorf("cannot render the credential at height %d: %w", vc.Proof.BlockHeight, err)
+	}
+	if !credential.Equal(vc, anchored) {
+		return fmt.Errorf("credential does not match the node rendering at height %d", vc.Proof.BlockHeight)
+	}
+	return nil
+}
+
+// credentialRecordID returns the ID of the record a credential was rendered
+// from, the last segment of its URN
+func credentialRecordID(vc credential.Credential) string {
+	kind := "subject-token"
+	if vc.CredentialType() == credential.TypeDegreeCredential {
+		kind = "degree"
+	}
+	id, _ := strings.CutPrefix(vc.ID, credential.URN(vc.Proof.ChainID, kind, ""))
+	return id
+}
+
+// fetchCredential queries a credential at the context height and attaches the
+// store proof of its anchor
+func fetchCredential(clientCtx client.Context, credentialType string, id string) (credential.Credential, error) {
+	var out string
+	switch credentialType {
+	case credential.TypeSubjectCredential:
+		res, err := academicnfttypes.NewQueryClient(clientCtx).SubjectTokenCredential(context.Background(), &academicnfttypes.QuerySubjectTokenCredentialRequest{TokenInstanceId: id})
+		if err != nil {
+			return credential.Credential{}, err
+		}
+		out = res.Credential
+	case credential.TypeDegreeCredential:
+		res, err := degreetypes.NewQueryClient(clientCtx).DegreeCredential(context.Background(), &degreetypes.QueryDegreeCredentialRequest{Index: id})
+		if err != nil {
+			return credential.Credential{}, err
+		}
+		out = res.Credential
+	default:
+		return credential.Credential{}, fmt.Errorf("unsupported credential type '%s'", credentialType)
+	}
+
+	vc, err := credential.Parse([]byte(out))
+	if err != nil {
+		return vc, err
+	}
+	return vc, attachStoreProof(clientCtx, &vc.Proof)
+}
+
+// attachStoreProof fetches the proof of an anchored key from the node
+func attachStoreProof(clientCtx client.Context, anchor *credential.Anchor) error {
+	key, err := anchor.Key()
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", anchor.StoreName),
+		Data:   key,
+		Height: anchor.BlockHeight,
+		Prove:  true,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot fetch the store proof at height %d: %w", anchor.BlockHeight, err)
+	}
+	if res.ProofOps == nil {
+		return fmt.Errorf("node returned no store proof at height %d", anchor.BlockHeight)
+	}
+	return anchor.SetStoreProof(res.ProofOps)
+}
+
+func printCredential(clientCtx client.Context, vc credential.Credential) error {
+	out, err := json.MarshalIndent(vc, "", "  ")
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintString(string(out) + "\n")
+}
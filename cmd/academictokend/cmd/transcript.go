@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	studenttypes "academictoken/x/student/types"
+)
+
+// cmdQueryTranscript implements the transcript query command
+func cmdQueryTranscript() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transcript [student-id]",
+		Short: "Show the transcript of a student: completed subjects per semester, credits and GPA",
+		Long: `Show the transcript of a student: the subjects completed in each semester with
+their grade, credits, workload and token instance, the semester and cumulative
+GPA, and the subjects credited through transfers or equivalences. With --term
+only the subjects of that academic term are shown.
+
+The transcript is rendered as a table, or as JSON with --output json.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			term, _ := cmd.Flags().GetString("term")
+
+			queryClient := studenttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Transcript(context.Background(), &studenttypes.QueryTranscriptRequest{StudentId: args[0], Term: term})
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == flags.OutputFormatJSON {
+				return clientCtx.PrintProto(res)
+			}
+			return clientCtx.PrintString(renderTranscript(res))
+		},
+	}
+
+	cmd.Flags().String("term", "", "Only show the subjects of this academic term")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// renderTranscript renders a transcript as plain text tables
+func renderTranscript(transcript *studenttypes.QueryTranscriptResponse) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Student:     %s (%s)\n", transcript.Name, transcript.StudentId)
+	fmt.Fprintf(&buf, "Address:     %s\n", transcript.StudentAddress)
+	fmt.Fprintf(&buf, "Institution: %s\n", transcript.Institution)
+	fmt.Fprintf(&buf, "Course:      %s %s\n", transcript.CourseId, transcript.CurriculumVersion)
+
+	for _, semester := range transcript.Semesters {
+		fmt.Fprintf(&buf, "\nSemester %s\n", semester.Semester)
+		w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "CODE\tSUBJECT\tAREA\tGRADE\tCREDITS\tHOURS\tTOKEN")
+		for _, entry := range semester.Subjects {
+			grade := entry.Grade
+			if !entry.Passed {
+				grade += " (failed)"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%s\n", entry.Code, entry.Title, entry.KnowledgeArea, grade, entry.Credits, entry.WorkloadHours, entry.TokenInstanceId)
+		}
+		w.Flush()
+		fmt.Fprintf(&buf, "Credits: %d  Hours: %d  GPA: %s  Cumulative GPA: %s\n", semester.Credits, semester.WorkloadHours, gpaOrDash(semester.Gpa), gpaOrDash(semester.CumulativeGpa))
+	}
+
+	if len(transcript.TransferredSubjects) > 0 {
+		fmt.Fprintf(&buf, "\nTransferred subjects\n")
+		w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "CODE\tSUBJECT\tAREA\tCREDITS\tHOURS\tSOURCE\tEQUIVALENCE")
+		for _, subject := range transcript.TransferredSubjects {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\t%s\n", subject.Code, subject.Title, subject.KnowledgeArea, subject.Credits, subject.WorkloadHours, subject.SourceSubjectId, subject.EquivalenceType)
+		}
+		w.Flush()
+	}
+
+	if len(transcript.PrerequisiteWaivers) > 0 {
+		fmt.Fprintf(&buf, "\nPrerequisite waivers\n")
+		w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "SUBJECT\tGRANTED BY\tGRANTED AT\tEXPIRES AT\tREASON")
+		for _, waiver := range transcript.PrerequisiteWaivers {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", waiver.SubjectId, waiver.GrantedBy, waiver.GrantedAt, waiver.ExpiresAt, waiver.Reason)
+		}
+		w.Flush()
+	}
+
+	fmt.Fprintf(&buf, "\nTotal credits: %d  Total hours: %d  Cumulative GPA: %s (%d credits)\n", transcript.TotalCredits, transcript.TotalWorkloadHours, gpaOrDash(transcript.CumulativeGpa), transcript.GpaCredits)
+	return buf.String()
+}
+
+func gpaOrDash(gpa string) string {
+	if gpa == "" {
+		return "-"
+	}
+	return gpa
+}
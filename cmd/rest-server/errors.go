@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// apiError is the JSON body returned for every failed request, both by the
+// academic routes and by the generated gateway handlers.
+type apiError struct {
+	Code    int    `json:"code"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// httpStatusFromError maps an error returned by a gRPC query client to the
+// HTTP status code that best describes it. Errors that do not carry a gRPC
+// status are reported as internal server errors.
+func httpStatusFromError(err error) (int, codes.Code) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return http.StatusInternalServerError, codes.Unknown
+	}
+	switch st.Code() {
+	case codes.OK:
+		return http.StatusOK, codes.OK
+	case codes.Canceled:
+		return http.StatusRequestTimeout, codes.Canceled
+	case codes.FailedPrecondition:
+		// The gateway maps this to 400, but for queries it means the
+		// requested state is not in the expected shape.
+		return http.StatusPreconditionFailed, codes.FailedPrecondition
+	default:
+		return runtime.HTTPStatusFromCode(st.Code()), st.Code()
+	}
+}
+
+// writeError writes err as an apiError with the mapped HTTP status code.
+func writeError(w http.ResponseWriter, err error) {
+	httpStatus, code := httpStatusFromError(err)
+	message := err.Error()
+	if st, ok := status.FromError(err); ok {
+		message = st.Message()
+	}
+	writeErrorStatus(w, httpStatus, code, message)
+}
+
+// writeErrorStatus writes an apiError with an explicit status code. It is used
+// for failures detected before the node is queried, such as malformed
+// pagination parameters.
+func writeErrorStatus(w http.ResponseWriter, httpStatus int, code codes.Code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(apiError{
+		Code:    httpStatus,
+		Status:  code.String(),
+		Message: message,
+	})
+}
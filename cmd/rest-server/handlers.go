@@ -0,0 +1,197 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/gorilla/mux"
+
+	academicnfttypes "academictoken/x/academicnft/types"
+	coursetypes "academictoken/x/course/types"
+	curriculumtypes "academictoken/x/curriculum/types"
+	degreetypes "academictoken/x/degree/types"
+	equivalencetypes "academictoken/x/equivalence/types"
+	institutiontypes "academictoken/x/institution/types"
+	scheduletypes "academictoken/x/schedule/types"
+	studenttypes "academictoken/x/student/types"
+	subjecttypes "academictoken/x/subject/types"
+	tokendeftypes "academictoken/x/tokendef/types"
+)
+
+// GET /academic/institution/list
+func (s *Server) listInstitutions(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.institution.InstitutionAll(r.Context(), &institutiontypes.QueryAllInstitutionRequest{Pagination: pageReq})
+}
+
+// GET /academic/institution/{id}
+func (s *Server) getInstitution(r *http.Request) (proto.Message, error) {
+	return s.institution.Institution(r.Context(), &institutiontypes.QueryGetInstitutionRequest{Index: mux.Vars(r)["id"]})
+}
+
+// GET /academic/institution/{id}/roles
+func (s *Server) listInstitutionRoles(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.institution.InstitutionRoles(r.Context(), &institutiontypes.QueryInstitutionRolesRequest{
+		Institution: mux.Vars(r)["id"],
+		Pagination:  pageReq,
+	})
+}
+
+// GET /academic/course/list
+func (s *Server) listCourses(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.course.CourseAll(r.Context(), &coursetypes.QueryAllCourseRequest{Pagination: pageReq})
+}
+
+// GET /academic/course/{id}
+func (s *Server) getCourse(r *http.Request) (proto.Message, error) {
+	return s.course.Course(r.Context(), &coursetypes.QueryGetCourseRequest{Index: mux.Vars(r)["id"]})
+}
+
+// GET /academic/subject/list
+func (s *Server) listSubjects(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.subject.ListSubjects(r.Context(), &subjecttypes.QueryListSubjectsRequest{Pagination: pageReq})
+}
+
+// GET /academic/subject/{id}
+func (s *Server) getSubject(r *http.Request) (proto.Message, error) {
+	return s.subject.GetSubject(r.Context(), &subjecttypes.QueryGetSubjectRequest{Index: mux.Vars(r)["id"]})
+}
+
+// GET /academic/tokendef/list
+func (s *Server) listTokenDefinitions(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.tokendef.ListTokenDefinitions(r.Context(), &tokendeftypes.QueryListTokenDefinitionsRequest{Pagination: pageReq})
+}
+
+// GET /academic/student/list
+func (s *Server) listStudents(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.student.ListStudents(r.Context(), &studenttypes.QueryListStudentsRequest{Pagination: pageReq})
+}
+
+// GET /academic/student/{id}
+func (s *Server) getStudent(r *http.Request) (proto.Message, error) {
+	return s.student.GetStudent(r.Context(), &studenttypes.QueryGetStudentRequest{StudentId: mux.Vars(r)["id"]})
+}
+
+// GET /academic/student/{id}/nfts
+//
+// NFTs are indexed by the student address, so the student record is resolved
+// first.
+func (s *Server) getStudentNFTs(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	student, err := s.student.GetStudent(r.Context(), &studenttypes.QueryGetStudentRequest{StudentId: mux.Vars(r)["id"]})
+	if err != nil {
+		return nil, err
+	}
+	return s.academicnft.GetStudentTokens(r.Context(), &academicnfttypes.QueryGetStudentTokensRequest{
+		StudentAddress: student.Student.Address,
+		Pagination:     pageReq,
+	})
+}
+
+// GET /academic/student/{id}/degrees
+func (s *Server) getStudentDegrees(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.degree.DegreesByStudent(r.Context(), &degreetypes.QueryDegreesByStudentRequest{
+		StudentId:  mux.Vars(r)["id"],
+		Pagination: pageReq,
+	})
+}
+
+// GET /academic/student/{id}/plans
+func (s *Server) getStudentPlans(r *http.Request) (proto.Message, error) {
+	return s.schedule.StudyPlansByStudent(r.Context(), &scheduletypes.QueryStudyPlansByStudentRequest{StudentId: mux.Vars(r)["id"]})
+}
+
+// GET /academic/student/{student_id}/prerequisites/{subject_id}
+func (s *Server) checkPrerequisites(r *http.Request) (proto.Message, error) {
+	vars := mux.Vars(r)
+	return s.subject.CheckPrerequisites(r.Context(), &subjecttypes.QueryCheckPrerequisitesRequest{
+		StudentId: vars["student_id"],
+		SubjectId: vars["subject_id"],
+	})
+}
+
+// GET /academic/curriculum/list
+func (s *Server) listCurriculumTrees(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.curriculum.CurriculumTreeAll(r.Context(), &curriculumtypes.QueryAllCurriculumTreeRequest{Pagination: pageReq})
+}
+
+// GET /academic/curriculum/{id}
+func (s *Server) getCurriculumTree(r *http.Request) (proto.Message, error) {
+	return s.curriculum.CurriculumTree(r.Context(), &curriculumtypes.QueryGetCurriculumTreeRequest{Index: mux.Vars(r)["id"]})
+}
+
+// GET /academic/equivalence/list
+func (s *Server) listEquivalences(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.equivalence.ListEquivalences(r.Context(), &equivalencetypes.QueryListEquivalencesRequest{
+		Pagination:   pageReq,
+		StatusFilter: r.URL.Query().Get("status"),
+	})
+}
+
+// GET /academic/equivalence/{id}
+func (s *Server) getEquivalence(r *http.Request) (proto.Message, error) {
+	return s.equivalence.GetEquivalence(r.Context(), &equivalencetypes.QueryGetEquivalenceRequest{Index: mux.Vars(r)["id"]})
+}
+
+// GET /academic/degree/list
+func (s *Server) listDegrees(r *http.Request) (proto.Message, error) {
+	pageReq, err := pageRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.degree.DegreeAll(r.Context(), &degreetypes.QueryAllDegreeRequest{Pagination: pageReq})
+}
+
+// GET /academic/degree/{id}
+func (s *Server) getDegree(r *http.Request) (proto.Message, error) {
+	return s.degree.Degree(r.Context(), &degreetypes.QueryGetDegreeRequest{Index: mux.Vars(r)["id"]})
+}
+
+// GET /academic/degree/{student_id}/eligibility
+func (s *Server) getDegreeEligibility(r *http.Request) (proto.Message, error) {
+	return s.student.CheckGraduationEligibility(r.Context(), &studenttypes.QueryCheckGraduationEligibilityRequest{StudentId: mux.Vars(r)["student_id"]})
+}
+
+// GET /cosmos/base/tendermint/v1beta1/node_info
+func (s *Server) getNodeInfo(r *http.Request) (proto.Message, error) {
+	return s.cmt.GetNodeInfo(r.Context(), &cmtservice.GetNodeInfoRequest{})
+}
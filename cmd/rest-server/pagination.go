@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Query parameters used for pagination. They follow the names accepted by the
+// Cosmos SDK gRPC gateway so clients can use the same parameters on both the
+// /academic routes and the generated /academictoken routes.
+const (
+	paginationKey        = "pagination.key"
+	paginationOffset     = "pagination.offset"
+	paginationLimit      = "pagination.limit"
+	paginationCountTotal = "pagination.count_total"
+	paginationReverse    = "pagination.reverse"
+)
+
+// parsePageRequest builds a PageRequest from the request query string. It
+// returns nil when no pagination parameter was provided so that the node
+// applies its own defaults.
+func parsePageRequest(r *http.Request) (*query.PageRequest, error) {
+	values := r.URL.Query()
+
+	var (
+		pageReq = &query.PageRequest{}
+		set     bool
+		err     error
+	)
+
+	if raw := values.Get(paginationKey); raw != "" {
+		pageReq.Key, err = base64.StdEncoding.DecodeString(raw)
+		if err != nil {
+			// Keys copied from a URL are often in the URL-safe alphabet.
+			pageReq.Key, err = base64.URLEncoding.DecodeString(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: must be base64 encoded", paginationKey)
+			}
+		}
+		set = true
+	}
+	if raw := values.Get(paginationOffset); raw != "" {
+		pageReq.Offset, err = strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", paginationOffset, err)
+		}
+		set = true
+	}
+	if raw := values.Get(paginationLimit); raw != "" {
+		pageReq.Limit, err = strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", paginationLimit, err)
+		}
+		set = true
+	}
+	if raw := values.Get(paginationCountTotal); raw != "" {
+		pageReq.CountTotal, err = strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", paginationCountTotal, err)
+		}
+		set = true
+	}
+	if raw := values.Get(paginationReverse); raw != "" {
+		pageReq.Reverse, err = strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", paginationReverse, err)
+		}
+		set = true
+	}
+
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, fmt.Errorf("%s and %s cannot be used together", paginationKey, paginationOffset)
+	}
+	if !set {
+		return nil, nil
+	}
+	return pageReq, nil
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gateway "github.com/cosmos/gogogateway"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+
+	academicnfttypes "academictoken/x/academicnft/types"
+	coursetypes "academictoken/x/course/types"
+	curriculumtypes "academictoken/x/curriculum/types"
+	degreetypes "academictoken/x/degree/types"
+	equivalencetypes "academictoken/x/equivalence/types"
+	institutiontypes "academictoken/x/institution/types"
+	scheduletypes "academictoken/x/schedule/types"
+	studenttypes "academictoken/x/student/types"
+	subjecttypes "academictoken/x/subject/types"
+	tokendeftypes "academictoken/x/tokendef/types"
+)
+
+// Server answers REST requests by calling the query services of a running
+// node over gRPC.
+type Server struct {
+	interfaceRegistry codectypes.InterfaceRegistry
+
+	institution institutiontypes.QueryClient
+	course      coursetypes.QueryClient
+	subject     subjecttypes.QueryClient
+	student     studenttypes.QueryClient
+	tokendef    tokendeftypes.QueryClient
+	academicnft academicnfttypes.QueryClient
+	curriculum  curriculumtypes.QueryClient
+	schedule    scheduletypes.QueryClient
+	equivalence equivalencetypes.QueryClient
+	degree      degreetypes.QueryClient
+	cmt         cmtservice.ServiceClient
+}
+
+// Dial opens a gRPC connection to the node at grpcAddress using the SDK proto
+// codec, which is required to exchange gogoproto messages.
+func Dial(grpcAddress string) (*grpc.ClientConn, error) {
+	protoCodec := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	return grpc.NewClient(
+		grpcAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(protoCodec.GRPCCodec())),
+	)
+}
+
+// NewServer creates a Server that queries the node through conn.
+func NewServer(conn grpc.ClientConnInterface) *Server {
+	return &Server{
+		interfaceRegistry: codectypes.NewInterfaceRegistry(),
+
+		institution: institutiontypes.NewQueryClient(conn),
+		course:      coursetypes.NewQueryClient(conn),
+		subject:     subjecttypes.NewQueryClient(conn),
+		student:     studenttypes.NewQueryClient(conn),
+		tokendef:    tokendeftypes.NewQueryClient(conn),
+		academicnft: academicnfttypes.NewQueryClient(conn),
+		curriculum:  curriculumtypes.NewQueryClient(conn),
+		schedule:    scheduletypes.NewQueryClient(conn),
+		equivalence: equivalencetypes.NewQueryClient(conn),
+		degree:      degreetypes.NewQueryClient(conn),
+		cmt:         cmtservice.NewServiceClient(conn),
+	}
+}
+
+// Router returns the HTTP handler serving both the /academic convenience
+// routes and the generated gateway routes of every module query service.
+func (s *Server) Router(ctx context.Context) (http.Handler, error) {
+	r := mux.NewRouter()
+
+	api := r.PathPrefix("/academic").Subrouter()
+	api.HandleFunc("/institution/list", s.handle(s.listInstitutions)).Methods(http.MethodGet)
+	api.HandleFunc("/institution/{id}", s.handle(s.getInstitution)).Methods(http.MethodGet)
+	api.HandleFunc("/institution/{id}/roles", s.handle(s.listInstitutionRoles)).Methods(http.MethodGet)
+	api.HandleFunc("/course/list", s.handle(s.listCourses)).Methods(http.MethodGet)
+	api.HandleFunc("/course/{id}", s.handle(s.getCourse)).Methods(http.MethodGet)
+	api.HandleFunc("/subject/list", s.handle(s.listSubjects)).Methods(http.MethodGet)
+	api.HandleFunc("/subject/{id}", s.handle(s.getSubject)).Methods(http.MethodGet)
+	api.HandleFunc("/tokendef/list", s.handle(s.listTokenDefinitions)).Methods(http.MethodGet)
+	api.HandleFunc("/student/list", s.handle(s.listStudents)).Methods(http.MethodGet)
+	api.HandleFunc("/student/{id}", s.handle(s.getStudent)).Methods(http.MethodGet)
+	api.HandleFunc("/student/{id}/nfts", s.handle(s.getStudentNFTs)).Methods(http.MethodGet)
+	api.HandleFunc("/student/{id}/degrees", s.handle(s.getStudentDegrees)).Methods(http.MethodGet)
+	api.HandleFunc("/student/{id}/plans", s.handle(s.getStudentPlans)).Methods(http.MethodGet)
+	api.HandleFunc("/student/{student_id}/prerequisites/{subject_id}", s.handle(s.checkPrerequisites)).Methods(http.MethodGet)
+	api.HandleFunc("/curriculum/list", s.handle(s.listCurriculumTrees)).Methods(http.MethodGet)
+	api.HandleFunc("/curriculum/{id}", s.handle(s.getCurriculumTree)).Methods(http.MethodGet)
+	api.HandleFunc("/equivalence/list", s.handle(s.listEquivalences)).Methods(http.MethodGet)
+	api.HandleFunc("/equivalence/{id}", s.handle(s.getEquivalence)).Methods(http.MethodGet)
+	api.HandleFunc("/degree/list", s.handle(s.listDegrees)).Methods(http.MethodGet)
+	api.HandleFunc("/degree/{id}", s.handle(s.getDegree)).Methods(http.MethodGet)
+	api.HandleFunc("/degree/{student_id}/eligibility", s.handle(s.getDegreeEligibility)).Methods(http.MethodGet)
+
+	r.HandleFunc("/cosmos/base/tendermint/v1beta1/node_info", s.handle(s.getNodeInfo)).Methods(http.MethodGet)
+	r.HandleFunc("/health", s.health).Methods(http.MethodGet)
+
+	gw, err := s.gateway(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.PathPrefix("/academictoken/").Handler(gw)
+
+	return r, nil
+}
+
+// gateway registers the generated grpc-gateway handlers of every module query
+// service against the shared client connection.
+func (s *Server) gateway(ctx context.Context) (*runtime.ServeMux, error) {
+	gw := runtime.NewServeMux(
+		// Custom marshaler option is required for gogo proto
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+			Marshaler: &gateway.JSONPb{
+				EmitDefaults: true,
+				Indent:       "",
+				OrigName:     true,
+				AnyResolver:  s.interfaceRegistry,
+			},
+		}),
+		runtime.WithProtoErrorHandler(func(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+			writeError(w, err)
+		}),
+	)
+
+	registrations := []struct {
+		name     string
+		register func(context.Context, *runtime.ServeMux) error
+	}{
+		{institutiontypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return institutiontypes.RegisterQueryHandlerClient(ctx, m, s.institution)
+		}},
+		{coursetypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return coursetypes.RegisterQueryHandlerClient(ctx, m, s.course)
+		}},
+		{subjecttypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return subjecttypes.RegisterQueryHandlerClient(ctx, m, s.subject)
+		}},
+		{studenttypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return studenttypes.RegisterQueryHandlerClient(ctx, m, s.student)
+		}},
+		{tokendeftypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return tokendeftypes.RegisterQueryHandlerClient(ctx, m, s.tokendef)
+		}},
+		{academicnfttypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return academicnfttypes.RegisterQueryHandlerClient(ctx, m, s.academicnft)
+		}},
+		{curriculumtypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return curriculumtypes.RegisterQueryHandlerClient(ctx, m, s.curriculum)
+		}},
+		{scheduletypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return scheduletypes.RegisterQueryHandlerClient(ctx, m, s.schedule)
+		}},
+		{equivalencetypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return equivalencetypes.RegisterQueryHandlerClient(ctx, m, s.equivalence)
+		}},
+		{degreetypes.ModuleName, func(ctx context.Context, m *runtime.ServeMux) error {
+			return degreetypes.RegisterQueryHandlerClient(ctx, m, s.degree)
+		}},
+	}
+	for _, reg := range registrations {
+		if err := reg.register(ctx, gw); err != nil {
+			return nil, fmt.Errorf("failed to register %s query gateway: %w", reg.name, err)
+		}
+	}
+	return gw, nil
+}
+
+// queryFunc performs one gRPC query for an incoming request.
+type queryFunc func(r *http.Request) (proto.Message, error)
+
+// handle adapts a queryFunc to an http.HandlerFunc, encoding the response with
+// the SDK JSON marshaler and mapping errors to HTTP status codes.
+func (s *Server) handle(fn queryFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := fn(r)
+		if err != nil {
+			if badReq, ok := err.(badRequestError); ok {
+				writeErrorStatus(w, http.StatusBadRequest, codes.InvalidArgument, badReq.Error())
+				return
+			}
+			writeError(w, err)
+			return
+		}
+
+		bz, err := codec.ProtoMarshalJSON(res, s.interfaceRegistry)
+		if err != nil {
+			writeErrorStatus(w, http.StatusInternalServerError, codes.Internal, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}
+}
+
+// badRequestError marks errors caused by the HTTP request itself, before any
+// query reached the node.
+type badRequestError struct{ error }
+
+// pageRequest parses the pagination query parameters of r.
+func pageRequest(r *http.Request) (*query.PageRequest, error) {
+	pageReq, err := parsePageRequest(r)
+	if err != nil {
+		return nil, badRequestError{err}
+	}
+	return pageReq, nil
+}
+
+// health reports whether the node answers gRPC queries.
+func (s *Server) health(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.cmt.GetSyncing(r.Context(), &cmtservice.GetSyncingRequest{}); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("OK"))
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/testutil/network"
+	"academictoken/testutil/sample"
+	institutiontypes "academictoken/x/institution/types"
+)
+
+func TestParsePageRequest(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		query    string
+		expected *query.PageRequest
+		err      bool
+	}{
+		{
+			desc:  "no pagination",
+			query: "",
+		},
+		{
+			desc:     "limit offset count",
+			query:    "pagination.limit=5&pagination.offset=10&pagination.count_total=true&pagination.reverse=true",
+			expected: &query.PageRequest{Limit: 5, Offset: 10, CountTotal: true, Reverse: true},
+		},
+		{
+			desc:     "key",
+			query:    "pagination.key=AQI%3D",
+			expected: &query.PageRequest{Key: []byte{1, 2}},
+		},
+		{
+			desc:  "invalid limit",
+			query: "pagination.limit=abc",
+			err:   true,
+		},
+		{
+			desc:  "invalid key",
+			query: "pagination.key=%25%25",
+			err:   true,
+		},
+		{
+			desc:  "key and offset",
+			query: "pagination.key=AQI%3D&pagination.offset=1",
+			err:   true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/?"+tc.query, nil)
+			got, err := parsePageRequest(r)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestHTTPStatusFromError(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		status int
+	}{
+		{status.Error(codes.NotFound, "not found"), http.StatusNotFound},
+		{status.Error(codes.InvalidArgument, "bad"), http.StatusBadRequest},
+		{status.Error(codes.PermissionDenied, "denied"), http.StatusForbidden},
+		{status.Error(codes.Unavailable, "down"), http.StatusServiceUnavailable},
+		{status.Error(codes.FailedPrecondition, "state"), http.StatusPreconditionFailed},
+		{status.Error(codes.Internal, "boom"), http.StatusInternalServerError},
+		{errors.New("plain"), http.StatusInternalServerError},
+	} {
+		got, _ := httpStatusFromError(tc.err)
+		require.Equal(t, tc.status, got, tc.err.Error())
+	}
+}
+
+func TestServerAgainstNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process network test in short mode")
+	}
+
+	cfg := network.DefaultConfig()
+	genesis := institutiontypes.DefaultGenesis()
+	for i := 0; i < 3; i++ {
+		genesis.RoleAssignmentList = append(genesis.RoleAssignmentList, institutiontypes.RoleAssignment{
+			Institution: "inst-1",
+			Address:     sample.AccAddress(),
+			Role:        institutiontypes.RoleProfessor,
+			SubjectId:   fmt.Sprintf("subject-%d", i),
+		})
+	}
+	cfg.GenesisState[institutiontypes.ModuleName] = cfg.Codec.MustMarshalJSON(genesis)
+	net := network.New(t, cfg)
+
+	conn, err := Dial(net.Validators[0].AppConfig.GRPC.Address)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	router, err := NewServer(conn).Router(context.Background())
+	require.NoError(t, err)
+	srv := httptest.NewServer(router)
+	t.Cleanup(srv.Close)
+
+	get := func(path string) (int, []byte) {
+		res, err := http.Get(srv.URL + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, body
+	}
+
+	t.Run("pagination passthrough", func(t *testing.T) {
+		code, body := get("/academic/institution/inst-1/roles?pagination.limit=2&pagination.count_total=true")
+		require.Equal(t, http.StatusOK, code, string(body))
+
+		var res struct {
+			RoleAssignment []institutiontypes.RoleAssignment `json:"roleAssignment"`
+			Pagination     struct {
+				NextKey string `json:"next_key"`
+				Total   string `json:"total"`
+			} `json:"pagination"`
+		}
+		require.NoError(t, json.Unmarshal(body, &res))
+		require.Len(t, res.RoleAssignment, 2)
+		require.Equal(t, "3", res.Pagination.Total)
+		require.NotEmpty(t, res.Pagination.NextKey)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		code, body := get("/academic/institution/missing")
+		require.Equal(t, http.StatusNotFound, code, string(body))
+
+		var apiErr apiError
+		require.NoError(t, json.Unmarshal(body, &apiErr))
+		require.Equal(t, codes.NotFound.String(), apiErr.Status)
+	})
+
+	t.Run("invalid pagination", func(t *testing.T) {
+		code, _ := get("/academic/course/list?pagination.limit=abc")
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("module gateways", func(t *testing.T) {
+		for _, path := range []string{
+			"/academictoken/institution/roles/inst-1",
+			"/academictoken/curriculum/params",
+			"/academictoken/schedule/params",
+			"/academictoken/equivalence/params",
+			"/academictoken/degree/params",
+		} {
+			code, body := get(path)
+			require.Equal(t, http.StatusOK, code, "%s: %s", path, body)
+		}
+
+		code, _ := get("/academictoken/institution/institution/missing")
+		require.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("node info", func(t *testing.T) {
+		code, body := get("/cosmos/base/tendermint/v1beta1/node_info")
+		require.Equal(t, http.StatusOK, code, string(body))
+	})
+}
@@ -0,0 +1,22 @@
+use std::env::current_dir;
+use std::fs::create_dir_all;
+
+use cosmwasm_schema::{export_schema, remove_schemas, schema_for};
+
+use academic_nft::msg::{ExecuteMsg, InstantiateMsg, QueryMsg};
+use academic_nft::state::{Config, SubjectNFT, DegreeNFT, NFTMetadata};
+
+fn main() {
+    let mut out_dir = current_dir().unwrap();
+    out_dir.push("schema");
+    create_dir_all(&out_dir).unwrap();
+    remove_schemas(&out_dir).unwrap();
+
+    export_schema(&schema_for!(InstantiateMsg), &out_dir);
+    export_schema(&schema_for!(ExecuteMsg), &out_dir);
+    export_schema(&schema_for!(QueryMsg), &out_dir);
+    export_schema(&schema_for!(Config), &out_dir);
+    export_schema(&schema_for!(SubjectNFT), &out_dir);
+    export_schema(&schema_for!(DegreeNFT), &out_dir);
+    export_schema(&schema_for!(NFTMetadata), &out_dir);
+}
@@ -0,0 +1,256 @@
+// Package academicnft holds the Go bindings of contracts/academic_nft.
+package academicnft
+
+import "encoding/json"
+
+// NFTType is the kind of an academic NFT
+type NFTType string
+
+const (
+	NFTTypeSubjectCompletion NFTType = "subject_completion"
+	NFTTypeDegree            NFTType = "degree"
+	NFTTypeCertificate       NFTType = "certificate"
+	NFTTypeAchievement       NFTType = "achievement"
+)
+
+// ExecuteMsg is the execute message of the academic NFT contract. serde
+// splits every capital letter of acronyms, so MintSubjectNFT is
+// mint_subject_n_f_t on the wire.
+type ExecuteMsg struct {
+	MintSubjectNFT   *MintSubjectNFTMsg   `json:"mint_subject_n_f_t,omitempty"`
+	MintDegreeNFT    *MintDegreeNFTMsg    `json:"mint_degree_n_f_t,omitempty"`
+	TransferNft      *TransferNftMsg      `json:"transfer_nft,omitempty"`
+	Approve          *ApproveMsg          `json:"approve,omitempty"`
+	Revoke           *RevokeMsg           `json:"revoke,omitempty"`
+	ApproveAll       *ApproveAllMsg       `json:"approve_all,omitempty"`
+	RevokeAll        *RevokeAllMsg        `json:"revoke_all,omitempty"`
+	UpdateMetadata   *UpdateMetadataMsg   `json:"update_metadata,omitempty"`
+	Burn             *BurnMsg             `json:"burn,omitempty"`
+	UpdateConfig     *UpdateConfigMsg     `json:"update_config,omitempty"`
+	CacheIpfsContent *CacheIpfsContentMsg `json:"cache_ipfs_content,omitempty"`
+	ValidateNFTData  *ValidateNFTDataMsg  `json:"validate_n_f_t_data,omitempty"`
+}
+
+type MintSubjectNFTMsg struct {
+	StudentId      string                `json:"student_id"`
+	SubjectData    SubjectCompletionData `json:"subject_data"`
+	Metadata       NFTMetadata           `json:"metadata"`
+	ValidationHash string                `json:"validation_hash"`
+}
+
+type MintDegreeNFTMsg struct {
+	StudentId      string               `json:"student_id"`
+	DegreeData     DegreeCompletionData `json:"degree_data"`
+	Metadata       NFTMetadata          `json:"metadata"`
+	ValidationHash string               `json:"validation_hash"`
+	Signatures     []string             `json:"signatures"`
+}
+
+type TransferNftMsg struct {
+	Recipient string `json:"recipient"`
+	TokenId   string `json:"token_id"`
+}
+
+type ApproveMsg struct {
+	Spender string          `json:"spender"`
+	TokenId string          `json:"token_id"`
+	Expires json.RawMessage `json:"expires,omitempty"`
+}
+
+type RevokeMsg struct {
+	Spender string `json:"spender"`
+	TokenId string `json:"token_id"`
+}
+
+type ApproveAllMsg struct {
+	Operator string          `json:"operator"`
+	Expires  json.RawMessage `json:"expires,omitempty"`
+}
+
+type RevokeAllMsg struct {
+	Operator string `json:"operator"`
+}
+
+type UpdateMetadataMsg struct {
+	TokenId  string      `json:"token_id"`
+	Metadata NFTMetadata `json:"metadata"`
+}
+
+type BurnMsg struct {
+	TokenId string `json:"token_id"`
+	Reason  string `json:"reason"`
+}
+
+type UpdateConfigMsg struct {
+	Admin       *string `json:"admin,omitempty"`
+	Minter      *string `json:"minter,omitempty"`
+	IpfsGateway *string `json:"ipfs_gateway,omitempty"`
+}
+
+type CacheIpfsContentMsg struct {
+	IpfsLink string `json:"ipfs_link"`
+	Content  string `json:"content"`
+}
+
+// ValidateNFTDataMsg validates the JSON serialized completion data of an NFT
+// before it is minted
+type ValidateNFTDataMsg struct {
+	NftType NFTType `json:"nft_type"`
+	Data    string  `json:"data"`
+}
+
+// QueryMsg is the query message of the academic NFT contract
+type QueryMsg struct {
+	GetConfig            *GetConfigQuery            `json:"get_config,omitempty"`
+	OwnerOf              *OwnerOfQuery              `json:"owner_of,omitempty"`
+	Approval             *ApprovalQuery             `json:"approval,omitempty"`
+	Approvals            *ApprovalsQuery            `json:"approvals,omitempty"`
+	Tokens               *TokensQuery               `json:"tokens,omitempty"`
+	AllTokens            *AllTokensQuery            `json:"all_tokens,omitempty"`
+	NftInfo              *NftInfoQuery              `json:"nft_info,omitempty"`
+	AllNftInfo           *AllNftInfoQuery           `json:"all_nft_info,omitempty"`
+	ContractInfo         *ContractInfoQuery         `json:"contract_info,omitempty"`
+	GetSubjectNFT        *GetSubjectNFTQuery        `json:"get_subject_n_f_t,omitempty"`
+	GetDegreeNFT         *GetDegreeNFTQuery         `json:"get_degree_n_f_t,omitempty"`
+	GetStudentCollection *GetStudentCollectionQuery `json:"get_student_collection,omitempty"`
+	GetNFTsByType        *GetNFTsByTypeQuery        `json:"get_n_f_ts_by_type,omitempty"`
+	GetStatistics        *GetStatisticsQuery        `json:"get_statistics,omitempty"`
+	GetNFTsByInstitution *GetNFTsByInstitutionQuery `json:"get_n_f_ts_by_institution,omitempty"`
+	NFTExists            *NFTExistsQuery            `json:"n_f_t_exists,omitempty"`
+	GetCachedContent     *GetCachedContentQuery     `json:"get_cached_content,omitempty"`
+	VerifyNFT            *VerifyNFTQuery            `json:"verify_n_f_t,omitempty"`
+}
+
+type GetConfigQuery struct{}
+
+type OwnerOfQuery struct {
+	TokenId        string `json:"token_id"`
+	IncludeExpired *bool  `json:"include_expired,omitempty"`
+}
+
+type ApprovalQuery struct {
+	TokenId        string `json:"token_id"`
+	Spender        string `json:"spender"`
+	IncludeExpired *bool  `json:"include_expired,omitempty"`
+}
+
+type ApprovalsQuery struct {
+	TokenId        string `json:"token_id"`
+	IncludeExpired *bool  `json:"include_expired,omitempty"`
+}
+
+type TokensQuery struct {
+	Owner      string  `json:"owner"`
+	StartAfter *string `json:"start_after,omitempty"`
+	Limit      *uint32 `json:"limit,omitempty"`
+}
+
+type AllTokensQuery struct {
+	StartAfter *string `json:"start_after,omitempty"`
+	Limit      *uint32 `json:"limit,omitempty"`
+}
+
+type NftInfoQuery struct {
+	TokenId string `json:"token_id"`
+}
+
+type AllNftInfoQuery struct {
+	TokenId        string `json:"token_id"`
+	IncludeExpired *bool  `json:"include_expired,omitempty"`
+}
+
+type ContractInfoQuery struct{}
+
+type GetSubjectNFTQuery struct {
+	TokenId string `json:"token_id"`
+}
+
+type GetDegreeNFTQuery struct {
+	TokenId string `json:"token_id"`
+}
+
+type GetStudentCollectionQuery struct {
+	StudentId string `json:"student_id"`
+}
+
+type GetNFTsByTypeQuery struct {
+	StudentId string  `json:"student_id"`
+	NftType   NFTType `json:"nft_type"`
+	Limit     *uint32 `json:"limit,omitempty"`
+}
+
+type GetStatisticsQuery struct{}
+
+type GetNFTsByInstitutionQuery struct {
+	InstitutionId string   `json:"institution_id"`
+	NftType       *NFTType `json:"nft_type,omitempty"`
+	Limit         *uint32  `json:"limit,omitempty"`
+	StartAfter    *string  `json:"start_after,omitempty"`
+}
+
+type NFTExistsQuery struct {
+	TokenId string `json:"token_id"`
+}
+
+type GetCachedContentQuery struct {
+	IpfsLink string `json:"ipfs_link"`
+}
+
+// VerifyNFTQuery returns a VerificationResponse
+type VerifyNFTQuery struct {
+	TokenId string `json:"token_id"`
+}
+
+// SubjectCompletionData is the completion data of a subject NFT
+type SubjectCompletionData struct {
+	SubjectId      string  `json:"subject_id"`
+	InstitutionId  string  `json:"institution_id"`
+	CourseId       string  `json:"course_id"`
+	SubjectName    string  `json:"subject_name"`
+	Credits        uint32  `json:"credits"`
+	FinalGrade     uint32  `json:"final_grade"`
+	CompletionDate string  `json:"completion_date"`
+	Semester       string  `json:"semester"`
+	AcademicYear   string  `json:"academic_year"`
+	Instructor     *string `json:"instructor,omitempty"`
+}
+
+// DegreeCompletionData is the completion data of a degree NFT
+type DegreeCompletionData struct {
+	InstitutionId  string  `json:"institution_id"`
+	CourseId       string  `json:"course_id"`
+	DegreeName     string  `json:"degree_name"`
+	DegreeType     string  `json:"degree_type"`
+	Major          string  `json:"major"`
+	Minor          *string `json:"minor,omitempty"`
+	GraduationDate string  `json:"graduation_date"`
+	FinalGpa       string  `json:"final_gpa"`
+	TotalCredits   uint32  `json:"total_credits"`
+	Honors         *string `json:"honors,omitempty"`
+}
+
+type NFTMetadata struct {
+	Name             string         `json:"name"`
+	Description      string         `json:"description"`
+	Image            string         `json:"image"`
+	ExternalUrl      *string        `json:"external_url,omitempty"`
+	AnimationUrl     *string        `json:"animation_url,omitempty"`
+	Attributes       []NFTAttribute `json:"attributes"`
+	IpfsMetadataLink string         `json:"ipfs_metadata_link"`
+	CreatedAt        uint64         `json:"created_at"`
+	UpdatedAt        uint64         `json:"updated_at"`
+}
+
+type NFTAttribute struct {
+	TraitType   string  `json:"trait_type"`
+	Value       string  `json:"value"`
+	DisplayType *string `json:"display_type,omitempty"`
+}
+
+type VerificationResponse struct {
+	IsValid             bool     `json:"is_valid"`
+	IssuedBy            string   `json:"issued_by"`
+	ValidationHash      string   `json:"validation_hash"`
+	IssueDate           uint64   `json:"issue_date"`
+	VerificationDetails []string `json:"verification_details"`
+}
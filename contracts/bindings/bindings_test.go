@@ -0,0 +1,311 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"academictoken/contracts/bindings/academicnft"
+	"academictoken/contracts/bindings/degree"
+	"academictoken/contracts/bindings/equivalence"
+	"academictoken/contracts/bindings/prerequisites"
+	"academictoken/contracts/bindings/progress"
+	"academictoken/contracts/bindings/schedule"
+)
+
+// contractBindings ties the Go bindings of a contract to its Rust crate
+type contractBindings struct {
+	dir     string
+	execute any
+	query   any
+	// types are Go structs checked against the Rust struct of the same name
+	types []any
+}
+
+var contracts = []contractBindings{
+	{
+		dir:     "prerequisites",
+		execute: prerequisites.ExecuteMsg{},
+		query:   prerequisites.QueryMsg{},
+		types: []any{
+			prerequisites.PrerequisiteGroup{}, prerequisites.CompletedSubjectMsg{}, prerequisites.PrerequisiteRegistration{},
+			prerequisites.VerificationResult{}, prerequisites.PrerequisitesResponse{}, prerequisites.StudentRecordResponse{},
+			prerequisites.StateResponse{}, prerequisites.IpfsCacheStatusResponse{},
+		},
+	},
+	{
+		dir:     "progress",
+		execute: progress.ExecuteMsg{},
+		query:   progress.QueryMsg{},
+	},
+	{
+		dir:     "degree",
+		execute: degree.ExecuteMsg{},
+		query:   degree.QueryMsg{},
+		types: []any{
+			degree.ValidateDegreeRequirementsResponse{}, degree.Config{}, degree.CurriculumRequirements{}, degree.DegreeValidationResult{},
+		},
+	},
+	{
+		dir:     "equivalence",
+		execute: equivalence.ExecuteMsg{},
+		query:   equivalence.QueryMsg{},
+		types: []any{
+			equivalence.SubjectInfo{}, equivalence.EquivalenceRegistration{}, equivalence.AnalysisOptions{},
+			equivalence.Equivalence{}, equivalence.EquivalenceResponse{}, equivalence.EquivalenceCheckResponse{},
+		},
+	},
+	{
+		dir:     "academic_nft",
+		execute: academicnft.ExecuteMsg{},
+		query:   academicnft.QueryMsg{},
+		types: []any{
+			academicnft.SubjectCompletionData{}, academicnft.DegreeCompletionData{}, academicnft.NFTMetadata{},
+			academicnft.VerificationResponse{},
+		},
+	},
+	{
+		dir:     "schedule",
+		execute: schedule.ExecuteMsg{},
+		query:   schedule.QueryMsg{},
+		types: []any{
+			schedule.GraduationTimelineResponse{}, schedule.SubjectSequenceResponse{},
+		},
+	},
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// goField is a JSON field of a Go struct
+type goField struct {
+	Type     reflect.Type
+	Optional bool
+}
+
+func goFields(t reflect.Type) map[string]goField {
+	fields := map[string]goField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = goField{Type: field.Type, Optional: strings.Contains(opts, "omitempty")}
+	}
+	return fields
+}
+
+// goKind returns the JSON kind a Go type serializes to
+func goKind(t reflect.Type) string {
+	if t == rawMessageType {
+		return "any"
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Interface:
+		return "any"
+	default:
+		return "number"
+	}
+}
+
+// goStruct returns the struct a field holds, directly or in a pointer or slice
+func goStruct(t reflect.Type) (reflect.Type, bool) {
+	if t == rawMessageType {
+		return nil, false
+	}
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t, t.Kind() == reflect.Struct
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// requireSameFields compares the fields of a Go struct with the Rust ones,
+// recursing into the nested structs both sides declare
+func requireSameFields(t *testing.T, defs rustDefs, path string, fields []rustField, goType reflect.Type) {
+	t.Helper()
+	rustNames := map[string]rustField{}
+	for _, field := range fields {
+		rustNames[field.Name] = field
+	}
+	goNames := goFields(goType)
+	require.Equal(t, sortedKeys(rustNames), sortedKeys(goNames), "%s: fields differ", path)
+
+	for _, name := range sortedKeys(rustNames) {
+		rust, gof := rustNames[name], goNames[name]
+		fieldPath := path + "." + name
+		_, optional := optionInner(rust.Type)
+		require.Equal(t, optional, gof.Optional, "%s: Rust type %s, Go omitempty %t", fieldPath, rust.Type, gof.Optional)
+
+		rustKind, goKind := defs.jsonKind(rust.Type), goKind(gof.Type)
+		if rustKind != "any" && goKind != "any" {
+			require.Equal(t, rustKind, goKind, "%s: Rust type %s, Go type %s", fieldPath, rust.Type, gof.Type)
+		}
+		if nested, ok := goStruct(gof.Type); ok {
+			rustNested, found := defs.Structs[namedType(rust.Type)]
+			require.True(t, found, "%s: Go struct %s has no Rust struct %s", fieldPath, nested, rust.Type)
+			requireSameFields(t, defs, fieldPath, rustNested, nested)
+		}
+	}
+}
+
+// requireSameEnum compares a Rust message enum with its Go union struct
+func requireSameEnum(t *testing.T, defs rustDefs, enum string, union any) {
+	t.Helper()
+	variants, found := defs.Enums[enum]
+	require.True(t, found, "enum %s not found", enum)
+
+	unionFields := goFields(reflect.TypeOf(union))
+	wireNames := map[string]rustVariant{}
+	for _, variant := range variants {
+		wireNames[variant.WireName] = variant
+	}
+	require.Equal(t, sortedKeys(wireNames), sortedKeys(unionFields), "%s: variants differ", enum)
+
+	for _, name := range sortedKeys(wireNames) {
+		field := unionFields[name]
+		require.True(t, field.Optional && field.Type.Kind() == reflect.Pointer, "%s.%s: variants must be omitempty pointers", enum, name)
+		requireSameFields(t, defs, enum+"::"+wireNames[name].Name, wireNames[name].Fields, field.Type.Elem())
+	}
+}
+
+func TestBindingsMatchContractSources(t *testing.T) {
+	for _, contract := range contracts {
+		t.Run(contract.dir, func(t *testing.T) {
+			defs, err := parseContract(filepath.Join("..", contract.dir))
+			require.NoError(t, err)
+
+			requireSameEnum(t, defs, "ExecuteMsg", contract.execute)
+			requireSameEnum(t, defs, "QueryMsg", contract.query)
+			for _, goValue := range contract.types {
+				goType := reflect.TypeOf(goValue)
+				fields, found := defs.Structs[goType.Name()]
+				require.True(t, found, "struct %s not found", goType.Name())
+				requireSameFields(t, defs, goType.Name(), fields, goType)
+			}
+		})
+	}
+}
+
+// jsonSchema is the subset of the cosmwasm-schema output describing messages
+type jsonSchema struct {
+	OneOf       []jsonSchema          `json:"oneOf"`
+	AnyOf       []jsonSchema          `json:"anyOf"`
+	Items       *jsonSchema           `json:"items"`
+	Required    []string              `json:"required"`
+	Properties  map[string]jsonSchema `json:"properties"`
+	Ref         string                `json:"$ref"`
+	Definitions map[string]jsonSchema `json:"definitions"`
+}
+
+// requireSchemaFields compares a schema object with a Go struct, following
+// references into the nested structs the Go side declares
+func requireSchemaFields(t *testing.T, root jsonSchema, path string, object jsonSchema, goType reflect.Type) {
+	t.Helper()
+	goNames := goFields(goType)
+	require.Equal(t, sortedKeys(object.Properties), sortedKeys(goNames), "%s: fields differ from schema", path)
+
+	required := map[string]bool{}
+	for _, name := range object.Required {
+		required[name] = true
+	}
+	for _, name := range sortedKeys(object.Properties) {
+		fieldPath := path + "." + name
+		require.Equal(t, !required[name], goNames[name].Optional, "%s: schema required %t", fieldPath, required[name])
+
+		nested, ok := goStruct(goNames[name].Type)
+		if !ok {
+			continue
+		}
+		if ref := schemaRef(object.Properties[name]); ref != "" {
+			definition, found := root.Definitions[ref]
+			require.True(t, found, "%s: definition %s not found", fieldPath, ref)
+			if definition.Properties != nil {
+				requireSchemaFields(t, root, fieldPath, definition, nested)
+			}
+		}
+	}
+}
+
+// schemaRef returns the definition referenced by a property, through arrays
+// and nullable wrappers
+func schemaRef(property jsonSchema) string {
+	if property.Ref != "" {
+		return strings.TrimPrefix(property.Ref, "#/definitions/")
+	}
+	if property.Items != nil {
+		return schemaRef(*property.Items)
+	}
+	for _, alternative := range append(property.OneOf, property.AnyOf...) {
+		if ref := schemaRef(alternative); ref != "" {
+			return ref
+		}
+	}
+	return ""
+}
+
+func TestBindingsMatchContractSchemas(t *testing.T) {
+	for _, contract := range contracts {
+		for file, union := range map[string]any{"execute_msg.json": contract.execute, "query_msg.json": contract.query} {
+			path := filepath.Join("..", contract.dir, "schema", file)
+			bz, err := os.ReadFile(path)
+			if os.IsNotExist(err) {
+				continue
+			}
+			require.NoError(t, err)
+
+			var root jsonSchema
+			require.NoError(t, json.Unmarshal(bz, &root))
+
+			unionFields := goFields(reflect.TypeOf(union))
+			variants := map[string]jsonSchema{}
+			for _, variant := range root.OneOf {
+				require.Len(t, variant.Required, 1, "%s: unexpected variant shape", path)
+				variants[variant.Required[0]] = variant.Properties[variant.Required[0]]
+			}
+			require.Equal(t, sortedKeys(variants), sortedKeys(unionFields), "%s: variants differ", path)
+			for _, name := range sortedKeys(variants) {
+				requireSchemaFields(t, root, path+":"+name, variants[name], unionFields[name].Type.Elem())
+			}
+		}
+	}
+}
+
+func TestBindingsMarshalSingleVariant(t *testing.T) {
+	bz, err := json.Marshal(degree.QueryMsg{GetMissingRequirements: &degree.GetMissingRequirementsQuery{
+		StudentId:         "student-1",
+		CurriculumId:      "curriculum-1",
+		CompletedSubjects: []string{"calc1"},
+	}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"get_missing_requirements":{"student_id":"student-1","curriculum_id":"curriculum-1","completed_subjects":["calc1"]}}`, string(bz))
+
+	bz, err = json.Marshal(academicnft.QueryMsg{GetStatistics: &academicnft.GetStatisticsQuery{}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"get_statistics":{}}`, string(bz))
+}
@@ -0,0 +1,97 @@
+// Package degree holds the Go bindings of contracts/degree.
+package degree
+
+// ExecuteMsg is the execute message of the degree contract
+type ExecuteMsg struct {
+	SetCurriculumRequirements  *SetCurriculumRequirementsMsg  `json:"set_curriculum_requirements,omitempty"`
+	ValidateDegreeRequirements *ValidateDegreeRequirementsMsg `json:"validate_degree_requirements,omitempty"`
+	ClearValidationCache       *ClearValidationCacheMsg       `json:"clear_validation_cache,omitempty"`
+}
+
+// SetCurriculumRequirementsMsg registers the requirements of a curriculum; min
+// credits is a Uint128
+type SetCurriculumRequirementsMsg struct {
+	CurriculumId           string   `json:"curriculum_id"`
+	MinCredits             string   `json:"min_credits"`
+	RequiredSubjects       []string `json:"required_subjects"`
+	MinGpa                 *string  `json:"min_gpa,omitempty"`
+	AdditionalRequirements []string `json:"additional_requirements"`
+}
+
+// ValidateDegreeRequirementsMsg validates a student against the requirements
+// of a curriculum; the response data is a ValidateDegreeRequirementsResponse
+type ValidateDegreeRequirementsMsg struct {
+	StudentId         string   `json:"student_id"`
+	CurriculumId      string   `json:"curriculum_id"`
+	InstitutionId     string   `json:"institution_id"`
+	FinalGpa          string   `json:"final_gpa"`
+	TotalCredits      uint64   `json:"total_credits"`
+	CompletedSubjects []string `json:"completed_subjects"`
+	Signatures        []string `json:"signatures"`
+	RequestedDate     string   `json:"requested_date"`
+}
+
+type ClearValidationCacheMsg struct {
+	StudentId    string `json:"student_id"`
+	CurriculumId string `json:"curriculum_id"`
+}
+
+// QueryMsg is the query message of the degree contract
+type QueryMsg struct {
+	GetConfig                 *GetConfigQuery                 `json:"get_config,omitempty"`
+	GetCurriculumRequirements *GetCurriculumRequirementsQuery `json:"get_curriculum_requirements,omitempty"`
+	GetValidationResult       *GetValidationResultQuery       `json:"get_validation_result,omitempty"`
+	GetMissingRequirements    *GetMissingRequirementsQuery    `json:"get_missing_requirements,omitempty"`
+}
+
+type GetConfigQuery struct{}
+
+type GetCurriculumRequirementsQuery struct {
+	CurriculumId string `json:"curriculum_id"`
+}
+
+// GetValidationResultQuery returns the cached DegreeValidationResult
+type GetValidationResultQuery struct {
+	StudentId    string `json:"student_id"`
+	CurriculumId string `json:"curriculum_id"`
+}
+
+// GetMissingRequirementsQuery returns the missing requirements as a list of strings
+type GetMissingRequirementsQuery struct {
+	StudentId         string   `json:"student_id"`
+	CurriculumId      string   `json:"curriculum_id"`
+	CompletedSubjects []string `json:"completed_subjects"`
+}
+
+type ValidateDegreeRequirementsResponse struct {
+	IsValid             bool     `json:"is_valid"`
+	Message             string   `json:"message"`
+	DegreeType          string   `json:"degree_type"`
+	CurriculumVersion   string   `json:"curriculum_version"`
+	ValidationHash      string   `json:"validation_hash"`
+	RequirementsMet     []string `json:"requirements_met"`
+	MissingRequirements []string `json:"missing_requirements"`
+}
+
+type Config struct {
+	Admin         string `json:"admin"`
+	ModuleAddress string `json:"module_address"`
+}
+
+type CurriculumRequirements struct {
+	CurriculumId           string   `json:"curriculum_id"`
+	MinCredits             string   `json:"min_credits"`
+	RequiredSubjects       []string `json:"required_subjects"`
+	MinGpa                 *string  `json:"min_gpa,omitempty"`
+	AdditionalRequirements []string `json:"additional_requirements"`
+}
+
+type DegreeValidationResult struct {
+	IsValid               bool     `json:"is_valid"`
+	ValidationScore       string   `json:"validation_score"`
+	Message               string   `json:"message"`
+	RequirementsMet       []string `json:"requirements_met"`
+	MissingRequirements   []string `json:"missing_requirements"`
+	TotalCreditsCompleted string   `json:"total_credits_completed"`
+	Gpa                   string   `json:"gpa"`
+}
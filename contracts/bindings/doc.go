@@ -0,0 +1,12 @@
+// Package bindings groups the Go bindings of the CosmWasm contracts under
+// contracts/. Each subpackage mirrors the ExecuteMsg and QueryMsg enums of one
+// contract as union structs holding one pointer per variant, so exactly one
+// variant is set when a message is marshalled:
+//
+//	msg, err := json.Marshal(degree.ExecuteMsg{ValidateDegreeRequirements: &degree.ValidateDegreeRequirementsMsg{...}})
+//
+// Rust Option fields are pointers or omitempty fields, Uint128 values are
+// strings, and nested types the chain never reads are kept as json.RawMessage.
+// The tests of this package parse the contract sources and JSON schemas and
+// fail when either side changes shape.
+package bindings
@@ -0,0 +1,236 @@
+// Package equivalence holds the Go bindings of contracts/equivalence.
+package equivalence
+
+import "encoding/json"
+
+// AcademicLevel is the level of a subject
+type AcademicLevel string
+
+const (
+	AcademicLevelUndergraduate AcademicLevel = "undergraduate"
+	AcademicLevelGraduate      AcademicLevel = "graduate"
+	AcademicLevelPostgraduate  AcademicLevel = "postgraduate"
+)
+
+// AnalysisMethod is how an equivalence is analyzed
+type AnalysisMethod string
+
+const (
+	AnalysisMethodAutomatic     AnalysisMethod = "automatic"
+	AnalysisMethodManual        AnalysisMethod = "manual"
+	AnalysisMethodHybrid        AnalysisMethod = "hybrid"
+	AnalysisMethodInstitutional AnalysisMethod = "institutional"
+)
+
+// EquivalenceType is the outcome of an equivalence
+type EquivalenceType string
+
+const (
+	EquivalenceTypeFull        EquivalenceType = "full"
+	EquivalenceTypePartial     EquivalenceType = "partial"
+	EquivalenceTypeConditional EquivalenceType = "conditional"
+	EquivalenceTypeNone        EquivalenceType = "none"
+)
+
+// EquivalenceStatus is the review status of an equivalence
+type EquivalenceStatus string
+
+const (
+	EquivalenceStatusPending     EquivalenceStatus = "pending"
+	EquivalenceStatusAnalyzing   EquivalenceStatus = "analyzing"
+	EquivalenceStatusUnderReview EquivalenceStatus = "under_review"
+	EquivalenceStatusApproved    EquivalenceStatus = "approved"
+	EquivalenceStatusRejected    EquivalenceStatus = "rejected"
+)
+
+// ExecuteMsg is the execute message of the equivalence contract
+type ExecuteMsg struct {
+	RegisterEquivalence        *RegisterEquivalenceMsg        `json:"register_equivalence,omitempty"`
+	AnalyzeEquivalence         *AnalyzeEquivalenceMsg         `json:"analyze_equivalence,omitempty"`
+	ApproveEquivalence         *ApproveEquivalenceMsg         `json:"approve_equivalence,omitempty"`
+	CacheIpfsContent           *CacheIpfsContentMsg           `json:"cache_ipfs_content,omitempty"`
+	AnalyzeEquivalenceEnhanced *AnalyzeEquivalenceEnhancedMsg `json:"analyze_equivalence_enhanced,omitempty"`
+	SubmitTransferRequest      *SubmitTransferRequestMsg      `json:"submit_transfer_request,omitempty"`
+	ProcessTransferRequest     *ProcessTransferRequestMsg     `json:"process_transfer_request,omitempty"`
+	BatchRegisterEquivalences  *BatchRegisterEquivalencesMsg  `json:"batch_register_equivalences,omitempty"`
+	UpdateConfig               *UpdateConfigMsg               `json:"update_config,omitempty"`
+}
+
+// RegisterEquivalenceMsg registers an equivalence; its ID is derived from the
+// two subject IDs and can be found with FindEquivalence
+type RegisterEquivalenceMsg struct {
+	SourceSubject  SubjectInfo    `json:"source_subject"`
+	TargetSubject  SubjectInfo    `json:"target_subject"`
+	AnalysisMethod AnalysisMethod `json:"analysis_method"`
+	Notes          *string        `json:"notes,omitempty"`
+}
+
+type AnalyzeEquivalenceMsg struct {
+	EquivalenceId   string `json:"equivalence_id"`
+	ForceReanalysis *bool  `json:"force_reanalysis,omitempty"`
+}
+
+type ApproveEquivalenceMsg struct {
+	EquivalenceId        string          `json:"equivalence_id"`
+	EquivalenceType      EquivalenceType `json:"equivalence_type"`
+	SimilarityPercentage uint32          `json:"similarity_percentage"`
+	Notes                *string         `json:"notes,omitempty"`
+}
+
+type CacheIpfsContentMsg struct {
+	IpfsLink string          `json:"ipfs_link"`
+	Content  json.RawMessage `json:"content"`
+}
+
+type AnalyzeEquivalenceEnhancedMsg struct {
+	EquivalenceId   string          `json:"equivalence_id"`
+	AnalysisOptions AnalysisOptions `json:"analysis_options"`
+}
+
+type SubmitTransferRequestMsg struct {
+	StudentId             string   `json:"student_id"`
+	SourceInstitution     string   `json:"source_institution"`
+	TargetInstitution     string   `json:"target_institution"`
+	CompletedSubjects     []string `json:"completed_subjects"`
+	RequestedEquivalences []string `json:"requested_equivalences"`
+}
+
+type ProcessTransferRequestMsg struct {
+	TransferId           string   `json:"transfer_id"`
+	ApprovedEquivalences []string `json:"approved_equivalences"`
+	Notes                *string  `json:"notes,omitempty"`
+}
+
+type BatchRegisterEquivalencesMsg struct {
+	Equivalences []EquivalenceRegistration `json:"equivalences"`
+}
+
+type UpdateConfigMsg struct {
+	AutoApprovalThreshold *uint32 `json:"auto_approval_threshold,omitempty"`
+	NewOwner              *string `json:"new_owner,omitempty"`
+}
+
+// QueryMsg is the query message of the equivalence contract
+type QueryMsg struct {
+	GetState                      *GetStateQuery                      `json:"get_state,omitempty"`
+	GetEquivalence                *GetEquivalenceQuery                `json:"get_equivalence,omitempty"`
+	FindEquivalence               *FindEquivalenceQuery               `json:"find_equivalence,omitempty"`
+	ListEquivalencesByInstitution *ListEquivalencesByInstitutionQuery `json:"list_equivalences_by_institution,omitempty"`
+	GetAnalysisResult             *GetAnalysisResultQuery             `json:"get_analysis_result,omitempty"`
+	GetDetailedAnalysisResult     *GetDetailedAnalysisResultQuery     `json:"get_detailed_analysis_result,omitempty"`
+	GetTransferRequest            *GetTransferRequestQuery            `json:"get_transfer_request,omitempty"`
+	ListStudentTransfers          *ListStudentTransfersQuery          `json:"list_student_transfers,omitempty"`
+	CheckEquivalence              *CheckEquivalenceQuery              `json:"check_equivalence,omitempty"`
+	GetStatistics                 *GetStatisticsQuery                 `json:"get_statistics,omitempty"`
+	DebugEquivalences             *DebugEquivalencesQuery             `json:"debug_equivalences,omitempty"`
+}
+
+type GetStateQuery struct{}
+
+// GetEquivalenceQuery returns an EquivalenceResponse
+type GetEquivalenceQuery struct {
+	EquivalenceId string `json:"equivalence_id"`
+}
+
+// FindEquivalenceQuery returns an EquivalenceResponse
+type FindEquivalenceQuery struct {
+	SourceSubjectId string `json:"source_subject_id"`
+	TargetSubjectId string `json:"target_subject_id"`
+}
+
+type ListEquivalencesByInstitutionQuery struct {
+	InstitutionId string  `json:"institution_id"`
+	Limit         *uint32 `json:"limit,omitempty"`
+	StartAfter    *string `json:"start_after,omitempty"`
+}
+
+type GetAnalysisResultQuery struct {
+	AnalysisId string `json:"analysis_id"`
+}
+
+type GetDetailedAnalysisResultQuery struct {
+	AnalysisId string `json:"analysis_id"`
+}
+
+type GetTransferRequestQuery struct {
+	TransferId string `json:"transfer_id"`
+}
+
+type ListStudentTransfersQuery struct {
+	StudentId string  `json:"student_id"`
+	Limit     *uint32 `json:"limit,omitempty"`
+}
+
+// CheckEquivalenceQuery returns an EquivalenceCheckResponse
+type CheckEquivalenceQuery struct {
+	SourceSubjectId   string  `json:"source_subject_id"`
+	TargetSubjectId   string  `json:"target_subject_id"`
+	MinimumSimilarity *uint32 `json:"minimum_similarity,omitempty"`
+}
+
+type GetStatisticsQuery struct {
+	InstitutionId *string `json:"institution_id,omitempty"`
+}
+
+type DebugEquivalencesQuery struct{}
+
+// SubjectInfo describes a subject taking part in an equivalence
+type SubjectInfo struct {
+	SubjectId     string          `json:"subject_id"`
+	Title         string          `json:"title"`
+	InstitutionId string          `json:"institution_id"`
+	Credits       uint32          `json:"credits"`
+	IpfsLink      string          `json:"ipfs_link"`
+	ContentHash   string          `json:"content_hash"`
+	Metadata      SubjectMetadata `json:"metadata"`
+}
+
+type SubjectMetadata struct {
+	Level         AcademicLevel `json:"level"`
+	Department    string        `json:"department"`
+	WorkloadHours uint32        `json:"workload_hours"`
+	Semester      uint32        `json:"semester"`
+	Language      string        `json:"language"`
+}
+
+type EquivalenceRegistration struct {
+	SourceSubject  SubjectInfo    `json:"source_subject"`
+	TargetSubject  SubjectInfo    `json:"target_subject"`
+	AnalysisMethod AnalysisMethod `json:"analysis_method"`
+	Notes          *string        `json:"notes,omitempty"`
+}
+
+type AnalysisOptions struct {
+	UseSemanticAnalysis     bool            `json:"use_semantic_analysis"`
+	CheckPrerequisites      bool            `json:"check_prerequisites"`
+	AnalyzeLearningOutcomes bool            `json:"analyze_learning_outcomes"`
+	MinimumContentDepth     uint32          `json:"minimum_content_depth"`
+	LanguagePreference      json.RawMessage `json:"language_preference,omitempty"`
+}
+
+// Equivalence is an equivalence stored by the contract
+type Equivalence struct {
+	Id                   string            `json:"id"`
+	SourceSubject        SubjectInfo       `json:"source_subject"`
+	TargetSubject        SubjectInfo       `json:"target_subject"`
+	EquivalenceType      EquivalenceType   `json:"equivalence_type"`
+	SimilarityPercentage uint32            `json:"similarity_percentage"`
+	Status               EquivalenceStatus `json:"status"`
+	AnalysisMethod       AnalysisMethod    `json:"analysis_method"`
+	CreatedTimestamp     uint64            `json:"created_timestamp"`
+	ApprovedTimestamp    *uint64           `json:"approved_timestamp,omitempty"`
+	ApprovedBy           *string           `json:"approved_by,omitempty"`
+	Notes                string            `json:"notes"`
+	ConfidenceScore      uint32            `json:"confidence_score"`
+}
+
+type EquivalenceResponse struct {
+	Equivalence *Equivalence `json:"equivalence,omitempty"`
+}
+
+type EquivalenceCheckResponse struct {
+	IsEquivalent         bool             `json:"is_equivalent"`
+	EquivalenceType      *EquivalenceType `json:"equivalence_type,omitempty"`
+	SimilarityPercentage uint32           `json:"similarity_percentage"`
+	EquivalenceId        *string          `json:"equivalence_id,omitempty"`
+}
@@ -0,0 +1,187 @@
+// Package prerequisites holds the Go bindings of contracts/prerequisites.
+package prerequisites
+
+import "encoding/json"
+
+// GroupType is the type of a prerequisite group
+type GroupType string
+
+const (
+	GroupTypeAll     GroupType = "all"
+	GroupTypeAny     GroupType = "any"
+	GroupTypeMinimum GroupType = "minimum"
+	GroupTypeNone    GroupType = "none"
+)
+
+// LogicType combines the groups of a subject
+type LogicType string
+
+const (
+	LogicTypeAnd       LogicType = "and"
+	LogicTypeOr        LogicType = "or"
+	LogicTypeXor       LogicType = "xor"
+	LogicTypeThreshold LogicType = "threshold"
+	LogicTypeNone      LogicType = "none"
+)
+
+// ExecuteMsg is the execute message of the prerequisites contract
+type ExecuteMsg struct {
+	RegisterPrerequisites           *RegisterPrerequisitesMsg           `json:"register_prerequisites,omitempty"`
+	UpdateStudentRecord             *UpdateStudentRecordMsg             `json:"update_student_record,omitempty"`
+	VerifyEnrollment                *VerifyEnrollmentMsg                `json:"verify_enrollment,omitempty"`
+	BatchRegisterPrerequisites      *BatchRegisterPrerequisitesMsg      `json:"batch_register_prerequisites,omitempty"`
+	UpdateOwner                     *UpdateOwnerMsg                     `json:"update_owner,omitempty"`
+	CacheIpfsContent                *CacheIpfsContentMsg                `json:"cache_ipfs_content,omitempty"`
+	AnalyzePrerequisiteRelationship *AnalyzePrerequisiteRelationshipMsg `json:"analyze_prerequisite_relationship,omitempty"`
+}
+
+type RegisterPrerequisitesMsg struct {
+	SubjectId     string              `json:"subject_id"`
+	Prerequisites []PrerequisiteGroup `json:"prerequisites"`
+}
+
+type UpdateStudentRecordMsg struct {
+	StudentId        string              `json:"student_id"`
+	CompletedSubject CompletedSubjectMsg `json:"completed_subject"`
+}
+
+type VerifyEnrollmentMsg struct {
+	StudentId string `json:"student_id"`
+	SubjectId string `json:"subject_id"`
+}
+
+type BatchRegisterPrerequisitesMsg struct {
+	Items []PrerequisiteRegistration `json:"items"`
+}
+
+type UpdateOwnerMsg struct {
+	NewOwner string `json:"new_owner"`
+}
+
+type CacheIpfsContentMsg struct {
+	IpfsLink string          `json:"ipfs_link"`
+	Content  json.RawMessage `json:"content"`
+}
+
+type AnalyzePrerequisiteRelationshipMsg struct {
+	SourceSubjectId string `json:"source_subject_id"`
+	TargetSubjectId string `json:"target_subject_id"`
+	SourceIpfsLink  string `json:"source_ipfs_link"`
+	TargetIpfsLink  string `json:"target_ipfs_link"`
+}
+
+// QueryMsg is the query message of the prerequisites contract
+type QueryMsg struct {
+	GetPrerequisites       *GetPrerequisitesQuery       `json:"get_prerequisites,omitempty"`
+	GetStudentRecord       *GetStudentRecordQuery       `json:"get_student_record,omitempty"`
+	CheckEligibility       *CheckEligibilityQuery       `json:"check_eligibility,omitempty"`
+	GetVerificationHistory *GetVerificationHistoryQuery `json:"get_verification_history,omitempty"`
+	GetState               *GetStateQuery               `json:"get_state,omitempty"`
+	GetIpfsCacheStatus     *GetIpfsCacheStatusQuery     `json:"get_ipfs_cache_status,omitempty"`
+	GetCachedContent       *GetCachedContentQuery       `json:"get_cached_content,omitempty"`
+}
+
+type GetPrerequisitesQuery struct {
+	SubjectId string `json:"subject_id"`
+}
+
+type GetStudentRecordQuery struct {
+	StudentId string `json:"student_id"`
+}
+
+// CheckEligibilityQuery returns a VerificationResult
+type CheckEligibilityQuery struct {
+	StudentId string `json:"student_id"`
+	SubjectId string `json:"subject_id"`
+}
+
+type GetVerificationHistoryQuery struct {
+	StudentId string  `json:"student_id"`
+	Limit     *uint32 `json:"limit,omitempty"`
+}
+
+type GetStateQuery struct{}
+
+type GetIpfsCacheStatusQuery struct {
+	IpfsLink string `json:"ipfs_link"`
+}
+
+type GetCachedContentQuery struct {
+	IpfsLink string `json:"ipfs_link"`
+}
+
+// PrerequisiteGroup is a prerequisite group of a subject
+type PrerequisiteGroup struct {
+	Id                       string    `json:"id"`
+	SubjectId                string    `json:"subject_id"`
+	GroupType                GroupType `json:"group_type"`
+	MinimumCredits           uint64    `json:"minimum_credits"`
+	MinimumCompletedSubjects uint64    `json:"minimum_completed_subjects"`
+	SubjectIds               []string  `json:"subject_ids"`
+	Logic                    LogicType `json:"logic"`
+	Priority                 uint32    `json:"priority"`
+	Confidence               uint32    `json:"confidence"`
+	IpfsLink                 *string   `json:"ipfs_link,omitempty"`
+}
+
+// CompletedSubjectMsg is a completed subject sent to the contract; the grade
+// is scaled by 100 (85.5 -> 8550)
+type CompletedSubjectMsg struct {
+	SubjectId      string  `json:"subject_id"`
+	Credits        uint64  `json:"credits"`
+	CompletionDate string  `json:"completion_date"`
+	Grade          uint32  `json:"grade"`
+	NftTokenId     string  `json:"nft_token_id"`
+	IpfsLink       *string `json:"ipfs_link,omitempty"`
+}
+
+type PrerequisiteRegistration struct {
+	SubjectId     string              `json:"subject_id"`
+	Prerequisites []PrerequisiteGroup `json:"prerequisites"`
+}
+
+// VerificationResult is the result of an eligibility check
+type VerificationResult struct {
+	CanEnroll             bool     `json:"can_enroll"`
+	MissingPrerequisites  []string `json:"missing_prerequisites"`
+	SatisfiedGroups       []string `json:"satisfied_groups"`
+	UnsatisfiedGroups     []string `json:"unsatisfied_groups"`
+	VerificationTimestamp uint64   `json:"verification_timestamp"`
+	Details               string   `json:"details"`
+	UsedIpfsContent       bool     `json:"used_ipfs_content"`
+}
+
+type PrerequisitesResponse struct {
+	SubjectId     string              `json:"subject_id"`
+	Prerequisites []PrerequisiteGroup `json:"prerequisites"`
+}
+
+type StudentRecordResponse struct {
+	Record StudentRecord `json:"record"`
+}
+
+type StudentRecord struct {
+	StudentId         string             `json:"student_id"`
+	CompletedSubjects []CompletedSubject `json:"completed_subjects"`
+	TotalCredits      uint64             `json:"total_credits"`
+}
+
+type CompletedSubject struct {
+	SubjectId      string  `json:"subject_id"`
+	Credits        uint64  `json:"credits"`
+	CompletionDate string  `json:"completion_date"`
+	Grade          uint32  `json:"grade"`
+	NftTokenId     string  `json:"nft_token_id"`
+	IpfsLink       *string `json:"ipfs_link,omitempty"`
+}
+
+type StateResponse struct {
+	Owner              string `json:"owner"`
+	TotalSubjects      uint64 `json:"total_subjects"`
+	TotalVerifications uint64 `json:"total_verifications"`
+}
+
+type IpfsCacheStatusResponse struct {
+	IsCached bool   `json:"is_cached"`
+	IpfsLink string `json:"ipfs_link"`
+}
@@ -0,0 +1,245 @@
+// Package progress holds the Go bindings of contracts/progress.
+package progress
+
+import "encoding/json"
+
+// ExecuteMsg is the execute message of the academic progress contract
+type ExecuteMsg struct {
+	UpdateConfig                 *UpdateConfigMsg                 `json:"update_config,omitempty"`
+	UpdateStudentProgress        *UpdateStudentProgressMsg        `json:"update_student_progress,omitempty"`
+	BatchUpdateStudentProgress   *BatchUpdateStudentProgressMsg   `json:"batch_update_student_progress,omitempty"`
+	RecordSubjectCompletion      *RecordSubjectCompletionMsg      `json:"record_subject_completion,omitempty"`
+	RecordSubjectEnrollment      *RecordSubjectEnrollmentMsg      `json:"record_subject_enrollment,omitempty"`
+	UpdateCurrentSubjectProgress *UpdateCurrentSubjectProgressMsg `json:"update_current_subject_progress,omitempty"`
+	RecordMilestoneAchievement   *RecordMilestoneAchievementMsg   `json:"record_milestone_achievement,omitempty"`
+	AddRiskFactor                *AddRiskFactorMsg                `json:"add_risk_factor,omitempty"`
+	RemoveRiskFactor             *RemoveRiskFactorMsg             `json:"remove_risk_factor,omitempty"`
+	GenerateInstitutionAnalytics *GenerateInstitutionAnalyticsMsg `json:"generate_institution_analytics,omitempty"`
+	GenerateStudentDashboard     *GenerateStudentDashboardMsg     `json:"generate_student_dashboard,omitempty"`
+	BatchGenerateDashboards      *BatchGenerateDashboardsMsg      `json:"batch_generate_dashboards,omitempty"`
+	RunGlobalAnalytics           *RunGlobalAnalyticsMsg           `json:"run_global_analytics,omitempty"`
+	UpdateAcademicStatus         *UpdateAcademicStatusMsg         `json:"update_academic_status,omitempty"`
+	ArchiveOldData               *ArchiveOldDataMsg               `json:"archive_old_data,omitempty"`
+	ResetStudentProgress         *ResetStudentProgressMsg         `json:"reset_student_progress,omitempty"`
+}
+
+type UpdateConfigMsg struct {
+	NewOwner            *string         `json:"new_owner,omitempty"`
+	AnalyticsEnabled    *bool           `json:"analytics_enabled,omitempty"`
+	UpdateFrequency     json.RawMessage `json:"update_frequency,omitempty"`
+	AnalyticsDepth      json.RawMessage `json:"analytics_depth,omitempty"`
+	RetentionPeriodDays *uint32         `json:"retention_period_days,omitempty"`
+}
+
+type UpdateStudentProgressMsg struct {
+	StudentProgress       json.RawMessage `json:"student_progress"`
+	ForceAnalyticsRefresh *bool           `json:"force_analytics_refresh,omitempty"`
+}
+
+type BatchUpdateStudentProgressMsg struct {
+	StudentProgressList  json.RawMessage `json:"student_progress_list"`
+	AnalyticsRefreshMode json.RawMessage `json:"analytics_refresh_mode"`
+}
+
+// RecordSubjectCompletionMsg records a completed subject; the final grade is
+// on the 0-100 scale and grades of 60 or more pass
+type RecordSubjectCompletionMsg struct {
+	StudentId          string  `json:"student_id"`
+	SubjectId          string  `json:"subject_id"`
+	FinalGrade         uint32  `json:"final_grade"`
+	CompletionDate     string  `json:"completion_date"`
+	StudyHours         *uint32 `json:"study_hours,omitempty"`
+	DifficultyRating   *uint32 `json:"difficulty_rating,omitempty"`
+	SatisfactionRating *uint32 `json:"satisfaction_rating,omitempty"`
+	NftTokenId         string  `json:"nft_token_id"`
+}
+
+type RecordSubjectEnrollmentMsg struct {
+	StudentId          string `json:"student_id"`
+	SubjectId          string `json:"subject_id"`
+	EnrollmentDate     string `json:"enrollment_date"`
+	ExpectedCompletion string `json:"expected_completion"`
+}
+
+type UpdateCurrentSubjectProgressMsg struct {
+	StudentId            string  `json:"student_id"`
+	SubjectId            string  `json:"subject_id"`
+	CurrentGrade         *uint32 `json:"current_grade,omitempty"`
+	AttendanceRate       *uint32 `json:"attendance_rate,omitempty"`
+	AssignmentsCompleted uint32  `json:"assignments_completed"`
+	StudyHoursLogged     uint32  `json:"study_hours_logged"`
+}
+
+type RecordMilestoneAchievementMsg struct {
+	StudentId       string  `json:"student_id"`
+	MilestoneId     string  `json:"milestone_id"`
+	AchievementDate string  `json:"achievement_date"`
+	Notes           *string `json:"notes,omitempty"`
+}
+
+type AddRiskFactorMsg struct {
+	StudentId                   string          `json:"student_id"`
+	RiskType                    json.RawMessage `json:"risk_type"`
+	Severity                    json.RawMessage `json:"severity"`
+	Description                 string          `json:"description"`
+	InterventionRecommendations []string        `json:"intervention_recommendations"`
+}
+
+type RemoveRiskFactorMsg struct {
+	StudentId string          `json:"student_id"`
+	RiskType  json.RawMessage `json:"risk_type"`
+}
+
+type GenerateInstitutionAnalyticsMsg struct {
+	InstitutionId string          `json:"institution_id"`
+	Period        json.RawMessage `json:"period"`
+	ForceRefresh  *bool           `json:"force_refresh,omitempty"`
+}
+
+type GenerateStudentDashboardMsg struct {
+	StudentId             string `json:"student_id"`
+	IncludePeerComparison *bool  `json:"include_peer_comparison,omitempty"`
+	ForceRefresh          *bool  `json:"force_refresh,omitempty"`
+}
+
+type BatchGenerateDashboardsMsg struct {
+	InstitutionId    string   `json:"institution_id"`
+	StudentIds       []string `json:"student_ids,omitempty"`
+	IncludeAnalytics bool     `json:"include_analytics"`
+}
+
+type RunGlobalAnalyticsMsg struct {
+	Period       json.RawMessage `json:"period"`
+	Institutions []string        `json:"institutions,omitempty"`
+}
+
+type UpdateAcademicStatusMsg struct {
+	StudentId     string          `json:"student_id"`
+	NewStatus     json.RawMessage `json:"new_status"`
+	EffectiveDate string          `json:"effective_date"`
+	Reason        *string         `json:"reason,omitempty"`
+}
+
+type ArchiveOldDataMsg struct {
+	CutoffDate string `json:"cutoff_date"`
+	DryRun     *bool  `json:"dry_run,omitempty"`
+}
+
+type ResetStudentProgressMsg struct {
+	StudentId string `json:"student_id"`
+	Reason    string `json:"reason"`
+	Backup    bool   `json:"backup"`
+}
+
+// QueryMsg is the query message of the academic progress contract
+type QueryMsg struct {
+	GetState                 *GetStateQuery                 `json:"get_state,omitempty"`
+	GetConfig                *GetConfigQuery                `json:"get_config,omitempty"`
+	GetStudentProgress       *GetStudentProgressQuery       `json:"get_student_progress,omitempty"`
+	GetStudentDashboard      *GetStudentDashboardQuery      `json:"get_student_dashboard,omitempty"`
+	GetInstitutionAnalytics  *GetInstitutionAnalyticsQuery  `json:"get_institution_analytics,omitempty"`
+	GetStudentsByInstitution *GetStudentsByInstitutionQuery `json:"get_students_by_institution,omitempty"`
+	GetStudentsByCourse      *GetStudentsByCourseQuery      `json:"get_students_by_course,omitempty"`
+	GetAtRiskStudents        *GetAtRiskStudentsQuery        `json:"get_at_risk_students,omitempty"`
+	GetTopPerformers         *GetTopPerformersQuery         `json:"get_top_performers,omitempty"`
+	GetGraduationForecast    *GetGraduationForecastQuery    `json:"get_graduation_forecast,omitempty"`
+	GetComparativeAnalytics  *GetComparativeAnalyticsQuery  `json:"get_comparative_analytics,omitempty"`
+	GetProgressTrends        *GetProgressTrendsQuery        `json:"get_progress_trends,omitempty"`
+	GetSubjectPerformance    *GetSubjectPerformanceQuery    `json:"get_subject_performance,omitempty"`
+	GetCohortAnalysis        *GetCohortAnalysisQuery        `json:"get_cohort_analysis,omitempty"`
+	GetPredictiveInsights    *GetPredictiveInsightsQuery    `json:"get_predictive_insights,omitempty"`
+	SearchStudents           *SearchStudentsQuery           `json:"search_students,omitempty"`
+	GetAnalyticsSummary      *GetAnalyticsSummaryQuery      `json:"get_analytics_summary,omitempty"`
+}
+
+type GetStateQuery struct{}
+
+type GetConfigQuery struct{}
+
+type GetStudentProgressQuery struct {
+	StudentId string `json:"student_id"`
+}
+
+type GetStudentDashboardQuery struct {
+	StudentId             string `json:"student_id"`
+	IncludePeerComparison *bool  `json:"include_peer_comparison,omitempty"`
+}
+
+type GetInstitutionAnalyticsQuery struct {
+	InstitutionId string          `json:"institution_id"`
+	Period        json.RawMessage `json:"period,omitempty"`
+}
+
+type GetStudentsByInstitutionQuery struct {
+	InstitutionId string          `json:"institution_id"`
+	StatusFilter  json.RawMessage `json:"status_filter,omitempty"`
+	Limit         *uint32         `json:"limit,omitempty"`
+	StartAfter    *string         `json:"start_after,omitempty"`
+}
+
+type GetStudentsByCourseQuery struct {
+	CourseId     string          `json:"course_id"`
+	StatusFilter json.RawMessage `json:"status_filter,omitempty"`
+	Limit        *uint32         `json:"limit,omitempty"`
+	StartAfter   *string         `json:"start_after,omitempty"`
+}
+
+type GetAtRiskStudentsQuery struct {
+	InstitutionId   *string         `json:"institution_id,omitempty"`
+	RiskLevelFilter json.RawMessage `json:"risk_level_filter,omitempty"`
+	Limit           *uint32         `json:"limit,omitempty"`
+}
+
+type GetTopPerformersQuery struct {
+	InstitutionId *string         `json:"institution_id,omitempty"`
+	CourseId      *string         `json:"course_id,omitempty"`
+	Metric        json.RawMessage `json:"metric"`
+	Limit         *uint32         `json:"limit,omitempty"`
+}
+
+type GetGraduationForecastQuery struct {
+	StudentId string          `json:"student_id"`
+	Scenario  json.RawMessage `json:"scenario"`
+}
+
+type GetComparativeAnalyticsQuery struct {
+	InstitutionId   string          `json:"institution_id"`
+	ComparisonGroup json.RawMessage `json:"comparison_group"`
+	Metrics         []string        `json:"metrics"`
+}
+
+type GetProgressTrendsQuery struct {
+	EntityId   string          `json:"entity_id"`
+	EntityType json.RawMessage `json:"entity_type"`
+	Period     json.RawMessage `json:"period"`
+	Metrics    []string        `json:"metrics"`
+}
+
+type GetSubjectPerformanceQuery struct {
+	SubjectId     string          `json:"subject_id"`
+	InstitutionId *string         `json:"institution_id,omitempty"`
+	Period        json.RawMessage `json:"period,omitempty"`
+}
+
+type GetCohortAnalysisQuery struct {
+	InstitutionId  string          `json:"institution_id"`
+	CourseId       string          `json:"course_id"`
+	EnrollmentYear uint32          `json:"enrollment_year"`
+	AnalysisType   json.RawMessage `json:"analysis_type"`
+}
+
+type GetPredictiveInsightsQuery struct {
+	StudentId                  string `json:"student_id"`
+	PredictionHorizonSemesters uint32 `json:"prediction_horizon_semesters"`
+}
+
+type SearchStudentsQuery struct {
+	Query      json.RawMessage `json:"query"`
+	Limit      *uint32         `json:"limit,omitempty"`
+	StartAfter *string         `json:"start_after,omitempty"`
+}
+
+type GetAnalyticsSummaryQuery struct {
+	Scope          json.RawMessage `json:"scope"`
+	QuickStatsOnly *bool           `json:"quick_stats_only,omitempty"`
+}
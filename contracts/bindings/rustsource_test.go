@@ -0,0 +1,281 @@
+package bindings_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// rustField is a named field of a struct or struct variant
+type rustField struct {
+	Name string
+	Type string
+}
+
+// rustVariant is a variant of a cw_serde enum, with its serialized name
+type rustVariant struct {
+	Name     string
+	WireName string
+	Fields   []rustField
+	Unit     bool
+}
+
+// rustDefs are the structs and enums declared by the sources of a contract
+type rustDefs struct {
+	Structs map[string][]rustField
+	Enums   map[string][]rustVariant
+}
+
+var rustItemRe = regexp.MustCompile(`pub\s+(struct|enum)\s+(\w+)(?:<[^>{]*>)?\s*\{`)
+
+var serdeRenameRe = regexp.MustCompile(`rename\s*=\s*"([^"]+)"`)
+
+// parseContract parses the structs and enums of every source file of a contract
+func parseContract(dir string) (rustDefs, error) {
+	defs := rustDefs{Structs: map[string][]rustField{}, Enums: map[string][]rustVariant{}}
+	files, err := filepath.Glob(filepath.Join(dir, "src", "*.rs"))
+	if err != nil {
+		return defs, err
+	}
+	for _, file := range files {
+		if strings.Contains(filepath.Base(file), "backup") {
+			continue
+		}
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return defs, err
+		}
+		src := stripComments(string(bz))
+		for _, loc := range rustItemRe.FindAllStringSubmatchIndex(src, -1) {
+			kind, name := src[loc[2]:loc[3]], src[loc[4]:loc[5]]
+			body := src[loc[1] : loc[1]+matchingBrace(src[loc[1]:])]
+			if kind == "struct" {
+				if _, found := defs.Structs[name]; !found {
+					defs.Structs[name] = parseFields(body)
+				}
+			} else if _, found := defs.Enums[name]; !found {
+				defs.Enums[name] = parseVariants(body)
+			}
+		}
+	}
+	return defs, nil
+}
+
+// stripComments removes line and block comments outside string literals
+func stripComments(src string) string {
+	var out strings.Builder
+	inString := false
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case inString:
+			if c == '\\' && i+1 < len(src) {
+				out.WriteByte(c)
+				i++
+				c = src[i]
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return out.String()
+			}
+			i += end + 3
+			continue
+		}
+		if i < len(src) {
+			out.WriteByte(src[i])
+		}
+	}
+	return out.String()
+}
+
+// matchingBrace returns the index of the brace closing an already opened block
+func matchingBrace(src string) int {
+	depth := 1
+	for i, c := range src {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(src)
+}
+
+// splitTopLevel splits on commas outside of generics, tuples and blocks
+func splitTopLevel(src string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range src {
+		switch c {
+		case '<', '(', '[', '{':
+			depth++
+		case '>', ')', ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, src[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, src[start:])
+}
+
+// takeAttributes strips the leading #[...] attributes of an item, returning
+// the serde rename among them if any
+func takeAttributes(item string) (string, string) {
+	rename := ""
+	item = strings.TrimSpace(item)
+	for strings.HasPrefix(item, "#[") {
+		depth, end := 0, len(item)
+		for i, c := range item {
+			if c == '[' {
+				depth++
+			} else if c == ']' {
+				depth--
+				if depth == 0 {
+					end = i + 1
+					break
+				}
+			}
+		}
+		attr := item[:end]
+		if strings.Contains(attr, "serde") {
+			if m := serdeRenameRe.FindStringSubmatch(attr); m != nil {
+				rename = m[1]
+			}
+		}
+		item = strings.TrimSpace(item[end:])
+	}
+	return item, rename
+}
+
+func parseFields(body string) []rustField {
+	var fields []rustField
+	for _, item := range splitTopLevel(body) {
+		item, rename := takeAttributes(item)
+		item = strings.TrimSpace(strings.TrimPrefix(item, "pub "))
+		name, ty, found := strings.Cut(item, ":")
+		if !found {
+			continue
+		}
+		field := rustField{Name: strings.TrimSpace(name), Type: strings.Join(strings.Fields(ty), "")}
+		if rename != "" {
+			field.Name = rename
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func parseVariants(body string) []rustVariant {
+	var variants []rustVariant
+	for _, item := range splitTopLevel(body) {
+		item, rename := takeAttributes(item)
+		if item == "" {
+			continue
+		}
+		name := item
+		variant := rustVariant{Unit: true}
+		if i := strings.IndexAny(item, "{("); i >= 0 {
+			name = item[:i]
+			variant.Unit = false
+			if item[i] == '{' {
+				variant.Fields = parseFields(item[i+1 : strings.LastIndex(item, "}")])
+			}
+		}
+		variant.Name = strings.TrimSpace(name)
+		variant.WireName = rename
+		if rename == "" {
+			variant.WireName = snakeCase(variant.Name)
+		}
+		variants = append(variants, variant)
+	}
+	return variants
+}
+
+// snakeCase converts a variant name the way serde's rename_all = "snake_case"
+// does, which splits every capital letter
+func snakeCase(name string) string {
+	var out strings.Builder
+	for i, c := range name {
+		if unicode.IsUpper(c) {
+			if i > 0 {
+				out.WriteByte('_')
+			}
+			out.WriteRune(unicode.ToLower(c))
+		} else {
+			out.WriteRune(c)
+		}
+	}
+	return out.String()
+}
+
+// optionInner returns the type wrapped by an Option
+func optionInner(ty string) (string, bool) {
+	if strings.HasPrefix(ty, "Option<") && strings.HasSuffix(ty, ">") {
+		return ty[len("Option<") : len(ty)-1], true
+	}
+	return ty, false
+}
+
+// jsonKind returns the JSON kind a Rust type serializes to, or "any" when it
+// cannot be told from the sources
+func (d rustDefs) jsonKind(ty string) string {
+	ty, _ = optionInner(ty)
+	if strings.HasPrefix(ty, "Vec<") || strings.HasPrefix(ty, "(") || strings.HasPrefix(ty, "[") {
+		return "array"
+	}
+	if strings.HasPrefix(ty, "HashMap<") || strings.HasPrefix(ty, "BTreeMap<") {
+		return "object"
+	}
+	if i := strings.LastIndex(ty, "::"); i >= 0 {
+		ty = ty[i+2:]
+	}
+	switch ty {
+	case "String", "Addr", "Uint128", "Uint64", "Timestamp", "Decimal":
+		return "string"
+	case "u8", "u16", "u32", "u64", "i32", "i64", "f32", "f64":
+		return "number"
+	case "bool":
+		return "bool"
+	}
+	if _, found := d.Structs[ty]; found {
+		return "object"
+	}
+	if variants, found := d.Enums[ty]; found {
+		for _, variant := range variants {
+			if !variant.Unit {
+				return "any"
+			}
+		}
+		return "string"
+	}
+	return "any"
+}
+
+// namedType returns the struct name nested in a field type, like
+// PrerequisiteGroup for Vec<PrerequisiteGroup>
+func namedType(ty string) string {
+	ty, _ = optionInner(ty)
+	ty = strings.TrimSuffix(strings.TrimPrefix(ty, "Vec<"), ">")
+	if i := strings.LastIndex(ty, "::"); i >= 0 {
+		ty = ty[i+2:]
+	}
+	return ty
+}
@@ -0,0 +1,220 @@
+// Package schedule holds the Go bindings of contracts/schedule.
+package schedule
+
+import "encoding/json"
+
+// ExecuteMsg is the execute message of the schedule contract
+type ExecuteMsg struct {
+	UpdateConfig                       *UpdateConfigMsg                       `json:"update_config,omitempty"`
+	RegisterStudentProgress            *RegisterStudentProgressMsg            `json:"register_student_progress,omitempty"`
+	UpdateStudentPreferences           *UpdateStudentPreferencesMsg           `json:"update_student_preferences,omitempty"`
+	RegisterSubjectScheduleInfo        *RegisterSubjectScheduleInfoMsg        `json:"register_subject_schedule_info,omitempty"`
+	BatchRegisterSubjects              *BatchRegisterSubjectsMsg              `json:"batch_register_subjects,omitempty"`
+	GenerateScheduleRecommendation     *GenerateScheduleRecommendationMsg     `json:"generate_schedule_recommendation,omitempty"`
+	CreateAcademicPath                 *CreateAcademicPathMsg                 `json:"create_academic_path,omitempty"`
+	OptimizeAcademicPath               *OptimizeAcademicPathMsg               `json:"optimize_academic_path,omitempty"`
+	UpdateAcademicPath                 *UpdateAcademicPathMsg                 `json:"update_academic_path,omitempty"`
+	ActivateAcademicPath               *ActivateAcademicPathMsg               `json:"activate_academic_path,omitempty"`
+	CompleteSubject                    *CompleteSubjectMsg                    `json:"complete_subject,omitempty"`
+	EnrollInSubject                    *EnrollInSubjectMsg                    `json:"enroll_in_subject,omitempty"`
+	CacheIpfsContent                   *CacheIpfsContentMsg                   `json:"cache_ipfs_content,omitempty"`
+	GenerateAlternativeRecommendations *GenerateAlternativeRecommendationsMsg `json:"generate_alternative_recommendations,omitempty"`
+	SimulateSchedule                   *SimulateScheduleMsg                   `json:"simulate_schedule,omitempty"`
+}
+
+type UpdateConfigMsg struct {
+	IpfsGateway             *string         `json:"ipfs_gateway,omitempty"`
+	MaxSubjectsPerSemester  *uint32         `json:"max_subjects_per_semester,omitempty"`
+	RecommendationAlgorithm json.RawMessage `json:"recommendation_algorithm,omitempty"`
+	NewOwner                *string         `json:"new_owner,omitempty"`
+}
+
+type RegisterStudentProgressMsg struct {
+	StudentProgress json.RawMessage `json:"student_progress"`
+}
+
+type UpdateStudentPreferencesMsg struct {
+	StudentId   string          `json:"student_id"`
+	Preferences json.RawMessage `json:"preferences"`
+}
+
+type RegisterSubjectScheduleInfoMsg struct {
+	SubjectInfo json.RawMessage `json:"subject_info"`
+}
+
+type BatchRegisterSubjectsMsg struct {
+	Subjects json.RawMessage `json:"subjects"`
+}
+
+type GenerateScheduleRecommendationMsg struct {
+	StudentId         string          `json:"student_id"`
+	TargetSemester    uint32          `json:"target_semester"`
+	ForceRefresh      *bool           `json:"force_refresh,omitempty"`
+	CustomPreferences json.RawMessage `json:"custom_preferences,omitempty"`
+}
+
+type CreateAcademicPathMsg struct {
+	StudentId                string          `json:"student_id"`
+	PathName                 string          `json:"path_name"`
+	OptimizationCriteria     json.RawMessage `json:"optimization_criteria"`
+	TargetGraduationSemester *uint32         `json:"target_graduation_semester,omitempty"`
+}
+
+type OptimizeAcademicPathMsg struct {
+	PathId                  string          `json:"path_id"`
+	OptimizationCriteria    json.RawMessage `json:"optimization_criteria"`
+	PreserveCurrentSemester *bool           `json:"preserve_current_semester,omitempty"`
+}
+
+type UpdateAcademicPathMsg struct {
+	PathId         string   `json:"path_id"`
+	SemesterNumber uint32   `json:"semester_number"`
+	NewSubjects    []string `json:"new_subjects"`
+	Notes          *string  `json:"notes,omitempty"`
+}
+
+type ActivateAcademicPathMsg struct {
+	PathId string `json:"path_id"`
+}
+
+type CompleteSubjectMsg struct {
+	StudentId        string  `json:"student_id"`
+	SubjectId        string  `json:"subject_id"`
+	Grade            uint32  `json:"grade"`
+	CompletionDate   string  `json:"completion_date"`
+	DifficultyRating *uint32 `json:"difficulty_rating,omitempty"`
+	WorkloadRating   *uint32 `json:"workload_rating,omitempty"`
+	NftTokenId       string  `json:"nft_token_id"`
+}
+
+type EnrollInSubjectMsg struct {
+	StudentId          string `json:"student_id"`
+	SubjectId          string `json:"subject_id"`
+	EnrollmentDate     string `json:"enrollment_date"`
+	ExpectedCompletion string `json:"expected_completion"`
+}
+
+type CacheIpfsContentMsg struct {
+	IpfsLink string          `json:"ipfs_link"`
+	Content  json.RawMessage `json:"content"`
+}
+
+type GenerateAlternativeRecommendationsMsg struct {
+	StudentId        string   `json:"student_id"`
+	TargetSemester   uint32   `json:"target_semester"`
+	ExcludedSubjects []string `json:"excluded_subjects"`
+}
+
+type SimulateScheduleMsg struct {
+	StudentId               string   `json:"student_id"`
+	HypotheticalCompletions []string `json:"hypothetical_completions"`
+	TargetSemester          uint32   `json:"target_semester"`
+}
+
+// QueryMsg is the query message of the schedule contract
+type QueryMsg struct {
+	GetState                  *GetStateQuery                  `json:"get_state,omitempty"`
+	GetConfig                 *GetConfigQuery                 `json:"get_config,omitempty"`
+	GetStudentProgress        *GetStudentProgressQuery        `json:"get_student_progress,omitempty"`
+	GetSubjectScheduleInfo    *GetSubjectScheduleInfoQuery    `json:"get_subject_schedule_info,omitempty"`
+	GetScheduleRecommendation *GetScheduleRecommendationQuery `json:"get_schedule_recommendation,omitempty"`
+	GetAcademicPath           *GetAcademicPathQuery           `json:"get_academic_path,omitempty"`
+	GetStudentPaths           *GetStudentPathsQuery           `json:"get_student_paths,omitempty"`
+	GetAvailableSubjects      *GetAvailableSubjectsQuery      `json:"get_available_subjects,omitempty"`
+	GetOptimalPath            *GetOptimalPathQuery            `json:"get_optimal_path,omitempty"`
+	GetGraduationTimeline     *GetGraduationTimelineQuery     `json:"get_graduation_timeline,omitempty"`
+	GetSubjectSequence        *GetSubjectSequenceQuery        `json:"get_subject_sequence,omitempty"`
+	GetWorkloadAnalysis       *GetWorkloadAnalysisQuery       `json:"get_workload_analysis,omitempty"`
+	GetIpfsCacheStatus        *GetIpfsCacheStatusQuery        `json:"get_ipfs_cache_status,omitempty"`
+	GetCachedContent          *GetCachedContentQuery          `json:"get_cached_content,omitempty"`
+	GetScheduleStatistics     *GetScheduleStatisticsQuery     `json:"get_schedule_statistics,omitempty"`
+}
+
+type GetStateQuery struct{}
+
+type GetConfigQuery struct{}
+
+type GetStudentProgressQuery struct {
+	StudentId string `json:"student_id"`
+}
+
+type GetSubjectScheduleInfoQuery struct {
+	SubjectId string `json:"subject_id"`
+}
+
+type GetScheduleRecommendationQuery struct {
+	StudentId string `json:"student_id"`
+	Semester  uint32 `json:"semester"`
+}
+
+type GetAcademicPathQuery struct {
+	PathId string `json:"path_id"`
+}
+
+type GetStudentPathsQuery struct {
+	StudentId       string `json:"student_id"`
+	IncludeInactive *bool  `json:"include_inactive,omitempty"`
+}
+
+type GetAvailableSubjectsQuery struct {
+	StudentId        string `json:"student_id"`
+	Semester         uint32 `json:"semester"`
+	IncludeElectives *bool  `json:"include_electives,omitempty"`
+}
+
+type GetOptimalPathQuery struct {
+	StudentId string          `json:"student_id"`
+	Criteria  json.RawMessage `json:"criteria"`
+	MaxPaths  *uint32         `json:"max_paths,omitempty"`
+}
+
+// GetGraduationTimelineQuery returns a GraduationTimelineResponse
+type GetGraduationTimelineQuery struct {
+	StudentId string  `json:"student_id"`
+	PathId    *string `json:"path_id,omitempty"`
+}
+
+type GetSubjectSequenceQuery struct {
+	StudentId      string   `json:"student_id"`
+	TargetSubjects []string `json:"target_subjects"`
+}
+
+type GetWorkloadAnalysisQuery struct {
+	StudentId        string   `json:"student_id"`
+	Semester         uint32   `json:"semester"`
+	ProposedSubjects []string `json:"proposed_subjects"`
+}
+
+type GetIpfsCacheStatusQuery struct {
+	IpfsLink string `json:"ipfs_link"`
+}
+
+type GetCachedContentQuery struct {
+	IpfsLink string `json:"ipfs_link"`
+}
+
+type GetScheduleStatisticsQuery struct {
+	StudentId     *string `json:"student_id,omitempty"`
+	InstitutionId *string `json:"institution_id,omitempty"`
+}
+
+type GraduationTimelineResponse struct {
+	CurrentProgressPercentage   string   `json:"current_progress_percentage"`
+	EstimatedGraduationSemester uint32   `json:"estimated_graduation_semester"`
+	EstimatedGraduationDate     string   `json:"estimated_graduation_date"`
+	RemainingCredits            uint32   `json:"remaining_credits"`
+	RemainingSubjects           []string `json:"remaining_subjects"`
+	CriticalPathSubjects        []string `json:"critical_path_subjects"`
+	RiskFactors                 []string `json:"risk_factors"`
+}
+
+type SubjectSequenceResponse struct {
+	RecommendedSequence []SemesterSubjects `json:"recommended_sequence"`
+	TotalSemesters      uint32             `json:"total_semesters"`
+	Notes               []string           `json:"notes"`
+}
+
+type SemesterSubjects struct {
+	Semester uint32   `json:"semester"`
+	Subjects []string `json:"subjects"`
+}
@@ -0,0 +1,22 @@
+use std::env::current_dir;
+use std::fs::create_dir_all;
+
+use cosmwasm_schema::{export_schema, remove_schemas, schema_for};
+
+use equivalence::msg::{ExecuteMsg, InstantiateMsg, QueryMsg};
+use equivalence::state::{State, Equivalence, AnalysisResult, TransferRequest};
+
+fn main() {
+    let mut out_dir = current_dir().unwrap();
+    out_dir.push("schema");
+    create_dir_all(&out_dir).unwrap();
+    remove_schemas(&out_dir).unwrap();
+
+    export_schema(&schema_for!(InstantiateMsg), &out_dir);
+    export_schema(&schema_for!(ExecuteMsg), &out_dir);
+    export_schema(&schema_for!(QueryMsg), &out_dir);
+    export_schema(&schema_for!(State), &out_dir);
+    export_schema(&schema_for!(Equivalence), &out_dir);
+    export_schema(&schema_for!(AnalysisResult), &out_dir);
+    export_schema(&schema_for!(TransferRequest), &out_dir);
+}
@@ -19,9 +19,29 @@ import (
 
 	"academictoken/x/academicnft/keeper"
 	"academictoken/x/academicnft/types"
+	tokendeftypes "academictoken/x/tokendef/types"
 )
 
 func AcademicnftKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return academicnftKeeper(t, nil, nil)
+}
+
+// AcademicnftKeeperWithTokenDefs returns a keeper that resolves the given token definitions
+func AcademicnftKeeperWithTokenDefs(t testing.TB, tokenDefs ...tokendeftypes.TokenDefinition) (keeper.Keeper, sdk.Context) {
+	mockTokenDefKeeper := MockAcademicNFTTokenDefKeeper{TokenDefs: make(map[string]tokendeftypes.TokenDefinition)}
+	for _, tokenDef := range tokenDefs {
+		mockTokenDefKeeper.TokenDefs[tokenDef.Index] = tokenDef
+	}
+	return academicnftKeeper(t, mockTokenDefKeeper, nil)
+}
+
+// AcademicnftKeeperWithInstitution returns a keeper that checks grading roles
+// and signing keys against the given institution keeper
+func AcademicnftKeeperWithInstitution(t testing.TB, institutionKeeper types.InstitutionKeeper) (keeper.Keeper, sdk.Context) {
+	return academicnftKeeper(t, nil, institutionKeeper)
+}
+
+func academicnftKeeper(t testing.TB, tokenDefKeeper types.TokenDefKeeper, institutionKeeper types.InstitutionKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -39,9 +59,9 @@ func AcademicnftKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
-		nil, // tokenDefKeeper - not needed for basic tests
+		tokenDefKeeper,
 		nil, // studentKeeper - not needed for basic tests
-		nil, // institutionKeeper - not needed for basic tests
+		institutionKeeper,
 		nil, // accountKeeper - not needed for basic tests
 		nil, // bankKeeper - not needed for basic tests
 	)
@@ -22,6 +22,11 @@ import (
 )
 
 func CourseKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return CourseKeeperWithInstitution(t, MockCourseInstitutionKeeper{})
+}
+
+// CourseKeeperWithInstitution creates a course keeper checking institutions with the given keeper
+func CourseKeeperWithInstitution(t testing.TB, institutionKeeper types.InstitutionKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -34,7 +39,6 @@ func CourseKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
 	// Use course-specific mocks
-	mockInstitutionKeeper := MockCourseInstitutionKeeper{} // Use course-specific mock
 	mockAccountKeeper := MockAccountKeeper{}
 	mockBankKeeper := MockBankKeeper{}
 
@@ -43,7 +47,7 @@ func CourseKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
-		mockInstitutionKeeper,
+		institutionKeeper,
 		mockAccountKeeper,
 		mockBankKeeper,
 	)
@@ -28,6 +28,12 @@ import (
 )
 
 func CurriculumKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return CurriculumKeeperWithCourses(t, MockCurriculumInstitutionKeeper{}, nil)
+}
+
+// CurriculumKeeperWithCourses creates a curriculum keeper checking roles with
+// the given institution keeper, with courses stored in its course keeper
+func CurriculumKeeperWithCourses(t testing.TB, institutionKeeper types.InstitutionKeeper, courses []coursetypes.Course) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	courseStoreKey := storetypes.NewKVStoreKey(coursetypes.StoreKey)
 	subjectStoreKey := storetypes.NewKVStoreKey(subjecttypes.StoreKey)
@@ -100,8 +106,12 @@ func CurriculumKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		curriculumParamSpace,
 		&courseKeeper, // Use real keeper for curriculum
 		&subjectKeeper,
+		institutionKeeper,
 		authority.String(),
 	)
+	for _, course := range courses {
+		require.NoError(t, courseKeeper.SetCourse(ctx, course))
+	}
 
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
@@ -20,10 +20,13 @@ import (
 
 	"academictoken/x/degree/keeper"
 	"academictoken/x/degree/types"
+	institutiontypes "academictoken/x/institution/types"
 )
 
 // Mock keepers for testing
-type mockStudentKeeper struct{}
+type mockStudentKeeper struct {
+	records map[string]types.StudentRecord
+}
 
 func (m mockStudentKeeper) GetStudent(ctx sdk.Context, id string) (types.Student, bool) {
 	return types.Student{}, true
@@ -53,8 +56,17 @@ func (m mockStudentKeeper) GetAcademicTreeByStudent(ctx sdk.Context, studentId s
 func (m mockStudentKeeper) GetContractIntegration() interface{} {
 	return struct{}{}
 }
+func (m mockStudentKeeper) GetStudentRecord(ctx sdk.Context, studentId string) (types.StudentRecord, bool) {
+	record, found := m.records[studentId]
+	return record, found
+}
+func (m mockStudentKeeper) GetIntegrationMode(ctx sdk.Context) string {
+	return types.IntegrationModeNative
+}
 
-type mockCurriculumKeeper struct{}
+type mockCurriculumKeeper struct {
+	curricula map[string]types.CurriculumRequirements
+}
 
 func (m mockCurriculumKeeper) GetCurriculum(ctx sdk.Context, id string) (types.Curriculum, bool) {
 	return types.Curriculum{}, true
@@ -74,6 +86,18 @@ func (m mockCurriculumKeeper) GetCurriculumsByInstitution(ctx sdk.Context, insti
 func (m mockCurriculumKeeper) GetCurriculumTree(ctx sdk.Context, id string) (interface{}, bool) {
 	return struct{}{}, true
 }
+func (m mockCurriculumKeeper) GetCurriculumRequirements(ctx sdk.Context, id string) (types.CurriculumRequirements, bool) {
+	curriculum, found := m.curricula[id]
+	return curriculum, found
+}
+func (m mockCurriculumKeeper) GetCurriculumVersion(ctx sdk.Context, courseId string, version string) (types.CurriculumRequirements, bool) {
+	for _, curriculum := range m.curricula {
+		if curriculum.CourseId == courseId && curriculum.Version == version {
+			return curriculum, true
+		}
+	}
+	return types.CurriculumRequirements{}, false
+}
 
 type mockAcademicNFTKeeper struct{}
 
@@ -103,10 +127,13 @@ func (m mockWasmKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress,
 	return []byte("{}"), nil
 }
 
-type mockInstitutionKeeper struct{}
+type mockInstitutionKeeper struct {
+	signingKeys []institutiontypes.SigningKey
+}
 
 func (m mockInstitutionKeeper) GetInstitution(ctx sdk.Context, id string) (interface{}, bool) {
-	return struct{}{}, true
+	// Same shape as the app adapter
+	return map[string]interface{}{"id": id, "name": "Institution " + id, "is_authorized": true}, true
 }
 func (m mockInstitutionKeeper) ValidateInstitutionAuthorization(ctx sdk.Context, institutionId string) error {
 	return nil
@@ -114,8 +141,40 @@ func (m mockInstitutionKeeper) ValidateInstitutionAuthorization(ctx sdk.Context,
 func (m mockInstitutionKeeper) IsAuthorizedToIssueDegrees(ctx sdk.Context, institutionId string) bool {
 	return true
 }
+func (m mockInstitutionKeeper) CanIssueDegree(ctx sdk.Context, institutionId string, address string, courseId string) bool {
+	return true
+}
+func (m mockInstitutionKeeper) GetInstitutionSigningKeys(ctx sdk.Context, institution string) []institutiontypes.SigningKey {
+	var keys []institutiontypes.SigningKey
+	for _, key := range m.signingKeys {
+		if key.Institution == institution {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+func (m mockInstitutionKeeper) GetSigningKey(ctx sdk.Context, institution string, keyId string) (institutiontypes.SigningKey, bool) {
+	for _, key := range m.signingKeys {
+		if key.Institution == institution && key.KeyId == keyId {
+			return key, true
+		}
+	}
+	return institutiontypes.SigningKey{}, false
+}
 
 func DegreeKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return DegreeKeeperWithRecords(t, nil, nil)
+}
+
+// DegreeKeeperWithRecords returns a degree keeper validating against the
+// given student records and curricula, both keyed by ID
+func DegreeKeeperWithRecords(t testing.TB, records map[string]types.StudentRecord, curricula map[string]types.CurriculumRequirements) (*keeper.Keeper, sdk.Context) {
+	return DegreeKeeperWithSigningKeys(t, records, curricula, nil)
+}
+
+// DegreeKeeperWithSigningKeys returns a degree keeper whose institutions
+// registered the given signing keys
+func DegreeKeeperWithSigningKeys(t testing.TB, records map[string]types.StudentRecord, curricula map[string]types.CurriculumRequirements, signingKeys []institutiontypes.SigningKey) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -142,11 +201,11 @@ func DegreeKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
-		mockStudentKeeper{},
-		mockCurriculumKeeper{},
+		mockStudentKeeper{records: records},
+		mockCurriculumKeeper{curricula: curricula},
 		mockAcademicNFTKeeper{},
 		mockWasmKeeper{},
-		mockInstitutionKeeper{},
+		mockInstitutionKeeper{signingKeys: signingKeys},
 		authority.String(),
 	)
 
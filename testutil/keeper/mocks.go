@@ -2,9 +2,15 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
+	"time"
 
 	"academictoken/x/course/types"
+	curriculumkeeper "academictoken/x/curriculum/keeper"
+	curriculumtypes "academictoken/x/curriculum/types"
 	degreetype "academictoken/x/degree/types"
 	institutiontypes "academictoken/x/institution/types"
 	scheduletypes "academictoken/x/schedule/types"
@@ -30,13 +36,19 @@ func (m MockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress)
 	return sdk.NewCoins() // Return empty coins for testing
 }
 
-// MockWasmMsgServer implements the WasmMsgServer interface for testing
+// MockWasmMsgServer implements the WasmMsgServer interface for testing. Degree
+// validations are answered with every requirement met.
 type MockWasmMsgServer struct{}
 
 func (m MockWasmMsgServer) ExecuteContract(ctx context.Context, req *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request is nil")
 	}
+	if mockContractCall(req.Msg) == "validate_degree_requirements" {
+		return &wasmtypes.MsgExecuteContractResponse{
+			Data: []byte(`{"is_valid":true,"message":"Mock degree validation","degree_type":"bachelor","curriculum_version":"v1.0","validation_hash":"mock-validation","requirements_met":["required_subjects"],"missing_requirements":[]}`),
+		}, nil
+	}
 	// Return mock successful execution
 	return &wasmtypes.MsgExecuteContractResponse{
 		Data: []byte(`{"success": true, "message": "Mock contract execution successful"}`),
@@ -48,12 +60,33 @@ type MockWasmQuerier struct{}
 
 // SmartContractState mocks a smart contract state query
 func (q MockWasmQuerier) SmartContractState(ctx context.Context, req *wasmtypes.QuerySmartContractStateRequest) (*wasmtypes.QuerySmartContractStateResponse, error) {
+	switch mockContractCall(req.QueryData) {
+	case "get_missing_requirements":
+		return &wasmtypes.QuerySmartContractStateResponse{Data: []byte(`[]`)}, nil
+	case "check_eligibility":
+		return &wasmtypes.QuerySmartContractStateResponse{
+			Data: []byte(`{"can_enroll":true,"missing_prerequisites":[]}`),
+		}, nil
+	}
 	// Return mock data for tests
 	return &wasmtypes.QuerySmartContractStateResponse{
 		Data: []byte(`{"is_eligible":true,"missing_prerequisites":[]}`),
 	}, nil
 }
 
+// mockContractCall returns the variant of a contract message, the single key
+// of its JSON object
+func mockContractCall(msg []byte) string {
+	var variant map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &variant); err != nil || len(variant) != 1 {
+		return ""
+	}
+	for name := range variant {
+		return name
+	}
+	return ""
+}
+
 // MockSubjectKeeper implements tokendefTypes.SubjectKeeper for testing
 type MockSubjectKeeper struct{}
 
@@ -331,6 +364,18 @@ func (m MockDegreeInstitutionKeeper) IsAuthorizedToIssueDegrees(ctx sdk.Context,
 	return institutionId != ""
 }
 
+func (m MockDegreeInstitutionKeeper) CanIssueDegree(ctx sdk.Context, institutionId string, address string, courseId string) bool {
+	return institutionId != "" && address != ""
+}
+
+func (m MockDegreeInstitutionKeeper) GetInstitutionSigningKeys(ctx sdk.Context, institution string) []institutiontypes.SigningKey {
+	return nil
+}
+
+func (m MockDegreeInstitutionKeeper) GetSigningKey(ctx sdk.Context, institution string, keyId string) (institutiontypes.SigningKey, bool) {
+	return institutiontypes.SigningKey{}, false
+}
+
 // For other modules that need institutiontypes.Institution
 func (m MockInstitutionKeeper) GetInstitutionOriginal(ctx sdk.Context, institutionID string) (institutiontypes.Institution, bool) {
 	return institutiontypes.Institution{
@@ -403,8 +448,11 @@ func (m MockSubjectKeeper) GetSubjectOriginal(ctx sdk.Context, subjectId string)
 	}, true
 }
 
-// MockCourseInstitutionKeeper implements course module's InstitutionKeeper interface
-type MockCourseInstitutionKeeper struct{}
+// MockCourseInstitutionKeeper implements course module's InstitutionKeeper
+// interface. Unaccredited lists the institutions whose accreditation lapsed.
+type MockCourseInstitutionKeeper struct {
+	Unaccredited map[string]bool
+}
 
 func (m MockCourseInstitutionKeeper) GetInstitution(ctx sdk.Context, institutionID string) (institutiontypes.Institution, bool) {
 	return institutiontypes.Institution{
@@ -417,7 +465,7 @@ func (m MockCourseInstitutionKeeper) GetInstitution(ctx sdk.Context, institution
 }
 
 func (m MockCourseInstitutionKeeper) IsInstitutionAuthorized(ctx sdk.Context, institutionID string) bool {
-	return institutionID != ""
+	return institutionID != "" && !m.Unaccredited[institutionID]
 }
 
 func (m MockCourseInstitutionKeeper) InstitutionExists(ctx sdk.Context, institutionID string) bool {
@@ -436,8 +484,24 @@ func (m MockCourseInstitutionKeeper) GetAuthorizedInstitutions(ctx sdk.Context)
 	}
 }
 
-// MockSubjectInstitutionKeeper implements subject module's InstitutionKeeper interface
-type MockSubjectInstitutionKeeper struct{}
+// MockCurriculumInstitutionKeeper implements curriculum module's
+// InstitutionKeeper interface. Managers lists the accounts allowed to manage
+// curricula of every course.
+type MockCurriculumInstitutionKeeper struct {
+	Managers map[string]bool
+}
+
+func (m MockCurriculumInstitutionKeeper) CanManageCurriculum(ctx sdk.Context, institution string, address string, courseId string) bool {
+	return m.Managers[address]
+}
+
+// MockSubjectInstitutionKeeper implements subject module's InstitutionKeeper interface.
+// Lapsed lists the institutions whose accreditation has lapsed and
+// CurriculumManagers the addresses managing the curricula of each institution.
+type MockSubjectInstitutionKeeper struct {
+	Lapsed             []string
+	CurriculumManagers map[string][]string
+}
 
 func (m MockSubjectInstitutionKeeper) GetInstitution(ctx sdk.Context, institutionID string) (institutiontypes.Institution, bool) {
 	return institutiontypes.Institution{
@@ -450,7 +514,11 @@ func (m MockSubjectInstitutionKeeper) GetInstitution(ctx sdk.Context, institutio
 }
 
 func (m MockSubjectInstitutionKeeper) IsInstitutionAuthorized(ctx sdk.Context, institutionID string) bool {
-	return institutionID != ""
+	return institutionID != "" && !slices.Contains(m.Lapsed, institutionID)
+}
+
+func (m MockSubjectInstitutionKeeper) CanManageCurriculum(ctx sdk.Context, institution string, address string, courseId string) bool {
+	return slices.Contains(m.CurriculumManagers[institution], address)
 }
 
 func (m MockSubjectInstitutionKeeper) InstitutionExists(ctx sdk.Context, institutionID string) bool {
@@ -487,13 +555,21 @@ func (m MockSubjectCourseKeeper) HasCourse(ctx sdk.Context, courseId string) boo
 // MOCKS ESPECÍFICOS PARA O MÓDULO STUDENT (APENAS ADICIONADOS)
 // ============================================================================
 
-// MockStudentCurriculumKeeper implements student module's CurriculumKeeper interface
-type MockStudentCurriculumKeeper struct{}
+// MockStudentCurriculumKeeper implements student module's CurriculumKeeper interface.
+// Curricula, when set, replaces the default curriculum returned for any course.
+type MockStudentCurriculumKeeper struct {
+	Curricula []studenttypes.CurriculumTree
+}
 
 func (m MockStudentCurriculumKeeper) GetCurriculumTree(ctx context.Context, curriculumId string) (studenttypes.CurriculumTree, bool) {
 	if curriculumId == "" {
 		return studenttypes.CurriculumTree{}, false
 	}
+	for _, curriculum := range m.Curricula {
+		if curriculum.Index == curriculumId {
+			return curriculum, true
+		}
+	}
 	return studenttypes.CurriculumTree{
 		Index:              curriculumId,
 		CourseId:           "course-1",
@@ -510,6 +586,7 @@ func (m MockStudentCurriculumKeeper) GetCurriculumTree(ctx context.Context, curr
 			MinimumTimeYears:        4.0,
 			MaximumTimeYears:        8.0,
 		},
+		Published: true,
 	}, true
 }
 
@@ -517,6 +594,15 @@ func (m MockStudentCurriculumKeeper) GetCurriculumTreesByCourse(ctx context.Cont
 	if courseId == "" {
 		return []studenttypes.CurriculumTree{}
 	}
+	if m.Curricula != nil {
+		var curricula []studenttypes.CurriculumTree
+		for _, curriculum := range m.Curricula {
+			if curriculum.CourseId == courseId {
+				curricula = append(curricula, curriculum)
+			}
+		}
+		return curricula
+	}
 	return []studenttypes.CurriculumTree{
 		{
 			Index:              "curriculum-1",
@@ -534,14 +620,71 @@ func (m MockStudentCurriculumKeeper) GetCurriculumTreesByCourse(ctx context.Cont
 				MinimumTimeYears:        4.0,
 				MaximumTimeYears:        8.0,
 			},
+			Published: true,
 		},
 	}
 }
 
-// MockStudentSubjectKeeper implements student module's SubjectKeeper interface
-type MockStudentSubjectKeeper struct{}
+func (m MockStudentCurriculumKeeper) GetLatestPublishedCurriculum(ctx context.Context, courseId string) (latest studenttypes.CurriculumTree, found bool) {
+	for _, curriculum := range m.GetCurriculumTreesByCourse(ctx, courseId) {
+		if curriculum.Published && (!found || m.PublishedAfter(curriculum, latest)) {
+			latest, found = curriculum, true
+		}
+	}
+	return latest, found
+}
+
+func (m MockStudentCurriculumKeeper) PublishedAfter(a, b studenttypes.CurriculumTree) bool {
+	return curriculumkeeper.PublishedAfter(
+		curriculumtypes.CurriculumTree{Index: a.Index, PublishedAt: a.PublishedAt},
+		curriculumtypes.CurriculumTree{Index: b.Index, PublishedAt: b.PublishedAt},
+	)
+}
+
+// MockStudentDegreeKeeper implements student module's DegreeKeeper interface,
+// returning the evaluation of Evaluations for each student
+type MockStudentDegreeKeeper struct {
+	Evaluations map[string]studenttypes.GraduationEvaluation
+}
+
+func (m MockStudentDegreeKeeper) EvaluateGraduation(ctx sdk.Context, studentId string, curriculumId string) (studenttypes.GraduationEvaluation, error) {
+	evaluation, found := m.Evaluations[studentId]
+	if !found {
+		return studenttypes.GraduationEvaluation{}, studenttypes.ErrCurriculumNotFound.Wrapf("no curriculum for student '%s'", studentId)
+	}
+	return evaluation, nil
+}
+
+// MockStudentEquivalenceKeeper implements student module's EquivalenceKeeper
+// interface, returning the equivalences of Equivalences by source and target
+type MockStudentEquivalenceKeeper struct {
+	Equivalences []studenttypes.SubjectEquivalence
+}
+
+func (m MockStudentEquivalenceKeeper) GetSubjectEquivalence(ctx sdk.Context, sourceSubjectId string, targetSubjectId string) (studenttypes.SubjectEquivalence, bool) {
+	for _, equivalence := range m.Equivalences {
+		if equivalence.SourceSubjectId == sourceSubjectId && equivalence.TargetSubjectId == targetSubjectId {
+			return equivalence, true
+		}
+	}
+	return studenttypes.SubjectEquivalence{}, false
+}
+
+// MockStudentSubjectKeeper implements student module's SubjectKeeper interface.
+// Subjects, when set, replaces the default subject returned for any index.
+// MissingPrerequisites lists the unmet prerequisites of each subject and
+// PrerequisitesErr makes every prerequisite evaluation fail.
+type MockStudentSubjectKeeper struct {
+	Subjects             map[string]studenttypes.SubjectContent
+	MissingPrerequisites map[string][]string
+	PrerequisitesErr     error
+}
 
 func (m MockStudentSubjectKeeper) GetSubject(ctx sdk.Context, index string) (studenttypes.SubjectContent, bool) {
+	if m.Subjects != nil {
+		subject, found := m.Subjects[index]
+		return subject, found
+	}
 	if index == "" {
 		return studenttypes.SubjectContent{}, false
 	}
@@ -573,10 +716,35 @@ func (m MockStudentSubjectKeeper) CheckEquivalenceViaContract(ctx sdk.Context, s
 	return 85, "High similarity", nil
 }
 
-// MockStudentTokenDefKeeper implements student module's TokenDefKeeper interface
-type MockStudentTokenDefKeeper struct{}
+func (m MockStudentSubjectKeeper) EvaluatePrerequisites(ctx sdk.Context, studentId string, subjectId string) (bool, []string, error) {
+	if m.PrerequisitesErr != nil {
+		return false, nil, m.PrerequisitesErr
+	}
+	missing := m.MissingPrerequisites[subjectId]
+	return len(missing) == 0, missing, nil
+}
+
+// MockSubjectStudentKeeper implements subject module's StudentKeeper interface
+type MockSubjectStudentKeeper struct {
+	Trees map[string]subjecttypes.StudentAcademicTree
+}
+
+func (m MockSubjectStudentKeeper) GetAcademicTree(ctx sdk.Context, studentId string) (subjecttypes.StudentAcademicTree, bool) {
+	tree, found := m.Trees[studentId]
+	return tree, found
+}
+
+// MockStudentTokenDefKeeper implements student module's TokenDefKeeper interface.
+// TokenDefs, when set, replaces the default token definition returned for any index.
+type MockStudentTokenDefKeeper struct {
+	TokenDefs map[string]studenttypes.TokenDefinition
+}
 
 func (m MockStudentTokenDefKeeper) GetTokenDefinitionByIndex(ctx sdk.Context, index string) (studenttypes.TokenDefinition, bool) {
+	if m.TokenDefs != nil {
+		tokenDef, found := m.TokenDefs[index]
+		return tokenDef, found
+	}
 	if index == "" {
 		return studenttypes.TokenDefinition{}, false
 	}
@@ -618,8 +786,74 @@ func (m MockStudentTokenDefKeeper) GetTokenDefinitionsBySubject(ctx sdk.Context,
 	}
 }
 
-// MockStudentAcademicNFTKeeper implements student module's AcademicNFTKeeper interface
-type MockStudentAcademicNFTKeeper struct{}
+// MockAcademicNFTTokenDefKeeper implements academicnft module's TokenDefKeeper interface
+type MockAcademicNFTTokenDefKeeper struct {
+	TokenDefs map[string]tokendefTypes.TokenDefinition
+}
+
+func (m MockAcademicNFTTokenDefKeeper) GetTokenDefinitionByIndex(ctx sdk.Context, index string) (tokendefTypes.TokenDefinition, bool) {
+	tokenDef, found := m.TokenDefs[index]
+	return tokenDef, found
+}
+
+// MockAcademicNFTInstitutionKeeper implements academicnft module's InstitutionKeeper
+// interface. Every institution exists and uses the default grading scale;
+// Graders lists the accounts holding a grading role.
+type MockAcademicNFTInstitutionKeeper struct {
+	Graders     map[string]bool
+	SigningKeys map[string]institutiontypes.SigningKey // by key ID
+}
+
+func (m MockAcademicNFTInstitutionKeeper) GetInstitution(ctx sdk.Context, index string) (institutiontypes.Institution, bool) {
+	return institutiontypes.Institution{Index: index, IsAuthorized: "true"}, true
+}
+
+func (m MockAcademicNFTInstitutionKeeper) IsInstitutionAuthorized(ctx sdk.Context, index string) bool {
+	return true
+}
+
+func (m MockAcademicNFTInstitutionKeeper) CanIssueGrade(ctx sdk.Context, institution string, address string, courseId string, subjectId string) bool {
+	return m.Graders[address]
+}
+
+func (m MockAcademicNFTInstitutionKeeper) IsInstitutionAdmin(ctx sdk.Context, institution string, address string) bool {
+	return false
+}
+
+func (m MockAcademicNFTInstitutionKeeper) ValidateGradeSubmission(ctx sdk.Context, institution string, termId string) error {
+	return nil
+}
+
+func (m MockAcademicNFTInstitutionKeeper) NormalizeGrade(ctx sdk.Context, institution string, grade string) (institutiontypes.NormalizedGrade, error) {
+	return institutiontypes.DefaultGradingScale(institution).Normalize(grade)
+}
+
+func (m MockAcademicNFTInstitutionKeeper) GetInstitutionSigningKeys(ctx sdk.Context, institution string) []institutiontypes.SigningKey {
+	keyIds := make([]string, 0, len(m.SigningKeys))
+	for keyId := range m.SigningKeys {
+		keyIds = append(keyIds, keyId)
+	}
+	sort.Strings(keyIds)
+
+	var keys []institutiontypes.SigningKey
+	for _, keyId := range keyIds {
+		if key := m.SigningKeys[keyId]; key.Institution == institution {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (m MockAcademicNFTInstitutionKeeper) GetSigningKey(ctx sdk.Context, institution string, keyId string) (institutiontypes.SigningKey, bool) {
+	key, found := m.SigningKeys[keyId]
+	return key, found && key.Institution == institution
+}
+
+// MockStudentAcademicNFTKeeper implements student module's AcademicNFTKeeper interface.
+// Tokens, when set, holds the token instances of each student address.
+type MockStudentAcademicNFTKeeper struct {
+	Tokens map[string][]studenttypes.SubjectTokenInstance
+}
 
 func (m MockStudentAcademicNFTKeeper) GetSubjectTokenInstance(ctx sdk.Context, tokenInstanceId string) (studenttypes.SubjectTokenInstance, bool) {
 	if tokenInstanceId == "" {
@@ -639,7 +873,54 @@ func (m MockStudentAcademicNFTKeeper) GetSubjectTokenInstance(ctx sdk.Context, t
 	}, true
 }
 
+// MintSubjectToken records the minted token in Tokens when it is set
+func (m MockStudentAcademicNFTKeeper) MintSubjectToken(ctx sdk.Context, tokenDefId string, student string, completionDate string, grade string, issuerInstitution string, semester string, professorSignature string) (string, error) {
+	tokenInstanceId := fmt.Sprintf("token-%s-%s", tokenDefId, student)
+	if m.Tokens != nil {
+		m.Tokens[student] = append(m.Tokens[student], studenttypes.SubjectTokenInstance{
+			TokenInstanceId:   tokenInstanceId,
+			TokenDefId:        tokenDefId,
+			Student:           student,
+			CompletionDate:    completionDate,
+			Grade:             grade,
+			IssuerInstitution: issuerInstitution,
+			Semester:          semester,
+			IsValid:           true,
+		})
+	}
+	return tokenInstanceId, nil
+}
+
+// MintTransferCreditToken records the minted token in Tokens when it is set
+func (m MockStudentAcademicNFTKeeper) MintTransferCreditToken(ctx sdk.Context, tokenDefId string, studentAddress string, issuerInstitution string, equivalenceId string) (string, error) {
+	tokenInstanceId := fmt.Sprintf("transfer-%s", equivalenceId)
+	if m.Tokens != nil {
+		m.Tokens[studentAddress] = append(m.Tokens[studentAddress], studenttypes.SubjectTokenInstance{
+			TokenInstanceId:   tokenInstanceId,
+			TokenDefId:        tokenDefId,
+			Student:           studentAddress,
+			IssuerInstitution: issuerInstitution,
+			IsValid:           true,
+			TransferCredit:    true,
+		})
+	}
+	return tokenInstanceId, nil
+}
+
+// GetStudentTokenForTokenDef looks the token of the definition up in Tokens
+func (m MockStudentAcademicNFTKeeper) GetStudentTokenForTokenDef(ctx sdk.Context, studentAddress string, tokenDefId string) (string, bool) {
+	for _, token := range m.Tokens[studentAddress] {
+		if token.TokenDefId == tokenDefId {
+			return token.TokenInstanceId, true
+		}
+	}
+	return "", false
+}
+
 func (m MockStudentAcademicNFTKeeper) GetStudentTokenInstances(ctx sdk.Context, studentAddress string) ([]studenttypes.SubjectTokenInstance, error) {
+	if m.Tokens != nil {
+		return m.Tokens[studentAddress], nil
+	}
 	if studentAddress == "" {
 		return []studenttypes.SubjectTokenInstance{}, nil
 	}
@@ -678,6 +959,57 @@ func (m MockStudentInstitutionKeeper) IsInstitutionAuthorized(ctx sdk.Context, i
 	return institutionIndex != ""
 }
 
+func (m MockStudentInstitutionKeeper) CanIssueGrade(ctx sdk.Context, institutionIndex string, address string, courseId string, subjectId string) bool {
+	return institutionIndex != "" && address != ""
+}
+
+func (m MockStudentInstitutionKeeper) CanManageEnrollment(ctx sdk.Context, institutionIndex string, address string, courseId string) bool {
+	return institutionIndex != "" && address != ""
+}
+
+func (m MockStudentInstitutionKeeper) CanGrantPrerequisiteWaiver(ctx sdk.Context, institutionIndex string, address string, courseId string) bool {
+	return institutionIndex != "" && address != ""
+}
+
+func (m MockStudentInstitutionKeeper) ValidateAcademicTerm(ctx sdk.Context, institutionIndex string, termId string) error {
+	return nil
+}
+
+func (m MockStudentInstitutionKeeper) ValidateGradeSubmission(ctx sdk.Context, institutionIndex string, termId string) error {
+	return nil
+}
+
+// GetAcademicTerm returns a spring term whose enrollment runs through January
+func (m MockStudentInstitutionKeeper) GetAcademicTerm(ctx sdk.Context, institutionIndex string, termId string) (studenttypes.AcademicTerm, bool) {
+	if institutionIndex == "" || termId == "" {
+		return studenttypes.AcademicTerm{}, false
+	}
+	return studenttypes.AcademicTerm{
+		Institution:     institutionIndex,
+		TermId:          termId,
+		StartDate:       time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC),
+		EnrollmentStart: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EnrollmentEnd:   time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC),
+	}, true
+}
+
+// NormalizeGrade validates grades against the default 0-100 grading scale
+func (m MockStudentInstitutionKeeper) NormalizeGrade(ctx sdk.Context, institutionIndex string, grade string) (studenttypes.NormalizedGrade, error) {
+	normalized, err := institutiontypes.DefaultGradingScale(institutionIndex).Normalize(grade)
+	if err != nil {
+		return studenttypes.NormalizedGrade{}, err
+	}
+	return studenttypes.NormalizedGrade{
+		Value:           normalized.Value,
+		Score:           normalized.Score,
+		Letter:          normalized.Letter,
+		GpaPoints:       normalized.GpaPoints,
+		Passing:         normalized.Passing,
+		CountsTowardGpa: normalized.CountsTowardGpa,
+	}, nil
+}
+
 // MockStudentCourseKeeper implements student module's CourseKeeper interface
 type MockStudentCourseKeeper struct{}
 
@@ -810,6 +1142,10 @@ func (m MockScheduleStudentKeeper) GetInProgressSubjects(ctx sdk.Context, studen
 	return []string{"subject-in-progress-1", "subject-in-progress-2"}
 }
 
+func (m MockScheduleStudentKeeper) ValidateStudentTerm(ctx sdk.Context, studentID string, termID string) error {
+	return nil
+}
+
 // MockScheduleCurriculumKeeper implements schedule module's CurriculumKeeper interface
 type MockScheduleCurriculumKeeper struct{}
 
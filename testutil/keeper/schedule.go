@@ -22,6 +22,11 @@ import (
 )
 
 func ScheduleKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return ScheduleKeeperWithKeepers(t, MockScheduleSubjectKeeper{}, MockScheduleStudentKeeper{}, MockScheduleCurriculumKeeper{})
+}
+
+// ScheduleKeeperWithKeepers returns a schedule keeper backed by the given subject, student and curriculum keepers
+func ScheduleKeeperWithKeepers(t testing.TB, subjectKeeper types.SubjectKeeper, studentKeeper types.StudentKeeper, curriculumKeeper types.CurriculumKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -37,10 +42,9 @@ func ScheduleKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		// Use the existing mocks from mocks.go file
-		MockScheduleSubjectKeeper{},
-		MockScheduleStudentKeeper{},
-		MockScheduleCurriculumKeeper{},
+		subjectKeeper,
+		studentKeeper,
+		curriculumKeeper,
 		MockAccountKeeper{},
 		MockBankKeeper{},
 	)
@@ -22,6 +22,21 @@ import (
 )
 
 func StudentKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return studentKeeper(t, MockStudentSubjectKeeper{}, MockStudentTokenDefKeeper{}, MockStudentAcademicNFTKeeper{}, MockStudentCurriculumKeeper{})
+}
+
+// StudentKeeperWithCurricula returns a keeper whose courses follow the given curricula
+func StudentKeeperWithCurricula(t testing.TB, curricula []types.CurriculumTree) (keeper.Keeper, sdk.Context) {
+	return studentKeeper(t, MockStudentSubjectKeeper{}, MockStudentTokenDefKeeper{}, MockStudentAcademicNFTKeeper{}, MockStudentCurriculumKeeper{Curricula: curricula})
+}
+
+// StudentKeeperWithRecords returns a keeper that resolves subjects, token
+// definitions and token instances with the given mocks
+func StudentKeeperWithRecords(t testing.TB, subjectKeeper MockStudentSubjectKeeper, tokenDefKeeper MockStudentTokenDefKeeper, academicNFTKeeper MockStudentAcademicNFTKeeper) (keeper.Keeper, sdk.Context) {
+	return studentKeeper(t, subjectKeeper, tokenDefKeeper, academicNFTKeeper, MockStudentCurriculumKeeper{})
+}
+
+func studentKeeper(t testing.TB, subjectKeeper MockStudentSubjectKeeper, tokenDefKeeper MockStudentTokenDefKeeper, academicNFTKeeper MockStudentAcademicNFTKeeper, curriculumKeeper MockStudentCurriculumKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
@@ -37,10 +52,6 @@ func StudentKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	bankKeeper := MockBankKeeper{}
 	institutionKeeper := MockStudentInstitutionKeeper{}
 	courseKeeper := MockStudentCourseKeeper{}
-	curriculumKeeper := MockStudentCurriculumKeeper{}
-	subjectKeeper := MockStudentSubjectKeeper{}
-	tokenDefKeeper := MockStudentTokenDefKeeper{}
-	academicNFTKeeper := MockStudentAcademicNFTKeeper{}
 	wasmMsgServer := MockWasmMsgServer{}
 	wasmQuerier := MockWasmQuerier{}
 
@@ -60,6 +71,8 @@ func StudentKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		wasmMsgServer,
 		wasmQuerier,
 	)
+	k.SetDegreeKeeper(MockStudentDegreeKeeper{})
+	k.SetEquivalenceKeeper(MockStudentEquivalenceKeeper{})
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
@@ -23,6 +23,16 @@ import (
 )
 
 func SubjectKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return subjectKeeper(t, MockSubjectInstitutionKeeper{})
+}
+
+// SubjectKeeperWithInstitutions returns a keeper that resolves institutions
+// with the given mock
+func SubjectKeeperWithInstitutions(t testing.TB, institutionKeeper MockSubjectInstitutionKeeper) (keeper.Keeper, sdk.Context) {
+	return subjectKeeper(t, institutionKeeper)
+}
+
+func subjectKeeper(t testing.TB, institutionKeeper MockSubjectInstitutionKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	paramStoreKey := storetypes.NewKVStoreKey("params")
 	paramTransientStoreKey := storetypes.NewTransientStoreKey("transient_params")
@@ -49,8 +59,7 @@ func SubjectKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 
 	// Use correct mocks for subject module
 	mockWasmQuerier := MockWasmQuerier{}
-	mockInstitutionKeeper := MockSubjectInstitutionKeeper{} // Use subject-specific mock
-	mockCourseKeeper := MockSubjectCourseKeeper{}           // Use subject-specific mock
+	mockCourseKeeper := MockSubjectCourseKeeper{} // Use subject-specific mock
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -58,11 +67,13 @@ func SubjectKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		log.NewNopLogger(),
 		paramSubspace,
 		mockWasmQuerier,
-		mockInstitutionKeeper,
+		institutionKeeper,
 		mockCourseKeeper,
 		authority.String(),
 	)
 
+	k.SetStudentKeeper(MockSubjectStudentKeeper{Trees: map[string]types.StudentAcademicTree{}})
+
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
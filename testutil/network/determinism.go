@@ -0,0 +1,253 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/app"
+	academicnfttypes "academictoken/x/academicnft/types"
+	institutiontypes "academictoken/x/institution/types"
+	studenttypes "academictoken/x/student/types"
+)
+
+// Academic records seeded in the genesis of the determinism network. The
+// first validator administers the institution and owns the token instance.
+// The student is not a validator, so validators can still register themselves.
+const (
+	DeterminismInstitution   = "institution-1"
+	DeterminismTermId        = "2025-1"
+	DeterminismStudentId     = "0"
+	DeterminismTokenInstance = "determinism-token"
+)
+
+// txTimeout bounds the wait for a broadcast transaction to be executed
+const txTimeout = 30 * time.Second
+
+// DeterminismNetwork is a multi-validator network keeping a handle on the
+// application of every validator, so the state they commit for the same
+// blocks can be compared.
+type DeterminismNetwork struct {
+	*Network
+
+	mu   sync.Mutex
+	apps []*app.App
+}
+
+// commitInfoStore is implemented by multistores keeping the commit info of past versions
+type commitInfoStore interface {
+	GetCommitInfo(ver int64) (*storetypes.CommitInfo, error)
+}
+
+// NewDeterminism creates a network with the given number of validators, all
+// executing the same transactions. The genesis registers an academic term, a
+// student enrolled in it and a token instance of the first validator.
+func NewDeterminism(t *testing.T, numValidators int) *DeterminismNetwork {
+	t.Helper()
+	cfg := DefaultConfig()
+	cfg.NumValidators = numValidators
+
+	// Fix the key of the first validator so its address can be seeded in genesis
+	record, mnemonic, err := keyring.NewInMemory(cfg.Codec).NewMnemonic("validator0", keyring.English,
+		sdk.GetConfig().GetFullBIP44Path(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	admin, err := record.GetAddress()
+	require.NoError(t, err)
+	cfg.Mnemonics = []string{mnemonic}
+	seedAcademicGenesis(t, cfg, admin.String())
+
+	n := &DeterminismNetwork{}
+	constructor := cfg.AppConstructor
+	cfg.AppConstructor = func(val network.ValidatorI) servertypes.Application {
+		application := constructor(val)
+		n.mu.Lock()
+		n.apps = append(n.apps, application.(*app.App))
+		n.mu.Unlock()
+		return application
+	}
+	n.Network = New(t, cfg)
+	return n
+}
+
+// seedAcademicGenesis adds the academic records of the determinism network to
+// the genesis of the institution, student and academicnft modules
+func seedAcademicGenesis(t *testing.T, cfg Config, admin string) {
+	t.Helper()
+
+	var institutionGenesis institutiontypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[institutiontypes.ModuleName], &institutionGenesis)
+	institutionGenesis.RoleAssignmentList = append(institutionGenesis.RoleAssignmentList, institutiontypes.RoleAssignment{
+		Institution: DeterminismInstitution,
+		Address:     admin,
+		Role:        institutiontypes.RoleInstitutionAdmin,
+	})
+	institutionGenesis.AcademicTermList = append(institutionGenesis.AcademicTermList, institutiontypes.AcademicTerm{
+		Institution:             DeterminismInstitution,
+		TermId:                  DeterminismTermId,
+		Name:                    "First term of 2025",
+		StartDate:               "2025-02-01T00:00:00Z",
+		EndDate:                 "2025-06-30T00:00:00Z",
+		EnrollmentStart:         "2025-01-01T00:00:00Z",
+		EnrollmentEnd:           "2025-02-15T00:00:00Z",
+		GradeSubmissionDeadline: "2025-07-31T00:00:00Z",
+		Creator:                 admin,
+	})
+	require.NoError(t, institutionGenesis.Validate())
+	cfg.GenesisState[institutiontypes.ModuleName] = cfg.Codec.MustMarshalJSON(&institutionGenesis)
+
+	var studentGenesis studenttypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[studenttypes.ModuleName], &studentGenesis)
+	studentGenesis.StudentList = append(studentGenesis.StudentList, studenttypes.Student{
+		Index:         DeterminismStudentId,
+		Name:          "Determinism Student",
+		Address:       sdk.AccAddress("determinism-student").String(),
+		EnrollmentIds: []string{"0"},
+	})
+	studentGenesis.StudentCount = 1
+	studentGenesis.StudentEnrollmentList = append(studentGenesis.StudentEnrollmentList, studenttypes.StudentEnrollment{
+		Index:       "0",
+		Student:     DeterminismStudentId,
+		Institution: DeterminismInstitution,
+		CourseId:    "course-1",
+		Status:      "active",
+	})
+	studentGenesis.StudentEnrollmentCount = 1
+	require.NoError(t, studentGenesis.Validate())
+	cfg.GenesisState[studenttypes.ModuleName] = cfg.Codec.MustMarshalJSON(&studentGenesis)
+
+	var academicnftGenesis academicnfttypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[academicnfttypes.ModuleName], &academicnftGenesis)
+	academicnftGenesis.SubjectTokenInstanceList = append(academicnftGenesis.SubjectTokenInstanceList, academicnfttypes.SubjectTokenInstance{
+		Index:             DeterminismTokenInstance,
+		TokenDefId:        "tokendef-1",
+		Student:           admin,
+		CompletionDate:    "2025-06-30T00:00:00Z",
+		Grade:             "9.0",
+		IssuerInstitution: DeterminismInstitution,
+		Semester:          DeterminismTermId,
+	})
+	academicnftGenesis.TokenSupplies = append(academicnftGenesis.TokenSupplies, academicnfttypes.TokenSupply{TokenDefId: "tokendef-1", Minted: 1})
+	require.NoError(t, academicnftGenesis.Validate())
+	cfg.GenesisState[academicnfttypes.ModuleName] = cfg.Codec.MustMarshalJSON(&academicnftGenesis)
+}
+
+// SubmitTx signs the messages with the key of the first validator, broadcasts
+// them and waits for the transaction to be executed successfully.
+func (n *DeterminismNetwork) SubmitTx(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	val := n.Validators[0]
+	clientCtx := val.ClientCtx.
+		WithFromAddress(val.Address).
+		WithFromName(val.Moniker).
+		WithSkipConfirmation(true).
+		WithBroadcastMode(flags.BroadcastSync).
+		WithOutput(io.Discard)
+
+	factory := clienttx.Factory{}.
+		WithTxConfig(clientCtx.TxConfig).
+		WithKeybase(clientCtx.Keyring).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithChainID(n.Config.ChainID).
+		WithGas(flags.DefaultGasLimit).
+		WithFees(fmt.Sprintf("10%s", n.Config.BondDenom))
+	factory, err := factory.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder, err := factory.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := clienttx.Sign(context.Background(), factory, val.Moniker, builder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("transaction rejected with code %d: %s", res.Code, res.RawLog)
+	}
+
+	// Validators committing different app hashes halt the chain before the
+	// transaction is indexed
+	deadline := time.Now().Add(txTimeout)
+	for {
+		executed, err := authtx.QueryTx(clientCtx, res.TxHash)
+		if err == nil {
+			if executed.Code != 0 {
+				return executed, fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, executed.Code, executed.RawLog)
+			}
+			return executed, nil
+		}
+		if time.Now().After(deadline) {
+			return res, fmt.Errorf("transaction %s not executed within %s: %w", res.TxHash, txTimeout, err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// AppHashes returns the app hash committed by every validator at the given height.
+func (n *DeterminismNetwork) AppHashes(height int64) ([][]byte, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	hashes := make([][]byte, 0, len(n.apps))
+	for i, application := range n.apps {
+		store, ok := application.CommitMultiStore().(commitInfoStore)
+		if !ok {
+			return nil, fmt.Errorf("validator %d: multistore does not keep commit info", i)
+		}
+		info, err := store.GetCommitInfo(height)
+		if err != nil {
+			return nil, fmt.Errorf("validator %d: %w", i, err)
+		}
+		hashes = append(hashes, info.Hash())
+	}
+	return hashes, nil
+}
+
+// RequireDeterministic waits until every validator committed the given height
+// and requires all of them to have committed the same app hash at each height.
+func (n *DeterminismNetwork) RequireDeterministic(t *testing.T, height int64) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		for _, application := range n.apps {
+			if application.LastBlockHeight() < height {
+				return false
+			}
+		}
+		return true
+	}, time.Minute, 100*time.Millisecond, "validators did not reach height %d", height)
+
+	for h := int64(1); h <= height; h++ {
+		hashes, err := n.AppHashes(h)
+		require.NoError(t, err)
+		for i := 1; i < len(hashes); i++ {
+			require.True(t, bytes.Equal(hashes[0], hashes[i]),
+				"app hash mismatch at height %d: validator 0 %X, validator %d %X", h, hashes[0], i, hashes[i])
+		}
+	}
+}
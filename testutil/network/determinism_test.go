@@ -0,0 +1,72 @@
+package network_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/testutil/network"
+	academicnfttypes "academictoken/x/academicnft/types"
+	degreetypes "academictoken/x/degree/types"
+	equivalencetypes "academictoken/x/equivalence/types"
+	scheduletypes "academictoken/x/schedule/types"
+	studenttypes "academictoken/x/student/types"
+)
+
+func TestDeterministicAppHash(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping multi-validator network test in short mode")
+	}
+
+	net := network.NewDeterminism(t, 2)
+	sender := net.Validators[0].Address.String()
+
+	studyPlan := scheduletypes.NewMsgCreateStudyPlan(sender, network.DeterminismStudentId, "2030-12-31", "determinism check")
+	studyPlan.SemesterCodes = []string{network.DeterminismTermId}
+
+	// Transactions storing block-dependent identifiers and timestamps
+	txs := [][]sdk.Msg{
+		{banktypes.NewMsgSend(net.Validators[0].Address, net.Validators[1].Address, sdk.NewCoins(sdk.NewCoin(net.Config.BondDenom, sdkmath.NewInt(100))))},
+		{studyPlan},
+		{&scheduletypes.MsgCreateSubjectRecommendation{
+			Creator:                sender,
+			Student:                network.DeterminismStudentId,
+			RecommendationSemester: network.DeterminismTermId,
+			RecommendationMetadata: "subject-1",
+		}},
+		{&studenttypes.MsgRegisterStudent{
+			Creator: sender,
+			Name:    "Validator Student",
+			Address: sender,
+		}},
+		{&degreetypes.MsgRequestDegree{
+			Creator:                sender,
+			StudentId:              network.DeterminismStudentId,
+			InstitutionId:          network.DeterminismInstitution,
+			CurriculumId:           "curriculum-1",
+			ExpectedGraduationDate: "2030-12-31",
+		}},
+		{&equivalencetypes.MsgRequestEquivalence{
+			Creator:           sender,
+			SourceSubjectId:   "subject-1",
+			TargetInstitution: network.DeterminismInstitution,
+			TargetSubjectId:   "subject-2",
+		}},
+		{&academicnfttypes.MsgRevokeTokenInstance{
+			Creator:         sender,
+			TokenInstanceId: network.DeterminismTokenInstance,
+			Reason:          "determinism check",
+		}},
+	}
+	for _, msgs := range txs {
+		_, err := net.SubmitTx(msgs...)
+		require.NoError(t, err)
+	}
+
+	height, err := net.LatestHeight()
+	require.NoError(t, err)
+	net.RequireDeterministic(t, height)
+}
@@ -2,9 +2,16 @@ package network
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/stretchr/testify/require"
 
 	"academictoken/app"
@@ -43,6 +50,41 @@ func DefaultConfig() network.Config {
 	if err != nil {
 		panic(err)
 	}
+
+	// The academic modules are registered manually in app.New rather than
+	// through app wiring, so the genesis, the codecs of the validator clients
+	// and the validator apps must be built from the full application.
+	tempDir, err := os.MkdirTemp("", "academictoken-network")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	tempApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(tempDir))
+	if err != nil {
+		panic(err)
+	}
+	cfg.GenesisState = tempApp.DefaultGenesis()
+	cfg.InterfaceRegistry = tempApp.InterfaceRegistry()
+	cfg.Codec = tempApp.AppCodec()
+	cfg.TxConfig = tempApp.TxConfig()
+	cfg.AppConstructor = func(val network.ValidatorI) servertypes.Application {
+		academicApp, err := app.New(
+			val.GetCtx().Logger,
+			dbm.NewMemDB(),
+			nil,
+			true,
+			simtestutil.NewAppOptionsWithFlagHome(val.GetCtx().Config.RootDir),
+			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(cfg.ChainID),
+		)
+		if err != nil {
+			panic(err)
+		}
+		return academicApp
+	}
+
 	ports, err := freePorts(3)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,258 @@
+// Package credential renders academic records as W3C Verifiable Credentials
+// anchored to the chain state they were read from, and verifies those anchors
+// against store proofs. It only depends on the SDK so that every module issuing
+// academic records and the CLI can share it.
+package credential
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ContextV1 is the JSON-LD context of the W3C Verifiable Credentials data model
+	ContextV1 = "https://www.w3.org/2018/credentials/v1"
+
+	TypeVerifiableCredential = "VerifiableCredential"
+	TypeSubjectCredential    = "AcademicSubjectCredential"
+	TypeDegreeCredential     = "AcademicDegreeCredential"
+
+	// ProofTypeStoreAnchor is the proof type of credentials anchored to a
+	// record of a module store at a block height
+	ProofTypeStoreAnchor = "AcademicTokenStoreAnchor"
+	// StatusTypeRecord is the credential status type reporting the status of
+	// the anchored record
+	StatusTypeRecord = "AcademicTokenRecordStatus"
+
+	ProofPurposeAssertion = "assertionMethod"
+)
+
+// Credential is a W3C Verifiable Credential
+type Credential struct {
+	Context           []string        `json:"@context"`
+	ID                string          `json:"id"`
+	Type              []string        `json:"type"`
+	Issuer            Issuer          `json:"issuer"`
+	IssuanceDate      string          `json:"issuanceDate"`
+	CredentialSubject json.RawMessage `json:"credentialSubject"`
+	CredentialStatus  Status          `json:"credentialStatus"`
+	Proof             Anchor          `json:"proof"`
+}
+
+// Issuer identifies the institution issuing a credential
+type Issuer struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// Status reports the status of the anchored record, e.g. revoked tokens
+type Status struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Anchor locates the record a credential was rendered from: the key of a
+// module store at a block height, with the stored bytes and their hash. A store
+// proof of the key at that height verifies against the app hash of the next
+// block.
+type Anchor struct {
+	Type         string `json:"type"`
+	Created      string `json:"created"`
+	ProofPurpose string `json:"proofPurpose"`
+	ChainID      string `json:"chainId"`
+	BlockHeight  int64  `json:"blockHeight"`
+	StoreName    string `json:"storeName"`
+	StoreKey     string `json:"storeKey"`  // hex
+	Record       string `json:"record"`    // base64 of the stored bytes
+	ValueHash    string `json:"valueHash"` // hex SHA-256 of the stored bytes
+	// StoreProof holds the base64 ICS-23 proof ops of the key. Queries cannot
+	// produce proofs, so clients attach them from an ABCI query.
+	StoreProof string `json:"storeProof,omitempty"`
+}
+
+// NewAnchor anchors a stored record to the block the context reads from
+func NewAnchor(ctx sdk.Context, storeName string, key []byte, record []byte) Anchor {
+	hash := sha256.Sum256(record)
+	return Anchor{
+		Type:         ProofTypeStoreAnchor,
+		Created:      ctx.BlockTime().UTC().Format(time.RFC3339),
+		ProofPurpose: ProofPurposeAssertion,
+		ChainID:      ctx.ChainID(),
+		BlockHeight:  ctx.BlockHeight(),
+		StoreName:    storeName,
+		StoreKey:     hex.EncodeToString(key),
+		Record:       base64.StdEncoding.EncodeToString(record),
+		ValueHash:    hex.EncodeToString(hash[:]),
+	}
+}
+
+// New builds a credential of the given type
+func New(credentialType string, id string, issuer Issuer, issuanceDate string, subject interface{}, status Status, anchor Anchor) (Credential, error) {
+	bz, err := json.Marshal(subject)
+	if err != nil {
+		return Credential{}, err
+	}
+	return Credential{
+		Context:           []string{ContextV1},
+		ID:                id,
+		Type:              []string{TypeVerifiableCredential, credentialType},
+		Issuer:            issuer,
+		IssuanceDate:      issuanceDate,
+		CredentialSubject: bz,
+		CredentialStatus:  status,
+		Proof:             anchor,
+	}, nil
+}
+
+// URN returns the identifier of an on-chain entity, e.g.
+// urn:academictoken:<chain-id>:subject-token:<index>
+func URN(chainID string, kind string, id string) string {
+	return fmt.Sprintf("urn:academictoken:%s:%s:%s", chainID, kind, id)
+}
+
+// IssuanceDate returns date as an RFC3339 time when it is one or a plain
+// date, or fallback otherwise
+func IssuanceDate(date string, fallback string) string {
+	if t, err := time.Parse(time.RFC3339, date); err == nil {
+		return t.UTC().Format(time.RFC3339)
+	}
+	if t, err := time.Parse(time.DateOnly, date); err == nil {
+		return t.Format(time.RFC3339)
+	}
+	return fallback
+}
+
+// Marshal encodes a credential as JSON
+func (c Credential) Marshal() (string, error) {
+	bz, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// CredentialType returns the specific type of a credential, next to VerifiableCredential
+func (c Credential) CredentialType() string {
+	for _, t := range c.Type {
+		if t != TypeVerifiableCredential {
+			return t
+		}
+	}
+	return ""
+}
+
+// Parse decodes a credential and checks it is an anchored verifiable credential
+func Parse(bz []byte) (Credential, error) {
+	var c Credential
+	if err := json.Unmarshal(bz, &c); err != nil {
+		return c, fmt.Errorf("invalid credential: %w", err)
+	}
+	if len(c.Context) == 0 || c.Context[0] != ContextV1 {
+		return c, fmt.Errorf("credential context must start with %s", ContextV1)
+	}
+	if len(c.Type) < 2 || c.Type[0] != TypeVerifiableCredential {
+		return c, fmt.Errorf("credential type must be %s and a specific type", TypeVerifiableCredential)
+	}
+	if c.Proof.Type != ProofTypeStoreAnchor {
+		return c, fmt.Errorf("unsupported proof type '%s'", c.Proof.Type)
+	}
+	if _, err := c.Proof.Key(); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// Key decodes the store key of the anchored record
+func (a Anchor) Key() ([]byte, error) {
+	key, err := hex.DecodeString(a.StoreKey)
+	if err != nil || len(key) == 0 {
+		return nil, fmt.Errorf("invalid anchor store key '%s'", a.StoreKey)
+	}
+	return key, nil
+}
+
+// RecordBytes decodes the anchored record and checks it against its hash
+func (a Anchor) RecordBytes() ([]byte, error) {
+	record, err := base64.StdEncoding.DecodeString(a.Record)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor record: %w", err)
+	}
+	hash := sha256.Sum256(record)
+	if hex.EncodeToString(hash[:]) != a.ValueHash {
+		return nil, fmt.Errorf("anchor record does not match its hash %s", a.ValueHash)
+	}
+	return record, nil
+}
+
+// SetStoreProof attaches the proof ops of the anchored key
+func (a *Anchor) SetStoreProof(proofOps *cmtcrypto.ProofOps) error {
+	bz, err := proofOps.Marshal()
+	if err != nil {
+		return err
+	}
+	a.StoreProof = base64.StdEncoding.EncodeToString(bz)
+	return nil
+}
+
+// VerifyStoreProof checks that the anchored record was stored under the
+// anchored key of a state with the given app hash. The app hash committing
+// the state at BlockHeight is the one in the header of block BlockHeight+1.
+func (a Anchor) VerifyStoreProof(appHash []byte) error {
+	if a.StoreProof == "" {
+		return fmt.Errorf("credential carries no store proof")
+	}
+	bz, err := base64.StdEncoding.DecodeString(a.StoreProof)
+	if err != nil {
+		return fmt.Errorf("invalid store proof: %w", err)
+	}
+	var proofOps cmtcrypto.ProofOps
+	if err := proofOps.Unmarshal(bz); err != nil {
+		return fmt.Errorf("invalid store proof: %w", err)
+	}
+	key, err := a.Key()
+	if err != nil {
+		return err
+	}
+	record, err := a.RecordBytes()
+	if err != nil {
+		return err
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(a.StoreName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex)
+	if err := rootmulti.DefaultProofRuntime().VerifyValue(&proofOps, appHash, keyPath.String(), record); err != nil {
+		return fmt.Errorf("store proof does not verify: %w", err)
+	}
+	return nil
+}
+
+// Equal reports whether two credentials have the same content, ignoring the
+// formatting of their JSON and the store proof attached by clients
+func Equal(a Credential, b Credential) bool {
+	a.Proof.StoreProof, b.Proof.StoreProof = "", ""
+	valueA, errA := jsonValue(a)
+	valueB, errB := jsonValue(b)
+	return errA == nil && errB == nil && reflect.DeepEqual(valueA, valueB)
+}
+
+func jsonValue(c Credential) (value interface{}, err error) {
+	bz, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bz, &value)
+	return value, err
+}
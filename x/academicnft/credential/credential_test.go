@@ -0,0 +1,101 @@
+package credential_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/x/academicnft/credential"
+)
+
+func TestAnchorStoreProof(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("academicnft")
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key, record := []byte("\x01token-1"), []byte("stored token")
+	ms.GetKVStore(storeKey).Set(key, record)
+	ms.GetKVStore(storeKey).Set([]byte("\x01token-2"), []byte("other token"))
+	commit := ms.Commit()
+
+	ctx := sdk.NewContext(ms, cmtproto.Header{ChainID: "academictoken", Height: commit.Version, Time: time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)}, false, log.NewNopLogger())
+	anchor := credential.NewAnchor(ctx, storeKey.Name(), key, record)
+	require.Equal(t, "2025-07-01T12:00:00Z", anchor.Created)
+
+	proven := func(anchor credential.Anchor) credential.Anchor {
+		k, err := anchor.Key()
+		require.NoError(t, err)
+		res, err := ms.Query(&storetypes.RequestQuery{Path: "/academicnft/key", Data: k, Height: anchor.BlockHeight, Prove: true})
+		require.NoError(t, err)
+		require.NoError(t, anchor.SetStoreProof(res.ProofOps))
+		return anchor
+	}
+
+	require.ErrorContains(t, anchor.VerifyStoreProof(commit.Hash), "no store proof")
+	anchor = proven(anchor)
+	require.NoError(t, anchor.VerifyStoreProof(commit.Hash))
+
+	// Another app hash, record or key does not verify
+	require.Error(t, anchor.VerifyStoreProof(make([]byte, 32)))
+	tampered := credential.NewAnchor(ctx, storeKey.Name(), key, []byte("forged token"))
+	tampered.StoreProof = anchor.StoreProof
+	require.ErrorContains(t, tampered.VerifyStoreProof(commit.Hash), "does not verify")
+	tampered = anchor
+	tampered.Record = credential.NewAnchor(ctx, storeKey.Name(), key, []byte("forged token")).Record
+	require.ErrorContains(t, tampered.VerifyStoreProof(commit.Hash), "does not match its hash")
+	tampered = proven(credential.NewAnchor(ctx, storeKey.Name(), []byte("\x01token-2"), record))
+	require.Error(t, tampered.VerifyStoreProof(commit.Hash))
+}
+
+func TestCredentialRoundTrip(t *testing.T) {
+	anchor := credential.Anchor{
+		Type:        credential.ProofTypeStoreAnchor,
+		ChainID:     "academictoken",
+		BlockHeight: 7,
+		StoreName:   "academicnft",
+		StoreKey:    "01",
+		Created:     "2025-07-01T12:00:00Z",
+	}
+	vc, err := credential.New(credential.TypeSubjectCredential, credential.URN("academictoken", "subject-token", "1"),
+		credential.Issuer{ID: credential.URN("academictoken", "institution", "inst-1")},
+		credential.IssuanceDate("2025-06-30", anchor.Created), map[string]string{"grade": "A"},
+		credential.Status{Status: "active"}, anchor)
+	require.NoError(t, err)
+	require.Equal(t, "urn:academictoken:academictoken:subject-token:1", vc.ID)
+	require.Equal(t, "2025-06-30T00:00:00Z", vc.IssuanceDate)
+	require.Equal(t, credential.TypeSubjectCredential, vc.CredentialType())
+
+	out, err := vc.Marshal()
+	require.NoError(t, err)
+	parsed, err := credential.Parse([]byte(out))
+	require.NoError(t, err)
+	require.True(t, credential.Equal(vc, parsed))
+
+	// Formatting and store proofs do not matter, content does
+	indented, err := json.MarshalIndent(vc, "", "  ")
+	require.NoError(t, err)
+	parsed, err = credential.Parse(indented)
+	require.NoError(t, err)
+	parsed.Proof.StoreProof = "proof"
+	require.True(t, credential.Equal(vc, parsed))
+	parsed.CredentialSubject = json.RawMessage(`{"grade":"B"}`)
+	require.False(t, credential.Equal(vc, parsed))
+
+	_, err = credential.Parse([]byte(`{"@context":["https://example.org"]}`))
+	require.Error(t, err)
+	vc.Proof.Type = "Ed25519Signature2020"
+	out, err = vc.Marshal()
+	require.NoError(t, err)
+	_, err = credential.Parse([]byte(out))
+	require.ErrorContains(t, err, "unsupported proof type")
+}
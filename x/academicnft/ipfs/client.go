@@ -7,6 +7,8 @@ import (
 )
 
 // AcademicNFTIPFSClient wraps the base IPFS client with academicnft-specific functionality
+// and performs network I/O, so it is for clients and off-chain services only;
+// keepers validate references with VerifyAcademicNFTData instead
 type AcademicNFTIPFSClient struct {
 	*subjectipfs.IPFSClient
 }
@@ -27,6 +29,16 @@ func (c *AcademicNFTIPFSClient) AddAcademicNFTData(data interface{}) (string, st
 	return c.Add(jsonData)
 }
 
+// VerifyAcademicNFTData checks, without any I/O, that data matches the content hash
+// and IPFS link uploaded for it
+func VerifyAcademicNFTData(data interface{}, contentHash, ipfsLink string) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal academicnft data: %w", err)
+	}
+	return subjectipfs.VerifyContent(jsonData, contentHash, ipfsLink)
+}
+
 // GetAcademicNFTData retrieves and unmarshals academicnft data from IPFS
 func (c *AcademicNFTIPFSClient) GetAcademicNFTData(ipfsLink string, target interface{}) error {
 	data, err := c.Get(ipfsLink)
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/x/academicnft/credential"
+	"academictoken/x/academicnft/types"
+)
+
+// SubjectTokenCredential renders a token instance as a W3C Verifiable
+// Credential anchored to the stored record at the queried height (Query Server method)
+func (k Keeper) SubjectTokenCredential(goCtx context.Context, req *types.QuerySubjectTokenCredentialRequest) (*types.QuerySubjectTokenCredentialResponse, error) {
+	if req == nil || req.TokenInstanceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The anchor carries the stored bytes, so read them rather than re-encode the token
+	key := types.SubjectTokenInstanceKey(req.TokenInstanceId)
+	bz, err := k.storeService.OpenKVStore(ctx).Get(key)
+	if err != nil || bz == nil {
+		return nil, status.Error(codes.NotFound, "token instance not found")
+	}
+	var tokenInstance types.SubjectTokenInstance
+	k.cdc.MustUnmarshal(bz, &tokenInstance)
+
+	tokenDef := types.CredentialTokenDefinition{ID: tokenInstance.TokenDefId}
+	if def, found := k.tokenDefinition(ctx, tokenInstance.TokenDefId); found {
+		tokenDef = types.NewCredentialTokenDefinition(def)
+	}
+
+	issuerName := ""
+	if k.institutionKeeper != nil {
+		if institution, found := k.institutionKeeper.GetInstitution(ctx, tokenInstance.IssuerInstitution); found {
+			issuerName = institution.Name
+		}
+	}
+
+	anchor := credential.NewAnchor(ctx, types.StoreKey, key, bz)
+	vc, err := types.NewSubjectTokenCredential(tokenInstance, tokenDef, issuerName, anchor)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out, err := vc.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubjectTokenCredentialResponse{Credential: out}, nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/academicnft/credential"
+	"academictoken/x/academicnft/keeper"
+	"academictoken/x/academicnft/types"
+	tokendeftypes "academictoken/x/tokendef/types"
+)
+
+func TestSubjectTokenCredential(t *testing.T) {
+	k, ctx := keepertest.AcademicnftKeeperWithTokenDefs(t, tokendeftypes.TokenDefinition{
+		Index:         "tokendef-1",
+		InstitutionId: "inst-1",
+		SubjectId:     "CALC1",
+		CourseId:      "course-1",
+		TokenName:     "Calculus I",
+		TokenSymbol:   "CALC1",
+		Metadata:      &tokendeftypes.TokenMetadata{Description: "Differential calculus"},
+		ContentHash:   "QmHash",
+	})
+	ctx = ctx.WithChainID("academictoken").WithBlockHeight(12).WithBlockTime(time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC))
+	student := sample.AccAddress()
+
+	res, err := keeper.NewMsgServerImpl(k).MintSubjectToken(ctx, &types.MsgMintSubjectToken{
+		Creator:           sample.AccAddress(),
+		TokenDefId:        "tokendef-1",
+		Student:           student,
+		CompletionDate:    "2025-06-30",
+		Grade:             "85",
+		IssuerInstitution: "inst-1",
+		Semester:          "2025-1",
+	})
+	require.NoError(t, err)
+
+	_, err = k.SubjectTokenCredential(ctx, &types.QuerySubjectTokenCredentialRequest{TokenInstanceId: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	out, err := k.SubjectTokenCredential(ctx, &types.QuerySubjectTokenCredentialRequest{TokenInstanceId: res.TokenInstanceId})
+	require.NoError(t, err)
+	vc, err := credential.Parse([]byte(out.Credential))
+	require.NoError(t, err)
+
+	require.Equal(t, credential.URN("academictoken", "subject-token", res.TokenInstanceId), vc.ID)
+	require.Equal(t, credential.TypeSubjectCredential, vc.CredentialType())
+	require.Equal(t, "urn:academictoken:academictoken:institution:inst-1", vc.Issuer.ID)
+	require.Equal(t, "2025-07-01T12:00:00Z", vc.IssuanceDate)
+	require.Equal(t, "active", vc.CredentialStatus.Status)
+	require.Equal(t, int64(12), vc.Proof.BlockHeight)
+	require.Equal(t, types.StoreKey, vc.Proof.StoreName)
+
+	var subject types.SubjectCredentialSubject
+	require.NoError(t, json.Unmarshal(vc.CredentialSubject, &subject))
+	require.Equal(t, credential.URN("academictoken", "student", student), subject.ID)
+	require.Equal(t, "85", subject.Grade)
+	require.Equal(t, "Calculus I", subject.TokenDefinition.Name)
+	require.Equal(t, "Differential calculus", subject.TokenDefinition.Description)
+
+	// The anchored record renders the same credential
+	record, err := vc.Proof.RecordBytes()
+	require.NoError(t, err)
+	var tokenInstance types.SubjectTokenInstance
+	require.NoError(t, tokenInstance.Unmarshal(record))
+	rendered, err := types.NewSubjectTokenCredential(tokenInstance, subject.TokenDefinition, vc.Issuer.Name, vc.Proof)
+	require.NoError(t, err)
+	require.True(t, credential.Equal(vc, rendered))
+
+	// Revoked tokens are exported with their revocation
+	tokenInstance.Revoked = true
+	tokenInstance.RevocationReason = "academic misconduct"
+	k.SetSubjectTokenInstance(ctx, tokenInstance)
+	out, err = k.SubjectTokenCredential(ctx, &types.QuerySubjectTokenCredentialRequest{TokenInstanceId: res.TokenInstanceId})
+	require.NoError(t, err)
+	revoked, err := credential.Parse([]byte(out.Credential))
+	require.NoError(t, err)
+	require.Equal(t, credential.Status{ID: vc.ID + "#status", Type: credential.StatusTypeRecord, Status: "revoked", Reason: "academic misconduct"}, revoked.CredentialStatus)
+	require.False(t, credential.Equal(vc, revoked))
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/testutil/sample"
+	academicnft "academictoken/x/academicnft/module"
+	"academictoken/x/academicnft/types"
+)
+
+func TestAmendGradeAndRevoke(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	creator := sample.AccAddress()
+	student := sample.AccAddress()
+
+	mint := &types.MsgMintSubjectToken{
+		Creator:           creator,
+		TokenDefId:        "tokendef-1",
+		Student:           student,
+		CompletionDate:    "2025-06-30",
+		Grade:             "70",
+		IssuerInstitution: "inst-1",
+		Semester:          "2025-1",
+	}
+	minted, err := ms.MintSubjectToken(ctx, mint)
+	require.NoError(t, err)
+	tokenId := minted.TokenInstanceId
+
+	// Without an institution keeper only the module authority may revoke, and
+	// only it or authorized contracts may amend
+	_, err = ms.AmendGrade(ctx, types.NewMsgAmendGrade(creator, tokenId, "85", "recount"))
+	require.ErrorIs(t, err, types.ErrUnauthorizedAmendment)
+	_, err = ms.RevokeTokenInstance(ctx, types.NewMsgRevokeTokenInstance(creator, tokenId, "academic misconduct"))
+	require.ErrorIs(t, err, types.ErrUnauthorizedAmendment)
+	authority := k.GetAuthority()
+
+	// Amendments require a reason and a passing grade
+	_, err = ms.AmendGrade(ctx, types.NewMsgAmendGrade(authority, tokenId, "85", ""))
+	require.Error(t, err)
+	_, err = ms.AmendGrade(ctx, types.NewMsgAmendGrade(authority, tokenId, "40", "recount"))
+	require.ErrorIs(t, err, types.ErrInvalidGrade)
+	_, err = ms.AmendGrade(ctx, types.NewMsgAmendGrade(authority, "missing", "85", "recount"))
+	require.ErrorIs(t, err, types.ErrTokenInstanceNotFound)
+
+	res, err := ms.AmendGrade(ctx, types.NewMsgAmendGrade(authority, tokenId, "85", "recount"))
+	require.NoError(t, err)
+	require.Equal(t, "85.000000000000000000", res.NormalizedGrade)
+	_, err = ms.AmendGrade(ctx, types.NewMsgAmendGrade(authority, tokenId, "90", "appeal"))
+	require.NoError(t, err)
+
+	token, found := k.GetSubjectTokenInstance(sdk.UnwrapSDKContext(ctx), tokenId)
+	require.True(t, found)
+	require.Equal(t, "90", token.Grade)
+	require.Len(t, token.Amendments, 2)
+	require.Equal(t, "70", token.Amendments[0].PreviousGrade)
+	require.Equal(t, "85", token.Amendments[0].NewGrade)
+	require.Equal(t, "recount", token.Amendments[0].Reason)
+	require.Equal(t, "85", token.Amendments[1].PreviousGrade)
+	require.Equal(t, "appeal", token.Amendments[1].Reason)
+
+	// Revocation keeps the history and blocks further amendments
+	_, err = ms.RevokeTokenInstance(ctx, types.NewMsgRevokeTokenInstance(authority, tokenId, ""))
+	require.Error(t, err)
+	_, err = ms.RevokeTokenInstance(ctx, types.NewMsgRevokeTokenInstance(authority, tokenId, "academic misconduct"))
+	require.NoError(t, err)
+	_, err = ms.RevokeTokenInstance(ctx, types.NewMsgRevokeTokenInstance(authority, tokenId, "again"))
+	require.ErrorIs(t, err, types.ErrTokenRevoked)
+	_, err = ms.AmendGrade(ctx, types.NewMsgAmendGrade(authority, tokenId, "95", "appeal"))
+	require.ErrorIs(t, err, types.ErrTokenRevoked)
+
+	token, found = k.GetSubjectTokenInstance(sdk.UnwrapSDKContext(ctx), tokenId)
+	require.True(t, found)
+	require.True(t, token.Revoked)
+	require.Equal(t, "academic misconduct", token.RevocationReason)
+	require.Equal(t, authority, token.RevokedBy)
+	require.Len(t, token.Amendments, 2)
+
+	verified, err := ms.VerifyTokenInstance(ctx, types.NewMsgVerifyTokenInstance(authority, tokenId))
+	require.NoError(t, err)
+	require.False(t, verified.IsValid)
+
+	// The subject can be issued again once its token was revoked, and the
+	// exported state with both tokens stays valid
+	reissued, err := ms.MintSubjectToken(ctx, mint)
+	require.NoError(t, err)
+	genesis := academicnft.ExportGenesis(sdk.UnwrapSDKContext(ctx), k)
+	require.Len(t, genesis.SubjectTokenInstanceList, 2)
+	require.NoError(t, genesis.Validate())
+
+	// Authorized contracts may amend, as they may mint
+	contract := sample.AccAddress()
+	k.SetAuthorizedContract(sdk.UnwrapSDKContext(ctx), contract)
+	_, err = ms.AmendGrade(ctx, types.NewMsgAmendGrade(contract, reissued.TokenInstanceId, "75", "recount"))
+	require.NoError(t, err)
+}
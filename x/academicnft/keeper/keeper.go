@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"academictoken/x/academicnft/types"
+	institutiontypes "academictoken/x/institution/types"
 )
 
 type (
@@ -31,6 +32,9 @@ type (
 		institutionKeeper types.InstitutionKeeper
 		accountKeeper     types.AccountKeeper
 		bankKeeper        types.BankKeeper
+
+		// degreeKeeper is set after construction since degree depends on this module
+		degreeKeeper types.DegreeKeeper
 	}
 )
 
@@ -63,6 +67,11 @@ func NewKeeper(
 }
 
 // GetAuthority returns the module's authority.
+// SetDegreeKeeper sets the degree keeper notified when a token is revoked
+func (k *Keeper) SetDegreeKeeper(degreeKeeper types.DegreeKeeper) {
+	k.degreeKeeper = degreeKeeper
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
@@ -110,6 +119,13 @@ func (k Keeper) SetSubjectTokenInstance(ctx sdk.Context, tokenInstance types.Sub
 	// Also create indexes for efficient querying
 	k.setStudentTokenIndex(ctx, tokenInstance.Student, tokenInstance.Index)
 	k.setTokenDefInstanceIndex(ctx, tokenInstance.TokenDefId, tokenInstance.Index)
+
+	// Only active tokens block a new mint for the same (student, tokenDefId)
+	if tokenInstance.Revoked {
+		k.removeStudentTokenDefIndex(ctx, tokenInstance.Student, tokenInstance.TokenDefId, tokenInstance.Index)
+	} else {
+		k.setStudentTokenDefIndex(ctx, tokenInstance.Student, tokenInstance.TokenDefId, tokenInstance.Index)
+	}
 }
 
 // GetSubjectTokenInstance retrieves a token instance by its index
@@ -149,6 +165,18 @@ func (k Keeper) RemoveSubjectTokenInstance(ctx sdk.Context, index string) {
 	// Clean up indexes
 	k.removeStudentTokenIndex(ctx, tokenInstance.Student, index)
 	k.removeTokenDefInstanceIndex(ctx, tokenInstance.TokenDefId, index)
+	k.removeStudentTokenDefIndex(ctx, tokenInstance.Student, tokenInstance.TokenDefId, index)
+}
+
+// GetStudentTokenForTokenDef returns the ID of the active token instance a
+// student holds for a token definition
+func (k Keeper) GetStudentTokenForTokenDef(ctx sdk.Context, studentAddress, tokenDefId string) (string, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.StudentTokenDefIndexKey(studentAddress, tokenDefId))
+	if err != nil || bz == nil {
+		return "", false
+	}
+	return string(bz), true
 }
 
 // GetAllSubjectTokenInstances returns all token instances
@@ -215,6 +243,9 @@ func (k Keeper) ValidateTokenInstance(ctx sdk.Context, tokenInstanceId string) (
 	if !found {
 		return false, fmt.Errorf("token instance %s not found", tokenInstanceId)
 	}
+	if tokenInstance.Revoked {
+		return false, types.ErrTokenRevoked.Wrapf("token instance %s was revoked: %s", tokenInstanceId, tokenInstance.RevocationReason)
+	}
 
 	// Only validate with keepers that are available (not nil)
 	if k.tokenDefKeeper != nil {
@@ -239,16 +270,61 @@ func (k Keeper) ValidateTokenInstance(ctx sdk.Context, tokenInstanceId string) (
 		}
 	}
 
+	if tokenInstance.SigningKeyId != "" && !k.SignatureStatus(ctx, tokenInstance).Verified() {
+		return false, types.ErrInvalidSignature.Wrapf("token instance %s does not match a signature valid at issuance", tokenInstanceId)
+	}
+
 	return true, nil
 }
 
+// normalizeGrade validates a grade against the grading scale of the issuing
+// institution, falling back to the default 0-100 scale without an institution keeper
+func (k Keeper) normalizeGrade(ctx sdk.Context, institution string, grade string) (institutiontypes.NormalizedGrade, error) {
+	if k.institutionKeeper != nil {
+		normalized, err := k.institutionKeeper.NormalizeGrade(ctx, institution, grade)
+		if err != nil {
+			return institutiontypes.NormalizedGrade{}, types.ErrInvalidGrade.Wrap(err.Error())
+		}
+		return normalized, nil
+	}
+
+	normalized, err := institutiontypes.DefaultGradingScale(institution).Normalize(grade)
+	if err != nil {
+		return institutiontypes.NormalizedGrade{}, types.ErrInvalidGrade.Wrap(err.Error())
+	}
+	return normalized, nil
+}
+
+// tokenSubject returns the course and subject of the token definition of a
+// token instance, or empty strings when they cannot be resolved
+func (k Keeper) tokenSubject(ctx sdk.Context, tokenInstance types.SubjectTokenInstance) (courseId string, subjectId string) {
+	if k.tokenDefKeeper == nil {
+		return "", ""
+	}
+	tokenDef, found := k.tokenDefKeeper.GetTokenDefinitionByIndex(ctx, tokenInstance.TokenDefId)
+	if !found {
+		return "", ""
+	}
+	return tokenDef.CourseId, tokenDef.SubjectId
+}
+
+// previousGrade normalizes the current grade of a token instance. Grades that
+// no longer fit the institution scale are returned without GPA points.
+func (k Keeper) previousGrade(ctx sdk.Context, tokenInstance types.SubjectTokenInstance) institutiontypes.NormalizedGrade {
+	grade, err := k.normalizeGrade(ctx, tokenInstance.IssuerInstitution, tokenInstance.Grade)
+	if err != nil {
+		return institutiontypes.NormalizedGrade{Value: tokenInstance.Grade}
+	}
+	return grade
+}
+
 // GenerateTokenInstanceID generates a unique ID for a new token instance
 func (k Keeper) GenerateTokenInstanceID(ctx sdk.Context) string {
 	// Simple counter-based ID generation
 	// In production, you might want something more sophisticated
 	count := k.GetTokenInstanceCount(ctx)
 	k.SetTokenInstanceCount(ctx, count+1)
-	return fmt.Sprintf("token-instance-%d", count+1)
+	return fmt.Sprintf("%s%d", types.TokenInstanceIDPrefix, count+1)
 }
 
 // GetTokenInstanceCount gets the current count of token instances
@@ -315,6 +391,9 @@ func (k Keeper) GetStudentTokens(goCtx context.Context, req *types.QueryGetStude
 	// Convert to pointer slice for response
 	var tokenInstances []*types.SubjectTokenInstance
 	for i := range tokens {
+		if req.Term != "" && tokens[i].Semester != req.Term {
+			continue
+		}
 		tokenInstances = append(tokenInstances, &tokens[i])
 	}
 
@@ -341,6 +420,9 @@ func (k Keeper) GetTokenDefInstances(goCtx context.Context, req *types.QueryGetT
 	// Convert to pointer slice for response
 	var tokenInstances []*types.SubjectTokenInstance
 	for i := range tokens {
+		if req.Term != "" && tokens[i].Semester != req.Term {
+			continue
+		}
 		tokenInstances = append(tokenInstances, &tokens[i])
 	}
 
@@ -368,21 +450,18 @@ func (k Keeper) VerifyTokenInstance(goCtx context.Context, req *types.QueryVerif
 		}, nil
 	}
 
-	// Validate token instance
+	// Validate token instance; tokens with validation errors are reported as invalid
 	isValid, err := k.ValidateTokenInstance(ctx, req.TokenInstanceId)
-	if err != nil {
-		// Token exists but has validation errors
-		return &types.QueryVerifyTokenInstanceResponse{
-			Exists:        true,
-			IsValid:       false,
-			TokenInstance: &tokenInstance,
-		}, nil
-	}
+	signature := k.SignatureStatus(ctx, tokenInstance)
 
 	return &types.QueryVerifyTokenInstanceResponse{
-		Exists:        true,
-		IsValid:       isValid,
-		TokenInstance: &tokenInstance,
+		Exists:             true,
+		IsValid:            err == nil && isValid,
+		TokenInstance:      &tokenInstance,
+		SigningKeyId:       signature.SigningKeyId,
+		Signer:             signature.Signer,
+		SignatureValid:     signature.SignatureValid,
+		KeyValidAtIssuance: signature.KeyValidAtIssuance,
 	}, nil
 }
 
@@ -425,6 +504,23 @@ func (k Keeper) getStudentTokenIndexes(ctx sdk.Context, studentAddress string) [
 	return tokenIndexes
 }
 
+// setStudentTokenDefIndex records the token instance a student holds for a TokenDef
+func (k Keeper) setStudentTokenDefIndex(ctx sdk.Context, studentAddress, tokenDefId, tokenInstanceId string) {
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.StudentTokenDefIndexKey(studentAddress, tokenDefId)
+	store.Set(key, []byte(tokenInstanceId))
+}
+
+// removeStudentTokenDefIndex removes the (student, tokenDefId) index entry if it
+// still points to tokenInstanceId
+func (k Keeper) removeStudentTokenDefIndex(ctx sdk.Context, studentAddress, tokenDefId, tokenInstanceId string) {
+	if current, found := k.GetStudentTokenForTokenDef(ctx, studentAddress, tokenDefId); !found || current != tokenInstanceId {
+		return
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	store.Delete(types.StudentTokenDefIndexKey(studentAddress, tokenDefId))
+}
+
 // setTokenDefInstanceIndex adds a token instance to TokenDef's index
 func (k Keeper) setTokenDefInstanceIndex(ctx sdk.Context, tokenDefId, tokenInstanceId string) {
 	store := k.storeService.OpenKVStore(ctx)
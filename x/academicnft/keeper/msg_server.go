@@ -3,7 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -78,21 +78,26 @@ func (k msgServer) MintSubjectToken(goCtx context.Context, req *types.MsgMintSub
 		return nil, fmt.Errorf("semester cannot be empty")
 	}
 
-	// Validate grade format and range
-	grade, err := strconv.ParseFloat(req.Grade, 64)
+	// Validate the grade against the issuing institution grading scale
+	grade, err := k.normalizeGrade(ctx, req.IssuerInstitution, req.Grade)
 	if err != nil {
-		return nil, fmt.Errorf("invalid grade format: %w", err)
+		return nil, err
 	}
-	if grade < 0 || grade > 100 {
-		return nil, fmt.Errorf("grade must be between 0 and 100, got %f", grade)
+	if !grade.Passing {
+		return nil, types.ErrInvalidGrade.Wrapf("grade %s is below the passing grade of institution '%s'", grade.Value, req.IssuerInstitution)
 	}
 
-	// Check if token definition exists
+	// Check if token definition exists and belongs to the issuing institution
+	var courseId, subjectId string
 	if k.tokenDefKeeper != nil {
-		_, found := k.tokenDefKeeper.GetTokenDefinitionByIndex(ctx, req.TokenDefId)
+		tokenDef, found := k.tokenDefKeeper.GetTokenDefinitionByIndex(ctx, req.TokenDefId)
 		if !found {
 			return nil, fmt.Errorf("token definition with ID '%s' not found", req.TokenDefId)
 		}
+		if tokenDef.InstitutionId != "" && tokenDef.InstitutionId != req.IssuerInstitution {
+			return nil, types.ErrInvalidInstitution.Wrapf("token definition '%s' belongs to institution '%s'", req.TokenDefId, tokenDef.InstitutionId)
+		}
+		courseId, subjectId = tokenDef.CourseId, tokenDef.SubjectId
 	}
 
 	// Check if student exists - convert string to AccAddress
@@ -113,14 +118,25 @@ func (k msgServer) MintSubjectToken(goCtx context.Context, req *types.MsgMintSub
 		if !found {
 			return nil, fmt.Errorf("institution with ID '%s' not found", req.IssuerInstitution)
 		}
+		if !k.institutionKeeper.IsInstitutionAuthorized(ctx, req.IssuerInstitution) {
+			return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", req.IssuerInstitution)
+		}
+
+		// The semester must be a term of the institution still accepting grades
+		if err := k.institutionKeeper.ValidateGradeSubmission(ctx, req.IssuerInstitution, req.Semester); err != nil {
+			return nil, err
+		}
+
+		// Only authorized contracts or holders of a grading role may mint
+		if !k.IsContractAuthorized(ctx, req.Creator) &&
+			!k.institutionKeeper.CanIssueGrade(ctx, req.IssuerInstitution, req.Creator, courseId, subjectId) {
+			return nil, types.ErrUnauthorizedMinter.Wrapf("'%s' holds no grading role for institution '%s'", req.Creator, req.IssuerInstitution)
+		}
 	}
 
 	// Check if student already has a token for this subject (prevent duplicates)
-	existingTokens := k.GetAllSubjectTokenInstances(ctx)
-	for _, token := range existingTokens {
-		if token.TokenDefId == req.TokenDefId && token.Student == req.Student {
-			return nil, fmt.Errorf("student '%s' already has a token for token definition '%s'", req.Student, req.TokenDefId)
-		}
+	if existing, found := k.GetStudentTokenForTokenDef(ctx, req.Student, req.TokenDefId); found {
+		return nil, types.ErrDuplicateTokenInstance.Wrapf("student '%s' already has token '%s' for token definition '%s'", req.Student, existing, req.TokenDefId)
 	}
 
 	// Generate a unique token instance ID
@@ -132,14 +148,18 @@ func (k msgServer) MintSubjectToken(goCtx context.Context, req *types.MsgMintSub
 		TokenDefId:         req.TokenDefId,
 		Student:            req.Student,
 		CompletionDate:     req.CompletionDate,
-		Grade:              req.Grade,
+		Grade:              grade.Value,
+		NormalizedGrade:    grade.Score.String(),
 		IssuerInstitution:  req.IssuerInstitution,
 		Semester:           req.Semester,
 		ProfessorSignature: req.ProfessorSignature,
+		SigningKeyId:       req.SigningKeyId,
 	}
 
-	// Store token instance
-	k.SetSubjectTokenInstance(ctx, tokenInstance)
+	// Store token instance within the supply of its token definition
+	if err := k.IssueSubjectTokenInstance(ctx, tokenInstance); err != nil {
+		return nil, err
+	}
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
@@ -148,11 +168,12 @@ func (k msgServer) MintSubjectToken(goCtx context.Context, req *types.MsgMintSub
 			sdk.NewAttribute("token_instance_id", tokenInstanceId),
 			sdk.NewAttribute("token_def_id", req.TokenDefId),
 			sdk.NewAttribute("student", req.Student),
-			sdk.NewAttribute("grade", req.Grade),
+			sdk.NewAttribute("grade", grade.Value),
+			sdk.NewAttribute("normalized_grade", grade.Score.String()),
 			sdk.NewAttribute("completion_date", req.CompletionDate),
 			sdk.NewAttribute("semester", req.Semester),
 			sdk.NewAttribute("issuer_institution", req.IssuerInstitution),
-			sdk.NewAttribute("minted_date", time.Now().UTC().Format(time.RFC3339)),
+			sdk.NewAttribute("minted_date", ctx.BlockTime().UTC().Format(time.RFC3339)),
 			sdk.NewAttribute("creator", req.Creator),
 		),
 	)
@@ -195,9 +216,11 @@ func (k msgServer) VerifyTokenInstance(goCtx context.Context, req *types.MsgVeri
 		}, nil
 	}
 
+	// Revoked tokens are never valid
+	isValid := !tokenInstance.Revoked
+
 	// Check if token definition exists
-	isValid := true
-	if k.tokenDefKeeper != nil {
+	if isValid && k.tokenDefKeeper != nil {
 		_, found := k.tokenDefKeeper.GetTokenDefinitionByIndex(ctx, tokenInstance.TokenDefId)
 		if !found {
 			isValid = false
@@ -225,9 +248,9 @@ func (k msgServer) VerifyTokenInstance(goCtx context.Context, req *types.MsgVeri
 		}
 	}
 
-	// Validate grade format
+	// Validate grade against the institution grading scale
 	if isValid {
-		_, err := strconv.ParseFloat(tokenInstance.Grade, 64)
+		_, err := k.normalizeGrade(ctx, tokenInstance.IssuerInstitution, tokenInstance.Grade)
 		if err != nil {
 			isValid = false
 		}
@@ -245,6 +268,12 @@ func (k msgServer) VerifyTokenInstance(goCtx context.Context, req *types.MsgVeri
 		}
 	}
 
+	// Signed tokens must still match the signature of a key valid at issuance
+	signature := k.SignatureStatus(ctx, tokenInstance)
+	if isValid && signature.SigningKeyId != "" && !signature.Verified() {
+		isValid = false
+	}
+
 	// Update verification status if valid by setting token again
 	// Note: Since there's no IsVerified field in the current struct,
 	// we just perform validation without modifying the token
@@ -255,6 +284,8 @@ func (k msgServer) VerifyTokenInstance(goCtx context.Context, req *types.MsgVeri
 			"token_instance_verified",
 			sdk.NewAttribute("token_instance_id", req.TokenInstanceId),
 			sdk.NewAttribute("is_valid", fmt.Sprintf("%t", isValid)),
+			sdk.NewAttribute("signing_key_id", signature.SigningKeyId),
+			sdk.NewAttribute("signer", signature.Signer),
 			sdk.NewAttribute("verifier", req.Creator),
 		),
 	)
@@ -266,6 +297,273 @@ func (k msgServer) VerifyTokenInstance(goCtx context.Context, req *types.MsgVeri
 	)
 
 	return &types.MsgVerifyTokenInstanceResponse{
-		IsValid: isValid,
+		IsValid:            isValid,
+		SigningKeyId:       signature.SigningKeyId,
+		Signer:             signature.Signer,
+		SignatureValid:     signature.SignatureValid,
+		KeyValidAtIssuance: signature.KeyValidAtIssuance,
 	}, nil
 }
+
+// AmendGrade corrects the grade of a token instance, recording the previous
+// grade in its amendment history and updating the student GPA
+func (k msgServer) AmendGrade(goCtx context.Context, req *types.MsgAmendGrade) (*types.MsgAmendGradeResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenInstance, found := k.GetSubjectTokenInstance(ctx, req.TokenInstanceId)
+	if !found {
+		return nil, types.ErrTokenInstanceNotFound.Wrapf("token instance '%s' not found", req.TokenInstanceId)
+	}
+	if tokenInstance.Revoked {
+		return nil, types.ErrTokenRevoked.Wrapf("token instance '%s' was revoked", req.TokenInstanceId)
+	}
+
+	// Only the module authority, authorized contracts or holders of a grading
+	// role may amend. Without an institution keeper roles cannot be checked, so
+	// only the module authority and authorized contracts may, as when minting.
+	courseId, subjectId := k.tokenSubject(ctx, tokenInstance)
+	if req.Creator != k.authority && !k.IsContractAuthorized(ctx, req.Creator) && (k.institutionKeeper == nil ||
+		!k.institutionKeeper.CanIssueGrade(ctx, tokenInstance.IssuerInstitution, req.Creator, courseId, subjectId)) {
+		return nil, types.ErrUnauthorizedAmendment.Wrapf("'%s' holds no grading role for institution '%s'", req.Creator, tokenInstance.IssuerInstitution)
+	}
+	if k.institutionKeeper != nil && !k.institutionKeeper.IsInstitutionAuthorized(ctx, tokenInstance.IssuerInstitution) {
+		return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", tokenInstance.IssuerInstitution)
+	}
+
+	amended, err := k.normalizeGrade(ctx, tokenInstance.IssuerInstitution, req.NewGrade)
+	if err != nil {
+		return nil, err
+	}
+	if !amended.Passing {
+		return nil, types.ErrInvalidGrade.Wrapf("grade %s is below the passing grade of institution '%s'; revoke the token instead", amended.Value, tokenInstance.IssuerInstitution)
+	}
+	previous := k.previousGrade(ctx, tokenInstance)
+
+	tokenInstance.Amendments = append(tokenInstance.Amendments, types.GradeAmendment{
+		PreviousGrade:           tokenInstance.Grade,
+		NewGrade:                amended.Value,
+		PreviousNormalizedGrade: tokenInstance.NormalizedGrade,
+		NewNormalizedGrade:      amended.Score.String(),
+		Reason:                  req.Reason,
+		AmendedBy:               req.Creator,
+		AmendedAt:               ctx.BlockTime().UTC().Format(time.RFC3339),
+	})
+	tokenInstance.Grade = amended.Value
+	tokenInstance.NormalizedGrade = amended.Score.String()
+	k.SetSubjectTokenInstance(ctx, tokenInstance)
+
+	// Keep the student GPA in line with the amended grade
+	if k.studentKeeper != nil && subjectId != "" {
+		if err := k.studentKeeper.AmendSubjectGrade(ctx, tokenInstance.Student, subjectId, tokenInstance.Index, previous, amended); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"subject_token_grade_amended",
+			sdk.NewAttribute("token_instance_id", tokenInstance.Index),
+			sdk.NewAttribute("previous_grade", previous.Value),
+			sdk.NewAttribute("grade", amended.Value),
+			sdk.NewAttribute("normalized_grade", amended.Score.String()),
+			sdk.NewAttribute("reason", req.Reason),
+			sdk.NewAttribute("creator", req.Creator),
+		),
+	)
+
+	return &types.MsgAmendGradeResponse{
+		NormalizedGrade: amended.Score.String(),
+	}, nil
+}
+
+// RevokeTokenInstance revokes a token instance, removing the subject from the
+// student academic tree and flagging dependent degree requests for revalidation
+func (k msgServer) RevokeTokenInstance(goCtx context.Context, req *types.MsgRevokeTokenInstance) (*types.MsgRevokeTokenInstanceResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenInstance, found := k.GetSubjectTokenInstance(ctx, req.TokenInstanceId)
+	if !found {
+		return nil, types.ErrTokenInstanceNotFound.Wrapf("token instance '%s' not found", req.TokenInstanceId)
+	}
+	if tokenInstance.Revoked {
+		return nil, types.ErrTokenRevoked.Wrapf("token instance '%s' was already revoked", req.TokenInstanceId)
+	}
+
+	// Only the module authority or institution admins may revoke, and only the
+	// module authority when admins cannot be checked
+	if req.Creator != k.authority && (k.institutionKeeper == nil ||
+		!k.institutionKeeper.IsInstitutionAdmin(ctx, tokenInstance.IssuerInstitution, req.Creator)) {
+		return nil, types.ErrUnauthorizedAmendment.Wrapf("'%s' is not an admin of institution '%s'", req.Creator, tokenInstance.IssuerInstitution)
+	}
+
+	_, subjectId := k.tokenSubject(ctx, tokenInstance)
+	grade := k.previousGrade(ctx, tokenInstance)
+
+	tokenInstance.Revoked = true
+	tokenInstance.RevocationReason = req.Reason
+	tokenInstance.RevokedBy = req.Creator
+	tokenInstance.RevokedAt = ctx.BlockTime().UTC().Format(time.RFC3339)
+	k.SetSubjectTokenInstance(ctx, tokenInstance)
+
+	// Cascade to the student academic tree and the degree requests relying on it
+	var invalidated []string
+	if k.studentKeeper != nil {
+		if subjectId != "" {
+			if err := k.studentKeeper.RevokeSubjectCompletion(ctx, tokenInstance.Student, subjectId, tokenInstance.Index, grade); err != nil {
+				return nil, err
+			}
+		}
+
+		if k.degreeKeeper != nil {
+			if studentAddr, err := sdk.AccAddressFromBech32(tokenInstance.Student); err == nil {
+				if student, found := k.studentKeeper.GetStudentByAddress(ctx, studentAddr); found {
+					reason := fmt.Sprintf("token instance %s revoked: %s", tokenInstance.Index, req.Reason)
+					invalidated = k.degreeKeeper.InvalidatePendingDegreeRequests(ctx, student.Index, tokenInstance.IssuerInstitution, subjectId, reason)
+				}
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"subject_token_revoked",
+			sdk.NewAttribute("token_instance_id", tokenInstance.Index),
+			sdk.NewAttribute("student", tokenInstance.Student),
+			sdk.NewAttribute("reason", req.Reason),
+			sdk.NewAttribute("invalidated_degree_requests", strings.Join(invalidated, ",")),
+			sdk.NewAttribute("creator", req.Creator),
+		),
+	)
+
+	k.Logger().Info("Subject token revoked",
+		"token_instance_id", tokenInstance.Index,
+		"student", tokenInstance.Student,
+		"reason", req.Reason,
+		"creator", req.Creator,
+	)
+
+	return &types.MsgRevokeTokenInstanceResponse{
+		InvalidatedDegreeRequests: invalidated,
+	}, nil
+}
+
+// TransferTokenInstance moves a token instance from its owner to another
+// student when the token definition is transferable
+func (k msgServer) TransferTokenInstance(goCtx context.Context, req *types.MsgTransferTokenInstance) (*types.MsgTransferTokenInstanceResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenInstance, found := k.GetSubjectTokenInstance(ctx, req.TokenInstanceId)
+	if !found {
+		return nil, types.ErrTokenInstanceNotFound.Wrapf("token instance '%s' not found", req.TokenInstanceId)
+	}
+	if tokenInstance.Student != req.Creator {
+		return nil, types.ErrUnauthorizedOwner.Wrapf("token instance '%s' is owned by '%s'", req.TokenInstanceId, tokenInstance.Student)
+	}
+	if tokenInstance.Revoked {
+		return nil, types.ErrTokenRevoked.Wrapf("token instance '%s' was revoked", req.TokenInstanceId)
+	}
+
+	tokenDef, found := k.tokenDefinition(ctx, tokenInstance.TokenDefId)
+	if !found {
+		return nil, types.ErrInvalidTokenDef.Wrapf("token definition '%s' not found", tokenInstance.TokenDefId)
+	}
+	if !tokenDef.IsTransferable {
+		return nil, types.ErrNotTransferable.Wrapf("token definition '%s' is not transferable", tokenInstance.TokenDefId)
+	}
+
+	// The recipient must be a student without a token for the same definition
+	if k.studentKeeper != nil {
+		recipientAddr, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient address format: %w", err)
+		}
+		if _, found := k.studentKeeper.GetStudentByAddress(ctx, recipientAddr); !found {
+			return nil, types.ErrInvalidStudent.Wrapf("student with address '%s' not found", req.Recipient)
+		}
+	}
+	if existing, found := k.GetStudentTokenForTokenDef(ctx, req.Recipient, tokenInstance.TokenDefId); found {
+		return nil, types.ErrDuplicateTokenInstance.Wrapf("student '%s' already has token '%s' for token definition '%s'", req.Recipient, existing, tokenInstance.TokenDefId)
+	}
+
+	k.TransferSubjectTokenInstance(ctx, tokenInstance, req.Recipient)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"subject_token_transferred",
+			sdk.NewAttribute("token_instance_id", tokenInstance.Index),
+			sdk.NewAttribute("token_def_id", tokenInstance.TokenDefId),
+			sdk.NewAttribute("sender", req.Creator),
+			sdk.NewAttribute("recipient", req.Recipient),
+		),
+	)
+
+	return &types.MsgTransferTokenInstanceResponse{}, nil
+}
+
+// BurnTokenInstance destroys a token instance when the token definition is
+// burnable. The academic record of the student is left untouched; use
+// RevokeTokenInstance to withdraw a completion.
+func (k msgServer) BurnTokenInstance(goCtx context.Context, req *types.MsgBurnTokenInstance) (*types.MsgBurnTokenInstanceResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenInstance, found := k.GetSubjectTokenInstance(ctx, req.TokenInstanceId)
+	if !found {
+		return nil, types.ErrTokenInstanceNotFound.Wrapf("token instance '%s' not found", req.TokenInstanceId)
+	}
+
+	// Only the owner, the module authority or institution admins may burn
+	if req.Creator != tokenInstance.Student && req.Creator != k.authority &&
+		(k.institutionKeeper == nil || !k.institutionKeeper.IsInstitutionAdmin(ctx, tokenInstance.IssuerInstitution, req.Creator)) {
+		return nil, types.ErrUnauthorizedOwner.Wrapf("'%s' neither owns token instance '%s' nor administers institution '%s'", req.Creator, req.TokenInstanceId, tokenInstance.IssuerInstitution)
+	}
+
+	tokenDef, found := k.tokenDefinition(ctx, tokenInstance.TokenDefId)
+	if !found {
+		return nil, types.ErrInvalidTokenDef.Wrapf("token definition '%s' not found", tokenInstance.TokenDefId)
+	}
+	if !tokenDef.IsBurnable {
+		return nil, types.ErrNotBurnable.Wrapf("token definition '%s' is not burnable", tokenInstance.TokenDefId)
+	}
+
+	k.BurnSubjectTokenInstance(ctx, tokenInstance)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"subject_token_burned",
+			sdk.NewAttribute("token_instance_id", tokenInstance.Index),
+			sdk.NewAttribute("token_def_id", tokenInstance.TokenDefId),
+			sdk.NewAttribute("owner", tokenInstance.Student),
+			sdk.NewAttribute("creator", req.Creator),
+		),
+	)
+
+	return &types.MsgBurnTokenInstanceResponse{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -31,21 +32,9 @@ func (k Keeper) isAuthorizedContractCall(ctx sdk.Context, caller string) bool {
 	}
 	
 	// Check against authorized contract addresses
-	// Since AuthorizedContracts is not in Params, we use a hardcoded list for now
-	// TODO: Add AuthorizedContracts to Params in future update
-	authorizedContracts := []string{
-		// Add actual contract addresses here
-		// For now, we'll use mock addresses for testing
-		"cosmos1mockcontract1address", // Mock NFT Minting Contract
-		"cosmos1mockcontract2address", // Mock Degree Contract  
-		"cosmos1mockcontract3address", // Mock Academic Progress Contract
-	}
-	
-	for _, contractAddr := range authorizedContracts {
-		if caller == contractAddr {
-			k.Logger().Info("Authorized contract call", "caller", caller, "contract", contractAddr)
-			return true
-		}
+	if k.hasAuthorizedContract(ctx, caller) {
+		k.Logger().Info("Authorized contract call", "caller", caller)
+		return true
 	}
 	
 	// Check if caller is admin (from params)
@@ -57,7 +46,6 @@ func (k Keeper) isAuthorizedContractCall(ctx sdk.Context, caller string) bool {
 	k.Logger().Error("Unauthorized contract call attempt", 
 		"caller", caller,
 		"module_addr", moduleAddr.String(),
-		"authorized_contracts", authorizedContracts,
 		"admin", params.Admin,
 	)
 	
@@ -132,67 +120,92 @@ func (k Keeper) calculateContractAuthorizationHash(data string) string {
 }
 
 // AddAuthorizedContract adds a contract address to the authorized list (governance)
-// Note: Since AuthorizedContracts is not in current Params, this is a placeholder
 func (k Keeper) AddAuthorizedContract(ctx sdk.Context, contractAddress string) error {
-	// TODO: Implement when AuthorizedContracts is added to Params
-	
-	k.Logger().Info("Contract authorization request (not implemented)", 
+	if contractAddress == "" {
+		return fmt.Errorf("contract address cannot be empty")
+	}
+
+	k.SetAuthorizedContract(ctx, contractAddress)
+
+	k.Logger().Info("Contract authorized",
 		"contract_address", contractAddress)
-	
+
 	// Emit event for tracking
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			"contract_authorization_requested",
+			"contract_authorized",
 			sdk.NewAttribute("contract_address", contractAddress),
 			sdk.NewAttribute("requested_by", k.authority),
-			sdk.NewAttribute("status", "pending_params_update"),
 		),
 	)
-	
-	return fmt.Errorf("contract authorization not implemented - AuthorizedContracts field needed in Params")
+
+	return nil
 }
 
 // RemoveAuthorizedContract removes a contract address from the authorized list (governance)
-// Note: Since AuthorizedContracts is not in current Params, this is a placeholder
 func (k Keeper) RemoveAuthorizedContract(ctx sdk.Context, contractAddress string) error {
-	// TODO: Implement when AuthorizedContracts is added to Params
-	
-	k.Logger().Info("Contract deauthorization request (not implemented)", 
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.AuthorizedContractKey(contractAddress))
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("contract %s is not authorized", contractAddress)
+	}
+
+	if err := store.Delete(types.AuthorizedContractKey(contractAddress)); err != nil {
+		return err
+	}
+
+	k.Logger().Info("Contract deauthorized",
 		"contract_address", contractAddress)
-	
+
 	// Emit event for tracking
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			"contract_deauthorization_requested",
+			"contract_deauthorized",
 			sdk.NewAttribute("contract_address", contractAddress),
 			sdk.NewAttribute("requested_by", k.authority),
-			sdk.NewAttribute("status", "pending_params_update"),
 		),
 	)
-	
-	return fmt.Errorf("contract deauthorization not implemented - AuthorizedContracts field needed in Params")
+
+	return nil
+}
+
+// SetAuthorizedContract stores a contract address in the authorized list
+func (k Keeper) SetAuthorizedContract(ctx sdk.Context, contractAddress string) {
+	store := k.storeService.OpenKVStore(ctx)
+	store.Set(types.AuthorizedContractKey(contractAddress), []byte{1}) // Just a marker
 }
 
 // GetAuthorizedContracts returns the list of authorized contract addresses
 func (k Keeper) GetAuthorizedContracts(ctx sdk.Context) []string {
-	// TODO: Return from params when AuthorizedContracts is added
-	
-	// Return hardcoded list for now
-	return []string{
-		"cosmos1mockcontract1address",
-		"cosmos1mockcontract2address", 
-		"cosmos1mockcontract3address",
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.AuthorizedContractKeyPrefix, storetypes.PrefixEndBytes(types.AuthorizedContractKeyPrefix))
+	if err != nil {
+		return []string{}
+	}
+	defer iterator.Close()
+
+	var contracts []string
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, string(iterator.Key()[len(types.AuthorizedContractKeyPrefix):]))
 	}
+
+	return contracts
+}
+
+// hasAuthorizedContract reports whether a contract address is in the authorized list
+func (k Keeper) hasAuthorizedContract(ctx sdk.Context, contractAddress string) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.AuthorizedContractKey(contractAddress))
+	return err == nil && has
 }
 
 // IsContractAuthorized checks if a specific contract address is authorized
 func (k Keeper) IsContractAuthorized(ctx sdk.Context, contractAddress string) bool {
-	authorizedContracts := k.GetAuthorizedContracts(ctx)
-	
-	for _, addr := range authorizedContracts {
-		if addr == contractAddress {
-			return true
-		}
+	if k.hasAuthorizedContract(ctx, contractAddress) {
+		return true
 	}
 	
 	// Also check if it's the admin
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/academicnft/types"
+	institutiontypes "academictoken/x/institution/types"
+)
+
+// verifyIssuerSignature checks the professor or registrar signature of a new
+// token instance against the signing keys of the issuing institution and
+// records the key that produced it. Institutions that registered signing keys
+// require a signature; a signature that is given must always verify. The key
+// must be active and its owner must be allowed to grade the subject.
+func (k Keeper) verifyIssuerSignature(ctx sdk.Context, tokenInstance *types.SubjectTokenInstance, courseId string, subjectId string) error {
+	if k.institutionKeeper == nil {
+		return nil
+	}
+
+	keys := k.institutionKeeper.GetInstitutionSigningKeys(ctx, tokenInstance.IssuerInstitution)
+	if tokenInstance.ProfessorSignature == "" {
+		if len(keys) > 0 {
+			return types.ErrMissingSignature.Wrapf("institution '%s' requires signed token instances", tokenInstance.IssuerInstitution)
+		}
+		tokenInstance.SigningKeyId = ""
+		return nil
+	}
+
+	sig, err := types.DecodeSignature(tokenInstance.ProfessorSignature)
+	if err != nil {
+		return err
+	}
+
+	// A named key is the only candidate, otherwise every key of the institution is tried
+	if tokenInstance.SigningKeyId != "" {
+		key, found := k.institutionKeeper.GetSigningKey(ctx, tokenInstance.IssuerInstitution, tokenInstance.SigningKeyId)
+		if !found {
+			return types.ErrInvalidSignature.Wrapf("signing key '%s' is not registered by institution '%s'", tokenInstance.SigningKeyId, tokenInstance.IssuerInstitution)
+		}
+		if !key.IsActive() {
+			return types.ErrInvalidSignature.Wrapf("signing key '%s' was revoked at %s", key.KeyId, key.RevokedAt)
+		}
+		keys = []institutiontypes.SigningKey{key}
+	}
+
+	signBytes := subjectTokenSignBytes(*tokenInstance, tokenInstance.Grade)
+	for _, key := range keys {
+		if !key.IsActive() || !key.VerifySignature(signBytes, sig) {
+			continue
+		}
+		if !k.institutionKeeper.CanIssueGrade(ctx, tokenInstance.IssuerInstitution, key.Owner, courseId, subjectId) {
+			return types.ErrInvalidSignature.Wrapf("owner '%s' of signing key '%s' cannot grade this subject", key.Owner, key.KeyId)
+		}
+		tokenInstance.SigningKeyId = key.KeyId
+		return nil
+	}
+
+	return types.ErrInvalidSignature.Wrapf("signature does not match an active signing key of institution '%s'", tokenInstance.IssuerInstitution)
+}
+
+// SignatureStatus reports which key signed a token instance, whether the
+// signature still matches the token and whether the key was valid when the
+// token was issued. Amended tokens are checked against their original grade.
+func (k Keeper) SignatureStatus(ctx sdk.Context, tokenInstance types.SubjectTokenInstance) types.SignatureStatus {
+	status := types.SignatureStatus{SigningKeyId: tokenInstance.SigningKeyId}
+	if tokenInstance.SigningKeyId == "" || k.institutionKeeper == nil {
+		return status
+	}
+
+	key, found := k.institutionKeeper.GetSigningKey(ctx, tokenInstance.IssuerInstitution, tokenInstance.SigningKeyId)
+	if !found {
+		return status
+	}
+	status.Signer = key.Owner
+
+	grade := tokenInstance.Grade
+	if len(tokenInstance.Amendments) > 0 {
+		grade = tokenInstance.Amendments[0].PreviousGrade
+	}
+	if sig, err := types.DecodeSignature(tokenInstance.ProfessorSignature); err == nil {
+		status.SignatureValid = key.VerifySignature(subjectTokenSignBytes(tokenInstance, grade), sig)
+	}
+	if issuedAt, err := time.Parse(time.RFC3339, tokenInstance.IssuedAt); err == nil {
+		status.KeyValidAtIssuance = key.ValidAt(issuedAt)
+	}
+
+	return status
+}
+
+func subjectTokenSignBytes(tokenInstance types.SubjectTokenInstance, grade string) []byte {
+	return types.SubjectTokenSignBytes(tokenInstance.Student, tokenInstance.TokenDefId, grade, tokenInstance.Semester, tokenInstance.CompletionDate)
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/academicnft/keeper"
+	"academictoken/x/academicnft/types"
+	institutiontypes "academictoken/x/institution/types"
+)
+
+func TestIssuerSignatures(t *testing.T) {
+	professor, outsider := sample.AccAddress(), sample.AccAddress()
+	professorKey := secp256k1.GenPrivKey()
+	outsiderKey := ed25519.GenPrivKey()
+	registeredAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	institutionKeeper := keepertest.MockAcademicNFTInstitutionKeeper{
+		Graders: map[string]bool{professor: true},
+		SigningKeys: map[string]institutiontypes.SigningKey{
+			"professor-key": {Institution: "inst-1", KeyId: "professor-key", Owner: professor, Algorithm: institutiontypes.SigningAlgorithmSecp256k1, PubKey: professorKey.PubKey().Bytes(), RegisteredAt: registeredAt.Format(time.RFC3339)},
+			"outsider-key":  {Institution: "inst-1", KeyId: "outsider-key", Owner: outsider, Algorithm: institutiontypes.SigningAlgorithmEd25519, PubKey: outsiderKey.PubKey().Bytes(), RegisteredAt: registeredAt.Format(time.RFC3339)},
+		},
+	}
+	k, ctx := keepertest.AcademicnftKeeperWithInstitution(t, institutionKeeper)
+	ctx = ctx.WithBlockTime(registeredAt.Add(time.Hour))
+	ms := keeper.NewMsgServerImpl(k)
+
+	sign := func(privKey cryptotypes.PrivKey, student string, grade string) string {
+		sig, err := privKey.Sign(types.SubjectTokenSignBytes(student, "tokendef-1", grade, "2025-1", "2025-06-30"))
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+	mint := func(student string, grade string, signature string, keyId string) (string, error) {
+		res, err := ms.MintSubjectToken(ctx, &types.MsgMintSubjectToken{
+			Creator:            professor,
+			TokenDefId:         "tokendef-1",
+			Student:            student,
+			CompletionDate:     "2025-06-30",
+			Grade:              grade,
+			IssuerInstitution:  "inst-1",
+			Semester:           "2025-1",
+			ProfessorSignature: signature,
+			SigningKeyId:       keyId,
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.TokenInstanceId, nil
+	}
+
+	student := sample.AccAddress()
+
+	// Institutions with signing keys only accept signed tokens
+	_, err := mint(student, "80", "", "")
+	require.ErrorIs(t, err, types.ErrMissingSignature)
+	// The signature covers the grade
+	_, err = mint(student, "90", sign(professorKey, student, "80"), "")
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	// The signature must come from the named key
+	_, err = mint(student, "80", sign(professorKey, student, "80"), "outsider-key")
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	// Keys of accounts that cannot grade the subject are rejected
+	_, err = mint(student, "80", sign(outsiderKey, student, "80"), "")
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// The signing key is found without being named
+	tokenId, err := mint(student, "80", sign(professorKey, student, "80"), "")
+	require.NoError(t, err)
+	token, found := k.GetSubjectTokenInstance(ctx, tokenId)
+	require.True(t, found)
+	require.Equal(t, "professor-key", token.SigningKeyId)
+
+	verified, err := ms.VerifyTokenInstance(ctx, &types.MsgVerifyTokenInstance{Creator: outsider, TokenInstanceId: tokenId})
+	require.NoError(t, err)
+	require.True(t, verified.IsValid)
+	require.Equal(t, "professor-key", verified.SigningKeyId)
+	require.Equal(t, professor, verified.Signer)
+	require.True(t, verified.SignatureValid)
+	require.True(t, verified.KeyValidAtIssuance)
+
+	// Amendments keep the signature of the original grade valid
+	_, err = ms.AmendGrade(ctx, types.NewMsgAmendGrade(professor, tokenId, "85", "recount"))
+	require.NoError(t, err)
+
+	// Revoking the key stops new tokens but keeps earlier ones verifiable
+	revoked := institutionKeeper.SigningKeys["professor-key"]
+	revoked.RevokedAt = registeredAt.Add(2 * time.Hour).Format(time.RFC3339)
+	institutionKeeper.SigningKeys["professor-key"] = revoked
+	ctx = ctx.WithBlockTime(registeredAt.Add(3 * time.Hour))
+
+	other := sample.AccAddress()
+	_, err = mint(other, "80", sign(professorKey, other, "80"), "professor-key")
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	_, err = mint(other, "80", sign(professorKey, other, "80"), "")
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	res, err := k.VerifyTokenInstance(ctx, &types.QueryVerifyTokenInstanceRequest{TokenInstanceId: tokenId})
+	require.NoError(t, err)
+	require.True(t, res.IsValid)
+	require.True(t, res.SignatureValid)
+	require.True(t, res.KeyValidAtIssuance)
+	require.Equal(t, professor, res.Signer)
+
+	// Tampering with the signed fields invalidates the token
+	token, _ = k.GetSubjectTokenInstance(ctx, tokenId)
+	token.Semester = "2025-2"
+	k.SetSubjectTokenInstance(ctx, token)
+	res, err = k.VerifyTokenInstance(ctx, &types.QueryVerifyTokenInstanceRequest{TokenInstanceId: tokenId})
+	require.NoError(t, err)
+	require.False(t, res.IsValid)
+	require.False(t, res.SignatureValid)
+	require.True(t, res.KeyValidAtIssuance)
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/x/academicnft/types"
+)
+
+func TestStudentTokenDefIndex(t *testing.T) {
+	k, ctx := keepertest.AcademicnftKeeper(t)
+
+	k.SetSubjectTokenInstance(ctx, types.SubjectTokenInstance{Index: "token-1", Student: "student-1", TokenDefId: "tokendef-1"})
+	id, found := k.GetStudentTokenForTokenDef(ctx, "student-1", "tokendef-1")
+	require.True(t, found)
+	require.Equal(t, "token-1", id)
+	_, found = k.GetStudentTokenForTokenDef(ctx, "student-1", "tokendef-2")
+	require.False(t, found)
+
+	// Revoking frees the slot without touching a newer token
+	k.SetSubjectTokenInstance(ctx, types.SubjectTokenInstance{Index: "token-1", Student: "student-1", TokenDefId: "tokendef-1", Revoked: true})
+	_, found = k.GetStudentTokenForTokenDef(ctx, "student-1", "tokendef-1")
+	require.False(t, found)
+
+	k.SetSubjectTokenInstance(ctx, types.SubjectTokenInstance{Index: "token-2", Student: "student-1", TokenDefId: "tokendef-1"})
+	k.RemoveSubjectTokenInstance(ctx, "token-1")
+	id, found = k.GetStudentTokenForTokenDef(ctx, "student-1", "tokendef-1")
+	require.True(t, found)
+	require.Equal(t, "token-2", id)
+
+	k.RemoveSubjectTokenInstance(ctx, "token-2")
+	_, found = k.GetStudentTokenForTokenDef(ctx, "student-1", "tokendef-1")
+	require.False(t, found)
+}
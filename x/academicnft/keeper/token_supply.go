@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/x/academicnft/types"
+	tokendeftypes "academictoken/x/tokendef/types"
+)
+
+// GetTokenSupply returns the supply accounting of a token definition
+func (k Keeper) GetTokenSupply(ctx sdk.Context, tokenDefId string) types.TokenSupply {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TokenSupplyKey(tokenDefId))
+	if err != nil || bz == nil {
+		return types.TokenSupply{TokenDefId: tokenDefId}
+	}
+
+	var supply types.TokenSupply
+	k.cdc.MustUnmarshal(bz, &supply)
+	return supply
+}
+
+// SetTokenSupply stores the supply accounting of a token definition
+func (k Keeper) SetTokenSupply(ctx sdk.Context, supply types.TokenSupply) {
+	store := k.storeService.OpenKVStore(ctx)
+	store.Set(types.TokenSupplyKey(supply.TokenDefId), k.cdc.MustMarshal(&supply))
+}
+
+// GetAllTokenSupplies returns the supply accounting of every token definition
+func (k Keeper) GetAllTokenSupplies(ctx sdk.Context) (list []types.TokenSupply) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.TokenSupplyKeyPrefix, storetypes.PrefixEndBytes(types.TokenSupplyKeyPrefix))
+	if err != nil {
+		return list
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var supply types.TokenSupply
+		k.cdc.MustUnmarshal(iterator.Value(), &supply)
+		list = append(list, supply)
+	}
+
+	return
+}
+
+// IssueSubjectTokenInstance stores a newly minted token instance, enforcing the
+// maximum supply of its token definition and verifying the issuer signature.
+// A maximum supply of 0 is unlimited.
+func (k Keeper) IssueSubjectTokenInstance(ctx sdk.Context, tokenInstance types.SubjectTokenInstance) error {
+	supply := k.GetTokenSupply(ctx, tokenInstance.TokenDefId)
+	if tokenDef, found := k.tokenDefinition(ctx, tokenInstance.TokenDefId); found &&
+		tokenDef.MaxSupply > 0 && supply.Minted >= tokenDef.MaxSupply {
+		return types.ErrMintingLimitExceeded.Wrapf("token definition '%s' reached its max supply of %d", tokenInstance.TokenDefId, tokenDef.MaxSupply)
+	}
+
+	// Transfer credits are issued by the chain on an approved equivalence,
+	// there is no grade for a professor to sign
+	if !tokenInstance.TransferCredit {
+		courseId, subjectId := k.tokenSubject(ctx, tokenInstance)
+		if err := k.verifyIssuerSignature(ctx, &tokenInstance, courseId, subjectId); err != nil {
+			return err
+		}
+	}
+	tokenInstance.IssuedAt = ctx.BlockTime().UTC().Format(time.RFC3339)
+
+	supply.Minted++
+	k.SetTokenSupply(ctx, supply)
+	k.SetSubjectTokenInstance(ctx, tokenInstance)
+	return nil
+}
+
+// TransferSubjectTokenInstance moves a token instance to a new owner, moving
+// its owner indexes along with it
+func (k Keeper) TransferSubjectTokenInstance(ctx sdk.Context, tokenInstance types.SubjectTokenInstance, recipient string) {
+	k.removeStudentTokenIndex(ctx, tokenInstance.Student, tokenInstance.Index)
+	k.removeStudentTokenDefIndex(ctx, tokenInstance.Student, tokenInstance.TokenDefId, tokenInstance.Index)
+
+	tokenInstance.Student = recipient
+	k.SetSubjectTokenInstance(ctx, tokenInstance)
+}
+
+// BurnSubjectTokenInstance removes a token instance and its indexes, counting
+// it as burned. Burning does not free supply.
+func (k Keeper) BurnSubjectTokenInstance(ctx sdk.Context, tokenInstance types.SubjectTokenInstance) {
+	k.RemoveSubjectTokenInstance(ctx, tokenInstance.Index)
+
+	supply := k.GetTokenSupply(ctx, tokenInstance.TokenDefId)
+	supply.Burned++
+	k.SetTokenSupply(ctx, supply)
+}
+
+// tokenDefinition returns the token definition of a token instance, if the
+// token definition keeper is available
+func (k Keeper) tokenDefinition(ctx sdk.Context, tokenDefId string) (tokendeftypes.TokenDefinition, bool) {
+	if k.tokenDefKeeper == nil {
+		return tokendeftypes.TokenDefinition{}, false
+	}
+	return k.tokenDefKeeper.GetTokenDefinitionByIndex(ctx, tokenDefId)
+}
+
+// TokenSupply returns the supply of a TokenDef and how many instances can still be minted (Query Server method)
+func (k Keeper) TokenSupply(goCtx context.Context, req *types.QueryTokenSupplyRequest) (*types.QueryTokenSupplyResponse, error) {
+	if req == nil || req.TokenDefId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenDef, found := k.tokenDefinition(ctx, req.TokenDefId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "token definition not found")
+	}
+
+	supply := k.GetTokenSupply(ctx, req.TokenDefId)
+	res := &types.QueryTokenSupplyResponse{
+		Supply:      supply,
+		MaxSupply:   tokenDef.MaxSupply,
+		Circulating: supply.Minted - supply.Burned,
+		Unlimited:   tokenDef.MaxSupply == 0,
+	}
+	if tokenDef.MaxSupply > supply.Minted {
+		res.Remaining = tokenDef.MaxSupply - supply.Minted
+	}
+
+	return res, nil
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/academicnft/keeper"
+	"academictoken/x/academicnft/types"
+	tokendeftypes "academictoken/x/tokendef/types"
+)
+
+func TestTokenSupplyTransferAndBurn(t *testing.T) {
+	k, ctx := keepertest.AcademicnftKeeperWithTokenDefs(t,
+		tokendeftypes.TokenDefinition{Index: "tokendef-1", InstitutionId: "inst-1", MaxSupply: 2, IsTransferable: true, IsBurnable: true},
+		tokendeftypes.TokenDefinition{Index: "tokendef-2", InstitutionId: "inst-1"},
+	)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	alice, bob, carol := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	mint := func(tokenDefId string, student string) (string, error) {
+		res, err := ms.MintSubjectToken(ctx, &types.MsgMintSubjectToken{
+			Creator:           creator,
+			TokenDefId:        tokenDefId,
+			Student:           student,
+			CompletionDate:    "2025-06-30",
+			Grade:             "80",
+			IssuerInstitution: "inst-1",
+			Semester:          "2025-1",
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.TokenInstanceId, nil
+	}
+
+	first, err := mint("tokendef-1", alice)
+	require.NoError(t, err)
+	_, err = mint("tokendef-1", bob)
+	require.NoError(t, err)
+	_, err = mint("tokendef-1", carol)
+	require.ErrorIs(t, err, types.ErrMintingLimitExceeded)
+
+	supply, err := k.TokenSupply(ctx, &types.QueryTokenSupplyRequest{TokenDefId: "tokendef-1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), supply.Supply.Minted)
+	require.Equal(t, uint64(0), supply.Remaining)
+	require.False(t, supply.Unlimited)
+
+	// Only the owner may transfer, and never to a student holding the same token
+	_, err = ms.TransferTokenInstance(ctx, types.NewMsgTransferTokenInstance(bob, first, carol))
+	require.ErrorIs(t, err, types.ErrUnauthorizedOwner)
+	_, err = ms.TransferTokenInstance(ctx, types.NewMsgTransferTokenInstance(alice, first, bob))
+	require.ErrorIs(t, err, types.ErrDuplicateTokenInstance)
+
+	_, err = ms.TransferTokenInstance(ctx, types.NewMsgTransferTokenInstance(alice, first, carol))
+	require.NoError(t, err)
+	aliceTokens, err := k.GetStudentTokenInstances(ctx, alice)
+	require.NoError(t, err)
+	require.Empty(t, aliceTokens)
+	_, found := k.GetStudentTokenForTokenDef(ctx, alice, "tokendef-1")
+	require.False(t, found)
+	id, found := k.GetStudentTokenForTokenDef(ctx, carol, "tokendef-1")
+	require.True(t, found)
+	require.Equal(t, first, id)
+
+	// Burning does not free supply
+	_, err = ms.BurnTokenInstance(ctx, types.NewMsgBurnTokenInstance(alice, first))
+	require.ErrorIs(t, err, types.ErrUnauthorizedOwner)
+	_, err = ms.BurnTokenInstance(ctx, types.NewMsgBurnTokenInstance(carol, first))
+	require.NoError(t, err)
+	require.False(t, k.HasSubjectTokenInstance(ctx, first))
+	carolTokens, err := k.GetStudentTokenInstances(ctx, carol)
+	require.NoError(t, err)
+	require.Empty(t, carolTokens)
+
+	supply, err = k.TokenSupply(ctx, &types.QueryTokenSupplyRequest{TokenDefId: "tokendef-1"})
+	require.NoError(t, err)
+	require.Equal(t, types.TokenSupply{TokenDefId: "tokendef-1", Minted: 2, Burned: 1}, supply.Supply)
+	require.Equal(t, uint64(1), supply.Circulating)
+	require.Equal(t, uint64(0), supply.Remaining)
+	_, err = mint("tokendef-1", carol)
+	require.ErrorIs(t, err, types.ErrMintingLimitExceeded)
+
+	// The token definition flags are honoured
+	locked, err := mint("tokendef-2", alice)
+	require.NoError(t, err)
+	_, err = ms.TransferTokenInstance(ctx, types.NewMsgTransferTokenInstance(alice, locked, bob))
+	require.ErrorIs(t, err, types.ErrNotTransferable)
+	_, err = ms.BurnTokenInstance(ctx, types.NewMsgBurnTokenInstance(alice, locked))
+	require.ErrorIs(t, err, types.ErrNotBurnable)
+
+	supply, err = k.TokenSupply(ctx, &types.QueryTokenSupplyRequest{TokenDefId: "tokendef-2"})
+	require.NoError(t, err)
+	require.True(t, supply.Unlimited)
+	require.Len(t, k.GetAllTokenSupplies(ctx), 2)
+}
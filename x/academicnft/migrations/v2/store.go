@@ -0,0 +1,34 @@
+package v2
+
+import (
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/academicnft/types"
+)
+
+// MigrateStore backfills the (student, tokenDefId) index for every token
+// instance minted before the index existed. Revoked tokens are skipped so the
+// subject can be issued again.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	iterator := storetypes.KVStorePrefixIterator(kvStore, types.SubjectTokenInstanceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenInstance types.SubjectTokenInstance
+		if err := cdc.Unmarshal(iterator.Value(), &tokenInstance); err != nil {
+			return err
+		}
+		if tokenInstance.Revoked {
+			continue
+		}
+		kvStore.Set(types.StudentTokenDefIndexKey(tokenInstance.Student, tokenInstance.TokenDefId), []byte(tokenInstance.Index))
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package v2_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "academictoken/x/academicnft/migrations/v2"
+	"academictoken/x/academicnft/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// v1 stored token instances without the (student, tokenDefId) index
+	for _, tokenInstance := range []types.SubjectTokenInstance{
+		{Index: "token-1", Student: "student-1", TokenDefId: "tokendef-1"},
+		{Index: "token-2", Student: "student-1", TokenDefId: "tokendef-2"},
+		{Index: "token-3", Student: "student-2", TokenDefId: "tokendef-1", Revoked: true},
+	} {
+		bz, err := cdc.Marshal(&tokenInstance)
+		require.NoError(t, err)
+		ctx.KVStore(storeKey).Set(types.SubjectTokenInstanceKey(tokenInstance.Index), bz)
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	kvStore := ctx.KVStore(storeKey)
+	require.Equal(t, []byte("token-1"), kvStore.Get(types.StudentTokenDefIndexKey("student-1", "tokendef-1")))
+	require.Equal(t, []byte("token-2"), kvStore.Get(types.StudentTokenDefIndexKey("student-1", "tokendef-2")))
+	require.Nil(t, kvStore.Get(types.StudentTokenDefIndexKey("student-2", "tokendef-1")))
+}
@@ -0,0 +1,45 @@
+package v3
+
+import (
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/academicnft/types"
+)
+
+// MigrateStore counts every token instance minted before supply accounting
+// existed, revoked ones included, against the supply of its token definition.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	var tokenDefIds []string
+	minted := make(map[string]uint64)
+
+	iterator := storetypes.KVStorePrefixIterator(kvStore, types.SubjectTokenInstanceKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenInstance types.SubjectTokenInstance
+		if err := cdc.Unmarshal(iterator.Value(), &tokenInstance); err != nil {
+			iterator.Close()
+			return err
+		}
+		if _, ok := minted[tokenInstance.TokenDefId]; !ok {
+			tokenDefIds = append(tokenDefIds, tokenInstance.TokenDefId)
+		}
+		minted[tokenInstance.TokenDefId]++
+	}
+	iterator.Close()
+
+	for _, tokenDefId := range tokenDefIds {
+		supply := types.TokenSupply{TokenDefId: tokenDefId, Minted: minted[tokenDefId]}
+		bz, err := cdc.Marshal(&supply)
+		if err != nil {
+			return err
+		}
+		kvStore.Set(types.TokenSupplyKey(tokenDefId), bz)
+	}
+
+	return nil
+}
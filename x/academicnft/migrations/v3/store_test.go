@@ -0,0 +1,41 @@
+package v3_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v3 "academictoken/x/academicnft/migrations/v3"
+	"academictoken/x/academicnft/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// v2 stored token instances without supply accounting
+	for _, tokenInstance := range []types.SubjectTokenInstance{
+		{Index: "token-1", Student: "student-1", TokenDefId: "tokendef-1"},
+		{Index: "token-2", Student: "student-1", TokenDefId: "tokendef-2"},
+		{Index: "token-3", Student: "student-2", TokenDefId: "tokendef-1", Revoked: true},
+	} {
+		bz, err := cdc.Marshal(&tokenInstance)
+		require.NoError(t, err)
+		ctx.KVStore(storeKey).Set(types.SubjectTokenInstanceKey(tokenInstance.Index), bz)
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, storeService, cdc))
+
+	for tokenDefId, minted := range map[string]uint64{"tokendef-1": 2, "tokendef-2": 1} {
+		var supply types.TokenSupply
+		require.NoError(t, cdc.Unmarshal(ctx.KVStore(storeKey).Get(types.TokenSupplyKey(tokenDefId)), &supply))
+		require.Equal(t, types.TokenSupply{TokenDefId: tokenDefId, Minted: minted}, supply)
+	}
+}
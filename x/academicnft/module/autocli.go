@@ -17,6 +17,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "TokenSupply",
+					Use:            "token-supply [token-def-id]",
+					Short:          "Show the supply of a token definition and how many instances can still be minted",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenDefId"}},
+				},
+				{
+					RpcMethod:      "SubjectTokenCredential",
+					Use:            "subject-token-credential [token-instance-id]",
+					Short:          "Export a token instance as a W3C Verifiable Credential",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenInstanceId"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -40,6 +52,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a verify-token-instance tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenInstanceId"}},
 				},
+				{
+					RpcMethod:      "AmendGrade",
+					Use:            "amend-grade [token-instance-id] [new-grade] [reason]",
+					Short:          "Correct the grade of a subject token instance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenInstanceId"}, {ProtoField: "newGrade"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "RevokeTokenInstance",
+					Use:            "revoke-token-instance [token-instance-id] [reason]",
+					Short:          "Revoke a subject token instance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenInstanceId"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "TransferTokenInstance",
+					Use:            "transfer-token-instance [token-instance-id] [recipient]",
+					Short:          "Transfer a subject token instance to another student",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenInstanceId"}, {ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "BurnTokenInstance",
+					Use:            "burn-token-instance [token-instance-id]",
+					Short:          "Burn a subject token instance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenInstanceId"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -9,6 +9,37 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the subjectTokenInstance; this also rebuilds the student and
+	// tokendef secondary indexes
+	for _, elem := range genState.SubjectTokenInstanceList {
+		k.SetSubjectTokenInstance(ctx, elem)
+	}
+
+	// Set token instance count
+	k.SetTokenInstanceCount(ctx, genState.TokenInstanceCount)
+
+	// Set the supply accounting of every token definition. Token definitions
+	// without one count their token instances as minted.
+	for _, supply := range genState.TokenSupplies {
+		k.SetTokenSupply(ctx, supply)
+	}
+	accounted := make(map[string]bool, len(genState.TokenSupplies))
+	for _, supply := range genState.TokenSupplies {
+		accounted[supply.TokenDefId] = true
+	}
+	for _, elem := range genState.SubjectTokenInstanceList {
+		if !accounted[elem.TokenDefId] {
+			supply := k.GetTokenSupply(ctx, elem.TokenDefId)
+			supply.Minted++
+			k.SetTokenSupply(ctx, supply)
+		}
+	}
+
+	// Set all the authorized contracts
+	for _, contract := range genState.AuthorizedContracts {
+		k.SetAuthorizedContract(ctx, contract)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -20,6 +51,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.SubjectTokenInstanceList = k.GetAllSubjectTokenInstances(ctx)
+	genesis.TokenInstanceCount = k.GetTokenInstanceCount(ctx)
+	genesis.AuthorizedContracts = k.GetAuthorizedContracts(ctx)
+	genesis.TokenSupplies = k.GetAllTokenSupplies(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -15,6 +15,13 @@ func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
+		SubjectTokenInstanceList: []types.SubjectTokenInstance{
+			{Index: "token-instance-1", Student: "student-0", TokenDefId: "tokendef-0", Grade: "85"},
+			{Index: "token-instance-2", Student: "student-0", TokenDefId: "tokendef-1", Grade: "90"},
+			{Index: "token-instance-3", Student: "student-1", TokenDefId: "tokendef-0", Grade: "70"},
+		},
+		TokenInstanceCount:  3,
+		AuthorizedContracts: []string{"contract-0"},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -26,5 +33,17 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.SubjectTokenInstanceList, got.SubjectTokenInstanceList)
+	require.Equal(t, genesisState.TokenInstanceCount, got.TokenInstanceCount)
+	require.ElementsMatch(t, genesisState.AuthorizedContracts, got.AuthorizedContracts)
+
+	// secondary indexes are rebuilt on import
+	studentTokens, err := k.GetStudentTokenInstances(ctx, "student-0")
+	require.NoError(t, err)
+	require.Len(t, studentTokens, 2)
+	tokenDefTokens, err := k.GetTokenDefInstancesInternal(ctx, "tokendef-0")
+	require.NoError(t, err)
+	require.Len(t, tokenDefTokens, 2)
+	require.True(t, k.IsContractAuthorized(ctx, "contract-0"))
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -14,6 +14,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgVerifyTokenInstance{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAmendGrade{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeTokenInstance{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferTokenInstance{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBurnTokenInstance{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"academictoken/x/academicnft/credential"
+	tokendeftypes "academictoken/x/tokendef/types"
+)
+
+// CredentialTokenDefinition is the token definition metadata embedded in a
+// subject credential
+type CredentialTokenDefinition struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Symbol      string `json:"symbol,omitempty"`
+	Type        string `json:"type,omitempty"`
+	SubjectID   string `json:"subjectId,omitempty"`
+	CourseID    string `json:"courseId,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImageURI    string `json:"imageUri,omitempty"`
+	ContentHash string `json:"contentHash,omitempty"`
+	IPFSLink    string `json:"ipfsLink,omitempty"`
+}
+
+// NewCredentialTokenDefinition extracts the credential metadata of a token definition
+func NewCredentialTokenDefinition(tokenDef tokendeftypes.TokenDefinition) CredentialTokenDefinition {
+	def := CredentialTokenDefinition{
+		ID:          tokenDef.Index,
+		Name:        tokenDef.TokenName,
+		Symbol:      tokenDef.TokenSymbol,
+		Type:        tokenDef.TokenType,
+		SubjectID:   tokenDef.SubjectId,
+		CourseID:    tokenDef.CourseId,
+		ContentHash: tokenDef.ContentHash,
+		IPFSLink:    tokenDef.IpfsLink,
+	}
+	if tokenDef.Metadata != nil {
+		def.Description = tokenDef.Metadata.Description
+		def.ImageURI = tokenDef.Metadata.ImageUri
+	}
+	return def
+}
+
+// SubjectCredentialSubject is the credentialSubject of a subject credential
+type SubjectCredentialSubject struct {
+	ID              string                    `json:"id"`
+	TokenInstanceID string                    `json:"tokenInstanceId"`
+	TokenDefinition CredentialTokenDefinition `json:"tokenDefinition"`
+	Grade           string                    `json:"grade"`
+	NormalizedGrade string                    `json:"normalizedGrade,omitempty"`
+	Semester        string                    `json:"semester,omitempty"`
+	CompletionDate  string                    `json:"completionDate,omitempty"`
+	Amendments      int                       `json:"amendments,omitempty"`
+	SigningKeyID    string                    `json:"signingKeyId,omitempty"`
+	IssuerSignature string                    `json:"issuerSignature,omitempty"`
+}
+
+// NewSubjectTokenCredential renders a token instance as a verifiable
+// credential anchored to the stored record. It only depends on its arguments,
+// so verifiers can render the anchored record again and compare.
+func NewSubjectTokenCredential(tokenInstance SubjectTokenInstance, tokenDef CredentialTokenDefinition, issuerName string, anchor credential.Anchor) (credential.Credential, error) {
+	chainID := anchor.ChainID
+	id := credential.URN(chainID, "subject-token", tokenInstance.Index)
+	tokenDef.ID = tokenInstance.TokenDefId
+
+	subject := SubjectCredentialSubject{
+		ID:              credential.URN(chainID, "student", tokenInstance.Student),
+		TokenInstanceID: tokenInstance.Index,
+		TokenDefinition: tokenDef,
+		Grade:           tokenInstance.Grade,
+		NormalizedGrade: tokenInstance.NormalizedGrade,
+		Semester:        tokenInstance.Semester,
+		CompletionDate:  tokenInstance.CompletionDate,
+		Amendments:      len(tokenInstance.Amendments),
+		SigningKeyID:    tokenInstance.SigningKeyId,
+		IssuerSignature: tokenInstance.ProfessorSignature,
+	}
+
+	status := credential.Status{ID: id + "#status", Type: credential.StatusTypeRecord, Status: "active"}
+	if tokenInstance.Revoked {
+		status.Status = "revoked"
+		status.Reason = tokenInstance.RevocationReason
+	}
+
+	issuer := credential.Issuer{
+		ID:   credential.URN(chainID, "institution", tokenInstance.IssuerInstitution),
+		Name: issuerName,
+	}
+	issuanceDate := credential.IssuanceDate(tokenInstance.IssuedAt, credential.IssuanceDate(tokenInstance.CompletionDate, anchor.Created))
+
+	return credential.New(credential.TypeSubjectCredential, id, issuer, issuanceDate, subject, status, anchor)
+}
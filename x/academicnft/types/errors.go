@@ -25,4 +25,13 @@ var (
 	ErrContractCallRequired          = sdkerrors.Register(ModuleName, 1114, "direct minting not allowed - must be called via authorized contract")
 	ErrInvalidContractCaller         = sdkerrors.Register(ModuleName, 1115, "caller is not an authorized contract address")
 	ErrPassiveModeViolation          = sdkerrors.Register(ModuleName, 1116, "passive mode violation - operation requires contract authorization")
+
+	ErrTokenRevoked          = sdkerrors.Register(ModuleName, 1117, "token instance revoked")
+	ErrUnauthorizedAmendment = sdkerrors.Register(ModuleName, 1118, "unauthorized to amend or revoke tokens of this institution")
+	ErrNotTransferable       = sdkerrors.Register(ModuleName, 1119, "token definition is not transferable")
+	ErrNotBurnable           = sdkerrors.Register(ModuleName, 1120, "token definition is not burnable")
+	ErrUnauthorizedOwner     = sdkerrors.Register(ModuleName, 1121, "not the owner of the token instance")
+	ErrInvalidSignature      = sdkerrors.Register(ModuleName, 1122, "invalid issuer signature")
+	ErrMissingSignature      = sdkerrors.Register(ModuleName, 1123, "issuer signature required")
+	ErrInstitutionNotAccredited = sdkerrors.Register(ModuleName, 1124, "institution is not accredited")
 )
@@ -25,6 +25,24 @@ type InstitutionKeeper interface {
 
 	// IsInstitutionAuthorized checks if institution is authorized - using real method
 	IsInstitutionAuthorized(ctx sdk.Context, index string) bool
+
+	// CanIssueGrade checks if an address holds a grading role for the subject
+	CanIssueGrade(ctx sdk.Context, institution string, address string, courseId string, subjectId string) bool
+
+	// IsInstitutionAdmin checks if an address administers the institution
+	IsInstitutionAdmin(ctx sdk.Context, institution string, address string) bool
+
+	// ValidateGradeSubmission checks that a term of the institution still accepts grades
+	ValidateGradeSubmission(ctx sdk.Context, institution string, termId string) error
+
+	// NormalizeGrade validates a grade against the institution grading scale
+	NormalizeGrade(ctx sdk.Context, institution string, grade string) (institutionmoduletypes.NormalizedGrade, error)
+
+	// GetInstitutionSigningKeys returns the signing keys registered by the institution
+	GetInstitutionSigningKeys(ctx sdk.Context, institution string) []institutionmoduletypes.SigningKey
+
+	// GetSigningKey returns a signing key of the institution
+	GetSigningKey(ctx sdk.Context, institution string, keyId string) (institutionmoduletypes.SigningKey, bool)
 }
 
 // TokenMetadata defines metadata for tokens
@@ -78,6 +96,19 @@ type StudentKeeper interface {
 
 	// ValidateStudentEligibility checks if student can receive tokens from institution
 	ValidateStudentEligibility(ctx sdk.Context, studentAddress string, institutionID string) error
+
+	// AmendSubjectGrade updates the academic tree after the grade of a completed subject was amended
+	AmendSubjectGrade(ctx sdk.Context, studentAddress string, subjectId string, tokenInstanceID string, previous institutionmoduletypes.NormalizedGrade, amended institutionmoduletypes.NormalizedGrade) error
+
+	// RevokeSubjectCompletion removes a revoked subject from the academic tree
+	RevokeSubjectCompletion(ctx sdk.Context, studentAddress string, subjectId string, tokenInstanceID string, grade institutionmoduletypes.NormalizedGrade) error
+}
+
+// DegreeKeeper defines the expected interface for the Degree module
+type DegreeKeeper interface {
+	// InvalidatePendingDegreeRequests flags the open degree requests of a student
+	// whose curriculum needs a revoked subject for revalidation
+	InvalidatePendingDegreeRequests(ctx sdk.Context, studentId string, institutionId string, subjectId string, reason string) []string
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters
@@ -1,13 +1,25 @@
 package types
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+// TokenInstanceIDPrefix is the prefix used by GenerateTokenInstanceID
+const TokenInstanceIDPrefix = "token-instance-"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		SubjectTokenInstanceList: []SubjectTokenInstance{},
+		AuthorizedContracts:      []string{},
+		TokenSupplies:            []TokenSupply{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -16,6 +28,79 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated index in subjectTokenInstance
+	tokenInstanceIndexMap := make(map[string]struct{})
+	// Check for duplicated (student, tokenDefId) pairs among active tokens, a
+	// revoked token can be minted again
+	studentTokenDefMap := make(map[string]string)
+	for _, elem := range gs.SubjectTokenInstanceList {
+		if elem.Index == "" {
+			return fmt.Errorf("token instance index cannot be empty")
+		}
+		if _, ok := tokenInstanceIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for subjectTokenInstance: %s", elem.Index)
+		}
+		tokenInstanceIndexMap[elem.Index] = struct{}{}
+
+		if strings.HasPrefix(elem.Index, TokenInstanceIDPrefix) {
+			id, err := strconv.ParseUint(strings.TrimPrefix(elem.Index, TokenInstanceIDPrefix), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid token instance index: %s", elem.Index)
+			}
+			if id > gs.TokenInstanceCount {
+				return fmt.Errorf("token instance %s exceeds the token instance count %d", elem.Index, gs.TokenInstanceCount)
+			}
+		}
+
+		if elem.Student == "" || elem.TokenDefId == "" {
+			return fmt.Errorf("token instance %s must have a student and a token definition", elem.Index)
+		}
+		if elem.Revoked {
+			continue
+		}
+		pairKey := elem.Student + "/" + elem.TokenDefId
+		if existing, ok := studentTokenDefMap[pairKey]; ok {
+			return fmt.Errorf("student %s has duplicate tokens %s and %s for token definition %s",
+				elem.Student, existing, elem.Index, elem.TokenDefId)
+		}
+		studentTokenDefMap[pairKey] = elem.Index
+	}
+
+	// Check for duplicated authorized contracts
+	authorizedContractMap := make(map[string]struct{})
+	for _, contract := range gs.AuthorizedContracts {
+		if contract == "" {
+			return fmt.Errorf("authorized contract address cannot be empty")
+		}
+		if _, ok := authorizedContractMap[contract]; ok {
+			return fmt.Errorf("duplicated authorized contract: %s", contract)
+		}
+		authorizedContractMap[contract] = struct{}{}
+	}
+
+	// Every token instance must be accounted for in the supply of its token definition
+	instancesByTokenDef := make(map[string]uint64)
+	for _, elem := range gs.SubjectTokenInstanceList {
+		instancesByTokenDef[elem.TokenDefId]++
+	}
+	supplyMap := make(map[string]struct{})
+	for _, supply := range gs.TokenSupplies {
+		if supply.TokenDefId == "" {
+			return fmt.Errorf("token supply must have a token definition")
+		}
+		if _, ok := supplyMap[supply.TokenDefId]; ok {
+			return fmt.Errorf("duplicated token supply for token definition: %s", supply.TokenDefId)
+		}
+		supplyMap[supply.TokenDefId] = struct{}{}
+		if supply.Burned > supply.Minted {
+			return fmt.Errorf("token definition %s burned %d of %d minted tokens", supply.TokenDefId, supply.Burned, supply.Minted)
+		}
+		if supply.Minted-supply.Burned != instancesByTokenDef[supply.TokenDefId] {
+			return fmt.Errorf("token definition %s has %d circulating tokens but %d token instances",
+				supply.TokenDefId, supply.Minted-supply.Burned, instancesByTokenDef[supply.TokenDefId])
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
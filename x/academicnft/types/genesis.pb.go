@@ -27,7 +27,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the academicnft module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params                   Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SubjectTokenInstanceList []SubjectTokenInstance `protobuf:"bytes,2,rep,name=subjectTokenInstanceList,proto3" json:"subjectTokenInstanceList"`
+	TokenInstanceCount       uint64                 `protobuf:"varint,3,opt,name=tokenInstanceCount,proto3" json:"tokenInstanceCount,omitempty"`
+	AuthorizedContracts      []string               `protobuf:"bytes,4,rep,name=authorizedContracts,proto3" json:"authorizedContracts,omitempty"`
+	TokenSupplies            []TokenSupply          `protobuf:"bytes,5,rep,name=tokenSupplies,proto3" json:"tokenSupplies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +74,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetSubjectTokenInstanceList() []SubjectTokenInstance {
+	if m != nil {
+		return m.SubjectTokenInstanceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenInstanceCount() uint64 {
+	if m != nil {
+		return m.TokenInstanceCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAuthorizedContracts() []string {
+	if m != nil {
+		return m.AuthorizedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenSupplies() []TokenSupply {
+	if m != nil {
+		return m.TokenSupplies
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "academictoken.academicnft.GenesisState")
 }
@@ -79,20 +111,30 @@ func init() {
 }
 
 var fileDescriptor_37cd95b7a47b99dc = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
+	// 353 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0x4c, 0x4e, 0x4c,
 	0x49, 0xcd, 0xcd, 0x4c, 0x2e, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x87, 0xf1, 0xf2, 0xd2, 0x4a, 0xf4,
 	0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24, 0x51,
 	0x14, 0xea, 0x21, 0x29, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xd5,
 	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x55, 0xc3, 0x6d, 0x59,
-	0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x2e, 0xa5, 0x10, 0x2e, 0x1e, 0x77, 0x88, 0xe5, 0xc1, 0x25,
-	0x89, 0x25, 0xa9, 0x42, 0x2e, 0x5c, 0x6c, 0x10, 0x79, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x45, 0x3d, 0x9c, 0x8e, 0xd1, 0x0b, 0x00, 0x2b, 0x74, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5,
-	0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0xbd, 0x4e, 0xd6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0xa5, 0x88, 0xea, 0xae, 0x0a, 0x14, 0x97, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0x5d, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x51, 0x29, 0x32, 0x71, 0x30, 0x01,
-	0x00, 0x00,
+	0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x2e, 0x29, 0x33, 0xdc, 0xea, 0x8a, 0x4b, 0x93, 0xb2, 0x52,
+	0x93, 0x4b, 0xe2, 0xc1, 0x32, 0xf1, 0x99, 0x79, 0xc5, 0x25, 0x89, 0x79, 0xc9, 0xa9, 0x50, 0x7d,
+	0x3a, 0xb8, 0xf5, 0x41, 0xd4, 0x17, 0x97, 0x16, 0x14, 0xe4, 0x54, 0x42, 0x54, 0x2b, 0xfd, 0x65,
+	0xe2, 0xe2, 0x71, 0x87, 0xf8, 0x31, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x85, 0x8b, 0x0d, 0xe2,
+	0x0c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x45, 0x3d, 0x9c, 0x7e, 0xd6, 0x0b, 0x00, 0x2b,
+	0x74, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0xbd, 0x42,
+	0x85, 0x5c, 0x12, 0x50, 0x47, 0x86, 0x80, 0x74, 0x79, 0x42, 0x9d, 0xe8, 0x93, 0x59, 0x5c, 0x22,
+	0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0x8f, 0xc7, 0xdc, 0x60, 0x2c, 0x5a, 0x9d, 0x58, 0x40,
+	0xb6, 0x04, 0xe1, 0x34, 0x56, 0x48, 0x8f, 0x4b, 0xa8, 0x04, 0x59, 0xd0, 0x39, 0xbf, 0x34, 0xaf,
+	0x44, 0x82, 0x59, 0x81, 0x51, 0x83, 0x25, 0x08, 0x8b, 0x8c, 0x90, 0x01, 0x97, 0x70, 0x62, 0x69,
+	0x49, 0x46, 0x7e, 0x51, 0x66, 0x55, 0x6a, 0x8a, 0x73, 0x7e, 0x5e, 0x49, 0x51, 0x62, 0x72, 0x49,
+	0xb1, 0x04, 0x8b, 0x02, 0xb3, 0x06, 0x67, 0x10, 0x36, 0x29, 0xa1, 0x20, 0x2e, 0x5e, 0xb0, 0x39,
+	0xc1, 0xa0, 0x00, 0xcc, 0x4c, 0x2d, 0x96, 0x60, 0x05, 0xfb, 0x44, 0x0d, 0x8f, 0x4f, 0x42, 0xe0,
+	0xea, 0x2b, 0xa1, 0x1e, 0x40, 0x35, 0xc2, 0xc9, 0xfa, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
+	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
+	0xe5, 0x18, 0xa2, 0x14, 0x51, 0xe3, 0xb1, 0x02, 0x35, 0x26, 0x2b, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
+	0xc0, 0x71, 0x68, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x60, 0x06, 0x96, 0xc0, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +157,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenSupplies) > 0 {
+		for iNdEx := len(m.TokenSupplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AuthorizedContracts) > 0 {
+		for iNdEx := len(m.AuthorizedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthorizedContracts[iNdEx])
+			copy(dAtA[i:], m.AuthorizedContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuthorizedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TokenInstanceCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TokenInstanceCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SubjectTokenInstanceList) > 0 {
+		for iNdEx := len(m.SubjectTokenInstanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubjectTokenInstanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +231,27 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SubjectTokenInstanceList) > 0 {
+		for _, e := range m.SubjectTokenInstanceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TokenInstanceCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TokenInstanceCount))
+	}
+	if len(m.AuthorizedContracts) > 0 {
+		for _, s := range m.AuthorizedContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenSupplies) > 0 {
+		for _, e := range m.TokenSupplies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +323,125 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectTokenInstanceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectTokenInstanceList = append(m.SubjectTokenInstanceList, SubjectTokenInstance{})
+			if err := m.SubjectTokenInstanceList[len(m.SubjectTokenInstanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceCount", wireType)
+			}
+			m.TokenInstanceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenInstanceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedContracts = append(m.AuthorizedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSupplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSupplies = append(m.TokenSupplies, TokenSupply{})
+			if err := m.TokenSupplies[len(m.TokenSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -20,13 +20,90 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				SubjectTokenInstanceList: []types.SubjectTokenInstance{
+					{Index: "token-instance-1", Student: "student-0", TokenDefId: "tokendef-0"},
+					{Index: "token-instance-2", Student: "student-0", TokenDefId: "tokendef-1"},
+				},
+				TokenInstanceCount:  2,
+				AuthorizedContracts: []string{"contract-0", "contract-1"},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "token supply matching the token instances",
+			genState: &types.GenesisState{
+				SubjectTokenInstanceList: []types.SubjectTokenInstance{
+					{Index: "token-instance-1", Student: "student-0", TokenDefId: "tokendef-0"},
+				},
+				TokenInstanceCount: 2,
+				TokenSupplies:      []types.TokenSupply{{TokenDefId: "tokendef-0", Minted: 2, Burned: 1}},
+			},
+			valid: true,
+		},
+		{
+			desc: "token supply not matching the token instances",
+			genState: &types.GenesisState{
+				SubjectTokenInstanceList: []types.SubjectTokenInstance{
+					{Index: "token-instance-1", Student: "student-0", TokenDefId: "tokendef-0"},
+				},
+				TokenInstanceCount: 1,
+				TokenSupplies:      []types.TokenSupply{{TokenDefId: "tokendef-0", Minted: 2}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated subjectTokenInstance",
+			genState: &types.GenesisState{
+				SubjectTokenInstanceList: []types.SubjectTokenInstance{
+					{Index: "token-instance-1", Student: "student-0", TokenDefId: "tokendef-0"},
+					{Index: "token-instance-1", Student: "student-1", TokenDefId: "tokendef-0"},
+				},
+				TokenInstanceCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated student and token definition pair",
+			genState: &types.GenesisState{
+				SubjectTokenInstanceList: []types.SubjectTokenInstance{
+					{Index: "token-instance-1", Student: "student-0", TokenDefId: "tokendef-0"},
+					{Index: "token-instance-2", Student: "student-0", TokenDefId: "tokendef-0"},
+				},
+				TokenInstanceCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "student and token definition pair minted again after revocation",
+			genState: &types.GenesisState{
+				SubjectTokenInstanceList: []types.SubjectTokenInstance{
+					{Index: "token-instance-1", Student: "student-0", TokenDefId: "tokendef-0", Revoked: true},
+					{Index: "token-instance-2", Student: "student-0", TokenDefId: "tokendef-0"},
+				},
+				TokenInstanceCount: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "token instance above count",
+			genState: &types.GenesisState{
+				SubjectTokenInstanceList: []types.SubjectTokenInstance{
+					{Index: "token-instance-3", Student: "student-0", TokenDefId: "tokendef-0"},
+				},
+				TokenInstanceCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated authorized contract",
+			genState: &types.GenesisState{
+				AuthorizedContracts: []string{"contract-0", "contract-0"},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -45,6 +45,15 @@ var (
 
 	// ParamsKey is the key for storing module parameters
 	ParamsKey = []byte{0x05}
+
+	// AuthorizedContractKeyPrefix is the prefix for contracts allowed to mint in passive mode
+	AuthorizedContractKeyPrefix = []byte{0x06}
+
+	// StudentTokenDefIndexKeyPrefix is the prefix for the (student, tokenDefId) index
+	StudentTokenDefIndexKeyPrefix = []byte{0x07}
+
+	// TokenSupplyKeyPrefix is the prefix for the supply accounting of each TokenDef
+	TokenSupplyKeyPrefix = []byte{0x08}
 )
 
 // SubjectTokenInstanceKey returns the store key for a subject token instance
@@ -80,6 +89,19 @@ func StudentTokenIndexPrefix(studentAddress string) []byte {
 	return append(prefix, []byte("/")...)
 }
 
+// StudentTokenDefIndexKey returns the store key of the token instance a
+// student holds for a token definition
+func StudentTokenDefIndexKey(studentAddress, tokenDefId string) []byte {
+	prefix := StudentTokenDefIndexPrefix(studentAddress)
+	return append(prefix, []byte(tokenDefId)...)
+}
+
+// StudentTokenDefIndexPrefix returns the prefix for a student's (student, tokenDefId) indexes
+func StudentTokenDefIndexPrefix(studentAddress string) []byte {
+	prefix := append(StudentTokenDefIndexKeyPrefix, []byte(studentAddress)...)
+	return append(prefix, []byte("/")...)
+}
+
 // StudentTokenIndexPrefixCompat returns the prefix using legacy string format
 func StudentTokenIndexPrefixCompat(studentAddress string) []byte {
 	return append([]byte(StudentTokenIndexKeyPrefixStr), []byte(studentAddress + "/")...)
@@ -107,6 +129,16 @@ func TokenDefInstanceIndexPrefixCompat(tokenDefId string) []byte {
 	return append([]byte(TokenDefInstanceIndexKeyPrefixStr), []byte(tokenDefId + "/")...)
 }
 
+// AuthorizedContractKey returns the store key for an authorized contract address
+func AuthorizedContractKey(contractAddress string) []byte {
+	return append(AuthorizedContractKeyPrefix, []byte(contractAddress)...)
+}
+
+// TokenSupplyKey returns the store key for the supply of a token definition
+func TokenSupplyKey(tokenDefId string) []byte {
+	return append(TokenSupplyKeyPrefix, []byte(tokenDefId)...)
+}
+
 // GetSubjectTokenInstanceIDBytes returns the byte representation of the ID
 func GetSubjectTokenInstanceIDBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAmendGrade_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAmendGrade
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAmendGrade{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing grade",
+			msg: MsgAmendGrade{
+				Creator:         sample.AccAddress(),
+				TokenInstanceId: "token-1",
+				Reason:          "typo",
+			},
+			err: ErrInvalidGrade,
+		}, {
+			name: "missing reason",
+			msg: MsgAmendGrade{
+				Creator:         sample.AccAddress(),
+				TokenInstanceId: "token-1",
+				NewGrade:        "85",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgAmendGrade{
+				Creator:         sample.AccAddress(),
+				TokenInstanceId: "token-1",
+				NewGrade:        "85",
+				Reason:          "typo",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
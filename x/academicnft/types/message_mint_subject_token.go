@@ -61,6 +61,15 @@ func (msg *MsgMintSubjectToken) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "issuerInstitution cannot be empty")
 	}
 
+	// Issuer signatures are base64 and name their key when one is given
+	if msg.ProfessorSignature != "" {
+		if _, err := DecodeSignature(msg.ProfessorSignature); err != nil {
+			return err
+		}
+	} else if msg.SigningKeyId != "" {
+		return errorsmod.Wrap(ErrMissingSignature, "signingKeyId given without a signature")
+	}
+
 	// NOTE: PASSIVE MODULE VALIDATION is handled by ExtendedMsgMintSubjectToken
 	// This base message only validates core fields
 
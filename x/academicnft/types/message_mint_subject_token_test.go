@@ -21,6 +21,30 @@ func TestMsgMintSubjectToken_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "signature is not base64",
+			msg: MsgMintSubjectToken{
+				Creator:            sample.AccAddress(),
+				TokenDefId:         "tokendef-1",
+				Student:            sample.AccAddress(),
+				CompletionDate:     "2025-06-30",
+				Grade:              "80",
+				IssuerInstitution:  "inst-1",
+				ProfessorSignature: "not base64!",
+			},
+			err: ErrInvalidSignature,
+		}, {
+			name: "signing key without a signature",
+			msg: MsgMintSubjectToken{
+				Creator:           sample.AccAddress(),
+				TokenDefId:        "tokendef-1",
+				Student:           sample.AccAddress(),
+				CompletionDate:    "2025-06-30",
+				Grade:             "80",
+				IssuerInstitution: "inst-1",
+				SigningKeyId:      "key-1",
+			},
+			err: ErrMissingSignature,
 		}, {
 			name: "valid address",
 			msg: MsgMintSubjectToken{
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRevokeTokenInstance{}
+
+func NewMsgRevokeTokenInstance(creator string, tokenInstanceId string, reason string) *MsgRevokeTokenInstance {
+	return &MsgRevokeTokenInstance{
+		Creator:         creator,
+		TokenInstanceId: tokenInstanceId,
+		Reason:          reason,
+	}
+}
+
+func (msg *MsgRevokeTokenInstance) ValidateBasic() error {
+	// Validate creator address
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// Validate required fields
+	if msg.TokenInstanceId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tokenInstanceId cannot be empty")
+	}
+	if strings.TrimSpace(msg.Reason) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+
+	return nil
+}
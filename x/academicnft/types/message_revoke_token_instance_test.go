@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRevokeTokenInstance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevokeTokenInstance
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevokeTokenInstance{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing reason",
+			msg: MsgRevokeTokenInstance{
+				Creator:         sample.AccAddress(),
+				TokenInstanceId: "token-1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgRevokeTokenInstance{
+				Creator:         sample.AccAddress(),
+				TokenInstanceId: "token-1",
+				Reason:          "academic misconduct",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTransferTokenInstance{}
+
+func NewMsgTransferTokenInstance(creator string, tokenInstanceId string, recipient string) *MsgTransferTokenInstance {
+	return &MsgTransferTokenInstance{
+		Creator:         creator,
+		TokenInstanceId: tokenInstanceId,
+		Recipient:       recipient,
+	}
+}
+
+func (msg *MsgTransferTokenInstance) ValidateBasic() error {
+	// Validate creator and recipient addresses
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+
+	// Validate required fields
+	if msg.TokenInstanceId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tokenInstanceId cannot be empty")
+	}
+	if msg.Recipient == msg.Creator {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recipient must differ from the owner")
+	}
+
+	return nil
+}
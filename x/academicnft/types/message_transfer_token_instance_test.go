@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferTokenInstance_ValidateBasic(t *testing.T) {
+	owner := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgTransferTokenInstance
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferTokenInstance{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg: MsgTransferTokenInstance{
+				Creator:         owner,
+				TokenInstanceId: "token-1",
+				Recipient:       "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "transfer to self",
+			msg: MsgTransferTokenInstance{
+				Creator:         owner,
+				TokenInstanceId: "token-1",
+				Recipient:       owner,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgTransferTokenInstance{
+				Creator:         owner,
+				TokenInstanceId: "token-1",
+				Recipient:       sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
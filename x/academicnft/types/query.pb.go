@@ -205,6 +205,7 @@ func (m *QueryGetTokenInstanceResponse) GetTokenInstance() *SubjectTokenInstance
 type QueryGetStudentTokensRequest struct {
 	StudentAddress string             `protobuf:"bytes,1,opt,name=studentAddress,proto3" json:"studentAddress,omitempty"`
 	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Term           string             `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
 }
 
 func (m *QueryGetStudentTokensRequest) Reset()         { *m = QueryGetStudentTokensRequest{} }
@@ -254,6 +255,13 @@ func (m *QueryGetStudentTokensRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGetStudentTokensRequest) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
 type QueryGetStudentTokensResponse struct {
 	TokenInstances []*SubjectTokenInstance `protobuf:"bytes,1,rep,name=tokenInstances,proto3" json:"tokenInstances,omitempty"`
 	Pagination     *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -309,6 +317,7 @@ func (m *QueryGetStudentTokensResponse) GetPagination() *query.PageResponse {
 type QueryGetTokenDefInstancesRequest struct {
 	TokenDefId string             `protobuf:"bytes,1,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Term       string             `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
 }
 
 func (m *QueryGetTokenDefInstancesRequest) Reset()         { *m = QueryGetTokenDefInstancesRequest{} }
@@ -358,6 +367,13 @@ func (m *QueryGetTokenDefInstancesRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGetTokenDefInstancesRequest) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
 type QueryGetTokenDefInstancesResponse struct {
 	TokenInstances []*SubjectTokenInstance `protobuf:"bytes,1,rep,name=tokenInstances,proto3" json:"tokenInstances,omitempty"`
 	Pagination     *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -455,9 +471,13 @@ func (m *QueryVerifyTokenInstanceRequest) GetTokenInstanceId() string {
 }
 
 type QueryVerifyTokenInstanceResponse struct {
-	Exists        bool                  `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
-	IsValid       bool                  `protobuf:"varint,2,opt,name=isValid,proto3" json:"isValid,omitempty"`
-	TokenInstance *SubjectTokenInstance `protobuf:"bytes,3,opt,name=tokenInstance,proto3" json:"tokenInstance,omitempty"`
+	Exists             bool                  `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	IsValid            bool                  `protobuf:"varint,2,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	TokenInstance      *SubjectTokenInstance `protobuf:"bytes,3,opt,name=tokenInstance,proto3" json:"tokenInstance,omitempty"`
+	SigningKeyId       string                `protobuf:"bytes,4,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"`
+	Signer             string                `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	SignatureValid     bool                  `protobuf:"varint,6,opt,name=signatureValid,proto3" json:"signatureValid,omitempty"`
+	KeyValidAtIssuance bool                  `protobuf:"varint,7,opt,name=keyValidAtIssuance,proto3" json:"keyValidAtIssuance,omitempty"`
 }
 
 func (m *QueryVerifyTokenInstanceResponse) Reset()         { *m = QueryVerifyTokenInstanceResponse{} }
@@ -514,6 +534,242 @@ func (m *QueryVerifyTokenInstanceResponse) GetTokenInstance() *SubjectTokenInsta
 	return nil
 }
 
+func (m *QueryVerifyTokenInstanceResponse) GetSigningKeyId() string {
+	if m != nil {
+		return m.SigningKeyId
+	}
+	return ""
+}
+
+func (m *QueryVerifyTokenInstanceResponse) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *QueryVerifyTokenInstanceResponse) GetSignatureValid() bool {
+	if m != nil {
+		return m.SignatureValid
+	}
+	return false
+}
+
+func (m *QueryVerifyTokenInstanceResponse) GetKeyValidAtIssuance() bool {
+	if m != nil {
+		return m.KeyValidAtIssuance
+	}
+	return false
+}
+
+type QueryTokenSupplyRequest struct {
+	TokenDefId string `protobuf:"bytes,1,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
+}
+
+func (m *QueryTokenSupplyRequest) Reset()         { *m = QueryTokenSupplyRequest{} }
+func (m *QueryTokenSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenSupplyRequest) ProtoMessage()    {}
+func (*QueryTokenSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aa85d028031045e, []int{10}
+}
+func (m *QueryTokenSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenSupplyRequest.Merge(m, src)
+}
+func (m *QueryTokenSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryTokenSupplyRequest) GetTokenDefId() string {
+	if m != nil {
+		return m.TokenDefId
+	}
+	return ""
+}
+
+type QueryTokenSupplyResponse struct {
+	Supply      TokenSupply `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+	MaxSupply   uint64      `protobuf:"varint,2,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
+	Circulating uint64      `protobuf:"varint,3,opt,name=circulating,proto3" json:"circulating,omitempty"`
+	Remaining   uint64      `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Unlimited   bool        `protobuf:"varint,5,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+}
+
+func (m *QueryTokenSupplyResponse) Reset()         { *m = QueryTokenSupplyResponse{} }
+func (m *QueryTokenSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenSupplyResponse) ProtoMessage()    {}
+func (*QueryTokenSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aa85d028031045e, []int{11}
+}
+func (m *QueryTokenSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenSupplyResponse.Merge(m, src)
+}
+func (m *QueryTokenSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryTokenSupplyResponse) GetSupply() TokenSupply {
+	if m != nil {
+		return m.Supply
+	}
+	return TokenSupply{}
+}
+
+func (m *QueryTokenSupplyResponse) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
+func (m *QueryTokenSupplyResponse) GetCirculating() uint64 {
+	if m != nil {
+		return m.Circulating
+	}
+	return 0
+}
+
+func (m *QueryTokenSupplyResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *QueryTokenSupplyResponse) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
+	}
+	return false
+}
+
+type QuerySubjectTokenCredentialRequest struct {
+	TokenInstanceId string `protobuf:"bytes,1,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+}
+
+func (m *QuerySubjectTokenCredentialRequest) Reset()         { *m = QuerySubjectTokenCredentialRequest{} }
+func (m *QuerySubjectTokenCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubjectTokenCredentialRequest) ProtoMessage()    {}
+func (*QuerySubjectTokenCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aa85d028031045e, []int{12}
+}
+func (m *QuerySubjectTokenCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubjectTokenCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubjectTokenCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubjectTokenCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubjectTokenCredentialRequest.Merge(m, src)
+}
+func (m *QuerySubjectTokenCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubjectTokenCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubjectTokenCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubjectTokenCredentialRequest proto.InternalMessageInfo
+
+func (m *QuerySubjectTokenCredentialRequest) GetTokenInstanceId() string {
+	if m != nil {
+		return m.TokenInstanceId
+	}
+	return ""
+}
+
+type QuerySubjectTokenCredentialResponse struct {
+	Credential string `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+}
+
+func (m *QuerySubjectTokenCredentialResponse) Reset()         { *m = QuerySubjectTokenCredentialResponse{} }
+func (m *QuerySubjectTokenCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubjectTokenCredentialResponse) ProtoMessage()    {}
+func (*QuerySubjectTokenCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aa85d028031045e, []int{13}
+}
+func (m *QuerySubjectTokenCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubjectTokenCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubjectTokenCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubjectTokenCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubjectTokenCredentialResponse.Merge(m, src)
+}
+func (m *QuerySubjectTokenCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubjectTokenCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubjectTokenCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubjectTokenCredentialResponse proto.InternalMessageInfo
+
+func (m *QuerySubjectTokenCredentialResponse) GetCredential() string {
+	if m != nil {
+		return m.Credential
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "academictoken.academicnft.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "academictoken.academicnft.QueryParamsResponse")
@@ -525,6 +781,10 @@ func init() {
 	proto.RegisterType((*QueryGetTokenDefInstancesResponse)(nil), "academictoken.academicnft.QueryGetTokenDefInstancesResponse")
 	proto.RegisterType((*QueryVerifyTokenInstanceRequest)(nil), "academictoken.academicnft.QueryVerifyTokenInstanceRequest")
 	proto.RegisterType((*QueryVerifyTokenInstanceResponse)(nil), "academictoken.academicnft.QueryVerifyTokenInstanceResponse")
+	proto.RegisterType((*QueryTokenSupplyRequest)(nil), "academictoken.academicnft.QueryTokenSupplyRequest")
+	proto.RegisterType((*QueryTokenSupplyResponse)(nil), "academictoken.academicnft.QueryTokenSupplyResponse")
+	proto.RegisterType((*QuerySubjectTokenCredentialRequest)(nil), "academictoken.academicnft.QuerySubjectTokenCredentialRequest")
+	proto.RegisterType((*QuerySubjectTokenCredentialResponse)(nil), "academictoken.academicnft.QuerySubjectTokenCredentialResponse")
 }
 
 func init() {
@@ -532,53 +792,70 @@ func init() {
 }
 
 var fileDescriptor_5aa85d028031045e = []byte{
-	// 722 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x4f, 0x13, 0x41,
-	0x18, 0xee, 0x40, 0x2c, 0xf0, 0x1a, 0x51, 0x07, 0x62, 0xb0, 0xc1, 0x85, 0xae, 0x11, 0x91, 0xc4,
-	0x9d, 0x00, 0x7e, 0x85, 0x72, 0x91, 0x10, 0x91, 0x78, 0xc1, 0xa2, 0x98, 0xe8, 0x81, 0x4c, 0xbb,
-	0xd3, 0x66, 0x95, 0xee, 0x94, 0xce, 0x94, 0x40, 0x08, 0x17, 0x8f, 0x1e, 0x8c, 0xd1, 0x3f, 0x61,
-	0xe2, 0xc5, 0xbf, 0xc0, 0xc1, 0x04, 0xe3, 0x85, 0x68, 0x4c, 0x3c, 0x19, 0x03, 0x26, 0xfe, 0x0d,
-	0xd3, 0x99, 0x59, 0xe8, 0x96, 0xed, 0x07, 0x95, 0x8b, 0x97, 0x66, 0xf7, 0xdd, 0xf7, 0xe3, 0x79,
-	0x9e, 0x79, 0xe7, 0x49, 0xe1, 0x0a, 0xcd, 0x52, 0x97, 0x15, 0xbc, 0xac, 0xe4, 0x2f, 0x98, 0x4f,
-	0x82, 0x37, 0x3f, 0x27, 0xc9, 0x6a, 0x99, 0x95, 0x36, 0x9c, 0x62, 0x89, 0x4b, 0x8e, 0x2f, 0x86,
-	0xd2, 0x9c, 0xaa, 0xb4, 0xc4, 0x79, 0x5a, 0xf0, 0x7c, 0x4e, 0xd4, 0xaf, 0xce, 0x4e, 0xf4, 0xe7,
-	0x79, 0x9e, 0xab, 0x47, 0x52, 0x79, 0x32, 0xd1, 0xc1, 0x3c, 0xe7, 0xf9, 0x15, 0x46, 0x68, 0xd1,
-	0x23, 0xd4, 0xf7, 0xb9, 0xa4, 0xd2, 0xe3, 0xbe, 0x30, 0x5f, 0xc7, 0xb2, 0x5c, 0x14, 0xb8, 0x20,
-	0x19, 0x2a, 0x98, 0x1e, 0x4d, 0xd6, 0xc6, 0x33, 0x4c, 0xd2, 0x71, 0x52, 0xa4, 0x79, 0xcf, 0x57,
-	0xc9, 0x26, 0x77, 0xa4, 0x3e, 0xe8, 0x22, 0x2d, 0xd1, 0x42, 0xd0, 0xf3, 0x56, 0xfd, 0x3c, 0x51,
-	0xce, 0x3c, 0x67, 0x59, 0xb9, 0xac, 0xbe, 0x2c, 0x7b, 0xbe, 0x90, 0xd4, 0xcf, 0x32, 0x5d, 0x67,
-	0xf7, 0x03, 0x7e, 0x58, 0x41, 0xb0, 0xa0, 0x9a, 0xa5, 0xd9, 0x6a, 0x99, 0x09, 0x69, 0x3f, 0x83,
-	0xbe, 0x50, 0x54, 0x14, 0xb9, 0x2f, 0x18, 0x9e, 0x85, 0xb8, 0x1e, 0x3a, 0x80, 0x86, 0xd1, 0xe8,
-	0xe9, 0x89, 0xa4, 0x53, 0x57, 0x2b, 0x47, 0x97, 0xce, 0xf4, 0xec, 0xfc, 0x1c, 0x8a, 0xbd, 0xff,
-	0xf3, 0x71, 0x0c, 0xa5, 0x4d, 0xad, 0x7d, 0x1f, 0x06, 0x55, 0xf3, 0x39, 0x26, 0x1f, 0x55, 0xca,
-	0xe6, 0x0d, 0x22, 0x33, 0x1c, 0x8f, 0xc2, 0x59, 0x59, 0x1d, 0x9f, 0x77, 0xd5, 0xb8, 0x9e, 0x74,
-	0x6d, 0xd8, 0x5e, 0x83, 0x4b, 0x75, 0x3a, 0x19, 0xc0, 0x8f, 0xe1, 0x4c, 0xa8, 0xc6, 0xe0, 0x26,
-	0x0d, 0x70, 0x2f, 0x6a, 0xb5, 0xc2, 0xfd, 0xc2, 0x5d, 0xec, 0xd7, 0xe8, 0x90, 0xc2, 0xa2, 0x2c,
-	0xbb, 0xcc, 0xd7, 0xf9, 0x81, 0x7e, 0x78, 0x04, 0x7a, 0x85, 0x8e, 0xdf, 0x75, 0xdd, 0x12, 0x13,
-	0xc2, 0x30, 0xa8, 0x89, 0xe2, 0x7b, 0x00, 0x87, 0x27, 0x3e, 0xd0, 0xa1, 0xc0, 0x8d, 0x38, 0x7a,
-	0x3d, 0x9c, 0xca, 0x7a, 0x38, 0x7a, 0x33, 0xcd, 0x7a, 0x38, 0x0b, 0x34, 0x1f, 0xc8, 0x94, 0xae,
-	0xaa, 0xb4, 0xb7, 0xd1, 0xa1, 0x12, 0x35, 0x80, 0x8c, 0x12, 0x4f, 0xa0, 0x37, 0xc4, 0xa1, 0x82,
-	0xa8, 0xb3, 0x1d, 0x29, 0x6a, 0xda, 0xe0, 0xb9, 0x08, 0x0a, 0x57, 0x9b, 0x52, 0xd0, 0xa8, 0x42,
-	0x1c, 0x5e, 0x21, 0x18, 0x0e, 0x9d, 0xe6, 0x2c, 0xcb, 0x1d, 0x8c, 0x09, 0x84, 0xb5, 0x00, 0x64,
-	0xf0, 0x2d, 0x58, 0x8b, 0xaa, 0xc8, 0x89, 0x09, 0xfa, 0x09, 0x41, 0xb2, 0x01, 0x98, 0xff, 0x46,
-	0xd4, 0x07, 0x30, 0xa4, 0x68, 0x2c, 0xb1, 0x92, 0x97, 0xdb, 0xf8, 0xc7, 0xeb, 0xf6, 0x21, 0x38,
-	0xa1, 0xc8, 0x6e, 0x46, 0x93, 0x0b, 0x10, 0x67, 0xeb, 0x9e, 0x90, 0x7a, 0xe5, 0xbb, 0xd3, 0xe6,
-	0x0d, 0x0f, 0x40, 0x97, 0x27, 0x96, 0xe8, 0x8a, 0xe7, 0x2a, 0x3e, 0xdd, 0xe9, 0xe0, 0xf5, 0xe8,
-	0x25, 0xed, 0x3c, 0x89, 0x4b, 0x3a, 0xf1, 0xb5, 0x0b, 0x4e, 0x29, 0xb4, 0xf8, 0x2d, 0x82, 0xb8,
-	0xb6, 0x23, 0x7c, 0xbd, 0x41, 0xd3, 0xa3, 0x3e, 0x98, 0x70, 0x5a, 0x4d, 0xd7, 0xe4, 0xed, 0x6b,
-	0x2f, 0xbf, 0xfd, 0x7e, 0xd7, 0x71, 0x19, 0x27, 0x49, 0x33, 0xdb, 0xc6, 0xdb, 0x08, 0xce, 0xd5,
-	0xfa, 0x16, 0xbe, 0xdd, 0x6c, 0x5e, 0x1d, 0xcf, 0x4c, 0xdc, 0x39, 0x7e, 0xa1, 0x81, 0x3c, 0xa5,
-	0x20, 0xdf, 0xc0, 0x13, 0x0d, 0x20, 0xeb, 0xc8, 0x66, 0xcd, 0x3e, 0x6c, 0xe1, 0xcf, 0x9a, 0x43,
-	0xc8, 0x71, 0x5a, 0xe2, 0x10, 0x65, 0x9a, 0x2d, 0x71, 0x88, 0x34, 0x37, 0x7b, 0x46, 0x71, 0x98,
-	0xc6, 0x53, 0x0d, 0x38, 0x18, 0xe7, 0x25, 0x9b, 0x61, 0x0b, 0xde, 0xd2, 0xe4, 0x04, 0xfe, 0x8e,
-	0xa0, 0x3f, 0xea, 0xb2, 0xe3, 0x54, 0xab, 0xd2, 0x46, 0xf8, 0x55, 0x62, 0xba, 0xbd, 0xe2, 0x63,
-	0xf0, 0x52, 0x11, 0x97, 0xe5, 0xcc, 0xf1, 0x28, 0x1b, 0xdc, 0x22, 0xde, 0x01, 0xfc, 0x2f, 0x08,
-	0xfa, 0x22, 0xee, 0x2b, 0x9e, 0x6a, 0x86, 0xac, 0xbe, 0x65, 0x24, 0x52, 0x6d, 0xd5, 0x1a, 0x52,
-	0x29, 0x45, 0xea, 0x26, 0x9e, 0x6c, 0x40, 0x6a, 0x4d, 0xd5, 0x1f, 0xdd, 0xb8, 0x99, 0xd4, 0xce,
-	0x9e, 0x85, 0x76, 0xf7, 0x2c, 0xf4, 0x6b, 0xcf, 0x42, 0x6f, 0xf6, 0xad, 0xd8, 0xee, 0xbe, 0x15,
-	0xfb, 0xb1, 0x6f, 0xc5, 0x9e, 0x26, 0xc3, 0xdd, 0xd6, 0xc3, 0x22, 0x6d, 0x14, 0x99, 0xc8, 0xc4,
-	0xd5, 0x5f, 0x9e, 0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xec, 0x41, 0xc6, 0xc9, 0x09, 0x0a,
-	0x00, 0x00,
+	// 1003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x24, 0xdb, 0x34, 0x79, 0x81, 0x02, 0xd3, 0xa8, 0x2c, 0xab, 0xb0, 0x4d, 0x5c, 0x11,
+	0x4a, 0x05, 0xb6, 0x9a, 0x00, 0xa5, 0x49, 0x01, 0x35, 0x14, 0x4a, 0x54, 0x09, 0x15, 0x07, 0x8a,
+	0x04, 0x87, 0x6a, 0xb2, 0x9e, 0x58, 0x43, 0x77, 0xed, 0xad, 0x67, 0x1c, 0x65, 0x55, 0xe5, 0xc2,
+	0x5f, 0x80, 0xe0, 0xce, 0x81, 0x13, 0x47, 0x6e, 0x1c, 0x51, 0x0f, 0x48, 0x45, 0x5c, 0x2a, 0x21,
+	0xa4, 0x1e, 0x10, 0x42, 0x09, 0x52, 0xff, 0x0d, 0xe4, 0x37, 0xe3, 0xd8, 0xde, 0xf5, 0xfe, 0x0a,
+	0x70, 0xe0, 0x12, 0x79, 0xde, 0xbc, 0xf7, 0xe6, 0xfb, 0xbe, 0x79, 0xf3, 0xde, 0x06, 0x5e, 0x60,
+	0x0d, 0xe6, 0xf1, 0x96, 0x68, 0xa8, 0xf0, 0x0e, 0x0f, 0x9c, 0x74, 0x15, 0xec, 0x28, 0xe7, 0x6e,
+	0xcc, 0xa3, 0x8e, 0xdd, 0x8e, 0x42, 0x15, 0xd2, 0xe7, 0x0a, 0x6e, 0x76, 0xce, 0xad, 0xf6, 0x0c,
+	0x6b, 0x89, 0x20, 0x74, 0xf0, 0xaf, 0xf6, 0xae, 0xcd, 0xfb, 0xa1, 0x1f, 0xe2, 0xa7, 0x93, 0x7c,
+	0x19, 0xeb, 0x82, 0x1f, 0x86, 0x7e, 0x93, 0x3b, 0xac, 0x2d, 0x1c, 0x16, 0x04, 0xa1, 0x62, 0x4a,
+	0x84, 0x81, 0x34, 0xbb, 0x17, 0x1a, 0xa1, 0x6c, 0x85, 0xd2, 0xd9, 0x66, 0x92, 0xeb, 0xa3, 0x9d,
+	0xdd, 0x8b, 0xdb, 0x5c, 0xb1, 0x8b, 0x4e, 0x9b, 0xf9, 0x22, 0x40, 0x67, 0xe3, 0xbb, 0xdc, 0x1f,
+	0x74, 0x9b, 0x45, 0xac, 0x95, 0xe6, 0x7c, 0xbd, 0xbf, 0x9f, 0x8c, 0xb7, 0x3f, 0xe7, 0x0d, 0x75,
+	0x1b, 0x77, 0x6e, 0x8b, 0x40, 0x2a, 0x16, 0x34, 0xb8, 0x89, 0x7b, 0xb9, 0x7f, 0x9c, 0xf6, 0x97,
+	0x71, 0xbb, 0xdd, 0x34, 0xda, 0x58, 0xf3, 0x40, 0x3f, 0x4c, 0xf0, 0xde, 0xc4, 0xa3, 0x5d, 0x7e,
+	0x37, 0xe6, 0x52, 0x59, 0x9f, 0xc1, 0xe9, 0x82, 0x55, 0xb6, 0xc3, 0x40, 0x72, 0x7a, 0x0d, 0xa6,
+	0x35, 0xc4, 0x2a, 0x59, 0x24, 0xe7, 0xe7, 0x56, 0x96, 0xec, 0xbe, 0xca, 0xda, 0x3a, 0x74, 0x63,
+	0xf6, 0xc1, 0x1f, 0x67, 0x27, 0xbe, 0x7b, 0xfc, 0xfd, 0x05, 0xe2, 0x9a, 0x58, 0xeb, 0x7d, 0x58,
+	0xc0, 0xe4, 0xd7, 0xb9, 0xfa, 0x28, 0x09, 0xdb, 0x34, 0xf8, 0xcd, 0xe1, 0xf4, 0x3c, 0x3c, 0xa5,
+	0xf2, 0xf6, 0x4d, 0x0f, 0x8f, 0x9b, 0x75, 0xbb, 0xcd, 0xd6, 0x2e, 0x3c, 0xdf, 0x27, 0x93, 0x01,
+	0xfc, 0x31, 0x3c, 0x59, 0x88, 0x31, 0xb8, 0x9d, 0x01, 0xb8, 0xb7, 0xb4, 0xb6, 0xc5, 0x7c, 0xc5,
+	0x2c, 0xd6, 0xb7, 0x24, 0xa3, 0xb0, 0xa5, 0x62, 0x8f, 0x07, 0xda, 0x3f, 0xd5, 0x8f, 0x2e, 0xc3,
+	0x29, 0xa9, 0xed, 0x57, 0x3d, 0x2f, 0xe2, 0x52, 0x1a, 0x06, 0x5d, 0x56, 0xfa, 0x1e, 0x40, 0x56,
+	0x1f, 0xd5, 0x49, 0x04, 0xb7, 0x6c, 0xeb, 0x62, 0xb2, 0x93, 0x62, 0xb2, 0x75, 0x1d, 0x9b, 0x62,
+	0xb2, 0x6f, 0x32, 0x3f, 0x95, 0xc9, 0xcd, 0x45, 0x52, 0x0a, 0x15, 0xc5, 0xa3, 0x56, 0x75, 0x0a,
+	0x4f, 0xc1, 0x6f, 0xeb, 0x3e, 0xc9, 0xd4, 0xe9, 0x02, 0x69, 0xd4, 0xf9, 0x04, 0x4e, 0x15, 0x78,
+	0x25, 0x28, 0xa7, 0x8e, 0x23, 0x4f, 0x57, 0x1a, 0x7a, 0xbd, 0x84, 0xd6, 0x8b, 0x43, 0x69, 0x69,
+	0x54, 0x79, 0x5e, 0xd6, 0x37, 0x04, 0x16, 0x0b, 0x37, 0x7c, 0x8d, 0xef, 0x1c, 0x1d, 0x93, 0x8a,
+	0x5d, 0x07, 0x50, 0xe9, 0x5e, 0x5a, 0x2a, 0x39, 0xcb, 0x7f, 0x2a, 0xf2, 0x4f, 0x04, 0x96, 0x06,
+	0x00, 0xfc, 0xdf, 0x08, 0x7d, 0x03, 0xce, 0x22, 0x8d, 0x5b, 0x3c, 0x12, 0x3b, 0x9d, 0x7f, 0xf8,
+	0x2c, 0x7f, 0x9c, 0x34, 0xb7, 0x56, 0x9a, 0xcd, 0x68, 0x72, 0x06, 0xa6, 0xf9, 0x9e, 0x90, 0x4a,
+	0x3f, 0x8d, 0x19, 0xd7, 0xac, 0x68, 0x15, 0x4e, 0x0a, 0x79, 0x8b, 0x35, 0x85, 0x87, 0x7c, 0x66,
+	0xdc, 0x74, 0xd9, 0xfb, 0x98, 0xa7, 0xfe, 0x8d, 0xc7, 0x4c, 0x2d, 0x78, 0x42, 0x0a, 0x3f, 0x10,
+	0x81, 0x7f, 0x83, 0x77, 0x36, 0xbd, 0x6a, 0x05, 0x49, 0x15, 0x6c, 0x09, 0xd8, 0x64, 0xcd, 0xa3,
+	0xea, 0x09, 0xdc, 0x35, 0x2b, 0x7c, 0xe7, 0xc2, 0x0f, 0x98, 0x8a, 0x23, 0xae, 0x31, 0x4f, 0x23,
+	0xe6, 0x2e, 0x2b, 0xb5, 0x81, 0xde, 0xe1, 0x1d, 0xfc, 0xbe, 0xaa, 0x36, 0xa5, 0x8c, 0x11, 0xff,
+	0x49, 0xf4, 0x2d, 0xd9, 0xb1, 0x2e, 0xc3, 0xb3, 0x28, 0x20, 0x02, 0xdf, 0xc2, 0x7e, 0x3d, 0x62,
+	0xb5, 0x5b, 0xbf, 0x13, 0xa8, 0xf6, 0xc6, 0x66, 0x0d, 0x5c, 0x77, 0x7f, 0xd3, 0x08, 0x97, 0x07,
+	0x68, 0x97, 0x8b, 0xdf, 0xa8, 0x24, 0x5d, 0xdc, 0x35, 0xb1, 0x74, 0x01, 0x66, 0x5b, 0x6c, 0x4f,
+	0x6f, 0xe1, 0x25, 0x55, 0xdc, 0xcc, 0x40, 0x17, 0x61, 0xae, 0x21, 0xa2, 0x46, 0xdc, 0x64, 0x4a,
+	0x04, 0x3e, 0x5e, 0x52, 0xc5, 0xcd, 0x9b, 0x92, 0xf8, 0x88, 0xb7, 0x98, 0x48, 0xf4, 0x45, 0xb9,
+	0x2b, 0x6e, 0x66, 0x48, 0x76, 0xe3, 0xa0, 0x29, 0x5a, 0x42, 0x71, 0x0f, 0xe5, 0x9e, 0x71, 0x33,
+	0x83, 0xf5, 0x01, 0x58, 0xc8, 0x2e, 0x7f, 0xb3, 0xef, 0x44, 0x3c, 0xe9, 0x6e, 0x82, 0x35, 0xc7,
+	0xaf, 0xd5, 0x77, 0xe1, 0xdc, 0xc0, 0x7c, 0x46, 0xb8, 0x3a, 0x40, 0xe3, 0xc8, 0x9a, 0xaa, 0x9e,
+	0x59, 0x56, 0x1e, 0x03, 0x9c, 0xc0, 0x3c, 0xf4, 0x2b, 0x02, 0xd3, 0x7a, 0xf6, 0xd1, 0x57, 0x06,
+	0xa8, 0xdb, 0x3b, 0x74, 0x6b, 0xf6, 0xa8, 0xee, 0x1a, 0x93, 0xf5, 0xd2, 0x17, 0xbf, 0xfe, 0xf5,
+	0xf5, 0xe4, 0x39, 0xba, 0xe4, 0x0c, 0xfb, 0x45, 0x41, 0xef, 0x13, 0x78, 0xba, 0x7b, 0x48, 0xd2,
+	0x4b, 0xc3, 0xce, 0xeb, 0x33, 0xa0, 0x6b, 0x6f, 0x8c, 0x1f, 0x68, 0x20, 0xaf, 0x21, 0xe4, 0x57,
+	0xe9, 0x8a, 0x33, 0xe4, 0x47, 0x8a, 0x73, 0xaf, 0xeb, 0xa2, 0xf6, 0xe9, 0xcf, 0x9a, 0x43, 0x61,
+	0x94, 0x8d, 0xc4, 0xa1, 0x6c, 0x42, 0x8f, 0xc4, 0xa1, 0x74, 0x6a, 0x5a, 0x1b, 0xc8, 0xe1, 0x0a,
+	0x5d, 0x1b, 0xc0, 0xc1, 0x8c, 0x79, 0xe7, 0x5e, 0x71, 0xde, 0xef, 0x6b, 0x72, 0x92, 0xfe, 0x46,
+	0x60, 0xbe, 0x6c, 0x62, 0xd0, 0xf5, 0x51, 0xa5, 0x2d, 0x19, 0x84, 0xb5, 0x2b, 0xc7, 0x0b, 0x1e,
+	0x83, 0x17, 0x5a, 0x3c, 0xbe, 0x63, 0xae, 0x07, 0x3b, 0xce, 0xbe, 0x23, 0x8e, 0xe0, 0xff, 0x42,
+	0xe0, 0x74, 0x49, 0xd3, 0xa7, 0x6b, 0xc3, 0x90, 0xf5, 0x9f, 0x3b, 0xb5, 0xf5, 0x63, 0xc5, 0x1a,
+	0x52, 0xeb, 0x48, 0xea, 0x35, 0xba, 0x3a, 0x80, 0xd4, 0x2e, 0xc6, 0x97, 0x54, 0xdc, 0x0f, 0x04,
+	0xe6, 0x72, 0x5d, 0x90, 0xae, 0x0c, 0x43, 0xd2, 0xdb, 0xae, 0x6b, 0xab, 0x63, 0xc5, 0x18, 0xd4,
+	0x6f, 0x23, 0xea, 0xcb, 0xf4, 0xd2, 0xd8, 0x57, 0x61, 0x3a, 0xf4, 0x23, 0x02, 0x67, 0xca, 0x3b,
+	0x1a, 0x7d, 0x73, 0x18, 0xa0, 0x81, 0x9d, 0xb5, 0xf6, 0xd6, 0x71, 0xc3, 0xc7, 0xa0, 0x96, 0xf5,
+	0xd5, 0xde, 0x4b, 0xd9, 0x58, 0x7f, 0x70, 0x50, 0x27, 0x0f, 0x0f, 0xea, 0xe4, 0xcf, 0x83, 0x3a,
+	0xf9, 0xf2, 0xb0, 0x3e, 0xf1, 0xf0, 0xb0, 0x3e, 0xf1, 0xe8, 0xb0, 0x3e, 0xf1, 0xe9, 0x52, 0x31,
+	0xe3, 0x5e, 0x51, 0xae, 0x4e, 0x9b, 0xcb, 0xed, 0x69, 0xfc, 0xa7, 0x67, 0xf5, 0xef, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xd5, 0xaa, 0xc2, 0x3f, 0x39, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -603,6 +880,10 @@ type QueryClient interface {
 	GetTokenDefInstances(ctx context.Context, in *QueryGetTokenDefInstancesRequest, opts ...grpc.CallOption) (*QueryGetTokenDefInstancesResponse, error)
 	// Verify if a token instance exists and is valid
 	VerifyTokenInstance(ctx context.Context, in *QueryVerifyTokenInstanceRequest, opts ...grpc.CallOption) (*QueryVerifyTokenInstanceResponse, error)
+	// Get the supply of a TokenDef and how many instances can still be minted
+	TokenSupply(ctx context.Context, in *QueryTokenSupplyRequest, opts ...grpc.CallOption) (*QueryTokenSupplyResponse, error)
+	// Render a SubjectTokenInstance as a W3C Verifiable Credential anchored to the queried height
+	SubjectTokenCredential(ctx context.Context, in *QuerySubjectTokenCredentialRequest, opts ...grpc.CallOption) (*QuerySubjectTokenCredentialResponse, error)
 }
 
 type queryClient struct {
@@ -658,6 +939,24 @@ func (c *queryClient) VerifyTokenInstance(ctx context.Context, in *QueryVerifyTo
 	return out, nil
 }
 
+func (c *queryClient) TokenSupply(ctx context.Context, in *QueryTokenSupplyRequest, opts ...grpc.CallOption) (*QueryTokenSupplyResponse, error) {
+	out := new(QueryTokenSupplyResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Query/TokenSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubjectTokenCredential(ctx context.Context, in *QuerySubjectTokenCredentialRequest, opts ...grpc.CallOption) (*QuerySubjectTokenCredentialResponse, error) {
+	out := new(QuerySubjectTokenCredentialResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Query/SubjectTokenCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -670,6 +969,10 @@ type QueryServer interface {
 	GetTokenDefInstances(context.Context, *QueryGetTokenDefInstancesRequest) (*QueryGetTokenDefInstancesResponse, error)
 	// Verify if a token instance exists and is valid
 	VerifyTokenInstance(context.Context, *QueryVerifyTokenInstanceRequest) (*QueryVerifyTokenInstanceResponse, error)
+	// Get the supply of a TokenDef and how many instances can still be minted
+	TokenSupply(context.Context, *QueryTokenSupplyRequest) (*QueryTokenSupplyResponse, error)
+	// Render a SubjectTokenInstance as a W3C Verifiable Credential anchored to the queried height
+	SubjectTokenCredential(context.Context, *QuerySubjectTokenCredentialRequest) (*QuerySubjectTokenCredentialResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -691,6 +994,12 @@ func (*UnimplementedQueryServer) GetTokenDefInstances(ctx context.Context, req *
 func (*UnimplementedQueryServer) VerifyTokenInstance(ctx context.Context, req *QueryVerifyTokenInstanceRequest) (*QueryVerifyTokenInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyTokenInstance not implemented")
 }
+func (*UnimplementedQueryServer) TokenSupply(ctx context.Context, req *QueryTokenSupplyRequest) (*QueryTokenSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenSupply not implemented")
+}
+func (*UnimplementedQueryServer) SubjectTokenCredential(ctx context.Context, req *QuerySubjectTokenCredentialRequest) (*QuerySubjectTokenCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubjectTokenCredential not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -786,6 +1095,42 @@ func _Query_VerifyTokenInstance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Query/TokenSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenSupply(ctx, req.(*QueryTokenSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubjectTokenCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubjectTokenCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubjectTokenCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Query/SubjectTokenCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubjectTokenCredential(ctx, req.(*QuerySubjectTokenCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "academictoken.academicnft.Query",
@@ -811,6 +1156,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyTokenInstance",
 			Handler:    _Query_VerifyTokenInstance_Handler,
 		},
+		{
+			MethodName: "TokenSupply",
+			Handler:    _Query_TokenSupply_Handler,
+		},
+		{
+			MethodName: "SubjectTokenCredential",
+			Handler:    _Query_SubjectTokenCredential_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/academicnft/query.proto",
@@ -957,6 +1310,13 @@ func (m *QueryGetStudentTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1048,6 +1408,13 @@ func (m *QueryGetTokenDefInstancesRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1169,6 +1536,40 @@ func (m *QueryVerifyTokenInstanceResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.KeyValidAtIssuance {
+		i--
+		if m.KeyValidAtIssuance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignatureValid {
+		i--
+		if m.SignatureValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SigningKeyId) > 0 {
+		i -= len(m.SigningKeyId)
+		copy(dAtA[i:], m.SigningKeyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigningKeyId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TokenInstance != nil {
 		{
 			size, err := m.TokenInstance.MarshalToSizedBuffer(dAtA[:i])
@@ -1204,36 +1605,184 @@ func (m *QueryVerifyTokenInstanceResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTokenSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryTokenSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTokenSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	if len(m.TokenDefId) > 0 {
+		i -= len(m.TokenDefId)
+		copy(dAtA[i:], m.TokenDefId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenDefId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Circulating != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Circulating))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubjectTokenCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubjectTokenCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubjectTokenCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenInstanceId) > 0 {
+		i -= len(m.TokenInstanceId)
+		copy(dAtA[i:], m.TokenInstanceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInstanceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubjectTokenCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubjectTokenCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubjectTokenCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
 
 func (m *QueryGetTokenInstanceRequest) Size() (n int) {
 	if m == nil {
@@ -1275,6 +1824,10 @@ func (m *QueryGetStudentTokensRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1311,6 +1864,10 @@ func (m *QueryGetTokenDefInstancesRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1362,6 +1919,82 @@ func (m *QueryVerifyTokenInstanceResponse) Size() (n int) {
 		l = m.TokenInstance.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.SigningKeyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SignatureValid {
+		n += 2
+	}
+	if m.KeyValidAtIssuance {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTokenSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenDefId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxSupply != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSupply))
+	}
+	if m.Circulating != 0 {
+		n += 1 + sovQuery(uint64(m.Circulating))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	if m.Unlimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySubjectTokenCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInstanceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubjectTokenCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1769,6 +2402,38 @@ func (m *QueryGetStudentTokensRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2007,17 +2672,49 @@ func (m *QueryGetTokenDefInstancesRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -2335,6 +3032,516 @@ func (m *QueryVerifyTokenInstanceResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureValid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignatureValid = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValidAtIssuance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyValidAtIssuance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDefId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenDefId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circulating", wireType)
+			}
+			m.Circulating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Circulating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlimited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubjectTokenCredentialRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubjectTokenCredentialRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubjectTokenCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubjectTokenCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubjectTokenCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubjectTokenCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
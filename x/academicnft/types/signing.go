@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// SubjectTokenSignType tags the payload professors and registrars sign, so a
+// signature over a token instance cannot be replayed as another kind of record
+const SubjectTokenSignType = "academictoken/SubjectTokenInstance"
+
+// SubjectTokenSignBytes returns the canonical serialization of the token fields
+// signed by the issuing professor or registrar: compact JSON with sorted keys.
+// The grade is the one recorded on the token, i.e. as the institution grading
+// scale expresses it (letters upper-case, "PASS"/"FAIL").
+func SubjectTokenSignBytes(student, tokenDefId, grade, semester, completionDate string) []byte {
+	// Maps are marshalled with sorted keys and string values cannot fail
+	bz, _ := json.Marshal(map[string]string{
+		"type":           SubjectTokenSignType,
+		"student":        student,
+		"tokenDefId":     tokenDefId,
+		"grade":          grade,
+		"semester":       semester,
+		"completionDate": completionDate,
+	})
+	return bz
+}
+
+// DecodeSignature decodes a base64 signature
+func DecodeSignature(signature string) ([]byte, error) {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, ErrInvalidSignature.Wrapf("signature is not base64: %s", err)
+	}
+	return sig, nil
+}
+
+// SignatureStatus describes the signature of a token instance
+type SignatureStatus struct {
+	SigningKeyId string
+	// Signer is the owner of the signing key
+	Signer         string
+	SignatureValid bool
+	// KeyValidAtIssuance reports whether the key was registered and not
+	// revoked when the token instance was issued
+	KeyValidAtIssuance bool
+}
+
+// Verified reports whether the token instance carries a valid signature made
+// with a key that was valid at issuance
+func (s SignatureStatus) Verified() bool {
+	return s.SignatureValid && s.KeyValidAtIssuance
+}
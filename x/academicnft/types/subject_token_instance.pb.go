@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -23,14 +24,24 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type SubjectTokenInstance struct {
-	Index              string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	TokenDefId         string `protobuf:"bytes,2,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
-	Student            string `protobuf:"bytes,3,opt,name=student,proto3" json:"student,omitempty"`
-	CompletionDate     string `protobuf:"bytes,4,opt,name=completionDate,proto3" json:"completionDate,omitempty"`
-	Grade              string `protobuf:"bytes,5,opt,name=grade,proto3" json:"grade,omitempty"`
-	IssuerInstitution  string `protobuf:"bytes,6,opt,name=issuerInstitution,proto3" json:"issuerInstitution,omitempty"`
-	Semester           string `protobuf:"bytes,7,opt,name=semester,proto3" json:"semester,omitempty"`
-	ProfessorSignature string `protobuf:"bytes,8,opt,name=professorSignature,proto3" json:"professorSignature,omitempty"`
+	Index              string           `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	TokenDefId         string           `protobuf:"bytes,2,opt,name=tokenDefId,proto3" json:"tokenDefId,omitempty"`
+	Student            string           `protobuf:"bytes,3,opt,name=student,proto3" json:"student,omitempty"`
+	CompletionDate     string           `protobuf:"bytes,4,opt,name=completionDate,proto3" json:"completionDate,omitempty"`
+	Grade              string           `protobuf:"bytes,5,opt,name=grade,proto3" json:"grade,omitempty"`
+	IssuerInstitution  string           `protobuf:"bytes,6,opt,name=issuerInstitution,proto3" json:"issuerInstitution,omitempty"`
+	Semester           string           `protobuf:"bytes,7,opt,name=semester,proto3" json:"semester,omitempty"`
+	ProfessorSignature string           `protobuf:"bytes,8,opt,name=professorSignature,proto3" json:"professorSignature,omitempty"`
+	NormalizedGrade    string           `protobuf:"bytes,9,opt,name=normalizedGrade,proto3" json:"normalizedGrade,omitempty"`
+	Amendments         []GradeAmendment `protobuf:"bytes,10,rep,name=amendments,proto3" json:"amendments"`
+	Revoked            bool             `protobuf:"varint,11,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevocationReason   string           `protobuf:"bytes,12,opt,name=revocationReason,proto3" json:"revocationReason,omitempty"`
+	RevokedBy          string           `protobuf:"bytes,13,opt,name=revokedBy,proto3" json:"revokedBy,omitempty"`
+	RevokedAt          string           `protobuf:"bytes,14,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
+	SigningKeyId       string           `protobuf:"bytes,15,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"`
+	IssuedAt           string           `protobuf:"bytes,16,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	TransferCredit     bool             `protobuf:"varint,17,opt,name=transferCredit,proto3" json:"transferCredit,omitempty"`
+	EquivalenceId      string           `protobuf:"bytes,18,opt,name=equivalenceId,proto3" json:"equivalenceId,omitempty"`
 }
 
 func (m *SubjectTokenInstance) Reset()         { *m = SubjectTokenInstance{} }
@@ -122,8 +133,172 @@ func (m *SubjectTokenInstance) GetProfessorSignature() string {
 	return ""
 }
 
+func (m *SubjectTokenInstance) GetNormalizedGrade() string {
+	if m != nil {
+		return m.NormalizedGrade
+	}
+	return ""
+}
+
+func (m *SubjectTokenInstance) GetAmendments() []GradeAmendment {
+	if m != nil {
+		return m.Amendments
+	}
+	return nil
+}
+
+func (m *SubjectTokenInstance) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func (m *SubjectTokenInstance) GetRevocationReason() string {
+	if m != nil {
+		return m.RevocationReason
+	}
+	return ""
+}
+
+func (m *SubjectTokenInstance) GetRevokedBy() string {
+	if m != nil {
+		return m.RevokedBy
+	}
+	return ""
+}
+
+func (m *SubjectTokenInstance) GetRevokedAt() string {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return ""
+}
+
+func (m *SubjectTokenInstance) GetSigningKeyId() string {
+	if m != nil {
+		return m.SigningKeyId
+	}
+	return ""
+}
+
+func (m *SubjectTokenInstance) GetIssuedAt() string {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return ""
+}
+
+func (m *SubjectTokenInstance) GetTransferCredit() bool {
+	if m != nil {
+		return m.TransferCredit
+	}
+	return false
+}
+
+func (m *SubjectTokenInstance) GetEquivalenceId() string {
+	if m != nil {
+		return m.EquivalenceId
+	}
+	return ""
+}
+
+// GradeAmendment records one correction of the grade of a token instance
+type GradeAmendment struct {
+	PreviousGrade           string `protobuf:"bytes,1,opt,name=previousGrade,proto3" json:"previousGrade,omitempty"`
+	NewGrade                string `protobuf:"bytes,2,opt,name=newGrade,proto3" json:"newGrade,omitempty"`
+	PreviousNormalizedGrade string `protobuf:"bytes,3,opt,name=previousNormalizedGrade,proto3" json:"previousNormalizedGrade,omitempty"`
+	NewNormalizedGrade      string `protobuf:"bytes,4,opt,name=newNormalizedGrade,proto3" json:"newNormalizedGrade,omitempty"`
+	Reason                  string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	AmendedBy               string `protobuf:"bytes,6,opt,name=amendedBy,proto3" json:"amendedBy,omitempty"`
+	AmendedAt               string `protobuf:"bytes,7,opt,name=amendedAt,proto3" json:"amendedAt,omitempty"`
+}
+
+func (m *GradeAmendment) Reset()         { *m = GradeAmendment{} }
+func (m *GradeAmendment) String() string { return proto.CompactTextString(m) }
+func (*GradeAmendment) ProtoMessage()    {}
+func (*GradeAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eac3c17f4fc0a72, []int{1}
+}
+func (m *GradeAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GradeAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GradeAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GradeAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradeAmendment.Merge(m, src)
+}
+func (m *GradeAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *GradeAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradeAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradeAmendment proto.InternalMessageInfo
+
+func (m *GradeAmendment) GetPreviousGrade() string {
+	if m != nil {
+		return m.PreviousGrade
+	}
+	return ""
+}
+
+func (m *GradeAmendment) GetNewGrade() string {
+	if m != nil {
+		return m.NewGrade
+	}
+	return ""
+}
+
+func (m *GradeAmendment) GetPreviousNormalizedGrade() string {
+	if m != nil {
+		return m.PreviousNormalizedGrade
+	}
+	return ""
+}
+
+func (m *GradeAmendment) GetNewNormalizedGrade() string {
+	if m != nil {
+		return m.NewNormalizedGrade
+	}
+	return ""
+}
+
+func (m *GradeAmendment) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *GradeAmendment) GetAmendedBy() string {
+	if m != nil {
+		return m.AmendedBy
+	}
+	return ""
+}
+
+func (m *GradeAmendment) GetAmendedAt() string {
+	if m != nil {
+		return m.AmendedAt
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SubjectTokenInstance)(nil), "academictoken.academicnft.SubjectTokenInstance")
+	proto.RegisterType((*GradeAmendment)(nil), "academictoken.academicnft.GradeAmendment")
 }
 
 func init() {
@@ -131,25 +306,42 @@ func init() {
 }
 
 var fileDescriptor_6eac3c17f4fc0a72 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0x93, 0x7e, 0x5f, 0x7f, 0xf0, 0x80, 0x84, 0xd5, 0xc1, 0x30, 0x58, 0xc0, 0x80, 0x18,
-	0x50, 0x3a, 0x20, 0xb1, 0xb0, 0xa1, 0x2e, 0x5d, 0x29, 0x13, 0x4b, 0xe5, 0x26, 0x27, 0x95, 0x81,
-	0xd8, 0x91, 0x7d, 0x2c, 0x95, 0xbb, 0xe0, 0x0a, 0xb8, 0x1e, 0xc6, 0x8e, 0x8c, 0x28, 0xb9, 0x11,
-	0x94, 0x13, 0x8a, 0x1a, 0x60, 0x7c, 0xcf, 0xf3, 0xbc, 0x39, 0x91, 0x0f, 0xbb, 0x52, 0xa9, 0xca,
-	0xa0, 0xd0, 0x29, 0xda, 0x47, 0x30, 0x93, 0x6d, 0x32, 0x39, 0x4e, 0x7c, 0x58, 0x3e, 0x40, 0x8a,
-	0x0b, 0x22, 0x0b, 0x6d, 0x3c, 0x2a, 0x93, 0x42, 0x52, 0x3a, 0x8b, 0x96, 0x1f, 0x76, 0x7a, 0xc9,
-	0x4e, 0xef, 0xf4, 0xb5, 0xc7, 0xc6, 0xf3, 0xb6, 0x7b, 0xd7, 0xc0, 0xd9, 0x57, 0x93, 0x8f, 0x59,
-	0x5f, 0x9b, 0x0c, 0xd6, 0x22, 0x3e, 0x8e, 0xcf, 0xf7, 0x6e, 0xdb, 0xc0, 0x25, 0x63, 0xf4, 0x8d,
-	0x29, 0xe4, 0xb3, 0x4c, 0xf4, 0x08, 0xed, 0x4c, 0xb8, 0x60, 0x43, 0x8f, 0x21, 0x03, 0x83, 0xe2,
-	0x1f, 0xc1, 0x6d, 0xe4, 0x67, 0x6c, 0x3f, 0xb5, 0x45, 0xf9, 0x04, 0xa8, 0xad, 0x99, 0x2a, 0x04,
-	0xf1, 0x9f, 0x84, 0x1f, 0xd3, 0x66, 0xef, 0xca, 0xa9, 0x0c, 0x44, 0xbf, 0xdd, 0x4b, 0x81, 0x5f,
-	0xb0, 0x03, 0xed, 0x7d, 0x00, 0xd7, 0xfc, 0x9f, 0xc6, 0xd0, 0xe8, 0x62, 0x40, 0xc6, 0x6f, 0xc0,
-	0x8f, 0xd8, 0xc8, 0x43, 0x01, 0x1e, 0xc1, 0x89, 0x21, 0x49, 0xdf, 0x99, 0x27, 0x8c, 0x97, 0xce,
-	0xe6, 0xe0, 0xbd, 0x75, 0x73, 0xbd, 0x32, 0x0a, 0x83, 0x03, 0x31, 0x22, 0xeb, 0x0f, 0x72, 0x73,
-	0xfd, 0x56, 0xc9, 0x78, 0x53, 0xc9, 0xf8, 0xa3, 0x92, 0xf1, 0x4b, 0x2d, 0xa3, 0x4d, 0x2d, 0xa3,
-	0xf7, 0x5a, 0x46, 0xf7, 0x27, 0xdd, 0x6b, 0xac, 0x3b, 0xf7, 0xc0, 0xe7, 0x12, 0xfc, 0x72, 0x40,
-	0xef, 0x7f, 0xf9, 0x19, 0x00, 0x00, 0xff, 0xff, 0x21, 0x51, 0x28, 0x9a, 0xb9, 0x01, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdd, 0x6a, 0x13, 0x4f,
+	0x14, 0xcf, 0xf6, 0x33, 0x99, 0xb6, 0x69, 0x3b, 0x84, 0xff, 0x7f, 0x2c, 0xb2, 0xc6, 0x20, 0x12,
+	0x45, 0x12, 0x50, 0x10, 0xc1, 0xab, 0xc4, 0x82, 0x04, 0x41, 0x21, 0xf5, 0xca, 0x9b, 0x32, 0xdd,
+	0x3d, 0x59, 0xc6, 0x66, 0x67, 0xe2, 0xcc, 0x6c, 0xda, 0xf8, 0x14, 0x7d, 0x0d, 0xdf, 0xa4, 0x97,
+	0xbd, 0xf4, 0x4a, 0x24, 0x79, 0x11, 0x99, 0x33, 0xd9, 0x26, 0x9b, 0xb6, 0x77, 0xfb, 0xfb, 0x3a,
+	0x3b, 0x3b, 0xbf, 0xc3, 0x92, 0xb7, 0x3c, 0xe2, 0x31, 0xa4, 0x22, 0xb2, 0xea, 0x1c, 0x64, 0x3b,
+	0x47, 0x72, 0x60, 0xdb, 0x26, 0x3b, 0xfb, 0x0e, 0x91, 0x3d, 0x45, 0xe5, 0x54, 0x48, 0x63, 0xb9,
+	0x8c, 0xa0, 0x35, 0xd2, 0xca, 0x2a, 0xfa, 0xa8, 0x90, 0x6b, 0x2d, 0xe5, 0x8e, 0x6a, 0x89, 0x4a,
+	0x14, 0xba, 0xda, 0xee, 0xc9, 0x07, 0x1a, 0xbf, 0x36, 0x49, 0xed, 0xc4, 0x4f, 0xfc, 0xea, 0x22,
+	0xbd, 0xf9, 0x3c, 0x5a, 0x23, 0x9b, 0x42, 0xc6, 0x70, 0xc9, 0x82, 0x7a, 0xd0, 0xac, 0xf4, 0x3d,
+	0xa0, 0x21, 0x21, 0x38, 0xf9, 0x18, 0x06, 0xbd, 0x98, 0xad, 0xa1, 0xb4, 0xc4, 0x50, 0x46, 0xb6,
+	0x8d, 0xcd, 0x62, 0x90, 0x96, 0xad, 0xa3, 0x98, 0x43, 0xfa, 0x9c, 0x54, 0x23, 0x95, 0x8e, 0x86,
+	0x60, 0x85, 0x92, 0xc7, 0xdc, 0x02, 0xdb, 0x40, 0xc3, 0x0a, 0xeb, 0xde, 0x9b, 0x68, 0x1e, 0x03,
+	0xdb, 0xf4, 0xef, 0x45, 0x40, 0x5f, 0x91, 0x43, 0x61, 0x4c, 0x06, 0xda, 0x9d, 0x4f, 0xd8, 0xcc,
+	0xd9, 0xd9, 0x16, 0x3a, 0xee, 0x0a, 0xf4, 0x88, 0x94, 0x0d, 0xa4, 0x60, 0x2c, 0x68, 0xb6, 0x8d,
+	0xa6, 0x5b, 0x4c, 0x5b, 0x84, 0x8e, 0xb4, 0x1a, 0x80, 0x31, 0x4a, 0x9f, 0x88, 0x44, 0x72, 0x9b,
+	0x69, 0x60, 0x65, 0x74, 0xdd, 0xa3, 0xd0, 0x26, 0xd9, 0x97, 0x4a, 0xa7, 0x7c, 0x28, 0x7e, 0x42,
+	0xfc, 0x11, 0x4f, 0x56, 0x41, 0xf3, 0x2a, 0x4d, 0xbf, 0x10, 0xc2, 0x53, 0x90, 0x71, 0x0a, 0xd2,
+	0x1a, 0x46, 0xea, 0xeb, 0xcd, 0x9d, 0xd7, 0x2f, 0x5a, 0x0f, 0x16, 0xd2, 0xc2, 0x54, 0x27, 0x4f,
+	0x74, 0x37, 0xae, 0xff, 0x3c, 0x29, 0xf5, 0x97, 0x46, 0xb8, 0xcb, 0xd4, 0x30, 0x56, 0xe7, 0x10,
+	0xb3, 0x9d, 0x7a, 0xd0, 0x2c, 0xf7, 0x73, 0x48, 0x5f, 0x92, 0x03, 0xf7, 0x18, 0x71, 0xf7, 0xb9,
+	0x7d, 0xe0, 0x46, 0x49, 0xb6, 0x8b, 0xa7, 0xba, 0xc3, 0xd3, 0xc7, 0xa4, 0x32, 0x8f, 0x75, 0x27,
+	0x6c, 0x0f, 0x4d, 0x0b, 0x62, 0x49, 0xed, 0x58, 0x56, 0x2d, 0xa8, 0x1d, 0x4b, 0x1b, 0x64, 0xd7,
+	0x88, 0x44, 0x0a, 0x99, 0x7c, 0x82, 0x49, 0x2f, 0x66, 0xfb, 0x68, 0x28, 0x70, 0xee, 0xb2, 0xb1,
+	0x01, 0x37, 0xe0, 0xc0, 0x5f, 0x76, 0x8e, 0x5d, 0xe9, 0x56, 0x73, 0x69, 0x06, 0xa0, 0x3f, 0x68,
+	0x88, 0x85, 0x65, 0x87, 0xf8, 0x21, 0x2b, 0x2c, 0x7d, 0x46, 0xf6, 0xe0, 0x47, 0x26, 0xc6, 0x7c,
+	0x08, 0x32, 0x82, 0x5e, 0xcc, 0x28, 0x0e, 0x2a, 0x92, 0x8d, 0xab, 0x35, 0x52, 0x2d, 0x5e, 0x9a,
+	0x0b, 0x8e, 0x34, 0x8c, 0x85, 0xca, 0x8c, 0xef, 0xc6, 0x6f, 0x6b, 0x91, 0x74, 0x47, 0x94, 0x70,
+	0xe1, 0x0d, 0x7e, 0x67, 0x6f, 0x31, 0x7d, 0x47, 0xfe, 0xcf, 0xcd, 0x9f, 0x57, 0x7a, 0xf6, 0x1b,
+	0xfc, 0x90, 0xec, 0x36, 0x49, 0xc2, 0xc5, 0x6a, 0xc8, 0x6f, 0xf5, 0x3d, 0x0a, 0xfd, 0x8f, 0x6c,
+	0x69, 0x5f, 0x95, 0x5f, 0xed, 0x39, 0x72, 0x15, 0x60, 0xe9, 0x58, 0x90, 0xdf, 0xe9, 0x05, 0xb1,
+	0xa4, 0x76, 0xec, 0x7c, 0x99, 0x17, 0x44, 0xf7, 0xfd, 0xf5, 0x34, 0x0c, 0x6e, 0xa6, 0x61, 0xf0,
+	0x77, 0x1a, 0x06, 0x57, 0xb3, 0xb0, 0x74, 0x33, 0x0b, 0x4b, 0xbf, 0x67, 0x61, 0xe9, 0xdb, 0xd3,
+	0xe2, 0x1f, 0xe4, 0xb2, 0xf0, 0x0f, 0xb1, 0x93, 0x11, 0x98, 0xb3, 0x2d, 0xfc, 0x05, 0xbc, 0xf9,
+	0x17, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x98, 0x52, 0x97, 0x6d, 0x04, 0x00, 0x00,
 }
 
 func (m *SubjectTokenInstance) Marshal() (dAtA []byte, err error) {
@@ -172,6 +364,95 @@ func (m *SubjectTokenInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EquivalenceId) > 0 {
+		i -= len(m.EquivalenceId)
+		copy(dAtA[i:], m.EquivalenceId)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.EquivalenceId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.TransferCredit {
+		i--
+		if m.TransferCredit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.IssuedAt) > 0 {
+		i -= len(m.IssuedAt)
+		copy(dAtA[i:], m.IssuedAt)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.IssuedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.SigningKeyId) > 0 {
+		i -= len(m.SigningKeyId)
+		copy(dAtA[i:], m.SigningKeyId)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.SigningKeyId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.RevokedAt) > 0 {
+		i -= len(m.RevokedAt)
+		copy(dAtA[i:], m.RevokedAt)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.RevokedAt)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RevokedBy) > 0 {
+		i -= len(m.RevokedBy)
+		copy(dAtA[i:], m.RevokedBy)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.RevokedBy)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RevocationReason) > 0 {
+		i -= len(m.RevocationReason)
+		copy(dAtA[i:], m.RevocationReason)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.RevocationReason)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Amendments) > 0 {
+		for iNdEx := len(m.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.NormalizedGrade) > 0 {
+		i -= len(m.NormalizedGrade)
+		copy(dAtA[i:], m.NormalizedGrade)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.NormalizedGrade)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ProfessorSignature) > 0 {
 		i -= len(m.ProfessorSignature)
 		copy(dAtA[i:], m.ProfessorSignature)
@@ -231,6 +512,78 @@ func (m *SubjectTokenInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GradeAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GradeAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GradeAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmendedAt) > 0 {
+		i -= len(m.AmendedAt)
+		copy(dAtA[i:], m.AmendedAt)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.AmendedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AmendedBy) > 0 {
+		i -= len(m.AmendedBy)
+		copy(dAtA[i:], m.AmendedBy)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.AmendedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewNormalizedGrade) > 0 {
+		i -= len(m.NewNormalizedGrade)
+		copy(dAtA[i:], m.NewNormalizedGrade)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.NewNormalizedGrade)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousNormalizedGrade) > 0 {
+		i -= len(m.PreviousNormalizedGrade)
+		copy(dAtA[i:], m.PreviousNormalizedGrade)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.PreviousNormalizedGrade)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewGrade) > 0 {
+		i -= len(m.NewGrade)
+		copy(dAtA[i:], m.NewGrade)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.NewGrade)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousGrade) > 0 {
+		i -= len(m.PreviousGrade)
+		copy(dAtA[i:], m.PreviousGrade)
+		i = encodeVarintSubjectTokenInstance(dAtA, i, uint64(len(m.PreviousGrade)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSubjectTokenInstance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSubjectTokenInstance(v)
 	base := offset
@@ -280,6 +633,83 @@ func (m *SubjectTokenInstance) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSubjectTokenInstance(uint64(l))
 	}
+	l = len(m.NormalizedGrade)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	if len(m.Amendments) > 0 {
+		for _, e := range m.Amendments {
+			l = e.Size()
+			n += 1 + l + sovSubjectTokenInstance(uint64(l))
+		}
+	}
+	if m.Revoked {
+		n += 2
+	}
+	l = len(m.RevocationReason)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.RevokedBy)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.RevokedAt)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.SigningKeyId)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.IssuedAt)
+	if l > 0 {
+		n += 2 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	if m.TransferCredit {
+		n += 3
+	}
+	l = len(m.EquivalenceId)
+	if l > 0 {
+		n += 2 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	return n
+}
+
+func (m *GradeAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousGrade)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.NewGrade)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.PreviousNormalizedGrade)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.NewNormalizedGrade)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.AmendedBy)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
+	l = len(m.AmendedAt)
+	if l > 0 {
+		n += 1 + l + sovSubjectTokenInstance(uint64(l))
+	}
 	return n
 }
 
@@ -574,6 +1004,578 @@ func (m *SubjectTokenInstance) Unmarshal(dAtA []byte) error {
 			}
 			m.ProfessorSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amendments = append(m.Amendments, GradeAmendment{})
+			if err := m.Amendments[len(m.Amendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocationReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferCredit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransferCredit = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EquivalenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubjectTokenInstance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GradeAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubjectTokenInstance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GradeAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GradeAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousNormalizedGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousNormalizedGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewNormalizedGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewNormalizedGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmendedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectTokenInstance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectTokenInstance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmendedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubjectTokenInstance(dAtA[iNdEx:])
@@ -134,6 +134,7 @@ type MsgMintSubjectToken struct {
 	IssuerInstitution  string `protobuf:"bytes,6,opt,name=issuerInstitution,proto3" json:"issuerInstitution,omitempty"`
 	Semester           string `protobuf:"bytes,7,opt,name=semester,proto3" json:"semester,omitempty"`
 	ProfessorSignature string `protobuf:"bytes,8,opt,name=professorSignature,proto3" json:"professorSignature,omitempty"`
+	SigningKeyId       string `protobuf:"bytes,9,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"`
 }
 
 func (m *MsgMintSubjectToken) Reset()         { *m = MsgMintSubjectToken{} }
@@ -225,6 +226,13 @@ func (m *MsgMintSubjectToken) GetProfessorSignature() string {
 	return ""
 }
 
+func (m *MsgMintSubjectToken) GetSigningKeyId() string {
+	if m != nil {
+		return m.SigningKeyId
+	}
+	return ""
+}
+
 type MsgMintSubjectTokenResponse struct {
 	TokenInstanceId string `protobuf:"bytes,1,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
 }
@@ -322,7 +330,11 @@ func (m *MsgVerifyTokenInstance) GetTokenInstanceId() string {
 }
 
 type MsgVerifyTokenInstanceResponse struct {
-	IsValid bool `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	IsValid            bool   `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	SigningKeyId       string `protobuf:"bytes,2,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"`
+	Signer             string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	SignatureValid     bool   `protobuf:"varint,4,opt,name=signatureValid,proto3" json:"signatureValid,omitempty"`
+	KeyValidAtIssuance bool   `protobuf:"varint,5,opt,name=keyValidAtIssuance,proto3" json:"keyValidAtIssuance,omitempty"`
 }
 
 func (m *MsgVerifyTokenInstanceResponse) Reset()         { *m = MsgVerifyTokenInstanceResponse{} }
@@ -365,585 +377,2538 @@ func (m *MsgVerifyTokenInstanceResponse) GetIsValid() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "academictoken.academicnft.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "academictoken.academicnft.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgMintSubjectToken)(nil), "academictoken.academicnft.MsgMintSubjectToken")
-	proto.RegisterType((*MsgMintSubjectTokenResponse)(nil), "academictoken.academicnft.MsgMintSubjectTokenResponse")
-	proto.RegisterType((*MsgVerifyTokenInstance)(nil), "academictoken.academicnft.MsgVerifyTokenInstance")
-	proto.RegisterType((*MsgVerifyTokenInstanceResponse)(nil), "academictoken.academicnft.MsgVerifyTokenInstanceResponse")
+func (m *MsgVerifyTokenInstanceResponse) GetSigningKeyId() string {
+	if m != nil {
+		return m.SigningKeyId
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("academictoken/academicnft/tx.proto", fileDescriptor_8f6b8d9858bc1d2a)
+func (m *MsgVerifyTokenInstanceResponse) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-var fileDescriptor_8f6b8d9858bc1d2a = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x53, 0xfa, 0x23, 0x47, 0x45, 0xe9, 0xb5, 0xa2, 0xae, 0x91, 0x4c, 0xeb, 0xa1, 0xaa,
-	0x02, 0xd8, 0x6a, 0x91, 0x2a, 0x51, 0x24, 0x24, 0xaa, 0x4a, 0x28, 0x43, 0x24, 0xe4, 0x94, 0x0e,
-	0x2c, 0xe8, 0x6a, 0x5f, 0xcc, 0x95, 0xfa, 0xce, 0xba, 0x3b, 0xa3, 0x86, 0x09, 0xc1, 0xc6, 0xc4,
-	0x9f, 0xc1, 0x46, 0x06, 0xfe, 0x88, 0x6e, 0x54, 0x4c, 0x4c, 0x08, 0x25, 0x43, 0xfe, 0x0b, 0x84,
-	0x7c, 0xb6, 0xd3, 0xc4, 0x75, 0x23, 0xba, 0x24, 0xfe, 0xde, 0xf7, 0xbd, 0xf7, 0xbe, 0xf7, 0x7c,
-	0x3e, 0x60, 0x21, 0x0f, 0xf9, 0x38, 0x24, 0x9e, 0x64, 0x6f, 0x31, 0x75, 0x72, 0x44, 0xdb, 0xd2,
-	0x91, 0xa7, 0x76, 0xc4, 0x99, 0x64, 0x70, 0x75, 0x4c, 0x63, 0x8f, 0x68, 0x8c, 0x45, 0x14, 0x12,
-	0xca, 0x1c, 0xf5, 0x9b, 0xaa, 0x8d, 0x15, 0x8f, 0x89, 0x90, 0x09, 0x27, 0x14, 0x81, 0xf3, 0x6e,
-	0x2b, 0xf9, 0xcb, 0x88, 0xd5, 0x94, 0x78, 0xad, 0x90, 0x93, 0x82, 0x8c, 0x5a, 0x0e, 0x58, 0xc0,
-	0xd2, 0x78, 0xf2, 0x94, 0x45, 0x37, 0xae, 0xf6, 0x16, 0x21, 0x8e, 0xc2, 0x2c, 0xdb, 0xfa, 0xa1,
-	0x81, 0x85, 0xa6, 0x08, 0x5e, 0x46, 0x3e, 0x92, 0xf8, 0x85, 0x62, 0xe0, 0x0e, 0xa8, 0xa1, 0x58,
-	0xbe, 0x61, 0x9c, 0xc8, 0x8e, 0xae, 0xad, 0x69, 0x9b, 0xb5, 0x3d, 0xfd, 0xe7, 0xf7, 0x87, 0xcb,
-	0x59, 0xdb, 0x67, 0xbe, 0xcf, 0xb1, 0x10, 0x2d, 0xc9, 0x09, 0x0d, 0xdc, 0x0b, 0x29, 0xdc, 0x07,
-	0x33, 0x69, 0x6d, 0xbd, 0xba, 0xa6, 0x6d, 0xde, 0xdc, 0x5e, 0xb7, 0xaf, 0x1c, 0xde, 0x4e, 0x5b,
-	0xed, 0xd5, 0xce, 0x7e, 0xdf, 0xab, 0x7c, 0x1d, 0x74, 0xeb, 0x9a, 0x9b, 0xe5, 0xee, 0x3e, 0xfd,
-	0x38, 0xe8, 0xd6, 0x2f, 0xaa, 0x7e, 0x1e, 0x74, 0xeb, 0xf7, 0xc7, 0x87, 0x39, 0x1d, 0x1b, 0xa7,
-	0xe0, 0xde, 0x5a, 0x05, 0x2b, 0x85, 0x90, 0x8b, 0x45, 0xc4, 0xa8, 0xc0, 0xd6, 0xb7, 0x2a, 0x58,
-	0x6a, 0x8a, 0xa0, 0x49, 0xa8, 0x6c, 0xc5, 0x47, 0xc7, 0xd8, 0x93, 0x07, 0x49, 0x41, 0xa8, 0x83,
-	0x59, 0x8f, 0x63, 0x24, 0x19, 0x4f, 0xc7, 0x75, 0x73, 0x08, 0x4d, 0x00, 0x54, 0xcf, 0x7d, 0xdc,
-	0x6e, 0xf8, 0x6a, 0xac, 0x9a, 0x3b, 0x12, 0x49, 0x32, 0x85, 0x8c, 0x7d, 0x4c, 0xa5, 0x3e, 0x95,
-	0x66, 0x66, 0x10, 0x6e, 0x80, 0x5b, 0x1e, 0x0b, 0xa3, 0x13, 0x2c, 0x09, 0xa3, 0xfb, 0x48, 0x62,
-	0xfd, 0x86, 0x12, 0x14, 0xa2, 0x70, 0x19, 0x4c, 0x07, 0x1c, 0xf9, 0x58, 0x9f, 0x56, 0x74, 0x0a,
-	0xe0, 0x03, 0xb0, 0x48, 0x84, 0x88, 0x31, 0x6f, 0x50, 0x21, 0x89, 0x8c, 0x13, 0xb9, 0x3e, 0xa3,
-	0x14, 0x97, 0x09, 0x68, 0x80, 0x39, 0x81, 0x43, 0x2c, 0x24, 0xe6, 0xfa, 0xac, 0x12, 0x0d, 0x31,
-	0xb4, 0x01, 0x8c, 0x38, 0x6b, 0x63, 0x21, 0x18, 0x6f, 0x91, 0x80, 0x22, 0x19, 0x73, 0xac, 0xcf,
-	0x29, 0x55, 0x09, 0xb3, 0x3b, 0x9f, 0xac, 0x3f, 0x9f, 0xdf, 0x7a, 0x0e, 0xee, 0x96, 0x2c, 0x2c,
-	0x5f, 0x28, 0xdc, 0x04, 0x0b, 0x6a, 0x19, 0x89, 0x19, 0x44, 0x3d, 0xdc, 0xf0, 0xb3, 0x05, 0x16,
-	0xc3, 0xd6, 0x31, 0xb8, 0xd3, 0x14, 0xc1, 0x21, 0xe6, 0xa4, 0xdd, 0x39, 0x18, 0xe5, 0x26, 0x2c,
-	0xbf, 0xa4, 0x7a, 0xb5, 0xb4, 0x7a, 0xc1, 0xf4, 0x2e, 0x30, 0xcb, 0x7b, 0x0d, 0x7d, 0xeb, 0x60,
-	0x96, 0x88, 0x43, 0x74, 0x42, 0x52, 0xbf, 0x73, 0x6e, 0x0e, 0xb7, 0xff, 0x56, 0xc1, 0x54, 0x53,
-	0x04, 0x90, 0x82, 0xf9, 0xb1, 0x6f, 0xa2, 0x3e, 0xe1, 0x2c, 0x17, 0x8e, 0x9b, 0xb1, 0xfd, 0xff,
-	0xda, 0xa1, 0xa3, 0xf7, 0xe0, 0xf6, 0xa5, 0x63, 0x69, 0x4f, 0xae, 0x53, 0xd4, 0x1b, 0x3b, 0xd7,
-	0xd3, 0x0f, 0x7b, 0x7f, 0xd2, 0xc0, 0x52, 0xd9, 0x9b, 0xd9, 0x9a, 0x5c, 0xaf, 0x24, 0xc5, 0x78,
-	0x7c, 0xed, 0x94, 0xdc, 0x85, 0x31, 0xfd, 0x21, 0xb9, 0x06, 0xf6, 0x9e, 0x9c, 0xf5, 0x4c, 0xed,
-	0xbc, 0x67, 0x6a, 0x7f, 0x7a, 0xa6, 0xf6, 0xa5, 0x6f, 0x56, 0xce, 0xfb, 0x66, 0xe5, 0x57, 0xdf,
-	0xac, 0xbc, 0x5a, 0x9f, 0x74, 0x0b, 0xc8, 0x4e, 0x84, 0xc5, 0xd1, 0x8c, 0xba, 0xd4, 0x1e, 0xfd,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0xff, 0xaa, 0xd6, 0x90, 0x9a, 0x05, 0x00, 0x00,
+func (m *MsgVerifyTokenInstanceResponse) GetSignatureValid() bool {
+	if m != nil {
+		return m.SignatureValid
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	MintSubjectToken(ctx context.Context, in *MsgMintSubjectToken, opts ...grpc.CallOption) (*MsgMintSubjectTokenResponse, error)
-	VerifyTokenInstance(ctx context.Context, in *MsgVerifyTokenInstance, opts ...grpc.CallOption) (*MsgVerifyTokenInstanceResponse, error)
+func (m *MsgVerifyTokenInstanceResponse) GetKeyValidAtIssuance() bool {
+	if m != nil {
+		return m.KeyValidAtIssuance
+	}
+	return false
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgAmendGrade corrects the grade of a token instance, keeping the previous
+// grade in its amendment history.
+type MsgAmendGrade struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+	NewGrade        string `protobuf:"bytes,3,opt,name=newGrade,proto3" json:"newGrade,omitempty"`
+	Reason          string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAmendGrade) Reset()         { *m = MsgAmendGrade{} }
+func (m *MsgAmendGrade) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendGrade) ProtoMessage()    {}
+func (*MsgAmendGrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6b8d9858bc1d2a, []int{6}
+}
+func (m *MsgAmendGrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendGrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendGrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendGrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendGrade.Merge(m, src)
+}
+func (m *MsgAmendGrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendGrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendGrade.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAmendGrade proto.InternalMessageInfo
+
+func (m *MsgAmendGrade) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MintSubjectToken(ctx context.Context, in *MsgMintSubjectToken, opts ...grpc.CallOption) (*MsgMintSubjectTokenResponse, error) {
-	out := new(MsgMintSubjectTokenResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/MintSubjectToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAmendGrade) GetTokenInstanceId() string {
+	if m != nil {
+		return m.TokenInstanceId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) VerifyTokenInstance(ctx context.Context, in *MsgVerifyTokenInstance, opts ...grpc.CallOption) (*MsgVerifyTokenInstanceResponse, error) {
-	out := new(MsgVerifyTokenInstanceResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/VerifyTokenInstance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAmendGrade) GetNewGrade() string {
+	if m != nil {
+		return m.NewGrade
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	MintSubjectToken(context.Context, *MsgMintSubjectToken) (*MsgMintSubjectTokenResponse, error)
-	VerifyTokenInstance(context.Context, *MsgVerifyTokenInstance) (*MsgVerifyTokenInstanceResponse, error)
+func (m *MsgAmendGrade) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgAmendGradeResponse struct {
+	NormalizedGrade string `protobuf:"bytes,1,opt,name=normalizedGrade,proto3" json:"normalizedGrade,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgAmendGradeResponse) Reset()         { *m = MsgAmendGradeResponse{} }
+func (m *MsgAmendGradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendGradeResponse) ProtoMessage()    {}
+func (*MsgAmendGradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6b8d9858bc1d2a, []int{7}
 }
-func (*UnimplementedMsgServer) MintSubjectToken(ctx context.Context, req *MsgMintSubjectToken) (*MsgMintSubjectTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintSubjectToken not implemented")
+func (m *MsgAmendGradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) VerifyTokenInstance(ctx context.Context, req *MsgVerifyTokenInstance) (*MsgVerifyTokenInstanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyTokenInstance not implemented")
+func (m *MsgAmendGradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendGradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgAmendGradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendGradeResponse.Merge(m, src)
+}
+func (m *MsgAmendGradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendGradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendGradeResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.academicnft.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgAmendGradeResponse proto.InternalMessageInfo
+
+func (m *MsgAmendGradeResponse) GetNormalizedGrade() string {
+	if m != nil {
+		return m.NormalizedGrade
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_MintSubjectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintSubjectToken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MintSubjectToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.academicnft.Msg/MintSubjectToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MintSubjectToken(ctx, req.(*MsgMintSubjectToken))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRevokeTokenInstance revokes a token instance, removing the subject from
+// the student academic tree and invalidating dependent degree requests.
+type MsgRevokeTokenInstance struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+	Reason          string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func _Msg_VerifyTokenInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVerifyTokenInstance)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VerifyTokenInstance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.academicnft.Msg/VerifyTokenInstance",
+func (m *MsgRevokeTokenInstance) Reset()         { *m = MsgRevokeTokenInstance{} }
+func (m *MsgRevokeTokenInstance) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTokenInstance) ProtoMessage()    {}
+func (*MsgRevokeTokenInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6b8d9858bc1d2a, []int{8}
+}
+func (m *MsgRevokeTokenInstance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTokenInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTokenInstance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VerifyTokenInstance(ctx, req.(*MsgVerifyTokenInstance))
+}
+func (m *MsgRevokeTokenInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTokenInstance.Merge(m, src)
+}
+func (m *MsgRevokeTokenInstance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTokenInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTokenInstance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeTokenInstance proto.InternalMessageInfo
+
+func (m *MsgRevokeTokenInstance) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "academictoken.academicnft.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "MintSubjectToken",
-			Handler:    _Msg_MintSubjectToken_Handler,
-		},
-		{
-			MethodName: "VerifyTokenInstance",
-			Handler:    _Msg_VerifyTokenInstance_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "academictoken/academicnft/tx.proto",
+func (m *MsgRevokeTokenInstance) GetTokenInstanceId() string {
+	if m != nil {
+		return m.TokenInstanceId
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeTokenInstance) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRevokeTokenInstanceResponse struct {
+	InvalidatedDegreeRequests []string `protobuf:"bytes,1,rep,name=invalidatedDegreeRequests,proto3" json:"invalidatedDegreeRequests,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRevokeTokenInstanceResponse) Reset()         { *m = MsgRevokeTokenInstanceResponse{} }
+func (m *MsgRevokeTokenInstanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTokenInstanceResponse) ProtoMessage()    {}
+func (*MsgRevokeTokenInstanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6b8d9858bc1d2a, []int{9}
+}
+func (m *MsgRevokeTokenInstanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTokenInstanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTokenInstanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevokeTokenInstanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTokenInstanceResponse.Merge(m, src)
+}
+func (m *MsgRevokeTokenInstanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTokenInstanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTokenInstanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeTokenInstanceResponse proto.InternalMessageInfo
+
+func (m *MsgRevokeTokenInstanceResponse) GetInvalidatedDegreeRequests() []string {
+	if m != nil {
+		return m.InvalidatedDegreeRequests
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTransferTokenInstance moves a token instance to another student. Only
+// the owner may transfer, and only when the token definition is transferable.
+type MsgTransferTokenInstance struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
+	Recipient       string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferTokenInstance) Reset()         { *m = MsgTransferTokenInstance{} }
+func (m *MsgTransferTokenInstance) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTokenInstance) ProtoMessage()    {}
+func (*MsgTransferTokenInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6b8d9858bc1d2a, []int{10}
 }
-
-func (m *MsgMintSubjectToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferTokenInstance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferTokenInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferTokenInstance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgMintSubjectToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferTokenInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTokenInstance.Merge(m, src)
+}
+func (m *MsgTransferTokenInstance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferTokenInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTokenInstance.DiscardUnknown(m)
 }
 
-func (m *MsgMintSubjectToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProfessorSignature) > 0 {
-		i -= len(m.ProfessorSignature)
-		copy(dAtA[i:], m.ProfessorSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProfessorSignature)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Semester) > 0 {
-		i -= len(m.Semester)
-		copy(dAtA[i:], m.Semester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Semester)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.IssuerInstitution) > 0 {
-		i -= len(m.IssuerInstitution)
-		copy(dAtA[i:], m.IssuerInstitution)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IssuerInstitution)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Grade) > 0 {
-		i -= len(m.Grade)
-		copy(dAtA[i:], m.Grade)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grade)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CompletionDate) > 0 {
-		i -= len(m.CompletionDate)
-		copy(dAtA[i:], m.CompletionDate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CompletionDate)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Student) > 0 {
-		i -= len(m.Student)
-		copy(dAtA[i:], m.Student)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Student)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TokenDefId) > 0 {
-		i -= len(m.TokenDefId)
-		copy(dAtA[i:], m.TokenDefId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenDefId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgTransferTokenInstance proto.InternalMessageInfo
+
+func (m *MsgTransferTokenInstance) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMintSubjectTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferTokenInstance) GetTokenInstanceId() string {
+	if m != nil {
+		return m.TokenInstanceId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMintSubjectTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferTokenInstance) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *MsgMintSubjectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenInstanceId) > 0 {
-		i -= len(m.TokenInstanceId)
-		copy(dAtA[i:], m.TokenInstanceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInstanceId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MsgTransferTokenInstanceResponse struct {
 }
 
-func (m *MsgVerifyTokenInstance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferTokenInstanceResponse) Reset()         { *m = MsgTransferTokenInstanceResponse{} }
+func (m *MsgTransferTokenInstanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTokenInstanceResponse) ProtoMessage()    {}
+func (*MsgTransferTokenInstanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6b8d9858bc1d2a, []int{11}
+}
+func (m *MsgTransferTokenInstanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferTokenInstanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferTokenInstanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTransferTokenInstanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTokenInstanceResponse.Merge(m, src)
+}
+func (m *MsgTransferTokenInstanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferTokenInstanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTokenInstanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgVerifyTokenInstance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferTokenInstanceResponse proto.InternalMessageInfo
+
+// MsgBurnTokenInstance destroys a token instance. The owner or an admin of the
+// issuing institution may burn, and only when the token definition is burnable.
+type MsgBurnTokenInstance struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,2,opt,name=tokenInstanceId,proto3" json:"tokenInstanceId,omitempty"`
 }
 
-func (m *MsgVerifyTokenInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenInstanceId) > 0 {
-		i -= len(m.TokenInstanceId)
-		copy(dAtA[i:], m.TokenInstanceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInstanceId)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgBurnTokenInstance) Reset()         { *m = MsgBurnTokenInstance{} }
+func (m *MsgBurnTokenInstance) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnTokenInstance) ProtoMessage()    {}
+func (*MsgBurnTokenInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6b8d9858bc1d2a, []int{12}
+}
+func (m *MsgBurnTokenInstance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnTokenInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnTokenInstance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgBurnTokenInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnTokenInstance.Merge(m, src)
+}
+func (m *MsgBurnTokenInstance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnTokenInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnTokenInstance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnTokenInstance proto.InternalMessageInfo
+
+func (m *MsgBurnTokenInstance) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgVerifyTokenInstanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBurnTokenInstance) GetTokenInstanceId() string {
+	if m != nil {
+		return m.TokenInstanceId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgVerifyTokenInstanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBurnTokenInstanceResponse struct {
 }
 
-func (m *MsgVerifyTokenInstanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsValid {
-		i--
-		if m.IsValid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgBurnTokenInstanceResponse) Reset()         { *m = MsgBurnTokenInstanceResponse{} }
+func (m *MsgBurnTokenInstanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnTokenInstanceResponse) ProtoMessage()    {}
+func (*MsgBurnTokenInstanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6b8d9858bc1d2a, []int{13}
+}
+func (m *MsgBurnTokenInstanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnTokenInstanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnTokenInstanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgBurnTokenInstanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnTokenInstanceResponse.Merge(m, src)
+}
+func (m *MsgBurnTokenInstanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnTokenInstanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnTokenInstanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnTokenInstanceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "academictoken.academicnft.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "academictoken.academicnft.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgMintSubjectToken)(nil), "academictoken.academicnft.MsgMintSubjectToken")
+	proto.RegisterType((*MsgMintSubjectTokenResponse)(nil), "academictoken.academicnft.MsgMintSubjectTokenResponse")
+	proto.RegisterType((*MsgVerifyTokenInstance)(nil), "academictoken.academicnft.MsgVerifyTokenInstance")
+	proto.RegisterType((*MsgVerifyTokenInstanceResponse)(nil), "academictoken.academicnft.MsgVerifyTokenInstanceResponse")
+	proto.RegisterType((*MsgAmendGrade)(nil), "academictoken.academicnft.MsgAmendGrade")
+	proto.RegisterType((*MsgAmendGradeResponse)(nil), "academictoken.academicnft.MsgAmendGradeResponse")
+	proto.RegisterType((*MsgRevokeTokenInstance)(nil), "academictoken.academicnft.MsgRevokeTokenInstance")
+	proto.RegisterType((*MsgRevokeTokenInstanceResponse)(nil), "academictoken.academicnft.MsgRevokeTokenInstanceResponse")
+	proto.RegisterType((*MsgTransferTokenInstance)(nil), "academictoken.academicnft.MsgTransferTokenInstance")
+	proto.RegisterType((*MsgTransferTokenInstanceResponse)(nil), "academictoken.academicnft.MsgTransferTokenInstanceResponse")
+	proto.RegisterType((*MsgBurnTokenInstance)(nil), "academictoken.academicnft.MsgBurnTokenInstance")
+	proto.RegisterType((*MsgBurnTokenInstanceResponse)(nil), "academictoken.academicnft.MsgBurnTokenInstanceResponse")
+}
+
+func init() {
+	proto.RegisterFile("academictoken/academicnft/tx.proto", fileDescriptor_8f6b8d9858bc1d2a)
+}
+
+var fileDescriptor_8f6b8d9858bc1d2a = []byte{
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x8d, 0x13, 0x3f, 0x02, 0x25, 0xdb, 0xb4, 0xdd, 0x2c, 0xd5, 0x92, 0xee, 0xa1,
+	0xb2, 0x02, 0xd8, 0x34, 0x95, 0x8a, 0x68, 0x11, 0x52, 0xa2, 0x48, 0x95, 0x85, 0x2c, 0xa1, 0x4d,
+	0xe8, 0x81, 0x03, 0x68, 0xea, 0x7d, 0xde, 0x4c, 0x93, 0x9d, 0x31, 0x33, 0xb3, 0xa1, 0xae, 0x84,
+	0x84, 0xca, 0x89, 0x9e, 0xb8, 0xf0, 0x1d, 0x38, 0xe6, 0xc0, 0x87, 0xe8, 0x09, 0x2a, 0xb8, 0x70,
+	0x42, 0x28, 0x39, 0xe4, 0x33, 0x70, 0x43, 0x3b, 0xfb, 0xc7, 0xf6, 0x7a, 0xbd, 0x4d, 0x50, 0x7a,
+	0xb1, 0xf7, 0xbd, 0xf7, 0x7b, 0x7f, 0x7e, 0x6f, 0xde, 0xfc, 0x01, 0x97, 0x74, 0x89, 0x8f, 0x21,
+	0xed, 0x2a, 0xbe, 0x8f, 0xac, 0x95, 0x49, 0xac, 0xa7, 0x5a, 0xea, 0x49, 0xb3, 0x2f, 0xb8, 0xe2,
+	0xe6, 0xea, 0x18, 0xa6, 0x39, 0x82, 0xb1, 0x97, 0x49, 0x48, 0x19, 0x6f, 0xe9, 0xdf, 0x04, 0x6d,
+	0x5f, 0xef, 0x72, 0x19, 0x72, 0xd9, 0x0a, 0x65, 0xd0, 0x3a, 0xbc, 0x1d, 0xff, 0xa5, 0x86, 0xd5,
+	0xc4, 0xf0, 0xb5, 0x96, 0x5a, 0x89, 0x90, 0x9a, 0x56, 0x02, 0x1e, 0xf0, 0x44, 0x1f, 0x7f, 0xa5,
+	0xda, 0x5b, 0xd3, 0x6b, 0xeb, 0x13, 0x41, 0xc2, 0xd4, 0xdb, 0xfd, 0xdd, 0x80, 0xcb, 0x1d, 0x19,
+	0x7c, 0xd1, 0xf7, 0x89, 0xc2, 0xcf, 0xb5, 0xc5, 0xbc, 0x0b, 0x75, 0x12, 0xa9, 0x3d, 0x2e, 0xa8,
+	0x1a, 0x58, 0xc6, 0x9a, 0xd1, 0xa8, 0x6f, 0x59, 0x7f, 0xfc, 0xfa, 0xc1, 0x4a, 0x9a, 0x76, 0xd3,
+	0xf7, 0x05, 0x4a, 0xb9, 0xa3, 0x04, 0x65, 0x81, 0x37, 0x84, 0x9a, 0xdb, 0x50, 0x4b, 0x62, 0x5b,
+	0xb3, 0x6b, 0x46, 0xe3, 0x8d, 0x8d, 0x9b, 0xcd, 0xa9, 0xe4, 0x9b, 0x49, 0xaa, 0xad, 0xfa, 0x8b,
+	0xbf, 0xdf, 0x9d, 0xf9, 0xe5, 0xf4, 0x68, 0xdd, 0xf0, 0x52, 0xdf, 0x7b, 0x9f, 0x3e, 0x3b, 0x3d,
+	0x5a, 0x1f, 0x46, 0x7d, 0x7e, 0x7a, 0xb4, 0xfe, 0xde, 0x38, 0x99, 0x27, 0x63, 0x74, 0x0a, 0xd5,
+	0xbb, 0xab, 0x70, 0xbd, 0xa0, 0xf2, 0x50, 0xf6, 0x39, 0x93, 0xe8, 0xfe, 0x39, 0x0b, 0x57, 0x3a,
+	0x32, 0xe8, 0x50, 0xa6, 0x76, 0xa2, 0x47, 0x8f, 0xb1, 0xab, 0x76, 0xe3, 0x80, 0xa6, 0x05, 0x0b,
+	0x5d, 0x81, 0x44, 0x71, 0x91, 0xd0, 0xf5, 0x32, 0xd1, 0x74, 0x00, 0x74, 0xce, 0x6d, 0xec, 0xb5,
+	0x7d, 0x4d, 0xab, 0xee, 0x8d, 0x68, 0x62, 0x4f, 0xa9, 0x22, 0x1f, 0x99, 0xb2, 0xe6, 0x12, 0xcf,
+	0x54, 0x34, 0x6f, 0xc1, 0x5b, 0x5d, 0x1e, 0xf6, 0x0f, 0x50, 0x51, 0xce, 0xb6, 0x89, 0x42, 0xeb,
+	0x92, 0x06, 0x14, 0xb4, 0xe6, 0x0a, 0xcc, 0x07, 0x82, 0xf8, 0x68, 0xcd, 0x6b, 0x73, 0x22, 0x98,
+	0xef, 0xc3, 0x32, 0x95, 0x32, 0x42, 0xd1, 0x66, 0x52, 0x51, 0x15, 0xc5, 0x70, 0xab, 0xa6, 0x11,
+	0x93, 0x06, 0xd3, 0x86, 0x45, 0x89, 0x21, 0x4a, 0x85, 0xc2, 0x5a, 0xd0, 0xa0, 0x5c, 0x36, 0x9b,
+	0x60, 0xf6, 0x05, 0xef, 0xa1, 0x94, 0x5c, 0xec, 0xd0, 0x80, 0x11, 0x15, 0x09, 0xb4, 0x16, 0x35,
+	0xaa, 0xc4, 0x62, 0xba, 0xb0, 0x24, 0x69, 0xc0, 0x28, 0x0b, 0x3e, 0xc3, 0x41, 0xdb, 0xb7, 0xea,
+	0x1a, 0x39, 0xa6, 0xbb, 0xb7, 0x14, 0x2f, 0x51, 0xd6, 0x23, 0xf7, 0x01, 0xbc, 0x53, 0xd2, 0xd4,
+	0xac, 0xe9, 0x66, 0x03, 0x2e, 0xeb, 0x86, 0xc5, 0x05, 0x13, 0xd6, 0xc5, 0xb6, 0x9f, 0x36, 0xb9,
+	0xa8, 0x76, 0x1f, 0xc3, 0xb5, 0x8e, 0x0c, 0x1e, 0xa2, 0xa0, 0xbd, 0xc1, 0xee, 0xa8, 0xad, 0x62,
+	0x81, 0x4a, 0xa2, 0xcf, 0x96, 0x46, 0x2f, 0x14, 0xfd, 0x9b, 0x01, 0x4e, 0x79, 0xb2, 0xbc, 0x70,
+	0x0b, 0x16, 0xa8, 0x7c, 0x48, 0x0e, 0x68, 0x52, 0xf0, 0xa2, 0x97, 0x89, 0x13, 0x3d, 0x9a, 0x9d,
+	0xec, 0x91, 0x79, 0x0d, 0x6a, 0xb1, 0x8c, 0x22, 0x1d, 0x8c, 0x54, 0x8a, 0xe7, 0x42, 0x66, 0xcd,
+	0x4e, 0x82, 0x5f, 0xd2, 0xc1, 0x0b, 0xda, 0x78, 0xdd, 0xf6, 0x71, 0xa0, 0xbf, 0x37, 0x55, 0x5b,
+	0xca, 0x28, 0xae, 0x4d, 0x0f, 0xc9, 0xa2, 0x57, 0x62, 0x71, 0x7f, 0x36, 0xe0, 0xcd, 0x8e, 0x0c,
+	0x36, 0x43, 0x64, 0xfe, 0x03, 0x3d, 0x43, 0x17, 0xd0, 0xb4, 0x78, 0xb2, 0x18, 0x7e, 0xab, 0xe3,
+	0xa5, 0x3c, 0x72, 0x39, 0x66, 0x28, 0x90, 0x48, 0xce, 0xd2, 0xc9, 0x4e, 0xa5, 0x42, 0xa3, 0x37,
+	0xe1, 0xea, 0x58, 0x59, 0xa3, 0x73, 0xc1, 0xb8, 0x08, 0xc9, 0x01, 0x7d, 0x8a, 0x89, 0x29, 0x9b,
+	0x8b, 0x82, 0xda, 0x7d, 0x66, 0xe8, 0xc1, 0xf0, 0xf0, 0x90, 0xef, 0xe3, 0x85, 0x0f, 0xc6, 0x08,
+	0x8f, 0xb9, 0x0a, 0x1e, 0x5f, 0xe9, 0x79, 0x29, 0xa9, 0x21, 0x27, 0xf4, 0x09, 0xac, 0x52, 0x76,
+	0x18, 0x2f, 0x0b, 0x51, 0xe8, 0x6f, 0x63, 0x20, 0x10, 0x3d, 0xfc, 0x26, 0x42, 0xa9, 0xa4, 0x65,
+	0xac, 0xcd, 0x35, 0xea, 0xde, 0x74, 0x80, 0xfb, 0xdc, 0x00, 0xab, 0x23, 0x83, 0x5d, 0x41, 0x98,
+	0xec, 0xa1, 0xb8, 0x78, 0x9a, 0x37, 0xa0, 0x2e, 0xb0, 0x4b, 0xfb, 0x74, 0x78, 0x58, 0x0d, 0x15,
+	0x05, 0xb2, 0x2e, 0xac, 0x4d, 0xab, 0x25, 0x3f, 0x4c, 0xf7, 0x60, 0xa5, 0x23, 0x83, 0xad, 0x48,
+	0xb0, 0xd7, 0xbd, 0x57, 0x1d, 0xb8, 0x51, 0x96, 0x29, 0xab, 0x64, 0xe3, 0xdf, 0x1a, 0xcc, 0x75,
+	0x64, 0x60, 0x32, 0x58, 0x1a, 0xbb, 0xc7, 0xd6, 0x2b, 0xee, 0x9f, 0xc2, 0x15, 0x61, 0x6f, 0x9c,
+	0x1d, 0x9b, 0x2f, 0xf8, 0x53, 0x78, 0x7b, 0xe2, 0x2a, 0x69, 0x56, 0xc7, 0x29, 0xe2, 0xed, 0xbb,
+	0xe7, 0xc3, 0xe7, 0xb9, 0x7f, 0x30, 0xe0, 0x4a, 0xd9, 0x49, 0x79, 0xbb, 0x3a, 0x5e, 0x89, 0x8b,
+	0xfd, 0xf1, 0xb9, 0x5d, 0xf2, 0x2a, 0xf6, 0x00, 0x46, 0x0e, 0x9c, 0x46, 0x75, 0xa0, 0x21, 0xd2,
+	0xfe, 0xf0, 0xac, 0xc8, 0x31, 0xbe, 0x65, 0x07, 0xc0, 0x2b, 0xf8, 0x96, 0xb8, 0xbc, 0x8a, 0x6f,
+	0xd5, 0x16, 0xff, 0xd1, 0x80, 0xab, 0xe5, 0x3b, 0xf4, 0x4e, 0x75, 0xd0, 0x52, 0x27, 0xfb, 0xfe,
+	0xff, 0x70, 0xca, 0x6b, 0xf9, 0x0e, 0x96, 0x27, 0x37, 0x5f, 0xab, 0x3a, 0xe2, 0x84, 0x83, 0xfd,
+	0xd1, 0x39, 0x1d, 0xb2, 0xf4, 0xf6, 0xfc, 0xf7, 0xf1, 0xab, 0x6d, 0xeb, 0xfe, 0x8b, 0x63, 0xc7,
+	0x78, 0x79, 0xec, 0x18, 0xff, 0x1c, 0x3b, 0xc6, 0x4f, 0x27, 0xce, 0xcc, 0xcb, 0x13, 0x67, 0xe6,
+	0xaf, 0x13, 0x67, 0xe6, 0xcb, 0x9b, 0x55, 0x8f, 0x36, 0x35, 0xe8, 0xa3, 0x7c, 0x54, 0xd3, 0x6f,
+	0xd0, 0x3b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xfb, 0x7f, 0xaf, 0x49, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	MintSubjectToken(ctx context.Context, in *MsgMintSubjectToken, opts ...grpc.CallOption) (*MsgMintSubjectTokenResponse, error)
+	VerifyTokenInstance(ctx context.Context, in *MsgVerifyTokenInstance, opts ...grpc.CallOption) (*MsgVerifyTokenInstanceResponse, error)
+	AmendGrade(ctx context.Context, in *MsgAmendGrade, opts ...grpc.CallOption) (*MsgAmendGradeResponse, error)
+	RevokeTokenInstance(ctx context.Context, in *MsgRevokeTokenInstance, opts ...grpc.CallOption) (*MsgRevokeTokenInstanceResponse, error)
+	TransferTokenInstance(ctx context.Context, in *MsgTransferTokenInstance, opts ...grpc.CallOption) (*MsgTransferTokenInstanceResponse, error)
+	BurnTokenInstance(ctx context.Context, in *MsgBurnTokenInstance, opts ...grpc.CallOption) (*MsgBurnTokenInstanceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) MintSubjectToken(ctx context.Context, in *MsgMintSubjectToken, opts ...grpc.CallOption) (*MsgMintSubjectTokenResponse, error) {
+	out := new(MsgMintSubjectTokenResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/MintSubjectToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) VerifyTokenInstance(ctx context.Context, in *MsgVerifyTokenInstance, opts ...grpc.CallOption) (*MsgVerifyTokenInstanceResponse, error) {
+	out := new(MsgVerifyTokenInstanceResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/VerifyTokenInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AmendGrade(ctx context.Context, in *MsgAmendGrade, opts ...grpc.CallOption) (*MsgAmendGradeResponse, error) {
+	out := new(MsgAmendGradeResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/AmendGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgMintSubjectToken) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RevokeTokenInstance(ctx context.Context, in *MsgRevokeTokenInstance, opts ...grpc.CallOption) (*MsgRevokeTokenInstanceResponse, error) {
+	out := new(MsgRevokeTokenInstanceResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/RevokeTokenInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) TransferTokenInstance(ctx context.Context, in *MsgTransferTokenInstance, opts ...grpc.CallOption) (*MsgTransferTokenInstanceResponse, error) {
+	out := new(MsgTransferTokenInstanceResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/TransferTokenInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TokenDefId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) BurnTokenInstance(ctx context.Context, in *MsgBurnTokenInstance, opts ...grpc.CallOption) (*MsgBurnTokenInstanceResponse, error) {
+	out := new(MsgBurnTokenInstanceResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.academicnft.Msg/BurnTokenInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Student)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	MintSubjectToken(context.Context, *MsgMintSubjectToken) (*MsgMintSubjectTokenResponse, error)
+	VerifyTokenInstance(context.Context, *MsgVerifyTokenInstance) (*MsgVerifyTokenInstanceResponse, error)
+	AmendGrade(context.Context, *MsgAmendGrade) (*MsgAmendGradeResponse, error)
+	RevokeTokenInstance(context.Context, *MsgRevokeTokenInstance) (*MsgRevokeTokenInstanceResponse, error)
+	TransferTokenInstance(context.Context, *MsgTransferTokenInstance) (*MsgTransferTokenInstanceResponse, error)
+	BurnTokenInstance(context.Context, *MsgBurnTokenInstance) (*MsgBurnTokenInstanceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) MintSubjectToken(ctx context.Context, req *MsgMintSubjectToken) (*MsgMintSubjectTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintSubjectToken not implemented")
+}
+func (*UnimplementedMsgServer) VerifyTokenInstance(ctx context.Context, req *MsgVerifyTokenInstance) (*MsgVerifyTokenInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTokenInstance not implemented")
+}
+func (*UnimplementedMsgServer) AmendGrade(ctx context.Context, req *MsgAmendGrade) (*MsgAmendGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendGrade not implemented")
+}
+func (*UnimplementedMsgServer) RevokeTokenInstance(ctx context.Context, req *MsgRevokeTokenInstance) (*MsgRevokeTokenInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTokenInstance not implemented")
+}
+func (*UnimplementedMsgServer) TransferTokenInstance(ctx context.Context, req *MsgTransferTokenInstance) (*MsgTransferTokenInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTokenInstance not implemented")
+}
+func (*UnimplementedMsgServer) BurnTokenInstance(ctx context.Context, req *MsgBurnTokenInstance) (*MsgBurnTokenInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnTokenInstance not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.CompletionDate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.Grade)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Msg/UpdateParams",
 	}
-	l = len(m.IssuerInstitution)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	l = len(m.Semester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintSubjectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintSubjectToken)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ProfessorSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).MintSubjectToken(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Msg/MintSubjectToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintSubjectToken(ctx, req.(*MsgMintSubjectToken))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMintSubjectTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_VerifyTokenInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyTokenInstance)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TokenInstanceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyTokenInstance(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Msg/VerifyTokenInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyTokenInstance(ctx, req.(*MsgVerifyTokenInstance))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVerifyTokenInstance) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AmendGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendGrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AmendGrade(ctx, in)
 	}
-	l = len(m.TokenInstanceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Msg/AmendGrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendGrade(ctx, req.(*MsgAmendGrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeTokenInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeTokenInstance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeTokenInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Msg/RevokeTokenInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeTokenInstance(ctx, req.(*MsgRevokeTokenInstance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTokenInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTokenInstance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTokenInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Msg/TransferTokenInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTokenInstance(ctx, req.(*MsgTransferTokenInstance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnTokenInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnTokenInstance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnTokenInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.academicnft.Msg/BurnTokenInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnTokenInstance(ctx, req.(*MsgBurnTokenInstance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "academictoken.academicnft.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "MintSubjectToken",
+			Handler:    _Msg_MintSubjectToken_Handler,
+		},
+		{
+			MethodName: "VerifyTokenInstance",
+			Handler:    _Msg_VerifyTokenInstance_Handler,
+		},
+		{
+			MethodName: "AmendGrade",
+			Handler:    _Msg_AmendGrade_Handler,
+		},
+		{
+			MethodName: "RevokeTokenInstance",
+			Handler:    _Msg_RevokeTokenInstance_Handler,
+		},
+		{
+			MethodName: "TransferTokenInstance",
+			Handler:    _Msg_TransferTokenInstance_Handler,
+		},
+		{
+			MethodName: "BurnTokenInstance",
+			Handler:    _Msg_BurnTokenInstance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "academictoken/academicnft/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintSubjectToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintSubjectToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintSubjectToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigningKeyId) > 0 {
+		i -= len(m.SigningKeyId)
+		copy(dAtA[i:], m.SigningKeyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SigningKeyId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProfessorSignature) > 0 {
+		i -= len(m.ProfessorSignature)
+		copy(dAtA[i:], m.ProfessorSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProfessorSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Semester) > 0 {
+		i -= len(m.Semester)
+		copy(dAtA[i:], m.Semester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Semester)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IssuerInstitution) > 0 {
+		i -= len(m.IssuerInstitution)
+		copy(dAtA[i:], m.IssuerInstitution)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IssuerInstitution)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CompletionDate) > 0 {
+		i -= len(m.CompletionDate)
+		copy(dAtA[i:], m.CompletionDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CompletionDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Student) > 0 {
+		i -= len(m.Student)
+		copy(dAtA[i:], m.Student)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Student)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenDefId) > 0 {
+		i -= len(m.TokenDefId)
+		copy(dAtA[i:], m.TokenDefId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenDefId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintSubjectTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintSubjectTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintSubjectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenInstanceId) > 0 {
+		i -= len(m.TokenInstanceId)
+		copy(dAtA[i:], m.TokenInstanceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInstanceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyTokenInstance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyTokenInstance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyTokenInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenInstanceId) > 0 {
+		i -= len(m.TokenInstanceId)
+		copy(dAtA[i:], m.TokenInstanceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInstanceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyTokenInstanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyTokenInstanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyTokenInstanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyValidAtIssuance {
+		i--
+		if m.KeyValidAtIssuance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignatureValid {
+		i--
+		if m.SignatureValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SigningKeyId) > 0 {
+		i -= len(m.SigningKeyId)
+		copy(dAtA[i:], m.SigningKeyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SigningKeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IsValid {
+		i--
+		if m.IsValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendGrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendGrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendGrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewGrade) > 0 {
+		i -= len(m.NewGrade)
+		copy(dAtA[i:], m.NewGrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewGrade)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenInstanceId) > 0 {
+		i -= len(m.TokenInstanceId)
+		copy(dAtA[i:], m.TokenInstanceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInstanceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendGradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendGradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendGradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NormalizedGrade) > 0 {
+		i -= len(m.NormalizedGrade)
+		copy(dAtA[i:], m.NormalizedGrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NormalizedGrade)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTokenInstance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTokenInstance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTokenInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenInstanceId) > 0 {
+		i -= len(m.TokenInstanceId)
+		copy(dAtA[i:], m.TokenInstanceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInstanceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTokenInstanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTokenInstanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTokenInstanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidatedDegreeRequests) > 0 {
+		for iNdEx := len(m.InvalidatedDegreeRequests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InvalidatedDegreeRequests[iNdEx])
+			copy(dAtA[i:], m.InvalidatedDegreeRequests[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.InvalidatedDegreeRequests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTokenInstance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTokenInstance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTokenInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenInstanceId) > 0 {
+		i -= len(m.TokenInstanceId)
+		copy(dAtA[i:], m.TokenInstanceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInstanceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTokenInstanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTokenInstanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTokenInstanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnTokenInstance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnTokenInstance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnTokenInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenInstanceId) > 0 {
+		i -= len(m.TokenInstanceId)
+		copy(dAtA[i:], m.TokenInstanceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInstanceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnTokenInstanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnTokenInstanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnTokenInstanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintSubjectToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenDefId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Student)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CompletionDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IssuerInstitution)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Semester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProfessorSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SigningKeyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintSubjectTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInstanceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVerifyTokenInstance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenInstanceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVerifyTokenInstanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsValid {
+		n += 2
+	}
+	l = len(m.SigningKeyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignatureValid {
+		n += 2
+	}
+	if m.KeyValidAtIssuance {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAmendGrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenInstanceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewGrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAmendGradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NormalizedGrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeTokenInstance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenInstanceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeTokenInstanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InvalidatedDegreeRequests) > 0 {
+		for _, s := range m.InvalidatedDegreeRequests {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferTokenInstance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenInstanceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferTokenInstanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnTokenInstance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenInstanceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnTokenInstanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintSubjectToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintSubjectToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDefId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenDefId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Student", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Student = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerInstitution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerInstitution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfessorSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfessorSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintSubjectTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintSubjectTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintSubjectTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyTokenInstance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyTokenInstance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyTokenInstance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgVerifyTokenInstanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsValid {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgVerifyTokenInstanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyTokenInstanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyTokenInstanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsValid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsValid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureValid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignatureValid = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValidAtIssuance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyValidAtIssuance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendGrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -966,15 +2931,79 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendGrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendGrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGrade", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1002,13 +3031,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.NewGrade = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1018,24 +3047,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1058,7 +3086,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendGradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1081,12 +3109,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendGradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendGradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1108,7 +3168,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeTokenInstance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1131,10 +3191,10 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintSubjectToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeTokenInstance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintSubjectToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeTokenInstance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1171,7 +3231,7 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenDefId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1199,11 +3259,11 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenDefId = string(dAtA[iNdEx:postIndex])
+			m.TokenInstanceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Student", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1231,43 +3291,61 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Student = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionDate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeTokenInstanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CompletionDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeTokenInstanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeTokenInstanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidatedDegreeRequests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1295,11 +3373,61 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grade = string(dAtA[iNdEx:postIndex])
+			m.InvalidatedDegreeRequests = append(m.InvalidatedDegreeRequests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTokenInstance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTokenInstance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTokenInstance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerInstitution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1327,11 +3455,11 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerInstitution = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1359,11 +3487,11 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Semester = string(dAtA[iNdEx:postIndex])
+			m.TokenInstanceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfessorSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1391,7 +3519,7 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProfessorSignature = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1414,7 +3542,7 @@ func (m *MsgMintSubjectToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintSubjectTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferTokenInstanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1437,44 +3565,12 @@ func (m *MsgMintSubjectTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintSubjectTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferTokenInstanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintSubjectTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferTokenInstanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenInstanceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1496,7 +3592,7 @@ func (m *MsgMintSubjectTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVerifyTokenInstance) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnTokenInstance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,10 +3615,10 @@ func (m *MsgVerifyTokenInstance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVerifyTokenInstance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnTokenInstance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVerifyTokenInstance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnTokenInstance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1610,7 +3706,7 @@ func (m *MsgVerifyTokenInstance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVerifyTokenInstanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnTokenInstanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1633,32 +3729,12 @@ func (m *MsgVerifyTokenInstanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVerifyTokenInstanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnTokenInstanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVerifyTokenInstanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnTokenInstanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsValid", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsValid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
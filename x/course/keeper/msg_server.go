@@ -99,6 +99,9 @@ func (k msgServer) CreateCourse(goCtx context.Context, req *types.MsgCreateCours
 		if !found {
 			return nil, fmt.Errorf("institution with ID '%s' not found", req.Institution)
 		}
+		if !k.institutionKeeper.IsInstitutionAuthorized(ctx, req.Institution) {
+			return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", req.Institution)
+		}
 	}
 
 	// Check if course with same code already exists for this institution
@@ -176,6 +179,11 @@ func (k msgServer) UpdateCourse(goCtx context.Context, req *types.MsgUpdateCours
 		return nil, fmt.Errorf("course with index '%s' not found", req.Index)
 	}
 
+	// Courses of institutions whose accreditation lapsed can no longer change
+	if k.institutionKeeper != nil && !k.institutionKeeper.IsInstitutionAuthorized(ctx, course.Institution) {
+		return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", course.Institution)
+	}
+
 	// Check permissions using the CanUpdateCourse method
 	if !k.CanUpdateCourse(ctx, req.Index, req.Creator) {
 		return nil, fmt.Errorf("creator '%s' is not authorized to update course '%s'", req.Creator, req.Index)
@@ -22,3 +22,22 @@ func TestMsgServer(t *testing.T) {
 	require.NotNil(t, ctx)
 	require.NotEmpty(t, k)
 }
+
+func TestCourseRequiresAccreditedInstitution(t *testing.T) {
+	institutionKeeper := keepertest.MockCourseInstitutionKeeper{Unaccredited: map[string]bool{"institution-2": true}}
+	k, ctx := keepertest.CourseKeeperWithInstitution(t, institutionKeeper)
+	ms := keeper.NewMsgServerImpl(k)
+
+	_, err := ms.CreateCourse(ctx, &types.MsgCreateCourse{Creator: "academic1test", Institution: "institution-2", Name: "Physics", Code: "PHY", TotalCredits: "120", DegreeLevel: "undergraduate"})
+	require.ErrorIs(t, err, types.ErrInstitutionNotAccredited)
+
+	_, err = ms.CreateCourse(ctx, &types.MsgCreateCourse{Creator: "academic1test", Institution: "institution-1", Name: "Computer Science", Code: "CS", TotalCredits: "120", DegreeLevel: "undergraduate"})
+	require.NoError(t, err)
+	courses := k.GetAllCourse(ctx)
+	require.Len(t, courses, 1)
+
+	// Courses can no longer be updated once the accreditation lapses
+	institutionKeeper.Unaccredited["institution-1"] = true
+	_, err = ms.UpdateCourse(ctx, &types.MsgUpdateCourse{Creator: "academic1test", Index: courses[0].Index, Name: "Computing"})
+	require.ErrorIs(t, err, types.ErrInstitutionNotAccredited)
+}
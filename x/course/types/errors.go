@@ -8,6 +8,7 @@ import (
 
 // x/course module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner            = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample                   = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInstitutionNotAccredited = sdkerrors.Register(ModuleName, 1102, "institution is not accredited")
 )
@@ -8,6 +8,8 @@ import (
 )
 
 // CurriculumIPFSClient wraps the base IPFS client with curriculum-specific functionality
+// and performs network I/O, so it is for clients and off-chain services only;
+// keepers validate references with VerifyCurriculumData instead
 type CurriculumIPFSClient struct {
 	*subjectipfs.IPFSClient
 }
@@ -32,6 +34,16 @@ func (c *CurriculumIPFSClient) AddCurriculumData(data interface{}) (string, stri
 	return c.Add(jsonData)
 }
 
+// VerifyCurriculumData checks, without any I/O, that data matches the content hash
+// and IPFS link uploaded for it
+func VerifyCurriculumData(data interface{}, contentHash, ipfsLink string) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal curriculum data: %w", err)
+	}
+	return subjectipfs.VerifyContent(jsonData, contentHash, ipfsLink)
+}
+
 // GetCurriculumData retrieves and unmarshals curriculum data from IPFS
 func (c *CurriculumIPFSClient) GetCurriculumData(ipfsLink string, target interface{}) error {
 	// Get raw data from IPFS
@@ -37,8 +37,9 @@ type (
 		authority string
 
 		// Dependencies on other modules
-		courseKeeper  *coursekeeper.Keeper
-		subjectKeeper *subjectkeeper.Keeper
+		courseKeeper      *coursekeeper.Keeper
+		subjectKeeper     *subjectkeeper.Keeper
+		institutionKeeper types.InstitutionKeeper
 	}
 )
 
@@ -50,6 +51,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	courseKeeper *coursekeeper.Keeper,
 	subjectKeeper *subjectkeeper.Keeper,
+	institutionKeeper types.InstitutionKeeper,
 	authority string,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
@@ -62,14 +64,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeService:  storeService,
-		storeKey:      storeKey,
-		authority:     authority,
-		logger:        logger,
-		paramstore:    ps,
-		courseKeeper:  courseKeeper,
-		subjectKeeper: subjectKeeper,
+		cdc:               cdc,
+		storeService:      storeService,
+		storeKey:          storeKey,
+		authority:         authority,
+		logger:            logger,
+		paramstore:        ps,
+		courseKeeper:      courseKeeper,
+		subjectKeeper:     subjectKeeper,
+		institutionKeeper: institutionKeeper,
 	}
 }
 
@@ -271,6 +274,9 @@ func (k Keeper) AddSemesterToCurriculum(ctx context.Context, curriculumIndex str
 	if !found {
 		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "curriculum tree not found")
 	}
+	if err := ensureDraft(curriculum); err != nil {
+		return err
+	}
 
 	// Validate subjects exist
 	if err := k.ValidateSubjectsExist(ctx, subjectIds); err != nil {
@@ -302,6 +308,9 @@ func (k Keeper) AddElectiveGroupToCurriculum(ctx context.Context, curriculumInde
 	if !found {
 		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "curriculum tree not found")
 	}
+	if err := ensureDraft(curriculum); err != nil {
+		return err
+	}
 
 	// Validate subjects exist
 	if err := k.ValidateSubjectsExist(ctx, electiveGroup.SubjectIds); err != nil {
@@ -323,6 +332,9 @@ func (k Keeper) SetGraduationRequirements(ctx context.Context, curriculumIndex s
 	if !found {
 		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "curriculum tree not found")
 	}
+	if err := ensureDraft(curriculum); err != nil {
+		return err
+	}
 
 	curriculum.GraduationRequirements = &requirements
 	k.SetCurriculumTree(ctx, curriculum)
@@ -120,6 +120,7 @@ func (k msgServer) CreateCurriculumTree(goCtx context.Context, req *types.MsgCre
 		ElectiveSubjects:  req.ElectiveSubjects,
 		SemesterStructure: []*types.CurriculumSemester{}, // Initialize empty
 		ElectiveGroups:    []*types.ElectiveGroup{},      // Initialize empty
+		Status:            types.CurriculumStatusDraft, // Editable until published
 	}
 
 	// Store curriculum tree
@@ -177,6 +178,9 @@ func (k msgServer) AddSemesterToCurriculum(goCtx context.Context, req *types.Msg
 	if !found {
 		return nil, fmt.Errorf("curriculum with index '%s' not found", req.CurriculumIndex)
 	}
+	if err := ensureDraft(curriculum); err != nil {
+		return nil, err
+	}
 
 	// Check permissions (only course creator or authority can update)
 	// Note: Need to implement proper authorization logic
@@ -277,6 +281,9 @@ func (k msgServer) AddElectiveGroup(goCtx context.Context, req *types.MsgAddElec
 	if !found {
 		return nil, fmt.Errorf("curriculum with index '%s' not found", req.CurriculumIndex)
 	}
+	if err := ensureDraft(curriculum); err != nil {
+		return nil, err
+	}
 
 	// Check permissions (only course creator or authority can update)
 	// Note: Need to implement proper authorization logic
@@ -389,6 +396,9 @@ func (k msgServer) SetGraduationRequirements(goCtx context.Context, req *types.M
 	if !found {
 		return nil, fmt.Errorf("curriculum with index '%s' not found", req.CurriculumIndex)
 	}
+	if err := ensureDraft(curriculum); err != nil {
+		return nil, err
+	}
 
 	// Check permissions (only course creator or authority can update)
 	// Note: Need to implement proper authorization logic
@@ -448,3 +458,51 @@ func (k msgServer) SetGraduationRequirements(goCtx context.Context, req *types.M
 
 	return &types.MsgSetGraduationRequirementsResponse{}, nil
 }
+
+// PublishCurriculumVersion publishes an immutable copy of a curriculum tree under a new version
+func (k msgServer) PublishCurriculumVersion(goCtx context.Context, req *types.MsgPublishCurriculumVersion) (*types.MsgPublishCurriculumVersionResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only admins and registrars of the course institution may publish
+	curriculum, found := k.GetCurriculumTree(ctx, req.CurriculumIndex)
+	if !found {
+		return nil, types.ErrCurriculumNotFound.Wrapf("curriculum '%s' not found", req.CurriculumIndex)
+	}
+	course, found := k.courseKeeper.GetCourse(ctx, curriculum.CourseId)
+	if !found {
+		return nil, fmt.Errorf("course with ID '%s' not found", curriculum.CourseId)
+	}
+	if req.Creator != k.authority && (k.institutionKeeper == nil ||
+		!k.institutionKeeper.CanManageCurriculum(ctx, course.Institution, req.Creator, course.Index)) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' cannot publish curricula of course '%s'", req.Creator, course.Index)
+	}
+
+	published, err := k.Keeper.PublishCurriculumVersion(ctx, req.CurriculumIndex, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCurriculumVersionPublished,
+			sdk.NewAttribute(types.AttributeKeyCurriculumIndex, published.Index),
+			sdk.NewAttribute(types.AttributeKeyCourseId, published.CourseId),
+			sdk.NewAttribute(types.AttributeKeyVersion, published.Version),
+			sdk.NewAttribute(types.AttributeKeyDerivedFrom, published.DerivedFrom),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		),
+	)
+
+	k.Logger().Info("Curriculum version published",
+		"curriculum_index", published.Index,
+		"course_id", published.CourseId,
+		"version", published.Version,
+		"derived_from", published.DerivedFrom,
+	)
+
+	return &types.MsgPublishCurriculumVersionResponse{CurriculumIndex: published.Index}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/curriculum/types"
+)
+
+// PublishCurriculumVersion copies a curriculum tree into a new, immutable version
+// of the same course. The source tree is left untouched, so students pinned to it
+// keep evaluating against the rules they enrolled under.
+func (k Keeper) PublishCurriculumVersion(ctx context.Context, curriculumIndex string, version string) (types.CurriculumTree, error) {
+	source, found := k.GetCurriculumTree(ctx, curriculumIndex)
+	if !found {
+		return types.CurriculumTree{}, errorsmod.Wrapf(types.ErrCurriculumNotFound, "curriculum with index '%s' not found", curriculumIndex)
+	}
+
+	for _, curriculum := range k.GetCurriculumTreesByCourse(ctx, source.CourseId) {
+		if curriculum.Version == version {
+			return types.CurriculumTree{}, errorsmod.Wrapf(types.ErrCurriculumVersionExists, "curriculum version '%s' already exists for course '%s'", version, source.CourseId)
+		}
+	}
+
+	// Copy through the codec so the published tree shares no slices with the source
+	var published types.CurriculumTree
+	k.cdc.MustUnmarshal(k.cdc.MustMarshal(&source), &published)
+
+	count := k.GetCurriculumTreeCount(ctx)
+	published.Index = strconv.FormatUint(count, 10)
+	published.Version = version
+	published.Status = types.CurriculumStatusPublished
+	published.PublishedAt = sdk.UnwrapSDKContext(ctx).BlockTime().UTC().Format(time.RFC3339)
+	published.DerivedFrom = source.Index
+
+	k.SetCurriculumTree(ctx, published)
+	k.SetCurriculumTreeCount(ctx, count+1)
+
+	return published, nil
+}
+
+// GetLatestPublishedCurriculum returns the most recently published curriculum
+// version of a course. Trees without a publication time predate versioning and
+// rank below any explicitly published version.
+func (k Keeper) GetLatestPublishedCurriculum(ctx context.Context, courseId string) (latest types.CurriculumTree, found bool) {
+	for _, curriculum := range k.GetCurriculumTreesByCourse(ctx, courseId) {
+		if !curriculum.IsPublished() {
+			continue
+		}
+		if !found || PublishedAfter(curriculum, latest) {
+			latest, found = curriculum, true
+		}
+	}
+	return latest, found
+}
+
+// PublishedAfter reports whether curriculum a was published after curriculum b,
+// breaking ties on the tree index.
+func PublishedAfter(a, b types.CurriculumTree) bool {
+	if a.PublishedAt != b.PublishedAt {
+		return a.PublishedAt > b.PublishedAt
+	}
+	ai, _ := strconv.ParseUint(a.Index, 10, 64)
+	bi, _ := strconv.ParseUint(b.Index, 10, 64)
+	return ai > bi
+}
+
+// ensureDraft rejects edits to a curriculum version that has been published.
+func ensureDraft(curriculum types.CurriculumTree) error {
+	if curriculum.IsPublished() {
+		return errorsmod.Wrapf(types.ErrCurriculumPublished, "curriculum '%s' version '%s' can no longer be edited; publish a new version instead", curriculum.Index, curriculum.Version)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	coursetypes "academictoken/x/course/types"
+	"academictoken/x/curriculum/keeper"
+	"academictoken/x/curriculum/types"
+)
+
+func TestPublishCurriculumVersion(t *testing.T) {
+	creator := sample.AccAddress()
+	institutionKeeper := keepertest.MockCurriculumInstitutionKeeper{Managers: map[string]bool{creator: true}}
+	courses := []coursetypes.Course{{Index: "course-1", Institution: "institution-1", Name: "Computer Science"}}
+	k, ctx := keepertest.CurriculumKeeperWithCourses(t, institutionKeeper, courses)
+	ms := keeper.NewMsgServerImpl(k)
+	sdkCtx := ctx.WithBlockTime(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC))
+
+	k.SetCurriculumTree(sdkCtx, types.CurriculumTree{Index: "0", CourseId: "course-1", Version: "2024", RequiredSubjects: []string{"calc1"}, Status: types.CurriculumStatusDraft})
+	k.SetCurriculumTreeCount(sdkCtx, 1)
+
+	// Only admins and registrars of the course institution may publish
+	_, err := ms.PublishCurriculumVersion(sdkCtx, &types.MsgPublishCurriculumVersion{Creator: sample.AccAddress(), CurriculumIndex: "0", Version: "2025"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := ms.PublishCurriculumVersion(sdkCtx, &types.MsgPublishCurriculumVersion{Creator: creator, CurriculumIndex: "0", Version: "2025"})
+	require.NoError(t, err)
+	require.Equal(t, "1", res.CurriculumIndex)
+
+	published, found := k.GetCurriculumTree(sdkCtx, "1")
+	require.True(t, found)
+	require.Equal(t, "2025", published.Version)
+	require.Equal(t, types.CurriculumStatusPublished, published.Status)
+	require.Equal(t, "2025-01-15T00:00:00Z", published.PublishedAt)
+	require.Equal(t, "0", published.DerivedFrom)
+	require.Equal(t, []string{"calc1"}, published.RequiredSubjects)
+
+	latest, found := k.GetLatestPublishedCurriculum(sdkCtx, "course-1")
+	require.True(t, found)
+	require.Equal(t, "1", latest.Index)
+
+	// Published versions are immutable, drafts stay editable
+	_, err = ms.SetGraduationRequirements(sdkCtx, &types.MsgSetGraduationRequirements{Creator: creator, CurriculumIndex: "1", TotalCreditsRequired: 10, MinGpa: 2, MinimumTimeYears: 1, MaximumTimeYears: 2})
+	require.ErrorIs(t, err, types.ErrCurriculumPublished)
+	require.ErrorIs(t, k.AddSemesterToCurriculum(sdkCtx, "1", 1, nil), types.ErrCurriculumPublished)
+	_, err = ms.SetGraduationRequirements(sdkCtx, &types.MsgSetGraduationRequirements{Creator: creator, CurriculumIndex: "0", TotalCreditsRequired: 10, MinGpa: 2, MinimumTimeYears: 1, MaximumTimeYears: 2})
+	require.NoError(t, err)
+
+	// Trees that predate versioning count as published
+	k.SetCurriculumTree(sdkCtx, types.CurriculumTree{Index: "2", CourseId: "course-2", Version: "legacy"})
+	require.ErrorIs(t, k.AddSemesterToCurriculum(sdkCtx, "2", 1, nil), types.ErrCurriculumPublished)
+
+	_, err = ms.PublishCurriculumVersion(sdkCtx, &types.MsgPublishCurriculumVersion{Creator: creator, CurriculumIndex: "0", Version: "2025"})
+	require.ErrorIs(t, err, types.ErrCurriculumVersionExists)
+	_, err = ms.PublishCurriculumVersion(sdkCtx, &types.MsgPublishCurriculumVersion{Creator: creator, CurriculumIndex: "9", Version: "2026"})
+	require.ErrorIs(t, err, types.ErrCurriculumNotFound)
+}
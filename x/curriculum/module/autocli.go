@@ -52,6 +52,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a set-graduation-requirements tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "curriculumIndex"}, {ProtoField: "totalCreditsRequired"}, {ProtoField: "minGpa"}, {ProtoField: "requiredElectiveCredits"}, {ProtoField: "minimumTimeYears"}, {ProtoField: "maximumTimeYears"}},
 				},
+				{
+					RpcMethod:      "PublishCurriculumVersion",
+					Use:            "publish-curriculum-version [curriculum-index] [version]",
+					Short:          "Publish an immutable copy of a curriculum tree under a new version",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "curriculumIndex"}, {ProtoField: "version"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -33,6 +33,12 @@ type CurriculumTree struct {
 	SemesterStructure      []*CurriculumSemester   `protobuf:"bytes,8,rep,name=semesterStructure,proto3" json:"semesterStructure,omitempty"`
 	GraduationRequirements *GraduationRequirements `protobuf:"bytes,9,opt,name=graduationRequirements,proto3" json:"graduationRequirements,omitempty"`
 	ElectiveGroups         []*ElectiveGroup        `protobuf:"bytes,10,rep,name=electiveGroups,proto3" json:"electiveGroups,omitempty"`
+	// status is draft while the tree can be edited and published once frozen;
+	// trees stored without a status predate versioning and count as published
+	Status      string `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	PublishedAt string `protobuf:"bytes,12,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	// derivedFrom is the index of the tree a published version was copied from
+	DerivedFrom string `protobuf:"bytes,13,opt,name=derivedFrom,proto3" json:"derivedFrom,omitempty"`
 }
 
 func (m *CurriculumTree) Reset()         { *m = CurriculumTree{} }
@@ -138,6 +144,27 @@ func (m *CurriculumTree) GetElectiveGroups() []*ElectiveGroup {
 	return nil
 }
 
+func (m *CurriculumTree) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *CurriculumTree) GetPublishedAt() string {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return ""
+}
+
+func (m *CurriculumTree) GetDerivedFrom() string {
+	if m != nil {
+		return m.DerivedFrom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CurriculumTree)(nil), "academictoken.curriculum.CurriculumTree")
 }
@@ -147,32 +174,35 @@ func init() {
 }
 
 var fileDescriptor_d89d4d5b3f4e6ad5 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x4a, 0xe3, 0x50,
-	0x18, 0x6d, 0xa6, 0xff, 0xb7, 0x50, 0x66, 0x2e, 0xc3, 0x70, 0xe9, 0x22, 0x84, 0xd9, 0x4c, 0x18,
-	0x66, 0xa2, 0x54, 0x70, 0xe1, 0x52, 0x91, 0xea, 0x42, 0x84, 0x54, 0x10, 0xba, 0x29, 0xe9, 0xcd,
-	0x47, 0xbd, 0x36, 0xc9, 0xad, 0xf7, 0xa7, 0xd4, 0xb7, 0xf0, 0x05, 0x7c, 0x1f, 0x97, 0x5d, 0xba,
-	0x94, 0xf6, 0x45, 0xa4, 0x49, 0xd3, 0xa6, 0x3f, 0x01, 0x77, 0x39, 0xdf, 0x77, 0xce, 0xb9, 0x9c,
-	0x9c, 0x0f, 0x39, 0x1e, 0xf5, 0x7c, 0x08, 0x19, 0x55, 0x7c, 0x04, 0xd1, 0x11, 0xd5, 0x42, 0x30,
-	0xaa, 0x03, 0x1d, 0x66, 0x3e, 0xfb, 0x4a, 0x00, 0x38, 0x63, 0xc1, 0x15, 0xc7, 0x64, 0x8b, 0xef,
-	0x6c, 0x48, 0xad, 0xf6, 0x57, 0x9c, 0x24, 0x84, 0x20, 0x15, 0x88, 0xc4, 0xad, 0x75, 0x9a, 0xab,
-	0x19, 0x0a, 0xcf, 0xd7, 0x9e, 0x62, 0x3c, 0xea, 0x0b, 0x78, 0xd2, 0x4c, 0x40, 0x08, 0x91, 0x92,
-	0x2b, 0xdd, 0xff, 0x5c, 0x1d, 0x04, 0x40, 0x15, 0x9b, 0x40, 0x7f, 0x28, 0xb8, 0x1e, 0x27, 0xf4,
-	0xdf, 0xaf, 0x25, 0xd4, 0xbc, 0x58, 0x73, 0xee, 0x04, 0x00, 0xfe, 0x89, 0xca, 0x2c, 0xf2, 0x61,
-	0x4a, 0x0c, 0xcb, 0xb0, 0xeb, 0x6e, 0x02, 0x70, 0x0b, 0xd5, 0x28, 0xd7, 0x42, 0xc2, 0xb5, 0x4f,
-	0xbe, 0xc5, 0x8b, 0x35, 0xc6, 0x04, 0x55, 0x27, 0x20, 0x24, 0xe3, 0x11, 0x29, 0xc6, 0xab, 0x14,
-	0x62, 0x07, 0x61, 0xc5, 0x95, 0x17, 0xdc, 0x73, 0x31, 0x0a, 0xb8, 0xe7, 0x5f, 0x2d, 0x25, 0xa4,
-	0x14, 0x93, 0x0e, 0x6c, 0xf0, 0x5f, 0xf4, 0x7d, 0x95, 0xc9, 0xef, 0xea, 0xc1, 0x23, 0x50, 0x25,
-	0x49, 0xd9, 0x2a, 0xda, 0x75, 0x77, 0x6f, 0x8e, 0x2d, 0xd4, 0x48, 0x23, 0xdd, 0xb0, 0x88, 0x54,
-	0x2c, 0xc3, 0x2e, 0xb9, 0xd9, 0xd1, 0xd2, 0x2d, 0x85, 0x6b, 0xb7, 0x6a, 0xe2, 0xb6, 0x3b, 0xc7,
-	0x3d, 0xf4, 0x23, 0x6d, 0xa0, 0xab, 0x84, 0xa6, 0x4a, 0x0b, 0x20, 0x35, 0xab, 0x68, 0x37, 0xda,
-	0xff, 0x9c, 0xbc, 0x66, 0x9d, 0xcd, 0xaf, 0xeb, 0xae, 0xc4, 0xee, 0xbe, 0x0d, 0x7e, 0x40, 0xbf,
-	0x36, 0xa5, 0xb9, 0x99, 0xce, 0x48, 0xdd, 0x32, 0xec, 0x46, 0xfb, 0x38, 0xff, 0x81, 0xce, 0x41,
-	0x9d, 0x9b, 0xe3, 0x87, 0x6f, 0x51, 0x33, 0x4d, 0xd6, 0x59, 0xb6, 0x2c, 0x09, 0x8a, 0x23, 0xfc,
-	0xc9, 0x7f, 0xe1, 0x32, 0xcb, 0x77, 0x77, 0xe4, 0xe7, 0x67, 0x6f, 0x73, 0xd3, 0x98, 0xcd, 0x4d,
-	0xe3, 0x63, 0x6e, 0x1a, 0x2f, 0x0b, 0xb3, 0x30, 0x5b, 0x98, 0x85, 0xf7, 0x85, 0x59, 0xe8, 0x59,
-	0xdb, 0x87, 0x36, 0xcd, 0x9e, 0x9a, 0x7a, 0x1e, 0x83, 0x1c, 0x54, 0xe2, 0x13, 0x3b, 0xf9, 0x0c,
-	0x00, 0x00, 0xff, 0xff, 0xcb, 0x72, 0xc1, 0x7c, 0x49, 0x03, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x49, 0x9a, 0x36, 0x1b, 0xa8, 0x60, 0x85, 0xaa, 0x55, 0x0e, 0x96, 0xc5, 0x05, 0x0b,
+	0x81, 0x41, 0x41, 0xe2, 0xc0, 0x0d, 0x10, 0x14, 0x0e, 0x08, 0xc9, 0x41, 0x42, 0xea, 0x25, 0x72,
+	0x76, 0x47, 0xed, 0x52, 0xdb, 0x1b, 0x66, 0x67, 0xa3, 0xf2, 0x17, 0x7c, 0x16, 0xc7, 0x1e, 0x39,
+	0xa2, 0xe4, 0x2b, 0xb8, 0x21, 0xdb, 0x71, 0xe2, 0xb6, 0xb1, 0xd4, 0x9b, 0xdf, 0x9b, 0xf7, 0x9e,
+	0x35, 0xfb, 0x86, 0x45, 0x89, 0x4c, 0x14, 0x64, 0x5a, 0x92, 0x39, 0x87, 0xfc, 0xb9, 0x74, 0x88,
+	0x5a, 0xba, 0xd4, 0x65, 0x8d, 0xcf, 0x29, 0x21, 0x40, 0x34, 0x47, 0x43, 0x86, 0x8b, 0x2b, 0xfa,
+	0x68, 0x2b, 0x1a, 0x8d, 0x6f, 0x93, 0x64, 0x21, 0x03, 0x4b, 0x80, 0x55, 0xda, 0xe8, 0x55, 0xab,
+	0xe7, 0x14, 0x13, 0xe5, 0x12, 0xd2, 0x26, 0x9f, 0x22, 0xfc, 0x70, 0x1a, 0x21, 0x83, 0x9c, 0xec,
+	0xda, 0xf7, 0xac, 0xd5, 0x07, 0x29, 0x48, 0xd2, 0x0b, 0x98, 0x9e, 0xa2, 0x71, 0xf3, 0x4a, 0xfe,
+	0xe8, 0x5f, 0x8f, 0x1d, 0xbe, 0xdb, 0x68, 0xbe, 0x22, 0x00, 0x7f, 0xc8, 0xf6, 0x74, 0xae, 0xe0,
+	0x42, 0x78, 0x81, 0x17, 0x0e, 0xe2, 0x0a, 0xf0, 0x11, 0x3b, 0x90, 0xc6, 0xa1, 0x85, 0x4f, 0x4a,
+	0xdc, 0x29, 0x07, 0x1b, 0xcc, 0x05, 0xdb, 0x5f, 0x00, 0x5a, 0x6d, 0x72, 0xd1, 0x2d, 0x47, 0x35,
+	0xe4, 0x11, 0xe3, 0x64, 0x28, 0x49, 0xbf, 0x19, 0x3c, 0x4f, 0x4d, 0xa2, 0x3e, 0x16, 0x16, 0xd1,
+	0x2b, 0x45, 0x3b, 0x26, 0xfc, 0x09, 0xbb, 0xbf, 0xde, 0x49, 0x4d, 0xdc, 0xec, 0x3b, 0x48, 0xb2,
+	0x62, 0x2f, 0xe8, 0x86, 0x83, 0xf8, 0x06, 0xcf, 0x03, 0x36, 0xac, 0x57, 0xfa, 0xac, 0x73, 0xd1,
+	0x0f, 0xbc, 0xb0, 0x17, 0x37, 0xa9, 0x22, 0xad, 0x86, 0x9b, 0xb4, 0xfd, 0x2a, 0xed, 0x3a, 0xcf,
+	0x4f, 0xd8, 0x83, 0xba, 0x81, 0x09, 0xa1, 0x93, 0xe4, 0x10, 0xc4, 0x41, 0xd0, 0x0d, 0x87, 0xe3,
+	0xa7, 0x51, 0x5b, 0xb3, 0xd1, 0xf6, 0xe9, 0x26, 0x6b, 0x73, 0x7c, 0x33, 0x86, 0x9f, 0xb1, 0xa3,
+	0x6d, 0x69, 0x71, 0xa3, 0x33, 0x31, 0x08, 0xbc, 0x70, 0x38, 0x7e, 0xd1, 0xfe, 0x83, 0xe3, 0x9d,
+	0xbe, 0xb8, 0x25, 0x8f, 0x7f, 0x61, 0x87, 0xf5, 0x66, 0xc7, 0x45, 0xcb, 0x56, 0xb0, 0x72, 0x85,
+	0xc7, 0xed, 0x7f, 0x78, 0xdf, 0xd4, 0xc7, 0xd7, 0xec, 0xfc, 0x88, 0xf5, 0x2d, 0x25, 0xe4, 0xac,
+	0x18, 0x96, 0xa5, 0xad, 0x51, 0xf1, 0xf8, 0x73, 0x37, 0x4b, 0xb5, 0x3d, 0x03, 0xf5, 0x86, 0xc4,
+	0xdd, 0x72, 0xd8, 0xa4, 0x0a, 0x85, 0x02, 0xd4, 0x0b, 0x50, 0x1f, 0xd0, 0x64, 0xe2, 0x5e, 0xa5,
+	0x68, 0x50, 0x6f, 0x5f, 0xff, 0x5e, 0xfa, 0xde, 0xe5, 0xd2, 0xf7, 0xfe, 0x2e, 0x7d, 0xef, 0xd7,
+	0xca, 0xef, 0x5c, 0xae, 0xfc, 0xce, 0x9f, 0x95, 0xdf, 0x39, 0x09, 0xae, 0x1e, 0xf1, 0x45, 0xf3,
+	0x8c, 0xe9, 0xe7, 0x1c, 0xec, 0xac, 0x5f, 0x9e, 0xef, 0xcb, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x61, 0xf0, 0x54, 0x22, 0xa5, 0x03, 0x00, 0x00,
 }
 
 func (m *CurriculumTree) Marshal() (dAtA []byte, err error) {
@@ -195,6 +225,27 @@ func (m *CurriculumTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DerivedFrom) > 0 {
+		i -= len(m.DerivedFrom)
+		copy(dAtA[i:], m.DerivedFrom)
+		i = encodeVarintCurriculumTree(dAtA, i, uint64(len(m.DerivedFrom)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PublishedAt) > 0 {
+		i -= len(m.PublishedAt)
+		copy(dAtA[i:], m.PublishedAt)
+		i = encodeVarintCurriculumTree(dAtA, i, uint64(len(m.PublishedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintCurriculumTree(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.ElectiveGroups) > 0 {
 		for iNdEx := len(m.ElectiveGroups) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -353,6 +404,18 @@ func (m *CurriculumTree) Size() (n int) {
 			n += 1 + l + sovCurriculumTree(uint64(l))
 		}
 	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovCurriculumTree(uint64(l))
+	}
+	l = len(m.PublishedAt)
+	if l > 0 {
+		n += 1 + l + sovCurriculumTree(uint64(l))
+	}
+	l = len(m.DerivedFrom)
+	if l > 0 {
+		n += 1 + l + sovCurriculumTree(uint64(l))
+	}
 	return n
 }
 
@@ -706,6 +769,102 @@ func (m *CurriculumTree) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCurriculumTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCurriculumTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCurriculumTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCurriculumTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCurriculumTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCurriculumTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublishedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCurriculumTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCurriculumTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCurriculumTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCurriculumTree(dAtA[iNdEx:])
@@ -8,6 +8,10 @@ import (
 
 // x/curriculum module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample                  = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrCurriculumNotFound      = sdkerrors.Register(ModuleName, 1102, "curriculum not found")
+	ErrCurriculumPublished     = sdkerrors.Register(ModuleName, 1103, "curriculum version is published")
+	ErrCurriculumVersionExists = sdkerrors.Register(ModuleName, 1104, "curriculum version already exists")
+	ErrUnauthorized            = sdkerrors.Register(ModuleName, 1105, "unauthorized curriculum manager")
 )
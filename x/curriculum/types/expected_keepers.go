@@ -36,6 +36,11 @@ type SubjectKeeper interface {
 	SubjectExists(ctx sdk.Context, index string) bool
 }
 
+// InstitutionKeeper defines the methods from the Institution module that Curriculum needs
+type InstitutionKeeper interface {
+	CanManageCurriculum(ctx sdk.Context, institution string, address string, courseId string) bool
+}
+
 // Types needed from the Course module
 type Course struct {
 	Index        string
@@ -32,10 +32,11 @@ func CurriculumTreeKey(index string) []byte {
 
 // Event types for curriculum module
 const (
-	EventTypeCurriculumTreeCreated     = "curriculum_tree_created"
-	EventTypeSemesterAdded             = "semester_added"
-	EventTypeElectiveGroupAdded        = "elective_group_added"
-	EventTypeGraduationRequirementsSet = "graduation_requirements_set"
+	EventTypeCurriculumTreeCreated      = "curriculum_tree_created"
+	EventTypeSemesterAdded              = "semester_added"
+	EventTypeElectiveGroupAdded         = "elective_group_added"
+	EventTypeGraduationRequirementsSet  = "graduation_requirements_set"
+	EventTypeCurriculumVersionPublished = "curriculum_version_published"
 
 	AttributeKeyIndex           = "index"
 	AttributeKeyCourseId        = "course_id"
@@ -43,6 +44,14 @@ const (
 	AttributeKeyCurriculumIndex = "curriculum_index"
 	AttributeKeySemesterNumber  = "semester_number"
 	AttributeKeyGroupName       = "group_name"
+	AttributeKeyVersion         = "version"
+	AttributeKeyDerivedFrom     = "derived_from"
+)
+
+// Curriculum tree statuses
+const (
+	CurriculumStatusDraft     = "draft"
+	CurriculumStatusPublished = "published"
 )
 
 // Event definitions
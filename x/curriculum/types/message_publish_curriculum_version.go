@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgPublishCurriculumVersion{}
+
+// NewMsgPublishCurriculumVersion creates a new message for publishing a curriculum version
+func NewMsgPublishCurriculumVersion(creator string, curriculumIndex string, version string) *MsgPublishCurriculumVersion {
+	return &MsgPublishCurriculumVersion{
+		Creator:         creator,
+		CurriculumIndex: curriculumIndex,
+		Version:         version,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgPublishCurriculumVersion) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.CurriculumIndex == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "curriculum index cannot be empty")
+	}
+
+	if msg.Version == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "version cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPublishCurriculumVersion_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPublishCurriculumVersion
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPublishCurriculumVersion{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing version",
+			msg: MsgPublishCurriculumVersion{
+				Creator:         sample.AccAddress(),
+				CurriculumIndex: "0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgPublishCurriculumVersion{
+				Creator:         sample.AccAddress(),
+				CurriculumIndex: "0",
+				Version:         "2025",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
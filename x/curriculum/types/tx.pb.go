@@ -629,6 +629,112 @@ func (m *MsgSetGraduationRequirementsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetGraduationRequirementsResponse proto.InternalMessageInfo
 
+// MsgPublishCurriculumVersion copies a curriculum tree into a new, immutable
+// version of its course, leaving the source tree untouched
+type MsgPublishCurriculumVersion struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CurriculumIndex string `protobuf:"bytes,2,opt,name=curriculumIndex,proto3" json:"curriculumIndex,omitempty"`
+	Version         string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgPublishCurriculumVersion) Reset()         { *m = MsgPublishCurriculumVersion{} }
+func (m *MsgPublishCurriculumVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgPublishCurriculumVersion) ProtoMessage()    {}
+func (*MsgPublishCurriculumVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a05da18e86456bf, []int{10}
+}
+func (m *MsgPublishCurriculumVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPublishCurriculumVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPublishCurriculumVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPublishCurriculumVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPublishCurriculumVersion.Merge(m, src)
+}
+func (m *MsgPublishCurriculumVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPublishCurriculumVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPublishCurriculumVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPublishCurriculumVersion proto.InternalMessageInfo
+
+func (m *MsgPublishCurriculumVersion) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgPublishCurriculumVersion) GetCurriculumIndex() string {
+	if m != nil {
+		return m.CurriculumIndex
+	}
+	return ""
+}
+
+func (m *MsgPublishCurriculumVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type MsgPublishCurriculumVersionResponse struct {
+	CurriculumIndex string `protobuf:"bytes,1,opt,name=curriculumIndex,proto3" json:"curriculumIndex,omitempty"`
+}
+
+func (m *MsgPublishCurriculumVersionResponse) Reset()         { *m = MsgPublishCurriculumVersionResponse{} }
+func (m *MsgPublishCurriculumVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPublishCurriculumVersionResponse) ProtoMessage()    {}
+func (*MsgPublishCurriculumVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a05da18e86456bf, []int{11}
+}
+func (m *MsgPublishCurriculumVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPublishCurriculumVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPublishCurriculumVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPublishCurriculumVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPublishCurriculumVersionResponse.Merge(m, src)
+}
+func (m *MsgPublishCurriculumVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPublishCurriculumVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPublishCurriculumVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPublishCurriculumVersionResponse proto.InternalMessageInfo
+
+func (m *MsgPublishCurriculumVersionResponse) GetCurriculumIndex() string {
+	if m != nil {
+		return m.CurriculumIndex
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "academictoken.curriculum.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "academictoken.curriculum.MsgUpdateParamsResponse")
@@ -640,67 +746,72 @@ func init() {
 	proto.RegisterType((*MsgAddElectiveGroupResponse)(nil), "academictoken.curriculum.MsgAddElectiveGroupResponse")
 	proto.RegisterType((*MsgSetGraduationRequirements)(nil), "academictoken.curriculum.MsgSetGraduationRequirements")
 	proto.RegisterType((*MsgSetGraduationRequirementsResponse)(nil), "academictoken.curriculum.MsgSetGraduationRequirementsResponse")
+	proto.RegisterType((*MsgPublishCurriculumVersion)(nil), "academictoken.curriculum.MsgPublishCurriculumVersion")
+	proto.RegisterType((*MsgPublishCurriculumVersionResponse)(nil), "academictoken.curriculum.MsgPublishCurriculumVersionResponse")
 }
 
 func init() { proto.RegisterFile("academictoken/curriculum/tx.proto", fileDescriptor_9a05da18e86456bf) }
 
 var fileDescriptor_9a05da18e86456bf = []byte{
-	// 867 bytes of a gzipped FileDescriptorProto
+	// 921 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x3a, 0x8e, 0x53, 0xbf, 0x16, 0x5a, 0xa6, 0x11, 0xd9, 0x2c, 0xe0, 0xba, 0x56, 0xa8,
-	0x8c, 0xa5, 0xda, 0x34, 0x40, 0x05, 0x11, 0x20, 0x25, 0x11, 0x0a, 0x39, 0x18, 0xa1, 0x4d, 0x10,
-	0x82, 0x0b, 0x9a, 0xec, 0x3e, 0x2d, 0x43, 0xbc, 0x3b, 0x66, 0x66, 0x36, 0xb8, 0x37, 0x84, 0x38,
-	0x21, 0x21, 0x21, 0xf1, 0x17, 0xf4, 0xc6, 0x31, 0x07, 0xc4, 0x95, 0x6b, 0x2f, 0x48, 0x15, 0x27,
-	0x4e, 0x08, 0x25, 0x87, 0xfc, 0x17, 0x08, 0xed, 0xec, 0xec, 0xda, 0xde, 0x78, 0x5d, 0x5a, 0xe5,
-	0x92, 0xec, 0x7c, 0xef, 0x7b, 0xbf, 0xbe, 0x37, 0x33, 0x1e, 0xb8, 0x4d, 0x3d, 0xea, 0x63, 0xc8,
-	0x3c, 0xc5, 0x8f, 0x30, 0xea, 0x79, 0xb1, 0x10, 0xcc, 0x8b, 0x07, 0x71, 0xd8, 0x53, 0xa3, 0xee,
-	0x50, 0x70, 0xc5, 0x89, 0x3d, 0x45, 0xe9, 0x8e, 0x29, 0xce, 0x0b, 0x34, 0x64, 0x11, 0xef, 0xe9,
-	0xbf, 0x29, 0xd9, 0x59, 0xf5, 0xb8, 0x0c, 0xb9, 0xec, 0x85, 0x32, 0xe8, 0x1d, 0xdf, 0x4b, 0xfe,
-	0x19, 0xc3, 0x5a, 0x6a, 0xf8, 0x42, 0xaf, 0x7a, 0xe9, 0xc2, 0x98, 0x56, 0x02, 0x1e, 0xf0, 0x14,
-	0x4f, 0xbe, 0x0c, 0xfa, 0x6a, 0x69, 0x65, 0x43, 0x2a, 0x68, 0x68, 0x9c, 0x5b, 0x7f, 0x58, 0x70,
-	0xbd, 0x2f, 0x83, 0x4f, 0x86, 0x3e, 0x55, 0xf8, 0xb1, 0xb6, 0x90, 0xfb, 0x50, 0xa7, 0xb1, 0xfa,
-	0x92, 0x0b, 0xa6, 0x1e, 0xd8, 0x56, 0xd3, 0x6a, 0xd7, 0xb7, 0xed, 0x3f, 0x7f, 0xbd, 0xbb, 0x62,
-	0xb2, 0x6e, 0xf9, 0xbe, 0x40, 0x29, 0xf7, 0x95, 0x60, 0x51, 0xe0, 0x8e, 0xa9, 0x64, 0x07, 0x6a,
-	0x69, 0x6c, 0xbb, 0xd2, 0xb4, 0xda, 0x57, 0x37, 0x9a, 0xdd, 0xb2, 0xd6, 0xbb, 0x69, 0xa6, 0xed,
-	0xfa, 0xa3, 0xbf, 0x6f, 0x2d, 0xfc, 0x72, 0x7e, 0xd2, 0xb1, 0x5c, 0xe3, 0xba, 0xf9, 0xde, 0x77,
-	0xe7, 0x27, 0x9d, 0x71, 0xd0, 0x1f, 0xce, 0x4f, 0x3a, 0x9d, 0xe9, 0x56, 0x46, 0x93, 0xcd, 0x14,
-	0x6a, 0x6f, 0xad, 0xc1, 0x6a, 0x01, 0x72, 0x51, 0x0e, 0x79, 0x24, 0xb1, 0xf5, 0xb0, 0xa2, 0x6d,
-	0x3b, 0x02, 0xa9, 0xc2, 0x9d, 0x3c, 0xc4, 0x81, 0x40, 0x24, 0x36, 0x2c, 0x7b, 0x09, 0xce, 0x45,
-	0xda, 0xb0, 0x9b, 0x2d, 0x89, 0x03, 0x57, 0x3c, 0x1e, 0x0b, 0x89, 0x7b, 0xbe, 0x6e, 0xab, 0xee,
-	0xe6, 0xeb, 0xc4, 0xeb, 0x18, 0x85, 0x64, 0x3c, 0xb2, 0x17, 0x53, 0x2f, 0xb3, 0x24, 0x4d, 0xb8,
-	0x8a, 0x03, 0xf4, 0x14, 0x3b, 0xc6, 0x3e, 0x8b, 0xec, 0x6a, 0xd3, 0x6a, 0x57, 0xdd, 0x49, 0x88,
-	0x74, 0x81, 0x28, 0xae, 0xe8, 0xe0, 0x53, 0x2e, 0x8e, 0x06, 0x9c, 0xfa, 0x1f, 0x26, 0x41, 0xed,
-	0x25, 0x4d, 0x9c, 0x61, 0x21, 0x1d, 0xb8, 0x21, 0xf0, 0xeb, 0x98, 0x09, 0xf4, 0xf7, 0xe3, 0xc3,
-	0xaf, 0xd0, 0x53, 0xd2, 0xae, 0x35, 0x17, 0xdb, 0x75, 0xf7, 0x02, 0x9e, 0x70, 0xb3, 0x54, 0x39,
-	0x77, 0x39, 0xe5, 0x16, 0xf1, 0xcd, 0x6b, 0x89, 0xde, 0x59, 0xb7, 0xad, 0xdb, 0x70, 0xab, 0x44,
-	0xa2, 0x5c, 0xc6, 0xdf, 0x2c, 0x70, 0xfa, 0x32, 0xd8, 0xf2, 0xfd, 0x7d, 0x0c, 0x51, 0x2a, 0x14,
-	0x07, 0x7c, 0x4c, 0x9d, 0xa3, 0x64, 0x1b, 0xae, 0x8f, 0x07, 0xb7, 0x17, 0xf9, 0x38, 0x32, 0x82,
-	0x16, 0x61, 0x72, 0x07, 0x9e, 0x97, 0x26, 0xf6, 0x47, 0x71, 0x78, 0x88, 0x42, 0xcb, 0x5b, 0x75,
-	0x0b, 0x28, 0x69, 0x00, 0xc8, 0xb4, 0x8f, 0x3d, 0x5f, 0xda, 0x55, 0xdd, 0xe1, 0x04, 0x52, 0xe8,
-	0x6d, 0x1d, 0x5a, 0xe5, 0x75, 0xe7, 0xed, 0xfd, 0x5e, 0x81, 0x9b, 0x29, 0xed, 0x03, 0x23, 0xd5,
-	0xae, 0xe0, 0xf1, 0xf0, 0x52, 0xfa, 0x22, 0x50, 0x8d, 0x68, 0x88, 0x66, 0xb3, 0xe8, 0xef, 0x64,
-	0xa7, 0xf8, 0x28, 0x3d, 0xc1, 0x86, 0x2a, 0xd9, 0x47, 0x55, 0x6d, 0x9a, 0x84, 0xc8, 0xeb, 0x70,
-	0x33, 0x64, 0x51, 0x36, 0x30, 0xd7, 0x0c, 0xdb, 0x6c, 0x95, 0x59, 0x26, 0x5d, 0x91, 0x40, 0x9f,
-	0x4d, 0xb0, 0x6b, 0x9a, 0x5d, 0x84, 0xc9, 0x3a, 0x3c, 0x77, 0x14, 0xf1, 0x6f, 0x06, 0xe8, 0x07,
-	0xb8, 0x25, 0x90, 0xda, 0xcb, 0x3a, 0xff, 0x34, 0x58, 0xd0, 0xf9, 0xca, 0x13, 0x74, 0x7e, 0x05,
-	0x5e, 0x9a, 0x21, 0x60, 0x2e, 0xf0, 0xbf, 0x15, 0x78, 0xb9, 0x2f, 0x83, 0x7d, 0x54, 0xbb, 0x82,
-	0xfa, 0x31, 0x4d, 0x7a, 0x34, 0xf5, 0x84, 0x18, 0x29, 0x79, 0x29, 0x4a, 0x6f, 0xc0, 0x8a, 0x3e,
-	0x43, 0x3b, 0x05, 0x19, 0xd2, 0x7d, 0x34, 0xd3, 0x46, 0x5e, 0x84, 0x5a, 0xc8, 0xa2, 0xdd, 0x21,
-	0xd5, 0x43, 0xa8, 0xb8, 0x66, 0x45, 0xde, 0x86, 0xd5, 0xec, 0x84, 0x65, 0x1d, 0x19, 0x57, 0x33,
-	0x83, 0x32, 0x73, 0x72, 0x0e, 0x43, 0x16, 0xb1, 0x30, 0x0e, 0x0f, 0x58, 0x88, 0x9f, 0x21, 0x15,
-	0x52, 0x0f, 0xa2, 0xe2, 0x5e, 0xc0, 0x35, 0x97, 0x8e, 0xa6, 0xb9, 0xcb, 0x86, 0x5b, 0xc0, 0x93,
-	0xbb, 0x23, 0x4b, 0xb9, 0x95, 0x24, 0x64, 0x8a, 0x61, 0x36, 0x97, 0x19, 0x96, 0xc2, 0x7c, 0xee,
-	0xc0, 0xfa, 0x3c, 0xfd, 0xb3, 0x41, 0x6d, 0x3c, 0x5c, 0x82, 0xc5, 0xbe, 0x0c, 0xc8, 0x00, 0xae,
-	0x4d, 0xfd, 0x3c, 0xbc, 0x56, 0x7e, 0xad, 0x17, 0xae, 0x5e, 0xe7, 0xde, 0xff, 0xa6, 0x66, 0x59,
-	0xc9, 0xf7, 0x16, 0xac, 0xcc, 0xbc, 0xa2, 0xe7, 0xc7, 0x9a, 0xe5, 0xe2, 0xbc, 0xf3, 0xd4, 0x2e,
-	0x79, 0x19, 0x3f, 0x5a, 0xb0, 0x5a, 0x76, 0xc5, 0xbd, 0x39, 0x37, 0x6c, 0x89, 0x97, 0xf3, 0xee,
-	0xb3, 0x78, 0xe5, 0xf5, 0x8c, 0xe0, 0xc6, 0x85, 0x2b, 0xe9, 0xee, 0x93, 0x22, 0x4e, 0xd1, 0x9d,
-	0xb7, 0x9e, 0x8a, 0x9e, 0x67, 0xfe, 0xd9, 0x82, 0xb5, 0xf2, 0xc3, 0x7a, 0x7f, 0x6e, 0xd0, 0x52,
-	0x3f, 0xe7, 0xfd, 0x67, 0xf3, 0xcb, 0xaa, 0x72, 0x96, 0xbe, 0x4d, 0x5e, 0x0d, 0xdb, 0x9b, 0x8f,
-	0x4e, 0x1b, 0xd6, 0xe3, 0xd3, 0x86, 0xf5, 0xcf, 0x69, 0xc3, 0xfa, 0xe9, 0xac, 0xb1, 0xf0, 0xf8,
-	0xac, 0xb1, 0xf0, 0xd7, 0x59, 0x63, 0xe1, 0xf3, 0xe6, 0x9c, 0x47, 0x83, 0x7a, 0x30, 0x44, 0x79,
-	0x58, 0xd3, 0x2f, 0xa0, 0x37, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x8b, 0x8b, 0x92, 0xc4,
-	0x09, 0x00, 0x00,
+	0x14, 0xce, 0x3a, 0xae, 0x53, 0xbf, 0x16, 0x5a, 0xb6, 0x11, 0xd9, 0x2c, 0xe0, 0xba, 0x26, 0x54,
+	0xc6, 0x52, 0x6d, 0x1a, 0xa0, 0x82, 0x88, 0x22, 0x25, 0x11, 0x0a, 0x39, 0x18, 0xaa, 0x4d, 0x00,
+	0xc1, 0x05, 0x4d, 0x76, 0x9f, 0xb6, 0x43, 0xbc, 0x3b, 0x66, 0x66, 0x36, 0xb8, 0x37, 0x84, 0xb8,
+	0x80, 0x84, 0x54, 0x89, 0xbf, 0x80, 0x1b, 0xc7, 0x1c, 0x2a, 0xae, 0x5c, 0x7b, 0x41, 0xaa, 0x38,
+	0x71, 0x42, 0x28, 0x39, 0xe4, 0xbf, 0x40, 0x68, 0x67, 0x67, 0xd7, 0xf6, 0x66, 0xd7, 0xa5, 0x51,
+	0x2e, 0x89, 0xe7, 0x7b, 0xdf, 0xfb, 0xf5, 0xbd, 0xf9, 0xb1, 0x70, 0x83, 0xb8, 0xc4, 0xc3, 0x80,
+	0xba, 0x92, 0xed, 0x63, 0xd8, 0x73, 0x23, 0xce, 0xa9, 0x1b, 0x0d, 0xa2, 0xa0, 0x27, 0x47, 0xdd,
+	0x21, 0x67, 0x92, 0x99, 0xd6, 0x14, 0xa5, 0x3b, 0xa6, 0xd8, 0x2f, 0x90, 0x80, 0x86, 0xac, 0xa7,
+	0xfe, 0x26, 0x64, 0x7b, 0xc9, 0x65, 0x22, 0x60, 0xa2, 0x17, 0x08, 0xbf, 0x77, 0x70, 0x3b, 0xfe,
+	0xa7, 0x0d, 0xcb, 0x89, 0xe1, 0x4b, 0xb5, 0xea, 0x25, 0x0b, 0x6d, 0x5a, 0xf4, 0x99, 0xcf, 0x12,
+	0x3c, 0xfe, 0xa5, 0xd1, 0xd7, 0x4a, 0x2b, 0x1b, 0x12, 0x4e, 0x02, 0xed, 0xdc, 0xfa, 0xc3, 0x80,
+	0x2b, 0x7d, 0xe1, 0x7f, 0x32, 0xf4, 0x88, 0xc4, 0x7b, 0xca, 0x62, 0xde, 0x81, 0x3a, 0x89, 0xe4,
+	0x7d, 0xc6, 0xa9, 0x7c, 0x60, 0x19, 0x4d, 0xa3, 0x5d, 0xdf, 0xb0, 0xfe, 0x7c, 0x74, 0x6b, 0x51,
+	0x67, 0x5d, 0xf7, 0x3c, 0x8e, 0x42, 0xec, 0x48, 0x4e, 0x43, 0xdf, 0x19, 0x53, 0xcd, 0x4d, 0xa8,
+	0x25, 0xb1, 0xad, 0x4a, 0xd3, 0x68, 0x5f, 0x5a, 0x6d, 0x76, 0xcb, 0x5a, 0xef, 0x26, 0x99, 0x36,
+	0xea, 0x8f, 0xff, 0xbe, 0x3e, 0xf7, 0xeb, 0xc9, 0x61, 0xc7, 0x70, 0xb4, 0xeb, 0xda, 0xdd, 0xef,
+	0x4e, 0x0e, 0x3b, 0xe3, 0xa0, 0x3f, 0x9e, 0x1c, 0x76, 0x3a, 0xd3, 0xad, 0x8c, 0x26, 0x9b, 0xc9,
+	0xd5, 0xde, 0x5a, 0x86, 0xa5, 0x1c, 0xe4, 0xa0, 0x18, 0xb2, 0x50, 0x60, 0xeb, 0x97, 0x8a, 0xb2,
+	0x6d, 0x72, 0x24, 0x12, 0x37, 0xb3, 0x10, 0xbb, 0x1c, 0xd1, 0xb4, 0x60, 0xc1, 0x8d, 0x71, 0xc6,
+	0x93, 0x86, 0x9d, 0x74, 0x69, 0xda, 0x70, 0xd1, 0x65, 0x11, 0x17, 0xb8, 0xed, 0xa9, 0xb6, 0xea,
+	0x4e, 0xb6, 0x8e, 0xbd, 0x0e, 0x90, 0x0b, 0xca, 0x42, 0x6b, 0x3e, 0xf1, 0xd2, 0x4b, 0xb3, 0x09,
+	0x97, 0x70, 0x80, 0xae, 0xa4, 0x07, 0xd8, 0xa7, 0xa1, 0x55, 0x6d, 0x1a, 0xed, 0xaa, 0x33, 0x09,
+	0x99, 0x5d, 0x30, 0x25, 0x93, 0x64, 0xf0, 0x19, 0xe3, 0xfb, 0x03, 0x46, 0xbc, 0x0f, 0xe3, 0xa0,
+	0xd6, 0x05, 0x45, 0x2c, 0xb0, 0x98, 0x1d, 0xb8, 0xca, 0xf1, 0xeb, 0x88, 0x72, 0xf4, 0x76, 0xa2,
+	0xbd, 0xaf, 0xd0, 0x95, 0xc2, 0xaa, 0x35, 0xe7, 0xdb, 0x75, 0xe7, 0x14, 0x1e, 0x73, 0xd3, 0x54,
+	0x19, 0x77, 0x21, 0xe1, 0xe6, 0xf1, 0xb5, 0xcb, 0xb1, 0xde, 0x69, 0xb7, 0xad, 0x1b, 0x70, 0xbd,
+	0x44, 0xa2, 0x4c, 0xc6, 0xdf, 0x0c, 0xb0, 0xfb, 0xc2, 0x5f, 0xf7, 0xbc, 0x1d, 0x0c, 0x50, 0x48,
+	0xe4, 0xbb, 0x6c, 0x4c, 0x9d, 0xa1, 0x64, 0x1b, 0xae, 0x8c, 0x07, 0xb7, 0x1d, 0x7a, 0x38, 0xd2,
+	0x82, 0xe6, 0x61, 0xf3, 0x26, 0x3c, 0x2f, 0x74, 0xec, 0x8f, 0xa2, 0x60, 0x0f, 0xb9, 0x92, 0xb7,
+	0xea, 0xe4, 0x50, 0xb3, 0x01, 0x20, 0x92, 0x3e, 0xb6, 0x3d, 0x61, 0x55, 0x55, 0x87, 0x13, 0x48,
+	0xae, 0xb7, 0x15, 0x68, 0x95, 0xd7, 0x9d, 0xb5, 0xf7, 0x7b, 0x05, 0xae, 0x25, 0xb4, 0x0f, 0xb4,
+	0x54, 0x5b, 0x9c, 0x45, 0xc3, 0x73, 0xe9, 0xcb, 0x84, 0x6a, 0x48, 0x02, 0xd4, 0x9b, 0x45, 0xfd,
+	0x8e, 0x77, 0x8a, 0x87, 0xc2, 0xe5, 0x74, 0x28, 0xe3, 0x7d, 0x54, 0x55, 0xa6, 0x49, 0xc8, 0x7c,
+	0x03, 0xae, 0x05, 0x34, 0x4c, 0x07, 0xe6, 0xe8, 0x61, 0xeb, 0xad, 0x52, 0x64, 0x52, 0x15, 0x71,
+	0xf4, 0xe8, 0x04, 0xbb, 0xa6, 0xd8, 0x79, 0xd8, 0x5c, 0x81, 0xe7, 0xf6, 0x43, 0xf6, 0xcd, 0x00,
+	0x3d, 0x1f, 0xd7, 0x39, 0x12, 0x6b, 0x41, 0xe5, 0x9f, 0x06, 0x73, 0x3a, 0x5f, 0x7c, 0x8a, 0xce,
+	0xaf, 0xc0, 0x4b, 0x05, 0x02, 0x66, 0x02, 0xff, 0x5b, 0x81, 0x97, 0xfb, 0xc2, 0xdf, 0x41, 0xb9,
+	0xc5, 0x89, 0x17, 0x91, 0xb8, 0x47, 0x5d, 0x4f, 0x80, 0xa1, 0x14, 0xe7, 0xa2, 0xf4, 0x2a, 0x2c,
+	0xaa, 0x33, 0xb4, 0x99, 0x93, 0x21, 0xd9, 0x47, 0x85, 0x36, 0xf3, 0x45, 0xa8, 0x05, 0x34, 0xdc,
+	0x1a, 0x12, 0x35, 0x84, 0x8a, 0xa3, 0x57, 0xe6, 0x3b, 0xb0, 0x94, 0x9e, 0xb0, 0xb4, 0x23, 0xed,
+	0xaa, 0x67, 0x50, 0x66, 0x8e, 0xcf, 0x61, 0x40, 0x43, 0x1a, 0x44, 0xc1, 0x2e, 0x0d, 0xf0, 0x73,
+	0x24, 0x5c, 0xa8, 0x41, 0x54, 0x9c, 0x53, 0xb8, 0xe2, 0x92, 0xd1, 0x34, 0x77, 0x41, 0x73, 0x73,
+	0x78, 0x7c, 0x77, 0xa4, 0x29, 0xd7, 0xe3, 0x84, 0x54, 0x52, 0x4c, 0xe7, 0x52, 0x60, 0xc9, 0xcd,
+	0xe7, 0x26, 0xac, 0xcc, 0xd2, 0x3f, 0x1b, 0xd4, 0x0f, 0x86, 0x1a, 0xe4, 0xbd, 0x68, 0x6f, 0x40,
+	0xc5, 0xfd, 0xf1, 0x51, 0xf9, 0x54, 0xdf, 0x71, 0xe7, 0x31, 0xa7, 0xd2, 0x1b, 0x34, 0x57, 0xf3,
+	0xc7, 0xf0, 0xea, 0x8c, 0x52, 0xd2, 0x92, 0x8b, 0x12, 0x1b, 0x85, 0x89, 0x57, 0x1f, 0xd5, 0x60,
+	0xbe, 0x2f, 0x7c, 0x73, 0x00, 0x97, 0xa7, 0xde, 0xbe, 0xd7, 0xcb, 0xdf, 0xac, 0xdc, 0xbb, 0x62,
+	0xdf, 0xfe, 0xdf, 0xd4, 0xac, 0xbe, 0xef, 0x0d, 0x58, 0x2c, 0x7c, 0x7f, 0x66, 0xc7, 0x2a, 0x72,
+	0xb1, 0xdf, 0x7d, 0x66, 0x97, 0xac, 0x8c, 0x9f, 0x0c, 0x58, 0x2a, 0xbb, 0xbf, 0xdf, 0x9a, 0x19,
+	0xb6, 0xc4, 0xcb, 0x7e, 0xef, 0x2c, 0x5e, 0x59, 0x3d, 0x23, 0xb8, 0x7a, 0xea, 0xbe, 0xbd, 0xf5,
+	0xb4, 0x88, 0x53, 0x74, 0xfb, 0xed, 0x67, 0xa2, 0x67, 0x99, 0x7f, 0x36, 0x60, 0xb9, 0xfc, 0x26,
+	0xba, 0x33, 0x33, 0x68, 0xa9, 0x9f, 0xfd, 0xfe, 0xd9, 0xfc, 0xb2, 0xaa, 0x1e, 0x1a, 0x60, 0x95,
+	0x1e, 0xbb, 0xd9, 0x9d, 0x96, 0xb9, 0xd9, 0x77, 0xcf, 0xe4, 0x96, 0x96, 0x64, 0x5f, 0xf8, 0x36,
+	0xfe, 0x4a, 0xdb, 0x58, 0x7b, 0x7c, 0xd4, 0x30, 0x9e, 0x1c, 0x35, 0x8c, 0x7f, 0x8e, 0x1a, 0xc6,
+	0xc3, 0xe3, 0xc6, 0xdc, 0x93, 0xe3, 0xc6, 0xdc, 0x5f, 0xc7, 0x8d, 0xb9, 0x2f, 0x9a, 0x33, 0x3e,
+	0xd2, 0xe4, 0x83, 0x21, 0x8a, 0xbd, 0x9a, 0xfa, 0xe2, 0x7c, 0xf3, 0xbf, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x31, 0x59, 0xd5, 0xc1, 0x34, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -722,6 +833,7 @@ type MsgClient interface {
 	AddSemesterToCurriculum(ctx context.Context, in *MsgAddSemesterToCurriculum, opts ...grpc.CallOption) (*MsgAddSemesterToCurriculumResponse, error)
 	AddElectiveGroup(ctx context.Context, in *MsgAddElectiveGroup, opts ...grpc.CallOption) (*MsgAddElectiveGroupResponse, error)
 	SetGraduationRequirements(ctx context.Context, in *MsgSetGraduationRequirements, opts ...grpc.CallOption) (*MsgSetGraduationRequirementsResponse, error)
+	PublishCurriculumVersion(ctx context.Context, in *MsgPublishCurriculumVersion, opts ...grpc.CallOption) (*MsgPublishCurriculumVersionResponse, error)
 }
 
 type msgClient struct {
@@ -777,6 +889,15 @@ func (c *msgClient) SetGraduationRequirements(ctx context.Context, in *MsgSetGra
 	return out, nil
 }
 
+func (c *msgClient) PublishCurriculumVersion(ctx context.Context, in *MsgPublishCurriculumVersion, opts ...grpc.CallOption) (*MsgPublishCurriculumVersionResponse, error) {
+	out := new(MsgPublishCurriculumVersionResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.curriculum.Msg/PublishCurriculumVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -786,6 +907,7 @@ type MsgServer interface {
 	AddSemesterToCurriculum(context.Context, *MsgAddSemesterToCurriculum) (*MsgAddSemesterToCurriculumResponse, error)
 	AddElectiveGroup(context.Context, *MsgAddElectiveGroup) (*MsgAddElectiveGroupResponse, error)
 	SetGraduationRequirements(context.Context, *MsgSetGraduationRequirements) (*MsgSetGraduationRequirementsResponse, error)
+	PublishCurriculumVersion(context.Context, *MsgPublishCurriculumVersion) (*MsgPublishCurriculumVersionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -807,6 +929,9 @@ func (*UnimplementedMsgServer) AddElectiveGroup(ctx context.Context, req *MsgAdd
 func (*UnimplementedMsgServer) SetGraduationRequirements(ctx context.Context, req *MsgSetGraduationRequirements) (*MsgSetGraduationRequirementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetGraduationRequirements not implemented")
 }
+func (*UnimplementedMsgServer) PublishCurriculumVersion(ctx context.Context, req *MsgPublishCurriculumVersion) (*MsgPublishCurriculumVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishCurriculumVersion not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -902,6 +1027,24 @@ func _Msg_SetGraduationRequirements_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PublishCurriculumVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPublishCurriculumVersion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PublishCurriculumVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.curriculum.Msg/PublishCurriculumVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PublishCurriculumVersion(ctx, req.(*MsgPublishCurriculumVersion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "academictoken.curriculum.Msg",
@@ -927,6 +1070,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetGraduationRequirements",
 			Handler:    _Msg_SetGraduationRequirements_Handler,
 		},
+		{
+			MethodName: "PublishCurriculumVersion",
+			Handler:    _Msg_PublishCurriculumVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/curriculum/tx.proto",
@@ -1361,6 +1508,80 @@ func (m *MsgSetGraduationRequirementsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPublishCurriculumVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublishCurriculumVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPublishCurriculumVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurriculumIndex) > 0 {
+		i -= len(m.CurriculumIndex)
+		copy(dAtA[i:], m.CurriculumIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CurriculumIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPublishCurriculumVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublishCurriculumVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPublishCurriculumVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurriculumIndex) > 0 {
+		i -= len(m.CurriculumIndex)
+		copy(dAtA[i:], m.CurriculumIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CurriculumIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1576,6 +1797,40 @@ func (m *MsgSetGraduationRequirementsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPublishCurriculumVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CurriculumIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPublishCurriculumVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurriculumIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2857,6 +3112,234 @@ func (m *MsgSetGraduationRequirementsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPublishCurriculumVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPublishCurriculumVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPublishCurriculumVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurriculumIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurriculumIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPublishCurriculumVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPublishCurriculumVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPublishCurriculumVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurriculumIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurriculumIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1 +1,8 @@
 package types
+
+// IsPublished reports whether the curriculum version is frozen. Trees created
+// before versioning have no status and are treated as published, since students
+// may already be enrolled under them.
+func (t CurriculumTree) IsPublished() bool {
+	return t.Status != CurriculumStatusDraft
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/x/academicnft/credential"
+	"academictoken/x/degree/types"
+)
+
+func TestDegreeCredential(t *testing.T) {
+	k, ctx := keepertest.DegreeKeeper(t)
+	ctx = ctx.WithChainID("academictoken").WithBlockHeight(30).WithBlockTime(time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC))
+
+	degree := types.Degree{
+		Index:          "degree-1",
+		DegreeId:       "degree-1",
+		Student:        "student-1",
+		Institution:    "inst-1",
+		CourseId:       "course-1",
+		IssueDate:      "2025-12-15",
+		TokenInstances: []string{"1", "2"},
+		FinalGrade:     "8.7",
+		TotalCredits:   240,
+		Status:         "issued",
+	}
+	k.SetDegree(ctx, degree)
+
+	out, err := k.DegreeCredential(ctx, &types.QueryDegreeCredentialRequest{Index: "degree-1"})
+	require.NoError(t, err)
+	vc, err := credential.Parse([]byte(out.Credential))
+	require.NoError(t, err)
+	require.Equal(t, credential.TypeDegreeCredential, vc.CredentialType())
+	require.Equal(t, credential.Issuer{ID: "urn:academictoken:academictoken:institution:inst-1", Name: "Institution inst-1"}, vc.Issuer)
+	require.Equal(t, "2025-12-15T00:00:00Z", vc.IssuanceDate)
+	require.Equal(t, "issued", vc.CredentialStatus.Status)
+	require.Equal(t, types.StoreKey, vc.Proof.StoreName)
+
+	var subject types.DegreeCredentialSubject
+	require.NoError(t, json.Unmarshal(vc.CredentialSubject, &subject))
+	require.Equal(t, []string{"1", "2"}, subject.SubjectTokens)
+	require.Equal(t, uint64(240), subject.TotalCredits)
+
+	// The anchored record renders the same credential
+	record, err := vc.Proof.RecordBytes()
+	require.NoError(t, err)
+	var anchored types.Degree
+	require.NoError(t, anchored.Unmarshal(record))
+	rendered, err := types.NewDegreeCredential(anchored, vc.Issuer.Name, vc.Proof)
+	require.NoError(t, err)
+	require.True(t, credential.Equal(vc, rendered))
+
+	_, err = k.DegreeCredential(ctx, &types.QueryDegreeCredentialRequest{Index: "degree-2"})
+	require.Error(t, err)
+}
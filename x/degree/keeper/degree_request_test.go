@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/x/degree/types"
+)
+
+func TestInvalidatePendingDegreeRequests(t *testing.T) {
+	records := map[string]types.StudentRecord{
+		"student-1": {StudentId: "student-1", CourseId: "course-1", CurriculumVersion: "2024"},
+		"student-2": {StudentId: "student-2", CourseId: "course-1", CurriculumVersion: "2024"},
+	}
+	curricula := map[string]types.CurriculumRequirements{
+		"curriculum-1": {Id: "curriculum-1", CourseId: "course-1", Version: "2024", RequiredSubjects: []string{"subject-1"}},
+	}
+	k, ctx := keepertest.DegreeKeeperWithRecords(t, records, curricula)
+
+	for _, request := range []types.DegreeRequest{
+		{Id: "1", StudentId: "student-1", InstitutionId: "inst-1", Status: types.DegreeRequestStatusPending},
+		{Id: "2", StudentId: "student-1", InstitutionId: "inst-1", Status: types.DegreeRequestStatusValidated},
+		{Id: "3", StudentId: "student-1", InstitutionId: "inst-1", Status: types.DegreeRequestStatusApproved},
+		{Id: "4", StudentId: "student-1", InstitutionId: "inst-2", Status: types.DegreeRequestStatusPending},
+		{Id: "5", StudentId: "student-2", InstitutionId: "inst-1", Status: types.DegreeRequestStatusPending},
+	} {
+		k.SetDegreeRequest(ctx, request)
+	}
+	require.Len(t, k.GetDegreeRequestsByStudent(ctx, "student-1"), 4)
+
+	// Subjects the curriculum does not count leave the requests alone
+	require.Empty(t, k.InvalidatePendingDegreeRequests(ctx, "student-1", "inst-1", "subject-9", "token revoked"))
+
+	invalidated := k.InvalidatePendingDegreeRequests(ctx, "student-1", "inst-1", "subject-1", "token revoked")
+	require.ElementsMatch(t, []string{"1", "2"}, invalidated)
+
+	for id, status := range map[string]string{
+		"1": types.DegreeRequestStatusRevalidationRequired,
+		"2": types.DegreeRequestStatusRevalidationRequired,
+		"3": types.DegreeRequestStatusApproved,
+		"4": types.DegreeRequestStatusPending,
+		"5": types.DegreeRequestStatusPending,
+	} {
+		request, found := k.GetDegreeRequest(ctx, id)
+		require.True(t, found)
+		require.Equal(t, status, request.Status, id)
+	}
+
+	k.RemoveDegreeRequest(ctx, "1")
+	require.Len(t, k.GetDegreeRequestsByStudent(ctx, "student-1"), 3)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -125,6 +126,7 @@ func (k Keeper) SetDegreeRequest(ctx sdk.Context, degreeRequest types.DegreeRequ
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&degreeRequest)
 	store.Set(append(types.DegreeRequestPrefix, []byte(degreeRequest.Id)...), b)
+	store.Set(types.DegreeRequestByStudentKey(degreeRequest.StudentId, degreeRequest.Id), []byte(degreeRequest.Id))
 }
 
 func (k Keeper) GetDegreeRequest(ctx sdk.Context, id string) (val types.DegreeRequest, found bool) {
@@ -139,6 +141,9 @@ func (k Keeper) GetDegreeRequest(ctx sdk.Context, id string) (val types.DegreeRe
 
 func (k Keeper) RemoveDegreeRequest(ctx sdk.Context, id string) {
 	store := ctx.KVStore(k.storeKey)
+	if degreeRequest, found := k.GetDegreeRequest(ctx, id); found {
+		store.Delete(types.DegreeRequestByStudentKey(degreeRequest.StudentId, id))
+	}
 	store.Delete(append(types.DegreeRequestPrefix, []byte(id)...))
 }
 
@@ -184,6 +189,25 @@ func (k Keeper) GetDegreesByInstitution(ctx sdk.Context, institutionId string) [
 	return degrees
 }
 
+// GetDegreeRequestsByStudent returns the degree requests of a student through
+// the by-student index
+func (k Keeper) GetDegreeRequestsByStudent(ctx sdk.Context, studentId string) []types.DegreeRequest {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DegreeRequestByStudentStoreKey(studentId))
+
+	defer iterator.Close()
+
+	var requests []types.DegreeRequest
+	for ; iterator.Valid(); iterator.Next() {
+		request, found := k.GetDegreeRequest(ctx, string(iterator.Value()))
+		if found && request.StudentId == studentId {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
 func (k Keeper) GetDegreeRequestsByStatus(ctx sdk.Context, status string) []types.DegreeRequest {
 	var requests []types.DegreeRequest
 	allRequests := k.GetAllDegreeRequest(ctx)
@@ -197,6 +221,72 @@ func (k Keeper) GetDegreeRequestsByStatus(ctx sdk.Context, status string) []type
 	return requests
 }
 
+// InvalidatePendingDegreeRequests flags the open degree requests of a student
+// at an institution for revalidation after the token of a subject their
+// curriculum needs was revoked, returning the IDs of the affected requests
+func (k Keeper) InvalidatePendingDegreeRequests(ctx sdk.Context, studentId string, institutionId string, subjectId string, reason string) []string {
+	var invalidated []string
+	for _, request := range k.GetDegreeRequestsByStudent(ctx, studentId) {
+		if request.InstitutionId != institutionId {
+			continue
+		}
+		switch request.Status {
+		case types.DegreeRequestStatusPending, types.DegreeRequestStatusProcessing, types.DegreeRequestStatusValidated:
+		default:
+			continue
+		}
+		if !k.curriculumNeedsSubject(ctx, request, subjectId) {
+			continue
+		}
+
+		request.Status = types.DegreeRequestStatusRevalidationRequired
+		request.ValidationDetails = reason
+		k.SetDegreeRequest(ctx, request)
+		invalidated = append(invalidated, request.Id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDegreeRequestInvalidated,
+				sdk.NewAttribute(types.AttributeKeyDegreeRequestID, request.Id),
+				sdk.NewAttribute(types.AttributeKeyStudentID, studentId),
+				sdk.NewAttribute(types.AttributeKeyDegreeStatus, request.Status),
+				sdk.NewAttribute(types.AttributeKeyRejectionReason, reason),
+			),
+		)
+	}
+
+	return invalidated
+}
+
+// curriculumNeedsSubject reports whether the curriculum of a degree request
+// counts a subject toward graduation. Requests whose curriculum cannot be
+// resolved, or revocations whose subject is unknown, are assumed to need it.
+func (k Keeper) curriculumNeedsSubject(ctx sdk.Context, request types.DegreeRequest, subjectId string) bool {
+	if subjectId == "" {
+		return true
+	}
+	record, found := k.studentKeeper.GetStudentRecord(ctx, request.StudentId)
+	if !found {
+		return true
+	}
+	curriculum, found := k.enrolledCurriculum(ctx, record, request.CurriculumId)
+	if !found {
+		return true
+	}
+
+	if slices.Contains(curriculum.RequiredSubjects, subjectId) ||
+		slices.Contains(curriculum.ElectiveSubjects, subjectId) ||
+		slices.Contains(curriculum.RequiredActivities, subjectId) {
+		return true
+	}
+	for _, group := range curriculum.ElectiveGroups {
+		if slices.Contains(group.SubjectIds, subjectId) {
+			return true
+		}
+	}
+	return false
+}
+
 // AppendDegreeRequest stores a new degree request and returns its ID
 func (k Keeper) AppendDegreeRequest(ctx sdk.Context, degreeRequest types.DegreeRequest) (uint64, error) {
 	// Get current count
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	degreecontract "academictoken/contracts/bindings/degree"
 	"academictoken/x/degree/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -22,7 +23,7 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
-// CASCA: Delega requisição de diploma para contrato
+// RequestDegree opens a degree request, validation against the degree contract happens in ValidateDegreeRequirements
 func (k msgServer) RequestDegree(goCtx context.Context, req *types.MsgRequestDegree) (*types.MsgRequestDegreeResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("invalid request")
@@ -35,94 +36,45 @@ func (k msgServer) RequestDegree(goCtx context.Context, req *types.MsgRequestDeg
 		return nil, fmt.Errorf("required fields cannot be empty")
 	}
 
-	// Prepare contract message
-	contractMsg := map[string]interface{}{
-		"request_degree": map[string]interface{}{
-			"student_id":               req.StudentId,
-			"institution_id":           req.InstitutionId,
-			"curriculum_id":            req.CurriculumId,
-			"expected_graduation_date": req.ExpectedGraduationDate,
-			"creator":                  req.Creator,
-		},
-	}
-
-	msgBytes, err := json.Marshal(contractMsg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal contract message: %w", err)
-	}
-
-	// Get contract address
-	contractAddr := k.GetDegreeContractAddress(ctx)
-
-	// Convert addresses
-	senderAddr, err := sdk.AccAddressFromBech32(req.Creator)
-	if err != nil {
-		return nil, fmt.Errorf("invalid creator address: %w", err)
-	}
-
-	contractAccAddr, err := sdk.AccAddressFromBech32(contractAddr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid contract address: %w", err)
-	}
-
-	// Execute contract via wasm keeper with correct signature
-	if k.wasmKeeper == nil {
-		return nil, fmt.Errorf("wasm keeper not available")
-	}
-
-	execResp, err := k.wasmKeeper.Execute(ctx, senderAddr, contractAccAddr, msgBytes, []sdk.Coin{})
-	if err != nil {
-		return nil, fmt.Errorf("contract execution failed: %w", err)
-	}
-
-	// Parse contract response - execResp is []byte, not struct with Data field
-	var contractResp struct {
-		DegreeRequestId string `json:"degree_request_id"`
-		Status          string `json:"status"`
-	}
-
-	if err := json.Unmarshal(execResp, &contractResp); err != nil {
-		return nil, fmt.Errorf("failed to parse contract response: %w", err)
-	}
-
-	// Store minimal reference on-chain (contract handles the full logic)
 	degreeRequest := types.DegreeRequest{
-		Id:                     contractResp.DegreeRequestId,
 		StudentId:              req.StudentId,
 		InstitutionId:          req.InstitutionId,
 		CurriculumId:           req.CurriculumId,
 		ExpectedGraduationDate: req.ExpectedGraduationDate,
-		Status:                 contractResp.Status,
-		RequestDate:            time.Now().UTC().Format(time.RFC3339),
+		Status:                 types.DegreeRequestStatusPending,
+		RequestDate:            ctx.BlockTime().UTC().Format(time.RFC3339),
 		Creator:                req.Creator,
 	}
 
-	k.SetDegreeRequest(ctx, degreeRequest)
+	id, err := k.AppendDegreeRequest(ctx, degreeRequest)
+	if err != nil {
+		return nil, err
+	}
+	degreeRequestId := fmt.Sprintf("%d", id)
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDegreeRequested,
-			sdk.NewAttribute(types.AttributeKeyDegreeRequestID, contractResp.DegreeRequestId),
+			sdk.NewAttribute(types.AttributeKeyDegreeRequestID, degreeRequestId),
 			sdk.NewAttribute(types.AttributeKeyStudentID, req.StudentId),
-			sdk.NewAttribute(types.AttributeKeyDegreeStatus, contractResp.Status),
-			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+			sdk.NewAttribute(types.AttributeKeyDegreeStatus, degreeRequest.Status),
 		),
 	)
 
-	k.Logger(ctx).Info("Degree request delegated to contract",
-		"degree_request_id", contractResp.DegreeRequestId,
-		"contract_address", contractAddr,
+	k.Logger(ctx).Info("Degree requested",
+		"degree_request_id", degreeRequestId,
 		"student_id", req.StudentId,
 	)
 
 	return &types.MsgRequestDegreeResponse{
-		DegreeRequestId: contractResp.DegreeRequestId,
-		Status:          contractResp.Status,
+		DegreeRequestId: degreeRequestId,
+		Status:          degreeRequest.Status,
 	}, nil
 }
 
-// CASCA: Delega validação para contrato
+// ValidateDegreeRequirements validates a degree request natively or in the
+// degree contract, following the integration mode of the chain
 func (k msgServer) ValidateDegreeRequirements(goCtx context.Context, req *types.MsgValidateDegreeRequirements) (*types.MsgValidateDegreeRequirementsResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("invalid request")
@@ -141,90 +93,144 @@ func (k msgServer) ValidateDegreeRequirements(goCtx context.Context, req *types.
 		return nil, fmt.Errorf("degree request with ID '%s' not found", req.DegreeRequestId)
 	}
 
+	var validation DegreeValidation
+	var err error
+	contractAddr := ""
+	switch mode := k.studentKeeper.GetIntegrationMode(ctx); mode {
+	case types.IntegrationModeNative:
+		validation, err = k.ValidateDegreeRequest(ctx, degreeRequest)
+	case types.IntegrationModeContract:
+		contractAddr = req.ContractAddress
+		if contractAddr == "" {
+			contractAddr = k.GetDegreeContractAddress(ctx)
+		}
+		validation, err = k.contractDegreeValidation(ctx, req.Creator, contractAddr, degreeRequest)
+	default:
+		return nil, types.ErrIntegrationDisabled.Wrapf("degree validation is unavailable in %s integration mode", mode)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Update degree request status based on the validation
+	if validation.Passed {
+		degreeRequest.Status = types.DegreeRequestStatusValidated
+	} else {
+		degreeRequest.Status = types.DegreeRequestStatusValidationFailed
+	}
+	degreeRequest.ValidationDetails = validation.Details
+	degreeRequest.ValidationScore = validation.Score
+	degreeRequest.MissingRequirements = validation.Missing
+
+	k.SetDegreeRequest(ctx, degreeRequest)
+
+	// Emit event
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyDegreeRequestID, req.DegreeRequestId),
+		sdk.NewAttribute(types.AttributeKeyValidationPassed, fmt.Sprintf("%t", validation.Passed)),
+		sdk.NewAttribute(types.AttributeKeyValidationScore, validation.Score),
+	}
+	if contractAddr != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCurriculumID, validation.CurriculumId))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDegreeValidated, attributes...))
+
+	k.Logger(ctx).Info("Degree requirements validated",
+		"degree_request_id", req.DegreeRequestId,
+		"validation_passed", validation.Passed,
+		"validation_score", validation.Score,
+	)
+
+	return &types.MsgValidateDegreeRequirementsResponse{
+		ValidationPassed:    validation.Passed,
+		ValidationScore:     validation.Score,
+		ValidationDetails:   validation.Details,
+		MissingRequirements: validation.Missing,
+	}, nil
+}
+
+// contractDegreeValidation delegates the validation of a degree request to the
+// degree contract
+func (k msgServer) contractDegreeValidation(ctx sdk.Context, creator string, contractAddr string, degreeRequest types.DegreeRequest) (DegreeValidation, error) {
+	// Gather the academic record the contract validates against
+	completedSubjects, err := k.studentKeeper.GetCompletedSubjects(ctx, degreeRequest.StudentId)
+	if err != nil {
+		return DegreeValidation{}, fmt.Errorf("failed to get completed subjects: %w", err)
+	}
+	finalGpa, err := k.studentKeeper.GetStudentGPA(ctx, degreeRequest.StudentId)
+	if err != nil {
+		return DegreeValidation{}, fmt.Errorf("failed to get student GPA: %w", err)
+	}
+	totalCredits, err := k.studentKeeper.GetStudentTotalCredits(ctx, degreeRequest.StudentId)
+	if err != nil {
+		return DegreeValidation{}, fmt.Errorf("failed to get student credits: %w", err)
+	}
+	if completedSubjects == nil {
+		completedSubjects = []string{}
+	}
+
 	// Prepare contract message
-	contractMsg := map[string]interface{}{
-		"validate_degree_requirements": map[string]interface{}{
-			"degree_request_id":     req.DegreeRequestId,
-			"validation_parameters": req.ValidationParameters,
-			"creator":               req.Creator,
+	contractMsg := degreecontract.ExecuteMsg{
+		ValidateDegreeRequirements: &degreecontract.ValidateDegreeRequirementsMsg{
+			StudentId:         degreeRequest.StudentId,
+			CurriculumId:      degreeRequest.CurriculumId,
+			InstitutionId:     degreeRequest.InstitutionId,
+			FinalGpa:          finalGpa,
+			TotalCredits:      totalCredits,
+			CompletedSubjects: completedSubjects,
+			Signatures:        []string{},
+			RequestedDate:     degreeRequest.RequestDate,
 		},
 	}
 
 	msgBytes, err := json.Marshal(contractMsg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal contract message: %w", err)
-	}
-
-	// Use contract address from request or from hardcode
-	contractAddr := req.ContractAddress
-	if contractAddr == "" {
-		contractAddr = k.GetDegreeContractAddress(ctx)
+		return DegreeValidation{}, fmt.Errorf("failed to marshal contract message: %w", err)
 	}
 
 	// Convert addresses
-	senderAddr, err := sdk.AccAddressFromBech32(req.Creator)
+	senderAddr, err := sdk.AccAddressFromBech32(creator)
 	if err != nil {
-		return nil, fmt.Errorf("invalid creator address: %w", err)
+		return DegreeValidation{}, fmt.Errorf("invalid creator address: %w", err)
 	}
 
 	contractAccAddr, err := sdk.AccAddressFromBech32(contractAddr)
 	if err != nil {
-		return nil, fmt.Errorf("invalid contract address: %w", err)
+		return DegreeValidation{}, fmt.Errorf("invalid contract address: %w", err)
 	}
 
 	// Call CosmWasm contract with correct signature
 	execResp, err := k.wasmKeeper.Execute(ctx, senderAddr, contractAccAddr, msgBytes, []sdk.Coin{})
 	if err != nil {
-		return nil, fmt.Errorf("contract execution failed: %w", err)
-	}
-
-	// Parse contract response - execResp is []byte
-	var contractResp struct {
-		ValidationPassed    bool     `json:"validation_passed"`
-		ValidationScore     string   `json:"validation_score"`
-		ValidationDetails   string   `json:"validation_details"`
-		MissingRequirements []string `json:"missing_requirements"`
+		return DegreeValidation{}, fmt.Errorf("contract execution failed: %w", err)
 	}
 
+	// Parse contract response - execResp is the response data
+	var contractResp degreecontract.ValidateDegreeRequirementsResponse
 	if err := json.Unmarshal(execResp, &contractResp); err != nil {
-		return nil, fmt.Errorf("failed to parse contract response: %w", err)
+		return DegreeValidation{}, fmt.Errorf("failed to parse contract response: %w", err)
 	}
 
-	// Update degree request status based on contract response
-	if contractResp.ValidationPassed {
-		degreeRequest.Status = "validated"
-	} else {
-		degreeRequest.Status = "validation_failed"
+	// The contract scores validations all or nothing and reports the missing
+	// requirements as plain descriptions
+	validation := DegreeValidation{
+		Passed:       contractResp.IsValid,
+		Score:        "0",
+		Details:      contractResp.Message,
+		CurriculumId: degreeRequest.CurriculumId,
 	}
-
-	k.SetDegreeRequest(ctx, degreeRequest)
-
-	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeDegreeValidated,
-			sdk.NewAttribute(types.AttributeKeyDegreeRequestID, req.DegreeRequestId),
-			sdk.NewAttribute(types.AttributeKeyValidationPassed, fmt.Sprintf("%t", contractResp.ValidationPassed)),
-			sdk.NewAttribute(types.AttributeKeyValidationScore, contractResp.ValidationScore),
-			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
-		),
-	)
-
-	k.Logger(ctx).Info("Degree validation delegated to contract",
-		"degree_request_id", req.DegreeRequestId,
-		"validation_passed", contractResp.ValidationPassed,
-		"contract_address", contractAddr,
-	)
-
-	return &types.MsgValidateDegreeRequirementsResponse{
-		ValidationPassed:    contractResp.ValidationPassed,
-		ValidationScore:     contractResp.ValidationScore,
-		ValidationDetails:   contractResp.ValidationDetails,
-		MissingRequirements: contractResp.MissingRequirements,
-	}, nil
+	if contractResp.IsValid {
+		validation.Score = "100"
+	}
+	for _, missing := range contractResp.MissingRequirements {
+		validation.Missing = append(validation.Missing, &types.MissingRequirement{Requirement: "contract", Description: missing})
+	}
+	return validation, nil
 }
 
-// CASCA: Delega emissão de diploma para contrato
+// IssueDegree issues a degree for a validated request and mints its NFT
 func (k msgServer) IssueDegree(goCtx context.Context, req *types.MsgIssueDegree) (*types.MsgIssueDegreeResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("invalid request")
@@ -242,71 +248,59 @@ func (k msgServer) IssueDegree(goCtx context.Context, req *types.MsgIssueDegree)
 	if !found {
 		return nil, fmt.Errorf("degree request with ID '%s' not found", req.DegreeRequestId)
 	}
-
-	// Prepare contract message
-	contractMsg := map[string]interface{}{
-		"issue_degree": map[string]interface{}{
-			"degree_request_id": req.DegreeRequestId,
-			"final_gpa":         req.FinalGpa,
-			"total_credits":     req.TotalCredits,
-			"signatures":        req.Signatures,
-			"creator":           req.Creator,
-		},
+	if degreeRequest.Status == types.DegreeRequestStatusRevalidationRequired {
+		return nil, types.ErrInvalidDegreeStatus.Wrapf("degree request '%s' must be validated again: %s", req.DegreeRequestId, degreeRequest.ValidationDetails)
 	}
-
-	msgBytes, err := json.Marshal(contractMsg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal contract message: %w", err)
+	if degreeRequest.Status != types.DegreeRequestStatusValidated {
+		return nil, types.ErrDegreeValidationPending.Wrapf("degree request '%s' is %s, it must pass validation first", req.DegreeRequestId, degreeRequest.Status)
 	}
 
-	// Get contract address
-	contractAddr := k.GetDegreeContractAddress(ctx)
-
-	// Convert addresses
-	senderAddr, err := sdk.AccAddressFromBech32(req.Creator)
-	if err != nil {
-		return nil, fmt.Errorf("invalid creator address: %w", err)
+	// Check that the signer is an admin or registrar of the degree's institution
+	courseId := ""
+	if curriculum, found := k.curriculumKeeper.GetCurriculum(ctx, degreeRequest.CurriculumId); found {
+		courseId = curriculum.CourseId
 	}
-
-	contractAccAddr, err := sdk.AccAddressFromBech32(contractAddr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid contract address: %w", err)
+	if !k.institutionKeeper.CanIssueDegree(ctx, degreeRequest.InstitutionId, req.Creator, courseId) {
+		return nil, types.ErrUnauthorizedDegreeIssuer.Wrapf("'%s' is not a registrar of institution '%s'", req.Creator, degreeRequest.InstitutionId)
 	}
 
-	// Call CosmWasm contract with correct signature
-	execResp, err := k.wasmKeeper.Execute(ctx, senderAddr, contractAccAddr, msgBytes, []sdk.Coin{})
+	// The degree contract only validates requirements, so the degree is issued here
+	issueDate := ctx.BlockTime().UTC().Format(time.RFC3339)
+	degree := types.Degree{
+		Student:         degreeRequest.StudentId,
+		Institution:     degreeRequest.InstitutionId,
+		CourseId:        degreeRequest.CurriculumId,
+		IssueDate:       issueDate,
+		Status:          types.DegreeStatusIssued,
+		FinalGrade:      req.FinalGpa,
+		TotalCredits:    req.TotalCredits,
+		Signatures:      req.Signatures,
+		ValidationScore: degreeRequest.ValidationScore,
+	}
+	if err := k.verifyDegreeSignatures(ctx, &degree, courseId); err != nil {
+		return nil, err
+	}
+
+	nftTokenId, err := k.academicNFTKeeper.MintDegreeNFT(ctx, degreeRequest.StudentId, types.DegreeNFTData{
+		StudentId:     degreeRequest.StudentId,
+		CurriculumId:  degreeRequest.CurriculumId,
+		InstitutionId: degreeRequest.InstitutionId,
+		IssueDate:     issueDate,
+		GPA:           req.FinalGpa,
+		TotalCredits:  req.TotalCredits,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("contract execution failed: %w", err)
-	}
-
-	// Parse contract response - execResp is []byte
-	var contractResp struct {
-		DegreeId   string `json:"degree_id"`
-		NftTokenId string `json:"nft_token_id"`
-		IpfsHash   string `json:"ipfs_hash"`
-		IssueDate  string `json:"issue_date"`
+		return nil, fmt.Errorf("failed to mint degree NFT: %w", err)
 	}
 
-	if err := json.Unmarshal(execResp, &contractResp); err != nil {
-		return nil, fmt.Errorf("failed to parse contract response: %w", err)
-	}
-
-	// Store minimal degree reference on-chain (contract handles full diploma data)
-	degree := types.Degree{
-		Index:        contractResp.DegreeId,
-		DegreeId:     contractResp.DegreeId,
-		Student:      degreeRequest.StudentId,
-		Institution:  degreeRequest.InstitutionId,
-		CourseId:     degreeRequest.CurriculumId,
-		IssueDate:    contractResp.IssueDate,
-		Status:       "issued",
-		NftTokenId:   contractResp.NftTokenId,
-		IpfsLink:     contractResp.IpfsHash,
-		FinalGrade:   req.FinalGpa,
-		TotalCredits: req.TotalCredits,
-		Signatures:   req.Signatures,
+	degree.NftTokenId = nftTokenId
+	id, err := k.AppendDegree(ctx, degree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store degree: %w", err)
 	}
-
+	degreeId := fmt.Sprintf("%d", id)
+	degree.Index = degreeId
+	degree.DegreeId = degreeId
 	k.SetDegree(ctx, degree)
 
 	// Update degree request status
@@ -317,24 +311,20 @@ func (k msgServer) IssueDegree(goCtx context.Context, req *types.MsgIssueDegree)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDegreeIssued,
-			sdk.NewAttribute(types.AttributeKeyDegreeID, contractResp.DegreeId),
-			sdk.NewAttribute(types.AttributeKeyNFTTokenID, contractResp.NftTokenId),
-			sdk.NewAttribute(types.AttributeKeyIPFSHash, contractResp.IpfsHash),
-			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+			sdk.NewAttribute(types.AttributeKeyDegreeID, degreeId),
+			sdk.NewAttribute(types.AttributeKeyNFTTokenID, nftTokenId),
 		),
 	)
 
-	k.Logger(ctx).Info("Degree issuance delegated to contract",
-		"degree_id", contractResp.DegreeId,
-		"nft_token_id", contractResp.NftTokenId,
-		"contract_address", contractAddr,
+	k.Logger(ctx).Info("Degree issued",
+		"degree_id", degreeId,
+		"nft_token_id", nftTokenId,
 	)
 
 	return &types.MsgIssueDegreeResponse{
-		DegreeId:   contractResp.DegreeId,
-		NftTokenId: contractResp.NftTokenId,
-		IpfsHash:   contractResp.IpfsHash,
-		IssueDate:  contractResp.IssueDate,
+		DegreeId:   degreeId,
+		NftTokenId: nftTokenId,
+		IssueDate:  issueDate,
 	}, nil
 }
 
@@ -369,11 +359,11 @@ func (k msgServer) UpdateDegreeContract(goCtx context.Context, msg *types.MsgUpd
 	return &types.MsgUpdateDegreeContractResponse{
 		OldContractAddress: oldAddress,
 		NewContractAddress: msg.NewContractAddress,
-		UpdateDate:         time.Now().UTC().Format(time.RFC3339),
+		UpdateDate:         ctx.BlockTime().UTC().Format(time.RFC3339),
 	}, nil
 }
 
-// CASCA: Delega cancelamento para contrato
+// CancelDegreeRequest cancels a pending degree request
 func (k msgServer) CancelDegreeRequest(goCtx context.Context, msg *types.MsgCancelDegreeRequest) (*types.MsgCancelDegreeRequestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -388,40 +378,6 @@ func (k msgServer) CancelDegreeRequest(goCtx context.Context, msg *types.MsgCanc
 		return nil, fmt.Errorf("degree request not found")
 	}
 
-	// Prepare contract message
-	contractMsg := map[string]interface{}{
-		"cancel_degree_request": map[string]interface{}{
-			"degree_request_id":   msg.DegreeRequestId,
-			"cancellation_reason": msg.CancellationReason,
-			"creator":             msg.Creator,
-		},
-	}
-
-	msgBytes, err := json.Marshal(contractMsg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal contract message: %w", err)
-	}
-
-	// Use hardcoded contract address since DegreeRequest doesn't have ContractAddress field
-	contractAddr := k.GetDegreeContractAddress(ctx)
-
-	// Convert addresses
-	senderAddr, err := sdk.AccAddressFromBech32(msg.Creator)
-	if err != nil {
-		return nil, fmt.Errorf("invalid creator address: %w", err)
-	}
-
-	contractAccAddr, err := sdk.AccAddressFromBech32(contractAddr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid contract address: %w", err)
-	}
-
-	// Call CosmWasm contract with correct signature
-	_, err = k.wasmKeeper.Execute(ctx, senderAddr, contractAccAddr, msgBytes, []sdk.Coin{})
-	if err != nil {
-		return nil, fmt.Errorf("contract execution failed: %w", err)
-	}
-
 	// Update local state
 	degreeRequest.Status = "cancelled"
 	k.SetDegreeRequest(ctx, degreeRequest)
@@ -439,7 +395,7 @@ func (k msgServer) CancelDegreeRequest(goCtx context.Context, msg *types.MsgCanc
 	return &types.MsgCancelDegreeRequestResponse{
 		DegreeRequestId:  msg.DegreeRequestId,
 		Status:           "cancelled",
-		CancellationDate: time.Now().UTC().Format(time.RFC3339),
+		CancellationDate: ctx.BlockTime().UTC().Format(time.RFC3339),
 	}, nil
 }
 
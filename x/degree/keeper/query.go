@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"academictoken/x/academicnft/credential"
 	"academictoken/x/degree/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -118,3 +119,78 @@ func (k Keeper) DegreeValidationStatus(goCtx context.Context, req *types.QueryDe
 		ValidationDetails: "Validation completed",
 	}, nil
 }
+
+// DegreeCredential renders a degree as a W3C Verifiable Credential anchored to
+// the stored record at the queried height
+func (k Keeper) DegreeCredential(goCtx context.Context, req *types.QueryDegreeCredentialRequest) (*types.QueryDegreeCredentialResponse, error) {
+	if req == nil || req.Index == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The anchor carries the stored bytes, so read them rather than re-encode the degree
+	key := append(append([]byte{}, types.DegreePrefix...), []byte(req.Index)...)
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return nil, status.Error(codes.NotFound, "degree not found")
+	}
+	var degree types.Degree
+	k.cdc.MustUnmarshal(bz, &degree)
+
+	vc, err := types.NewDegreeCredential(degree, k.institutionName(ctx, degree.Institution), credential.NewAnchor(ctx, types.StoreKey, key, bz))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out, err := vc.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDegreeCredentialResponse{Credential: out}, nil
+}
+
+// VerifyDegree reports whether the signatures of a degree match it and were
+// made with keys of its institution that were valid when it was issued
+func (k Keeper) VerifyDegree(goCtx context.Context, req *types.QueryVerifyDegreeRequest) (*types.QueryVerifyDegreeResponse, error) {
+	if req == nil || req.Index == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	degree, found := k.GetDegree(ctx, req.Index)
+	if !found {
+		return &types.QueryVerifyDegreeResponse{Exists: false}, nil
+	}
+
+	signatures := k.SignatureStatuses(ctx, degree)
+	valid := len(signatures) > 0
+	for _, signature := range signatures {
+		valid = valid && signature.SignatureValid && signature.KeyValidAtIssuance
+	}
+
+	return &types.QueryVerifyDegreeResponse{
+		Exists:          true,
+		Degree:          &degree,
+		Signatures:      signatures,
+		SignaturesValid: valid,
+	}, nil
+}
+
+// institutionName returns the name of an institution, or "" when it is unknown
+func (k Keeper) institutionName(ctx sdk.Context, institutionId string) string {
+	if k.institutionKeeper == nil {
+		return ""
+	}
+	institution, found := k.institutionKeeper.GetInstitution(ctx, institutionId)
+	if !found {
+		return ""
+	}
+	if fields, ok := institution.(map[string]interface{}); ok {
+		if name, ok := fields["name"].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
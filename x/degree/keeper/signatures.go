@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/degree/types"
+)
+
+// verifyDegreeSignatures checks every signature of a new degree against the
+// active signing keys of the issuing institution and records the key that
+// produced each one. The owner of each key must be allowed to issue the
+// degree, and no key may sign twice.
+func (k Keeper) verifyDegreeSignatures(ctx sdk.Context, degree *types.Degree, courseId string) error {
+	if len(degree.Signatures) == 0 {
+		return types.ErrInvalidSignature.Wrap("at least one signature is required")
+	}
+
+	keys := k.institutionKeeper.GetInstitutionSigningKeys(ctx, degree.Institution)
+	signBytes := degreeSignBytes(*degree)
+	used := make(map[string]bool, len(degree.Signatures))
+	degree.SigningKeyIds = make([]string, 0, len(degree.Signatures))
+
+	for i, signature := range degree.Signatures {
+		sig, err := types.DecodeSignature(signature)
+		if err != nil {
+			return err
+		}
+
+		signingKeyId := ""
+		for _, key := range keys {
+			if used[key.KeyId] || !key.IsActive() || !key.VerifySignature(signBytes, sig) {
+				continue
+			}
+			if !k.institutionKeeper.CanIssueDegree(ctx, degree.Institution, key.Owner, courseId) {
+				return types.ErrInvalidSignature.Wrapf("owner '%s' of signing key '%s' cannot issue this degree", key.Owner, key.KeyId)
+			}
+			signingKeyId = key.KeyId
+			break
+		}
+		if signingKeyId == "" {
+			return types.ErrInvalidSignature.Wrapf("signature %d does not match an unused active signing key of institution '%s'", i, degree.Institution)
+		}
+		used[signingKeyId] = true
+		degree.SigningKeyIds = append(degree.SigningKeyIds, signingKeyId)
+	}
+
+	return nil
+}
+
+// SignatureStatuses reports, for each signature of a degree, which key signed
+// it, whether the signature still matches the degree and whether the key was
+// valid when the degree was issued
+func (k Keeper) SignatureStatuses(ctx sdk.Context, degree types.Degree) []types.DegreeSignatureStatus {
+	statuses := make([]types.DegreeSignatureStatus, len(degree.Signatures))
+	if k.institutionKeeper == nil {
+		return statuses
+	}
+
+	signBytes := degreeSignBytes(degree)
+	issuedAt, issuedAtErr := time.Parse(time.RFC3339, degree.IssueDate)
+	for i, signature := range degree.Signatures {
+		if i >= len(degree.SigningKeyIds) {
+			break
+		}
+		statuses[i].SigningKeyId = degree.SigningKeyIds[i]
+
+		key, found := k.institutionKeeper.GetSigningKey(ctx, degree.Institution, degree.SigningKeyIds[i])
+		if !found {
+			continue
+		}
+		statuses[i].Signer = key.Owner
+		if sig, err := types.DecodeSignature(signature); err == nil {
+			statuses[i].SignatureValid = key.VerifySignature(signBytes, sig)
+		}
+		if issuedAtErr == nil {
+			statuses[i].KeyValidAtIssuance = key.ValidAt(issuedAt)
+		}
+	}
+
+	return statuses
+}
+
+// degreeSignBytes returns the sign bytes of a degree. The curriculum of the
+// degree request is stored as the degree's course ID.
+func degreeSignBytes(degree types.Degree) []byte {
+	return types.DegreeSignBytes(degree.Student, degree.Institution, degree.CourseId, degree.FinalGrade, degree.TotalCredits)
+}
@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/degree/types"
+)
+
+// hoursPerYear is the length of a year in hours, counting leap years
+const hoursPerYear = 8766
+
+// DegreeValidation is the outcome of validating a degree request
+type DegreeValidation struct {
+	Passed bool
+	// Score is the average progress toward the requirements, as a percentage
+	// rounded down so that only a passed validation scores 100
+	Score        string
+	Details      string
+	CurriculumId string
+	// RequirementsMet names the requirements the student meets
+	RequirementsMet []string
+	Missing         []*types.MissingRequirement
+}
+
+// ValidateDegreeRequest evaluates the academic record of the student of a
+// degree request against the graduation requirements of the curriculum
+// version the student enrolled under
+func (k Keeper) ValidateDegreeRequest(ctx sdk.Context, degreeRequest types.DegreeRequest) (DegreeValidation, error) {
+	record, found := k.studentKeeper.GetStudentRecord(ctx, degreeRequest.StudentId)
+	if !found {
+		return DegreeValidation{
+			Score:   "0",
+			Details: "No academic record found for student",
+			Missing: []*types.MissingRequirement{{
+				Requirement: "academic_record",
+				Description: fmt.Sprintf("student '%s' has no academic record", degreeRequest.StudentId),
+			}},
+		}, nil
+	}
+
+	curriculum, found := k.enrolledCurriculum(ctx, record, degreeRequest.CurriculumId)
+	if !found {
+		return DegreeValidation{}, types.ErrCurriculumNotFound.Wrapf("no curriculum %q for course '%s' of student '%s'", record.CurriculumVersion, record.CourseId, degreeRequest.StudentId)
+	}
+
+	check, err := evaluateGraduation(record, curriculum, ctx.BlockTime())
+	if err != nil {
+		return DegreeValidation{}, err
+	}
+	if degreeRequest.CurriculumId != "" && degreeRequest.CurriculumId != curriculum.Id {
+		check.add(math.LegacyZeroDec(), false, types.MissingRequirement{
+			Requirement: "curriculum",
+			Description: fmt.Sprintf("student enrolled under curriculum '%s', not '%s'", curriculum.Id, degreeRequest.CurriculumId),
+			Required:    degreeRequest.CurriculumId,
+			Completed:   curriculum.Id,
+		})
+	}
+
+	validation := DegreeValidation{
+		Passed:          len(check.missing) == 0,
+		Score:           check.score(),
+		Details:         "All graduation requirements met",
+		CurriculumId:    curriculum.Id,
+		RequirementsMet: check.met,
+		Missing:         check.missing,
+	}
+	if !validation.Passed {
+		descriptions := make([]string, 0, len(check.missing))
+		for _, missing := range check.missing {
+			descriptions = append(descriptions, fmt.Sprintf("%s: %s", missing.Requirement, missing.Description))
+		}
+		validation.Details = fmt.Sprintf("Missing requirements: %s", strings.Join(descriptions, "; "))
+	}
+	return validation, nil
+}
+
+// enrolledCurriculum returns the curriculum version the student enrolled
+// under, or the requested curriculum for students without a course
+func (k Keeper) enrolledCurriculum(ctx sdk.Context, record types.StudentRecord, curriculumId string) (types.CurriculumRequirements, bool) {
+	if record.CourseId != "" {
+		return k.curriculumKeeper.GetCurriculumVersion(ctx, record.CourseId, record.CurriculumVersion)
+	}
+	if curriculumId == "" {
+		return types.CurriculumRequirements{}, false
+	}
+	return k.curriculumKeeper.GetCurriculumRequirements(ctx, curriculumId)
+}
+
+// graduationCheck collects the progress toward each requirement, the
+// requirements that are met and those that are not
+type graduationCheck struct {
+	progress []math.LegacyDec
+	met      []string
+	missing  []*types.MissingRequirement
+}
+
+func (c *graduationCheck) add(progress math.LegacyDec, met bool, requirement types.MissingRequirement) {
+	if progress.GT(math.LegacyOneDec()) {
+		progress = math.LegacyOneDec()
+	}
+	c.progress = append(c.progress, progress)
+	if met {
+		c.met = append(c.met, requirement.Requirement)
+	} else {
+		c.missing = append(c.missing, &requirement)
+	}
+}
+
+func (c graduationCheck) score() string {
+	if len(c.progress) == 0 {
+		return "100"
+	}
+	total := math.LegacyZeroDec()
+	for _, progress := range c.progress {
+		total = total.Add(progress)
+	}
+	return total.MulInt64(100).QuoInt64(int64(len(c.progress))).TruncateInt().String()
+}
+
+// evaluateGraduation checks the required subjects, electives, elective groups,
+// credits, GPA, required activities and time limits of a curriculum version.
+// Requirements the curriculum leaves empty are not evaluated. Activities have
+// no record of their own, so they count as met once completed like a subject.
+func evaluateGraduation(record types.StudentRecord, curriculum types.CurriculumRequirements, now time.Time) (graduationCheck, error) {
+	var check graduationCheck
+
+	completed := make(map[string]bool, len(record.Subjects))
+	credits := make(map[string]uint64, len(record.Subjects))
+	var totalCredits, electiveCredits uint64
+	notElective := make(map[string]bool)
+	for _, subjectId := range append(append([]string{}, curriculum.RequiredSubjects...), curriculum.RequiredActivities...) {
+		notElective[subjectId] = true
+	}
+	for _, subject := range record.Subjects {
+		if completed[subject.SubjectId] {
+			continue
+		}
+		completed[subject.SubjectId] = true
+		credits[subject.SubjectId] = subject.Credits
+		totalCredits += subject.Credits
+		if !notElective[subject.SubjectId] {
+			electiveCredits += subject.Credits
+		}
+	}
+
+	if len(curriculum.RequiredSubjects) > 0 {
+		remaining := missingSubjects(curriculum.RequiredSubjects, completed)
+		done := uint64(len(curriculum.RequiredSubjects) - len(remaining))
+		check.add(ratio(done, uint64(len(curriculum.RequiredSubjects))), len(remaining) == 0, types.MissingRequirement{
+			Requirement: "required_subjects",
+			Description: fmt.Sprintf("%d required subjects not completed", len(remaining)),
+			Required:    fmt.Sprintf("%d", len(curriculum.RequiredSubjects)),
+			Completed:   fmt.Sprintf("%d", done),
+			SubjectIds:  remaining,
+		})
+	}
+
+	if curriculum.ElectiveMin > 0 {
+		remaining := missingSubjects(curriculum.ElectiveSubjects, completed)
+		done := uint64(len(curriculum.ElectiveSubjects) - len(remaining))
+		check.add(ratio(done, curriculum.ElectiveMin), done >= curriculum.ElectiveMin, types.MissingRequirement{
+			Requirement: "elective_subjects",
+			Description: fmt.Sprintf("%d of %d elective subjects completed", done, curriculum.ElectiveMin),
+			Required:    fmt.Sprintf("%d", curriculum.ElectiveMin),
+			Completed:   fmt.Sprintf("%d", done),
+			SubjectIds:  remaining,
+		})
+	}
+
+	for _, group := range curriculum.ElectiveGroups {
+		remaining := missingSubjects(group.SubjectIds, completed)
+		done := uint64(len(group.SubjectIds) - len(remaining))
+		if group.MinSubjects > 0 {
+			check.add(ratio(done, group.MinSubjects), done >= group.MinSubjects, types.MissingRequirement{
+				Requirement: "elective_group_subjects",
+				Description: fmt.Sprintf("%d of %d subjects of elective group '%s' completed", done, group.MinSubjects, group.GroupId),
+				Required:    fmt.Sprintf("%d", group.MinSubjects),
+				Completed:   fmt.Sprintf("%d", done),
+				SubjectIds:  remaining,
+			})
+		}
+		if group.CreditsRequired > 0 {
+			var groupCredits uint64
+			for _, subjectId := range group.SubjectIds {
+				groupCredits += credits[subjectId]
+			}
+			check.add(ratio(groupCredits, group.CreditsRequired), groupCredits >= group.CreditsRequired, types.MissingRequirement{
+				Requirement: "elective_group_credits",
+				Description: fmt.Sprintf("%d of %d credits of elective group '%s' completed", groupCredits, group.CreditsRequired, group.GroupId),
+				Required:    fmt.Sprintf("%d", group.CreditsRequired),
+				Completed:   fmt.Sprintf("%d", groupCredits),
+				SubjectIds:  remaining,
+			})
+		}
+	}
+
+	if curriculum.ElectiveCredits > 0 {
+		check.add(ratio(electiveCredits, curriculum.ElectiveCredits), electiveCredits >= curriculum.ElectiveCredits, types.MissingRequirement{
+			Requirement: "elective_credits",
+			Description: fmt.Sprintf("%d elective credits remaining", curriculum.ElectiveCredits-min(electiveCredits, curriculum.ElectiveCredits)),
+			Required:    fmt.Sprintf("%d", curriculum.ElectiveCredits),
+			Completed:   fmt.Sprintf("%d", electiveCredits),
+		})
+	}
+
+	if curriculum.TotalCredits > 0 {
+		check.add(ratio(totalCredits, curriculum.TotalCredits), totalCredits >= curriculum.TotalCredits, types.MissingRequirement{
+			Requirement: "total_credits",
+			Description: fmt.Sprintf("%d credits remaining", curriculum.TotalCredits-min(totalCredits, curriculum.TotalCredits)),
+			Required:    fmt.Sprintf("%d", curriculum.TotalCredits),
+			Completed:   fmt.Sprintf("%d", totalCredits),
+		})
+	}
+
+	if curriculum.MinGPA != "" {
+		minGpa, err := math.LegacyNewDecFromStr(curriculum.MinGPA)
+		if err != nil {
+			return check, fmt.Errorf("invalid minimum GPA '%s' in curriculum '%s': %w", curriculum.MinGPA, curriculum.Id, err)
+		}
+		gpa := math.LegacyZeroDec()
+		if record.GPA != "" {
+			if gpa, err = math.LegacyNewDecFromStr(record.GPA); err != nil {
+				return check, fmt.Errorf("invalid GPA '%s' for student '%s': %w", record.GPA, record.StudentId, err)
+			}
+		}
+		progress := math.LegacyOneDec()
+		if minGpa.IsPositive() {
+			progress = gpa.Quo(minGpa)
+		}
+		check.add(progress, gpa.GTE(minGpa), types.MissingRequirement{
+			Requirement: "min_gpa",
+			Description: fmt.Sprintf("GPA %s below %s", formatDec(gpa), curriculum.MinGPA),
+			Required:    curriculum.MinGPA,
+			Completed:   formatDec(gpa),
+		})
+	}
+
+	if len(curriculum.RequiredActivities) > 0 {
+		remaining := missingSubjects(curriculum.RequiredActivities, completed)
+		done := uint64(len(curriculum.RequiredActivities) - len(remaining))
+		check.add(ratio(done, uint64(len(curriculum.RequiredActivities))), len(remaining) == 0, types.MissingRequirement{
+			Requirement: "required_activities",
+			Description: fmt.Sprintf("%d required activities not completed", len(remaining)),
+			Required:    fmt.Sprintf("%d", len(curriculum.RequiredActivities)),
+			Completed:   fmt.Sprintf("%d", done),
+			SubjectIds:  remaining,
+		})
+	}
+
+	if curriculum.MinimumYears == "" && curriculum.MaximumYears == "" {
+		return check, nil
+	}
+	enrolledFor, err := yearsEnrolled(record, now)
+	if err != nil {
+		return check, err
+	}
+	if curriculum.MinimumYears != "" {
+		minYears, err := math.LegacyNewDecFromStr(curriculum.MinimumYears)
+		if err != nil {
+			return check, fmt.Errorf("invalid minimum years '%s' in curriculum '%s': %w", curriculum.MinimumYears, curriculum.Id, err)
+		}
+		progress := math.LegacyOneDec()
+		if minYears.IsPositive() {
+			progress = enrolledFor.Quo(minYears)
+		}
+		check.add(progress, enrolledFor.GTE(minYears), types.MissingRequirement{
+			Requirement: "minimum_time_years",
+			Description: fmt.Sprintf("enrolled for %s of at least %s years", formatDec(enrolledFor), curriculum.MinimumYears),
+			Required:    curriculum.MinimumYears,
+			Completed:   formatDec(enrolledFor),
+		})
+	}
+	if curriculum.MaximumYears != "" {
+		maxYears, err := math.LegacyNewDecFromStr(curriculum.MaximumYears)
+		if err != nil {
+			return check, fmt.Errorf("invalid maximum years '%s' in curriculum '%s': %w", curriculum.MaximumYears, curriculum.Id, err)
+		}
+		withinLimit := enrolledFor.LTE(maxYears)
+		progress := math.LegacyZeroDec()
+		if withinLimit {
+			progress = math.LegacyOneDec()
+		}
+		check.add(progress, withinLimit, types.MissingRequirement{
+			Requirement: "maximum_time_years",
+			Description: fmt.Sprintf("enrolled for %s of at most %s years", formatDec(enrolledFor), curriculum.MaximumYears),
+			Required:    curriculum.MaximumYears,
+			Completed:   formatDec(enrolledFor),
+		})
+	}
+
+	return check, nil
+}
+
+// yearsEnrolled returns the years elapsed since the enrollment of a student,
+// zero when the enrollment date is unknown
+func yearsEnrolled(record types.StudentRecord, now time.Time) (math.LegacyDec, error) {
+	if record.EnrollmentDate == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	enrolledAt, err := time.Parse(time.RFC3339, record.EnrollmentDate)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid enrollment date '%s' for student '%s': %w", record.EnrollmentDate, record.StudentId, err)
+	}
+	hours := int64(now.Sub(enrolledAt) / time.Hour)
+	if hours < 0 {
+		hours = 0
+	}
+	return math.LegacyNewDec(hours).QuoInt64(hoursPerYear), nil
+}
+
+// ratio returns done over required, one when nothing is required
+func ratio(done uint64, required uint64) math.LegacyDec {
+	if required == 0 {
+		return math.LegacyOneDec()
+	}
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(done)).QuoInt(math.NewIntFromUint64(required))
+}
+
+// formatDec formats a decimal rounded to two decimals
+func formatDec(d math.LegacyDec) string {
+	hundredths := d.MulInt64(100).RoundInt64()
+	return fmt.Sprintf("%d.%02d", hundredths/100, hundredths%100)
+}
+
+// missingSubjects returns the subjects of required that are not completed
+func missingSubjects(required []string, completed map[string]bool) []string {
+	var missing []string
+	for _, subjectId := range required {
+		if !completed[subjectId] {
+			missing = append(missing, subjectId)
+		}
+	}
+	return missing
+}
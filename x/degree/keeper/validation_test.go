@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/degree/keeper"
+	"academictoken/x/degree/types"
+	institutiontypes "academictoken/x/institution/types"
+)
+
+func TestValidateDegreeRequirements(t *testing.T) {
+	curricula := map[string]types.CurriculumRequirements{
+		"curriculum-1": {Id: "curriculum-1", CourseId: "course-1", Version: "v1", TotalCredits: 10},
+		"curriculum-2": {
+			Id:                 "curriculum-2",
+			CourseId:           "course-1",
+			Version:            "v2",
+			RequiredSubjects:   []string{"a", "b"},
+			ElectiveSubjects:   []string{"e1", "e2", "e3"},
+			ElectiveMin:        1,
+			ElectiveGroups:     []types.ElectiveGroupRequirement{{GroupId: "g1", SubjectIds: []string{"e1", "e2"}, CreditsRequired: 8}},
+			TotalCredits:       20,
+			ElectiveCredits:    4,
+			RequiredActivities: []string{"internship"},
+			MinGPA:             "3.0",
+			MinimumYears:       "4",
+			MaximumYears:       "6",
+		},
+	}
+	record := types.StudentRecord{
+		StudentId:         "student-1",
+		CourseId:          "course-1",
+		CurriculumVersion: "v2",
+		EnrollmentDate:    "2021-02-01T00:00:00Z",
+		GPA:               "3.20",
+		Subjects: []types.CompletedSubject{
+			{SubjectId: "a", Credits: 6},
+			{SubjectId: "b", Credits: 6},
+			{SubjectId: "e1", Credits: 4, Transferred: true},
+		},
+	}
+	records := map[string]types.StudentRecord{"student-1": record}
+
+	creator := sample.AccAddress()
+	registrarKey := secp256k1.GenPrivKey()
+	signingKeys := []institutiontypes.SigningKey{
+		{Institution: "inst-1", KeyId: "registrar-key", Owner: creator, Algorithm: institutiontypes.SigningAlgorithmSecp256k1, PubKey: registrarKey.PubKey().Bytes(), RegisteredAt: "2020-01-01T00:00:00Z"},
+	}
+	sign := func(finalGpa string, totalCredits uint64) string {
+		sig, err := registrarKey.Sign(types.DegreeSignBytes("student-1", "inst-1", "curriculum-2", finalGpa, totalCredits))
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+
+	k, ctx := keepertest.DegreeKeeperWithSigningKeys(t, records, curricula, signingKeys)
+	ctx = ctx.WithBlockTime(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+	srv := keeper.NewMsgServerImpl(*k)
+
+	// The request names the curriculum the student did not enroll under
+	k.SetDegreeRequest(ctx, types.DegreeRequest{Id: "1", StudentId: "student-1", InstitutionId: "inst-1", CurriculumId: "curriculum-1", Status: types.DegreeRequestStatusPending})
+	res, err := srv.ValidateDegreeRequirements(ctx, &types.MsgValidateDegreeRequirements{Creator: creator, DegreeRequestId: "1"})
+	require.NoError(t, err)
+	require.False(t, res.ValidationPassed)
+
+	requirements := make(map[string]*types.MissingRequirement)
+	for _, missing := range res.MissingRequirements {
+		requirements[missing.Requirement] = missing
+	}
+	require.Len(t, requirements, 4)
+	require.Equal(t, &types.MissingRequirement{
+		Requirement: "elective_group_credits",
+		Description: "4 of 8 credits of elective group 'g1' completed",
+		Required:    "8",
+		Completed:   "4",
+		SubjectIds:  []string{"e2"},
+	}, requirements["elective_group_credits"])
+	require.Equal(t, "16", requirements["total_credits"].Completed)
+	require.Equal(t, []string{"internship"}, requirements["required_activities"].SubjectIds)
+	require.Equal(t, "curriculum-2", requirements["curriculum"].Completed)
+	// Progress 1 + 1 + 0.5 + 1 + 0.8 + 1 + 0 + 1 + 1 + 0 over 10 requirements
+	require.Equal(t, "73", res.ValidationScore)
+
+	request, found := k.GetDegreeRequest(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.DegreeRequestStatusValidationFailed, request.Status)
+	require.Equal(t, "73", request.ValidationScore)
+
+	// The student module evaluates graduation with the same rules
+	validation, err := k.ValidateDegreeRequest(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, "curriculum-2", validation.CurriculumId)
+	require.Len(t, validation.RequirementsMet, 6)
+	require.Contains(t, validation.RequirementsMet, "min_gpa")
+	require.Equal(t, res.MissingRequirements, request.MissingRequirements)
+	require.Contains(t, request.ValidationDetails, "required_activities: 1 required activities not completed")
+
+	_, err = srv.IssueDegree(ctx, &types.MsgIssueDegree{Creator: creator, DegreeRequestId: "1", FinalGpa: "3.20", TotalCredits: 16})
+	require.ErrorIs(t, err, types.ErrDegreeValidationPending)
+
+	// Past the maximum time the requirement fails even once everything else is met
+	record.Subjects = append(record.Subjects, types.CompletedSubject{SubjectId: "e2", Credits: 4}, types.CompletedSubject{SubjectId: "internship"})
+	records["student-1"] = record
+	k.SetDegreeRequest(ctx, types.DegreeRequest{Id: "2", StudentId: "student-1", InstitutionId: "inst-1", CurriculumId: "curriculum-2", Status: types.DegreeRequestStatusPending})
+	late := ctx.WithBlockTime(time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC))
+	res, err = srv.ValidateDegreeRequirements(late, &types.MsgValidateDegreeRequirements{Creator: creator, DegreeRequestId: "2"})
+	require.NoError(t, err)
+	require.False(t, res.ValidationPassed)
+	require.Len(t, res.MissingRequirements, 1)
+	require.Equal(t, "maximum_time_years", res.MissingRequirements[0].Requirement)
+	require.Equal(t, "6.33", res.MissingRequirements[0].Completed)
+
+	res, err = srv.ValidateDegreeRequirements(ctx, &types.MsgValidateDegreeRequirements{Creator: creator, DegreeRequestId: "2"})
+	require.NoError(t, err)
+	require.True(t, res.ValidationPassed)
+	require.Equal(t, "100", res.ValidationScore)
+	require.Empty(t, res.MissingRequirements)
+
+	// Signatures must match the degree and a signing key of the institution
+	issue := func(signatures ...string) (*types.MsgIssueDegreeResponse, error) {
+		return srv.IssueDegree(ctx, &types.MsgIssueDegree{Creator: creator, DegreeRequestId: "2", FinalGpa: "3.20", TotalCredits: 20, Signatures: signatures})
+	}
+	_, err = issue()
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	_, err = issue(sign("3.90", 20))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	_, err = issue(sign("3.20", 20), sign("3.20", 20))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	issued, err := issue(sign("3.20", 20))
+	require.NoError(t, err)
+	degree, found := k.GetDegree(ctx, issued.DegreeId)
+	require.True(t, found)
+	require.Equal(t, "100", degree.ValidationScore)
+	require.Equal(t, []string{"registrar-key"}, degree.SigningKeyIds)
+
+	verified, err := k.VerifyDegree(ctx, &types.QueryVerifyDegreeRequest{Index: issued.DegreeId})
+	require.NoError(t, err)
+	require.True(t, verified.SignaturesValid)
+	require.Equal(t, []types.DegreeSignatureStatus{{SigningKeyId: "registrar-key", Signer: creator, SignatureValid: true, KeyValidAtIssuance: true}}, verified.Signatures)
+
+	// Students without an academic record fail validation
+	k.SetDegreeRequest(ctx, types.DegreeRequest{Id: "3", StudentId: "student-2", InstitutionId: "inst-1", CurriculumId: "curriculum-2", Status: types.DegreeRequestStatusPending})
+	res, err = srv.ValidateDegreeRequirements(ctx, &types.MsgValidateDegreeRequirements{Creator: creator, DegreeRequestId: "3"})
+	require.NoError(t, err)
+	require.False(t, res.ValidationPassed)
+	require.Equal(t, "academic_record", res.MissingRequirements[0].Requirement)
+}
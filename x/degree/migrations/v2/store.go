@@ -0,0 +1,27 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/degree/types"
+)
+
+// MigrateStore indexes the degree requests stored by v1 under their student,
+// so that they can be looked up without scanning every request.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DegreeRequestPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.DegreeRequest
+		if err := cdc.Unmarshal(iterator.Value(), &request); err != nil {
+			return err
+		}
+		store.Set(types.DegreeRequestByStudentKey(request.StudentId, request.Id), []byte(request.Id))
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package v2_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "academictoken/x/degree/migrations/v2"
+	"academictoken/x/degree/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// v1 stored degree requests without the by-student index
+	request := types.DegreeRequest{Id: "0", StudentId: "student-1", InstitutionId: "inst-1"}
+	ctx.KVStore(storeKey).Set(append(types.DegreeRequestPrefix, []byte(request.Id)...), cdc.MustMarshal(&request))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+	require.Equal(t, []byte("0"), ctx.KVStore(storeKey).Get(types.DegreeRequestByStudentKey("student-1", "0")))
+}
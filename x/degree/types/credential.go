@@ -0,0 +1,55 @@
+package types
+
+import (
+	"academictoken/x/academicnft/credential"
+)
+
+// DegreeCredentialSubject is the credentialSubject of a degree credential
+type DegreeCredentialSubject struct {
+	ID                string   `json:"id"`
+	DegreeID          string   `json:"degreeId"`
+	CourseID          string   `json:"courseId"`
+	CurriculumVersion string   `json:"curriculumVersion,omitempty"`
+	FinalGrade        string   `json:"finalGrade,omitempty"`
+	TotalCredits      uint64   `json:"totalCredits,omitempty"`
+	ValidationScore   string   `json:"validationScore,omitempty"`
+	ValidationHash    string   `json:"validationHash,omitempty"`
+	SubjectTokens     []string `json:"subjectTokens,omitempty"`
+	IPFSLink          string   `json:"ipfsLink,omitempty"`
+	NftTokenID        string   `json:"nftTokenId,omitempty"`
+}
+
+// NewDegreeCredential renders a degree as a verifiable credential anchored to
+// the stored record. It only depends on its arguments, so verifiers can
+// render the anchored record again and compare.
+func NewDegreeCredential(degree Degree, issuerName string, anchor credential.Anchor) (credential.Credential, error) {
+	chainID := anchor.ChainID
+	id := credential.URN(chainID, "degree", degree.Index)
+
+	subject := DegreeCredentialSubject{
+		ID:                credential.URN(chainID, "student", degree.Student),
+		DegreeID:          degree.DegreeId,
+		CourseID:          degree.CourseId,
+		CurriculumVersion: degree.CurriculumVersion,
+		FinalGrade:        degree.FinalGrade,
+		TotalCredits:      degree.TotalCredits,
+		ValidationScore:   degree.ValidationScore,
+		ValidationHash:    degree.ValidationHash,
+		SubjectTokens:     degree.TokenInstances,
+		IPFSLink:          degree.IpfsLink,
+		NftTokenID:        degree.NftTokenId,
+	}
+
+	degreeStatus := degree.Status
+	if degreeStatus == "" {
+		degreeStatus = "issued"
+	}
+	status := credential.Status{ID: id + "#status", Type: credential.StatusTypeRecord, Status: degreeStatus}
+
+	issuer := credential.Issuer{
+		ID:   credential.URN(chainID, "institution", degree.Institution),
+		Name: issuerName,
+	}
+
+	return credential.New(credential.TypeDegreeCredential, id, issuer, credential.IssuanceDate(degree.IssueDate, anchor.Created), subject, status, anchor)
+}
@@ -36,11 +36,12 @@ type Degree struct {
 	ValidationHash    string   `protobuf:"bytes,11,opt,name=validationHash,proto3" json:"validationHash,omitempty"`
 	IpfsLink          string   `protobuf:"bytes,12,opt,name=ipfsLink,proto3" json:"ipfsLink,omitempty"`
 	// Additional fields for enhanced functionality
-	Status          string `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
-	NftTokenId      string `protobuf:"bytes,14,opt,name=nftTokenId,proto3" json:"nftTokenId,omitempty"`
-	TotalCredits    uint64 `protobuf:"varint,15,opt,name=totalCredits,proto3" json:"totalCredits,omitempty"`
-	ValidationScore string `protobuf:"bytes,16,opt,name=validationScore,proto3" json:"validationScore,omitempty"`
-	ContractAddress string `protobuf:"bytes,17,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Status          string   `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	NftTokenId      string   `protobuf:"bytes,14,opt,name=nftTokenId,proto3" json:"nftTokenId,omitempty"`
+	TotalCredits    uint64   `protobuf:"varint,15,opt,name=totalCredits,proto3" json:"totalCredits,omitempty"`
+	ValidationScore string   `protobuf:"bytes,16,opt,name=validationScore,proto3" json:"validationScore,omitempty"`
+	ContractAddress string   `protobuf:"bytes,17,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	SigningKeyIds   []string `protobuf:"bytes,18,rep,name=signingKeyIds,proto3" json:"signingKeyIds,omitempty"`
 }
 
 func (m *Degree) Reset()         { *m = Degree{} }
@@ -195,6 +196,13 @@ func (m *Degree) GetContractAddress() string {
 	return ""
 }
 
+func (m *Degree) GetSigningKeyIds() []string {
+	if m != nil {
+		return m.SigningKeyIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Degree)(nil), "academictoken.degree.Degree")
 }
@@ -202,33 +210,34 @@ func init() {
 func init() { proto.RegisterFile("academictoken/degree/degree.proto", fileDescriptor_9b254e14ba7376f7) }
 
 var fileDescriptor_9b254e14ba7376f7 = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6a, 0x14, 0x41,
-	0x10, 0x86, 0x77, 0xcc, 0x66, 0x93, 0xe9, 0xc4, 0xc4, 0x34, 0x41, 0x0a, 0x09, 0xc3, 0x98, 0x83,
-	0xcc, 0x41, 0xe2, 0x41, 0xf0, 0xae, 0x06, 0x34, 0xe0, 0x69, 0x15, 0x0f, 0xde, 0xda, 0xee, 0xda,
-	0xd8, 0x64, 0xd2, 0xbd, 0x74, 0x55, 0x4b, 0x7c, 0x0b, 0x9f, 0xc7, 0x27, 0xf0, 0x98, 0xa3, 0x47,
-	0xd9, 0x7d, 0x11, 0xe9, 0x9e, 0x8c, 0xbb, 0x3b, 0x39, 0xcd, 0xfc, 0xdf, 0xff, 0xf3, 0x77, 0x15,
-	0x94, 0x78, 0xaa, 0xb4, 0x32, 0x78, 0x6d, 0x35, 0xfb, 0x2b, 0x74, 0x2f, 0x0c, 0x5e, 0x06, 0xc4,
-	0xbb, 0xcf, 0xd9, 0x3c, 0x78, 0xf6, 0xf2, 0x78, 0x23, 0x72, 0xd6, 0x79, 0xa7, 0xbf, 0xc6, 0x62,
-	0x72, 0x9e, 0x7f, 0xe5, 0xb1, 0xd8, 0xb6, 0xce, 0xe0, 0x0d, 0x14, 0x75, 0xd1, 0x94, 0xd3, 0x4e,
-	0xc8, 0x27, 0x62, 0xb7, 0x8b, 0x5e, 0x18, 0x78, 0x90, 0x8d, 0xff, 0x5a, 0x82, 0xd8, 0x21, 0x8e,
-	0x06, 0x1d, 0xc3, 0x56, 0xb6, 0x7a, 0x29, 0x6b, 0xb1, 0x67, 0x1d, 0xb1, 0xe5, 0xc8, 0xd6, 0x3b,
-	0x18, 0x67, 0x77, 0x1d, 0xa5, 0x5e, 0xed, 0x63, 0xa0, 0xd4, 0xbb, 0xdd, 0xf5, 0xf6, 0x5a, 0x3e,
-	0x17, 0x47, 0x3a, 0x86, 0x60, 0x75, 0x6c, 0xe3, 0xf5, 0x67, 0x0c, 0x94, 0x3a, 0x26, 0x39, 0x74,
-	0xdf, 0x90, 0x27, 0xa2, 0xb4, 0x44, 0x11, 0xcf, 0x15, 0x23, 0xec, 0xe4, 0xd4, 0x0a, 0xc8, 0x67,
-	0xe2, 0x20, 0x2f, 0x7c, 0xe1, 0x88, 0x95, 0xd3, 0x48, 0xb0, 0x5b, 0x6f, 0x35, 0xe5, 0x74, 0x40,
-	0x65, 0x25, 0xc4, 0xcc, 0x3a, 0xd5, 0xbe, 0x0b, 0xca, 0x20, 0x94, 0xb9, 0x66, 0x8d, 0x24, 0x9f,
-	0xec, 0xa5, 0x53, 0x1c, 0x03, 0x12, 0x88, 0xdc, 0xb1, 0x46, 0xd2, 0x3b, 0xdf, 0x55, 0x6b, 0x8d,
-	0x4a, 0xdb, 0xbd, 0x57, 0xf4, 0x0d, 0xf6, 0x72, 0xc7, 0x80, 0xa6, 0xbd, 0xed, 0x7c, 0x46, 0x1f,
-	0xac, 0xbb, 0x82, 0xfd, 0x6e, 0xef, 0x5e, 0xcb, 0xc7, 0x62, 0x42, 0xac, 0x38, 0x12, 0x3c, 0xcc,
-	0xce, 0x9d, 0x4a, 0x6f, 0xbb, 0x19, 0x7f, 0xca, 0x03, 0x1b, 0x38, 0xe8, 0x66, 0x5b, 0x11, 0x79,
-	0x2a, 0xf6, 0xd9, 0xb3, 0x6a, 0xdf, 0x06, 0x34, 0x96, 0x09, 0x0e, 0xeb, 0xa2, 0x19, 0x4f, 0x37,
-	0x98, 0x6c, 0xc4, 0xe1, 0x6a, 0x92, 0x8f, 0xda, 0x07, 0x84, 0x47, 0xb9, 0x68, 0x88, 0x53, 0x52,
-	0x7b, 0xc7, 0x41, 0x69, 0x7e, 0x6d, 0x4c, 0x40, 0x22, 0x38, 0xea, 0x92, 0x03, 0xfc, 0xe6, 0xd5,
-	0xef, 0x45, 0x55, 0xdc, 0x2e, 0xaa, 0xe2, 0xef, 0xa2, 0x2a, 0x7e, 0x2e, 0xab, 0xd1, 0xed, 0xb2,
-	0x1a, 0xfd, 0x59, 0x56, 0xa3, 0x2f, 0x27, 0x9b, 0xf7, 0x78, 0xd3, 0x5f, 0x24, 0xff, 0x98, 0x23,
-	0x7d, 0x9d, 0xe4, 0x8b, 0x7c, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x79, 0x24, 0x8e, 0xb6,
-	0x02, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcd, 0x6e, 0x53, 0x31,
+	0x10, 0x85, 0x73, 0x69, 0x9a, 0x36, 0xd3, 0x3f, 0x6a, 0x55, 0x68, 0x84, 0xaa, 0xab, 0x50, 0x21,
+	0x94, 0x05, 0x2a, 0x0b, 0x24, 0xf6, 0x40, 0x25, 0x88, 0x60, 0x15, 0x10, 0x0b, 0x76, 0xc6, 0x9e,
+	0x04, 0xab, 0xa9, 0x1d, 0x79, 0xc6, 0xa8, 0x7d, 0x0b, 0x1e, 0x8b, 0x65, 0x97, 0x2c, 0xab, 0xe4,
+	0x45, 0x90, 0x7d, 0x1b, 0xf2, 0xd3, 0xd5, 0xbd, 0xe7, 0x3b, 0x47, 0xc7, 0x1e, 0x6b, 0xe0, 0x99,
+	0x36, 0xda, 0xd2, 0x95, 0x33, 0x12, 0x2e, 0xc9, 0xbf, 0xb2, 0x34, 0x8e, 0x44, 0xf7, 0x9f, 0xf3,
+	0x69, 0x0c, 0x12, 0xd4, 0xc9, 0x5a, 0xe4, 0xbc, 0xf1, 0xce, 0xee, 0xda, 0xd0, 0xb9, 0x28, 0xbf,
+	0xea, 0x04, 0xb6, 0x9d, 0xb7, 0x74, 0x8d, 0x55, 0xaf, 0xea, 0x77, 0x87, 0x8d, 0x50, 0x4f, 0x61,
+	0xb7, 0x89, 0x0e, 0x2c, 0x3e, 0x2a, 0xc6, 0x7f, 0xad, 0x10, 0x76, 0x58, 0x92, 0x25, 0x2f, 0xb8,
+	0x55, 0xac, 0x85, 0x54, 0x3d, 0xd8, 0x73, 0x9e, 0xc5, 0x49, 0x12, 0x17, 0x3c, 0xb6, 0x8b, 0xbb,
+	0x8a, 0x72, 0xaf, 0x09, 0x29, 0x72, 0xee, 0xdd, 0x6e, 0x7a, 0x17, 0x5a, 0xbd, 0x84, 0x63, 0x93,
+	0x62, 0x74, 0x26, 0x4d, 0xd2, 0xd5, 0x37, 0x8a, 0x9c, 0x3b, 0x3a, 0x25, 0xf4, 0xd0, 0x50, 0xa7,
+	0xd0, 0x75, 0xcc, 0x89, 0x2e, 0xb4, 0x10, 0xee, 0x94, 0xd4, 0x12, 0xa8, 0x17, 0x70, 0x58, 0x06,
+	0x1e, 0x78, 0x16, 0xed, 0x0d, 0x31, 0xee, 0xf6, 0xb6, 0xfa, 0xdd, 0xe1, 0x06, 0x55, 0x35, 0xc0,
+	0xc8, 0x79, 0x3d, 0xf9, 0x10, 0xb5, 0x25, 0xec, 0x96, 0x9a, 0x15, 0x92, 0x7d, 0x76, 0x63, 0xaf,
+	0x25, 0x45, 0x62, 0x84, 0xd2, 0xb1, 0x42, 0xf2, 0x39, 0xbf, 0xf4, 0xc4, 0x59, 0x9d, 0xa7, 0xfb,
+	0xa8, 0xf9, 0x27, 0xee, 0x95, 0x8e, 0x0d, 0x9a, 0xe7, 0x76, 0xd3, 0x11, 0x7f, 0x76, 0xfe, 0x12,
+	0xf7, 0x9b, 0xb9, 0x17, 0x5a, 0x3d, 0x81, 0x0e, 0x8b, 0x96, 0xc4, 0x78, 0x50, 0x9c, 0x7b, 0x95,
+	0xcf, 0xf6, 0x23, 0xf9, 0x5a, 0x2e, 0x6c, 0xf1, 0xb0, 0xb9, 0xdb, 0x92, 0xa8, 0x33, 0xd8, 0x97,
+	0x20, 0x7a, 0xf2, 0x3e, 0x92, 0x75, 0xc2, 0x78, 0xd4, 0xab, 0xfa, 0xed, 0xe1, 0x1a, 0x53, 0x7d,
+	0x38, 0x5a, 0xde, 0xe4, 0x8b, 0x09, 0x91, 0xf0, 0x71, 0x29, 0xda, 0xc4, 0x39, 0x69, 0x82, 0x97,
+	0xa8, 0x8d, 0xbc, 0xb5, 0x36, 0x12, 0x33, 0x1e, 0x37, 0xc9, 0x0d, 0xac, 0x9e, 0xc3, 0x41, 0x7e,
+	0x01, 0xe7, 0xc7, 0x9f, 0xe8, 0x66, 0x60, 0x19, 0x55, 0x79, 0x96, 0x75, 0xf8, 0xee, 0xcd, 0x9f,
+	0x59, 0x5d, 0xdd, 0xce, 0xea, 0xea, 0x6e, 0x56, 0x57, 0xbf, 0xe7, 0x75, 0xeb, 0x76, 0x5e, 0xb7,
+	0xfe, 0xce, 0xeb, 0xd6, 0xf7, 0xd3, 0xf5, 0xad, 0xbd, 0x5e, 0xec, 0xad, 0xdc, 0x4c, 0x89, 0x7f,
+	0x74, 0xca, 0xde, 0xbe, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x6b, 0x15, 0xaa, 0xdc, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Degree) Marshal() (dAtA []byte, err error) {
@@ -251,6 +260,17 @@ func (m *Degree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SigningKeyIds) > 0 {
+		for iNdEx := len(m.SigningKeyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigningKeyIds[iNdEx])
+			copy(dAtA[i:], m.SigningKeyIds[iNdEx])
+			i = encodeVarintDegree(dAtA, i, uint64(len(m.SigningKeyIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.ContractAddress) > 0 {
 		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
@@ -467,6 +487,12 @@ func (m *Degree) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovDegree(uint64(l))
 	}
+	if len(m.SigningKeyIds) > 0 {
+		for _, s := range m.SigningKeyIds {
+			l = len(s)
+			n += 2 + l + sovDegree(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1036,6 +1062,38 @@ func (m *Degree) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDegree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDegree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDegree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyIds = append(m.SigningKeyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDegree(dAtA[iNdEx:])
@@ -23,17 +23,17 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type DegreeRequest struct {
-	Id                     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator                string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	StudentId              string   `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	InstitutionId          string   `protobuf:"bytes,4,opt,name=institution_id,json=institutionId,proto3" json:"institution_id,omitempty"`
-	CurriculumId           string   `protobuf:"bytes,5,opt,name=curriculum_id,json=curriculumId,proto3" json:"curriculum_id,omitempty"`
-	ExpectedGraduationDate string   `protobuf:"bytes,6,opt,name=expected_graduation_date,json=expectedGraduationDate,proto3" json:"expected_graduation_date,omitempty"`
-	RequestDate            string   `protobuf:"bytes,7,opt,name=request_date,json=requestDate,proto3" json:"request_date,omitempty"`
-	Status                 string   `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	ValidationScore        string   `protobuf:"bytes,9,opt,name=validation_score,json=validationScore,proto3" json:"validation_score,omitempty"`
-	ValidationDetails      string   `protobuf:"bytes,10,opt,name=validation_details,json=validationDetails,proto3" json:"validation_details,omitempty"`
-	MissingRequirements    []string `protobuf:"bytes,11,rep,name=missing_requirements,json=missingRequirements,proto3" json:"missing_requirements,omitempty"`
+	Id                     string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator                string                `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	StudentId              string                `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	InstitutionId          string                `protobuf:"bytes,4,opt,name=institution_id,json=institutionId,proto3" json:"institution_id,omitempty"`
+	CurriculumId           string                `protobuf:"bytes,5,opt,name=curriculum_id,json=curriculumId,proto3" json:"curriculum_id,omitempty"`
+	ExpectedGraduationDate string                `protobuf:"bytes,6,opt,name=expected_graduation_date,json=expectedGraduationDate,proto3" json:"expected_graduation_date,omitempty"`
+	RequestDate            string                `protobuf:"bytes,7,opt,name=request_date,json=requestDate,proto3" json:"request_date,omitempty"`
+	Status                 string                `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ValidationScore        string                `protobuf:"bytes,9,opt,name=validation_score,json=validationScore,proto3" json:"validation_score,omitempty"`
+	ValidationDetails      string                `protobuf:"bytes,10,opt,name=validation_details,json=validationDetails,proto3" json:"validation_details,omitempty"`
+	MissingRequirements    []*MissingRequirement `protobuf:"bytes,12,rep,name=missing_requirements,json=missingRequirements,proto3" json:"missing_requirements,omitempty"`
 }
 
 func (m *DegreeRequest) Reset()         { *m = DegreeRequest{} }
@@ -139,15 +139,97 @@ func (m *DegreeRequest) GetValidationDetails() string {
 	return ""
 }
 
-func (m *DegreeRequest) GetMissingRequirements() []string {
+func (m *DegreeRequest) GetMissingRequirements() []*MissingRequirement {
 	if m != nil {
 		return m.MissingRequirements
 	}
 	return nil
 }
 
+// MissingRequirement is a graduation requirement a degree request does not meet
+type MissingRequirement struct {
+	// requirement names the requirement, e.g. required_subjects or min_gpa
+	Requirement string `protobuf:"bytes,1,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// required and completed are the amounts the requirement compares, in its
+	// own unit: subjects, credits, grade points or years
+	Required  string `protobuf:"bytes,3,opt,name=required,proto3" json:"required,omitempty"`
+	Completed string `protobuf:"bytes,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	// subject_ids lists the subjects or activities still to be completed
+	SubjectIds []string `protobuf:"bytes,5,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
+}
+
+func (m *MissingRequirement) Reset()         { *m = MissingRequirement{} }
+func (m *MissingRequirement) String() string { return proto.CompactTextString(m) }
+func (*MissingRequirement) ProtoMessage()    {}
+func (*MissingRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07daacb6c13072d5, []int{1}
+}
+func (m *MissingRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissingRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissingRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissingRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissingRequirement.Merge(m, src)
+}
+func (m *MissingRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissingRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissingRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissingRequirement proto.InternalMessageInfo
+
+func (m *MissingRequirement) GetRequirement() string {
+	if m != nil {
+		return m.Requirement
+	}
+	return ""
+}
+
+func (m *MissingRequirement) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MissingRequirement) GetRequired() string {
+	if m != nil {
+		return m.Required
+	}
+	return ""
+}
+
+func (m *MissingRequirement) GetCompleted() string {
+	if m != nil {
+		return m.Completed
+	}
+	return ""
+}
+
+func (m *MissingRequirement) GetSubjectIds() []string {
+	if m != nil {
+		return m.SubjectIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DegreeRequest)(nil), "academictoken.degree.DegreeRequest")
+	proto.RegisterType((*MissingRequirement)(nil), "academictoken.degree.MissingRequirement")
 }
 
 func init() {
@@ -155,30 +237,35 @@ func init() {
 }
 
 var fileDescriptor_07daacb6c13072d5 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xcd, 0x4e, 0xea, 0x40,
-	0x14, 0x80, 0x29, 0xdc, 0x0b, 0xb7, 0x87, 0x9f, 0x7b, 0xef, 0x48, 0xc8, 0x2c, 0xb4, 0x41, 0x8d,
-	0x09, 0x2c, 0xc4, 0x18, 0x13, 0xe3, 0xda, 0x90, 0x18, 0xb6, 0x75, 0xe7, 0x86, 0x8c, 0x33, 0x27,
-	0x64, 0x22, 0x6d, 0x71, 0xe6, 0x8c, 0xc1, 0xb7, 0xf0, 0x29, 0x7c, 0x16, 0x97, 0x2c, 0x5d, 0x1a,
-	0x78, 0x11, 0xc3, 0xb4, 0x08, 0xac, 0xda, 0xf9, 0xbe, 0x2f, 0x6d, 0x32, 0xe7, 0x40, 0x5f, 0x48,
-	0xa1, 0x30, 0xd1, 0x92, 0xb2, 0x27, 0x4c, 0x2f, 0x14, 0x4e, 0x0c, 0x62, 0xf1, 0x18, 0x1b, 0x7c,
-	0x76, 0x68, 0x69, 0x30, 0x33, 0x19, 0x65, 0xac, 0xbd, 0x97, 0x0e, 0xf2, 0xe6, 0xe4, 0xbd, 0x02,
-	0xcd, 0xa1, 0x7f, 0x8d, 0xf3, 0x9a, 0xb5, 0xa0, 0xac, 0x15, 0x0f, 0xba, 0x41, 0x2f, 0x8c, 0xcb,
-	0x5a, 0x31, 0x0e, 0x35, 0x69, 0x50, 0x50, 0x66, 0x78, 0xd9, 0xc3, 0xcd, 0x91, 0x1d, 0x01, 0x58,
-	0x72, 0x0a, 0x53, 0x1a, 0x6b, 0xc5, 0x2b, 0x5e, 0x86, 0x05, 0x19, 0x29, 0x76, 0x06, 0x2d, 0x9d,
-	0x5a, 0xd2, 0xe4, 0x48, 0x67, 0xe9, 0x3a, 0xf9, 0xe5, 0x93, 0xe6, 0x0e, 0x1d, 0x29, 0x76, 0x0a,
-	0x4d, 0xe9, 0x8c, 0xd1, 0xd2, 0x4d, 0x5d, 0xb2, 0xae, 0x7e, 0xfb, 0xaa, 0xb1, 0x85, 0x23, 0xc5,
-	0x6e, 0x80, 0xe3, 0x7c, 0x86, 0x92, 0x50, 0x8d, 0x27, 0x46, 0x28, 0x27, 0xfc, 0x37, 0x95, 0x20,
-	0xe4, 0x55, 0xdf, 0x77, 0x36, 0xfe, 0xee, 0x47, 0x0f, 0x05, 0x21, 0x3b, 0x86, 0x46, 0x71, 0x0f,
-	0x79, 0x5d, 0xf3, 0x75, 0xbd, 0x60, 0x3e, 0xe9, 0x40, 0xd5, 0x92, 0x20, 0x67, 0xf9, 0x1f, 0x2f,
-	0x8b, 0x13, 0xeb, 0xc3, 0xbf, 0x17, 0x31, 0xd5, 0x2a, 0xff, 0x97, 0x95, 0x99, 0x41, 0x1e, 0xfa,
-	0xe2, 0xef, 0x96, 0xdf, 0xaf, 0x31, 0x3b, 0x07, 0xb6, 0x93, 0x2a, 0x24, 0xa1, 0xa7, 0x96, 0x83,
-	0x8f, 0xff, 0x6f, 0xcd, 0x30, 0x17, 0xec, 0x12, 0xda, 0x89, 0xb6, 0x56, 0xa7, 0x13, 0x3f, 0x24,
-	0x6d, 0x30, 0xc1, 0x94, 0x2c, 0xaf, 0x77, 0x2b, 0xbd, 0x30, 0x3e, 0x28, 0x5c, 0xbc, 0xa3, 0x6e,
-	0xaf, 0x3f, 0x96, 0x51, 0xb0, 0x58, 0x46, 0xc1, 0xd7, 0x32, 0x0a, 0xde, 0x56, 0x51, 0x69, 0xb1,
-	0x8a, 0x4a, 0x9f, 0xab, 0xa8, 0xf4, 0x70, 0xb8, 0xbf, 0x03, 0xf3, 0xcd, 0x16, 0xd0, 0xeb, 0x0c,
-	0xed, 0x63, 0xd5, 0x4f, 0xff, 0xea, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x8a, 0x34, 0x8f, 0x2a,
-	0x02, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xa4, 0x4d, 0xe3, 0x71, 0x52, 0xca, 0x52, 0x55, 0x2b, 0x54, 0x4c, 0x28, 0x42,
+	0x4a, 0x0f, 0x04, 0x09, 0x24, 0xc4, 0x19, 0x45, 0x42, 0x41, 0xe2, 0x62, 0x6e, 0x70, 0x88, 0xb6,
+	0xbb, 0xa3, 0x68, 0x21, 0xfe, 0xc3, 0xee, 0x18, 0x95, 0xb7, 0xe0, 0x5d, 0x78, 0x09, 0x8e, 0x3d,
+	0x21, 0x8e, 0x28, 0x79, 0x11, 0xe4, 0xf5, 0xba, 0x76, 0xd5, 0x9e, 0x92, 0xfd, 0x7d, 0xbf, 0xf1,
+	0x5a, 0xfe, 0x06, 0xce, 0x85, 0x14, 0x0a, 0x53, 0x2d, 0x29, 0xff, 0x8a, 0xd9, 0x0b, 0x85, 0x6b,
+	0x83, 0xe8, 0x7f, 0x56, 0x06, 0xbf, 0x95, 0x68, 0x69, 0x5e, 0x98, 0x9c, 0x72, 0x76, 0x7c, 0x43,
+	0x9d, 0xd7, 0xce, 0xd9, 0x9f, 0x01, 0x4c, 0x16, 0xee, 0x6f, 0x52, 0xdb, 0xec, 0x10, 0xfa, 0x5a,
+	0xf1, 0x60, 0x1a, 0xcc, 0xc2, 0xa4, 0xaf, 0x15, 0xe3, 0x70, 0x20, 0x0d, 0x0a, 0xca, 0x0d, 0xef,
+	0x3b, 0xd8, 0x1c, 0xd9, 0x23, 0x00, 0x4b, 0xa5, 0xc2, 0x8c, 0x56, 0x5a, 0xf1, 0x81, 0x0b, 0x43,
+	0x4f, 0x96, 0x8a, 0x3d, 0x83, 0x43, 0x9d, 0x59, 0xd2, 0x54, 0x92, 0xce, 0xb3, 0x4a, 0xd9, 0x73,
+	0xca, 0xa4, 0x43, 0x97, 0x8a, 0x3d, 0x85, 0x89, 0x2c, 0x8d, 0xd1, 0xb2, 0xdc, 0x94, 0x69, 0x65,
+	0xed, 0x3b, 0x6b, 0xdc, 0xc2, 0xa5, 0x62, 0x6f, 0x80, 0xe3, 0x65, 0x81, 0x92, 0x50, 0xad, 0xd6,
+	0x46, 0xa8, 0x52, 0xb8, 0x67, 0x2a, 0x41, 0xc8, 0x87, 0xce, 0x3f, 0x69, 0xf2, 0x77, 0xd7, 0xf1,
+	0x42, 0x10, 0xb2, 0x27, 0x30, 0xf6, 0xdf, 0xa1, 0xb6, 0x0f, 0x9c, 0x1d, 0x79, 0xe6, 0x94, 0x13,
+	0x18, 0x5a, 0x12, 0x54, 0x5a, 0x3e, 0x72, 0xa1, 0x3f, 0xb1, 0x73, 0x38, 0xfa, 0x2e, 0x36, 0x5a,
+	0xd5, 0x77, 0x59, 0x99, 0x1b, 0xe4, 0xa1, 0x33, 0xee, 0xb5, 0xfc, 0x63, 0x85, 0xd9, 0x73, 0x60,
+	0x1d, 0x55, 0x21, 0x09, 0xbd, 0xb1, 0x1c, 0x9c, 0x7c, 0xbf, 0x4d, 0x16, 0x75, 0xc0, 0x3e, 0xc3,
+	0x71, 0xaa, 0xad, 0xd5, 0xd9, 0xda, 0x95, 0xa4, 0x0d, 0xa6, 0x98, 0x91, 0xe5, 0xe3, 0xe9, 0x60,
+	0x16, 0xbd, 0x9c, 0xcd, 0xef, 0xaa, 0x6a, 0xfe, 0xa1, 0x9e, 0x48, 0xda, 0x81, 0xe4, 0x41, 0x7a,
+	0x8b, 0xd9, 0xf7, 0x7b, 0xa3, 0xe8, 0x68, 0x7c, 0xf6, 0x2b, 0x00, 0x76, 0x7b, 0x82, 0x4d, 0x21,
+	0xea, 0xdc, 0xe8, 0x6b, 0xee, 0xa2, 0xca, 0x50, 0x68, 0xa5, 0xd1, 0x45, 0xf5, 0xc6, 0xbe, 0xf3,
+	0x2e, 0x62, 0x0f, 0x61, 0xe4, 0x07, 0x9a, 0xd6, 0xaf, 0xcf, 0xec, 0x14, 0x42, 0x99, 0xa7, 0xc5,
+	0x06, 0x09, 0x9b, 0xbe, 0x5b, 0xc0, 0x1e, 0x43, 0x64, 0xcb, 0x8b, 0x2f, 0x28, 0xab, 0x8d, 0xb1,
+	0x7c, 0x7f, 0x3a, 0x98, 0x85, 0x09, 0x78, 0xb4, 0x54, 0xf6, 0xed, 0xeb, 0xdf, 0xdb, 0x38, 0xb8,
+	0xda, 0xc6, 0xc1, 0xbf, 0x6d, 0x1c, 0xfc, 0xdc, 0xc5, 0xbd, 0xab, 0x5d, 0xdc, 0xfb, 0xbb, 0x8b,
+	0x7b, 0x9f, 0x4e, 0x6f, 0x6e, 0xfa, 0x65, 0xb3, 0xeb, 0xf4, 0xa3, 0x40, 0x7b, 0x31, 0x74, 0x3b,
+	0xfe, 0xea, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xdd, 0xc9, 0xa8, 0x10, 0x03, 0x00, 0x00,
 }
 
 func (m *DegreeRequest) Marshal() (dAtA []byte, err error) {
@@ -203,11 +290,16 @@ func (m *DegreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = l
 	if len(m.MissingRequirements) > 0 {
 		for iNdEx := len(m.MissingRequirements) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MissingRequirements[iNdEx])
-			copy(dAtA[i:], m.MissingRequirements[iNdEx])
-			i = encodeVarintDegreeRequest(dAtA, i, uint64(len(m.MissingRequirements[iNdEx])))
+			{
+				size, err := m.MissingRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDegreeRequest(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x62
 		}
 	}
 	if len(m.ValidationDetails) > 0 {
@@ -283,6 +375,66 @@ func (m *DegreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MissingRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissingRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissingRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubjectIds) > 0 {
+		for iNdEx := len(m.SubjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubjectIds[iNdEx])
+			copy(dAtA[i:], m.SubjectIds[iNdEx])
+			i = encodeVarintDegreeRequest(dAtA, i, uint64(len(m.SubjectIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Completed) > 0 {
+		i -= len(m.Completed)
+		copy(dAtA[i:], m.Completed)
+		i = encodeVarintDegreeRequest(dAtA, i, uint64(len(m.Completed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Required) > 0 {
+		i -= len(m.Required)
+		copy(dAtA[i:], m.Required)
+		i = encodeVarintDegreeRequest(dAtA, i, uint64(len(m.Required)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDegreeRequest(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requirement) > 0 {
+		i -= len(m.Requirement)
+		copy(dAtA[i:], m.Requirement)
+		i = encodeVarintDegreeRequest(dAtA, i, uint64(len(m.Requirement)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDegreeRequest(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDegreeRequest(v)
 	base := offset
@@ -341,7 +493,38 @@ func (m *DegreeRequest) Size() (n int) {
 		n += 1 + l + sovDegreeRequest(uint64(l))
 	}
 	if len(m.MissingRequirements) > 0 {
-		for _, s := range m.MissingRequirements {
+		for _, e := range m.MissingRequirements {
+			l = e.Size()
+			n += 1 + l + sovDegreeRequest(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MissingRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requirement)
+	if l > 0 {
+		n += 1 + l + sovDegreeRequest(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDegreeRequest(uint64(l))
+	}
+	l = len(m.Required)
+	if l > 0 {
+		n += 1 + l + sovDegreeRequest(uint64(l))
+	}
+	l = len(m.Completed)
+	if l > 0 {
+		n += 1 + l + sovDegreeRequest(uint64(l))
+	}
+	if len(m.SubjectIds) > 0 {
+		for _, s := range m.SubjectIds {
 			l = len(s)
 			n += 1 + l + sovDegreeRequest(uint64(l))
 		}
@@ -704,10 +887,222 @@ func (m *DegreeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidationDetails = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MissingRequirements", wireType)
 			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDegreeRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingRequirements = append(m.MissingRequirements, &MissingRequirement{})
+			if err := m.MissingRequirements[len(m.MissingRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDegreeRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissingRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDegreeRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissingRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissingRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDegreeRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requirement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDegreeRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDegreeRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Required = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDegreeRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDegreeRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Completed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectIds", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -734,7 +1129,7 @@ func (m *DegreeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissingRequirements = append(m.MissingRequirements, string(dAtA[iNdEx:postIndex]))
+			m.SubjectIds = append(m.SubjectIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
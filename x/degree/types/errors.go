@@ -23,4 +23,7 @@ var (
 	ErrDuplicateDegreeRequest   = sdkerrors.Register(ModuleName, 1115, "duplicate degree request")
 	ErrInvalidSigner            = sdkerrors.Register(ModuleName, 1116, "invalid signer for the operation")
 	ErrInvalidAddress           = sdkerrors.Register(ModuleName, 1117, "invalid address format")
+	ErrCurriculumNotFound       = sdkerrors.Register(ModuleName, 1118, "curriculum not found")
+	ErrIntegrationDisabled      = sdkerrors.Register(ModuleName, 1119, "integration disabled")
+	ErrInvalidSignature         = sdkerrors.Register(ModuleName, 1120, "invalid degree signature")
 )
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	institutionmoduletypes "academictoken/x/institution/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -47,10 +49,60 @@ type AcademicRecord struct {
 type Curriculum struct {
 	Id               string
 	InstitutionId    string
+	CourseId         string
 	RequiredCredits  uint64
 	RequiredSubjects []string
 }
 
+// StudentRecord is the academic record of a student a degree is validated
+// against: the subjects passed or credited through transfers, the cumulative
+// GPA and the curriculum version the student enrolled under
+type StudentRecord struct {
+	StudentId         string
+	CourseId          string
+	CurriculumVersion string
+	// EnrollmentDate is an RFC3339 timestamp, empty when unknown
+	EnrollmentDate string
+	Subjects       []CompletedSubject
+	// GPA is empty when no graded credits count toward it
+	GPA string
+}
+
+// CompletedSubject is a subject a student passed or was credited with
+type CompletedSubject struct {
+	SubjectId   string
+	Credits     uint64
+	Transferred bool
+}
+
+// CurriculumRequirements represents the graduation requirements of a
+// curriculum version
+type CurriculumRequirements struct {
+	Id                 string
+	CourseId           string
+	Version            string
+	RequiredSubjects   []string
+	ElectiveSubjects   []string
+	ElectiveMin        uint64
+	ElectiveGroups     []ElectiveGroupRequirement
+	TotalCredits       uint64
+	ElectiveCredits    uint64
+	RequiredActivities []string
+	// MinGPA, MinimumYears and MaximumYears are decimals, empty when not required
+	MinGPA       string
+	MinimumYears string
+	MaximumYears string
+}
+
+// ElectiveGroupRequirement represents an elective group of a curriculum
+type ElectiveGroupRequirement struct {
+	GroupId         string
+	Name            string
+	SubjectIds      []string
+	MinSubjects     uint64
+	CreditsRequired uint64
+}
+
 // DegreeNFTData represents data for creating degree NFT
 type DegreeNFTData struct {
 	StudentId     string
@@ -83,6 +135,8 @@ type StudentKeeper interface {
 	GetStudentTotalCredits(ctx sdk.Context, studentId string) (uint64, error)
 	GetCompletedSubjects(ctx sdk.Context, studentId string) ([]string, error)
 	GetContractIntegration() interface{} // Returns ContractIntegration from student module
+	GetStudentRecord(ctx sdk.Context, studentId string) (StudentRecord, bool)
+	GetIntegrationMode(ctx sdk.Context) string
 }
 
 // CurriculumKeeper defines the expected curriculum keeper interface
@@ -93,6 +147,8 @@ type CurriculumKeeper interface {
 	GetCurriculumRequiredCredits(ctx sdk.Context, curriculumId string) (uint64, error)
 	GetCurriculumRequiredSubjects(ctx sdk.Context, curriculumId string) ([]string, error)
 	GetCurriculumsByInstitution(ctx sdk.Context, institutionId string) []Curriculum
+	GetCurriculumRequirements(ctx sdk.Context, id string) (CurriculumRequirements, bool)
+	GetCurriculumVersion(ctx sdk.Context, courseId string, version string) (CurriculumRequirements, bool)
 }
 
 // AcademicNFTKeeper defines the expected academic NFT keeper interface
@@ -116,4 +172,11 @@ type InstitutionKeeper interface {
 	GetInstitution(ctx sdk.Context, id string) (interface{}, bool) // Using interface{} to avoid circular import
 	ValidateInstitutionAuthorization(ctx sdk.Context, institutionId string) error
 	IsAuthorizedToIssueDegrees(ctx sdk.Context, institutionId string) bool
+	CanIssueDegree(ctx sdk.Context, institutionId string, address string, courseId string) bool
+
+	// GetInstitutionSigningKeys returns the signing keys registered by the institution
+	GetInstitutionSigningKeys(ctx sdk.Context, institution string) []institutionmoduletypes.SigningKey
+
+	// GetSigningKey returns a signing key of the institution
+	GetSigningKey(ctx sdk.Context, institution string, keyId string) (institutionmoduletypes.SigningKey, bool)
 }
@@ -47,15 +47,27 @@ var (
 	DegreeRequestByStatusPrefix = []byte("degree_request/by_status/")
 )
 
+// DegreeRequestByStudentKey returns the key indexing a degree request under its student
+func DegreeRequestByStudentKey(studentId string, requestId string) []byte {
+	return append(DegreeRequestByStudentStoreKey(studentId), []byte(requestId)...)
+}
+
+// DegreeRequestByStudentStoreKey returns the prefix of the degree requests indexed under a student
+func DegreeRequestByStudentStoreKey(studentId string) []byte {
+	key := append([]byte{}, DegreeRequestByStudentPrefix...)
+	return append(key, []byte(studentId+"/")...)
+}
+
 // Event types
 const (
-	EventTypeDegreeRequested    = "degree_requested"
-	EventTypeDegreeValidated    = "degree_validated"
-	EventTypeDegreeIssued       = "degree_issued"
-	EventTypeDegreeRejected     = "degree_rejected"
-	EventTypeContractUpdated    = "degree_contract_updated"
-	EventTypeValidationStarted  = "degree_validation_started"
-	EventTypeValidationComplete = "degree_validation_complete"
+	EventTypeDegreeRequested          = "degree_requested"
+	EventTypeDegreeValidated          = "degree_validated"
+	EventTypeDegreeIssued             = "degree_issued"
+	EventTypeDegreeRejected           = "degree_rejected"
+	EventTypeContractUpdated          = "degree_contract_updated"
+	EventTypeValidationStarted        = "degree_validation_started"
+	EventTypeValidationComplete       = "degree_validation_complete"
+	EventTypeDegreeRequestInvalidated = "degree_request_invalidated"
 )
 
 // Event attributes
@@ -99,6 +111,18 @@ const (
 	DegreeRequestStatusCancelled        = "cancelled"
 	DegreeRequestStatusValidated        = "validated"
 	DegreeRequestStatusValidationFailed = "validation_failed"
+	// DegreeRequestStatusRevalidationRequired marks requests relying on a revoked token
+	DegreeRequestStatusRevalidationRequired = "revalidation_required"
+)
+
+// Integration modes, mirroring the integration mode of the student module
+const (
+	// IntegrationModeContract validates degree requirements in the degree contract
+	IntegrationModeContract = "contract"
+	// IntegrationModeNative validates degree requirements in the keeper
+	IntegrationModeNative = "native"
+	// IntegrationModeDisabled rejects degree validations
+	IntegrationModeDisabled = "disabled"
 )
 
 // Default values
@@ -721,6 +721,275 @@ func (m *QueryDegreeValidationStatusResponse) GetValidationDetails() string {
 	return ""
 }
 
+type QueryDegreeCredentialRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryDegreeCredentialRequest) Reset()         { *m = QueryDegreeCredentialRequest{} }
+func (m *QueryDegreeCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDegreeCredentialRequest) ProtoMessage()    {}
+func (*QueryDegreeCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c05b3c9c35b5f4, []int{14}
+}
+func (m *QueryDegreeCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDegreeCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDegreeCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDegreeCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDegreeCredentialRequest.Merge(m, src)
+}
+func (m *QueryDegreeCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDegreeCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDegreeCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDegreeCredentialRequest proto.InternalMessageInfo
+
+func (m *QueryDegreeCredentialRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type QueryDegreeCredentialResponse struct {
+	Credential string `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+}
+
+func (m *QueryDegreeCredentialResponse) Reset()         { *m = QueryDegreeCredentialResponse{} }
+func (m *QueryDegreeCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDegreeCredentialResponse) ProtoMessage()    {}
+func (*QueryDegreeCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c05b3c9c35b5f4, []int{15}
+}
+func (m *QueryDegreeCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDegreeCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDegreeCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDegreeCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDegreeCredentialResponse.Merge(m, src)
+}
+func (m *QueryDegreeCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDegreeCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDegreeCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDegreeCredentialResponse proto.InternalMessageInfo
+
+func (m *QueryDegreeCredentialResponse) GetCredential() string {
+	if m != nil {
+		return m.Credential
+	}
+	return ""
+}
+
+type QueryVerifyDegreeRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryVerifyDegreeRequest) Reset()         { *m = QueryVerifyDegreeRequest{} }
+func (m *QueryVerifyDegreeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyDegreeRequest) ProtoMessage()    {}
+func (*QueryVerifyDegreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c05b3c9c35b5f4, []int{16}
+}
+func (m *QueryVerifyDegreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyDegreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyDegreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyDegreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyDegreeRequest.Merge(m, src)
+}
+func (m *QueryVerifyDegreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyDegreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyDegreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyDegreeRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyDegreeRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type QueryVerifyDegreeResponse struct {
+	Exists          bool                    `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Degree          *Degree                 `protobuf:"bytes,2,opt,name=degree,proto3" json:"degree,omitempty"`
+	Signatures      []DegreeSignatureStatus `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures"`
+	SignaturesValid bool                    `protobuf:"varint,4,opt,name=signaturesValid,proto3" json:"signaturesValid,omitempty"`
+}
+
+func (m *QueryVerifyDegreeResponse) Reset()         { *m = QueryVerifyDegreeResponse{} }
+func (m *QueryVerifyDegreeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyDegreeResponse) ProtoMessage()    {}
+func (*QueryVerifyDegreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c05b3c9c35b5f4, []int{17}
+}
+func (m *QueryVerifyDegreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyDegreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyDegreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyDegreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyDegreeResponse.Merge(m, src)
+}
+func (m *QueryVerifyDegreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyDegreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyDegreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyDegreeResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyDegreeResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+func (m *QueryVerifyDegreeResponse) GetDegree() *Degree {
+	if m != nil {
+		return m.Degree
+	}
+	return nil
+}
+
+func (m *QueryVerifyDegreeResponse) GetSignatures() []DegreeSignatureStatus {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *QueryVerifyDegreeResponse) GetSignaturesValid() bool {
+	if m != nil {
+		return m.SignaturesValid
+	}
+	return false
+}
+
+// DegreeSignatureStatus describes one signature of a degree
+type DegreeSignatureStatus struct {
+	SigningKeyId       string `protobuf:"bytes,1,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"`
+	Signer             string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	SignatureValid     bool   `protobuf:"varint,3,opt,name=signatureValid,proto3" json:"signatureValid,omitempty"`
+	KeyValidAtIssuance bool   `protobuf:"varint,4,opt,name=keyValidAtIssuance,proto3" json:"keyValidAtIssuance,omitempty"`
+}
+
+func (m *DegreeSignatureStatus) Reset()         { *m = DegreeSignatureStatus{} }
+func (m *DegreeSignatureStatus) String() string { return proto.CompactTextString(m) }
+func (*DegreeSignatureStatus) ProtoMessage()    {}
+func (*DegreeSignatureStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c05b3c9c35b5f4, []int{18}
+}
+func (m *DegreeSignatureStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DegreeSignatureStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DegreeSignatureStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DegreeSignatureStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DegreeSignatureStatus.Merge(m, src)
+}
+func (m *DegreeSignatureStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DegreeSignatureStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DegreeSignatureStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DegreeSignatureStatus proto.InternalMessageInfo
+
+func (m *DegreeSignatureStatus) GetSigningKeyId() string {
+	if m != nil {
+		return m.SigningKeyId
+	}
+	return ""
+}
+
+func (m *DegreeSignatureStatus) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *DegreeSignatureStatus) GetSignatureValid() bool {
+	if m != nil {
+		return m.SignatureValid
+	}
+	return false
+}
+
+func (m *DegreeSignatureStatus) GetKeyValidAtIssuance() bool {
+	if m != nil {
+		return m.KeyValidAtIssuance
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "academictoken.degree.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "academictoken.degree.QueryParamsResponse")
@@ -736,67 +1005,87 @@ func init() {
 	proto.RegisterType((*QueryDegreeRequestsResponse)(nil), "academictoken.degree.QueryDegreeRequestsResponse")
 	proto.RegisterType((*QueryDegreeValidationStatusRequest)(nil), "academictoken.degree.QueryDegreeValidationStatusRequest")
 	proto.RegisterType((*QueryDegreeValidationStatusResponse)(nil), "academictoken.degree.QueryDegreeValidationStatusResponse")
+	proto.RegisterType((*QueryDegreeCredentialRequest)(nil), "academictoken.degree.QueryDegreeCredentialRequest")
+	proto.RegisterType((*QueryDegreeCredentialResponse)(nil), "academictoken.degree.QueryDegreeCredentialResponse")
+	proto.RegisterType((*QueryVerifyDegreeRequest)(nil), "academictoken.degree.QueryVerifyDegreeRequest")
+	proto.RegisterType((*QueryVerifyDegreeResponse)(nil), "academictoken.degree.QueryVerifyDegreeResponse")
+	proto.RegisterType((*DegreeSignatureStatus)(nil), "academictoken.degree.DegreeSignatureStatus")
 }
 
 func init() { proto.RegisterFile("academictoken/degree/query.proto", fileDescriptor_35c05b3c9c35b5f4) }
 
 var fileDescriptor_35c05b3c9c35b5f4 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x4f, 0x2b, 0x55,
-	0x14, 0xc7, 0x7b, 0x79, 0xef, 0xd5, 0xd7, 0x63, 0xc4, 0xe7, 0xb5, 0x12, 0x32, 0x94, 0xb1, 0x0c,
-	0xc8, 0x0f, 0xa5, 0x33, 0x02, 0x01, 0xfc, 0x45, 0x0c, 0x04, 0x24, 0xec, 0xa0, 0x18, 0x17, 0x6e,
-	0x9a, 0xdb, 0xce, 0x4d, 0x33, 0xb1, 0xcc, 0x94, 0xde, 0x5b, 0x42, 0x83, 0xdd, 0x68, 0xdc, 0xb9,
-	0x90, 0xb8, 0x66, 0xeb, 0x42, 0xe3, 0x3f, 0xe0, 0xca, 0x25, 0x71, 0x61, 0x48, 0xdc, 0xb8, 0x32,
-	0x06, 0xfc, 0x43, 0x4c, 0xef, 0xbd, 0xd3, 0x99, 0x29, 0xd3, 0x29, 0x25, 0x5d, 0xbc, 0xd5, 0x7b,
-	0x3d, 0xf7, 0x7b, 0xee, 0xf9, 0x9c, 0xef, 0x9d, 0x9c, 0x03, 0xe4, 0x49, 0x85, 0xd8, 0xf4, 0xc4,
-	0xa9, 0x70, 0xef, 0x2b, 0xea, 0x5a, 0x36, 0xad, 0x36, 0x28, 0xb5, 0x4e, 0x9b, 0xb4, 0xd1, 0x32,
-	0xeb, 0x0d, 0x8f, 0x7b, 0x38, 0x1b, 0x51, 0x98, 0x52, 0xa1, 0x65, 0xab, 0x5e, 0xd5, 0x13, 0x02,
-	0xab, 0xf3, 0x3f, 0xa9, 0xd5, 0x72, 0x55, 0xcf, 0xab, 0xd6, 0xa8, 0x45, 0xea, 0x8e, 0x45, 0x5c,
-	0xd7, 0xe3, 0x84, 0x3b, 0x9e, 0xcb, 0xd4, 0xe9, 0xbb, 0x15, 0x8f, 0x9d, 0x78, 0xcc, 0x2a, 0x13,
-	0xa6, 0x4a, 0x58, 0x67, 0x2b, 0x65, 0xca, 0xc9, 0x8a, 0x55, 0x27, 0x55, 0xc7, 0x15, 0x62, 0xa5,
-	0x9d, 0x89, 0xe5, 0xaa, 0x93, 0x06, 0x39, 0x61, 0x89, 0x12, 0xf9, 0x8f, 0x92, 0x2c, 0x25, 0x48,
-	0x4a, 0x0d, 0x7a, 0xda, 0xa4, 0x8c, 0x4b, 0xa9, 0x91, 0x05, 0x7c, 0xd4, 0x41, 0x3a, 0x14, 0x25,
-	0x8a, 0xf2, 0xcc, 0x38, 0x82, 0x37, 0x23, 0x51, 0x56, 0xf7, 0x5c, 0x46, 0xf1, 0x47, 0x90, 0x96,
-	0x28, 0x93, 0x28, 0x8f, 0x16, 0x5f, 0x5d, 0xcd, 0x99, 0x71, 0x26, 0x99, 0x32, 0x6b, 0xe7, 0xe9,
-	0xf5, 0x3f, 0x6f, 0xa7, 0x8a, 0x2a, 0xc3, 0x28, 0xc0, 0x5b, 0xe2, 0xca, 0x7d, 0xca, 0x77, 0x85,
-	0x4c, 0xd5, 0xc2, 0x59, 0x78, 0xe6, 0xb8, 0x36, 0x3d, 0x17, 0x77, 0x66, 0x8a, 0xf2, 0x87, 0xf1,
-	0x39, 0x4c, 0xf4, 0xca, 0x03, 0x08, 0x59, 0x27, 0x19, 0x42, 0x66, 0xf9, 0x10, 0x32, 0x68, 0x94,
-	0x14, 0xc4, 0x76, 0xad, 0x16, 0x85, 0xf8, 0x0c, 0x20, 0x78, 0x0b, 0x75, 0xf1, 0xbc, 0x29, 0x1f,
-	0xce, 0xec, 0x3c, 0x9c, 0x29, 0xbf, 0x0d, 0xf5, 0x70, 0xe6, 0x21, 0xa9, 0xfa, 0xb9, 0xc5, 0x50,
-	0xa6, 0x71, 0x85, 0x14, 0x77, 0xa8, 0x42, 0x0c, 0xf7, 0x93, 0xe1, 0xb8, 0xf1, 0x7e, 0x04, 0x6f,
-	0x4c, 0xe0, 0x2d, 0x0c, 0xc4, 0x93, 0x85, 0x23, 0x7c, 0xdf, 0x21, 0xc8, 0x09, 0x3e, 0x59, 0x86,
-	0xed, 0xb4, 0x8e, 0x79, 0xd3, 0xa6, 0x2e, 0xf7, 0x8d, 0x98, 0x06, 0x60, 0x32, 0x52, 0x72, 0x6c,
-	0xf5, 0x24, 0x19, 0x15, 0x39, 0xb0, 0x7b, 0x7c, 0x1a, 0x7b, 0xb4, 0x4f, 0x3f, 0x21, 0x98, 0xee,
-	0xc3, 0xa1, 0xec, 0xfa, 0x04, 0x5e, 0x91, 0xcd, 0xb3, 0x21, 0xfc, 0xf2, 0x53, 0x46, 0x67, 0xd8,
-	0x25, 0x82, 0x7c, 0x14, 0xf4, 0xc0, 0x65, 0xdc, 0xe1, 0xcd, 0xce, 0xa9, 0x6f, 0xda, 0x3b, 0x30,
-	0xee, 0x04, 0xd1, 0xc0, 0xb8, 0xd7, 0x42, 0xd1, 0x11, 0x9a, 0xf7, 0x33, 0x82, 0x99, 0x04, 0xa6,
-	0x97, 0xcb, 0xc0, 0xaf, 0x41, 0x0b, 0xb1, 0xaa, 0x76, 0xfc, 0x41, 0x83, 0x27, 0x20, 0xcd, 0x38,
-	0xe1, 0x4d, 0xa6, 0x1c, 0x53, 0xbf, 0x46, 0x66, 0xd5, 0xaf, 0x08, 0xa6, 0x62, 0xcb, 0x2b, 0x93,
-	0xf6, 0xe0, 0xb9, 0x9a, 0x87, 0xbe, 0x4b, 0xb3, 0x49, 0x2e, 0xa9, 0x7c, 0x65, 0x56, 0x37, 0x75,
-	0x74, 0x6e, 0x6d, 0x83, 0x11, 0xc2, 0xfd, 0x82, 0xd4, 0x1c, 0x5b, 0x1c, 0x1c, 0x0b, 0x5b, 0x7c,
-	0xd7, 0xa6, 0x20, 0xa3, 0x86, 0x79, 0xf7, 0x53, 0x7b, 0x2e, 0x03, 0x07, 0xb6, 0xf1, 0x3b, 0x82,
-	0xd9, 0xc4, 0x3b, 0x54, 0xeb, 0xfd, 0xac, 0x5f, 0x82, 0x17, 0x67, 0xdd, 0x9c, 0x12, 0xab, 0x78,
-	0x0d, 0x2a, 0x3a, 0xca, 0x14, 0x5f, 0x0f, 0xe2, 0xc7, 0x9d, 0x30, 0x5e, 0x80, 0x50, 0xa8, 0x64,
-	0x13, 0x4e, 0x27, 0x9f, 0x08, 0xe5, 0x78, 0x10, 0xde, 0x25, 0x9c, 0xe2, 0x02, 0xe0, 0xb0, 0x90,
-	0x72, 0xe2, 0xd4, 0xd8, 0xe4, 0x53, 0xa1, 0x7d, 0x23, 0xa4, 0x95, 0x07, 0xab, 0xbf, 0x64, 0xe0,
-	0x99, 0x68, 0x01, 0x7f, 0x8b, 0x20, 0x2d, 0xd7, 0x09, 0x5e, 0x8c, 0x7f, 0x98, 0xfb, 0xdb, 0x4b,
-	0x5b, 0x7a, 0x80, 0x52, 0x9a, 0x60, 0xcc, 0x7d, 0xf3, 0xd7, 0x7f, 0x3f, 0x8e, 0xe9, 0x38, 0x67,
-	0x25, 0x2c, 0x5e, 0x7c, 0x89, 0x20, 0x2d, 0xdd, 0xc4, 0xef, 0x25, 0xdc, 0xdd, 0xbb, 0xda, 0xb4,
-	0xe5, 0x87, 0x89, 0x15, 0xcb, 0xb2, 0x60, 0x99, 0xc7, 0x73, 0x56, 0xc2, 0xfa, 0xb6, 0x2e, 0xc4,
-	0x7e, 0x6c, 0xe3, 0xef, 0x11, 0x64, 0xe4, 0x05, 0xdb, 0xb5, 0x5a, 0x22, 0x56, 0xef, 0xb2, 0x4b,
-	0xc4, 0xba, 0xb7, 0xb7, 0x06, 0x59, 0xa4, 0x36, 0xd4, 0x6f, 0x08, 0x5e, 0xf4, 0xce, 0x72, 0xbc,
-	0x9a, 0x50, 0xa8, 0xcf, 0x02, 0xd2, 0xd6, 0x86, 0xca, 0x51, 0x8c, 0x5b, 0x82, 0x71, 0x13, 0xaf,
-	0x27, 0x31, 0xb2, 0x42, 0xb9, 0x55, 0x50, 0xab, 0xcc, 0xba, 0x08, 0xb6, 0x5c, 0x1b, 0xff, 0x89,
-	0x20, 0x1b, 0x37, 0x4b, 0xf1, 0xc6, 0x43, 0x60, 0xee, 0x2f, 0x04, 0x6d, 0x73, 0xe8, 0x3c, 0xd5,
-	0xc8, 0x9e, 0x68, 0xe4, 0x53, 0xbc, 0x35, 0xb0, 0x91, 0xd0, 0x6a, 0xe9, 0x7c, 0x13, 0xe1, 0xed,
-	0xd3, 0xc6, 0x57, 0x08, 0xc6, 0xa3, 0x13, 0x0f, 0xbf, 0x3f, 0x10, 0xa9, 0x67, 0x36, 0x6b, 0x2b,
-	0x43, 0x64, 0x28, 0xfc, 0x79, 0x81, 0x9f, 0xc7, 0x7a, 0x3c, 0x7e, 0x77, 0x5e, 0xfe, 0x81, 0x60,
-	0x22, 0x7e, 0x3c, 0xe1, 0x0f, 0x06, 0x56, 0xed, 0x33, 0x15, 0xb5, 0x0f, 0x1f, 0x91, 0xa9, 0xb8,
-	0x3f, 0x16, 0xdc, 0xeb, 0x78, 0x2d, 0x9e, 0x3b, 0x98, 0x50, 0x05, 0x39, 0x24, 0xad, 0x8b, 0xee,
-	0xfc, 0x6d, 0xef, 0x6c, 0x5c, 0xdf, 0xea, 0xe8, 0xe6, 0x56, 0x47, 0xff, 0xde, 0xea, 0xe8, 0x87,
-	0x3b, 0x3d, 0x75, 0x73, 0xa7, 0xa7, 0xfe, 0xbe, 0xd3, 0x53, 0x5f, 0xe6, 0xa2, 0xb7, 0x9d, 0xfb,
-	0xf7, 0xf1, 0x56, 0x9d, 0xb2, 0x72, 0x5a, 0xfc, 0x05, 0xbe, 0xf6, 0x7f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x45, 0xff, 0x0c, 0x11, 0x8c, 0x0c, 0x00, 0x00,
+	// 1105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x24, 0xad, 0x89, 0x5f, 0x4b, 0x5a, 0x06, 0x37, 0x32, 0x5b, 0xd7, 0xa4, 0xdb, 0x92,
+	0x3a, 0x6d, 0xbd, 0x9b, 0x8f, 0x7e, 0xf0, 0x55, 0x55, 0x09, 0x2d, 0x95, 0xc5, 0xa5, 0x71, 0x50,
+	0x0f, 0x5c, 0xac, 0x89, 0x77, 0x58, 0xad, 0xea, 0xec, 0xba, 0x3b, 0xe3, 0x28, 0x56, 0xc8, 0x05,
+	0xc4, 0x8d, 0x03, 0x15, 0xe7, 0x5c, 0x39, 0x80, 0xfa, 0x0f, 0x70, 0xe2, 0x58, 0x71, 0x40, 0x95,
+	0xb8, 0x70, 0x42, 0x28, 0x41, 0xfc, 0x13, 0x5c, 0x90, 0x67, 0x66, 0xbf, 0xec, 0xcd, 0xda, 0x8e,
+	0x72, 0xe8, 0x29, 0xde, 0x37, 0xbf, 0x37, 0xef, 0x37, 0xbf, 0x79, 0x9a, 0xdf, 0x0b, 0xcc, 0x91,
+	0x26, 0xb1, 0xe8, 0x96, 0xd3, 0xe4, 0xde, 0x53, 0xea, 0x9a, 0x16, 0xb5, 0x7d, 0x4a, 0xcd, 0x67,
+	0x1d, 0xea, 0x77, 0x8d, 0xb6, 0xef, 0x71, 0x0f, 0x17, 0x12, 0x08, 0x43, 0x22, 0xb4, 0x82, 0xed,
+	0xd9, 0x9e, 0x00, 0x98, 0xbd, 0x5f, 0x12, 0xab, 0x95, 0x6c, 0xcf, 0xb3, 0x5b, 0xd4, 0x24, 0x6d,
+	0xc7, 0x24, 0xae, 0xeb, 0x71, 0xc2, 0x1d, 0xcf, 0x65, 0x6a, 0xf5, 0x7a, 0xd3, 0x63, 0x5b, 0x1e,
+	0x33, 0x37, 0x09, 0x53, 0x25, 0xcc, 0xed, 0xa5, 0x4d, 0xca, 0xc9, 0x92, 0xd9, 0x26, 0xb6, 0xe3,
+	0x0a, 0xb0, 0xc2, 0x5e, 0x4e, 0xe5, 0xd5, 0x26, 0x3e, 0xd9, 0x62, 0x99, 0x10, 0xf9, 0x47, 0x41,
+	0x16, 0x32, 0x20, 0x0d, 0x9f, 0x3e, 0xeb, 0x50, 0xc6, 0x25, 0x54, 0x2f, 0x00, 0x5e, 0xef, 0x51,
+	0x7a, 0x2c, 0x4a, 0xd4, 0xe5, 0x9a, 0xbe, 0x0e, 0x6f, 0x27, 0xa2, 0xac, 0xed, 0xb9, 0x8c, 0xe2,
+	0x0f, 0x21, 0x27, 0xa9, 0x14, 0xd1, 0x1c, 0xaa, 0x9c, 0x59, 0x2e, 0x19, 0x69, 0x22, 0x19, 0x32,
+	0x6b, 0xed, 0xd4, 0xcb, 0xbf, 0xde, 0x9d, 0xa8, 0xab, 0x0c, 0xbd, 0x0a, 0x17, 0xc4, 0x96, 0x8f,
+	0x28, 0x7f, 0x20, 0x60, 0xaa, 0x16, 0x2e, 0xc0, 0x69, 0xc7, 0xb5, 0xe8, 0x8e, 0xd8, 0x33, 0x5f,
+	0x97, 0x1f, 0xfa, 0xe7, 0x30, 0xdb, 0x0f, 0x8f, 0x48, 0xc8, 0x3a, 0xd9, 0x24, 0x64, 0x56, 0x40,
+	0x42, 0x06, 0xf5, 0x86, 0x22, 0xb1, 0xda, 0x6a, 0x25, 0x49, 0x7c, 0x0a, 0x10, 0xdd, 0x85, 0xda,
+	0x78, 0xde, 0x90, 0x17, 0x67, 0xf4, 0x2e, 0xce, 0x90, 0xbd, 0xa1, 0x2e, 0xce, 0x78, 0x4c, 0xec,
+	0x20, 0xb7, 0x1e, 0xcb, 0xd4, 0xf7, 0x91, 0xe2, 0x1d, 0xab, 0x90, 0xc2, 0x7b, 0x6a, 0x3c, 0xde,
+	0xf8, 0x51, 0x82, 0xde, 0xa4, 0xa0, 0x77, 0x6d, 0x28, 0x3d, 0x59, 0x38, 0xc1, 0xef, 0x5b, 0x04,
+	0x25, 0xc1, 0x4f, 0x96, 0x61, 0x6b, 0xdd, 0x0d, 0xde, 0xb1, 0xa8, 0xcb, 0x03, 0x21, 0x2e, 0x01,
+	0x30, 0x19, 0x69, 0x38, 0x96, 0xba, 0x92, 0xbc, 0x8a, 0xd4, 0xac, 0x3e, 0x9d, 0x26, 0x8f, 0xad,
+	0xd3, 0x8f, 0x08, 0x2e, 0x1d, 0xc1, 0x43, 0xc9, 0xf5, 0x31, 0xbc, 0x21, 0x0f, 0xcf, 0xc6, 0xd0,
+	0x2b, 0x48, 0x39, 0x39, 0xc1, 0x9e, 0x23, 0x98, 0x4b, 0x12, 0xad, 0xb9, 0x8c, 0x3b, 0xbc, 0xd3,
+	0x5b, 0x0d, 0x44, 0x7b, 0x0f, 0x66, 0x9c, 0x28, 0x1a, 0x09, 0xf7, 0x66, 0x2c, 0x7a, 0x82, 0xe2,
+	0xfd, 0x84, 0xe0, 0x72, 0x06, 0xa7, 0xd7, 0x4b, 0xc0, 0xaf, 0x40, 0x8b, 0x71, 0x55, 0xc7, 0x09,
+	0x1e, 0x1a, 0x3c, 0x0b, 0x39, 0xc6, 0x09, 0xef, 0x30, 0xa5, 0x98, 0xfa, 0x3a, 0x31, 0xa9, 0x5e,
+	0x20, 0xb8, 0x98, 0x5a, 0x5e, 0x89, 0xf4, 0x10, 0xa6, 0xd5, 0x7b, 0x18, 0xa8, 0x74, 0x25, 0x4b,
+	0x25, 0x95, 0xaf, 0xc4, 0x0a, 0x53, 0x4f, 0x4e, 0xad, 0x55, 0xd0, 0x63, 0x74, 0x9f, 0x90, 0x96,
+	0x63, 0x89, 0x85, 0x0d, 0x21, 0x4b, 0xa0, 0xda, 0x45, 0xc8, 0xab, 0xc7, 0x3c, 0x6c, 0xb5, 0x69,
+	0x19, 0xa8, 0x59, 0xfa, 0xaf, 0x08, 0xae, 0x64, 0xee, 0xa1, 0x8e, 0x7e, 0x94, 0xf4, 0x0b, 0x70,
+	0x7e, 0x3b, 0xcc, 0x69, 0xb0, 0xa6, 0xe7, 0x53, 0x71, 0xa2, 0x7c, 0xfd, 0x5c, 0x14, 0xdf, 0xe8,
+	0x85, 0xf1, 0x35, 0x88, 0x85, 0x1a, 0x16, 0xe1, 0xb4, 0x38, 0x25, 0x90, 0x33, 0x51, 0xf8, 0x01,
+	0xe1, 0x14, 0x57, 0x01, 0xc7, 0x81, 0x94, 0x13, 0xa7, 0xc5, 0x8a, 0xa7, 0x04, 0xf6, 0xad, 0x18,
+	0x56, 0x2e, 0xe8, 0xb7, 0x12, 0x8f, 0xd4, 0x27, 0x3e, 0xed, 0xbd, 0x0c, 0x0e, 0x69, 0x65, 0x5b,
+	0xc6, 0xfd, 0xc4, 0x93, 0x12, 0xcf, 0x52, 0x27, 0x2e, 0x03, 0x34, 0xc3, 0xa8, 0xca, 0x8d, 0x45,
+	0xf4, 0x45, 0x28, 0x8a, 0x0d, 0x9e, 0x50, 0xdf, 0xf9, 0xb2, 0x3b, 0x8a, 0x4b, 0xfd, 0x8b, 0xe0,
+	0x9d, 0x94, 0x94, 0x48, 0x61, 0xba, 0xe3, 0xc8, 0xd6, 0x42, 0x95, 0xe9, 0xba, 0xfa, 0xc2, 0xb7,
+	0x42, 0x27, 0x98, 0x1c, 0xee, 0x60, 0xa1, 0x07, 0xac, 0x03, 0x30, 0xc7, 0x76, 0x09, 0xef, 0xf8,
+	0x94, 0x15, 0xa7, 0x44, 0xb3, 0xde, 0xc8, 0xca, 0xdc, 0x08, 0xd0, 0xf2, 0xe2, 0x55, 0xd3, 0xc6,
+	0x36, 0xc1, 0x15, 0x38, 0x17, 0x7d, 0x89, 0x46, 0x11, 0x77, 0x32, 0x5d, 0xef, 0x0f, 0xeb, 0x3f,
+	0x23, 0xb8, 0x90, 0xba, 0x2b, 0xd6, 0xe1, 0x6c, 0x0f, 0xec, 0xb8, 0xf6, 0x67, 0xb4, 0x5b, 0x0b,
+	0xda, 0x31, 0x11, 0x13, 0xad, 0xe6, 0xd8, 0x2e, 0xf5, 0x55, 0x23, 0xa9, 0x2f, 0x3c, 0x0f, 0x33,
+	0x61, 0x21, 0x59, 0x7e, 0x4a, 0x94, 0xef, 0x8b, 0x62, 0x03, 0xf0, 0x53, 0xda, 0x15, 0xbf, 0x57,
+	0x79, 0x8d, 0xb1, 0x0e, 0x71, 0x9b, 0x54, 0x51, 0x4d, 0x59, 0x59, 0xfe, 0xef, 0x0c, 0x9c, 0x16,
+	0xd7, 0x82, 0xbf, 0x41, 0x90, 0x93, 0xe3, 0x08, 0xae, 0xa4, 0x6b, 0x35, 0x38, 0xfd, 0x68, 0x0b,
+	0x23, 0x20, 0xe5, 0x15, 0xeb, 0x57, 0xbf, 0xfe, 0xe3, 0x9f, 0x1f, 0x26, 0xcb, 0xb8, 0x64, 0x66,
+	0x0c, 0x6e, 0xf8, 0x39, 0x82, 0x9c, 0x54, 0x0f, 0xdf, 0xc8, 0xd8, 0xbb, 0x7f, 0x34, 0xd2, 0x6e,
+	0x8e, 0x06, 0x56, 0x5c, 0x6e, 0x0a, 0x2e, 0xf3, 0xf8, 0xaa, 0x99, 0x31, 0xfe, 0x99, 0xbb, 0xa2,
+	0x73, 0xf7, 0xf0, 0x77, 0x08, 0xf2, 0x72, 0x83, 0xd5, 0x56, 0x2b, 0x93, 0x56, 0xff, 0xb0, 0x94,
+	0x49, 0x6b, 0x60, 0xee, 0x19, 0x26, 0x91, 0xea, 0xee, 0x5f, 0x10, 0x9c, 0xef, 0x9f, 0x05, 0xf0,
+	0x72, 0x46, 0xa1, 0x23, 0x06, 0x18, 0x6d, 0x65, 0xac, 0x1c, 0xc5, 0xf1, 0x9e, 0xe0, 0x78, 0x17,
+	0xdf, 0xce, 0xe2, 0xc8, 0xaa, 0x9b, 0xdd, 0xaa, 0x1a, 0x85, 0xcc, 0xdd, 0x68, 0x4a, 0xda, 0xc3,
+	0xbf, 0x23, 0x28, 0xa4, 0x79, 0x31, 0xbe, 0x33, 0x0a, 0x99, 0xc1, 0x81, 0x42, 0xbb, 0x3b, 0x76,
+	0x9e, 0x3a, 0xc8, 0x43, 0x71, 0x90, 0xfb, 0xf8, 0xde, 0xd0, 0x83, 0xc4, 0x46, 0x93, 0x5e, 0x4f,
+	0xc4, 0xa7, 0x97, 0x3d, 0xbc, 0x8f, 0x60, 0x26, 0xe9, 0x98, 0x78, 0x71, 0x28, 0xa5, 0x3e, 0x6f,
+	0xd7, 0x96, 0xc6, 0xc8, 0x50, 0xf4, 0xe7, 0x05, 0xfd, 0x39, 0x5c, 0x4e, 0xa7, 0x1f, 0xfa, 0xed,
+	0x6f, 0x08, 0x66, 0xd3, 0xed, 0x0d, 0xbf, 0x3f, 0xb4, 0xea, 0x11, 0xae, 0xaa, 0x7d, 0x70, 0x8c,
+	0x4c, 0xc5, 0xfb, 0x23, 0xc1, 0xfb, 0x36, 0x5e, 0x49, 0xe7, 0x1d, 0x39, 0x5c, 0x55, 0x9a, 0xac,
+	0xb9, 0x1b, 0xfa, 0xf7, 0x1e, 0x7e, 0x11, 0xb6, 0x7e, 0xe4, 0x59, 0x23, 0xb4, 0xfe, 0x80, 0x2d,
+	0x8e, 0xd0, 0xfa, 0x83, 0xa6, 0xa8, 0x2f, 0x0a, 0xea, 0xd7, 0x71, 0x25, 0x9d, 0x7a, 0x64, 0x8f,
+	0xe1, 0xcb, 0xb1, 0x8f, 0xe0, 0x6c, 0xdc, 0xef, 0xb0, 0x91, 0x51, 0x37, 0xc5, 0x4b, 0x35, 0x73,
+	0x64, 0xfc, 0x68, 0x2f, 0xdb, 0xb6, 0xc8, 0x09, 0xf8, 0xad, 0xdd, 0x79, 0x79, 0x50, 0x46, 0xaf,
+	0x0e, 0xca, 0xe8, 0xef, 0x83, 0x32, 0xfa, 0xfe, 0xb0, 0x3c, 0xf1, 0xea, 0xb0, 0x3c, 0xf1, 0xe7,
+	0x61, 0x79, 0xe2, 0x8b, 0x52, 0x32, 0x7d, 0x27, 0xd8, 0x80, 0x77, 0xdb, 0x94, 0x6d, 0xe6, 0xc4,
+	0x7f, 0xc4, 0x2b, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xec, 0x9c, 0xda, 0x1c, 0x10, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -825,6 +1114,10 @@ type QueryClient interface {
 	DegreeRequests(ctx context.Context, in *QueryDegreeRequestsRequest, opts ...grpc.CallOption) (*QueryDegreeRequestsResponse, error)
 	// Queries degree validation status
 	DegreeValidationStatus(ctx context.Context, in *QueryDegreeValidationStatusRequest, opts ...grpc.CallOption) (*QueryDegreeValidationStatusResponse, error)
+	// Renders a Degree as a W3C Verifiable Credential anchored to the queried height
+	DegreeCredential(ctx context.Context, in *QueryDegreeCredentialRequest, opts ...grpc.CallOption) (*QueryDegreeCredentialResponse, error)
+	// Verifies the signatures of a Degree against the signing keys of its institution
+	VerifyDegree(ctx context.Context, in *QueryVerifyDegreeRequest, opts ...grpc.CallOption) (*QueryVerifyDegreeResponse, error)
 }
 
 type queryClient struct {
@@ -898,6 +1191,24 @@ func (c *queryClient) DegreeValidationStatus(ctx context.Context, in *QueryDegre
 	return out, nil
 }
 
+func (c *queryClient) DegreeCredential(ctx context.Context, in *QueryDegreeCredentialRequest, opts ...grpc.CallOption) (*QueryDegreeCredentialResponse, error) {
+	out := new(QueryDegreeCredentialResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.degree.Query/DegreeCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyDegree(ctx context.Context, in *QueryVerifyDegreeRequest, opts ...grpc.CallOption) (*QueryVerifyDegreeResponse, error) {
+	out := new(QueryVerifyDegreeResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.degree.Query/VerifyDegree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -914,6 +1225,10 @@ type QueryServer interface {
 	DegreeRequests(context.Context, *QueryDegreeRequestsRequest) (*QueryDegreeRequestsResponse, error)
 	// Queries degree validation status
 	DegreeValidationStatus(context.Context, *QueryDegreeValidationStatusRequest) (*QueryDegreeValidationStatusResponse, error)
+	// Renders a Degree as a W3C Verifiable Credential anchored to the queried height
+	DegreeCredential(context.Context, *QueryDegreeCredentialRequest) (*QueryDegreeCredentialResponse, error)
+	// Verifies the signatures of a Degree against the signing keys of its institution
+	VerifyDegree(context.Context, *QueryVerifyDegreeRequest) (*QueryVerifyDegreeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -941,6 +1256,12 @@ func (*UnimplementedQueryServer) DegreeRequests(ctx context.Context, req *QueryD
 func (*UnimplementedQueryServer) DegreeValidationStatus(ctx context.Context, req *QueryDegreeValidationStatusRequest) (*QueryDegreeValidationStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DegreeValidationStatus not implemented")
 }
+func (*UnimplementedQueryServer) DegreeCredential(ctx context.Context, req *QueryDegreeCredentialRequest) (*QueryDegreeCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DegreeCredential not implemented")
+}
+func (*UnimplementedQueryServer) VerifyDegree(ctx context.Context, req *QueryVerifyDegreeRequest) (*QueryVerifyDegreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDegree not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1072,6 +1393,42 @@ func _Query_DegreeValidationStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DegreeCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDegreeCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DegreeCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.degree.Query/DegreeCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DegreeCredential(ctx, req.(*QueryDegreeCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyDegree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyDegreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyDegree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.degree.Query/VerifyDegree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyDegree(ctx, req.(*QueryVerifyDegreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "academictoken.degree.Query",
@@ -1105,6 +1462,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DegreeValidationStatus",
 			Handler:    _Query_DegreeValidationStatus_Handler,
 		},
+		{
+			MethodName: "DegreeCredential",
+			Handler:    _Query_DegreeCredential_Handler,
+		},
+		{
+			MethodName: "VerifyDegree",
+			Handler:    _Query_VerifyDegree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/degree/query.proto",
@@ -1667,48 +2032,264 @@ func (m *QueryDegreeValidationStatusResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDegreeCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDegreeCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetDegreeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDegreeCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDegreeCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDegreeCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDegreeCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyDegreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyDegreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyDegreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyDegreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyDegreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyDegreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignaturesValid {
+		i--
+		if m.SignaturesValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Degree != nil {
+		{
+			size, err := m.Degree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DegreeSignatureStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DegreeSignatureStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DegreeSignatureStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyValidAtIssuance {
+		i--
+		if m.KeyValidAtIssuance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SignatureValid {
+		i--
+		if m.SignatureValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SigningKeyId) > 0 {
+		i -= len(m.SigningKeyId)
+		copy(dAtA[i:], m.SigningKeyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigningKeyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDegreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryGetDegreeResponse) Size() (n int) {
@@ -1900,6 +2481,93 @@ func (m *QueryDegreeValidationStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDegreeCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDegreeCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyDegreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyDegreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	if m.Degree != nil {
+		l = m.Degree.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SignaturesValid {
+		n += 2
+	}
+	return n
+}
+
+func (m *DegreeSignatureStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SigningKeyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SignatureValid {
+		n += 2
+	}
+	if m.KeyValidAtIssuance {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3384,6 +4052,566 @@ func (m *QueryDegreeValidationStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDegreeCredentialRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDegreeCredentialRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDegreeCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDegreeCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDegreeCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDegreeCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyDegreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyDegreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyDegreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyDegreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyDegreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyDegreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Degree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Degree == nil {
+				m.Degree = &Degree{}
+			}
+			if err := m.Degree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, DegreeSignatureStatus{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignaturesValid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignaturesValid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DegreeSignatureStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DegreeSignatureStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DegreeSignatureStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureValid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignatureValid = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValidAtIssuance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyValidAtIssuance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+)
+
+// DegreeSignType tags the payload registrars sign, so a signature over a
+// degree cannot be replayed as another kind of record
+const DegreeSignType = "academictoken/Degree"
+
+// DegreeSignBytes returns the canonical serialization of the degree fields
+// signed by the registrars of the issuing institution: compact JSON with
+// sorted keys. The curriculum is the one the degree request names.
+func DegreeSignBytes(student, institution, curriculumId, finalGrade string, totalCredits uint64) []byte {
+	// Maps are marshalled with sorted keys and string values cannot fail
+	bz, _ := json.Marshal(map[string]string{
+		"type":         DegreeSignType,
+		"student":      student,
+		"institution":  institution,
+		"curriculumId": curriculumId,
+		"finalGrade":   finalGrade,
+		"totalCredits": strconv.FormatUint(totalCredits, 10),
+	})
+	return bz
+}
+
+// DecodeSignature decodes a base64 signature
+func DecodeSignature(signature string) ([]byte, error) {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, ErrInvalidSignature.Wrapf("signature is not base64: %s", err)
+	}
+	return sig, nil
+}
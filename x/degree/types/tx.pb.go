@@ -230,10 +230,10 @@ func (m *MsgValidateDegreeRequirements) GetValidationParameters() string {
 }
 
 type MsgValidateDegreeRequirementsResponse struct {
-	ValidationPassed    bool     `protobuf:"varint,1,opt,name=validation_passed,json=validationPassed,proto3" json:"validation_passed,omitempty"`
-	ValidationScore     string   `protobuf:"bytes,2,opt,name=validation_score,json=validationScore,proto3" json:"validation_score,omitempty"`
-	ValidationDetails   string   `protobuf:"bytes,3,opt,name=validation_details,json=validationDetails,proto3" json:"validation_details,omitempty"`
-	MissingRequirements []string `protobuf:"bytes,4,rep,name=missing_requirements,json=missingRequirements,proto3" json:"missing_requirements,omitempty"`
+	ValidationPassed    bool                  `protobuf:"varint,1,opt,name=validation_passed,json=validationPassed,proto3" json:"validation_passed,omitempty"`
+	ValidationScore     string                `protobuf:"bytes,2,opt,name=validation_score,json=validationScore,proto3" json:"validation_score,omitempty"`
+	ValidationDetails   string                `protobuf:"bytes,3,opt,name=validation_details,json=validationDetails,proto3" json:"validation_details,omitempty"`
+	MissingRequirements []*MissingRequirement `protobuf:"bytes,5,rep,name=missing_requirements,json=missingRequirements,proto3" json:"missing_requirements,omitempty"`
 }
 
 func (m *MsgValidateDegreeRequirementsResponse) Reset()         { *m = MsgValidateDegreeRequirementsResponse{} }
@@ -290,7 +290,7 @@ func (m *MsgValidateDegreeRequirementsResponse) GetValidationDetails() string {
 	return ""
 }
 
-func (m *MsgValidateDegreeRequirementsResponse) GetMissingRequirements() []string {
+func (m *MsgValidateDegreeRequirementsResponse) GetMissingRequirements() []*MissingRequirement {
 	if m != nil {
 		return m.MissingRequirements
 	}
@@ -811,77 +811,78 @@ func init() {
 func init() { proto.RegisterFile("academictoken/degree/tx.proto", fileDescriptor_ad5023fdcab9ac5b) }
 
 var fileDescriptor_ad5023fdcab9ac5b = []byte{
-	// 1105 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x4f, 0x24, 0x45,
-	0x14, 0xa6, 0x87, 0x1f, 0x32, 0x0f, 0x58, 0xa0, 0x19, 0xd9, 0xd9, 0xd9, 0x65, 0xc0, 0x51, 0xd6,
-	0x15, 0x65, 0x66, 0x77, 0x59, 0xc9, 0x8a, 0x89, 0x66, 0x01, 0xb3, 0xce, 0x01, 0x63, 0x66, 0x75,
-	0x0f, 0x1e, 0xec, 0x94, 0x5d, 0x45, 0x53, 0xb1, 0xa7, 0x6b, 0xac, 0xaa, 0x66, 0x21, 0xf1, 0x60,
-	0x3c, 0xaa, 0x31, 0x1a, 0x8f, 0x7a, 0x36, 0x1e, 0x39, 0xf8, 0x2f, 0x98, 0x70, 0x73, 0xe3, 0xc9,
-	0x93, 0x51, 0x38, 0xf0, 0x6f, 0x98, 0xaa, 0xea, 0xee, 0xe9, 0x19, 0xba, 0xf9, 0xb1, 0xd9, 0x0b,
-	0xd0, 0xdf, 0xfb, 0xba, 0xea, 0x7b, 0xdf, 0x7b, 0xaf, 0xba, 0x80, 0x39, 0xe4, 0x22, 0x4c, 0xda,
-	0xd4, 0x95, 0xec, 0x73, 0x12, 0x34, 0x30, 0xf1, 0x38, 0x21, 0x0d, 0xb9, 0x57, 0xef, 0x70, 0x26,
-	0x99, 0x5d, 0xea, 0x09, 0xd7, 0x4d, 0xb8, 0x52, 0xf2, 0x98, 0xc7, 0x34, 0xa1, 0xa1, 0xfe, 0x32,
-	0xdc, 0xca, 0x35, 0x97, 0x89, 0x36, 0x13, 0x8e, 0x09, 0x98, 0x87, 0x28, 0x74, 0xd5, 0x3c, 0x35,
-	0xda, 0xc2, 0x6b, 0xec, 0xde, 0x51, 0xbf, 0xa2, 0xc0, 0x34, 0x6a, 0xd3, 0x80, 0x35, 0xf4, 0xcf,
-	0x08, 0x7a, 0x29, 0x53, 0x51, 0x07, 0x71, 0xd4, 0x8e, 0x96, 0xab, 0x7d, 0x5b, 0x80, 0xa9, 0x2d,
-	0xe1, 0xb5, 0xc8, 0x17, 0x21, 0x11, 0x72, 0x53, 0x33, 0xec, 0x32, 0xbc, 0xe0, 0x72, 0x82, 0x24,
-	0xe3, 0x65, 0x6b, 0xc1, 0xba, 0x55, 0x6c, 0xc5, 0x8f, 0xf6, 0x1c, 0x80, 0x90, 0x21, 0x26, 0x81,
-	0x74, 0x28, 0x2e, 0x17, 0x74, 0xb0, 0x18, 0x21, 0x4d, 0x6c, 0x2f, 0xc2, 0x15, 0x1a, 0x08, 0x49,
-	0x65, 0x28, 0x29, 0x0b, 0x14, 0x65, 0x50, 0x53, 0x26, 0x52, 0x68, 0x13, 0xdb, 0x2f, 0xc3, 0x84,
-	0x1b, 0x72, 0x4e, 0xdd, 0xd0, 0x0f, 0xdb, 0x8a, 0x35, 0xa4, 0x59, 0xe3, 0x5d, 0xb0, 0x89, 0xed,
-	0xfb, 0x50, 0x26, 0x7b, 0x1d, 0xe2, 0x4a, 0x82, 0x1d, 0x8f, 0x23, 0x1c, 0x22, 0xbd, 0x26, 0x46,
-	0x92, 0x94, 0x87, 0x35, 0x7f, 0x36, 0x8e, 0x3f, 0x4c, 0xc2, 0x9b, 0x48, 0x92, 0xb5, 0xfb, 0x5f,
-	0x9f, 0x1c, 0x2c, 0xc5, 0x92, 0xbf, 0x39, 0x39, 0x58, 0x7a, 0xb5, 0xd7, 0x87, 0xbd, 0xd8, 0x89,
-	0xfe, 0xc4, 0x6b, 0x9f, 0x42, 0xb9, 0x1f, 0x6b, 0x11, 0xd1, 0x61, 0x81, 0x20, 0xf6, 0x12, 0x4c,
-	0x9b, 0xd7, 0x1c, 0x6e, 0xe2, 0x4a, 0xb8, 0xb1, 0x67, 0x12, 0x47, 0x54, 0x8d, 0x37, 0xb1, 0x3d,
-	0x0b, 0x23, 0x42, 0x22, 0x19, 0x8a, 0xc8, 0xa2, 0xe8, 0xa9, 0xf6, 0x5d, 0x01, 0xe6, 0xb6, 0x84,
-	0xf7, 0x18, 0xf9, 0x54, 0xe5, 0xb1, 0x99, 0xbc, 0x46, 0x39, 0x69, 0x93, 0x40, 0x8a, 0x33, 0xac,
-	0xcf, 0xdc, 0xbf, 0x90, 0xbd, 0xff, 0x6b, 0x30, 0xe5, 0xb2, 0x40, 0x72, 0xe4, 0x4a, 0x07, 0x61,
-	0xcc, 0x89, 0x10, 0x51, 0x25, 0x26, 0x63, 0xfc, 0x81, 0x81, 0xed, 0x15, 0x78, 0x71, 0xd7, 0xc8,
-	0x51, 0xee, 0xea, 0xde, 0x20, 0x92, 0x70, 0x11, 0xd5, 0xa4, 0xd4, 0x0d, 0x7e, 0x98, 0xc4, 0xd6,
-	0xde, 0xeb, 0x77, 0xf8, 0x5e, 0xbe, 0xc3, 0xf9, 0xc9, 0xd6, 0xfe, 0xb3, 0x60, 0xf1, 0x4c, 0x46,
-	0x62, 0xfe, 0xeb, 0x30, 0xdd, 0xa3, 0x52, 0x08, 0x62, 0xcc, 0x1f, 0x6d, 0x4d, 0xa5, 0x15, 0x2a,
-	0x5c, 0x65, 0x9f, 0x22, 0x0b, 0x97, 0x71, 0x12, 0x1b, 0xd5, 0xc5, 0x1f, 0x29, 0xd8, 0x5e, 0x06,
-	0x3b, 0x45, 0xc5, 0x44, 0x22, 0xea, 0xc7, 0x56, 0xa5, 0x76, 0xdc, 0x34, 0x01, 0xfb, 0x0e, 0x94,
-	0xda, 0x54, 0x08, 0x1a, 0x78, 0xba, 0x08, 0xb1, 0xcc, 0xf2, 0xd0, 0xc2, 0xe0, 0xad, 0x62, 0x6b,
-	0x26, 0x8a, 0xf5, 0xe4, 0xf8, 0x73, 0x01, 0xae, 0x6c, 0x09, 0xaf, 0x29, 0x44, 0x48, 0xce, 0x1d,
-	0xaf, 0xcb, 0xd4, 0xf8, 0x3a, 0x14, 0xb7, 0x69, 0x80, 0x7c, 0xc7, 0xeb, 0xa0, 0x48, 0xf1, 0xa8,
-	0x06, 0x1e, 0x76, 0x90, 0x9a, 0x30, 0xc9, 0x24, 0xf2, 0x1d, 0x97, 0x13, 0x4c, 0xa5, 0xa9, 0xe6,
-	0x50, 0x6b, 0x5c, 0x83, 0x1b, 0x06, 0xb3, 0xab, 0x00, 0x82, 0x7a, 0x01, 0x92, 0x21, 0x27, 0xa2,
-	0x3c, 0xac, 0x73, 0x48, 0x21, 0xca, 0x47, 0x84, 0x31, 0x55, 0x06, 0x20, 0xdf, 0x09, 0x98, 0x24,
-	0xa2, 0x3c, 0x62, 0xc4, 0x74, 0xf1, 0x0f, 0x14, 0xbc, 0xb6, 0xda, 0xdf, 0x10, 0x8b, 0xf9, 0x0d,
-	0x91, 0xb2, 0xa2, 0xf6, 0x93, 0x05, 0xb3, 0xbd, 0x50, 0x52, 0xf2, 0xeb, 0x50, 0x8c, 0xbc, 0x48,
-	0xe6, 0x6c, 0xd4, 0x00, 0x4d, 0x6c, 0x2f, 0xc0, 0x78, 0xb0, 0x2d, 0x1d, 0xbd, 0x78, 0xd7, 0x23,
-	0x08, 0xb6, 0xe5, 0x47, 0x0a, 0x32, 0xf6, 0xd0, 0xce, 0xb6, 0x70, 0x76, 0x90, 0xd8, 0x89, 0xed,
-	0x51, 0xc0, 0xfb, 0x48, 0xec, 0xa8, 0x63, 0x8c, 0xaa, 0x2d, 0xcd, 0x69, 0x62, 0x3a, 0xbd, 0xa8,
-	0x11, 0x75, 0x80, 0xd4, 0x7e, 0x29, 0xc0, 0xd5, 0x2d, 0xe1, 0x7d, 0xdc, 0xe9, 0x76, 0xe5, 0x46,
-	0x34, 0x36, 0xf6, 0x2a, 0x14, 0x51, 0x28, 0x77, 0x18, 0xa7, 0x72, 0xdf, 0xc8, 0x5a, 0x2f, 0xff,
-	0xf5, 0xfb, 0x72, 0x29, 0x3a, 0xa4, 0xa3, 0xb1, 0x7a, 0x24, 0xb9, 0x6a, 0x84, 0x2e, 0xd5, 0xbe,
-	0x0d, 0xa5, 0x80, 0x3c, 0x71, 0x4e, 0x8d, 0xa5, 0x51, 0x6e, 0x07, 0xe4, 0xc9, 0x46, 0xdf, 0x64,
-	0xa6, 0x87, 0x78, 0x97, 0x70, 0x41, 0x59, 0xd0, 0x3f, 0xc4, 0x8f, 0x0d, 0xac, 0xa8, 0x6d, 0xea,
-	0x71, 0xd3, 0xc5, 0x9c, 0x20, 0xc1, 0x82, 0x28, 0xab, 0xc9, 0x04, 0x6f, 0x69, 0x78, 0xed, 0x81,
-	0xaa, 0x54, 0x57, 0x97, 0xaa, 0x55, 0x3d, 0xbf, 0x56, 0x59, 0x16, 0xd4, 0x7e, 0xb5, 0x60, 0x3e,
-	0x27, 0x96, 0x54, 0xef, 0x36, 0x94, 0x98, 0x8f, 0x4f, 0xa7, 0x6b, 0x0a, 0x69, 0x33, 0x1f, 0xf7,
-	0xa7, 0x7b, 0x79, 0x83, 0xe6, 0x61, 0x2c, 0xd4, 0x1a, 0x4c, 0x19, 0x8d, 0x37, 0x60, 0x20, 0x5d,
-	0xc7, 0x3f, 0x4d, 0x77, 0x6d, 0xa0, 0xc0, 0x25, 0xfe, 0x66, 0x7a, 0x7e, 0x9e, 0xd3, 0x0c, 0x36,
-	0x60, 0xc6, 0xd5, 0x8b, 0xfb, 0x3d, 0xd6, 0x1b, 0x25, 0x76, 0x3a, 0x14, 0xb9, 0xff, 0x4e, 0xff,
-	0x9c, 0x2c, 0xe7, 0x7b, 0x9f, 0x21, 0xbb, 0xf6, 0xa3, 0x05, 0xd5, 0xec, 0xd0, 0xf3, 0xfc, 0x4e,
-	0xa9, 0xe3, 0xb6, 0x27, 0xaf, 0x94, 0xbf, 0x53, 0xe9, 0x80, 0x76, 0xf9, 0x0f, 0x0b, 0x26, 0x93,
-	0x76, 0xd0, 0x1f, 0x09, 0xf1, 0xcc, 0x53, 0xf2, 0x2e, 0x8c, 0x98, 0xeb, 0x89, 0x16, 0x34, 0x76,
-	0xf7, 0x46, 0x3d, 0xeb, 0xd6, 0x54, 0x37, 0xbb, 0xac, 0x17, 0x0f, 0xff, 0x99, 0x1f, 0xf8, 0xed,
-	0xe4, 0x60, 0xc9, 0x6a, 0x45, 0xaf, 0xad, 0xbd, 0x75, 0xba, 0xbd, 0x6f, 0x9e, 0xd7, 0xde, 0x66,
-	0xb5, 0xda, 0xb5, 0xd4, 0xd0, 0x1b, 0x28, 0xf6, 0xf4, 0xee, 0xe1, 0x30, 0x0c, 0x6e, 0x09, 0xcf,
-	0xf6, 0x60, 0xa2, 0xf7, 0xa6, 0x74, 0x33, 0x5b, 0x5f, 0xff, 0x25, 0xa2, 0x52, 0xbf, 0x18, 0x2f,
-	0x29, 0xe2, 0xf7, 0x16, 0x54, 0xce, 0xb8, 0x25, 0xac, 0xe4, 0x2e, 0x97, 0xff, 0x52, 0xe5, 0xed,
-	0x67, 0x78, 0x29, 0x11, 0x84, 0x60, 0x2c, 0xfd, 0x09, 0x7b, 0x25, 0x77, 0xad, 0x14, 0xab, 0xf2,
-	0xc6, 0x45, 0x58, 0xc9, 0x16, 0x5f, 0x42, 0x29, 0xf3, 0xc4, 0x5d, 0xce, 0x5d, 0x25, 0x8b, 0x5e,
-	0x79, 0xf3, 0x52, 0xf4, 0x64, 0xf7, 0x7d, 0x98, 0xc9, 0x3a, 0x27, 0xf2, 0x53, 0xc8, 0x60, 0x57,
-	0xee, 0x5d, 0x86, 0x9d, 0x6c, 0x8d, 0x61, 0xbc, 0x67, 0x78, 0x16, 0xcf, 0xc9, 0xc0, 0xd0, 0x2a,
-	0xcb, 0x17, 0xa2, 0xc5, 0xbb, 0x54, 0x86, 0xbf, 0x52, 0x83, 0xb2, 0xbe, 0x7a, 0x78, 0x54, 0xb5,
-	0x9e, 0x1e, 0x55, 0xad, 0x7f, 0x8f, 0xaa, 0xd6, 0x0f, 0xc7, 0xd5, 0x81, 0xa7, 0xc7, 0xd5, 0x81,
-	0xbf, 0x8f, 0xab, 0x03, 0x9f, 0xdc, 0xc8, 0x99, 0x13, 0xb9, 0xdf, 0x21, 0xe2, 0xb3, 0x11, 0xfd,
-	0xff, 0xc2, 0xca, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x92, 0x20, 0x24, 0xb6, 0xe6, 0x0c, 0x00,
-	0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xba, 0x49, 0x88, 0x5f, 0x92, 0x26, 0xd9, 0x98, 0xd4, 0x75, 0x1b, 0x27, 0x18, 0x52,
+	0xd2, 0x40, 0xec, 0x92, 0x94, 0xa8, 0x04, 0x09, 0xd4, 0x24, 0xa8, 0x18, 0xc9, 0x08, 0xb9, 0xd0,
+	0x03, 0x48, 0xac, 0x86, 0x9d, 0xc9, 0x66, 0x84, 0xbd, 0x63, 0x66, 0x66, 0xd3, 0x44, 0xe2, 0x80,
+	0x38, 0x02, 0x42, 0x54, 0x1c, 0xe1, 0x8c, 0x38, 0xe6, 0xc0, 0xbf, 0x80, 0x94, 0x1b, 0x15, 0x27,
+	0x4e, 0x08, 0x25, 0x87, 0xfc, 0x1b, 0x68, 0x66, 0x76, 0xd7, 0x6b, 0x67, 0x37, 0x3f, 0xaa, 0x5e,
+	0xea, 0xee, 0xf7, 0xbe, 0x99, 0x79, 0xef, 0x7b, 0xef, 0x9b, 0xdd, 0xc0, 0x2c, 0x72, 0x11, 0x26,
+	0x6d, 0xea, 0x4a, 0xf6, 0x25, 0xf1, 0x6b, 0x98, 0x78, 0x9c, 0x90, 0x9a, 0xdc, 0xab, 0x76, 0x38,
+	0x93, 0xcc, 0x2e, 0xf4, 0x84, 0xab, 0x26, 0x5c, 0x2a, 0x78, 0xcc, 0x63, 0x9a, 0x50, 0x53, 0xff,
+	0x33, 0xdc, 0xd2, 0x75, 0x97, 0x89, 0x36, 0x13, 0x8e, 0x09, 0x98, 0x87, 0x30, 0x74, 0xcd, 0x3c,
+	0xd5, 0xda, 0xc2, 0xab, 0xed, 0xbe, 0xa1, 0x7e, 0xc2, 0xc0, 0x14, 0x6a, 0x53, 0x9f, 0xd5, 0xf4,
+	0xbf, 0x21, 0xf4, 0x52, 0x6a, 0x46, 0x1d, 0xc4, 0x51, 0x3b, 0xda, 0xee, 0x76, 0x2a, 0xc5, 0xfc,
+	0x38, 0x9c, 0x7c, 0x15, 0x10, 0x21, 0x0d, 0xb5, 0xf2, 0x7d, 0x0e, 0x26, 0x1b, 0xc2, 0x6b, 0x1a,
+	0x70, 0x4b, 0x53, 0xec, 0x22, 0xbc, 0xe0, 0x72, 0x82, 0x24, 0xe3, 0x45, 0x6b, 0xde, 0x5a, 0xcc,
+	0x37, 0xa3, 0x47, 0x7b, 0x16, 0x40, 0xc8, 0x00, 0x13, 0x5f, 0x3a, 0x14, 0x17, 0x73, 0x3a, 0x98,
+	0x0f, 0x91, 0x3a, 0xb6, 0x17, 0xe0, 0x2a, 0xf5, 0x85, 0xa4, 0x32, 0x90, 0x94, 0xf9, 0x8a, 0x72,
+	0x45, 0x53, 0xc6, 0x13, 0x68, 0x1d, 0xdb, 0x2f, 0xc3, 0xb8, 0x1b, 0x70, 0x4e, 0xdd, 0xa0, 0x15,
+	0xb4, 0x15, 0x6b, 0x50, 0xb3, 0xc6, 0xba, 0x60, 0x1d, 0xdb, 0xf7, 0xa0, 0x48, 0xf6, 0x3a, 0xc4,
+	0x95, 0x04, 0x3b, 0x1e, 0x47, 0x38, 0x40, 0x7a, 0x4f, 0x8c, 0x24, 0x29, 0x0e, 0x69, 0xfe, 0x4c,
+	0x14, 0x7f, 0x10, 0x87, 0xb7, 0x90, 0x24, 0xeb, 0xf7, 0xbe, 0x3d, 0x39, 0x58, 0x8a, 0x52, 0xfe,
+	0xee, 0xe4, 0x60, 0xe9, 0xd5, 0x5e, 0x3d, 0xf6, 0x22, 0x45, 0xfa, 0x0b, 0xaf, 0x7c, 0x0e, 0xc5,
+	0x7e, 0xac, 0x49, 0x44, 0x87, 0xf9, 0x82, 0xd8, 0x4b, 0x30, 0xd5, 0xab, 0xa0, 0x4a, 0xdc, 0xc8,
+	0x33, 0x81, 0x43, 0xaa, 0xc6, 0xeb, 0xd8, 0x9e, 0x81, 0x61, 0x21, 0x91, 0x0c, 0x44, 0x28, 0x51,
+	0xf8, 0x54, 0xf9, 0x21, 0x07, 0xb3, 0x0d, 0xe1, 0x3d, 0x42, 0x2d, 0xaa, 0xea, 0xd8, 0x8a, 0x97,
+	0x51, 0x4e, 0xda, 0xc4, 0x97, 0xe2, 0x0c, 0xe9, 0x53, 0xcf, 0xcf, 0xa5, 0x9f, 0x7f, 0x1b, 0x26,
+	0x5d, 0xe6, 0x4b, 0x8e, 0x5c, 0xe9, 0x20, 0x8c, 0x39, 0x11, 0x22, 0xec, 0xc4, 0x44, 0x84, 0xdf,
+	0x37, 0xb0, 0xbd, 0x0a, 0x2f, 0xee, 0x9a, 0x74, 0x94, 0xba, 0x7a, 0x8c, 0x88, 0x24, 0x5c, 0x84,
+	0x3d, 0x29, 0x74, 0x83, 0x1f, 0xc5, 0xb1, 0xf5, 0xf7, 0xfa, 0x15, 0xbe, 0x9b, 0xad, 0x70, 0x76,
+	0xb1, 0x95, 0x27, 0x39, 0x58, 0x38, 0x93, 0x11, 0x8b, 0xff, 0x1a, 0x4c, 0xf5, 0x64, 0x29, 0x04,
+	0x31, 0xe2, 0x8f, 0x34, 0x27, 0x93, 0x19, 0x2a, 0x5c, 0x55, 0x9f, 0x20, 0x0b, 0x97, 0x71, 0x12,
+	0x09, 0xd5, 0xc5, 0x1f, 0x2a, 0xd8, 0x5e, 0x06, 0x3b, 0x41, 0xc5, 0x44, 0x22, 0xda, 0x8a, 0xa4,
+	0x4a, 0x9c, 0xb8, 0x65, 0x02, 0xf6, 0x67, 0x50, 0x68, 0x53, 0x21, 0xa8, 0xef, 0xe9, 0x26, 0x44,
+	0x69, 0x16, 0x87, 0xe6, 0xaf, 0x2c, 0x8e, 0xae, 0x2c, 0x56, 0xd3, 0x6e, 0x83, 0x6a, 0xc3, 0xac,
+	0x48, 0xd4, 0xd5, 0x9c, 0x6e, 0x9f, 0xc2, 0xc4, 0x07, 0x83, 0x23, 0x83, 0x93, 0x43, 0x95, 0x5f,
+	0x72, 0x70, 0xb5, 0x21, 0xbc, 0xba, 0x10, 0x01, 0x39, 0xd7, 0x8e, 0x97, 0x99, 0x89, 0x1b, 0x90,
+	0xdf, 0xa6, 0x3e, 0x6a, 0x39, 0x5e, 0x07, 0x85, 0x15, 0x8e, 0x68, 0xe0, 0x41, 0x07, 0x29, 0x47,
+	0x4a, 0x26, 0x51, 0xcb, 0x71, 0x39, 0xc1, 0x54, 0x9a, 0xee, 0x0f, 0x36, 0xc7, 0x34, 0xb8, 0x69,
+	0x30, 0xbb, 0x0c, 0x20, 0xa8, 0xe7, 0x23, 0x19, 0x70, 0x62, 0x6a, 0xce, 0x37, 0x13, 0x88, 0xd2,
+	0x1d, 0x61, 0x4c, 0x95, 0x60, 0xa8, 0xe5, 0xf8, 0x4c, 0x12, 0x51, 0x1c, 0x36, 0xc9, 0x74, 0xf1,
+	0x0f, 0x15, 0xbc, 0xbe, 0xd6, 0x3f, 0x40, 0x0b, 0xd9, 0x03, 0x94, 0x90, 0xa2, 0xf2, 0xb3, 0x05,
+	0x33, 0xbd, 0x50, 0x3c, 0x22, 0x37, 0x20, 0x1f, 0x6a, 0x11, 0xfb, 0x72, 0xc4, 0x00, 0x75, 0x6c,
+	0xcf, 0xc3, 0x98, 0xbf, 0x2d, 0x1d, 0xbd, 0x79, 0x57, 0x23, 0xf0, 0xb7, 0xe5, 0xc7, 0x0a, 0x32,
+	0xf2, 0xd0, 0xce, 0xb6, 0x70, 0x76, 0x90, 0xd8, 0x89, 0xe4, 0x51, 0xc0, 0xfb, 0x48, 0xec, 0xa8,
+	0x6b, 0x8f, 0xaa, 0x23, 0xcd, 0xed, 0x63, 0x9c, 0x91, 0xd7, 0x88, 0xba, 0x70, 0x2a, 0xbf, 0xe6,
+	0xe0, 0x5a, 0x43, 0x78, 0x9f, 0x74, 0xba, 0x53, 0xbc, 0x19, 0xda, 0xcc, 0x5e, 0x83, 0x3c, 0x0a,
+	0xe4, 0x0e, 0xe3, 0x54, 0xee, 0x9b, 0xb4, 0x36, 0x8a, 0x7f, 0xff, 0xb1, 0x5c, 0x08, 0xef, 0xff,
+	0xd0, 0x86, 0x0f, 0x25, 0x57, 0xe3, 0xd0, 0xa5, 0xda, 0x77, 0xa0, 0xe0, 0x93, 0xc7, 0xce, 0x29,
+	0x1b, 0x9b, 0xcc, 0x6d, 0x9f, 0x3c, 0xde, 0xec, 0x73, 0x72, 0xd2, 0xf4, 0xbb, 0x84, 0x0b, 0xca,
+	0xfc, 0x7e, 0xd3, 0x3f, 0x32, 0xb0, 0xa2, 0xb6, 0xa9, 0xc7, 0xcd, 0xd4, 0x73, 0x82, 0x04, 0xf3,
+	0xc3, 0xaa, 0x26, 0x62, 0xbc, 0xa9, 0xe1, 0xf5, 0xfb, 0xaa, 0x53, 0xdd, 0xbc, 0x54, 0xaf, 0xaa,
+	0xd9, 0xbd, 0x4a, 0x93, 0xa0, 0xf2, 0x9b, 0x05, 0x73, 0x19, 0xb1, 0xb8, 0x7b, 0x77, 0xa0, 0xc0,
+	0x5a, 0xf8, 0x74, 0xb9, 0xa6, 0x91, 0x36, 0x6b, 0xe1, 0xfe, 0x72, 0x2f, 0x2f, 0xd0, 0x1c, 0x8c,
+	0x06, 0x3a, 0x07, 0xd3, 0x46, 0xa3, 0x0d, 0x18, 0x48, 0xf7, 0xf1, 0x2f, 0x33, 0x5d, 0x9b, 0xc8,
+	0x77, 0x49, 0x6b, 0x2b, 0xe9, 0x9f, 0xe7, 0xe4, 0xc1, 0x1a, 0x4c, 0xbb, 0x7a, 0xf3, 0x56, 0x8f,
+	0xf4, 0x26, 0x13, 0x3b, 0x19, 0x0a, 0xd5, 0x7f, 0xa7, 0xdf, 0x27, 0xcb, 0xd9, 0xda, 0xa7, 0xa4,
+	0x5d, 0x79, 0x62, 0x41, 0x39, 0x3d, 0xf4, 0x3c, 0xdf, 0x6b, 0xea, 0x7a, 0xee, 0xa9, 0x2b, 0xa1,
+	0xef, 0x64, 0x32, 0xa0, 0x55, 0xfe, 0xd3, 0x82, 0x89, 0x78, 0x1c, 0xf4, 0x4b, 0x45, 0x3c, 0xb3,
+	0x4b, 0xde, 0x85, 0x61, 0xf3, 0xe5, 0xa3, 0x13, 0x1a, 0x5d, 0xb9, 0x99, 0x7e, 0x05, 0x9b, 0x53,
+	0x36, 0xf2, 0x87, 0xff, 0xce, 0x0d, 0xfc, 0x7e, 0x72, 0xb0, 0x64, 0x35, 0xc3, 0x65, 0xeb, 0x6f,
+	0x9d, 0x1e, 0xef, 0x5b, 0xe7, 0x8d, 0xb7, 0xd9, 0xad, 0x72, 0x3d, 0x61, 0x7a, 0x03, 0x45, 0x9a,
+	0xae, 0x1c, 0x0e, 0xc1, 0x95, 0x86, 0xf0, 0x6c, 0x0f, 0xc6, 0x7b, 0xbf, 0xac, 0x6e, 0x65, 0xbc,
+	0x22, 0xfa, 0x3e, 0x3a, 0x4a, 0xd5, 0x8b, 0xf1, 0xe2, 0x26, 0xfe, 0x68, 0x41, 0xe9, 0x8c, 0xaf,
+	0x8a, 0xd5, 0xcc, 0xed, 0xb2, 0x17, 0x95, 0xde, 0x7e, 0x86, 0x45, 0x71, 0x42, 0x08, 0x46, 0x93,
+	0xaf, 0xb0, 0x57, 0x32, 0xf7, 0x4a, 0xb0, 0x4a, 0xaf, 0x5f, 0x84, 0x15, 0x1f, 0xf1, 0x35, 0x14,
+	0x52, 0x6f, 0xdc, 0xe5, 0xcc, 0x5d, 0xd2, 0xe8, 0xa5, 0x37, 0x2f, 0x45, 0x8f, 0x4f, 0xdf, 0x87,
+	0xe9, 0xb4, 0x7b, 0x22, 0xbb, 0x84, 0x14, 0x76, 0xe9, 0xee, 0x65, 0xd8, 0xf1, 0xd1, 0x18, 0xc6,
+	0x7a, 0xcc, 0xb3, 0x70, 0x4e, 0x05, 0x86, 0x56, 0x5a, 0xbe, 0x10, 0x2d, 0x3a, 0xa5, 0x34, 0xf4,
+	0x8d, 0x32, 0xca, 0xc6, 0xda, 0xe1, 0x51, 0xd9, 0x7a, 0x7a, 0x54, 0xb6, 0xfe, 0x3b, 0x2a, 0x5b,
+	0x3f, 0x1d, 0x97, 0x07, 0x9e, 0x1e, 0x97, 0x07, 0xfe, 0x39, 0x2e, 0x0f, 0x7c, 0x7a, 0x33, 0xc3,
+	0x27, 0x72, 0xbf, 0x43, 0xc4, 0x17, 0xc3, 0xfa, 0xef, 0x8b, 0xd5, 0xff, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0xcc, 0x5b, 0xc5, 0x5b, 0x41, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1327,11 +1328,16 @@ func (m *MsgValidateDegreeRequirementsResponse) MarshalToSizedBuffer(dAtA []byte
 	_ = l
 	if len(m.MissingRequirements) > 0 {
 		for iNdEx := len(m.MissingRequirements) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MissingRequirements[iNdEx])
-			copy(dAtA[i:], m.MissingRequirements[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.MissingRequirements[iNdEx])))
+			{
+				size, err := m.MissingRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2a
 		}
 	}
 	if len(m.ValidationDetails) > 0 {
@@ -1823,8 +1829,8 @@ func (m *MsgValidateDegreeRequirementsResponse) Size() (n int) {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if len(m.MissingRequirements) > 0 {
-		for _, s := range m.MissingRequirements {
-			l = len(s)
+		for _, e := range m.MissingRequirements {
+			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
@@ -2623,11 +2629,11 @@ func (m *MsgValidateDegreeRequirementsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidationDetails = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MissingRequirements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2637,23 +2643,25 @@ func (m *MsgValidateDegreeRequirementsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissingRequirements = append(m.MissingRequirements, string(dAtA[iNdEx:postIndex]))
+			m.MissingRequirements = append(m.MissingRequirements, &MissingRequirement{})
+			if err := m.MissingRequirements[len(m.MissingRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
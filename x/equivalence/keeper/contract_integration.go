@@ -44,7 +44,7 @@ func (k Keeper) CallEquivalenceContract(ctx context.Context, equivalenceId strin
 		"target_subject": "%s"
 	}`, 
 		contractAddress, 
-		time.Now().Format(time.RFC3339),
+		sdk.UnwrapSDKContext(ctx).BlockTime().UTC().Format(time.RFC3339),
 		types.DefaultSimilarityAlgorithm,
 		k.GetIPFSGateway(ctx),
 		k.GetMinApprovalThreshold(ctx),
@@ -358,7 +358,7 @@ func (k Keeper) CleanupFailedAnalyses(ctx context.Context, maxAge int64) uint64
 	allEquivalences := k.GetAllSubjectEquivalences(ctx)
 	cleaned := uint64(0)
 	
-	currentTime := time.Now().Unix()
+	currentTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
 	
 	for _, eq := range allEquivalences {
 		if eq.EquivalenceStatus == types.EquivalenceStatusError {
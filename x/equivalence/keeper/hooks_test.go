@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/x/equivalence/types"
+)
+
+type approvalRecorder struct {
+	approved []string
+}
+
+func (r *approvalRecorder) AfterEquivalenceApproved(_ context.Context, equivalence types.SubjectEquivalence) error {
+	r.approved = append(r.approved, equivalence.Index)
+	return nil
+}
+
+func TestAfterEquivalenceApprovedHook(t *testing.T) {
+	k, ctx := keepertest.EquivalenceKeeper(t)
+	recorder := &approvalRecorder{}
+	k.SetHooks(recorder)
+	require.Panics(t, func() { k.SetHooks(recorder) })
+
+	index := types.GenerateEquivalenceIndex("subject-1", "subject-2")
+	k.SetSubjectEquivalence(ctx, types.SubjectEquivalence{Index: index, SourceSubjectId: "subject-1", TargetSubjectId: "subject-2", EquivalenceStatus: types.EquivalenceStatusPending})
+
+	require.NoError(t, k.UpdateEquivalenceAnalysis(ctx, index, "contract", "50.00", "{}", "v1"))
+	require.Empty(t, recorder.approved)
+
+	// Only the transition to approved is reported
+	require.NoError(t, k.UpdateEquivalenceAnalysis(ctx, index, "contract", "90.00", "{}", "v1"))
+	require.NoError(t, k.UpdateEquivalenceAnalysis(ctx, index, "contract", "95.00", "{}", "v1"))
+	require.Equal(t, []string{index}, recorder.approved)
+}
@@ -32,6 +32,8 @@ type (
 
 		subjectKeeper     types.SubjectKeeper
 		institutionKeeper types.InstitutionKeeper
+
+		hooks types.EquivalenceHooks
 	}
 )
 
@@ -63,6 +65,15 @@ func (k *Keeper) SetInstitutionKeeper(institutionKeeper types.InstitutionKeeper)
 	k.institutionKeeper = institutionKeeper
 }
 
+// SetHooks sets the hooks called on equivalence decisions. It can only be
+// called once.
+func (k *Keeper) SetHooks(hooks types.EquivalenceHooks) {
+	if k.hooks != nil {
+		panic("cannot set equivalence hooks twice")
+	}
+	k.hooks = hooks
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -313,7 +324,7 @@ func (k Keeper) CreateEquivalenceRequest(ctx context.Context, sourceSubjectId, t
 	}
 
 	// Create or update equivalence
-	now := strconv.FormatInt(time.Now().Unix(), 10)
+	now := strconv.FormatInt(sdk.UnwrapSDKContext(ctx).BlockTime().Unix(), 10)
 
 	equivalence := types.SubjectEquivalence{
 		Index:               index,
@@ -355,7 +366,8 @@ func (k Keeper) UpdateEquivalenceAnalysis(ctx context.Context, equivalenceId, co
 	status := k.determineStatusFromPercent(equivalencePercent)
 
 	// Update equivalence
-	now := strconv.FormatInt(time.Now().Unix(), 10)
+	wasApproved := equivalence.EquivalenceStatus == types.EquivalenceStatusApproved
+	now := strconv.FormatInt(sdk.UnwrapSDKContext(ctx).BlockTime().Unix(), 10)
 	equivalence.EquivalenceStatus = status
 	equivalence.EquivalencePercent = equivalencePercent
 	equivalence.AnalysisMetadata = analysisMetadata
@@ -365,6 +377,11 @@ func (k Keeper) UpdateEquivalenceAnalysis(ctx context.Context, equivalenceId, co
 	equivalence.LastUpdateTimestamp = now
 
 	k.SetSubjectEquivalence(ctx, equivalence)
+
+	// Re-analyses confirming an approval do not approve it again
+	if status == types.EquivalenceStatusApproved && !wasApproved && k.hooks != nil {
+		return k.hooks.AfterEquivalenceApproved(ctx, equivalence)
+	}
 	return nil
 }
 
@@ -576,129 +593,105 @@ func (k Keeper) determineStatusFromPercent(percentStr string) string {
 }
 
 // ============================================================================
-// HARDCODED CONFIGURATION METHODS (FIXED)
+// CONFIGURATION METHODS
 // ============================================================================
 
-// GetHardcodedConfiguration returns all hardcoded configuration values
-func (k Keeper) GetHardcodedConfiguration(ctx context.Context) map[string]interface{} {
+// GetConfiguration returns all configuration values from the module params
+func (k Keeper) GetConfiguration(ctx context.Context) map[string]interface{} {
+	params := k.GetParams(ctx)
 	config := map[string]interface{}{
-		"equivalence_contract_address": types.GetHardcodedEquivalenceContractAddress(),
-		"ipfs_gateway":                types.GetHardcodedIPFSGateway(),
-		"ipfs_enabled":                types.IsHardcodedIPFSEnabled(),
-		"min_approval_threshold":      types.GetHardcodedMinApprovalThreshold(),
-		"max_analysis_retries":        types.GetHardcodedMaxAnalysisRetries(),
-		"analysis_timeout_seconds":    types.GetHardcodedAnalysisTimeoutSeconds(),
-		"require_contract_auth":       types.IsHardcodedContractAuthRequired(),
-		"admin":                       types.GetHardcodedAdmin(),
-	}
-	
-	k.Logger().Debug("Retrieved hardcoded configuration", "config", config)
+		"equivalence_contract_address": params.EquivalenceContractAddress,
+		"ipfs_gateway":                 params.IpfsGateway,
+		"ipfs_enabled":                 params.IpfsEnabled,
+		"min_approval_threshold":       params.MinApprovalThreshold,
+		"max_analysis_retries":         params.MaxAnalysisRetries,
+		"analysis_timeout_seconds":     params.AnalysisTimeoutSeconds,
+		"require_contract_auth":        params.RequireContractAuth,
+		"admin":                        params.Admin,
+	}
+
+	k.Logger().Debug("Retrieved configuration", "config", config)
 	return config
 }
 
-// GetContractConfigurationInternal returns contract-specific hardcoded configuration
+// GetContractConfigurationInternal returns contract-specific configuration
 func (k Keeper) GetContractConfigurationInternal(ctx context.Context) types.ContractInfo {
 	info := types.ContractInfo{
-		Address:         types.GetHardcodedEquivalenceContractAddress(),
-		Version:         "v1.0.0",
-		Status:          "active",
-		LastUpdated:     time.Now().Format(time.RFC3339),
-		AnalysisCount:   0,
-		SuccessRate:     "100.0",
-		AverageGasUsed:  150000,
-	}
-	
-	k.Logger().Debug("Retrieved hardcoded contract configuration", "info", info)
+		Address:        k.GetEquivalenceContractAddress(ctx),
+		Version:        "v1.0.0",
+		Status:         "active",
+		LastUpdated:    sdk.UnwrapSDKContext(ctx).BlockTime().UTC().Format(time.RFC3339),
+		AnalysisCount:  0,
+		SuccessRate:    "100.0",
+		AverageGasUsed: 150000,
+	}
+
+	k.Logger().Debug("Retrieved contract configuration", "info", info)
 	return info
 }
 
-// GetAnalysisConfigurationInternal returns analysis-specific hardcoded configuration
+// GetAnalysisConfigurationInternal returns analysis-specific configuration
 func (k Keeper) GetAnalysisConfigurationInternal(ctx context.Context) types.AnalysisConfig {
-	config := types.GetDefaultAnalysisConfig()
-	k.Logger().Debug("Retrieved hardcoded analysis configuration", "config", config)
+	config := k.GetParams(ctx).AnalysisConfig()
+	k.Logger().Debug("Retrieved analysis configuration", "config", config)
 	return config
 }
 
-// ValidateHardcodedConfigurationInternal validates all hardcoded configuration values
-func (k Keeper) ValidateHardcodedConfigurationInternal(ctx context.Context) error {
-	k.Logger().Info("Validating hardcoded configuration")
-	
-	// Validate contract address
-	contractAddr := types.GetHardcodedEquivalenceContractAddress()
-	if !types.IsValidContractAddress(contractAddr) {
-		k.Logger().Error("Invalid hardcoded contract address", "address", contractAddr)
-		return fmt.Errorf("invalid hardcoded contract address")
-	}
-	
-	// Validate IPFS gateway
-	ipfsGateway := types.GetHardcodedIPFSGateway()
-	if ipfsGateway == "" {
-		k.Logger().Error("Invalid hardcoded IPFS gateway", "gateway", ipfsGateway)
-		return fmt.Errorf("invalid hardcoded IPFS gateway: cannot be empty")
-	}
-	
-	// Validate min approval threshold
-	threshold := types.GetHardcodedMinApprovalThreshold()
-	if !types.IsValidEquivalencePercent(threshold) {
-		k.Logger().Error("Invalid hardcoded min approval threshold", "threshold", threshold)
-		return fmt.Errorf("invalid hardcoded min approval threshold")
-	}
-	
-	// Validate max retries
-	maxRetries := types.GetHardcodedMaxAnalysisRetries()
-	if maxRetries == 0 {
-		k.Logger().Error("Invalid hardcoded max analysis retries", "retries", maxRetries)
-		return fmt.Errorf("invalid hardcoded max analysis retries: cannot be zero")
-	}
-	
-	// Validate timeout
-	timeout := types.GetHardcodedAnalysisTimeoutSeconds()
-	if timeout == 0 {
-		k.Logger().Error("Invalid hardcoded analysis timeout", "timeout", timeout)
-		return fmt.Errorf("invalid hardcoded analysis timeout: cannot be zero")
-	}
-	
-	k.Logger().Info("All hardcoded configuration values validated successfully")
+// ValidateConfigurationInternal validates all configuration values
+func (k Keeper) ValidateConfigurationInternal(ctx context.Context) error {
+	params := k.GetParams(ctx)
+
+	if !types.IsValidContractAddress(params.EquivalenceContractAddress) {
+		k.Logger().Error("Equivalence contract address not configured")
+		return fmt.Errorf("equivalence contract address not configured")
+	}
+
+	if err := params.Validate(); err != nil {
+		k.Logger().Error("Invalid equivalence params", "error", err)
+		return fmt.Errorf("invalid equivalence params: %w", err)
+	}
+
 	return nil
 }
 
-// GetSystemStatus returns the overall system status based on hardcoded configuration
+// GetSystemStatus returns the overall system status based on the module params
 func (k Keeper) GetSystemStatus(ctx context.Context) map[string]string {
+	params := k.GetParams(ctx)
 	status := map[string]string{
 		"module_status":          "active",
-		"configuration_source":   "hardcoded",
+		"configuration_source":   "params",
 		"contract_available":     fmt.Sprintf("%t", k.IsContractAvailable(ctx)),
-		"ipfs_enabled":          fmt.Sprintf("%t", types.IsHardcodedIPFSEnabled()),
-		"contract_auth_required": fmt.Sprintf("%t", types.IsHardcodedContractAuthRequired()),
-		"last_validation":       time.Now().Format(time.RFC3339),
+		"ipfs_enabled":           fmt.Sprintf("%t", params.IpfsEnabled),
+		"contract_auth_required": fmt.Sprintf("%t", params.RequireContractAuth),
+		"last_validation":        sdk.UnwrapSDKContext(ctx).BlockTime().UTC().Format(time.RFC3339),
 	}
-	
+
 	// Add contract information
-	contractAddr := types.GetHardcodedEquivalenceContractAddress()
-	if contractAddr != "" {
-		status["contract_address"] = contractAddr
+	if params.EquivalenceContractAddress != "" {
+		status["contract_address"] = params.EquivalenceContractAddress
 		status["contract_version"] = "v1.0.0"
 	}
-	
+
 	// Add IPFS information
-	if types.IsHardcodedIPFSEnabled() {
-		status["ipfs_gateway"] = types.GetHardcodedIPFSGateway()
+	if params.IpfsEnabled {
+		status["ipfs_gateway"] = params.IpfsGateway
 	}
-	
+
 	k.Logger().Debug("Retrieved system status", "status", status)
 	return status
 }
 
-// LogHardcodedConfiguration logs all hardcoded configuration for debugging
-func (k Keeper) LogHardcodedConfiguration(ctx context.Context) {
-	k.Logger().Info("=== HARDCODED CONFIGURATION ===")
-	k.Logger().Info("Contract Address", "value", types.GetHardcodedEquivalenceContractAddress())
-	k.Logger().Info("IPFS Gateway", "value", types.GetHardcodedIPFSGateway())
-	k.Logger().Info("IPFS Enabled", "value", types.IsHardcodedIPFSEnabled())
-	k.Logger().Info("Min Approval Threshold", "value", types.GetHardcodedMinApprovalThreshold())
-	k.Logger().Info("Max Analysis Retries", "value", types.GetHardcodedMaxAnalysisRetries())
-	k.Logger().Info("Analysis Timeout Seconds", "value", types.GetHardcodedAnalysisTimeoutSeconds())
-	k.Logger().Info("Contract Auth Required", "value", types.IsHardcodedContractAuthRequired())
-	k.Logger().Info("Admin Address", "value", types.GetHardcodedAdmin())
-	k.Logger().Info("=== END HARDCODED CONFIGURATION ===")
+// LogConfiguration logs the current configuration for debugging
+func (k Keeper) LogConfiguration(ctx context.Context) {
+	params := k.GetParams(ctx)
+	k.Logger().Info("Equivalence configuration",
+		"contract_address", params.EquivalenceContractAddress,
+		"ipfs_gateway", params.IpfsGateway,
+		"ipfs_enabled", params.IpfsEnabled,
+		"min_approval_threshold", params.MinApprovalThreshold,
+		"max_analysis_retries", params.MaxAnalysisRetries,
+		"analysis_timeout_seconds", params.AnalysisTimeoutSeconds,
+		"require_contract_auth", params.RequireContractAuth,
+		"admin", params.Admin,
+	)
 }
@@ -40,7 +40,9 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	// Set the new params
-	k.SetParams(ctx, req.Params)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, fmt.Errorf("failed to set params: %w", err)
+	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -100,7 +100,7 @@ func (k msgServer) ExecuteEquivalenceAnalysis(goCtx context.Context, req *types.
 		"similarity_score": %s,
 		"analysis_method": "content_comparison",
 		"confidence_level": "high"
-	}`, time.Now().Format(time.RFC3339), req.ContractAddress, equivalence.SourceSubjectId, equivalence.TargetSubjectId, mockEquivalencePercent)
+	}`, ctx.BlockTime().UTC().Format(time.RFC3339), req.ContractAddress, equivalence.SourceSubjectId, equivalence.TargetSubjectId, mockEquivalencePercent)
 
 	// Update equivalence with analysis results
 	err := k.Keeper.UpdateEquivalenceAnalysis(
@@ -263,7 +263,7 @@ func (k msgServer) ReanalyzeEquivalence(goCtx context.Context, req *types.MsgRea
 		"analysis_method": "enhanced_content_comparison",
 		"confidence_level": "high",
 		"reanalysis_reason": "%s"
-	}`, time.Now().Format(time.RFC3339), contractAddress, equivalence.SourceSubjectId, equivalence.TargetSubjectId, newEquivalencePercent, req.ReanalysisReason)
+	}`, ctx.BlockTime().UTC().Format(time.RFC3339), contractAddress, equivalence.SourceSubjectId, equivalence.TargetSubjectId, newEquivalencePercent, req.ReanalysisReason)
 
 	// Update equivalence
 	err := k.Keeper.UpdateEquivalenceAnalysis(
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"strconv"
-	"time"
 
 	"academictoken/x/equivalence/types"
 
@@ -279,6 +278,6 @@ func (k Keeper) VerifyAnalysisIntegrity(goCtx context.Context, req *types.QueryV
 		IntegrityValid:        isValid,
 		StoredHash:            storedHash,
 		CalculatedHash:        calculatedHash,
-		VerificationTimestamp: strconv.FormatInt(time.Now().Unix(), 10),
+		VerificationTimestamp: strconv.FormatInt(ctx.BlockTime().Unix(), 10),
 	}, nil
 }
@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/equivalence/types"
+)
+
+// LegacyParams returns the values that v1 of the module served from its
+// hardcoded getters. The v1 contract address was a placeholder that is not
+// a valid bech32 address, so it is left unset here and has to be configured
+// through MsgUpdateParams.
+func LegacyParams() types.Params {
+	return types.NewParams(
+		"",
+		"http://localhost:5001",
+		true,
+		"70.0",
+		3,
+		300,
+		true,
+		"",
+	)
+}
+
+// MigrateStore replaces the empty v1 params with the legacy hardcoded values.
+// v1 Params had no fields, so there is nothing stored worth preserving.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	params := LegacyParams()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	kvStore.Set(types.ParamsKey, bz)
+
+	return nil
+}
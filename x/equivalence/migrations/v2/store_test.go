@@ -0,0 +1,32 @@
+package v2_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "academictoken/x/equivalence/migrations/v2"
+	"academictoken/x/equivalence/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// v1 stored an empty Params message
+	ctx.KVStore(storeKey).Set(types.ParamsKey, []byte{})
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	var params types.Params
+	require.NoError(t, cdc.Unmarshal(ctx.KVStore(storeKey).Get(types.ParamsKey), &params))
+	require.Equal(t, v2.LegacyParams(), params)
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+)
+
+// EquivalenceHooks lets other modules react to equivalence decisions
+type EquivalenceHooks interface {
+	// AfterEquivalenceApproved is called once when an equivalence becomes
+	// approved. An error reverts the approval.
+	AfterEquivalenceApproved(ctx context.Context, equivalence SubjectEquivalence) error
+}
+
+// MultiEquivalenceHooks combines several EquivalenceHooks, called in order
+type MultiEquivalenceHooks []EquivalenceHooks
+
+// NewMultiEquivalenceHooks combines the given hooks
+func NewMultiEquivalenceHooks(hooks ...EquivalenceHooks) MultiEquivalenceHooks {
+	return hooks
+}
+
+// AfterEquivalenceApproved calls every hook, stopping at the first error
+func (h MultiEquivalenceHooks) AfterEquivalenceApproved(ctx context.Context, equivalence SubjectEquivalence) error {
+	for _, hook := range h {
+		if err := hook.AfterEquivalenceApproved(ctx, equivalence); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,21 +1,26 @@
 package types
 
 import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
-// Parameter store keys - HARDCODED KEYS
+// Parameter store keys
 var (
 	KeyEquivalenceContractAddress = []byte("EquivalenceContractAddress")
-	KeyIPFSGateway               = []byte("IPFSGateway") 
-	KeyIPFSEnabled               = []byte("IPFSEnabled")
-	KeyMinApprovalThreshold      = []byte("MinApprovalThreshold")
-	KeyMaxAnalysisRetries        = []byte("MaxAnalysisRetries")
-	KeyAnalysisTimeoutSeconds    = []byte("AnalysisTimeoutSeconds")
-	KeyRequireContractAuth       = []byte("RequireContractAuth")
-	KeyAdmin                     = []byte("Admin")
+	KeyIPFSGateway                = []byte("IPFSGateway")
+	KeyIPFSEnabled                = []byte("IPFSEnabled")
+	KeyMinApprovalThreshold       = []byte("MinApprovalThreshold")
+	KeyMaxAnalysisRetries         = []byte("MaxAnalysisRetries")
+	KeyAnalysisTimeoutSeconds     = []byte("AnalysisTimeoutSeconds")
+	KeyRequireContractAuth        = []byte("RequireContractAuth")
+	KeyAdmin                      = []byte("Admin")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -23,115 +28,181 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams creates a new Params instance with HARDCODED values
-func NewParams() Params {
+// NewParams creates a new Params instance
+func NewParams(
+	equivalenceContractAddress string,
+	ipfsGateway string,
+	ipfsEnabled bool,
+	minApprovalThreshold string,
+	maxAnalysisRetries uint64,
+	analysisTimeoutSeconds uint64,
+	requireContractAuth bool,
+	admin string,
+) Params {
 	return Params{
-		// Note: Since protobuf Params is empty, we'll store hardcoded values in functions
-		// and return empty Params struct for compatibility
+		EquivalenceContractAddress: equivalenceContractAddress,
+		IpfsGateway:                ipfsGateway,
+		IpfsEnabled:                ipfsEnabled,
+		MinApprovalThreshold:       minApprovalThreshold,
+		MaxAnalysisRetries:         maxAnalysisRetries,
+		AnalysisTimeoutSeconds:     analysisTimeoutSeconds,
+		RequireContractAuth:        requireContractAuth,
+		Admin:                      admin,
 	}
 }
 
-// DefaultParams returns HARDCODED default parameters
+// DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
-}
-
-// ParamSetPairs get the params.ParamSet with HARDCODED keys
-// Note: Since protobuf Params is empty, we'll use empty ParamSetPairs for now
+	return NewParams(
+		"",                      // contract address is set through governance
+		"http://localhost:5001", // default IPFS gateway
+		true,                    // IPFS enabled by default
+		"70.0",                  // minimum approval threshold
+		3,                       // max analysis retries
+		300,                     // analysis timeout in seconds
+		true,                    // contract auth required
+		"",                      // empty admin
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		// When protobuf params are added, uncomment these:
-		// paramtypes.NewParamSetPair(KeyEquivalenceContractAddress, &p.EquivalenceContractAddress, validateString),
-		// paramtypes.NewParamSetPair(KeyIPFSGateway, &p.IpfsGateway, validateString),
-		// paramtypes.NewParamSetPair(KeyIPFSEnabled, &p.IpfsEnabled, validateBool),
-		// paramtypes.NewParamSetPair(KeyMinApprovalThreshold, &p.MinApprovalThreshold, validateString),
-		// paramtypes.NewParamSetPair(KeyMaxAnalysisRetries, &p.MaxAnalysisRetries, validateUint64),
-		// paramtypes.NewParamSetPair(KeyAnalysisTimeoutSeconds, &p.AnalysisTimeoutSeconds, validateUint64),
-		// paramtypes.NewParamSetPair(KeyRequireContractAuth, &p.RequireContractAuth, validateBool),
-		// paramtypes.NewParamSetPair(KeyAdmin, &p.Admin, validateString),
+		paramtypes.NewParamSetPair(KeyEquivalenceContractAddress, &p.EquivalenceContractAddress, validateString),
+		paramtypes.NewParamSetPair(KeyIPFSGateway, &p.IpfsGateway, validateString),
+		paramtypes.NewParamSetPair(KeyIPFSEnabled, &p.IpfsEnabled, validateBool),
+		paramtypes.NewParamSetPair(KeyMinApprovalThreshold, &p.MinApprovalThreshold, validateString),
+		paramtypes.NewParamSetPair(KeyMaxAnalysisRetries, &p.MaxAnalysisRetries, validateUint64),
+		paramtypes.NewParamSetPair(KeyAnalysisTimeoutSeconds, &p.AnalysisTimeoutSeconds, validateUint64),
+		paramtypes.NewParamSetPair(KeyRequireContractAuth, &p.RequireContractAuth, validateBool),
+		paramtypes.NewParamSetPair(KeyAdmin, &p.Admin, validateString),
 	}
 }
 
-// Validate validates the HARDCODED params
+// Validate validates the set of params
 func (p Params) Validate() error {
-	// Since protobuf Params is empty, validation always passes
+	if err := validateAddress("equivalence contract", p.EquivalenceContractAddress); err != nil {
+		return err
+	}
+	if err := validateIPFSGateway(p.IpfsGateway, p.IpfsEnabled); err != nil {
+		return err
+	}
+	threshold, err := strconv.ParseFloat(p.MinApprovalThreshold, 64)
+	if err != nil {
+		return fmt.Errorf("invalid min approval threshold %q: %w", p.MinApprovalThreshold, err)
+	}
+	if threshold < 0 || threshold > 100 {
+		return fmt.Errorf("min approval threshold must be between 0 and 100, got %s", p.MinApprovalThreshold)
+	}
+	if p.MaxAnalysisRetries == 0 {
+		return fmt.Errorf("max analysis retries cannot be zero")
+	}
+	if p.AnalysisTimeoutSeconds == 0 {
+		return fmt.Errorf("analysis timeout cannot be zero")
+	}
+	if err := validateAddress("admin", p.Admin); err != nil {
+		return err
+	}
 	return nil
 }
 
-// ============================================================================
-// HARDCODED PARAMETER GETTERS (Since protobuf Params is empty)
-// ============================================================================
+// validateIPFSGateway requires an http(s) URL when a gateway is set, and a
+// gateway whenever IPFS is enabled.
+func validateIPFSGateway(gateway string, enabled bool) error {
+	if gateway == "" {
+		if enabled {
+			return fmt.Errorf("ipfs gateway is required when ipfs is enabled")
+		}
+		return nil
+	}
 
-// GetHardcodedEquivalenceContractAddress returns the hardcoded contract address
-func GetHardcodedEquivalenceContractAddress() string {
-	return "cosmos1mockequivalencecontract"
+	u, err := url.Parse(gateway)
+	if err != nil {
+		return fmt.Errorf("invalid ipfs gateway %q: %w", gateway, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid ipfs gateway %q: scheme must be http or https", gateway)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid ipfs gateway %q: missing host", gateway)
+	}
+	return nil
 }
 
-// GetHardcodedIPFSGateway returns the hardcoded IPFS gateway
-func GetHardcodedIPFSGateway() string {
-	return "http://localhost:5001"
+// validateAddress accepts an empty value (not configured) or a valid bech32
+// account address.
+func validateAddress(name, addr string) error {
+	if addr == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return fmt.Errorf("invalid %s address %q: %w", name, addr, err)
+	}
+	return nil
 }
 
-// IsHardcodedIPFSEnabled returns if IPFS is enabled (hardcoded true)
-func IsHardcodedIPFSEnabled() bool {
-	return true
+func validateString(i interface{}) error {
+	if _, ok := i.(string); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
 }
 
-// GetHardcodedMinApprovalThreshold returns the hardcoded minimum approval threshold
-func GetHardcodedMinApprovalThreshold() string {
-	return "70.0"
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
 }
 
-// GetHardcodedMaxAnalysisRetries returns the hardcoded max analysis retries
-func GetHardcodedMaxAnalysisRetries() uint64 {
-	return 3
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
 }
 
-// GetHardcodedAnalysisTimeoutSeconds returns the hardcoded analysis timeout
-func GetHardcodedAnalysisTimeoutSeconds() uint64 {
-	return 300
-}
+// ============================================================================
+// HELPER FUNCTIONS FOR EQUIVALENCE MODULE
+// ============================================================================
 
-// IsHardcodedContractAuthRequired returns if contract auth is required (hardcoded true)
-func IsHardcodedContractAuthRequired() bool {
-	return true
+// ContractConfig returns the contract configuration described by the params
+func (p Params) ContractConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"contract_address":       p.EquivalenceContractAddress,
+		"ipfs_gateway":           p.IpfsGateway,
+		"ipfs_enabled":           p.IpfsEnabled,
+		"min_approval_threshold": p.MinApprovalThreshold,
+		"max_analysis_retries":   p.MaxAnalysisRetries,
+		"analysis_timeout":       p.AnalysisTimeoutSeconds,
+		"require_contract_auth":  p.RequireContractAuth,
+		"admin":                  p.Admin,
+	}
 }
 
-// GetHardcodedAdmin returns the hardcoded admin address (empty)
-func GetHardcodedAdmin() string {
-	return ""
+// AnalysisConfig returns the analysis configuration, taking retries and
+// timeout from the params
+func (p Params) AnalysisConfig() AnalysisConfig {
+	return AnalysisConfig{
+		SimilarityAlgorithm:   DefaultSimilarityAlgorithm,
+		ConfidenceThreshold:   DefaultConfidenceThreshold,
+		TextSimilarityWeight:  DefaultTextSimilarityWeight,
+		TopicSimilarityWeight: DefaultTopicSimilarityWeight,
+		DefaultLanguage:       DefaultLanguage,
+		AnalysisMode:          DefaultAnalysisMode,
+		MaxRetries:            p.MaxAnalysisRetries,
+		TimeoutSeconds:        p.AnalysisTimeoutSeconds,
+	}
 }
 
-// ============================================================================
-// HELPER FUNCTIONS FOR EQUIVALENCE MODULE (FIXED)
-// ============================================================================
-
 // GetDefaultContractConfig returns default contract configuration
 func GetDefaultContractConfig() map[string]interface{} {
-	return map[string]interface{}{
-		"contract_address":        GetHardcodedEquivalenceContractAddress(),
-		"ipfs_gateway":           GetHardcodedIPFSGateway(),
-		"ipfs_enabled":           IsHardcodedIPFSEnabled(),
-		"min_approval_threshold": GetHardcodedMinApprovalThreshold(),
-		"max_analysis_retries":   GetHardcodedMaxAnalysisRetries(),
-		"analysis_timeout":       GetHardcodedAnalysisTimeoutSeconds(),
-		"require_contract_auth":  IsHardcodedContractAuthRequired(),
-		"admin":                  GetHardcodedAdmin(),
-	}
+	return DefaultParams().ContractConfig()
 }
 
-// GetDefaultAnalysisConfig returns default analysis configuration - FIXED SIGNATURE
+// GetDefaultAnalysisConfig returns default analysis configuration
 func GetDefaultAnalysisConfig() AnalysisConfig {
-	return AnalysisConfig{
-		SimilarityAlgorithm:    DefaultSimilarityAlgorithm,
-		ConfidenceThreshold:    DefaultConfidenceThreshold,
-		TextSimilarityWeight:   DefaultTextSimilarityWeight,
-		TopicSimilarityWeight:  DefaultTopicSimilarityWeight,
-		DefaultLanguage:        DefaultLanguage,
-		AnalysisMode:           DefaultAnalysisMode,
-		MaxRetries:             GetHardcodedMaxAnalysisRetries(),
-		TimeoutSeconds:         GetHardcodedAnalysisTimeoutSeconds(),
-	}
+	return DefaultParams().AnalysisConfig()
 }
 
 // IsValidEquivalencePercent validates equivalence percentage
@@ -26,6 +26,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	EquivalenceContractAddress string `protobuf:"bytes,1,opt,name=equivalence_contract_address,json=equivalenceContractAddress,proto3" json:"equivalence_contract_address,omitempty" yaml:"equivalence_contract_address"`
+	IpfsGateway                string `protobuf:"bytes,2,opt,name=ipfs_gateway,json=ipfsGateway,proto3" json:"ipfs_gateway,omitempty" yaml:"ipfs_gateway"`
+	IpfsEnabled                bool   `protobuf:"varint,3,opt,name=ipfs_enabled,json=ipfsEnabled,proto3" json:"ipfs_enabled,omitempty" yaml:"ipfs_enabled"`
+	MinApprovalThreshold       string `protobuf:"bytes,4,opt,name=min_approval_threshold,json=minApprovalThreshold,proto3" json:"min_approval_threshold,omitempty" yaml:"min_approval_threshold"`
+	MaxAnalysisRetries         uint64 `protobuf:"varint,5,opt,name=max_analysis_retries,json=maxAnalysisRetries,proto3" json:"max_analysis_retries,omitempty" yaml:"max_analysis_retries"`
+	AnalysisTimeoutSeconds     uint64 `protobuf:"varint,6,opt,name=analysis_timeout_seconds,json=analysisTimeoutSeconds,proto3" json:"analysis_timeout_seconds,omitempty" yaml:"analysis_timeout_seconds"`
+	RequireContractAuth        bool   `protobuf:"varint,7,opt,name=require_contract_auth,json=requireContractAuth,proto3" json:"require_contract_auth,omitempty" yaml:"require_contract_auth"`
+	Admin                      string `protobuf:"bytes,8,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +69,62 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetEquivalenceContractAddress() string {
+	if m != nil {
+		return m.EquivalenceContractAddress
+	}
+	return ""
+}
+
+func (m *Params) GetIpfsGateway() string {
+	if m != nil {
+		return m.IpfsGateway
+	}
+	return ""
+}
+
+func (m *Params) GetIpfsEnabled() bool {
+	if m != nil {
+		return m.IpfsEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMinApprovalThreshold() string {
+	if m != nil {
+		return m.MinApprovalThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetMaxAnalysisRetries() uint64 {
+	if m != nil {
+		return m.MaxAnalysisRetries
+	}
+	return 0
+}
+
+func (m *Params) GetAnalysisTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.AnalysisTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetRequireContractAuth() bool {
+	if m != nil {
+		return m.RequireContractAuth
+	}
+	return false
+}
+
+func (m *Params) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "academictoken.equivalence.Params")
 }
@@ -70,18 +134,37 @@ func init() {
 }
 
 var fileDescriptor_f6b72a11195b5fb2 = []byte{
-	// 163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4c, 0x4e, 0x4c,
-	0x49, 0xcd, 0xcd, 0x4c, 0x2e, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x4f, 0x2d, 0x2c, 0xcd, 0x2c, 0x4b,
-	0xcc, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x44, 0x51, 0xa7, 0x87, 0xa4, 0x4e, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f,
-	0x5f, 0x1f, 0x4c, 0x42, 0x54, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16,
-	0x44, 0x54, 0xc9, 0x94, 0x8b, 0x2d, 0x00, 0x6c, 0xa6, 0x95, 0xf6, 0x8b, 0x05, 0xf2, 0x8c, 0x5d,
-	0xcf, 0x37, 0x68, 0x29, 0xa1, 0x5a, 0x5f, 0x81, 0xe2, 0x00, 0x88, 0x62, 0x27, 0xeb, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0xc4, 0xa7, 0xbb, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0x6c, 0xb5, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x67, 0x0b, 0x3f, 0x94, 0xe8,
-	0x00, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x58, 0xcb, 0x08, 0x3b, 0x40, 0x56, 0x46, 0x28, 0x23, 0xee, 0x3c, 0x69, 0x54,
+	0x20, 0xad, 0x07, 0x6e, 0xe5, 0xd4, 0x22, 0xc4, 0x15, 0x42, 0x25, 0x24, 0x24, 0x14, 0x7d, 0x4b,
+	0x4c, 0x6b, 0x11, 0xdb, 0xc1, 0x76, 0x47, 0xfb, 0x0a, 0x9c, 0x78, 0x04, 0x78, 0x03, 0x1e, 0x83,
+	0xe3, 0x8e, 0x9c, 0x22, 0xd4, 0x1e, 0xe0, 0x9c, 0x27, 0x40, 0xb5, 0xb3, 0x2a, 0x15, 0x5d, 0x2f,
+	0x96, 0xbf, 0xff, 0xff, 0xff, 0xfd, 0x64, 0xf9, 0xfb, 0xdc, 0x13, 0x88, 0x21, 0x21, 0x8c, 0xc6,
+	0x5a, 0x7c, 0x24, 0xbc, 0x4b, 0x3e, 0x4d, 0xe8, 0x39, 0xa4, 0x84, 0xc7, 0xa4, 0x9b, 0x81, 0x04,
+	0xa6, 0x4e, 0x33, 0x29, 0xb4, 0xf0, 0xee, 0xaf, 0xe5, 0x4e, 0x2b, 0xb9, 0xd6, 0x1d, 0x60, 0x94,
+	0x8b, 0xae, 0x39, 0x6d, 0xba, 0xd5, 0x1c, 0x89, 0x91, 0x30, 0xd7, 0xee, 0xf2, 0x66, 0x55, 0xfc,
+	0xbd, 0xee, 0x36, 0x5e, 0x19, 0xa8, 0x47, 0xdd, 0xc3, 0x0a, 0x22, 0x8a, 0x05, 0xd7, 0x12, 0x62,
+	0x1d, 0x41, 0x92, 0x48, 0xa2, 0x94, 0xef, 0xb4, 0x9d, 0xce, 0xcd, 0xc1, 0xa3, 0x22, 0x47, 0xc7,
+	0x33, 0x60, 0x69, 0x0f, 0x6f, 0x4b, 0xe3, 0xb0, 0x55, 0xb1, 0x9f, 0x97, 0x6e, 0xdf, 0x9a, 0x5e,
+	0xcf, 0xdd, 0xa3, 0xd9, 0x07, 0x15, 0x8d, 0x40, 0x93, 0xcf, 0x30, 0xf3, 0xaf, 0x19, 0xf4, 0xbd,
+	0x22, 0x47, 0xfb, 0x16, 0x5d, 0x75, 0x71, 0x78, 0x6b, 0x59, 0xbe, 0xb4, 0xd5, 0xaa, 0x97, 0x70,
+	0x38, 0x4b, 0x49, 0xe2, 0x5f, 0x6f, 0x3b, 0x9d, 0xdd, 0xff, 0x7a, 0x4b, 0xb7, 0xec, 0x7d, 0x61,
+	0x2b, 0xef, 0xad, 0x7b, 0xc0, 0x28, 0x8f, 0x20, 0xcb, 0xa4, 0x38, 0x87, 0x34, 0xd2, 0x63, 0x49,
+	0xd4, 0x58, 0xa4, 0x89, 0xbf, 0x63, 0x5e, 0x70, 0x54, 0xe4, 0xe8, 0xa1, 0xa5, 0x6c, 0xce, 0xe1,
+	0xb0, 0xc9, 0x28, 0xef, 0x97, 0xfa, 0xf0, 0x52, 0xf6, 0x5e, 0xbb, 0x4d, 0x06, 0xd3, 0x08, 0x38,
+	0xa4, 0x33, 0x45, 0x55, 0x24, 0x89, 0x96, 0x94, 0x28, 0xbf, 0xde, 0x76, 0x3a, 0x3b, 0x03, 0x54,
+	0xe4, 0xe8, 0x41, 0x89, 0xdd, 0x90, 0xc2, 0xa1, 0xc7, 0x60, 0xda, 0x2f, 0xd5, 0xd0, 0x8a, 0xde,
+	0x7b, 0xd7, 0x5f, 0x05, 0x35, 0x65, 0x44, 0x4c, 0x74, 0xa4, 0x48, 0x2c, 0x78, 0xa2, 0xfc, 0x86,
+	0xc1, 0x1e, 0x17, 0x39, 0x42, 0x16, 0x7b, 0x55, 0x12, 0x87, 0x07, 0x97, 0xd6, 0xd0, 0x3a, 0x6f,
+	0xac, 0xe1, 0x0d, 0xdd, 0xbb, 0x72, 0x39, 0x21, 0x59, 0x9d, 0xdd, 0x44, 0x8f, 0xfd, 0x1b, 0xe6,
+	0x3f, 0xdb, 0x45, 0x8e, 0x0e, 0x2d, 0x7b, 0x63, 0x0c, 0x87, 0xfb, 0xa5, 0xbe, 0x9a, 0xed, 0x44,
+	0x8f, 0xbd, 0x13, 0xb7, 0x0e, 0x09, 0xa3, 0xdc, 0xdf, 0x35, 0xff, 0x79, 0xbb, 0xc8, 0xd1, 0x5e,
+	0xf9, 0xc2, 0xa5, 0x8c, 0x43, 0x6b, 0xf7, 0x9e, 0xfc, 0xfd, 0x86, 0x9c, 0x2f, 0x7f, 0x7e, 0x3c,
+	0xc6, 0xeb, 0xbb, 0x3e, 0x5d, 0xdb, 0x76, 0xbb, 0x98, 0x83, 0x67, 0x3f, 0xe7, 0x81, 0x73, 0x31,
+	0x0f, 0x9c, 0xdf, 0xf3, 0xc0, 0xf9, 0xba, 0x08, 0x6a, 0x17, 0x8b, 0xa0, 0xf6, 0x6b, 0x11, 0xd4,
+	0xde, 0x1d, 0x6d, 0xeb, 0xd6, 0xb3, 0x8c, 0xa8, 0xb3, 0x86, 0xd9, 0xf3, 0xa7, 0xff, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xa8, 0x34, 0x1e, 0x8e, 0x55, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +186,30 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.EquivalenceContractAddress != that1.EquivalenceContractAddress {
+		return false
+	}
+	if this.IpfsGateway != that1.IpfsGateway {
+		return false
+	}
+	if this.IpfsEnabled != that1.IpfsEnabled {
+		return false
+	}
+	if this.MinApprovalThreshold != that1.MinApprovalThreshold {
+		return false
+	}
+	if this.MaxAnalysisRetries != that1.MaxAnalysisRetries {
+		return false
+	}
+	if this.AnalysisTimeoutSeconds != that1.AnalysisTimeoutSeconds {
+		return false
+	}
+	if this.RequireContractAuth != that1.RequireContractAuth {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +232,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RequireContractAuth {
+		i--
+		if m.RequireContractAuth {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AnalysisTimeoutSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AnalysisTimeoutSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxAnalysisRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAnalysisRetries))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MinApprovalThreshold) > 0 {
+		i -= len(m.MinApprovalThreshold)
+		copy(dAtA[i:], m.MinApprovalThreshold)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinApprovalThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IpfsEnabled {
+		i--
+		if m.IpfsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IpfsGateway) > 0 {
+		i -= len(m.IpfsGateway)
+		copy(dAtA[i:], m.IpfsGateway)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.IpfsGateway)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EquivalenceContractAddress) > 0 {
+		i -= len(m.EquivalenceContractAddress)
+		copy(dAtA[i:], m.EquivalenceContractAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EquivalenceContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +310,34 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.EquivalenceContractAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.IpfsGateway)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.IpfsEnabled {
+		n += 2
+	}
+	l = len(m.MinApprovalThreshold)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxAnalysisRetries != 0 {
+		n += 1 + sovParams(uint64(m.MaxAnalysisRetries))
+	}
+	if m.AnalysisTimeoutSeconds != 0 {
+		n += 1 + sovParams(uint64(m.AnalysisTimeoutSeconds))
+	}
+	if m.RequireContractAuth {
+		n += 2
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -183,6 +376,212 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalenceContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EquivalenceContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsGateway", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsGateway = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IpfsEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinApprovalThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinApprovalThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnalysisRetries", wireType)
+			}
+			m.MaxAnalysisRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAnalysisRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisTimeoutSeconds", wireType)
+			}
+			m.AnalysisTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnalysisTimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireContractAuth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireContractAuth = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -124,7 +124,7 @@ func IsValidStatus(status string) bool {
 // GetMockContractInfo returns mock contract information for development
 func GetMockContractInfo() ContractInfo {
 	return ContractInfo{
-		Address:         GetHardcodedEquivalenceContractAddress(),
+		Address:         DefaultParams().EquivalenceContractAddress,
 		Version:         "v1.0.0",
 		Status:          "active",
 		LastUpdated:     "2024-01-01T00:00:00Z",
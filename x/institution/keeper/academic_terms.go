@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/x/institution/types"
+)
+
+// SetAcademicTerm stores an academic term
+func (k Keeper) SetAcademicTerm(ctx sdk.Context, term types.AcademicTerm) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.AcademicTermKey(term.Institution, term.TermId), k.cdc.MustMarshal(&term))
+}
+
+// GetAcademicTerm returns an academic term of an institution
+func (k Keeper) GetAcademicTerm(ctx sdk.Context, institution string, termId string) (val types.AcademicTerm, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.AcademicTermKey(institution, termId))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// GetInstitutionAcademicTerms returns the academic calendar of an institution
+func (k Keeper) GetInstitutionAcademicTerms(ctx sdk.Context, institution string) []types.AcademicTerm {
+	return k.getAcademicTerms(ctx, types.AcademicTermInstitutionPrefix(institution))
+}
+
+// GetAllAcademicTerms returns every academic term
+func (k Keeper) GetAllAcademicTerms(ctx sdk.Context) []types.AcademicTerm {
+	return k.getAcademicTerms(ctx, types.KeyPrefix(types.AcademicTermKeyPrefix))
+}
+
+func (k Keeper) getAcademicTerms(ctx sdk.Context, keyPrefix []byte) (list []types.AcademicTerm) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AcademicTerm
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ValidateAcademicTerm checks that a term ID is registered in the academic
+// calendar of an institution
+func (k Keeper) ValidateAcademicTerm(ctx sdk.Context, institution string, termId string) error {
+	if _, found := k.GetAcademicTerm(ctx, institution, termId); !found {
+		return types.ErrAcademicTermNotFound.Wrapf("term '%s' is not in the academic calendar of institution '%s'", termId, institution)
+	}
+	return nil
+}
+
+// ValidateGradeSubmission checks that grades of a registered term can still be
+// submitted at the current block time
+func (k Keeper) ValidateGradeSubmission(ctx sdk.Context, institution string, termId string) error {
+	term, found := k.GetAcademicTerm(ctx, institution, termId)
+	if !found {
+		return types.ErrAcademicTermNotFound.Wrapf("term '%s' is not in the academic calendar of institution '%s'", termId, institution)
+	}
+	if !term.AcceptsGradesAt(ctx.BlockTime()) {
+		return types.ErrGradeSubmissionClosed.Wrapf("grades of term '%s' were due by %s", termId, term.GradeSubmissionDeadline)
+	}
+	return nil
+}
+
+// AcademicTerm returns a term of an institution's academic calendar
+func (k Keeper) AcademicTerm(c context.Context, req *types.QueryAcademicTermRequest) (*types.QueryAcademicTermResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	term, found := k.GetAcademicTerm(ctx, req.Institution, req.TermId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryAcademicTermResponse{AcademicTerm: term}, nil
+}
+
+// AcademicTerms returns the academic calendar of an institution
+func (k Keeper) AcademicTerms(c context.Context, req *types.QueryAcademicTermsRequest) (*types.QueryAcademicTermsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAcademicTermsResponse{AcademicTerm: k.GetInstitutionAcademicTerms(ctx, req.Institution)}, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/testutil/sample"
+	"academictoken/x/institution/types"
+)
+
+func TestAcademicTermRegistry(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	ctx = sdkCtx
+
+	creator := sample.AccAddress()
+	outsider := sample.AccAddress()
+
+	res, err := ms.RegisterInstitution(ctx, types.NewMsgRegisterInstitution(creator, "University", "Main St"))
+	require.NoError(t, err)
+	institution := res.Index
+
+	term := types.AcademicTerm{
+		Institution:             institution,
+		TermId:                  "2025-1",
+		Name:                    "First semester 2025",
+		StartDate:               "2025-02-01T00:00:00Z",
+		EndDate:                 "2025-06-30T00:00:00Z",
+		EnrollmentStart:         "2025-01-10T00:00:00Z",
+		EnrollmentEnd:           "2025-02-15T00:00:00Z",
+		GradeSubmissionDeadline: "2025-07-15T00:00:00Z",
+	}
+
+	// only admins manage the calendar
+	_, err = ms.SetAcademicTerm(ctx, types.NewMsgSetAcademicTerm(outsider, term))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorIs(t, k.ValidateAcademicTerm(sdkCtx, institution, "2025-1"), types.ErrAcademicTermNotFound)
+
+	_, err = ms.SetAcademicTerm(ctx, types.NewMsgSetAcademicTerm(creator, term))
+	require.NoError(t, err)
+	require.NoError(t, k.ValidateAcademicTerm(sdkCtx, institution, "2025-1"))
+
+	// other spellings of the same term are not registered
+	require.ErrorIs(t, k.ValidateAcademicTerm(sdkCtx, institution, "2025.1"), types.ErrAcademicTermNotFound)
+	require.ErrorIs(t, k.ValidateAcademicTerm(sdkCtx, "other-institution", "2025-1"), types.ErrAcademicTermNotFound)
+
+	stored, found := k.GetAcademicTerm(sdkCtx, institution, "2025-1")
+	require.True(t, found)
+	require.Equal(t, creator, stored.Creator)
+	require.True(t, stored.IsEnrollmentOpenAt(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)))
+	require.False(t, stored.IsEnrollmentOpenAt(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	terms, err := k.AcademicTerms(ctx, &types.QueryAcademicTermsRequest{Institution: institution})
+	require.NoError(t, err)
+	require.Len(t, terms.AcademicTerm, 1)
+
+	// grades are accepted until the submission deadline, which can be extended
+	require.NoError(t, k.ValidateGradeSubmission(sdkCtx, institution, "2025-1"))
+	late := sdkCtx.WithBlockTime(time.Date(2025, 7, 20, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, k.ValidateGradeSubmission(late, institution, "2025-1"), types.ErrGradeSubmissionClosed)
+
+	term.GradeSubmissionDeadline = "2025-07-31T00:00:00Z"
+	_, err = ms.SetAcademicTerm(ctx, types.NewMsgSetAcademicTerm(creator, term))
+	require.NoError(t, err)
+	require.NoError(t, k.ValidateGradeSubmission(late, institution, "2025-1"))
+}
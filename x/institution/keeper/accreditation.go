@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/x/institution/types"
+)
+
+// SetAccreditationProposal stores the latest accreditation proposal of an institution
+func (k Keeper) SetAccreditationProposal(ctx sdk.Context, proposal types.AccreditationProposal) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.AccreditationProposalKey(proposal.Institution), k.cdc.MustMarshal(&proposal))
+}
+
+// GetAccreditationProposal returns the latest accreditation proposal of an institution
+func (k Keeper) GetAccreditationProposal(ctx sdk.Context, institution string) (val types.AccreditationProposal, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.AccreditationProposalKey(institution))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// GetAllAccreditationProposals returns every stored accreditation proposal
+func (k Keeper) GetAllAccreditationProposals(ctx sdk.Context) (list []types.AccreditationProposal) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefix(types.AccreditationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AccreditationProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Accreditation returns the accreditation status and latest proposal of an institution
+func (k Keeper) Accreditation(c context.Context, req *types.QueryAccreditationRequest) (*types.QueryAccreditationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	institution, found := k.GetInstitution(ctx, req.Institution)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	res := &types.QueryAccreditationResponse{
+		Accredited:      institution.IsAccreditedAt(ctx.BlockTime()),
+		AccreditedUntil: institution.AccreditedUntil,
+	}
+	if proposal, found := k.GetAccreditationProposal(ctx, req.Institution); found {
+		res.Proposal = &proposal
+	}
+
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/testutil/sample"
+	"academictoken/x/institution/types"
+)
+
+func TestAccreditation(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(now)
+	ctx = sdkCtx
+
+	creator := sample.AccAddress()
+	body := sample.AccAddress()
+	params := types.DefaultParams()
+	params.AccreditationBodies = []string{body}
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	res, err := ms.RegisterInstitution(ctx, types.NewMsgRegisterInstitution(creator, "University", "Main St"))
+	require.NoError(t, err)
+	institution := res.Index
+	require.False(t, k.IsInstitutionAuthorized(sdkCtx, institution))
+
+	// institutions can no longer authorize themselves
+	_, err = ms.UpdateInstitution(ctx, types.NewMsgUpdateInstitution(creator, institution, "", "", "true"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetAccreditation(ctx, types.NewMsgSetAccreditation(creator, institution, true, "2026-01-01T00:00:00Z"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// only admins propose, and only one proposal can be pending
+	links := []string{"https://ministry.example/reports/42"}
+	_, err = ms.ProposeAccreditation(ctx, types.NewMsgProposeAccreditation(body, institution, links, "site visit"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.ProposeAccreditation(ctx, types.NewMsgProposeAccreditation(creator, institution, links, "site visit"))
+	require.NoError(t, err)
+	_, err = ms.ProposeAccreditation(ctx, types.NewMsgProposeAccreditation(creator, institution, links, "site visit"))
+	require.ErrorIs(t, err, types.ErrInvalidAccreditation)
+
+	// expiry dates must lie in the future
+	_, err = ms.SetAccreditation(ctx, types.NewMsgSetAccreditation(body, institution, true, "2024-12-31T00:00:00Z"))
+	require.ErrorIs(t, err, types.ErrInvalidAccreditation)
+
+	_, err = ms.SetAccreditation(ctx, types.NewMsgSetAccreditation(body, institution, true, "2026-01-01T00:00:00Z"))
+	require.NoError(t, err)
+	require.True(t, k.IsInstitutionAuthorized(sdkCtx, institution))
+	require.Len(t, k.GetAuthorizedInstitutions(sdkCtx), 1)
+
+	stored, found := k.GetInstitution(sdkCtx, institution)
+	require.True(t, found)
+	require.Equal(t, body, stored.AccreditedBy)
+	require.Equal(t, links, stored.AccreditationEvidence)
+
+	accreditation, err := k.Accreditation(ctx, &types.QueryAccreditationRequest{Institution: institution})
+	require.NoError(t, err)
+	require.True(t, accreditation.Accredited)
+	require.Equal(t, "2026-01-01T00:00:00Z", accreditation.AccreditedUntil)
+	require.Equal(t, types.AccreditationStatusApproved, accreditation.Proposal.Status)
+	require.Equal(t, body, accreditation.Proposal.ReviewedBy)
+
+	// accreditation lapses at its expiry date
+	lapsed := sdkCtx.WithBlockTime(now.AddDate(1, 0, 0))
+	require.False(t, k.IsInstitutionAuthorized(lapsed, institution))
+	require.Empty(t, k.GetAuthorizedInstitutions(lapsed))
+
+	// governance can withdraw it
+	_, err = ms.SetAccreditation(ctx, types.NewMsgSetAccreditation(k.GetAuthority(), institution, false, ""))
+	require.NoError(t, err)
+	require.False(t, k.IsInstitutionAuthorized(sdkCtx, institution))
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/x/institution/types"
+)
+
+// SetGradingScale stores the grading scale of an institution
+func (k Keeper) SetGradingScale(ctx sdk.Context, scale types.GradingScale) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GradingScaleKey(scale.Institution), k.cdc.MustMarshal(&scale))
+}
+
+// GetGradingScale returns the grading scale configured by an institution
+func (k Keeper) GetGradingScale(ctx sdk.Context, institution string) (val types.GradingScale, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GradingScaleKey(institution))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// GetGradingScaleOrDefault returns the grading scale of an institution, or the
+// default 0-100 scale if none was configured
+func (k Keeper) GetGradingScaleOrDefault(ctx sdk.Context, institution string) types.GradingScale {
+	if scale, found := k.GetGradingScale(ctx, institution); found {
+		return scale
+	}
+	return types.DefaultGradingScale(institution)
+}
+
+// GetAllGradingScales returns every configured grading scale
+func (k Keeper) GetAllGradingScales(ctx sdk.Context) (list []types.GradingScale) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefix(types.GradingScaleKeyPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GradingScale
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// NormalizeGrade validates a grade issued by an institution against its
+// grading scale and maps it onto the common 0-100 score
+func (k Keeper) NormalizeGrade(ctx sdk.Context, institution string, grade string) (types.NormalizedGrade, error) {
+	return k.GetGradingScaleOrDefault(ctx, institution).Normalize(grade)
+}
+
+// GradingScale returns the grading scale of an institution
+func (k Keeper) GradingScale(c context.Context, req *types.QueryGradingScaleRequest) (*types.QueryGradingScaleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.InstitutionExists(ctx, req.Institution) {
+		return nil, status.Error(codes.NotFound, "institution not found")
+	}
+
+	scale, found := k.GetGradingScale(ctx, req.Institution)
+	if !found {
+		return &types.QueryGradingScaleResponse{GradingScale: types.DefaultGradingScale(req.Institution), IsDefault: true}, nil
+	}
+	return &types.QueryGradingScaleResponse{GradingScale: scale}, nil
+}
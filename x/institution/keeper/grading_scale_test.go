@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/testutil/sample"
+	"academictoken/x/institution/types"
+)
+
+func TestGradingScale(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sample.AccAddress()
+	professor := sample.AccAddress()
+
+	res, err := ms.RegisterInstitution(ctx, types.NewMsgRegisterInstitution(creator, "University", "Main St"))
+	require.NoError(t, err)
+	institution := res.Index
+
+	// institutions without a scale use the default 0-100 scale
+	query, err := k.GradingScale(ctx, &types.QueryGradingScaleRequest{Institution: institution})
+	require.NoError(t, err)
+	require.True(t, query.IsDefault)
+	require.Equal(t, types.DefaultGradingScale(institution), query.GradingScale)
+
+	grade, err := k.NormalizeGrade(sdkCtx, institution, "60")
+	require.NoError(t, err)
+	require.True(t, grade.Passing)
+
+	_, err = k.GradingScale(ctx, &types.QueryGradingScaleRequest{Institution: "institution-404"})
+	require.Error(t, err)
+
+	// only admins can configure the scale
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(creator, institution, professor, types.RoleProfessor, "", ""))
+	require.NoError(t, err)
+	_, err = ms.ConfigureGradingScale(ctx, types.NewMsgConfigureGradingScale(professor, institution, types.ScaleTypeNumeric, "0", "10", "5", nil, ""))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.ConfigureGradingScale(ctx, types.NewMsgConfigureGradingScale(creator, institution, types.ScaleTypeNumeric, "0", "10", "5", nil, ""))
+	require.NoError(t, err)
+
+	query, err = k.GradingScale(ctx, &types.QueryGradingScaleRequest{Institution: institution})
+	require.NoError(t, err)
+	require.False(t, query.IsDefault)
+	require.Equal(t, "10", query.GradingScale.MaxGrade)
+	require.Equal(t, types.DefaultMaxGpa, query.GradingScale.MaxGpa)
+	require.Equal(t, creator, query.GradingScale.UpdatedBy)
+
+	// grades are now validated against the 0-10 scale
+	grade, err = k.NormalizeGrade(sdkCtx, institution, "8")
+	require.NoError(t, err)
+	require.True(t, math.LegacyNewDec(80).Equal(grade.Score))
+	require.True(t, grade.Passing)
+
+	grade, err = k.NormalizeGrade(sdkCtx, institution, "4")
+	require.NoError(t, err)
+	require.False(t, grade.Passing)
+
+	_, err = k.NormalizeGrade(sdkCtx, institution, "60")
+	require.ErrorIs(t, err, types.ErrInvalidGrade)
+
+	require.Len(t, k.GetAllGradingScales(sdkCtx), 1)
+}
@@ -163,8 +163,8 @@ func (k Keeper) IsInstitutionAuthorized(ctx sdk.Context, index string) bool {
 		return false
 	}
 
-	// Check if institution is authorized (assuming "true" string means authorized)
-	return institution.IsAuthorized == "true"
+	// Authorized institutions must hold an accreditation that has not lapsed
+	return institution.IsAccreditedAt(ctx.BlockTime())
 }
 
 // IsInstitutionCreator checks if the given address is the original creator of the institution
@@ -188,16 +188,16 @@ func (k Keeper) CanUpdateInstitution(ctx sdk.Context, institutionIndex string, u
 	return k.IsInstitutionCreator(ctx, institutionIndex, updaterAddress)
 }
 
-// CanAuthorizeInstitution checks if an address can change authorization status
+// CanAuthorizeInstitution checks if an address can change the accreditation of
+// an institution: governance or a designated accreditation body, never the
+// institution itself
 func (k Keeper) CanAuthorizeInstitution(ctx sdk.Context, institutionIndex string, updaterAddress string) bool {
 	// Authority (governance) can always authorize
 	if updaterAddress == k.GetAuthority() {
 		return true
 	}
 
-	// TEMPORARY: Allow the creator to authorize their own institution
-	// In production, only governance should be able to do this
-	return k.IsInstitutionCreator(ctx, institutionIndex, updaterAddress)
+	return k.GetParams(ctx).IsAccreditationBody(updaterAddress)
 }
 
 // GetInstitutionCount returns the total count of institutions
@@ -270,7 +270,7 @@ func (k Keeper) AuthorizedInstitutions(c context.Context, req *types.QueryAuthor
 
 	var authorizedInstitutions []types.Institution
 	for _, institution := range allInstitutions {
-		if institution.IsAuthorized == "true" {
+		if institution.IsAccreditedAt(ctx.BlockTime()) {
 			authorizedInstitutions = append(authorizedInstitutions, institution)
 		}
 	}
@@ -304,7 +304,7 @@ func (k Keeper) GetAuthorizedInstitutions(ctx sdk.Context) []types.Institution {
 	var authorized []types.Institution
 
 	for _, inst := range allInstitutions {
-		if inst.IsAuthorized == "true" {
+		if inst.IsAccreditedAt(ctx.BlockTime()) {
 			authorized = append(authorized, inst)
 		}
 	}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -160,20 +163,10 @@ func (k msgServer) UpdateInstitution(goCtx context.Context, req *types.MsgUpdate
 		updated = true
 	}
 
-	// Handle authorization status change
+	// Authorization status follows the accreditation, which carries an expiry
+	// date and is granted through MsgSetAccreditation
 	if req.IsAuthorized != "" && req.IsAuthorized != institution.IsAuthorized {
-		// Check if the requester can change authorization status
-		if !k.CanAuthorizeInstitution(ctx, req.Index, req.Creator) {
-			return nil, fmt.Errorf("creator '%s' is not authorized to change authorization status of institution '%s'", req.Creator, req.Index)
-		}
-
-		// Validate the new authorization status
-		if req.IsAuthorized != "true" && req.IsAuthorized != "false" {
-			return nil, fmt.Errorf("invalid authorization status: must be 'true' or 'false', got '%s'", req.IsAuthorized)
-		}
-
-		institution.IsAuthorized = req.IsAuthorized
-		updated = true
+		return nil, types.ErrUnauthorized.Wrapf("the authorization status of institution '%s' can only change through MsgSetAccreditation", req.Index)
 	}
 
 	if !updated {
@@ -205,3 +198,400 @@ func (k msgServer) UpdateInstitution(goCtx context.Context, req *types.MsgUpdate
 
 	return &types.MsgUpdateInstitutionResponse{}, nil
 }
+
+// GrantRole assigns an institution role to an account
+func (k msgServer) GrantRole(goCtx context.Context, req *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Check if institution exists
+	if !k.InstitutionExists(ctx, req.Institution) {
+		return nil, fmt.Errorf("institution with index '%s' not found", req.Institution)
+	}
+
+	// Check permissions
+	if !k.CanManageRoles(ctx, req.Institution, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' cannot manage roles of institution '%s'", req.Creator, req.Institution)
+	}
+
+	if _, found := k.GetRoleAssignment(ctx, req.Institution, req.Address, req.Role, req.CourseId, req.SubjectId); found {
+		return nil, fmt.Errorf("role '%s' already granted to '%s'", req.Role, req.Address)
+	}
+
+	k.SetRoleAssignment(ctx, types.RoleAssignment{
+		Institution: req.Institution,
+		Address:     req.Address,
+		Role:        req.Role,
+		CourseId:    req.CourseId,
+		SubjectId:   req.SubjectId,
+		GrantedBy:   req.Creator,
+	})
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantRole,
+			sdk.NewAttribute(types.AttributeKeyInstitutionIndex, req.Institution),
+			sdk.NewAttribute(types.AttributeKeyAddress, req.Address),
+			sdk.NewAttribute(types.AttributeKeyRole, req.Role),
+			sdk.NewAttribute(types.AttributeKeyCourseId, req.CourseId),
+			sdk.NewAttribute(types.AttributeKeySubjectId, req.SubjectId),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		),
+	)
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+// RevokeRole removes an institution role from an account
+func (k msgServer) RevokeRole(goCtx context.Context, req *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Check permissions
+	if !k.CanManageRoles(ctx, req.Institution, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' cannot manage roles of institution '%s'", req.Creator, req.Institution)
+	}
+
+	if _, found := k.GetRoleAssignment(ctx, req.Institution, req.Address, req.Role, req.CourseId, req.SubjectId); !found {
+		return nil, types.ErrRoleNotFound.Wrapf("role '%s' of '%s' in institution '%s'", req.Role, req.Address, req.Institution)
+	}
+
+	k.RemoveRoleAssignment(ctx, req.Institution, req.Address, req.Role, req.CourseId, req.SubjectId)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeRole,
+			sdk.NewAttribute(types.AttributeKeyInstitutionIndex, req.Institution),
+			sdk.NewAttribute(types.AttributeKeyAddress, req.Address),
+			sdk.NewAttribute(types.AttributeKeyRole, req.Role),
+			sdk.NewAttribute(types.AttributeKeyCourseId, req.CourseId),
+			sdk.NewAttribute(types.AttributeKeySubjectId, req.SubjectId),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		),
+	)
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+// ConfigureGradingScale configures the grading scale used to validate the grades
+// issued by an institution
+func (k msgServer) ConfigureGradingScale(goCtx context.Context, req *types.MsgConfigureGradingScale) (*types.MsgConfigureGradingScaleResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Check if institution exists
+	if !k.InstitutionExists(ctx, req.Institution) {
+		return nil, fmt.Errorf("institution with index '%s' not found", req.Institution)
+	}
+
+	// Check permissions (authority or institution admins)
+	if !k.CanManageRoles(ctx, req.Institution, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' cannot configure the grading scale of institution '%s'", req.Creator, req.Institution)
+	}
+
+	scale := req.GradingScale()
+	k.SetGradingScale(ctx, scale)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConfigureGradingScale,
+			sdk.NewAttribute(types.AttributeKeyInstitutionIndex, req.Institution),
+			sdk.NewAttribute(types.AttributeKeyScaleType, scale.ScaleType),
+			sdk.NewAttribute(types.AttributeKeyPassingGrade, scale.PassingGrade),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		),
+	)
+
+	return &types.MsgConfigureGradingScaleResponse{}, nil
+}
+
+// RegisterSigningKey registers the public key a professor or registrar signs
+// issued records with
+func (k msgServer) RegisterSigningKey(goCtx context.Context, req *types.MsgRegisterSigningKey) (*types.MsgRegisterSigningKeyResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Check if institution exists
+	if !k.InstitutionExists(ctx, req.Institution) {
+		return nil, fmt.Errorf("institution with index '%s' not found", req.Institution)
+	}
+
+	// Check permissions (authority or institution admins)
+	if !k.CanManageRoles(ctx, req.Institution, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' cannot manage signing keys of institution '%s'", req.Creator, req.Institution)
+	}
+
+	if !k.CanHoldSigningKey(ctx, req.Institution, req.Owner) {
+		return nil, types.ErrInvalidSigningKey.Wrapf("'%s' holds no role in institution '%s'", req.Owner, req.Institution)
+	}
+
+	// Key IDs are never reused, so signatures keep pointing at the same key
+	if _, found := k.GetSigningKey(ctx, req.Institution, req.KeyId); found {
+		return nil, types.ErrInvalidSigningKey.Wrapf("signing key '%s' already registered", req.KeyId)
+	}
+
+	k.SetSigningKey(ctx, types.SigningKey{
+		Institution:  req.Institution,
+		KeyId:        req.KeyId,
+		Owner:        req.Owner,
+		Algorithm:    req.Algorithm,
+		PubKey:       req.PubKey,
+		RegisteredBy: req.Creator,
+		RegisteredAt: ctx.BlockTime().UTC().Format(time.RFC3339),
+	})
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterSigningKey,
+			sdk.NewAttribute(types.AttributeKeyInstitutionIndex, req.Institution),
+			sdk.NewAttribute(types.AttributeKeyKeyId, req.KeyId),
+			sdk.NewAttribute(types.AttributeKeyOwner, req.Owner),
+			sdk.NewAttribute(types.AttributeKeyAlgorithm, req.Algorithm),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		),
+	)
+
+	return &types.MsgRegisterSigningKeyResponse{}, nil
+}
+
+// RevokeSigningKey stops a signing key from being used for new records. The
+// key is kept so that records it signed earlier remain verifiable.
+func (k msgServer) RevokeSigningKey(goCtx context.Context, req *types.MsgRevokeSigningKey) (*types.MsgRevokeSigningKeyResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Check permissions (authority or institution admins)
+	if !k.CanManageRoles(ctx, req.Institution, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' cannot manage signing keys of institution '%s'", req.Creator, req.Institution)
+	}
+
+	key, found := k.GetSigningKey(ctx, req.Institution, req.KeyId)
+	if !found {
+		return nil, types.ErrSigningKeyNotFound.Wrapf("signing key '%s' of institution '%s'", req.KeyId, req.Institution)
+	}
+	if !key.IsActive() {
+		return nil, types.ErrInvalidSigningKey.Wrapf("signing key '%s' was already revoked at %s", req.KeyId, key.RevokedAt)
+	}
+
+	key.RevokedAt = ctx.BlockTime().UTC().Format(time.RFC3339)
+	k.SetSigningKey(ctx, key)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeSigningKey,
+			sdk.NewAttribute(types.AttributeKeyInstitutionIndex, req.Institution),
+			sdk.NewAttribute(types.AttributeKeyKeyId, req.KeyId),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		),
+	)
+
+	return &types.MsgRevokeSigningKeyResponse{}, nil
+}
+
+// ProposeAccreditation submits an institution for accreditation with links to
+// the supporting evidence. The proposal replaces any earlier one.
+func (k msgServer) ProposeAccreditation(goCtx context.Context, req *types.MsgProposeAccreditation) (*types.MsgProposeAccreditationResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Check if institution exists
+	if !k.InstitutionExists(ctx, req.Institution) {
+		return nil, fmt.Errorf("institution with index '%s' not found", req.Institution)
+	}
+
+	// Check permissions (institution admins)
+	if !k.IsInstitutionAdmin(ctx, req.Institution, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' cannot propose the accreditation of institution '%s'", req.Creator, req.Institution)
+	}
+
+	if proposal, found := k.GetAccreditationProposal(ctx, req.Institution); found && proposal.Status == types.AccreditationStatusPending {
+		return nil, types.ErrInvalidAccreditation.Wrapf("institution '%s' already has a pending accreditation proposal", req.Institution)
+	}
+
+	k.SetAccreditationProposal(ctx, types.AccreditationProposal{
+		Institution:   req.Institution,
+		Proposer:      req.Creator,
+		EvidenceLinks: req.EvidenceLinks,
+		Description:   req.Description,
+		SubmittedAt:   ctx.BlockTime().UTC().Format(time.RFC3339),
+		Status:        types.AccreditationStatusPending,
+	})
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposeAccreditation,
+			sdk.NewAttribute(types.AttributeKeyInstitutionIndex, req.Institution),
+			sdk.NewAttribute(types.AttributeKeyEvidenceLinks, strings.Join(req.EvidenceLinks, ",")),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		),
+	)
+
+	return &types.MsgProposeAccreditationResponse{}, nil
+}
+
+// SetAccreditation grants an institution accreditation until the given expiry
+// date, or withdraws it. Only governance and accreditation bodies may call it.
+func (k msgServer) SetAccreditation(goCtx context.Context, req *types.MsgSetAccreditation) (*types.MsgSetAccreditationResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Check permissions
+	if !k.CanAuthorizeInstitution(ctx, req.Institution, req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' is neither the governance authority nor an accreditation body", req.Authority)
+	}
+
+	institution, found := k.GetInstitution(ctx, req.Institution)
+	if !found {
+		return nil, fmt.Errorf("institution with index '%s' not found", req.Institution)
+	}
+
+	now := ctx.BlockTime().UTC()
+	proposal, hasProposal := k.GetAccreditationProposal(ctx, req.Institution)
+
+	if req.Accredited {
+		expiresAt, _ := time.Parse(time.RFC3339, req.ExpiresAt)
+		if !expiresAt.After(now) {
+			return nil, types.ErrInvalidAccreditation.Wrapf("expiry date %s is not in the future", req.ExpiresAt)
+		}
+		institution.IsAuthorized = "true"
+		institution.AccreditedUntil = expiresAt.UTC().Format(time.RFC3339)
+		institution.AccreditedAt = now.Format(time.RFC3339)
+		institution.AccreditedBy = req.Authority
+		institution.AccreditationEvidence = nil
+		if hasProposal {
+			institution.AccreditationEvidence = proposal.EvidenceLinks
+		}
+	} else {
+		institution.IsAuthorized = "false"
+		institution.AccreditedUntil = ""
+	}
+
+	if err := k.SetInstitution(ctx, institution); err != nil {
+		return nil, fmt.Errorf("failed to update institution: %w", err)
+	}
+
+	// Settle the pending proposal with the decision
+	if hasProposal && proposal.Status == types.AccreditationStatusPending {
+		proposal.Status = types.AccreditationStatusRejected
+		if req.Accredited {
+			proposal.Status = types.AccreditationStatusApproved
+		}
+		proposal.ReviewedBy = req.Authority
+		proposal.ReviewedAt = now.Format(time.RFC3339)
+		k.SetAccreditationProposal(ctx, proposal)
+	}
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAccreditation,
+			sdk.NewAttribute(types.AttributeKeyInstitutionIndex, req.Institution),
+			sdk.NewAttribute(types.AttributeKeyAccredited, strconv.FormatBool(req.Accredited)),
+			sdk.NewAttribute(types.AttributeKeyAccreditedUntil, institution.AccreditedUntil),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Authority),
+		),
+	)
+
+	k.Logger().Info("Institution accreditation updated",
+		"index", req.Institution,
+		"accredited", req.Accredited,
+		"accredited_until", institution.AccreditedUntil,
+		"authority", req.Authority,
+	)
+
+	return &types.MsgSetAccreditationResponse{}, nil
+}
+
+// SetAcademicTerm adds a term to the academic calendar of an institution or
+// replaces its dates
+func (k msgServer) SetAcademicTerm(goCtx context.Context, req *types.MsgSetAcademicTerm) (*types.MsgSetAcademicTermResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Check if institution exists
+	if !k.InstitutionExists(ctx, req.Institution) {
+		return nil, fmt.Errorf("institution with index '%s' not found", req.Institution)
+	}
+
+	// Check permissions (authority or institution admins)
+	if !k.CanManageRoles(ctx, req.Institution, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' cannot manage the academic calendar of institution '%s'", req.Creator, req.Institution)
+	}
+
+	k.Keeper.SetAcademicTerm(ctx, req.AcademicTerm())
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAcademicTerm,
+			sdk.NewAttribute(types.AttributeKeyInstitutionIndex, req.Institution),
+			sdk.NewAttribute(types.AttributeKeyTermId, req.TermId),
+			sdk.NewAttribute(types.AttributeKeyGradeSubmissionDeadline, req.GradeSubmissionDeadline),
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		),
+	)
+
+	return &types.MsgSetAcademicTermResponse{}, nil
+}
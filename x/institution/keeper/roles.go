@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/x/institution/types"
+)
+
+// SetRoleAssignment stores a role assignment
+func (k Keeper) SetRoleAssignment(ctx sdk.Context, assignment types.RoleAssignment) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := types.RoleAssignmentKey(assignment.Institution, assignment.Address, assignment.Role, assignment.CourseId, assignment.SubjectId)
+	store.Set(key, k.cdc.MustMarshal(&assignment))
+}
+
+// GetRoleAssignment returns a role assignment from its key fields
+func (k Keeper) GetRoleAssignment(ctx sdk.Context, institution, address, role, courseId, subjectId string) (val types.RoleAssignment, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.RoleAssignmentKey(institution, address, role, courseId, subjectId))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// RemoveRoleAssignment removes a role assignment from the store
+func (k Keeper) RemoveRoleAssignment(ctx sdk.Context, institution, address, role, courseId, subjectId string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.RoleAssignmentKey(institution, address, role, courseId, subjectId))
+}
+
+// GetAllRoleAssignments returns every role assignment
+func (k Keeper) GetAllRoleAssignments(ctx sdk.Context) []types.RoleAssignment {
+	return k.getRoleAssignments(ctx, types.KeyPrefix(types.RoleAssignmentKeyPrefix))
+}
+
+// GetAccountRoles returns the role assignments held by an address in an institution
+func (k Keeper) GetAccountRoles(ctx sdk.Context, institution string, address string) []types.RoleAssignment {
+	return k.getRoleAssignments(ctx, types.RoleAssignmentAccountPrefix(institution, address))
+}
+
+func (k Keeper) getRoleAssignments(ctx sdk.Context, keyPrefix []byte) (list []types.RoleAssignment) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RoleAssignment
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// HasRole checks if an address holds role in an institution for the given
+// course and subject. Pass empty course/subject IDs to require an
+// institution-wide assignment.
+func (k Keeper) HasRole(ctx sdk.Context, institution, address, role, courseId, subjectId string) bool {
+	for _, assignment := range k.GetAccountRoles(ctx, institution, address) {
+		if assignment.Matches(role, courseId, subjectId) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInstitutionAdmin checks if an address administers an institution, either
+// as its creator or through the institution admin role
+func (k Keeper) IsInstitutionAdmin(ctx sdk.Context, institution string, address string) bool {
+	if k.IsInstitutionCreator(ctx, institution, address) {
+		return true
+	}
+	return k.HasRole(ctx, institution, address, types.RoleInstitutionAdmin, "", "")
+}
+
+// CanManageRoles checks if an address can grant and revoke roles in an institution
+func (k Keeper) CanManageRoles(ctx sdk.Context, institution string, address string) bool {
+	// Authority (governance) can always manage roles
+	if address == k.GetAuthority() {
+		return true
+	}
+	return k.IsInstitutionAdmin(ctx, institution, address)
+}
+
+// CanIssueGrade checks if an address can record grades for a subject of an
+// institution: admins, registrars and professors scoped to the course or subject
+func (k Keeper) CanIssueGrade(ctx sdk.Context, institution, address, courseId, subjectId string) bool {
+	if k.IsInstitutionAdmin(ctx, institution, address) {
+		return true
+	}
+	return k.HasRole(ctx, institution, address, types.RoleRegistrar, courseId, subjectId) ||
+		k.HasRole(ctx, institution, address, types.RoleProfessor, courseId, subjectId)
+}
+
+// CanIssueDegree checks if an address can issue degrees of a course:
+// institution admins and registrars scoped to the course
+func (k Keeper) CanIssueDegree(ctx sdk.Context, institution, address, courseId string) bool {
+	if k.IsInstitutionAdmin(ctx, institution, address) {
+		return true
+	}
+	return k.HasRole(ctx, institution, address, types.RoleRegistrar, courseId, "")
+}
+
+// CanManageCurriculum checks if an address can publish curriculum versions of
+// a course: institution admins and registrars scoped to the course
+func (k Keeper) CanManageCurriculum(ctx sdk.Context, institution, address, courseId string) bool {
+	if k.IsInstitutionAdmin(ctx, institution, address) {
+		return true
+	}
+	return k.HasRole(ctx, institution, address, types.RoleRegistrar, courseId, "")
+}
+
+// CanManageEnrollment checks if an address can manage student enrollments in a
+// course, such as approving curriculum migrations: institution admins and
+// registrars scoped to the course
+func (k Keeper) CanManageEnrollment(ctx sdk.Context, institution, address, courseId string) bool {
+	if k.IsInstitutionAdmin(ctx, institution, address) {
+		return true
+	}
+	return k.HasRole(ctx, institution, address, types.RoleRegistrar, courseId, "")
+}
+
+// CanGrantPrerequisiteWaiver checks if an address can exempt students of a
+// course from subject prerequisites: institution admins, and registrars and
+// advisors scoped to the course
+func (k Keeper) CanGrantPrerequisiteWaiver(ctx sdk.Context, institution, address, courseId string) bool {
+	if k.IsInstitutionAdmin(ctx, institution, address) {
+		return true
+	}
+	return k.HasRole(ctx, institution, address, types.RoleRegistrar, courseId, "") ||
+		k.HasRole(ctx, institution, address, types.RoleAdvisor, courseId, "")
+}
+
+// InstitutionRoles returns the role assignments of an institution with pagination
+func (k Keeper) InstitutionRoles(c context.Context, req *types.QueryInstitutionRolesRequest) (*types.QueryInstitutionRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var assignments []types.RoleAssignment
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RoleAssignmentInstitutionPrefix(req.Institution))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var assignment types.RoleAssignment
+		if err := k.cdc.Unmarshal(value, &assignment); err != nil {
+			return err
+		}
+
+		assignments = append(assignments, assignment)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInstitutionRolesResponse{RoleAssignment: assignments, Pagination: pageRes}, nil
+}
+
+// AccountRoles returns the role assignments held by an address in an institution
+func (k Keeper) AccountRoles(c context.Context, req *types.QueryAccountRolesRequest) (*types.QueryAccountRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAccountRolesResponse{RoleAssignment: k.GetAccountRoles(ctx, req.Institution, req.Address)}, nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/testutil/sample"
+	"academictoken/x/institution/types"
+)
+
+func TestRoleRegistry(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sample.AccAddress()
+	registrar := sample.AccAddress()
+	professor := sample.AccAddress()
+	outsider := sample.AccAddress()
+
+	res, err := ms.RegisterInstitution(ctx, types.NewMsgRegisterInstitution(creator, "University", "Main St"))
+	require.NoError(t, err)
+	institution := res.Index
+
+	// the creator administers the institution without an explicit grant
+	require.True(t, k.IsInstitutionAdmin(sdkCtx, institution, creator))
+	require.True(t, k.CanIssueGrade(sdkCtx, institution, creator, "course-1", "subject-1"))
+	require.False(t, k.CanIssueGrade(sdkCtx, institution, outsider, "course-1", "subject-1"))
+
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(creator, institution, registrar, types.RoleRegistrar, "course-1", ""))
+	require.NoError(t, err)
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(creator, institution, professor, types.RoleProfessor, "", "subject-1"))
+	require.NoError(t, err)
+
+	// duplicated grant
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(creator, institution, professor, types.RoleProfessor, "", "subject-1"))
+	require.Error(t, err)
+
+	// only admins can manage roles
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(registrar, institution, outsider, types.RoleProfessor, "", ""))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// unknown institution
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(creator, "institution-404", outsider, types.RoleProfessor, "", ""))
+	require.Error(t, err)
+
+	// registrar is scoped to course-1
+	require.True(t, k.CanIssueGrade(sdkCtx, institution, registrar, "course-1", "subject-9"))
+	require.False(t, k.CanIssueGrade(sdkCtx, institution, registrar, "course-2", "subject-9"))
+	require.True(t, k.CanIssueDegree(sdkCtx, institution, registrar, "course-1"))
+	require.False(t, k.CanIssueDegree(sdkCtx, institution, registrar, "course-2"))
+	require.True(t, k.CanManageCurriculum(sdkCtx, institution, registrar, "course-1"))
+	require.False(t, k.CanManageCurriculum(sdkCtx, institution, registrar, "course-2"))
+
+	// professor is scoped to subject-1 and cannot issue degrees
+	require.True(t, k.CanIssueGrade(sdkCtx, institution, professor, "course-1", "subject-1"))
+	require.False(t, k.CanIssueGrade(sdkCtx, institution, professor, "course-1", "subject-2"))
+	require.False(t, k.CanIssueDegree(sdkCtx, institution, professor, "course-1"))
+	require.False(t, k.CanManageCurriculum(sdkCtx, institution, professor, "course-1"))
+
+	// registrars can waive prerequisites in their course, professors cannot
+	require.True(t, k.CanGrantPrerequisiteWaiver(sdkCtx, institution, registrar, "course-1"))
+	require.False(t, k.CanGrantPrerequisiteWaiver(sdkCtx, institution, registrar, "course-2"))
+	require.False(t, k.CanGrantPrerequisiteWaiver(sdkCtx, institution, professor, "course-1"))
+
+	// roles do not leak across institutions
+	require.False(t, k.CanIssueGrade(sdkCtx, institution+"0", professor, "course-1", "subject-1"))
+
+	rolesRes, err := k.InstitutionRoles(ctx, &types.QueryInstitutionRolesRequest{Institution: institution})
+	require.NoError(t, err)
+	require.Len(t, rolesRes.RoleAssignment, 2)
+
+	accountRes, err := k.AccountRoles(ctx, &types.QueryAccountRolesRequest{Institution: institution, Address: professor})
+	require.NoError(t, err)
+	require.Equal(t, []types.RoleAssignment{{
+		Institution: institution,
+		Address:     professor,
+		Role:        types.RoleProfessor,
+		SubjectId:   "subject-1",
+		GrantedBy:   creator,
+	}}, accountRes.RoleAssignment)
+
+	// revocation
+	_, err = ms.RevokeRole(ctx, types.NewMsgRevokeRole(creator, institution, professor, types.RoleProfessor, "", "subject-1"))
+	require.NoError(t, err)
+	require.False(t, k.CanIssueGrade(sdkCtx, institution, professor, "course-1", "subject-1"))
+
+	_, err = ms.RevokeRole(ctx, types.NewMsgRevokeRole(creator, institution, professor, types.RoleProfessor, "", "subject-1"))
+	require.ErrorIs(t, err, types.ErrRoleNotFound)
+
+	// governance can manage roles of any institution
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(k.GetAuthority(), institution, outsider, types.RoleInstitutionAdmin, "", ""))
+	require.NoError(t, err)
+	require.True(t, k.IsInstitutionAdmin(sdkCtx, institution, outsider))
+
+	// advisors only counsel students: they waive prerequisites but issue nothing
+	advisor := sample.AccAddress()
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(creator, institution, advisor, types.RoleAdvisor, "course-1", ""))
+	require.NoError(t, err)
+	require.True(t, k.CanGrantPrerequisiteWaiver(sdkCtx, institution, advisor, "course-1"))
+	require.False(t, k.CanGrantPrerequisiteWaiver(sdkCtx, institution, advisor, "course-2"))
+	require.False(t, k.CanIssueGrade(sdkCtx, institution, advisor, "course-1", "subject-1"))
+	require.False(t, k.CanManageEnrollment(sdkCtx, institution, advisor, "course-1"))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"academictoken/x/institution/types"
+)
+
+// SetSigningKey stores a signing key
+func (k Keeper) SetSigningKey(ctx sdk.Context, key types.SigningKey) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.SigningKeyKey(key.Institution, key.KeyId), k.cdc.MustMarshal(&key))
+}
+
+// GetSigningKey returns a signing key of an institution
+func (k Keeper) GetSigningKey(ctx sdk.Context, institution string, keyId string) (val types.SigningKey, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.SigningKeyKey(institution, keyId))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// GetInstitutionSigningKeys returns every signing key of an institution,
+// revoked ones included
+func (k Keeper) GetInstitutionSigningKeys(ctx sdk.Context, institution string) []types.SigningKey {
+	return k.getSigningKeys(ctx, types.SigningKeyInstitutionPrefix(institution))
+}
+
+// GetAllSigningKeys returns every signing key
+func (k Keeper) GetAllSigningKeys(ctx sdk.Context) []types.SigningKey {
+	return k.getSigningKeys(ctx, types.KeyPrefix(types.SigningKeyKeyPrefix))
+}
+
+func (k Keeper) getSigningKeys(ctx sdk.Context, keyPrefix []byte) (list []types.SigningKey) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SigningKey
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CanHoldSigningKey checks if an address may own a signing key of an
+// institution: its admins and the holders of any of its roles
+func (k Keeper) CanHoldSigningKey(ctx sdk.Context, institution string, address string) bool {
+	return k.IsInstitutionAdmin(ctx, institution, address) || len(k.GetAccountRoles(ctx, institution, address)) > 0
+}
+
+// SigningKeys returns the signing keys registered by an institution
+func (k Keeper) SigningKeys(c context.Context, req *types.QuerySigningKeysRequest) (*types.QuerySigningKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySigningKeysResponse{SigningKey: k.GetInstitutionSigningKeys(ctx, req.Institution)}, nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/testutil/sample"
+	"academictoken/x/institution/types"
+)
+
+func TestSigningKeyRegistry(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	registeredAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockTime(registeredAt)
+
+	creator := sample.AccAddress()
+	professor := sample.AccAddress()
+	outsider := sample.AccAddress()
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().Bytes()
+
+	res, err := ms.RegisterInstitution(ctx, types.NewMsgRegisterInstitution(creator, "University", "Main St"))
+	require.NoError(t, err)
+	institution := res.Index
+
+	// keys can only be held by members of the institution
+	_, err = ms.RegisterSigningKey(ctx, types.NewMsgRegisterSigningKey(creator, institution, "key-1", professor, types.SigningAlgorithmSecp256k1, pubKey))
+	require.ErrorIs(t, err, types.ErrInvalidSigningKey)
+
+	_, err = ms.GrantRole(ctx, types.NewMsgGrantRole(creator, institution, professor, types.RoleProfessor, "", "subject-1"))
+	require.NoError(t, err)
+
+	// only admins can register keys
+	_, err = ms.RegisterSigningKey(ctx, types.NewMsgRegisterSigningKey(professor, institution, "key-1", professor, types.SigningAlgorithmSecp256k1, pubKey))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.RegisterSigningKey(ctx, types.NewMsgRegisterSigningKey(creator, institution, "key-1", professor, types.SigningAlgorithmSecp256k1, pubKey))
+	require.NoError(t, err)
+	_, err = ms.RegisterSigningKey(ctx, types.NewMsgRegisterSigningKey(creator, institution, "key-1", professor, types.SigningAlgorithmSecp256k1, pubKey))
+	require.ErrorIs(t, err, types.ErrInvalidSigningKey)
+
+	key, found := k.GetSigningKey(sdk.UnwrapSDKContext(ctx), institution, "key-1")
+	require.True(t, found)
+	require.True(t, key.IsActive())
+	require.Equal(t, creator, key.RegisteredBy)
+
+	msg := []byte("record")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, key.VerifySignature(msg, sig))
+	require.False(t, key.VerifySignature([]byte("tampered"), sig))
+
+	// revoked keys are kept and stay valid for the period before the revocation
+	revokedAt := registeredAt.Add(24 * time.Hour)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockTime(revokedAt)
+	_, err = ms.RevokeSigningKey(ctx, types.NewMsgRevokeSigningKey(outsider, institution, "key-1"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RevokeSigningKey(ctx, types.NewMsgRevokeSigningKey(creator, institution, "key-2"))
+	require.ErrorIs(t, err, types.ErrSigningKeyNotFound)
+	_, err = ms.RevokeSigningKey(ctx, types.NewMsgRevokeSigningKey(creator, institution, "key-1"))
+	require.NoError(t, err)
+	_, err = ms.RevokeSigningKey(ctx, types.NewMsgRevokeSigningKey(creator, institution, "key-1"))
+	require.ErrorIs(t, err, types.ErrInvalidSigningKey)
+
+	keys, err := k.SigningKeys(ctx, &types.QuerySigningKeysRequest{Institution: institution})
+	require.NoError(t, err)
+	require.Len(t, keys.SigningKey, 1)
+	key = keys.SigningKey[0]
+	require.False(t, key.IsActive())
+	require.False(t, key.ValidAt(registeredAt.Add(-time.Second)))
+	require.True(t, key.ValidAt(registeredAt))
+	require.True(t, key.ValidAt(revokedAt.Add(-time.Second)))
+	require.False(t, key.ValidAt(revokedAt))
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/institution/types"
+)
+
+// MigrateStore revokes the authorization of institutions that were never
+// accredited. v1 let institution creators authorize themselves, leaving
+// institutions authorized without an accreditation expiry; accreditations
+// granted through MsgSetAccreditation always carry one and are kept.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec, logger log.Logger) error {
+	store := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), types.KeyPrefix(types.InstitutionKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var revoked []types.Institution
+	for ; iterator.Valid(); iterator.Next() {
+		var institution types.Institution
+		if err := cdc.Unmarshal(iterator.Value(), &institution); err != nil {
+			return err
+		}
+		if institution.IsAuthorized == "true" && institution.AccreditedUntil == "" {
+			institution.IsAuthorized = "false"
+			revoked = append(revoked, institution)
+		}
+	}
+
+	for _, institution := range revoked {
+		bz, err := cdc.Marshal(&institution)
+		if err != nil {
+			return err
+		}
+		store.Set([]byte(institution.Index), bz)
+		logger.Info("revoking self-granted institution authorization", "institution", institution.Index)
+	}
+
+	return nil
+}
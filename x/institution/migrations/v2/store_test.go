@@ -0,0 +1,44 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "academictoken/x/institution/migrations/v2"
+	"academictoken/x/institution/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	institutions := []types.Institution{
+		{Index: "self-authorized", Name: "Self", Creator: "creator", IsAuthorized: "true"},
+		{Index: "accredited", Name: "Accredited", Creator: "creator", IsAuthorized: "true", AccreditedUntil: "2030-01-01T00:00:00Z"},
+		{Index: "unauthorized", Name: "Unauthorized", Creator: "creator", IsAuthorized: "false"},
+	}
+	for _, institution := range institutions {
+		bz, err := cdc.Marshal(&institution)
+		require.NoError(t, err)
+		ctx.KVStore(storeKey).Set(types.InstitutionKey(institution.Index), bz)
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc, log.NewNopLogger()))
+
+	expected := map[string]string{"self-authorized": "false", "accredited": "true", "unauthorized": "false"}
+	for _, institution := range institutions {
+		var migrated types.Institution
+		require.NoError(t, cdc.Unmarshal(ctx.KVStore(storeKey).Get(types.InstitutionKey(institution.Index)), &migrated))
+		require.Equal(t, expected[institution.Index], migrated.IsAuthorized, institution.Index)
+		migrated.IsAuthorized = institution.IsAuthorized
+		require.Equal(t, institution, migrated)
+	}
+}
@@ -17,6 +17,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "InstitutionRoles",
+					Use:            "institution-roles [institution]",
+					Short:          "List the role assignments of an institution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}},
+				},
+				{
+					RpcMethod:      "AccountRoles",
+					Use:            "account-roles [institution] [address]",
+					Short:          "List the roles an account holds in an institution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "GradingScale",
+					Use:            "grading-scale [institution]",
+					Short:          "Show the grading scale of an institution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}},
+				},
+				{
+					RpcMethod:      "SigningKeys",
+					Use:            "signing-keys [institution]",
+					Short:          "List the signing keys registered by an institution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -40,6 +64,69 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a update-institution tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "name"}, {ProtoField: "address"}, {ProtoField: "isAuthorized"}},
 				},
+				{
+					RpcMethod:      "GrantRole",
+					Use:            "grant-role [institution] [address] [role]",
+					Short:          "Grant an institution role (institution_admin, registrar, professor, advisor)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "address"}, {ProtoField: "role"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"courseId":  {Name: "course-id", Usage: "restrict the role to a course"},
+						"subjectId": {Name: "subject-id", Usage: "restrict the role to a subject"},
+					},
+				},
+				{
+					RpcMethod:      "RevokeRole",
+					Use:            "revoke-role [institution] [address] [role]",
+					Short:          "Revoke an institution role",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "address"}, {ProtoField: "role"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"courseId":  {Name: "course-id", Usage: "course the role was restricted to"},
+						"subjectId": {Name: "subject-id", Usage: "subject the role was restricted to"},
+					},
+				},
+				{
+					RpcMethod:      "ConfigureGradingScale",
+					Use:            "configure-grading-scale [institution] [scale-type]",
+					Short:          "Configure the grading scale of an institution (numeric, letter, pass_fail)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "scaleType"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"minGrade":     {Name: "min-grade", Usage: "lowest grade of the scale"},
+						"maxGrade":     {Name: "max-grade", Usage: "highest grade of the scale"},
+						"passingGrade": {Name: "passing-grade", Usage: "lowest passing grade"},
+						"maxGpa":       {Name: "max-gpa", Usage: "highest GPA (defaults to 4.0)"},
+						"letterGrades": {Name: "letter-grade", Usage: `letter mapping as JSON, e.g. '{"letter":"A","minValue":"90","gpaPoints":"4.0"}' (repeatable)`},
+					},
+				},
+				{
+					RpcMethod:      "RegisterSigningKey",
+					Use:            "register-signing-key [institution] [key-id] [owner] [algorithm] [pub-key]",
+					Short:          "Register the key a professor or registrar signs records with (secp256k1, ed25519; pub-key in hex or base64)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "keyId"}, {ProtoField: "owner"}, {ProtoField: "algorithm"}, {ProtoField: "pubKey"}},
+				},
+				{
+					RpcMethod:      "RevokeSigningKey",
+					Use:            "revoke-signing-key [institution] [key-id]",
+					Short:          "Revoke a signing key, keeping it to verify earlier records",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "keyId"}},
+				},
+				{
+					RpcMethod:      "ProposeAccreditation",
+					Use:            "propose-accreditation [institution] [description] [evidence-links]...",
+					Short:          "Submit an institution for accreditation with links to the supporting evidence",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "description"}, {ProtoField: "evidenceLinks", Varargs: true}},
+				},
+				{
+					RpcMethod:      "SetAccreditation",
+					Use:            "set-accreditation [institution] [accredited] [expires-at]",
+					Short:          "Grant (until an RFC3339 expiry date) or withdraw the accreditation of an institution; governance or accreditation bodies only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "accredited"}, {ProtoField: "expiresAt", Optional: true}},
+				},
+				{
+					RpcMethod:      "SetAcademicTerm",
+					Use:            "set-academic-term [institution] [term-id] [name] [start-date] [end-date] [enrollment-start] [enrollment-end] [grade-submission-deadline]",
+					Short:          "Add a term to the academic calendar of an institution or replace its dates (RFC3339)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "institution"}, {ProtoField: "termId"}, {ProtoField: "name"}, {ProtoField: "startDate"}, {ProtoField: "endDate"}, {ProtoField: "enrollmentStart"}, {ProtoField: "enrollmentEnd"}, {ProtoField: "gradeSubmissionDeadline"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
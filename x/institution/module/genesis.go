@@ -9,6 +9,29 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the roleAssignment
+	for _, elem := range genState.RoleAssignmentList {
+		k.SetRoleAssignment(ctx, elem)
+	}
+
+	// Set all the gradingScale
+	for _, elem := range genState.GradingScaleList {
+		k.SetGradingScale(ctx, elem)
+	}
+
+	// Set all the signingKey
+	for _, elem := range genState.SigningKeyList {
+		k.SetSigningKey(ctx, elem)
+	}
+
+	// Set all the accreditationProposal
+	for _, elem := range genState.AccreditationProposalList {
+		k.SetAccreditationProposal(ctx, elem)
+	}
+	for _, elem := range genState.AcademicTermList {
+		k.SetAcademicTerm(ctx, elem)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -20,6 +43,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.RoleAssignmentList = k.GetAllRoleAssignments(ctx)
+	genesis.GradingScaleList = k.GetAllGradingScales(ctx)
+	genesis.SigningKeyList = k.GetAllSigningKeys(ctx)
+	genesis.AccreditationProposalList = k.GetAllAccreditationProposals(ctx)
+	genesis.AcademicTermList = k.GetAllAcademicTerms(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -15,6 +15,14 @@ func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
+		RoleAssignmentList: []types.RoleAssignment{
+			{Institution: "institution-1", Address: "registrar", Role: types.RoleRegistrar, GrantedBy: "creator"},
+			{Institution: "institution-1", Address: "professor", Role: types.RoleProfessor, SubjectId: "subject-1", GrantedBy: "creator"},
+		},
+		GradingScaleList: []types.GradingScale{
+			types.DefaultGradingScale("institution-1"),
+			{Institution: "institution-2", ScaleType: types.ScaleTypePassFail, MaxGpa: types.DefaultMaxGpa, UpdatedBy: "creator"},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -26,5 +34,7 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.RoleAssignmentList, got.RoleAssignmentList)
+	require.ElementsMatch(t, genesisState.GradingScaleList, got.GradingScaleList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
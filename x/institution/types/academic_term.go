@@ -0,0 +1,92 @@
+package types
+
+import (
+	"regexp"
+	"time"
+)
+
+// termIdPattern restricts term IDs to a single token such as "2024-1" or "2024-fall"
+var termIdPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// ValidateTermId checks the format of an academic term ID
+func ValidateTermId(termId string) error {
+	if !termIdPattern.MatchString(termId) {
+		return ErrInvalidAcademicTerm.Wrapf("invalid term ID '%s': use letters, digits, '.', '_' or '-'", termId)
+	}
+	return nil
+}
+
+// termDates holds the parsed dates of an academic term
+type termDates struct {
+	start, end, enrollmentStart, enrollmentEnd, gradeDeadline time.Time
+}
+
+// parseDates parses the RFC3339 dates of the term
+func (term AcademicTerm) parseDates() (termDates, error) {
+	var dates termDates
+	fields := []struct {
+		name  string
+		value string
+		dest  *time.Time
+	}{
+		{"start date", term.StartDate, &dates.start},
+		{"end date", term.EndDate, &dates.end},
+		{"enrollment start", term.EnrollmentStart, &dates.enrollmentStart},
+		{"enrollment end", term.EnrollmentEnd, &dates.enrollmentEnd},
+		{"grade submission deadline", term.GradeSubmissionDeadline, &dates.gradeDeadline},
+	}
+	for _, field := range fields {
+		parsed, err := time.Parse(time.RFC3339, field.value)
+		if err != nil {
+			return dates, ErrInvalidAcademicTerm.Wrapf("invalid %s '%s', expected RFC3339", field.name, field.value)
+		}
+		*field.dest = parsed
+	}
+	return dates, nil
+}
+
+// Validate checks the ID and the ordering of the dates of an academic term
+func (term AcademicTerm) Validate() error {
+	if term.Institution == "" {
+		return ErrInvalidAcademicTerm.Wrap("institution cannot be empty")
+	}
+	if err := ValidateTermId(term.TermId); err != nil {
+		return err
+	}
+
+	dates, err := term.parseDates()
+	if err != nil {
+		return err
+	}
+	if !dates.start.Before(dates.end) {
+		return ErrInvalidAcademicTerm.Wrap("start date must be before end date")
+	}
+	if !dates.enrollmentStart.Before(dates.enrollmentEnd) {
+		return ErrInvalidAcademicTerm.Wrap("enrollment start must be before enrollment end")
+	}
+	if dates.enrollmentEnd.After(dates.end) {
+		return ErrInvalidAcademicTerm.Wrap("enrollment must end before the term ends")
+	}
+	if dates.gradeDeadline.Before(dates.end) {
+		return ErrInvalidAcademicTerm.Wrap("grade submission deadline cannot be before the term ends")
+	}
+	return nil
+}
+
+// IsEnrollmentOpenAt reports whether t falls within the enrollment window
+func (term AcademicTerm) IsEnrollmentOpenAt(t time.Time) bool {
+	dates, err := term.parseDates()
+	if err != nil {
+		return false
+	}
+	return !t.Before(dates.enrollmentStart) && !t.After(dates.enrollmentEnd)
+}
+
+// AcceptsGradesAt reports whether grades of the term can still be submitted at t
+func (term AcademicTerm) AcceptsGradesAt(t time.Time) bool {
+	dates, err := term.parseDates()
+	if err != nil {
+		return false
+	}
+	return !t.After(dates.gradeDeadline)
+}
@@ -0,0 +1,735 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: academictoken/institution/academic_term.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AcademicTerm is an entry of an institution's academic calendar. Records
+// that happen in a term (completed subjects, tokens, study plans) refer to it
+// by termId. All dates are RFC3339.
+type AcademicTerm struct {
+	Institution             string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	TermId                  string `protobuf:"bytes,2,opt,name=termId,proto3" json:"termId,omitempty"`
+	Name                    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate               string `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate                 string `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	EnrollmentStart         string `protobuf:"bytes,6,opt,name=enrollmentStart,proto3" json:"enrollmentStart,omitempty"`
+	EnrollmentEnd           string `protobuf:"bytes,7,opt,name=enrollmentEnd,proto3" json:"enrollmentEnd,omitempty"`
+	GradeSubmissionDeadline string `protobuf:"bytes,8,opt,name=gradeSubmissionDeadline,proto3" json:"gradeSubmissionDeadline,omitempty"`
+	Creator                 string `protobuf:"bytes,9,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *AcademicTerm) Reset()         { *m = AcademicTerm{} }
+func (m *AcademicTerm) String() string { return proto.CompactTextString(m) }
+func (*AcademicTerm) ProtoMessage()    {}
+func (*AcademicTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b20bca5d45699a6, []int{0}
+}
+func (m *AcademicTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcademicTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcademicTerm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcademicTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcademicTerm.Merge(m, src)
+}
+func (m *AcademicTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcademicTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcademicTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcademicTerm proto.InternalMessageInfo
+
+func (m *AcademicTerm) GetInstitution() string {
+	if m != nil {
+		return m.Institution
+	}
+	return ""
+}
+
+func (m *AcademicTerm) GetTermId() string {
+	if m != nil {
+		return m.TermId
+	}
+	return ""
+}
+
+func (m *AcademicTerm) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AcademicTerm) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *AcademicTerm) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *AcademicTerm) GetEnrollmentStart() string {
+	if m != nil {
+		return m.EnrollmentStart
+	}
+	return ""
+}
+
+func (m *AcademicTerm) GetEnrollmentEnd() string {
+	if m != nil {
+		return m.EnrollmentEnd
+	}
+	return ""
+}
+
+func (m *AcademicTerm) GetGradeSubmissionDeadline() string {
+	if m != nil {
+		return m.GradeSubmissionDeadline
+	}
+	return ""
+}
+
+func (m *AcademicTerm) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AcademicTerm)(nil), "academictoken.institution.AcademicTerm")
+}
+
+func init() {
+	proto.RegisterFile("academictoken/institution/academic_term.proto", fileDescriptor_1b20bca5d45699a6)
+}
+
+var fileDescriptor_1b20bca5d45699a6 = []byte{
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x93, 0xfe, 0xfd, 0x53, 0x33, 0x2a, 0xc2, 0x2c, 0x74, 0x04, 0x19, 0xaa, 0xb8, 0xe8,
+	0xc6, 0x76, 0xe1, 0x46, 0x70, 0xa5, 0xd4, 0x85, 0x5b, 0xeb, 0xca, 0x8d, 0x4c, 0x33, 0x17, 0x19,
+	0xcc, 0xcc, 0x94, 0xc9, 0x2d, 0xe8, 0x5b, 0xf8, 0x38, 0x3e, 0x82, 0xcb, 0x2e, 0x5d, 0x4a, 0xf2,
+	0x22, 0x92, 0x6b, 0x4a, 0x13, 0xc1, 0x5d, 0xce, 0xf7, 0x9d, 0x0c, 0x17, 0x0e, 0x3b, 0x53, 0x99,
+	0xd2, 0x60, 0x4d, 0x86, 0xfe, 0x19, 0xdc, 0xc4, 0xb8, 0x02, 0x0d, 0x2e, 0xd1, 0x78, 0x37, 0x59,
+	0x9b, 0x47, 0x84, 0x60, 0xc7, 0x8b, 0xe0, 0xd1, 0xf3, 0xc3, 0x4e, 0x7d, 0xdc, 0xaa, 0x9f, 0xbc,
+	0xf7, 0xd8, 0xce, 0x55, 0x63, 0xef, 0x21, 0x58, 0x3e, 0x64, 0xdb, 0x2d, 0x2f, 0xe2, 0x61, 0x3c,
+	0x4a, 0xef, 0xda, 0x88, 0xef, 0xb3, 0xa4, 0x7e, 0xfb, 0x56, 0x8b, 0x1e, 0xc9, 0x26, 0x71, 0xce,
+	0xfa, 0x4e, 0x59, 0x10, 0xff, 0x88, 0xd2, 0x37, 0x3f, 0x62, 0x69, 0x81, 0x2a, 0xe0, 0x54, 0x21,
+	0x88, 0x3e, 0x89, 0x0d, 0xe0, 0x82, 0x0d, 0xc0, 0x69, 0x72, 0xff, 0xc9, 0xad, 0x23, 0x1f, 0xb1,
+	0x3d, 0x70, 0xc1, 0xe7, 0xb9, 0x05, 0x87, 0xb3, 0xfa, 0x07, 0x91, 0x50, 0xe3, 0x37, 0xe6, 0xa7,
+	0x6c, 0x77, 0x83, 0x6e, 0x9c, 0x16, 0x03, 0xea, 0x75, 0x21, 0xbf, 0x60, 0x07, 0x4f, 0x41, 0x69,
+	0x98, 0x2d, 0xe7, 0xd6, 0x14, 0x85, 0xf1, 0x6e, 0x0a, 0x4a, 0xe7, 0xc6, 0x81, 0xd8, 0xa2, 0xfe,
+	0x5f, 0xba, 0xbe, 0x31, 0x0b, 0xa0, 0xd0, 0x07, 0x91, 0xfe, 0xdc, 0xd8, 0xc4, 0xeb, 0xcb, 0x8f,
+	0x52, 0xc6, 0xab, 0x52, 0xc6, 0x5f, 0xa5, 0x8c, 0xdf, 0x2a, 0x19, 0xad, 0x2a, 0x19, 0x7d, 0x56,
+	0x32, 0x7a, 0x38, 0xee, 0xce, 0xf3, 0xd2, 0x19, 0x08, 0x5f, 0x17, 0x50, 0xcc, 0x13, 0x5a, 0xe6,
+	0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xce, 0x5c, 0x01, 0x4e, 0xca, 0x01, 0x00, 0x00,
+}
+
+func (m *AcademicTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcademicTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcademicTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.GradeSubmissionDeadline) > 0 {
+		i -= len(m.GradeSubmissionDeadline)
+		copy(dAtA[i:], m.GradeSubmissionDeadline)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.GradeSubmissionDeadline)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.EnrollmentEnd) > 0 {
+		i -= len(m.EnrollmentEnd)
+		copy(dAtA[i:], m.EnrollmentEnd)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.EnrollmentEnd)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EnrollmentStart) > 0 {
+		i -= len(m.EnrollmentStart)
+		copy(dAtA[i:], m.EnrollmentStart)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.EnrollmentStart)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TermId) > 0 {
+		i -= len(m.TermId)
+		copy(dAtA[i:], m.TermId)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.TermId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintAcademicTerm(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAcademicTerm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAcademicTerm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AcademicTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	l = len(m.TermId)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	l = len(m.EnrollmentStart)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	l = len(m.EnrollmentEnd)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	l = len(m.GradeSubmissionDeadline)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovAcademicTerm(uint64(l))
+	}
+	return n
+}
+
+func sovAcademicTerm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAcademicTerm(x uint64) (n int) {
+	return sovAcademicTerm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AcademicTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAcademicTerm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcademicTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcademicTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentStart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrollmentStart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrollmentEnd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradeSubmissionDeadline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GradeSubmissionDeadline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAcademicTerm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAcademicTerm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAcademicTerm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAcademicTerm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAcademicTerm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAcademicTerm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAcademicTerm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAcademicTerm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAcademicTerm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAcademicTerm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAcademicTerm = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"net/url"
+	"time"
+)
+
+// Accreditation proposal statuses
+const (
+	AccreditationStatusPending  = "pending"
+	AccreditationStatusApproved = "approved"
+	AccreditationStatusRejected = "rejected"
+)
+
+// IsAccreditedAt reports whether the institution holds an accreditation that
+// has not lapsed at t. Accreditations are always granted with an expiry date,
+// so authorizations without one are not accreditations.
+func (institution Institution) IsAccreditedAt(t time.Time) bool {
+	if institution.IsAuthorized != "true" || institution.AccreditedUntil == "" {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, institution.AccreditedUntil)
+	return err == nil && t.Before(until)
+}
+
+// ValidateEvidenceLinks checks that evidence links are absolute URLs
+func ValidateEvidenceLinks(links []string) error {
+	if len(links) == 0 {
+		return ErrInvalidAccreditation.Wrap("at least one evidence link is required")
+	}
+	for _, link := range links {
+		parsed, err := url.Parse(link)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return ErrInvalidAccreditation.Wrapf("evidence link '%s' is not an absolute URL", link)
+		}
+	}
+	return nil
+}
+
+// Validate checks the institution, evidence and status of an accreditation proposal
+func (proposal AccreditationProposal) Validate() error {
+	if proposal.Institution == "" {
+		return ErrInvalidAccreditation.Wrap("institution cannot be empty")
+	}
+	if err := ValidateEvidenceLinks(proposal.EvidenceLinks); err != nil {
+		return err
+	}
+	switch proposal.Status {
+	case AccreditationStatusPending, AccreditationStatusApproved, AccreditationStatusRejected:
+	default:
+		return ErrInvalidAccreditation.Wrapf("invalid proposal status '%s'", proposal.Status)
+	}
+	return nil
+}
@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: academictoken/institution/accreditation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccreditationProposal is an institution's request to be accredited, backed
+// by links to the evidence reviewers should assess. Only the latest proposal
+// of each institution is kept.
+type AccreditationProposal struct {
+	Institution   string   `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	Proposer      string   `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	EvidenceLinks []string `protobuf:"bytes,3,rep,name=evidenceLinks,proto3" json:"evidenceLinks,omitempty"`
+	Description   string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SubmittedAt   string   `protobuf:"bytes,5,opt,name=submittedAt,proto3" json:"submittedAt,omitempty"`
+	Status        string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ReviewedBy    string   `protobuf:"bytes,7,opt,name=reviewedBy,proto3" json:"reviewedBy,omitempty"`
+	ReviewedAt    string   `protobuf:"bytes,8,opt,name=reviewedAt,proto3" json:"reviewedAt,omitempty"`
+}
+
+func (m *AccreditationProposal) Reset()         { *m = AccreditationProposal{} }
+func (m *AccreditationProposal) String() string { return proto.CompactTextString(m) }
+func (*AccreditationProposal) ProtoMessage()    {}
+func (*AccreditationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30c61120e12e185d, []int{0}
+}
+func (m *AccreditationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccreditationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccreditationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccreditationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccreditationProposal.Merge(m, src)
+}
+func (m *AccreditationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccreditationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccreditationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccreditationProposal proto.InternalMessageInfo
+
+func (m *AccreditationProposal) GetInstitution() string {
+	if m != nil {
+		return m.Institution
+	}
+	return ""
+}
+
+func (m *AccreditationProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *AccreditationProposal) GetEvidenceLinks() []string {
+	if m != nil {
+		return m.EvidenceLinks
+	}
+	return nil
+}
+
+func (m *AccreditationProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AccreditationProposal) GetSubmittedAt() string {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return ""
+}
+
+func (m *AccreditationProposal) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *AccreditationProposal) GetReviewedBy() string {
+	if m != nil {
+		return m.ReviewedBy
+	}
+	return ""
+}
+
+func (m *AccreditationProposal) GetReviewedAt() string {
+	if m != nil {
+		return m.ReviewedAt
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AccreditationProposal)(nil), "academictoken.institution.AccreditationProposal")
+}
+
+func init() {
+	proto.RegisterFile("academictoken/institution/accreditation.proto", fileDescriptor_30c61120e12e185d)
+}
+
+var fileDescriptor_30c61120e12e185d = []byte{
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0x93, 0xf6, 0xff, 0x43, 0x6b, 0xc4, 0x62, 0x09, 0x64, 0x18, 0xac, 0x80, 0x18, 0xba,
+	0xd0, 0x0e, 0x8c, 0x4c, 0xe9, 0xcc, 0x80, 0x3a, 0xb2, 0xb9, 0xf6, 0x1d, 0xae, 0x4a, 0xe3, 0xc8,
+	0xbe, 0x29, 0xf4, 0x2d, 0xd8, 0x78, 0x25, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0x14, 0x17, 0x21,
+	0x7b, 0xbc, 0xe7, 0xfb, 0xae, 0x8e, 0x74, 0xd8, 0x9d, 0xd2, 0xca, 0xc0, 0x16, 0x35, 0xd9, 0x0d,
+	0xd4, 0x0b, 0xac, 0x3d, 0x21, 0xb5, 0x84, 0xb6, 0x5e, 0x28, 0xad, 0x1d, 0x18, 0x24, 0x35, 0x5c,
+	0xf3, 0xc6, 0x59, 0xb2, 0xfc, 0x32, 0xd1, 0xe7, 0x91, 0x7e, 0xf3, 0x31, 0x62, 0xe7, 0x55, 0xfc,
+	0xf2, 0xe4, 0x6c, 0x63, 0xbd, 0x7a, 0xe1, 0x25, 0x3b, 0x8d, 0x44, 0x91, 0x97, 0xf9, 0x6c, 0xba,
+	0x8a, 0x23, 0x7e, 0xc5, 0x26, 0x4d, 0xb0, 0xc1, 0x89, 0x51, 0xc0, 0x7f, 0x37, 0xbf, 0x65, 0x67,
+	0xb0, 0x43, 0x03, 0xb5, 0x86, 0x47, 0xac, 0x37, 0x5e, 0x8c, 0xcb, 0xf1, 0x6c, 0xba, 0x4a, 0xc3,
+	0xa1, 0xc3, 0x80, 0xd7, 0x0e, 0x9b, 0xd0, 0xf1, 0xef, 0xd8, 0x11, 0x45, 0x83, 0xe1, 0xdb, 0xf5,
+	0x16, 0x89, 0xc0, 0x54, 0x24, 0xfe, 0x1f, 0x8d, 0x28, 0xe2, 0x17, 0xac, 0xf0, 0xa4, 0xa8, 0xf5,
+	0xa2, 0x08, 0xf0, 0xf7, 0xe2, 0x92, 0x31, 0x07, 0x3b, 0x84, 0x57, 0x30, 0xcb, 0xbd, 0x38, 0x09,
+	0x2c, 0x4a, 0x62, 0x5e, 0x91, 0x98, 0xa4, 0xbc, 0xa2, 0xe5, 0xc3, 0x67, 0x27, 0xf3, 0x43, 0x27,
+	0xf3, 0xef, 0x4e, 0xe6, 0xef, 0xbd, 0xcc, 0x0e, 0xbd, 0xcc, 0xbe, 0x7a, 0x99, 0x3d, 0x5f, 0xa7,
+	0xeb, 0xbf, 0x25, 0xfb, 0xd3, 0xbe, 0x01, 0xbf, 0x2e, 0xc2, 0xf0, 0xf7, 0x3f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0xb6, 0xfe, 0x1b, 0xa3, 0xa9, 0x01, 0x00, 0x00,
+}
+
+func (m *AccreditationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccreditationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccreditationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReviewedAt) > 0 {
+		i -= len(m.ReviewedAt)
+		copy(dAtA[i:], m.ReviewedAt)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.ReviewedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ReviewedBy) > 0 {
+		i -= len(m.ReviewedBy)
+		copy(dAtA[i:], m.ReviewedBy)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.ReviewedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SubmittedAt) > 0 {
+		i -= len(m.SubmittedAt)
+		copy(dAtA[i:], m.SubmittedAt)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.SubmittedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvidenceLinks) > 0 {
+		for iNdEx := len(m.EvidenceLinks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EvidenceLinks[iNdEx])
+			copy(dAtA[i:], m.EvidenceLinks[iNdEx])
+			i = encodeVarintAccreditation(dAtA, i, uint64(len(m.EvidenceLinks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccreditation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccreditation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccreditationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	if len(m.EvidenceLinks) > 0 {
+		for _, s := range m.EvidenceLinks {
+			l = len(s)
+			n += 1 + l + sovAccreditation(uint64(l))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.SubmittedAt)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.ReviewedBy)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.ReviewedAt)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	return n
+}
+
+func sovAccreditation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccreditation(x uint64) (n int) {
+	return sovAccreditation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccreditationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccreditation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccreditationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccreditationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceLinks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceLinks = append(m.EvidenceLinks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmittedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccreditation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccreditation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccreditation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccreditation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccreditation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccreditation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccreditation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccreditation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccreditation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -14,6 +14,26 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateInstitution{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantRole{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeRole{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgConfigureGradingScale{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterSigningKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeSigningKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProposeAccreditation{},
+		&MsgSetAccreditation{},
+		&MsgSetAcademicTerm{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
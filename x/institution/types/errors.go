@@ -8,6 +8,18 @@ import (
 
 // x/institution module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner         = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample                = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidRole           = sdkerrors.Register(ModuleName, 1102, "invalid role")
+	ErrRoleNotFound          = sdkerrors.Register(ModuleName, 1103, "role assignment not found")
+	ErrUnauthorized          = sdkerrors.Register(ModuleName, 1104, "unauthorized")
+	ErrInvalidGradingScale   = sdkerrors.Register(ModuleName, 1105, "invalid grading scale")
+	ErrInvalidGrade          = sdkerrors.Register(ModuleName, 1106, "invalid grade")
+	ErrInvalidSigningKey     = sdkerrors.Register(ModuleName, 1107, "invalid signing key")
+	ErrSigningKeyNotFound    = sdkerrors.Register(ModuleName, 1108, "signing key not found")
+	ErrInvalidAccreditation  = sdkerrors.Register(ModuleName, 1109, "invalid accreditation")
+	ErrNotAccredited         = sdkerrors.Register(ModuleName, 1110, "institution not accredited")
+	ErrInvalidAcademicTerm   = sdkerrors.Register(ModuleName, 1111, "invalid academic term")
+	ErrAcademicTermNotFound  = sdkerrors.Register(ModuleName, 1112, "academic term not found")
+	ErrGradeSubmissionClosed = sdkerrors.Register(ModuleName, 1113, "grade submission deadline has passed")
 )
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,6 +14,11 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		RoleAssignmentList:        []RoleAssignment{},
+		GradingScaleList:          []GradingScale{},
+		SigningKeyList:            []SigningKey{},
+		AccreditationProposalList: []AccreditationProposal{},
+		AcademicTermList:          []AcademicTerm{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -16,6 +27,75 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated role assignments
+	roleAssignmentKeys := make(map[string]struct{})
+	for _, elem := range gs.RoleAssignmentList {
+		if elem.Institution == "" {
+			return fmt.Errorf("role assignment for %s has an empty institution", elem.Address)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid role holder address %s: %w", elem.Address, err)
+		}
+		if err := ValidateRoleScope(elem.Role, elem.CourseId, elem.SubjectId); err != nil {
+			return err
+		}
+		key := string(RoleAssignmentKey(elem.Institution, elem.Address, elem.Role, elem.CourseId, elem.SubjectId))
+		if _, ok := roleAssignmentKeys[key]; ok {
+			return fmt.Errorf("duplicated role assignment: %s", key)
+		}
+		roleAssignmentKeys[key] = struct{}{}
+	}
+
+	// Check for invalid or duplicated grading scales
+	gradingScaleIndexMap := make(map[string]struct{})
+	for _, elem := range gs.GradingScaleList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid grading scale for institution %s: %w", elem.Institution, err)
+		}
+		if _, ok := gradingScaleIndexMap[elem.Institution]; ok {
+			return fmt.Errorf("duplicated grading scale for institution: %s", elem.Institution)
+		}
+		gradingScaleIndexMap[elem.Institution] = struct{}{}
+	}
+
+	// Check for invalid or duplicated signing keys
+	signingKeyIndexMap := make(map[string]struct{})
+	for _, elem := range gs.SigningKeyList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid signing key %s of institution %s: %w", elem.KeyId, elem.Institution, err)
+		}
+		key := string(SigningKeyKey(elem.Institution, elem.KeyId))
+		if _, ok := signingKeyIndexMap[key]; ok {
+			return fmt.Errorf("duplicated signing key: %s", key)
+		}
+		signingKeyIndexMap[key] = struct{}{}
+	}
+
+	// Check for invalid or duplicated accreditation proposals
+	accreditationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AccreditationProposalList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid accreditation proposal of institution %s: %w", elem.Institution, err)
+		}
+		if _, ok := accreditationIndexMap[elem.Institution]; ok {
+			return fmt.Errorf("duplicated accreditation proposal for institution: %s", elem.Institution)
+		}
+		accreditationIndexMap[elem.Institution] = struct{}{}
+	}
+
+	// Check for invalid or duplicated academic terms
+	academicTermIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AcademicTermList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid academic term %s of institution %s: %w", elem.TermId, elem.Institution, err)
+		}
+		key := string(AcademicTermKey(elem.Institution, elem.TermId))
+		if _, ok := academicTermIndexMap[key]; ok {
+			return fmt.Errorf("duplicated academic term: %s", key)
+		}
+		academicTermIndexMap[key] = struct{}{}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
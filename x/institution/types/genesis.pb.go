@@ -27,7 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the institution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params                    Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	RoleAssignmentList        []RoleAssignment        `protobuf:"bytes,2,rep,name=roleAssignmentList,proto3" json:"roleAssignmentList"`
+	GradingScaleList          []GradingScale          `protobuf:"bytes,3,rep,name=gradingScaleList,proto3" json:"gradingScaleList"`
+	SigningKeyList            []SigningKey            `protobuf:"bytes,4,rep,name=signingKeyList,proto3" json:"signingKeyList"`
+	AccreditationProposalList []AccreditationProposal `protobuf:"bytes,5,rep,name=accreditationProposalList,proto3" json:"accreditationProposalList"`
+	AcademicTermList          []AcademicTerm          `protobuf:"bytes,6,rep,name=academicTermList,proto3" json:"academicTermList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +75,41 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRoleAssignmentList() []RoleAssignment {
+	if m != nil {
+		return m.RoleAssignmentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGradingScaleList() []GradingScale {
+	if m != nil {
+		return m.GradingScaleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSigningKeyList() []SigningKey {
+	if m != nil {
+		return m.SigningKeyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccreditationProposalList() []AccreditationProposal {
+	if m != nil {
+		return m.AccreditationProposalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAcademicTermList() []AcademicTerm {
+	if m != nil {
+		return m.AcademicTermList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "academictoken.institution.GenesisState")
 }
@@ -79,20 +119,32 @@ func init() {
 }
 
 var fileDescriptor_3b073558f7f8e569 = []byte{
-	// 200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0x4c, 0x4e, 0x4c,
-	0x49, 0xcd, 0xcd, 0x4c, 0x2e, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0xcf, 0xcc, 0x2b, 0x2e, 0xc9, 0x2c,
-	0x29, 0x2d, 0xc9, 0xcc, 0xcf, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x44, 0x51, 0xa8, 0x87, 0xa4, 0x50, 0x4a, 0x30, 0x31, 0x37, 0x33,
-	0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x54, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20,
-	0x16, 0x54, 0x54, 0x0d, 0xb7, 0x65, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0xbb, 0x94, 0x42, 0xb8,
-	0x78, 0xdc, 0x21, 0x96, 0x07, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xb9, 0x70, 0xb1, 0x41, 0xe4, 0x25,
-	0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x14, 0xf5, 0x70, 0x3a, 0x46, 0x2f, 0x00, 0xac, 0xd0, 0x89,
-	0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0xf5, 0x3a, 0x59, 0x9f,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x22, 0xaa, 0xbb, 0x2a, 0x50, 0x5c,
-	0x56, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x99, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff,
-	0x70, 0x97, 0x4d, 0x32, 0x30, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x4e, 0xc2, 0x40,
+	0x1c, 0xc6, 0x5b, 0x41, 0x12, 0x8b, 0x31, 0xda, 0x38, 0x00, 0x43, 0x05, 0xa3, 0x82, 0x1a, 0x5b,
+	0x83, 0xa3, 0x13, 0xc4, 0x84, 0x41, 0x07, 0x42, 0x5d, 0x74, 0x21, 0x67, 0xb9, 0x34, 0x17, 0xe8,
+	0x5d, 0x73, 0x77, 0x26, 0xf2, 0x16, 0x3e, 0x86, 0xa3, 0x93, 0xcf, 0xc0, 0xc8, 0xe8, 0x64, 0x0c,
+	0x0c, 0xbe, 0x86, 0xb9, 0xeb, 0x41, 0x28, 0xd2, 0xe2, 0xd2, 0x5c, 0xdb, 0xdf, 0xf7, 0xfb, 0xb7,
+	0xdf, 0x9d, 0x51, 0x05, 0x1e, 0xe8, 0xc1, 0x00, 0x79, 0x9c, 0xf4, 0x21, 0x76, 0x10, 0x66, 0x1c,
+	0xf1, 0x67, 0x8e, 0x08, 0x76, 0x7c, 0x88, 0x21, 0x43, 0xcc, 0x0e, 0x29, 0xe1, 0xc4, 0x2c, 0xc6,
+	0x40, 0x7b, 0x01, 0x2c, 0xed, 0x81, 0x00, 0x61, 0xe2, 0xc8, 0x6b, 0x44, 0x97, 0xf6, 0x7d, 0xe2,
+	0x13, 0xb9, 0x74, 0xc4, 0x4a, 0x3d, 0x3d, 0x49, 0x1e, 0x16, 0x02, 0x0a, 0x02, 0x35, 0xab, 0x74,
+	0x94, 0xcc, 0x51, 0x32, 0x80, 0x8a, 0xba, 0x48, 0xf9, 0x74, 0x0a, 0x7a, 0x08, 0xfb, 0x5d, 0xe6,
+	0x81, 0x39, 0x7e, 0x9e, 0x8c, 0x33, 0xe4, 0x63, 0x81, 0xf7, 0xe1, 0x70, 0xbd, 0x1b, 0x78, 0x1e,
+	0x85, 0x3d, 0xc4, 0x81, 0xb8, 0xfb, 0x0f, 0x1e, 0xbd, 0xe9, 0x72, 0x48, 0x83, 0x08, 0x3f, 0xfc,
+	0xc8, 0x1a, 0xdb, 0xad, 0xa8, 0x5d, 0x97, 0x03, 0x0e, 0xcd, 0x1b, 0x23, 0x17, 0x15, 0x50, 0xd0,
+	0xcb, 0x7a, 0x2d, 0x5f, 0xaf, 0xd8, 0x89, 0x6d, 0xdb, 0x6d, 0x09, 0x36, 0xb7, 0x46, 0x5f, 0x07,
+	0xda, 0xdb, 0xcf, 0xfb, 0x99, 0xde, 0x51, 0x59, 0xb3, 0x6b, 0x98, 0xa2, 0x9e, 0x06, 0x13, 0xff,
+	0x13, 0x40, 0xcc, 0xef, 0x10, 0xe3, 0x85, 0x8d, 0x72, 0xa6, 0x96, 0xaf, 0x9f, 0xa6, 0x18, 0x3b,
+	0xb1, 0x50, 0x33, 0x2b, 0xcc, 0x9d, 0x15, 0x2a, 0xf3, 0xc1, 0xd8, 0x55, 0xcd, 0xba, 0xa2, 0x58,
+	0xa9, 0xcf, 0x48, 0x7d, 0x35, 0x45, 0xdf, 0x5a, 0x88, 0x28, 0xf9, 0x1f, 0x8d, 0xe9, 0x1a, 0x3b,
+	0x6a, 0x17, 0x6e, 0xe1, 0x50, 0x8a, 0xb3, 0x52, 0x7c, 0x9c, 0x22, 0x76, 0xe7, 0x01, 0xa5, 0x5d,
+	0x52, 0x98, 0xdc, 0x28, 0xc6, 0x76, 0xab, 0x4d, 0x49, 0x48, 0x18, 0x18, 0x48, 0xff, 0xa6, 0xf4,
+	0x5f, 0xa6, 0xf8, 0x1b, 0xab, 0xb2, 0x6a, 0x54, 0xb2, 0x58, 0xb4, 0x34, 0x73, 0xde, 0x43, 0x1a,
+	0xc8, 0x61, 0xb9, 0xb5, 0x2d, 0x35, 0x16, 0x22, 0xb3, 0x96, 0x96, 0x35, 0xcd, 0xeb, 0xd1, 0xc4,
+	0xd2, 0xc7, 0x13, 0x4b, 0xff, 0x9e, 0x58, 0xfa, 0xeb, 0xd4, 0xd2, 0xc6, 0x53, 0x4b, 0xfb, 0x9c,
+	0x5a, 0xda, 0x63, 0x25, 0x7e, 0x02, 0x5f, 0x62, 0x67, 0x90, 0x0f, 0x43, 0xc8, 0x9e, 0x72, 0xf2,
+	0xf0, 0x5d, 0xfd, 0x06, 0x00, 0x00, 0xff, 0xff, 0x44, 0x35, 0xbe, 0xbe, 0xf3, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +167,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AcademicTermList) > 0 {
+		for iNdEx := len(m.AcademicTermList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcademicTermList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AccreditationProposalList) > 0 {
+		for iNdEx := len(m.AccreditationProposalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccreditationProposalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SigningKeyList) > 0 {
+		for iNdEx := len(m.SigningKeyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningKeyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GradingScaleList) > 0 {
+		for iNdEx := len(m.GradingScaleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GradingScaleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RoleAssignmentList) > 0 {
+		for iNdEx := len(m.RoleAssignmentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleAssignmentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +269,36 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RoleAssignmentList) > 0 {
+		for _, e := range m.RoleAssignmentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GradingScaleList) > 0 {
+		for _, e := range m.GradingScaleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SigningKeyList) > 0 {
+		for _, e := range m.SigningKeyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccreditationProposalList) > 0 {
+		for _, e := range m.AccreditationProposalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AcademicTermList) > 0 {
+		for _, e := range m.AcademicTermList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +370,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleAssignmentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleAssignmentList = append(m.RoleAssignmentList, RoleAssignment{})
+			if err := m.RoleAssignmentList[len(m.RoleAssignmentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradingScaleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GradingScaleList = append(m.GradingScaleList, GradingScale{})
+			if err := m.GradingScaleList[len(m.GradingScaleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyList = append(m.SigningKeyList, SigningKey{})
+			if err := m.SigningKeyList[len(m.SigningKeyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationProposalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccreditationProposalList = append(m.AccreditationProposalList, AccreditationProposal{})
+			if err := m.AccreditationProposalList[len(m.AccreditationProposalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcademicTermList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcademicTermList = append(m.AcademicTermList, AcademicTerm{})
+			if err := m.AcademicTermList[len(m.AcademicTermList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,12 +3,25 @@ package types_test
 import (
 	"testing"
 
+	"academictoken/testutil/sample"
 	"academictoken/x/institution/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	admin := sample.AccAddress()
+	professor := sample.AccAddress()
+	signingKey := types.SigningKey{
+		Institution:  "institution-1",
+		KeyId:        "key-1",
+		Owner:        professor,
+		Algorithm:    types.SigningAlgorithmSecp256k1,
+		PubKey:       secp256k1.GenPrivKey().PubKey().Bytes(),
+		RegisteredAt: "2025-01-01T00:00:00Z",
+	}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,13 +33,92 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				RoleAssignmentList: []types.RoleAssignment{
+					{Institution: "institution-1", Address: admin, Role: types.RoleInstitutionAdmin},
+					{Institution: "institution-1", Address: professor, Role: types.RoleProfessor, SubjectId: "subject-1"},
+					{Institution: "institution-1", Address: professor, Role: types.RoleProfessor, SubjectId: "subject-2"},
+				},
+				GradingScaleList: []types.GradingScale{
+					types.DefaultGradingScale("institution-1"),
+					{Institution: "institution-2", ScaleType: types.ScaleTypePassFail, MaxGpa: types.DefaultMaxGpa},
+				},
+				SigningKeyList: []types.SigningKey{signingKey},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated signing key",
+			genState: &types.GenesisState{
+				SigningKeyList: []types.SigningKey{signingKey, signingKey},
+			},
+			valid: false,
+		},
+		{
+			desc: "signing key without a public key",
+			genState: &types.GenesisState{
+				SigningKeyList: []types.SigningKey{{Institution: "institution-1", KeyId: "key-1", Owner: professor, Algorithm: types.SigningAlgorithmEd25519, RegisteredAt: "2025-01-01T00:00:00Z"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated role assignment",
+			genState: &types.GenesisState{
+				RoleAssignmentList: []types.RoleAssignment{
+					{Institution: "institution-1", Address: professor, Role: types.RoleProfessor, SubjectId: "subject-1"},
+					{Institution: "institution-1", Address: professor, Role: types.RoleProfessor, SubjectId: "subject-1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown role",
+			genState: &types.GenesisState{
+				RoleAssignmentList: []types.RoleAssignment{
+					{Institution: "institution-1", Address: admin, Role: "dean"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "scoped institution admin",
+			genState: &types.GenesisState{
+				RoleAssignmentList: []types.RoleAssignment{
+					{Institution: "institution-1", Address: admin, Role: types.RoleInstitutionAdmin, CourseId: "course-1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid role holder address",
+			genState: &types.GenesisState{
+				RoleAssignmentList: []types.RoleAssignment{
+					{Institution: "institution-1", Address: "invalid", Role: types.RoleRegistrar},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated grading scale",
+			genState: &types.GenesisState{
+				GradingScaleList: []types.GradingScale{
+					types.DefaultGradingScale("institution-1"),
+					types.DefaultGradingScale("institution-1"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid grading scale",
+			genState: &types.GenesisState{
+				GradingScaleList: []types.GradingScale{
+					{Institution: "institution-1", ScaleType: types.ScaleTypeNumeric, MinGrade: "10", MaxGrade: "0", PassingGrade: "5", MaxGpa: types.DefaultMaxGpa},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,269 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// Grading scale types
+const (
+	ScaleTypeNumeric  = "numeric"
+	ScaleTypeLetter   = "letter"
+	ScaleTypePassFail = "pass_fail"
+)
+
+// Pass/fail grades
+const (
+	GradePass = "PASS"
+	GradeFail = "FAIL"
+)
+
+// DefaultMaxGpa is the GPA upper bound used when a scale does not define one
+const DefaultMaxGpa = "4.0"
+
+// NormalizedGrade is a grade expressed on the common 0-100 scale together with
+// the values derived from the institution grading scale
+type NormalizedGrade struct {
+	// Value is the grade as given, trimmed and with letters upper-cased
+	Value string
+	// Score is the grade mapped onto 0-100
+	Score math.LegacyDec
+	// Letter is the letter earned, when the scale defines letters
+	Letter string
+	// GpaPoints are the grade points earned on the scale's GPA range
+	GpaPoints math.LegacyDec
+	// Passing reports whether the grade reaches the passing threshold
+	Passing bool
+	// CountsTowardGpa is false for grades, such as pass/fail, that carry no points
+	CountsTowardGpa bool
+}
+
+// DefaultGradingScale returns the 0-100 numeric scale with a passing grade of
+// 60 that applies to institutions without a configured scale
+func DefaultGradingScale(institution string) GradingScale {
+	return GradingScale{
+		Institution:  institution,
+		ScaleType:    ScaleTypeNumeric,
+		MinGrade:     "0",
+		MaxGrade:     "100",
+		PassingGrade: "60",
+		MaxGpa:       DefaultMaxGpa,
+	}
+}
+
+// IsValidScaleType checks if a scale type is supported
+func IsValidScaleType(scaleType string) bool {
+	switch scaleType {
+	case ScaleTypeNumeric, ScaleTypeLetter, ScaleTypePassFail:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate checks that the scale is internally consistent
+func (s GradingScale) Validate() error {
+	if s.Institution == "" {
+		return errorsmod.Wrap(ErrInvalidGradingScale, "institution cannot be empty")
+	}
+	if !IsValidScaleType(s.ScaleType) {
+		return errorsmod.Wrapf(ErrInvalidGradingScale, "unknown scale type %q", s.ScaleType)
+	}
+
+	maxGpa, err := parseDec("maxGpa", s.MaxGpa)
+	if err != nil {
+		return err
+	}
+	if !maxGpa.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidGradingScale, "maxGpa must be positive")
+	}
+
+	if s.ScaleType == ScaleTypePassFail {
+		if len(s.LetterGrades) > 0 {
+			return errorsmod.Wrap(ErrInvalidGradingScale, "pass/fail scales cannot define letter grades")
+		}
+		return nil
+	}
+
+	minGrade, maxGrade, passingGrade, err := s.bounds()
+	if err != nil {
+		return err
+	}
+	if !minGrade.LT(maxGrade) {
+		return errorsmod.Wrapf(ErrInvalidGradingScale, "minGrade %s must be lower than maxGrade %s", s.MinGrade, s.MaxGrade)
+	}
+	if passingGrade.LT(minGrade) || passingGrade.GT(maxGrade) {
+		return errorsmod.Wrapf(ErrInvalidGradingScale, "passingGrade %s must be within %s-%s", s.PassingGrade, s.MinGrade, s.MaxGrade)
+	}
+
+	if s.ScaleType == ScaleTypeLetter && len(s.LetterGrades) == 0 {
+		return errorsmod.Wrap(ErrInvalidGradingScale, "letter scales must define at least one letter grade")
+	}
+
+	letters := make(map[string]struct{}, len(s.LetterGrades))
+	for _, lg := range s.LetterGrades {
+		letter := strings.ToUpper(strings.TrimSpace(lg.Letter))
+		if letter == "" {
+			return errorsmod.Wrap(ErrInvalidGradingScale, "letter cannot be empty")
+		}
+		if _, ok := letters[letter]; ok {
+			return errorsmod.Wrapf(ErrInvalidGradingScale, "duplicated letter %s", letter)
+		}
+		letters[letter] = struct{}{}
+
+		value, err := parseDec("letter "+letter+" minValue", lg.MinValue)
+		if err != nil {
+			return err
+		}
+		if value.LT(minGrade) || value.GT(maxGrade) {
+			return errorsmod.Wrapf(ErrInvalidGradingScale, "letter %s value %s must be within %s-%s", letter, lg.MinValue, s.MinGrade, s.MaxGrade)
+		}
+		points, err := parseDec("letter "+letter+" gpaPoints", lg.GpaPoints)
+		if err != nil {
+			return err
+		}
+		if points.IsNegative() || points.GT(maxGpa) {
+			return errorsmod.Wrapf(ErrInvalidGradingScale, "letter %s gpaPoints %s must be within 0-%s", letter, lg.GpaPoints, s.MaxGpa)
+		}
+	}
+
+	return nil
+}
+
+// Normalize validates a grade against the scale and maps it onto the common
+// 0-100 score and the scale's GPA points
+func (s GradingScale) Normalize(grade string) (NormalizedGrade, error) {
+	value := strings.TrimSpace(grade)
+	if value == "" {
+		return NormalizedGrade{}, errorsmod.Wrap(ErrInvalidGrade, "grade cannot be empty")
+	}
+
+	switch s.ScaleType {
+	case ScaleTypePassFail:
+		return s.normalizePassFail(value)
+	case ScaleTypeLetter:
+		return s.normalizeLetter(value)
+	case ScaleTypeNumeric:
+		return s.normalizeNumeric(value)
+	default:
+		return NormalizedGrade{}, errorsmod.Wrapf(ErrInvalidGradingScale, "unknown scale type %q", s.ScaleType)
+	}
+}
+
+func (s GradingScale) normalizePassFail(value string) (NormalizedGrade, error) {
+	switch strings.ToUpper(value) {
+	case GradePass:
+		return NormalizedGrade{Value: GradePass, Score: math.LegacyNewDec(100), GpaPoints: math.LegacyZeroDec(), Passing: true}, nil
+	case GradeFail:
+		return NormalizedGrade{Value: GradeFail, Score: math.LegacyZeroDec(), GpaPoints: math.LegacyZeroDec()}, nil
+	default:
+		return NormalizedGrade{}, errorsmod.Wrapf(ErrInvalidGrade, "grade %q must be %s or %s", value, GradePass, GradeFail)
+	}
+}
+
+func (s GradingScale) normalizeLetter(value string) (NormalizedGrade, error) {
+	letter := strings.ToUpper(value)
+	for _, lg := range s.LetterGrades {
+		if strings.ToUpper(strings.TrimSpace(lg.Letter)) != letter {
+			continue
+		}
+		numeric, err := parseDec("letter "+letter+" minValue", lg.MinValue)
+		if err != nil {
+			return NormalizedGrade{}, err
+		}
+		points, err := parseDec("letter "+letter+" gpaPoints", lg.GpaPoints)
+		if err != nil {
+			return NormalizedGrade{}, err
+		}
+		normalized, err := s.scoreOf(numeric)
+		if err != nil {
+			return NormalizedGrade{}, err
+		}
+		normalized.Value = letter
+		normalized.Letter = letter
+		normalized.GpaPoints = points
+		return normalized, nil
+	}
+	return NormalizedGrade{}, errorsmod.Wrapf(ErrInvalidGrade, "letter %q is not part of the grading scale of %s", value, s.Institution)
+}
+
+func (s GradingScale) normalizeNumeric(value string) (NormalizedGrade, error) {
+	numeric, err := math.LegacyNewDecFromStr(value)
+	if err != nil {
+		return NormalizedGrade{}, errorsmod.Wrapf(ErrInvalidGrade, "grade %q is not a number", value)
+	}
+	normalized, err := s.scoreOf(numeric)
+	if err != nil {
+		return NormalizedGrade{}, err
+	}
+	normalized.Value = value
+
+	// Without letters, GPA points are proportional to the score
+	maxGpa, err := parseDec("maxGpa", s.MaxGpa)
+	if err != nil {
+		return NormalizedGrade{}, err
+	}
+	normalized.GpaPoints = normalized.Score.Mul(maxGpa).QuoInt64(100)
+
+	// Otherwise the highest letter reached determines the points
+	best := math.LegacyDec{}
+	for _, lg := range s.LetterGrades {
+		threshold, err := parseDec("letter "+lg.Letter+" minValue", lg.MinValue)
+		if err != nil {
+			return NormalizedGrade{}, err
+		}
+		if numeric.LT(threshold) || (!best.IsNil() && threshold.LTE(best)) {
+			continue
+		}
+		points, err := parseDec("letter "+lg.Letter+" gpaPoints", lg.GpaPoints)
+		if err != nil {
+			return NormalizedGrade{}, err
+		}
+		best = threshold
+		normalized.Letter = strings.ToUpper(strings.TrimSpace(lg.Letter))
+		normalized.GpaPoints = points
+	}
+	if len(s.LetterGrades) > 0 && best.IsNil() {
+		normalized.GpaPoints = math.LegacyZeroDec()
+	}
+
+	return normalized, nil
+}
+
+// scoreOf checks a numeric grade against the scale bounds and maps it onto 0-100
+func (s GradingScale) scoreOf(numeric math.LegacyDec) (NormalizedGrade, error) {
+	minGrade, maxGrade, passingGrade, err := s.bounds()
+	if err != nil {
+		return NormalizedGrade{}, err
+	}
+	if numeric.LT(minGrade) || numeric.GT(maxGrade) {
+		return NormalizedGrade{}, errorsmod.Wrapf(ErrInvalidGrade, "grade %s must be within %s-%s", numeric, s.MinGrade, s.MaxGrade)
+	}
+
+	return NormalizedGrade{
+		Score:           numeric.Sub(minGrade).MulInt64(100).Quo(maxGrade.Sub(minGrade)),
+		Passing:         numeric.GTE(passingGrade),
+		CountsTowardGpa: true,
+	}, nil
+}
+
+func (s GradingScale) bounds() (minGrade, maxGrade, passingGrade math.LegacyDec, err error) {
+	if minGrade, err = parseDec("minGrade", s.MinGrade); err != nil {
+		return
+	}
+	if maxGrade, err = parseDec("maxGrade", s.MaxGrade); err != nil {
+		return
+	}
+	passingGrade, err = parseDec("passingGrade", s.PassingGrade)
+	return
+}
+
+func parseDec(field string, value string) (math.LegacyDec, error) {
+	dec, err := math.LegacyNewDecFromStr(strings.TrimSpace(value))
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(ErrInvalidGradingScale, "invalid %s %q", field, value)
+	}
+	return dec, nil
+}
@@ -0,0 +1,973 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: academictoken/institution/grading_scale.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LetterGrade maps a letter to a value of the grading scale and to GPA points.
+// On numeric scales minValue is the lowest grade that earns the letter; on
+// letter scales it is the value the letter stands for.
+type LetterGrade struct {
+	Letter    string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	MinValue  string `protobuf:"bytes,2,opt,name=minValue,proto3" json:"minValue,omitempty"`
+	GpaPoints string `protobuf:"bytes,3,opt,name=gpaPoints,proto3" json:"gpaPoints,omitempty"`
+}
+
+func (m *LetterGrade) Reset()         { *m = LetterGrade{} }
+func (m *LetterGrade) String() string { return proto.CompactTextString(m) }
+func (*LetterGrade) ProtoMessage()    {}
+func (*LetterGrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43ebfcc684d2cad2, []int{0}
+}
+func (m *LetterGrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LetterGrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LetterGrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LetterGrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LetterGrade.Merge(m, src)
+}
+func (m *LetterGrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *LetterGrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_LetterGrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LetterGrade proto.InternalMessageInfo
+
+func (m *LetterGrade) GetLetter() string {
+	if m != nil {
+		return m.Letter
+	}
+	return ""
+}
+
+func (m *LetterGrade) GetMinValue() string {
+	if m != nil {
+		return m.MinValue
+	}
+	return ""
+}
+
+func (m *LetterGrade) GetGpaPoints() string {
+	if m != nil {
+		return m.GpaPoints
+	}
+	return ""
+}
+
+// GradingScale describes how an institution expresses grades. Decimal values
+// are stored as strings.
+type GradingScale struct {
+	Institution  string        `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	ScaleType    string        `protobuf:"bytes,2,opt,name=scaleType,proto3" json:"scaleType,omitempty"`
+	MinGrade     string        `protobuf:"bytes,3,opt,name=minGrade,proto3" json:"minGrade,omitempty"`
+	MaxGrade     string        `protobuf:"bytes,4,opt,name=maxGrade,proto3" json:"maxGrade,omitempty"`
+	PassingGrade string        `protobuf:"bytes,5,opt,name=passingGrade,proto3" json:"passingGrade,omitempty"`
+	LetterGrades []LetterGrade `protobuf:"bytes,6,rep,name=letterGrades,proto3" json:"letterGrades"`
+	MaxGpa       string        `protobuf:"bytes,7,opt,name=maxGpa,proto3" json:"maxGpa,omitempty"`
+	UpdatedBy    string        `protobuf:"bytes,8,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+}
+
+func (m *GradingScale) Reset()         { *m = GradingScale{} }
+func (m *GradingScale) String() string { return proto.CompactTextString(m) }
+func (*GradingScale) ProtoMessage()    {}
+func (*GradingScale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43ebfcc684d2cad2, []int{1}
+}
+func (m *GradingScale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GradingScale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GradingScale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GradingScale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradingScale.Merge(m, src)
+}
+func (m *GradingScale) XXX_Size() int {
+	return m.Size()
+}
+func (m *GradingScale) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradingScale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradingScale proto.InternalMessageInfo
+
+func (m *GradingScale) GetInstitution() string {
+	if m != nil {
+		return m.Institution
+	}
+	return ""
+}
+
+func (m *GradingScale) GetScaleType() string {
+	if m != nil {
+		return m.ScaleType
+	}
+	return ""
+}
+
+func (m *GradingScale) GetMinGrade() string {
+	if m != nil {
+		return m.MinGrade
+	}
+	return ""
+}
+
+func (m *GradingScale) GetMaxGrade() string {
+	if m != nil {
+		return m.MaxGrade
+	}
+	return ""
+}
+
+func (m *GradingScale) GetPassingGrade() string {
+	if m != nil {
+		return m.PassingGrade
+	}
+	return ""
+}
+
+func (m *GradingScale) GetLetterGrades() []LetterGrade {
+	if m != nil {
+		return m.LetterGrades
+	}
+	return nil
+}
+
+func (m *GradingScale) GetMaxGpa() string {
+	if m != nil {
+		return m.MaxGpa
+	}
+	return ""
+}
+
+func (m *GradingScale) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LetterGrade)(nil), "academictoken.institution.LetterGrade")
+	proto.RegisterType((*GradingScale)(nil), "academictoken.institution.GradingScale")
+}
+
+func init() {
+	proto.RegisterFile("academictoken/institution/grading_scale.proto", fileDescriptor_43ebfcc684d2cad2)
+}
+
+var fileDescriptor_43ebfcc684d2cad2 = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xbf, 0x4f, 0x02, 0x31,
+	0x14, 0xbe, 0x03, 0x44, 0x28, 0x4c, 0x8d, 0x31, 0x95, 0x98, 0x8a, 0x0c, 0x86, 0xc5, 0x23, 0xd1,
+	0xd1, 0x8d, 0x85, 0xc5, 0x81, 0xa0, 0x71, 0x70, 0x21, 0x95, 0x6b, 0x9a, 0xc6, 0xa3, 0x6d, 0xae,
+	0x25, 0x81, 0xff, 0xc2, 0xbf, 0xc5, 0xbf, 0x82, 0x91, 0xd1, 0xc9, 0x18, 0xf8, 0x47, 0x4c, 0x7f,
+	0xc8, 0xdd, 0x0d, 0x6e, 0xf7, 0x7d, 0xdf, 0xbb, 0xf7, 0xbe, 0xf7, 0xf5, 0x81, 0x5b, 0xb2, 0x20,
+	0x29, 0x5d, 0xf2, 0x85, 0x91, 0xef, 0x54, 0x8c, 0xb8, 0xd0, 0x86, 0x9b, 0x95, 0xe1, 0x52, 0x8c,
+	0x58, 0x4e, 0x52, 0x2e, 0xd8, 0x5c, 0x2f, 0x48, 0x46, 0x13, 0x95, 0x4b, 0x23, 0xe1, 0x45, 0xa5,
+	0x3c, 0x29, 0x95, 0xf7, 0xce, 0x98, 0x64, 0xd2, 0x55, 0x8d, 0xec, 0x97, 0xff, 0x61, 0x30, 0x07,
+	0x9d, 0x47, 0x6a, 0x0c, 0xcd, 0x27, 0x39, 0x49, 0x29, 0x3c, 0x07, 0xcd, 0xcc, 0x41, 0x14, 0xf7,
+	0xe3, 0x61, 0x7b, 0x16, 0x10, 0xec, 0x81, 0xd6, 0x92, 0x8b, 0x17, 0x92, 0xad, 0x28, 0xaa, 0x39,
+	0xe5, 0x88, 0xe1, 0x25, 0x68, 0x33, 0x45, 0xa6, 0x92, 0x0b, 0xa3, 0x51, 0xdd, 0x89, 0x05, 0x31,
+	0xf8, 0xac, 0x81, 0xee, 0xc4, 0x3b, 0x7d, 0xb2, 0x46, 0x61, 0x1f, 0x74, 0x4a, 0xb6, 0xc2, 0x9c,
+	0x32, 0x65, 0x1b, 0xba, 0x9d, 0x9e, 0x37, 0xea, 0x6f, 0x5a, 0x41, 0x04, 0x2b, 0xce, 0x6e, 0x98,
+	0x76, 0xc4, 0x4e, 0x23, 0x6b, 0xaf, 0x35, 0x82, 0x16, 0x30, 0x1c, 0x80, 0xae, 0x22, 0x5a, 0x73,
+	0xc1, 0xbc, 0x7e, 0xe2, 0xf4, 0x0a, 0x07, 0xa7, 0xa0, 0x9b, 0x15, 0x69, 0x68, 0xd4, 0xec, 0xd7,
+	0x87, 0x9d, 0xbb, 0x9b, 0xe4, 0xdf, 0x54, 0x93, 0x52, 0x78, 0xe3, 0xc6, 0xf6, 0xfb, 0x2a, 0x9a,
+	0x55, 0x3a, 0xd8, 0x40, 0xad, 0x03, 0x45, 0xd0, 0xa9, 0x0f, 0xd4, 0x23, 0xbb, 0xe3, 0x4a, 0xa5,
+	0xc4, 0xd0, 0x74, 0xbc, 0x41, 0x2d, 0xbf, 0xe3, 0x91, 0x18, 0x3f, 0x6c, 0xf7, 0x38, 0xde, 0xed,
+	0x71, 0xfc, 0xb3, 0xc7, 0xf1, 0xc7, 0x01, 0x47, 0xbb, 0x03, 0x8e, 0xbe, 0x0e, 0x38, 0x7a, 0xbd,
+	0xae, 0xde, 0xc3, 0xba, 0x72, 0x11, 0x66, 0xa3, 0xa8, 0x7e, 0x6b, 0xba, 0x97, 0xbd, 0xff, 0x0d,
+	0x00, 0x00, 0xff, 0xff, 0x02, 0x1b, 0x01, 0x76, 0x3b, 0x02, 0x00, 0x00,
+}
+
+func (m *LetterGrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LetterGrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LetterGrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GpaPoints) > 0 {
+		i -= len(m.GpaPoints)
+		copy(dAtA[i:], m.GpaPoints)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.GpaPoints)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinValue) > 0 {
+		i -= len(m.MinValue)
+		copy(dAtA[i:], m.MinValue)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.MinValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Letter) > 0 {
+		i -= len(m.Letter)
+		copy(dAtA[i:], m.Letter)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.Letter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GradingScale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GradingScale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GradingScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MaxGpa) > 0 {
+		i -= len(m.MaxGpa)
+		copy(dAtA[i:], m.MaxGpa)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.MaxGpa)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LetterGrades) > 0 {
+		for iNdEx := len(m.LetterGrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LetterGrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGradingScale(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PassingGrade) > 0 {
+		i -= len(m.PassingGrade)
+		copy(dAtA[i:], m.PassingGrade)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.PassingGrade)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MaxGrade) > 0 {
+		i -= len(m.MaxGrade)
+		copy(dAtA[i:], m.MaxGrade)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.MaxGrade)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinGrade) > 0 {
+		i -= len(m.MinGrade)
+		copy(dAtA[i:], m.MinGrade)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.MinGrade)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScaleType) > 0 {
+		i -= len(m.ScaleType)
+		copy(dAtA[i:], m.ScaleType)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.ScaleType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintGradingScale(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGradingScale(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGradingScale(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LetterGrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Letter)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	l = len(m.MinValue)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	l = len(m.GpaPoints)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	return n
+}
+
+func (m *GradingScale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	l = len(m.ScaleType)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	l = len(m.MinGrade)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	l = len(m.MaxGrade)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	l = len(m.PassingGrade)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	if len(m.LetterGrades) > 0 {
+		for _, e := range m.LetterGrades {
+			l = e.Size()
+			n += 1 + l + sovGradingScale(uint64(l))
+		}
+	}
+	l = len(m.MaxGpa)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovGradingScale(uint64(l))
+	}
+	return n
+}
+
+func sovGradingScale(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGradingScale(x uint64) (n int) {
+	return sovGradingScale(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LetterGrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGradingScale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LetterGrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LetterGrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Letter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Letter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpaPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GpaPoints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGradingScale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GradingScale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGradingScale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GradingScale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GradingScale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScaleType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassingGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassingGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LetterGrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LetterGrades = append(m.LetterGrades, LetterGrade{})
+			if err := m.LetterGrades[len(m.LetterGrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGpa", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGpa = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGradingScale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGradingScale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGradingScale(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGradingScale
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGradingScale
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGradingScale
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGradingScale
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGradingScale
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGradingScale        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGradingScale          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGradingScale = fmt.Errorf("proto: unexpected end of group")
+)
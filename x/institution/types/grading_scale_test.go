@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/x/institution/types"
+)
+
+func TestGradingScale_Validate(t *testing.T) {
+	letters := []types.LetterGrade{
+		{Letter: "A", MinValue: "90", GpaPoints: "4.0"},
+		{Letter: "B", MinValue: "80", GpaPoints: "3.0"},
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		scale types.GradingScale
+		valid bool
+	}{
+		{desc: "default", scale: types.DefaultGradingScale("inst"), valid: true},
+		{desc: "zero to ten", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypeNumeric, MinGrade: "0", MaxGrade: "10", PassingGrade: "5", MaxGpa: "4"}, valid: true},
+		{desc: "numeric with letters", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypeNumeric, MinGrade: "0", MaxGrade: "100", PassingGrade: "60", LetterGrades: letters, MaxGpa: "4"}, valid: true},
+		{desc: "pass fail", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypePassFail, MaxGpa: "4"}, valid: true},
+		{desc: "missing institution", scale: types.GradingScale{ScaleType: types.ScaleTypePassFail, MaxGpa: "4"}},
+		{desc: "unknown type", scale: types.GradingScale{Institution: "inst", ScaleType: "stars", MaxGpa: "4"}},
+		{desc: "inverted bounds", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypeNumeric, MinGrade: "10", MaxGrade: "0", PassingGrade: "5", MaxGpa: "4"}},
+		{desc: "passing out of range", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypeNumeric, MinGrade: "0", MaxGrade: "10", PassingGrade: "11", MaxGpa: "4"}},
+		{desc: "letter scale without letters", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypeLetter, MinGrade: "0", MaxGrade: "4", PassingGrade: "1", MaxGpa: "4"}},
+		{desc: "duplicated letter", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypeLetter, MinGrade: "0", MaxGrade: "100", PassingGrade: "60", LetterGrades: append(letters, types.LetterGrade{Letter: "a", MinValue: "95", GpaPoints: "4"}), MaxGpa: "4"}},
+		{desc: "gpa points above max", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypeLetter, MinGrade: "0", MaxGrade: "100", PassingGrade: "60", LetterGrades: []types.LetterGrade{{Letter: "A", MinValue: "90", GpaPoints: "5"}}, MaxGpa: "4"}},
+		{desc: "invalid max gpa", scale: types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypePassFail, MaxGpa: "abc"}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.scale.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidGradingScale)
+			}
+		})
+	}
+}
+
+func TestGradingScale_Normalize(t *testing.T) {
+	zeroToTen := types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypeNumeric, MinGrade: "0", MaxGrade: "10", PassingGrade: "5", MaxGpa: "4"}
+	letter := types.GradingScale{
+		Institution:  "inst",
+		ScaleType:    types.ScaleTypeLetter,
+		MinGrade:     "0",
+		MaxGrade:     "4",
+		PassingGrade: "1",
+		MaxGpa:       "4",
+		LetterGrades: []types.LetterGrade{
+			{Letter: "A", MinValue: "4", GpaPoints: "4"},
+			{Letter: "B", MinValue: "3", GpaPoints: "3"},
+			{Letter: "F", MinValue: "0", GpaPoints: "0"},
+		},
+	}
+	numericWithLetters := types.DefaultGradingScale("inst")
+	numericWithLetters.LetterGrades = []types.LetterGrade{
+		{Letter: "A", MinValue: "90", GpaPoints: "4.0"},
+		{Letter: "B", MinValue: "80", GpaPoints: "3.0"},
+		{Letter: "C", MinValue: "60", GpaPoints: "2.0"},
+	}
+	passFail := types.GradingScale{Institution: "inst", ScaleType: types.ScaleTypePassFail, MaxGpa: "4"}
+
+	for _, tc := range []struct {
+		desc     string
+		scale    types.GradingScale
+		grade    string
+		score    string
+		points   string
+		letter   string
+		passing  bool
+		countGpa bool
+		err      bool
+	}{
+		{desc: "default scale", scale: types.DefaultGradingScale("inst"), grade: "85", score: "85", points: "3.4", passing: true, countGpa: true},
+		{desc: "default failing", scale: types.DefaultGradingScale("inst"), grade: "59.5", score: "59.5", points: "2.38", countGpa: true},
+		{desc: "default out of range", scale: types.DefaultGradingScale("inst"), grade: "101", err: true},
+		{desc: "not a number", scale: types.DefaultGradingScale("inst"), grade: "B", err: true},
+		{desc: "zero to ten", scale: zeroToTen, grade: "7.5", score: "75", points: "3", passing: true, countGpa: true},
+		{desc: "zero to ten failing", scale: zeroToTen, grade: "4.9", score: "49", points: "1.96", countGpa: true},
+		{desc: "letter", scale: letter, grade: "b", score: "75", points: "3", letter: "B", passing: true, countGpa: true},
+		{desc: "letter failing", scale: letter, grade: "F", score: "0", points: "0", letter: "F", countGpa: true},
+		{desc: "unknown letter", scale: letter, grade: "E", err: true},
+		{desc: "numeric with letters", scale: numericWithLetters, grade: "85", score: "85", points: "3", letter: "B", passing: true, countGpa: true},
+		{desc: "numeric below every letter", scale: numericWithLetters, grade: "40", score: "40", points: "0", countGpa: true},
+		{desc: "pass", scale: passFail, grade: "pass", score: "100", points: "0", passing: true},
+		{desc: "fail", scale: passFail, grade: "FAIL", score: "0", points: "0"},
+		{desc: "invalid pass fail", scale: passFail, grade: "maybe", err: true},
+		{desc: "empty", scale: passFail, grade: " ", err: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := tc.scale.Normalize(tc.grade)
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrInvalidGrade)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, math.LegacyMustNewDecFromStr(tc.score).Equal(got.Score), "score %s", got.Score)
+			require.True(t, math.LegacyMustNewDecFromStr(tc.points).Equal(got.GpaPoints), "points %s", got.GpaPoints)
+			require.Equal(t, tc.letter, got.Letter)
+			require.Equal(t, tc.passing, got.Passing)
+			require.Equal(t, tc.countGpa, got.CountsTowardGpa)
+		})
+	}
+}
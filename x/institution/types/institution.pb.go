@@ -28,6 +28,12 @@ type Institution struct {
 	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	IsAuthorized string `protobuf:"bytes,4,opt,name=isAuthorized,proto3" json:"isAuthorized,omitempty"`
 	Creator      string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Accreditation granted through governance or an accreditation body.
+	// Institutions stop being authorized once accreditedUntil has passed.
+	AccreditedUntil       string   `protobuf:"bytes,6,opt,name=accreditedUntil,proto3" json:"accreditedUntil,omitempty"`
+	AccreditedAt          string   `protobuf:"bytes,7,opt,name=accreditedAt,proto3" json:"accreditedAt,omitempty"`
+	AccreditedBy          string   `protobuf:"bytes,8,opt,name=accreditedBy,proto3" json:"accreditedBy,omitempty"`
+	AccreditationEvidence []string `protobuf:"bytes,9,rep,name=accreditationEvidence,proto3" json:"accreditationEvidence,omitempty"`
 }
 
 func (m *Institution) Reset()         { *m = Institution{} }
@@ -98,6 +104,34 @@ func (m *Institution) GetCreator() string {
 	return ""
 }
 
+func (m *Institution) GetAccreditedUntil() string {
+	if m != nil {
+		return m.AccreditedUntil
+	}
+	return ""
+}
+
+func (m *Institution) GetAccreditedAt() string {
+	if m != nil {
+		return m.AccreditedAt
+	}
+	return ""
+}
+
+func (m *Institution) GetAccreditedBy() string {
+	if m != nil {
+		return m.AccreditedBy
+	}
+	return ""
+}
+
+func (m *Institution) GetAccreditationEvidence() []string {
+	if m != nil {
+		return m.AccreditationEvidence
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Institution)(nil), "academictoken.institution.Institution")
 }
@@ -107,20 +141,25 @@ func init() {
 }
 
 var fileDescriptor_884e339a5dd28a25 = []byte{
-	// 203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0x4c, 0x4e, 0x4c,
-	0x49, 0xcd, 0xcd, 0x4c, 0x2e, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0xcf, 0xcc, 0x2b, 0x2e, 0xc9, 0x2c,
-	0x29, 0x2d, 0xc9, 0xcc, 0x47, 0x61, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x49, 0xa2, 0x28,
-	0xd6, 0x43, 0x52, 0xa0, 0xd4, 0xcf, 0xc8, 0xc5, 0xed, 0x89, 0xe0, 0x0b, 0x89, 0x70, 0xb1, 0x66,
-	0xe6, 0xa5, 0xa4, 0x56, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x41, 0x38, 0x42, 0x12, 0x5c,
-	0xec, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0x12, 0x4c, 0x60, 0x71, 0x18, 0x57, 0x48, 0x88,
-	0x8b, 0x25, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x19, 0x2c, 0x0c, 0x66, 0x0b, 0x29, 0x71, 0xf1, 0x64,
-	0x16, 0x3b, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x56, 0xa5, 0xa6, 0x48, 0xb0, 0x80, 0xe5, 0x50,
-	0xc4, 0x40, 0x26, 0x26, 0x17, 0xa5, 0x26, 0x96, 0xe4, 0x17, 0x49, 0xb0, 0x42, 0x4c, 0x84, 0x72,
-	0x9d, 0xac, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x11, 0xd5, 0xcf,
-	0x15, 0x28, 0xbe, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xd8, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0x1d, 0xeb, 0x40, 0x58, 0x1f, 0x01, 0x00, 0x00,
+	// 275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0x03, 0x31,
+	0x10, 0xc6, 0x77, 0xfb, 0xd7, 0x8d, 0x82, 0x10, 0x14, 0xe2, 0x25, 0xd4, 0x9e, 0x16, 0x84, 0x7a,
+	0xd0, 0x9b, 0xa7, 0x2e, 0x78, 0xf0, 0x5a, 0xf0, 0xe2, 0x2d, 0x26, 0x03, 0x06, 0x6d, 0x52, 0x92,
+	0xa9, 0x74, 0x7d, 0x0a, 0xdf, 0xc6, 0x57, 0xf0, 0xd8, 0xa3, 0x47, 0xd9, 0x7d, 0x11, 0xd9, 0xac,
+	0xa5, 0x4d, 0xe9, 0x2d, 0xdf, 0x6f, 0x7e, 0xcc, 0x40, 0x3e, 0x72, 0x25, 0xa4, 0x50, 0x30, 0xd7,
+	0x12, 0xed, 0x2b, 0x98, 0x6b, 0x6d, 0x3c, 0x6a, 0x5c, 0xa2, 0xb6, 0xd1, 0x7b, 0xb2, 0x70, 0x16,
+	0x2d, 0xbd, 0x88, 0xe4, 0xc9, 0x8e, 0x30, 0xfe, 0xea, 0x90, 0xe3, 0x87, 0x6d, 0xa6, 0x67, 0xa4,
+	0xaf, 0x8d, 0x82, 0x15, 0x4b, 0x47, 0x69, 0x9e, 0xcd, 0xda, 0x40, 0x19, 0x19, 0x0a, 0xa5, 0x1c,
+	0x78, 0xcf, 0x3a, 0x81, 0x6f, 0x22, 0xa5, 0xa4, 0x67, 0xc4, 0x1c, 0x58, 0x37, 0xe0, 0xf0, 0xa6,
+	0x63, 0x72, 0xa2, 0xfd, 0x74, 0x89, 0x2f, 0xd6, 0xe9, 0x0f, 0x50, 0xac, 0x17, 0x66, 0x11, 0x6b,
+	0x36, 0x4a, 0x07, 0x02, 0xad, 0x63, 0xfd, 0x76, 0xe3, 0x7f, 0xa4, 0x39, 0x39, 0x15, 0x52, 0x3a,
+	0x50, 0x1a, 0x41, 0x3d, 0x1a, 0xd4, 0x6f, 0x6c, 0x10, 0x8c, 0x7d, 0xdc, 0xdc, 0xd9, 0xa2, 0x29,
+	0xb2, 0x61, 0x7b, 0x67, 0x97, 0xc5, 0x4e, 0x51, 0xb2, 0xa3, 0x7d, 0xa7, 0x28, 0xe9, 0x2d, 0x39,
+	0xdf, 0x64, 0xd1, 0x7c, 0xc2, 0xfd, 0xbb, 0x56, 0x60, 0x24, 0xb0, 0x6c, 0xd4, 0xcd, 0xb3, 0xd9,
+	0xe1, 0x61, 0x71, 0xf7, 0x5d, 0xf1, 0x74, 0x5d, 0xf1, 0xf4, 0xb7, 0xe2, 0xe9, 0x67, 0xcd, 0x93,
+	0x75, 0xcd, 0x93, 0x9f, 0x9a, 0x27, 0x4f, 0x97, 0x71, 0x37, 0xab, 0xa8, 0x1d, 0x2c, 0x17, 0xe0,
+	0x9f, 0x07, 0xa1, 0x98, 0x9b, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x21, 0x52, 0x7f, 0xc7,
+	0x01, 0x00, 0x00,
 }
 
 func (m *Institution) Marshal() (dAtA []byte, err error) {
@@ -143,6 +182,36 @@ func (m *Institution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccreditationEvidence) > 0 {
+		for iNdEx := len(m.AccreditationEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccreditationEvidence[iNdEx])
+			copy(dAtA[i:], m.AccreditationEvidence[iNdEx])
+			i = encodeVarintInstitution(dAtA, i, uint64(len(m.AccreditationEvidence[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AccreditedBy) > 0 {
+		i -= len(m.AccreditedBy)
+		copy(dAtA[i:], m.AccreditedBy)
+		i = encodeVarintInstitution(dAtA, i, uint64(len(m.AccreditedBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AccreditedAt) > 0 {
+		i -= len(m.AccreditedAt)
+		copy(dAtA[i:], m.AccreditedAt)
+		i = encodeVarintInstitution(dAtA, i, uint64(len(m.AccreditedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AccreditedUntil) > 0 {
+		i -= len(m.AccreditedUntil)
+		copy(dAtA[i:], m.AccreditedUntil)
+		i = encodeVarintInstitution(dAtA, i, uint64(len(m.AccreditedUntil)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -218,6 +287,24 @@ func (m *Institution) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovInstitution(uint64(l))
 	}
+	l = len(m.AccreditedUntil)
+	if l > 0 {
+		n += 1 + l + sovInstitution(uint64(l))
+	}
+	l = len(m.AccreditedAt)
+	if l > 0 {
+		n += 1 + l + sovInstitution(uint64(l))
+	}
+	l = len(m.AccreditedBy)
+	if l > 0 {
+		n += 1 + l + sovInstitution(uint64(l))
+	}
+	if len(m.AccreditationEvidence) > 0 {
+		for _, s := range m.AccreditationEvidence {
+			l = len(s)
+			n += 1 + l + sovInstitution(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -416,6 +503,134 @@ func (m *Institution) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditedUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInstitution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInstitution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInstitution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccreditedUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInstitution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInstitution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInstitution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccreditedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInstitution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInstitution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInstitution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccreditedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationEvidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInstitution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInstitution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInstitution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccreditationEvidence = append(m.AccreditationEvidence, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInstitution(dAtA[iNdEx:])
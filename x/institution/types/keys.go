@@ -19,6 +19,14 @@ const (
 	InstitutionCountKey  = "Institution/count/"
 )
 
+const (
+	RoleAssignmentKeyPrefix = "RoleAssignment/value/"
+	GradingScaleKeyPrefix   = "GradingScale/value/"
+	SigningKeyKeyPrefix     = "SigningKey/value/"
+	AccreditationKeyPrefix  = "AccreditationProposal/value/"
+	AcademicTermKeyPrefix   = "AcademicTerm/value/"
+)
+
 const (
 	// Event types
 	EventTypeRegisterInstitution    = "register_institution"
@@ -34,6 +42,58 @@ const (
 	AttributeKeyIsAuthorized     = "is_authorized"
 )
 
+const (
+	// Event types
+	EventTypeGrantRole  = "grant_role"
+	EventTypeRevokeRole = "revoke_role"
+
+	// Event attribute keys
+	AttributeKeyAddress   = "address"
+	AttributeKeyRole      = "role"
+	AttributeKeyCourseId  = "course_id"
+	AttributeKeySubjectId = "subject_id"
+)
+
+const (
+	// Event types
+	EventTypeConfigureGradingScale = "configure_grading_scale"
+
+	// Event attribute keys
+	AttributeKeyScaleType    = "scale_type"
+	AttributeKeyPassingGrade = "passing_grade"
+)
+
+const (
+	// Event types
+	EventTypeRegisterSigningKey = "register_signing_key"
+	EventTypeRevokeSigningKey   = "revoke_signing_key"
+
+	// Event attribute keys
+	AttributeKeyKeyId     = "key_id"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyAlgorithm = "algorithm"
+)
+
+const (
+	// Event types
+	EventTypeProposeAccreditation = "propose_accreditation"
+	EventTypeSetAccreditation     = "set_accreditation"
+
+	// Event attribute keys
+	AttributeKeyEvidenceLinks   = "evidence_links"
+	AttributeKeyAccredited      = "accredited"
+	AttributeKeyAccreditedUntil = "accredited_until"
+)
+
+const (
+	// Event types
+	EventTypeSetAcademicTerm = "set_academic_term"
+
+	// Event attribute keys
+	AttributeKeyTermId                  = "term_id"
+	AttributeKeyGradeSubmissionDeadline = "grade_submission_deadline"
+)
+
 var (
 	ParamsKey = []byte("params")
 )
@@ -50,6 +110,56 @@ func InstitutionKey(index string) []byte {
 	return key
 }
 
+// RoleAssignmentInstitutionPrefix returns the store prefix holding every role
+// assignment of an institution
+func RoleAssignmentInstitutionPrefix(institution string) []byte {
+	return KeyPrefix(RoleAssignmentKeyPrefix + institution + "/")
+}
+
+// RoleAssignmentAccountPrefix returns the store prefix holding the role
+// assignments of an account inside an institution
+func RoleAssignmentAccountPrefix(institution string, address string) []byte {
+	return append(RoleAssignmentInstitutionPrefix(institution), []byte(address+"/")...)
+}
+
+// RoleAssignmentKey returns the store key of a single role assignment
+func RoleAssignmentKey(institution string, address string, role string, courseId string, subjectId string) []byte {
+	key := RoleAssignmentAccountPrefix(institution, address)
+	return append(key, []byte(role+"/"+courseId+"/"+subjectId)...)
+}
+
+// GradingScaleKey returns the store key of an institution grading scale
+func GradingScaleKey(institution string) []byte {
+	return KeyPrefix(GradingScaleKeyPrefix + institution)
+}
+
+// SigningKeyInstitutionPrefix returns the store prefix holding every signing
+// key of an institution
+func SigningKeyInstitutionPrefix(institution string) []byte {
+	return KeyPrefix(SigningKeyKeyPrefix + institution + "/")
+}
+
+// SigningKeyKey returns the store key of a single signing key
+func SigningKeyKey(institution string, keyId string) []byte {
+	return append(SigningKeyInstitutionPrefix(institution), []byte(keyId)...)
+}
+
+// AccreditationProposalKey returns the store key of an institution's latest
+// accreditation proposal
+func AccreditationProposalKey(institution string) []byte {
+	return KeyPrefix(AccreditationKeyPrefix + institution)
+}
+
+// AcademicTermInstitutionPrefix returns the store prefix of an institution's academic terms
+func AcademicTermInstitutionPrefix(institution string) []byte {
+	return KeyPrefix(AcademicTermKeyPrefix + institution + "/")
+}
+
+// AcademicTermKey returns the store key of a single academic term
+func AcademicTermKey(institution string, termId string) []byte {
+	return append(AcademicTermInstitutionPrefix(institution), []byte(termId)...)
+}
+
 // KeyPrefix creates a key prefix from a string
 func KeyPrefix(p string) []byte {
 	return []byte(p)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgConfigureGradingScale{}
+
+// NewMsgConfigureGradingScale creates a new message for configuring the grading
+// scale of an institution
+func NewMsgConfigureGradingScale(creator string, institution string, scaleType string, minGrade string, maxGrade string, passingGrade string, letterGrades []LetterGrade, maxGpa string) *MsgConfigureGradingScale {
+	return &MsgConfigureGradingScale{
+		Creator:      creator,
+		Institution:  institution,
+		ScaleType:    scaleType,
+		MinGrade:     minGrade,
+		MaxGrade:     maxGrade,
+		PassingGrade: passingGrade,
+		LetterGrades: letterGrades,
+		MaxGpa:       maxGpa,
+	}
+}
+
+// GradingScale returns the grading scale described by the message
+func (msg *MsgConfigureGradingScale) GradingScale() GradingScale {
+	maxGpa := msg.MaxGpa
+	if maxGpa == "" {
+		maxGpa = DefaultMaxGpa
+	}
+	return GradingScale{
+		Institution:  msg.Institution,
+		ScaleType:    msg.ScaleType,
+		MinGrade:     msg.MinGrade,
+		MaxGrade:     msg.MaxGrade,
+		PassingGrade: msg.PassingGrade,
+		LetterGrades: msg.LetterGrades,
+		MaxGpa:       maxGpa,
+		UpdatedBy:    msg.Creator,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgConfigureGradingScale) ValidateBasic() error {
+	// Validate the creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// Validate institution index
+	if msg.Institution == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution index cannot be empty")
+	}
+	if strings.Contains(msg.Institution, "/") {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution index cannot contain '/'")
+	}
+
+	return msg.GradingScale().Validate()
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgConfigureGradingScale_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgConfigureGradingScale
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgConfigureGradingScale{
+				Creator:     "invalid_address",
+				Institution: "institution-1",
+				ScaleType:   ScaleTypePassFail,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty institution",
+			msg: MsgConfigureGradingScale{
+				Creator:   sample.AccAddress(),
+				ScaleType: ScaleTypePassFail,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid scale",
+			msg: MsgConfigureGradingScale{
+				Creator:      sample.AccAddress(),
+				Institution:  "institution-1",
+				ScaleType:    ScaleTypeNumeric,
+				MinGrade:     "0",
+				MaxGrade:     "10",
+				PassingGrade: "12",
+			},
+			err: ErrInvalidGradingScale,
+		},
+		{
+			name: "valid message with default max gpa",
+			msg: MsgConfigureGradingScale{
+				Creator:      sample.AccAddress(),
+				Institution:  "institution-1",
+				ScaleType:    ScaleTypeNumeric,
+				MinGrade:     "0",
+				MaxGrade:     "10",
+				PassingGrade: "5",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
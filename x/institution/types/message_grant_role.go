@@ -0,0 +1,48 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+// NewMsgGrantRole creates a new message for granting an institution role
+func NewMsgGrantRole(creator string, institution string, address string, role string, courseId string, subjectId string) *MsgGrantRole {
+	return &MsgGrantRole{
+		Creator:     creator,
+		Institution: institution,
+		Address:     address,
+		Role:        role,
+		CourseId:    courseId,
+		SubjectId:   subjectId,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgGrantRole) ValidateBasic() error {
+	// Validate the creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// Validate the role holder address
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid role holder address (%s)", err)
+	}
+
+	// Validate institution index
+	if msg.Institution == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution index cannot be empty")
+	}
+
+	// Scope identifiers are part of the store key
+	if strings.Contains(msg.Institution, "/") || strings.Contains(msg.CourseId, "/") || strings.Contains(msg.SubjectId, "/") {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution, course and subject IDs cannot contain '/'")
+	}
+
+	return ValidateRoleScope(msg.Role, msg.CourseId, msg.SubjectId)
+}
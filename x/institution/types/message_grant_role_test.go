@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgGrantRole_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgGrantRole
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgGrantRole{
+				Creator:     "invalid_address",
+				Institution: "institution-1",
+				Address:     sample.AccAddress(),
+				Role:        RoleProfessor,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid role holder address",
+			msg: MsgGrantRole{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+				Address:     "invalid_address",
+				Role:        RoleProfessor,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty institution",
+			msg: MsgGrantRole{
+				Creator: sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Role:    RoleProfessor,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "unknown role",
+			msg: MsgGrantRole{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+				Address:     sample.AccAddress(),
+				Role:        "dean",
+			},
+			err: ErrInvalidRole,
+		},
+		{
+			name: "scoped institution admin",
+			msg: MsgGrantRole{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+				Address:     sample.AccAddress(),
+				Role:        RoleInstitutionAdmin,
+				CourseId:    "course-1",
+			},
+			err: ErrInvalidRole,
+		},
+		{
+			name: "valid message",
+			msg: MsgGrantRole{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+				Address:     sample.AccAddress(),
+				Role:        RoleProfessor,
+				SubjectId:   "subject-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
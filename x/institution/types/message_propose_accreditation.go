@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgProposeAccreditation{}
+
+// NewMsgProposeAccreditation creates a new message for proposing an institution accreditation
+func NewMsgProposeAccreditation(creator string, institution string, evidenceLinks []string, description string) *MsgProposeAccreditation {
+	return &MsgProposeAccreditation{
+		Creator:       creator,
+		Institution:   institution,
+		EvidenceLinks: evidenceLinks,
+		Description:   description,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgProposeAccreditation) ValidateBasic() error {
+	// Validate the creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Institution == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution index cannot be empty")
+	}
+
+	return ValidateEvidenceLinks(msg.EvidenceLinks)
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgProposeAccreditation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgProposeAccreditation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgProposeAccreditation{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing evidence",
+			msg: MsgProposeAccreditation{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+			},
+			err: ErrInvalidAccreditation,
+		}, {
+			name: "relative evidence link",
+			msg: MsgProposeAccreditation{
+				Creator:       sample.AccAddress(),
+				Institution:   "institution-1",
+				EvidenceLinks: []string{"reports/2025.pdf"},
+			},
+			err: ErrInvalidAccreditation,
+		}, {
+			name: "valid address",
+			msg: MsgProposeAccreditation{
+				Creator:       sample.AccAddress(),
+				Institution:   "institution-1",
+				EvidenceLinks: []string{"https://example.edu/accreditation/2025.pdf", "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterSigningKey{}
+
+// NewMsgRegisterSigningKey creates a new message for registering a signing key
+func NewMsgRegisterSigningKey(creator string, institution string, keyId string, owner string, algorithm string, pubKey []byte) *MsgRegisterSigningKey {
+	return &MsgRegisterSigningKey{
+		Creator:     creator,
+		Institution: institution,
+		KeyId:       keyId,
+		Owner:       owner,
+		Algorithm:   algorithm,
+		PubKey:      pubKey,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgRegisterSigningKey) ValidateBasic() error {
+	// Validate the creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// Validate the key owner address
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid key owner address (%s)", err)
+	}
+
+	// Validate identifiers, which are part of the store key
+	if msg.Institution == "" || msg.KeyId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution index and key ID cannot be empty")
+	}
+	if strings.Contains(msg.Institution, "/") || strings.Contains(msg.KeyId, "/") {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution and key IDs cannot contain '/'")
+	}
+
+	_, err := SigningKey{Algorithm: msg.Algorithm, PubKey: msg.PubKey}.PublicKey()
+	return err
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterSigningKey_ValidateBasic(t *testing.T) {
+	secpKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	edKey := ed25519.GenPrivKey().PubKey().Bytes()
+
+	tests := []struct {
+		name string
+		msg  MsgRegisterSigningKey
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg:  *NewMsgRegisterSigningKey("invalid_address", "institution-1", "key-1", sample.AccAddress(), SigningAlgorithmSecp256k1, secpKey),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid owner address",
+			msg:  *NewMsgRegisterSigningKey(sample.AccAddress(), "institution-1", "key-1", "invalid_address", SigningAlgorithmSecp256k1, secpKey),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty key ID",
+			msg:  *NewMsgRegisterSigningKey(sample.AccAddress(), "institution-1", "", sample.AccAddress(), SigningAlgorithmSecp256k1, secpKey),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "key ID with separator",
+			msg:  *NewMsgRegisterSigningKey(sample.AccAddress(), "institution-1", "key/1", sample.AccAddress(), SigningAlgorithmSecp256k1, secpKey),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "unsupported algorithm",
+			msg:  *NewMsgRegisterSigningKey(sample.AccAddress(), "institution-1", "key-1", sample.AccAddress(), "rsa", secpKey),
+			err:  ErrInvalidSigningKey,
+		},
+		{
+			name: "public key of another algorithm",
+			msg:  *NewMsgRegisterSigningKey(sample.AccAddress(), "institution-1", "key-1", sample.AccAddress(), SigningAlgorithmSecp256k1, edKey),
+			err:  ErrInvalidSigningKey,
+		},
+		{
+			name: "valid secp256k1 key",
+			msg:  *NewMsgRegisterSigningKey(sample.AccAddress(), "institution-1", "key-1", sample.AccAddress(), SigningAlgorithmSecp256k1, secpKey),
+		},
+		{
+			name: "valid ed25519 key",
+			msg:  *NewMsgRegisterSigningKey(sample.AccAddress(), "institution-1", "key-1", sample.AccAddress(), SigningAlgorithmEd25519, edKey),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
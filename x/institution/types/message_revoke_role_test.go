@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRevokeRole_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevokeRole
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgRevokeRole{
+				Creator:     "invalid_address",
+				Institution: "institution-1",
+				Address:     sample.AccAddress(),
+				Role:        RoleProfessor,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid role holder address",
+			msg: MsgRevokeRole{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+				Address:     "invalid_address",
+				Role:        RoleProfessor,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty institution",
+			msg: MsgRevokeRole{
+				Creator: sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Role:    RoleProfessor,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "unknown role",
+			msg: MsgRevokeRole{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+				Address:     sample.AccAddress(),
+				Role:        "dean",
+			},
+			err: ErrInvalidRole,
+		},
+		{
+			name: "scoped institution admin",
+			msg: MsgRevokeRole{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+				Address:     sample.AccAddress(),
+				Role:        RoleInstitutionAdmin,
+				CourseId:    "course-1",
+			},
+			err: ErrInvalidRole,
+		},
+		{
+			name: "valid message",
+			msg: MsgRevokeRole{
+				Creator:     sample.AccAddress(),
+				Institution: "institution-1",
+				Address:     sample.AccAddress(),
+				Role:        RoleProfessor,
+				SubjectId:   "subject-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRevokeSigningKey{}
+
+// NewMsgRevokeSigningKey creates a new message for revoking a signing key
+func NewMsgRevokeSigningKey(creator string, institution string, keyId string) *MsgRevokeSigningKey {
+	return &MsgRevokeSigningKey{
+		Creator:     creator,
+		Institution: institution,
+		KeyId:       keyId,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgRevokeSigningKey) ValidateBasic() error {
+	// Validate the creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Institution == "" || msg.KeyId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution index and key ID cannot be empty")
+	}
+
+	return nil
+}
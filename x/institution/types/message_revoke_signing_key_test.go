@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRevokeSigningKey_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevokeSigningKey
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg:  *NewMsgRevokeSigningKey("invalid_address", "institution-1", "key-1"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty key ID",
+			msg:  *NewMsgRevokeSigningKey(sample.AccAddress(), "institution-1", ""),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid message",
+			msg:  *NewMsgRevokeSigningKey(sample.AccAddress(), "institution-1", "key-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetAcademicTerm{}
+
+// NewMsgSetAcademicTerm creates a new message for adding or updating an academic term
+func NewMsgSetAcademicTerm(creator string, term AcademicTerm) *MsgSetAcademicTerm {
+	return &MsgSetAcademicTerm{
+		Creator:                 creator,
+		Institution:             term.Institution,
+		TermId:                  term.TermId,
+		Name:                    term.Name,
+		StartDate:               term.StartDate,
+		EndDate:                 term.EndDate,
+		EnrollmentStart:         term.EnrollmentStart,
+		EnrollmentEnd:           term.EnrollmentEnd,
+		GradeSubmissionDeadline: term.GradeSubmissionDeadline,
+	}
+}
+
+// AcademicTerm returns the term described by the message
+func (msg *MsgSetAcademicTerm) AcademicTerm() AcademicTerm {
+	return AcademicTerm{
+		Institution:             msg.Institution,
+		TermId:                  msg.TermId,
+		Name:                    msg.Name,
+		StartDate:               msg.StartDate,
+		EndDate:                 msg.EndDate,
+		EnrollmentStart:         msg.EnrollmentStart,
+		EnrollmentEnd:           msg.EnrollmentEnd,
+		GradeSubmissionDeadline: msg.GradeSubmissionDeadline,
+		Creator:                 msg.Creator,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgSetAcademicTerm) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return msg.AcademicTerm().Validate()
+}
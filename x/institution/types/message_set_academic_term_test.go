@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetAcademicTerm_ValidateBasic(t *testing.T) {
+	term := AcademicTerm{
+		Institution:             "institution-1",
+		TermId:                  "2025-1",
+		Name:                    "First semester 2025",
+		StartDate:               "2025-02-01T00:00:00Z",
+		EndDate:                 "2025-06-30T00:00:00Z",
+		EnrollmentStart:         "2025-01-10T00:00:00Z",
+		EnrollmentEnd:           "2025-02-15T00:00:00Z",
+		GradeSubmissionDeadline: "2025-07-15T00:00:00Z",
+	}
+	withTermId := func(termId string) AcademicTerm {
+		t := term
+		t.TermId = termId
+		return t
+	}
+	withEndDate := func(endDate string) AcademicTerm {
+		t := term
+		t.EndDate = endDate
+		return t
+	}
+
+	tests := []struct {
+		name string
+		msg  *MsgSetAcademicTerm
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgSetAcademicTerm("invalid_address", term),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "free-form term ID",
+			msg:  NewMsgSetAcademicTerm(sample.AccAddress(), withTermId("1/2024")),
+			err:  ErrInvalidAcademicTerm,
+		}, {
+			name: "malformed date",
+			msg:  NewMsgSetAcademicTerm(sample.AccAddress(), withEndDate("2025-06-30")),
+			err:  ErrInvalidAcademicTerm,
+		}, {
+			name: "enrollment after term end",
+			msg:  NewMsgSetAcademicTerm(sample.AccAddress(), withEndDate("2025-02-10T00:00:00Z")),
+			err:  ErrInvalidAcademicTerm,
+		}, {
+			name: "valid address",
+			msg:  NewMsgSetAcademicTerm(sample.AccAddress(), term),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
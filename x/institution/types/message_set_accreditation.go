@@ -0,0 +1,42 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetAccreditation{}
+
+// NewMsgSetAccreditation creates a new message for granting or withdrawing an accreditation
+func NewMsgSetAccreditation(authority string, institution string, accredited bool, expiresAt string) *MsgSetAccreditation {
+	return &MsgSetAccreditation{
+		Authority:   authority,
+		Institution: institution,
+		Accredited:  accredited,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgSetAccreditation) ValidateBasic() error {
+	// Validate the authority address
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.Institution == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "institution index cannot be empty")
+	}
+
+	// Accreditations are granted for a fixed period
+	if msg.Accredited {
+		if _, err := time.Parse(time.RFC3339, msg.ExpiresAt); err != nil {
+			return ErrInvalidAccreditation.Wrapf("invalid expiry date '%s', expected RFC3339", msg.ExpiresAt)
+		}
+	}
+
+	return nil
+}
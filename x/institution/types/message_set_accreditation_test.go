@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetAccreditation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetAccreditation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetAccreditation{
+				Authority: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "accreditation without expiry",
+			msg: MsgSetAccreditation{
+				Authority:   sample.AccAddress(),
+				Institution: "institution-1",
+				Accredited:  true,
+			},
+			err: ErrInvalidAccreditation,
+		}, {
+			name: "withdrawal",
+			msg: MsgSetAccreditation{
+				Authority:   sample.AccAddress(),
+				Institution: "institution-1",
+			},
+		}, {
+			name: "valid address",
+			msg: MsgSetAccreditation{
+				Authority:   sample.AccAddress(),
+				Institution: "institution-1",
+				Accredited:  true,
+				ExpiresAt:   "2030-01-01T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -28,5 +31,25 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	seen := make(map[string]struct{})
+	for _, body := range p.AccreditationBodies {
+		if _, err := sdk.AccAddressFromBech32(body); err != nil {
+			return fmt.Errorf("invalid accreditation body address %s: %w", body, err)
+		}
+		if _, ok := seen[body]; ok {
+			return fmt.Errorf("duplicated accreditation body: %s", body)
+		}
+		seen[body] = struct{}{}
+	}
 	return nil
 }
+
+// IsAccreditationBody checks if an address is a designated accreditation body
+func (p Params) IsAccreditationBody(address string) bool {
+	for _, body := range p.AccreditationBodies {
+		if body == address {
+			return true
+		}
+	}
+	return false
+}
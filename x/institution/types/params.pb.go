@@ -29,6 +29,8 @@ type Params struct {
 	IpfsGateway string `protobuf:"bytes,1,opt,name=ipfs_gateway,json=ipfsGateway,proto3" json:"ipfs_gateway,omitempty" yaml:"ipfs_gateway"`
 	IpfsEnabled bool   `protobuf:"varint,2,opt,name=ipfs_enabled,json=ipfsEnabled,proto3" json:"ipfs_enabled,omitempty" yaml:"ipfs_enabled"`
 	Admin       string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// accreditation_bodies may grant and withdraw accreditations alongside governance
+	AccreditationBodies []string `protobuf:"bytes,4,rep,name=accreditation_bodies,json=accreditationBodies,proto3" json:"accreditation_bodies,omitempty" yaml:"accreditation_bodies"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -85,6 +87,13 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetAccreditationBodies() []string {
+	if m != nil {
+		return m.AccreditationBodies
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "academictoken.institution.Params")
 }
@@ -94,24 +103,27 @@ func init() {
 }
 
 var fileDescriptor_91ccc5fe6a477cef = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
+	// 306 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4c, 0x4e, 0x4c,
 	0x49, 0xcd, 0xcd, 0x4c, 0x2e, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0xcf, 0xcc, 0x2b, 0x2e, 0xc9, 0x2c,
 	0x29, 0x2d, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0x92, 0x44, 0x51, 0xa7, 0x87, 0xa4, 0x4e, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f,
 	0x5f, 0x1f, 0x4c, 0x42, 0x54, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16,
-	0x44, 0x54, 0xe9, 0x2a, 0x23, 0x17, 0x5b, 0x00, 0xd8, 0x50, 0x21, 0x2b, 0x2e, 0x9e, 0xcc, 0x82,
+	0x44, 0x54, 0x69, 0x25, 0x13, 0x17, 0x5b, 0x00, 0xd8, 0x50, 0x21, 0x2b, 0x2e, 0x9e, 0xcc, 0x82,
 	0xb4, 0xe2, 0xf8, 0xf4, 0xc4, 0x92, 0xd4, 0xf2, 0xc4, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e,
 	0x27, 0xf1, 0x4f, 0xf7, 0xe4, 0x85, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x90, 0x65, 0x95, 0x82,
 	0xb8, 0x41, 0x5c, 0x77, 0x08, 0x0f, 0xae, 0x37, 0x35, 0x2f, 0x31, 0x29, 0x27, 0x35, 0x45, 0x82,
 	0x49, 0x81, 0x51, 0x83, 0x03, 0x43, 0x2f, 0x54, 0x16, 0xaa, 0xd7, 0x15, 0xc2, 0x13, 0x52, 0xe3,
 	0x62, 0x4d, 0x4c, 0xc9, 0xcd, 0xcc, 0x93, 0x60, 0x06, 0x5b, 0x28, 0xf0, 0xe9, 0x9e, 0x3c, 0x0f,
-	0x44, 0x13, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xa5, 0xfd, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3,
-	0x0d, 0x5a, 0x4a, 0xa8, 0xe1, 0x53, 0x81, 0x12, 0x42, 0x10, 0xcf, 0x38, 0x59, 0x9f, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x22, 0x3e, 0xdd, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0xe0, 0xb0, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x8e, 0x64, 0x53, 0x89,
-	0x01, 0x00, 0x00,
+	0x44, 0x13, 0x58, 0x58, 0x29, 0x08, 0x22, 0x2d, 0x14, 0xc4, 0x25, 0x92, 0x98, 0x9c, 0x5c, 0x94,
+	0x9a, 0x92, 0x59, 0x92, 0x08, 0xf2, 0x64, 0x7c, 0x52, 0x7e, 0x4a, 0x66, 0x6a, 0xb1, 0x04, 0x8b,
+	0x02, 0xb3, 0x06, 0xa7, 0x93, 0xfc, 0xa7, 0x7b, 0xf2, 0xd2, 0x50, 0x6d, 0x58, 0x54, 0x29, 0x05,
+	0x09, 0xa3, 0x08, 0x3b, 0x81, 0x45, 0xad, 0xb4, 0x5f, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41,
+	0x4b, 0x09, 0x35, 0xcc, 0x2b, 0x50, 0x42, 0x1d, 0x12, 0x40, 0x4e, 0xd6, 0x27, 0x1e, 0xc9, 0x31,
+	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
+	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x88, 0x4f, 0x77, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
+	0x1b, 0x38, 0xbc, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x4d, 0xbf, 0x29, 0xdd, 0x01,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -142,6 +154,14 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.AccreditationBodies) != len(that1.AccreditationBodies) {
+		return false
+	}
+	for i := range this.AccreditationBodies {
+		if this.AccreditationBodies[i] != that1.AccreditationBodies[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -164,6 +184,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccreditationBodies) > 0 {
+		for iNdEx := len(m.AccreditationBodies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccreditationBodies[iNdEx])
+			copy(dAtA[i:], m.AccreditationBodies[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AccreditationBodies[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -219,6 +248,12 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.AccreditationBodies) > 0 {
+		for _, s := range m.AccreditationBodies {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -341,6 +376,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationBodies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccreditationBodies = append(m.AccreditationBodies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -482,815 +482,3852 @@ func (m *QueryInstitutionCountResponse) GetCount() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "academictoken.institution.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "academictoken.institution.QueryParamsResponse")
-	proto.RegisterType((*QueryGetInstitutionRequest)(nil), "academictoken.institution.QueryGetInstitutionRequest")
-	proto.RegisterType((*QueryGetInstitutionResponse)(nil), "academictoken.institution.QueryGetInstitutionResponse")
-	proto.RegisterType((*QueryAllInstitutionRequest)(nil), "academictoken.institution.QueryAllInstitutionRequest")
-	proto.RegisterType((*QueryAllInstitutionResponse)(nil), "academictoken.institution.QueryAllInstitutionResponse")
-	proto.RegisterType((*QueryAuthorizedInstitutionsRequest)(nil), "academictoken.institution.QueryAuthorizedInstitutionsRequest")
-	proto.RegisterType((*QueryAuthorizedInstitutionsResponse)(nil), "academictoken.institution.QueryAuthorizedInstitutionsResponse")
-	proto.RegisterType((*QueryInstitutionCountRequest)(nil), "academictoken.institution.QueryInstitutionCountRequest")
-	proto.RegisterType((*QueryInstitutionCountResponse)(nil), "academictoken.institution.QueryInstitutionCountResponse")
+// QueryInstitutionRolesRequest is request type for the Query/InstitutionRoles RPC method.
+type QueryInstitutionRolesRequest struct {
+	Institution string             `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("academictoken/institution/query.proto", fileDescriptor_51b5a99d8a588c4c)
+func (m *QueryInstitutionRolesRequest) Reset()         { *m = QueryInstitutionRolesRequest{} }
+func (m *QueryInstitutionRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInstitutionRolesRequest) ProtoMessage()    {}
+func (*QueryInstitutionRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{10}
 }
-
-var fileDescriptor_51b5a99d8a588c4c = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xb5, 0x89, 0x74, 0x02, 0xa2, 0x63, 0x10, 0x5d, 0xeb, 0xda, 0x6c, 0x69, 0x1a,
-	0x2b, 0xdd, 0xb1, 0x91, 0x56, 0x41, 0x14, 0x1a, 0xc5, 0xe2, 0x45, 0xea, 0x1e, 0xf5, 0x34, 0x49,
-	0x86, 0x75, 0x71, 0xb3, 0xb3, 0xcd, 0xce, 0x4a, 0xab, 0x08, 0xe2, 0x5f, 0x20, 0xfa, 0x4f, 0x78,
-	0xd3, 0x83, 0x78, 0xf4, 0x28, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x49, 0x04, 0xff, 0x0d, 0xd9, 0x99,
-	0x69, 0xbb, 0x5b, 0xf7, 0x47, 0xaa, 0x1e, 0x7a, 0x09, 0xb3, 0xb3, 0xef, 0xfb, 0xbe, 0x9f, 0x37,
-	0xb3, 0xef, 0x05, 0xce, 0x91, 0x2e, 0xe9, 0xd1, 0xbe, 0xd3, 0xe5, 0xec, 0x09, 0xf5, 0xb0, 0xe3,
-	0x05, 0xdc, 0xe1, 0x21, 0x77, 0x98, 0x87, 0x37, 0x42, 0x3a, 0xd8, 0x32, 0xfd, 0x01, 0xe3, 0x0c,
-	0x9d, 0x4b, 0x84, 0x99, 0xb1, 0x30, 0xed, 0x14, 0xe9, 0x3b, 0x1e, 0xc3, 0xe2, 0x57, 0x46, 0x6b,
-	0x35, 0x9b, 0xd9, 0x4c, 0x2c, 0x71, 0xb4, 0x52, 0xbb, 0xd3, 0x36, 0x63, 0xb6, 0x4b, 0x31, 0xf1,
-	0x1d, 0x4c, 0x3c, 0x8f, 0x71, 0x12, 0xe9, 0x03, 0xf5, 0x76, 0xa1, 0xcb, 0x82, 0x3e, 0x0b, 0x70,
-	0x87, 0x04, 0x54, 0x5a, 0xe3, 0xa7, 0x4b, 0x1d, 0xca, 0xc9, 0x12, 0xf6, 0x89, 0xed, 0x78, 0x22,
-	0x58, 0xc5, 0x36, 0xb2, 0xa1, 0x7d, 0x32, 0x20, 0xfd, 0xdd, 0x9c, 0x97, 0xb3, 0xe3, 0x62, 0x6b,
-	0x19, 0x6c, 0xd4, 0x20, 0x7a, 0x10, 0xd9, 0xae, 0x8b, 0x0c, 0x16, 0xdd, 0x08, 0x69, 0xc0, 0x8d,
-	0x47, 0xf0, 0x74, 0x62, 0x37, 0xf0, 0x99, 0x17, 0x50, 0x74, 0x07, 0x56, 0xa4, 0xd3, 0x59, 0x30,
-	0x03, 0x9a, 0xd5, 0x56, 0xdd, 0xcc, 0x3c, 0x20, 0x53, 0x4a, 0xdb, 0x53, 0xdb, 0xdf, 0x2f, 0x96,
-	0xde, 0xfd, 0xfa, 0xb0, 0x00, 0x2c, 0xa5, 0x35, 0x5a, 0x50, 0x13, 0xc9, 0xd7, 0x28, 0xbf, 0xb7,
-	0x2f, 0x50, 0xd6, 0xa8, 0x06, 0xcb, 0x8e, 0xd7, 0xa3, 0x9b, 0xc2, 0x62, 0xca, 0x92, 0x0f, 0x46,
-	0x1f, 0x9e, 0x4f, 0xd5, 0x28, 0xb0, 0xfb, 0xb0, 0x1a, 0xf3, 0x56, 0x74, 0x8d, 0x1c, 0xba, 0x58,
-	0x92, 0xf6, 0x64, 0x84, 0x68, 0xc5, 0x13, 0x18, 0x3d, 0x85, 0xb8, 0xea, 0xba, 0x29, 0x88, 0x77,
-	0x21, 0xdc, 0xbf, 0x9c, 0x3d, 0x33, 0x79, 0x93, 0x66, 0x74, 0x93, 0xa6, 0xfc, 0x88, 0xd4, 0x4d,
-	0x9a, 0xeb, 0xc4, 0xa6, 0x4a, 0x6b, 0xc5, 0x94, 0xc6, 0x27, 0xa0, 0xaa, 0x3a, 0x68, 0x93, 0x55,
-	0xd5, 0xb1, 0x7f, 0xaa, 0x0a, 0xad, 0x25, 0xb8, 0x27, 0x04, 0xf7, 0x7c, 0x21, 0xb7, 0x84, 0x49,
-	0x80, 0xbb, 0xd0, 0x90, 0xdc, 0x21, 0x7f, 0xcc, 0x06, 0xce, 0x33, 0xda, 0x8b, 0x39, 0x07, 0xff,
-	0xfb, 0x98, 0x3e, 0x03, 0x38, 0x9b, 0x6b, 0x77, 0xd4, 0x8f, 0x4b, 0x87, 0xd3, 0x82, 0x3f, 0xe6,
-	0x77, 0x9b, 0x85, 0x1e, 0xdf, 0xed, 0xb6, 0x65, 0x78, 0x21, 0xe3, 0xbd, 0xaa, 0xac, 0x06, 0xcb,
-	0xdd, 0x68, 0x43, 0x1c, 0xe2, 0xa4, 0x25, 0x1f, 0x5a, 0x2f, 0x8f, 0xc3, 0xb2, 0xd0, 0xa1, 0x37,
-	0x00, 0x56, 0x64, 0xbf, 0xa1, 0xc5, 0x9c, 0x7a, 0xff, 0x6c, 0x74, 0xcd, 0x1c, 0x37, 0x5c, 0x92,
-	0x18, 0x97, 0x5e, 0x7d, 0xfd, 0xf9, 0x76, 0x62, 0x16, 0xd5, 0x71, 0xd1, 0x30, 0x42, 0xef, 0x01,
-	0x3c, 0x11, 0xab, 0x68, 0xd5, 0x75, 0xd1, 0x72, 0x91, 0x5b, 0x6a, 0xbf, 0x69, 0x2b, 0x87, 0x95,
-	0x29, 0x58, 0x53, 0xc0, 0x36, 0x51, 0x03, 0x8f, 0x35, 0x11, 0xd1, 0x47, 0x00, 0xab, 0xb1, 0x3c,
-	0xc5, 0xb8, 0xa9, 0x13, 0xac, 0x18, 0x37, 0x7d, 0x88, 0x19, 0x2b, 0x02, 0xf7, 0x0a, 0x32, 0xc7,
-	0xc3, 0xc5, 0xcf, 0xc5, 0x68, 0x7c, 0x81, 0xbe, 0x00, 0x78, 0x26, 0xbd, 0x35, 0xd0, 0xcd, 0xc2,
-	0x93, 0xcb, 0xeb, 0x60, 0xed, 0xd6, 0xdf, 0xca, 0x55, 0x45, 0x8b, 0xa2, 0xa2, 0x79, 0x34, 0x97,
-	0x53, 0x11, 0xd9, 0x4b, 0x11, 0x7d, 0x31, 0x27, 0x0f, 0xf6, 0x00, 0xba, 0x56, 0xc4, 0x90, 0xd1,
-	0x55, 0xda, 0xf5, 0xc3, 0x0b, 0x15, 0x76, 0x53, 0x60, 0x1b, 0x68, 0x26, 0x07, 0x5b, 0xb4, 0x60,
-	0xfb, 0xc6, 0xf6, 0x50, 0x07, 0x3b, 0x43, 0x1d, 0xfc, 0x18, 0xea, 0xe0, 0xf5, 0x48, 0x2f, 0xed,
-	0x8c, 0xf4, 0xd2, 0xb7, 0x91, 0x5e, 0x7a, 0x58, 0x4f, 0x4a, 0x37, 0x13, 0x62, 0xbe, 0xe5, 0xd3,
-	0xa0, 0x53, 0x11, 0xff, 0xc0, 0x57, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x1e, 0x9d, 0x5e,
-	0x8d, 0x08, 0x00, 0x00,
+func (m *QueryInstitutionRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInstitutionRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInstitutionRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInstitutionRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInstitutionRolesRequest.Merge(m, src)
+}
+func (m *QueryInstitutionRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInstitutionRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInstitutionRolesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryInstitutionRolesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Institution items.
-	InstitutionAll(ctx context.Context, in *QueryAllInstitutionRequest, opts ...grpc.CallOption) (*QueryAllInstitutionResponse, error)
-	// Queries a Institution by index.
-	Institution(ctx context.Context, in *QueryGetInstitutionRequest, opts ...grpc.CallOption) (*QueryGetInstitutionResponse, error)
-	// Queries authorized institutions only.
-	AuthorizedInstitutions(ctx context.Context, in *QueryAuthorizedInstitutionsRequest, opts ...grpc.CallOption) (*QueryAuthorizedInstitutionsResponse, error)
-	// Queries institution count.
-	InstitutionCount(ctx context.Context, in *QueryInstitutionCountRequest, opts ...grpc.CallOption) (*QueryInstitutionCountResponse, error)
+func (m *QueryInstitutionRolesRequest) GetInstitution() string {
+	if m != nil {
+		return m.Institution
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryInstitutionRolesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryInstitutionRolesResponse is response type for the Query/InstitutionRoles RPC method.
+type QueryInstitutionRolesResponse struct {
+	RoleAssignment []RoleAssignment    `protobuf:"bytes,1,rep,name=roleAssignment,proto3" json:"roleAssignment"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInstitutionRolesResponse) Reset()         { *m = QueryInstitutionRolesResponse{} }
+func (m *QueryInstitutionRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInstitutionRolesResponse) ProtoMessage()    {}
+func (*QueryInstitutionRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{11}
+}
+func (m *QueryInstitutionRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInstitutionRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInstitutionRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryInstitutionRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInstitutionRolesResponse.Merge(m, src)
+}
+func (m *QueryInstitutionRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInstitutionRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInstitutionRolesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) InstitutionAll(ctx context.Context, in *QueryAllInstitutionRequest, opts ...grpc.CallOption) (*QueryAllInstitutionResponse, error) {
-	out := new(QueryAllInstitutionResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/InstitutionAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInstitutionRolesResponse proto.InternalMessageInfo
+
+func (m *QueryInstitutionRolesResponse) GetRoleAssignment() []RoleAssignment {
+	if m != nil {
+		return m.RoleAssignment
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Institution(ctx context.Context, in *QueryGetInstitutionRequest, opts ...grpc.CallOption) (*QueryGetInstitutionResponse, error) {
-	out := new(QueryGetInstitutionResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/Institution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInstitutionRolesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AuthorizedInstitutions(ctx context.Context, in *QueryAuthorizedInstitutionsRequest, opts ...grpc.CallOption) (*QueryAuthorizedInstitutionsResponse, error) {
-	out := new(QueryAuthorizedInstitutionsResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/AuthorizedInstitutions", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAccountRolesRequest is request type for the Query/AccountRoles RPC method.
+type QueryAccountRolesRequest struct {
+	Institution string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountRolesRequest) Reset()         { *m = QueryAccountRolesRequest{} }
+func (m *QueryAccountRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRolesRequest) ProtoMessage()    {}
+func (*QueryAccountRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{12}
+}
+func (m *QueryAccountRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRolesRequest.Merge(m, src)
+}
+func (m *QueryAccountRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRolesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) InstitutionCount(ctx context.Context, in *QueryInstitutionCountRequest, opts ...grpc.CallOption) (*QueryInstitutionCountResponse, error) {
-	out := new(QueryInstitutionCountResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/InstitutionCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountRolesRequest proto.InternalMessageInfo
+
+func (m *QueryAccountRolesRequest) GetInstitution() string {
+	if m != nil {
+		return m.Institution
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Institution items.
-	InstitutionAll(context.Context, *QueryAllInstitutionRequest) (*QueryAllInstitutionResponse, error)
-	// Queries a Institution by index.
-	Institution(context.Context, *QueryGetInstitutionRequest) (*QueryGetInstitutionResponse, error)
-	// Queries authorized institutions only.
-	AuthorizedInstitutions(context.Context, *QueryAuthorizedInstitutionsRequest) (*QueryAuthorizedInstitutionsResponse, error)
-	// Queries institution count.
-	InstitutionCount(context.Context, *QueryInstitutionCountRequest) (*QueryInstitutionCountResponse, error)
+func (m *QueryAccountRolesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryAccountRolesResponse is response type for the Query/AccountRoles RPC method.
+type QueryAccountRolesResponse struct {
+	RoleAssignment []RoleAssignment `protobuf:"bytes,1,rep,name=roleAssignment,proto3" json:"roleAssignment"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAccountRolesResponse) Reset()         { *m = QueryAccountRolesResponse{} }
+func (m *QueryAccountRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRolesResponse) ProtoMessage()    {}
+func (*QueryAccountRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{13}
 }
-func (*UnimplementedQueryServer) InstitutionAll(ctx context.Context, req *QueryAllInstitutionRequest) (*QueryAllInstitutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstitutionAll not implemented")
+func (m *QueryAccountRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Institution(ctx context.Context, req *QueryGetInstitutionRequest) (*QueryGetInstitutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Institution not implemented")
+func (m *QueryAccountRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AuthorizedInstitutions(ctx context.Context, req *QueryAuthorizedInstitutionsRequest) (*QueryAuthorizedInstitutionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedInstitutions not implemented")
+func (m *QueryAccountRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRolesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) InstitutionCount(ctx context.Context, req *QueryInstitutionCountRequest) (*QueryInstitutionCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstitutionCount not implemented")
+func (m *QueryAccountRolesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAccountRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRolesResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.institution.Query/Params",
+var xxx_messageInfo_QueryAccountRolesResponse proto.InternalMessageInfo
+
+func (m *QueryAccountRolesResponse) GetRoleAssignment() []RoleAssignment {
+	if m != nil {
+		return m.RoleAssignment
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+// QueryGradingScaleRequest is request type for the Query/GradingScale RPC method.
+type QueryGradingScaleRequest struct {
+	Institution string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+}
+
+func (m *QueryGradingScaleRequest) Reset()         { *m = QueryGradingScaleRequest{} }
+func (m *QueryGradingScaleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGradingScaleRequest) ProtoMessage()    {}
+func (*QueryGradingScaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{14}
+}
+func (m *QueryGradingScaleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGradingScaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGradingScaleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGradingScaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGradingScaleRequest.Merge(m, src)
+}
+func (m *QueryGradingScaleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGradingScaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGradingScaleRequest.DiscardUnknown(m)
 }
 
-func _Query_InstitutionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInstitutionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGradingScaleRequest proto.InternalMessageInfo
+
+func (m *QueryGradingScaleRequest) GetInstitution() string {
+	if m != nil {
+		return m.Institution
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).InstitutionAll(ctx, in)
+	return ""
+}
+
+// QueryGradingScaleResponse is response type for the Query/GradingScale RPC method.
+type QueryGradingScaleResponse struct {
+	GradingScale GradingScale `protobuf:"bytes,1,opt,name=gradingScale,proto3" json:"gradingScale"`
+	IsDefault    bool         `protobuf:"varint,2,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+}
+
+func (m *QueryGradingScaleResponse) Reset()         { *m = QueryGradingScaleResponse{} }
+func (m *QueryGradingScaleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGradingScaleResponse) ProtoMessage()    {}
+func (*QueryGradingScaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{15}
+}
+func (m *QueryGradingScaleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGradingScaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGradingScaleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.institution.Query/InstitutionAll",
+}
+func (m *QueryGradingScaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGradingScaleResponse.Merge(m, src)
+}
+func (m *QueryGradingScaleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGradingScaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGradingScaleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGradingScaleResponse proto.InternalMessageInfo
+
+func (m *QueryGradingScaleResponse) GetGradingScale() GradingScale {
+	if m != nil {
+		return m.GradingScale
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InstitutionAll(ctx, req.(*QueryAllInstitutionRequest))
+	return GradingScale{}
+}
+
+func (m *QueryGradingScaleResponse) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_Institution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetInstitutionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Institution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.institution.Query/Institution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Institution(ctx, req.(*QueryGetInstitutionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySigningKeysRequest is request type for the Query/SigningKeys RPC method.
+type QuerySigningKeysRequest struct {
+	Institution string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
 }
 
-func _Query_AuthorizedInstitutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAuthorizedInstitutionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AuthorizedInstitutions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.institution.Query/AuthorizedInstitutions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AuthorizedInstitutions(ctx, req.(*QueryAuthorizedInstitutionsRequest))
+func (m *QuerySigningKeysRequest) Reset()         { *m = QuerySigningKeysRequest{} }
+func (m *QuerySigningKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningKeysRequest) ProtoMessage()    {}
+func (*QuerySigningKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{16}
+}
+func (m *QuerySigningKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySigningKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningKeysRequest.Merge(m, src)
+}
+func (m *QuerySigningKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningKeysRequest.DiscardUnknown(m)
 }
 
-func _Query_InstitutionCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInstitutionCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InstitutionCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.institution.Query/InstitutionCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InstitutionCount(ctx, req.(*QueryInstitutionCountRequest))
+var xxx_messageInfo_QuerySigningKeysRequest proto.InternalMessageInfo
+
+func (m *QuerySigningKeysRequest) GetInstitution() string {
+	if m != nil {
+		return m.Institution
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "academictoken.institution.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "InstitutionAll",
-			Handler:    _Query_InstitutionAll_Handler,
-		},
-		{
-			MethodName: "Institution",
-			Handler:    _Query_Institution_Handler,
-		},
-		{
-			MethodName: "AuthorizedInstitutions",
-			Handler:    _Query_AuthorizedInstitutions_Handler,
-		},
-		{
-			MethodName: "InstitutionCount",
-			Handler:    _Query_InstitutionCount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "academictoken/institution/query.proto",
+// QuerySigningKeysResponse is response type for the Query/SigningKeys RPC method.
+type QuerySigningKeysResponse struct {
+	SigningKey []SigningKey `protobuf:"bytes,1,rep,name=signingKey,proto3" json:"signingKey"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySigningKeysResponse) Reset()         { *m = QuerySigningKeysResponse{} }
+func (m *QuerySigningKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningKeysResponse) ProtoMessage()    {}
+func (*QuerySigningKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{17}
+}
+func (m *QuerySigningKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySigningKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningKeysResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySigningKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningKeysResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySigningKeysResponse proto.InternalMessageInfo
+
+func (m *QuerySigningKeysResponse) GetSigningKey() []SigningKey {
+	if m != nil {
+		return m.SigningKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccreditationRequest is request type for the Query/Accreditation RPC method.
+type QueryAccreditationRequest struct {
+	Institution string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAccreditationRequest) Reset()         { *m = QueryAccreditationRequest{} }
+func (m *QueryAccreditationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccreditationRequest) ProtoMessage()    {}
+func (*QueryAccreditationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{18}
+}
+func (m *QueryAccreditationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccreditationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccreditationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccreditationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccreditationRequest.Merge(m, src)
+}
+func (m *QueryAccreditationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccreditationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccreditationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetInstitutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccreditationRequest proto.InternalMessageInfo
+
+func (m *QueryAccreditationRequest) GetInstitution() string {
+	if m != nil {
+		return m.Institution
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetInstitutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccreditationResponse is response type for the Query/Accreditation RPC method.
+type QueryAccreditationResponse struct {
+	Accredited      bool                   `protobuf:"varint,1,opt,name=accredited,proto3" json:"accredited,omitempty"`
+	AccreditedUntil string                 `protobuf:"bytes,2,opt,name=accreditedUntil,proto3" json:"accreditedUntil,omitempty"`
+	Proposal        *AccreditationProposal `protobuf:"bytes,3,opt,name=proposal,proto3" json:"proposal,omitempty"`
 }
 
-func (m *QueryGetInstitutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAccreditationResponse) Reset()         { *m = QueryAccreditationResponse{} }
+func (m *QueryAccreditationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccreditationResponse) ProtoMessage()    {}
+func (*QueryAccreditationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{19}
 }
-
-func (m *QueryGetInstitutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccreditationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccreditationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccreditationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAccreditationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccreditationResponse.Merge(m, src)
+}
+func (m *QueryAccreditationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccreditationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccreditationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetInstitutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAccreditationResponse proto.InternalMessageInfo
+
+func (m *QueryAccreditationResponse) GetAccredited() bool {
+	if m != nil {
+		return m.Accredited
+	}
+	return false
 }
 
-func (m *QueryGetInstitutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Institution.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAccreditationResponse) GetAccreditedUntil() string {
+	if m != nil {
+		return m.AccreditedUntil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllInstitutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccreditationResponse) GetProposal() *AccreditationProposal {
+	if m != nil {
+		return m.Proposal
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllInstitutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAcademicTermRequest is request type for the Query/AcademicTerm RPC method.
+type QueryAcademicTermRequest struct {
+	Institution string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	TermId      string `protobuf:"bytes,2,opt,name=termId,proto3" json:"termId,omitempty"`
 }
 
-func (m *QueryAllInstitutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAcademicTermRequest) Reset()         { *m = QueryAcademicTermRequest{} }
+func (m *QueryAcademicTermRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAcademicTermRequest) ProtoMessage()    {}
+func (*QueryAcademicTermRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{20}
+}
+func (m *QueryAcademicTermRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcademicTermRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcademicTermRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllInstitutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAcademicTermRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcademicTermRequest.Merge(m, src)
 }
-
-func (m *QueryAllInstitutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAcademicTermRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcademicTermRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcademicTermRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllInstitutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Institution) > 0 {
-		for iNdEx := len(m.Institution) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Institution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryAcademicTermRequest proto.InternalMessageInfo
+
+func (m *QueryAcademicTermRequest) GetInstitution() string {
+	if m != nil {
+		return m.Institution
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAuthorizedInstitutionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAcademicTermRequest) GetTermId() string {
+	if m != nil {
+		return m.TermId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAuthorizedInstitutionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAcademicTermResponse is response type for the Query/AcademicTerm RPC method.
+type QueryAcademicTermResponse struct {
+	AcademicTerm AcademicTerm `protobuf:"bytes,1,opt,name=academicTerm,proto3" json:"academicTerm"`
 }
 
-func (m *QueryAuthorizedInstitutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAcademicTermResponse) Reset()         { *m = QueryAcademicTermResponse{} }
+func (m *QueryAcademicTermResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAcademicTermResponse) ProtoMessage()    {}
+func (*QueryAcademicTermResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{21}
+}
+func (m *QueryAcademicTermResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcademicTermResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcademicTermResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAcademicTermResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcademicTermResponse.Merge(m, src)
+}
+func (m *QueryAcademicTermResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcademicTermResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcademicTermResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAuthorizedInstitutionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAcademicTermResponse proto.InternalMessageInfo
+
+func (m *QueryAcademicTermResponse) GetAcademicTerm() AcademicTerm {
+	if m != nil {
+		return m.AcademicTerm
 	}
-	return dAtA[:n], nil
+	return AcademicTerm{}
 }
 
-func (m *QueryAuthorizedInstitutionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAcademicTermsRequest is request type for the Query/AcademicTerms RPC method.
+type QueryAcademicTermsRequest struct {
+	Institution string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
 }
 
-func (m *QueryAuthorizedInstitutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Institution) > 0 {
-		for iNdEx := len(m.Institution) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Institution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAcademicTermsRequest) Reset()         { *m = QueryAcademicTermsRequest{} }
+func (m *QueryAcademicTermsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAcademicTermsRequest) ProtoMessage()    {}
+func (*QueryAcademicTermsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{22}
+}
+func (m *QueryAcademicTermsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcademicTermsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcademicTermsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryInstitutionCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAcademicTermsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcademicTermsRequest.Merge(m, src)
 }
-
-func (m *QueryInstitutionCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAcademicTermsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryInstitutionCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAcademicTermsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcademicTermsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInstitutionCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAcademicTermsRequest proto.InternalMessageInfo
+
+func (m *QueryAcademicTermsRequest) GetInstitution() string {
+	if m != nil {
+		return m.Institution
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryInstitutionCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAcademicTermsResponse is response type for the Query/AcademicTerms RPC method.
+type QueryAcademicTermsResponse struct {
+	AcademicTerm []AcademicTerm `protobuf:"bytes,1,rep,name=academicTerm,proto3" json:"academicTerm"`
 }
 
-func (m *QueryInstitutionCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAcademicTermsResponse) Reset()         { *m = QueryAcademicTermsResponse{} }
+func (m *QueryAcademicTermsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAcademicTermsResponse) ProtoMessage()    {}
+func (*QueryAcademicTermsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51b5a99d8a588c4c, []int{23}
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryAcademicTermsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAcademicTermsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcademicTermsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryAcademicTermsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcademicTermsResponse.Merge(m, src)
+}
+func (m *QueryAcademicTermsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcademicTermsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcademicTermsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAcademicTermsResponse proto.InternalMessageInfo
+
+func (m *QueryAcademicTermsResponse) GetAcademicTerm() []AcademicTerm {
+	if m != nil {
+		return m.AcademicTerm
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetInstitutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "academictoken.institution.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "academictoken.institution.QueryParamsResponse")
+	proto.RegisterType((*QueryGetInstitutionRequest)(nil), "academictoken.institution.QueryGetInstitutionRequest")
+	proto.RegisterType((*QueryGetInstitutionResponse)(nil), "academictoken.institution.QueryGetInstitutionResponse")
+	proto.RegisterType((*QueryAllInstitutionRequest)(nil), "academictoken.institution.QueryAllInstitutionRequest")
+	proto.RegisterType((*QueryAllInstitutionResponse)(nil), "academictoken.institution.QueryAllInstitutionResponse")
+	proto.RegisterType((*QueryAuthorizedInstitutionsRequest)(nil), "academictoken.institution.QueryAuthorizedInstitutionsRequest")
+	proto.RegisterType((*QueryAuthorizedInstitutionsResponse)(nil), "academictoken.institution.QueryAuthorizedInstitutionsResponse")
+	proto.RegisterType((*QueryInstitutionCountRequest)(nil), "academictoken.institution.QueryInstitutionCountRequest")
+	proto.RegisterType((*QueryInstitutionCountResponse)(nil), "academictoken.institution.QueryInstitutionCountResponse")
+	proto.RegisterType((*QueryInstitutionRolesRequest)(nil), "academictoken.institution.QueryInstitutionRolesRequest")
+	proto.RegisterType((*QueryInstitutionRolesResponse)(nil), "academictoken.institution.QueryInstitutionRolesResponse")
+	proto.RegisterType((*QueryAccountRolesRequest)(nil), "academictoken.institution.QueryAccountRolesRequest")
+	proto.RegisterType((*QueryAccountRolesResponse)(nil), "academictoken.institution.QueryAccountRolesResponse")
+	proto.RegisterType((*QueryGradingScaleRequest)(nil), "academictoken.institution.QueryGradingScaleRequest")
+	proto.RegisterType((*QueryGradingScaleResponse)(nil), "academictoken.institution.QueryGradingScaleResponse")
+	proto.RegisterType((*QuerySigningKeysRequest)(nil), "academictoken.institution.QuerySigningKeysRequest")
+	proto.RegisterType((*QuerySigningKeysResponse)(nil), "academictoken.institution.QuerySigningKeysResponse")
+	proto.RegisterType((*QueryAccreditationRequest)(nil), "academictoken.institution.QueryAccreditationRequest")
+	proto.RegisterType((*QueryAccreditationResponse)(nil), "academictoken.institution.QueryAccreditationResponse")
+	proto.RegisterType((*QueryAcademicTermRequest)(nil), "academictoken.institution.QueryAcademicTermRequest")
+	proto.RegisterType((*QueryAcademicTermResponse)(nil), "academictoken.institution.QueryAcademicTermResponse")
+	proto.RegisterType((*QueryAcademicTermsRequest)(nil), "academictoken.institution.QueryAcademicTermsRequest")
+	proto.RegisterType((*QueryAcademicTermsResponse)(nil), "academictoken.institution.QueryAcademicTermsResponse")
 }
 
-func (m *QueryGetInstitutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Institution.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() {
+	proto.RegisterFile("academictoken/institution/query.proto", fileDescriptor_51b5a99d8a588c4c)
 }
 
-func (m *QueryAllInstitutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var fileDescriptor_51b5a99d8a588c4c = []byte{
+	// 1208 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0xc7, 0x33, 0x4d, 0x93, 0x5f, 0x76, 0x92, 0xf6, 0x47, 0x87, 0xa8, 0xa4, 0x4b, 0x58, 0x92,
+	0x29, 0x79, 0x69, 0x51, 0xec, 0x36, 0x21, 0x2f, 0x50, 0x52, 0x9a, 0x50, 0x35, 0x54, 0x45, 0x28,
+	0x75, 0x0b, 0x48, 0x70, 0xa8, 0x26, 0xeb, 0xc1, 0x58, 0xf5, 0x7a, 0xb6, 0x1e, 0x2f, 0x6a, 0xa8,
+	0x72, 0xe1, 0xc4, 0x81, 0x03, 0x82, 0x7f, 0x82, 0x1b, 0x1c, 0x0a, 0x08, 0xf1, 0x22, 0x21, 0x24,
+	0xd4, 0x63, 0x25, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x37, 0x90, 0xc7, 0xb3, 0xf6, 0x78, 0xed,
+	0xf5, 0x4b, 0x5a, 0x24, 0x2e, 0xd1, 0x7a, 0xf6, 0x79, 0x9e, 0xf9, 0x3c, 0x2f, 0xb3, 0xf3, 0x8d,
+	0xe1, 0x0c, 0x69, 0x12, 0x93, 0xb6, 0xec, 0xa6, 0xcf, 0x6e, 0x53, 0x57, 0xb7, 0x5d, 0xee, 0xdb,
+	0x7e, 0xc7, 0xb7, 0x99, 0xab, 0xdf, 0xe9, 0x50, 0x6f, 0x57, 0x6b, 0x7b, 0xcc, 0x67, 0xe8, 0x54,
+	0xc2, 0x4c, 0x53, 0xcc, 0xea, 0x27, 0x48, 0xcb, 0x76, 0x99, 0x2e, 0xfe, 0x86, 0xd6, 0xf5, 0x71,
+	0x8b, 0x59, 0x4c, 0x7c, 0xd4, 0x83, 0x4f, 0x72, 0x75, 0xd2, 0x62, 0xcc, 0x72, 0xa8, 0x4e, 0xda,
+	0xb6, 0x4e, 0x5c, 0x97, 0xf9, 0x24, 0xf0, 0xe7, 0xf2, 0xdb, 0xb3, 0x4d, 0xc6, 0x5b, 0x8c, 0xeb,
+	0x3b, 0x84, 0xd3, 0x70, 0x6b, 0xfd, 0x83, 0xf3, 0x3b, 0xd4, 0x27, 0xe7, 0xf5, 0x36, 0xb1, 0x6c,
+	0x57, 0x18, 0x4b, 0xdb, 0xd9, 0xfe, 0xd0, 0x6d, 0xe2, 0x91, 0x56, 0x37, 0xe6, 0xf3, 0xfd, 0xed,
+	0x94, 0xcf, 0xd2, 0xf8, 0xb9, 0xfe, 0xc6, 0x1e, 0x73, 0xa8, 0xb4, 0x5a, 0xe8, 0x6f, 0x65, 0x79,
+	0xc4, 0xb4, 0x5d, 0xeb, 0x16, 0x6f, 0x92, 0xc8, 0x3c, 0x87, 0x80, 0xdb, 0x96, 0x1b, 0x98, 0xdf,
+	0xa6, 0xbb, 0xc5, 0xb1, 0x49, 0xb3, 0xe9, 0x51, 0xd3, 0xf6, 0xd5, 0x2a, 0xe4, 0x9a, 0x87, 0xdf,
+	0xdc, 0xf2, 0xa9, 0xd7, 0x0a, 0xcd, 0xf1, 0x38, 0x44, 0xd7, 0x83, 0xb2, 0x6e, 0x8b, 0x0a, 0x19,
+	0xf4, 0x4e, 0x87, 0x72, 0x1f, 0xbf, 0x0b, 0x9f, 0x4c, 0xac, 0xf2, 0x36, 0x73, 0x39, 0x45, 0x97,
+	0xe1, 0x70, 0x58, 0xc9, 0x09, 0x30, 0x05, 0xe6, 0x47, 0x17, 0xa7, 0xb5, 0xbe, 0x03, 0xa0, 0x85,
+	0xae, 0x9b, 0xb5, 0x07, 0x7f, 0x3c, 0x3b, 0xf0, 0xc5, 0xdf, 0x5f, 0x9d, 0x05, 0x86, 0xf4, 0xc5,
+	0x8b, 0xb0, 0x2e, 0x82, 0x6f, 0x51, 0xff, 0x6a, 0xec, 0x20, 0xb7, 0x46, 0xe3, 0x70, 0xc8, 0x76,
+	0x4d, 0x7a, 0x57, 0x6c, 0x51, 0x33, 0xc2, 0x07, 0xdc, 0x82, 0x4f, 0x67, 0xfa, 0x48, 0xb0, 0x37,
+	0xe0, 0xa8, 0xb2, 0xb7, 0xa4, 0x9b, 0xcd, 0xa1, 0x53, 0x82, 0x6c, 0x1e, 0x0d, 0x10, 0x0d, 0x35,
+	0x00, 0x36, 0x25, 0xe2, 0x86, 0xe3, 0x64, 0x20, 0x5e, 0x81, 0x30, 0x1e, 0xbe, 0x68, 0xb3, 0x70,
+	0x52, 0xb5, 0x60, 0x52, 0xb5, 0xf0, 0x90, 0xc8, 0x49, 0xd5, 0xb6, 0x89, 0x45, 0xa5, 0xaf, 0xa1,
+	0x78, 0xe2, 0x6f, 0x80, 0xcc, 0xaa, 0x77, 0x9b, 0x7e, 0x59, 0x0d, 0x3e, 0x52, 0x56, 0x68, 0x2b,
+	0xc1, 0x7d, 0x44, 0x70, 0xcf, 0x15, 0x72, 0x87, 0x30, 0x09, 0x70, 0x07, 0xe2, 0x90, 0xbb, 0xe3,
+	0xbf, 0xcf, 0x3c, 0xfb, 0x43, 0x6a, 0x2a, 0x3b, 0xf3, 0xc7, 0x5d, 0xa6, 0x9f, 0x00, 0x3c, 0x9d,
+	0xbb, 0xdd, 0x7f, 0xbd, 0x5c, 0x0d, 0x38, 0x29, 0xf8, 0x95, 0xfd, 0x5e, 0x65, 0x1d, 0xd7, 0xef,
+	0x9e, 0xb6, 0x65, 0xf8, 0x4c, 0x9f, 0xef, 0x65, 0x66, 0xe3, 0x70, 0xa8, 0x19, 0x2c, 0x88, 0x22,
+	0x1e, 0x35, 0xc2, 0x07, 0xfc, 0x31, 0x48, 0xc7, 0x35, 0x98, 0x43, 0xa3, 0x06, 0x4c, 0xa5, 0x4f,
+	0x45, 0x2d, 0x99, 0xe2, 0x95, 0x8c, 0x14, 0x0f, 0xd3, 0xa2, 0x9f, 0x41, 0x3a, 0x05, 0x89, 0x22,
+	0x53, 0x78, 0x1b, 0x1e, 0x0f, 0x7e, 0x2f, 0x37, 0x78, 0xf0, 0x03, 0xd7, 0xa2, 0x22, 0x97, 0xa0,
+	0x3f, 0x67, 0x72, 0xfa, 0x63, 0x24, 0x1c, 0x64, 0x8b, 0x7a, 0xc2, 0x3c, 0xbe, 0x2e, 0xbd, 0x05,
+	0x27, 0xc2, 0x29, 0x6b, 0x8a, 0xf2, 0x56, 0xac, 0xe4, 0x04, 0xfc, 0x1f, 0x31, 0x4d, 0x8f, 0x72,
+	0x2e, 0x18, 0x6a, 0x46, 0xf7, 0x11, 0xfb, 0xf0, 0x54, 0x46, 0xdc, 0x7f, 0xb9, 0x2c, 0xf8, 0x65,
+	0x99, 0xcd, 0x56, 0x78, 0xfd, 0xdc, 0x08, 0x6e, 0x9f, 0xd2, 0xd9, 0xe0, 0x4f, 0x80, 0x84, 0x4e,
+	0xba, 0x4b, 0xe8, 0xeb, 0x70, 0xcc, 0x52, 0xd6, 0xe5, 0xd1, 0x9e, 0xcb, 0x41, 0x56, 0xc3, 0x48,
+	0xe0, 0x44, 0x08, 0x34, 0x09, 0x6b, 0x36, 0xbf, 0x4c, 0xdf, 0x23, 0x1d, 0xc7, 0x17, 0x05, 0x1c,
+	0x31, 0xe2, 0x05, 0x7c, 0x01, 0x3e, 0x25, 0x68, 0x6e, 0x84, 0x97, 0xe3, 0x35, 0xba, 0x5b, 0xbe,
+	0x33, 0xd8, 0x92, 0x95, 0x48, 0x38, 0xcb, 0x4c, 0xae, 0x41, 0xc8, 0xa3, 0x65, 0x59, 0xfa, 0x99,
+	0x9c, 0x3c, 0xe2, 0x18, 0x32, 0x0b, 0xc5, 0x1d, 0xaf, 0xc7, 0x8d, 0x8e, 0x6f, 0xe5, 0xf2, 0x9c,
+	0xf7, 0x41, 0xf7, 0xd2, 0x49, 0xfa, 0x4b, 0xd4, 0x06, 0x84, 0xdd, 0xeb, 0x9e, 0x9a, 0xc2, 0x7f,
+	0xc4, 0x50, 0x56, 0xd0, 0x3c, 0xfc, 0x7f, 0xfc, 0xf4, 0xa6, 0xeb, 0xdb, 0x8e, 0x1c, 0xc4, 0xde,
+	0x65, 0xf4, 0x3a, 0x1c, 0x69, 0x7b, 0xac, 0xcd, 0x38, 0x71, 0x26, 0x06, 0x45, 0xeb, 0xce, 0xe5,
+	0xa4, 0x9c, 0xa0, 0xd9, 0x96, 0x7e, 0x46, 0x14, 0x01, 0xdf, 0x8c, 0x8e, 0x4d, 0x18, 0xe1, 0x26,
+	0xf5, 0x5a, 0xe5, 0x8f, 0xcd, 0x49, 0x38, 0x1c, 0x88, 0x91, 0xab, 0xa6, 0x84, 0x95, 0x4f, 0xd8,
+	0x8d, 0x6a, 0xa9, 0x46, 0x8d, 0xe7, 0x8f, 0x28, 0xeb, 0x25, 0xe6, 0x4f, 0x0d, 0xd3, 0x9d, 0x3f,
+	0x35, 0x84, 0xd2, 0xbb, 0x78, 0xb1, 0xc2, 0x8c, 0xb1, 0xa8, 0x75, 0x09, 0xf7, 0xbe, 0xbc, 0x83,
+	0x8f, 0xc8, 0xbb, 0xf8, 0xed, 0x09, 0x38, 0x24, 0x76, 0x44, 0x9f, 0x01, 0x38, 0x1c, 0x6a, 0x2d,
+	0xb4, 0x90, 0x13, 0x31, 0x2d, 0xf2, 0xea, 0x5a, 0x59, 0xf3, 0x30, 0x0d, 0x7c, 0xe6, 0xa3, 0xdf,
+	0xfe, 0xfa, 0xfc, 0xc8, 0x69, 0x34, 0xad, 0x17, 0x09, 0x6d, 0xf4, 0x25, 0x80, 0xc7, 0x95, 0xab,
+	0x60, 0xc3, 0x71, 0xd0, 0x72, 0xd1, 0x6e, 0x99, 0x5a, 0xab, 0xbe, 0x52, 0xd5, 0x4d, 0xc2, 0x6a,
+	0x02, 0x76, 0x1e, 0xcd, 0xea, 0xa5, 0xd4, 0x3e, 0xba, 0x0f, 0xe0, 0xa8, 0x12, 0xa7, 0x18, 0x37,
+	0x53, 0xbd, 0x16, 0xe3, 0x66, 0x0b, 0x58, 0xbc, 0x22, 0x70, 0xcf, 0x21, 0xad, 0x1c, 0xae, 0x7e,
+	0x4f, 0xc8, 0xe2, 0x3d, 0xf4, 0x2b, 0x80, 0x27, 0xb3, 0x65, 0x11, 0x5a, 0x2f, 0xac, 0x5c, 0x9e,
+	0x7a, 0xab, 0x5f, 0x3c, 0xac, 0xbb, 0xcc, 0x68, 0x41, 0x64, 0x34, 0x87, 0x66, 0x72, 0x32, 0x22,
+	0x51, 0x88, 0x60, 0x62, 0x9e, 0xe8, 0xd5, 0x3f, 0x68, 0xb5, 0x88, 0xa1, 0x8f, 0xa2, 0xaa, 0xaf,
+	0x55, 0x77, 0x94, 0xd8, 0xf3, 0x02, 0x1b, 0xa3, 0xa9, 0x1c, 0x6c, 0x71, 0x8f, 0xa3, 0x1f, 0x93,
+	0xc4, 0xe2, 0x5e, 0xaf, 0x44, 0xac, 0x2a, 0x8c, 0x4a, 0xc4, 0x09, 0x09, 0x51, 0x6a, 0x74, 0x02,
+	0x71, 0xc0, 0x83, 0xa1, 0x89, 0x96, 0xf6, 0xd0, 0xf7, 0x00, 0x8e, 0xa9, 0x9a, 0x04, 0x2d, 0x15,
+	0x76, 0x3c, 0xad, 0x8c, 0xea, 0x2f, 0x54, 0x73, 0x92, 0xcc, 0x97, 0x04, 0xf3, 0x4b, 0x68, 0xad,
+	0x1a, 0xb3, 0x7e, 0x4f, 0x8a, 0xaa, 0x3d, 0xf4, 0x1d, 0x80, 0x63, 0xaa, 0xaa, 0x28, 0xa6, 0xcf,
+	0x50, 0x42, 0xc5, 0xf4, 0x59, 0xfa, 0x07, 0x5f, 0x14, 0xf4, 0x6b, 0x68, 0x45, 0x2f, 0xf9, 0x6f,
+	0x7f, 0x4f, 0xe5, 0xbf, 0x06, 0x70, 0x54, 0x51, 0x23, 0x68, 0xb1, 0x88, 0x22, 0xad, 0x7b, 0xea,
+	0x4b, 0x95, 0x7c, 0x24, 0xf8, 0xba, 0x00, 0x5f, 0x45, 0xcb, 0x7a, 0xa9, 0x17, 0x10, 0xbd, 0x13,
+	0xf3, 0x03, 0x80, 0xc7, 0x12, 0x72, 0x00, 0x95, 0xe9, 0x7e, 0x4a, 0x0b, 0xd5, 0x97, 0x2b, 0x7a,
+	0x55, 0x28, 0x7b, 0xe2, 0x8d, 0x48, 0x0f, 0xfe, 0x2f, 0x62, 0xe0, 0xe3, 0x4b, 0xb4, 0xcc, 0xc0,
+	0xa7, 0x34, 0x4d, 0x99, 0x81, 0x4f, 0x4b, 0x16, 0xfc, 0x9a, 0x60, 0xdf, 0x44, 0x97, 0xf4, 0x92,
+	0xaf, 0x67, 0x52, 0x93, 0x1f, 0x0a, 0xa3, 0xbd, 0xe0, 0x67, 0xe7, 0x58, 0x42, 0x66, 0xa0, 0x4a,
+	0x44, 0xbc, 0x42, 0x13, 0x32, 0xb4, 0x0c, 0x7e, 0x45, 0x24, 0xf2, 0x22, 0x5a, 0x3d, 0x64, 0x22,
+	0x9b, 0x17, 0x1e, 0xec, 0x37, 0xc0, 0xc3, 0xfd, 0x06, 0xf8, 0x73, 0xbf, 0x01, 0x3e, 0x3d, 0x68,
+	0x0c, 0x3c, 0x3c, 0x68, 0x0c, 0xfc, 0x7e, 0xd0, 0x18, 0x78, 0x67, 0x3a, 0x19, 0xf1, 0x6e, 0x22,
+	0xa6, 0xbf, 0xdb, 0xa6, 0x7c, 0x67, 0x58, 0xbc, 0xb4, 0x5a, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff,
+	0xe6, 0xe9, 0x7e, 0x14, 0xa0, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Institution items.
+	InstitutionAll(ctx context.Context, in *QueryAllInstitutionRequest, opts ...grpc.CallOption) (*QueryAllInstitutionResponse, error)
+	// Queries a Institution by index.
+	Institution(ctx context.Context, in *QueryGetInstitutionRequest, opts ...grpc.CallOption) (*QueryGetInstitutionResponse, error)
+	// Queries authorized institutions only.
+	AuthorizedInstitutions(ctx context.Context, in *QueryAuthorizedInstitutionsRequest, opts ...grpc.CallOption) (*QueryAuthorizedInstitutionsResponse, error)
+	// Queries institution count.
+	InstitutionCount(ctx context.Context, in *QueryInstitutionCountRequest, opts ...grpc.CallOption) (*QueryInstitutionCountResponse, error)
+	// Queries the role assignments of an institution.
+	InstitutionRoles(ctx context.Context, in *QueryInstitutionRolesRequest, opts ...grpc.CallOption) (*QueryInstitutionRolesResponse, error)
+	// Queries the role assignments held by an account in an institution.
+	AccountRoles(ctx context.Context, in *QueryAccountRolesRequest, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error)
+	// Queries the grading scale of an institution. Institutions without a
+	// configured scale report the default 0-100 scale.
+	GradingScale(ctx context.Context, in *QueryGradingScaleRequest, opts ...grpc.CallOption) (*QueryGradingScaleResponse, error)
+	// Queries the signing keys registered by an institution, revoked ones included.
+	SigningKeys(ctx context.Context, in *QuerySigningKeysRequest, opts ...grpc.CallOption) (*QuerySigningKeysResponse, error)
+	// Queries the accreditation status and latest accreditation proposal of an institution.
+	Accreditation(ctx context.Context, in *QueryAccreditationRequest, opts ...grpc.CallOption) (*QueryAccreditationResponse, error)
+	// Queries a term of an institution's academic calendar.
+	AcademicTerm(ctx context.Context, in *QueryAcademicTermRequest, opts ...grpc.CallOption) (*QueryAcademicTermResponse, error)
+	// Queries the academic calendar of an institution.
+	AcademicTerms(ctx context.Context, in *QueryAcademicTermsRequest, opts ...grpc.CallOption) (*QueryAcademicTermsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllInstitutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) InstitutionAll(ctx context.Context, in *QueryAllInstitutionRequest, opts ...grpc.CallOption) (*QueryAllInstitutionResponse, error) {
+	out := new(QueryAllInstitutionResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/InstitutionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Institution) > 0 {
-		for _, e := range m.Institution {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) Institution(ctx context.Context, in *QueryGetInstitutionRequest, opts ...grpc.CallOption) (*QueryGetInstitutionResponse, error) {
+	out := new(QueryGetInstitutionResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/Institution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AuthorizedInstitutions(ctx context.Context, in *QueryAuthorizedInstitutionsRequest, opts ...grpc.CallOption) (*QueryAuthorizedInstitutionsResponse, error) {
+	out := new(QueryAuthorizedInstitutionsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/AuthorizedInstitutions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAuthorizedInstitutionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) InstitutionCount(ctx context.Context, in *QueryInstitutionCountRequest, opts ...grpc.CallOption) (*QueryInstitutionCountResponse, error) {
+	out := new(QueryInstitutionCountResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/InstitutionCount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) InstitutionRoles(ctx context.Context, in *QueryInstitutionRolesRequest, opts ...grpc.CallOption) (*QueryInstitutionRolesResponse, error) {
+	out := new(QueryInstitutionRolesResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/InstitutionRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAuthorizedInstitutionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AccountRoles(ctx context.Context, in *QueryAccountRolesRequest, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error) {
+	out := new(QueryAccountRolesResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/AccountRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Institution) > 0 {
-		for _, e := range m.Institution {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) GradingScale(ctx context.Context, in *QueryGradingScaleRequest, opts ...grpc.CallOption) (*QueryGradingScaleResponse, error) {
+	out := new(QueryGradingScaleResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/GradingScale", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) SigningKeys(ctx context.Context, in *QuerySigningKeysRequest, opts ...grpc.CallOption) (*QuerySigningKeysResponse, error) {
+	out := new(QuerySigningKeysResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/SigningKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryInstitutionCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Accreditation(ctx context.Context, in *QueryAccreditationRequest, opts ...grpc.CallOption) (*QueryAccreditationResponse, error) {
+	out := new(QueryAccreditationResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/Accreditation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryInstitutionCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AcademicTerm(ctx context.Context, in *QueryAcademicTermRequest, opts ...grpc.CallOption) (*QueryAcademicTermResponse, error) {
+	out := new(QueryAcademicTermResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/AcademicTerm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+	return out, nil
+}
+
+func (c *queryClient) AcademicTerms(ctx context.Context, in *QueryAcademicTermsRequest, opts ...grpc.CallOption) (*QueryAcademicTermsResponse, error) {
+	out := new(QueryAcademicTermsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.institution.Query/AcademicTerms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Institution items.
+	InstitutionAll(context.Context, *QueryAllInstitutionRequest) (*QueryAllInstitutionResponse, error)
+	// Queries a Institution by index.
+	Institution(context.Context, *QueryGetInstitutionRequest) (*QueryGetInstitutionResponse, error)
+	// Queries authorized institutions only.
+	AuthorizedInstitutions(context.Context, *QueryAuthorizedInstitutionsRequest) (*QueryAuthorizedInstitutionsResponse, error)
+	// Queries institution count.
+	InstitutionCount(context.Context, *QueryInstitutionCountRequest) (*QueryInstitutionCountResponse, error)
+	// Queries the role assignments of an institution.
+	InstitutionRoles(context.Context, *QueryInstitutionRolesRequest) (*QueryInstitutionRolesResponse, error)
+	// Queries the role assignments held by an account in an institution.
+	AccountRoles(context.Context, *QueryAccountRolesRequest) (*QueryAccountRolesResponse, error)
+	// Queries the grading scale of an institution. Institutions without a
+	// configured scale report the default 0-100 scale.
+	GradingScale(context.Context, *QueryGradingScaleRequest) (*QueryGradingScaleResponse, error)
+	// Queries the signing keys registered by an institution, revoked ones included.
+	SigningKeys(context.Context, *QuerySigningKeysRequest) (*QuerySigningKeysResponse, error)
+	// Queries the accreditation status and latest accreditation proposal of an institution.
+	Accreditation(context.Context, *QueryAccreditationRequest) (*QueryAccreditationResponse, error)
+	// Queries a term of an institution's academic calendar.
+	AcademicTerm(context.Context, *QueryAcademicTermRequest) (*QueryAcademicTermResponse, error)
+	// Queries the academic calendar of an institution.
+	AcademicTerms(context.Context, *QueryAcademicTermsRequest) (*QueryAcademicTermsResponse, error)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) InstitutionAll(ctx context.Context, req *QueryAllInstitutionRequest) (*QueryAllInstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstitutionAll not implemented")
+}
+func (*UnimplementedQueryServer) Institution(ctx context.Context, req *QueryGetInstitutionRequest) (*QueryGetInstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Institution not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizedInstitutions(ctx context.Context, req *QueryAuthorizedInstitutionsRequest) (*QueryAuthorizedInstitutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedInstitutions not implemented")
+}
+func (*UnimplementedQueryServer) InstitutionCount(ctx context.Context, req *QueryInstitutionCountRequest) (*QueryInstitutionCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstitutionCount not implemented")
+}
+func (*UnimplementedQueryServer) InstitutionRoles(ctx context.Context, req *QueryInstitutionRolesRequest) (*QueryInstitutionRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstitutionRoles not implemented")
+}
+func (*UnimplementedQueryServer) AccountRoles(ctx context.Context, req *QueryAccountRolesRequest) (*QueryAccountRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRoles not implemented")
+}
+func (*UnimplementedQueryServer) GradingScale(ctx context.Context, req *QueryGradingScaleRequest) (*QueryGradingScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradingScale not implemented")
+}
+func (*UnimplementedQueryServer) SigningKeys(ctx context.Context, req *QuerySigningKeysRequest) (*QuerySigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningKeys not implemented")
+}
+func (*UnimplementedQueryServer) Accreditation(ctx context.Context, req *QueryAccreditationRequest) (*QueryAccreditationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accreditation not implemented")
+}
+func (*UnimplementedQueryServer) AcademicTerm(ctx context.Context, req *QueryAcademicTermRequest) (*QueryAcademicTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcademicTerm not implemented")
+}
+func (*UnimplementedQueryServer) AcademicTerms(ctx context.Context, req *QueryAcademicTermsRequest) (*QueryAcademicTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcademicTerms not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InstitutionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInstitutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InstitutionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/InstitutionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InstitutionAll(ctx, req.(*QueryAllInstitutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Institution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetInstitutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Institution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/Institution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Institution(ctx, req.(*QueryGetInstitutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthorizedInstitutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthorizedInstitutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizedInstitutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/AuthorizedInstitutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizedInstitutions(ctx, req.(*QueryAuthorizedInstitutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InstitutionCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInstitutionCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InstitutionCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/InstitutionCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InstitutionCount(ctx, req.(*QueryInstitutionCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InstitutionRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInstitutionRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InstitutionRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/InstitutionRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InstitutionRoles(ctx, req.(*QueryInstitutionRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/AccountRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRoles(ctx, req.(*QueryAccountRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGradingScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/GradingScale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GradingScale(ctx, req.(*QueryGradingScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/SigningKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningKeys(ctx, req.(*QuerySigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Accreditation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccreditationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accreditation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/Accreditation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accreditation(ctx, req.(*QueryAccreditationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AcademicTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcademicTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcademicTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/AcademicTerm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcademicTerm(ctx, req.(*QueryAcademicTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AcademicTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcademicTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcademicTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.institution.Query/AcademicTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcademicTerms(ctx, req.(*QueryAcademicTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "academictoken.institution.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "InstitutionAll",
+			Handler:    _Query_InstitutionAll_Handler,
+		},
+		{
+			MethodName: "Institution",
+			Handler:    _Query_Institution_Handler,
+		},
+		{
+			MethodName: "AuthorizedInstitutions",
+			Handler:    _Query_AuthorizedInstitutions_Handler,
+		},
+		{
+			MethodName: "InstitutionCount",
+			Handler:    _Query_InstitutionCount_Handler,
+		},
+		{
+			MethodName: "InstitutionRoles",
+			Handler:    _Query_InstitutionRoles_Handler,
+		},
+		{
+			MethodName: "AccountRoles",
+			Handler:    _Query_AccountRoles_Handler,
+		},
+		{
+			MethodName: "GradingScale",
+			Handler:    _Query_GradingScale_Handler,
+		},
+		{
+			MethodName: "SigningKeys",
+			Handler:    _Query_SigningKeys_Handler,
+		},
+		{
+			MethodName: "Accreditation",
+			Handler:    _Query_Accreditation_Handler,
+		},
+		{
+			MethodName: "AcademicTerm",
+			Handler:    _Query_AcademicTerm_Handler,
+		},
+		{
+			MethodName: "AcademicTerms",
+			Handler:    _Query_AcademicTerms_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "academictoken/institution/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetInstitutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetInstitutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetInstitutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetInstitutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetInstitutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetInstitutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Institution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInstitutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInstitutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInstitutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInstitutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInstitutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInstitutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		for iNdEx := len(m.Institution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Institution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorizedInstitutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorizedInstitutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizedInstitutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorizedInstitutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorizedInstitutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizedInstitutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		for iNdEx := len(m.Institution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Institution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstitutionCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstitutionCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstitutionCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstitutionCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstitutionCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstitutionCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstitutionRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstitutionRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstitutionRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInstitutionRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInstitutionRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInstitutionRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoleAssignment) > 0 {
+		for iNdEx := len(m.RoleAssignment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleAssignment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoleAssignment) > 0 {
+		for iNdEx := len(m.RoleAssignment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleAssignment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGradingScaleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGradingScaleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGradingScaleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGradingScaleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGradingScaleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGradingScaleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GradingScale.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigningKey) > 0 {
+		for iNdEx := len(m.SigningKey) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningKey[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccreditationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccreditationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccreditationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccreditationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccreditationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccreditationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccreditedUntil) > 0 {
+		i -= len(m.AccreditedUntil)
+		copy(dAtA[i:], m.AccreditedUntil)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccreditedUntil)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Accredited {
+		i--
+		if m.Accredited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcademicTermRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcademicTermRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcademicTermRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TermId) > 0 {
+		i -= len(m.TermId)
+		copy(dAtA[i:], m.TermId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TermId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcademicTermResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcademicTermResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcademicTermResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AcademicTerm.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcademicTermsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcademicTermsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcademicTermsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcademicTermsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcademicTermsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcademicTermsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AcademicTerm) > 0 {
+		for iNdEx := len(m.AcademicTerm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcademicTerm[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetInstitutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Institution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllInstitutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Institution) > 0 {
+		for _, e := range m.Institution {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuthorizedInstitutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuthorizedInstitutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Institution) > 0 {
+		for _, e := range m.Institution {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInstitutionCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInstitutionCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryInstitutionRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInstitutionRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleAssignment) > 0 {
+		for _, e := range m.RoleAssignment {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleAssignment) > 0 {
+		for _, e := range m.RoleAssignment {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGradingScaleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGradingScaleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GradingScale.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsDefault {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySigningKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningKey) > 0 {
+		for _, e := range m.SigningKey {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccreditationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccreditationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accredited {
+		n += 2
+	}
+	l = len(m.AccreditedUntil)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAcademicTermRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TermId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAcademicTermResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AcademicTerm.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAcademicTermsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAcademicTermsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AcademicTerm) > 0 {
+		for _, e := range m.AcademicTerm {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInstitutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInstitutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInstitutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInstitutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInstitutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInstitutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Institution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInstitutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInstitutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInstitutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInstitutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInstitutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInstitutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = append(m.Institution, Institution{})
+			if err := m.Institution[len(m.Institution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorizedInstitutionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorizedInstitutionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorizedInstitutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorizedInstitutionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorizedInstitutionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorizedInstitutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = append(m.Institution, Institution{})
+			if err := m.Institution[len(m.Institution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstitutionCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstitutionCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstitutionCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstitutionCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstitutionCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstitutionCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstitutionRolesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstitutionRolesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstitutionRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInstitutionRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInstitutionRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInstitutionRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleAssignment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleAssignment = append(m.RoleAssignment, RoleAssignment{})
+			if err := m.RoleAssignment[len(m.RoleAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRolesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRolesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleAssignment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleAssignment = append(m.RoleAssignment, RoleAssignment{})
+			if err := m.RoleAssignment[len(m.RoleAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGradingScaleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGradingScaleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGradingScaleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1312,7 +4349,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGradingScaleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,15 +4372,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGradingScaleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGradingScaleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GradingScale", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1370,10 +4407,30 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GradingScale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefault = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1395,7 +4452,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInstitutionRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1418,15 +4475,15 @@ func (m *QueryGetInstitutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInstitutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInstitutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1454,7 +4511,7 @@ func (m *QueryGetInstitutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Institution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1477,7 +4534,7 @@ func (m *QueryGetInstitutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInstitutionResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1500,15 +4557,15 @@ func (m *QueryGetInstitutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInstitutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInstitutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1535,7 +4592,8 @@ func (m *QueryGetInstitutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Institution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SigningKey = append(m.SigningKey, SigningKey{})
+			if err := m.SigningKey[len(m.SigningKey)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1560,7 +4618,7 @@ func (m *QueryGetInstitutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInstitutionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccreditationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1583,17 +4641,17 @@ func (m *QueryAllInstitutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInstitutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccreditationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInstitutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccreditationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1603,27 +4661,23 @@ func (m *QueryAllInstitutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1646,7 +4700,7 @@ func (m *QueryAllInstitutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInstitutionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccreditationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1669,17 +4723,37 @@ func (m *QueryAllInstitutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInstitutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccreditationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInstitutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccreditationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accredited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accredited = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditedUntil", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1689,29 +4763,27 @@ func (m *QueryAllInstitutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Institution = append(m.Institution, Institution{})
-			if err := m.Institution[len(m.Institution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AccreditedUntil = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1738,10 +4810,10 @@ func (m *QueryAllInstitutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Proposal == nil {
+				m.Proposal = &AccreditationProposal{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1766,7 +4838,7 @@ func (m *QueryAllInstitutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuthorizedInstitutionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAcademicTermRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1789,17 +4861,17 @@ func (m *QueryAuthorizedInstitutionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuthorizedInstitutionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAcademicTermRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuthorizedInstitutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAcademicTermRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1809,27 +4881,55 @@ func (m *QueryAuthorizedInstitutionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TermId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1852,7 +4952,7 @@ func (m *QueryAuthorizedInstitutionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuthorizedInstitutionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAcademicTermResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1875,49 +4975,15 @@ func (m *QueryAuthorizedInstitutionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuthorizedInstitutionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAcademicTermResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuthorizedInstitutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAcademicTermResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Institution = append(m.Institution, Institution{})
-			if err := m.Institution[len(m.Institution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcademicTerm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1944,10 +5010,7 @@ func (m *QueryAuthorizedInstitutionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AcademicTerm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1972,7 +5035,7 @@ func (m *QueryAuthorizedInstitutionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInstitutionCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAcademicTermsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1995,12 +5058,44 @@ func (m *QueryInstitutionCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInstitutionCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAcademicTermsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInstitutionCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAcademicTermsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2022,7 +5117,7 @@ func (m *QueryInstitutionCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInstitutionCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAcademicTermsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2045,17 +5140,17 @@ func (m *QueryInstitutionCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInstitutionCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAcademicTermsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInstitutionCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAcademicTermsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcademicTerm", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2065,11 +5160,26 @@ func (m *QueryInstitutionCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcademicTerm = append(m.AcademicTerm, AcademicTerm{})
+			if err := m.AcademicTerm[len(m.AcademicTerm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
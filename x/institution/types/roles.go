@@ -0,0 +1,44 @@
+package types
+
+// Institution roles
+const (
+	RoleInstitutionAdmin = "institution_admin"
+	RoleRegistrar        = "registrar"
+	RoleProfessor        = "professor"
+	RoleAdvisor          = "advisor"
+)
+
+// IsValidRole checks if a role is one of the known institution roles
+func IsValidRole(role string) bool {
+	return role == RoleInstitutionAdmin ||
+		role == RoleRegistrar ||
+		role == RoleProfessor ||
+		role == RoleAdvisor
+}
+
+// Matches reports whether the assignment grants role for the given course and
+// subject. Empty scope fields on the assignment match any course or subject.
+func (r RoleAssignment) Matches(role string, courseId string, subjectId string) bool {
+	if r.Role != role {
+		return false
+	}
+	if r.CourseId != "" && r.CourseId != courseId {
+		return false
+	}
+	if r.SubjectId != "" && r.SubjectId != subjectId {
+		return false
+	}
+	return true
+}
+
+// ValidateRoleScope checks the role name and that institution admins are not
+// restricted to a course or subject
+func ValidateRoleScope(role string, courseId string, subjectId string) error {
+	if !IsValidRole(role) {
+		return ErrInvalidRole.Wrapf("unknown role '%s'", role)
+	}
+	if role == RoleInstitutionAdmin && (courseId != "" || subjectId != "") {
+		return ErrInvalidRole.Wrap("institution admin role cannot be scoped to a course or subject")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Signing key algorithms. secp256k1 is the algorithm of the keys held in the
+// chain keyring, so records can be signed with the usual CLI tooling.
+const (
+	SigningAlgorithmSecp256k1 = "secp256k1"
+	SigningAlgorithmEd25519   = "ed25519"
+)
+
+// IsValidSigningAlgorithm checks if an algorithm is supported for signing keys
+func IsValidSigningAlgorithm(algorithm string) bool {
+	return algorithm == SigningAlgorithmSecp256k1 || algorithm == SigningAlgorithmEd25519
+}
+
+// Validate checks the identifiers, owner and public key of a signing key
+func (key SigningKey) Validate() error {
+	if key.Institution == "" || key.KeyId == "" {
+		return ErrInvalidSigningKey.Wrap("institution and key ID cannot be empty")
+	}
+	if strings.Contains(key.Institution, "/") || strings.Contains(key.KeyId, "/") {
+		return ErrInvalidSigningKey.Wrap("institution and key IDs cannot contain '/'")
+	}
+	if _, err := sdk.AccAddressFromBech32(key.Owner); err != nil {
+		return ErrInvalidSigningKey.Wrapf("invalid owner address (%s)", err)
+	}
+	if _, err := key.PublicKey(); err != nil {
+		return err
+	}
+	if _, err := time.Parse(time.RFC3339, key.RegisteredAt); err != nil {
+		return ErrInvalidSigningKey.Wrapf("invalid registration time '%s'", key.RegisteredAt)
+	}
+	if key.RevokedAt != "" {
+		if _, err := time.Parse(time.RFC3339, key.RevokedAt); err != nil {
+			return ErrInvalidSigningKey.Wrapf("invalid revocation time '%s'", key.RevokedAt)
+		}
+	}
+	return nil
+}
+
+// PublicKey decodes the public key of a signing key
+func (key SigningKey) PublicKey() (cryptotypes.PubKey, error) {
+	switch key.Algorithm {
+	case SigningAlgorithmSecp256k1:
+		if len(key.PubKey) != secp256k1.PubKeySize {
+			return nil, ErrInvalidSigningKey.Wrapf("secp256k1 public keys are %d bytes, got %d", secp256k1.PubKeySize, len(key.PubKey))
+		}
+		return &secp256k1.PubKey{Key: key.PubKey}, nil
+	case SigningAlgorithmEd25519:
+		if len(key.PubKey) != ed25519.PubKeySize {
+			return nil, ErrInvalidSigningKey.Wrapf("ed25519 public keys are %d bytes, got %d", ed25519.PubKeySize, len(key.PubKey))
+		}
+		return &ed25519.PubKey{Key: key.PubKey}, nil
+	default:
+		return nil, ErrInvalidSigningKey.Wrapf("unsupported algorithm '%s'", key.Algorithm)
+	}
+}
+
+// IsActive reports whether the key can still sign new records
+func (key SigningKey) IsActive() bool {
+	return key.RevokedAt == ""
+}
+
+// ValidAt reports whether the key was registered and not yet revoked at t
+func (key SigningKey) ValidAt(t time.Time) bool {
+	registeredAt, err := time.Parse(time.RFC3339, key.RegisteredAt)
+	if err != nil || t.Before(registeredAt) {
+		return false
+	}
+	if key.RevokedAt == "" {
+		return true
+	}
+	revokedAt, err := time.Parse(time.RFC3339, key.RevokedAt)
+	return err == nil && t.Before(revokedAt)
+}
+
+// VerifySignature checks a signature over msg against the key
+func (key SigningKey) VerifySignature(msg []byte, sig []byte) bool {
+	pubKey, err := key.PublicKey()
+	if err != nil {
+		return false
+	}
+	return pubKey.VerifySignature(msg, sig)
+}
@@ -0,0 +1,685 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: academictoken/institution/signing_key.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SigningKey is a public key registered by an institution for a professor or
+// registrar to sign the records they issue. Keys are never deleted: revoked
+// keys are kept so that records signed before the revocation stay verifiable.
+type SigningKey struct {
+	Institution  string `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	KeyId        string `protobuf:"bytes,2,opt,name=keyId,proto3" json:"keyId,omitempty"`
+	Owner        string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Algorithm    string `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	PubKey       []byte `protobuf:"bytes,5,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	RegisteredBy string `protobuf:"bytes,6,opt,name=registeredBy,proto3" json:"registeredBy,omitempty"`
+	RegisteredAt string `protobuf:"bytes,7,opt,name=registeredAt,proto3" json:"registeredAt,omitempty"`
+	RevokedAt    string `protobuf:"bytes,8,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
+}
+
+func (m *SigningKey) Reset()         { *m = SigningKey{} }
+func (m *SigningKey) String() string { return proto.CompactTextString(m) }
+func (*SigningKey) ProtoMessage()    {}
+func (*SigningKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78f73970f7517159, []int{0}
+}
+func (m *SigningKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningKey.Merge(m, src)
+}
+func (m *SigningKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningKey proto.InternalMessageInfo
+
+func (m *SigningKey) GetInstitution() string {
+	if m != nil {
+		return m.Institution
+	}
+	return ""
+}
+
+func (m *SigningKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *SigningKey) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *SigningKey) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *SigningKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SigningKey) GetRegisteredBy() string {
+	if m != nil {
+		return m.RegisteredBy
+	}
+	return ""
+}
+
+func (m *SigningKey) GetRegisteredAt() string {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return ""
+}
+
+func (m *SigningKey) GetRevokedAt() string {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SigningKey)(nil), "academictoken.institution.SigningKey")
+}
+
+func init() {
+	proto.RegisterFile("academictoken/institution/signing_key.proto", fileDescriptor_78f73970f7517159)
+}
+
+var fileDescriptor_78f73970f7517159 = []byte{
+	// 259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0xa0, 0x81, 0x9a, 0x4e, 0x16, 0x42, 0x46, 0x42, 0x56, 0xe8, 0x54, 0x09, 0xa9,
+	0x1d, 0x18, 0x99, 0xda, 0x0d, 0x75, 0x2b, 0x1b, 0x0b, 0x4a, 0x9b, 0x53, 0x38, 0x85, 0xda, 0x91,
+	0x73, 0x05, 0xfc, 0x16, 0x3c, 0x16, 0x63, 0x47, 0x46, 0x94, 0xbc, 0x03, 0x33, 0xaa, 0x83, 0x20,
+	0xee, 0xf8, 0x7f, 0xff, 0x77, 0x3a, 0xe9, 0xe7, 0xd7, 0xe9, 0x2a, 0xcd, 0x60, 0x8d, 0x2b, 0x32,
+	0x05, 0xe8, 0x09, 0xea, 0x8a, 0x90, 0x36, 0x84, 0x46, 0x4f, 0x2a, 0xcc, 0x35, 0xea, 0xfc, 0xb1,
+	0x00, 0x37, 0x2e, 0xad, 0x21, 0x23, 0x2e, 0x02, 0x79, 0xdc, 0x91, 0x87, 0xdf, 0x8c, 0xf3, 0xfb,
+	0xf6, 0x60, 0x0e, 0x4e, 0x24, 0xfc, 0xb4, 0xd3, 0x4a, 0x96, 0xb0, 0x51, 0x7f, 0xd1, 0x45, 0xe2,
+	0x8c, 0xf7, 0x0a, 0x70, 0x77, 0x99, 0x3c, 0xf0, 0x5d, 0x1b, 0x76, 0xd4, 0xbc, 0x6a, 0xb0, 0xf2,
+	0xb0, 0xa5, 0x3e, 0x88, 0x4b, 0xde, 0x4f, 0x9f, 0x73, 0x63, 0x91, 0x9e, 0xd6, 0xf2, 0xc8, 0x37,
+	0xff, 0x40, 0x9c, 0xf3, 0xb8, 0xdc, 0x2c, 0xe7, 0xe0, 0x64, 0x2f, 0x61, 0xa3, 0xc1, 0xe2, 0x37,
+	0x89, 0x21, 0x1f, 0x58, 0xc8, 0xb1, 0x22, 0xb0, 0x90, 0xcd, 0x9c, 0x8c, 0xfd, 0x61, 0xc0, 0x42,
+	0x67, 0x4a, 0xf2, 0x78, 0xdf, 0x99, 0xd2, 0xee, 0xbb, 0x85, 0x17, 0x53, 0x78, 0xe1, 0xa4, 0xfd,
+	0xfe, 0x07, 0x66, 0xb7, 0x1f, 0xb5, 0x62, 0xdb, 0x5a, 0xb1, 0xaf, 0x5a, 0xb1, 0xf7, 0x46, 0x45,
+	0xdb, 0x46, 0x45, 0x9f, 0x8d, 0x8a, 0x1e, 0xae, 0xc2, 0x69, 0xdf, 0x82, 0x71, 0xc9, 0x95, 0x50,
+	0x2d, 0x63, 0xbf, 0xeb, 0xcd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xbb, 0x64, 0xb9, 0x86,
+	0x01, 0x00, 0x00,
+}
+
+func (m *SigningKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevokedAt) > 0 {
+		i -= len(m.RevokedAt)
+		copy(dAtA[i:], m.RevokedAt)
+		i = encodeVarintSigningKey(dAtA, i, uint64(len(m.RevokedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RegisteredAt) > 0 {
+		i -= len(m.RegisteredAt)
+		copy(dAtA[i:], m.RegisteredAt)
+		i = encodeVarintSigningKey(dAtA, i, uint64(len(m.RegisteredAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RegisteredBy) > 0 {
+		i -= len(m.RegisteredBy)
+		copy(dAtA[i:], m.RegisteredBy)
+		i = encodeVarintSigningKey(dAtA, i, uint64(len(m.RegisteredBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintSigningKey(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Algorithm) > 0 {
+		i -= len(m.Algorithm)
+		copy(dAtA[i:], m.Algorithm)
+		i = encodeVarintSigningKey(dAtA, i, uint64(len(m.Algorithm)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSigningKey(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintSigningKey(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintSigningKey(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigningKey(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigningKey(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SigningKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovSigningKey(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovSigningKey(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSigningKey(uint64(l))
+	}
+	l = len(m.Algorithm)
+	if l > 0 {
+		n += 1 + l + sovSigningKey(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovSigningKey(uint64(l))
+	}
+	l = len(m.RegisteredBy)
+	if l > 0 {
+		n += 1 + l + sovSigningKey(uint64(l))
+	}
+	l = len(m.RegisteredAt)
+	if l > 0 {
+		n += 1 + l + sovSigningKey(uint64(l))
+	}
+	l = len(m.RevokedAt)
+	if l > 0 {
+		n += 1 + l + sovSigningKey(uint64(l))
+	}
+	return n
+}
+
+func sovSigningKey(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigningKey(x uint64) (n int) {
+	return sovSigningKey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SigningKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigningKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigningKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigningKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigningKey(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigningKey
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigningKey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigningKey
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigningKey
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigningKey
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigningKey        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigningKey          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigningKey = fmt.Errorf("proto: unexpected end of group")
+)
@@ -76,13 +76,13 @@ func (k Keeper) Logger() log.Logger {
 // CreateStudyPlan creates a new study plan for a student
 func (k Keeper) CreateStudyPlan(ctx sdk.Context, msg *types.MsgCreateStudyPlan) (string, error) {
 	// Generate unique study plan ID
-	studyPlanId := fmt.Sprintf("sp_%s_%d", msg.Student, time.Now().Unix())
+	studyPlanId := fmt.Sprintf("sp_%s_%d", msg.Student, ctx.BlockTime().Unix())
 
 	// Create the study plan with only fields that exist in proto
 	studyPlan := types.StudyPlan{
 		Index:            studyPlanId,
 		Student:          msg.Student,
-		CreationDate:     time.Now().Format(time.RFC3339),
+		CreationDate:     ctx.BlockTime().UTC().Format(time.RFC3339),
 		CompletionTarget: msg.CompletionTarget,
 		AdditionalNotes:  msg.AdditionalNotes,
 		Status:           "draft",
@@ -139,7 +139,7 @@ func (k Keeper) AddPlannedSemester(ctx sdk.Context, msg *types.MsgAddPlannedSeme
 // CreateSubjectRecommendation creates subject recommendations for a student
 func (k Keeper) CreateSubjectRecommendation(ctx sdk.Context, msg *types.MsgCreateSubjectRecommendation) (string, error) {
 	// Generate unique recommendation ID
-	recommendationId := fmt.Sprintf("sr_%s_%d", msg.Student, time.Now().Unix())
+	recommendationId := fmt.Sprintf("sr_%s_%d", msg.Student, ctx.BlockTime().Unix())
 
 	// Create recommendation subjects with only proto fields
 	var recommendedSubjects []*types.RecommendedSubject
@@ -163,7 +163,7 @@ func (k Keeper) CreateSubjectRecommendation(ctx sdk.Context, msg *types.MsgCreat
 		Student:                msg.Student,
 		RecommendationSemester: msg.RecommendationSemester,
 		RecommendationMetadata: msg.RecommendationMetadata,
-		GeneratedDate:          time.Now().Format(time.RFC3339),
+		GeneratedDate:          ctx.BlockTime().UTC().Format(time.RFC3339),
 		RecommendedSubjects:    recommendedSubjects,
 	}
 
@@ -173,24 +173,24 @@ func (k Keeper) CreateSubjectRecommendation(ctx sdk.Context, msg *types.MsgCreat
 	return recommendationId, nil
 }
 
-// BuildRecommendations generates automatic subject recommendations for a student (internal logic)
+// BuildRecommendations generates subject recommendations for a student from
+// the first semester of the critical-path plan (internal logic)
 func (k Keeper) BuildRecommendations(ctx sdk.Context, studentId string, semesterCode string) ([]types.SubjectRecommendation, error) {
-	var recommendations []types.SubjectRecommendation
-
-	// Simple recommendation generation
-	recommendationId := fmt.Sprintf("sr_%s_%d", studentId, time.Now().Unix())
+	plan, err := k.BuildCurriculumPlan(ctx, studentId)
+	if err != nil {
+		return nil, err
+	}
 
 	recommendation := types.SubjectRecommendation{
-		Index:                  recommendationId,
+		Index:                  fmt.Sprintf("sr_%s_%d", studentId, ctx.BlockTime().Unix()),
 		Student:                studentId,
 		RecommendationSemester: semesterCode,
-		RecommendationMetadata: "auto-generated",
-		GeneratedDate:          time.Now().Format(time.RFC3339),
-		RecommendedSubjects:    []*types.RecommendedSubject{},
+		RecommendationMetadata: plan.Metadata(),
+		GeneratedDate:          ctx.BlockTime().UTC().Format(time.RFC3339),
+		RecommendedSubjects:    plan.Recommendations(),
 	}
 
-	recommendations = append(recommendations, recommendation)
-	return recommendations, nil
+	return []types.SubjectRecommendation{recommendation}, nil
 }
 
 // ============================================================================
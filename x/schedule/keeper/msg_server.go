@@ -32,6 +32,13 @@ func (k msgServer) CreateSubjectRecommendation(goCtx context.Context, req *types
 		return nil, fmt.Errorf("required fields cannot be empty")
 	}
 
+	// The semester must be a term of the student's academic calendar
+	if req.RecommendationSemester != "" {
+		if err := k.studentKeeper.ValidateStudentTerm(ctx, req.Student, req.RecommendationSemester); err != nil {
+			return nil, err
+		}
+	}
+
 	// Use unique variable name to avoid conflicts
 	recId, err := k.Keeper.CreateSubjectRecommendation(ctx, req)
 	if err != nil {
@@ -76,6 +83,13 @@ func (k msgServer) CreateStudyPlan(goCtx context.Context, req *types.MsgCreateSt
 		return nil, fmt.Errorf("student has reached maximum number of study plans (%d)", k.GetMaxStudyPlansPerStudent(ctx))
 	}
 
+	// Planned semesters must be terms of the student's academic calendar
+	for _, semesterCode := range req.SemesterCodes {
+		if err := k.studentKeeper.ValidateStudentTerm(ctx, req.Student, semesterCode); err != nil {
+			return nil, err
+		}
+	}
+
 	// Use unique variable name to avoid conflicts
 	planId, err := k.Keeper.CreateStudyPlan(ctx, req)
 	if err != nil {
@@ -115,11 +129,16 @@ func (k msgServer) AddPlannedSemester(goCtx context.Context, req *types.MsgAddPl
 	}
 
 	// Check if study plan exists
-	_, found := k.GetStudyPlan(ctx, req.StudyPlanId)
+	studyPlan, found := k.GetStudyPlan(ctx, req.StudyPlanId)
 	if !found {
 		return nil, fmt.Errorf("study plan with ID '%s' not found", req.StudyPlanId)
 	}
 
+	// The semester must be a term of the student's academic calendar
+	if err := k.studentKeeper.ValidateStudentTerm(ctx, studyPlan.Student, req.SemesterCode); err != nil {
+		return nil, err
+	}
+
 	// Validate max credits per semester
 	if req.TotalCredits > k.GetMaxCreditsPerSemester(ctx) {
 		return nil, fmt.Errorf("total credits (%d) exceeds maximum allowed per semester (%d)",
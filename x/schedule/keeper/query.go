@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
 
 	"academictoken/x/schedule/types"
 
@@ -149,7 +153,7 @@ func (k Keeper) GenerateRecommendations(c context.Context, req *types.QueryGener
 	ctx := sdk.UnwrapSDKContext(c)
 	recommendations, err := k.BuildRecommendations(ctx, req.StudentId, req.SemesterCode)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, planError(err)
 	}
 
 	return &types.QueryGenerateRecommendationsResponse{
@@ -186,7 +190,8 @@ func (k Keeper) CheckStudentProgress(c context.Context, req *types.QueryCheckStu
 	}, nil
 }
 
-// OptimizeSchedule optimizes a student's schedule (simplified)
+// OptimizeSchedule replaces the pending semesters of a study plan with the
+// critical-path plan and estimates the semesters it saves
 func (k Keeper) OptimizeSchedule(c context.Context, req *types.QueryOptimizeScheduleRequest) (*types.QueryOptimizeScheduleResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -204,11 +209,75 @@ func (k Keeper) OptimizeSchedule(c context.Context, req *types.QueryOptimizeSche
 		return nil, status.Error(codes.NotFound, "study plan not found")
 	}
 
-	// Simplified optimization - just return the existing plan
+	plan, err := k.BuildCurriculumPlan(ctx, studyPlan.Student)
+	if err != nil {
+		return nil, planError(err)
+	}
+
+	// Completed semesters are kept; pending ones are replaced, reusing their codes
+	var kept []*types.PlannedSemester
+	var pendingCodes []string
+	for _, semester := range studyPlan.PlannedSemesters {
+		if semester == nil {
+			continue
+		}
+		if semester.Status == types.StudyPlanStatusCompleted {
+			kept = append(kept, semester)
+		} else {
+			pendingCodes = append(pendingCodes, semester.SemesterCode)
+		}
+	}
+
+	optimized := studyPlan
+	optimized.PlannedSemesters = kept
+	for i, term := range plan.Terms {
+		code := fmt.Sprintf("semester-%d", term.Number)
+		if i < len(pendingCodes) {
+			code = pendingCodes[i]
+		}
+		optimized.PlannedSemesters = append(optimized.PlannedSemesters, &types.PlannedSemester{
+			SemesterCode:    code,
+			PlannedSubjects: term.SubjectIds,
+			TotalCredits:    strconv.FormatUint(term.Credits, 10),
+			TotalHours:      strconv.FormatUint(term.Hours, 10),
+			Status:          "planned",
+		})
+	}
+
+	// Without pending semesters the plan is compared with the curriculum pace
+	baseline := uint64(len(pendingCodes))
+	if baseline == 0 {
+		baseline = plan.CurriculumSemesters
+	}
+	saved := plan.SemestersSaved(baseline)
+
+	suggestions := []string{
+		fmt.Sprintf("Remaining subjects fit in %d semesters at up to %d credits per semester", len(plan.Terms), k.GetMaxCreditsPerSemester(ctx)),
+	}
+	for _, subjectId := range plan.CriticalSubjects() {
+		suggestions = append(suggestions, fmt.Sprintf("Take %s early: it heads a prerequisite chain of %d semesters", subjectId, plan.CriticalPath(subjectId)))
+	}
+	if len(plan.Unschedulable) > 0 {
+		suggestions = append(suggestions, fmt.Sprintf("Subjects that cannot be scheduled: %v", plan.Unschedulable))
+	}
+
+	unit := "semesters"
+	if saved == 1 {
+		unit = "semester"
+	}
+
 	return &types.QueryOptimizeScheduleResponse{
 		StudyPlanId:      req.StudyPlanId,
-		OptimizedPlan:    studyPlan,
-		Suggestions:      []string{"Consider taking prerequisite subjects earlier"},
-		EstimatedSavings: "0 semesters",
+		OptimizedPlan:    optimized,
+		Suggestions:      suggestions,
+		EstimatedSavings: fmt.Sprintf("%d %s", saved, unit),
 	}, nil
 }
+
+// planError converts a recommendation engine error into a gRPC status
+func planError(err error) error {
+	if errorsmod.IsOf(err, types.ErrStudentNotFound, types.ErrCurriculumNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
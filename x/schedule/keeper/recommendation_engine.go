@@ -0,0 +1,568 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/schedule/types"
+)
+
+// Prerequisite group types understood by the recommendation engine
+const (
+	prerequisiteGroupAll         = "ALL"
+	prerequisiteGroupAny         = "ANY"
+	prerequisiteGroupCredits     = "CREDITS"
+	prerequisiteGroupCombination = "COMBINATION"
+)
+
+// PlannedTerm is a semester produced by the recommendation engine
+type PlannedTerm struct {
+	Number     uint64
+	SubjectIds []string
+	Credits    uint64
+	Hours      uint64
+}
+
+// CurriculumPlan is the critical-path plan computed for a student. It is
+// fully derived from chain state, so the same inputs always produce the same
+// plan.
+type CurriculumPlan struct {
+	StudentId         string
+	CourseId          string
+	CurriculumVersion string
+	Terms             []PlannedTerm
+	// Subjects that cannot be placed within the planning horizon
+	Unschedulable []string
+	// Semesters needed when following the curriculum semester structure
+	CurriculumSemesters uint64
+
+	subjects map[string]plannedSubject
+}
+
+// plannedSubject is a subject still to be taken by the student
+type plannedSubject struct {
+	content            types.SubjectContent
+	required           bool
+	electiveGroup      string
+	curriculumSemester uint64
+	criticalPath       uint64
+	unlocks            []string
+}
+
+// planState tracks the subjects and credits a student holds at a point of the plan
+type planState struct {
+	satisfied      map[string]bool
+	subjectCredits map[string]uint64
+	credits        uint64
+}
+
+// hold records a subject as held by the student
+func (s planState) hold(subjectId string, credits uint64) {
+	s.satisfied[subjectId] = true
+	s.subjectCredits[subjectId] = credits
+}
+
+// clone returns an independent copy of the state
+func (s planState) clone() planState {
+	clone := planState{
+		satisfied:      make(map[string]bool, len(s.satisfied)),
+		subjectCredits: make(map[string]uint64, len(s.subjectCredits)),
+		credits:        s.credits,
+	}
+	for subjectId := range s.satisfied {
+		clone.satisfied[subjectId] = true
+	}
+	for subjectId, credits := range s.subjectCredits {
+		clone.subjectCredits[subjectId] = credits
+	}
+	return clone
+}
+
+// BuildCurriculumPlan computes the critical-path semester plan for a student.
+// The plan covers the required subjects not yet completed or in progress and
+// the electives needed to fulfil unmet elective groups, together with any
+// missing prerequisites. Subjects are packed into semesters respecting the
+// prerequisite groups and the maximum credits per semester.
+func (k Keeper) BuildCurriculumPlan(ctx sdk.Context, studentId string) (CurriculumPlan, error) {
+	tree, found := k.studentKeeper.GetAcademicTree(ctx, studentId, "")
+	if !found {
+		return CurriculumPlan{}, errorsmod.Wrapf(types.ErrStudentNotFound, "academic tree for student %s not found", studentId)
+	}
+
+	version := tree.CurriculumVersion
+	if version == "" {
+		version = k.curriculumKeeper.GetCurrentCurriculumVersion(ctx, tree.CourseId)
+	}
+	curriculum, found := k.curriculumKeeper.GetCurriculumTree(ctx, tree.CourseId, version)
+	if !found {
+		return CurriculumPlan{}, errorsmod.Wrapf(types.ErrCurriculumNotFound, "curriculum for course %s not found", tree.CourseId)
+	}
+
+	plan := CurriculumPlan{
+		StudentId:         studentId,
+		CourseId:          tree.CourseId,
+		CurriculumVersion: curriculum.Version,
+		subjects:          make(map[string]plannedSubject),
+	}
+
+	state := planState{
+		satisfied:      make(map[string]bool),
+		subjectCredits: make(map[string]uint64),
+		credits:        tree.TotalCredits,
+	}
+	for _, subjectId := range tree.CompletedTokens {
+		subject, _ := k.subjectKeeper.GetSubject(ctx, subjectId)
+		state.hold(subjectId, subject.Credits)
+	}
+	// Subjects in progress are expected to be completed before the planned semesters
+	for _, subjectId := range tree.InProgressTokens {
+		if state.satisfied[subjectId] {
+			continue
+		}
+		subject, _ := k.subjectKeeper.GetSubject(ctx, subjectId)
+		state.hold(subjectId, subject.Credits)
+		state.credits += subject.Credits
+	}
+
+	semesterOf := make(map[string]uint64)
+	for _, semester := range curriculum.SemesterStructure {
+		for _, subjectId := range semester.SubjectIds {
+			if current, ok := semesterOf[subjectId]; !ok || semester.SemesterNumber < current {
+				semesterOf[subjectId] = semester.SemesterNumber
+			}
+		}
+	}
+
+	// Required subjects still to be taken
+	for _, subjectId := range sortedUnique(curriculum.RequiredSubjects) {
+		if !state.satisfied[subjectId] {
+			k.addPlannedSubject(ctx, &plan, state, semesterOf, subjectId, true, "")
+		}
+	}
+
+	// Missing prerequisites become part of the plan before electives are
+	// chosen, so electives can reuse them
+	k.addMissingPrerequisites(ctx, &plan, state, semesterOf)
+
+	groups := append([]types.ElectiveGroup(nil), curriculum.ElectiveGroups...)
+	sort.Slice(groups, func(i, j int) bool { return groups[i].GroupId < groups[j].GroupId })
+	for _, group := range groups {
+		k.selectElectives(ctx, &plan, state, semesterOf, group)
+	}
+	k.addMissingPrerequisites(ctx, &plan, state, semesterOf)
+
+	plan.computeCriticalPaths()
+	plan.CurriculumSemesters = plan.curriculumSemesters()
+	plan.pack(state, k.GetMaxCreditsPerSemester(ctx), k.GetMaxPlannedSemesters(ctx))
+
+	return plan, nil
+}
+
+// addPlannedSubject adds a subject to the plan, ignoring subjects that do not exist
+func (k Keeper) addPlannedSubject(ctx sdk.Context, plan *CurriculumPlan, state planState, semesterOf map[string]uint64, subjectId string, required bool, electiveGroup string) bool {
+	if state.satisfied[subjectId] {
+		return false
+	}
+	if existing, ok := plan.subjects[subjectId]; ok {
+		if required && !existing.required {
+			existing.required = true
+			plan.subjects[subjectId] = existing
+		}
+		return false
+	}
+
+	subject, found := k.subjectKeeper.GetSubject(ctx, subjectId)
+	if !found {
+		plan.Unschedulable = append(plan.Unschedulable, subjectId)
+		return false
+	}
+
+	plan.subjects[subjectId] = plannedSubject{
+		content:            subject,
+		required:           required,
+		electiveGroup:      electiveGroup,
+		curriculumSemester: semesterOf[subjectId],
+	}
+	return true
+}
+
+// addMissingPrerequisites adds the prerequisites of planned subjects that the
+// student does not hold yet, until the plan is closed under prerequisites.
+// For ANY and COMBINATION groups the lowest subject ID is chosen when no
+// member is satisfied or planned already.
+func (k Keeper) addMissingPrerequisites(ctx sdk.Context, plan *CurriculumPlan, state planState, semesterOf map[string]uint64) {
+	for {
+		added := false
+		for _, subjectId := range plan.subjectIds() {
+			for _, group := range plan.subjects[subjectId].content.PrerequisiteGroups {
+				for _, prerequisite := range missingPrerequisites(group, state, plan.subjects) {
+					if k.addPlannedSubject(ctx, plan, state, semesterOf, prerequisite, false, "") {
+						added = true
+					}
+				}
+			}
+		}
+		if !added {
+			return
+		}
+	}
+}
+
+// missingPrerequisites returns the members of a prerequisite group that must
+// be added to the plan for the group to become satisfiable
+func missingPrerequisites(group types.PrerequisiteGroup, state planState, planned map[string]plannedSubject) []string {
+	members := sortedUnique(group.SubjectIds)
+
+	var missing []string
+	available := 0
+	for _, subjectId := range members {
+		if _, ok := planned[subjectId]; ok || state.satisfied[subjectId] {
+			available++
+			continue
+		}
+		missing = append(missing, subjectId)
+	}
+
+	switch strings.ToUpper(group.GroupType) {
+	case prerequisiteGroupAll:
+		return missing
+	case prerequisiteGroupAny, prerequisiteGroupCombination:
+		needed := int(group.MinimumCompletedSubjects)
+		if needed == 0 {
+			needed = 1
+		}
+		if available >= needed || len(missing) == 0 {
+			return nil
+		}
+		if needed-available < len(missing) {
+			missing = missing[:needed-available]
+		}
+		return missing
+	default:
+		return nil
+	}
+}
+
+// selectElectives adds the electives needed to fulfil an elective group,
+// preferring subjects with the shortest prerequisite chain
+func (k Keeper) selectElectives(ctx sdk.Context, plan *CurriculumPlan, state planState, semesterOf map[string]uint64, group types.ElectiveGroup) {
+	var count, credits uint64
+	var candidates []types.SubjectContent
+	for _, subjectId := range sortedUnique(group.SubjectIds) {
+		if planned, ok := plan.subjects[subjectId]; ok {
+			count++
+			credits += planned.content.Credits
+			continue
+		}
+		if state.satisfied[subjectId] {
+			count++
+			credits += state.subjectCredits[subjectId]
+			continue
+		}
+		if subject, found := k.subjectKeeper.GetSubject(ctx, subjectId); found {
+			candidates = append(candidates, subject)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		di, dj := len(candidates[i].PrerequisiteGroups), len(candidates[j].PrerequisiteGroups)
+		if di != dj {
+			return di < dj
+		}
+		si, sj := semesterOf[candidates[i].SubjectId], semesterOf[candidates[j].SubjectId]
+		if si != sj {
+			return semesterRank(si) < semesterRank(sj)
+		}
+		return candidates[i].SubjectId < candidates[j].SubjectId
+	})
+
+	for _, candidate := range candidates {
+		if count >= group.MinSubjectsRequired && credits >= group.CreditsRequired {
+			return
+		}
+		if k.addPlannedSubject(ctx, plan, state, semesterOf, candidate.SubjectId, false, group.GroupId) {
+			count++
+			credits += candidate.Credits
+		}
+	}
+}
+
+// computeCriticalPaths sets, for every planned subject, the length of the
+// longest chain of planned subjects depending on it, counting the subject
+// itself, and the planned subjects it directly unlocks
+func (p *CurriculumPlan) computeCriticalPaths() {
+	dependents := make(map[string][]string)
+	for _, subjectId := range p.subjectIds() {
+		for _, group := range p.subjects[subjectId].content.PrerequisiteGroups {
+			for _, prerequisite := range sortedUnique(group.SubjectIds) {
+				if _, ok := p.subjects[prerequisite]; ok && prerequisite != subjectId {
+					dependents[prerequisite] = appendUnique(dependents[prerequisite], subjectId)
+				}
+			}
+		}
+	}
+
+	depth := make(map[string]uint64)
+	visiting := make(map[string]bool)
+	var visit func(subjectId string) uint64
+	visit = func(subjectId string) uint64 {
+		if d, ok := depth[subjectId]; ok {
+			return d
+		}
+		// Cyclic prerequisites are broken at the subject being visited
+		if visiting[subjectId] {
+			return 0
+		}
+		visiting[subjectId] = true
+		var longest uint64
+		for _, dependent := range dependents[subjectId] {
+			if d := visit(dependent); d > longest {
+				longest = d
+			}
+		}
+		visiting[subjectId] = false
+		depth[subjectId] = longest + 1
+		return longest + 1
+	}
+
+	for _, subjectId := range p.subjectIds() {
+		subject := p.subjects[subjectId]
+		subject.criticalPath = visit(subjectId)
+		subject.unlocks = dependents[subjectId]
+		p.subjects[subjectId] = subject
+	}
+}
+
+// pack places the planned subjects into semesters. Each semester takes the
+// eligible subjects with the longest critical path first, then the earliest
+// curriculum semester, required before elective, until the credit limit is
+// reached. A subject larger than the limit is placed alone in a semester.
+func (p *CurriculumPlan) pack(state planState, maxCredits uint64, maxSemesters uint64) {
+	current := state.clone()
+
+	remaining := p.rankedSubjectIds()
+	for number := uint64(1); number <= maxSemesters && len(remaining) > 0; number++ {
+		term := PlannedTerm{Number: number}
+		var deferred []string
+		for _, subjectId := range remaining {
+			subject := p.subjects[subjectId]
+			fits := term.Credits+subject.content.Credits <= maxCredits || len(term.SubjectIds) == 0
+			if !fits || !prerequisitesSatisfied(subject.content, current) {
+				deferred = append(deferred, subjectId)
+				continue
+			}
+			term.SubjectIds = append(term.SubjectIds, subjectId)
+			term.Credits += subject.content.Credits
+			term.Hours += subject.content.WorkloadHours
+		}
+
+		// No remaining subject can ever become eligible
+		if len(term.SubjectIds) == 0 {
+			break
+		}
+
+		for _, subjectId := range term.SubjectIds {
+			current.hold(subjectId, p.subjects[subjectId].content.Credits)
+		}
+		current.credits += term.Credits
+		p.Terms = append(p.Terms, term)
+		remaining = deferred
+	}
+
+	p.Unschedulable = sortedUnique(append(p.Unschedulable, remaining...))
+}
+
+// prerequisiteGroupSatisfied reports whether a student state satisfies a
+// prerequisite group. Credit thresholds count the credits of the satisfied
+// members of the group, or every credit earned when the group has no members.
+func prerequisiteGroupSatisfied(group types.PrerequisiteGroup, state planState) bool {
+	members := sortedUnique(group.SubjectIds)
+
+	var count, credits uint64
+	for _, subjectId := range members {
+		if state.satisfied[subjectId] {
+			count++
+			credits += state.subjectCredits[subjectId]
+		}
+	}
+	if len(members) == 0 {
+		credits = state.credits
+	}
+
+	switch strings.ToUpper(group.GroupType) {
+	case prerequisiteGroupAll:
+		if count < uint64(len(members)) {
+			return false
+		}
+	case prerequisiteGroupAny:
+		if count == 0 && len(members) > 0 {
+			return false
+		}
+	case prerequisiteGroupCredits, prerequisiteGroupCombination:
+	default:
+		return false
+	}
+
+	return count >= group.MinimumCompletedSubjects && credits >= group.MinimumCredits
+}
+
+// prerequisitesSatisfied reports whether every prerequisite group of a subject is satisfied
+func prerequisitesSatisfied(subject types.SubjectContent, state planState) bool {
+	for _, group := range subject.PrerequisiteGroups {
+		if !prerequisiteGroupSatisfied(group, state) {
+			return false
+		}
+	}
+	return true
+}
+
+// Recommendations returns the subjects planned for the first semester,
+// ranked by priority
+func (p CurriculumPlan) Recommendations() []*types.RecommendedSubject {
+	if len(p.Terms) == 0 {
+		return []*types.RecommendedSubject{}
+	}
+
+	recommended := make([]*types.RecommendedSubject, 0, len(p.Terms[0].SubjectIds))
+	for i, subjectId := range p.Terms[0].SubjectIds {
+		subject := p.subjects[subjectId]
+		alignment := ""
+		if subject.curriculumSemester > 0 {
+			alignment = fmt.Sprintf("%d", subject.curriculumSemester)
+		}
+		recommended = append(recommended, &types.RecommendedSubject{
+			SubjectId:          subjectId,
+			RecommendationRank: fmt.Sprintf("%d", i+1),
+			Reason:             p.reason(subject),
+			IsRequired:         fmt.Sprintf("%t", subject.required),
+			SemesterAlignment:  alignment,
+			DifficultyLevel:    subject.content.DifficultyLevel,
+		})
+	}
+	return recommended
+}
+
+// reason explains why a subject was recommended
+func (p CurriculumPlan) reason(subject plannedSubject) string {
+	var parts []string
+	switch {
+	case subject.required:
+		parts = append(parts, "required by the curriculum")
+	case subject.electiveGroup != "":
+		parts = append(parts, fmt.Sprintf("fulfils elective group %s", subject.electiveGroup))
+	default:
+		parts = append(parts, "prerequisite of planned subjects")
+	}
+	if subject.criticalPath > 1 {
+		parts = append(parts, fmt.Sprintf("starts a prerequisite chain of %d semesters", subject.criticalPath))
+	}
+	if len(subject.unlocks) > 0 {
+		parts = append(parts, fmt.Sprintf("unlocks %s", strings.Join(subject.unlocks, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Metadata summarizes the plan for storage alongside a recommendation
+func (p CurriculumPlan) Metadata() string {
+	return fmt.Sprintf("engine=critical-path;course=%s;curriculum=%s;remaining_subjects=%d;planned_semesters=%d;unschedulable=%d",
+		p.CourseId, p.CurriculumVersion, len(p.subjects), len(p.Terms), len(p.Unschedulable))
+}
+
+// SemestersSaved returns how many semesters the plan saves compared to a
+// baseline number of semesters
+func (p CurriculumPlan) SemestersSaved(baseline uint64) uint64 {
+	if uint64(len(p.Terms)) >= baseline {
+		return 0
+	}
+	return baseline - uint64(len(p.Terms))
+}
+
+// CriticalSubjects returns the planned subjects heading the longest
+// prerequisite chains, longest first
+func (p CurriculumPlan) CriticalSubjects() []string {
+	var critical []string
+	for _, subjectId := range p.rankedSubjectIds() {
+		if p.subjects[subjectId].criticalPath > 1 {
+			critical = append(critical, subjectId)
+		}
+	}
+	return critical
+}
+
+// CriticalPath returns the length of the prerequisite chain headed by a planned subject
+func (p CurriculumPlan) CriticalPath(subjectId string) uint64 {
+	return p.subjects[subjectId].criticalPath
+}
+
+// curriculumSemesters counts the curriculum semesters holding planned
+// subjects. Subjects outside the semester structure need one more semester.
+func (p CurriculumPlan) curriculumSemesters() uint64 {
+	semesters := make(map[uint64]bool)
+	for _, subject := range p.subjects {
+		semesters[subject.curriculumSemester] = true
+	}
+	return uint64(len(semesters))
+}
+
+// rankedSubjectIds returns the planned subjects in priority order
+func (p CurriculumPlan) rankedSubjectIds() []string {
+	ids := p.subjectIds()
+	sort.SliceStable(ids, func(i, j int) bool {
+		a, b := p.subjects[ids[i]], p.subjects[ids[j]]
+		if a.criticalPath != b.criticalPath {
+			return a.criticalPath > b.criticalPath
+		}
+		if a.curriculumSemester != b.curriculumSemester {
+			return semesterRank(a.curriculumSemester) < semesterRank(b.curriculumSemester)
+		}
+		if a.required != b.required {
+			return a.required
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// subjectIds returns the planned subjects sorted by ID
+func (p CurriculumPlan) subjectIds() []string {
+	ids := make([]string, 0, len(p.subjects))
+	for subjectId := range p.subjects {
+		ids = append(ids, subjectId)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// semesterRank orders subjects outside the semester structure last
+func semesterRank(semester uint64) uint64 {
+	if semester == 0 {
+		return ^uint64(0)
+	}
+	return semester
+}
+
+func sortedUnique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" && !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/x/schedule/keeper"
+	"academictoken/x/schedule/types"
+)
+
+type engineSubjectKeeper map[string]types.SubjectContent
+
+func (m engineSubjectKeeper) GetSubject(ctx sdk.Context, subjectID string) (types.SubjectContent, bool) {
+	subject, found := m[subjectID]
+	return subject, found
+}
+
+func (m engineSubjectKeeper) CheckPrerequisites(ctx sdk.Context, studentID string, subjectID string) (bool, []string, error) {
+	return true, nil, nil
+}
+
+func (m engineSubjectKeeper) GetSubjectsByArea(ctx sdk.Context, area string) []types.SubjectContent {
+	return nil
+}
+
+type engineStudentKeeper map[string]types.StudentAcademicTree
+
+func (m engineStudentKeeper) GetAcademicTree(ctx sdk.Context, studentID string, courseID string) (types.StudentAcademicTree, bool) {
+	tree, found := m[studentID]
+	return tree, found
+}
+
+func (m engineStudentKeeper) GetCompletedSubjects(ctx sdk.Context, studentID string) []string {
+	return m[studentID].CompletedTokens
+}
+
+func (m engineStudentKeeper) GetInProgressSubjects(ctx sdk.Context, studentID string) []string {
+	return m[studentID].InProgressTokens
+}
+
+func (m engineStudentKeeper) ValidateStudentTerm(ctx sdk.Context, studentID string, termID string) error {
+	return nil
+}
+
+type engineCurriculumKeeper map[string]types.CurriculumTree
+
+func (m engineCurriculumKeeper) GetCurriculumTree(ctx sdk.Context, courseID string, version string) (types.CurriculumTree, bool) {
+	curriculum, found := m[courseID]
+	return curriculum, found
+}
+
+func (m engineCurriculumKeeper) GetCurrentCurriculumVersion(ctx sdk.Context, courseID string) string {
+	return m[courseID].Version
+}
+
+func engineSubject(id string, credits uint64, prerequisites ...types.PrerequisiteGroup) types.SubjectContent {
+	return types.SubjectContent{
+		Index:              id,
+		SubjectId:          id,
+		Credits:            credits,
+		WorkloadHours:      credits * 15,
+		PrerequisiteGroups: prerequisites,
+		DifficultyLevel:    "medium",
+	}
+}
+
+func requires(groupType string, subjectIds ...string) types.PrerequisiteGroup {
+	return types.PrerequisiteGroup{GroupType: groupType, SubjectIds: subjectIds}
+}
+
+func setupEngine(t testing.TB) (keeper.Keeper, sdk.Context) {
+	subjects := engineSubjectKeeper{}
+	for _, subject := range []types.SubjectContent{
+		engineSubject("intro", 6),
+		engineSubject("calc1", 8),
+		engineSubject("calc2", 8, requires("ALL", "calc1")),
+		engineSubject("calc3", 8, requires("ALL", "calc2")),
+		engineSubject("prog1", 6),
+		engineSubject("prog2", 6, requires("ANY", "prog1")),
+		engineSubject("adv", 4, requires("ALL", "ghost")),
+		engineSubject("elecA", 6, requires("ALL", "prog1")),
+		engineSubject("elecB", 6),
+	} {
+		subjects[subject.SubjectId] = subject
+	}
+
+	students := engineStudentKeeper{
+		"student-1": {
+			Student:           "student-1",
+			CourseId:          "course-1",
+			CurriculumVersion: "v1",
+			CompletedTokens:   []string{"intro"},
+			TotalCredits:      6,
+		},
+	}
+
+	curricula := engineCurriculumKeeper{
+		"course-1": {
+			CourseId:         "course-1",
+			Version:          "v1",
+			RequiredSubjects: []string{"prog2", "calc3", "calc2", "calc1", "intro", "prog1", "adv"},
+			ElectiveSubjects: []string{"elecA", "elecB"},
+			SemesterStructure: []types.CurriculumSemester{
+				{SemesterNumber: 1, SubjectIds: []string{"calc1", "intro"}},
+				{SemesterNumber: 2, SubjectIds: []string{"calc2", "prog1"}},
+				{SemesterNumber: 3, SubjectIds: []string{"calc3", "prog2"}},
+			},
+			ElectiveGroups: []types.ElectiveGroup{
+				{GroupId: "g1", SubjectIds: []string{"elecA", "elecB"}, MinSubjectsRequired: 1},
+			},
+		},
+	}
+
+	k, ctx := keepertest.ScheduleKeeperWithKeepers(t, subjects, students, curricula)
+	return k, ctx.WithBlockTime(time.Unix(1700000000, 0))
+}
+
+func TestBuildCurriculumPlan(t *testing.T) {
+	k, ctx := setupEngine(t)
+
+	plan, err := k.BuildCurriculumPlan(ctx, "student-1")
+	require.NoError(t, err)
+
+	// The longest prerequisite chain starts first and the elective with no
+	// prerequisites fills the first semester
+	require.Len(t, plan.Terms, 3)
+	require.Equal(t, []string{"calc1", "prog1", "elecB"}, plan.Terms[0].SubjectIds)
+	require.Equal(t, uint64(20), plan.Terms[0].Credits)
+	require.Equal(t, []string{"calc2", "prog2"}, plan.Terms[1].SubjectIds)
+	require.Equal(t, []string{"calc3"}, plan.Terms[2].SubjectIds)
+	for _, term := range plan.Terms {
+		require.LessOrEqual(t, term.Credits, k.GetMaxCreditsPerSemester(ctx))
+	}
+
+	// Subjects depending on missing subjects cannot be scheduled
+	require.Equal(t, []string{"adv", "ghost"}, plan.Unschedulable)
+	require.Equal(t, uint64(3), plan.CriticalPath("calc1"))
+	require.Equal(t, []string{"calc1", "calc2", "prog1"}, plan.CriticalSubjects())
+
+	// Following the curriculum takes three semesters plus one for the elective
+	require.Equal(t, uint64(4), plan.CurriculumSemesters)
+	require.Equal(t, uint64(1), plan.SemestersSaved(plan.CurriculumSemesters))
+
+	// The plan only depends on chain state
+	again, err := k.BuildCurriculumPlan(ctx, "student-1")
+	require.NoError(t, err)
+	require.Equal(t, plan, again)
+
+	_, err = k.BuildCurriculumPlan(ctx, "unknown")
+	require.ErrorIs(t, err, types.ErrStudentNotFound)
+}
+
+func TestBuildRecommendations(t *testing.T) {
+	k, ctx := setupEngine(t)
+
+	recommendations, err := k.BuildRecommendations(ctx, "student-1", "2025-1")
+	require.NoError(t, err)
+	require.Len(t, recommendations, 1)
+
+	recommendation := recommendations[0]
+	require.Equal(t, "sr_student-1_1700000000", recommendation.Index)
+	require.Equal(t, "2023-11-14T22:13:20Z", recommendation.GeneratedDate)
+	require.Contains(t, recommendation.RecommendationMetadata, "engine=critical-path")
+	require.Len(t, recommendation.RecommendedSubjects, 3)
+
+	first := recommendation.RecommendedSubjects[0]
+	require.Equal(t, "calc1", first.SubjectId)
+	require.Equal(t, "1", first.RecommendationRank)
+	require.Equal(t, "true", first.IsRequired)
+	require.Equal(t, "1", first.SemesterAlignment)
+	require.Contains(t, first.Reason, "prerequisite chain of 3 semesters")
+
+	elective := recommendation.RecommendedSubjects[2]
+	require.Equal(t, "elecB", elective.SubjectId)
+	require.Equal(t, "false", elective.IsRequired)
+	require.Contains(t, elective.Reason, "elective group g1")
+}
+
+func TestOptimizeSchedule(t *testing.T) {
+	k, ctx := setupEngine(t)
+
+	k.SetStudyPlan(ctx, types.StudyPlan{Index: "plan-1", Student: "student-1"})
+	response, err := k.OptimizeSchedule(ctx, &types.QueryOptimizeScheduleRequest{StudyPlanId: "plan-1"})
+	require.NoError(t, err)
+	require.Equal(t, "1 semester", response.EstimatedSavings)
+	require.Len(t, response.OptimizedPlan.PlannedSemesters, 3)
+	require.Equal(t, "semester-1", response.OptimizedPlan.PlannedSemesters[0].SemesterCode)
+	require.Equal(t, "20", response.OptimizedPlan.PlannedSemesters[0].TotalCredits)
+
+	// Pending semesters are compared with the plan and their codes reused
+	var pending []*types.PlannedSemester
+	for _, code := range []string{"2025-1", "2025-2", "2026-1", "2026-2", "2027-1"} {
+		pending = append(pending, &types.PlannedSemester{SemesterCode: code, Status: "planned"})
+	}
+	completed := &types.PlannedSemester{SemesterCode: "2024-2", Status: types.StudyPlanStatusCompleted}
+	k.SetStudyPlan(ctx, types.StudyPlan{Index: "plan-2", Student: "student-1", PlannedSemesters: append([]*types.PlannedSemester{completed}, pending...)})
+	response, err = k.OptimizeSchedule(ctx, &types.QueryOptimizeScheduleRequest{StudyPlanId: "plan-2"})
+	require.NoError(t, err)
+	require.Equal(t, "2 semesters", response.EstimatedSavings)
+	require.Len(t, response.OptimizedPlan.PlannedSemesters, 4)
+	require.Equal(t, "2024-2", response.OptimizedPlan.PlannedSemesters[0].SemesterCode)
+	require.Equal(t, "2025-1", response.OptimizedPlan.PlannedSemesters[1].SemesterCode)
+	require.Equal(t, []string{"calc3"}, response.OptimizedPlan.PlannedSemesters[3].PlannedSubjects)
+}
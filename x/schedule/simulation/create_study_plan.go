@@ -3,7 +3,6 @@ package simulation
 import (
 	"math/rand"
 	//"strconv"
-	"time"
 
 	"academictoken/x/schedule/keeper"
 	"academictoken/x/schedule/types"
@@ -40,7 +39,7 @@ func SimulateMsgCreateStudyPlan(
 
 		// Generate completion target (random date 2-6 years from now)
 		yearsToComplete := r.Intn(4) + 2
-		completionTarget := time.Now().AddDate(yearsToComplete, 0, 0).Format("2006-01-02")
+		completionTarget := ctx.BlockTime().AddDate(yearsToComplete, 0, 0).Format("2006-01-02")
 
 		// Generate random additional notes
 		notes := []string{
@@ -91,7 +91,7 @@ func SimulateMsgCreateSubjectRecommendation(
 			RecommendationSemester: recommendationSemester,
 			RecommendedSubjects:    recommendedSubjects,
 			RecommendationMetadata: recommendationMetadata,
-			GeneratedDate:          time.Now().Format(time.RFC3339),
+			GeneratedDate:          ctx.BlockTime().UTC().Format(time.RFC3339),
 		}
 
 		// Validate the message
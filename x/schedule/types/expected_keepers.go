@@ -68,6 +68,10 @@ type StudentKeeper interface {
 
 	// GetInProgressSubjects returns a list of in-progress subject IDs
 	GetInProgressSubjects(ctx sdk.Context, studentID string) []string
+
+	// ValidateStudentTerm checks that a term ID is in the academic calendar of
+	// an institution the student is enrolled in
+	ValidateStudentTerm(ctx sdk.Context, studentID string, termID string) error
 }
 
 // CurriculumTree defines the locally defined version of curriculum tree
@@ -7,6 +7,8 @@ import (
 )
 
 // StudentIPFSClient wraps the base IPFS client with student-specific functionality
+// and performs network I/O, so it is for clients and off-chain services only;
+// keepers validate references with VerifyStudentData instead
 type StudentIPFSClient struct {
 	*subjectipfs.IPFSClient
 }
@@ -27,6 +29,16 @@ func (c *StudentIPFSClient) AddStudentData(data interface{}) (string, string, er
 	return c.Add(jsonData)
 }
 
+// VerifyStudentData checks, without any I/O, that data matches the content hash
+// and IPFS link uploaded for it
+func VerifyStudentData(data interface{}, contentHash, ipfsLink string) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal student data: %w", err)
+	}
+	return subjectipfs.VerifyContent(jsonData, contentHash, ipfsLink)
+}
+
 // GetStudentData retrieves and unmarshals student data from IPFS
 func (c *StudentIPFSClient) GetStudentData(ipfsLink string, target interface{}) error {
 	data, err := c.Get(ipfsLink)
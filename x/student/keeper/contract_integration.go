@@ -1,13 +1,22 @@
 package keeper
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strings"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"academictoken/contracts/bindings/academicnft"
+	"academictoken/contracts/bindings/degree"
+	"academictoken/contracts/bindings/equivalence"
+	"academictoken/contracts/bindings/prerequisites"
+	"academictoken/contracts/bindings/progress"
 	"academictoken/x/student/types"
 )
 
@@ -31,20 +40,24 @@ func NewContractIntegration(keeper *Keeper, wasmMsgServer types.WasmMsgServer, w
 // PREREQUISITES CONTRACT INTEGRATION
 // ============================================================================
 
-// CheckPrerequisites calls the prerequisites contract to verify if student can enroll
+// CheckPrerequisites verifies if student can enroll. In native mode the subject
+// keeper evaluates the prerequisite groups; contract mode requires the
+// prerequisites contract.
 func (ci *ContractIntegration) CheckPrerequisites(ctx sdk.Context, studentId string, subjectId string) (bool, []string, error) {
 	params := ci.keeper.GetParams(ctx)
-	contractAddr := params.PrerequisitesContractAddr
-
+	contractAddr, err := ci.contractFor(params, "check_prerequisites", "prerequisites", params.PrerequisitesContractAddr)
+	if err != nil {
+		return false, nil, err
+	}
 	if contractAddr == "" {
-		return false, nil, fmt.Errorf("prerequisites contract address not configured")
+		return ci.keeper.subjectKeeper.EvaluatePrerequisites(ctx, studentId, subjectId)
 	}
 
 	// Create query message
-	queryMsg := map[string]interface{}{
-		"check_eligibility": map[string]interface{}{
-			"student_id": studentId,
-			"subject_id": subjectId,
+	queryMsg := prerequisites.QueryMsg{
+		CheckEligibility: &prerequisites.CheckEligibilityQuery{
+			StudentId: studentId,
+			SubjectId: subjectId,
 		},
 	}
 
@@ -65,12 +78,7 @@ func (ci *ContractIntegration) CheckPrerequisites(ctx sdk.Context, studentId str
 	}
 
 	// Parse response
-	var response struct {
-		CanEnroll            bool     `json:"can_enroll"`
-		MissingPrerequisites []string `json:"missing_prerequisites"`
-		Details              string   `json:"details"`
-	}
-
+	var response prerequisites.VerificationResult
 	if err := json.Unmarshal(res.Data, &response); err != nil {
 		return false, nil, fmt.Errorf("failed to unmarshal contract response: %w", err)
 	}
@@ -88,15 +96,15 @@ func (ci *ContractIntegration) UpdateStudentRecord(ctx sdk.Context, studentId st
 	}
 
 	// Create execute message
-	executeMsg := map[string]interface{}{
-		"update_student_record": map[string]interface{}{
-			"student_id": studentId,
-			"completed_subject": map[string]interface{}{
-				"subject_id":      completedSubject.SubjectId,
-				"credits":         completedSubject.Credits,
-				"completion_date": completedSubject.CompletionDate,
-				"grade":           completedSubject.Grade,
-				"nft_token_id":    completedSubject.NftTokenId,
+	executeMsg := prerequisites.ExecuteMsg{
+		UpdateStudentRecord: &prerequisites.UpdateStudentRecordMsg{
+			StudentId: studentId,
+			CompletedSubject: prerequisites.CompletedSubjectMsg{
+				SubjectId:      completedSubject.SubjectId,
+				Credits:        completedSubject.Credits,
+				CompletionDate: completedSubject.CompletionDate,
+				Grade:          uint32(completedSubject.Grade),
+				NftTokenId:     completedSubject.NftTokenId,
 			},
 		},
 	}
@@ -126,7 +134,7 @@ func (ci *ContractIntegration) UpdateStudentRecord(ctx sdk.Context, studentId st
 }
 
 // RegisterPrerequisites registers prerequisite groups for a subject in the contract
-func (ci *ContractIntegration) RegisterPrerequisites(ctx sdk.Context, subjectId string, prerequisites []types.PrerequisiteGroup) error {
+func (ci *ContractIntegration) RegisterPrerequisites(ctx sdk.Context, subjectId string, groups []types.PrerequisiteGroup) error {
 	params := ci.keeper.GetParams(ctx)
 	contractAddr := params.PrerequisitesContractAddr
 
@@ -134,27 +142,27 @@ func (ci *ContractIntegration) RegisterPrerequisites(ctx sdk.Context, subjectId
 		return fmt.Errorf("prerequisites contract address not configured")
 	}
 
-	// Convert to contract format
-	contractPrereqs := make([]map[string]interface{}, len(prerequisites))
-	for i, prereq := range prerequisites {
-		contractPrereqs[i] = map[string]interface{}{
-			"id":                         prereq.Id,
-			"subject_id":                 prereq.SubjectId,
-			"group_type":                 prereq.GroupType,
-			"minimum_credits":            prereq.MinimumCredits,
-			"minimum_completed_subjects": prereq.MinimumCompletedSubjects,
-			"subject_ids":                prereq.SubjectIds,
-			"logic":                      prereq.Logic,
-			"priority":                   prereq.Priority,
-			"confidence":                 prereq.Confidence,
+	// Convert to contract format; the contract keeps confidence as a percentage
+	contractPrereqs := make([]prerequisites.PrerequisiteGroup, len(groups))
+	for i, group := range groups {
+		contractPrereqs[i] = prerequisites.PrerequisiteGroup{
+			Id:                       group.Id,
+			SubjectId:                group.SubjectId,
+			GroupType:                prerequisites.GroupType(group.GroupType),
+			MinimumCredits:           group.MinimumCredits,
+			MinimumCompletedSubjects: group.MinimumCompletedSubjects,
+			SubjectIds:               nonNil(group.SubjectIds),
+			Logic:                    prerequisites.LogicType(group.Logic),
+			Priority:                 uint32(group.Priority),
+			Confidence:               uint32(math.Round(group.Confidence * 100)),
 		}
 	}
 
 	// Create execute message
-	executeMsg := map[string]interface{}{
-		"register_prerequisites": map[string]interface{}{
-			"subject_id":    subjectId,
-			"prerequisites": contractPrereqs,
+	executeMsg := prerequisites.ExecuteMsg{
+		RegisterPrerequisites: &prerequisites.RegisterPrerequisitesMsg{
+			SubjectId:     subjectId,
+			Prerequisites: contractPrereqs,
 		},
 	}
 
@@ -206,50 +214,12 @@ func (ci *ContractIntegration) RequestEquivalence(ctx sdk.Context, sourceSubject
 		return "", fmt.Errorf("target subject not found: %s", targetSubjectId)
 	}
 
-	// Convert to contract format
-	sourceInfo := map[string]interface{}{
-		"subject_id":     sourceSubject.Index,
-		"institution_id": sourceSubject.Institution,
-		"subject_name":   sourceSubject.Title,
-		"credits":        sourceSubject.Credits,
-		"course_code":    sourceSubject.Code,
-		"content_hash":   sourceSubject.ContentHash,
-		"ipfs_link":      sourceSubject.IPFSLink,
-		"metadata": map[string]interface{}{
-			"department":          sourceSubject.KnowledgeArea,
-			"level":               "undergraduate", // Default for now
-			"duration_weeks":      16,              // Default for now
-			"workload_hours":      sourceSubject.WorkloadHours,
-			"prerequisites_count": 0,           // Could be calculated
-			"language":            "português", // Default for now
-		},
-	}
-
-	targetInfo := map[string]interface{}{
-		"subject_id":     targetSubject.Index,
-		"institution_id": targetSubject.Institution,
-		"subject_name":   targetSubject.Title,
-		"credits":        targetSubject.Credits,
-		"course_code":    targetSubject.Code,
-		"content_hash":   targetSubject.ContentHash,
-		"ipfs_link":      targetSubject.IPFSLink,
-		"metadata": map[string]interface{}{
-			"department":          targetSubject.KnowledgeArea,
-			"level":               "undergraduate",
-			"duration_weeks":      16,
-			"workload_hours":      targetSubject.WorkloadHours,
-			"prerequisites_count": 0,
-			"language":            "português",
-		},
-	}
-
 	// Create execute message
-	executeMsg := map[string]interface{}{
-		"register_equivalence": map[string]interface{}{
-			"source_subject":  sourceInfo,
-			"target_subject":  targetInfo,
-			"analysis_method": "automatic",
-			"notes":           nil,
+	executeMsg := equivalence.ExecuteMsg{
+		RegisterEquivalence: &equivalence.RegisterEquivalenceMsg{
+			SourceSubject:  contractSubjectInfo(sourceSubject),
+			TargetSubject:  contractSubjectInfo(targetSubject),
+			AnalysisMethod: equivalence.AnalysisMethodAutomatic,
 		},
 	}
 
@@ -269,31 +239,57 @@ func (ci *ContractIntegration) RequestEquivalence(ctx sdk.Context, sourceSubject
 		Funds:    nil,
 	}
 
-	res, err := ci.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), req)
+	_, err = ci.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), req)
 	if err != nil {
 		return "", fmt.Errorf("failed to execute equivalence contract: %w", err)
 	}
 
-	// Parse response to get equivalence ID
-	var equivalenceId string
+	// The contract returns no data, the registered equivalence is looked up by subject pair
+	queryData, err := json.Marshal(equivalence.QueryMsg{
+		FindEquivalence: &equivalence.FindEquivalenceQuery{
+			SourceSubjectId: sourceSubject.Index,
+			TargetSubjectId: targetSubject.Index,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal query message: %w", err)
+	}
 
-	// Try to find equivalence_id in the response data
-	if res.Data != nil {
-		var responseData map[string]interface{}
-		if err := json.Unmarshal(res.Data, &responseData); err == nil {
-			if id, exists := responseData["equivalence_id"]; exists {
-				if idStr, ok := id.(string); ok {
-					equivalenceId = idStr
-				}
-			}
-		}
+	res, err := ci.wasmQuerier.SmartContractState(sdk.WrapSDKContext(ctx), &wasmtypes.QuerySmartContractStateRequest{
+		Address:   contractAddr,
+		QueryData: queryData,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to query equivalence contract: %w", err)
 	}
 
-	if equivalenceId == "" {
+	var response equivalence.EquivalenceResponse
+	if err := json.Unmarshal(res.Data, &response); err != nil {
+		return "", fmt.Errorf("failed to unmarshal contract response: %w", err)
+	}
+	if response.Equivalence == nil {
 		return "", fmt.Errorf("equivalence ID not found in contract response")
 	}
 
-	return equivalenceId, nil
+	return response.Equivalence.Id, nil
+}
+
+// contractSubjectInfo converts a subject to the subject info of the equivalence contract
+func contractSubjectInfo(subject types.SubjectContent) equivalence.SubjectInfo {
+	return equivalence.SubjectInfo{
+		SubjectId:     subject.Index,
+		Title:         subject.Title,
+		InstitutionId: subject.Institution,
+		Credits:       uint32(subject.Credits),
+		IpfsLink:      subject.IPFSLink,
+		ContentHash:   subject.ContentHash,
+		Metadata: equivalence.SubjectMetadata{
+			Level:         equivalence.AcademicLevelUndergraduate, // Default for now
+			Department:    subject.KnowledgeArea,
+			WorkloadHours: uint32(subject.WorkloadHours),
+			Language:      "português", // Default for now
+		},
+	}
 }
 
 // CheckEquivalenceStatus checks the status of an equivalence analysis
@@ -306,9 +302,9 @@ func (ci *ContractIntegration) CheckEquivalenceStatus(ctx sdk.Context, equivalen
 	}
 
 	// Create query message
-	queryMsg := map[string]interface{}{
-		"get_equivalence": map[string]interface{}{
-			"equivalence_id": equivalenceId,
+	queryMsg := equivalence.QueryMsg{
+		GetEquivalence: &equivalence.GetEquivalenceQuery{
+			EquivalenceId: equivalenceId,
 		},
 	}
 
@@ -329,25 +325,19 @@ func (ci *ContractIntegration) CheckEquivalenceStatus(ctx sdk.Context, equivalen
 	}
 
 	// Parse response
-	var response struct {
-		Equivalence struct {
-			Id                   string `json:"id"`
-			SimilarityPercentage uint32 `json:"similarity_percentage"`
-			Status               string `json:"status"`
-			EquivalenceType      string `json:"equivalence_type"`
-			Notes                string `json:"notes"`
-		} `json:"equivalence"`
-	}
-
+	var response equivalence.EquivalenceResponse
 	if err := json.Unmarshal(res.Data, &response); err != nil {
 		return types.EquivalenceResult{}, fmt.Errorf("failed to unmarshal contract response: %w", err)
 	}
+	if response.Equivalence == nil {
+		return types.EquivalenceResult{}, fmt.Errorf("equivalence %s not found in contract", equivalenceId)
+	}
 
 	return types.EquivalenceResult{
 		EquivalenceId:        response.Equivalence.Id,
 		SimilarityPercentage: response.Equivalence.SimilarityPercentage,
-		Status:               response.Equivalence.Status,
-		EquivalenceType:      response.Equivalence.EquivalenceType,
+		Status:               string(response.Equivalence.Status),
+		EquivalenceType:      string(response.Equivalence.EquivalenceType),
 		Notes:                response.Equivalence.Notes,
 	}, nil
 }
@@ -356,166 +346,147 @@ func (ci *ContractIntegration) CheckEquivalenceStatus(ctx sdk.Context, equivalen
 // ACADEMIC PROGRESS CONTRACT INTEGRATION
 // ============================================================================
 
-// ProcessSubjectCompletion calls Academic Progress Contract to validate and update progress
+// ProcessSubjectCompletion records the completion in the Academic Progress Contract and updates the progress
 func (ci *ContractIntegration) ProcessSubjectCompletion(ctx sdk.Context, request types.SubjectCompletionRequest) (types.SubjectCompletionResult, error) {
 	params := ci.keeper.GetParams(ctx)
-	contractAddr := params.AcademicProgressContractAddr
-
-	if contractAddr == "" {
-		ci.LogContractCall(ctx, "ProcessSubjectCompletion", "none", false, fmt.Errorf("contract not configured"))
-		return ci.mockProcessSubjectCompletion(ctx, request)
-	}
-
-	// Create execute message for contract
-	executeMsg := map[string]interface{}{
-		"process_completion": map[string]interface{}{
-			"student_id":      request.StudentId,
-			"subject_id":      request.SubjectId,
-			"grade":           request.Grade,
-			"completion_date": request.CompletionDate,
-			"semester":        request.Semester,
-			"credits":         request.Credits,
-			"institution":     request.Institution,
-		},
-	}
-
-	execData, err := json.Marshal(executeMsg)
+	contractAddr, err := ci.contractFor(params, "ProcessSubjectCompletion", "academic_progress", params.AcademicProgressContractAddr)
 	if err != nil {
-		ci.LogContractCall(ctx, "ProcessSubjectCompletion", contractAddr, false, err)
-		return types.SubjectCompletionResult{}, fmt.Errorf("failed to marshal execute message: %w", err)
+		return types.SubjectCompletionResult{}, err
 	}
 
-	// Execute contract
-	req := &wasmtypes.MsgExecuteContract{
-		Sender:   ci.GetModuleAddress().String(),
-		Contract: contractAddr,
-		Msg:      execData,
-		Funds:    nil,
-	}
-
-	res, err := ci.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), req)
-	if err != nil {
-		ci.LogContractCall(ctx, "ProcessSubjectCompletion", contractAddr, false, err)
-		return types.SubjectCompletionResult{}, fmt.Errorf("failed to execute academic progress contract: %w", err)
-	}
+	if contractAddr != "" {
+		// Create execute message for contract; grades are on the 0-100 scale
+		executeMsg := progress.ExecuteMsg{
+			RecordSubjectCompletion: &progress.RecordSubjectCompletionMsg{
+				StudentId:      request.StudentId,
+				SubjectId:      request.SubjectId,
+				FinalGrade:     uint32(request.Grade),
+				CompletionDate: request.CompletionDate,
+			},
+		}
 
-	// Parse response from data field
-	var response types.SubjectCompletionResult
-	if res.Data != nil {
-		if err := json.Unmarshal(res.Data, &response); err != nil {
-			ci.LogContractCall(ctx, "ProcessSubjectCompletion", contractAddr, false, err)
-			return types.SubjectCompletionResult{}, fmt.Errorf("failed to parse contract response: %w", err)
+		if _, err := ci.executeContract(ctx, "ProcessSubjectCompletion", contractAddr, executeMsg); err != nil {
+			return types.SubjectCompletionResult{}, fmt.Errorf("failed to execute academic progress contract: %w", err)
 		}
-		ci.LogContractCall(ctx, "ProcessSubjectCompletion", contractAddr, true, nil)
-		return response, nil
 	}
 
-	ci.LogContractCall(ctx, "ProcessSubjectCompletion", contractAddr, false, fmt.Errorf("no completion result in response"))
-	return types.SubjectCompletionResult{}, fmt.Errorf("no completion result found in contract response")
+	// The contract only records the completion, the updated progress is derived from the academic tree
+	result := ci.subjectCompletionProgress(ctx, request)
+	if contractAddr != "" {
+		result.Message = "Subject completion recorded"
+	}
+	return result, nil
 }
 
-// CheckGraduationEligibility calls Degree Contract to check graduation status
+// CheckGraduationEligibility asks the Degree Contract for the requirements the student is missing
 func (ci *ContractIntegration) CheckGraduationEligibility(ctx sdk.Context, studentId string) (types.GraduationEligibilityResult, error) {
 	params := ci.keeper.GetParams(ctx)
-	contractAddr := params.DegreeContractAddr
-
-	if contractAddr == "" {
-		ci.LogContractCall(ctx, "CheckGraduationEligibility", "none", false, fmt.Errorf("contract not configured"))
-		return ci.mockCheckGraduationEligibility(ctx, studentId)
+	contractAddr, err := ci.contractFor(params, "CheckGraduationEligibility", "degree", params.DegreeContractAddr)
+	if err != nil {
+		return types.GraduationEligibilityResult{}, err
 	}
 
-	// Create query message
-	queryMsg := map[string]interface{}{
-		"check_graduation_eligibility": map[string]interface{}{
-			"student_id": studentId,
-		},
+	academicTree, found := ci.keeper.getAcademicTreeByStudent(ctx, studentId)
+	if !found {
+		return types.GraduationEligibilityResult{
+			IsEligible: false,
+			Message:    "No academic tree found",
+		}, nil
 	}
 
-	queryData, err := json.Marshal(queryMsg)
-	if err != nil {
-		ci.LogContractCall(ctx, "CheckGraduationEligibility", contractAddr, false, err)
-		return types.GraduationEligibilityResult{}, fmt.Errorf("failed to marshal query message: %w", err)
+	if contractAddr == "" {
+		return ci.nativeGraduationEligibility(ctx, academicTree)
 	}
 
-	// Query contract
-	req := &wasmtypes.QuerySmartContractStateRequest{
-		Address:   contractAddr,
-		QueryData: queryData,
+	// Curriculum requirements are registered in the contract per course
+	queryMsg := degree.QueryMsg{
+		GetMissingRequirements: &degree.GetMissingRequirementsQuery{
+			StudentId:         studentId,
+			CurriculumId:      academicTree.CourseId,
+			CompletedSubjects: nonNil(academicTree.CompletedTokens),
+		},
 	}
 
-	res, err := ci.wasmQuerier.SmartContractState(sdk.WrapSDKContext(ctx), req)
-	if err != nil {
-		ci.LogContractCall(ctx, "CheckGraduationEligibility", contractAddr, false, err)
+	var missing []string
+	if err := ci.queryContract(ctx, "CheckGraduationEligibility", contractAddr, queryMsg, &missing); err != nil {
 		return types.GraduationEligibilityResult{}, fmt.Errorf("failed to query degree contract: %w", err)
 	}
 
-	// Parse response
-	var response types.GraduationEligibilityResult
-	if err := json.Unmarshal(res.Data, &response); err != nil {
-		ci.LogContractCall(ctx, "CheckGraduationEligibility", contractAddr, false, err)
-		return types.GraduationEligibilityResult{}, fmt.Errorf("failed to unmarshal contract response: %w", err)
+	result := types.GraduationEligibilityResult{
+		IsEligible:                len(missing) == 0,
+		Message:                   "Eligible for graduation",
+		RequiredSubjectsRemaining: missing,
 	}
-
-	ci.LogContractCall(ctx, "CheckGraduationEligibility", contractAddr, true, nil)
-	return response, nil
+	if !result.IsEligible {
+		result.Message = fmt.Sprintf("Missing requirements: %s", strings.Join(missing, "; "))
+	}
+	return result, nil
 }
 
-// RequestNFTMinting calls NFT Minting Contract to authorize NFT creation
+// RequestNFTMinting has the Academic NFT Contract validate the subject NFT data
+// and mints the subject token through the academicnft keeper. Students already
+// holding a token of the subject's token definition keep that one.
 func (ci *ContractIntegration) RequestNFTMinting(ctx sdk.Context, request types.NFTMintingRequest) (types.NFTMintingResult, error) {
 	params := ci.keeper.GetParams(ctx)
-	contractAddr := params.NftMintingContractAddr
-
-	if contractAddr == "" {
-		ci.LogContractCall(ctx, "RequestNFTMinting", "none", false, fmt.Errorf("contract not configured"))
-		return ci.mockRequestNFTMinting(ctx, request)
-	}
-
-	// Create execute message
-	executeMsg := map[string]interface{}{
-		"authorize_minting": map[string]interface{}{
-			"student_address":    request.StudentAddress,
-			"subject_id":         request.SubjectId,
-			"grade":              request.Grade,
-			"completion_date":    request.CompletionDate,
-			"semester":           request.Semester,
-			"issuer_institution": request.IssuerInstitution,
-			"progress_data":      request.ProgressData,
-		},
+	contractAddr, err := ci.contractFor(params, "RequestNFTMinting", "nft_minting", params.NftMintingContractAddr)
+	if err != nil {
+		return types.NFTMintingResult{}, err
 	}
 
-	execData, err := json.Marshal(executeMsg)
+	data, err := json.Marshal(academicnft.SubjectCompletionData{
+		SubjectId:      request.SubjectId,
+		InstitutionId:  request.IssuerInstitution,
+		FinalGrade:     uint32(request.Grade),
+		CompletionDate: request.CompletionDate,
+		Semester:       request.Semester,
+	})
 	if err != nil {
-		ci.LogContractCall(ctx, "RequestNFTMinting", contractAddr, false, err)
-		return types.NFTMintingResult{}, fmt.Errorf("failed to marshal execute message: %w", err)
+		return types.NFTMintingResult{}, fmt.Errorf("failed to marshal subject completion data: %w", err)
+	}
+	metadataHash := sha256.Sum256(data)
+	result := types.NFTMintingResult{MetadataHash: hex.EncodeToString(metadataHash[:])}
+
+	// The grade was already validated against the institution grading scale,
+	// natively the token is minted without a contract validation
+	if contractAddr != "" {
+		executeMsg := academicnft.ExecuteMsg{
+			ValidateNFTData: &academicnft.ValidateNFTDataMsg{
+				NftType: academicnft.NFTTypeSubjectCompletion,
+				Data:    string(data),
+			},
+		}
+
+		if _, err := ci.executeContract(ctx, "RequestNFTMinting", contractAddr, executeMsg); err != nil {
+			return types.NFTMintingResult{}, fmt.Errorf("failed to execute NFT minting contract: %w", err)
+		}
 	}
 
-	// Execute contract
-	req := &wasmtypes.MsgExecuteContract{
-		Sender:   ci.GetModuleAddress().String(),
-		Contract: contractAddr,
-		Msg:      execData,
-		Funds:    nil,
+	if ci.keeper.academicNFTKeeper == nil {
+		result.Message = "Academic NFT module not available"
+		return result, nil
+	}
+	tokenDefs := ci.keeper.tokenDefKeeper.GetTokenDefinitionsBySubject(ctx, request.SubjectId)
+	if len(tokenDefs) == 0 {
+		result.Message = fmt.Sprintf("No token definition for subject %s", request.SubjectId)
+		return result, nil
 	}
 
-	res, err := ci.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), req)
-	if err != nil {
-		ci.LogContractCall(ctx, "RequestNFTMinting", contractAddr, false, err)
-		return types.NFTMintingResult{}, fmt.Errorf("failed to execute NFT minting contract: %w", err)
+	if existing, found := ci.keeper.academicNFTKeeper.GetStudentTokenForTokenDef(ctx, request.StudentAddress, tokenDefs[0].Index); found {
+		result.Success = true
+		result.TokenInstanceId = existing
+		result.Message = "Subject token already minted"
+		return result, nil
 	}
 
-	// Parse response from data field
-	var response types.NFTMintingResult
-	if res.Data != nil {
-		if err := json.Unmarshal(res.Data, &response); err != nil {
-			ci.LogContractCall(ctx, "RequestNFTMinting", contractAddr, false, err)
-			return types.NFTMintingResult{}, fmt.Errorf("failed to parse contract response: %w", err)
-		}
-		ci.LogContractCall(ctx, "RequestNFTMinting", contractAddr, true, nil)
-		return response, nil
+	tokenInstanceId, err := ci.keeper.academicNFTKeeper.MintSubjectToken(ctx, tokenDefs[0].Index, request.StudentAddress, request.CompletionDate, request.GradeValue, request.IssuerInstitution, request.Semester, "")
+	if err != nil {
+		return types.NFTMintingResult{}, fmt.Errorf("failed to mint subject token: %w", err)
 	}
 
-	ci.LogContractCall(ctx, "RequestNFTMinting", contractAddr, false, fmt.Errorf("no minting result in response"))
-	return types.NFTMintingResult{}, fmt.Errorf("no minting result found in contract response")
+	result.Success = true
+	result.TokenInstanceId = tokenInstanceId
+	result.Message = "Subject token minted"
+	return result, nil
 }
 
 // ============================================================================
@@ -525,286 +496,188 @@ func (ci *ContractIntegration) RequestNFTMinting(ctx sdk.Context, request types.
 // ValidateDegreeRequirements calls Degree Contract to validate graduation requirements
 func (ci *ContractIntegration) ValidateDegreeRequirements(ctx sdk.Context, request types.DegreeValidationRequest) (types.DegreeValidationResult, error) {
 	params := ci.keeper.GetParams(ctx)
-	contractAddr := params.DegreeContractAddr
-
-	if contractAddr == "" {
-		ci.LogContractCall(ctx, "ValidateDegreeRequirements", "none", false, fmt.Errorf("contract not configured"))
-		return ci.mockValidateDegreeRequirements(ctx, request)
+	contractAddr, err := ci.contractFor(params, "ValidateDegreeRequirements", "degree", params.DegreeContractAddr)
+	if err != nil {
+		return types.DegreeValidationResult{}, err
 	}
 
-	// Create execute message for degree validation
-	executeMsg := map[string]interface{}{
-		"validate_degree_requirements": map[string]interface{}{
-			"student_id":     request.StudentId,
-			"curriculum_id":  request.CurriculumId,
-			"institution_id": request.InstitutionId,
-			"final_gpa":      request.FinalGPA,
-			"total_credits":  request.TotalCredits,
-			"signatures":     request.Signatures,
-			"requested_date": request.RequestedDate,
-		},
+	if contractAddr == "" {
+		return ci.nativeDegreeValidation(ctx, request)
 	}
 
-	execData, err := json.Marshal(executeMsg)
-	if err != nil {
-		ci.LogContractCall(ctx, "ValidateDegreeRequirements", contractAddr, false, err)
-		return types.DegreeValidationResult{}, fmt.Errorf("failed to marshal execute message: %w", err)
+	completedSubjects := request.CompletedSubjects
+	if len(completedSubjects) == 0 {
+		if academicTree, found := ci.keeper.getAcademicTreeByStudent(ctx, request.StudentId); found {
+			completedSubjects = academicTree.CompletedTokens
+		}
 	}
 
-	// Execute contract
-	req := &wasmtypes.MsgExecuteContract{
-		Sender:   ci.GetModuleAddress().String(),
-		Contract: contractAddr,
-		Msg:      execData,
-		Funds:    nil,
+	// Create execute message for degree validation
+	executeMsg := degree.ExecuteMsg{
+		ValidateDegreeRequirements: &degree.ValidateDegreeRequirementsMsg{
+			StudentId:         request.StudentId,
+			CurriculumId:      request.CurriculumId,
+			InstitutionId:     request.InstitutionId,
+			FinalGpa:          request.FinalGPA,
+			TotalCredits:      request.TotalCredits,
+			CompletedSubjects: nonNil(completedSubjects),
+			Signatures:        nonNil(request.Signatures),
+			RequestedDate:     request.RequestedDate,
+		},
 	}
 
-	res, err := ci.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), req)
+	data, err := ci.executeContract(ctx, "ValidateDegreeRequirements", contractAddr, executeMsg)
 	if err != nil {
-		ci.LogContractCall(ctx, "ValidateDegreeRequirements", contractAddr, false, err)
 		return types.DegreeValidationResult{}, fmt.Errorf("failed to execute degree validation contract: %w", err)
 	}
+	if data == nil {
+		return types.DegreeValidationResult{}, fmt.Errorf("no validation result found in contract response")
+	}
 
-	// Parse response from data field
-	var response types.DegreeValidationResult
-	if res.Data != nil {
-		if err := json.Unmarshal(res.Data, &response); err != nil {
-			ci.LogContractCall(ctx, "ValidateDegreeRequirements", contractAddr, false, err)
-			return types.DegreeValidationResult{}, fmt.Errorf("failed to parse contract response: %w", err)
-		}
-		ci.LogContractCall(ctx, "ValidateDegreeRequirements", contractAddr, true, nil)
-		return response, nil
+	var response degree.ValidateDegreeRequirementsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return types.DegreeValidationResult{}, fmt.Errorf("failed to parse contract response: %w", err)
 	}
 
-	ci.LogContractCall(ctx, "ValidateDegreeRequirements", contractAddr, false, fmt.Errorf("no validation result in response"))
-	return types.DegreeValidationResult{}, fmt.Errorf("no validation result found in contract response")
+	return types.DegreeValidationResult{
+		IsValid:             response.IsValid,
+		Message:             response.Message,
+		DegreeType:          response.DegreeType,
+		CurriculumVersion:   response.CurriculumVersion,
+		ValidationHash:      response.ValidationHash,
+		RequirementsMet:     response.RequirementsMet,
+		MissingRequirements: response.MissingRequirements,
+	}, nil
 }
 
-// AuthorizeDegreeNFTMinting calls Degree Contract to authorize degree NFT minting
+// AuthorizeDegreeNFTMinting has the Academic NFT Contract validate the degree NFT data before the token is minted
 func (ci *ContractIntegration) AuthorizeDegreeNFTMinting(ctx sdk.Context, request types.DegreeNFTMintingRequest) (types.DegreeNFTMintingResult, error) {
 	params := ci.keeper.GetParams(ctx)
-	contractAddr := params.DegreeContractAddr
-
-	if contractAddr == "" {
-		ci.LogContractCall(ctx, "AuthorizeDegreeNFTMinting", "none", false, fmt.Errorf("contract not configured"))
-		return ci.mockAuthorizeDegreeNFTMinting(ctx, request)
-	}
-
-	// Create execute message for degree NFT authorization
-	executeMsg := map[string]interface{}{
-		"authorize_degree_nft": map[string]interface{}{
-			"student_id":      request.StudentId,
-			"curriculum_id":   request.CurriculumId,
-			"institution_id":  request.InstitutionId,
-			"degree_type":     request.DegreeType,
-			"final_gpa":       request.FinalGPA,
-			"total_credits":   request.TotalCredits,
-			"validation_data": request.ValidationData,
-			"issue_date":      request.IssueDate,
-		},
-	}
-
-	execData, err := json.Marshal(executeMsg)
+	contractAddr, err := ci.contractFor(params, "AuthorizeDegreeNFTMinting", "nft_minting", params.NftMintingContractAddr)
 	if err != nil {
-		ci.LogContractCall(ctx, "AuthorizeDegreeNFTMinting", contractAddr, false, err)
-		return types.DegreeNFTMintingResult{}, fmt.Errorf("failed to marshal execute message: %w", err)
+		return types.DegreeNFTMintingResult{}, err
 	}
 
-	// Execute contract
-	req := &wasmtypes.MsgExecuteContract{
-		Sender:   ci.GetModuleAddress().String(),
-		Contract: contractAddr,
-		Msg:      execData,
-		Funds:    nil,
-	}
-
-	res, err := ci.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), req)
-	if err != nil {
-		ci.LogContractCall(ctx, "AuthorizeDegreeNFTMinting", contractAddr, false, err)
-		return types.DegreeNFTMintingResult{}, fmt.Errorf("failed to execute degree NFT authorization contract: %w", err)
+	if !request.ValidationData.IsValid {
+		return types.DegreeNFTMintingResult{
+			Success: false,
+			Message: "Degree requirements have not been validated",
+		}, nil
 	}
 
-	// Parse response from data field
-	var response types.DegreeNFTMintingResult
-	if res.Data != nil {
-		if err := json.Unmarshal(res.Data, &response); err != nil {
-			ci.LogContractCall(ctx, "AuthorizeDegreeNFTMinting", contractAddr, false, err)
-			return types.DegreeNFTMintingResult{}, fmt.Errorf("failed to parse contract response: %w", err)
+	if contractAddr != "" {
+		data, err := json.Marshal(academicnft.DegreeCompletionData{
+			InstitutionId:  request.InstitutionId,
+			CourseId:       request.CurriculumId,
+			DegreeType:     request.DegreeType,
+			GraduationDate: request.IssueDate,
+			FinalGpa:       request.FinalGPA,
+			TotalCredits:   uint32(request.TotalCredits),
+		})
+		if err != nil {
+			return types.DegreeNFTMintingResult{}, fmt.Errorf("failed to marshal degree completion data: %w", err)
 		}
-		ci.LogContractCall(ctx, "AuthorizeDegreeNFTMinting", contractAddr, true, nil)
-		return response, nil
-	}
 
-	ci.LogContractCall(ctx, "AuthorizeDegreeNFTMinting", contractAddr, false, fmt.Errorf("no degree NFT result in response"))
-	return types.DegreeNFTMintingResult{}, fmt.Errorf("no degree NFT result found in contract response")
-}
-
-// ============================================================================
-// MOCK IMPLEMENTATIONS FOR DEVELOPMENT
-// ============================================================================
-
-// mockProcessSubjectCompletion provides mock data when contract is not available
-func (ci *ContractIntegration) mockProcessSubjectCompletion(ctx sdk.Context, request types.SubjectCompletionRequest) (types.SubjectCompletionResult, error) {
-	// Get current academic tree to simulate progress calculation
-	academicTree, found := ci.keeper.getAcademicTreeByStudent(ctx, request.StudentId)
-	if !found {
-		// Return basic progress data
-		return types.SubjectCompletionResult{
-			Success:                   true,
-			Message:                   "Subject completion validated (mock)",
-			UpdatedCompletedSubjects:  []string{request.SubjectId},
-			UpdatedInProgressSubjects: []string{},
-			UpdatedProgress: types.AcademicProgress{
-				RequiredCreditsCompleted:   request.Credits,
-				ElectiveCreditsCompleted:   0,
-				RequiredSubjectsPercentage: 10.0, // Mock 10% progress
-				CurrentSemester:            1,
-				CurrentYear:                1,
-				EnrollmentYears:            0.5,
-				ElectivesByAreaCompleted:   make(map[string]uint64),
+		executeMsg := academicnft.ExecuteMsg{
+			ValidateNFTData: &academicnft.ValidateNFTDataMsg{
+				NftType: academicnft.NFTTypeDegree,
+				Data:    string(data),
 			},
-			ShouldCheckGraduation: false,
-		}, nil
-	}
-
-	// Simulate progress update
-	updatedCompleted := append(academicTree.CompletedTokens, request.SubjectId)
-	updatedInProgress := ci.removeTokenFromList(academicTree.InProgressTokens, request.SubjectId)
-
-	// Calculate mock progress
-	totalCredits := request.Credits
-	if academicTree.AcademicProgress != nil {
-		totalCredits += academicTree.AcademicProgress.RequiredCreditsCompleted
-	}
-
-	progressPercentage := float32(float64(len(updatedCompleted)) * 5.0) // Mock: each subject = 5% progress
-	shouldCheckGraduation := len(updatedCompleted) >= 8                 // Mock: 8 subjects = eligible for graduation
-
-	return types.SubjectCompletionResult{
-		Success:                   true,
-		Message:                   "Subject completion validated (mock)",
-		UpdatedCompletedSubjects:  updatedCompleted,
-		UpdatedInProgressSubjects: updatedInProgress,
-		UpdatedProgress: types.AcademicProgress{
-			RequiredCreditsCompleted:   totalCredits,
-			ElectiveCreditsCompleted:   0,
-			RequiredSubjectsPercentage: progressPercentage,
-			CurrentSemester:            1,
-			CurrentYear:                1,
-			EnrollmentYears:            0.5,
-			ElectivesByAreaCompleted:   make(map[string]uint64),
-		},
-		ShouldCheckGraduation: shouldCheckGraduation,
-	}, nil
-}
+		}
 
-// mockCheckGraduationEligibility provides mock graduation eligibility data
-func (ci *ContractIntegration) mockCheckGraduationEligibility(ctx sdk.Context, studentId string) (types.GraduationEligibilityResult, error) {
-	academicTree, found := ci.keeper.getAcademicTreeByStudent(ctx, studentId)
-	if !found {
-		return types.GraduationEligibilityResult{
-			IsEligible: false,
-			Message:    "No academic tree found",
-		}, nil
+		if _, err := ci.executeContract(ctx, "AuthorizeDegreeNFTMinting", contractAddr, executeMsg); err != nil {
+			return types.DegreeNFTMintingResult{}, fmt.Errorf("failed to execute degree NFT authorization contract: %w", err)
+		}
 	}
 
-	// Mock graduation criteria: 8+ completed subjects
-	isEligible := len(academicTree.CompletedTokens) >= 8
-	message := "Not eligible yet"
-	estimatedDate := "2025-12-01"
-
-	if isEligible {
-		message = "Eligible for graduation"
-		estimatedDate = "2025-06-01"
+	// Degree tokens are minted by the degree module once it issues the degree
+	if contractAddr == "" {
+		return types.DegreeNFTMintingResult{
+			Success: false,
+			Message: "No NFT minting contract to authorize the degree NFT",
+		}, nil
 	}
-
-	return types.GraduationEligibilityResult{
-		IsEligible:                isEligible,
-		Message:                   message,
-		EstimatedGraduationDate:   estimatedDate,
-		RequiredCreditsRemaining:  0,
-		RequiredSubjectsRemaining: []string{},
+	return types.DegreeNFTMintingResult{
+		Success: true,
+		Message: "Degree NFT authorization granted",
 	}, nil
 }
 
-// mockRequestNFTMinting provides mock NFT minting authorization
-func (ci *ContractIntegration) mockRequestNFTMinting(ctx sdk.Context, request types.NFTMintingRequest) (types.NFTMintingResult, error) {
-	// Generate mock token instance ID
-	tokenInstanceId := fmt.Sprintf("mock-token-%s-%s-%d", request.StudentAddress[:8], request.SubjectId, ctx.BlockHeight())
-
-	return types.NFTMintingResult{
-		Success:         true,
-		TokenInstanceId: tokenInstanceId,
-		Message:         "NFT minting authorized (mock)",
-		MetadataHash:    "mock-metadata-hash",
-	}, nil
+// contractFor resolves the contract serving an operation under the integration
+// mode of params. An empty address with no error means the operation is
+// evaluated natively; a contract mode without the contract and a disabled
+// integration fail instead of falling back.
+func (ci *ContractIntegration) contractFor(params types.Params, operation string, contract string, contractAddr string) (string, error) {
+	switch mode := params.Mode(); mode {
+	case types.IntegrationModeContract:
+		if contractAddr == "" {
+			ci.keeper.Logger().Error("Contract not configured", "operation", operation, "contract", contract)
+			return "", types.ErrContractNotConfigured.Wrapf("%s requires the %s contract in %s integration mode", operation, contract, mode)
+		}
+		return contractAddr, nil
+	case types.IntegrationModeNative:
+		return "", nil
+	case types.IntegrationModeDisabled:
+		return "", types.ErrIntegrationDisabled.Wrapf("%s is unavailable while the integration is disabled", operation)
+	default:
+		return "", types.ErrInvalidIntegrationMode.Wrapf("%s cannot run in integration mode %q", operation, mode)
+	}
 }
 
-// mockValidateDegreeRequirements provides mock degree validation
-func (ci *ContractIntegration) mockValidateDegreeRequirements(ctx sdk.Context, request types.DegreeValidationRequest) (types.DegreeValidationResult, error) {
-	// Get student's academic record for mock validation
-	academicTree, found := ci.keeper.getAcademicTreeByStudent(ctx, request.StudentId)
-	if !found {
-		return types.DegreeValidationResult{
-			IsValid:             false,
-			Message:             "No academic record found for student",
-			MissingRequirements: []string{"academic_record"},
-		}, nil
+// executeContract executes a typed message on a contract from the module account and returns the response data
+func (ci *ContractIntegration) executeContract(ctx sdk.Context, operation string, contractAddr string, msg any) ([]byte, error) {
+	execData, err := json.Marshal(msg)
+	if err != nil {
+		ci.LogContractCall(ctx, operation, contractAddr, false, err)
+		return nil, fmt.Errorf("failed to marshal execute message: %w", err)
 	}
 
-	// Mock graduation criteria
-	requiredCredits := uint64(120)
-	requiredSubjects := 8
-	currentCredits := uint64(0)
-	if academicTree.AcademicProgress != nil {
-		currentCredits = academicTree.AcademicProgress.RequiredCreditsCompleted
+	res, err := ci.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), &wasmtypes.MsgExecuteContract{
+		Sender:   ci.GetModuleAddress().String(),
+		Contract: contractAddr,
+		Msg:      execData,
+		Funds:    nil,
+	})
+	if err != nil {
+		ci.LogContractCall(ctx, operation, contractAddr, false, err)
+		return nil, err
 	}
 
-	// Check if requirements are met
-	isValid := len(academicTree.CompletedTokens) >= requiredSubjects && currentCredits >= requiredCredits
-	message := "Requirements not met"
-	requirementsMet := []string{}
-	missingRequirements := []string{}
+	ci.LogContractCall(ctx, operation, contractAddr, true, nil)
+	return res.Data, nil
+}
 
-	if len(academicTree.CompletedTokens) >= requiredSubjects {
-		requirementsMet = append(requirementsMet, "required_subjects")
-	} else {
-		missingRequirements = append(missingRequirements, "required_subjects")
+// queryContract runs a typed smart query on a contract and decodes the response
+func (ci *ContractIntegration) queryContract(ctx sdk.Context, operation string, contractAddr string, msg any, response any) error {
+	queryData, err := json.Marshal(msg)
+	if err != nil {
+		ci.LogContractCall(ctx, operation, contractAddr, false, err)
+		return fmt.Errorf("failed to marshal query message: %w", err)
 	}
 
-	if currentCredits >= requiredCredits {
-		requirementsMet = append(requirementsMet, "required_credits")
-	} else {
-		missingRequirements = append(missingRequirements, "required_credits")
+	res, err := ci.wasmQuerier.SmartContractState(sdk.WrapSDKContext(ctx), &wasmtypes.QuerySmartContractStateRequest{
+		Address:   contractAddr,
+		QueryData: queryData,
+	})
+	if err != nil {
+		ci.LogContractCall(ctx, operation, contractAddr, false, err)
+		return err
 	}
-
-	if isValid {
-		message = "All graduation requirements met"
+	if err := json.Unmarshal(res.Data, response); err != nil {
+		ci.LogContractCall(ctx, operation, contractAddr, false, err)
+		return fmt.Errorf("failed to unmarshal contract response: %w", err)
 	}
 
-	return types.DegreeValidationResult{
-		IsValid:             isValid,
-		Message:             message,
-		DegreeType:          "Bachelor",
-		CurriculumVersion:   "1.0",
-		ValidationHash:      fmt.Sprintf("mock-validation-%s-%d", request.StudentId, ctx.BlockHeight()),
-		RequirementsMet:     requirementsMet,
-		MissingRequirements: missingRequirements,
-	}, nil
+	ci.LogContractCall(ctx, operation, contractAddr, true, nil)
+	return nil
 }
 
-// mockAuthorizeDegreeNFTMinting provides mock degree NFT authorization
-func (ci *ContractIntegration) mockAuthorizeDegreeNFTMinting(ctx sdk.Context, request types.DegreeNFTMintingRequest) (types.DegreeNFTMintingResult, error) {
-	// Generate mock degree token ID
-	tokenId := fmt.Sprintf("degree-nft-%s-%s-%d", request.StudentId, request.InstitutionId, ctx.BlockHeight())
-	ipfsHash := fmt.Sprintf("QmMockDegreeHash%s", request.StudentId[:8])
-
-	return types.DegreeNFTMintingResult{
-		Success:          true,
-		TokenId:          tokenId,
-		MetadataIPFSHash: ipfsHash,
-		Message:          "Degree NFT authorization granted (mock)",
-	}, nil
+// nonNil returns an empty list for nil, contracts reject null for required lists
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
 }
 
 // removeTokenFromList helper function
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/x/student/keeper"
+	"academictoken/x/student/types"
+)
+
+func setupIntegration(t *testing.T, mode string) (keeper.Keeper, sdk.Context, *keeper.ContractIntegration) {
+	k, ctx := keepertest.StudentKeeper(t)
+	params := k.GetParams(ctx)
+	params.IntegrationMode = mode
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// The mock curriculum of course-1 requires subject-1 and subject-2
+	k.SetStudentAcademicTree(ctx, types.StudentAcademicTree{
+		Index:             "tree-1",
+		Student:           "student-1",
+		CourseId:          "course-1",
+		CurriculumVersion: "v1.0",
+		CompletedTokens:   []string{"subject-1"},
+		InProgressTokens:  []string{"subject-2"},
+		AcademicProgress:  &types.AcademicProgress{RequiredCreditsCompleted: 4},
+	})
+	k.SetDegreeKeeper(keepertest.MockStudentDegreeKeeper{Evaluations: map[string]types.GraduationEvaluation{
+		"student-1": {
+			CurriculumId: "curriculum-1",
+			Missing: []types.MissingGraduationRequirement{
+				{Requirement: "required_subjects", Description: "1 required subjects not completed", Required: "2", Completed: "1", SubjectIds: []string{"subject-2"}},
+				{Requirement: "total_credits", Description: "116 credits remaining", Required: "120", Completed: "4"},
+			},
+		},
+	}})
+
+	return k, ctx, k.GetContractIntegration().(*keeper.ContractIntegration)
+}
+
+func TestNativeIntegration(t *testing.T) {
+	_, ctx, ci := setupIntegration(t, types.IntegrationModeNative)
+
+	result, err := ci.ProcessSubjectCompletion(ctx, types.SubjectCompletionRequest{StudentId: "student-1", SubjectId: "subject-2", Credits: 4})
+	require.NoError(t, err)
+	require.Equal(t, []string{"subject-1", "subject-2"}, result.UpdatedCompletedSubjects)
+	require.Empty(t, result.UpdatedInProgressSubjects)
+	require.Equal(t, uint64(8), result.UpdatedProgress.RequiredCreditsCompleted)
+	require.Equal(t, float32(100), result.UpdatedProgress.RequiredSubjectsPercentage)
+	require.True(t, result.ShouldCheckGraduation)
+
+	// Completing a subject again adds no credits
+	result, err = ci.ProcessSubjectCompletion(ctx, types.SubjectCompletionRequest{StudentId: "student-1", SubjectId: "subject-1", Credits: 4})
+	require.NoError(t, err)
+	require.Equal(t, []string{"subject-1"}, result.UpdatedCompletedSubjects)
+	require.Equal(t, uint64(4), result.UpdatedProgress.RequiredCreditsCompleted)
+	require.Equal(t, float32(50), result.UpdatedProgress.RequiredSubjectsPercentage)
+	require.False(t, result.ShouldCheckGraduation)
+
+	eligibility, err := ci.CheckGraduationEligibility(ctx, "student-1")
+	require.NoError(t, err)
+	require.False(t, eligibility.IsEligible)
+	require.Equal(t, []string{"subject-2"}, eligibility.RequiredSubjectsRemaining)
+	require.Equal(t, uint64(116), eligibility.RequiredCreditsRemaining)
+
+	validation, err := ci.ValidateDegreeRequirements(ctx, types.DegreeValidationRequest{StudentId: "student-1"})
+	require.NoError(t, err)
+	require.False(t, validation.IsValid)
+	require.Equal(t, "v1.0", validation.CurriculumVersion)
+	require.Contains(t, validation.MissingRequirements, "required_subjects: 1 required subjects not completed")
+	require.NotEmpty(t, validation.ValidationHash)
+
+	// Prerequisites are evaluated by the subject keeper
+	canEnroll, missing, err := ci.CheckPrerequisites(ctx, "student-1", "subject-2")
+	require.NoError(t, err)
+	require.True(t, canEnroll)
+	require.Empty(t, missing)
+
+	minting, err := ci.RequestNFTMinting(ctx, types.NFTMintingRequest{StudentAddress: "student-address", SubjectId: "subject-2", Grade: 90})
+	require.NoError(t, err)
+	require.True(t, minting.Success)
+	require.Equal(t, "token-token-1-student-address", minting.TokenInstanceId)
+	require.Len(t, minting.MetadataHash, 64)
+
+	// Degree tokens are not minted by the student module
+	degreeMinting, err := ci.AuthorizeDegreeNFTMinting(ctx, types.DegreeNFTMintingRequest{StudentId: "student-1", ValidationData: validation})
+	require.NoError(t, err)
+	require.False(t, degreeMinting.Success)
+	require.Empty(t, degreeMinting.TokenId)
+}
+
+func TestIntegrationFailsClosed(t *testing.T) {
+	completion := types.SubjectCompletionRequest{StudentId: "student-1", SubjectId: "subject-2", Credits: 4}
+
+	// Disabled integrations never produce results
+	_, ctx, ci := setupIntegration(t, types.IntegrationModeDisabled)
+	_, err := ci.ProcessSubjectCompletion(ctx, completion)
+	require.ErrorIs(t, err, types.ErrIntegrationDisabled)
+	_, err = ci.RequestNFTMinting(ctx, types.NFTMintingRequest{SubjectId: "subject-2"})
+	require.ErrorIs(t, err, types.ErrIntegrationDisabled)
+	_, _, err = ci.CheckPrerequisites(ctx, "student-1", "subject-2")
+	require.ErrorIs(t, err, types.ErrIntegrationDisabled)
+
+	// Contract mode without the contract fails instead of falling back
+	_, ctx, ci = setupIntegration(t, types.IntegrationModeContract)
+	_, err = ci.ProcessSubjectCompletion(ctx, completion)
+	require.ErrorIs(t, err, types.ErrContractNotConfigured)
+	_, err = ci.CheckGraduationEligibility(ctx, "student-1")
+	require.ErrorIs(t, err, types.ErrContractNotConfigured)
+	_, err = ci.ValidateDegreeRequirements(ctx, types.DegreeValidationRequest{StudentId: "student-1"})
+	require.ErrorIs(t, err, types.ErrContractNotConfigured)
+	_, _, err = ci.CheckPrerequisites(ctx, "student-1", "subject-2")
+	require.ErrorIs(t, err, types.ErrContractNotConfigured)
+
+	_, ctx, ci = setupIntegration(t, "mock")
+	_, err = ci.ProcessSubjectCompletion(ctx, completion)
+	require.ErrorIs(t, err, types.ErrInvalidIntegrationMode)
+}
+
+func TestContractIntegration(t *testing.T) {
+	k, ctx, ci := setupIntegration(t, types.IntegrationModeContract)
+	contract := sdk.AccAddress("contract____________").String()
+	params := k.GetParams(ctx)
+	params.AcademicProgressContractAddr = contract
+	params.DegreeContractAddr = contract
+	params.NftMintingContractAddr = contract
+	require.NoError(t, params.Validate())
+	require.NoError(t, k.SetParams(ctx, params))
+
+	result, err := ci.ProcessSubjectCompletion(ctx, types.SubjectCompletionRequest{StudentId: "student-1", SubjectId: "subject-2", Credits: 4})
+	require.NoError(t, err)
+	require.Equal(t, "Subject completion recorded", result.Message)
+	require.Equal(t, uint64(8), result.UpdatedProgress.RequiredCreditsCompleted)
+
+	eligibility, err := ci.CheckGraduationEligibility(ctx, "student-1")
+	require.NoError(t, err)
+	require.True(t, eligibility.IsEligible)
+
+	validation, err := ci.ValidateDegreeRequirements(ctx, types.DegreeValidationRequest{StudentId: "student-1"})
+	require.NoError(t, err)
+	require.True(t, validation.IsValid)
+}
+
+func TestIntegrationModeQuery(t *testing.T) {
+	k, ctx, _ := setupIntegration(t, types.IntegrationModeNative)
+
+	res, err := k.IntegrationMode(ctx, &types.QueryIntegrationModeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.IntegrationModeNative, res.Mode)
+	require.True(t, res.Ready)
+	require.Len(t, res.Contracts, 5)
+	for _, contract := range res.Contracts {
+		require.False(t, contract.Required)
+	}
+
+	k, ctx, _ = setupIntegration(t, types.IntegrationModeContract)
+	res, err = k.IntegrationMode(ctx, &types.QueryIntegrationModeRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Ready)
+	require.Equal(t, "The academic_progress contract is not configured", res.Message)
+
+	// Params stored without a mode are reported as disabled
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+	res, err = k.IntegrationMode(ctx, &types.QueryIntegrationModeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.IntegrationModeDisabled, res.Mode)
+	require.False(t, res.Ready)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/student/types"
+)
+
+// curriculumVersion returns the curriculum of a course with the given version
+func (k Keeper) curriculumVersion(ctx sdk.Context, courseId string, version string) (types.CurriculumTree, bool) {
+	for _, curriculum := range k.curriculumKeeper.GetCurriculumTreesByCourse(ctx, courseId) {
+		if curriculum.Version == version {
+			return curriculum, true
+		}
+	}
+	return types.CurriculumTree{}, false
+}
+
+// RequestCurriculumMigration records a student's request to move to a newer
+// published curriculum version of their course. The migration takes effect
+// once an institution admin or course registrar approves it.
+func (k msgServer) RequestCurriculumMigration(goCtx context.Context, msg *types.MsgRequestCurriculumMigration) (*types.MsgRequestCurriculumMigrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	student, found := k.getStudentByIndex(ctx, msg.StudentId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrStudentNotFound, "student with ID %s not found", msg.StudentId)
+	}
+	if msg.Creator != student.Address {
+		return nil, errorsmod.Wrapf(types.ErrNotAuthorized, "only student %s can request a curriculum migration", msg.StudentId)
+	}
+
+	academicTree, found := k.getAcademicTreeByStudent(ctx, msg.StudentId)
+	if !found || academicTree.CourseId == "" {
+		return nil, errorsmod.Wrapf(types.ErrAcademicTreeNotFound, "student %s does not follow a course curriculum", msg.StudentId)
+	}
+
+	for _, migration := range academicTree.CurriculumMigrations {
+		if migration.Status == types.CurriculumMigrationStatusPending {
+			return nil, errorsmod.Wrapf(types.ErrCurriculumMigrationPending, "migration %s to version %s is awaiting review", migration.Id, migration.ToVersion)
+		}
+	}
+
+	target, found := k.curriculumVersion(ctx, academicTree.CourseId, msg.TargetVersion)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCurriculumNotFound, "no curriculum version %s for course %s", msg.TargetVersion, academicTree.CourseId)
+	}
+	if !target.Published {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCurriculumMigration, "curriculum version %s is not published", msg.TargetVersion)
+	}
+	if current, found := k.curriculumVersion(ctx, academicTree.CourseId, academicTree.CurriculumVersion); found && !k.curriculumKeeper.PublishedAfter(target, current) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCurriculumMigration, "curriculum version %s is not newer than %s", msg.TargetVersion, academicTree.CurriculumVersion)
+	}
+
+	migration := &types.CurriculumMigration{
+		Id:          strconv.Itoa(len(academicTree.CurriculumMigrations) + 1),
+		FromVersion: academicTree.CurriculumVersion,
+		ToVersion:   target.Version,
+		Reason:      msg.Reason,
+		RequestedBy: msg.Creator,
+		RequestedAt: ctx.BlockTime().Format(time.RFC3339),
+		Status:      types.CurriculumMigrationStatusPending,
+	}
+	academicTree.CurriculumMigrations = append(academicTree.CurriculumMigrations, migration)
+	k.setStudentAcademicTree(ctx, academicTree)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRequestCurriculumMigration,
+			sdk.NewAttribute(types.AttributeKeyStudent, msg.StudentId),
+			sdk.NewAttribute(types.AttributeKeyMigrationId, migration.Id),
+			sdk.NewAttribute(types.AttributeKeyFromVersion, migration.FromVersion),
+			sdk.NewAttribute(types.AttributeKeyToVersion, migration.ToVersion),
+		),
+	)
+
+	return &types.MsgRequestCurriculumMigrationResponse{MigrationId: migration.Id}, nil
+}
+
+// ReviewCurriculumMigration approves or rejects a pending curriculum migration.
+// Approval re-pins the academic tree and the student's open enrollments in the
+// course to the target version.
+func (k msgServer) ReviewCurriculumMigration(goCtx context.Context, msg *types.MsgReviewCurriculumMigration) (*types.MsgReviewCurriculumMigrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	student, found := k.getStudentByIndex(ctx, msg.StudentId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrStudentNotFound, "student with ID %s not found", msg.StudentId)
+	}
+
+	academicTree, found := k.getAcademicTreeByStudent(ctx, msg.StudentId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAcademicTreeNotFound, "academic tree for student %s not found", msg.StudentId)
+	}
+
+	var migration *types.CurriculumMigration
+	for _, candidate := range academicTree.CurriculumMigrations {
+		if candidate.Id == msg.MigrationId {
+			migration = candidate
+			break
+		}
+	}
+	if migration == nil {
+		return nil, errorsmod.Wrapf(types.ErrCurriculumMigrationNotFound, "migration %s not found for student %s", msg.MigrationId, msg.StudentId)
+	}
+	if migration.Status != types.CurriculumMigrationStatusPending {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCurriculumMigration, "migration %s was already %s", msg.MigrationId, migration.Status)
+	}
+
+	// Students cannot approve their own migration
+	if msg.Creator == student.Address || !k.institutionKeeper.CanManageEnrollment(ctx, academicTree.Institution, msg.Creator, academicTree.CourseId) {
+		return nil, errorsmod.Wrapf(types.ErrNotAuthorized, "address %s cannot review curriculum migrations of course %s", msg.Creator, academicTree.CourseId)
+	}
+
+	migration.ReviewedBy = msg.Creator
+	migration.ReviewedAt = ctx.BlockTime().Format(time.RFC3339)
+	migration.ReviewNote = msg.Note
+	migration.Status = types.CurriculumMigrationStatusRejected
+	if msg.Approve {
+		migration.Status = types.CurriculumMigrationStatusApproved
+		academicTree.CurriculumVersion = migration.ToVersion
+
+		for _, enrollment := range k.getEnrollmentsByStudentId(ctx, msg.StudentId) {
+			if enrollment.CourseId != academicTree.CourseId {
+				continue
+			}
+			if enrollment.Status == types.EnrollmentStatusActive || enrollment.Status == types.EnrollmentStatusPending {
+				enrollment.CurriculumVersion = migration.ToVersion
+				k.setStudentEnrollment(ctx, enrollment)
+			}
+		}
+	}
+	k.setStudentAcademicTree(ctx, academicTree)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReviewCurriculumMigration,
+			sdk.NewAttribute(types.AttributeKeyStudent, msg.StudentId),
+			sdk.NewAttribute(types.AttributeKeyMigrationId, migration.Id),
+			sdk.NewAttribute(types.AttributeKeyStatus, migration.Status),
+			sdk.NewAttribute(types.AttributeKeyCurriculumVersion, academicTree.CurriculumVersion),
+			sdk.NewAttribute(types.AttributeKeyUpdatedBy, msg.Creator),
+		),
+	)
+
+	return &types.MsgReviewCurriculumMigrationResponse{CurriculumVersion: academicTree.CurriculumVersion}, nil
+}
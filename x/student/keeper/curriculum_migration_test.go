@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/student/keeper"
+	"academictoken/x/student/types"
+)
+
+func TestCurriculumMigration(t *testing.T) {
+	curricula := []types.CurriculumTree{
+		{Index: "0", CourseId: "course-1", Version: "2023", Published: true},
+		{Index: "1", CourseId: "course-1", Version: "2024", Published: true, PublishedAt: "2024-01-10T00:00:00Z"},
+		{Index: "2", CourseId: "course-1", Version: "2025", Published: false},
+	}
+	k, ctx := keepertest.StudentKeeperWithCurricula(t, curricula)
+	srv := keeper.NewMsgServerImpl(k)
+	address, registrar := sample.AccAddress(), sample.AccAddress()
+
+	// New enrollments pin the latest published version and carry it to the academic tree
+	k.SetStudent(ctx, types.Student{Index: "student-1", Address: address, Name: "Ada"})
+	k.SetStudentAcademicTree(ctx, types.StudentAcademicTree{Index: "tree-1", Student: "student-1", Institution: "inst-1"})
+	_, err := srv.CreateEnrollment(ctx, &types.MsgCreateEnrollment{Creator: address, Student: "student-1", Institution: "inst-1", CourseId: "course-1"})
+	require.NoError(t, err)
+	enrollments, err := k.GetStudentEnrollments(ctx, "student-1")
+	require.NoError(t, err)
+	require.Len(t, enrollments, 1)
+	require.Equal(t, "2024", enrollments[0].CurriculumVersion)
+	tree, found := k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.True(t, found)
+	require.Equal(t, "course-1", tree.CourseId)
+	require.Equal(t, "2024", tree.CurriculumVersion)
+
+	// A student enrolled under an older catalog year
+	enrollments[0].CurriculumVersion = "2023"
+	k.SetStudentEnrollment(ctx, enrollments[0])
+	tree.CurriculumVersion = "2023"
+	k.SetStudentAcademicTree(ctx, tree)
+
+	request := func(creator string, version string) (*types.MsgRequestCurriculumMigrationResponse, error) {
+		return srv.RequestCurriculumMigration(ctx, &types.MsgRequestCurriculumMigration{Creator: creator, StudentId: "student-1", TargetVersion: version, Reason: "new catalog"})
+	}
+	_, err = request(registrar, "2024")
+	require.ErrorIs(t, err, types.ErrNotAuthorized)
+	_, err = request(address, "2025")
+	require.ErrorIs(t, err, types.ErrInvalidCurriculumMigration)
+	_, err = request(address, "2023")
+	require.ErrorIs(t, err, types.ErrInvalidCurriculumMigration)
+	_, err = request(address, "2030")
+	require.ErrorIs(t, err, types.ErrCurriculumNotFound)
+
+	res, err := request(address, "2024")
+	require.NoError(t, err)
+	require.Equal(t, "1", res.MigrationId)
+	_, err = request(address, "2024")
+	require.ErrorIs(t, err, types.ErrCurriculumMigrationPending)
+
+	// The student cannot approve their own migration
+	review := &types.MsgReviewCurriculumMigration{Creator: address, StudentId: "student-1", MigrationId: "1", Approve: true, Note: "ok"}
+	_, err = srv.ReviewCurriculumMigration(ctx, review)
+	require.ErrorIs(t, err, types.ErrNotAuthorized)
+
+	review.Creator = registrar
+	reviewed, err := srv.ReviewCurriculumMigration(ctx, review)
+	require.NoError(t, err)
+	require.Equal(t, "2024", reviewed.CurriculumVersion)
+
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Equal(t, "2024", tree.CurriculumVersion)
+	require.Len(t, tree.CurriculumMigrations, 1)
+	migration := tree.CurriculumMigrations[0]
+	require.Equal(t, types.CurriculumMigrationStatusApproved, migration.Status)
+	require.Equal(t, "2023", migration.FromVersion)
+	require.Equal(t, registrar, migration.ReviewedBy)
+	require.Equal(t, "ok", migration.ReviewNote)
+	enrollments, _ = k.GetStudentEnrollments(ctx, "student-1")
+	require.Equal(t, "2024", enrollments[0].CurriculumVersion)
+
+	_, err = srv.ReviewCurriculumMigration(ctx, review)
+	require.ErrorIs(t, err, types.ErrInvalidCurriculumMigration)
+}
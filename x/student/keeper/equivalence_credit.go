@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/student/types"
+)
+
+// recordEquivalenceRequest adds a pending equivalence request to the academic
+// tree of a student so that its approval can be credited. Students without a
+// tree get one at the institution of the target subject. Requests for a pair
+// the equivalence module already approved are credited right away.
+func (k Keeper) recordEquivalenceRequest(ctx sdk.Context, msg *types.MsgRequestEquivalence, equivalenceId string) error {
+	subject, found := k.subjectKeeper.GetSubject(ctx, msg.TargetSubjectId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSubjectNotAvailable, "subject %s not found", msg.TargetSubjectId)
+	}
+
+	academicTree, found := k.getAcademicTreeByStudent(ctx, msg.StudentId)
+	if !found {
+		academicTree = k.newAcademicTree(ctx, msg.StudentId, subject.Institution)
+		id, err := k.AppendStudentAcademicTree(ctx, academicTree)
+		if err != nil {
+			return err
+		}
+		academicTree.Index = strconv.FormatUint(id, 10)
+	}
+
+	request := &types.EquivalenceRequest{
+		Id:              equivalenceId,
+		StudentId:       msg.StudentId,
+		SourceSubjectId: msg.SourceSubjectId,
+		TargetSubjectId: msg.TargetSubjectId,
+		Status:          "pending",
+		RequestDate:     ctx.BlockTime().Format(time.RFC3339),
+		Reason:          msg.Reason,
+		CreatedBy:       msg.Creator,
+	}
+	academicTree.EquivalenceRequests = append(academicTree.EquivalenceRequests, request)
+
+	if k.equivalenceKeeper != nil {
+		if equivalence, found := k.equivalenceKeeper.GetSubjectEquivalence(ctx, msg.SourceSubjectId, msg.TargetSubjectId); found && equivalence.Status == "approved" {
+			k.creditEquivalence(ctx, &academicTree, request, subject, equivalence.Index, equivalence.EquivalencePercent)
+			k.setStudentAcademicTree(ctx, academicTree)
+			return nil
+		}
+	}
+
+	k.setStudentAcademicTree(ctx, academicTree)
+	k.setPendingEquivalence(ctx, msg.SourceSubjectId, msg.TargetSubjectId, academicTree)
+	return nil
+}
+
+// ApplyApprovedEquivalence credits the target subject of an approved
+// equivalence to every student with a pending request for it. The subject is
+// transferred once, its credits added to the progress and, when enabled in
+// params, a transfer-credit token is minted for it.
+func (k Keeper) ApplyApprovedEquivalence(ctx sdk.Context, equivalenceId, sourceSubjectId, targetSubjectId, equivalencePercent string) error {
+	subject, found := k.subjectKeeper.GetSubject(ctx, targetSubjectId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSubjectNotAvailable, "subject %s not found", targetSubjectId)
+	}
+
+	for _, treeIndex := range k.getPendingEquivalences(ctx, sourceSubjectId, targetSubjectId) {
+		k.removePendingEquivalence(ctx, sourceSubjectId, targetSubjectId, treeIndex)
+		academicTree, found := k.getStudentAcademicTree(ctx, treeIndex)
+		if !found {
+			continue
+		}
+
+		// Subject IDs holding a "/" can share a prefix with another pair
+		var request *types.EquivalenceRequest
+		for _, r := range academicTree.EquivalenceRequests {
+			if r != nil && r.Status == "pending" && r.SourceSubjectId == sourceSubjectId && r.TargetSubjectId == targetSubjectId {
+				request = r
+				break
+			}
+		}
+		if request == nil {
+			continue
+		}
+
+		k.creditEquivalence(ctx, &academicTree, request, subject, equivalenceId, equivalencePercent)
+		k.setStudentAcademicTree(ctx, academicTree)
+	}
+
+	return nil
+}
+
+// creditEquivalence approves a request of the academic tree and transfers its
+// target subject unless the student already has credit for it
+func (k Keeper) creditEquivalence(ctx sdk.Context, academicTree *types.StudentAcademicTree, request *types.EquivalenceRequest, subject types.SubjectContent, equivalenceId, equivalencePercent string) {
+	var similarity uint32
+	if percent, err := strconv.ParseFloat(equivalencePercent, 64); err == nil && percent > 0 {
+		similarity = uint32(percent)
+	}
+	isTarget := func(token string) bool { return token == subject.Index }
+
+	request.Status = "approved"
+	request.ProcessedDate = ctx.BlockTime().Format(time.RFC3339)
+	request.EquivalenceType = "full"
+	request.SimilarityPercentage = similarity
+
+	if !containsToken(creditedSubjects(*academicTree), subject.Index) {
+		academicTree.TransferredSubjects = append(academicTree.TransferredSubjects, subject.Index)
+		academicTree.InProgressTokens = slices.DeleteFunc(academicTree.InProgressTokens, isTarget)
+		academicTree.AvailableTokens = slices.DeleteFunc(academicTree.AvailableTokens, isTarget)
+		academicTree.TotalCredits += subject.Credits
+		academicTree.TotalCompletedHours += subject.WorkloadHours
+
+		progress := copyAcademicProgress(academicTree.AcademicProgress)
+		creditSubject(&progress, subject, subject.Credits)
+		k.updateRequiredSubjectsPercentage(ctx, *academicTree, &progress)
+		academicTree.AcademicProgress = &progress
+
+		if k.GetParams(ctx).MintTransferCreditTokens {
+			request.TransferTokenId = k.mintTransferCreditToken(ctx, academicTree.Student, subject, equivalenceId)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEquivalenceCredited,
+			sdk.NewAttribute(types.AttributeKeyStudent, academicTree.Student),
+			sdk.NewAttribute(types.AttributeKeyEquivalenceId, equivalenceId),
+			sdk.NewAttribute(types.AttributeKeySubjectId, subject.Index),
+			sdk.NewAttribute(types.AttributeKeyTransferTokenId, request.TransferTokenId),
+		),
+	)
+}
+
+// mintTransferCreditToken mints a token marked as transfer credit for a
+// subject. Like subject completion, a failed mint does not undo the credit,
+// and students already holding a token of the definition keep that one.
+func (k Keeper) mintTransferCreditToken(ctx sdk.Context, studentId string, subject types.SubjectContent, equivalenceId string) string {
+	student, found := k.getStudentByIndex(ctx, studentId)
+	if !found || k.academicNFTKeeper == nil {
+		return ""
+	}
+	tokenDefs := k.tokenDefKeeper.GetTokenDefinitionsBySubject(ctx, subject.Index)
+	if len(tokenDefs) == 0 {
+		k.Logger().Info("No token definition for transferred subject", "subject", subject.Index)
+		return ""
+	}
+	if existing, found := k.academicNFTKeeper.GetStudentTokenForTokenDef(ctx, student.Address, tokenDefs[0].Index); found {
+		return existing
+	}
+
+	tokenId, err := k.academicNFTKeeper.MintTransferCreditToken(ctx, tokenDefs[0].Index, student.Address, subject.Institution, equivalenceId)
+	if err != nil {
+		k.Logger().Error("Failed to mint transfer-credit token",
+			"student", studentId,
+			"subject", subject.Index,
+			"error", err,
+		)
+		return ""
+	}
+	return tokenId
+}
+
+// setPendingEquivalence indexes the academic tree under the subject pair of a
+// pending equivalence request
+func (k Keeper) setPendingEquivalence(ctx sdk.Context, sourceSubjectId, targetSubjectId string, academicTree types.StudentAcademicTree) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingEquivalencePrefix(sourceSubjectId, targetSubjectId))
+	store.Set(types.KeyPrefix(academicTree.Index), []byte(academicTree.Index))
+}
+
+// getPendingEquivalences returns the indexes of the academic trees with a
+// pending request for the subject pair
+func (k Keeper) getPendingEquivalences(ctx sdk.Context, sourceSubjectId, targetSubjectId string) (treeIndexes []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingEquivalencePrefix(sourceSubjectId, targetSubjectId))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		treeIndexes = append(treeIndexes, string(iterator.Value()))
+	}
+
+	return
+}
+
+func (k Keeper) removePendingEquivalence(ctx sdk.Context, sourceSubjectId, targetSubjectId, treeIndex string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingEquivalencePrefix(sourceSubjectId, targetSubjectId))
+	store.Delete(types.KeyPrefix(treeIndex))
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/student/keeper"
+	"academictoken/x/student/types"
+)
+
+func TestApplyApprovedEquivalence(t *testing.T) {
+	academicNFTKeeper := keepertest.MockStudentAcademicNFTKeeper{Tokens: map[string][]types.SubjectTokenInstance{}}
+	k, ctx := keepertest.StudentKeeperWithRecords(t, keepertest.MockStudentSubjectKeeper{}, keepertest.MockStudentTokenDefKeeper{}, academicNFTKeeper)
+	srv := keeper.NewMsgServerImpl(k)
+	address := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.MintTransferCreditTokens = true
+	require.NoError(t, k.SetParams(ctx, params))
+	k.SetStudent(ctx, types.Student{Index: "student-1", Address: address, Name: "Ada"})
+	k.SetStudentAcademicTree(ctx, types.StudentAcademicTree{Index: "tree-1", Student: "student-1", Institution: "institution-1", InProgressTokens: []string{"subject-1"}})
+
+	res, err := srv.RequestEquivalence(ctx, &types.MsgRequestEquivalence{Creator: address, StudentId: "student-1", SourceSubjectId: "external-1", TargetSubjectId: "subject-1", Reason: "taken abroad"})
+	require.NoError(t, err)
+	tree, _ := k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Len(t, tree.EquivalenceRequests, 1)
+	require.Equal(t, "pending", tree.EquivalenceRequests[0].Status)
+
+	// Other subject pairs are left alone
+	require.NoError(t, k.ApplyApprovedEquivalence(ctx, "external-2-subject-1", "external-2", "subject-1", "90.00"))
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Empty(t, tree.TransferredSubjects)
+
+	require.NoError(t, k.ApplyApprovedEquivalence(ctx, res.EquivalenceId, "external-1", "subject-1", "92.50"))
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Equal(t, []string{"subject-1"}, tree.TransferredSubjects)
+	require.NotContains(t, tree.InProgressTokens, "subject-1")
+	require.Equal(t, uint64(4), tree.TotalCredits)
+	require.Equal(t, uint64(4), tree.AcademicProgress.RequiredCreditsCompleted)
+	request := tree.EquivalenceRequests[0]
+	require.Equal(t, "approved", request.Status)
+	require.Equal(t, uint32(92), request.SimilarityPercentage)
+	require.Equal(t, "transfer-"+res.EquivalenceId, request.TransferTokenId)
+	require.Len(t, academicNFTKeeper.Tokens[address], 1)
+
+	// Approving again does not credit the subject twice
+	require.NoError(t, k.ApplyApprovedEquivalence(ctx, res.EquivalenceId, "external-1", "subject-1", "92.50"))
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Equal(t, uint64(4), tree.TotalCredits)
+
+	// The transfer-credit token is listed as transferred, not as a completed subject
+	transcript, err := k.Transcript(ctx, &types.QueryTranscriptRequest{StudentId: "student-1"})
+	require.NoError(t, err)
+	require.Empty(t, transcript.Semesters)
+	require.Len(t, transcript.TransferredSubjects, 1)
+	require.Equal(t, "external-1", transcript.TransferredSubjects[0].SourceSubjectId)
+}
+
+func TestRequestApprovedEquivalence(t *testing.T) {
+	academicNFTKeeper := keepertest.MockStudentAcademicNFTKeeper{Tokens: map[string][]types.SubjectTokenInstance{}}
+	k, ctx := keepertest.StudentKeeperWithRecords(t, keepertest.MockStudentSubjectKeeper{}, keepertest.MockStudentTokenDefKeeper{}, academicNFTKeeper)
+	k.SetEquivalenceKeeper(keepertest.MockStudentEquivalenceKeeper{Equivalences: []types.SubjectEquivalence{
+		{Index: "external-2-subject-2", SourceSubjectId: "external-2", TargetSubjectId: "subject-2", Status: "approved", EquivalencePercent: "88.00"},
+	}})
+	srv := keeper.NewMsgServerImpl(k)
+	address := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.MintTransferCreditTokens = true
+	require.NoError(t, k.SetParams(ctx, params))
+	// The student has no academic tree yet and already holds a token of the subject
+	k.SetStudent(ctx, types.Student{Index: "student-2", Address: address, Name: "Grace"})
+	academicNFTKeeper.Tokens[address] = []types.SubjectTokenInstance{{TokenInstanceId: "token-instance-7", TokenDefId: "token-1", Student: address}}
+
+	res, err := srv.RequestEquivalence(ctx, &types.MsgRequestEquivalence{Creator: address, StudentId: "student-2", SourceSubjectId: "external-2", TargetSubjectId: "subject-2", Reason: "taken abroad"})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.EquivalenceId)
+
+	tree, found := k.GetAcademicTreeByStudentTyped(ctx, "student-2")
+	require.True(t, found)
+	require.Equal(t, "institution-1", tree.Institution)
+	require.Equal(t, []string{"subject-2"}, tree.TransferredSubjects)
+	require.Equal(t, uint64(4), tree.TotalCredits)
+	request := tree.EquivalenceRequests[0]
+	require.Equal(t, "approved", request.Status)
+	require.Equal(t, uint32(88), request.SimilarityPercentage)
+	require.Equal(t, "token-instance-7", request.TransferTokenId)
+	require.Len(t, academicNFTKeeper.Tokens[address], 1)
+
+	// A later approval hook finds no pending request for the pair
+	require.NoError(t, k.ApplyApprovedEquivalence(ctx, "external-2-subject-2", "external-2", "subject-2", "88.00"))
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-2")
+	require.Equal(t, uint64(4), tree.TotalCredits)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/student/types"
+)
+
+// AmendSubjectGrade replaces the GPA contribution of a completed subject after
+// its grade was amended. Subjects not recorded as completed in the academic
+// tree are left untouched.
+func (k Keeper) AmendSubjectGrade(ctx sdk.Context, studentAddress string, subjectId string, tokenInstanceId string, previous types.NormalizedGrade, amended types.NormalizedGrade) error {
+	tree, subject, found, err := k.completedSubjectTree(ctx, studentAddress, subjectId, tokenInstanceId)
+	if err != nil || !found {
+		return err
+	}
+
+	// Trees completed before grades were tracked have no GPA contribution to revert
+	if tree.GpaCredits >= subject.Credits {
+		if err := tree.RemoveGrade(previous, subject.Credits); err != nil {
+			return err
+		}
+	}
+	if err := tree.RecordGrade(amended, subject.Credits); err != nil {
+		return err
+	}
+	k.setStudentAcademicTree(ctx, tree)
+
+	return nil
+}
+
+// RevokeSubjectCompletion removes a completed subject from the academic tree
+// of a student after its token was revoked, reverting exactly what its
+// completion added: the progress credits and the GPA contribution. Subjects
+// not recorded as completed are left untouched.
+func (k Keeper) RevokeSubjectCompletion(ctx sdk.Context, studentAddress string, subjectId string, tokenInstanceId string, grade types.NormalizedGrade) error {
+	tree, subject, found, err := k.completedSubjectTree(ctx, studentAddress, subjectId, tokenInstanceId)
+	if err != nil || !found {
+		return err
+	}
+
+	var completed []string
+	for _, token := range tree.CompletedTokens {
+		if token != subjectId && token != tokenInstanceId {
+			completed = append(completed, token)
+		}
+	}
+	tree.CompletedTokens = completed
+
+	if tree.AcademicProgress != nil {
+		progress := copyAcademicProgress(tree.AcademicProgress)
+		uncreditSubject(&progress, subject, subject.Credits)
+		k.updateRequiredSubjectsPercentage(ctx, tree, &progress)
+		tree.AcademicProgress = &progress
+	}
+
+	if tree.GpaCredits >= subject.Credits {
+		if err := tree.RemoveGrade(grade, subject.Credits); err != nil {
+			return err
+		}
+	}
+	k.setStudentAcademicTree(ctx, tree)
+
+	k.Logger().Info("Subject completion revoked",
+		"student", studentAddress,
+		"subject_id", subjectId,
+		"token_instance_id", tokenInstanceId,
+	)
+
+	return nil
+}
+
+// completedSubjectTree returns the academic tree of the student at
+// studentAddress together with the subject, reporting whether the subject or
+// its token is recorded as completed in the tree.
+func (k Keeper) completedSubjectTree(ctx sdk.Context, studentAddress string, subjectId string, tokenInstanceId string) (types.StudentAcademicTree, types.SubjectContent, bool, error) {
+	student, found := k.getStudentByAddress(ctx, studentAddress)
+	if !found {
+		return types.StudentAcademicTree{}, types.SubjectContent{}, false, fmt.Errorf("student with address '%s' not found", studentAddress)
+	}
+
+	tree, found := k.getAcademicTreeByStudent(ctx, student.Index)
+	if !found {
+		return types.StudentAcademicTree{}, types.SubjectContent{}, false, nil
+	}
+
+	completed := false
+	for _, token := range tree.CompletedTokens {
+		if token == subjectId || token == tokenInstanceId {
+			completed = true
+			break
+		}
+	}
+	if !completed {
+		return types.StudentAcademicTree{}, types.SubjectContent{}, false, nil
+	}
+
+	subject, found := k.subjectKeeper.GetSubject(ctx, subjectId)
+	if !found {
+		return types.StudentAcademicTree{}, types.SubjectContent{}, false, fmt.Errorf("subject with ID '%s' not found", subjectId)
+	}
+
+	return tree, subject, true, nil
+}
+
+func subtractCredits(total uint64, credits uint64) uint64 {
+	if credits > total {
+		return 0
+	}
+	return total - credits
+}
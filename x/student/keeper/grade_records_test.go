@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/student/keeper"
+	"academictoken/x/student/types"
+)
+
+func TestRevokeSubjectCompletion(t *testing.T) {
+	k, ctx := keepertest.StudentKeeperWithCurricula(t, []types.CurriculumTree{{Index: "curriculum-1", CourseId: "course-1", RequiredSubjects: []string{"subject-1", "subject-2"}}})
+	address := sample.AccAddress()
+	k.SetStudent(ctx, types.Student{Index: "student-1", Address: address})
+
+	grade := types.NormalizedGrade{Value: "80", GpaPoints: math.LegacyMustNewDecFromStr("3.2"), CountsTowardGpa: true}
+	tree := types.StudentAcademicTree{
+		Index:               "tree-1",
+		Student:             "student-1",
+		CourseId:            "course-1",
+		TotalCredits:        4,
+		TransferredSubjects: []string{"subject-9"},
+		CompletedTokens:     []string{"subject-1", "subject-2"},
+		AcademicProgress:    &types.AcademicProgress{RequiredCreditsCompleted: 12, RequiredSubjectsPercentage: 100},
+	}
+	require.NoError(t, tree.RecordGrade(grade, 4))
+	require.NoError(t, tree.RecordGrade(types.NormalizedGrade{GpaPoints: math.LegacyMustNewDecFromStr("4.0"), CountsTowardGpa: true}, 4))
+	k.SetStudentAcademicTree(ctx, tree)
+
+	// Amending the grade replaces its GPA contribution
+	amended := types.NormalizedGrade{Value: "100", GpaPoints: math.LegacyMustNewDecFromStr("4.0"), CountsTowardGpa: true}
+	require.NoError(t, k.AmendSubjectGrade(ctx, address, "subject-1", "token-1", grade, amended))
+	tree, found := k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.True(t, found)
+	require.Equal(t, uint64(8), tree.GpaCredits)
+	require.InDelta(t, 4.0, tree.CoefficientGpa, 0.0001)
+
+	// Revoking removes the subject, its credits and its GPA contribution,
+	// leaving the transferred credits alone
+	require.NoError(t, k.RevokeSubjectCompletion(ctx, address, "subject-1", "token-1", amended))
+	tree, found = k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.True(t, found)
+	require.Equal(t, []string{"subject-2"}, tree.CompletedTokens)
+	require.Equal(t, uint64(4), tree.TotalCredits)
+	require.Equal(t, uint64(8), tree.AcademicProgress.RequiredCreditsCompleted)
+	require.InDelta(t, 50, tree.AcademicProgress.RequiredSubjectsPercentage, 0.0001)
+	require.Equal(t, uint64(4), tree.GpaCredits)
+	require.InDelta(t, 4.0, tree.CoefficientGpa, 0.0001)
+
+	// Subjects not recorded as completed leave the tree untouched
+	require.NoError(t, k.RevokeSubjectCompletion(ctx, address, "subject-3", "token-3", amended))
+	unchanged, _ := k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Equal(t, tree, unchanged)
+
+	require.Error(t, k.RevokeSubjectCompletion(ctx, sample.AccAddress(), "subject-2", "token-2", amended))
+}
+
+func TestCompleteSubjectTwice(t *testing.T) {
+	k, ctx := keepertest.StudentKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	address := sample.AccAddress()
+	k.SetStudent(ctx, types.Student{Index: "student-1", Address: address})
+	k.SetStudentAcademicTree(ctx, types.StudentAcademicTree{Index: "tree-1", Student: "student-1", CourseId: "course-1", InProgressTokens: []string{"subject-1"}})
+
+	msg := &types.MsgCompleteSubject{Creator: address, StudentId: "student-1", SubjectId: "subject-1", GradeValue: "90", CompletionDate: "2024-06-30", Semester: "2024-1"}
+	_, err := srv.CompleteSubject(ctx, msg)
+	require.NoError(t, err)
+	tree, _ := k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Equal(t, []string{"subject-1"}, tree.CompletedTokens)
+	require.Equal(t, uint64(4), tree.GpaCredits)
+	gpa := tree.CoefficientGpa
+
+	// Completing the subject again, even with another grade, leaves the GPA alone
+	msg.GradeValue = "60"
+	_, err = srv.CompleteSubject(ctx, msg)
+	require.ErrorIs(t, err, types.ErrSubjectAlreadyCompleted)
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Equal(t, uint64(4), tree.GpaCredits)
+	require.Equal(t, gpa, tree.CoefficientGpa)
+}
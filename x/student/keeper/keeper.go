@@ -34,6 +34,8 @@ type (
 		subjectKeeper     types.SubjectKeeper
 		tokenDefKeeper    types.TokenDefKeeper
 		academicNFTKeeper types.AcademicNFTKeeper
+		degreeKeeper      types.DegreeKeeper
+		equivalenceKeeper types.EquivalenceKeeper
 
 		// Contract integration components
 		wasmMsgServer       types.WasmMsgServer
@@ -85,6 +87,26 @@ func NewKeeper(
 	return keeper
 }
 
+// SetAcademicNFTKeeper sets the academicnft keeper, which is created after
+// the student keeper because it depends on it
+func (k *Keeper) SetAcademicNFTKeeper(academicNFTKeeper types.AcademicNFTKeeper) {
+	k.academicNFTKeeper = academicNFTKeeper
+}
+
+// SetEquivalenceKeeper sets the equivalence keeper, which is created after
+// the student keeper because it depends on it
+func (k *Keeper) SetEquivalenceKeeper(equivalenceKeeper types.EquivalenceKeeper) {
+	k.equivalenceKeeper = equivalenceKeeper
+}
+
+// SetDegreeKeeper sets the degree keeper, which is created after the student
+// keeper because it depends on it. The contract integration is rebuilt so
+// that the native evaluation sees it.
+func (k *Keeper) SetDegreeKeeper(degreeKeeper types.DegreeKeeper) {
+	k.degreeKeeper = degreeKeeper
+	k.contractIntegration = NewContractIntegration(k, k.wasmMsgServer, k.wasmQuerier)
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -296,6 +318,18 @@ func (k Keeper) setStudentAcademicTree(ctx sdk.Context, studentAcademicTree type
 	store.Set(types.KeyPrefix(studentAcademicTree.Index), b)
 }
 
+// getStudentAcademicTree returns a studentAcademicTree from its index
+func (k Keeper) getStudentAcademicTree(ctx sdk.Context, index string) (val types.StudentAcademicTree, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StudentAcademicTreeKeyPrefix))
+	b := store.Get(types.KeyPrefix(index))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
 /*
 // removeStudentAcademicTree removes a studentAcademicTree from the store
 func (k Keeper) removeStudentAcademicTree(ctx sdk.Context, index string) {
@@ -520,9 +554,40 @@ func (k Keeper) GetAcademicTreeByStudentTyped(ctx sdk.Context, studentIndex stri
 	return k.getAcademicTreeByStudent(ctx, studentIndex)
 }
 
-// SetStudentAcademicTree sets academic tree
+// SetStudentAcademicTree sets academic tree, indexing its pending
+// equivalence requests (for genesis)
 func (k Keeper) SetStudentAcademicTree(ctx sdk.Context, academicTree types.StudentAcademicTree) {
 	k.setStudentAcademicTree(ctx, academicTree)
+	for _, request := range academicTree.EquivalenceRequests {
+		if request != nil && request.Status == "pending" {
+			k.setPendingEquivalence(ctx, request.SourceSubjectId, request.TargetSubjectId, academicTree)
+		}
+	}
+}
+
+// SetStudent sets a student (for genesis)
+func (k Keeper) SetStudent(ctx sdk.Context, student types.Student) {
+	k.setStudent(ctx, student)
+}
+
+// SetStudentEnrollment sets an enrollment (for genesis)
+func (k Keeper) SetStudentEnrollment(ctx sdk.Context, enrollment types.StudentEnrollment) {
+	k.setStudentEnrollment(ctx, enrollment)
+}
+
+// GetAllStudents returns all students (for genesis)
+func (k Keeper) GetAllStudents(ctx sdk.Context) []types.Student {
+	return k.getAllStudents(ctx)
+}
+
+// GetAllStudentEnrollments returns all enrollments (for genesis)
+func (k Keeper) GetAllStudentEnrollments(ctx sdk.Context) []types.StudentEnrollment {
+	return k.getAllStudentEnrollments(ctx)
+}
+
+// GetAllStudentAcademicTrees returns all academic trees (for genesis)
+func (k Keeper) GetAllStudentAcademicTrees(ctx sdk.Context) []types.StudentAcademicTree {
+	return k.getAllStudentAcademicTrees(ctx)
 }
 
 // GetStudentEnrollments returns enrollments by student (for adapters)
@@ -531,6 +596,23 @@ func (k Keeper) GetStudentEnrollments(ctx sdk.Context, studentIndex string) ([]t
 	return enrollments, nil
 }
 
+// ValidateStudentTerm checks that a term ID is in the academic calendar of an
+// institution the student is enrolled in (for adapters)
+func (k Keeper) ValidateStudentTerm(ctx sdk.Context, studentIndex string, termId string) error {
+	enrollments := k.getEnrollmentsByStudentId(ctx, studentIndex)
+	if len(enrollments) == 0 {
+		return types.ErrEnrollmentNotFound.Wrapf("student '%s' has no enrollments", studentIndex)
+	}
+
+	var err error
+	for _, enrollment := range enrollments {
+		if err = k.institutionKeeper.ValidateAcademicTerm(ctx, enrollment.Institution, termId); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
 // GetStudentByIndex returns a student by index (for adapters)
 func (k Keeper) GetStudentByIndex(ctx sdk.Context, index string) (types.Student, bool) {
 	return k.getStudentByIndex(ctx, index)
@@ -898,3 +980,39 @@ func (k Keeper) CheckGraduationEligibility(ctx context.Context, req *types.Query
 		Message:          fmt.Sprintf("Graduation eligibility status for student %s", req.StudentId),
 	}, nil
 }
+
+// IntegrationMode implements the QueryServer interface
+func (k Keeper) IntegrationMode(ctx context.Context, req *types.QueryIntegrationModeRequest) (*types.QueryIntegrationModeResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	params := k.GetParams(sdk.UnwrapSDKContext(ctx))
+	res := &types.QueryIntegrationModeResponse{
+		Mode:      params.Mode(),
+		Contracts: params.ContractStatuses(),
+		Ready:     true,
+	}
+
+	switch res.Mode {
+	case types.IntegrationModeContract:
+		res.Message = "Progress, graduation and NFT authorization are evaluated by the configured contracts"
+		for _, contract := range res.Contracts {
+			if contract.Required && !contract.Configured {
+				res.Ready = false
+				res.Message = fmt.Sprintf("The %s contract is not configured", contract.Name)
+				break
+			}
+		}
+	case types.IntegrationModeNative:
+		res.Message = "Progress, graduation and NFT authorization are evaluated natively against the curriculum"
+	case types.IntegrationModeDisabled:
+		res.Ready = false
+		res.Message = "Subject completions and degree validations are rejected while the integration is disabled"
+	default:
+		res.Ready = false
+		res.Message = fmt.Sprintf("Unknown integration mode %q", res.Mode)
+	}
+
+	return res, nil
+}
@@ -29,11 +29,10 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TEMPORARILY COMMENTED OUT FOR TESTING CONTRACT ADDRESSES
 	// Check if the message signer is the authority
-	// if req.Authority != k.GetAuthority() {
-	//	return nil, fmt.Errorf("invalid authority: expected %s, got %s", k.GetAuthority(), req.Authority)
-	// }
+	if req.Authority != k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority: expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
 
 	// Validate the new params using the Validate method from params.go
 	if err := req.Params.Validate(); err != nil {
@@ -202,52 +201,24 @@ func (k msgServer) RequestSubjectEnrollment(goCtx context.Context, req *types.Ms
 		return nil, fmt.Errorf("subject with ID '%s' not found", req.SubjectId)
 	}
 
-	// Use contract integration to check prerequisites
-	contractIntegration := k.GetContractIntegration().(*ContractIntegration)
-	canEnroll, missingPrereqs, err := contractIntegration.CheckPrerequisites(ctx, req.Student, req.SubjectId)
-	if err != nil {
-		k.Logger().Error("Failed to check prerequisites via contract",
-			"student", req.Student,
-			"subject", req.SubjectId,
-			"error", err,
-		)
-		// Continue without contract check for now, but log the issue
-	}
-
-	if !canEnroll && len(missingPrereqs) > 0 {
-		return nil, fmt.Errorf("student does not meet prerequisites for subject '%s'. Missing: %v", req.SubjectId, missingPrereqs)
+	// Prerequisites fail closed unless waived by an advisor or registrar
+	if err := k.checkSubjectPrerequisites(ctx, req.Student, req.SubjectId); err != nil {
+		return nil, err
 	}
 
-	// Get or create academic tree
-	academicTree, found := k.getAcademicTreeByStudent(ctx, req.Student)
-	if !found {
-		// Create new academic tree
-		academicTree = types.StudentAcademicTree{
-			Student:          req.Student,
-			Institution:      subject.Institution,
-			CourseId:         "", // Will be set when enrollment is created
-			CompletedTokens:  []string{},
-			InProgressTokens: []string{},
-			AvailableTokens:  []string{req.SubjectId},
-			AcademicProgress: &types.AcademicProgress{},
-			GraduationStatus: &types.GraduationStatus{},
-		}
-		k.AppendStudentAcademicTree(ctx, academicTree)
-	} else {
-		// Add subject to in-progress tokens if not already there
-		alreadyInProgress := false
-		for _, token := range academicTree.InProgressTokens {
-			if token == req.SubjectId {
-				alreadyInProgress = true
-				break
-			}
-		}
-
-		if !alreadyInProgress {
-			academicTree.InProgressTokens = append(academicTree.InProgressTokens, req.SubjectId)
-			k.setStudentAcademicTree(ctx, academicTree)
+	// Enrollment in a concrete offering takes a seat or a waitlist place;
+	// waitlisted students reach the academic tree once promoted
+	response := &types.MsgRequestSubjectEnrollmentResponse{}
+	if req.OfferingId != "" {
+		var err error
+		response, err = k.enrollInSubjectOffering(ctx, req.Student, req.SubjectId, req.OfferingId)
+		if err != nil {
+			return nil, err
 		}
 	}
+	if response.Status != types.OfferingStatusWaitlisted {
+		k.markSubjectInProgress(ctx, req.Student, req.SubjectId, subject.Institution)
+	}
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
@@ -265,7 +236,56 @@ func (k msgServer) RequestSubjectEnrollment(goCtx context.Context, req *types.Ms
 		"creator", req.Creator,
 	)
 
-	return &types.MsgRequestSubjectEnrollmentResponse{}, nil
+	return response, nil
+}
+
+// newAcademicTree builds the academic tree of a student who has none yet,
+// following the course and curriculum version of the student's enrollment
+func (k Keeper) newAcademicTree(ctx sdk.Context, studentId string, institution string) types.StudentAcademicTree {
+	academicTree := types.StudentAcademicTree{
+		Student:          studentId,
+		Institution:      institution,
+		CourseId:         "", // Will be set when enrollment is created
+		CompletedTokens:  []string{},
+		InProgressTokens: []string{},
+		AvailableTokens:  []string{},
+		AcademicProgress: &types.AcademicProgress{},
+		GraduationStatus: &types.GraduationStatus{},
+	}
+	for _, enrollment := range k.getEnrollmentsByStudentId(ctx, studentId) {
+		if enrollment.Status == types.EnrollmentStatusActive || enrollment.Status == types.EnrollmentStatusPending {
+			academicTree.CourseId = enrollment.CourseId
+			academicTree.CurriculumVersion = enrollment.CurriculumVersion
+			break
+		}
+	}
+	return academicTree
+}
+
+// markSubjectInProgress records a subject the student enrolled in on the
+// academic tree, creating the tree when the student has none yet
+func (k Keeper) markSubjectInProgress(ctx sdk.Context, studentId string, subjectId string, institution string) {
+	// Get or create academic tree
+	academicTree, found := k.getAcademicTreeByStudent(ctx, studentId)
+	if !found {
+		academicTree = k.newAcademicTree(ctx, studentId, institution)
+		academicTree.AvailableTokens = []string{subjectId}
+		k.AppendStudentAcademicTree(ctx, academicTree)
+	} else {
+		// Add subject to in-progress tokens if not already there
+		alreadyInProgress := false
+		for _, token := range academicTree.InProgressTokens {
+			if token == subjectId {
+				alreadyInProgress = true
+				break
+			}
+		}
+
+		if !alreadyInProgress {
+			academicTree.InProgressTokens = append(academicTree.InProgressTokens, subjectId)
+			k.setStudentAcademicTree(ctx, academicTree)
+		}
+	}
 }
 
 // UpdateAcademicTree updates a student's academic tree
@@ -362,9 +382,6 @@ func (k msgServer) CompleteSubject(goCtx context.Context, req *types.MsgComplete
 	if req.SubjectId == "" {
 		return nil, fmt.Errorf("subject ID cannot be empty")
 	}
-	if req.Grade == 0 {
-		return nil, fmt.Errorf("grade cannot be zero")
-	}
 	if req.CompletionDate == "" {
 		return nil, fmt.Errorf("completion date cannot be empty")
 	}
@@ -372,11 +389,6 @@ func (k msgServer) CompleteSubject(goCtx context.Context, req *types.MsgComplete
 		return nil, fmt.Errorf("semester cannot be empty")
 	}
 
-	// Validate grade range
-	if req.Grade < 60 || req.Grade > 100 {
-		return nil, fmt.Errorf("grade must be between 60 and 100, got %d", req.Grade)
-	}
-
 	// Check if student exists
 	student, found := k.getStudentByIndex(ctx, req.StudentId)
 	if !found {
@@ -389,15 +401,37 @@ func (k msgServer) CompleteSubject(goCtx context.Context, req *types.MsgComplete
 		return nil, fmt.Errorf("subject with ID '%s' not found", req.SubjectId)
 	}
 
-	// Check permissions (only professors/institutions or authority can complete subjects)
-	// For now, allow any creator but in production this should be restricted
-	// TODO: Add proper authorization logic
+	// Check permissions (only admins, registrars and professors of the subject's institution)
+	if !k.institutionKeeper.CanIssueGrade(ctx, subject.Institution, req.Creator, subject.CourseId, req.SubjectId) {
+		return nil, types.ErrInsufficientPermissions.Wrapf("'%s' holds no grading role for subject '%s' in institution '%s'", req.Creator, req.SubjectId, subject.Institution)
+	}
+
+	// The semester must be a term of the institution still accepting grades
+	if err := k.institutionKeeper.ValidateGradeSubmission(ctx, subject.Institution, req.Semester); err != nil {
+		return nil, err
+	}
+
+	// Validate the grade against the institution grading scale
+	grade, err := k.institutionKeeper.NormalizeGrade(ctx, subject.Institution, req.GradeOnScale())
+	if err != nil {
+		return nil, types.ErrInvalidGrade.Wrap(err.Error())
+	}
+	if !grade.Passing {
+		return nil, types.ErrInvalidGrade.Wrapf("grade %s is below the passing grade of institution '%s'", grade.Value, subject.Institution)
+	}
+	// Contracts receive the grade on the common 0-100 scale
+	score := grade.Score.TruncateInt().Uint64()
+
+	// Grades of completed subjects are changed through AmendGrade
+	if academicTree, found := k.getAcademicTreeByStudent(ctx, req.StudentId); found && containsToken(academicTree.CompletedTokens, req.SubjectId) {
+		return nil, types.ErrSubjectAlreadyCompleted.Wrapf("student '%s' already completed subject '%s'", req.StudentId, req.SubjectId)
+	}
 
 	// Create subject completion request for contract
 	completionRequest := types.SubjectCompletionRequest{
 		StudentId:      req.StudentId,
 		SubjectId:      req.SubjectId,
-		Grade:          uint64(req.Grade), // Convert uint32 to uint64
+		Grade:          score,
 		CompletionDate: req.CompletionDate,
 		Semester:       req.Semester,
 		Credits:        subject.Credits,
@@ -422,6 +456,9 @@ func (k msgServer) CompleteSubject(goCtx context.Context, req *types.MsgComplete
 		academicTree.CompletedTokens = completionResult.UpdatedCompletedSubjects
 		academicTree.InProgressTokens = completionResult.UpdatedInProgressSubjects
 		academicTree.AcademicProgress = &completionResult.UpdatedProgress
+		if err := academicTree.RecordGrade(grade, subject.Credits); err != nil {
+			return nil, err
+		}
 		k.setStudentAcademicTree(ctx, academicTree)
 	}
 
@@ -429,7 +466,8 @@ func (k msgServer) CompleteSubject(goCtx context.Context, req *types.MsgComplete
 	nftRequest := types.NFTMintingRequest{
 		StudentAddress:    student.Address,
 		SubjectId:         req.SubjectId,
-		Grade:             uint64(req.Grade), // Convert uint32 to uint64
+		Grade:             score,
+		GradeValue:        grade.Value,
 		CompletionDate:    req.CompletionDate,
 		Semester:          req.Semester,
 		IssuerInstitution: subject.Institution,
@@ -443,7 +481,7 @@ func (k msgServer) CompleteSubject(goCtx context.Context, req *types.MsgComplete
 			"subject", req.SubjectId,
 			"error", err,
 		)
-		// Continue execution even if NFT minting fails
+		return nil, fmt.Errorf("failed to request NFT minting: %w", err)
 	}
 
 	nftTokenId := ""
@@ -471,7 +509,8 @@ func (k msgServer) CompleteSubject(goCtx context.Context, req *types.MsgComplete
 			"subject_completed",
 			sdk.NewAttribute("student_id", req.StudentId),
 			sdk.NewAttribute("subject_id", req.SubjectId),
-			sdk.NewAttribute("grade", fmt.Sprintf("%d", req.Grade)),
+			sdk.NewAttribute("grade", grade.Value),
+			sdk.NewAttribute("normalized_grade", grade.Score.String()),
 			sdk.NewAttribute("completion_date", req.CompletionDate),
 			sdk.NewAttribute("semester", req.Semester),
 			sdk.NewAttribute("nft_token_id", nftTokenId),
@@ -485,7 +524,7 @@ func (k msgServer) CompleteSubject(goCtx context.Context, req *types.MsgComplete
 	k.Logger().Info("Subject completed successfully",
 		"student_id", req.StudentId,
 		"subject_id", req.SubjectId,
-		"grade", req.Grade,
+		"grade", grade.Value,
 		"nft_token_id", nftTokenId,
 		"eligible_for_graduation", isEligibleForGraduation,
 		"creator", req.Creator,
@@ -539,16 +578,30 @@ func (k msgServer) RequestEquivalence(goCtx context.Context, req *types.MsgReque
 		return nil, fmt.Errorf("target subject with ID '%s' not found", req.TargetSubjectId)
 	}
 
-	// Use contract integration to request equivalence
+	// Use contract integration to request equivalence. In native mode the
+	// analysis runs in the equivalence module, whose index is the subject pair.
+	params := k.GetParams(ctx)
 	contractIntegration := k.GetContractIntegration().(*ContractIntegration)
-	equivalenceId, err := contractIntegration.RequestEquivalence(ctx, req.SourceSubjectId, req.TargetSubjectId)
+	contractAddr, err := contractIntegration.contractFor(params, "RequestEquivalence", "equivalence", params.EquivalenceContractAddr)
 	if err != nil {
-		k.Logger().Error("Failed to request equivalence via contract",
-			"source_subject", req.SourceSubjectId,
-			"target_subject", req.TargetSubjectId,
-			"error", err,
-		)
-		return nil, fmt.Errorf("failed to request equivalence: %w", err)
+		return nil, err
+	}
+	equivalenceId := fmt.Sprintf("%s-%s", req.SourceSubjectId, req.TargetSubjectId)
+	if contractAddr != "" {
+		equivalenceId, err = contractIntegration.RequestEquivalence(ctx, req.SourceSubjectId, req.TargetSubjectId)
+		if err != nil {
+			k.Logger().Error("Failed to request equivalence via contract",
+				"source_subject", req.SourceSubjectId,
+				"target_subject", req.TargetSubjectId,
+				"error", err,
+			)
+			return nil, fmt.Errorf("failed to request equivalence: %w", err)
+		}
+	}
+
+	// The request is credited to the academic tree once the equivalence is approved
+	if err := k.recordEquivalenceRequest(ctx, req, equivalenceId); err != nil {
+		return nil, err
 	}
 
 	// Emit event
@@ -53,14 +53,21 @@ func (k msgServer) CreateEnrollment(goCtx context.Context, msg *types.MsgCreateE
 		}
 	}
 
+	// Pin the enrollment to the course's current catalog year
+	curriculumVersion := ""
+	if curriculum, found := k.curriculumKeeper.GetLatestPublishedCurriculum(ctx, msg.CourseId); found {
+		curriculumVersion = curriculum.Version
+	}
+
 	// Create new enrollment
 	enrollment := types.StudentEnrollment{
-		Student:        msg.Student,
-		Institution:    msg.Institution,
-		CourseId:       msg.CourseId,
-		EnrollmentDate: ctx.BlockTime().Format(time.RFC3339),
-		Status:         types.EnrollmentStatusPending, // Start as pending, needs approval
-		AcademicTreeId: "",                            // Will be set when enrollment is activated
+		Student:           msg.Student,
+		Institution:       msg.Institution,
+		CourseId:          msg.CourseId,
+		EnrollmentDate:    ctx.BlockTime().Format(time.RFC3339),
+		Status:            types.EnrollmentStatusPending, // Start as pending, needs approval
+		AcademicTreeId:    "",                            // Will be set when enrollment is activated
+		CurriculumVersion: curriculumVersion,
 	}
 
 	// Save enrollment
@@ -79,6 +86,13 @@ func (k msgServer) CreateEnrollment(goCtx context.Context, msg *types.MsgCreateE
 	student.EnrollmentIds = append(student.EnrollmentIds, savedEnrollment.Index)
 	k.Keeper.setStudent(ctx, student)
 
+	// An academic tree opened before the enrollment follows the same curriculum
+	if academicTree, found := k.Keeper.getAcademicTreeByStudent(ctx, msg.Student); found && academicTree.CourseId == "" {
+		academicTree.CourseId = msg.CourseId
+		academicTree.CurriculumVersion = curriculumVersion
+		k.Keeper.setStudentAcademicTree(ctx, academicTree)
+	}
+
 	// Emit enrollment creation event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -89,6 +103,7 @@ func (k msgServer) CreateEnrollment(goCtx context.Context, msg *types.MsgCreateE
 			sdk.NewAttribute(types.AttributeKeyCourseId, msg.CourseId),
 			sdk.NewAttribute(types.AttributeKeyEnrollmentDate, enrollment.EnrollmentDate),
 			sdk.NewAttribute(types.AttributeKeyStatus, enrollment.Status),
+			sdk.NewAttribute(types.AttributeKeyCurriculumVersion, curriculumVersion),
 			sdk.NewAttribute("created_by", msg.Creator),
 		),
 	)
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/student/types"
+)
+
+// ============================================================================
+// NATIVE EVALUATION
+// ============================================================================
+
+// subjectCompletionProgress derives the academic progress after a subject
+// completion from the academic tree and the curriculum the student follows.
+// Credits are only added the first time a subject is completed.
+func (ci *ContractIntegration) subjectCompletionProgress(ctx sdk.Context, request types.SubjectCompletionRequest) types.SubjectCompletionResult {
+	academicTree, _ := ci.keeper.getAcademicTreeByStudent(ctx, request.StudentId)
+
+	progress := copyAcademicProgress(academicTree.AcademicProgress)
+
+	completed := academicTree.CompletedTokens
+	if !containsToken(completed, request.SubjectId) {
+		completed = append(append([]string{}, completed...), request.SubjectId)
+
+		subject, _ := ci.keeper.subjectKeeper.GetSubject(ctx, request.SubjectId)
+		creditSubject(&progress, subject, request.Credits)
+	}
+
+	academicTree.CompletedTokens = completed
+	shouldCheckGraduation := ci.keeper.updateRequiredSubjectsPercentage(ctx, academicTree, &progress)
+
+	return types.SubjectCompletionResult{
+		Success:                   true,
+		Message:                   "Subject completion validated",
+		UpdatedCompletedSubjects:  completed,
+		UpdatedInProgressSubjects: ci.removeTokenFromList(academicTree.InProgressTokens, request.SubjectId),
+		UpdatedProgress:           progress,
+		ShouldCheckGraduation:     shouldCheckGraduation,
+	}
+}
+
+// nativeGraduationEligibility evaluates the academic record of the student
+// against the graduation requirements of its curriculum, with the same rules
+// the degree module validates degree requests with
+func (ci *ContractIntegration) nativeGraduationEligibility(ctx sdk.Context, academicTree types.StudentAcademicTree) (types.GraduationEligibilityResult, error) {
+	evaluation, err := ci.keeper.evaluateGraduation(ctx, academicTree.Student, "")
+	if err != nil {
+		return types.GraduationEligibilityResult{}, err
+	}
+
+	result := types.GraduationEligibilityResult{
+		IsEligible:                evaluation.Passed,
+		Message:                   "Eligible for graduation",
+		RequiredSubjectsRemaining: []string{},
+		GPARequirementMet:         true,
+	}
+	for _, missing := range evaluation.Missing {
+		switch missing.Requirement {
+		case "required_subjects":
+			result.RequiredSubjectsRemaining = missing.SubjectIds
+		case "total_credits":
+			result.RequiredCreditsRemaining = creditsRemaining(missing)
+		case "elective_credits":
+			result.MissingElectiveCredits = creditsRemaining(missing)
+		case "min_gpa":
+			result.GPARequirementMet = false
+		}
+	}
+	if !result.IsEligible {
+		result.Message = fmt.Sprintf("Missing requirements: %s", strings.Join(describeMissing(evaluation.Missing), "; "))
+	}
+	return result, nil
+}
+
+// nativeDegreeValidation validates the degree requirements against the
+// academic record kept on chain rather than the figures in the request
+func (ci *ContractIntegration) nativeDegreeValidation(ctx sdk.Context, request types.DegreeValidationRequest) (types.DegreeValidationResult, error) {
+	if _, found := ci.keeper.getAcademicTreeByStudent(ctx, request.StudentId); !found {
+		return types.DegreeValidationResult{
+			IsValid:             false,
+			Message:             "No academic record found for student",
+			MissingRequirements: []string{"academic_record"},
+		}, nil
+	}
+
+	evaluation, err := ci.keeper.evaluateGraduation(ctx, request.StudentId, request.CurriculumId)
+	if err != nil {
+		return types.DegreeValidationResult{}, err
+	}
+	result := types.DegreeValidationResult{
+		IsValid:             evaluation.Passed,
+		Message:             "All graduation requirements met",
+		RequirementsMet:     evaluation.RequirementsMet,
+		MissingRequirements: describeMissing(evaluation.Missing),
+	}
+	if !result.IsValid {
+		result.Message = "Requirements not met"
+	}
+	if curriculum, found := ci.keeper.curriculumKeeper.GetCurriculumTree(ctx, evaluation.CurriculumId); found {
+		result.CurriculumVersion = curriculum.Version
+		if course, found := ci.keeper.courseKeeper.GetCourse(ctx, curriculum.CourseId); found {
+			result.DegreeType = course.DegreeLevel
+		}
+	}
+
+	bz, err := json.Marshal(struct {
+		StudentId    string                       `json:"student_id"`
+		CurriculumId string                       `json:"curriculum_id"`
+		Height       int64                        `json:"height"`
+		Result       types.DegreeValidationResult `json:"result"`
+	}{request.StudentId, evaluation.CurriculumId, ctx.BlockHeight(), result})
+	if err != nil {
+		return types.DegreeValidationResult{}, fmt.Errorf("failed to marshal validation result: %w", err)
+	}
+	hash := sha256.Sum256(bz)
+	result.ValidationHash = hex.EncodeToString(hash[:])
+
+	return result, nil
+}
+
+// evaluateGraduation has the degree module evaluate the graduation
+// requirements, so that eligibility and degree requests follow one set of rules
+func (k Keeper) evaluateGraduation(ctx sdk.Context, studentId string, curriculumId string) (types.GraduationEvaluation, error) {
+	if k.degreeKeeper == nil {
+		return types.GraduationEvaluation{}, types.ErrIntegrationDisabled.Wrap("degree keeper not set")
+	}
+	return k.degreeKeeper.EvaluateGraduation(ctx, studentId, curriculumId)
+}
+
+// describeMissing formats the missing requirements as "requirement: description"
+func describeMissing(missing []types.MissingGraduationRequirement) []string {
+	descriptions := make([]string, 0, len(missing))
+	for _, requirement := range missing {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", requirement.Requirement, requirement.Description))
+	}
+	return descriptions
+}
+
+// creditsRemaining returns the credits still required by a missing credit
+// requirement
+func creditsRemaining(missing types.MissingGraduationRequirement) uint64 {
+	required, _ := strconv.ParseUint(missing.Required, 10, 64)
+	completed, _ := strconv.ParseUint(missing.Completed, 10, 64)
+	return subtractCredits(required, completed)
+}
+
+// copyAcademicProgress returns a copy of progress that can be updated without
+// touching the stored academic tree
+func copyAcademicProgress(progress *types.AcademicProgress) types.AcademicProgress {
+	updated := types.AcademicProgress{ElectivesByAreaCompleted: make(map[string]uint64)}
+	if progress != nil {
+		updated = *progress
+		updated.ElectivesByAreaCompleted = make(map[string]uint64, len(progress.ElectivesByAreaCompleted))
+		for area, credits := range progress.ElectivesByAreaCompleted {
+			updated.ElectivesByAreaCompleted[area] = credits
+		}
+	}
+	return updated
+}
+
+// creditSubject adds the credits of a subject to the elective or required
+// credits of progress
+func creditSubject(progress *types.AcademicProgress, subject types.SubjectContent, credits uint64) {
+	if strings.EqualFold(subject.SubjectType, "elective") {
+		progress.ElectiveCreditsCompleted += credits
+		if subject.KnowledgeArea != "" {
+			progress.ElectivesByAreaCompleted[subject.KnowledgeArea] += credits
+		}
+	} else {
+		progress.RequiredCreditsCompleted += credits
+	}
+}
+
+// uncreditSubject reverts creditSubject
+func uncreditSubject(progress *types.AcademicProgress, subject types.SubjectContent, credits uint64) {
+	if strings.EqualFold(subject.SubjectType, "elective") {
+		progress.ElectiveCreditsCompleted = subtractCredits(progress.ElectiveCreditsCompleted, credits)
+		if subject.KnowledgeArea != "" {
+			progress.ElectivesByAreaCompleted[subject.KnowledgeArea] = subtractCredits(progress.ElectivesByAreaCompleted[subject.KnowledgeArea], credits)
+		}
+	} else {
+		progress.RequiredCreditsCompleted = subtractCredits(progress.RequiredCreditsCompleted, credits)
+	}
+}
+
+// updateRequiredSubjectsPercentage recomputes the share of required subjects
+// of the curriculum the academic tree has credited and reports whether none
+// are left
+func (k Keeper) updateRequiredSubjectsPercentage(ctx sdk.Context, academicTree types.StudentAcademicTree, progress *types.AcademicProgress) bool {
+	curriculum, found := k.curriculumForTree(ctx, academicTree)
+	if !found {
+		return false
+	}
+	remaining := missingSubjects(curriculum.RequiredSubjects, creditedSubjects(academicTree))
+	if len(curriculum.RequiredSubjects) > 0 {
+		done := len(curriculum.RequiredSubjects) - len(remaining)
+		progress.RequiredSubjectsPercentage = float32(done) * 100 / float32(len(curriculum.RequiredSubjects))
+	}
+	return len(remaining) == 0
+}
+
+// creditedSubjects returns the subjects the academic tree has credit for,
+// completed on chain or transferred through an approved equivalence
+func creditedSubjects(academicTree types.StudentAcademicTree) []string {
+	credited := append([]string{}, academicTree.CompletedTokens...)
+	return append(credited, academicTree.TransferredSubjects...)
+}
+
+// curriculumForTree returns the curriculum of the course the academic tree
+// follows, matching the tree's curriculum version. Trees without a version
+// only resolve when the course has a single curriculum.
+func (k Keeper) curriculumForTree(ctx sdk.Context, academicTree types.StudentAcademicTree) (types.CurriculumTree, bool) {
+	if academicTree.CourseId == "" {
+		return types.CurriculumTree{}, false
+	}
+
+	curricula := k.curriculumKeeper.GetCurriculumTreesByCourse(ctx, academicTree.CourseId)
+	if academicTree.CurriculumVersion == "" {
+		if len(curricula) == 1 {
+			return curricula[0], true
+		}
+		return types.CurriculumTree{}, false
+	}
+	for _, curriculum := range curricula {
+		if curriculum.Version == academicTree.CurriculumVersion {
+			return curriculum, true
+		}
+	}
+	return types.CurriculumTree{}, false
+}
+
+// missingSubjects returns the subjects of required that are not in completed
+func missingSubjects(required []string, completed []string) []string {
+	missing := []string{}
+	for _, subject := range required {
+		if !containsToken(completed, subject) {
+			missing = append(missing, subject)
+		}
+	}
+	return missing
+}
+
+func containsToken(tokens []string, token string) bool {
+	for _, t := range tokens {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
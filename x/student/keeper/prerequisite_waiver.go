@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/student/types"
+)
+
+// checkSubjectPrerequisites fails closed: enrollment is refused when the
+// prerequisites are not met or cannot be verified, unless the student holds an
+// active waiver for the subject
+func (k Keeper) checkSubjectPrerequisites(ctx sdk.Context, studentId string, subjectId string) error {
+	if academicTree, found := k.getAcademicTreeByStudent(ctx, studentId); found {
+		if waiver, waived := academicTree.ActivePrerequisiteWaiver(subjectId, ctx.BlockTime()); waived {
+			k.Logger().Info("Prerequisites waived for subject enrollment",
+				"student", studentId,
+				"subject_id", subjectId,
+				"granted_by", waiver.GrantedBy,
+				"expires_at", waiver.ExpiresAt,
+			)
+			return nil
+		}
+	}
+
+	contractIntegration := k.GetContractIntegration().(*ContractIntegration)
+	canEnroll, missingPrereqs, err := contractIntegration.CheckPrerequisites(ctx, studentId, subjectId)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrPrerequisiteCheckFailed, "prerequisites of subject %s could not be verified: %s", subjectId, err)
+	}
+	if !canEnroll {
+		return errorsmod.Wrapf(types.ErrPrerequisitesNotMet, "student %s does not meet prerequisites for subject %s. Missing: %v", studentId, subjectId, missingPrereqs)
+	}
+	return nil
+}
+
+// GrantPrerequisiteWaiver records on the academic tree a waiver exempting a
+// student from the prerequisites of a subject until it expires
+func (k msgServer) GrantPrerequisiteWaiver(goCtx context.Context, msg *types.MsgGrantPrerequisiteWaiver) (*types.MsgGrantPrerequisiteWaiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	student, found := k.getStudentByIndex(ctx, msg.StudentId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrStudentNotFound, "student with ID %s not found", msg.StudentId)
+	}
+	subject, found := k.subjectKeeper.GetSubject(ctx, msg.SubjectId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSubjectNotAvailable, "subject %s not found", msg.SubjectId)
+	}
+
+	// Students cannot waive their own prerequisites, and only the institution
+	// and course offering the subject may waive them
+	if msg.Creator == student.Address || !k.institutionKeeper.CanGrantPrerequisiteWaiver(ctx, subject.Institution, msg.Creator, subject.CourseId) {
+		return nil, errorsmod.Wrapf(types.ErrNotAuthorized, "address %s cannot waive prerequisites of subject %s", msg.Creator, msg.SubjectId)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, msg.ExpiresAt)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrerequisiteWaiver, "invalid expiry '%s', expected RFC3339", msg.ExpiresAt)
+	}
+	if !expiresAt.After(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrerequisiteWaiver, "expiry %s is not in the future", msg.ExpiresAt)
+	}
+
+	// Waivers can be granted before the student's first enrollment in a subject
+	academicTree, found := k.getAcademicTreeByStudent(ctx, msg.StudentId)
+	if !found {
+		academicTree = k.newAcademicTree(ctx, msg.StudentId, subject.Institution)
+	}
+	academicTree.SetPrerequisiteWaiver(types.PrerequisiteWaiver{
+		SubjectId: msg.SubjectId,
+		Reason:    msg.Reason,
+		GrantedBy: msg.Creator,
+		GrantedAt: ctx.BlockTime().Format(time.RFC3339),
+		ExpiresAt: msg.ExpiresAt,
+	})
+	if found {
+		k.setStudentAcademicTree(ctx, academicTree)
+	} else if _, err := k.AppendStudentAcademicTree(ctx, academicTree); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantPrerequisiteWaiver,
+			sdk.NewAttribute(types.AttributeKeyStudent, msg.StudentId),
+			sdk.NewAttribute(types.AttributeKeySubjectId, msg.SubjectId),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, msg.ExpiresAt),
+			sdk.NewAttribute(types.AttributeKeyUpdatedBy, msg.Creator),
+		),
+	)
+
+	return &types.MsgGrantPrerequisiteWaiverResponse{}, nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/student/keeper"
+	"academictoken/x/student/types"
+)
+
+func TestPrerequisiteWaiver(t *testing.T) {
+	subjectKeeper := keepertest.MockStudentSubjectKeeper{MissingPrerequisites: map[string][]string{"subject-2": {"subject-1"}}}
+	k, ctx := keepertest.StudentKeeperWithRecords(t, subjectKeeper, keepertest.MockStudentTokenDefKeeper{}, keepertest.MockStudentAcademicNFTKeeper{})
+	srv := keeper.NewMsgServerImpl(k)
+	address, advisor := sample.AccAddress(), sample.AccAddress()
+
+	ctx = ctx.WithBlockTime(time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC))
+	k.SetStudent(ctx, types.Student{Index: "student-1", Address: address, Name: "Ada"})
+	k.SetStudentAcademicTree(ctx, types.StudentAcademicTree{Index: "tree-1", Student: "student-1", Institution: "institution-1", CourseId: "course-1"})
+
+	enroll := func() error {
+		_, err := srv.RequestSubjectEnrollment(ctx, &types.MsgRequestSubjectEnrollment{Creator: address, Student: "student-1", SubjectId: "subject-2"})
+		return err
+	}
+	require.ErrorIs(t, enroll(), types.ErrPrerequisitesNotMet)
+
+	grant := func(creator string, expiresAt string) error {
+		_, err := srv.GrantPrerequisiteWaiver(ctx, &types.MsgGrantPrerequisiteWaiver{Creator: creator, StudentId: "student-1", SubjectId: "subject-2", Reason: "industry experience", ExpiresAt: expiresAt})
+		return err
+	}
+	require.ErrorIs(t, grant(address, "2024-02-01T00:00:00Z"), types.ErrNotAuthorized)
+	require.ErrorIs(t, grant(advisor, "2024-01-01T00:00:00Z"), types.ErrInvalidPrerequisiteWaiver)
+	require.NoError(t, grant(advisor, "2024-02-01T00:00:00Z"))
+
+	// The waiver lets the student in and shows on the tree and the transcript
+	require.NoError(t, enroll())
+	tree, _ := k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Contains(t, tree.InProgressTokens, "subject-2")
+	require.Len(t, tree.PrerequisiteWaivers, 1)
+	require.Equal(t, advisor, tree.PrerequisiteWaivers[0].GrantedBy)
+	transcript, err := k.Transcript(ctx, &types.QueryTranscriptRequest{StudentId: "student-1"})
+	require.NoError(t, err)
+	require.Len(t, transcript.PrerequisiteWaivers, 1)
+	require.Equal(t, "industry experience", transcript.PrerequisiteWaivers[0].Reason)
+
+	// Expired waivers no longer apply
+	ctx = ctx.WithBlockTime(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, enroll(), types.ErrPrerequisitesNotMet)
+
+	// Waivers for a student without a tree create one at the subject's institution
+	k.SetStudent(ctx, types.Student{Index: "student-2", Address: sample.AccAddress(), Name: "Grace"})
+	_, err = srv.GrantPrerequisiteWaiver(ctx, &types.MsgGrantPrerequisiteWaiver{Creator: advisor, StudentId: "student-2", SubjectId: "subject-2", Reason: "transfer", ExpiresAt: "2024-04-01T00:00:00Z"})
+	require.NoError(t, err)
+	tree, found := k.GetAcademicTreeByStudentTyped(ctx, "student-2")
+	require.True(t, found)
+	require.Equal(t, "institution-1", tree.Institution)
+	require.Len(t, tree.PrerequisiteWaivers, 1)
+
+	// A failing check blocks enrollment instead of skipping the prerequisites
+	subjectKeeper = keepertest.MockStudentSubjectKeeper{PrerequisitesErr: errors.New("contract unavailable")}
+	k, ctx = keepertest.StudentKeeperWithRecords(t, subjectKeeper, keepertest.MockStudentTokenDefKeeper{}, keepertest.MockStudentAcademicNFTKeeper{})
+	srv = keeper.NewMsgServerImpl(k)
+	k.SetStudent(ctx, types.Student{Index: "student-1", Address: address, Name: "Ada"})
+	_, err = srv.RequestSubjectEnrollment(ctx, &types.MsgRequestSubjectEnrollment{Creator: address, Student: "student-1", SubjectId: "subject-2"})
+	require.ErrorIs(t, err, types.ErrPrerequisiteCheckFailed)
+}
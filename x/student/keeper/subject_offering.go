@@ -0,0 +1,426 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/student/types"
+)
+
+// SetSubjectOffering stores a subject offering
+func (k Keeper) SetSubjectOffering(ctx sdk.Context, offering types.SubjectOffering) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SubjectOfferingKeyPrefix))
+	store.Set([]byte(offering.Index), k.cdc.MustMarshal(&offering))
+}
+
+// GetSubjectOffering returns a subject offering by its index
+func (k Keeper) GetSubjectOffering(ctx sdk.Context, index string) (val types.SubjectOffering, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SubjectOfferingKeyPrefix))
+
+	b := store.Get([]byte(index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSubjectOfferings returns all subject offerings
+func (k Keeper) GetAllSubjectOfferings(ctx sdk.Context) (list []types.SubjectOffering) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SubjectOfferingKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SubjectOffering
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetSubjectOfferingCount get the total number of subject offerings
+func (k Keeper) GetSubjectOfferingCount(ctx sdk.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	bz := store.Get(types.KeyPrefix(types.SubjectOfferingCounterKey))
+
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetSubjectOfferingCount set the total number of subject offerings
+func (k Keeper) SetSubjectOfferingCount(ctx sdk.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	store.Set(types.KeyPrefix(types.SubjectOfferingCounterKey), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendSubjectOffering appends a subject offering in the store with a new id and update the count
+func (k Keeper) AppendSubjectOffering(ctx sdk.Context, offering types.SubjectOffering) string {
+	count := k.GetSubjectOfferingCount(ctx)
+	offering.Index = fmt.Sprintf("%d", count)
+
+	k.SetSubjectOffering(ctx, offering)
+	k.SetSubjectOfferingCount(ctx, count+1)
+
+	return offering.Index
+}
+
+// SetPendingPromotion marks an offering whose waitlist must be promoted at
+// the end of the block
+func (k Keeper) SetPendingPromotion(ctx sdk.Context, offeringId string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PendingPromotionKeyPrefix))
+	store.Set([]byte(offeringId), []byte{1})
+}
+
+// GetPendingPromotions returns the offerings awaiting waitlist promotion
+func (k Keeper) GetPendingPromotions(ctx sdk.Context) (offeringIds []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PendingPromotionKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		offeringIds = append(offeringIds, string(iterator.Key()))
+	}
+
+	return
+}
+
+func (k Keeper) removePendingPromotion(ctx sdk.Context, offeringId string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PendingPromotionKeyPrefix))
+	store.Delete([]byte(offeringId))
+}
+
+// PromoteWaitlists fills the seats freed during the block with the students
+// at the head of each waitlist, as long as the enrollment window of the
+// offering's term is still open. Waitlists of closed terms are left as they are.
+func (k Keeper) PromoteWaitlists(ctx sdk.Context) {
+	for _, offeringId := range k.GetPendingPromotions(ctx) {
+		k.removePendingPromotion(ctx, offeringId)
+
+		offering, found := k.GetSubjectOffering(ctx, offeringId)
+		if !found {
+			continue
+		}
+		term, found := k.institutionKeeper.GetAcademicTerm(ctx, offering.Institution, offering.Term)
+		if !found || !term.IsEnrollmentOpenAt(ctx.BlockTime()) {
+			continue
+		}
+
+		for offering.HasFreeSeat() && len(offering.Waitlist) > 0 {
+			studentId := offering.Waitlist[0]
+			offering.Waitlist = offering.Waitlist[1:]
+			offering.EnrolledStudents = append(offering.EnrolledStudents, studentId)
+			k.markSubjectInProgress(ctx, studentId, offering.SubjectId, offering.Institution)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeWaitlistPromoted,
+					sdk.NewAttribute(types.AttributeKeyOfferingId, offering.Index),
+					sdk.NewAttribute(types.AttributeKeyStudent, studentId),
+					sdk.NewAttribute(types.AttributeKeySubjectId, offering.SubjectId),
+				),
+			)
+		}
+		k.SetSubjectOffering(ctx, offering)
+	}
+}
+
+// enrollInSubjectOffering gives the student a seat in the offering, or a
+// place at the end of its waitlist when the offering is full. Students already
+// waiting keep their priority over newcomers while promotion is pending.
+func (k Keeper) enrollInSubjectOffering(ctx sdk.Context, studentId string, subjectId string, offeringId string) (*types.MsgRequestSubjectEnrollmentResponse, error) {
+	offering, found := k.GetSubjectOffering(ctx, offeringId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSubjectOfferingNotFound, "offering %s not found", offeringId)
+	}
+	if offering.SubjectId != subjectId {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSubjectOffering, "offering %s is not an offering of subject %s", offeringId, subjectId)
+	}
+	if offering.IsEnrolled(studentId) || offering.WaitlistPosition(studentId) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSubjectOffering, "student %s already holds a place in offering %s", studentId, offering.Label())
+	}
+
+	term, err := k.offeringTerm(ctx, offering)
+	if err != nil {
+		return nil, err
+	}
+	if !term.IsEnrollmentOpenAt(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrEnrollmentWindowClosed, "enrollment in term %s runs from %s to %s",
+			term.TermId, term.EnrollmentStart.Format(time.RFC3339), term.EnrollmentEnd.Format(time.RFC3339))
+	}
+
+	if offering.HasFreeSeat() && len(offering.Waitlist) == 0 {
+		offering.EnrolledStudents = append(offering.EnrolledStudents, studentId)
+		k.SetSubjectOffering(ctx, offering)
+		return &types.MsgRequestSubjectEnrollmentResponse{Status: types.OfferingStatusEnrolled}, nil
+	}
+
+	offering.Waitlist = append(offering.Waitlist, studentId)
+	k.SetSubjectOffering(ctx, offering)
+	position := uint64(len(offering.Waitlist))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubjectOfferingWaitlisted,
+			sdk.NewAttribute(types.AttributeKeyOfferingId, offering.Index),
+			sdk.NewAttribute(types.AttributeKeyStudent, studentId),
+			sdk.NewAttribute(types.AttributeKeyWaitlistPosition, strconv.FormatUint(position, 10)),
+		),
+	)
+
+	return &types.MsgRequestSubjectEnrollmentResponse{Status: types.OfferingStatusWaitlisted, WaitlistPosition: position}, nil
+}
+
+// offeringTerm returns the academic term an offering is scheduled in
+func (k Keeper) offeringTerm(ctx sdk.Context, offering types.SubjectOffering) (types.AcademicTerm, error) {
+	term, found := k.institutionKeeper.GetAcademicTerm(ctx, offering.Institution, offering.Term)
+	if !found {
+		return term, errorsmod.Wrapf(types.ErrInvalidSubjectOffering, "term %s is not in the academic calendar of institution %s", offering.Term, offering.Institution)
+	}
+	return term, nil
+}
+
+// authorizeOfferingChange checks that the creator is the student or an
+// enrollment manager of the offering's course
+func (k Keeper) authorizeOfferingChange(ctx sdk.Context, creator string, studentId string, offering types.SubjectOffering) error {
+	student, found := k.getStudentByIndex(ctx, studentId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrStudentNotFound, "student with ID %s not found", studentId)
+	}
+	if creator != student.Address && !k.institutionKeeper.CanManageEnrollment(ctx, offering.Institution, creator, offering.CourseId) {
+		return errorsmod.Wrapf(types.ErrNotAuthorized, "address %s cannot change the enrollment of student %s", creator, studentId)
+	}
+	return nil
+}
+
+// removeInProgressSubject takes a subject off the in-progress tokens of the
+// student's academic tree
+func (k Keeper) removeInProgressSubject(ctx sdk.Context, studentId string, subjectId string) (types.StudentAcademicTree, bool) {
+	academicTree, found := k.getAcademicTreeByStudent(ctx, studentId)
+	if !found {
+		return academicTree, false
+	}
+	academicTree.InProgressTokens = slices.DeleteFunc(academicTree.InProgressTokens, func(token string) bool {
+		return token == subjectId
+	})
+	return academicTree, true
+}
+
+// CreateSubjectOffering opens a section of a subject for a term of the
+// institution's academic calendar
+func (k msgServer) CreateSubjectOffering(goCtx context.Context, msg *types.MsgCreateSubjectOffering) (*types.MsgCreateSubjectOfferingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subject, found := k.subjectKeeper.GetSubject(ctx, msg.SubjectId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSubjectNotAvailable, "subject %s not found", msg.SubjectId)
+	}
+	if !k.institutionKeeper.CanManageEnrollment(ctx, subject.Institution, msg.Creator, subject.CourseId) {
+		return nil, errorsmod.Wrapf(types.ErrNotAuthorized, "address %s cannot open offerings of course %s", msg.Creator, subject.CourseId)
+	}
+	if !k.institutionKeeper.CanIssueGrade(ctx, subject.Institution, msg.Instructor, subject.CourseId, msg.SubjectId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSubjectOffering, "instructor %s cannot grade subject %s", msg.Instructor, msg.SubjectId)
+	}
+
+	offering := types.SubjectOffering{
+		SubjectId:        msg.SubjectId,
+		Institution:      subject.Institution,
+		CourseId:         subject.CourseId,
+		Term:             msg.Term,
+		Section:          msg.Section,
+		Capacity:         msg.Capacity,
+		Instructor:       msg.Instructor,
+		EnrolledStudents: []string{},
+		Waitlist:         []string{},
+		Creator:          msg.Creator,
+	}
+	if _, err := k.offeringTerm(ctx, offering); err != nil {
+		return nil, err
+	}
+	for _, existing := range k.GetAllSubjectOfferings(ctx) {
+		if existing.SubjectId == offering.SubjectId && existing.Term == offering.Term && existing.Section == offering.Section {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSubjectOffering, "offering %s already exists", offering.Label())
+		}
+	}
+
+	offering.Index = k.AppendSubjectOffering(ctx, offering)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateSubjectOffering,
+			sdk.NewAttribute(types.AttributeKeyOfferingId, offering.Index),
+			sdk.NewAttribute(types.AttributeKeySubjectId, offering.SubjectId),
+			sdk.NewAttribute(types.AttributeKeyTerm, offering.Term),
+			sdk.NewAttribute(types.AttributeKeySection, offering.Section),
+			sdk.NewAttribute(types.AttributeKeyCapacity, strconv.FormatUint(offering.Capacity, 10)),
+		),
+	)
+
+	return &types.MsgCreateSubjectOfferingResponse{OfferingId: offering.Index}, nil
+}
+
+// DropSubjectOffering removes a student from an offering without a record.
+// Seats can be dropped while the enrollment window is open; waitlist places
+// at any time.
+func (k msgServer) DropSubjectOffering(goCtx context.Context, msg *types.MsgDropSubjectOffering) (*types.MsgDropSubjectOfferingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offering, found := k.GetSubjectOffering(ctx, msg.OfferingId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSubjectOfferingNotFound, "offering %s not found", msg.OfferingId)
+	}
+	if err := k.authorizeOfferingChange(ctx, msg.Creator, msg.StudentId, offering); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case offering.WaitlistPosition(msg.StudentId) > 0:
+		offering.Waitlist = slices.DeleteFunc(offering.Waitlist, func(studentId string) bool {
+			return studentId == msg.StudentId
+		})
+	case offering.IsEnrolled(msg.StudentId):
+		term, err := k.offeringTerm(ctx, offering)
+		if err != nil {
+			return nil, err
+		}
+		if !term.IsEnrollmentOpenAt(ctx.BlockTime()) {
+			return nil, errorsmod.Wrapf(types.ErrEnrollmentWindowClosed, "seats of term %s can no longer be dropped, withdraw instead", term.TermId)
+		}
+
+		offering.EnrolledStudents = slices.DeleteFunc(offering.EnrolledStudents, func(studentId string) bool {
+			return studentId == msg.StudentId
+		})
+		if academicTree, found := k.removeInProgressSubject(ctx, msg.StudentId, offering.SubjectId); found {
+			k.setStudentAcademicTree(ctx, academicTree)
+		}
+		k.SetPendingPromotion(ctx, offering.Index)
+	default:
+		return nil, errorsmod.Wrapf(types.ErrNotInSubjectOffering, "student %s holds no place in offering %s", msg.StudentId, offering.Label())
+	}
+	k.SetSubjectOffering(ctx, offering)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDropSubjectOffering,
+			sdk.NewAttribute(types.AttributeKeyOfferingId, offering.Index),
+			sdk.NewAttribute(types.AttributeKeyStudent, msg.StudentId),
+			sdk.NewAttribute(types.AttributeKeyUpdatedBy, msg.Creator),
+		),
+	)
+
+	return &types.MsgDropSubjectOfferingResponse{}, nil
+}
+
+// WithdrawSubjectOffering releases the seat of a student after the enrollment
+// window closed and before the term ends, recording the withdrawal in the
+// academic tree. The freed seat is not offered to the waitlist, as nobody can
+// join an offering once its enrollment window has closed.
+func (k msgServer) WithdrawSubjectOffering(goCtx context.Context, msg *types.MsgWithdrawSubjectOffering) (*types.MsgWithdrawSubjectOfferingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offering, found := k.GetSubjectOffering(ctx, msg.OfferingId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSubjectOfferingNotFound, "offering %s not found", msg.OfferingId)
+	}
+	if err := k.authorizeOfferingChange(ctx, msg.Creator, msg.StudentId, offering); err != nil {
+		return nil, err
+	}
+	if !offering.IsEnrolled(msg.StudentId) {
+		return nil, errorsmod.Wrapf(types.ErrNotInSubjectOffering, "student %s holds no seat in offering %s", msg.StudentId, offering.Label())
+	}
+
+	term, err := k.offeringTerm(ctx, offering)
+	if err != nil {
+		return nil, err
+	}
+	now := ctx.BlockTime()
+	if !now.After(term.EnrollmentEnd) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSubjectOffering, "enrollment in term %s is still open, drop the offering instead", term.TermId)
+	}
+	if now.After(term.EndDate) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSubjectOffering, "term %s ended on %s", term.TermId, term.EndDate.Format(time.RFC3339))
+	}
+
+	academicTree, found := k.removeInProgressSubject(ctx, msg.StudentId, offering.SubjectId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAcademicTreeNotFound, "academic tree for student %s not found", msg.StudentId)
+	}
+	academicTree.Withdrawals = append(academicTree.Withdrawals, &types.SubjectWithdrawal{
+		SubjectId:   offering.SubjectId,
+		OfferingId:  offering.Index,
+		Term:        offering.Term,
+		Reason:      msg.Reason,
+		WithdrawnBy: msg.Creator,
+		WithdrawnAt: now.Format(time.RFC3339),
+	})
+	k.setStudentAcademicTree(ctx, academicTree)
+
+	offering.EnrolledStudents = slices.DeleteFunc(offering.EnrolledStudents, func(studentId string) bool {
+		return studentId == msg.StudentId
+	})
+	k.SetSubjectOffering(ctx, offering)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawSubjectOffering,
+			sdk.NewAttribute(types.AttributeKeyOfferingId, offering.Index),
+			sdk.NewAttribute(types.AttributeKeyStudent, msg.StudentId),
+			sdk.NewAttribute(types.AttributeKeyTerm, offering.Term),
+			sdk.NewAttribute(types.AttributeKeyUpdatedBy, msg.Creator),
+		),
+	)
+
+	return &types.MsgWithdrawSubjectOfferingResponse{}, nil
+}
+
+// SubjectOffering implements the QueryServer interface
+func (k Keeper) SubjectOffering(ctx context.Context, req *types.QuerySubjectOfferingRequest) (*types.QuerySubjectOfferingResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	offering, found := k.GetSubjectOffering(sdk.UnwrapSDKContext(ctx), req.OfferingId)
+	if !found {
+		return nil, types.ErrSubjectOfferingNotFound
+	}
+	return &types.QuerySubjectOfferingResponse{Offering: offering}, nil
+}
+
+// SubjectOfferings implements the QueryServer interface, optionally narrowed
+// to a subject and a term
+func (k Keeper) SubjectOfferings(ctx context.Context, req *types.QuerySubjectOfferingsRequest) (*types.QuerySubjectOfferingsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	offerings := []types.SubjectOffering{}
+	for _, offering := range k.GetAllSubjectOfferings(sdk.UnwrapSDKContext(ctx)) {
+		if (req.SubjectId == "" || offering.SubjectId == req.SubjectId) && (req.Term == "" || offering.Term == req.Term) {
+			offerings = append(offerings, offering)
+		}
+	}
+	return &types.QuerySubjectOfferingsResponse{Offerings: offerings}, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/student/keeper"
+	"academictoken/x/student/types"
+)
+
+func TestSubjectOfferingWaitlist(t *testing.T) {
+	k, ctx := keepertest.StudentKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	registrar, instructor := sample.AccAddress(), sample.AccAddress()
+
+	// The mock term enrolls through January and ends in June
+	ctx = ctx.WithBlockTime(time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC))
+	for _, id := range []string{"student-1", "student-2", "student-3"} {
+		k.SetStudent(ctx, types.Student{Index: id, Address: sample.AccAddress(), Name: id})
+		k.SetStudentAcademicTree(ctx, types.StudentAcademicTree{Index: id, Student: id, Institution: "institution-1"})
+	}
+
+	created, err := srv.CreateSubjectOffering(ctx, &types.MsgCreateSubjectOffering{Creator: registrar, SubjectId: "subject-1", Term: "2024-1", Section: "A", Capacity: 1, Instructor: instructor})
+	require.NoError(t, err)
+	require.Equal(t, "0", created.OfferingId)
+	_, err = srv.CreateSubjectOffering(ctx, &types.MsgCreateSubjectOffering{Creator: registrar, SubjectId: "subject-1", Term: "2024-1", Section: "A", Capacity: 2, Instructor: instructor})
+	require.ErrorIs(t, err, types.ErrInvalidSubjectOffering)
+
+	request := func(studentId string) (*types.MsgRequestSubjectEnrollmentResponse, error) {
+		return srv.RequestSubjectEnrollment(ctx, &types.MsgRequestSubjectEnrollment{Creator: registrar, Student: studentId, SubjectId: "subject-1", OfferingId: created.OfferingId})
+	}
+
+	// The first student takes the only seat, the others queue up
+	res, err := request("student-1")
+	require.NoError(t, err)
+	require.Equal(t, types.OfferingStatusEnrolled, res.Status)
+	res, err = request("student-2")
+	require.NoError(t, err)
+	require.Equal(t, types.OfferingStatusWaitlisted, res.Status)
+	require.Equal(t, uint64(1), res.WaitlistPosition)
+	res, err = request("student-3")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.WaitlistPosition)
+	_, err = request("student-3")
+	require.ErrorIs(t, err, types.ErrInvalidSubjectOffering)
+
+	tree, _ := k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.Contains(t, tree.InProgressTokens, "subject-1")
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-2")
+	require.NotContains(t, tree.InProgressTokens, "subject-1")
+
+	// Dropping the seat promotes the head of the waitlist at the end of the block
+	_, err = srv.DropSubjectOffering(ctx, &types.MsgDropSubjectOffering{Creator: registrar, StudentId: "student-1", OfferingId: created.OfferingId})
+	require.NoError(t, err)
+	require.Equal(t, []string{created.OfferingId}, k.GetPendingPromotions(ctx))
+	k.PromoteWaitlists(ctx)
+	require.Empty(t, k.GetPendingPromotions(ctx))
+
+	offering, found := k.GetSubjectOffering(ctx, created.OfferingId)
+	require.True(t, found)
+	require.Equal(t, []string{"student-2"}, offering.EnrolledStudents)
+	require.Equal(t, []string{"student-3"}, offering.Waitlist)
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-1")
+	require.NotContains(t, tree.InProgressTokens, "subject-1")
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-2")
+	require.Contains(t, tree.InProgressTokens, "subject-1")
+
+	// After enrollment closes seats can only be withdrawn, which is recorded
+	ctx = ctx.WithBlockTime(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC))
+	_, err = request("student-1")
+	require.ErrorIs(t, err, types.ErrEnrollmentWindowClosed)
+	_, err = srv.DropSubjectOffering(ctx, &types.MsgDropSubjectOffering{Creator: registrar, StudentId: "student-2", OfferingId: created.OfferingId})
+	require.ErrorIs(t, err, types.ErrEnrollmentWindowClosed)
+	_, err = srv.WithdrawSubjectOffering(ctx, &types.MsgWithdrawSubjectOffering{Creator: registrar, StudentId: "student-1", OfferingId: created.OfferingId, Reason: "illness"})
+	require.ErrorIs(t, err, types.ErrNotInSubjectOffering)
+	_, err = srv.WithdrawSubjectOffering(ctx, &types.MsgWithdrawSubjectOffering{Creator: registrar, StudentId: "student-2", OfferingId: created.OfferingId, Reason: "illness"})
+	require.NoError(t, err)
+	require.Empty(t, k.GetPendingPromotions(ctx))
+
+	tree, _ = k.GetAcademicTreeByStudentTyped(ctx, "student-2")
+	require.NotContains(t, tree.InProgressTokens, "subject-1")
+	require.Len(t, tree.Withdrawals, 1)
+	require.Equal(t, "2024-1", tree.Withdrawals[0].Term)
+	require.Equal(t, "illness", tree.Withdrawals[0].Reason)
+
+	// Waitlists are not promoted once enrollment has closed
+	k.PromoteWaitlists(ctx)
+	offering, _ = k.GetSubjectOffering(ctx, created.OfferingId)
+	require.Empty(t, offering.EnrolledStudents)
+	require.Equal(t, []string{"student-3"}, offering.Waitlist)
+
+	offerings, err := k.SubjectOfferings(ctx, &types.QuerySubjectOfferingsRequest{Term: "2024-2"})
+	require.NoError(t, err)
+	require.Empty(t, offerings.Offerings)
+}
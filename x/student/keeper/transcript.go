@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/student/types"
+)
+
+// Transcript implements the QueryServer interface
+func (k Keeper) Transcript(ctx context.Context, req *types.QueryTranscriptRequest) (*types.QueryTranscriptResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	student, found := k.getStudentByIndex(sdkCtx, req.StudentId)
+	if !found {
+		return nil, types.ErrStudentNotFound
+	}
+
+	transcript, err := k.BuildTranscript(sdkCtx, student)
+	if err != nil || req.Term == "" {
+		return transcript, err
+	}
+	return transcriptForTerm(transcript, req.Term), nil
+}
+
+// transcriptForTerm narrows a transcript down to the subjects of a single
+// academic term. The cumulative GPA is the one reached at the end of the term;
+// transferred subjects and prerequisite waivers, which belong to no term, are
+// left out.
+func transcriptForTerm(transcript *types.QueryTranscriptResponse, term string) *types.QueryTranscriptResponse {
+	filtered := *transcript
+	filtered.Semesters = nil
+	filtered.TransferredSubjects = nil
+	filtered.PrerequisiteWaivers = nil
+	filtered.TotalCredits = 0
+	filtered.TotalWorkloadHours = 0
+	filtered.CumulativeGpa = gpaAccumulator{points: math.LegacyZeroDec()}.String()
+	filtered.GpaCredits = 0
+
+	for _, semester := range transcript.Semesters {
+		if semester.Semester != term {
+			continue
+		}
+		filtered.Semesters = []types.TranscriptSemester{semester}
+		filtered.TotalCredits = semester.Credits
+		filtered.TotalWorkloadHours = semester.WorkloadHours
+		filtered.CumulativeGpa = semester.CumulativeGpa
+		for _, entry := range semester.Subjects {
+			if entry.CountsTowardGpa {
+				filtered.GpaCredits += entry.Credits
+			}
+		}
+	}
+
+	return &filtered
+}
+
+// BuildTranscript aggregates the valid subject tokens of a student per
+// semester, resolving each token to its subject through its token definition,
+// together with the subjects credited through transfers and approved
+// equivalences. Revoked tokens are left out. GPAs weight the grade points of
+// each subject by its credits; grades that carry no points are left out of
+// them.
+func (k Keeper) BuildTranscript(ctx sdk.Context, student types.Student) (*types.QueryTranscriptResponse, error) {
+	transcript := &types.QueryTranscriptResponse{
+		StudentId:      student.Index,
+		StudentAddress: student.Address,
+		Name:           student.Name,
+	}
+
+	tree, hasTree := k.getAcademicTreeByStudent(ctx, student.Index)
+	if hasTree {
+		transcript.Institution = tree.Institution
+		transcript.CourseId = tree.CourseId
+		transcript.CurriculumVersion = tree.CurriculumVersion
+		for _, waiver := range tree.PrerequisiteWaivers {
+			transcript.PrerequisiteWaivers = append(transcript.PrerequisiteWaivers, *waiver)
+		}
+	}
+
+	var tokens []types.SubjectTokenInstance
+	if k.academicNFTKeeper != nil {
+		var err error
+		tokens, err = k.academicNFTKeeper.GetStudentTokenInstances(ctx, student.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	completed := make(map[string]bool)
+	bySemester := make(map[string][]types.TranscriptEntry)
+	gradePoints := make(map[string]math.LegacyDec)
+	for _, token := range tokens {
+		// Transfer-credit tokens are listed with the transferred subjects
+		if !token.IsValid || token.TransferCredit {
+			continue
+		}
+		entry, points := k.transcriptEntry(ctx, token)
+		completed[entry.SubjectId] = true
+		bySemester[token.Semester] = append(bySemester[token.Semester], entry)
+		gradePoints[entry.TokenInstanceId] = points
+	}
+
+	semesters := make([]string, 0, len(bySemester))
+	for semester := range bySemester {
+		semesters = append(semesters, semester)
+	}
+	sort.Strings(semesters)
+
+	cumulative := gpaAccumulator{points: math.LegacyZeroDec()}
+	for _, semester := range semesters {
+		entries := bySemester[semester]
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].CompletionDate != entries[j].CompletionDate {
+				return entries[i].CompletionDate < entries[j].CompletionDate
+			}
+			return entries[i].SubjectId < entries[j].SubjectId
+		})
+
+		record := types.TranscriptSemester{Semester: semester, Subjects: entries}
+		semesterGpa := gpaAccumulator{points: math.LegacyZeroDec()}
+		for _, entry := range entries {
+			if entry.Passed {
+				record.Credits += entry.Credits
+				record.WorkloadHours += entry.WorkloadHours
+			}
+			if entry.CountsTowardGpa {
+				semesterGpa.add(gradePoints[entry.TokenInstanceId], entry.Credits)
+				cumulative.add(gradePoints[entry.TokenInstanceId], entry.Credits)
+			}
+		}
+		record.Gpa = semesterGpa.String()
+		record.CumulativeGpa = cumulative.String()
+
+		transcript.Semesters = append(transcript.Semesters, record)
+		transcript.TotalCredits += record.Credits
+		transcript.TotalWorkloadHours += record.WorkloadHours
+	}
+
+	if hasTree {
+		for _, transferred := range k.transferredSubjects(ctx, tree, completed) {
+			transcript.TransferredSubjects = append(transcript.TransferredSubjects, transferred)
+			transcript.TotalCredits += transferred.Credits
+			transcript.TotalWorkloadHours += transferred.WorkloadHours
+		}
+	}
+
+	transcript.CumulativeGpa = cumulative.String()
+	transcript.GpaCredits = cumulative.credits
+
+	return transcript, nil
+}
+
+// transcriptEntry resolves a token instance to the subject it certifies and
+// returns the GPA points of its grade
+func (k Keeper) transcriptEntry(ctx sdk.Context, token types.SubjectTokenInstance) (types.TranscriptEntry, math.LegacyDec) {
+	entry := types.TranscriptEntry{
+		Grade:           token.Grade,
+		TokenInstanceId: token.TokenInstanceId,
+		CompletionDate:  token.CompletionDate,
+		Institution:     token.IssuerInstitution,
+		Passed:          true,
+	}
+
+	if k.tokenDefKeeper != nil {
+		if tokenDef, found := k.tokenDefKeeper.GetTokenDefinitionByIndex(ctx, token.TokenDefId); found {
+			entry.SubjectId = tokenDef.SubjectId
+		}
+	}
+	if subject, found := k.subjectKeeper.GetSubject(ctx, entry.SubjectId); found {
+		entry.Code = subject.Code
+		entry.Title = subject.Title
+		entry.Credits = subject.Credits
+		entry.WorkloadHours = subject.WorkloadHours
+		entry.KnowledgeArea = subject.KnowledgeArea
+	}
+
+	// Grades the institution scale no longer accepts still certify the completion
+	points := math.LegacyZeroDec()
+	if grade, err := k.institutionKeeper.NormalizeGrade(ctx, token.IssuerInstitution, token.Grade); err == nil {
+		entry.Passed = grade.Passing
+		entry.CountsTowardGpa = grade.CountsTowardGpa && !grade.GpaPoints.IsNil() && entry.Credits > 0
+		if entry.CountsTowardGpa {
+			points = grade.GpaPoints
+		}
+	}
+
+	return entry, points
+}
+
+// transferredSubjects returns the subjects the academic tree credits through
+// transfers and approved equivalences, skipping those already completed
+func (k Keeper) transferredSubjects(ctx sdk.Context, tree types.StudentAcademicTree, completed map[string]bool) []types.TransferredSubject {
+	var transferred []types.TransferredSubject
+	seen := make(map[string]bool)
+	add := func(subjectId string, request *types.EquivalenceRequest) {
+		if subjectId == "" || completed[subjectId] || seen[subjectId] {
+			return
+		}
+		seen[subjectId] = true
+
+		entry := types.TransferredSubject{SubjectId: subjectId}
+		if subject, found := k.subjectKeeper.GetSubject(ctx, subjectId); found {
+			entry.Code = subject.Code
+			entry.Title = subject.Title
+			entry.Credits = subject.Credits
+			entry.WorkloadHours = subject.WorkloadHours
+			entry.KnowledgeArea = subject.KnowledgeArea
+		}
+		if request != nil {
+			entry.SourceSubjectId = request.SourceSubjectId
+			entry.EquivalenceType = request.EquivalenceType
+		}
+		transferred = append(transferred, entry)
+	}
+
+	approved := make(map[string]*types.EquivalenceRequest)
+	for _, request := range tree.EquivalenceRequests {
+		if request != nil && request.Status == "approved" {
+			approved[request.TargetSubjectId] = request
+		}
+	}
+	for _, subjectId := range tree.TransferredSubjects {
+		add(subjectId, approved[subjectId])
+	}
+	for _, request := range tree.EquivalenceRequests {
+		if request != nil && request.Status == "approved" {
+			add(request.TargetSubjectId, request)
+		}
+	}
+
+	return transferred
+}
+
+// gpaAccumulator sums grade points weighted by credits
+type gpaAccumulator struct {
+	points  math.LegacyDec
+	credits uint64
+}
+
+func (a *gpaAccumulator) add(points math.LegacyDec, credits uint64) {
+	a.points = a.points.Add(points.MulInt(math.NewIntFromUint64(credits)))
+	a.credits += credits
+}
+
+// String returns the GPA rounded to two decimals, or "" without graded credits
+func (a gpaAccumulator) String() string {
+	if a.credits == 0 {
+		return ""
+	}
+	hundredths := a.points.QuoInt(math.NewIntFromUint64(a.credits)).MulInt64(100).RoundInt64()
+	return fmt.Sprintf("%d.%02d", hundredths/100, hundredths%100)
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/student/types"
+)
+
+func TestTranscript(t *testing.T) {
+	address := sample.AccAddress()
+	subjects := keepertest.MockStudentSubjectKeeper{Subjects: map[string]types.SubjectContent{
+		"calc1":   {Index: "calc1", Code: "MAT101", Title: "Calculus I", Credits: 4, WorkloadHours: 60, KnowledgeArea: "mathematics"},
+		"prog1":   {Index: "prog1", Code: "CS101", Title: "Programming I", Credits: 6, WorkloadHours: 90, KnowledgeArea: "computing"},
+		"calc2":   {Index: "calc2", Code: "MAT102", Title: "Calculus II", Credits: 4, WorkloadHours: 60, KnowledgeArea: "mathematics"},
+		"physics": {Index: "physics", Code: "PHY101", Title: "Physics I", Credits: 4, WorkloadHours: 60, KnowledgeArea: "physics"},
+		"ethics":  {Index: "ethics", Code: "HUM101", Title: "Ethics", Credits: 2, WorkloadHours: 30, KnowledgeArea: "humanities"},
+	}}
+	tokenDefs := keepertest.MockStudentTokenDefKeeper{TokenDefs: map[string]types.TokenDefinition{
+		"def-calc1":   {Index: "def-calc1", SubjectId: "calc1"},
+		"def-prog1":   {Index: "def-prog1", SubjectId: "prog1"},
+		"def-calc2":   {Index: "def-calc2", SubjectId: "calc2"},
+		"def-physics": {Index: "def-physics", SubjectId: "physics"},
+	}}
+	token := func(id string, tokenDefId string, grade string, semester string, valid bool) types.SubjectTokenInstance {
+		return types.SubjectTokenInstance{TokenInstanceId: id, TokenDefId: tokenDefId, Student: address, Grade: grade, Semester: semester, IssuerInstitution: "inst-1", CompletionDate: semester + "-end", IsValid: valid}
+	}
+	tokens := keepertest.MockStudentAcademicNFTKeeper{Tokens: map[string][]types.SubjectTokenInstance{address: {
+		token("3", "def-calc2", "100", "2025-2", true),
+		token("1", "def-calc1", "80", "2025-1", true),
+		token("2", "def-prog1", "90", "2025-1", true),
+		token("4", "def-physics", "70", "2025-2", false),
+	}}}
+
+	k, ctx := keepertest.StudentKeeperWithRecords(t, subjects, tokenDefs, tokens)
+	k.SetStudent(ctx, types.Student{Index: "student-1", Address: address, Name: "Ada"})
+	k.SetStudentAcademicTree(ctx, types.StudentAcademicTree{
+		Index:               "tree-1",
+		Student:             "student-1",
+		Institution:         "inst-1",
+		CourseId:            "course-1",
+		TransferredSubjects: []string{"ethics"},
+		EquivalenceRequests: []*types.EquivalenceRequest{
+			{Id: "eq-1", TargetSubjectId: "ethics", SourceSubjectId: "other-ethics", Status: "approved", EquivalenceType: "full"},
+			{Id: "eq-2", TargetSubjectId: "physics", SourceSubjectId: "other-physics", Status: "rejected"},
+		},
+	})
+
+	_, err := k.Transcript(ctx, &types.QueryTranscriptRequest{StudentId: "student-2"})
+	require.ErrorIs(t, err, types.ErrStudentNotFound)
+
+	transcript, err := k.Transcript(ctx, &types.QueryTranscriptRequest{StudentId: "student-1"})
+	require.NoError(t, err)
+	require.Equal(t, "Ada", transcript.Name)
+	require.Equal(t, "course-1", transcript.CourseId)
+
+	// Revoked tokens are left out and semesters are in order
+	require.Len(t, transcript.Semesters, 2)
+	first, second := transcript.Semesters[0], transcript.Semesters[1]
+	require.Equal(t, "2025-1", first.Semester)
+	require.Equal(t, []string{"calc1", "prog1"}, []string{first.Subjects[0].SubjectId, first.Subjects[1].SubjectId})
+	require.Equal(t, types.TranscriptEntry{
+		SubjectId:       "calc1",
+		Code:            "MAT101",
+		Title:           "Calculus I",
+		Grade:           "80",
+		Credits:         4,
+		WorkloadHours:   60,
+		KnowledgeArea:   "mathematics",
+		TokenInstanceId: "1",
+		CompletionDate:  "2025-1-end",
+		Institution:     "inst-1",
+		Passed:          true,
+		CountsTowardGpa: true,
+	}, first.Subjects[0])
+	require.Equal(t, uint64(10), first.Credits)
+	require.Equal(t, uint64(150), first.WorkloadHours)
+	// (3.2*4 + 3.6*6) / 10
+	require.Equal(t, "3.44", first.Gpa)
+	require.Equal(t, "3.44", first.CumulativeGpa)
+
+	require.Equal(t, "2025-2", second.Semester)
+	require.Len(t, second.Subjects, 1)
+	require.Equal(t, "4.00", second.Gpa)
+	// (3.2*4 + 3.6*6 + 4.0*4) / 14
+	require.Equal(t, "3.60", second.CumulativeGpa)
+
+	// Transferred subjects count toward credits but not the GPA
+	require.Equal(t, []types.TransferredSubject{{
+		SubjectId:       "ethics",
+		Code:            "HUM101",
+		Title:           "Ethics",
+		Credits:         2,
+		WorkloadHours:   30,
+		KnowledgeArea:   "humanities",
+		SourceSubjectId: "other-ethics",
+		EquivalenceType: "full",
+	}}, transcript.TransferredSubjects)
+	require.Equal(t, uint64(16), transcript.TotalCredits)
+	require.Equal(t, uint64(240), transcript.TotalWorkloadHours)
+	require.Equal(t, "3.60", transcript.CumulativeGpa)
+	require.Equal(t, uint64(14), transcript.GpaCredits)
+
+	// filtered by term, only that semester is reported
+	byTerm, err := k.Transcript(ctx, &types.QueryTranscriptRequest{StudentId: "student-1", Term: "2025-2"})
+	require.NoError(t, err)
+	require.Equal(t, []types.TranscriptSemester{second}, byTerm.Semesters)
+	require.Empty(t, byTerm.TransferredSubjects)
+	require.Equal(t, second.Credits, byTerm.TotalCredits)
+	require.Equal(t, "3.60", byTerm.CumulativeGpa)
+}
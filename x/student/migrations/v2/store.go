@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"academictoken/x/student/types"
+)
+
+// LegacyParams returns the values that v1 of the module served from
+// GetHardcodedParams instead of reading them from state.
+func LegacyParams() types.Params {
+	return types.Params{
+		IpfsGateway:                  "http://localhost:5001",
+		IpfsEnabled:                  true,
+		Admin:                        "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+		PrerequisitesContractAddr:    "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr",
+		EquivalenceContractAddr:      "cosmos1nc5tatafv6eyq7llkr2gv50ff9e22mnf70qgjlv737ktmt4eswrqrr2r7y",
+		AcademicProgressContractAddr: "cosmos1a7x8aj7k38vnj9edrlymkerhrl5d4ud3makmqhx6vt3dhu0d824swy3kus",
+		DegreeContractAddr:           "cosmos15f3n26jmjyh3qfwd7rtmpnr0c6n9qhc9w3j6e2jqz9x8h7f2k6hqeqvhzm",
+		NftMintingContractAddr:       "cosmos1z6a9x2xs5n8j7k3l4f8m9c2v1b6h4g8r7e3w5q9t8y7u6i5o4p3a2s1d0f",
+	}
+}
+
+// MigrateStore seeds the params store with the legacy hardcoded values.
+// v1 never persisted params, so anything already stored was written by
+// governance and is left untouched. Legacy addresses are re-encoded with the
+// chain's account prefix; addresses that are not valid bech32 are dropped
+// and have to be configured through MsgUpdateParams.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec, logger log.Logger) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	if kvStore.Has(types.KeyPrefix(types.ParamsKey)) {
+		return nil
+	}
+
+	params := LegacyParams()
+	params.Admin = convertAddress(logger, "admin", params.Admin)
+	params.PrerequisitesContractAddr = convertAddress(logger, "prerequisites contract", params.PrerequisitesContractAddr)
+	params.EquivalenceContractAddr = convertAddress(logger, "equivalence contract", params.EquivalenceContractAddr)
+	params.AcademicProgressContractAddr = convertAddress(logger, "academic progress contract", params.AcademicProgressContractAddr)
+	params.DegreeContractAddr = convertAddress(logger, "degree contract", params.DegreeContractAddr)
+	params.NftMintingContractAddr = convertAddress(logger, "NFT minting contract", params.NftMintingContractAddr)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	kvStore.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	return nil
+}
+
+// convertAddress re-encodes addr with the configured account prefix, returning
+// an empty string when addr cannot be decoded.
+func convertAddress(logger log.Logger, name, addr string) string {
+	_, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		logger.Error("dropping invalid legacy address from student params", "param", name, "address", addr, "error", err)
+		return ""
+	}
+	return sdk.AccAddress(bz).String()
+}
@@ -0,0 +1,70 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+
+	v2 "academictoken/x/student/migrations/v2"
+	"academictoken/x/student/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc, log.NewNopLogger()))
+
+	var params types.Params
+	bz := ctx.KVStore(storeKey).Get(types.KeyPrefix(types.ParamsKey))
+	require.NotNil(t, bz)
+	require.NoError(t, cdc.Unmarshal(bz, &params))
+	require.NoError(t, params.Validate())
+
+	legacy := v2.LegacyParams()
+	require.Equal(t, legacy.IpfsGateway, params.IpfsGateway)
+	require.Equal(t, legacy.IpfsEnabled, params.IpfsEnabled)
+
+	// valid legacy addresses keep their account bytes under the chain prefix
+	_, adminBz, err := bech32.DecodeAndConvert(legacy.Admin)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(adminBz).String(), params.Admin)
+	_, prereqBz, err := bech32.DecodeAndConvert(legacy.PrerequisitesContractAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(prereqBz).String(), params.PrerequisitesContractAddr)
+
+	// addresses with a broken checksum are dropped
+	require.Empty(t, params.EquivalenceContractAddr)
+	require.Empty(t, params.AcademicProgressContractAddr)
+	require.Empty(t, params.DegreeContractAddr)
+	require.Empty(t, params.NftMintingContractAddr)
+}
+
+func TestMigrateStoreKeepsExistingParams(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	existing := types.DefaultParams()
+	existing.Admin = sdk.AccAddress("admin_______________").String()
+	bz, err := cdc.Marshal(&existing)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc, log.NewNopLogger()))
+
+	var params types.Params
+	require.NoError(t, cdc.Unmarshal(ctx.KVStore(storeKey).Get(types.KeyPrefix(types.ParamsKey)), &params))
+	require.Equal(t, existing, params)
+}
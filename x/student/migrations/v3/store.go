@@ -0,0 +1,50 @@
+package v3
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/student/types"
+)
+
+// MigrateStore sets the integration mode of params stored before modes
+// existed. v2 served mock results for every contract without an address, so
+// chains with all contracts configured keep calling them and every other
+// chain switches to native evaluation. Params that already carry a mode are
+// left untouched.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec, logger log.Logger) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	bz := kvStore.Get(types.KeyPrefix(types.ParamsKey))
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	if params.IntegrationMode != "" {
+		return nil
+	}
+
+	params.IntegrationMode = types.IntegrationModeContract
+	if params.Validate() != nil {
+		params.IntegrationMode = types.IntegrationModeNative
+	}
+	logger.Info("setting student integration mode", "mode", params.IntegrationMode)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	kvStore.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	return nil
+}
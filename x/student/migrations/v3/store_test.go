@@ -0,0 +1,67 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "academictoken/x/student/migrations/v3"
+	"academictoken/x/student/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+
+	tests := []struct {
+		desc     string
+		params   types.Params
+		expected string
+	}{
+		{
+			desc:     "no contracts",
+			params:   types.NewParams("http://localhost:5001", true, "", "", "", "", "", "", ""),
+			expected: types.IntegrationModeNative,
+		},
+		{
+			desc:     "missing NFT minting contract",
+			params:   types.NewParams("http://localhost:5001", true, "", contract, contract, contract, contract, "", ""),
+			expected: types.IntegrationModeNative,
+		},
+		{
+			desc:     "all contracts",
+			params:   types.NewParams("http://localhost:5001", true, "", contract, contract, contract, contract, contract, ""),
+			expected: types.IntegrationModeContract,
+		},
+		{
+			desc:     "mode already set",
+			params:   types.NewParams("http://localhost:5001", true, "", "", "", "", "", "", types.IntegrationModeDisabled),
+			expected: types.IntegrationModeDisabled,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+			bz, err := cdc.Marshal(&tc.params)
+			require.NoError(t, err)
+			ctx.KVStore(storeKey).Set(types.KeyPrefix(types.ParamsKey), bz)
+
+			require.NoError(t, v3.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc, log.NewNopLogger()))
+
+			var params types.Params
+			require.NoError(t, cdc.Unmarshal(ctx.KVStore(storeKey).Get(types.KeyPrefix(types.ParamsKey)), &params))
+			require.Equal(t, tc.expected, params.IntegrationMode)
+			params.IntegrationMode = tc.params.IntegrationMode
+			require.Equal(t, tc.params, params)
+		})
+	}
+}
@@ -17,6 +17,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "IntegrationMode",
+					Use:       "integration-mode",
+					Short:     "Shows how the module evaluates progress and which contracts it calls",
+				},
+				{
+					RpcMethod:      "Transcript",
+					Use:            "transcript [student-id]",
+					Short:          "Shows the transcript of a student: completed subjects per semester, credits and GPA",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "student_id"}},
+				},
+				{
+					RpcMethod:      "SubjectOffering",
+					Use:            "subject-offering [offering-id]",
+					Short:          "Shows a subject offering with its seats and waitlist",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "offeringId"}},
+				},
+				{
+					RpcMethod: "SubjectOfferings",
+					Use:       "subject-offerings",
+					Short:     "Lists subject offerings, optionally of a subject and a term",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -70,6 +92,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Shorthand: "",
 							Usage:     "NFT minting contract address",
 						},
+						"params.integration_mode": {
+							Name:      "integration-mode",
+							Shorthand: "",
+							Usage:     "Integration mode: contract, native or disabled",
+						},
 					},
 				},
 				{
@@ -92,9 +119,9 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "RequestSubjectEnrollment",
-					Use:            "request-subject-enrollment [student] [subject-id]",
-					Short:          "Send a request-subject-enrollment tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "student"}, {ProtoField: "subjectId"}},
+					Use:            "request-subject-enrollment [student] [subject-id] [offering-id]",
+					Short:          "Send a request-subject-enrollment tx, taking a seat or a waitlist place when an offering is given",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "student"}, {ProtoField: "subjectId"}, {ProtoField: "offeringId", Optional: true}},
 				},
 				{
 					RpcMethod:      "UpdateAcademicTree",
@@ -102,6 +129,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a update-academic-tree tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "studentId"}},
 				},
+				{
+					RpcMethod:      "RequestCurriculumMigration",
+					Use:            "request-curriculum-migration [student-id] [target-version] [reason]",
+					Short:          "Request to move a student to a newer published curriculum version",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "studentId"}, {ProtoField: "targetVersion"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "ReviewCurriculumMigration",
+					Use:            "review-curriculum-migration [student-id] [migration-id] [approve] [note]",
+					Short:          "Approve or reject a pending curriculum migration",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "studentId"}, {ProtoField: "migrationId"}, {ProtoField: "approve"}, {ProtoField: "note"}},
+				},
+				{
+					RpcMethod:      "CreateSubjectOffering",
+					Use:            "create-subject-offering [subject-id] [term] [section] [capacity] [instructor]",
+					Short:          "Open a section of a subject for an academic term with a seat capacity",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subjectId"}, {ProtoField: "term"}, {ProtoField: "section"}, {ProtoField: "capacity"}, {ProtoField: "instructor"}},
+				},
+				{
+					RpcMethod:      "DropSubjectOffering",
+					Use:            "drop-subject-offering [student-id] [offering-id]",
+					Short:          "Drop a seat or waitlist place in a subject offering while enrollment is open",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "studentId"}, {ProtoField: "offeringId"}},
+				},
+				{
+					RpcMethod:      "WithdrawSubjectOffering",
+					Use:            "withdraw-subject-offering [student-id] [offering-id] [reason]",
+					Short:          "Withdraw from a subject offering after enrollment closed, recording it in the academic tree",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "studentId"}, {ProtoField: "offeringId"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "GrantPrerequisiteWaiver",
+					Use:            "grant-prerequisite-waiver [student-id] [subject-id] [reason] [expires-at]",
+					Short:          "Exempt a student from the prerequisites of a subject until an RFC3339 expiry",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "studentId"}, {ProtoField: "subjectId"}, {ProtoField: "reason"}, {ProtoField: "expiresAt"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
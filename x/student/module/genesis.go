@@ -9,6 +9,43 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the student
+	for _, elem := range genState.StudentList {
+		k.SetStudent(ctx, elem)
+	}
+
+	// Set student count
+	k.SetStudentCount(ctx, genState.StudentCount)
+
+	// Set all the studentEnrollment
+	for _, elem := range genState.StudentEnrollmentList {
+		k.SetStudentEnrollment(ctx, elem)
+	}
+
+	// Set studentEnrollment count
+	k.SetStudentEnrollmentCount(ctx, genState.StudentEnrollmentCount)
+
+	// Set all the studentAcademicTree
+	for _, elem := range genState.StudentAcademicTreeList {
+		k.SetStudentAcademicTree(ctx, elem)
+	}
+
+	// Set studentAcademicTree count
+	k.SetStudentAcademicTreeCount(ctx, genState.StudentAcademicTreeCount)
+
+	// Set all the subjectOffering
+	for _, elem := range genState.SubjectOfferingList {
+		k.SetSubjectOffering(ctx, elem)
+	}
+
+	// Set subjectOffering count
+	k.SetSubjectOfferingCount(ctx, genState.SubjectOfferingCount)
+
+	// Set offerings awaiting waitlist promotion
+	for _, offeringId := range genState.PendingPromotions {
+		k.SetPendingPromotion(ctx, offeringId)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -20,6 +57,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.StudentList = k.GetAllStudents(ctx)
+	genesis.StudentCount = k.GetStudentCount(ctx)
+	genesis.StudentEnrollmentList = k.GetAllStudentEnrollments(ctx)
+	genesis.StudentEnrollmentCount = k.GetStudentEnrollmentCount(ctx)
+	genesis.StudentAcademicTreeList = k.GetAllStudentAcademicTrees(ctx)
+	genesis.StudentAcademicTreeCount = k.GetStudentAcademicTreeCount(ctx)
+	genesis.SubjectOfferingList = k.GetAllSubjectOfferings(ctx)
+	genesis.SubjectOfferingCount = k.GetSubjectOfferingCount(ctx)
+	genesis.PendingPromotions = k.GetPendingPromotions(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -15,6 +15,19 @@ func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
+		StudentList: []types.Student{
+			{Index: "0", Address: "addr0", EnrollmentIds: []string{"0"}},
+			{Index: "1", Address: "addr1"},
+		},
+		StudentCount: 2,
+		StudentEnrollmentList: []types.StudentEnrollment{
+			{Index: "0", Student: "0", AcademicTreeId: "0"},
+		},
+		StudentEnrollmentCount: 1,
+		StudentAcademicTreeList: []types.StudentAcademicTree{
+			{Index: "0", Student: "0", CompletedTokens: []string{"subject-1"}},
+		},
+		StudentAcademicTreeCount: 1,
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -26,5 +39,11 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.StudentList, got.StudentList)
+	require.Equal(t, genesisState.StudentCount, got.StudentCount)
+	require.ElementsMatch(t, genesisState.StudentEnrollmentList, got.StudentEnrollmentList)
+	require.Equal(t, genesisState.StudentEnrollmentCount, got.StudentEnrollmentCount)
+	require.ElementsMatch(t, genesisState.StudentAcademicTreeList, got.StudentAcademicTreeList)
+	require.Equal(t, genesisState.StudentAcademicTreeCount, got.StudentAcademicTreeCount)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -38,6 +38,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRequestEquivalence{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestCurriculumMigration{},
+		&MsgReviewCurriculumMigration{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateSubjectOffering{},
+		&MsgDropSubjectOffering{},
+		&MsgWithdrawSubjectOffering{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantPrerequisiteWaiver{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -175,6 +175,7 @@ type NFTMintingRequest struct {
 	StudentAddress    string           `json:"student_address"`
 	SubjectId         string           `json:"subject_id"`
 	Grade             uint64           `json:"grade"`
+	GradeValue        string           `json:"grade_value"` // Grade on the institution scale
 	CompletionDate    string           `json:"completion_date"`
 	Semester          string           `json:"semester"`
 	IssuerInstitution string           `json:"issuer_institution"`
@@ -199,6 +200,8 @@ type DegreeValidationRequest struct {
 	TotalCredits  uint64   `json:"total_credits"`
 	Signatures    []string `json:"signatures"`
 	RequestedDate string   `json:"requested_date"`
+	// CompletedSubjects defaults to the completed tokens of the academic tree
+	CompletedSubjects []string `json:"completed_subjects"`
 }
 
 // DegreeValidationResult represents the result of degree validation
@@ -35,6 +35,7 @@ type EquivalenceRequest struct {
 	EquivalenceType      string `protobuf:"bytes,10,opt,name=equivalenceType,proto3" json:"equivalenceType,omitempty"`
 	SimilarityPercentage uint32 `protobuf:"varint,11,opt,name=similarityPercentage,proto3" json:"similarityPercentage,omitempty"`
 	Notes                string `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"`
+	TransferTokenId      string `protobuf:"bytes,13,opt,name=transferTokenId,proto3" json:"transferTokenId,omitempty"`
 }
 
 func (m *EquivalenceRequest) Reset()         { *m = EquivalenceRequest{} }
@@ -154,6 +155,13 @@ func (m *EquivalenceRequest) GetNotes() string {
 	return ""
 }
 
+func (m *EquivalenceRequest) GetTransferTokenId() string {
+	if m != nil {
+		return m.TransferTokenId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EquivalenceRequest)(nil), "academictoken.student.EquivalenceRequest")
 }
@@ -163,28 +171,29 @@ func init() {
 }
 
 var fileDescriptor_0719778b710af1de = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x4e, 0x02, 0x31,
-	0x10, 0x87, 0x59, 0x10, 0x94, 0x22, 0x9a, 0x34, 0x68, 0x7a, 0xd0, 0x0d, 0x31, 0x1e, 0x38, 0x41,
-	0xa2, 0x07, 0xef, 0x44, 0x0f, 0xde, 0x0c, 0x7a, 0xf2, 0x62, 0x4a, 0x3b, 0x21, 0x55, 0xd8, 0x2e,
-	0xed, 0xd4, 0xb8, 0x6f, 0xe1, 0x23, 0xf8, 0x38, 0x1e, 0x39, 0x7a, 0x34, 0xf0, 0x22, 0x66, 0xdb,
-	0x0d, 0x7f, 0x36, 0x1e, 0xe7, 0xeb, 0xf7, 0x9b, 0xcc, 0xec, 0x0e, 0x19, 0x70, 0xc1, 0x25, 0xcc,
-	0x94, 0x40, 0xfd, 0x06, 0xc9, 0xc0, 0xa2, 0x93, 0x90, 0xe0, 0x00, 0xe6, 0x4e, 0xbd, 0xf3, 0x29,
-	0x24, 0x02, 0x5e, 0x0c, 0xcc, 0x1d, 0x58, 0xec, 0xa7, 0x46, 0xa3, 0xa6, 0x27, 0x3b, 0x81, 0x7e,
-	0x11, 0xb8, 0xf8, 0xaa, 0x11, 0x7a, 0xb7, 0x09, 0x8d, 0x42, 0x86, 0x1e, 0x91, 0xaa, 0x92, 0x2c,
-	0xea, 0x46, 0xbd, 0xe6, 0xa8, 0xaa, 0x24, 0x3d, 0x23, 0xcd, 0x22, 0x71, 0x2f, 0x59, 0xd5, 0xe3,
-	0x0d, 0xa0, 0x3d, 0x72, 0x6c, 0xb5, 0x33, 0x02, 0x1e, 0xdd, 0xf8, 0x15, 0x44, 0xee, 0xd4, 0xbc,
-	0x53, 0xc6, 0xb9, 0x89, 0xdc, 0x4c, 0x00, 0x37, 0xe6, 0x5e, 0x30, 0x4b, 0x98, 0x9e, 0x92, 0x86,
-	0x45, 0x8e, 0xce, 0xb2, 0xba, 0x17, 0x8a, 0x8a, 0x76, 0x49, 0xab, 0x58, 0xec, 0x96, 0x23, 0xb0,
-	0x86, 0x7f, 0xdc, 0x46, 0xf4, 0x92, 0xb4, 0x53, 0xa3, 0x05, 0x58, 0x0b, 0xd2, 0x3b, 0xfb, 0xde,
-	0xd9, 0x85, 0x79, 0x7f, 0x03, 0xdc, 0xea, 0x84, 0x1d, 0x84, 0xfe, 0xa1, 0xca, 0x37, 0x15, 0x06,
-	0x38, 0x82, 0x1c, 0x66, 0xac, 0x19, 0x36, 0x5d, 0x83, 0x7c, 0xfe, 0xad, 0x4f, 0xfc, 0x94, 0xa5,
-	0xc0, 0x48, 0x98, 0xbf, 0x84, 0xe9, 0x15, 0xe9, 0x58, 0x35, 0x53, 0x53, 0x6e, 0x14, 0x66, 0x0f,
-	0x60, 0x04, 0x24, 0xc8, 0x27, 0xc0, 0x5a, 0xdd, 0xa8, 0xd7, 0x1e, 0xfd, 0xfb, 0x46, 0x3b, 0xa4,
-	0x9e, 0x68, 0x04, 0xcb, 0x0e, 0x7d, 0xcf, 0x50, 0x0c, 0x6f, 0xbe, 0x97, 0x71, 0xb4, 0x58, 0xc6,
-	0xd1, 0xef, 0x32, 0x8e, 0x3e, 0x57, 0x71, 0x65, 0xb1, 0x8a, 0x2b, 0x3f, 0xab, 0xb8, 0xf2, 0x7c,
-	0xbe, 0x7b, 0x04, 0x1f, 0xeb, 0x33, 0xc0, 0x2c, 0x05, 0x3b, 0x6e, 0xf8, 0x3f, 0x7f, 0xfd, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0x0f, 0xf1, 0xe2, 0xf2, 0x2c, 0x02, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x4f, 0x2a, 0x31,
+	0x10, 0xc7, 0x59, 0x78, 0xf0, 0x1e, 0xe5, 0xf1, 0x5e, 0xd2, 0xa0, 0xe9, 0x41, 0x37, 0xc4, 0x78,
+	0xe0, 0x04, 0x89, 0x1e, 0xbc, 0x13, 0x3d, 0x70, 0x33, 0xc8, 0xc9, 0x8b, 0x29, 0xed, 0x48, 0xaa,
+	0xd0, 0x2e, 0xed, 0xac, 0x71, 0xbf, 0x85, 0x1f, 0xcb, 0x78, 0xe2, 0xe8, 0xd1, 0xc0, 0x17, 0x31,
+	0xdb, 0x6e, 0x80, 0x25, 0x1e, 0xe7, 0xb7, 0xbf, 0xff, 0xa4, 0x33, 0x3b, 0x64, 0xc0, 0x05, 0x97,
+	0xb0, 0x50, 0x02, 0xcd, 0x33, 0xe8, 0x81, 0xc3, 0x54, 0x82, 0xc6, 0x01, 0x2c, 0x53, 0xf5, 0xc2,
+	0xe7, 0xa0, 0x05, 0x3c, 0x58, 0x58, 0xa6, 0xe0, 0xb0, 0x9f, 0x58, 0x83, 0x86, 0x1e, 0x95, 0x02,
+	0xfd, 0x22, 0x70, 0xf6, 0x51, 0x23, 0xf4, 0x66, 0x17, 0x1a, 0x87, 0x0c, 0xfd, 0x47, 0xaa, 0x4a,
+	0xb2, 0xa8, 0x1b, 0xf5, 0x9a, 0xe3, 0xaa, 0x92, 0xf4, 0x84, 0x34, 0x8b, 0xc4, 0x48, 0xb2, 0xaa,
+	0xc7, 0x3b, 0x40, 0x7b, 0xe4, 0xbf, 0x33, 0xa9, 0x15, 0x70, 0x97, 0x4e, 0x9f, 0x40, 0xe4, 0x4e,
+	0xcd, 0x3b, 0x87, 0x38, 0x37, 0x91, 0xdb, 0x19, 0xe0, 0xce, 0xfc, 0x15, 0xcc, 0x03, 0x4c, 0x8f,
+	0x49, 0xc3, 0x21, 0xc7, 0xd4, 0xb1, 0xba, 0x17, 0x8a, 0x8a, 0x76, 0x49, 0xab, 0x18, 0xec, 0x9a,
+	0x23, 0xb0, 0x86, 0xff, 0xb8, 0x8f, 0xe8, 0x39, 0x69, 0x27, 0xd6, 0x08, 0x70, 0x0e, 0xa4, 0x77,
+	0x7e, 0x7b, 0xa7, 0x0c, 0xf3, 0xfe, 0x16, 0xb8, 0x33, 0x9a, 0xfd, 0x09, 0xfd, 0x43, 0x95, 0x4f,
+	0x2a, 0x2c, 0x70, 0x04, 0x39, 0xcc, 0x58, 0x33, 0x4c, 0xba, 0x05, 0xf9, 0xfb, 0xf7, 0x56, 0x3c,
+	0xc9, 0x12, 0x60, 0x24, 0xbc, 0xff, 0x00, 0xd3, 0x0b, 0xd2, 0x71, 0x6a, 0xa1, 0xe6, 0xdc, 0x2a,
+	0xcc, 0x6e, 0xc1, 0x0a, 0xd0, 0xc8, 0x67, 0xc0, 0x5a, 0xdd, 0xa8, 0xd7, 0x1e, 0xff, 0xf8, 0x8d,
+	0x76, 0x48, 0x5d, 0x1b, 0x04, 0xc7, 0xfe, 0xfa, 0x9e, 0xa1, 0xf0, 0x3b, 0xb3, 0x5c, 0xbb, 0x47,
+	0xb0, 0x93, 0xfc, 0xdf, 0x8d, 0x24, 0x6b, 0x17, 0x3b, 0x2b, 0xe3, 0xe1, 0xd5, 0xfb, 0x3a, 0x8e,
+	0x56, 0xeb, 0x38, 0xfa, 0x5a, 0xc7, 0xd1, 0xdb, 0x26, 0xae, 0xac, 0x36, 0x71, 0xe5, 0x73, 0x13,
+	0x57, 0xee, 0x4f, 0xcb, 0xe7, 0xf2, 0xba, 0x3d, 0x18, 0xcc, 0x12, 0x70, 0xd3, 0x86, 0xbf, 0x91,
+	0xcb, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x4f, 0x04, 0x11, 0x56, 0x02, 0x00, 0x00,
 }
 
 func (m *EquivalenceRequest) Marshal() (dAtA []byte, err error) {
@@ -207,6 +216,13 @@ func (m *EquivalenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferTokenId) > 0 {
+		i -= len(m.TransferTokenId)
+		copy(dAtA[i:], m.TransferTokenId)
+		i = encodeVarintEquivalenceRequest(dAtA, i, uint64(len(m.TransferTokenId)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Notes) > 0 {
 		i -= len(m.Notes)
 		copy(dAtA[i:], m.Notes)
@@ -356,6 +372,10 @@ func (m *EquivalenceRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEquivalenceRequest(uint64(l))
 	}
+	l = len(m.TransferTokenId)
+	if l > 0 {
+		n += 1 + l + sovEquivalenceRequest(uint64(l))
+	}
 	return n
 }
 
@@ -765,6 +785,38 @@ func (m *EquivalenceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Notes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivalenceRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEquivalenceRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivalenceRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferTokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEquivalenceRequest(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ var (
 	ErrInvalidInstitution   = errorsmod.Register(ModuleName, 2060, "invalid institution")
 	ErrInvalidCourse        = errorsmod.Register(ModuleName, 2061, "invalid course")
 	ErrInvalidSubject       = errorsmod.Register(ModuleName, 2062, "invalid subject")
+	ErrInvalidGrade         = errorsmod.Register(ModuleName, 2063, "invalid grade")
 	ErrInvalidPacketTimeout = errorsmod.Register(ModuleName, 1500, "invalid packet timeout")
 	ErrInvalidVersion       = errorsmod.Register(ModuleName, 1501, "invalid version")
 	ErrInvalidSigner        = errorsmod.Register(ModuleName, 1502, "invalid signer")
@@ -27,17 +28,31 @@ var (
 	ErrAcademicTreeAlreadyExists = errorsmod.Register(ModuleName, 2021, "academic tree already exists")
 
 	// Curriculum errors
-	ErrCurriculumNotFound = errorsmod.Register(ModuleName, 2030, "curriculum not found")
+	ErrCurriculumNotFound          = errorsmod.Register(ModuleName, 2030, "curriculum not found")
+	ErrCurriculumMigrationNotFound = errorsmod.Register(ModuleName, 2031, "curriculum migration not found")
+	ErrInvalidCurriculumMigration  = errorsmod.Register(ModuleName, 2032, "invalid curriculum migration")
+	ErrCurriculumMigrationPending  = errorsmod.Register(ModuleName, 2033, "curriculum migration already pending")
 
 	// Subject enrollment errors
 	ErrSubjectEnrollmentNotAllowed = errorsmod.Register(ModuleName, 2040, "subject enrollment not allowed")
 	ErrPrerequisitesNotMet         = errorsmod.Register(ModuleName, 2041, "prerequisites not met")
 	ErrSubjectNotAvailable         = errorsmod.Register(ModuleName, 2042, "subject not available")
+	ErrSubjectOfferingNotFound     = errorsmod.Register(ModuleName, 2043, "subject offering not found")
+	ErrInvalidSubjectOffering      = errorsmod.Register(ModuleName, 2044, "invalid subject offering")
+	ErrEnrollmentWindowClosed      = errorsmod.Register(ModuleName, 2045, "enrollment window closed")
+	ErrNotInSubjectOffering        = errorsmod.Register(ModuleName, 2046, "student not in subject offering")
+	ErrPrerequisiteCheckFailed     = errorsmod.Register(ModuleName, 2047, "prerequisite check failed")
+	ErrInvalidPrerequisiteWaiver   = errorsmod.Register(ModuleName, 2048, "invalid prerequisite waiver")
 
 	// Authorization errors
 	ErrNotAuthorized           = errorsmod.Register(ModuleName, 2050, "not authorized")
 	ErrInsufficientPermissions = errorsmod.Register(ModuleName, 2051, "insufficient permissions")
 
+	// Integration errors
+	ErrIntegrationDisabled    = errorsmod.Register(ModuleName, 2070, "integration disabled")
+	ErrContractNotConfigured  = errorsmod.Register(ModuleName, 2071, "contract not configured")
+	ErrInvalidIntegrationMode = errorsmod.Register(ModuleName, 2072, "invalid integration mode")
+
 	ErrInvalidBasicValidation  = errorsmod.Register(ModuleName, 1101, "invalid basic validation")
 	ErrStudyPlanNotFound       = errorsmod.Register(ModuleName, 1102, "study plan not found")
 	ErrStudyPlanAlreadyExists  = errorsmod.Register(ModuleName, 1103, "study plan already exists")
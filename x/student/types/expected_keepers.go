@@ -2,7 +2,9 @@ package types
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -39,6 +41,13 @@ type WasmQuerier interface {
 type InstitutionKeeper interface {
 	GetInstitution(ctx sdk.Context, index string) (Institution, bool)
 	IsInstitutionAuthorized(ctx sdk.Context, institutionIndex string) bool
+	CanIssueGrade(ctx sdk.Context, institutionIndex string, address string, courseId string, subjectId string) bool
+	CanManageEnrollment(ctx sdk.Context, institutionIndex string, address string, courseId string) bool
+	CanGrantPrerequisiteWaiver(ctx sdk.Context, institutionIndex string, address string, courseId string) bool
+	NormalizeGrade(ctx sdk.Context, institutionIndex string, grade string) (NormalizedGrade, error)
+	ValidateAcademicTerm(ctx sdk.Context, institutionIndex string, termId string) error
+	ValidateGradeSubmission(ctx sdk.Context, institutionIndex string, termId string) error
+	GetAcademicTerm(ctx sdk.Context, institutionIndex string, termId string) (AcademicTerm, bool)
 }
 
 // CourseKeeper defines the methods from the Course module that Student needs
@@ -51,6 +60,10 @@ type CourseKeeper interface {
 type CurriculumKeeper interface {
 	GetCurriculumTree(ctx context.Context, index string) (CurriculumTree, bool)
 	GetCurriculumTreesByCourse(ctx context.Context, courseId string) []CurriculumTree
+	// GetLatestPublishedCurriculum returns the most recently published version of a course
+	GetLatestPublishedCurriculum(ctx context.Context, courseId string) (CurriculumTree, bool)
+	// PublishedAfter reports whether curriculum a was published after curriculum b
+	PublishedAfter(a, b CurriculumTree) bool
 }
 
 // SubjectKeeper defines the methods from the Subject module that Student needs
@@ -58,6 +71,7 @@ type SubjectKeeper interface {
 	GetSubject(ctx sdk.Context, subjectId string) (SubjectContent, bool)
 	SubjectExists(ctx sdk.Context, index string) bool                                                                                           // ✅ Existe no Subject keeper
 	CheckPrerequisitesViaContract(ctx sdk.Context, studentID string, subjectID string) (bool, []string, error)                                  // ✅ Existe no Subject keeper
+	EvaluatePrerequisites(ctx sdk.Context, studentId string, subjectId string) (bool, []string, error)                                          // Native prerequisite evaluation
 	CheckEquivalenceViaContract(ctx sdk.Context, sourceSubjectID string, targetSubjectID string, forceRecalculate bool) (uint64, string, error) // ✅ Existe no Subject keeper
 }
 
@@ -71,6 +85,22 @@ type TokenDefKeeper interface {
 type AcademicNFTKeeper interface {
 	GetSubjectTokenInstance(ctx sdk.Context, tokenInstanceId string) (SubjectTokenInstance, bool)
 	GetStudentTokenInstances(ctx sdk.Context, studentAddress string) ([]SubjectTokenInstance, error)
+	MintSubjectToken(ctx sdk.Context, tokenDefId string, student string, completionDate string, grade string, issuerInstitution string, semester string, professorSignature string) (string, error)
+	MintTransferCreditToken(ctx sdk.Context, tokenDefId string, studentAddress string, issuerInstitution string, equivalenceId string) (string, error)
+	GetStudentTokenForTokenDef(ctx sdk.Context, studentAddress string, tokenDefId string) (string, bool)
+}
+
+// EquivalenceKeeper defines the methods from the Equivalence module that Student needs
+type EquivalenceKeeper interface {
+	GetSubjectEquivalence(ctx sdk.Context, sourceSubjectId string, targetSubjectId string) (SubjectEquivalence, bool)
+}
+
+// DegreeKeeper defines the methods from the Degree module that Student needs
+type DegreeKeeper interface {
+	// EvaluateGraduation evaluates the academic record of a student against
+	// the graduation requirements of the curriculum version the student
+	// enrolled under, or of curriculumId for students without a course
+	EvaluateGraduation(ctx sdk.Context, studentId string, curriculumId string) (GraduationEvaluation, error)
 }
 
 // AcademicNFTMsgServer defines message server methods that Student might need to call
@@ -87,6 +117,31 @@ type Institution struct {
 	IsAuthorized bool
 }
 
+// AcademicTerm holds the dates of a term of an institution's academic calendar
+type AcademicTerm struct {
+	Institution     string
+	TermId          string
+	StartDate       time.Time
+	EndDate         time.Time
+	EnrollmentStart time.Time
+	EnrollmentEnd   time.Time
+}
+
+// IsEnrollmentOpenAt reports whether t falls within the enrollment window
+func (term AcademicTerm) IsEnrollmentOpenAt(t time.Time) bool {
+	return !t.Before(term.EnrollmentStart) && !t.After(term.EnrollmentEnd)
+}
+
+// NormalizedGrade is a grade validated against the institution grading scale
+type NormalizedGrade struct {
+	Value           string
+	Score           math.LegacyDec // 0-100
+	Letter          string
+	GpaPoints       math.LegacyDec
+	Passing         bool
+	CountsTowardGpa bool
+}
+
 // Types needed from the Course module
 type Course struct {
 	Index        string
@@ -108,6 +163,8 @@ type CurriculumTree struct {
 	ElectiveSubjects       []string
 	TotalWorkloadHours     uint64
 	GraduationRequirements GraduationRequirements
+	Published              bool   // published versions are immutable and open to enrollment
+	PublishedAt            string // RFC3339, empty for trees that predate versioning
 }
 
 type GraduationRequirements struct {
@@ -119,11 +176,39 @@ type GraduationRequirements struct {
 	MaximumTimeYears        float64
 }
 
+// Types needed from the Degree module
+type GraduationEvaluation struct {
+	Passed          bool
+	CurriculumId    string
+	RequirementsMet []string
+	Missing         []MissingGraduationRequirement
+}
+
+// MissingGraduationRequirement is a graduation requirement a student does not
+// meet, with the amount required and completed so far
+type MissingGraduationRequirement struct {
+	Requirement string
+	Description string
+	Required    string
+	Completed   string
+	SubjectIds  []string
+}
+
+// Types needed from the Equivalence module
+type SubjectEquivalence struct {
+	Index              string
+	SourceSubjectId    string
+	TargetSubjectId    string
+	Status             string
+	EquivalencePercent string
+}
+
 // Types needed from the Subject module
 type SubjectContent struct {
 	Index         string
 	SubjectId     string
 	Institution   string
+	CourseId      string
 	Title         string
 	Code          string
 	WorkloadHours uint64
@@ -163,6 +248,7 @@ type SubjectTokenInstance struct {
 	ProfessorSignature string
 	MintedAt           string
 	IsValid            bool
+	TransferCredit     bool
 }
 
 // Message types needed for AcademicNFT operations
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,6 +13,10 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		StudentList:             []Student{},
+		StudentEnrollmentList:   []StudentEnrollment{},
+		StudentAcademicTreeList: []StudentAcademicTree{},
+		SubjectOfferingList:     []SubjectOffering{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -16,7 +25,104 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated index in student
+	studentIndexMap := make(map[string]Student)
+	for _, elem := range gs.StudentList {
+		if err := validateAppendedIndex(elem.Index, gs.StudentCount); err != nil {
+			return fmt.Errorf("invalid student index %q: %w", elem.Index, err)
+		}
+		if _, ok := studentIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for student: %s", elem.Index)
+		}
+		studentIndexMap[elem.Index] = elem
+	}
+
+	// Check for duplicated index in studentAcademicTree
+	treeIndexMap := make(map[string]StudentAcademicTree)
+	for _, elem := range gs.StudentAcademicTreeList {
+		if err := validateAppendedIndex(elem.Index, gs.StudentAcademicTreeCount); err != nil {
+			return fmt.Errorf("invalid academic tree index %q: %w", elem.Index, err)
+		}
+		if _, ok := treeIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for academic tree: %s", elem.Index)
+		}
+		if _, ok := studentIndexMap[elem.Student]; !ok {
+			return fmt.Errorf("academic tree %s references unknown student %s", elem.Index, elem.Student)
+		}
+		treeIndexMap[elem.Index] = elem
+	}
+
+	// Check for duplicated index in studentEnrollment
+	enrollmentIndexMap := make(map[string]StudentEnrollment)
+	for _, elem := range gs.StudentEnrollmentList {
+		if err := validateAppendedIndex(elem.Index, gs.StudentEnrollmentCount); err != nil {
+			return fmt.Errorf("invalid enrollment index %q: %w", elem.Index, err)
+		}
+		if _, ok := enrollmentIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for enrollment: %s", elem.Index)
+		}
+		if _, ok := studentIndexMap[elem.Student]; !ok {
+			return fmt.Errorf("enrollment %s references unknown student %s", elem.Index, elem.Student)
+		}
+		if elem.AcademicTreeId != "" {
+			tree, ok := treeIndexMap[elem.AcademicTreeId]
+			if !ok {
+				return fmt.Errorf("enrollment %s references unknown academic tree %s", elem.Index, elem.AcademicTreeId)
+			}
+			if tree.Student != elem.Student {
+				return fmt.Errorf("enrollment %s and academic tree %s belong to different students", elem.Index, elem.AcademicTreeId)
+			}
+		}
+		enrollmentIndexMap[elem.Index] = elem
+	}
+
+	// Check that every enrollment listed on a student exists and belongs to it
+	for _, student := range gs.StudentList {
+		for _, enrollmentId := range student.EnrollmentIds {
+			enrollment, ok := enrollmentIndexMap[enrollmentId]
+			if !ok {
+				return fmt.Errorf("student %s references unknown enrollment %s", student.Index, enrollmentId)
+			}
+			if enrollment.Student != student.Index {
+				return fmt.Errorf("enrollment %s listed on student %s belongs to student %s", enrollmentId, student.Index, enrollment.Student)
+			}
+		}
+	}
+
+	// Check for invalid or duplicated subject offerings
+	offeringIndexMap := make(map[string]SubjectOffering)
+	for _, elem := range gs.SubjectOfferingList {
+		if err := validateAppendedIndex(elem.Index, gs.SubjectOfferingCount); err != nil {
+			return fmt.Errorf("invalid subject offering index %q: %w", elem.Index, err)
+		}
+		if _, ok := offeringIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for subject offering: %s", elem.Index)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid subject offering %s: %w", elem.Index, err)
+		}
+		offeringIndexMap[elem.Index] = elem
+	}
+	for _, offeringId := range gs.PendingPromotions {
+		if _, ok := offeringIndexMap[offeringId]; !ok {
+			return fmt.Errorf("pending promotion references unknown subject offering %s", offeringId)
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// validateAppendedIndex checks that an index produced by the Append* helpers
+// is numeric and lower than the stored counter.
+func validateAppendedIndex(index string, count uint64) error {
+	id, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return fmt.Errorf("index must be a decimal number")
+	}
+	if id >= count {
+		return fmt.Errorf("index should be lower than the count %d", count)
+	}
+	return nil
+}
@@ -27,7 +27,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the student module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params                   Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	StudentList              []Student             `protobuf:"bytes,2,rep,name=studentList,proto3" json:"studentList"`
+	StudentCount             uint64                `protobuf:"varint,3,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	StudentEnrollmentList    []StudentEnrollment   `protobuf:"bytes,4,rep,name=studentEnrollmentList,proto3" json:"studentEnrollmentList"`
+	StudentEnrollmentCount   uint64                `protobuf:"varint,5,opt,name=studentEnrollmentCount,proto3" json:"studentEnrollmentCount,omitempty"`
+	StudentAcademicTreeList  []StudentAcademicTree `protobuf:"bytes,6,rep,name=studentAcademicTreeList,proto3" json:"studentAcademicTreeList"`
+	StudentAcademicTreeCount uint64                `protobuf:"varint,7,opt,name=studentAcademicTreeCount,proto3" json:"studentAcademicTreeCount,omitempty"`
+	SubjectOfferingList      []SubjectOffering     `protobuf:"bytes,8,rep,name=subjectOfferingList,proto3" json:"subjectOfferingList"`
+	SubjectOfferingCount     uint64                `protobuf:"varint,9,opt,name=subjectOfferingCount,proto3" json:"subjectOfferingCount,omitempty"`
+	PendingPromotions        []string              `protobuf:"bytes,10,rep,name=pendingPromotions,proto3" json:"pendingPromotions,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +79,69 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetStudentList() []Student {
+	if m != nil {
+		return m.StudentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStudentCount() uint64 {
+	if m != nil {
+		return m.StudentCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStudentEnrollmentList() []StudentEnrollment {
+	if m != nil {
+		return m.StudentEnrollmentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStudentEnrollmentCount() uint64 {
+	if m != nil {
+		return m.StudentEnrollmentCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStudentAcademicTreeList() []StudentAcademicTree {
+	if m != nil {
+		return m.StudentAcademicTreeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStudentAcademicTreeCount() uint64 {
+	if m != nil {
+		return m.StudentAcademicTreeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSubjectOfferingList() []SubjectOffering {
+	if m != nil {
+		return m.SubjectOfferingList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubjectOfferingCount() uint64 {
+	if m != nil {
+		return m.SubjectOfferingCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingPromotions() []string {
+	if m != nil {
+		return m.PendingPromotions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "academictoken.student.GenesisState")
 }
@@ -79,20 +151,35 @@ func init() {
 }
 
 var fileDescriptor_f145bd81d183a2f0 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x4c, 0x4e, 0x4c,
-	0x49, 0xcd, 0xcd, 0x4c, 0x2e, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2f, 0x2e, 0x29, 0x4d, 0x49, 0xcd,
-	0x2b, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x45, 0x51, 0xa4, 0x07, 0x55, 0x24, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26,
-	0x21, 0x2a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0xaa, 0x84,
-	0xdd, 0x92, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x1d, 0x4a, 0x01, 0x5c, 0x3c, 0xee, 0x10, 0x4b,
-	0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x1c, 0xb8, 0xd8, 0x20, 0xf2, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
-	0xdc, 0x46, 0xb2, 0x7a, 0x58, 0x1d, 0xa1, 0x17, 0x00, 0x56, 0xe4, 0xc4, 0x79, 0xe2, 0x9e, 0x3c,
-	0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0xfa, 0x9c, 0xcc, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x16, 0xd5, 0x3d, 0x15, 0x70, 0x17, 0x95, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0x5d, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xc0, 0x0b, 0x4c,
-	0x1c, 0x01, 0x00, 0x00,
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0xc6, 0x5b, 0xf9, 0xa3, 0x0c, 0x6c, 0x18, 0x41, 0x1b, 0x12, 0x6a, 0x83, 0x89, 0x69, 0x08,
+	0x69, 0x23, 0x26, 0x9a, 0xb8, 0x52, 0x8c, 0xba, 0x31, 0x91, 0x14, 0x57, 0x2e, 0x24, 0x85, 0x0e,
+	0x4d, 0x91, 0xce, 0x34, 0xed, 0x90, 0xe8, 0x5b, 0xf8, 0x18, 0x2e, 0x7d, 0x08, 0x17, 0x2c, 0x59,
+	0xba, 0x32, 0x37, 0xb0, 0xb8, 0xaf, 0x71, 0xc3, 0xcc, 0xb9, 0x40, 0x2f, 0x6d, 0x37, 0x9d, 0x3f,
+	0xdf, 0xf7, 0x9d, 0xf3, 0xeb, 0x64, 0x06, 0x3d, 0x75, 0xe7, 0xae, 0x47, 0xc2, 0x60, 0xce, 0xd9,
+	0x77, 0x42, 0xed, 0x84, 0xaf, 0x3d, 0x42, 0xb9, 0xed, 0x13, 0x4a, 0x92, 0x20, 0xb1, 0xa2, 0x98,
+	0x71, 0x86, 0xdb, 0x29, 0x93, 0x05, 0xa6, 0x4e, 0xd3, 0x0d, 0x03, 0xca, 0x6c, 0xf1, 0x95, 0xce,
+	0x4e, 0xcb, 0x67, 0x3e, 0x13, 0x53, 0xfb, 0x30, 0x83, 0xdd, 0x5e, 0x76, 0x93, 0xc8, 0x8d, 0xdd,
+	0x10, 0x7a, 0x74, 0x72, 0x40, 0x60, 0x04, 0x93, 0x55, 0x68, 0x9a, 0x12, 0x1a, 0xb3, 0xd5, 0x2a,
+	0x3c, 0xf9, 0x9f, 0x17, 0xfb, 0x6f, 0xd5, 0x29, 0x8f, 0x09, 0x81, 0xc8, 0x20, 0x27, 0xb2, 0x9e,
+	0x2d, 0xc9, 0x9c, 0x4f, 0xd9, 0x62, 0x41, 0xe2, 0x80, 0xfa, 0xd2, 0xdd, 0xfb, 0x5b, 0x41, 0x8d,
+	0x8f, 0xf2, 0xac, 0x26, 0xdc, 0xe5, 0x04, 0xbf, 0x41, 0x55, 0xf9, 0x5b, 0x9a, 0x6a, 0xa8, 0x66,
+	0x7d, 0xd8, 0xb5, 0x32, 0xcf, 0xce, 0x1a, 0x0b, 0xd3, 0xa8, 0xb6, 0xf9, 0xff, 0x44, 0xf9, 0x7d,
+	0xfd, 0xa7, 0xaf, 0x3a, 0x90, 0xc3, 0x1f, 0x50, 0x1d, 0x4c, 0x9f, 0x82, 0x84, 0x6b, 0xf7, 0x8c,
+	0x92, 0x59, 0x1f, 0xea, 0x39, 0x65, 0x26, 0x72, 0x1c, 0x95, 0x0f, 0x75, 0x9c, 0xf3, 0x20, 0xee,
+	0xa1, 0x06, 0x2c, 0xdf, 0xb1, 0x35, 0xe5, 0x5a, 0xc9, 0x50, 0xcd, 0xb2, 0x93, 0xda, 0xc3, 0x1e,
+	0x6a, 0xc3, 0xfa, 0xfd, 0xf1, 0xe8, 0x44, 0xd7, 0xb2, 0xe8, 0x6a, 0x16, 0x77, 0x3d, 0x65, 0xa0,
+	0x7f, 0x76, 0x31, 0xfc, 0x12, 0x3d, 0xba, 0x10, 0x24, 0x53, 0x45, 0x30, 0xe5, 0xa8, 0x78, 0x89,
+	0x1e, 0x83, 0xf2, 0x16, 0x30, 0xbe, 0xc4, 0x84, 0x08, 0xbe, 0xaa, 0xe0, 0xeb, 0x17, 0xf3, 0x9d,
+	0xa7, 0x80, 0x30, 0xaf, 0x20, 0x7e, 0x8d, 0xb4, 0x0c, 0x49, 0x52, 0xde, 0x17, 0x94, 0xb9, 0x3a,
+	0xfe, 0x86, 0x1e, 0xc2, 0xf5, 0xf8, 0x0c, 0xb7, 0x43, 0x30, 0x3e, 0x10, 0x8c, 0xcf, 0xf2, 0x18,
+	0xd3, 0x09, 0xe0, 0xcb, 0x2a, 0x84, 0x87, 0xa8, 0x75, 0x67, 0x5b, 0x72, 0xd5, 0x04, 0x57, 0xa6,
+	0x86, 0x07, 0xa8, 0x19, 0x11, 0xea, 0x05, 0xd4, 0x1f, 0xc7, 0x2c, 0x64, 0x3c, 0x60, 0x34, 0xd1,
+	0x90, 0x51, 0x32, 0x6b, 0xce, 0xa5, 0x30, 0x7a, 0xb5, 0xd9, 0xe9, 0xea, 0x76, 0xa7, 0xab, 0x57,
+	0x3b, 0x5d, 0xfd, 0xb5, 0xd7, 0x95, 0xed, 0x5e, 0x57, 0xfe, 0xed, 0x75, 0xe5, 0x6b, 0x37, 0xfd,
+	0x1c, 0x7e, 0x1c, 0x1f, 0x04, 0xff, 0x19, 0x91, 0x64, 0x56, 0x15, 0xcf, 0xe0, 0xc5, 0x4d, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x37, 0x7e, 0x0d, 0x8e, 0x47, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +202,91 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingPromotions) > 0 {
+		for iNdEx := len(m.PendingPromotions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingPromotions[iNdEx])
+			copy(dAtA[i:], m.PendingPromotions[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingPromotions[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.SubjectOfferingCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubjectOfferingCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SubjectOfferingList) > 0 {
+		for iNdEx := len(m.SubjectOfferingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubjectOfferingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.StudentAcademicTreeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StudentAcademicTreeCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.StudentAcademicTreeList) > 0 {
+		for iNdEx := len(m.StudentAcademicTreeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StudentAcademicTreeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.StudentEnrollmentCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StudentEnrollmentCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StudentEnrollmentList) > 0 {
+		for iNdEx := len(m.StudentEnrollmentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StudentEnrollmentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StudentCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StudentCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StudentList) > 0 {
+		for iNdEx := len(m.StudentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StudentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +319,48 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.StudentList) > 0 {
+		for _, e := range m.StudentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StudentCount != 0 {
+		n += 1 + sovGenesis(uint64(m.StudentCount))
+	}
+	if len(m.StudentEnrollmentList) > 0 {
+		for _, e := range m.StudentEnrollmentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StudentEnrollmentCount != 0 {
+		n += 1 + sovGenesis(uint64(m.StudentEnrollmentCount))
+	}
+	if len(m.StudentAcademicTreeList) > 0 {
+		for _, e := range m.StudentAcademicTreeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StudentAcademicTreeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.StudentAcademicTreeCount))
+	}
+	if len(m.SubjectOfferingList) > 0 {
+		for _, e := range m.SubjectOfferingList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SubjectOfferingCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SubjectOfferingCount))
+	}
+	if len(m.PendingPromotions) > 0 {
+		for _, s := range m.PendingPromotions {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +432,250 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentList = append(m.StudentList, Student{})
+			if err := m.StudentList[len(m.StudentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentCount", wireType)
+			}
+			m.StudentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StudentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentEnrollmentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentEnrollmentList = append(m.StudentEnrollmentList, StudentEnrollment{})
+			if err := m.StudentEnrollmentList[len(m.StudentEnrollmentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentEnrollmentCount", wireType)
+			}
+			m.StudentEnrollmentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StudentEnrollmentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentAcademicTreeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentAcademicTreeList = append(m.StudentAcademicTreeList, StudentAcademicTree{})
+			if err := m.StudentAcademicTreeList[len(m.StudentAcademicTreeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentAcademicTreeCount", wireType)
+			}
+			m.StudentAcademicTreeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StudentAcademicTreeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectOfferingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectOfferingList = append(m.SubjectOfferingList, SubjectOffering{})
+			if err := m.SubjectOfferingList[len(m.SubjectOfferingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectOfferingCount", wireType)
+			}
+			m.SubjectOfferingCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubjectOfferingCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPromotions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPromotions = append(m.PendingPromotions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -20,13 +20,90 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				StudentList: []types.Student{
+					{Index: "0", EnrollmentIds: []string{"0"}},
+					{Index: "1"},
+				},
+				StudentCount: 2,
+				StudentEnrollmentList: []types.StudentEnrollment{
+					{Index: "0", Student: "0", AcademicTreeId: "0"},
+				},
+				StudentEnrollmentCount: 1,
+				StudentAcademicTreeList: []types.StudentAcademicTree{
+					{Index: "0", Student: "0"},
+					{Index: "1", Student: "1"},
+				},
+				StudentAcademicTreeCount: 2,
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated student",
+			genState: &types.GenesisState{
+				StudentList:  []types.Student{{Index: "0"}, {Index: "0"}},
+				StudentCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid student count",
+			genState: &types.GenesisState{
+				StudentList:  []types.Student{{Index: "1"}},
+				StudentCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "enrollment references unknown student",
+			genState: &types.GenesisState{
+				StudentList:            []types.Student{{Index: "0"}},
+				StudentCount:           1,
+				StudentEnrollmentList:  []types.StudentEnrollment{{Index: "0", Student: "1"}},
+				StudentEnrollmentCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "enrollment references unknown academic tree",
+			genState: &types.GenesisState{
+				StudentList:            []types.Student{{Index: "0"}},
+				StudentCount:           1,
+				StudentEnrollmentList:  []types.StudentEnrollment{{Index: "0", Student: "0", AcademicTreeId: "3"}},
+				StudentEnrollmentCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "enrollment and academic tree of different students",
+			genState: &types.GenesisState{
+				StudentList:              []types.Student{{Index: "0"}, {Index: "1"}},
+				StudentCount:             2,
+				StudentEnrollmentList:    []types.StudentEnrollment{{Index: "0", Student: "0", AcademicTreeId: "0"}},
+				StudentEnrollmentCount:   1,
+				StudentAcademicTreeList:  []types.StudentAcademicTree{{Index: "0", Student: "1"}},
+				StudentAcademicTreeCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "student references unknown enrollment",
+			genState: &types.GenesisState{
+				StudentList:  []types.Student{{Index: "0", EnrollmentIds: []string{"0"}}},
+				StudentCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "academic tree references unknown student",
+			genState: &types.GenesisState{
+				StudentAcademicTreeList:  []types.StudentAcademicTree{{Index: "0", Student: "0"}},
+				StudentAcademicTreeCount: 1,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
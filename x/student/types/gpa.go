@@ -0,0 +1,72 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// RecordGrade adds a completed subject grade to the GPA accumulators of the
+// academic tree and refreshes CoefficientGpa. Grades that carry no points,
+// such as pass/fail grades, leave the GPA untouched.
+func (t *StudentAcademicTree) RecordGrade(grade NormalizedGrade, credits uint64) error {
+	if !grade.CountsTowardGpa || credits == 0 {
+		return nil
+	}
+
+	total, err := t.gradePointsTotal()
+	if err != nil {
+		return err
+	}
+
+	total = total.Add(grade.GpaPoints.MulInt(math.NewIntFromUint64(credits)))
+	t.GpaCredits += credits
+	return t.setGpa(total)
+}
+
+// RemoveGrade reverts a grade previously added with RecordGrade, as done when
+// the grade is amended or its token revoked.
+func (t *StudentAcademicTree) RemoveGrade(grade NormalizedGrade, credits uint64) error {
+	if !grade.CountsTowardGpa || credits == 0 {
+		return nil
+	}
+	if credits > t.GpaCredits {
+		return ErrInvalidGrade.Wrapf("cannot remove %d credits from the %d GPA credits of academic tree %s", credits, t.GpaCredits, t.Index)
+	}
+
+	total, err := t.gradePointsTotal()
+	if err != nil {
+		return err
+	}
+
+	total = total.Sub(grade.GpaPoints.MulInt(math.NewIntFromUint64(credits)))
+	if total.IsNegative() {
+		total = math.LegacyZeroDec()
+	}
+	t.GpaCredits -= credits
+	return t.setGpa(total)
+}
+
+func (t *StudentAcademicTree) gradePointsTotal() (math.LegacyDec, error) {
+	if t.GradePointsTotal == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	total, err := math.LegacyNewDecFromStr(t.GradePointsTotal)
+	if err != nil {
+		return math.LegacyDec{}, ErrInvalidGrade.Wrapf("invalid grade points total %q on academic tree %s", t.GradePointsTotal, t.Index)
+	}
+	return total, nil
+}
+
+func (t *StudentAcademicTree) setGpa(total math.LegacyDec) error {
+	t.GradePointsTotal = total.String()
+	if t.GpaCredits == 0 {
+		t.CoefficientGpa = 0
+		return nil
+	}
+
+	gpa, err := total.QuoInt(math.NewIntFromUint64(t.GpaCredits)).Float64()
+	if err != nil {
+		return err
+	}
+	t.CoefficientGpa = float32(gpa)
+	return nil
+}
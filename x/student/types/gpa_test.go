@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStudentAcademicTree_RecordGrade(t *testing.T) {
+	tree := StudentAcademicTree{Index: "tree-1"}
+
+	// 4.0 points over 4 credits
+	require.NoError(t, tree.RecordGrade(NormalizedGrade{GpaPoints: math.LegacyMustNewDecFromStr("4.0"), CountsTowardGpa: true}, 4))
+	require.Equal(t, uint64(4), tree.GpaCredits)
+	require.InDelta(t, 4.0, tree.CoefficientGpa, 0.0001)
+
+	// 2.5 points over 2 credits: (16 + 5) / 6
+	require.NoError(t, tree.RecordGrade(NormalizedGrade{GpaPoints: math.LegacyMustNewDecFromStr("2.5"), CountsTowardGpa: true}, 2))
+	require.Equal(t, uint64(6), tree.GpaCredits)
+	require.Equal(t, math.LegacyMustNewDecFromStr("21").String(), tree.GradePointsTotal)
+	require.InDelta(t, 3.5, tree.CoefficientGpa, 0.0001)
+
+	// Pass/fail grades and zero-credit subjects leave the GPA untouched
+	require.NoError(t, tree.RecordGrade(NormalizedGrade{GpaPoints: math.LegacyZeroDec(), Passing: true}, 4))
+	require.NoError(t, tree.RecordGrade(NormalizedGrade{GpaPoints: math.LegacyZeroDec(), CountsTowardGpa: true}, 0))
+	require.Equal(t, uint64(6), tree.GpaCredits)
+	require.InDelta(t, 3.5, tree.CoefficientGpa, 0.0001)
+
+	// A corrupted accumulator is reported
+	tree.GradePointsTotal = "invalid"
+	require.ErrorIs(t, tree.RecordGrade(NormalizedGrade{GpaPoints: math.LegacyOneDec(), CountsTowardGpa: true}, 1), ErrInvalidGrade)
+}
+
+func TestStudentAcademicTree_RemoveGrade(t *testing.T) {
+	tree := StudentAcademicTree{Index: "tree-1"}
+	a := NormalizedGrade{GpaPoints: math.LegacyMustNewDecFromStr("4.0"), CountsTowardGpa: true}
+	b := NormalizedGrade{GpaPoints: math.LegacyMustNewDecFromStr("2.0"), CountsTowardGpa: true}
+	require.NoError(t, tree.RecordGrade(a, 4))
+	require.NoError(t, tree.RecordGrade(b, 4))
+	require.InDelta(t, 3.0, tree.CoefficientGpa, 0.0001)
+
+	require.NoError(t, tree.RemoveGrade(b, 4))
+	require.Equal(t, uint64(4), tree.GpaCredits)
+	require.InDelta(t, 4.0, tree.CoefficientGpa, 0.0001)
+
+	// Removing more credits than recorded is rejected
+	require.ErrorIs(t, tree.RemoveGrade(a, 8), ErrInvalidGrade)
+
+	require.NoError(t, tree.RemoveGrade(a, 4))
+	require.Zero(t, tree.GpaCredits)
+	require.Zero(t, tree.CoefficientGpa)
+}
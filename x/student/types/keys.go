@@ -34,6 +34,9 @@ const (
 const (
 	StudentAcademicTreeKeyPrefix  = "StudentAcademicTree/value/"
 	StudentAcademicTreeCounterKey = "StudentAcademicTree/count/"
+	// PendingEquivalenceKeyPrefix indexes the academic trees holding a pending
+	// equivalence request by source and target subject
+	PendingEquivalenceKeyPrefix = "StudentAcademicTree/equivalence/"
 )
 
 // SubjectEnrollment store keys
@@ -42,18 +45,42 @@ const (
 	SubjectEnrollmentCounterKey = "SubjectEnrollment/count/"
 )
 
+// SubjectOffering store keys
+const (
+	SubjectOfferingKeyPrefix  = "SubjectOffering/value/"
+	SubjectOfferingCounterKey = "SubjectOffering/count/"
+	// PendingPromotionKeyPrefix indexes the offerings whose waitlist must be
+	// promoted at the end of the block
+	PendingPromotionKeyPrefix = "SubjectOffering/promote/"
+)
+
 // KeyPrefix returns the key prefix for the given string
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// PendingEquivalencePrefix returns the store prefix holding the indexes of the
+// academic trees with a pending request for the equivalence of source to target
+func PendingEquivalencePrefix(sourceSubjectId, targetSubjectId string) []byte {
+	return KeyPrefix(PendingEquivalenceKeyPrefix + sourceSubjectId + "/" + targetSubjectId + "/")
+}
+
 // Event types
 const (
-	EventTypeRegisterStudent          = "register_student"
-	EventTypeCreateEnrollment         = "create_enrollment"
-	EventTypeUpdateEnrollmentStatus   = "update_enrollment_status"
-	EventTypeRequestSubjectEnrollment = "request_subject_enrollment"
-	EventTypeUpdateAcademicTree       = "update_academic_tree"
+	EventTypeRegisterStudent            = "register_student"
+	EventTypeCreateEnrollment           = "create_enrollment"
+	EventTypeUpdateEnrollmentStatus     = "update_enrollment_status"
+	EventTypeRequestSubjectEnrollment   = "request_subject_enrollment"
+	EventTypeUpdateAcademicTree         = "update_academic_tree"
+	EventTypeRequestCurriculumMigration = "request_curriculum_migration"
+	EventTypeReviewCurriculumMigration  = "review_curriculum_migration"
+	EventTypeCreateSubjectOffering      = "create_subject_offering"
+	EventTypeSubjectOfferingWaitlisted  = "subject_offering_waitlisted"
+	EventTypeWaitlistPromoted           = "waitlist_promoted"
+	EventTypeDropSubjectOffering        = "drop_subject_offering"
+	EventTypeWithdrawSubjectOffering    = "withdraw_subject_offering"
+	EventTypeGrantPrerequisiteWaiver    = "grant_prerequisite_waiver"
+	EventTypeEquivalenceCredited        = "equivalence_credited"
 )
 
 // Event attribute keys
@@ -78,4 +105,18 @@ const (
 	AttributeKeyCompletedTokens  = "completed_tokens"
 	AttributeKeyInProgressTokens = "in_progress_tokens"
 	AttributeKeyAvailableTokens  = "available_tokens"
+
+	AttributeKeyMigrationId       = "migration_id"
+	AttributeKeyFromVersion       = "from_version"
+	AttributeKeyToVersion         = "to_version"
+	AttributeKeyCurriculumVersion = "curriculum_version"
+
+	AttributeKeyOfferingId       = "offering_id"
+	AttributeKeyTerm             = "term"
+	AttributeKeySection          = "section"
+	AttributeKeyCapacity         = "capacity"
+	AttributeKeyWaitlistPosition = "waitlist_position"
+	AttributeKeyExpiresAt        = "expires_at"
+
+	AttributeKeyTransferTokenId = "transfer_token_id"
 )
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"strconv"
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -51,6 +54,18 @@ func (msg *MsgCompleteSubject) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+// GradeOnScale returns the grade to validate against the institution grading
+// scale: gradeValue when set, otherwise the numeric grade
+func (msg *MsgCompleteSubject) GradeOnScale() string {
+	if msg.GradeValue != "" {
+		return msg.GradeValue
+	}
+	if msg.Grade == 0 {
+		return ""
+	}
+	return strconv.FormatUint(uint64(msg.Grade), 10)
+}
+
 func (msg *MsgCompleteSubject) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
@@ -69,6 +84,10 @@ func (msg *MsgCompleteSubject) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidBasicValidation, "grade cannot exceed 100.00")
 	}
 
+	if strings.TrimSpace(msg.GradeOnScale()) == "" {
+		return errorsmod.Wrap(ErrInvalidBasicValidation, "grade cannot be empty")
+	}
+
 	if msg.CompletionDate == "" {
 		return errorsmod.Wrap(ErrInvalidBasicValidation, "completion date cannot be empty")
 	}
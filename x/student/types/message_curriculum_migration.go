@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Curriculum migration statuses
+const (
+	CurriculumMigrationStatusPending  = "pending"
+	CurriculumMigrationStatusApproved = "approved"
+	CurriculumMigrationStatusRejected = "rejected"
+)
+
+var (
+	_ sdk.Msg = &MsgRequestCurriculumMigration{}
+	_ sdk.Msg = &MsgReviewCurriculumMigration{}
+)
+
+func NewMsgRequestCurriculumMigration(creator string, studentId string, targetVersion string, reason string) *MsgRequestCurriculumMigration {
+	return &MsgRequestCurriculumMigration{
+		Creator:       creator,
+		StudentId:     studentId,
+		TargetVersion: targetVersion,
+		Reason:        reason,
+	}
+}
+
+func (msg *MsgRequestCurriculumMigration) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if strings.TrimSpace(msg.StudentId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "student ID cannot be empty")
+	}
+
+	if strings.TrimSpace(msg.TargetVersion) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "target curriculum version cannot be empty")
+	}
+
+	return nil
+}
+
+func NewMsgReviewCurriculumMigration(creator string, studentId string, migrationId string, approve bool, note string) *MsgReviewCurriculumMigration {
+	return &MsgReviewCurriculumMigration{
+		Creator:     creator,
+		StudentId:   studentId,
+		MigrationId: migrationId,
+		Approve:     approve,
+		Note:        note,
+	}
+}
+
+func (msg *MsgReviewCurriculumMigration) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if strings.TrimSpace(msg.StudentId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "student ID cannot be empty")
+	}
+
+	if strings.TrimSpace(msg.MigrationId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "migration ID cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRequestCurriculumMigration_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRequestCurriculumMigration
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRequestCurriculumMigration{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing target version",
+			msg: MsgRequestCurriculumMigration{
+				Creator:   sample.AccAddress(),
+				StudentId: "0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgRequestCurriculumMigration{
+				Creator:       sample.AccAddress(),
+				StudentId:     "0",
+				TargetVersion: "2025",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgReviewCurriculumMigration_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReviewCurriculumMigration
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgReviewCurriculumMigration{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing migration",
+			msg: MsgReviewCurriculumMigration{
+				Creator:   sample.AccAddress(),
+				StudentId: "0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgReviewCurriculumMigration{
+				Creator:     sample.AccAddress(),
+				StudentId:   "0",
+				MigrationId: "1",
+				Approve:     true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgGrantPrerequisiteWaiver{}
+
+func NewMsgGrantPrerequisiteWaiver(creator string, studentId string, subjectId string, reason string, expiresAt string) *MsgGrantPrerequisiteWaiver {
+	return &MsgGrantPrerequisiteWaiver{
+		Creator:   creator,
+		StudentId: studentId,
+		SubjectId: subjectId,
+		Reason:    reason,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (msg *MsgGrantPrerequisiteWaiver) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if strings.TrimSpace(msg.StudentId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "student ID cannot be empty")
+	}
+	if strings.TrimSpace(msg.SubjectId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "subject ID cannot be empty")
+	}
+	if strings.TrimSpace(msg.Reason) == "" {
+		return ErrInvalidPrerequisiteWaiver.Wrap("a reason is required")
+	}
+	if _, err := time.Parse(time.RFC3339, msg.ExpiresAt); err != nil {
+		return ErrInvalidPrerequisiteWaiver.Wrapf("invalid expiry '%s', expected RFC3339", msg.ExpiresAt)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgGrantPrerequisiteWaiver_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgGrantPrerequisiteWaiver
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgGrantPrerequisiteWaiver("invalid_address", "student-1", "subject-1", "prior experience", "2025-12-31T00:00:00Z"),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing subject",
+			msg:  NewMsgGrantPrerequisiteWaiver(sample.AccAddress(), "student-1", " ", "prior experience", "2025-12-31T00:00:00Z"),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing reason",
+			msg:  NewMsgGrantPrerequisiteWaiver(sample.AccAddress(), "student-1", "subject-1", "", "2025-12-31T00:00:00Z"),
+			err:  ErrInvalidPrerequisiteWaiver,
+		}, {
+			name: "invalid expiry",
+			msg:  NewMsgGrantPrerequisiteWaiver(sample.AccAddress(), "student-1", "subject-1", "prior experience", "2025-12-31"),
+			err:  ErrInvalidPrerequisiteWaiver,
+		}, {
+			name: "valid address",
+			msg:  NewMsgGrantPrerequisiteWaiver(sample.AccAddress(), "student-1", "subject-1", "prior experience", "2025-12-31T00:00:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateSubjectOffering{}
+	_ sdk.Msg = &MsgDropSubjectOffering{}
+	_ sdk.Msg = &MsgWithdrawSubjectOffering{}
+)
+
+func NewMsgCreateSubjectOffering(creator string, subjectId string, term string, section string, capacity uint64, instructor string) *MsgCreateSubjectOffering {
+	return &MsgCreateSubjectOffering{
+		Creator:    creator,
+		SubjectId:  subjectId,
+		Term:       term,
+		Section:    section,
+		Capacity:   capacity,
+		Instructor: instructor,
+	}
+}
+
+func (msg *MsgCreateSubjectOffering) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if strings.TrimSpace(msg.SubjectId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "subject ID cannot be empty")
+	}
+	if strings.TrimSpace(msg.Term) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "term cannot be empty")
+	}
+	if strings.TrimSpace(msg.Section) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "section cannot be empty")
+	}
+	if msg.Capacity == 0 {
+		return ErrInvalidSubjectOffering.Wrap("capacity must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Instructor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid instructor address (%s)", err)
+	}
+
+	return nil
+}
+
+func NewMsgDropSubjectOffering(creator string, studentId string, offeringId string) *MsgDropSubjectOffering {
+	return &MsgDropSubjectOffering{
+		Creator:    creator,
+		StudentId:  studentId,
+		OfferingId: offeringId,
+	}
+}
+
+func (msg *MsgDropSubjectOffering) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return validateOfferingPlace(msg.StudentId, msg.OfferingId)
+}
+
+func NewMsgWithdrawSubjectOffering(creator string, studentId string, offeringId string, reason string) *MsgWithdrawSubjectOffering {
+	return &MsgWithdrawSubjectOffering{
+		Creator:    creator,
+		StudentId:  studentId,
+		OfferingId: offeringId,
+		Reason:     reason,
+	}
+}
+
+func (msg *MsgWithdrawSubjectOffering) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return validateOfferingPlace(msg.StudentId, msg.OfferingId)
+}
+
+// validateOfferingPlace checks the student and offering a place refers to
+func validateOfferingPlace(studentId string, offeringId string) error {
+	if strings.TrimSpace(studentId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "student ID cannot be empty")
+	}
+	if strings.TrimSpace(offeringId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "offering ID cannot be empty")
+	}
+	return nil
+}
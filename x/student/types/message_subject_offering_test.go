@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+
+	"academictoken/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateSubjectOffering_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgCreateSubjectOffering
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgCreateSubjectOffering("invalid_address", "subject-1", "2025-1", "A", 30, sample.AccAddress()),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing section",
+			msg:  NewMsgCreateSubjectOffering(sample.AccAddress(), "subject-1", "2025-1", "", 30, sample.AccAddress()),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no seats",
+			msg:  NewMsgCreateSubjectOffering(sample.AccAddress(), "subject-1", "2025-1", "A", 0, sample.AccAddress()),
+			err:  ErrInvalidSubjectOffering,
+		}, {
+			name: "invalid instructor",
+			msg:  NewMsgCreateSubjectOffering(sample.AccAddress(), "subject-1", "2025-1", "A", 30, "professor"),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg:  NewMsgCreateSubjectOffering(sample.AccAddress(), "subject-1", "2025-1", "A", 30, sample.AccAddress()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDropSubjectOffering_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgDropSubjectOffering
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgDropSubjectOffering("invalid_address", "student-1", "0"),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing offering",
+			msg:  NewMsgDropSubjectOffering(sample.AccAddress(), "student-1", ""),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg:  NewMsgDropSubjectOffering(sample.AccAddress(), "student-1", "0"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgWithdrawSubjectOffering_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgWithdrawSubjectOffering
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgWithdrawSubjectOffering("invalid_address", "student-1", "0", "health"),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing student",
+			msg:  NewMsgWithdrawSubjectOffering(sample.AccAddress(), "", "0", "health"),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg:  NewMsgWithdrawSubjectOffering(sample.AccAddress(), "student-1", "0", "health"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
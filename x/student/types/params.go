@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"net/url"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -18,6 +20,19 @@ var (
 	KeyAcademicProgressContractAddr = []byte("AcademicProgressContractAddr")
 	KeyDegreeContractAddr           = []byte("DegreeContractAddr")
 	KeyNftMintingContractAddr       = []byte("NftMintingContractAddr")
+	KeyIntegrationMode              = []byte("IntegrationMode")
+	KeyMintTransferCreditTokens     = []byte("MintTransferCreditTokens")
+)
+
+// Integration modes select how academic progress, graduation eligibility and
+// NFT authorization are evaluated. Params without a mode are disabled.
+const (
+	// IntegrationModeContract delegates to the configured CosmWasm contracts
+	IntegrationModeContract = "contract"
+	// IntegrationModeNative evaluates the curriculum in the keeper
+	IntegrationModeNative = "native"
+	// IntegrationModeDisabled rejects every operation that needs an evaluation
+	IntegrationModeDisabled = "disabled"
 )
 
 // ParamKeyTable the param key table for launch module
@@ -35,6 +50,7 @@ func NewParams(
 	academicProgressContractAddr string,
 	degreeContractAddr string,
 	nftMintingContractAddr string,
+	integrationMode string,
 ) Params {
 	return Params{
 		IpfsGateway:                  ipfsGateway,
@@ -45,6 +61,7 @@ func NewParams(
 		AcademicProgressContractAddr: academicProgressContractAddr,
 		DegreeContractAddr:           degreeContractAddr,
 		NftMintingContractAddr:       nftMintingContractAddr,
+		IntegrationMode:              integrationMode,
 	}
 }
 
@@ -59,6 +76,7 @@ func DefaultParams() Params {
 		"",                      // empty academic progress contract address
 		"",                      // empty degree contract address
 		"",                      // empty NFT minting contract address
+		IntegrationModeNative,   // no contracts are deployed at genesis
 	)
 }
 
@@ -73,32 +91,113 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAcademicProgressContractAddr, &p.AcademicProgressContractAddr, validateString),
 		paramtypes.NewParamSetPair(KeyDegreeContractAddr, &p.DegreeContractAddr, validateString),
 		paramtypes.NewParamSetPair(KeyNftMintingContractAddr, &p.NftMintingContractAddr, validateString),
+		paramtypes.NewParamSetPair(KeyIntegrationMode, &p.IntegrationMode, validateString),
+		paramtypes.NewParamSetPair(KeyMintTransferCreditTokens, &p.MintTransferCreditTokens, validateBool),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	if err := validateString(p.IpfsGateway); err != nil {
+	if err := validateIPFSGateway(p.IpfsGateway, p.IpfsEnabled); err != nil {
 		return err
 	}
-	if err := validateString(p.Admin); err != nil {
+	if err := validateAddress("admin", p.Admin); err != nil {
 		return err
 	}
-	if err := validateString(p.PrerequisitesContractAddr); err != nil {
+	if err := validateAddress("prerequisites contract", p.PrerequisitesContractAddr); err != nil {
 		return err
 	}
-	if err := validateString(p.EquivalenceContractAddr); err != nil {
+	if err := validateAddress("equivalence contract", p.EquivalenceContractAddr); err != nil {
 		return err
 	}
-	if err := validateString(p.AcademicProgressContractAddr); err != nil {
+	if err := validateAddress("academic progress contract", p.AcademicProgressContractAddr); err != nil {
 		return err
 	}
-	if err := validateString(p.DegreeContractAddr); err != nil {
+	if err := validateAddress("degree contract", p.DegreeContractAddr); err != nil {
 		return err
 	}
-	if err := validateString(p.NftMintingContractAddr); err != nil {
+	if err := validateAddress("NFT minting contract", p.NftMintingContractAddr); err != nil {
 		return err
 	}
+	return p.validateIntegrationMode()
+}
+
+// Mode returns the integration mode, treating params without a mode as disabled.
+func (p Params) Mode() string {
+	if p.IntegrationMode == "" {
+		return IntegrationModeDisabled
+	}
+	return p.IntegrationMode
+}
+
+// ContractStatuses reports the contracts the module calls, marking those the
+// integration mode requires.
+func (p Params) ContractStatuses() []ContractStatus {
+	contractMode := p.Mode() == IntegrationModeContract
+	statuses := []ContractStatus{
+		{Name: "prerequisites", Address: p.PrerequisitesContractAddr},
+		{Name: "equivalence", Address: p.EquivalenceContractAddr},
+		{Name: "academic_progress", Address: p.AcademicProgressContractAddr, Required: contractMode},
+		{Name: "degree", Address: p.DegreeContractAddr, Required: contractMode},
+		{Name: "nft_minting", Address: p.NftMintingContractAddr, Required: contractMode},
+	}
+	for i := range statuses {
+		statuses[i].Configured = statuses[i].Address != ""
+	}
+	return statuses
+}
+
+// validateIntegrationMode requires a known mode, and in contract mode the
+// contracts that have no native fallback.
+func (p Params) validateIntegrationMode() error {
+	switch p.Mode() {
+	case IntegrationModeNative, IntegrationModeDisabled:
+		return nil
+	case IntegrationModeContract:
+		for _, contract := range p.ContractStatuses() {
+			if contract.Required && !contract.Configured {
+				return fmt.Errorf("%s contract address is required in %s integration mode", contract.Name, IntegrationModeContract)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid integration mode %q: must be %s, %s or %s",
+			p.IntegrationMode, IntegrationModeContract, IntegrationModeNative, IntegrationModeDisabled)
+	}
+}
+
+// validateIPFSGateway requires an http(s) URL when a gateway is set, and a
+// gateway whenever IPFS is enabled.
+func validateIPFSGateway(gateway string, enabled bool) error {
+	if gateway == "" {
+		if enabled {
+			return fmt.Errorf("ipfs gateway is required when ipfs is enabled")
+		}
+		return nil
+	}
+
+	u, err := url.Parse(gateway)
+	if err != nil {
+		return fmt.Errorf("invalid ipfs gateway %q: %w", gateway, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid ipfs gateway %q: scheme must be http or https", gateway)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid ipfs gateway %q: missing host", gateway)
+	}
+	return nil
+}
+
+// validateAddress accepts an empty value (not configured) or a valid bech32
+// account address.
+func validateAddress(name, addr string) error {
+	if addr == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return fmt.Errorf("invalid %s address %q: %w", name, addr, err)
+	}
 	return nil
 }
 
@@ -34,6 +34,12 @@ type Params struct {
 	AcademicProgressContractAddr string `protobuf:"bytes,6,opt,name=academic_progress_contract_addr,json=academicProgressContractAddr,proto3" json:"academic_progress_contract_addr,omitempty" yaml:"academic_progress_contract_addr"`
 	DegreeContractAddr           string `protobuf:"bytes,7,opt,name=degree_contract_addr,json=degreeContractAddr,proto3" json:"degree_contract_addr,omitempty" yaml:"degree_contract_addr"`
 	NftMintingContractAddr       string `protobuf:"bytes,8,opt,name=nft_minting_contract_addr,json=nftMintingContractAddr,proto3" json:"nft_minting_contract_addr,omitempty" yaml:"nft_minting_contract_addr"`
+	// integration_mode selects how academic progress, graduation and NFT
+	// authorization are evaluated: "contract", "native" or "disabled"
+	IntegrationMode string `protobuf:"bytes,9,opt,name=integration_mode,json=integrationMode,proto3" json:"integration_mode,omitempty" yaml:"integration_mode"`
+	// mint_transfer_credit_tokens issues a token marked as transfer credit for
+	// each subject credited through an approved equivalence
+	MintTransferCreditTokens bool `protobuf:"varint,10,opt,name=mint_transfer_credit_tokens,json=mintTransferCreditTokens,proto3" json:"mint_transfer_credit_tokens,omitempty" yaml:"mint_transfer_credit_tokens"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -125,6 +131,20 @@ func (m *Params) GetNftMintingContractAddr() string {
 	return ""
 }
 
+func (m *Params) GetIntegrationMode() string {
+	if m != nil {
+		return m.IntegrationMode
+	}
+	return ""
+}
+
+func (m *Params) GetMintTransferCreditTokens() bool {
+	if m != nil {
+		return m.MintTransferCreditTokens
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "academictoken.student.Params")
 }
@@ -134,35 +154,39 @@ func init() {
 }
 
 var fileDescriptor_e856631a2f19ed9a = []byte{
-	// 438 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xb1, 0xae, 0xd3, 0x30,
-	0x14, 0x86, 0x1b, 0xa0, 0xe5, 0x62, 0xee, 0x00, 0xe1, 0xc2, 0x4d, 0x6f, 0x21, 0xae, 0x2c, 0x54,
-	0xaa, 0x0e, 0xcd, 0xc0, 0x80, 0xd4, 0x8d, 0x22, 0xc4, 0x84, 0x54, 0x32, 0xb2, 0x04, 0x37, 0x76,
-	0x22, 0x8b, 0xc6, 0x4e, 0x1d, 0x17, 0xe8, 0x2b, 0x30, 0xf1, 0x08, 0x3c, 0x02, 0xcf, 0xc0, 0xc4,
-	0xd8, 0x91, 0x29, 0x42, 0xed, 0x00, 0x73, 0x9e, 0x00, 0xd5, 0x4e, 0xab, 0x26, 0xd0, 0x2e, 0x95,
-	0xcf, 0xf9, 0xff, 0xff, 0x7c, 0xb2, 0x9b, 0x03, 0x10, 0x0e, 0x31, 0xa1, 0x09, 0x0b, 0x95, 0x78,
-	0x4f, 0xb9, 0x97, 0xa9, 0x05, 0xa1, 0x5c, 0x79, 0x29, 0x96, 0x38, 0xc9, 0x86, 0xa9, 0x14, 0x4a,
-	0xd8, 0xf7, 0x2b, 0x9e, 0x61, 0xe9, 0xb9, 0xba, 0x8b, 0x13, 0xc6, 0x85, 0xa7, 0x7f, 0x8d, 0xf3,
-	0xea, 0x22, 0x16, 0xb1, 0xd0, 0x47, 0x6f, 0x7b, 0x32, 0x5d, 0xf4, 0xbd, 0x09, 0x5a, 0x13, 0x3d,
-	0xd0, 0x1e, 0x81, 0x73, 0x96, 0x46, 0x59, 0x10, 0x63, 0x45, 0x3f, 0xe2, 0xa5, 0x63, 0x75, 0xad,
-	0xfe, 0xad, 0xf1, 0x65, 0x91, 0xc3, 0x7b, 0x4b, 0x9c, 0xcc, 0x46, 0xe8, 0x50, 0x45, 0xfe, 0xed,
-	0x6d, 0xf9, 0xca, 0x54, 0xfb, 0x2c, 0xe5, 0x78, 0x3a, 0xa3, 0xc4, 0xb9, 0xd6, 0xb5, 0xfa, 0x67,
-	0xff, 0x64, 0x4b, 0xb5, 0xcc, 0xbe, 0x34, 0x95, 0xdd, 0x03, 0x4d, 0x4c, 0x12, 0xc6, 0x9d, 0xeb,
-	0x1a, 0x78, 0xa7, 0xc8, 0xe1, 0xb9, 0x09, 0xe9, 0x36, 0xf2, 0x8d, 0x6c, 0x47, 0xa0, 0x93, 0x4a,
-	0x2a, 0xe9, 0x7c, 0xc1, 0x32, 0xa6, 0x68, 0x16, 0x84, 0x82, 0x2b, 0x89, 0x43, 0x15, 0x60, 0x42,
-	0xa4, 0x73, 0x43, 0xa7, 0x7b, 0x45, 0x0e, 0x91, 0x49, 0x9f, 0x30, 0x23, 0xbf, 0x5d, 0x51, 0x5f,
-	0x94, 0xe2, 0x73, 0x42, 0xa4, 0xfd, 0x0e, 0xb4, 0xb7, 0xc2, 0x07, 0x3c, 0xa3, 0x3c, 0xa4, 0x35,
-	0x4a, 0x53, 0x53, 0x1e, 0x17, 0x39, 0xec, 0x1a, 0xca, 0x51, 0x2b, 0xf2, 0x2f, 0x0f, 0xb4, 0x0a,
-	0x61, 0x0e, 0xe0, 0xee, 0x6f, 0x0b, 0x52, 0x29, 0x62, 0x49, 0xb3, 0xfa, 0x6d, 0x5a, 0x9a, 0x33,
-	0x28, 0x72, 0xd8, 0x2b, 0xdf, 0xe2, 0x74, 0x00, 0xf9, 0x0f, 0x77, 0x8e, 0x49, 0x69, 0xa8, 0x20,
-	0xdf, 0x80, 0x0b, 0x42, 0x63, 0x49, 0xeb, 0xf7, 0xb9, 0xa9, 0x39, 0xb0, 0xc8, 0x61, 0xc7, 0x70,
-	0xfe, 0xe7, 0x42, 0xbe, 0x6d, 0xda, 0x95, 0x91, 0x01, 0x68, 0xf3, 0x48, 0x05, 0x09, 0xe3, 0x8a,
-	0xf1, 0xb8, 0x36, 0xf7, 0xac, 0xfe, 0x4e, 0x47, 0xad, 0xc8, 0x7f, 0xc0, 0x23, 0xf5, 0xda, 0x48,
-	0x87, 0x80, 0xd1, 0x93, 0x3f, 0x5f, 0xa1, 0xf5, 0xf9, 0xf7, 0xb7, 0x81, 0x5b, 0x5d, 0x84, 0x4f,
-	0xfb, 0x55, 0x30, 0x5f, 0xee, 0xf8, 0xd9, 0x8f, 0xb5, 0x6b, 0xad, 0xd6, 0xae, 0xf5, 0x6b, 0xed,
-	0x5a, 0x5f, 0x36, 0x6e, 0x63, 0xb5, 0x71, 0x1b, 0x3f, 0x37, 0x6e, 0xe3, 0xed, 0xa3, 0x63, 0x49,
-	0xb5, 0x4c, 0x69, 0x36, 0x6d, 0xe9, 0x25, 0x78, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0x36, 0x7d,
-	0x6f, 0x4d, 0x6a, 0x03, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x17, 0x60, 0x65, 0x33, 0x93, 0x18, 0x61, 0xd0, 0x74, 0x85, 0xb8, 0xb2, 0x50, 0x99,
+	0x76, 0x58, 0x0f, 0x1c, 0x90, 0x7a, 0x63, 0x13, 0x70, 0x9a, 0x34, 0xa2, 0x9d, 0xb8, 0x18, 0x2f,
+	0xfe, 0x1a, 0x59, 0x34, 0x76, 0xe6, 0x78, 0x40, 0x5f, 0x81, 0x13, 0x8f, 0xc0, 0x23, 0xf0, 0x18,
+	0x1c, 0x77, 0xe4, 0x14, 0xa1, 0xf6, 0x00, 0x57, 0xf2, 0x04, 0xa8, 0x76, 0x36, 0x35, 0x81, 0xf6,
+	0x12, 0xd9, 0xdf, 0xef, 0xff, 0xff, 0xfe, 0xb2, 0xe3, 0x0f, 0x11, 0x16, 0x33, 0x0e, 0xa9, 0x88,
+	0x8d, 0x7a, 0x0f, 0x72, 0x90, 0x9b, 0x0b, 0x0e, 0xd2, 0x0c, 0x32, 0xa6, 0x59, 0x9a, 0x1f, 0x64,
+	0x5a, 0x19, 0xe5, 0x3f, 0xa8, 0x69, 0x0e, 0x2a, 0xcd, 0xee, 0x3d, 0x96, 0x0a, 0xa9, 0x06, 0xf6,
+	0xeb, 0x94, 0xbb, 0x3b, 0x89, 0x4a, 0x94, 0x5d, 0x0e, 0xe6, 0x2b, 0x57, 0x25, 0x7f, 0x5a, 0xa8,
+	0x75, 0x62, 0x1b, 0xfa, 0x43, 0xb4, 0x25, 0xb2, 0x51, 0x4e, 0x13, 0x66, 0xe0, 0x23, 0x9b, 0x04,
+	0x5e, 0xcf, 0xdb, 0xdb, 0x3c, 0x6c, 0x97, 0x05, 0xbe, 0x3f, 0x61, 0xe9, 0x78, 0x48, 0x16, 0x29,
+	0x89, 0xee, 0xcc, 0xb7, 0xaf, 0xdd, 0xee, 0xda, 0x0b, 0x92, 0x9d, 0x8d, 0x81, 0x07, 0x37, 0x7a,
+	0xde, 0xde, 0xc6, 0x3f, 0xde, 0x8a, 0x56, 0xde, 0x97, 0x6e, 0xe7, 0xf7, 0xd1, 0x3a, 0xe3, 0xa9,
+	0x90, 0xc1, 0x4d, 0x1b, 0xb8, 0x5d, 0x16, 0x78, 0xcb, 0x99, 0x6c, 0x99, 0x44, 0x0e, 0xfb, 0x23,
+	0xd4, 0xcd, 0x34, 0x68, 0x38, 0xbf, 0x10, 0xb9, 0x30, 0x90, 0xd3, 0x58, 0x49, 0xa3, 0x59, 0x6c,
+	0x28, 0xe3, 0x5c, 0x07, 0xb7, 0xac, 0xbb, 0x5f, 0x16, 0x98, 0x38, 0xf7, 0x0a, 0x31, 0x89, 0x3a,
+	0x35, 0x7a, 0x54, 0xc1, 0x17, 0x9c, 0x6b, 0xff, 0x1d, 0xea, 0xcc, 0xc1, 0x07, 0x36, 0x06, 0x19,
+	0x43, 0x23, 0x65, 0xdd, 0xa6, 0x3c, 0x29, 0x0b, 0xdc, 0x73, 0x29, 0x4b, 0xa5, 0x24, 0x6a, 0x2f,
+	0xb0, 0x5a, 0xc2, 0x39, 0xc2, 0x57, 0xbf, 0x8d, 0x66, 0x5a, 0x25, 0x1a, 0xf2, 0xe6, 0x69, 0x5a,
+	0x36, 0x67, 0xbf, 0x2c, 0x70, 0xbf, 0xba, 0x8b, 0xd5, 0x06, 0x12, 0x3d, 0xba, 0x52, 0x9c, 0x54,
+	0x82, 0x5a, 0xe4, 0x1b, 0xb4, 0xc3, 0x21, 0xd1, 0xd0, 0x3c, 0xcf, 0x6d, 0x9b, 0x83, 0xcb, 0x02,
+	0x77, 0x5d, 0xce, 0xff, 0x54, 0x24, 0xf2, 0x5d, 0xb9, 0xd6, 0x92, 0xa2, 0x8e, 0x1c, 0x19, 0x9a,
+	0x0a, 0x69, 0x84, 0x4c, 0x1a, 0x7d, 0x37, 0x9a, 0xf7, 0xb4, 0x54, 0x4a, 0xa2, 0x87, 0x72, 0x64,
+	0x8e, 0x1d, 0xaa, 0x05, 0xbc, 0x42, 0xdb, 0x42, 0x1a, 0x48, 0x34, 0x33, 0x42, 0x49, 0x9a, 0x2a,
+	0x0e, 0xc1, 0xa6, 0xed, 0xdb, 0x2d, 0x0b, 0xdc, 0xae, 0x1e, 0x56, 0x43, 0x41, 0xa2, 0xbb, 0x0b,
+	0xa5, 0x63, 0xc5, 0xc1, 0x07, 0xd4, 0x9d, 0x27, 0x53, 0xa3, 0x99, 0xcc, 0x47, 0xa0, 0x69, 0xac,
+	0x81, 0x0b, 0x43, 0xed, 0xc8, 0xe4, 0x01, 0xb2, 0x6f, 0x75, 0xe1, 0xe1, 0xac, 0x10, 0x93, 0x28,
+	0x98, 0xd3, 0xd3, 0x0a, 0x1e, 0x59, 0x76, 0x6a, 0xd1, 0xf0, 0xe9, 0xef, 0xaf, 0xd8, 0xfb, 0xfc,
+	0xeb, 0xdb, 0x7e, 0x58, 0x9f, 0xdb, 0x4f, 0xd7, 0x93, 0xeb, 0x06, 0xed, 0xf0, 0xf9, 0xf7, 0x69,
+	0xe8, 0x5d, 0x4e, 0x43, 0xef, 0xe7, 0x34, 0xf4, 0xbe, 0xcc, 0xc2, 0xb5, 0xcb, 0x59, 0xb8, 0xf6,
+	0x63, 0x16, 0xae, 0xbd, 0x7d, 0xbc, 0xcc, 0x69, 0x26, 0x19, 0xe4, 0x67, 0x2d, 0x3b, 0xb3, 0xcf,
+	0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xfd, 0x68, 0x3f, 0x19, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -208,6 +232,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.NftMintingContractAddr != that1.NftMintingContractAddr {
 		return false
 	}
+	if this.IntegrationMode != that1.IntegrationMode {
+		return false
+	}
+	if this.MintTransferCreditTokens != that1.MintTransferCreditTokens {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -230,6 +260,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintTransferCreditTokens {
+		i--
+		if m.MintTransferCreditTokens {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.IntegrationMode) > 0 {
+		i -= len(m.IntegrationMode)
+		copy(dAtA[i:], m.IntegrationMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.IntegrationMode)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.NftMintingContractAddr) > 0 {
 		i -= len(m.NftMintingContractAddr)
 		copy(dAtA[i:], m.NftMintingContractAddr)
@@ -340,6 +387,13 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = len(m.IntegrationMode)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MintTransferCreditTokens {
+		n += 2
+	}
 	return n
 }
 
@@ -622,6 +676,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.NftMintingContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegrationMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegrationMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintTransferCreditTokens", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintTransferCreditTokens = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"academictoken/x/student/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+
+	tests := []struct {
+		desc   string
+		params types.Params
+		valid  bool
+	}{
+		{
+			desc:   "default",
+			params: types.DefaultParams(),
+			valid:  true,
+		},
+		{
+			desc:   "empty",
+			params: types.Params{},
+			valid:  true,
+		},
+		{
+			desc: "all addresses set",
+			params: types.NewParams("https://ipfs.example.org", true, contract,
+				contract, contract, contract, contract, contract, types.IntegrationModeContract),
+			valid: true,
+		},
+		{
+			desc:   "ipfs enabled without gateway",
+			params: types.NewParams("", true, "", "", "", "", "", "", types.IntegrationModeNative),
+			valid:  false,
+		},
+		{
+			desc:   "gateway without scheme",
+			params: types.NewParams("localhost:5001", true, "", "", "", "", "", "", types.IntegrationModeNative),
+			valid:  false,
+		},
+		{
+			desc:   "gateway with unsupported scheme",
+			params: types.NewParams("ftp://ipfs.example.org", true, "", "", "", "", "", "", types.IntegrationModeNative),
+			valid:  false,
+		},
+		{
+			desc: "invalid contract address",
+			params: types.NewParams("http://localhost:5001", true, "",
+				"cosmos1z6a9x2xs5n8j7k3l4f8m9c2v1b6h4g8r7e3w5q9t8y7u6i5o4p3a2s1d0f", "", "", "", "", types.IntegrationModeNative),
+			valid: false,
+		},
+		{
+			desc:   "invalid admin address",
+			params: types.NewParams("http://localhost:5001", true, "admin", "", "", "", "", "", types.IntegrationModeNative),
+			valid:  false,
+		},
+		{
+			desc:   "disabled integration",
+			params: types.NewParams("http://localhost:5001", true, "", "", "", "", "", "", types.IntegrationModeDisabled),
+			valid:  true,
+		},
+		{
+			desc:   "unknown integration mode",
+			params: types.NewParams("http://localhost:5001", true, "", "", "", "", "", "", "mock"),
+			valid:  false,
+		},
+		{
+			desc: "contract mode without degree contract",
+			params: types.NewParams("http://localhost:5001", true, "",
+				"", "", contract, "", contract, types.IntegrationModeContract),
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
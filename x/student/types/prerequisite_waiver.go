@@ -0,0 +1,37 @@
+package types
+
+import (
+	"time"
+)
+
+// IsActiveAt reports whether the waiver still exempts the student at t
+func (w PrerequisiteWaiver) IsActiveAt(t time.Time) bool {
+	expiresAt, err := time.Parse(time.RFC3339, w.ExpiresAt)
+	if err != nil {
+		return false
+	}
+	return !t.After(expiresAt)
+}
+
+// SetPrerequisiteWaiver records a waiver on the academic tree, replacing any
+// previous waiver for the same subject
+func (t *StudentAcademicTree) SetPrerequisiteWaiver(waiver PrerequisiteWaiver) {
+	for i, existing := range t.PrerequisiteWaivers {
+		if existing.SubjectId == waiver.SubjectId {
+			t.PrerequisiteWaivers[i] = &waiver
+			return
+		}
+	}
+	t.PrerequisiteWaivers = append(t.PrerequisiteWaivers, &waiver)
+}
+
+// ActivePrerequisiteWaiver returns the waiver exempting the student from the
+// prerequisites of a subject at the given time, if any
+func (t StudentAcademicTree) ActivePrerequisiteWaiver(subjectId string, at time.Time) (PrerequisiteWaiver, bool) {
+	for _, waiver := range t.PrerequisiteWaivers {
+		if waiver.SubjectId == subjectId && waiver.IsActiveAt(at) {
+			return *waiver, true
+		}
+	}
+	return PrerequisiteWaiver{}, false
+}
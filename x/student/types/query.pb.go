@@ -1098,1262 +1098,1340 @@ func (m *QueryCheckGraduationEligibilityResponse) GetMessage() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "academictoken.student.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "academictoken.student.QueryParamsResponse")
-	proto.RegisterType((*QueryListStudentsRequest)(nil), "academictoken.student.QueryListStudentsRequest")
-	proto.RegisterType((*QueryListStudentsResponse)(nil), "academictoken.student.QueryListStudentsResponse")
-	proto.RegisterType((*QueryGetStudentRequest)(nil), "academictoken.student.QueryGetStudentRequest")
-	proto.RegisterType((*QueryGetStudentResponse)(nil), "academictoken.student.QueryGetStudentResponse")
-	proto.RegisterType((*QueryListEnrollmentsRequest)(nil), "academictoken.student.QueryListEnrollmentsRequest")
-	proto.RegisterType((*QueryListEnrollmentsResponse)(nil), "academictoken.student.QueryListEnrollmentsResponse")
-	proto.RegisterType((*QueryGetEnrollmentRequest)(nil), "academictoken.student.QueryGetEnrollmentRequest")
-	proto.RegisterType((*QueryGetEnrollmentResponse)(nil), "academictoken.student.QueryGetEnrollmentResponse")
-	proto.RegisterType((*QueryGetEnrollmentsByStudentRequest)(nil), "academictoken.student.QueryGetEnrollmentsByStudentRequest")
-	proto.RegisterType((*QueryGetEnrollmentsByStudentResponse)(nil), "academictoken.student.QueryGetEnrollmentsByStudentResponse")
-	proto.RegisterType((*QueryGetStudentProgressRequest)(nil), "academictoken.student.QueryGetStudentProgressRequest")
-	proto.RegisterType((*QueryGetStudentProgressResponse)(nil), "academictoken.student.QueryGetStudentProgressResponse")
-	proto.RegisterType((*QueryGetStudentsByInstitutionRequest)(nil), "academictoken.student.QueryGetStudentsByInstitutionRequest")
-	proto.RegisterType((*QueryGetStudentsByInstitutionResponse)(nil), "academictoken.student.QueryGetStudentsByInstitutionResponse")
-	proto.RegisterType((*QueryGetStudentsByCourseRequest)(nil), "academictoken.student.QueryGetStudentsByCourseRequest")
-	proto.RegisterType((*QueryGetStudentsByCourseResponse)(nil), "academictoken.student.QueryGetStudentsByCourseResponse")
-	proto.RegisterType((*QueryGetStudentAcademicTreeRequest)(nil), "academictoken.student.QueryGetStudentAcademicTreeRequest")
-	proto.RegisterType((*QueryGetStudentAcademicTreeResponse)(nil), "academictoken.student.QueryGetStudentAcademicTreeResponse")
-	proto.RegisterType((*QueryCheckGraduationEligibilityRequest)(nil), "academictoken.student.QueryCheckGraduationEligibilityRequest")
-	proto.RegisterType((*QueryCheckGraduationEligibilityResponse)(nil), "academictoken.student.QueryCheckGraduationEligibilityResponse")
+// QueryIntegrationModeRequest is request type for the Query/IntegrationMode RPC method.
+type QueryIntegrationModeRequest struct {
 }
 
-func init() { proto.RegisterFile("academictoken/student/query.proto", fileDescriptor_70d66d97c81cd8b4) }
-
-var fileDescriptor_70d66d97c81cd8b4 = []byte{
-	// 1191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x4f, 0x1d, 0xd5,
-	0x17, 0xe7, 0xd2, 0xef, 0x97, 0xc2, 0x01, 0xb4, 0xbd, 0xad, 0x2d, 0x4e, 0xcb, 0xa3, 0x1d, 0x4a,
-	0x41, 0x22, 0x33, 0x05, 0x4a, 0x51, 0x28, 0x2d, 0x3e, 0x82, 0x84, 0x68, 0x0c, 0xbe, 0x6a, 0x8c,
-	0xdd, 0x90, 0x81, 0x77, 0x33, 0x4e, 0xfa, 0xde, 0x9b, 0xd7, 0xb9, 0xf3, 0x8c, 0x2f, 0xc8, 0xc6,
-	0x45, 0x57, 0x2e, 0x4c, 0x8c, 0x1b, 0x77, 0x6e, 0x1a, 0x37, 0xc6, 0xc6, 0x9d, 0x3b, 0x7f, 0x6c,
-	0xba, 0x6c, 0x52, 0x17, 0x2e, 0x8c, 0x31, 0x60, 0xa2, 0x7f, 0x86, 0x79, 0x77, 0xce, 0x7d, 0x33,
-	0xf3, 0xe6, 0xc7, 0x9b, 0x21, 0x2c, 0xd8, 0xc0, 0x70, 0xe6, 0xfc, 0xf8, 0x7c, 0xce, 0x39, 0xf7,
-	0xce, 0x27, 0xc0, 0x55, 0x63, 0xd7, 0x28, 0xb3, 0xaa, 0xb5, 0xeb, 0xda, 0x0f, 0x58, 0x4d, 0xe7,
-	0x6e, 0xa3, 0xcc, 0x6a, 0xae, 0xfe, 0xb0, 0xc1, 0x9c, 0xa6, 0x56, 0x77, 0x6c, 0xd7, 0xa6, 0x2f,
-	0x85, 0x5c, 0x34, 0x74, 0x51, 0xce, 0x1a, 0x55, 0xab, 0x66, 0xeb, 0xe2, 0xa7, 0xe7, 0xa9, 0x9c,
-	0x37, 0x6d, 0xd3, 0x16, 0x8f, 0x7a, 0xeb, 0x09, 0xad, 0x97, 0x4d, 0xdb, 0x36, 0x2b, 0x4c, 0x37,
-	0xea, 0x96, 0x6e, 0xd4, 0x6a, 0xb6, 0x6b, 0xb8, 0x96, 0x5d, 0xe3, 0xf8, 0x76, 0x7a, 0xd7, 0xe6,
-	0x55, 0x9b, 0xeb, 0x3b, 0x06, 0x67, 0x5e, 0x59, 0xfd, 0xe3, 0xd9, 0x1d, 0xe6, 0x1a, 0xb3, 0x7a,
-	0xdd, 0x30, 0xad, 0x9a, 0x70, 0x46, 0x5f, 0x35, 0x1e, 0x6c, 0xdd, 0x70, 0x8c, 0xaa, 0xcc, 0x37,
-	0x1e, 0xef, 0x83, 0xbf, 0xd1, 0x49, 0x4b, 0x75, 0xda, 0x66, 0x35, 0xc7, 0xae, 0x54, 0xaa, 0xbe,
-	0xff, 0x6c, 0xba, 0xbf, 0x7c, 0xbb, 0xed, 0x3a, 0x8c, 0x61, 0xc8, 0x4c, 0x7c, 0x48, 0xdb, 0xb5,
-	0xee, 0xd8, 0xa6, 0xc3, 0x38, 0x4f, 0x77, 0x37, 0x1d, 0xa3, 0xdc, 0x10, 0x2d, 0xd8, 0xe6, 0xae,
-	0xe1, 0x36, 0xd0, 0x5d, 0x3d, 0x0f, 0xf4, 0xdd, 0x56, 0xaf, 0xb6, 0x04, 0xf5, 0x12, 0x7b, 0xd8,
-	0x60, 0xdc, 0x55, 0x3f, 0x80, 0x73, 0x21, 0x2b, 0xaf, 0xdb, 0x35, 0xce, 0xe8, 0x2a, 0xf4, 0x79,
-	0x2d, 0x1a, 0x21, 0x57, 0xc8, 0xd4, 0xe0, 0xdc, 0xa8, 0x16, 0x3b, 0x51, 0xcd, 0x0b, 0x2b, 0x0e,
-	0x3c, 0xfd, 0x73, 0xac, 0xe7, 0xdb, 0x7f, 0x9e, 0x4c, 0x93, 0x12, 0xc6, 0xa9, 0x3b, 0x30, 0x22,
-	0x12, 0xbf, 0x6d, 0x71, 0xf7, 0x9e, 0xe7, 0x2d, 0x8b, 0xd2, 0x37, 0x01, 0xfc, 0x41, 0x61, 0x85,
-	0xeb, 0x9a, 0x37, 0x55, 0xad, 0x35, 0x55, 0xcd, 0x5b, 0x26, 0x9c, 0xaa, 0xb6, 0x65, 0x98, 0x0c,
-	0x63, 0x4b, 0x81, 0x48, 0xf5, 0x31, 0x81, 0x97, 0x63, 0x8a, 0xb4, 0x39, 0xf4, 0x23, 0xcc, 0x16,
-	0x8b, 0x53, 0x53, 0x83, 0x73, 0x85, 0x04, 0x16, 0x18, 0x5a, 0xfc, 0x5f, 0x8b, 0x46, 0xa9, 0x1d,
-	0x45, 0x37, 0x42, 0x38, 0x7b, 0x05, 0xce, 0xc9, 0xae, 0x38, 0xbd, 0xf2, 0x21, 0xa0, 0x8b, 0x70,
-	0x41, 0xe0, 0xdc, 0x60, 0x12, 0xa6, 0x6c, 0xc5, 0x28, 0x80, 0x5c, 0x09, 0xab, 0x2c, 0x5a, 0x31,
-	0x50, 0x1a, 0x40, 0xcb, 0x66, 0x59, 0xfd, 0x10, 0x2e, 0x46, 0x02, 0x91, 0xde, 0x1d, 0x38, 0x8d,
-	0x7e, 0xd8, 0xc1, 0x6c, 0xec, 0x64, 0x90, 0xca, 0xe0, 0x52, 0xbb, 0x77, 0xeb, 0xed, 0xed, 0x3d,
-	0xf6, 0x19, 0xfd, 0x48, 0xe0, 0x72, 0x7c, 0x1d, 0xe4, 0xb1, 0x05, 0x83, 0xfe, 0xe1, 0x91, 0x93,
-	0x9a, 0x4a, 0xe7, 0xe2, 0xe7, 0x41, 0x56, 0xc1, 0x14, 0xc7, 0x37, 0xb6, 0x55, 0x5c, 0xaf, 0x0d,
-	0x16, 0xa8, 0x28, 0x1b, 0x34, 0x0e, 0xc3, 0x7e, 0x51, 0x7f, 0x78, 0x43, 0xbe, 0x71, 0xb3, 0xac,
-	0x56, 0x40, 0x89, 0xcb, 0x80, 0xd4, 0xdf, 0x01, 0xf0, 0xbd, 0xb1, 0xc7, 0x79, 0x99, 0x07, 0x32,
-	0xa8, 0x9f, 0x13, 0x18, 0x8f, 0x96, 0xe3, 0xc5, 0x66, 0xae, 0xa5, 0xeb, 0x18, 0x7d, 0xef, 0x91,
-	0x47, 0xff, 0x33, 0x81, 0x6b, 0xe9, 0x70, 0x4e, 0xfe, 0x0a, 0xdc, 0x85, 0x42, 0xc7, 0x01, 0xdc,
-	0xc2, 0x5b, 0x38, 0xe3, 0x09, 0xae, 0xc0, 0x58, 0x62, 0x02, 0xa4, 0xbf, 0x09, 0xfd, 0xf2, 0x6a,
-	0xc7, 0x25, 0x98, 0x4c, 0xe0, 0xfe, 0x06, 0x5a, 0x65, 0x0a, 0x79, 0x63, 0xc9, 0x70, 0xf5, 0xab,
-	0x40, 0xcb, 0xe5, 0x85, 0x58, 0x6c, 0x6e, 0xd6, 0xb8, 0x6b, 0xb9, 0x8d, 0x16, 0x21, 0x89, 0x7a,
-	0x02, 0x5e, 0xb0, 0x7c, 0xab, 0x8f, 0x7c, 0x38, 0x60, 0x3d, 0xc6, 0x55, 0xf8, 0x81, 0xc0, 0x44,
-	0x17, 0x5c, 0x27, 0xef, 0xd6, 0x7e, 0x44, 0x22, 0xb3, 0xe3, 0xc5, 0xe6, 0x9a, 0xdd, 0x70, 0xb8,
-	0x24, 0x49, 0x2f, 0xc1, 0xc0, 0xae, 0x30, 0xf8, 0x2d, 0xec, 0xf7, 0x0c, 0xc7, 0xd8, 0xbd, 0xef,
-	0x08, 0x5c, 0x49, 0x06, 0x72, 0xf2, 0x1a, 0xb7, 0x06, 0x6a, 0x07, 0x5c, 0xb9, 0xb8, 0xef, 0x39,
-	0x8c, 0x65, 0x3c, 0x38, 0x9f, 0xfa, 0x77, 0x59, 0x6c, 0x12, 0xa4, 0xfd, 0x3e, 0x0c, 0x87, 0xb4,
-	0x14, 0x9e, 0xa0, 0xe9, 0x74, 0xee, 0xc1, 0x54, 0xd8, 0x87, 0x21, 0x23, 0x60, 0x53, 0x37, 0xe0,
-	0xba, 0xa8, 0xbe, 0xf6, 0x11, 0xdb, 0x7d, 0xb0, 0xd1, 0x96, 0x54, 0xeb, 0x15, 0xcb, 0xb4, 0x76,
-	0xac, 0x8a, 0xe5, 0x36, 0x33, 0xd2, 0xf8, 0x89, 0xc0, 0x64, 0xd7, 0x4c, 0xc8, 0xe5, 0x3e, 0x9c,
-	0x8d, 0xa8, 0xb7, 0x2e, 0x37, 0x82, 0x9f, 0xf0, 0x9e, 0x70, 0x47, 0x32, 0x67, 0xcc, 0x0e, 0x3b,
-	0x1d, 0x83, 0x41, 0x8b, 0x6f, 0x33, 0x51, 0xb5, 0xc2, 0xc4, 0x74, 0xfb, 0x4b, 0x60, 0xf1, 0x75,
-	0xb4, 0xd0, 0x11, 0x38, 0x5d, 0x65, 0x9c, 0x1b, 0x26, 0x1b, 0x39, 0x25, 0x48, 0xc8, 0x3f, 0xe7,
-	0xbe, 0x3f, 0x03, 0xff, 0x17, 0x14, 0xe8, 0x23, 0x02, 0x7d, 0x9e, 0xe4, 0xa3, 0xaf, 0x24, 0x00,
-	0x8a, 0x6a, 0x4c, 0x65, 0x3a, 0x8b, 0xab, 0xd7, 0x02, 0x75, 0xe2, 0xb3, 0xe7, 0x7f, 0x7f, 0xd9,
-	0x3b, 0x46, 0x47, 0xf5, 0x34, 0xe1, 0x4e, 0xbf, 0x26, 0x30, 0x14, 0x14, 0x7d, 0x54, 0x4f, 0xab,
-	0x11, 0xa3, 0x41, 0x95, 0x1b, 0xd9, 0x03, 0x10, 0xda, 0xa4, 0x80, 0x76, 0x95, 0x8e, 0xe9, 0xa9,
-	0xd2, 0x9e, 0xd3, 0x6f, 0x08, 0x80, 0xbf, 0xb5, 0x74, 0x26, 0xad, 0x52, 0x44, 0x11, 0x2a, 0x5a,
-	0x56, 0x77, 0x84, 0x75, 0x53, 0xc0, 0xd2, 0xe8, 0xab, 0x5d, 0x60, 0xe9, 0x7b, 0xfe, 0x9a, 0xee,
-	0xd3, 0xc7, 0x04, 0x5e, 0xec, 0x50, 0x64, 0x74, 0xae, 0x5b, 0x4b, 0xa2, 0x32, 0x51, 0x99, 0xcf,
-	0x15, 0x83, 0x90, 0xa7, 0x05, 0xe4, 0x6b, 0x54, 0x4d, 0x80, 0x1c, 0xfc, 0x92, 0x3f, 0x21, 0x30,
-	0x1c, 0xd2, 0x0f, 0xf4, 0x46, 0x97, 0x06, 0x45, 0xa4, 0x9a, 0x32, 0x9b, 0x23, 0x02, 0x21, 0x2e,
-	0x09, 0x88, 0x37, 0xe9, 0x5c, 0x77, 0x88, 0xfa, 0x5e, 0x48, 0x07, 0xee, 0xd3, 0xdf, 0x08, 0x5c,
-	0x4c, 0x90, 0x3c, 0x74, 0x29, 0x33, 0x94, 0x88, 0x6c, 0x53, 0x96, 0x8f, 0x14, 0x8b, 0x84, 0x56,
-	0x05, 0xa1, 0x25, 0xfa, 0x5a, 0x9e, 0x35, 0x09, 0x4d, 0xe2, 0x17, 0x02, 0x34, 0xaa, 0x62, 0xe8,
-	0x42, 0xb6, 0x7d, 0xed, 0x90, 0x4d, 0xca, 0xad, 0xbc, 0x61, 0xc8, 0x63, 0x45, 0xf0, 0x58, 0xa4,
-	0x0b, 0xb9, 0x78, 0x48, 0x81, 0x44, 0xff, 0x20, 0x30, 0x92, 0xa4, 0x41, 0xe8, 0x72, 0x36, 0x4c,
-	0xb1, 0x8a, 0x4a, 0xb9, 0x7d, 0xb4, 0x60, 0xa4, 0xb5, 0x2e, 0x68, 0xdd, 0xa5, 0x2b, 0x09, 0xb4,
-	0x02, 0xb2, 0x8c, 0xeb, 0x7b, 0x61, 0xe9, 0xb6, 0xef, 0x5f, 0x3d, 0xbf, 0x12, 0x38, 0x17, 0x23,
-	0x12, 0xe8, 0xad, 0xcc, 0xe0, 0x42, 0xf2, 0x46, 0x59, 0xcc, 0x1d, 0x87, 0x7c, 0x96, 0x05, 0x9f,
-	0x05, 0x3a, 0x9f, 0xc0, 0xc7, 0xd3, 0x48, 0x5c, 0xdf, 0x6b, 0xab, 0xa7, 0x00, 0x8b, 0xe7, 0x04,
-	0x2e, 0xc4, 0x7f, 0xf6, 0xe9, 0xeb, 0xd9, 0x00, 0xc5, 0xe8, 0x0d, 0x65, 0xe9, 0x28, 0xa1, 0x48,
-	0xa7, 0x28, 0xe8, 0xdc, 0xa6, 0x4b, 0xb9, 0xb6, 0x4e, 0xfa, 0xce, 0xb4, 0x84, 0x09, 0xfd, 0x97,
-	0x80, 0x92, 0x2c, 0x02, 0xe8, 0x4a, 0x1a, 0xbc, 0xae, 0x32, 0x44, 0xb9, 0x73, 0xd4, 0x70, 0x64,
-	0xf8, 0x96, 0x60, 0xb8, 0x4e, 0xd7, 0x72, 0x31, 0xf4, 0x65, 0xc6, 0x0c, 0xf3, 0x93, 0x16, 0x17,
-	0x9f, 0x1e, 0x14, 0xc8, 0xb3, 0x83, 0x02, 0xf9, 0xeb, 0xa0, 0x40, 0xbe, 0x38, 0x2c, 0xf4, 0x3c,
-	0x3b, 0x2c, 0xf4, 0xfc, 0x7e, 0x58, 0xe8, 0xb9, 0x3f, 0x1a, 0xce, 0xfe, 0x49, 0x3b, 0xbf, 0xdb,
-	0xac, 0x33, 0xbe, 0xd3, 0x27, 0xfe, 0x57, 0x35, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb5,
-	0x61, 0xb6, 0x31, 0x64, 0x14, 0x00, 0x00,
+func (m *QueryIntegrationModeRequest) Reset()         { *m = QueryIntegrationModeRequest{} }
+func (m *QueryIntegrationModeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrationModeRequest) ProtoMessage()    {}
+func (*QueryIntegrationModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{22}
+}
+func (m *QueryIntegrationModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrationModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrationModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegrationModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrationModeRequest.Merge(m, src)
+}
+func (m *QueryIntegrationModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrationModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrationModeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryIntegrationModeRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListStudents queries all students with pagination
-	ListStudents(ctx context.Context, in *QueryListStudentsRequest, opts ...grpc.CallOption) (*QueryListStudentsResponse, error)
-	// GetStudent queries a student by index
-	GetStudent(ctx context.Context, in *QueryGetStudentRequest, opts ...grpc.CallOption) (*QueryGetStudentResponse, error)
-	// ListEnrollments queries all enrollments with pagination
-	ListEnrollments(ctx context.Context, in *QueryListEnrollmentsRequest, opts ...grpc.CallOption) (*QueryListEnrollmentsResponse, error)
-	// GetEnrollment queries an enrollment by index
-	GetEnrollment(ctx context.Context, in *QueryGetEnrollmentRequest, opts ...grpc.CallOption) (*QueryGetEnrollmentResponse, error)
-	// GetEnrollmentsByStudent queries all enrollments for a specific student
-	GetEnrollmentsByStudent(ctx context.Context, in *QueryGetEnrollmentsByStudentRequest, opts ...grpc.CallOption) (*QueryGetEnrollmentsByStudentResponse, error)
-	// GetStudentProgress queries academic progress for a specific student
-	GetStudentProgress(ctx context.Context, in *QueryGetStudentProgressRequest, opts ...grpc.CallOption) (*QueryGetStudentProgressResponse, error)
-	// GetStudentsByInstitution queries students enrolled in a specific institution
-	GetStudentsByInstitution(ctx context.Context, in *QueryGetStudentsByInstitutionRequest, opts ...grpc.CallOption) (*QueryGetStudentsByInstitutionResponse, error)
-	// GetStudentsByCourse queries students enrolled in a specific course
-	GetStudentsByCourse(ctx context.Context, in *QueryGetStudentsByCourseRequest, opts ...grpc.CallOption) (*QueryGetStudentsByCourseResponse, error)
-	// GetStudentAcademicTree queries the academic tree for a specific student
-	GetStudentAcademicTree(ctx context.Context, in *QueryGetStudentAcademicTreeRequest, opts ...grpc.CallOption) (*QueryGetStudentAcademicTreeResponse, error)
-	// CheckGraduationEligibility checks if a student is eligible for graduation
-	CheckGraduationEligibility(ctx context.Context, in *QueryCheckGraduationEligibilityRequest, opts ...grpc.CallOption) (*QueryCheckGraduationEligibilityResponse, error)
+// ContractStatus reports the configuration of one of the contracts the module calls.
+type ContractStatus struct {
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Configured bool   `protobuf:"varint,3,opt,name=configured,proto3" json:"configured,omitempty"`
+	// required is set when the integration mode needs the contract
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ContractStatus) Reset()         { *m = ContractStatus{} }
+func (m *ContractStatus) String() string { return proto.CompactTextString(m) }
+func (*ContractStatus) ProtoMessage()    {}
+func (*ContractStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{23}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ContractStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ContractStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ListStudents(ctx context.Context, in *QueryListStudentsRequest, opts ...grpc.CallOption) (*QueryListStudentsResponse, error) {
-	out := new(QueryListStudentsResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/ListStudents", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ContractStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStatus.Merge(m, src)
+}
+func (m *ContractStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStatus.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetStudent(ctx context.Context, in *QueryGetStudentRequest, opts ...grpc.CallOption) (*QueryGetStudentResponse, error) {
-	out := new(QueryGetStudentResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ContractStatus proto.InternalMessageInfo
+
+func (m *ContractStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListEnrollments(ctx context.Context, in *QueryListEnrollmentsRequest, opts ...grpc.CallOption) (*QueryListEnrollmentsResponse, error) {
-	out := new(QueryListEnrollmentsResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/ListEnrollments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ContractStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetEnrollment(ctx context.Context, in *QueryGetEnrollmentRequest, opts ...grpc.CallOption) (*QueryGetEnrollmentResponse, error) {
-	out := new(QueryGetEnrollmentResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetEnrollment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ContractStatus) GetConfigured() bool {
+	if m != nil {
+		return m.Configured
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) GetEnrollmentsByStudent(ctx context.Context, in *QueryGetEnrollmentsByStudentRequest, opts ...grpc.CallOption) (*QueryGetEnrollmentsByStudentResponse, error) {
-	out := new(QueryGetEnrollmentsByStudentResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetEnrollmentsByStudent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ContractStatus) GetRequired() bool {
+	if m != nil {
+		return m.Required
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) GetStudentProgress(ctx context.Context, in *QueryGetStudentProgressRequest, opts ...grpc.CallOption) (*QueryGetStudentProgressResponse, error) {
-	out := new(QueryGetStudentProgressResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudentProgress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryIntegrationModeResponse is response type for the Query/IntegrationMode RPC method.
+type QueryIntegrationModeResponse struct {
+	Mode      string           `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Contracts []ContractStatus `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts"`
+	// ready is false when the integration mode cannot serve transactions
+	Ready   bool   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (c *queryClient) GetStudentsByInstitution(ctx context.Context, in *QueryGetStudentsByInstitutionRequest, opts ...grpc.CallOption) (*QueryGetStudentsByInstitutionResponse, error) {
-	out := new(QueryGetStudentsByInstitutionResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudentsByInstitution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIntegrationModeResponse) Reset()         { *m = QueryIntegrationModeResponse{} }
+func (m *QueryIntegrationModeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrationModeResponse) ProtoMessage()    {}
+func (*QueryIntegrationModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{24}
+}
+func (m *QueryIntegrationModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrationModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrationModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIntegrationModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrationModeResponse.Merge(m, src)
+}
+func (m *QueryIntegrationModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrationModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrationModeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetStudentsByCourse(ctx context.Context, in *QueryGetStudentsByCourseRequest, opts ...grpc.CallOption) (*QueryGetStudentsByCourseResponse, error) {
-	out := new(QueryGetStudentsByCourseResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudentsByCourse", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIntegrationModeResponse proto.InternalMessageInfo
+
+func (m *QueryIntegrationModeResponse) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetStudentAcademicTree(ctx context.Context, in *QueryGetStudentAcademicTreeRequest, opts ...grpc.CallOption) (*QueryGetStudentAcademicTreeResponse, error) {
-	out := new(QueryGetStudentAcademicTreeResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudentAcademicTree", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIntegrationModeResponse) GetContracts() []ContractStatus {
+	if m != nil {
+		return m.Contracts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CheckGraduationEligibility(ctx context.Context, in *QueryCheckGraduationEligibilityRequest, opts ...grpc.CallOption) (*QueryCheckGraduationEligibilityResponse, error) {
-	out := new(QueryCheckGraduationEligibilityResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Query/CheckGraduationEligibility", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIntegrationModeResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListStudents queries all students with pagination
-	ListStudents(context.Context, *QueryListStudentsRequest) (*QueryListStudentsResponse, error)
-	// GetStudent queries a student by index
-	GetStudent(context.Context, *QueryGetStudentRequest) (*QueryGetStudentResponse, error)
-	// ListEnrollments queries all enrollments with pagination
-	ListEnrollments(context.Context, *QueryListEnrollmentsRequest) (*QueryListEnrollmentsResponse, error)
-	// GetEnrollment queries an enrollment by index
-	GetEnrollment(context.Context, *QueryGetEnrollmentRequest) (*QueryGetEnrollmentResponse, error)
-	// GetEnrollmentsByStudent queries all enrollments for a specific student
-	GetEnrollmentsByStudent(context.Context, *QueryGetEnrollmentsByStudentRequest) (*QueryGetEnrollmentsByStudentResponse, error)
-	// GetStudentProgress queries academic progress for a specific student
-	GetStudentProgress(context.Context, *QueryGetStudentProgressRequest) (*QueryGetStudentProgressResponse, error)
-	// GetStudentsByInstitution queries students enrolled in a specific institution
-	GetStudentsByInstitution(context.Context, *QueryGetStudentsByInstitutionRequest) (*QueryGetStudentsByInstitutionResponse, error)
-	// GetStudentsByCourse queries students enrolled in a specific course
-	GetStudentsByCourse(context.Context, *QueryGetStudentsByCourseRequest) (*QueryGetStudentsByCourseResponse, error)
-	// GetStudentAcademicTree queries the academic tree for a specific student
-	GetStudentAcademicTree(context.Context, *QueryGetStudentAcademicTreeRequest) (*QueryGetStudentAcademicTreeResponse, error)
-	// CheckGraduationEligibility checks if a student is eligible for graduation
-	CheckGraduationEligibility(context.Context, *QueryCheckGraduationEligibilityRequest) (*QueryCheckGraduationEligibilityResponse, error)
+func (m *QueryIntegrationModeResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryTranscriptRequest is request type for the Query/Transcript RPC method.
+type QueryTranscriptRequest struct {
+	StudentId string `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Term      string `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryTranscriptRequest) Reset()         { *m = QueryTranscriptRequest{} }
+func (m *QueryTranscriptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTranscriptRequest) ProtoMessage()    {}
+func (*QueryTranscriptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{25}
 }
-func (*UnimplementedQueryServer) ListStudents(ctx context.Context, req *QueryListStudentsRequest) (*QueryListStudentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStudents not implemented")
+func (m *QueryTranscriptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetStudent(ctx context.Context, req *QueryGetStudentRequest) (*QueryGetStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudent not implemented")
+func (m *QueryTranscriptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTranscriptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListEnrollments(ctx context.Context, req *QueryListEnrollmentsRequest) (*QueryListEnrollmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEnrollments not implemented")
+func (m *QueryTranscriptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTranscriptRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetEnrollment(ctx context.Context, req *QueryGetEnrollmentRequest) (*QueryGetEnrollmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollment not implemented")
+func (m *QueryTranscriptRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetEnrollmentsByStudent(ctx context.Context, req *QueryGetEnrollmentsByStudentRequest) (*QueryGetEnrollmentsByStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByStudent not implemented")
+func (m *QueryTranscriptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTranscriptRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetStudentProgress(ctx context.Context, req *QueryGetStudentProgressRequest) (*QueryGetStudentProgressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentProgress not implemented")
+
+var xxx_messageInfo_QueryTranscriptRequest proto.InternalMessageInfo
+
+func (m *QueryTranscriptRequest) GetStudentId() string {
+	if m != nil {
+		return m.StudentId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetStudentsByInstitution(ctx context.Context, req *QueryGetStudentsByInstitutionRequest) (*QueryGetStudentsByInstitutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByInstitution not implemented")
+
+func (m *QueryTranscriptRequest) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetStudentsByCourse(ctx context.Context, req *QueryGetStudentsByCourseRequest) (*QueryGetStudentsByCourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByCourse not implemented")
+
+// QueryTranscriptResponse is response type for the Query/Transcript RPC method.
+type QueryTranscriptResponse struct {
+	StudentId           string               `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentAddress      string               `protobuf:"bytes,2,opt,name=student_address,json=studentAddress,proto3" json:"student_address,omitempty"`
+	Name                string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Institution         string               `protobuf:"bytes,4,opt,name=institution,proto3" json:"institution,omitempty"`
+	CourseId            string               `protobuf:"bytes,5,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CurriculumVersion   string               `protobuf:"bytes,6,opt,name=curriculum_version,json=curriculumVersion,proto3" json:"curriculum_version,omitempty"`
+	Semesters           []TranscriptSemester `protobuf:"bytes,7,rep,name=semesters,proto3" json:"semesters"`
+	TransferredSubjects []TransferredSubject `protobuf:"bytes,8,rep,name=transferred_subjects,json=transferredSubjects,proto3" json:"transferred_subjects"`
+	TotalCredits        uint64               `protobuf:"varint,9,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	TotalWorkloadHours  uint64               `protobuf:"varint,10,opt,name=total_workload_hours,json=totalWorkloadHours,proto3" json:"total_workload_hours,omitempty"`
+	CumulativeGpa       string               `protobuf:"bytes,11,opt,name=cumulative_gpa,json=cumulativeGpa,proto3" json:"cumulative_gpa,omitempty"`
+	GpaCredits          uint64               `protobuf:"varint,12,opt,name=gpa_credits,json=gpaCredits,proto3" json:"gpa_credits,omitempty"`
+	PrerequisiteWaivers []PrerequisiteWaiver `protobuf:"bytes,13,rep,name=prerequisite_waivers,json=prerequisiteWaivers,proto3" json:"prerequisite_waivers"`
+}
+
+func (m *QueryTranscriptResponse) Reset()         { *m = QueryTranscriptResponse{} }
+func (m *QueryTranscriptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTranscriptResponse) ProtoMessage()    {}
+func (*QueryTranscriptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{26}
+}
+func (m *QueryTranscriptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetStudentAcademicTree(ctx context.Context, req *QueryGetStudentAcademicTreeRequest) (*QueryGetStudentAcademicTreeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentAcademicTree not implemented")
+func (m *QueryTranscriptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTranscriptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CheckGraduationEligibility(ctx context.Context, req *QueryCheckGraduationEligibilityRequest) (*QueryCheckGraduationEligibilityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckGraduationEligibility not implemented")
+func (m *QueryTranscriptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTranscriptResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTranscriptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTranscriptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTranscriptResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryTranscriptResponse proto.InternalMessageInfo
+
+func (m *QueryTranscriptResponse) GetStudentId() string {
+	if m != nil {
+		return m.StudentId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListStudentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListStudents(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/ListStudents",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListStudents(ctx, req.(*QueryListStudentsRequest))
+func (m *QueryTranscriptResponse) GetStudentAddress() string {
+	if m != nil {
+		return m.StudentAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStudentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStudent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/GetStudent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStudent(ctx, req.(*QueryGetStudentRequest))
+func (m *QueryTranscriptResponse) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListEnrollmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListEnrollments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/ListEnrollments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListEnrollments(ctx, req.(*QueryListEnrollmentsRequest))
+func (m *QueryTranscriptResponse) GetInstitution() string {
+	if m != nil {
+		return m.Institution
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetEnrollmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetEnrollment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/GetEnrollment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetEnrollment(ctx, req.(*QueryGetEnrollmentRequest))
+func (m *QueryTranscriptResponse) GetCourseId() string {
+	if m != nil {
+		return m.CourseId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetEnrollmentsByStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetEnrollmentsByStudentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetEnrollmentsByStudent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/GetEnrollmentsByStudent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetEnrollmentsByStudent(ctx, req.(*QueryGetEnrollmentsByStudentRequest))
+func (m *QueryTranscriptResponse) GetCurriculumVersion() string {
+	if m != nil {
+		return m.CurriculumVersion
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetStudentProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStudentProgressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStudentProgress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/GetStudentProgress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStudentProgress(ctx, req.(*QueryGetStudentProgressRequest))
+func (m *QueryTranscriptResponse) GetSemesters() []TranscriptSemester {
+	if m != nil {
+		return m.Semesters
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetStudentsByInstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStudentsByInstitutionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStudentsByInstitution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/GetStudentsByInstitution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStudentsByInstitution(ctx, req.(*QueryGetStudentsByInstitutionRequest))
+func (m *QueryTranscriptResponse) GetTransferredSubjects() []TransferredSubject {
+	if m != nil {
+		return m.TransferredSubjects
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetStudentsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStudentsByCourseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStudentsByCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/GetStudentsByCourse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStudentsByCourse(ctx, req.(*QueryGetStudentsByCourseRequest))
+func (m *QueryTranscriptResponse) GetTotalCredits() uint64 {
+	if m != nil {
+		return m.TotalCredits
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetStudentAcademicTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStudentAcademicTreeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStudentAcademicTree(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/GetStudentAcademicTree",
+func (m *QueryTranscriptResponse) GetTotalWorkloadHours() uint64 {
+	if m != nil {
+		return m.TotalWorkloadHours
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStudentAcademicTree(ctx, req.(*QueryGetStudentAcademicTreeRequest))
+	return 0
+}
+
+func (m *QueryTranscriptResponse) GetCumulativeGpa() string {
+	if m != nil {
+		return m.CumulativeGpa
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_CheckGraduationEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCheckGraduationEligibilityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryTranscriptResponse) GetGpaCredits() uint64 {
+	if m != nil {
+		return m.GpaCredits
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CheckGraduationEligibility(ctx, in)
+	return 0
+}
+
+func (m *QueryTranscriptResponse) GetPrerequisiteWaivers() []PrerequisiteWaiver {
+	if m != nil {
+		return m.PrerequisiteWaivers
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Query/CheckGraduationEligibility",
+	return nil
+}
+
+// QuerySubjectOfferingRequest is request type for the Query/SubjectOffering RPC method.
+type QuerySubjectOfferingRequest struct {
+	OfferingId string `protobuf:"bytes,1,opt,name=offeringId,proto3" json:"offeringId,omitempty"`
+}
+
+func (m *QuerySubjectOfferingRequest) Reset()         { *m = QuerySubjectOfferingRequest{} }
+func (m *QuerySubjectOfferingRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubjectOfferingRequest) ProtoMessage()    {}
+func (*QuerySubjectOfferingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{27}
+}
+func (m *QuerySubjectOfferingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubjectOfferingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubjectOfferingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CheckGraduationEligibility(ctx, req.(*QueryCheckGraduationEligibilityRequest))
+}
+func (m *QuerySubjectOfferingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubjectOfferingRequest.Merge(m, src)
+}
+func (m *QuerySubjectOfferingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubjectOfferingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubjectOfferingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubjectOfferingRequest proto.InternalMessageInfo
+
+func (m *QuerySubjectOfferingRequest) GetOfferingId() string {
+	if m != nil {
+		return m.OfferingId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "academictoken.student.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ListStudents",
-			Handler:    _Query_ListStudents_Handler,
-		},
-		{
-			MethodName: "GetStudent",
-			Handler:    _Query_GetStudent_Handler,
-		},
-		{
-			MethodName: "ListEnrollments",
-			Handler:    _Query_ListEnrollments_Handler,
-		},
-		{
-			MethodName: "GetEnrollment",
-			Handler:    _Query_GetEnrollment_Handler,
-		},
-		{
-			MethodName: "GetEnrollmentsByStudent",
-			Handler:    _Query_GetEnrollmentsByStudent_Handler,
-		},
-		{
-			MethodName: "GetStudentProgress",
-			Handler:    _Query_GetStudentProgress_Handler,
-		},
-		{
-			MethodName: "GetStudentsByInstitution",
-			Handler:    _Query_GetStudentsByInstitution_Handler,
-		},
-		{
-			MethodName: "GetStudentsByCourse",
-			Handler:    _Query_GetStudentsByCourse_Handler,
-		},
-		{
-			MethodName: "GetStudentAcademicTree",
-			Handler:    _Query_GetStudentAcademicTree_Handler,
-		},
-		{
-			MethodName: "CheckGraduationEligibility",
-			Handler:    _Query_CheckGraduationEligibility_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "academictoken/student/query.proto",
+// QuerySubjectOfferingResponse is response type for the Query/SubjectOffering RPC method.
+type QuerySubjectOfferingResponse struct {
+	Offering SubjectOffering `protobuf:"bytes,1,opt,name=offering,proto3" json:"offering"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySubjectOfferingResponse) Reset()         { *m = QuerySubjectOfferingResponse{} }
+func (m *QuerySubjectOfferingResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubjectOfferingResponse) ProtoMessage()    {}
+func (*QuerySubjectOfferingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{28}
+}
+func (m *QuerySubjectOfferingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubjectOfferingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubjectOfferingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySubjectOfferingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubjectOfferingResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySubjectOfferingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubjectOfferingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubjectOfferingResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubjectOfferingResponse proto.InternalMessageInfo
+
+func (m *QuerySubjectOfferingResponse) GetOffering() SubjectOffering {
+	if m != nil {
+		return m.Offering
 	}
-	return dAtA[:n], nil
+	return SubjectOffering{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySubjectOfferingsRequest is request type for the Query/SubjectOfferings RPC method.
+type QuerySubjectOfferingsRequest struct {
+	SubjectId string `protobuf:"bytes,1,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	Term      string `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySubjectOfferingsRequest) Reset()         { *m = QuerySubjectOfferingsRequest{} }
+func (m *QuerySubjectOfferingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubjectOfferingsRequest) ProtoMessage()    {}
+func (*QuerySubjectOfferingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{29}
+}
+func (m *QuerySubjectOfferingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubjectOfferingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubjectOfferingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryListStudentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySubjectOfferingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubjectOfferingsRequest.Merge(m, src)
 }
-
-func (m *QueryListStudentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySubjectOfferingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubjectOfferingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubjectOfferingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListStudentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySubjectOfferingsRequest proto.InternalMessageInfo
+
+func (m *QuerySubjectOfferingsRequest) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryListStudentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySubjectOfferingsRequest) GetTerm() string {
+	if m != nil {
+		return m.Term
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListStudentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySubjectOfferingsResponse is response type for the Query/SubjectOfferings RPC method.
+type QuerySubjectOfferingsResponse struct {
+	Offerings []SubjectOffering `protobuf:"bytes,1,rep,name=offerings,proto3" json:"offerings"`
 }
 
-func (m *QueryListStudentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Students) > 0 {
-		for iNdEx := len(m.Students) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Students[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySubjectOfferingsResponse) Reset()         { *m = QuerySubjectOfferingsResponse{} }
+func (m *QuerySubjectOfferingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubjectOfferingsResponse) ProtoMessage()    {}
+func (*QuerySubjectOfferingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d66d97c81cd8b4, []int{30}
+}
+func (m *QuerySubjectOfferingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubjectOfferingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubjectOfferingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetStudentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySubjectOfferingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubjectOfferingsResponse.Merge(m, src)
 }
-
-func (m *QueryGetStudentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySubjectOfferingsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetStudentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StudentId) > 0 {
-		i -= len(m.StudentId)
-		copy(dAtA[i:], m.StudentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySubjectOfferingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubjectOfferingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetStudentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubjectOfferingsResponse proto.InternalMessageInfo
+
+func (m *QuerySubjectOfferingsResponse) GetOfferings() []SubjectOffering {
+	if m != nil {
+		return m.Offerings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetStudentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "academictoken.student.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "academictoken.student.QueryParamsResponse")
+	proto.RegisterType((*QueryListStudentsRequest)(nil), "academictoken.student.QueryListStudentsRequest")
+	proto.RegisterType((*QueryListStudentsResponse)(nil), "academictoken.student.QueryListStudentsResponse")
+	proto.RegisterType((*QueryGetStudentRequest)(nil), "academictoken.student.QueryGetStudentRequest")
+	proto.RegisterType((*QueryGetStudentResponse)(nil), "academictoken.student.QueryGetStudentResponse")
+	proto.RegisterType((*QueryListEnrollmentsRequest)(nil), "academictoken.student.QueryListEnrollmentsRequest")
+	proto.RegisterType((*QueryListEnrollmentsResponse)(nil), "academictoken.student.QueryListEnrollmentsResponse")
+	proto.RegisterType((*QueryGetEnrollmentRequest)(nil), "academictoken.student.QueryGetEnrollmentRequest")
+	proto.RegisterType((*QueryGetEnrollmentResponse)(nil), "academictoken.student.QueryGetEnrollmentResponse")
+	proto.RegisterType((*QueryGetEnrollmentsByStudentRequest)(nil), "academictoken.student.QueryGetEnrollmentsByStudentRequest")
+	proto.RegisterType((*QueryGetEnrollmentsByStudentResponse)(nil), "academictoken.student.QueryGetEnrollmentsByStudentResponse")
+	proto.RegisterType((*QueryGetStudentProgressRequest)(nil), "academictoken.student.QueryGetStudentProgressRequest")
+	proto.RegisterType((*QueryGetStudentProgressResponse)(nil), "academictoken.student.QueryGetStudentProgressResponse")
+	proto.RegisterType((*QueryGetStudentsByInstitutionRequest)(nil), "academictoken.student.QueryGetStudentsByInstitutionRequest")
+	proto.RegisterType((*QueryGetStudentsByInstitutionResponse)(nil), "academictoken.student.QueryGetStudentsByInstitutionResponse")
+	proto.RegisterType((*QueryGetStudentsByCourseRequest)(nil), "academictoken.student.QueryGetStudentsByCourseRequest")
+	proto.RegisterType((*QueryGetStudentsByCourseResponse)(nil), "academictoken.student.QueryGetStudentsByCourseResponse")
+	proto.RegisterType((*QueryGetStudentAcademicTreeRequest)(nil), "academictoken.student.QueryGetStudentAcademicTreeRequest")
+	proto.RegisterType((*QueryGetStudentAcademicTreeResponse)(nil), "academictoken.student.QueryGetStudentAcademicTreeResponse")
+	proto.RegisterType((*QueryCheckGraduationEligibilityRequest)(nil), "academictoken.student.QueryCheckGraduationEligibilityRequest")
+	proto.RegisterType((*QueryCheckGraduationEligibilityResponse)(nil), "academictoken.student.QueryCheckGraduationEligibilityResponse")
+	proto.RegisterType((*QueryIntegrationModeRequest)(nil), "academictoken.student.QueryIntegrationModeRequest")
+	proto.RegisterType((*ContractStatus)(nil), "academictoken.student.ContractStatus")
+	proto.RegisterType((*QueryIntegrationModeResponse)(nil), "academictoken.student.QueryIntegrationModeResponse")
+	proto.RegisterType((*QueryTranscriptRequest)(nil), "academictoken.student.QueryTranscriptRequest")
+	proto.RegisterType((*QueryTranscriptResponse)(nil), "academictoken.student.QueryTranscriptResponse")
+	proto.RegisterType((*QuerySubjectOfferingRequest)(nil), "academictoken.student.QuerySubjectOfferingRequest")
+	proto.RegisterType((*QuerySubjectOfferingResponse)(nil), "academictoken.student.QuerySubjectOfferingResponse")
+	proto.RegisterType((*QuerySubjectOfferingsRequest)(nil), "academictoken.student.QuerySubjectOfferingsRequest")
+	proto.RegisterType((*QuerySubjectOfferingsResponse)(nil), "academictoken.student.QuerySubjectOfferingsResponse")
 }
 
-func (m *QueryGetStudentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Student.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("academictoken/student/query.proto", fileDescriptor_70d66d97c81cd8b4) }
+
+var fileDescriptor_70d66d97c81cd8b4 = []byte{
+	// 1826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1b, 0x5b,
+	0x15, 0xcf, 0xa4, 0x49, 0x6a, 0x9f, 0x24, 0xcd, 0xeb, 0x6d, 0xde, 0x7b, 0x66, 0x5e, 0xe2, 0xe4,
+	0x4d, 0x5f, 0x9a, 0xbc, 0xe8, 0xc5, 0xd3, 0xa4, 0xcd, 0x0b, 0x2f, 0x6d, 0xfa, 0x91, 0x28, 0xa4,
+	0xa6, 0x14, 0x82, 0x53, 0xa8, 0xe8, 0xc6, 0x1a, 0xdb, 0x37, 0xd3, 0x21, 0xf6, 0x8c, 0x3b, 0x77,
+	0xdc, 0x12, 0x42, 0x36, 0x2c, 0xba, 0x62, 0x81, 0x84, 0x58, 0xc0, 0x8e, 0x4d, 0x41, 0x02, 0xa4,
+	0xc2, 0x8e, 0x1d, 0x1f, 0x9b, 0x2e, 0x2b, 0x95, 0x05, 0x0b, 0x84, 0x50, 0x8b, 0x04, 0xe2, 0xaf,
+	0x78, 0x9a, 0x3b, 0xe7, 0xce, 0x87, 0x3d, 0x33, 0xb6, 0xa3, 0x2c, 0xba, 0x69, 0xed, 0x73, 0xcf,
+	0xc7, 0xef, 0x77, 0xce, 0xfd, 0x38, 0x27, 0x86, 0x8f, 0xb5, 0xaa, 0x56, 0xa3, 0x0d, 0xa3, 0xea,
+	0x58, 0x07, 0xd4, 0x54, 0x99, 0xd3, 0xaa, 0x51, 0xd3, 0x51, 0x1f, 0xb7, 0xa8, 0x7d, 0x58, 0x68,
+	0xda, 0x96, 0x63, 0x91, 0xf7, 0x23, 0x2a, 0x05, 0x54, 0x91, 0xcf, 0x6b, 0x0d, 0xc3, 0xb4, 0x54,
+	0xfe, 0xaf, 0xa7, 0x29, 0x4f, 0xea, 0x96, 0x6e, 0xf1, 0x8f, 0xaa, 0xfb, 0x09, 0xa5, 0x53, 0xba,
+	0x65, 0xe9, 0x75, 0xaa, 0x6a, 0x4d, 0x43, 0xd5, 0x4c, 0xd3, 0x72, 0x34, 0xc7, 0xb0, 0x4c, 0x86,
+	0xab, 0x8b, 0x55, 0x8b, 0x35, 0x2c, 0xa6, 0x56, 0x34, 0x46, 0xbd, 0xb0, 0xea, 0x93, 0xe5, 0x0a,
+	0x75, 0xb4, 0x65, 0xb5, 0xa9, 0xe9, 0x86, 0xc9, 0x95, 0x51, 0x57, 0x89, 0x07, 0xdb, 0xd4, 0x6c,
+	0xad, 0x21, 0xfc, 0x5d, 0x8c, 0xd7, 0xc1, 0xff, 0x51, 0xa9, 0x90, 0xaa, 0x54, 0xa6, 0xa6, 0x6d,
+	0xd5, 0xeb, 0x8d, 0x40, 0x7f, 0x39, 0x5d, 0x5f, 0xac, 0x96, 0x1d, 0x9b, 0x52, 0x34, 0x59, 0x8a,
+	0x37, 0xf1, 0x55, 0x9b, 0xb6, 0xa5, 0xdb, 0x94, 0xb1, 0x74, 0x75, 0xdd, 0xd6, 0x6a, 0x2d, 0x9e,
+	0x82, 0x32, 0x73, 0x34, 0xa7, 0x25, 0xd4, 0x2f, 0xc5, 0xab, 0x3b, 0xb6, 0x66, 0xb2, 0xaa, 0x6d,
+	0x34, 0x05, 0xf0, 0xcf, 0x12, 0x80, 0xb7, 0x2a, 0xdf, 0xa7, 0x55, 0xa7, 0x6c, 0xed, 0xef, 0x53,
+	0xdb, 0x30, 0x75, 0x4f, 0x5b, 0x99, 0x04, 0xf2, 0x6d, 0xb7, 0x02, 0xbb, 0x3c, 0xa1, 0x25, 0xfa,
+	0xb8, 0x45, 0x99, 0xa3, 0x3c, 0x80, 0x0b, 0x11, 0x29, 0x6b, 0x5a, 0x26, 0xa3, 0xe4, 0x16, 0x8c,
+	0x78, 0x89, 0xcf, 0x49, 0xb3, 0xd2, 0xc2, 0xe8, 0xca, 0x74, 0x21, 0x76, 0x9f, 0x14, 0x3c, 0xb3,
+	0xcd, 0xec, 0xcb, 0x7f, 0xcd, 0x0c, 0xfc, 0xe6, 0xbf, 0x2f, 0x16, 0xa5, 0x12, 0xda, 0x29, 0x15,
+	0xc8, 0x71, 0xc7, 0xdf, 0x30, 0x98, 0xb3, 0xe7, 0x69, 0x8b, 0xa0, 0xe4, 0x6b, 0x00, 0x41, 0xf9,
+	0x31, 0xc2, 0xa5, 0x82, 0xb7, 0x57, 0x0a, 0xee, 0x5e, 0x29, 0x78, 0x5b, 0x14, 0xf7, 0x4a, 0x61,
+	0x57, 0xd3, 0x29, 0xda, 0x96, 0x42, 0x96, 0xca, 0x73, 0x09, 0xbe, 0x12, 0x13, 0xc4, 0xe7, 0x90,
+	0x41, 0x98, 0x2e, 0x8b, 0x33, 0x0b, 0xa3, 0x2b, 0xf9, 0x04, 0x16, 0x68, 0xba, 0x39, 0xe4, 0xd2,
+	0x28, 0xf9, 0x56, 0x64, 0x27, 0x82, 0x73, 0x90, 0xe3, 0x9c, 0xef, 0x8a, 0xd3, 0x0b, 0x1f, 0x01,
+	0xba, 0x06, 0x1f, 0x70, 0x9c, 0x3b, 0x54, 0xc0, 0x14, 0xa9, 0x98, 0x06, 0x10, 0x1b, 0xcd, 0xa8,
+	0xf1, 0x54, 0x64, 0x4b, 0x59, 0x94, 0x14, 0x6b, 0xca, 0xf7, 0xe0, 0xc3, 0x0e, 0x43, 0xa4, 0x77,
+	0x03, 0xce, 0xa2, 0x1e, 0x66, 0xb0, 0x37, 0x76, 0xc2, 0x48, 0xa1, 0xf0, 0x91, 0x9f, 0xbb, 0x6d,
+	0xff, 0x4c, 0x9c, 0x7a, 0x8d, 0xfe, 0x24, 0xc1, 0x54, 0x7c, 0x1c, 0xe4, 0xb1, 0x0b, 0xa3, 0xc1,
+	0x91, 0x14, 0x95, 0x5a, 0x48, 0xe7, 0x12, 0xf8, 0x41, 0x56, 0x61, 0x17, 0xa7, 0x57, 0xb6, 0x5b,
+	0xb8, 0xbd, 0x76, 0x68, 0x28, 0xa2, 0x48, 0xd0, 0x45, 0x18, 0x0f, 0x82, 0x06, 0xc5, 0x1b, 0x0b,
+	0x84, 0xc5, 0x9a, 0x52, 0x07, 0x39, 0xce, 0x03, 0x52, 0xff, 0x26, 0x40, 0xa0, 0x8d, 0x39, 0xee,
+	0x97, 0x79, 0xc8, 0x83, 0xf2, 0x13, 0x09, 0x2e, 0x76, 0x86, 0x63, 0x9b, 0x87, 0x7d, 0x6d, 0xba,
+	0xb6, 0xd2, 0x0f, 0x9e, 0xb8, 0xf4, 0x7f, 0x91, 0xe0, 0x93, 0x74, 0x38, 0xef, 0xfe, 0x16, 0xb8,
+	0x09, 0xf9, 0xb6, 0x03, 0xb8, 0x8b, 0x77, 0x7b, 0x8f, 0x27, 0xb8, 0x0e, 0x33, 0x89, 0x0e, 0x90,
+	0x7e, 0x11, 0x32, 0xe2, 0xc1, 0xc0, 0x4d, 0x30, 0x9f, 0xc0, 0xfd, 0x36, 0x4a, 0x85, 0x0b, 0x71,
+	0x63, 0x09, 0x73, 0xe5, 0xe7, 0xa1, 0x94, 0x8b, 0x0b, 0x71, 0xf3, 0xb0, 0x68, 0x32, 0xc7, 0x70,
+	0x5a, 0x2e, 0x21, 0x81, 0x7a, 0x0e, 0xce, 0x19, 0x81, 0x34, 0x40, 0x3e, 0x1e, 0x92, 0x9e, 0xe2,
+	0x56, 0xf8, 0xa3, 0x04, 0x73, 0x5d, 0x70, 0xbd, 0x7b, 0xb7, 0xf6, 0x33, 0xa9, 0xa3, 0x76, 0x6c,
+	0xf3, 0x70, 0xcb, 0x6a, 0xd9, 0x4c, 0x90, 0x24, 0x1f, 0x41, 0xb6, 0xca, 0x05, 0x41, 0x0a, 0x33,
+	0x9e, 0xe0, 0x14, 0xb3, 0xf7, 0x7b, 0x09, 0x66, 0x93, 0x81, 0xbc, 0x7b, 0x89, 0xdb, 0x02, 0xa5,
+	0x0d, 0xae, 0xd8, 0xb8, 0xf7, 0x6d, 0x4a, 0x7b, 0x3c, 0x38, 0x3f, 0x0a, 0xee, 0xb2, 0x58, 0x27,
+	0x48, 0xfb, 0x3b, 0x30, 0x1e, 0xe9, 0xd0, 0xf0, 0x04, 0x2d, 0xa6, 0x73, 0x0f, 0xbb, 0xc2, 0x3c,
+	0x8c, 0x69, 0x21, 0x99, 0xb2, 0x03, 0x97, 0x78, 0xf4, 0xad, 0x47, 0xb4, 0x7a, 0xb0, 0xe3, 0x37,
+	0x6a, 0xdb, 0x75, 0x43, 0x37, 0x2a, 0x46, 0xdd, 0x70, 0x0e, 0x7b, 0xa4, 0xf1, 0x67, 0x09, 0xe6,
+	0xbb, 0x7a, 0x42, 0x2e, 0x0f, 0xe1, 0x7c, 0x47, 0x4f, 0xd8, 0xe5, 0x46, 0x08, 0x1c, 0xee, 0x71,
+	0x75, 0x24, 0xf3, 0x9e, 0xde, 0x26, 0x27, 0x33, 0x30, 0x6a, 0xb0, 0x32, 0xe5, 0x51, 0xeb, 0x94,
+	0x57, 0x37, 0x53, 0x02, 0x83, 0x6d, 0xa3, 0x84, 0xe4, 0xe0, 0x6c, 0x83, 0x32, 0xa6, 0xe9, 0x34,
+	0x77, 0x86, 0x93, 0x10, 0x5f, 0x95, 0x69, 0xec, 0x14, 0x8a, 0xa6, 0x43, 0x75, 0x9b, 0x3b, 0xbd,
+	0x67, 0xd5, 0x44, 0x1d, 0x95, 0x1f, 0xc2, 0xb9, 0x2d, 0xcb, 0x74, 0x6c, 0xad, 0xea, 0x60, 0x2c,
+	0x02, 0x43, 0xa6, 0xd6, 0xa0, 0x98, 0x0c, 0xfe, 0xd9, 0x75, 0xaf, 0xd5, 0x6a, 0xfc, 0x8e, 0x1b,
+	0xf4, 0xdc, 0xe3, 0x57, 0x92, 0x07, 0xa8, 0x5a, 0xe6, 0xbe, 0xa1, 0xb7, 0x6c, 0x5a, 0xe3, 0xb1,
+	0x33, 0xa5, 0x90, 0x84, 0xc8, 0x90, 0xb1, 0xe9, 0xe3, 0x96, 0xe1, 0xae, 0x0e, 0xf1, 0x55, 0xff,
+	0xbb, 0x7b, 0x32, 0xa6, 0xe2, 0xb1, 0x61, 0x4a, 0x09, 0x0c, 0x35, 0xac, 0x9a, 0x0f, 0xc5, 0xfd,
+	0x4c, 0x8a, 0xee, 0x99, 0xf5, 0x00, 0xbb, 0x60, 0xdc, 0xa3, 0x32, 0x97, 0x90, 0xde, 0x28, 0x31,
+	0x4c, 0x6e, 0x60, 0x4d, 0x26, 0x61, 0xd8, 0xa6, 0x5a, 0xed, 0x10, 0x61, 0x7b, 0x5f, 0xc2, 0xa9,
+	0x1c, 0x8a, 0xa6, 0xf2, 0x2e, 0x36, 0x82, 0xf7, 0xfd, 0x5e, 0xbe, 0xc7, 0x37, 0x99, 0xc0, 0x90,
+	0x43, 0xed, 0x06, 0xe6, 0x8e, 0x7f, 0x56, 0x7e, 0x31, 0x8c, 0xdd, 0x61, 0xd8, 0x1b, 0xf2, 0xee,
+	0xe2, 0x6e, 0x1e, 0x26, 0xfc, 0xf9, 0x26, 0x52, 0x95, 0x73, 0x28, 0xbe, 0x8d, 0xc5, 0x11, 0xa5,
+	0x3c, 0x13, 0x2a, 0xe5, 0x2c, 0x8c, 0x86, 0x5e, 0x09, 0xa4, 0x18, 0x16, 0x45, 0x6f, 0xc5, 0xe1,
+	0xb6, 0x5b, 0x71, 0x09, 0x48, 0xb5, 0x65, 0xdb, 0x46, 0xb5, 0x55, 0x6f, 0x35, 0xca, 0x4f, 0xa8,
+	0xcd, 0x5c, 0x2f, 0x23, 0x5c, 0xeb, 0x7c, 0xb0, 0xf2, 0x5d, 0x6f, 0x81, 0xdc, 0x83, 0x2c, 0xa3,
+	0x0d, 0xca, 0x1c, 0x6a, 0xb3, 0xdc, 0x59, 0x5e, 0xad, 0x4f, 0x13, 0xaa, 0x15, 0xe4, 0x61, 0x0f,
+	0x2d, 0x44, 0xc5, 0x7c, 0x0f, 0xa4, 0x02, 0x93, 0x7c, 0x90, 0xda, 0xa7, 0xb6, 0x4d, 0x6b, 0x65,
+	0x1c, 0x96, 0x58, 0x2e, 0xd3, 0xdd, 0xb3, 0x67, 0xb2, 0xe7, 0x59, 0xa0, 0xe7, 0x0b, 0x4e, 0xc7,
+	0x0a, 0x73, 0x5b, 0x43, 0xc7, 0x72, 0xb4, 0x7a, 0xb9, 0x6a, 0xd3, 0x9a, 0xe1, 0xb0, 0x5c, 0x76,
+	0x56, 0x5a, 0x18, 0x2a, 0x8d, 0x71, 0xe1, 0x96, 0x27, 0x23, 0x97, 0x61, 0xd2, 0x53, 0x7a, 0x6a,
+	0xd9, 0x07, 0x75, 0x4b, 0xab, 0x95, 0x1f, 0xb9, 0x19, 0xca, 0x01, 0xd7, 0x25, 0x7c, 0xed, 0x01,
+	0x2e, 0xdd, 0x71, 0x57, 0xdc, 0x37, 0xbb, 0xda, 0x6a, 0xb4, 0xea, 0x9a, 0x63, 0x3c, 0xa1, 0x65,
+	0xbd, 0xa9, 0xe5, 0x46, 0xbd, 0x37, 0x3b, 0x90, 0xee, 0x34, 0x35, 0xf7, 0xa4, 0xeb, 0x4d, 0xcd,
+	0x8f, 0x3d, 0xc6, 0xfd, 0x81, 0xde, 0xd4, 0x44, 0xe4, 0x0a, 0x4c, 0x36, 0x6d, 0xca, 0xcf, 0x10,
+	0x33, 0x1c, 0x5a, 0x7e, 0xaa, 0x19, 0x6e, 0x15, 0x72, 0xe3, 0xa9, 0x29, 0xd8, 0x0d, 0x99, 0x3c,
+	0xe0, 0x16, 0x22, 0x05, 0xcd, 0x8e, 0x15, 0xa6, 0x6c, 0xe0, 0x9d, 0x81, 0x39, 0xf9, 0x16, 0xce,
+	0xa2, 0x62, 0xb7, 0xe7, 0x01, 0xc4, 0x78, 0x5a, 0x14, 0xdb, 0x33, 0x24, 0x51, 0x1e, 0xe1, 0xb1,
+	0xee, 0x30, 0xc7, 0xed, 0x7d, 0x07, 0x32, 0x42, 0xdb, 0x9f, 0x4d, 0x12, 0x2e, 0xfc, 0xa8, 0x07,
+	0xf1, 0xe8, 0x09, 0x6b, 0x65, 0x37, 0x3e, 0x92, 0xdf, 0xde, 0x4d, 0x41, 0x16, 0xf7, 0x48, 0x31,
+	0x38, 0x47, 0x42, 0x10, 0x7b, 0x2c, 0x0f, 0x60, 0x3a, 0xc1, 0x23, 0x82, 0xff, 0x3a, 0x64, 0x45,
+	0x78, 0xf1, 0x54, 0xf7, 0x87, 0x3e, 0x30, 0x5f, 0xf9, 0xff, 0xfb, 0x30, 0xcc, 0xa3, 0x91, 0x67,
+	0x12, 0x8c, 0x78, 0xe3, 0x38, 0x49, 0x2a, 0x61, 0xe7, 0xfc, 0x2f, 0x2f, 0xf6, 0xa2, 0xea, 0xe1,
+	0x56, 0xe6, 0x7e, 0xfc, 0xfa, 0x3f, 0x3f, 0x1b, 0x9c, 0x21, 0xd3, 0x6a, 0xda, 0x9f, 0x6a, 0xc8,
+	0x2f, 0x25, 0x18, 0x0b, 0x0f, 0xe4, 0x44, 0x4d, 0x8b, 0x11, 0xf3, 0xf7, 0x01, 0xf9, 0x72, 0xef,
+	0x06, 0x08, 0x6d, 0x9e, 0x43, 0xfb, 0x98, 0xcc, 0xa8, 0xa9, 0x7f, 0xcc, 0x61, 0xe4, 0x57, 0x12,
+	0x40, 0xd0, 0x51, 0x90, 0xa5, 0xb4, 0x48, 0x1d, 0xd3, 0xba, 0x5c, 0xe8, 0x55, 0x1d, 0x61, 0x5d,
+	0xe5, 0xb0, 0x0a, 0xe4, 0xb3, 0x2e, 0xb0, 0xd4, 0xa3, 0xe0, 0xb2, 0x3e, 0x26, 0xcf, 0x25, 0x98,
+	0x68, 0x9b, 0x96, 0xc9, 0x4a, 0xb7, 0x94, 0x74, 0x8e, 0xf0, 0xf2, 0x95, 0xbe, 0x6c, 0x10, 0xf2,
+	0x22, 0x87, 0xfc, 0x09, 0x51, 0x12, 0x20, 0x87, 0xa7, 0xac, 0x17, 0x12, 0x8c, 0x47, 0x66, 0x3b,
+	0x72, 0xb9, 0x4b, 0x82, 0x3a, 0xc6, 0x68, 0x79, 0xb9, 0x0f, 0x0b, 0x84, 0xb8, 0xce, 0x21, 0x5e,
+	0x25, 0x2b, 0xdd, 0x21, 0xaa, 0x47, 0x91, 0x19, 0xfd, 0x98, 0xfc, 0x5d, 0x82, 0x0f, 0x13, 0xc6,
+	0x51, 0xb2, 0xde, 0x33, 0x94, 0x8e, 0x91, 0x5a, 0xbe, 0x76, 0x22, 0x5b, 0x24, 0x74, 0x8b, 0x13,
+	0x5a, 0x27, 0x5f, 0xed, 0x67, 0x9b, 0x44, 0x2a, 0xf1, 0x57, 0x09, 0x48, 0xe7, 0x84, 0x49, 0x56,
+	0x7b, 0xdb, 0xaf, 0x6d, 0x23, 0xad, 0xfc, 0x79, 0xbf, 0x66, 0xc8, 0x63, 0x83, 0xf3, 0x58, 0x23,
+	0xab, 0x7d, 0xf1, 0x10, 0xc3, 0x2b, 0xf9, 0xa7, 0x04, 0xb9, 0xa4, 0xf9, 0x90, 0x5c, 0xeb, 0x0d,
+	0x53, 0xec, 0xb4, 0x2b, 0x5f, 0x3f, 0x99, 0x31, 0xd2, 0xda, 0xe6, 0xb4, 0x6e, 0x92, 0x8d, 0x04,
+	0x5a, 0xa1, 0xce, 0x87, 0xa9, 0x47, 0xd1, 0xb1, 0xfa, 0x38, 0xb8, 0x7a, 0xfe, 0x26, 0xc1, 0x85,
+	0x98, 0x01, 0x8e, 0x7c, 0xde, 0x33, 0xb8, 0xc8, 0xe8, 0x29, 0xaf, 0xf5, 0x6d, 0x87, 0x7c, 0xae,
+	0x71, 0x3e, 0xab, 0xe4, 0x4a, 0x02, 0x1f, 0xaf, 0x53, 0x63, 0xea, 0x91, 0xdf, 0xc3, 0x85, 0x58,
+	0xbc, 0x96, 0xe0, 0x83, 0xf8, 0x91, 0x8c, 0x7c, 0xd1, 0x1b, 0xa0, 0x98, 0x59, 0x50, 0x5e, 0x3f,
+	0x89, 0x29, 0xd2, 0xd9, 0xe4, 0x74, 0xae, 0x93, 0xf5, 0xbe, 0x76, 0x9d, 0xd0, 0x5d, 0x72, 0x87,
+	0x46, 0xf2, 0x3f, 0x09, 0xe4, 0xe4, 0x01, 0x8d, 0x6c, 0xa4, 0xc1, 0xeb, 0x3a, 0x22, 0xca, 0x37,
+	0x4e, 0x6a, 0x8e, 0x0c, 0xef, 0x72, 0x86, 0xdb, 0x64, 0xab, 0x2f, 0x86, 0xc1, 0x08, 0xb8, 0x44,
+	0x43, 0x5c, 0x7e, 0x2b, 0xc1, 0x44, 0xdb, 0xb4, 0x94, 0xfe, 0xba, 0xc4, 0x8f, 0x7d, 0xe9, 0xaf,
+	0x4b, 0xc2, 0x38, 0xa6, 0xa8, 0x9c, 0xc9, 0xa7, 0x64, 0x3e, 0xf1, 0x28, 0xf9, 0x76, 0x4b, 0x7c,
+	0x56, 0xfb, 0x9d, 0x04, 0x10, 0xb4, 0xf5, 0xe9, 0xef, 0x75, 0xc7, 0x50, 0x95, 0xfe, 0x5e, 0x77,
+	0x4e, 0x4d, 0xca, 0x4d, 0x0e, 0xef, 0x0b, 0xb2, 0xd6, 0x57, 0xa2, 0x83, 0x1f, 0x66, 0xc8, 0x1f,
+	0x24, 0x98, 0x68, 0xeb, 0xd9, 0xd2, 0x93, 0x1b, 0xdf, 0x1f, 0xa7, 0x27, 0x37, 0xa1, 0x29, 0x56,
+	0x56, 0x39, 0x7a, 0x95, 0x2c, 0x25, 0xa0, 0xf7, 0xbb, 0x46, 0xf5, 0x28, 0x68, 0xb5, 0x8f, 0xc9,
+	0xaf, 0x25, 0x78, 0xaf, 0xbd, 0x57, 0x25, 0xfd, 0x00, 0xf0, 0xdf, 0x8d, 0xab, 0xfd, 0x19, 0x21,
+	0xec, 0x05, 0x0e, 0x5b, 0x21, 0xb3, 0xdd, 0x60, 0x6f, 0xae, 0xbd, 0x7c, 0x93, 0x97, 0x5e, 0xbd,
+	0xc9, 0x4b, 0xff, 0x7e, 0x93, 0x97, 0x7e, 0xfa, 0x36, 0x3f, 0xf0, 0xea, 0x6d, 0x7e, 0xe0, 0x1f,
+	0x6f, 0xf3, 0x03, 0x0f, 0xa7, 0xa3, 0xa6, 0x3f, 0x08, 0x7e, 0x34, 0x3b, 0x6c, 0x52, 0x56, 0x19,
+	0xe1, 0x3f, 0x81, 0x5d, 0xf9, 0x32, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x6e, 0x87, 0x45, 0x11, 0x1d,
+	0x00, 0x00,
 }
 
-func (m *QueryListEnrollmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListStudents queries all students with pagination
+	ListStudents(ctx context.Context, in *QueryListStudentsRequest, opts ...grpc.CallOption) (*QueryListStudentsResponse, error)
+	// GetStudent queries a student by index
+	GetStudent(ctx context.Context, in *QueryGetStudentRequest, opts ...grpc.CallOption) (*QueryGetStudentResponse, error)
+	// ListEnrollments queries all enrollments with pagination
+	ListEnrollments(ctx context.Context, in *QueryListEnrollmentsRequest, opts ...grpc.CallOption) (*QueryListEnrollmentsResponse, error)
+	// GetEnrollment queries an enrollment by index
+	GetEnrollment(ctx context.Context, in *QueryGetEnrollmentRequest, opts ...grpc.CallOption) (*QueryGetEnrollmentResponse, error)
+	// GetEnrollmentsByStudent queries all enrollments for a specific student
+	GetEnrollmentsByStudent(ctx context.Context, in *QueryGetEnrollmentsByStudentRequest, opts ...grpc.CallOption) (*QueryGetEnrollmentsByStudentResponse, error)
+	// GetStudentProgress queries academic progress for a specific student
+	GetStudentProgress(ctx context.Context, in *QueryGetStudentProgressRequest, opts ...grpc.CallOption) (*QueryGetStudentProgressResponse, error)
+	// GetStudentsByInstitution queries students enrolled in a specific institution
+	GetStudentsByInstitution(ctx context.Context, in *QueryGetStudentsByInstitutionRequest, opts ...grpc.CallOption) (*QueryGetStudentsByInstitutionResponse, error)
+	// GetStudentsByCourse queries students enrolled in a specific course
+	GetStudentsByCourse(ctx context.Context, in *QueryGetStudentsByCourseRequest, opts ...grpc.CallOption) (*QueryGetStudentsByCourseResponse, error)
+	// GetStudentAcademicTree queries the academic tree for a specific student
+	GetStudentAcademicTree(ctx context.Context, in *QueryGetStudentAcademicTreeRequest, opts ...grpc.CallOption) (*QueryGetStudentAcademicTreeResponse, error)
+	// CheckGraduationEligibility checks if a student is eligible for graduation
+	CheckGraduationEligibility(ctx context.Context, in *QueryCheckGraduationEligibilityRequest, opts ...grpc.CallOption) (*QueryCheckGraduationEligibilityResponse, error)
+	// IntegrationMode reports how the module evaluates academic progress and
+	// which contracts it is configured to call
+	IntegrationMode(ctx context.Context, in *QueryIntegrationModeRequest, opts ...grpc.CallOption) (*QueryIntegrationModeResponse, error)
+	// Transcript aggregates the subjects completed by a student per semester
+	// with their grades, credits and GPA
+	Transcript(ctx context.Context, in *QueryTranscriptRequest, opts ...grpc.CallOption) (*QueryTranscriptResponse, error)
+	// SubjectOffering returns an offering with its enrolled students and waitlist
+	SubjectOffering(ctx context.Context, in *QuerySubjectOfferingRequest, opts ...grpc.CallOption) (*QuerySubjectOfferingResponse, error)
+	// SubjectOfferings lists the offerings, optionally of a subject or a term
+	SubjectOfferings(ctx context.Context, in *QuerySubjectOfferingsRequest, opts ...grpc.CallOption) (*QuerySubjectOfferingsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListEnrollmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListStudents(ctx context.Context, in *QueryListStudentsRequest, opts ...grpc.CallOption) (*QueryListStudentsResponse, error) {
+	out := new(QueryListStudentsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/ListStudents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListEnrollmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetStudent(ctx context.Context, in *QueryGetStudentRequest, opts ...grpc.CallOption) (*QueryGetStudentResponse, error) {
+	out := new(QueryGetStudentResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListEnrollmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListEnrollments(ctx context.Context, in *QueryListEnrollmentsRequest, opts ...grpc.CallOption) (*QueryListEnrollmentsResponse, error) {
+	out := new(QueryListEnrollmentsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/ListEnrollments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListEnrollmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetEnrollment(ctx context.Context, in *QueryGetEnrollmentRequest, opts ...grpc.CallOption) (*QueryGetEnrollmentResponse, error) {
+	out := new(QueryGetEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListEnrollmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) GetEnrollmentsByStudent(ctx context.Context, in *QueryGetEnrollmentsByStudentRequest, opts ...grpc.CallOption) (*QueryGetEnrollmentsByStudentResponse, error) {
+	out := new(QueryGetEnrollmentsByStudentResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetEnrollmentsByStudent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Enrollments) > 0 {
-		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) GetStudentProgress(ctx context.Context, in *QueryGetStudentProgressRequest, opts ...grpc.CallOption) (*QueryGetStudentProgressResponse, error) {
+	out := new(QueryGetStudentProgressResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudentProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStudentsByInstitution(ctx context.Context, in *QueryGetStudentsByInstitutionRequest, opts ...grpc.CallOption) (*QueryGetStudentsByInstitutionResponse, error) {
+	out := new(QueryGetStudentsByInstitutionResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudentsByInstitution", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStudentsByCourse(ctx context.Context, in *QueryGetStudentsByCourseRequest, opts ...grpc.CallOption) (*QueryGetStudentsByCourseResponse, error) {
+	out := new(QueryGetStudentsByCourseResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudentsByCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EnrollmentId) > 0 {
-		i -= len(m.EnrollmentId)
-		copy(dAtA[i:], m.EnrollmentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EnrollmentId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetStudentAcademicTree(ctx context.Context, in *QueryGetStudentAcademicTreeRequest, opts ...grpc.CallOption) (*QueryGetStudentAcademicTreeResponse, error) {
+	out := new(QueryGetStudentAcademicTreeResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/GetStudentAcademicTree", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CheckGraduationEligibility(ctx context.Context, in *QueryCheckGraduationEligibilityRequest, opts ...grpc.CallOption) (*QueryCheckGraduationEligibilityResponse, error) {
+	out := new(QueryCheckGraduationEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/CheckGraduationEligibility", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IntegrationMode(ctx context.Context, in *QueryIntegrationModeRequest, opts ...grpc.CallOption) (*QueryIntegrationModeResponse, error) {
+	out := new(QueryIntegrationModeResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/IntegrationMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Enrollment.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Transcript(ctx context.Context, in *QueryTranscriptRequest, opts ...grpc.CallOption) (*QueryTranscriptResponse, error) {
+	out := new(QueryTranscriptResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/Transcript", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentsByStudentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SubjectOffering(ctx context.Context, in *QuerySubjectOfferingRequest, opts ...grpc.CallOption) (*QuerySubjectOfferingResponse, error) {
+	out := new(QuerySubjectOfferingResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/SubjectOffering", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentsByStudentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SubjectOfferings(ctx context.Context, in *QuerySubjectOfferingsRequest, opts ...grpc.CallOption) (*QuerySubjectOfferingsResponse, error) {
+	out := new(QuerySubjectOfferingsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Query/SubjectOfferings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetEnrollmentsByStudentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StudentId) > 0 {
-		i -= len(m.StudentId)
-		copy(dAtA[i:], m.StudentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListStudents queries all students with pagination
+	ListStudents(context.Context, *QueryListStudentsRequest) (*QueryListStudentsResponse, error)
+	// GetStudent queries a student by index
+	GetStudent(context.Context, *QueryGetStudentRequest) (*QueryGetStudentResponse, error)
+	// ListEnrollments queries all enrollments with pagination
+	ListEnrollments(context.Context, *QueryListEnrollmentsRequest) (*QueryListEnrollmentsResponse, error)
+	// GetEnrollment queries an enrollment by index
+	GetEnrollment(context.Context, *QueryGetEnrollmentRequest) (*QueryGetEnrollmentResponse, error)
+	// GetEnrollmentsByStudent queries all enrollments for a specific student
+	GetEnrollmentsByStudent(context.Context, *QueryGetEnrollmentsByStudentRequest) (*QueryGetEnrollmentsByStudentResponse, error)
+	// GetStudentProgress queries academic progress for a specific student
+	GetStudentProgress(context.Context, *QueryGetStudentProgressRequest) (*QueryGetStudentProgressResponse, error)
+	// GetStudentsByInstitution queries students enrolled in a specific institution
+	GetStudentsByInstitution(context.Context, *QueryGetStudentsByInstitutionRequest) (*QueryGetStudentsByInstitutionResponse, error)
+	// GetStudentsByCourse queries students enrolled in a specific course
+	GetStudentsByCourse(context.Context, *QueryGetStudentsByCourseRequest) (*QueryGetStudentsByCourseResponse, error)
+	// GetStudentAcademicTree queries the academic tree for a specific student
+	GetStudentAcademicTree(context.Context, *QueryGetStudentAcademicTreeRequest) (*QueryGetStudentAcademicTreeResponse, error)
+	// CheckGraduationEligibility checks if a student is eligible for graduation
+	CheckGraduationEligibility(context.Context, *QueryCheckGraduationEligibilityRequest) (*QueryCheckGraduationEligibilityResponse, error)
+	// IntegrationMode reports how the module evaluates academic progress and
+	// which contracts it is configured to call
+	IntegrationMode(context.Context, *QueryIntegrationModeRequest) (*QueryIntegrationModeResponse, error)
+	// Transcript aggregates the subjects completed by a student per semester
+	// with their grades, credits and GPA
+	Transcript(context.Context, *QueryTranscriptRequest) (*QueryTranscriptResponse, error)
+	// SubjectOffering returns an offering with its enrolled students and waitlist
+	SubjectOffering(context.Context, *QuerySubjectOfferingRequest) (*QuerySubjectOfferingResponse, error)
+	// SubjectOfferings lists the offerings, optionally of a subject or a term
+	SubjectOfferings(context.Context, *QuerySubjectOfferingsRequest) (*QuerySubjectOfferingsResponse, error)
 }
 
-func (m *QueryGetEnrollmentsByStudentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetEnrollmentsByStudentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetEnrollmentsByStudentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Enrollments) > 0 {
-		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ListStudents(ctx context.Context, req *QueryListStudentsRequest) (*QueryListStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudents not implemented")
 }
-
-func (m *QueryGetStudentProgressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetStudent(ctx context.Context, req *QueryGetStudentRequest) (*QueryGetStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudent not implemented")
+}
+func (*UnimplementedQueryServer) ListEnrollments(ctx context.Context, req *QueryListEnrollmentsRequest) (*QueryListEnrollmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnrollments not implemented")
+}
+func (*UnimplementedQueryServer) GetEnrollment(ctx context.Context, req *QueryGetEnrollmentRequest) (*QueryGetEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollment not implemented")
+}
+func (*UnimplementedQueryServer) GetEnrollmentsByStudent(ctx context.Context, req *QueryGetEnrollmentsByStudentRequest) (*QueryGetEnrollmentsByStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByStudent not implemented")
+}
+func (*UnimplementedQueryServer) GetStudentProgress(ctx context.Context, req *QueryGetStudentProgressRequest) (*QueryGetStudentProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentProgress not implemented")
+}
+func (*UnimplementedQueryServer) GetStudentsByInstitution(ctx context.Context, req *QueryGetStudentsByInstitutionRequest) (*QueryGetStudentsByInstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByInstitution not implemented")
+}
+func (*UnimplementedQueryServer) GetStudentsByCourse(ctx context.Context, req *QueryGetStudentsByCourseRequest) (*QueryGetStudentsByCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByCourse not implemented")
+}
+func (*UnimplementedQueryServer) GetStudentAcademicTree(ctx context.Context, req *QueryGetStudentAcademicTreeRequest) (*QueryGetStudentAcademicTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentAcademicTree not implemented")
+}
+func (*UnimplementedQueryServer) CheckGraduationEligibility(ctx context.Context, req *QueryCheckGraduationEligibilityRequest) (*QueryCheckGraduationEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckGraduationEligibility not implemented")
+}
+func (*UnimplementedQueryServer) IntegrationMode(ctx context.Context, req *QueryIntegrationModeRequest) (*QueryIntegrationModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrationMode not implemented")
+}
+func (*UnimplementedQueryServer) Transcript(ctx context.Context, req *QueryTranscriptRequest) (*QueryTranscriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transcript not implemented")
+}
+func (*UnimplementedQueryServer) SubjectOffering(ctx context.Context, req *QuerySubjectOfferingRequest) (*QuerySubjectOfferingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubjectOffering not implemented")
+}
+func (*UnimplementedQueryServer) SubjectOfferings(ctx context.Context, req *QuerySubjectOfferingsRequest) (*QuerySubjectOfferingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubjectOfferings not implemented")
 }
 
-func (m *QueryGetStudentProgressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetStudentProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StudentId) > 0 {
-		i -= len(m.StudentId)
-		copy(dAtA[i:], m.StudentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentProgressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListStudentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/ListStudents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListStudents(ctx, req.(*QueryListStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentProgressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/GetStudent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStudent(ctx, req.(*QueryGetStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_ListEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/ListEnrollments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListEnrollments(ctx, req.(*QueryListEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByInstitutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEnrollmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/GetEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEnrollment(ctx, req.(*QueryGetEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByInstitutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetEnrollmentsByStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEnrollmentsByStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEnrollmentsByStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/GetEnrollmentsByStudent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEnrollmentsByStudent(ctx, req.(*QueryGetEnrollmentsByStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByInstitutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_GetStudentProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStudentProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InstitutionId) > 0 {
-		i -= len(m.InstitutionId)
-		copy(dAtA[i:], m.InstitutionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InstitutionId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetStudentProgress(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/GetStudentProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStudentProgress(ctx, req.(*QueryGetStudentProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByInstitutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetStudentsByInstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStudentsByInstitutionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetStudentsByInstitution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/GetStudentsByInstitution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStudentsByInstitution(ctx, req.(*QueryGetStudentsByInstitutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByInstitutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetStudentsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStudentsByCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStudentsByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/GetStudentsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStudentsByCourse(ctx, req.(*QueryGetStudentsByCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByInstitutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_GetStudentAcademicTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStudentAcademicTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Students) > 0 {
-		for iNdEx := len(m.Students) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Students[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStudentAcademicTree(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/GetStudentAcademicTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStudentAcademicTree(ctx, req.(*QueryGetStudentAcademicTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByCourseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CheckGraduationEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckGraduationEligibilityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CheckGraduationEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/CheckGraduationEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckGraduationEligibility(ctx, req.(*QueryCheckGraduationEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByCourseRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_IntegrationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegrationModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegrationMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/IntegrationMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegrationMode(ctx, req.(*QueryIntegrationModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByCourseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Transcript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CourseId) > 0 {
-		i -= len(m.CourseId)
-		copy(dAtA[i:], m.CourseId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CourseId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Transcript(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/Transcript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Transcript(ctx, req.(*QueryTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByCourseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SubjectOffering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubjectOfferingRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SubjectOffering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/SubjectOffering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubjectOffering(ctx, req.(*QuerySubjectOfferingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByCourseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SubjectOfferings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubjectOfferingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubjectOfferings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Query/SubjectOfferings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubjectOfferings(ctx, req.(*QuerySubjectOfferingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStudentsByCourseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "academictoken.student.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Students) > 0 {
-		for iNdEx := len(m.Students) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Students[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ListStudents",
+			Handler:    _Query_ListStudents_Handler,
+		},
+		{
+			MethodName: "GetStudent",
+			Handler:    _Query_GetStudent_Handler,
+		},
+		{
+			MethodName: "ListEnrollments",
+			Handler:    _Query_ListEnrollments_Handler,
+		},
+		{
+			MethodName: "GetEnrollment",
+			Handler:    _Query_GetEnrollment_Handler,
+		},
+		{
+			MethodName: "GetEnrollmentsByStudent",
+			Handler:    _Query_GetEnrollmentsByStudent_Handler,
+		},
+		{
+			MethodName: "GetStudentProgress",
+			Handler:    _Query_GetStudentProgress_Handler,
+		},
+		{
+			MethodName: "GetStudentsByInstitution",
+			Handler:    _Query_GetStudentsByInstitution_Handler,
+		},
+		{
+			MethodName: "GetStudentsByCourse",
+			Handler:    _Query_GetStudentsByCourse_Handler,
+		},
+		{
+			MethodName: "GetStudentAcademicTree",
+			Handler:    _Query_GetStudentAcademicTree_Handler,
+		},
+		{
+			MethodName: "CheckGraduationEligibility",
+			Handler:    _Query_CheckGraduationEligibility_Handler,
+		},
+		{
+			MethodName: "IntegrationMode",
+			Handler:    _Query_IntegrationMode_Handler,
+		},
+		{
+			MethodName: "Transcript",
+			Handler:    _Query_Transcript_Handler,
+		},
+		{
+			MethodName: "SubjectOffering",
+			Handler:    _Query_SubjectOffering_Handler,
+		},
+		{
+			MethodName: "SubjectOfferings",
+			Handler:    _Query_SubjectOfferings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "academictoken/student/query.proto",
 }
 
-func (m *QueryGetStudentAcademicTreeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2363,27 +2441,20 @@ func (m *QueryGetStudentAcademicTreeRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetStudentAcademicTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetStudentAcademicTreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StudentId) > 0 {
-		i -= len(m.StudentId)
-		copy(dAtA[i:], m.StudentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStudentAcademicTreeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2393,18 +2464,18 @@ func (m *QueryGetStudentAcademicTreeResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetStudentAcademicTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetStudentAcademicTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AcademicTree.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2416,7 +2487,7 @@ func (m *QueryGetStudentAcademicTreeResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCheckGraduationEligibilityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListStudentsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2426,27 +2497,32 @@ func (m *QueryCheckGraduationEligibilityRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryCheckGraduationEligibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListStudentsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCheckGraduationEligibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListStudentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StudentId) > 0 {
-		i -= len(m.StudentId)
-		copy(dAtA[i:], m.StudentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCheckGraduationEligibilityResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListStudentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2456,35 +2532,97 @@ func (m *QueryCheckGraduationEligibilityResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryCheckGraduationEligibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListStudentsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCheckGraduationEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListStudentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.IsEligible {
-		i--
-		if m.IsEligible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Students) > 0 {
+		for iNdEx := len(m.Students) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Students[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStudentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStudentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.GraduationStatus.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Student.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2496,343 +2634,2601 @@ func (m *QueryCheckGraduationEligibilityResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListEnrollmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryListEnrollmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListStudentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListEnrollmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListStudentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListEnrollmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEnrollmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEnrollmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Students) > 0 {
-		for _, e := range m.Students {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Enrollments) > 0 {
+		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStudentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StudentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetEnrollmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetStudentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Student.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetEnrollmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListEnrollmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetEnrollmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EnrollmentId) > 0 {
+		i -= len(m.EnrollmentId)
+		copy(dAtA[i:], m.EnrollmentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EnrollmentId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListEnrollmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Enrollments) > 0 {
-		for _, e := range m.Enrollments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetEnrollmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetEnrollmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetEnrollmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEnrollmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EnrollmentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Enrollment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEnrollmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetEnrollmentsByStudentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Enrollment.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetEnrollmentsByStudentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StudentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetEnrollmentsByStudentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetEnrollmentsByStudentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetEnrollmentsByStudentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Enrollments) > 0 {
-		for _, e := range m.Enrollments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStudentProgressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StudentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetEnrollmentsByStudentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetStudentProgressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Progress.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetEnrollmentsByStudentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetStudentsByInstitutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetEnrollmentsByStudentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InstitutionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Enrollments) > 0 {
+		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStudentsByInstitutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetStudentProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Students) > 0 {
-		for _, e := range m.Students {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStudentsByCourseRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetStudentProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CourseId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStudentsByCourseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetStudentsByInstitutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentsByInstitutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentsByInstitutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Students) > 0 {
-		for _, e := range m.Students {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InstitutionId) > 0 {
+		i -= len(m.InstitutionId)
+		copy(dAtA[i:], m.InstitutionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InstitutionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStudentAcademicTreeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetStudentsByInstitutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentsByInstitutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentsByInstitutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StudentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Students) > 0 {
+		for iNdEx := len(m.Students) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Students[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStudentAcademicTreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetStudentsByCourseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AcademicTree.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCheckGraduationEligibilityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetStudentsByCourseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentsByCourseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StudentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.CourseId) > 0 {
+		i -= len(m.CourseId)
+		copy(dAtA[i:], m.CourseId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CourseId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCheckGraduationEligibilityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetStudentsByCourseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentsByCourseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentsByCourseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.GraduationStatus.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Students) > 0 {
+		for iNdEx := len(m.Students) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Students[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStudentAcademicTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentAcademicTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentAcademicTreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStudentAcademicTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStudentAcademicTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStudentAcademicTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AcademicTree.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckGraduationEligibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckGraduationEligibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckGraduationEligibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckGraduationEligibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckGraduationEligibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckGraduationEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.IsEligible {
-		n += 2
+		i--
+		if m.IsEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.GraduationStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryIntegrationModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryIntegrationModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrationModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Configured {
+		i--
+		if m.Configured {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrationModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrationModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrationModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTranscriptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTranscriptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTranscriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTranscriptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTranscriptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTranscriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrerequisiteWaivers) > 0 {
+		for iNdEx := len(m.PrerequisiteWaivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrerequisiteWaivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.GpaCredits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GpaCredits))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CumulativeGpa) > 0 {
+		i -= len(m.CumulativeGpa)
+		copy(dAtA[i:], m.CumulativeGpa)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CumulativeGpa)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TotalWorkloadHours != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalWorkloadHours))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TotalCredits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalCredits))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TransferredSubjects) > 0 {
+		for iNdEx := len(m.TransferredSubjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferredSubjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Semesters) > 0 {
+		for iNdEx := len(m.Semesters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Semesters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CurriculumVersion) > 0 {
+		i -= len(m.CurriculumVersion)
+		copy(dAtA[i:], m.CurriculumVersion)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurriculumVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CourseId) > 0 {
+		i -= len(m.CourseId)
+		copy(dAtA[i:], m.CourseId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CourseId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StudentAddress) > 0 {
+		i -= len(m.StudentAddress)
+		copy(dAtA[i:], m.StudentAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubjectOfferingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubjectOfferingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubjectOfferingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OfferingId) > 0 {
+		i -= len(m.OfferingId)
+		copy(dAtA[i:], m.OfferingId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubjectOfferingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubjectOfferingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubjectOfferingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Offering.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubjectOfferingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubjectOfferingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubjectOfferingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubjectOfferingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubjectOfferingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubjectOfferingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offerings) > 0 {
+		for iNdEx := len(m.Offerings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offerings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListStudentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListStudentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Students) > 0 {
+		for _, e := range m.Students {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Student.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListEnrollmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEnrollmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Enrollments) > 0 {
+		for _, e := range m.Enrollments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEnrollmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EnrollmentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEnrollmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Enrollment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetEnrollmentsByStudentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEnrollmentsByStudentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Enrollments) > 0 {
+		for _, e := range m.Enrollments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Progress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetStudentsByInstitutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InstitutionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentsByInstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Students) > 0 {
+		for _, e := range m.Students {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentsByCourseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CourseId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentsByCourseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Students) > 0 {
+		for _, e := range m.Students {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentAcademicTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStudentAcademicTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AcademicTree.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCheckGraduationEligibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckGraduationEligibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GraduationStatus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsEligible {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegrationModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ContractStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Configured {
+		n += 2
+	}
+	if m.Required {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIntegrationModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Ready {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTranscriptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTranscriptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StudentAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CourseId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CurriculumVersion)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Semesters) > 0 {
+		for _, e := range m.Semesters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TransferredSubjects) > 0 {
+		for _, e := range m.TransferredSubjects {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalCredits != 0 {
+		n += 1 + sovQuery(uint64(m.TotalCredits))
+	}
+	if m.TotalWorkloadHours != 0 {
+		n += 1 + sovQuery(uint64(m.TotalWorkloadHours))
+	}
+	l = len(m.CumulativeGpa)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GpaCredits != 0 {
+		n += 1 + sovQuery(uint64(m.GpaCredits))
+	}
+	if len(m.PrerequisiteWaivers) > 0 {
+		for _, e := range m.PrerequisiteWaivers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySubjectOfferingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferingId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubjectOfferingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Offering.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubjectOfferingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubjectOfferingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offerings) > 0 {
+		for _, e := range m.Offerings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListStudentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListStudentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListStudentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListStudentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListStudentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListStudentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Students", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Students = append(m.Students, Student{})
+			if err := m.Students[len(m.Students)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStudentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStudentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStudentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStudentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStudentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStudentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Student", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Student.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEnrollmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEnrollmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEnrollmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEnrollmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEnrollmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEnrollmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enrollments = append(m.Enrollments, StudentEnrollment{})
+			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEnrollmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEnrollmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEnrollmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrollmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEnrollmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEnrollmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEnrollmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Enrollment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEnrollmentsByStudentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEnrollmentsByStudentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEnrollmentsByStudentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEnrollmentsByStudentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2855,12 +5251,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEnrollmentsByStudentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEnrollmentsByStudentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enrollments = append(m.Enrollments, StudentEnrollment{})
+			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2882,7 +5348,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStudentProgressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2905,17 +5371,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStudentProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStudentProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2925,24 +5391,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StudentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2965,7 +5430,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListStudentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStudentProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2988,15 +5453,15 @@ func (m *QueryListStudentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListStudentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStudentProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListStudentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStudentProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3023,10 +5488,7 @@ func (m *QueryListStudentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3051,7 +5513,7 @@ func (m *QueryListStudentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListStudentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStudentsByInstitutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3074,17 +5536,17 @@ func (m *QueryListStudentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListStudentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStudentsByInstitutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListStudentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStudentsByInstitutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Students", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstitutionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3094,25 +5556,23 @@ func (m *QueryListStudentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Students = append(m.Students, Student{})
-			if err := m.Students[len(m.Students)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InstitutionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3144,7 +5604,7 @@ func (m *QueryListStudentsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3171,7 +5631,7 @@ func (m *QueryListStudentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStudentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStudentsByInstitutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3194,17 +5654,17 @@ func (m *QueryGetStudentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStudentsByInstitutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStudentsByInstitutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Students", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3214,23 +5674,61 @@ func (m *QueryGetStudentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StudentId = string(dAtA[iNdEx:postIndex])
+			m.Students = append(m.Students, Student{})
+			if err := m.Students[len(m.Students)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3253,7 +5751,7 @@ func (m *QueryGetStudentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStudentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStudentsByCourseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3276,17 +5774,17 @@ func (m *QueryGetStudentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStudentsByCourseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStudentsByCourseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Student", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3296,76 +5794,25 @@ func (m *QueryGetStudentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Student.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListEnrollmentsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEnrollmentsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEnrollmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CourseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3422,7 +5869,7 @@ func (m *QueryListEnrollmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEnrollmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStudentsByCourseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3445,15 +5892,15 @@ func (m *QueryListEnrollmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEnrollmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStudentsByCourseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEnrollmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStudentsByCourseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Students", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3480,8 +5927,8 @@ func (m *QueryListEnrollmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enrollments = append(m.Enrollments, StudentEnrollment{})
-			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Students = append(m.Students, Student{})
+			if err := m.Students[len(m.Students)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3542,7 +5989,7 @@ func (m *QueryListEnrollmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEnrollmentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStudentAcademicTreeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3565,15 +6012,15 @@ func (m *QueryGetEnrollmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEnrollmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStudentAcademicTreeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEnrollmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStudentAcademicTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3601,7 +6048,7 @@ func (m *QueryGetEnrollmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnrollmentId = string(dAtA[iNdEx:postIndex])
+			m.StudentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3624,7 +6071,7 @@ func (m *QueryGetEnrollmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEnrollmentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStudentAcademicTreeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3647,15 +6094,15 @@ func (m *QueryGetEnrollmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEnrollmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStudentAcademicTreeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEnrollmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStudentAcademicTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrollment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcademicTree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3682,7 +6129,7 @@ func (m *QueryGetEnrollmentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Enrollment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AcademicTree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3707,7 +6154,7 @@ func (m *QueryGetEnrollmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEnrollmentsByStudentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckGraduationEligibilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3730,10 +6177,10 @@ func (m *QueryGetEnrollmentsByStudentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEnrollmentsByStudentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckGraduationEligibilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEnrollmentsByStudentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckGraduationEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3768,42 +6215,6 @@ func (m *QueryGetEnrollmentsByStudentRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.StudentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3825,7 +6236,7 @@ func (m *QueryGetEnrollmentsByStudentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEnrollmentsByStudentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckGraduationEligibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3848,15 +6259,15 @@ func (m *QueryGetEnrollmentsByStudentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEnrollmentsByStudentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckGraduationEligibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEnrollmentsByStudentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckGraduationEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GraduationStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3883,16 +6294,35 @@ func (m *QueryGetEnrollmentsByStudentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enrollments = append(m.Enrollments, StudentEnrollment{})
-			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GraduationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEligible = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3902,28 +6332,74 @@ func (m *QueryGetEnrollmentsByStudentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrationModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrationModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrationModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3945,7 +6421,7 @@ func (m *QueryGetEnrollmentsByStudentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStudentProgressRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3968,15 +6444,47 @@ func (m *QueryGetStudentProgressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentProgressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4004,8 +6512,48 @@ func (m *QueryGetStudentProgressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StudentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configured", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Configured = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4027,7 +6575,7 @@ func (m *QueryGetStudentProgressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStudentProgressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegrationModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4050,15 +6598,47 @@ func (m *QueryGetStudentProgressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentProgressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegrationModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegrationModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4085,10 +6665,63 @@ func (m *QueryGetStudentProgressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contracts = append(m.Contracts, ContractStatus{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4110,7 +6743,7 @@ func (m *QueryGetStudentProgressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStudentsByInstitutionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTranscriptRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4133,15 +6766,15 @@ func (m *QueryGetStudentsByInstitutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentsByInstitutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTranscriptRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentsByInstitutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTranscriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstitutionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4169,13 +6802,13 @@ func (m *QueryGetStudentsByInstitutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstitutionId = string(dAtA[iNdEx:postIndex])
+			m.StudentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4185,27 +6818,23 @@ func (m *QueryGetStudentsByInstitutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Term = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4228,7 +6857,7 @@ func (m *QueryGetStudentsByInstitutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStudentsByInstitutionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTranscriptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4251,17 +6880,17 @@ func (m *QueryGetStudentsByInstitutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentsByInstitutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTranscriptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentsByInstitutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTranscriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Students", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4271,31 +6900,29 @@ func (m *QueryGetStudentsByInstitutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Students = append(m.Students, Student{})
-			if err := m.Students[len(m.Students)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StudentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4305,81 +6932,123 @@ func (m *QueryGetStudentsByInstitutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.StudentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetStudentsByCourseRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentsByCourseRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentsByCourseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CourseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurriculumVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4407,11 +7076,11 @@ func (m *QueryGetStudentsByCourseRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CourseId = string(dAtA[iNdEx:postIndex])
+			m.CurriculumVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Semesters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4438,68 +7107,88 @@ func (m *QueryGetStudentsByCourseRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Semesters = append(m.Semesters, TranscriptSemester{})
+			if err := m.Semesters[len(m.Semesters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredSubjects", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetStudentsByCourseResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.TransferredSubjects = append(m.TransferredSubjects, TransferredSubject{})
+			if err := m.TransferredSubjects[len(m.TransferredSubjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCredits", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TotalCredits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCredits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentsByCourseResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentsByCourseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWorkloadHours", wireType)
+			}
+			m.TotalWorkloadHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalWorkloadHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Students", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGpa", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4509,29 +7198,46 @@ func (m *QueryGetStudentsByCourseResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Students = append(m.Students, Student{})
-			if err := m.Students[len(m.Students)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CumulativeGpa = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpaCredits", wireType)
+			}
+			m.GpaCredits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GpaCredits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrerequisiteWaivers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4558,10 +7264,8 @@ func (m *QueryGetStudentsByCourseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PrerequisiteWaivers = append(m.PrerequisiteWaivers, PrerequisiteWaiver{})
+			if err := m.PrerequisiteWaivers[len(m.PrerequisiteWaivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4586,7 +7290,7 @@ func (m *QueryGetStudentsByCourseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStudentAcademicTreeRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubjectOfferingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4609,15 +7313,15 @@ func (m *QueryGetStudentAcademicTreeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentAcademicTreeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubjectOfferingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentAcademicTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubjectOfferingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4645,7 +7349,7 @@ func (m *QueryGetStudentAcademicTreeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StudentId = string(dAtA[iNdEx:postIndex])
+			m.OfferingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4668,7 +7372,7 @@ func (m *QueryGetStudentAcademicTreeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStudentAcademicTreeResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubjectOfferingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4691,15 +7395,15 @@ func (m *QueryGetStudentAcademicTreeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStudentAcademicTreeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubjectOfferingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStudentAcademicTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubjectOfferingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcademicTree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offering", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4726,7 +7430,7 @@ func (m *QueryGetStudentAcademicTreeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AcademicTree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Offering.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4751,7 +7455,7 @@ func (m *QueryGetStudentAcademicTreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckGraduationEligibilityRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubjectOfferingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4774,15 +7478,15 @@ func (m *QueryCheckGraduationEligibilityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckGraduationEligibilityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubjectOfferingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckGraduationEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubjectOfferingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4810,7 +7514,39 @@ func (m *QueryCheckGraduationEligibilityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StudentId = string(dAtA[iNdEx:postIndex])
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4833,7 +7569,7 @@ func (m *QueryCheckGraduationEligibilityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckGraduationEligibilityResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubjectOfferingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4856,15 +7592,15 @@ func (m *QueryCheckGraduationEligibilityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckGraduationEligibilityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubjectOfferingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckGraduationEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubjectOfferingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GraduationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offerings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4891,62 +7627,11 @@ func (m *QueryCheckGraduationEligibilityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GraduationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offerings = append(m.Offerings, SubjectOffering{})
+			if err := m.Offerings[len(m.Offerings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsEligible = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
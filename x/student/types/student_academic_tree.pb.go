@@ -75,30 +75,317 @@ func (m *ElectiveCompletionGroup) GetCompletedSubjects() []string {
 	return nil
 }
 
+// CurriculumMigration records a request to move a student to a newer
+// curriculum version of their course and the review of the institution
+type CurriculumMigration struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromVersion string `protobuf:"bytes,2,opt,name=fromVersion,proto3" json:"fromVersion,omitempty"`
+	ToVersion   string `protobuf:"bytes,3,opt,name=toVersion,proto3" json:"toVersion,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy string `protobuf:"bytes,5,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"`
+	RequestedAt string `protobuf:"bytes,6,opt,name=requestedAt,proto3" json:"requestedAt,omitempty"`
+	Status      string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ReviewedBy  string `protobuf:"bytes,8,opt,name=reviewedBy,proto3" json:"reviewedBy,omitempty"`
+	ReviewedAt  string `protobuf:"bytes,9,opt,name=reviewedAt,proto3" json:"reviewedAt,omitempty"`
+	ReviewNote  string `protobuf:"bytes,10,opt,name=reviewNote,proto3" json:"reviewNote,omitempty"`
+}
+
+func (m *CurriculumMigration) Reset()         { *m = CurriculumMigration{} }
+func (m *CurriculumMigration) String() string { return proto.CompactTextString(m) }
+func (*CurriculumMigration) ProtoMessage()    {}
+func (*CurriculumMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1146de6bee7f2261, []int{1}
+}
+func (m *CurriculumMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurriculumMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurriculumMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurriculumMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurriculumMigration.Merge(m, src)
+}
+func (m *CurriculumMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurriculumMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurriculumMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurriculumMigration proto.InternalMessageInfo
+
+func (m *CurriculumMigration) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetFromVersion() string {
+	if m != nil {
+		return m.FromVersion
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetToVersion() string {
+	if m != nil {
+		return m.ToVersion
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetRequestedBy() string {
+	if m != nil {
+		return m.RequestedBy
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetRequestedAt() string {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetReviewedBy() string {
+	if m != nil {
+		return m.ReviewedBy
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetReviewedAt() string {
+	if m != nil {
+		return m.ReviewedAt
+	}
+	return ""
+}
+
+func (m *CurriculumMigration) GetReviewNote() string {
+	if m != nil {
+		return m.ReviewNote
+	}
+	return ""
+}
+
+// SubjectWithdrawal records a subject the student withdrew from after the
+// enrollment window of its term closed
+type SubjectWithdrawal struct {
+	SubjectId   string `protobuf:"bytes,1,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	OfferingId  string `protobuf:"bytes,2,opt,name=offeringId,proto3" json:"offeringId,omitempty"`
+	Term        string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	WithdrawnBy string `protobuf:"bytes,5,opt,name=withdrawnBy,proto3" json:"withdrawnBy,omitempty"`
+	WithdrawnAt string `protobuf:"bytes,6,opt,name=withdrawnAt,proto3" json:"withdrawnAt,omitempty"`
+}
+
+func (m *SubjectWithdrawal) Reset()         { *m = SubjectWithdrawal{} }
+func (m *SubjectWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*SubjectWithdrawal) ProtoMessage()    {}
+func (*SubjectWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1146de6bee7f2261, []int{2}
+}
+func (m *SubjectWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubjectWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubjectWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubjectWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubjectWithdrawal.Merge(m, src)
+}
+func (m *SubjectWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubjectWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubjectWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubjectWithdrawal proto.InternalMessageInfo
+
+func (m *SubjectWithdrawal) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
+}
+
+func (m *SubjectWithdrawal) GetOfferingId() string {
+	if m != nil {
+		return m.OfferingId
+	}
+	return ""
+}
+
+func (m *SubjectWithdrawal) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *SubjectWithdrawal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SubjectWithdrawal) GetWithdrawnBy() string {
+	if m != nil {
+		return m.WithdrawnBy
+	}
+	return ""
+}
+
+func (m *SubjectWithdrawal) GetWithdrawnAt() string {
+	if m != nil {
+		return m.WithdrawnAt
+	}
+	return ""
+}
+
+// PrerequisiteWaiver lets a student enroll in a subject without meeting its
+// prerequisites until the waiver expires
+type PrerequisiteWaiver struct {
+	SubjectId string `protobuf:"bytes,1,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedBy string `protobuf:"bytes,3,opt,name=grantedBy,proto3" json:"grantedBy,omitempty"`
+	GrantedAt string `protobuf:"bytes,4,opt,name=grantedAt,proto3" json:"grantedAt,omitempty"`
+	ExpiresAt string `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (m *PrerequisiteWaiver) Reset()         { *m = PrerequisiteWaiver{} }
+func (m *PrerequisiteWaiver) String() string { return proto.CompactTextString(m) }
+func (*PrerequisiteWaiver) ProtoMessage()    {}
+func (*PrerequisiteWaiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1146de6bee7f2261, []int{3}
+}
+func (m *PrerequisiteWaiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrerequisiteWaiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrerequisiteWaiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrerequisiteWaiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrerequisiteWaiver.Merge(m, src)
+}
+func (m *PrerequisiteWaiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrerequisiteWaiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrerequisiteWaiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrerequisiteWaiver proto.InternalMessageInfo
+
+func (m *PrerequisiteWaiver) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
+}
+
+func (m *PrerequisiteWaiver) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PrerequisiteWaiver) GetGrantedBy() string {
+	if m != nil {
+		return m.GrantedBy
+	}
+	return ""
+}
+
+func (m *PrerequisiteWaiver) GetGrantedAt() string {
+	if m != nil {
+		return m.GrantedAt
+	}
+	return ""
+}
+
+func (m *PrerequisiteWaiver) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
 type StudentAcademicTree struct {
-	Index               string                     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Student             string                     `protobuf:"bytes,2,opt,name=student,proto3" json:"student,omitempty"`
-	Institution         string                     `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
-	CourseId            string                     `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId,omitempty"`
-	CurriculumVersion   string                     `protobuf:"bytes,5,opt,name=curriculumVersion,proto3" json:"curriculumVersion,omitempty"`
-	TotalCredits        uint64                     `protobuf:"varint,6,opt,name=totalCredits,proto3" json:"totalCredits,omitempty"`
-	TotalCompletedHours uint64                     `protobuf:"varint,7,opt,name=totalCompletedHours,proto3" json:"totalCompletedHours,omitempty"`
-	CoefficientGpa      float32                    `protobuf:"fixed32,8,opt,name=coefficientGpa,proto3" json:"coefficientGpa,omitempty"`
-	CompletedTokens     []string                   `protobuf:"bytes,9,rep,name=completedTokens,proto3" json:"completedTokens,omitempty"`
-	InProgressTokens    []string                   `protobuf:"bytes,10,rep,name=inProgressTokens,proto3" json:"inProgressTokens,omitempty"`
-	AvailableTokens     []string                   `protobuf:"bytes,11,rep,name=availableTokens,proto3" json:"availableTokens,omitempty"`
-	AcademicProgress    *AcademicProgress          `protobuf:"bytes,12,opt,name=academicProgress,proto3" json:"academicProgress,omitempty"`
-	GraduationStatus    *GraduationStatus          `protobuf:"bytes,13,opt,name=graduationStatus,proto3" json:"graduationStatus,omitempty"`
-	TransferredSubjects []string                   `protobuf:"bytes,14,rep,name=transferredSubjects,proto3" json:"transferredSubjects,omitempty"`
-	CompletedElectives  []*ElectiveCompletionGroup `protobuf:"bytes,15,rep,name=completedElectives,proto3" json:"completedElectives,omitempty"`
-	EquivalenceRequests []*EquivalenceRequest      `protobuf:"bytes,16,rep,name=equivalenceRequests,proto3" json:"equivalenceRequests,omitempty"`
+	Index                string                     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Student              string                     `protobuf:"bytes,2,opt,name=student,proto3" json:"student,omitempty"`
+	Institution          string                     `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
+	CourseId             string                     `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	CurriculumVersion    string                     `protobuf:"bytes,5,opt,name=curriculumVersion,proto3" json:"curriculumVersion,omitempty"`
+	TotalCredits         uint64                     `protobuf:"varint,6,opt,name=totalCredits,proto3" json:"totalCredits,omitempty"`
+	TotalCompletedHours  uint64                     `protobuf:"varint,7,opt,name=totalCompletedHours,proto3" json:"totalCompletedHours,omitempty"`
+	CoefficientGpa       float32                    `protobuf:"fixed32,8,opt,name=coefficientGpa,proto3" json:"coefficientGpa,omitempty"`
+	CompletedTokens      []string                   `protobuf:"bytes,9,rep,name=completedTokens,proto3" json:"completedTokens,omitempty"`
+	InProgressTokens     []string                   `protobuf:"bytes,10,rep,name=inProgressTokens,proto3" json:"inProgressTokens,omitempty"`
+	AvailableTokens      []string                   `protobuf:"bytes,11,rep,name=availableTokens,proto3" json:"availableTokens,omitempty"`
+	AcademicProgress     *AcademicProgress          `protobuf:"bytes,12,opt,name=academicProgress,proto3" json:"academicProgress,omitempty"`
+	GraduationStatus     *GraduationStatus          `protobuf:"bytes,13,opt,name=graduationStatus,proto3" json:"graduationStatus,omitempty"`
+	TransferredSubjects  []string                   `protobuf:"bytes,14,rep,name=transferredSubjects,proto3" json:"transferredSubjects,omitempty"`
+	CompletedElectives   []*ElectiveCompletionGroup `protobuf:"bytes,15,rep,name=completedElectives,proto3" json:"completedElectives,omitempty"`
+	EquivalenceRequests  []*EquivalenceRequest      `protobuf:"bytes,16,rep,name=equivalenceRequests,proto3" json:"equivalenceRequests,omitempty"`
+	GradePointsTotal     string                     `protobuf:"bytes,17,opt,name=gradePointsTotal,proto3" json:"gradePointsTotal,omitempty"`
+	GpaCredits           uint64                     `protobuf:"varint,18,opt,name=gpaCredits,proto3" json:"gpaCredits,omitempty"`
+	CurriculumMigrations []*CurriculumMigration     `protobuf:"bytes,19,rep,name=curriculumMigrations,proto3" json:"curriculumMigrations,omitempty"`
+	Withdrawals          []*SubjectWithdrawal       `protobuf:"bytes,20,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	PrerequisiteWaivers  []*PrerequisiteWaiver      `protobuf:"bytes,21,rep,name=prerequisiteWaivers,proto3" json:"prerequisiteWaivers,omitempty"`
 }
 
 func (m *StudentAcademicTree) Reset()         { *m = StudentAcademicTree{} }
 func (m *StudentAcademicTree) String() string { return proto.CompactTextString(m) }
 func (*StudentAcademicTree) ProtoMessage()    {}
 func (*StudentAcademicTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1146de6bee7f2261, []int{1}
+	return fileDescriptor_1146de6bee7f2261, []int{4}
 }
 func (m *StudentAcademicTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,8 +526,46 @@ func (m *StudentAcademicTree) GetEquivalenceRequests() []*EquivalenceRequest {
 	return nil
 }
 
+func (m *StudentAcademicTree) GetGradePointsTotal() string {
+	if m != nil {
+		return m.GradePointsTotal
+	}
+	return ""
+}
+
+func (m *StudentAcademicTree) GetGpaCredits() uint64 {
+	if m != nil {
+		return m.GpaCredits
+	}
+	return 0
+}
+
+func (m *StudentAcademicTree) GetCurriculumMigrations() []*CurriculumMigration {
+	if m != nil {
+		return m.CurriculumMigrations
+	}
+	return nil
+}
+
+func (m *StudentAcademicTree) GetWithdrawals() []*SubjectWithdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+func (m *StudentAcademicTree) GetPrerequisiteWaivers() []*PrerequisiteWaiver {
+	if m != nil {
+		return m.PrerequisiteWaivers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ElectiveCompletionGroup)(nil), "academictoken.student.ElectiveCompletionGroup")
+	proto.RegisterType((*CurriculumMigration)(nil), "academictoken.student.CurriculumMigration")
+	proto.RegisterType((*SubjectWithdrawal)(nil), "academictoken.student.SubjectWithdrawal")
+	proto.RegisterType((*PrerequisiteWaiver)(nil), "academictoken.student.PrerequisiteWaiver")
 	proto.RegisterType((*StudentAcademicTree)(nil), "academictoken.student.StudentAcademicTree")
 }
 
@@ -249,41 +574,61 @@ func init() {
 }
 
 var fileDescriptor_1146de6bee7f2261 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc6, 0xe3, 0xa4, 0x4d, 0x9b, 0x4d, 0xdf, 0x36, 0xef, 0x06, 0xc4, 0xaa, 0x12, 0x96, 0x95,
-	0x03, 0x18, 0x04, 0x29, 0x94, 0x03, 0xe7, 0x52, 0xa1, 0xd0, 0x1b, 0x72, 0x2a, 0x0e, 0x20, 0x11,
-	0x6d, 0xd6, 0x93, 0x68, 0xc1, 0xf1, 0xba, 0xfb, 0x27, 0x2a, 0xdf, 0x82, 0x8f, 0xd5, 0x63, 0x8f,
-	0x1c, 0x51, 0xf2, 0x45, 0x90, 0xd7, 0x76, 0x48, 0x62, 0x87, 0x53, 0x32, 0x33, 0xcf, 0xfe, 0x66,
-	0xbc, 0xfb, 0x0c, 0x7a, 0x4d, 0x19, 0x0d, 0x61, 0xc6, 0x99, 0x16, 0xdf, 0x21, 0x3e, 0x53, 0xda,
-	0x84, 0x10, 0xeb, 0xe2, 0x77, 0x54, 0x54, 0x47, 0x5a, 0x02, 0xf4, 0x13, 0x29, 0xb4, 0xc0, 0x0f,
-	0x37, 0x8e, 0xf4, 0x73, 0xe9, 0xe9, 0xcb, 0x6a, 0xd2, 0x8a, 0x90, 0x48, 0x31, 0x95, 0xa0, 0x54,
-	0x46, 0xd9, 0x25, 0x9f, 0x4a, 0x1a, 0x1a, 0xaa, 0xb9, 0x88, 0x47, 0x4a, 0x53, 0x6d, 0x0a, 0xf9,
-	0x59, 0xb5, 0x1c, 0x6e, 0x0c, 0x9f, 0xd3, 0x08, 0x62, 0x06, 0x23, 0x09, 0x37, 0x06, 0x94, 0xce,
-	0x0e, 0xf4, 0x28, 0x7a, 0xf4, 0x3e, 0x02, 0xa6, 0xf9, 0x1c, 0x2e, 0xc5, 0x2c, 0x89, 0x20, 0x65,
-	0x0e, 0xa4, 0x30, 0x09, 0x26, 0xe8, 0x60, 0x9a, 0xfe, 0xb9, 0x0a, 0x89, 0xe3, 0x39, 0x7e, 0x2b,
-	0x28, 0x42, 0xfc, 0x02, 0xfd, 0xcf, 0x32, 0x31, 0x84, 0x43, 0x33, 0xfe, 0x06, 0x4c, 0x2b, 0x52,
-	0xf7, 0x1a, 0x7e, 0x2b, 0x28, 0x17, 0x7a, 0x77, 0x4d, 0xd4, 0x1d, 0x66, 0x83, 0x5c, 0xe4, 0xd3,
-	0x5d, 0x4b, 0x00, 0xfc, 0x00, 0xed, 0xf3, 0x38, 0x84, 0xdb, 0x9c, 0x9e, 0x05, 0x69, 0xd7, 0x7c,
-	0x6a, 0x52, 0xcf, 0xba, 0xe6, 0x21, 0xf6, 0x50, 0x9b, 0xc7, 0x4a, 0x73, 0x6d, 0xd2, 0x19, 0x49,
-	0xc3, 0x56, 0xd7, 0x53, 0xf8, 0x14, 0x1d, 0x32, 0x61, 0xa4, 0x82, 0xab, 0x90, 0xec, 0xd9, 0xf2,
-	0x2a, 0xb6, 0x33, 0x1b, 0x29, 0x39, 0x33, 0x91, 0x99, 0x7d, 0x02, 0xa9, 0x52, 0xc6, 0xbe, 0x15,
-	0x95, 0x0b, 0xb8, 0x87, 0x8e, 0xb4, 0xd0, 0x34, 0xba, 0x94, 0x10, 0x72, 0xad, 0x48, 0xd3, 0x73,
-	0xfc, 0xbd, 0x60, 0x23, 0x87, 0x5f, 0xa1, 0x6e, 0x16, 0x17, 0x5f, 0xfc, 0x21, 0xed, 0x45, 0x0e,
-	0xac, 0xb4, 0xaa, 0x84, 0x9f, 0xa0, 0x63, 0x26, 0x60, 0x32, 0xe1, 0x8c, 0x43, 0xac, 0x07, 0x09,
-	0x25, 0x87, 0x9e, 0xe3, 0xd7, 0x83, 0xad, 0x2c, 0xf6, 0xd1, 0xc9, 0xea, 0x1a, 0xaf, 0xd3, 0x87,
-	0x54, 0xa4, 0x65, 0x6f, 0x77, 0x3b, 0x8d, 0x9f, 0xa3, 0x0e, 0x8f, 0x3f, 0xe6, 0x96, 0xc9, 0xa5,
-	0xc8, 0x4a, 0x4b, 0xf9, 0x94, 0x4a, 0xe7, 0x94, 0x47, 0x74, 0x1c, 0x41, 0x2e, 0x6d, 0x67, 0xd4,
-	0xad, 0x34, 0x1e, 0xa2, 0x4e, 0xe1, 0xa3, 0x82, 0x41, 0x8e, 0x3c, 0xc7, 0x6f, 0x9f, 0x3f, 0xed,
-	0x57, 0xba, 0xba, 0x7f, 0xb1, 0x25, 0x0f, 0x4a, 0x80, 0x14, 0xfa, 0xd7, 0xb5, 0x43, 0x6b, 0x5a,
-	0xf2, 0xdf, 0x3f, 0xa1, 0x83, 0x2d, 0x79, 0x50, 0x02, 0xd8, 0x37, 0x90, 0x34, 0x56, 0x13, 0x90,
-	0x72, 0xcd, 0x8b, 0xc7, 0xf6, 0xbb, 0xaa, 0x4a, 0xf8, 0x2b, 0xc2, 0xab, 0x4b, 0x2c, 0x9c, 0xaf,
-	0xc8, 0x89, 0xd7, 0xf0, 0xdb, 0xe7, 0xfd, 0x1d, 0x83, 0xec, 0xd8, 0x90, 0xa0, 0x82, 0x84, 0xbf,
-	0xa0, 0xee, 0xda, 0xb6, 0x05, 0xd9, 0xb2, 0x29, 0xd2, 0xb1, 0x0d, 0x9e, 0xed, 0x6a, 0x50, 0x3a,
-	0x11, 0x54, 0x51, 0xde, 0xbd, 0xbd, 0x5b, 0xb8, 0xce, 0xfd, 0xc2, 0x75, 0x7e, 0x2f, 0x5c, 0xe7,
-	0xe7, 0xd2, 0xad, 0xdd, 0x2f, 0xdd, 0xda, 0xaf, 0xa5, 0x5b, 0xfb, 0xfc, 0x78, 0x73, 0xf1, 0x6f,
-	0x57, 0xab, 0xaf, 0x7f, 0x24, 0xa0, 0xc6, 0x4d, 0xbb, 0xed, 0x6f, 0xfe, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x53, 0xb1, 0xbc, 0x01, 0xc8, 0x04, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0x38, 0xde, 0xec, 0xba, 0xbd, 0x64, 0x93, 0x76, 0x16, 0x5a, 0xab, 0xc5, 0xb2, 0x7c,
+	0x00, 0xb3, 0x02, 0x2f, 0x2c, 0x07, 0xce, 0x93, 0x08, 0x85, 0x20, 0x81, 0xa2, 0x71, 0x44, 0x24,
+	0x90, 0x62, 0x75, 0x66, 0xca, 0xa6, 0x61, 0x3c, 0x3d, 0xe9, 0xee, 0x71, 0x92, 0xb7, 0xe0, 0x05,
+	0xb8, 0xf1, 0x12, 0x1c, 0xb9, 0x71, 0xcc, 0x91, 0x23, 0x4a, 0x5e, 0x04, 0xf5, 0xcf, 0xfc, 0xd8,
+	0x33, 0x0e, 0x27, 0xbb, 0xbf, 0xaf, 0xe6, 0xeb, 0xea, 0xea, 0xaa, 0xaf, 0xd1, 0x17, 0x34, 0xa4,
+	0x11, 0x2c, 0x58, 0xa8, 0xf8, 0xaf, 0x90, 0xbc, 0x95, 0x2a, 0x8b, 0x20, 0x51, 0xf9, 0xef, 0x34,
+	0x67, 0xa7, 0x4a, 0x00, 0x8c, 0x53, 0xc1, 0x15, 0xc7, 0x2f, 0x57, 0x3e, 0x19, 0xbb, 0xd0, 0x57,
+	0x9f, 0x35, 0x2b, 0x15, 0x0a, 0xa9, 0xe0, 0x73, 0x01, 0x52, 0x5a, 0x95, 0x4d, 0xe1, 0x73, 0x41,
+	0xa3, 0x8c, 0x2a, 0xc6, 0x93, 0xa9, 0x54, 0x54, 0x65, 0x79, 0xf8, 0xdb, 0xe6, 0x70, 0xb8, 0xca,
+	0xd8, 0x92, 0xc6, 0x90, 0x84, 0x30, 0x15, 0x70, 0x95, 0x81, 0x54, 0xf6, 0x83, 0x21, 0x45, 0x1f,
+	0x7c, 0x1d, 0x43, 0xa8, 0xd8, 0x12, 0x8e, 0xf8, 0x22, 0x8d, 0x41, 0x6b, 0x1e, 0x0b, 0x9e, 0xa5,
+	0x98, 0xa0, 0xa7, 0x73, 0xfd, 0xe7, 0x24, 0x22, 0xde, 0xc0, 0x1b, 0x75, 0x82, 0x7c, 0x89, 0x3f,
+	0x45, 0xfb, 0xa1, 0x0d, 0x86, 0x68, 0x92, 0x5d, 0xfe, 0x02, 0xa1, 0x92, 0xa4, 0x35, 0xd8, 0x1e,
+	0x75, 0x82, 0x3a, 0x31, 0xfc, 0xb3, 0x85, 0x7a, 0x47, 0x99, 0x10, 0x2c, 0xcc, 0xe2, 0x6c, 0xf1,
+	0x1d, 0x9b, 0x0b, 0x93, 0x38, 0xde, 0x45, 0x2d, 0x96, 0x4b, 0xb7, 0x58, 0x84, 0x07, 0xa8, 0x3b,
+	0x13, 0x7c, 0xf1, 0x03, 0x08, 0xc9, 0x78, 0x42, 0x5a, 0x86, 0xa8, 0x42, 0xf8, 0x35, 0xea, 0x28,
+	0x9e, 0xf3, 0xdb, 0x86, 0x2f, 0x01, 0xfc, 0x3e, 0xda, 0x11, 0x40, 0x25, 0x4f, 0x48, 0xdb, 0x50,
+	0x6e, 0xa5, 0x75, 0xdd, 0x99, 0x21, 0x3a, 0xbc, 0x25, 0x4f, 0xac, 0x6e, 0x05, 0x5a, 0x89, 0xf0,
+	0x15, 0xd9, 0x59, 0x8b, 0xf0, 0x95, 0xd6, 0xb6, 0x75, 0x26, 0x4f, 0xad, 0xb6, 0x5d, 0xe1, 0x3e,
+	0x42, 0x02, 0x96, 0x0c, 0xae, 0x8d, 0xf4, 0x33, 0xc3, 0x55, 0x90, 0x2a, 0xef, 0x2b, 0xd2, 0x59,
+	0xe5, 0x7d, 0x55, 0xf2, 0xdf, 0x73, 0x05, 0x04, 0x55, 0x79, 0x8d, 0x0c, 0xff, 0xf2, 0xd0, 0xbe,
+	0x2b, 0xe4, 0x39, 0x53, 0x3f, 0x47, 0x82, 0x5e, 0xd3, 0x58, 0xd7, 0x41, 0x5a, 0xb0, 0xb8, 0x9b,
+	0x12, 0xd0, 0x9a, 0x7c, 0x36, 0x03, 0xc1, 0x92, 0xf9, 0x49, 0xe4, 0xca, 0x58, 0x41, 0x30, 0x46,
+	0x6d, 0x05, 0x62, 0xe1, 0x0a, 0x68, 0xfe, 0x3f, 0x56, 0xbb, 0x6b, 0xb7, 0x6f, 0x52, 0xd6, 0xae,
+	0x02, 0xad, 0x44, 0x94, 0xb5, 0xab, 0x40, 0xc3, 0x3f, 0x3c, 0x84, 0x4f, 0x05, 0xe8, 0x72, 0x32,
+	0xc9, 0x14, 0x9c, 0x53, 0xb6, 0x04, 0xf1, 0x3f, 0x87, 0x28, 0x13, 0x6a, 0xad, 0x24, 0xf4, 0x1a,
+	0x75, 0xe6, 0x82, 0x26, 0xf6, 0x2a, 0x5d, 0x0b, 0x14, 0x40, 0x85, 0xf5, 0x95, 0x3b, 0x49, 0x09,
+	0x68, 0x16, 0x6e, 0x52, 0x26, 0x40, 0xfa, 0xca, 0x1d, 0xa5, 0x04, 0x86, 0xbf, 0x77, 0x50, 0x6f,
+	0x62, 0xe7, 0xc5, 0x77, 0x43, 0x74, 0x26, 0x00, 0xf0, 0x01, 0x7a, 0xc2, 0x92, 0x08, 0x6e, 0x5c,
+	0x8e, 0x76, 0xa1, 0x87, 0xc3, 0x0d, 0x97, 0x4b, 0x30, 0x5f, 0xea, 0x82, 0xb0, 0x44, 0x2a, 0xa6,
+	0x32, 0x55, 0xb6, 0x69, 0x15, 0xc2, 0xaf, 0xd0, 0xb3, 0x90, 0x67, 0x42, 0xc2, 0x49, 0xe4, 0x92,
+	0x2c, 0xd6, 0x66, 0xb4, 0x8a, 0x59, 0xc9, 0x5b, 0xdd, 0xe6, 0x5a, 0x27, 0xf0, 0x10, 0x3d, 0x57,
+	0x5c, 0xd1, 0xf8, 0x48, 0x40, 0xc4, 0x94, 0x34, 0xd5, 0x6f, 0x07, 0x2b, 0x18, 0xfe, 0x1c, 0xf5,
+	0xec, 0x3a, 0x1f, 0xcc, 0x6f, 0xf4, 0x5e, 0xa6, 0x8f, 0xdb, 0x41, 0x13, 0x85, 0x3f, 0x42, 0xbb,
+	0x21, 0x87, 0xd9, 0x8c, 0x85, 0x0c, 0x12, 0x75, 0x9c, 0x52, 0xd3, 0xd8, 0xad, 0x60, 0x0d, 0xc5,
+	0x23, 0xf4, 0xa2, 0x98, 0xf6, 0x33, 0xed, 0x37, 0x92, 0x74, 0x8c, 0x09, 0xac, 0xc3, 0xf8, 0x0d,
+	0xda, 0x63, 0xc9, 0xa9, 0x73, 0x36, 0x17, 0x8a, 0x4c, 0x68, 0x0d, 0xd7, 0xaa, 0x74, 0x49, 0x59,
+	0x4c, 0x2f, 0x63, 0x70, 0xa1, 0x5d, 0xab, 0xba, 0x06, 0xe3, 0x09, 0xda, 0xcb, 0xed, 0x2e, 0xd7,
+	0x20, 0xcf, 0x07, 0xde, 0xa8, 0xfb, 0xee, 0xe3, 0x71, 0xa3, 0xf9, 0x8e, 0xfd, 0xb5, 0xf0, 0xa0,
+	0x26, 0xa0, 0x45, 0x4b, 0x73, 0x9d, 0xd8, 0x99, 0x7f, 0xef, 0x51, 0xd1, 0xe3, 0xb5, 0xf0, 0xa0,
+	0x26, 0x60, 0xee, 0x40, 0xd0, 0x44, 0xce, 0x40, 0x88, 0x8a, 0x65, 0xee, 0x9a, 0x73, 0x35, 0x51,
+	0xf8, 0x02, 0xe1, 0xa2, 0x88, 0xb9, 0x41, 0x4b, 0xf2, 0x62, 0xb0, 0x3d, 0xea, 0xbe, 0x1b, 0x6f,
+	0x48, 0x64, 0x83, 0x91, 0x07, 0x0d, 0x4a, 0xf8, 0x27, 0xd4, 0xab, 0x3c, 0x0a, 0x81, 0xb5, 0x3a,
+	0x49, 0xf6, 0xcc, 0x06, 0x9f, 0x6c, 0xda, 0xa0, 0xf6, 0x45, 0xd0, 0xa4, 0xa2, 0xaf, 0x5b, 0x97,
+	0x00, 0x4e, 0x39, 0x4b, 0x94, 0x3c, 0xd3, 0x2d, 0x46, 0xf6, 0x4d, 0x0f, 0xd7, 0x70, 0xed, 0x56,
+	0xf3, 0x94, 0xe6, 0x0d, 0x8c, 0x4d, 0x57, 0x56, 0x10, 0x7c, 0x81, 0x0e, 0xc2, 0xfa, 0xe3, 0x21,
+	0x49, 0xcf, 0x64, 0xfa, 0x66, 0x43, 0xa6, 0x0d, 0xef, 0x4d, 0xd0, 0xa8, 0x83, 0xbf, 0x2d, 0xfd,
+	0x8b, 0xc6, 0x92, 0x1c, 0x18, 0xd9, 0xd1, 0x06, 0xd9, 0x9a, 0x15, 0x07, 0xd5, 0x8f, 0x75, 0x51,
+	0xd3, 0x9a, 0xd1, 0x49, 0xf2, 0xf2, 0xd1, 0xa2, 0xd6, 0xad, 0x31, 0x68, 0x52, 0x39, 0xfc, 0xea,
+	0xef, 0xfb, 0xbe, 0x77, 0x77, 0xdf, 0xf7, 0xfe, 0xbd, 0xef, 0x7b, 0xbf, 0x3d, 0xf4, 0xb7, 0xee,
+	0x1e, 0xfa, 0x5b, 0xff, 0x3c, 0xf4, 0xb7, 0x7e, 0xfc, 0x70, 0xf5, 0xd1, 0xbf, 0x29, 0x9e, 0x7d,
+	0x75, 0x9b, 0x82, 0xbc, 0xdc, 0x31, 0x2f, 0xfd, 0x97, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x52,
+	0x6f, 0xee, 0xb0, 0xc4, 0x08, 0x00, 0x00,
 }
 
 func (m *ElectiveCompletionGroup) Marshal() (dAtA []byte, err error) {
@@ -325,7 +670,7 @@ func (m *ElectiveCompletionGroup) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *StudentAcademicTree) Marshal() (dAtA []byte, err error) {
+func (m *CurriculumMigration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -335,76 +680,356 @@ func (m *StudentAcademicTree) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StudentAcademicTree) MarshalTo(dAtA []byte) (int, error) {
+func (m *CurriculumMigration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StudentAcademicTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CurriculumMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EquivalenceRequests) > 0 {
-		for iNdEx := len(m.EquivalenceRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EquivalenceRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
+	if len(m.ReviewNote) > 0 {
+		i -= len(m.ReviewNote)
+		copy(dAtA[i:], m.ReviewNote)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.ReviewNote)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.CompletedElectives) > 0 {
-		for iNdEx := len(m.CompletedElectives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CompletedElectives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x7a
-		}
+	if len(m.ReviewedAt) > 0 {
+		i -= len(m.ReviewedAt)
+		copy(dAtA[i:], m.ReviewedAt)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.ReviewedAt)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.TransferredSubjects) > 0 {
-		for iNdEx := len(m.TransferredSubjects) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TransferredSubjects[iNdEx])
-			copy(dAtA[i:], m.TransferredSubjects[iNdEx])
-			i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.TransferredSubjects[iNdEx])))
-			i--
-			dAtA[i] = 0x72
-		}
+	if len(m.ReviewedBy) > 0 {
+		i -= len(m.ReviewedBy)
+		copy(dAtA[i:], m.ReviewedBy)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.ReviewedBy)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.GraduationStatus != nil {
-		{
-			size, err := m.GraduationStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
-		}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.Status)))
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x3a
 	}
-	if m.AcademicProgress != nil {
-		{
-			size, err := m.AcademicProgress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
-		}
+	if len(m.RequestedAt) > 0 {
+		i -= len(m.RequestedAt)
+		copy(dAtA[i:], m.RequestedAt)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.RequestedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ToVersion) > 0 {
+		i -= len(m.ToVersion)
+		copy(dAtA[i:], m.ToVersion)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.ToVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromVersion) > 0 {
+		i -= len(m.FromVersion)
+		copy(dAtA[i:], m.FromVersion)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.FromVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubjectWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubjectWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubjectWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnAt) > 0 {
+		i -= len(m.WithdrawnAt)
+		copy(dAtA[i:], m.WithdrawnAt)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.WithdrawnAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WithdrawnBy) > 0 {
+		i -= len(m.WithdrawnBy)
+		copy(dAtA[i:], m.WithdrawnBy)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.WithdrawnBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OfferingId) > 0 {
+		i -= len(m.OfferingId)
+		copy(dAtA[i:], m.OfferingId)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.OfferingId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrerequisiteWaiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrerequisiteWaiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrerequisiteWaiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GrantedAt) > 0 {
+		i -= len(m.GrantedAt)
+		copy(dAtA[i:], m.GrantedAt)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.GrantedAt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GrantedBy) > 0 {
+		i -= len(m.GrantedBy)
+		copy(dAtA[i:], m.GrantedBy)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.GrantedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StudentAcademicTree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StudentAcademicTree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StudentAcademicTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrerequisiteWaivers) > 0 {
+		for iNdEx := len(m.PrerequisiteWaivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrerequisiteWaivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.CurriculumMigrations) > 0 {
+		for iNdEx := len(m.CurriculumMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurriculumMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.GpaCredits != 0 {
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(m.GpaCredits))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.GradePointsTotal) > 0 {
+		i -= len(m.GradePointsTotal)
+		copy(dAtA[i:], m.GradePointsTotal)
+		i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.GradePointsTotal)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.EquivalenceRequests) > 0 {
+		for iNdEx := len(m.EquivalenceRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EquivalenceRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CompletedElectives) > 0 {
+		for iNdEx := len(m.CompletedElectives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletedElectives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TransferredSubjects) > 0 {
+		for iNdEx := len(m.TransferredSubjects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TransferredSubjects[iNdEx])
+			copy(dAtA[i:], m.TransferredSubjects[iNdEx])
+			i = encodeVarintStudentAcademicTree(dAtA, i, uint64(len(m.TransferredSubjects[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.GraduationStatus != nil {
+		{
+			size, err := m.GraduationStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.AcademicProgress != nil {
+		{
+			size, err := m.AcademicProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStudentAcademicTree(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x62
 	}
@@ -519,65 +1144,176 @@ func (m *ElectiveCompletionGroup) Size() (n int) {
 	return n
 }
 
-func (m *StudentAcademicTree) Size() (n int) {
+func (m *CurriculumMigration) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Index)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	l = len(m.Student)
+	l = len(m.FromVersion)
 	if l > 0 {
 		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	l = len(m.Institution)
+	l = len(m.ToVersion)
 	if l > 0 {
 		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	l = len(m.CourseId)
+	l = len(m.Reason)
 	if l > 0 {
 		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	l = len(m.CurriculumVersion)
+	l = len(m.RequestedBy)
 	if l > 0 {
 		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	if m.TotalCredits != 0 {
-		n += 1 + sovStudentAcademicTree(uint64(m.TotalCredits))
-	}
-	if m.TotalCompletedHours != 0 {
-		n += 1 + sovStudentAcademicTree(uint64(m.TotalCompletedHours))
-	}
-	if m.CoefficientGpa != 0 {
-		n += 5
-	}
-	if len(m.CompletedTokens) > 0 {
-		for _, s := range m.CompletedTokens {
-			l = len(s)
-			n += 1 + l + sovStudentAcademicTree(uint64(l))
-		}
+	l = len(m.RequestedAt)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	if len(m.InProgressTokens) > 0 {
-		for _, s := range m.InProgressTokens {
-			l = len(s)
-			n += 1 + l + sovStudentAcademicTree(uint64(l))
-		}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	if len(m.AvailableTokens) > 0 {
-		for _, s := range m.AvailableTokens {
-			l = len(s)
-			n += 1 + l + sovStudentAcademicTree(uint64(l))
-		}
+	l = len(m.ReviewedBy)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	if m.AcademicProgress != nil {
-		l = m.AcademicProgress.Size()
+	l = len(m.ReviewedAt)
+	if l > 0 {
 		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
-	if m.GraduationStatus != nil {
-		l = m.GraduationStatus.Size()
+	l = len(m.ReviewNote)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	return n
+}
+
+func (m *SubjectWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.OfferingId)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.WithdrawnBy)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.WithdrawnAt)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	return n
+}
+
+func (m *PrerequisiteWaiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.GrantedBy)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.GrantedAt)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	return n
+}
+
+func (m *StudentAcademicTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.Student)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.CourseId)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	l = len(m.CurriculumVersion)
+	if l > 0 {
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	if m.TotalCredits != 0 {
+		n += 1 + sovStudentAcademicTree(uint64(m.TotalCredits))
+	}
+	if m.TotalCompletedHours != 0 {
+		n += 1 + sovStudentAcademicTree(uint64(m.TotalCompletedHours))
+	}
+	if m.CoefficientGpa != 0 {
+		n += 5
+	}
+	if len(m.CompletedTokens) > 0 {
+		for _, s := range m.CompletedTokens {
+			l = len(s)
+			n += 1 + l + sovStudentAcademicTree(uint64(l))
+		}
+	}
+	if len(m.InProgressTokens) > 0 {
+		for _, s := range m.InProgressTokens {
+			l = len(s)
+			n += 1 + l + sovStudentAcademicTree(uint64(l))
+		}
+	}
+	if len(m.AvailableTokens) > 0 {
+		for _, s := range m.AvailableTokens {
+			l = len(s)
+			n += 1 + l + sovStudentAcademicTree(uint64(l))
+		}
+	}
+	if m.AcademicProgress != nil {
+		l = m.AcademicProgress.Size()
+		n += 1 + l + sovStudentAcademicTree(uint64(l))
+	}
+	if m.GraduationStatus != nil {
+		l = m.GraduationStatus.Size()
 		n += 1 + l + sovStudentAcademicTree(uint64(l))
 	}
 	if len(m.TransferredSubjects) > 0 {
@@ -598,16 +1334,767 @@ func (m *StudentAcademicTree) Size() (n int) {
 			n += 2 + l + sovStudentAcademicTree(uint64(l))
 		}
 	}
-	return n
-}
+	l = len(m.GradePointsTotal)
+	if l > 0 {
+		n += 2 + l + sovStudentAcademicTree(uint64(l))
+	}
+	if m.GpaCredits != 0 {
+		n += 2 + sovStudentAcademicTree(uint64(m.GpaCredits))
+	}
+	if len(m.CurriculumMigrations) > 0 {
+		for _, e := range m.CurriculumMigrations {
+			l = e.Size()
+			n += 2 + l + sovStudentAcademicTree(uint64(l))
+		}
+	}
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 2 + l + sovStudentAcademicTree(uint64(l))
+		}
+	}
+	if len(m.PrerequisiteWaivers) > 0 {
+		for _, e := range m.PrerequisiteWaivers {
+			l = e.Size()
+			n += 2 + l + sovStudentAcademicTree(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStudentAcademicTree(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStudentAcademicTree(x uint64) (n int) {
+	return sovStudentAcademicTree(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ElectiveCompletionGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStudentAcademicTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElectiveCompletionGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElectiveCompletionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedSubjects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletedSubjects = append(m.CompletedSubjects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStudentAcademicTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurriculumMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStudentAcademicTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurriculumMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurriculumMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewNote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewNote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStudentAcademicTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubjectWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStudentAcademicTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubjectWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubjectWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStudentAcademicTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovStudentAcademicTree(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStudentAcademicTree(x uint64) (n int) {
-	return sovStudentAcademicTree(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ElectiveCompletionGroup) Unmarshal(dAtA []byte) error {
+func (m *PrerequisiteWaiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -630,15 +2117,15 @@ func (m *ElectiveCompletionGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ElectiveCompletionGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrerequisiteWaiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ElectiveCompletionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrerequisiteWaiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -666,11 +2153,11 @@ func (m *ElectiveCompletionGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedSubjects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -698,7 +2185,103 @@ func (m *ElectiveCompletionGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CompletedSubjects = append(m.CompletedSubjects, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1227,6 +2810,159 @@ func (m *StudentAcademicTree) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradePointsTotal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GradePointsTotal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpaCredits", wireType)
+			}
+			m.GpaCredits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GpaCredits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurriculumMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurriculumMigrations = append(m.CurriculumMigrations, &CurriculumMigration{})
+			if err := m.CurriculumMigrations[len(m.CurriculumMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, &SubjectWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrerequisiteWaivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentAcademicTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentAcademicTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrerequisiteWaivers = append(m.PrerequisiteWaivers, &PrerequisiteWaiver{})
+			if err := m.PrerequisiteWaivers[len(m.PrerequisiteWaivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStudentAcademicTree(dAtA[iNdEx:])
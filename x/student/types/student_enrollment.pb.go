@@ -30,6 +30,8 @@ type StudentEnrollment struct {
 	EnrollmentDate string `protobuf:"bytes,5,opt,name=enrollmentDate,proto3" json:"enrollmentDate,omitempty"`
 	Status         string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	AcademicTreeId string `protobuf:"bytes,7,opt,name=academicTreeId,proto3" json:"academicTreeId,omitempty"`
+	// curriculumVersion is the published curriculum version the enrollment is pinned to
+	CurriculumVersion string `protobuf:"bytes,8,opt,name=curriculumVersion,proto3" json:"curriculumVersion,omitempty"`
 }
 
 func (m *StudentEnrollment) Reset()         { *m = StudentEnrollment{} }
@@ -114,6 +116,13 @@ func (m *StudentEnrollment) GetAcademicTreeId() string {
 	return ""
 }
 
+func (m *StudentEnrollment) GetCurriculumVersion() string {
+	if m != nil {
+		return m.CurriculumVersion
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StudentEnrollment)(nil), "academictoken.student.StudentEnrollment")
 }
@@ -123,23 +132,24 @@ func init() {
 }
 
 var fileDescriptor_52449f9ee4aa1da4 = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0x4c, 0x4e, 0x4c,
-	0x49, 0xcd, 0xcd, 0x4c, 0x2e, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2f, 0x2e, 0x29, 0x4d, 0x49, 0xcd,
-	0x2b, 0x81, 0xd1, 0xf1, 0xa9, 0x79, 0x45, 0xf9, 0x39, 0x39, 0xb9, 0xa9, 0x79, 0x25, 0x7a, 0x05,
-	0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x28, 0xea, 0xf5, 0xa0, 0xea, 0x94, 0x5e, 0x32, 0x72, 0x09,
-	0x06, 0x43, 0xd8, 0xae, 0x70, 0x2d, 0x42, 0x22, 0x5c, 0xac, 0x99, 0x79, 0x29, 0xa9, 0x15, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x04, 0x17, 0x3b, 0x54, 0x9b, 0x04, 0x13,
-	0x58, 0x1c, 0xc6, 0x15, 0x52, 0xe0, 0xe2, 0xce, 0xcc, 0x2b, 0x2e, 0xc9, 0x2c, 0x29, 0x2d, 0xc9,
-	0xcc, 0xcf, 0x93, 0x60, 0x06, 0xcb, 0x22, 0x0b, 0x09, 0x49, 0x71, 0x71, 0x24, 0xe7, 0x97, 0x16,
-	0x15, 0xa7, 0x7a, 0xa6, 0x48, 0xb0, 0x80, 0xa5, 0xe1, 0x7c, 0x21, 0x35, 0x2e, 0x3e, 0x84, 0x73,
-	0x5d, 0x12, 0x4b, 0x52, 0x25, 0x58, 0xc1, 0x2a, 0xd0, 0x44, 0x85, 0xc4, 0xb8, 0xd8, 0x8a, 0x4b,
-	0x12, 0x4b, 0x4a, 0x8b, 0x25, 0xd8, 0xc0, 0xf2, 0x50, 0x1e, 0x48, 0x3f, 0xcc, 0x73, 0x21, 0x45,
-	0xa9, 0x20, 0x1b, 0xd8, 0x21, 0xfa, 0x51, 0x45, 0x9d, 0xcc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x16, 0x35, 0x30, 0x2b, 0xe0, 0xc1, 0x59, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0x0e, 0x42, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x65, 0xc0, 0x39,
-	0x74, 0x01, 0x00, 0x00,
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0x3d, 0x4b, 0xc4, 0x30,
+	0x18, 0x6e, 0x4e, 0xaf, 0x77, 0x46, 0x10, 0x2e, 0xa8, 0x04, 0xc1, 0x70, 0x38, 0x88, 0x83, 0xd4,
+	0xc1, 0xc1, 0x5d, 0x74, 0x70, 0x55, 0x71, 0x70, 0x91, 0x9a, 0xbe, 0x43, 0xb0, 0x4d, 0x8e, 0xe4,
+	0x0d, 0x9c, 0xff, 0xc2, 0xcd, 0xbf, 0xe4, 0xd8, 0xd1, 0x51, 0xda, 0x3f, 0x22, 0x4d, 0x3f, 0xb4,
+	0x3a, 0x25, 0xcf, 0x17, 0x79, 0xf2, 0xd0, 0x24, 0x95, 0x69, 0x06, 0x85, 0x92, 0x68, 0x5e, 0x40,
+	0x9f, 0x39, 0xf4, 0x19, 0x68, 0xec, 0xcf, 0x27, 0xd0, 0xd6, 0xe4, 0x79, 0x01, 0x1a, 0x93, 0x95,
+	0x35, 0x68, 0xd8, 0xde, 0xc8, 0x9f, 0x74, 0xbe, 0xa3, 0xf7, 0x09, 0x5d, 0xdc, 0xb5, 0xf7, 0xeb,
+	0x21, 0xc2, 0x76, 0xe9, 0x54, 0xe9, 0x0c, 0xd6, 0x9c, 0x2c, 0xc9, 0xc9, 0xd6, 0x6d, 0x0b, 0x18,
+	0xa7, 0xb3, 0x2e, 0xc6, 0x27, 0x81, 0xef, 0x21, 0x5b, 0xd2, 0x6d, 0xa5, 0x1d, 0x2a, 0xf4, 0xa8,
+	0x8c, 0xe6, 0x1b, 0x41, 0xfd, 0x4d, 0xb1, 0x03, 0x3a, 0x97, 0xc6, 0x5b, 0x07, 0x37, 0x19, 0xdf,
+	0x0c, 0xf2, 0x80, 0xd9, 0x31, 0xdd, 0xf9, 0xa9, 0x7b, 0x95, 0x22, 0xf0, 0x69, 0x70, 0xfc, 0x61,
+	0xd9, 0x3e, 0x8d, 0x1d, 0xa6, 0xe8, 0x1d, 0x8f, 0x83, 0xde, 0xa1, 0x26, 0xdf, 0x7f, 0xee, 0xde,
+	0x42, 0xf3, 0xc2, 0xac, 0xcd, 0x8f, 0x59, 0x76, 0x4a, 0x17, 0xd2, 0x5b, 0xab, 0xa4, 0xcf, 0x7d,
+	0xf1, 0x00, 0xd6, 0x35, 0x5d, 0xe7, 0xc1, 0xfa, 0x5f, 0xb8, 0xbc, 0xf8, 0xa8, 0x04, 0x29, 0x2b,
+	0x41, 0xbe, 0x2a, 0x41, 0xde, 0x6a, 0x11, 0x95, 0xb5, 0x88, 0x3e, 0x6b, 0x11, 0x3d, 0x1e, 0x8e,
+	0xa7, 0x5f, 0x0f, 0xe3, 0xe3, 0xeb, 0x0a, 0xdc, 0x73, 0x1c, 0x06, 0x3f, 0xff, 0x0e, 0x00, 0x00,
+	0xff, 0xff, 0x2a, 0x62, 0x26, 0x8c, 0xa2, 0x01, 0x00, 0x00,
 }
 
 func (m *StudentEnrollment) Marshal() (dAtA []byte, err error) {
@@ -162,6 +172,13 @@ func (m *StudentEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CurriculumVersion) > 0 {
+		i -= len(m.CurriculumVersion)
+		copy(dAtA[i:], m.CurriculumVersion)
+		i = encodeVarintStudentEnrollment(dAtA, i, uint64(len(m.CurriculumVersion)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.AcademicTreeId) > 0 {
 		i -= len(m.AcademicTreeId)
 		copy(dAtA[i:], m.AcademicTreeId)
@@ -259,6 +276,10 @@ func (m *StudentEnrollment) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStudentEnrollment(uint64(l))
 	}
+	l = len(m.CurriculumVersion)
+	if l > 0 {
+		n += 1 + l + sovStudentEnrollment(uint64(l))
+	}
 	return n
 }
 
@@ -521,6 +542,38 @@ func (m *StudentEnrollment) Unmarshal(dAtA []byte) error {
 			}
 			m.AcademicTreeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurriculumVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStudentEnrollment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStudentEnrollment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStudentEnrollment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurriculumVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStudentEnrollment(dAtA[iNdEx:])
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Statuses of an enrollment request against a subject offering
+const (
+	OfferingStatusEnrolled   = "enrolled"
+	OfferingStatusWaitlisted = "waitlisted"
+)
+
+// HasFreeSeat reports whether the offering can take another student
+func (offering SubjectOffering) HasFreeSeat() bool {
+	return uint64(len(offering.EnrolledStudents)) < offering.Capacity
+}
+
+// IsEnrolled reports whether a student holds a seat in the offering
+func (offering SubjectOffering) IsEnrolled(studentId string) bool {
+	return slices.Contains(offering.EnrolledStudents, studentId)
+}
+
+// WaitlistPosition returns the 1-based position of a student in the
+// waitlist, or 0 when the student is not waiting
+func (offering SubjectOffering) WaitlistPosition(studentId string) uint64 {
+	return uint64(slices.Index(offering.Waitlist, studentId) + 1)
+}
+
+// Validate checks the fields and seat assignments of an offering
+func (offering SubjectOffering) Validate() error {
+	if offering.Index == "" || offering.SubjectId == "" || offering.Term == "" || offering.Section == "" {
+		return ErrInvalidSubjectOffering.Wrap("index, subject, term and section are required")
+	}
+	if offering.Capacity == 0 {
+		return ErrInvalidSubjectOffering.Wrap("capacity must be positive")
+	}
+	if uint64(len(offering.EnrolledStudents)) > offering.Capacity {
+		return ErrInvalidSubjectOffering.Wrapf("%d students enrolled past a capacity of %d", len(offering.EnrolledStudents), offering.Capacity)
+	}
+
+	seen := make(map[string]bool)
+	for _, studentId := range append(slices.Clone(offering.EnrolledStudents), offering.Waitlist...) {
+		if seen[studentId] {
+			return ErrInvalidSubjectOffering.Wrapf("student %s holds more than one place", studentId)
+		}
+		seen[studentId] = true
+	}
+	return nil
+}
+
+// Label identifies the offering by subject, term and section in errors
+func (offering SubjectOffering) Label() string {
+	return fmt.Sprintf("%s/%s/%s", offering.SubjectId, offering.Term, offering.Section)
+}
@@ -0,0 +1,831 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: academictoken/student/subject_offering.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubjectOffering is a section of a subject taught in an academic term with a
+// limited number of seats. Students requesting a full offering wait in line
+// and are promoted in order as seats free up.
+type SubjectOffering struct {
+	Index            string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	SubjectId        string   `protobuf:"bytes,2,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	Institution      string   `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
+	CourseId         string   `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	Term             string   `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+	Section          string   `protobuf:"bytes,6,opt,name=section,proto3" json:"section,omitempty"`
+	Capacity         uint64   `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Instructor       string   `protobuf:"bytes,8,opt,name=instructor,proto3" json:"instructor,omitempty"`
+	EnrolledStudents []string `protobuf:"bytes,9,rep,name=enrolledStudents,proto3" json:"enrolledStudents,omitempty"`
+	Waitlist         []string `protobuf:"bytes,10,rep,name=waitlist,proto3" json:"waitlist,omitempty"`
+	Creator          string   `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *SubjectOffering) Reset()         { *m = SubjectOffering{} }
+func (m *SubjectOffering) String() string { return proto.CompactTextString(m) }
+func (*SubjectOffering) ProtoMessage()    {}
+func (*SubjectOffering) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a29da843c58bb2f1, []int{0}
+}
+func (m *SubjectOffering) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubjectOffering) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubjectOffering.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubjectOffering) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubjectOffering.Merge(m, src)
+}
+func (m *SubjectOffering) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubjectOffering) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubjectOffering.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubjectOffering proto.InternalMessageInfo
+
+func (m *SubjectOffering) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *SubjectOffering) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
+}
+
+func (m *SubjectOffering) GetInstitution() string {
+	if m != nil {
+		return m.Institution
+	}
+	return ""
+}
+
+func (m *SubjectOffering) GetCourseId() string {
+	if m != nil {
+		return m.CourseId
+	}
+	return ""
+}
+
+func (m *SubjectOffering) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *SubjectOffering) GetSection() string {
+	if m != nil {
+		return m.Section
+	}
+	return ""
+}
+
+func (m *SubjectOffering) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *SubjectOffering) GetInstructor() string {
+	if m != nil {
+		return m.Instructor
+	}
+	return ""
+}
+
+func (m *SubjectOffering) GetEnrolledStudents() []string {
+	if m != nil {
+		return m.EnrolledStudents
+	}
+	return nil
+}
+
+func (m *SubjectOffering) GetWaitlist() []string {
+	if m != nil {
+		return m.Waitlist
+	}
+	return nil
+}
+
+func (m *SubjectOffering) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SubjectOffering)(nil), "academictoken.student.SubjectOffering")
+}
+
+func init() {
+	proto.RegisterFile("academictoken/student/subject_offering.proto", fileDescriptor_a29da843c58bb2f1)
+}
+
+var fileDescriptor_a29da843c58bb2f1 = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x3d, 0x4f, 0xf3, 0x30,
+	0x10, 0x80, 0x9b, 0x7e, 0xe7, 0x3a, 0xbc, 0xaf, 0x2c, 0x90, 0x2c, 0x04, 0x56, 0xc4, 0x54, 0x21,
+	0xd4, 0x0e, 0x0c, 0xec, 0x6c, 0x9d, 0x90, 0xda, 0x8d, 0x05, 0xa5, 0xf6, 0x15, 0x19, 0x5a, 0xbb,
+	0xb2, 0x2f, 0xa2, 0xfd, 0x17, 0xfc, 0x2c, 0x36, 0x3a, 0x32, 0xa2, 0xe6, 0x8f, 0xa0, 0x38, 0x21,
+	0xb4, 0x62, 0xf3, 0xf3, 0xdc, 0xa7, 0x7c, 0x70, 0x9d, 0xca, 0x54, 0xe1, 0x4a, 0x4b, 0xb2, 0x2f,
+	0x68, 0xc6, 0x9e, 0x32, 0x85, 0x86, 0xc6, 0x3e, 0x9b, 0x3f, 0xa3, 0xa4, 0x47, 0xbb, 0x58, 0xa0,
+	0xd3, 0xe6, 0x69, 0xb4, 0x76, 0x96, 0x2c, 0x3b, 0x3d, 0xca, 0x1e, 0x55, 0xd9, 0x97, 0x1f, 0x4d,
+	0xf8, 0x37, 0x2b, 0x2b, 0xee, 0xab, 0x02, 0x76, 0x02, 0x1d, 0x6d, 0x14, 0x6e, 0x78, 0x94, 0x44,
+	0xc3, 0x78, 0x5a, 0x02, 0x3b, 0x87, 0xb8, 0x6a, 0x3d, 0x51, 0xbc, 0x19, 0x22, 0xbf, 0x82, 0x25,
+	0x30, 0xd0, 0xc6, 0x93, 0xa6, 0x8c, 0xb4, 0x35, 0xbc, 0x15, 0xe2, 0x87, 0x8a, 0x9d, 0x41, 0x5f,
+	0xda, 0xcc, 0x79, 0x9c, 0x28, 0xde, 0x0e, 0xe1, 0x9a, 0x19, 0x83, 0x36, 0xa1, 0x5b, 0xf1, 0x4e,
+	0xf0, 0xe1, 0xcd, 0x38, 0xf4, 0x3c, 0xca, 0xd0, 0xad, 0x1b, 0xf4, 0x0f, 0x86, 0x4e, 0xe9, 0x3a,
+	0x95, 0x9a, 0xb6, 0xbc, 0x97, 0x44, 0xc3, 0xf6, 0xb4, 0x66, 0x26, 0x00, 0x8a, 0xa1, 0x2e, 0x93,
+	0x64, 0x1d, 0xef, 0x87, 0xc2, 0x03, 0xc3, 0xae, 0xe0, 0x3f, 0x1a, 0x67, 0x97, 0x4b, 0x54, 0xb3,
+	0xf2, 0x0b, 0x3c, 0x8f, 0x93, 0xd6, 0x30, 0x9e, 0xfe, 0xf1, 0xc5, 0x9c, 0xd7, 0x54, 0xd3, 0x52,
+	0x7b, 0xe2, 0x10, 0x72, 0x6a, 0x2e, 0xb6, 0x93, 0x0e, 0xd3, 0x62, 0xc8, 0xa0, 0xdc, 0xae, 0xc2,
+	0xbb, 0xdb, 0xf7, 0xbd, 0x88, 0x76, 0x7b, 0x11, 0x7d, 0xed, 0x45, 0xf4, 0x96, 0x8b, 0xc6, 0x2e,
+	0x17, 0x8d, 0xcf, 0x5c, 0x34, 0x1e, 0x2e, 0x8e, 0x0f, 0xb6, 0xa9, 0x4f, 0x46, 0xdb, 0x35, 0xfa,
+	0x79, 0x37, 0x1c, 0xea, 0xe6, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x8d, 0xe7, 0x51, 0xd8, 0x01,
+	0x00, 0x00,
+}
+
+func (m *SubjectOffering) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubjectOffering) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubjectOffering) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Waitlist) > 0 {
+		for iNdEx := len(m.Waitlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Waitlist[iNdEx])
+			copy(dAtA[i:], m.Waitlist[iNdEx])
+			i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.Waitlist[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EnrolledStudents) > 0 {
+		for iNdEx := len(m.EnrolledStudents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnrolledStudents[iNdEx])
+			copy(dAtA[i:], m.EnrolledStudents[iNdEx])
+			i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.EnrolledStudents[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Instructor) > 0 {
+		i -= len(m.Instructor)
+		copy(dAtA[i:], m.Instructor)
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.Instructor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Section) > 0 {
+		i -= len(m.Section)
+		copy(dAtA[i:], m.Section)
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.Section)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CourseId) > 0 {
+		i -= len(m.CourseId)
+		copy(dAtA[i:], m.CourseId)
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.CourseId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintSubjectOffering(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubjectOffering(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubjectOffering(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubjectOffering) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovSubjectOffering(uint64(l))
+	}
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovSubjectOffering(uint64(l))
+	}
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovSubjectOffering(uint64(l))
+	}
+	l = len(m.CourseId)
+	if l > 0 {
+		n += 1 + l + sovSubjectOffering(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovSubjectOffering(uint64(l))
+	}
+	l = len(m.Section)
+	if l > 0 {
+		n += 1 + l + sovSubjectOffering(uint64(l))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSubjectOffering(uint64(m.Capacity))
+	}
+	l = len(m.Instructor)
+	if l > 0 {
+		n += 1 + l + sovSubjectOffering(uint64(l))
+	}
+	if len(m.EnrolledStudents) > 0 {
+		for _, s := range m.EnrolledStudents {
+			l = len(s)
+			n += 1 + l + sovSubjectOffering(uint64(l))
+		}
+	}
+	if len(m.Waitlist) > 0 {
+		for _, s := range m.Waitlist {
+			l = len(s)
+			n += 1 + l + sovSubjectOffering(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovSubjectOffering(uint64(l))
+	}
+	return n
+}
+
+func sovSubjectOffering(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubjectOffering(x uint64) (n int) {
+	return sovSubjectOffering(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubjectOffering) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubjectOffering
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubjectOffering: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubjectOffering: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CourseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Section", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Section = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instructor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instructor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledStudents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrolledStudents = append(m.EnrolledStudents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waitlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waitlist = append(m.Waitlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubjectOffering(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubjectOffering
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubjectOffering(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubjectOffering
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubjectOffering
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubjectOffering
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubjectOffering
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubjectOffering
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubjectOffering        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubjectOffering          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubjectOffering = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1753 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: academictoken/student/transcript.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TranscriptEntry is a subject completed by the student, as recorded by its token instance
+type TranscriptEntry struct {
+	SubjectId       string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Code            string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Title           string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Grade           string `protobuf:"bytes,4,opt,name=grade,proto3" json:"grade,omitempty"`
+	Credits         uint64 `protobuf:"varint,5,opt,name=credits,proto3" json:"credits,omitempty"`
+	WorkloadHours   uint64 `protobuf:"varint,6,opt,name=workload_hours,json=workloadHours,proto3" json:"workload_hours,omitempty"`
+	KnowledgeArea   string `protobuf:"bytes,7,opt,name=knowledge_area,json=knowledgeArea,proto3" json:"knowledge_area,omitempty"`
+	TokenInstanceId string `protobuf:"bytes,8,opt,name=token_instance_id,json=tokenInstanceId,proto3" json:"token_instance_id,omitempty"`
+	CompletionDate  string `protobuf:"bytes,9,opt,name=completion_date,json=completionDate,proto3" json:"completion_date,omitempty"`
+	Institution     string `protobuf:"bytes,10,opt,name=institution,proto3" json:"institution,omitempty"`
+	Passed          bool   `protobuf:"varint,11,opt,name=passed,proto3" json:"passed,omitempty"`
+	CountsTowardGpa bool   `protobuf:"varint,12,opt,name=counts_toward_gpa,json=countsTowardGpa,proto3" json:"counts_toward_gpa,omitempty"`
+}
+
+func (m *TranscriptEntry) Reset()         { *m = TranscriptEntry{} }
+func (m *TranscriptEntry) String() string { return proto.CompactTextString(m) }
+func (*TranscriptEntry) ProtoMessage()    {}
+func (*TranscriptEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d602ec3a779e03eb, []int{0}
+}
+func (m *TranscriptEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TranscriptEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TranscriptEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TranscriptEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TranscriptEntry.Merge(m, src)
+}
+func (m *TranscriptEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TranscriptEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TranscriptEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TranscriptEntry proto.InternalMessageInfo
+
+func (m *TranscriptEntry) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
+}
+
+func (m *TranscriptEntry) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *TranscriptEntry) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *TranscriptEntry) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+func (m *TranscriptEntry) GetCredits() uint64 {
+	if m != nil {
+		return m.Credits
+	}
+	return 0
+}
+
+func (m *TranscriptEntry) GetWorkloadHours() uint64 {
+	if m != nil {
+		return m.WorkloadHours
+	}
+	return 0
+}
+
+func (m *TranscriptEntry) GetKnowledgeArea() string {
+	if m != nil {
+		return m.KnowledgeArea
+	}
+	return ""
+}
+
+func (m *TranscriptEntry) GetTokenInstanceId() string {
+	if m != nil {
+		return m.TokenInstanceId
+	}
+	return ""
+}
+
+func (m *TranscriptEntry) GetCompletionDate() string {
+	if m != nil {
+		return m.CompletionDate
+	}
+	return ""
+}
+
+func (m *TranscriptEntry) GetInstitution() string {
+	if m != nil {
+		return m.Institution
+	}
+	return ""
+}
+
+func (m *TranscriptEntry) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *TranscriptEntry) GetCountsTowardGpa() bool {
+	if m != nil {
+		return m.CountsTowardGpa
+	}
+	return false
+}
+
+// TranscriptSemester groups the subjects completed in a semester
+type TranscriptSemester struct {
+	Semester      string            `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	Subjects      []TranscriptEntry `protobuf:"bytes,2,rep,name=subjects,proto3" json:"subjects"`
+	Credits       uint64            `protobuf:"varint,3,opt,name=credits,proto3" json:"credits,omitempty"`
+	WorkloadHours uint64            `protobuf:"varint,4,opt,name=workload_hours,json=workloadHours,proto3" json:"workload_hours,omitempty"`
+	Gpa           string            `protobuf:"bytes,5,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	CumulativeGpa string            `protobuf:"bytes,6,opt,name=cumulative_gpa,json=cumulativeGpa,proto3" json:"cumulative_gpa,omitempty"`
+}
+
+func (m *TranscriptSemester) Reset()         { *m = TranscriptSemester{} }
+func (m *TranscriptSemester) String() string { return proto.CompactTextString(m) }
+func (*TranscriptSemester) ProtoMessage()    {}
+func (*TranscriptSemester) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d602ec3a779e03eb, []int{1}
+}
+func (m *TranscriptSemester) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TranscriptSemester) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TranscriptSemester.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TranscriptSemester) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TranscriptSemester.Merge(m, src)
+}
+func (m *TranscriptSemester) XXX_Size() int {
+	return m.Size()
+}
+func (m *TranscriptSemester) XXX_DiscardUnknown() {
+	xxx_messageInfo_TranscriptSemester.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TranscriptSemester proto.InternalMessageInfo
+
+func (m *TranscriptSemester) GetSemester() string {
+	if m != nil {
+		return m.Semester
+	}
+	return ""
+}
+
+func (m *TranscriptSemester) GetSubjects() []TranscriptEntry {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+func (m *TranscriptSemester) GetCredits() uint64 {
+	if m != nil {
+		return m.Credits
+	}
+	return 0
+}
+
+func (m *TranscriptSemester) GetWorkloadHours() uint64 {
+	if m != nil {
+		return m.WorkloadHours
+	}
+	return 0
+}
+
+func (m *TranscriptSemester) GetGpa() string {
+	if m != nil {
+		return m.Gpa
+	}
+	return ""
+}
+
+func (m *TranscriptSemester) GetCumulativeGpa() string {
+	if m != nil {
+		return m.CumulativeGpa
+	}
+	return ""
+}
+
+// TransferredSubject is a subject credited to the student without a token of
+// its own, e.g. through an approved equivalence
+type TransferredSubject struct {
+	SubjectId       string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Code            string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Title           string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Credits         uint64 `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	WorkloadHours   uint64 `protobuf:"varint,5,opt,name=workload_hours,json=workloadHours,proto3" json:"workload_hours,omitempty"`
+	KnowledgeArea   string `protobuf:"bytes,6,opt,name=knowledge_area,json=knowledgeArea,proto3" json:"knowledge_area,omitempty"`
+	SourceSubjectId string `protobuf:"bytes,7,opt,name=source_subject_id,json=sourceSubjectId,proto3" json:"source_subject_id,omitempty"`
+	EquivalenceType string `protobuf:"bytes,8,opt,name=equivalence_type,json=equivalenceType,proto3" json:"equivalence_type,omitempty"`
+}
+
+func (m *TransferredSubject) Reset()         { *m = TransferredSubject{} }
+func (m *TransferredSubject) String() string { return proto.CompactTextString(m) }
+func (*TransferredSubject) ProtoMessage()    {}
+func (*TransferredSubject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d602ec3a779e03eb, []int{2}
+}
+func (m *TransferredSubject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferredSubject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferredSubject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferredSubject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferredSubject.Merge(m, src)
+}
+func (m *TransferredSubject) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferredSubject) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferredSubject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferredSubject proto.InternalMessageInfo
+
+func (m *TransferredSubject) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
+}
+
+func (m *TransferredSubject) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *TransferredSubject) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *TransferredSubject) GetCredits() uint64 {
+	if m != nil {
+		return m.Credits
+	}
+	return 0
+}
+
+func (m *TransferredSubject) GetWorkloadHours() uint64 {
+	if m != nil {
+		return m.WorkloadHours
+	}
+	return 0
+}
+
+func (m *TransferredSubject) GetKnowledgeArea() string {
+	if m != nil {
+		return m.KnowledgeArea
+	}
+	return ""
+}
+
+func (m *TransferredSubject) GetSourceSubjectId() string {
+	if m != nil {
+		return m.SourceSubjectId
+	}
+	return ""
+}
+
+func (m *TransferredSubject) GetEquivalenceType() string {
+	if m != nil {
+		return m.EquivalenceType
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TranscriptEntry)(nil), "academictoken.student.TranscriptEntry")
+	proto.RegisterType((*TranscriptSemester)(nil), "academictoken.student.TranscriptSemester")
+	proto.RegisterType((*TransferredSubject)(nil), "academictoken.student.TransferredSubject")
+}
+
+func init() {
+	proto.RegisterFile("academictoken/student/transcript.proto", fileDescriptor_d602ec3a779e03eb)
+}
+
+var fileDescriptor_d602ec3a779e03eb = []byte{
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xc6, 0x49, 0x93, 0x0d, 0x6d, 0xca, 0xaa, 0xa0, 0x55, 0xa5, 0x9a, 0xa8, 0x12,
+	0x25, 0x70, 0x48, 0x24, 0x38, 0x70, 0xa6, 0x02, 0xd1, 0x5c, 0x93, 0x9c, 0xb8, 0x58, 0xdb, 0xdd,
+	0x21, 0x98, 0x38, 0x5e, 0xb3, 0x3b, 0x6e, 0xc8, 0x5b, 0x20, 0x71, 0xe5, 0x81, 0x7a, 0xec, 0x91,
+	0x13, 0x42, 0xc9, 0x2b, 0xf0, 0x00, 0x68, 0xd7, 0x4e, 0xe2, 0xa2, 0xa2, 0x72, 0xe0, 0x36, 0xf3,
+	0xcd, 0xbf, 0xab, 0x19, 0xff, 0xe3, 0x25, 0xa7, 0x5c, 0x70, 0x09, 0xb3, 0x48, 0xa0, 0x9a, 0x42,
+	0xd2, 0x37, 0x98, 0x49, 0x48, 0xb0, 0x8f, 0x9a, 0x27, 0x46, 0xe8, 0x28, 0xc5, 0x5e, 0xaa, 0x15,
+	0x2a, 0xfa, 0xe0, 0x86, 0xae, 0x57, 0xe8, 0x8e, 0x0e, 0x27, 0x6a, 0xa2, 0x9c, 0xa2, 0x6f, 0xa3,
+	0x5c, 0x7c, 0xf2, 0xb5, 0x4a, 0xda, 0xe3, 0xcd, 0x0d, 0x6f, 0x12, 0xd4, 0x0b, 0x7a, 0x4c, 0x88,
+	0xc9, 0x2e, 0x3e, 0x82, 0xc0, 0x30, 0x92, 0xcc, 0xeb, 0x78, 0xdd, 0xe6, 0xb0, 0x59, 0x90, 0x81,
+	0xa4, 0x94, 0xf8, 0x42, 0x49, 0x60, 0x3b, 0xae, 0xe0, 0x62, 0x7a, 0x48, 0x6a, 0x18, 0x61, 0x0c,
+	0xac, 0xea, 0x60, 0x9e, 0x58, 0x3a, 0xd1, 0x5c, 0x02, 0xf3, 0x73, 0xea, 0x12, 0xca, 0xc8, 0xae,
+	0xd0, 0x20, 0x23, 0x34, 0xac, 0xd6, 0xf1, 0xba, 0xfe, 0x70, 0x9d, 0xd2, 0xc7, 0x64, 0x7f, 0xae,
+	0xf4, 0x34, 0x56, 0x5c, 0x86, 0x1f, 0x54, 0xa6, 0x0d, 0xab, 0x3b, 0xc1, 0xde, 0x9a, 0x9e, 0x5b,
+	0x68, 0x65, 0xd3, 0x44, 0xcd, 0x63, 0x90, 0x13, 0x08, 0xb9, 0x06, 0xce, 0x76, 0xdd, 0xfd, 0x7b,
+	0x1b, 0xfa, 0x4a, 0x03, 0xa7, 0xcf, 0xc8, 0x7d, 0xf7, 0x05, 0xc2, 0x28, 0x31, 0xc8, 0x13, 0x01,
+	0x76, 0x9a, 0x86, 0x53, 0xb6, 0x5d, 0x61, 0x50, 0xf0, 0x81, 0xa4, 0x4f, 0x48, 0x5b, 0xa8, 0x59,
+	0x1a, 0x03, 0x46, 0x2a, 0x09, 0x25, 0x47, 0x60, 0x4d, 0xa7, 0xdc, 0xdf, 0xe2, 0xd7, 0x1c, 0x81,
+	0x76, 0x48, 0xcb, 0x5e, 0x17, 0x61, 0x66, 0x11, 0x23, 0x4e, 0x54, 0x46, 0xf4, 0x21, 0xa9, 0xa7,
+	0xdc, 0x18, 0x90, 0xac, 0xd5, 0xf1, 0xba, 0x8d, 0x61, 0x91, 0xd9, 0x76, 0x84, 0xca, 0x12, 0x34,
+	0x21, 0xaa, 0x39, 0xd7, 0x32, 0x9c, 0xa4, 0x9c, 0xdd, 0x73, 0x92, 0x76, 0x5e, 0x18, 0x3b, 0xfe,
+	0x36, 0xe5, 0x27, 0xbf, 0x3c, 0x42, 0xb7, 0xae, 0x8c, 0x60, 0x06, 0x06, 0x41, 0xd3, 0x23, 0xd2,
+	0x30, 0x45, 0x5c, 0xd8, 0xb2, 0xc9, 0xe9, 0x39, 0x69, 0x14, 0x16, 0x19, 0xb6, 0xd3, 0xa9, 0x76,
+	0x5b, 0xcf, 0x4f, 0x7b, 0xb7, 0x2e, 0x42, 0xef, 0x0f, 0xbb, 0xcf, 0xfc, 0xab, 0x1f, 0x8f, 0x2a,
+	0xc3, 0xcd, 0xe9, 0xb2, 0x3f, 0xd5, 0xbb, 0xfc, 0xf1, 0x6f, 0xf3, 0xe7, 0x80, 0x54, 0xed, 0x6c,
+	0x35, 0xd7, 0xa1, 0x0d, 0xed, 0x41, 0x91, 0xcd, 0xb2, 0x98, 0x63, 0x74, 0x09, 0x6e, 0xf0, 0x7a,
+	0xee, 0xd8, 0x96, 0xda, 0xb1, 0xbf, 0xed, 0x14, 0x63, 0xbf, 0x07, 0xad, 0x41, 0x8e, 0xf2, 0x8e,
+	0xfe, 0xdf, 0x3e, 0x96, 0x26, 0xf3, 0xef, 0x9a, 0xac, 0xf6, 0x6f, 0x9b, 0x57, 0xff, 0xcb, 0xe6,
+	0x19, 0x95, 0x69, 0x01, 0x61, 0xa9, 0xef, 0x7c, 0x47, 0xdb, 0x79, 0x61, 0xb4, 0xe9, 0xfe, 0x29,
+	0x39, 0x80, 0x4f, 0x59, 0x74, 0xc9, 0x63, 0xb0, 0x2b, 0x8a, 0x8b, 0x14, 0xd6, 0x4b, 0x5a, 0xe2,
+	0xe3, 0x45, 0x0a, 0x67, 0x2f, 0xaf, 0x96, 0x81, 0x77, 0xbd, 0x0c, 0xbc, 0x9f, 0xcb, 0xc0, 0xfb,
+	0xb2, 0x0a, 0x2a, 0xd7, 0xab, 0xa0, 0xf2, 0x7d, 0x15, 0x54, 0xde, 0x1d, 0xdf, 0x7c, 0x1a, 0x3e,
+	0x6f, 0x1f, 0x87, 0x45, 0x0a, 0xe6, 0xa2, 0xee, 0xfe, 0xf5, 0x17, 0xbf, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0xd6, 0x33, 0x93, 0xa2, 0x42, 0x04, 0x00, 0x00,
+}
+
+func (m *TranscriptEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TranscriptEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TranscriptEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CountsTowardGpa {
+		i--
+		if m.CountsTowardGpa {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CompletionDate) > 0 {
+		i -= len(m.CompletionDate)
+		copy(dAtA[i:], m.CompletionDate)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.CompletionDate)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TokenInstanceId) > 0 {
+		i -= len(m.TokenInstanceId)
+		copy(dAtA[i:], m.TokenInstanceId)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.TokenInstanceId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.KnowledgeArea) > 0 {
+		i -= len(m.KnowledgeArea)
+		copy(dAtA[i:], m.KnowledgeArea)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.KnowledgeArea)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.WorkloadHours != 0 {
+		i = encodeVarintTranscript(dAtA, i, uint64(m.WorkloadHours))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Credits != 0 {
+		i = encodeVarintTranscript(dAtA, i, uint64(m.Credits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TranscriptSemester) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TranscriptSemester) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TranscriptSemester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeGpa) > 0 {
+		i -= len(m.CumulativeGpa)
+		copy(dAtA[i:], m.CumulativeGpa)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.CumulativeGpa)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Gpa) > 0 {
+		i -= len(m.Gpa)
+		copy(dAtA[i:], m.Gpa)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.Gpa)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WorkloadHours != 0 {
+		i = encodeVarintTranscript(dAtA, i, uint64(m.WorkloadHours))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Credits != 0 {
+		i = encodeVarintTranscript(dAtA, i, uint64(m.Credits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTranscript(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Semester) > 0 {
+		i -= len(m.Semester)
+		copy(dAtA[i:], m.Semester)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.Semester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferredSubject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferredSubject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferredSubject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EquivalenceType) > 0 {
+		i -= len(m.EquivalenceType)
+		copy(dAtA[i:], m.EquivalenceType)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.EquivalenceType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SourceSubjectId) > 0 {
+		i -= len(m.SourceSubjectId)
+		copy(dAtA[i:], m.SourceSubjectId)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.SourceSubjectId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.KnowledgeArea) > 0 {
+		i -= len(m.KnowledgeArea)
+		copy(dAtA[i:], m.KnowledgeArea)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.KnowledgeArea)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WorkloadHours != 0 {
+		i = encodeVarintTranscript(dAtA, i, uint64(m.WorkloadHours))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Credits != 0 {
+		i = encodeVarintTranscript(dAtA, i, uint64(m.Credits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintTranscript(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTranscript(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTranscript(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TranscriptEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	if m.Credits != 0 {
+		n += 1 + sovTranscript(uint64(m.Credits))
+	}
+	if m.WorkloadHours != 0 {
+		n += 1 + sovTranscript(uint64(m.WorkloadHours))
+	}
+	l = len(m.KnowledgeArea)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.TokenInstanceId)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.CompletionDate)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	if m.CountsTowardGpa {
+		n += 2
+	}
+	return n
+}
+
+func (m *TranscriptSemester) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Semester)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovTranscript(uint64(l))
+		}
+	}
+	if m.Credits != 0 {
+		n += 1 + sovTranscript(uint64(m.Credits))
+	}
+	if m.WorkloadHours != 0 {
+		n += 1 + sovTranscript(uint64(m.WorkloadHours))
+	}
+	l = len(m.Gpa)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.CumulativeGpa)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferredSubject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	if m.Credits != 0 {
+		n += 1 + sovTranscript(uint64(m.Credits))
+	}
+	if m.WorkloadHours != 0 {
+		n += 1 + sovTranscript(uint64(m.WorkloadHours))
+	}
+	l = len(m.KnowledgeArea)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.SourceSubjectId)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	l = len(m.EquivalenceType)
+	if l > 0 {
+		n += 1 + l + sovTranscript(uint64(l))
+	}
+	return n
+}
+
+func sovTranscript(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTranscript(x uint64) (n int) {
+	return sovTranscript(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TranscriptEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTranscript
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TranscriptEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TranscriptEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			m.Credits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Credits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadHours", wireType)
+			}
+			m.WorkloadHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkloadHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnowledgeArea", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KnowledgeArea = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInstanceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInstanceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountsTowardGpa", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountsTowardGpa = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTranscript(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TranscriptSemester) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTranscript
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TranscriptSemester: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TranscriptSemester: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subjects = append(m.Subjects, TranscriptEntry{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			m.Credits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Credits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadHours", wireType)
+			}
+			m.WorkloadHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkloadHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpa", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gpa = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGpa", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeGpa = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTranscript(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferredSubject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTranscript
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferredSubject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferredSubject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			m.Credits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Credits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadHours", wireType)
+			}
+			m.WorkloadHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkloadHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnowledgeArea", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KnowledgeArea = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceSubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceSubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalenceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EquivalenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTranscript(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTranscript
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTranscript(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTranscript
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTranscript
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTranscript
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTranscript
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTranscript
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTranscript        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTranscript          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTranscript = fmt.Errorf("proto: unexpected end of group")
+)
@@ -425,6 +425,9 @@ type MsgRequestSubjectEnrollment struct {
 	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Student   string `protobuf:"bytes,2,opt,name=student,proto3" json:"student,omitempty"`
 	SubjectId string `protobuf:"bytes,3,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	// offeringId requests a seat in an offering of the subject. When the
+	// offering is full the student joins its waitlist.
+	OfferingId string `protobuf:"bytes,4,opt,name=offeringId,proto3" json:"offeringId,omitempty"`
 }
 
 func (m *MsgRequestSubjectEnrollment) Reset()         { *m = MsgRequestSubjectEnrollment{} }
@@ -481,7 +484,16 @@ func (m *MsgRequestSubjectEnrollment) GetSubjectId() string {
 	return ""
 }
 
+func (m *MsgRequestSubjectEnrollment) GetOfferingId() string {
+	if m != nil {
+		return m.OfferingId
+	}
+	return ""
+}
+
 type MsgRequestSubjectEnrollmentResponse struct {
+	Status           string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	WaitlistPosition uint64 `protobuf:"varint,2,opt,name=waitlistPosition,proto3" json:"waitlistPosition,omitempty"`
 }
 
 func (m *MsgRequestSubjectEnrollmentResponse) Reset()         { *m = MsgRequestSubjectEnrollmentResponse{} }
@@ -517,6 +529,20 @@ func (m *MsgRequestSubjectEnrollmentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestSubjectEnrollmentResponse proto.InternalMessageInfo
 
+func (m *MsgRequestSubjectEnrollmentResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *MsgRequestSubjectEnrollmentResponse) GetWaitlistPosition() uint64 {
+	if m != nil {
+		return m.WaitlistPosition
+	}
+	return 0
+}
+
 type MsgUpdateAcademicTree struct {
 	Creator          string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	StudentId        string   `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
@@ -637,6 +663,9 @@ type MsgCompleteSubject struct {
 	CompletionDate     string `protobuf:"bytes,5,opt,name=completionDate,proto3" json:"completionDate,omitempty"`
 	Semester           string `protobuf:"bytes,6,opt,name=semester,proto3" json:"semester,omitempty"`
 	ProfessorSignature string `protobuf:"bytes,7,opt,name=professorSignature,proto3" json:"professorSignature,omitempty"`
+	// gradeValue is the grade on the institution grading scale (e.g. "8.5",
+	// "B+" or "pass"). When empty, grade is used.
+	GradeValue string `protobuf:"bytes,8,opt,name=gradeValue,proto3" json:"gradeValue,omitempty"`
 }
 
 func (m *MsgCompleteSubject) Reset()         { *m = MsgCompleteSubject{} }
@@ -721,6 +750,13 @@ func (m *MsgCompleteSubject) GetProfessorSignature() string {
 	return ""
 }
 
+func (m *MsgCompleteSubject) GetGradeValue() string {
+	if m != nil {
+		return m.GradeValue
+	}
+	return ""
+}
+
 type MsgCompleteSubjectResponse struct {
 	NftTokenId              string  `protobuf:"bytes,1,opt,name=nftTokenId,proto3" json:"nftTokenId,omitempty"`
 	ProgressPercentage      float64 `protobuf:"fixed64,2,opt,name=progressPercentage,proto3" json:"progressPercentage,omitempty"`
@@ -909,1374 +945,4688 @@ func (m *MsgRequestEquivalenceResponse) GetEquivalenceId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "academictoken.student.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "academictoken.student.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRegisterStudent)(nil), "academictoken.student.MsgRegisterStudent")
-	proto.RegisterType((*MsgRegisterStudentResponse)(nil), "academictoken.student.MsgRegisterStudentResponse")
-	proto.RegisterType((*MsgCreateEnrollment)(nil), "academictoken.student.MsgCreateEnrollment")
-	proto.RegisterType((*MsgCreateEnrollmentResponse)(nil), "academictoken.student.MsgCreateEnrollmentResponse")
-	proto.RegisterType((*MsgUpdateEnrollmentStatus)(nil), "academictoken.student.MsgUpdateEnrollmentStatus")
-	proto.RegisterType((*MsgUpdateEnrollmentStatusResponse)(nil), "academictoken.student.MsgUpdateEnrollmentStatusResponse")
-	proto.RegisterType((*MsgRequestSubjectEnrollment)(nil), "academictoken.student.MsgRequestSubjectEnrollment")
-	proto.RegisterType((*MsgRequestSubjectEnrollmentResponse)(nil), "academictoken.student.MsgRequestSubjectEnrollmentResponse")
-	proto.RegisterType((*MsgUpdateAcademicTree)(nil), "academictoken.student.MsgUpdateAcademicTree")
-	proto.RegisterType((*MsgUpdateAcademicTreeResponse)(nil), "academictoken.student.MsgUpdateAcademicTreeResponse")
-	proto.RegisterType((*MsgCompleteSubject)(nil), "academictoken.student.MsgCompleteSubject")
-	proto.RegisterType((*MsgCompleteSubjectResponse)(nil), "academictoken.student.MsgCompleteSubjectResponse")
-	proto.RegisterType((*MsgRequestEquivalence)(nil), "academictoken.student.MsgRequestEquivalence")
-	proto.RegisterType((*MsgRequestEquivalenceResponse)(nil), "academictoken.student.MsgRequestEquivalenceResponse")
+// MsgRequestCurriculumMigration asks to move a student to a newer published
+// curriculum version of their course
+type MsgRequestCurriculumMigration struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StudentId     string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	TargetVersion string `protobuf:"bytes,3,opt,name=targetVersion,proto3" json:"targetVersion,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func init() { proto.RegisterFile("academictoken/student/tx.proto", fileDescriptor_d98bea83cb488220) }
-
-var fileDescriptor_d98bea83cb488220 = []byte{
-	// 988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x73, 0x1b, 0x35,
-	0x14, 0xce, 0xb6, 0xf9, 0x51, 0xbf, 0xa6, 0xa4, 0x88, 0xb4, 0xd9, 0x2c, 0x8d, 0x1b, 0x5c, 0x28,
-	0x21, 0x53, 0x6c, 0x1a, 0x18, 0xe8, 0xe4, 0x44, 0x53, 0x02, 0x93, 0x43, 0x66, 0x32, 0xeb, 0x72,
-	0xe1, 0xc2, 0x28, 0xbb, 0x2f, 0xcb, 0x16, 0x7b, 0xe5, 0x4a, 0xda, 0x4c, 0x7a, 0x60, 0x60, 0x3a,
-	0x9c, 0x7a, 0xe2, 0xc2, 0xff, 0xc0, 0x31, 0x07, 0xfe, 0x07, 0xca, 0xad, 0x93, 0x13, 0x17, 0x18,
-	0x26, 0x39, 0xe4, 0xdf, 0x60, 0x56, 0x92, 0x65, 0x5b, 0xf6, 0x3a, 0x09, 0xbd, 0x24, 0x7e, 0xdf,
-	0xfb, 0x24, 0x7d, 0xef, 0xd3, 0x93, 0xb4, 0x50, 0xa5, 0x11, 0x8d, 0xb1, 0x9d, 0x46, 0x92, 0x7d,
-	0x8f, 0x59, 0x43, 0xc8, 0x3c, 0xc6, 0x4c, 0x36, 0xe4, 0x41, 0xbd, 0xc3, 0x99, 0x64, 0xe4, 0xc6,
-	0x40, 0xbe, 0x6e, 0xf2, 0xc1, 0x9b, 0xb4, 0x9d, 0x66, 0xac, 0xa1, 0xfe, 0x6a, 0x66, 0xb0, 0x10,
-	0x31, 0xd1, 0x66, 0xa2, 0xd1, 0x16, 0x49, 0x63, 0xff, 0x7e, 0xf1, 0xcf, 0x24, 0x16, 0x75, 0xe2,
-	0x5b, 0x15, 0x35, 0x74, 0x60, 0x52, 0xf3, 0x09, 0x4b, 0x98, 0xc6, 0x8b, 0x5f, 0x06, 0xad, 0x8d,
-	0xd6, 0xd4, 0xa1, 0x9c, 0xb6, 0xcd, 0xc8, 0xda, 0x1f, 0x1e, 0xcc, 0x6d, 0x8b, 0xe4, 0xeb, 0x4e,
-	0x4c, 0x25, 0xee, 0xa8, 0x0c, 0xf9, 0x14, 0x2a, 0x34, 0x97, 0xdf, 0x31, 0x9e, 0xca, 0x67, 0xbe,
-	0xb7, 0xec, 0xad, 0x54, 0x36, 0xfc, 0xa3, 0xdf, 0x3f, 0x9c, 0x37, 0x4b, 0x3e, 0x8c, 0x63, 0x8e,
-	0x42, 0x34, 0x25, 0x4f, 0xb3, 0x24, 0xec, 0x51, 0xc9, 0xe7, 0x30, 0xad, 0xe7, 0xf6, 0x2f, 0x2d,
-	0x7b, 0x2b, 0x57, 0xd7, 0x96, 0xea, 0x23, 0x8b, 0xae, 0xeb, 0x65, 0x36, 0x2a, 0x2f, 0xff, 0xb9,
-	0x3d, 0xf1, 0xdb, 0xe9, 0xe1, 0xaa, 0x17, 0x9a, 0x71, 0xeb, 0xeb, 0xcf, 0x4f, 0x0f, 0x57, 0x7b,
-	0x33, 0xbe, 0x38, 0x3d, 0x5c, 0x7d, 0x7f, 0xb0, 0x88, 0x03, 0x5b, 0x86, 0xa3, 0xba, 0xb6, 0x08,
-	0x0b, 0x0e, 0x14, 0xa2, 0xe8, 0xb0, 0x4c, 0x60, 0xed, 0x09, 0x90, 0x6d, 0x91, 0x84, 0x98, 0xa4,
-	0x42, 0x22, 0x6f, 0xea, 0x09, 0x88, 0x0f, 0x33, 0x11, 0x47, 0x2a, 0x19, 0xd7, 0x45, 0x86, 0xdd,
-	0x90, 0x10, 0x98, 0xcc, 0x68, 0x1b, 0x55, 0x19, 0x95, 0x50, 0xfd, 0x2e, 0xd8, 0x54, 0x17, 0xee,
-	0x5f, 0xd6, 0x6c, 0x13, 0xae, 0xcf, 0x16, 0xa2, 0xbb, 0x63, 0x6b, 0xb7, 0x20, 0x18, 0x5e, 0xcb,
-	0x2a, 0xf9, 0xd5, 0x83, 0xb7, 0xb6, 0x45, 0xf2, 0xa8, 0x20, 0xe3, 0x66, 0xc6, 0x59, 0xab, 0xd5,
-	0x1e, 0xaf, 0xc5, 0x87, 0x19, 0x53, 0xb1, 0x91, 0xd3, 0x0d, 0xc9, 0x32, 0x5c, 0x4d, 0x33, 0x21,
-	0x53, 0x99, 0xcb, 0x94, 0x65, 0x46, 0x55, 0x3f, 0x44, 0x02, 0xb8, 0x12, 0xb1, 0x9c, 0x0b, 0xdc,
-	0x8a, 0xfd, 0x49, 0x95, 0xb6, 0xb1, 0xa3, 0x7a, 0x09, 0xde, 0x1e, 0x21, 0xcb, 0xca, 0xfe, 0x11,
-	0x16, 0xad, 0xb7, 0xbd, 0x74, 0x53, 0x52, 0x99, 0x8b, 0x31, 0xda, 0x6b, 0x30, 0x8b, 0x96, 0xbd,
-	0x15, 0x9b, 0x02, 0x06, 0x30, 0x72, 0x13, 0xa6, 0x85, 0x9a, 0xc7, 0x14, 0x60, 0x22, 0x47, 0xdf,
-	0x1d, 0x78, 0xa7, 0x54, 0x80, 0x55, 0xf9, 0x83, 0x2a, 0x22, 0xc4, 0xa7, 0x39, 0x0a, 0xd9, 0xcc,
-	0x77, 0x9f, 0x60, 0x24, 0x5f, 0xd3, 0xe3, 0x5b, 0x50, 0x11, 0x7a, 0xa2, 0xad, 0xd8, 0x08, 0xec,
-	0x01, 0x8e, 0xc6, 0xf7, 0xe0, 0xce, 0x98, 0xe5, 0xad, 0xca, 0xbf, 0x3d, 0xb8, 0x61, 0x6b, 0x79,
-	0x68, 0x9a, 0xfb, 0x31, 0x47, 0x1c, 0x23, 0xb0, 0x90, 0xa1, 0x15, 0x59, 0x17, 0x7b, 0x00, 0x59,
-	0x81, 0xb9, 0x88, 0xb5, 0x3b, 0x2d, 0x94, 0x18, 0x3f, 0x2e, 0x4e, 0x49, 0xe1, 0xe5, 0xe5, 0x95,
-	0x4a, 0xe8, 0xc2, 0x64, 0x15, 0xae, 0xa7, 0xd9, 0x0e, 0x67, 0x49, 0xd1, 0xb8, 0x86, 0x3a, 0xa9,
-	0xa8, 0x43, 0x78, 0x31, 0x2b, 0xdd, 0xa7, 0x69, 0x8b, 0xee, 0xb6, 0xd0, 0x50, 0xa7, 0xf4, 0xac,
-	0x0e, 0xec, 0xd8, 0x70, 0x1b, 0x96, 0x46, 0x96, 0x67, 0x0d, 0x78, 0x7e, 0x49, 0x1d, 0xc7, 0x47,
-	0x46, 0x9b, 0x71, 0xea, 0x7f, 0x57, 0x3f, 0x76, 0x8b, 0xc8, 0x3c, 0x4c, 0x25, 0x9c, 0xc6, 0xa8,
-	0xfa, 0xff, 0x5a, 0xa8, 0x03, 0x72, 0x17, 0xde, 0x30, 0xd6, 0xa4, 0x2c, 0xfb, 0x82, 0x4a, 0xf4,
-	0xa7, 0xd4, 0x40, 0x07, 0x2d, 0x0e, 0x90, 0xc0, 0x36, 0x16, 0x27, 0xd9, 0x9f, 0xd6, 0x07, 0xa8,
-	0x1b, 0x93, 0x3a, 0x90, 0x0e, 0x67, 0x7b, 0x28, 0x04, 0xe3, 0xcd, 0x34, 0xc9, 0xa8, 0xcc, 0x39,
-	0xfa, 0x33, 0x8a, 0x35, 0x22, 0xe3, 0xb8, 0x74, 0xe4, 0xa9, 0x7b, 0xc2, 0x31, 0xa1, 0xeb, 0x11,
-	0xa9, 0x02, 0x64, 0x7b, 0x52, 0xf9, 0xbb, 0x15, 0x1b, 0x3f, 0xfa, 0x10, 0xb3, 0xb8, 0xda, 0xae,
-	0x1d, 0xe4, 0x11, 0x66, 0x92, 0x26, 0xfa, 0xbe, 0xf2, 0xc2, 0x11, 0x99, 0x62, 0xe3, 0x23, 0x8e,
-	0x71, 0x2a, 0x45, 0x77, 0x45, 0xed, 0xd5, 0x64, 0x38, 0x84, 0x93, 0x07, 0xb0, 0x90, 0x8a, 0xcd,
-	0x56, 0x9a, 0xa4, 0xbb, 0x2d, 0xfc, 0x92, 0xf1, 0xaf, 0x38, 0x8d, 0x73, 0xaa, 0xee, 0x98, 0xc2,
-	0xc4, 0x2b, 0x61, 0x59, 0xba, 0xf6, 0xa7, 0x6e, 0x6d, 0x73, 0x04, 0x36, 0x9f, 0xe6, 0xe9, 0x3e,
-	0x6d, 0x61, 0x16, 0xbd, 0x56, 0x6b, 0x0b, 0x96, 0xf3, 0xa8, 0x6b, 0x90, 0xdd, 0x62, 0x17, 0x2e,
-	0x98, 0x92, 0xf2, 0x04, 0x65, 0x8f, 0xa9, 0xaf, 0x3c, 0x17, 0x2e, 0x6e, 0x1c, 0x8e, 0x54, 0xb0,
-	0xcc, 0x6c, 0xba, 0x89, 0x9c, 0x0d, 0xda, 0x54, 0x6d, 0x3c, 0x5c, 0x8a, 0xdd, 0xa2, 0x77, 0xe1,
-	0x1a, 0xf6, 0x60, 0xbb, 0x4b, 0x83, 0xe0, 0xda, 0xd1, 0x0c, 0x5c, 0xde, 0x16, 0x09, 0xd9, 0x83,
-	0xd9, 0x81, 0x37, 0xf6, 0x6e, 0xc9, 0xdb, 0xe8, 0x3c, 0x61, 0x41, 0xfd, 0x7c, 0x3c, 0xab, 0x8a,
-	0xc1, 0x9c, 0xfb, 0xce, 0x7d, 0x50, 0x3e, 0x85, 0x43, 0x0d, 0xee, 0x9f, 0x9b, 0x6a, 0x17, 0xe4,
-	0x70, 0x7d, 0xe8, 0x35, 0x5b, 0x2d, 0x9f, 0xc6, 0xe5, 0x06, 0x6b, 0xe7, 0xe7, 0xda, 0x35, 0x7f,
-	0xf6, 0xe0, 0x66, 0xc9, 0x63, 0xf4, 0xd1, 0x59, 0x7e, 0xb9, 0x23, 0x82, 0x07, 0x17, 0x1d, 0x61,
-	0x65, 0xbc, 0xf0, 0xc0, 0x2f, 0x7d, 0x6d, 0xd6, 0xc6, 0x59, 0x39, 0x7a, 0x4c, 0xb0, 0x7e, 0xf1,
-	0x31, 0x56, 0xcc, 0x01, 0x90, 0x11, 0x4f, 0xca, 0xbd, 0xb3, 0x8a, 0xeb, 0x67, 0x07, 0x9f, 0x5c,
-	0x84, 0xdd, 0xdf, 0x72, 0xee, 0x5d, 0x3e, 0xa6, 0xe5, 0x1c, 0xea, 0xb8, 0x96, 0x2b, 0xbb, 0x1c,
-	0x0f, 0x80, 0x8c, 0xb8, 0x62, 0xee, 0x9d, 0x69, 0x5e, 0x1f, 0x7b, 0x5c, 0xa9, 0xe5, 0x67, 0x3e,
-	0x98, 0xfa, 0xa9, 0xf8, 0x5c, 0xdd, 0xf8, 0xec, 0xe5, 0x71, 0xd5, 0x7b, 0x75, 0x5c, 0xf5, 0xfe,
-	0x3d, 0xae, 0x7a, 0xbf, 0x9c, 0x54, 0x27, 0x5e, 0x9d, 0x54, 0x27, 0xfe, 0x3a, 0xa9, 0x4e, 0x7c,
-	0xb3, 0x54, 0xf6, 0xb5, 0x2a, 0x9f, 0x75, 0x50, 0xec, 0x4e, 0xab, 0x8f, 0xee, 0x8f, 0xff, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0x84, 0xea, 0xae, 0xe5, 0x2e, 0x0c, 0x00, 0x00,
+func (m *MsgRequestCurriculumMigration) Reset()         { *m = MsgRequestCurriculumMigration{} }
+func (m *MsgRequestCurriculumMigration) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestCurriculumMigration) ProtoMessage()    {}
+func (*MsgRequestCurriculumMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{16}
+}
+func (m *MsgRequestCurriculumMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestCurriculumMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestCurriculumMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestCurriculumMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestCurriculumMigration.Merge(m, src)
+}
+func (m *MsgRequestCurriculumMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestCurriculumMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestCurriculumMigration.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRequestCurriculumMigration proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	RegisterStudent(ctx context.Context, in *MsgRegisterStudent, opts ...grpc.CallOption) (*MsgRegisterStudentResponse, error)
-	CreateEnrollment(ctx context.Context, in *MsgCreateEnrollment, opts ...grpc.CallOption) (*MsgCreateEnrollmentResponse, error)
-	UpdateEnrollmentStatus(ctx context.Context, in *MsgUpdateEnrollmentStatus, opts ...grpc.CallOption) (*MsgUpdateEnrollmentStatusResponse, error)
-	RequestSubjectEnrollment(ctx context.Context, in *MsgRequestSubjectEnrollment, opts ...grpc.CallOption) (*MsgRequestSubjectEnrollmentResponse, error)
-	UpdateAcademicTree(ctx context.Context, in *MsgUpdateAcademicTree, opts ...grpc.CallOption) (*MsgUpdateAcademicTreeResponse, error)
-	CompleteSubject(ctx context.Context, in *MsgCompleteSubject, opts ...grpc.CallOption) (*MsgCompleteSubjectResponse, error)
-	RequestEquivalence(ctx context.Context, in *MsgRequestEquivalence, opts ...grpc.CallOption) (*MsgRequestEquivalenceResponse, error)
+func (m *MsgRequestCurriculumMigration) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRequestCurriculumMigration) GetStudentId() string {
+	if m != nil {
+		return m.StudentId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRequestCurriculumMigration) GetTargetVersion() string {
+	if m != nil {
+		return m.TargetVersion
+	}
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestCurriculumMigration) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterStudent(ctx context.Context, in *MsgRegisterStudent, opts ...grpc.CallOption) (*MsgRegisterStudentResponse, error) {
-	out := new(MsgRegisterStudentResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/RegisterStudent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRequestCurriculumMigrationResponse struct {
+	MigrationId string `protobuf:"bytes,1,opt,name=migrationId,proto3" json:"migrationId,omitempty"`
 }
 
-func (c *msgClient) CreateEnrollment(ctx context.Context, in *MsgCreateEnrollment, opts ...grpc.CallOption) (*MsgCreateEnrollmentResponse, error) {
-	out := new(MsgCreateEnrollmentResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/CreateEnrollment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestCurriculumMigrationResponse) Reset()         { *m = MsgRequestCurriculumMigrationResponse{} }
+func (m *MsgRequestCurriculumMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestCurriculumMigrationResponse) ProtoMessage()    {}
+func (*MsgRequestCurriculumMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{17}
+}
+func (m *MsgRequestCurriculumMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestCurriculumMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestCurriculumMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRequestCurriculumMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestCurriculumMigrationResponse.Merge(m, src)
+}
+func (m *MsgRequestCurriculumMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestCurriculumMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestCurriculumMigrationResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateEnrollmentStatus(ctx context.Context, in *MsgUpdateEnrollmentStatus, opts ...grpc.CallOption) (*MsgUpdateEnrollmentStatusResponse, error) {
-	out := new(MsgUpdateEnrollmentStatusResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/UpdateEnrollmentStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestCurriculumMigrationResponse proto.InternalMessageInfo
+
+func (m *MsgRequestCurriculumMigrationResponse) GetMigrationId() string {
+	if m != nil {
+		return m.MigrationId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RequestSubjectEnrollment(ctx context.Context, in *MsgRequestSubjectEnrollment, opts ...grpc.CallOption) (*MsgRequestSubjectEnrollmentResponse, error) {
-	out := new(MsgRequestSubjectEnrollmentResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/RequestSubjectEnrollment", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgReviewCurriculumMigration approves or rejects a pending curriculum
+// migration of a student
+type MsgReviewCurriculumMigration struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StudentId   string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	MigrationId string `protobuf:"bytes,3,opt,name=migrationId,proto3" json:"migrationId,omitempty"`
+	Approve     bool   `protobuf:"varint,4,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note        string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (m *MsgReviewCurriculumMigration) Reset()         { *m = MsgReviewCurriculumMigration{} }
+func (m *MsgReviewCurriculumMigration) String() string { return proto.CompactTextString(m) }
+func (*MsgReviewCurriculumMigration) ProtoMessage()    {}
+func (*MsgReviewCurriculumMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{18}
+}
+func (m *MsgReviewCurriculumMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReviewCurriculumMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReviewCurriculumMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgReviewCurriculumMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReviewCurriculumMigration.Merge(m, src)
+}
+func (m *MsgReviewCurriculumMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReviewCurriculumMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReviewCurriculumMigration.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateAcademicTree(ctx context.Context, in *MsgUpdateAcademicTree, opts ...grpc.CallOption) (*MsgUpdateAcademicTreeResponse, error) {
-	out := new(MsgUpdateAcademicTreeResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/UpdateAcademicTree", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReviewCurriculumMigration proto.InternalMessageInfo
+
+func (m *MsgReviewCurriculumMigration) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CompleteSubject(ctx context.Context, in *MsgCompleteSubject, opts ...grpc.CallOption) (*MsgCompleteSubjectResponse, error) {
-	out := new(MsgCompleteSubjectResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/CompleteSubject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReviewCurriculumMigration) GetStudentId() string {
+	if m != nil {
+		return m.StudentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RequestEquivalence(ctx context.Context, in *MsgRequestEquivalence, opts ...grpc.CallOption) (*MsgRequestEquivalenceResponse, error) {
-	out := new(MsgRequestEquivalenceResponse)
-	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/RequestEquivalence", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReviewCurriculumMigration) GetMigrationId() string {
+	if m != nil {
+		return m.MigrationId
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	RegisterStudent(context.Context, *MsgRegisterStudent) (*MsgRegisterStudentResponse, error)
-	CreateEnrollment(context.Context, *MsgCreateEnrollment) (*MsgCreateEnrollmentResponse, error)
-	UpdateEnrollmentStatus(context.Context, *MsgUpdateEnrollmentStatus) (*MsgUpdateEnrollmentStatusResponse, error)
-	RequestSubjectEnrollment(context.Context, *MsgRequestSubjectEnrollment) (*MsgRequestSubjectEnrollmentResponse, error)
-	UpdateAcademicTree(context.Context, *MsgUpdateAcademicTree) (*MsgUpdateAcademicTreeResponse, error)
-	CompleteSubject(context.Context, *MsgCompleteSubject) (*MsgCompleteSubjectResponse, error)
-	RequestEquivalence(context.Context, *MsgRequestEquivalence) (*MsgRequestEquivalenceResponse, error)
+func (m *MsgReviewCurriculumMigration) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgReviewCurriculumMigration) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+type MsgReviewCurriculumMigrationResponse struct {
+	CurriculumVersion string `protobuf:"bytes,1,opt,name=curriculumVersion,proto3" json:"curriculumVersion,omitempty"`
 }
-func (*UnimplementedMsgServer) RegisterStudent(ctx context.Context, req *MsgRegisterStudent) (*MsgRegisterStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterStudent not implemented")
+
+func (m *MsgReviewCurriculumMigrationResponse) Reset()         { *m = MsgReviewCurriculumMigrationResponse{} }
+func (m *MsgReviewCurriculumMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReviewCurriculumMigrationResponse) ProtoMessage()    {}
+func (*MsgReviewCurriculumMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{19}
 }
-func (*UnimplementedMsgServer) CreateEnrollment(ctx context.Context, req *MsgCreateEnrollment) (*MsgCreateEnrollmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEnrollment not implemented")
+func (m *MsgReviewCurriculumMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateEnrollmentStatus(ctx context.Context, req *MsgUpdateEnrollmentStatus) (*MsgUpdateEnrollmentStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollmentStatus not implemented")
+func (m *MsgReviewCurriculumMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReviewCurriculumMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RequestSubjectEnrollment(ctx context.Context, req *MsgRequestSubjectEnrollment) (*MsgRequestSubjectEnrollmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestSubjectEnrollment not implemented")
+func (m *MsgReviewCurriculumMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReviewCurriculumMigrationResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateAcademicTree(ctx context.Context, req *MsgUpdateAcademicTree) (*MsgUpdateAcademicTreeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAcademicTree not implemented")
+func (m *MsgReviewCurriculumMigrationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CompleteSubject(ctx context.Context, req *MsgCompleteSubject) (*MsgCompleteSubjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteSubject not implemented")
+func (m *MsgReviewCurriculumMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReviewCurriculumMigrationResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RequestEquivalence(ctx context.Context, req *MsgRequestEquivalence) (*MsgRequestEquivalenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestEquivalence not implemented")
+
+var xxx_messageInfo_MsgReviewCurriculumMigrationResponse proto.InternalMessageInfo
+
+func (m *MsgReviewCurriculumMigrationResponse) GetCurriculumVersion() string {
+	if m != nil {
+		return m.CurriculumVersion
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgCreateSubjectOffering opens a section of a subject in an academic term
+type MsgCreateSubjectOffering struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SubjectId  string `protobuf:"bytes,2,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	Term       string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	Section    string `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	Capacity   uint64 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Instructor string `protobuf:"bytes,6,opt,name=instructor,proto3" json:"instructor,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCreateSubjectOffering) Reset()         { *m = MsgCreateSubjectOffering{} }
+func (m *MsgCreateSubjectOffering) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubjectOffering) ProtoMessage()    {}
+func (*MsgCreateSubjectOffering) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{20}
 }
-
-func _Msg_RegisterStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterStudent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterStudent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Msg/RegisterStudent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterStudent(ctx, req.(*MsgRegisterStudent))
+func (m *MsgCreateSubjectOffering) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSubjectOffering) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSubjectOffering.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreateSubjectOffering) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubjectOffering.Merge(m, src)
+}
+func (m *MsgCreateSubjectOffering) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSubjectOffering) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubjectOffering.DiscardUnknown(m)
 }
 
-func _Msg_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateEnrollment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateEnrollment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Msg/CreateEnrollment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateEnrollment(ctx, req.(*MsgCreateEnrollment))
+var xxx_messageInfo_MsgCreateSubjectOffering proto.InternalMessageInfo
+
+func (m *MsgCreateSubjectOffering) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateEnrollmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateEnrollmentStatus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateEnrollmentStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Msg/UpdateEnrollmentStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateEnrollmentStatus(ctx, req.(*MsgUpdateEnrollmentStatus))
+func (m *MsgCreateSubjectOffering) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RequestSubjectEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestSubjectEnrollment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestSubjectEnrollment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Msg/RequestSubjectEnrollment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestSubjectEnrollment(ctx, req.(*MsgRequestSubjectEnrollment))
+func (m *MsgCreateSubjectOffering) GetTerm() string {
+	if m != nil {
+		return m.Term
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateAcademicTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateAcademicTree)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateAcademicTree(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Msg/UpdateAcademicTree",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateAcademicTree(ctx, req.(*MsgUpdateAcademicTree))
+func (m *MsgCreateSubjectOffering) GetSection() string {
+	if m != nil {
+		return m.Section
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CompleteSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteSubject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteSubject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Msg/CompleteSubject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteSubject(ctx, req.(*MsgCompleteSubject))
+func (m *MsgCreateSubjectOffering) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RequestEquivalence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestEquivalence)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestEquivalence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/academictoken.student.Msg/RequestEquivalence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestEquivalence(ctx, req.(*MsgRequestEquivalence))
+func (m *MsgCreateSubjectOffering) GetInstructor() string {
+	if m != nil {
+		return m.Instructor
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "academictoken.student.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RegisterStudent",
-			Handler:    _Msg_RegisterStudent_Handler,
-		},
-		{
-			MethodName: "CreateEnrollment",
-			Handler:    _Msg_CreateEnrollment_Handler,
-		},
-		{
-			MethodName: "UpdateEnrollmentStatus",
-			Handler:    _Msg_UpdateEnrollmentStatus_Handler,
-		},
-		{
-			MethodName: "RequestSubjectEnrollment",
-			Handler:    _Msg_RequestSubjectEnrollment_Handler,
-		},
-		{
-			MethodName: "UpdateAcademicTree",
-			Handler:    _Msg_UpdateAcademicTree_Handler,
-		},
-		{
-			MethodName: "CompleteSubject",
-			Handler:    _Msg_CompleteSubject_Handler,
-		},
-		{
-			MethodName: "RequestEquivalence",
-			Handler:    _Msg_RequestEquivalence_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "academictoken/student/tx.proto",
+type MsgCreateSubjectOfferingResponse struct {
+	OfferingId string `protobuf:"bytes,1,opt,name=offeringId,proto3" json:"offeringId,omitempty"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateSubjectOfferingResponse) Reset()         { *m = MsgCreateSubjectOfferingResponse{} }
+func (m *MsgCreateSubjectOfferingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubjectOfferingResponse) ProtoMessage()    {}
+func (*MsgCreateSubjectOfferingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{21}
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateSubjectOfferingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCreateSubjectOfferingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSubjectOfferingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateSubjectOfferingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubjectOfferingResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateSubjectOfferingResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateSubjectOfferingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubjectOfferingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterStudent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateSubjectOfferingResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSubjectOfferingResponse) GetOfferingId() string {
+	if m != nil {
+		return m.OfferingId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRegisterStudent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDropSubjectOffering gives up a seat or waitlist place in an offering
+// while the enrollment window of its term is open. Dropped subjects leave no
+// record.
+type MsgDropSubjectOffering struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StudentId  string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	OfferingId string `protobuf:"bytes,3,opt,name=offeringId,proto3" json:"offeringId,omitempty"`
 }
 
-func (m *MsgRegisterStudent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgDropSubjectOffering) Reset()         { *m = MsgDropSubjectOffering{} }
+func (m *MsgDropSubjectOffering) String() string { return proto.CompactTextString(m) }
+func (*MsgDropSubjectOffering) ProtoMessage()    {}
+func (*MsgDropSubjectOffering) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{22}
+}
+func (m *MsgDropSubjectOffering) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDropSubjectOffering) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDropSubjectOffering.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgDropSubjectOffering) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDropSubjectOffering.Merge(m, src)
+}
+func (m *MsgDropSubjectOffering) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDropSubjectOffering) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDropSubjectOffering.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDropSubjectOffering proto.InternalMessageInfo
+
+func (m *MsgDropSubjectOffering) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRegisterStudentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDropSubjectOffering) GetStudentId() string {
+	if m != nil {
+		return m.StudentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRegisterStudentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDropSubjectOffering) GetOfferingId() string {
+	if m != nil {
+		return m.OfferingId
+	}
+	return ""
 }
 
-func (m *MsgRegisterStudentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgDropSubjectOfferingResponse struct {
 }
 
-func (m *MsgCreateEnrollment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDropSubjectOfferingResponse) Reset()         { *m = MsgDropSubjectOfferingResponse{} }
+func (m *MsgDropSubjectOfferingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDropSubjectOfferingResponse) ProtoMessage()    {}
+func (*MsgDropSubjectOfferingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{23}
+}
+func (m *MsgDropSubjectOfferingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDropSubjectOfferingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDropSubjectOfferingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateEnrollment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDropSubjectOfferingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDropSubjectOfferingResponse.Merge(m, src)
+}
+func (m *MsgDropSubjectOfferingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDropSubjectOfferingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDropSubjectOfferingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CourseId) > 0 {
-		i -= len(m.CourseId)
-		copy(dAtA[i:], m.CourseId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CourseId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Institution) > 0 {
-		i -= len(m.Institution)
-		copy(dAtA[i:], m.Institution)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Institution)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Student) > 0 {
-		i -= len(m.Student)
-		copy(dAtA[i:], m.Student)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Student)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgDropSubjectOfferingResponse proto.InternalMessageInfo
+
+// MsgWithdrawSubjectOffering leaves an offering after the enrollment window
+// of its term closed and before the term ends. The withdrawal is recorded in
+// the student's academic tree.
+type MsgWithdrawSubjectOffering struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StudentId  string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	OfferingId string `protobuf:"bytes,3,opt,name=offeringId,proto3" json:"offeringId,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgCreateEnrollmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawSubjectOffering) Reset()         { *m = MsgWithdrawSubjectOffering{} }
+func (m *MsgWithdrawSubjectOffering) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSubjectOffering) ProtoMessage()    {}
+func (*MsgWithdrawSubjectOffering) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{24}
+}
+func (m *MsgWithdrawSubjectOffering) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSubjectOffering) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSubjectOffering.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateEnrollmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawSubjectOffering) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSubjectOffering.Merge(m, src)
 }
-
-func (m *MsgCreateEnrollmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgWithdrawSubjectOffering) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSubjectOffering) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSubjectOffering.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateEnrollmentStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawSubjectOffering proto.InternalMessageInfo
+
+func (m *MsgWithdrawSubjectOffering) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateEnrollmentStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawSubjectOffering) GetStudentId() string {
+	if m != nil {
+		return m.StudentId
+	}
+	return ""
 }
 
-func (m *MsgUpdateEnrollmentStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.EnrollmentId) > 0 {
-		i -= len(m.EnrollmentId)
-		copy(dAtA[i:], m.EnrollmentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EnrollmentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgWithdrawSubjectOffering) GetOfferingId() string {
+	if m != nil {
+		return m.OfferingId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateEnrollmentStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawSubjectOffering) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateEnrollmentStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawSubjectOfferingResponse struct {
 }
 
-func (m *MsgUpdateEnrollmentStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgWithdrawSubjectOfferingResponse) Reset()         { *m = MsgWithdrawSubjectOfferingResponse{} }
+func (m *MsgWithdrawSubjectOfferingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSubjectOfferingResponse) ProtoMessage()    {}
+func (*MsgWithdrawSubjectOfferingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{25}
 }
-
-func (m *MsgRequestSubjectEnrollment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawSubjectOfferingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSubjectOfferingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSubjectOfferingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgWithdrawSubjectOfferingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSubjectOfferingResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSubjectOfferingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSubjectOfferingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSubjectOfferingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRequestSubjectEnrollment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawSubjectOfferingResponse proto.InternalMessageInfo
+
+// MsgGrantPrerequisiteWaiver lets an advisor or registrar exempt a student from
+// the prerequisites of a subject until the waiver expires. Granting a new
+// waiver for the same subject replaces the previous one.
+type MsgGrantPrerequisiteWaiver struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StudentId string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	SubjectId string `protobuf:"bytes,3,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt string `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (m *MsgRequestSubjectEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SubjectId) > 0 {
-		i -= len(m.SubjectId)
-		copy(dAtA[i:], m.SubjectId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Student) > 0 {
-		i -= len(m.Student)
-		copy(dAtA[i:], m.Student)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Student)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgGrantPrerequisiteWaiver) Reset()         { *m = MsgGrantPrerequisiteWaiver{} }
+func (m *MsgGrantPrerequisiteWaiver) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPrerequisiteWaiver) ProtoMessage()    {}
+func (*MsgGrantPrerequisiteWaiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{26}
+}
+func (m *MsgGrantPrerequisiteWaiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantPrerequisiteWaiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantPrerequisiteWaiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgGrantPrerequisiteWaiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPrerequisiteWaiver.Merge(m, src)
+}
+func (m *MsgGrantPrerequisiteWaiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantPrerequisiteWaiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPrerequisiteWaiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantPrerequisiteWaiver proto.InternalMessageInfo
+
+func (m *MsgGrantPrerequisiteWaiver) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRequestSubjectEnrollmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantPrerequisiteWaiver) GetStudentId() string {
+	if m != nil {
+		return m.StudentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRequestSubjectEnrollmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGrantPrerequisiteWaiver) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
 }
 
-func (m *MsgRequestSubjectEnrollmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgGrantPrerequisiteWaiver) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgUpdateAcademicTree) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantPrerequisiteWaiver) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateAcademicTree) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgGrantPrerequisiteWaiverResponse struct {
 }
 
-func (m *MsgUpdateAcademicTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AvailableTokens) > 0 {
-		for iNdEx := len(m.AvailableTokens) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AvailableTokens[iNdEx])
-			copy(dAtA[i:], m.AvailableTokens[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AvailableTokens[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.InProgressTokens) > 0 {
-		for iNdEx := len(m.InProgressTokens) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.InProgressTokens[iNdEx])
-			copy(dAtA[i:], m.InProgressTokens[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.InProgressTokens[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.CompletedTokens) > 0 {
-		for iNdEx := len(m.CompletedTokens) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CompletedTokens[iNdEx])
-			copy(dAtA[i:], m.CompletedTokens[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CompletedTokens[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgGrantPrerequisiteWaiverResponse) Reset()         { *m = MsgGrantPrerequisiteWaiverResponse{} }
+func (m *MsgGrantPrerequisiteWaiverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPrerequisiteWaiverResponse) ProtoMessage()    {}
+func (*MsgGrantPrerequisiteWaiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d98bea83cb488220, []int{27}
+}
+func (m *MsgGrantPrerequisiteWaiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantPrerequisiteWaiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantPrerequisiteWaiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.StudentId) > 0 {
-		i -= len(m.StudentId)
-		copy(dAtA[i:], m.StudentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgGrantPrerequisiteWaiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPrerequisiteWaiverResponse.Merge(m, src)
+}
+func (m *MsgGrantPrerequisiteWaiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantPrerequisiteWaiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPrerequisiteWaiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantPrerequisiteWaiverResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "academictoken.student.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "academictoken.student.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterStudent)(nil), "academictoken.student.MsgRegisterStudent")
+	proto.RegisterType((*MsgRegisterStudentResponse)(nil), "academictoken.student.MsgRegisterStudentResponse")
+	proto.RegisterType((*MsgCreateEnrollment)(nil), "academictoken.student.MsgCreateEnrollment")
+	proto.RegisterType((*MsgCreateEnrollmentResponse)(nil), "academictoken.student.MsgCreateEnrollmentResponse")
+	proto.RegisterType((*MsgUpdateEnrollmentStatus)(nil), "academictoken.student.MsgUpdateEnrollmentStatus")
+	proto.RegisterType((*MsgUpdateEnrollmentStatusResponse)(nil), "academictoken.student.MsgUpdateEnrollmentStatusResponse")
+	proto.RegisterType((*MsgRequestSubjectEnrollment)(nil), "academictoken.student.MsgRequestSubjectEnrollment")
+	proto.RegisterType((*MsgRequestSubjectEnrollmentResponse)(nil), "academictoken.student.MsgRequestSubjectEnrollmentResponse")
+	proto.RegisterType((*MsgUpdateAcademicTree)(nil), "academictoken.student.MsgUpdateAcademicTree")
+	proto.RegisterType((*MsgUpdateAcademicTreeResponse)(nil), "academictoken.student.MsgUpdateAcademicTreeResponse")
+	proto.RegisterType((*MsgCompleteSubject)(nil), "academictoken.student.MsgCompleteSubject")
+	proto.RegisterType((*MsgCompleteSubjectResponse)(nil), "academictoken.student.MsgCompleteSubjectResponse")
+	proto.RegisterType((*MsgRequestEquivalence)(nil), "academictoken.student.MsgRequestEquivalence")
+	proto.RegisterType((*MsgRequestEquivalenceResponse)(nil), "academictoken.student.MsgRequestEquivalenceResponse")
+	proto.RegisterType((*MsgRequestCurriculumMigration)(nil), "academictoken.student.MsgRequestCurriculumMigration")
+	proto.RegisterType((*MsgRequestCurriculumMigrationResponse)(nil), "academictoken.student.MsgRequestCurriculumMigrationResponse")
+	proto.RegisterType((*MsgReviewCurriculumMigration)(nil), "academictoken.student.MsgReviewCurriculumMigration")
+	proto.RegisterType((*MsgReviewCurriculumMigrationResponse)(nil), "academictoken.student.MsgReviewCurriculumMigrationResponse")
+	proto.RegisterType((*MsgCreateSubjectOffering)(nil), "academictoken.student.MsgCreateSubjectOffering")
+	proto.RegisterType((*MsgCreateSubjectOfferingResponse)(nil), "academictoken.student.MsgCreateSubjectOfferingResponse")
+	proto.RegisterType((*MsgDropSubjectOffering)(nil), "academictoken.student.MsgDropSubjectOffering")
+	proto.RegisterType((*MsgDropSubjectOfferingResponse)(nil), "academictoken.student.MsgDropSubjectOfferingResponse")
+	proto.RegisterType((*MsgWithdrawSubjectOffering)(nil), "academictoken.student.MsgWithdrawSubjectOffering")
+	proto.RegisterType((*MsgWithdrawSubjectOfferingResponse)(nil), "academictoken.student.MsgWithdrawSubjectOfferingResponse")
+	proto.RegisterType((*MsgGrantPrerequisiteWaiver)(nil), "academictoken.student.MsgGrantPrerequisiteWaiver")
+	proto.RegisterType((*MsgGrantPrerequisiteWaiverResponse)(nil), "academictoken.student.MsgGrantPrerequisiteWaiverResponse")
+}
+
+func init() { proto.RegisterFile("academictoken/student/tx.proto", fileDescriptor_d98bea83cb488220) }
+
+var fileDescriptor_d98bea83cb488220 = []byte{
+	// 1453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6f, 0x14, 0xc7,
+	0x16, 0x76, 0xe3, 0x07, 0xf8, 0xf0, 0x6e, 0x1e, 0x1e, 0xf7, 0xc5, 0x83, 0xef, 0xe0, 0xcb, 0xf5,
+	0xb5, 0x8c, 0xe7, 0x62, 0x92, 0x40, 0x9c, 0x2c, 0x82, 0xc1, 0x41, 0x5e, 0x58, 0xb1, 0xc6, 0x04,
+	0xa4, 0x6c, 0x50, 0xb9, 0xbb, 0xdc, 0x14, 0x99, 0xe9, 0x1a, 0xaa, 0xaa, 0x8d, 0x51, 0xa4, 0xbc,
+	0x14, 0x29, 0x12, 0x52, 0x24, 0x36, 0xd9, 0x44, 0xca, 0x26, 0xd9, 0x64, 0xe9, 0x05, 0xca, 0x5f,
+	0x08, 0xc9, 0x0a, 0xb1, 0xca, 0x26, 0x51, 0x04, 0x0b, 0xff, 0x8d, 0xa8, 0xaa, 0x6b, 0x6a, 0x7a,
+	0x6a, 0xba, 0x7b, 0x3c, 0x26, 0xc9, 0xc6, 0xee, 0x3a, 0x75, 0x4e, 0xd5, 0xf7, 0x7d, 0x7d, 0x4e,
+	0xd5, 0xe9, 0x81, 0x32, 0xf2, 0x51, 0x80, 0x1b, 0xc4, 0x17, 0xf4, 0x43, 0x1c, 0x55, 0xb9, 0x88,
+	0x03, 0x1c, 0x89, 0xaa, 0xd8, 0x9a, 0x6b, 0x32, 0x2a, 0xa8, 0x7b, 0xaa, 0x63, 0x7e, 0x4e, 0xcf,
+	0x7b, 0xc7, 0x51, 0x83, 0x44, 0xb4, 0xaa, 0xfe, 0x26, 0x9e, 0xde, 0x98, 0x4f, 0x79, 0x83, 0xf2,
+	0x6a, 0x83, 0x87, 0xd5, 0xcd, 0x8b, 0xf2, 0x9f, 0x9e, 0x18, 0x4f, 0x26, 0xee, 0xa8, 0x51, 0x35,
+	0x19, 0xe8, 0xa9, 0x93, 0x21, 0x0d, 0x69, 0x62, 0x97, 0x4f, 0xda, 0x5a, 0xc9, 0xc6, 0xd4, 0x44,
+	0x0c, 0x35, 0x5a, 0x91, 0xb3, 0xd9, 0x3e, 0x3c, 0x5e, 0xbf, 0x87, 0x7d, 0x71, 0x87, 0x6e, 0x6c,
+	0x60, 0x46, 0x22, 0x0d, 0xa1, 0xf2, 0x93, 0x03, 0x47, 0x57, 0x78, 0xf8, 0x7e, 0x33, 0x40, 0x02,
+	0xaf, 0xaa, 0x75, 0xdc, 0x37, 0x60, 0x14, 0xc5, 0xe2, 0x2e, 0x65, 0x44, 0x3c, 0x2c, 0x39, 0x93,
+	0xce, 0xf4, 0xe8, 0x62, 0xe9, 0xf9, 0x93, 0x0b, 0x27, 0x35, 0xc0, 0xab, 0x41, 0xc0, 0x30, 0xe7,
+	0x6b, 0x42, 0x2e, 0x53, 0x6b, 0xbb, 0xba, 0xef, 0xc0, 0x48, 0x82, 0xa4, 0xb4, 0x6f, 0xd2, 0x99,
+	0x3e, 0x38, 0x3f, 0x31, 0x97, 0x29, 0xd1, 0x5c, 0xb2, 0xcd, 0xe2, 0xe8, 0xd3, 0xdf, 0xcf, 0x0e,
+	0xfc, 0xb0, 0xb3, 0x3d, 0xe3, 0xd4, 0x74, 0xdc, 0xc2, 0xc2, 0xe7, 0x3b, 0xdb, 0x33, 0xed, 0x15,
+	0x1f, 0xed, 0x6c, 0xcf, 0xfc, 0xb7, 0x93, 0xce, 0x96, 0x21, 0x64, 0xa1, 0xae, 0x8c, 0xc3, 0x98,
+	0x65, 0xaa, 0x61, 0xde, 0xa4, 0x11, 0xc7, 0x95, 0x7b, 0xe0, 0xae, 0xf0, 0xb0, 0x86, 0x43, 0xc2,
+	0x05, 0x66, 0x6b, 0xc9, 0x02, 0x6e, 0x09, 0xf6, 0xfb, 0x0c, 0x23, 0x41, 0x59, 0x42, 0xb2, 0xd6,
+	0x1a, 0xba, 0x2e, 0x0c, 0x45, 0xa8, 0x81, 0x15, 0x8d, 0xd1, 0x9a, 0x7a, 0x96, 0xde, 0x28, 0x21,
+	0x5e, 0x1a, 0x4c, 0xbc, 0xf5, 0x70, 0xe1, 0x90, 0x04, 0xdd, 0x8a, 0xad, 0x9c, 0x01, 0xaf, 0x7b,
+	0x2f, 0x83, 0xe4, 0x6b, 0x07, 0x4e, 0xac, 0xf0, 0xf0, 0x9a, 0x74, 0xc6, 0x4b, 0x11, 0xa3, 0xf5,
+	0x7a, 0xa3, 0x18, 0x4b, 0x09, 0xf6, 0x6b, 0xc6, 0x1a, 0x4e, 0x6b, 0xe8, 0x4e, 0xc2, 0x41, 0x12,
+	0x71, 0x41, 0x44, 0x2c, 0x08, 0x8d, 0x34, 0xaa, 0xb4, 0xc9, 0xf5, 0xe0, 0x80, 0x4f, 0x63, 0xc6,
+	0xf1, 0x72, 0x50, 0x1a, 0x52, 0xd3, 0x66, 0x6c, 0xa1, 0x9e, 0x80, 0x7f, 0x65, 0xc0, 0x32, 0xb0,
+	0x3f, 0x81, 0x71, 0xa3, 0x6d, 0x7b, 0x7a, 0x4d, 0x20, 0x11, 0xf3, 0x02, 0xec, 0x15, 0x38, 0x84,
+	0x8d, 0xf7, 0x72, 0xa0, 0x09, 0x74, 0xd8, 0xdc, 0xd3, 0x30, 0xc2, 0xd5, 0x3a, 0x9a, 0x80, 0x1e,
+	0x59, 0xf8, 0xce, 0xc1, 0xbf, 0x73, 0x01, 0x18, 0x94, 0xdf, 0x3a, 0x8a, 0x45, 0x0d, 0xdf, 0x8f,
+	0x31, 0x17, 0x6b, 0x49, 0xc2, 0xbf, 0xa2, 0xc8, 0x67, 0x60, 0x54, 0x57, 0xce, 0x72, 0xa0, 0x11,
+	0xb6, 0x0d, 0x6e, 0x19, 0xa0, 0x55, 0x4f, 0x46, 0xe2, 0x94, 0xc5, 0x22, 0x41, 0xe0, 0x5c, 0x01,
+	0xbc, 0x16, 0x8d, 0x94, 0x22, 0x4e, 0x5a, 0x11, 0x77, 0x06, 0x8e, 0x3d, 0x40, 0x44, 0xd4, 0x09,
+	0x17, 0xab, 0x94, 0x13, 0xf5, 0xd2, 0x25, 0xda, 0xa1, 0x5a, 0x97, 0xbd, 0xf2, 0x9b, 0x03, 0xa7,
+	0x8c, 0x60, 0x57, 0x75, 0x05, 0xdd, 0x64, 0x18, 0x17, 0x88, 0x20, 0xa9, 0x26, 0xac, 0xcd, 0xab,
+	0x6a, 0x1b, 0xdc, 0x69, 0x38, 0xea, 0xd3, 0x46, 0xb3, 0x8e, 0x05, 0x0e, 0x6e, 0xca, 0x52, 0x94,
+	0x2f, 0x6c, 0x70, 0x7a, 0xb4, 0x66, 0x9b, 0x25, 0x4e, 0x12, 0xad, 0x32, 0x1a, 0xca, 0xea, 0xd0,
+	0xae, 0x43, 0xca, 0xb5, 0xcb, 0x2e, 0x57, 0x45, 0x9b, 0x88, 0xd4, 0xd1, 0x7a, 0x1d, 0x6b, 0xd7,
+	0xe1, 0x64, 0x55, 0xcb, 0x6c, 0x49, 0x79, 0x16, 0x26, 0x32, 0xe9, 0x99, 0x5c, 0xf8, 0x6e, 0x9f,
+	0xaa, 0xf9, 0x6b, 0x1a, 0x9b, 0x56, 0x7b, 0xcf, 0xec, 0x8b, 0xd3, 0xe0, 0x24, 0x0c, 0x87, 0x0c,
+	0x05, 0x58, 0x65, 0xc0, 0xe1, 0x5a, 0x32, 0x70, 0xcf, 0xc3, 0x11, 0x2d, 0x0d, 0xa1, 0xd1, 0x75,
+	0x24, 0x70, 0x69, 0x58, 0x05, 0x5a, 0x56, 0x59, 0xa5, 0x1c, 0x37, 0xb0, 0x3c, 0x2e, 0x4a, 0x23,
+	0x49, 0x95, 0xb6, 0xc6, 0xee, 0x1c, 0xb8, 0x4d, 0x46, 0x37, 0x30, 0xe7, 0x94, 0xad, 0x91, 0x30,
+	0x42, 0x22, 0x66, 0xb8, 0xb4, 0x5f, 0x79, 0x65, 0xcc, 0xc8, 0x84, 0x54, 0x9b, 0xdf, 0x42, 0xf5,
+	0x18, 0x97, 0x0e, 0x24, 0x09, 0xd9, 0xb6, 0x58, 0x2a, 0x3e, 0x77, 0xd4, 0x61, 0x65, 0x89, 0x64,
+	0x12, 0xb1, 0x0c, 0x10, 0x6d, 0x08, 0xa5, 0xff, 0x72, 0xa0, 0xf5, 0x4a, 0x59, 0x34, 0x38, 0xf5,
+	0x3a, 0x57, 0x31, 0xf3, 0x71, 0x24, 0x50, 0x98, 0x1c, 0x9a, 0x4e, 0x2d, 0x63, 0x46, 0x26, 0x86,
+	0xcf, 0x70, 0x40, 0x04, 0x6f, 0xed, 0x98, 0x68, 0x39, 0x54, 0xeb, 0xb2, 0xbb, 0x57, 0x60, 0x8c,
+	0xf0, 0xa5, 0x3a, 0x09, 0xc9, 0x7a, 0x1d, 0xbf, 0x4b, 0xd9, 0x0d, 0x86, 0x82, 0x18, 0xa9, 0x9c,
+	0x97, 0x22, 0x1f, 0xa8, 0xe5, 0x4d, 0x57, 0x7e, 0x4e, 0x52, 0x5f, 0x97, 0xd9, 0xd2, 0xfd, 0x98,
+	0x6c, 0xa2, 0x3a, 0x8e, 0xfc, 0x57, 0x4a, 0x7d, 0x4e, 0x63, 0xe6, 0xb7, 0x04, 0x32, 0x29, 0x60,
+	0x9b, 0xa5, 0xa7, 0x40, 0x2c, 0xc4, 0xa2, 0xed, 0x99, 0x1c, 0x0a, 0xb6, 0x59, 0x16, 0x39, 0xc3,
+	0x88, 0xd3, 0x48, 0x27, 0x85, 0x1e, 0x59, 0x2f, 0x68, 0x49, 0xa5, 0x79, 0x37, 0x15, 0xf3, 0x8a,
+	0xa6, 0xe0, 0x30, 0x6e, 0x9b, 0xcd, 0x5b, 0xea, 0x34, 0x56, 0xbe, 0x77, 0xd2, 0xeb, 0x5c, 0x8b,
+	0x19, 0x23, 0x7e, 0x5c, 0x8f, 0x1b, 0x2b, 0x24, 0x64, 0x4a, 0xb4, 0x3d, 0x4b, 0x33, 0x05, 0x87,
+	0x13, 0x66, 0xb7, 0x30, 0xe3, 0xed, 0x5b, 0xa8, 0xd3, 0x98, 0x22, 0x3b, 0x54, 0x40, 0x76, 0x19,
+	0xfe, 0x53, 0x08, 0xd2, 0x90, 0x9e, 0x84, 0x83, 0x8d, 0x96, 0xd1, 0x50, 0x4e, 0x9b, 0x2a, 0x4f,
+	0x1c, 0x38, 0xa3, 0xd6, 0xda, 0x24, 0xf8, 0xc1, 0x5f, 0xc9, 0xd7, 0xda, 0x7a, 0xb0, 0x6b, 0x6b,
+	0xd5, 0x27, 0x34, 0x9b, 0x8c, 0x6e, 0x62, 0x9d, 0xa8, 0xad, 0xa1, 0xea, 0x2a, 0xa8, 0x39, 0x05,
+	0xd4, 0xb3, 0xa5, 0xc0, 0x4d, 0x98, 0x2a, 0x42, 0x6d, 0x04, 0x98, 0x85, 0xe3, 0xbe, 0x99, 0x6e,
+	0x29, 0x9f, 0xf0, 0xe8, 0x9e, 0xa8, 0xfc, 0xe2, 0x40, 0xc9, 0x5c, 0xee, 0x3a, 0x03, 0xdf, 0xd3,
+	0x77, 0x54, 0x0f, 0x21, 0x4c, 0x16, 0xef, 0xb3, 0x8f, 0x3c, 0x17, 0x86, 0x04, 0x66, 0x0d, 0xad,
+	0x80, 0x7a, 0x56, 0xb7, 0x28, 0xf6, 0x4d, 0x8d, 0xca, 0x5b, 0x34, 0x19, 0xaa, 0x46, 0x04, 0x35,
+	0x91, 0x2f, 0x1b, 0xca, 0x61, 0x55, 0xf1, 0x66, 0x2c, 0x4f, 0x19, 0xd9, 0xb3, 0xb0, 0xd8, 0x97,
+	0x20, 0x92, 0x03, 0x30, 0x65, 0xb1, 0x24, 0x5a, 0x84, 0xc9, 0x3c, 0x2e, 0xe9, 0x73, 0x2b, 0x75,
+	0x2b, 0x3b, 0xf6, 0xad, 0x5c, 0xf9, 0x18, 0x4e, 0xaf, 0xf0, 0xf0, 0x3a, 0xa3, 0xcd, 0xfe, 0xd4,
+	0xc8, 0x4f, 0x8b, 0xce, 0x1d, 0x07, 0x7b, 0xf4, 0x01, 0x93, 0x50, 0xce, 0xde, 0xdf, 0xdc, 0x5e,
+	0xdf, 0x24, 0x07, 0xf3, 0x6d, 0x22, 0xee, 0x06, 0x0c, 0x3d, 0xf8, 0x87, 0x60, 0xee, 0xb2, 0x4e,
+	0xa7, 0xa0, 0x92, 0x8f, 0xcd, 0x50, 0xf8, 0x31, 0xa1, 0x70, 0x83, 0xa1, 0x48, 0xac, 0x32, 0xcc,
+	0xe4, 0x89, 0xc4, 0x89, 0xc0, 0xb7, 0x11, 0xd9, 0xc4, 0xec, 0x6f, 0xba, 0x88, 0x73, 0x08, 0xc8,
+	0x28, 0xbc, 0xd5, 0x24, 0x0c, 0xf3, 0xab, 0x42, 0xd7, 0x5f, 0xdb, 0x90, 0x49, 0x2f, 0x07, 0x77,
+	0x8b, 0xde, 0xfc, 0xa3, 0x23, 0x30, 0xb8, 0xc2, 0x43, 0x77, 0x03, 0x0e, 0x75, 0x7c, 0x3b, 0x9d,
+	0xcf, 0xf9, 0xe6, 0xb1, 0x3e, 0x4d, 0xbc, 0xb9, 0xdd, 0xf9, 0x99, 0x9c, 0xa6, 0x70, 0xd4, 0xfe,
+	0x7e, 0xf9, 0x5f, 0xfe, 0x12, 0x96, 0xab, 0x77, 0x71, 0xd7, 0xae, 0x66, 0x43, 0x06, 0xc7, 0xba,
+	0xbe, 0x52, 0x66, 0xf2, 0x97, 0xb1, 0x7d, 0xbd, 0xf9, 0xdd, 0xfb, 0x9a, 0x3d, 0xbf, 0x70, 0xe0,
+	0x74, 0xce, 0x47, 0xc6, 0xff, 0x7b, 0xe9, 0x65, 0x47, 0x78, 0x57, 0xfa, 0x8d, 0x30, 0x30, 0x1e,
+	0x39, 0x50, 0xca, 0xfd, 0x88, 0x98, 0x2f, 0x92, 0x32, 0x3b, 0xc6, 0x5b, 0xe8, 0x3f, 0xc6, 0x80,
+	0xd9, 0x02, 0x37, 0xa3, 0x8b, 0x9f, 0xed, 0x45, 0x2e, 0xed, 0xed, 0xbd, 0xd6, 0x8f, 0x77, 0x3a,
+	0xe5, 0xec, 0xf6, 0xb9, 0x20, 0xe5, 0x2c, 0xd7, 0xa2, 0x94, 0xcb, 0xeb, 0x37, 0xb7, 0xc0, 0xcd,
+	0xe8, 0xda, 0x66, 0x7b, 0x8a, 0x97, 0xf2, 0x2e, 0xa2, 0x5a, 0xd0, 0x46, 0x3d, 0x76, 0xc0, 0x2b,
+	0xe8, 0x8e, 0x7a, 0x2f, 0x9a, 0x11, 0xe5, 0xbd, 0xbd, 0x97, 0x28, 0x03, 0xe9, 0x2b, 0x07, 0xc6,
+	0xf3, 0xfb, 0x97, 0x4b, 0x45, 0x6b, 0xe7, 0x04, 0x79, 0x6f, 0xed, 0x21, 0xc8, 0xe0, 0xf9, 0xcc,
+	0x81, 0x53, 0xd9, 0x2d, 0x44, 0xb5, 0x57, 0xa5, 0x5b, 0x01, 0xde, 0xe5, 0x3e, 0x03, 0x0c, 0x86,
+	0x8f, 0xe0, 0x44, 0xd6, 0xad, 0x7d, 0x21, 0x7f, 0xbd, 0x0c, 0x77, 0xef, 0xf5, 0xbe, 0xdc, 0xcd,
+	0xe6, 0x5f, 0x3a, 0x30, 0x96, 0x77, 0x21, 0x17, 0x24, 0x7b, 0x4e, 0x88, 0xf7, 0x66, 0xdf, 0x21,
+	0x1d, 0x48, 0xf2, 0xee, 0xd5, 0x02, 0x24, 0x39, 0x21, 0x45, 0x48, 0x7a, 0xdc, 0x82, 0xde, 0xf0,
+	0xa7, 0x3b, 0xdb, 0x33, 0xce, 0xe2, 0xe5, 0xa7, 0x2f, 0xca, 0xce, 0xb3, 0x17, 0x65, 0xe7, 0x8f,
+	0x17, 0x65, 0xe7, 0xf1, 0xcb, 0xf2, 0xc0, 0xb3, 0x97, 0xe5, 0x81, 0x5f, 0x5f, 0x96, 0x07, 0x3e,
+	0x98, 0xc8, 0xfb, 0xf5, 0x4e, 0x3c, 0x6c, 0x62, 0xbe, 0x3e, 0xa2, 0x7e, 0x84, 0xbc, 0xf4, 0x67,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x76, 0x88, 0x9c, 0x6c, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RegisterStudent(ctx context.Context, in *MsgRegisterStudent, opts ...grpc.CallOption) (*MsgRegisterStudentResponse, error)
+	CreateEnrollment(ctx context.Context, in *MsgCreateEnrollment, opts ...grpc.CallOption) (*MsgCreateEnrollmentResponse, error)
+	UpdateEnrollmentStatus(ctx context.Context, in *MsgUpdateEnrollmentStatus, opts ...grpc.CallOption) (*MsgUpdateEnrollmentStatusResponse, error)
+	RequestSubjectEnrollment(ctx context.Context, in *MsgRequestSubjectEnrollment, opts ...grpc.CallOption) (*MsgRequestSubjectEnrollmentResponse, error)
+	UpdateAcademicTree(ctx context.Context, in *MsgUpdateAcademicTree, opts ...grpc.CallOption) (*MsgUpdateAcademicTreeResponse, error)
+	CompleteSubject(ctx context.Context, in *MsgCompleteSubject, opts ...grpc.CallOption) (*MsgCompleteSubjectResponse, error)
+	RequestEquivalence(ctx context.Context, in *MsgRequestEquivalence, opts ...grpc.CallOption) (*MsgRequestEquivalenceResponse, error)
+	RequestCurriculumMigration(ctx context.Context, in *MsgRequestCurriculumMigration, opts ...grpc.CallOption) (*MsgRequestCurriculumMigrationResponse, error)
+	ReviewCurriculumMigration(ctx context.Context, in *MsgReviewCurriculumMigration, opts ...grpc.CallOption) (*MsgReviewCurriculumMigrationResponse, error)
+	CreateSubjectOffering(ctx context.Context, in *MsgCreateSubjectOffering, opts ...grpc.CallOption) (*MsgCreateSubjectOfferingResponse, error)
+	DropSubjectOffering(ctx context.Context, in *MsgDropSubjectOffering, opts ...grpc.CallOption) (*MsgDropSubjectOfferingResponse, error)
+	WithdrawSubjectOffering(ctx context.Context, in *MsgWithdrawSubjectOffering, opts ...grpc.CallOption) (*MsgWithdrawSubjectOfferingResponse, error)
+	GrantPrerequisiteWaiver(ctx context.Context, in *MsgGrantPrerequisiteWaiver, opts ...grpc.CallOption) (*MsgGrantPrerequisiteWaiverResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateAcademicTreeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterStudent(ctx context.Context, in *MsgRegisterStudent, opts ...grpc.CallOption) (*MsgRegisterStudentResponse, error) {
+	out := new(MsgRegisterStudentResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/RegisterStudent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateAcademicTreeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateEnrollment(ctx context.Context, in *MsgCreateEnrollment, opts ...grpc.CallOption) (*MsgCreateEnrollmentResponse, error) {
+	out := new(MsgCreateEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/CreateEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateAcademicTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateEnrollmentStatus(ctx context.Context, in *MsgUpdateEnrollmentStatus, opts ...grpc.CallOption) (*MsgUpdateEnrollmentStatusResponse, error) {
+	out := new(MsgUpdateEnrollmentStatusResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/UpdateEnrollmentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteSubject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestSubjectEnrollment(ctx context.Context, in *MsgRequestSubjectEnrollment, opts ...grpc.CallOption) (*MsgRequestSubjectEnrollmentResponse, error) {
+	out := new(MsgRequestSubjectEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/RequestSubjectEnrollment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteSubject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateAcademicTree(ctx context.Context, in *MsgUpdateAcademicTree, opts ...grpc.CallOption) (*MsgUpdateAcademicTreeResponse, error) {
+	out := new(MsgUpdateAcademicTreeResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/UpdateAcademicTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteSubject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProfessorSignature) > 0 {
-		i -= len(m.ProfessorSignature)
-		copy(dAtA[i:], m.ProfessorSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProfessorSignature)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Semester) > 0 {
-		i -= len(m.Semester)
-		copy(dAtA[i:], m.Semester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Semester)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.CompletionDate) > 0 {
-		i -= len(m.CompletionDate)
-		copy(dAtA[i:], m.CompletionDate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CompletionDate)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Grade != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Grade))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.SubjectId) > 0 {
-		i -= len(m.SubjectId)
-		copy(dAtA[i:], m.SubjectId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StudentId) > 0 {
-		i -= len(m.StudentId)
-		copy(dAtA[i:], m.StudentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CompleteSubject(ctx context.Context, in *MsgCompleteSubject, opts ...grpc.CallOption) (*MsgCompleteSubjectResponse, error) {
+	out := new(MsgCompleteSubjectResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/CompleteSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCompleteSubjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestEquivalence(ctx context.Context, in *MsgRequestEquivalence, opts ...grpc.CallOption) (*MsgRequestEquivalenceResponse, error) {
+	out := new(MsgRequestEquivalenceResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/RequestEquivalence", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCompleteSubjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestCurriculumMigration(ctx context.Context, in *MsgRequestCurriculumMigration, opts ...grpc.CallOption) (*MsgRequestCurriculumMigrationResponse, error) {
+	out := new(MsgRequestCurriculumMigrationResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/RequestCurriculumMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCompleteSubjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsEligibleForGraduation {
-		i--
-		if m.IsEligibleForGraduation {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.CreditsCompleted != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CreditsCompleted))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ProgressPercentage != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProgressPercentage))))
-		i--
-		dAtA[i] = 0x11
-	}
-	if len(m.NftTokenId) > 0 {
-		i -= len(m.NftTokenId)
-		copy(dAtA[i:], m.NftTokenId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NftTokenId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ReviewCurriculumMigration(ctx context.Context, in *MsgReviewCurriculumMigration, opts ...grpc.CallOption) (*MsgReviewCurriculumMigrationResponse, error) {
+	out := new(MsgReviewCurriculumMigrationResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/ReviewCurriculumMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRequestEquivalence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateSubjectOffering(ctx context.Context, in *MsgCreateSubjectOffering, opts ...grpc.CallOption) (*MsgCreateSubjectOfferingResponse, error) {
+	out := new(MsgCreateSubjectOfferingResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/CreateSubjectOffering", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestEquivalence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DropSubjectOffering(ctx context.Context, in *MsgDropSubjectOffering, opts ...grpc.CallOption) (*MsgDropSubjectOfferingResponse, error) {
+	out := new(MsgDropSubjectOfferingResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/DropSubjectOffering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRequestEquivalence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TargetSubjectId) > 0 {
-		i -= len(m.TargetSubjectId)
-		copy(dAtA[i:], m.TargetSubjectId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetSubjectId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceSubjectId) > 0 {
-		i -= len(m.SourceSubjectId)
-		copy(dAtA[i:], m.SourceSubjectId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubjectId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StudentId) > 0 {
-		i -= len(m.StudentId)
-		copy(dAtA[i:], m.StudentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) WithdrawSubjectOffering(ctx context.Context, in *MsgWithdrawSubjectOffering, opts ...grpc.CallOption) (*MsgWithdrawSubjectOfferingResponse, error) {
+	out := new(MsgWithdrawSubjectOfferingResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/WithdrawSubjectOffering", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRequestEquivalenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantPrerequisiteWaiver(ctx context.Context, in *MsgGrantPrerequisiteWaiver, opts ...grpc.CallOption) (*MsgGrantPrerequisiteWaiverResponse, error) {
+	out := new(MsgGrantPrerequisiteWaiverResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.student.Msg/GrantPrerequisiteWaiver", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestEquivalenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RegisterStudent(context.Context, *MsgRegisterStudent) (*MsgRegisterStudentResponse, error)
+	CreateEnrollment(context.Context, *MsgCreateEnrollment) (*MsgCreateEnrollmentResponse, error)
+	UpdateEnrollmentStatus(context.Context, *MsgUpdateEnrollmentStatus) (*MsgUpdateEnrollmentStatusResponse, error)
+	RequestSubjectEnrollment(context.Context, *MsgRequestSubjectEnrollment) (*MsgRequestSubjectEnrollmentResponse, error)
+	UpdateAcademicTree(context.Context, *MsgUpdateAcademicTree) (*MsgUpdateAcademicTreeResponse, error)
+	CompleteSubject(context.Context, *MsgCompleteSubject) (*MsgCompleteSubjectResponse, error)
+	RequestEquivalence(context.Context, *MsgRequestEquivalence) (*MsgRequestEquivalenceResponse, error)
+	RequestCurriculumMigration(context.Context, *MsgRequestCurriculumMigration) (*MsgRequestCurriculumMigrationResponse, error)
+	ReviewCurriculumMigration(context.Context, *MsgReviewCurriculumMigration) (*MsgReviewCurriculumMigrationResponse, error)
+	CreateSubjectOffering(context.Context, *MsgCreateSubjectOffering) (*MsgCreateSubjectOfferingResponse, error)
+	DropSubjectOffering(context.Context, *MsgDropSubjectOffering) (*MsgDropSubjectOfferingResponse, error)
+	WithdrawSubjectOffering(context.Context, *MsgWithdrawSubjectOffering) (*MsgWithdrawSubjectOfferingResponse, error)
+	GrantPrerequisiteWaiver(context.Context, *MsgGrantPrerequisiteWaiver) (*MsgGrantPrerequisiteWaiverResponse, error)
 }
 
-func (m *MsgRequestEquivalenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EquivalenceId) > 0 {
-		i -= len(m.EquivalenceId)
-		copy(dAtA[i:], m.EquivalenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EquivalenceId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServer) RegisterStudent(ctx context.Context, req *MsgRegisterStudent) (*MsgRegisterStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterStudent not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) CreateEnrollment(ctx context.Context, req *MsgCreateEnrollment) (*MsgCreateEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnrollment not implemented")
 }
-
-func (m *MsgRegisterStudent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) UpdateEnrollmentStatus(ctx context.Context, req *MsgUpdateEnrollmentStatus) (*MsgUpdateEnrollmentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollmentStatus not implemented")
+}
+func (*UnimplementedMsgServer) RequestSubjectEnrollment(ctx context.Context, req *MsgRequestSubjectEnrollment) (*MsgRequestSubjectEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSubjectEnrollment not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAcademicTree(ctx context.Context, req *MsgUpdateAcademicTree) (*MsgUpdateAcademicTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAcademicTree not implemented")
+}
+func (*UnimplementedMsgServer) CompleteSubject(ctx context.Context, req *MsgCompleteSubject) (*MsgCompleteSubjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSubject not implemented")
+}
+func (*UnimplementedMsgServer) RequestEquivalence(ctx context.Context, req *MsgRequestEquivalence) (*MsgRequestEquivalenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEquivalence not implemented")
+}
+func (*UnimplementedMsgServer) RequestCurriculumMigration(ctx context.Context, req *MsgRequestCurriculumMigration) (*MsgRequestCurriculumMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCurriculumMigration not implemented")
+}
+func (*UnimplementedMsgServer) ReviewCurriculumMigration(ctx context.Context, req *MsgReviewCurriculumMigration) (*MsgReviewCurriculumMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewCurriculumMigration not implemented")
+}
+func (*UnimplementedMsgServer) CreateSubjectOffering(ctx context.Context, req *MsgCreateSubjectOffering) (*MsgCreateSubjectOfferingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubjectOffering not implemented")
+}
+func (*UnimplementedMsgServer) DropSubjectOffering(ctx context.Context, req *MsgDropSubjectOffering) (*MsgDropSubjectOfferingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSubjectOffering not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSubjectOffering(ctx context.Context, req *MsgWithdrawSubjectOffering) (*MsgWithdrawSubjectOfferingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSubjectOffering not implemented")
+}
+func (*UnimplementedMsgServer) GrantPrerequisiteWaiver(ctx context.Context, req *MsgGrantPrerequisiteWaiver) (*MsgGrantPrerequisiteWaiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPrerequisiteWaiver not implemented")
 }
 
-func (m *MsgRegisterStudentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateEnrollment) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterStudent)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterStudent(ctx, in)
 	}
-	l = len(m.Student)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/RegisterStudent",
 	}
-	l = len(m.Institution)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterStudent(ctx, req.(*MsgRegisterStudent))
 	}
-	l = len(m.CourseId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEnrollment)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/CreateEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEnrollment(ctx, req.(*MsgCreateEnrollment))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateEnrollmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateEnrollmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEnrollmentStatus)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEnrollmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/UpdateEnrollmentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEnrollmentStatus(ctx, req.(*MsgUpdateEnrollmentStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateEnrollmentStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RequestSubjectEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSubjectEnrollment)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSubjectEnrollment(ctx, in)
 	}
-	l = len(m.EnrollmentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/RequestSubjectEnrollment",
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSubjectEnrollment(ctx, req.(*MsgRequestSubjectEnrollment))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateEnrollmentStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateAcademicTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAcademicTree)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAcademicTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/UpdateAcademicTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAcademicTree(ctx, req.(*MsgUpdateAcademicTree))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestSubjectEnrollment) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CompleteSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteSubject)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteSubject(ctx, in)
 	}
-	l = len(m.Student)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/CompleteSubject",
 	}
-	l = len(m.SubjectId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteSubject(ctx, req.(*MsgCompleteSubject))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestSubjectEnrollmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RequestEquivalence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestEquivalence)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RequestEquivalence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/RequestEquivalence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestEquivalence(ctx, req.(*MsgRequestEquivalence))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateAcademicTree) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RequestCurriculumMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestCurriculumMigration)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RequestCurriculumMigration(ctx, in)
 	}
-	l = len(m.StudentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/RequestCurriculumMigration",
 	}
-	if len(m.CompletedTokens) > 0 {
-		for _, s := range m.CompletedTokens {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestCurriculumMigration(ctx, req.(*MsgRequestCurriculumMigration))
 	}
-	if len(m.InProgressTokens) > 0 {
-		for _, s := range m.InProgressTokens {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReviewCurriculumMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReviewCurriculumMigration)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AvailableTokens) > 0 {
-		for _, s := range m.AvailableTokens {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ReviewCurriculumMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/ReviewCurriculumMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReviewCurriculumMigration(ctx, req.(*MsgReviewCurriculumMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSubjectOffering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubjectOffering)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubjectOffering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/CreateSubjectOffering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubjectOffering(ctx, req.(*MsgCreateSubjectOffering))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DropSubjectOffering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDropSubjectOffering)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DropSubjectOffering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/DropSubjectOffering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DropSubjectOffering(ctx, req.(*MsgDropSubjectOffering))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSubjectOffering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSubjectOffering)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSubjectOffering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/WithdrawSubjectOffering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSubjectOffering(ctx, req.(*MsgWithdrawSubjectOffering))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantPrerequisiteWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantPrerequisiteWaiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantPrerequisiteWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.student.Msg/GrantPrerequisiteWaiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantPrerequisiteWaiver(ctx, req.(*MsgGrantPrerequisiteWaiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "academictoken.student.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterStudent",
+			Handler:    _Msg_RegisterStudent_Handler,
+		},
+		{
+			MethodName: "CreateEnrollment",
+			Handler:    _Msg_CreateEnrollment_Handler,
+		},
+		{
+			MethodName: "UpdateEnrollmentStatus",
+			Handler:    _Msg_UpdateEnrollmentStatus_Handler,
+		},
+		{
+			MethodName: "RequestSubjectEnrollment",
+			Handler:    _Msg_RequestSubjectEnrollment_Handler,
+		},
+		{
+			MethodName: "UpdateAcademicTree",
+			Handler:    _Msg_UpdateAcademicTree_Handler,
+		},
+		{
+			MethodName: "CompleteSubject",
+			Handler:    _Msg_CompleteSubject_Handler,
+		},
+		{
+			MethodName: "RequestEquivalence",
+			Handler:    _Msg_RequestEquivalence_Handler,
+		},
+		{
+			MethodName: "RequestCurriculumMigration",
+			Handler:    _Msg_RequestCurriculumMigration_Handler,
+		},
+		{
+			MethodName: "ReviewCurriculumMigration",
+			Handler:    _Msg_ReviewCurriculumMigration_Handler,
+		},
+		{
+			MethodName: "CreateSubjectOffering",
+			Handler:    _Msg_CreateSubjectOffering_Handler,
+		},
+		{
+			MethodName: "DropSubjectOffering",
+			Handler:    _Msg_DropSubjectOffering_Handler,
+		},
+		{
+			MethodName: "WithdrawSubjectOffering",
+			Handler:    _Msg_WithdrawSubjectOffering_Handler,
+		},
+		{
+			MethodName: "GrantPrerequisiteWaiver",
+			Handler:    _Msg_GrantPrerequisiteWaiver_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "academictoken/student/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterStudent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterStudent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterStudent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterStudentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterStudentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterStudentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEnrollment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEnrollment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CourseId) > 0 {
+		i -= len(m.CourseId)
+		copy(dAtA[i:], m.CourseId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CourseId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Institution) > 0 {
+		i -= len(m.Institution)
+		copy(dAtA[i:], m.Institution)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Institution)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Student) > 0 {
+		i -= len(m.Student)
+		copy(dAtA[i:], m.Student)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Student)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEnrollmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEnrollmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEnrollmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEnrollmentStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEnrollmentStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEnrollmentStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EnrollmentId) > 0 {
+		i -= len(m.EnrollmentId)
+		copy(dAtA[i:], m.EnrollmentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EnrollmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEnrollmentStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEnrollmentStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEnrollmentStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSubjectEnrollment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSubjectEnrollment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSubjectEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OfferingId) > 0 {
+		i -= len(m.OfferingId)
+		copy(dAtA[i:], m.OfferingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OfferingId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Student) > 0 {
+		i -= len(m.Student)
+		copy(dAtA[i:], m.Student)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Student)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSubjectEnrollmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSubjectEnrollmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSubjectEnrollmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WaitlistPosition != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WaitlistPosition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAcademicTree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAcademicTree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAcademicTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvailableTokens) > 0 {
+		for iNdEx := len(m.AvailableTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AvailableTokens[iNdEx])
+			copy(dAtA[i:], m.AvailableTokens[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AvailableTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.InProgressTokens) > 0 {
+		for iNdEx := len(m.InProgressTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InProgressTokens[iNdEx])
+			copy(dAtA[i:], m.InProgressTokens[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.InProgressTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CompletedTokens) > 0 {
+		for iNdEx := len(m.CompletedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CompletedTokens[iNdEx])
+			copy(dAtA[i:], m.CompletedTokens[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CompletedTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAcademicTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAcademicTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAcademicTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteSubject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteSubject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteSubject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GradeValue) > 0 {
+		i -= len(m.GradeValue)
+		copy(dAtA[i:], m.GradeValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GradeValue)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ProfessorSignature) > 0 {
+		i -= len(m.ProfessorSignature)
+		copy(dAtA[i:], m.ProfessorSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProfessorSignature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Semester) > 0 {
+		i -= len(m.Semester)
+		copy(dAtA[i:], m.Semester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Semester)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CompletionDate) > 0 {
+		i -= len(m.CompletionDate)
+		copy(dAtA[i:], m.CompletionDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CompletionDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Grade != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Grade))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteSubjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteSubjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteSubjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsEligibleForGraduation {
+		i--
+		if m.IsEligibleForGraduation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreditsCompleted != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreditsCompleted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProgressPercentage != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProgressPercentage))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.NftTokenId) > 0 {
+		i -= len(m.NftTokenId)
+		copy(dAtA[i:], m.NftTokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NftTokenId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestEquivalence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestEquivalence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestEquivalence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetSubjectId) > 0 {
+		i -= len(m.TargetSubjectId)
+		copy(dAtA[i:], m.TargetSubjectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetSubjectId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceSubjectId) > 0 {
+		i -= len(m.SourceSubjectId)
+		copy(dAtA[i:], m.SourceSubjectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestEquivalenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestEquivalenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestEquivalenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EquivalenceId) > 0 {
+		i -= len(m.EquivalenceId)
+		copy(dAtA[i:], m.EquivalenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EquivalenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestCurriculumMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestCurriculumMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestCurriculumMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetVersion) > 0 {
+		i -= len(m.TargetVersion)
+		copy(dAtA[i:], m.TargetVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestCurriculumMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestCurriculumMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestCurriculumMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigrationId) > 0 {
+		i -= len(m.MigrationId)
+		copy(dAtA[i:], m.MigrationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReviewCurriculumMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReviewCurriculumMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReviewCurriculumMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MigrationId) > 0 {
+		i -= len(m.MigrationId)
+		copy(dAtA[i:], m.MigrationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReviewCurriculumMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReviewCurriculumMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReviewCurriculumMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurriculumVersion) > 0 {
+		i -= len(m.CurriculumVersion)
+		copy(dAtA[i:], m.CurriculumVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CurriculumVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubjectOffering) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubjectOffering) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubjectOffering) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Instructor) > 0 {
+		i -= len(m.Instructor)
+		copy(dAtA[i:], m.Instructor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Instructor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Section) > 0 {
+		i -= len(m.Section)
+		copy(dAtA[i:], m.Section)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Section)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubjectOfferingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubjectOfferingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubjectOfferingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OfferingId) > 0 {
+		i -= len(m.OfferingId)
+		copy(dAtA[i:], m.OfferingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OfferingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDropSubjectOffering) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDropSubjectOffering) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDropSubjectOffering) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OfferingId) > 0 {
+		i -= len(m.OfferingId)
+		copy(dAtA[i:], m.OfferingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OfferingId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDropSubjectOfferingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDropSubjectOfferingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDropSubjectOfferingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSubjectOffering) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSubjectOffering) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSubjectOffering) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OfferingId) > 0 {
+		i -= len(m.OfferingId)
+		copy(dAtA[i:], m.OfferingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OfferingId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSubjectOfferingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSubjectOfferingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSubjectOfferingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPrerequisiteWaiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPrerequisiteWaiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPrerequisiteWaiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StudentId) > 0 {
+		i -= len(m.StudentId)
+		copy(dAtA[i:], m.StudentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StudentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPrerequisiteWaiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPrerequisiteWaiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPrerequisiteWaiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterStudent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterStudentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateEnrollment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Student)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Institution)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CourseId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEnrollmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEnrollmentStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EnrollmentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateEnrollmentStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestSubjectEnrollment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Student)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OfferingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestSubjectEnrollmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WaitlistPosition != 0 {
+		n += 1 + sovTx(uint64(m.WaitlistPosition))
+	}
+	return n
+}
+
+func (m *MsgUpdateAcademicTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CompletedTokens) > 0 {
+		for _, s := range m.CompletedTokens {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.InProgressTokens) > 0 {
+		for _, s := range m.InProgressTokens {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AvailableTokens) > 0 {
+		for _, s := range m.AvailableTokens {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAcademicTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteSubject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Grade != 0 {
+		n += 1 + sovTx(uint64(m.Grade))
+	}
+	l = len(m.CompletionDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Semester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProfessorSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GradeValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCompleteSubjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NftTokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProgressPercentage != 0 {
+		n += 9
+	}
+	if m.CreditsCompleted != 0 {
+		n += 1 + sovTx(uint64(m.CreditsCompleted))
+	}
+	if m.IsEligibleForGraduation {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRequestEquivalence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceSubjectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TargetSubjectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestEquivalenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EquivalenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestCurriculumMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TargetVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestCurriculumMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MigrationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReviewCurriculumMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MigrationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Approve {
+		n += 2
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReviewCurriculumMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurriculumVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateSubjectOffering) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Section)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovTx(uint64(m.Capacity))
+	}
+	l = len(m.Instructor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateSubjectOfferingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDropSubjectOffering) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OfferingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDropSubjectOfferingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSubjectOffering) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OfferingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawSubjectOfferingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantPrerequisiteWaiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StudentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantPrerequisiteWaiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterStudent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterStudent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterStudent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterStudentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterStudentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterStudentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEnrollment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEnrollment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEnrollment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Student", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Student = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Institution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CourseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEnrollmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEnrollmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEnrollmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEnrollmentStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEnrollmentStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEnrollmentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrollmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEnrollmentStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEnrollmentStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEnrollmentStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSubjectEnrollment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSubjectEnrollment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSubjectEnrollment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Student", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Student = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSubjectEnrollmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSubjectEnrollmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSubjectEnrollmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitlistPosition", wireType)
+			}
+			m.WaitlistPosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitlistPosition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAcademicTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAcademicTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAcademicTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletedTokens = append(m.CompletedTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InProgressTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InProgressTokens = append(m.InProgressTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableTokens = append(m.AvailableTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAcademicTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAcademicTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAcademicTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteSubject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteSubject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteSubject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			m.Grade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Grade |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfessorSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfessorSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradeValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GradeValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgUpdateAcademicTreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCompleteSubject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StudentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SubjectId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Grade != 0 {
-		n += 1 + sovTx(uint64(m.Grade))
-	}
-	l = len(m.CompletionDate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Semester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProfessorSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgCompleteSubjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NftTokenId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProgressPercentage != 0 {
-		n += 9
-	}
-	if m.CreditsCompleted != 0 {
-		n += 1 + sovTx(uint64(m.CreditsCompleted))
-	}
-	if m.IsEligibleForGraduation {
-		n += 2
-	}
-	return n
-}
-
-func (m *MsgRequestEquivalence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StudentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceSubjectId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TargetSubjectId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgRequestEquivalenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EquivalenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteSubjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2299,15 +5649,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteSubjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteSubjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftTokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2335,13 +5685,24 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.NftTokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressPercentage", wireType)
 			}
-			var msglen int
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ProgressPercentage = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditsCompleted", wireType)
+			}
+			m.CreditsCompleted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2351,25 +5712,31 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreditsCompleted |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEligibleForGraduation", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.IsEligibleForGraduation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2391,7 +5758,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestEquivalence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2414,65 +5781,79 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestEquivalence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestEquivalence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRegisterStudent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterStudent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterStudent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StudentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceSubjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2500,11 +5881,11 @@ func (m *MsgRegisterStudent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.SourceSubjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSubjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2532,11 +5913,11 @@ func (m *MsgRegisterStudent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TargetSubjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2564,7 +5945,7 @@ func (m *MsgRegisterStudent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2587,7 +5968,7 @@ func (m *MsgRegisterStudent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterStudentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestEquivalenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2610,12 +5991,44 @@ func (m *MsgRegisterStudentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterStudentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestEquivalenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterStudentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestEquivalenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EquivalenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2637,7 +6050,7 @@ func (m *MsgRegisterStudentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEnrollment) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestCurriculumMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2660,10 +6073,10 @@ func (m *MsgCreateEnrollment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEnrollment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestCurriculumMigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEnrollment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestCurriculumMigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2700,7 +6113,7 @@ func (m *MsgCreateEnrollment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Student", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2728,11 +6141,11 @@ func (m *MsgCreateEnrollment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Student = string(dAtA[iNdEx:postIndex])
+			m.StudentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Institution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2760,11 +6173,11 @@ func (m *MsgCreateEnrollment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Institution = string(dAtA[iNdEx:postIndex])
+			m.TargetVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2792,7 +6205,7 @@ func (m *MsgCreateEnrollment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CourseId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2815,7 +6228,7 @@ func (m *MsgCreateEnrollment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEnrollmentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestCurriculumMigrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2838,12 +6251,44 @@ func (m *MsgCreateEnrollmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEnrollmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestCurriculumMigrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEnrollmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestCurriculumMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2865,7 +6310,7 @@ func (m *MsgCreateEnrollmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEnrollmentStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgReviewCurriculumMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2884,19 +6329,51 @@ func (m *MsgUpdateEnrollmentStatus) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEnrollmentStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEnrollmentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReviewCurriculumMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReviewCurriculumMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2924,11 +6401,11 @@ func (m *MsgUpdateEnrollmentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.StudentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2956,11 +6433,31 @@ func (m *MsgUpdateEnrollmentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnrollmentId = string(dAtA[iNdEx:postIndex])
+			m.MigrationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approve = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2988,7 +6485,7 @@ func (m *MsgUpdateEnrollmentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Note = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3011,7 +6508,7 @@ func (m *MsgUpdateEnrollmentStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEnrollmentStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReviewCurriculumMigrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3034,12 +6531,44 @@ func (m *MsgUpdateEnrollmentStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEnrollmentStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReviewCurriculumMigrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEnrollmentStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReviewCurriculumMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurriculumVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurriculumVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3061,7 +6590,7 @@ func (m *MsgUpdateEnrollmentStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestSubjectEnrollment) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSubjectOffering) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3084,10 +6613,10 @@ func (m *MsgRequestSubjectEnrollment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestSubjectEnrollment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSubjectOffering: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestSubjectEnrollment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSubjectOffering: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3124,7 +6653,7 @@ func (m *MsgRequestSubjectEnrollment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Student", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3152,11 +6681,11 @@ func (m *MsgRequestSubjectEnrollment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Student = string(dAtA[iNdEx:postIndex])
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3184,58 +6713,91 @@ func (m *MsgRequestSubjectEnrollment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			m.Term = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Section", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRequestSubjectEnrollmentResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Section = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instructor", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestSubjectEnrollmentResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestSubjectEnrollmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instructor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3257,7 +6819,7 @@ func (m *MsgRequestSubjectEnrollmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAcademicTree) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSubjectOfferingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3280,15 +6842,15 @@ func (m *MsgUpdateAcademicTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAcademicTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSubjectOfferingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAcademicTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSubjectOfferingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3316,43 +6878,61 @@ func (m *MsgUpdateAcademicTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.OfferingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDropSubjectOffering) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StudentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDropSubjectOffering: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDropSubjectOffering: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3380,11 +6960,11 @@ func (m *MsgUpdateAcademicTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CompletedTokens = append(m.CompletedTokens, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InProgressTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StudentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3412,11 +6992,11 @@ func (m *MsgUpdateAcademicTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InProgressTokens = append(m.InProgressTokens, string(dAtA[iNdEx:postIndex]))
+			m.StudentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3444,7 +7024,7 @@ func (m *MsgUpdateAcademicTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailableTokens = append(m.AvailableTokens, string(dAtA[iNdEx:postIndex]))
+			m.OfferingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3467,7 +7047,7 @@ func (m *MsgUpdateAcademicTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAcademicTreeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDropSubjectOfferingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3490,10 +7070,10 @@ func (m *MsgUpdateAcademicTreeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAcademicTreeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDropSubjectOfferingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAcademicTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDropSubjectOfferingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3517,7 +7097,7 @@ func (m *MsgUpdateAcademicTreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteSubject) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSubjectOffering) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3540,10 +7120,10 @@ func (m *MsgCompleteSubject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteSubject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSubjectOffering: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteSubject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSubjectOffering: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3612,7 +7192,7 @@ func (m *MsgCompleteSubject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3640,94 +7220,11 @@ func (m *MsgCompleteSubject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			m.OfferingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
-			}
-			m.Grade = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Grade |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionDate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CompletionDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semester", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Semester = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfessorSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3755,7 +7252,7 @@ func (m *MsgCompleteSubject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProfessorSignature = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3778,7 +7275,7 @@ func (m *MsgCompleteSubject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteSubjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSubjectOfferingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3801,94 +7298,12 @@ func (m *MsgCompleteSubjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteSubjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSubjectOfferingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteSubjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSubjectOfferingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftTokenId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NftTokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressPercentage", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.ProgressPercentage = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditsCompleted", wireType)
-			}
-			m.CreditsCompleted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreditsCompleted |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEligibleForGraduation", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsEligibleForGraduation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3910,7 +7325,7 @@ func (m *MsgCompleteSubjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestEquivalence) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPrerequisiteWaiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3933,10 +7348,10 @@ func (m *MsgRequestEquivalence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestEquivalence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPrerequisiteWaiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestEquivalence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPrerequisiteWaiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4005,7 +7420,7 @@ func (m *MsgRequestEquivalence) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceSubjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4033,11 +7448,11 @@ func (m *MsgRequestEquivalence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceSubjectId = string(dAtA[iNdEx:postIndex])
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSubjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4065,11 +7480,11 @@ func (m *MsgRequestEquivalence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetSubjectId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4097,7 +7512,7 @@ func (m *MsgRequestEquivalence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4120,7 +7535,7 @@ func (m *MsgRequestEquivalence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestEquivalenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPrerequisiteWaiverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4143,44 +7558,12 @@ func (m *MsgRequestEquivalenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestEquivalenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPrerequisiteWaiverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestEquivalenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPrerequisiteWaiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquivalenceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EquivalenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
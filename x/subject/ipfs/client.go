@@ -2,8 +2,6 @@ package ipfs
 
 import (
 	"bytes"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,7 +12,9 @@ import (
 	"time"
 )
 
-// IPFSClient provides functionality to interact with IPFS
+// IPFSClient provides functionality to interact with IPFS. It performs HTTP
+// and filesystem I/O, so it must only be used by clients and off-chain
+// services, never while executing transactions
 type IPFSClient struct {
 	ApiEndpoint string
 	LocalPath   string
@@ -35,8 +35,7 @@ func NewIPFSClient(apiEndpoint, localPath string, useHTTP bool) *IPFSClient {
 // Add adds content to IPFS and returns its hash and link
 func (c *IPFSClient) Add(content []byte) (string, string, error) {
 	// Calculate content hash before sending to IPFS
-	hash := sha256.Sum256(content)
-	contentHash := hex.EncodeToString(hash[:])
+	contentHash := ContentHash(content)
 
 	var ipfsHash string
 	var err error
@@ -73,7 +72,9 @@ func (c *IPFSClient) Get(ipfsLink string) ([]byte, error) {
 
 // addViaHTTP adds content to IPFS via HTTP API
 func (c *IPFSClient) addViaHTTP(content []byte) (string, error) {
-	url := fmt.Sprintf("%s/api/v0/add", c.ApiEndpoint)
+	// CIDv1 addresses small files as a single raw block, which lets the chain
+	// check the CID against the content hash
+	url := fmt.Sprintf("%s/api/v0/add?cid-version=1", c.ApiEndpoint)
 
 	// Create multipart/form-data request
 	body := &bytes.Buffer{}
@@ -155,9 +156,8 @@ func (c *IPFSClient) getViaHTTP(ipfsHash string) ([]byte, error) {
 
 // addViaLocalFS adds content to local filesystem (for development/testing)
 func (c *IPFSClient) addViaLocalFS(content []byte) (string, error) {
-	// Generate unique hash for the file
-	hash := sha256.Sum256(content)
-	ipfsHash := hex.EncodeToString(hash[:])
+	// Name the file after the CID IPFS would give it
+	ipfsHash := RawCID(content)
 
 	// Create directory if it doesn't exist
 	err := os.MkdirAll(c.LocalPath, 0755)
@@ -204,9 +204,8 @@ func NewMockIPFSClient() *MockIPFSClient {
 // Add mocks adding content to IPFS
 func (m *MockIPFSClient) Add(content []byte) (string, string, error) {
 	m.addCalled = true
-	hash := sha256.Sum256(content)
-	contentHash := hex.EncodeToString(hash[:])
-	ipfsHash := contentHash[0:16] // Simplified for testing
+	contentHash := ContentHash(content)
+	ipfsHash := RawCID(content)
 	ipfsLink := fmt.Sprintf("ipfs://%s", ipfsHash)
 
 	m.storage[ipfsHash] = content
@@ -0,0 +1,143 @@
+package ipfs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/btcutil/base58"
+)
+
+// The functions in this file never touch the network or the filesystem, so
+// they are safe to call while executing transactions. Uploading and fetching
+// content with IPFSClient belongs to clients and off-chain services only.
+
+// LinkPrefix is the scheme of the IPFS links stored on chain
+const LinkPrefix = "ipfs://"
+
+// Multicodec codes used by the CIDs accepted on chain
+const (
+	CodecRaw    uint64 = 0x55
+	CodecDagPB  uint64 = 0x70
+	HashSHA2256 uint64 = 0x12
+)
+
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// CID is a decoded content identifier
+type CID struct {
+	Version  uint64
+	Codec    uint64
+	HashCode uint64
+	Digest   []byte
+}
+
+// ContentHash returns the hex encoded SHA-256 of the content
+func ContentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// RawCID returns the CIDv1 of content stored as a single raw block, which is
+// what `ipfs add --cid-version=1` produces for small files
+func RawCID(content []byte) string {
+	hash := sha256.Sum256(content)
+	bz := binary.AppendUvarint(nil, 1)
+	bz = binary.AppendUvarint(bz, CodecRaw)
+	bz = binary.AppendUvarint(bz, HashSHA2256)
+	bz = binary.AppendUvarint(bz, uint64(len(hash)))
+	bz = append(bz, hash[:]...)
+	return "b" + base32Lower.EncodeToString(bz)
+}
+
+// ParseCID decodes a CIDv0 (base58 "Qm...") or a base32 or base58 CIDv1
+func ParseCID(s string) (CID, error) {
+	if len(s) == 46 && strings.HasPrefix(s, "Qm") {
+		bz := base58.Decode(s)
+		if len(bz) != 34 || bz[0] != byte(HashSHA2256) || bz[1] != 32 {
+			return CID{}, fmt.Errorf("invalid CIDv0 %q", s)
+		}
+		return CID{Version: 0, Codec: CodecDagPB, HashCode: HashSHA2256, Digest: bz[2:]}, nil
+	}
+	if len(s) < 2 {
+		return CID{}, fmt.Errorf("invalid CID %q", s)
+	}
+
+	var bz []byte
+	var err error
+	switch s[0] {
+	case 'b':
+		bz, err = base32Lower.DecodeString(s[1:])
+	case 'z':
+		bz = base58.Decode(s[1:])
+		if len(bz) == 0 {
+			err = fmt.Errorf("invalid base58")
+		}
+	default:
+		return CID{}, fmt.Errorf("unsupported CID multibase %q", s[:1])
+	}
+	if err != nil {
+		return CID{}, fmt.Errorf("invalid CID %q: %w", s, err)
+	}
+
+	r := bytes.NewReader(bz)
+	var fields [4]uint64
+	for i := range fields {
+		if fields[i], err = binary.ReadUvarint(r); err != nil {
+			return CID{}, fmt.Errorf("invalid CID %q: truncated", s)
+		}
+	}
+	cid := CID{Version: fields[0], Codec: fields[1], HashCode: fields[2], Digest: make([]byte, r.Len())}
+	r.Read(cid.Digest)
+	if cid.Version != 1 {
+		return CID{}, fmt.Errorf("unsupported CID version %d", cid.Version)
+	}
+	if uint64(len(cid.Digest)) != fields[3] || len(cid.Digest) == 0 {
+		return CID{}, fmt.Errorf("invalid CID %q: digest length", s)
+	}
+	return cid, nil
+}
+
+// ParseLink decodes the CID of an ipfs:// link
+func ParseLink(link string) (CID, error) {
+	if !strings.HasPrefix(link, LinkPrefix) {
+		return CID{}, fmt.Errorf("IPFS link must start with %s", LinkPrefix)
+	}
+	return ParseCID(strings.TrimPrefix(link, LinkPrefix))
+}
+
+// ValidateReference checks the format of a content hash and IPFS link pair.
+// When the CID addresses the raw content its digest must be the content hash;
+// for chunked content the binding is checked off-chain by pinning verifiers
+func ValidateReference(contentHash, ipfsLink string) error {
+	if contentHash == "" || ipfsLink == "" {
+		return fmt.Errorf("content hash and IPFS link must be provided together")
+	}
+	digest, err := hex.DecodeString(contentHash)
+	if err != nil || len(digest) != sha256.Size || contentHash != strings.ToLower(contentHash) {
+		return fmt.Errorf("content hash must be a lowercase hex SHA-256")
+	}
+	cid, err := ParseLink(ipfsLink)
+	if err != nil {
+		return err
+	}
+	if cid.Codec == CodecRaw && cid.HashCode == HashSHA2256 && !bytes.Equal(cid.Digest, digest) {
+		return fmt.Errorf("IPFS link %s does not address content hash %s", ipfsLink, contentHash)
+	}
+	return nil
+}
+
+// VerifyContent checks that content matches its content hash and IPFS link
+func VerifyContent(content []byte, contentHash, ipfsLink string) error {
+	if err := ValidateReference(contentHash, ipfsLink); err != nil {
+		return err
+	}
+	if hash := ContentHash(content); hash != contentHash {
+		return fmt.Errorf("content hash mismatch: expected %s, got %s", contentHash, hash)
+	}
+	return nil
+}
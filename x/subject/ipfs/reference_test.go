@@ -0,0 +1,54 @@
+package ipfs
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/cosmos/btcutil/base58"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawCID(t *testing.T) {
+	// CID of the empty file as computed by `ipfs add --cid-version=1`
+	require.Equal(t, "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku", RawCID(nil))
+
+	content := []byte(`{"objectives":["Limits"]}`)
+	cid, err := ParseCID(RawCID(content))
+	require.NoError(t, err)
+	require.Equal(t, CodecRaw, cid.Codec)
+	require.Equal(t, HashSHA2256, cid.HashCode)
+	hash := sha256.Sum256(content)
+	require.Equal(t, hash[:], cid.Digest)
+}
+
+func TestParseCID(t *testing.T) {
+	digest := sha256.Sum256([]byte("chunked"))
+	cidV0 := base58.Encode(append([]byte{0x12, 0x20}, digest[:]...))
+
+	cid, err := ParseCID(cidV0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), cid.Version)
+	require.Equal(t, CodecDagPB, cid.Codec)
+
+	for _, invalid := range []string{"", "b", "Qm123", "xabc", "bafk!", "subject-1"} {
+		_, err := ParseCID(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestValidateReference(t *testing.T) {
+	content := []byte(`{"objectives":["Limits"]}`)
+	hash := ContentHash(content)
+	link := LinkPrefix + RawCID(content)
+	otherLink := LinkPrefix + RawCID([]byte("other"))
+
+	require.NoError(t, ValidateReference(hash, link))
+	require.NoError(t, VerifyContent(content, hash, link))
+
+	require.Error(t, ValidateReference(hash, ""))
+	require.Error(t, ValidateReference("", link))
+	require.Error(t, ValidateReference("abc", link))
+	require.Error(t, ValidateReference(hash, RawCID(content)))
+	require.ErrorContains(t, ValidateReference(hash, otherLink), "does not address")
+	require.ErrorContains(t, VerifyContent([]byte("tampered"), hash, link), "mismatch")
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/subject/types"
+)
+
+// SetContentAttestation stores the latest attestation of a verifier for the
+// content of a subject
+func (k Keeper) SetContentAttestation(ctx sdk.Context, attestation types.ContentAttestation) error {
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.ContentAttestationKey(attestation.SubjectId, attestation.Attester)
+	return store.Set(key, k.cdc.MustMarshal(&attestation))
+}
+
+// GetContentAttestations returns the attestations for the content of a subject
+func (k Keeper) GetContentAttestations(ctx sdk.Context, subjectId string) ([]types.ContentAttestation, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.GetContentAttestationPrefix(subjectId)
+
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var attestations []types.ContentAttestation
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.ContentAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+		attestations = append(attestations, attestation)
+	}
+
+	return attestations, nil
+}
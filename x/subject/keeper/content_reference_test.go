@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/subject/ipfs"
+	"academictoken/x/subject/keeper"
+	"academictoken/x/subject/types"
+)
+
+func TestCreateSubjectStoresContentReference(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	creator := sample.AccAddress()
+
+	content := types.ExtendedContent{Objectives: []string{"Limits"}, TopicUnits: []string{"Derivatives"}}
+	msg := types.NewMsgCreateSubjectContent(creator, "institution-1", "course-1", "Calculus I", "MAT101", 60, 4,
+		"", "required", "mathematics", content.Objectives, content.TopicUnits,
+		ipfs.ContentHash(content.Bytes()), ipfs.LinkPrefix+ipfs.RawCID(content.Bytes()))
+
+	res, err := ms.CreateSubjectContent(ctx, msg)
+	require.NoError(t, err)
+	subject, found := k.GetSubject(sdk.UnwrapSDKContext(ctx), res.Index)
+	require.True(t, found)
+	require.Equal(t, msg.ContentHash, subject.ContentHash)
+	require.Equal(t, msg.IpfsLink, subject.IpfsLink)
+
+	// Content without references, or with references to other content, is rejected
+	missing := *msg
+	missing.ContentHash, missing.IpfsLink = "", ""
+	_, err = ms.CreateSubjectContent(ctx, &missing)
+	require.ErrorIs(t, err, types.ErrInvalidIPFS)
+
+	tampered := *msg
+	tampered.Objectives = []string{"Integrals"}
+	_, err = ms.CreateSubjectContent(ctx, &tampered)
+	require.ErrorIs(t, err, types.ErrInvalidIPFS)
+
+	// Subjects without extended content need no references
+	bare := *msg
+	bare.Objectives, bare.TopicUnits, bare.ContentHash, bare.IpfsLink = nil, nil, "", ""
+	_, err = ms.CreateSubjectContent(ctx, &bare)
+	require.NoError(t, err)
+}
+
+func TestAttestContentAvailability(t *testing.T) {
+	verifier := sample.AccAddress()
+	k, ctx := keepertest.SubjectKeeperWithInstitutions(t, keepertest.MockSubjectInstitutionKeeper{CurriculumManagers: map[string][]string{"institution-1": {verifier}}})
+	ms := keeper.NewMsgServerImpl(k)
+
+	content := types.ExtendedContent{Keywords: []string{"calculus"}}
+	link := ipfs.LinkPrefix + ipfs.RawCID(content.Bytes())
+	require.NoError(t, k.SetSubjectWithoutIPFS(ctx, types.SubjectContent{
+		Index:       "subject-1",
+		SubjectId:   "subject-1",
+		Institution: "institution-1",
+		ContentHash: ipfs.ContentHash(content.Bytes()),
+		IpfsLink:    link,
+	}))
+
+	// Only accounts managing the subject can attest its content
+	_, err := ms.AttestContentAvailability(ctx, types.NewMsgAttestContentAvailability(sample.AccAddress(), "subject-1", link, true, ""))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.AttestContentAvailability(ctx, types.NewMsgAttestContentAvailability(verifier, "subject-1", link, true, ""))
+	require.NoError(t, err)
+	_, err = ms.AttestContentAvailability(ctx, types.NewMsgAttestContentAvailability(verifier, "subject-1", link, false, "timeout"))
+	require.NoError(t, err)
+
+	// Attestations for superseded content are rejected
+	stale := ipfs.LinkPrefix + ipfs.RawCID([]byte("old"))
+	_, err = ms.AttestContentAvailability(ctx, types.NewMsgAttestContentAvailability(verifier, "subject-1", stale, true, ""))
+	require.ErrorIs(t, err, types.ErrInvalidIPFS)
+
+	attestations, err := k.GetContentAttestations(ctx, "subject-1")
+	require.NoError(t, err)
+	require.Len(t, attestations, 1)
+	require.False(t, attestations[0].Available)
+	require.Equal(t, "timeout", attestations[0].Details)
+}
@@ -29,6 +29,9 @@ type (
 		authority         string
 		institutionKeeper types.InstitutionKeeper
 		courseKeeper      types.CourseKeeper
+
+		// studentKeeper is set after construction, x/student depends on x/subject
+		studentKeeper types.StudentKeeper
 	}
 )
 
@@ -157,8 +160,8 @@ func NewKeeper(
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
-	// Create the IPFS client with default parameters
-	// These can be updated later based on module parameters
+	// Create the IPFS client used by queries to read content with default
+	// parameters; these can be updated later based on module parameters
 	ipfsClient := ipfs.NewIPFSClient(
 		"http://localhost:5001",
 		"/tmp/ipfs-cache",
@@ -188,6 +191,11 @@ func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetStudentKeeper sets the student keeper used to evaluate prerequisites
+func (k *Keeper) SetStudentKeeper(studentKeeper types.StudentKeeper) {
+	k.studentKeeper = studentKeeper
+}
+
 // SetIPFSClient sets the IPFS client (useful for testing or config updates)
 func (k *Keeper) SetIPFSClient(client *ipfs.IPFSClient) {
 	k.ipfsClient = client
@@ -207,29 +215,6 @@ func (k *Keeper) UpdateIPFSClientFromParams(ctx sdk.Context) {
 	}
 }
 
-// SetSubject stores a subject following the hybrid storage model (MANDATORY)
-func (k Keeper) SetSubject(ctx sdk.Context, subject types.SubjectContent, fullContent []byte) error {
-	// Store in IPFS (MANDATORY)
-	contentHash, ipfsLink, err := k.ipfsClient.Add(fullContent)
-	if err != nil {
-		return fmt.Errorf("error storing in IPFS: %v", err)
-	}
-
-	// Update subject with references
-	subject.ContentHash = contentHash
-	subject.IpfsLink = ipfsLink
-
-	// Store on-chain (only essential data)
-	store := k.storeService.OpenKVStore(ctx)
-	key := types.SubjectKey(subject.Index)
-	value := k.cdc.MustMarshal(&subject)
-	if err := store.Set(key, value); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // GetSubject retrieves a subject's basic data from the blockchain
 func (k Keeper) GetSubject(ctx sdk.Context, index string) (types.SubjectContent, bool) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -245,7 +230,8 @@ func (k Keeper) GetSubject(ctx sdk.Context, index string) (types.SubjectContent,
 	return subject, true
 }
 
-// GetSubjectFull retrieves a subject with content from IPFS
+// GetSubjectFull retrieves a subject with content from IPFS; like
+// FetchFromIPFS it is for queries only
 func (k Keeper) GetSubjectFull(ctx sdk.Context, index string) (types.SubjectContent, map[string]interface{}, error) {
 	// Get on-chain data
 	subject, found := k.GetSubject(ctx, index)
@@ -258,11 +244,14 @@ func (k Keeper) GetSubjectFull(ctx sdk.Context, index string) (types.SubjectCont
 		return subject, nil, nil
 	}
 
-	// Get data from IPFS (MANDATORY)
-	extendedContentBytes, err := k.ipfsClient.Get(subject.IpfsLink)
+	// Get data from IPFS and check it against the hash recorded on chain
+	extendedContentBytes, err := k.FetchFromIPFS(ctx, subject.IpfsLink)
 	if err != nil {
 		return subject, nil, fmt.Errorf("error retrieving from IPFS: %v", err)
 	}
+	if err := ipfs.VerifyContent(extendedContentBytes, subject.ContentHash, subject.IpfsLink); err != nil {
+		return subject, nil, types.ErrInvalidIPFS.Wrap(err.Error())
+	}
 
 	// Deserialize
 	var extendedContent map[string]interface{}
@@ -321,16 +310,8 @@ func (k Keeper) GetNextSubjectIndex(ctx sdk.Context) string {
 	return fmt.Sprintf("subject-%d", count)
 }
 
-// StoreOnIPFS allows other modules to store content in IPFS
-func (k Keeper) StoreOnIPFS(ctx sdk.Context, content []byte) (string, string, error) {
-	// Update IPFS client from parameters if needed
-	k.UpdateIPFSClientFromParams(ctx)
-
-	// Store in IPFS
-	return k.ipfsClient.Add(content)
-}
-
-// FetchFromIPFS allows other modules to fetch content from IPFS
+// FetchFromIPFS fetches content from IPFS. It performs network I/O, so it
+// serves queries only and must never be called while executing messages
 func (k Keeper) FetchFromIPFS(ctx sdk.Context, ipfsLink string) ([]byte, error) {
 	// Update IPFS client from parameters if needed
 	k.UpdateIPFSClientFromParams(ctx)
@@ -2,8 +2,8 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -96,6 +96,9 @@ func (k msgServer) CreateSubject(goCtx context.Context, req *types.MsgCreateSubj
 		if !found {
 			return nil, fmt.Errorf("institution with ID '%s' not found", req.Institution)
 		}
+		if !k.institutionKeeper.IsInstitutionAuthorized(ctx, req.Institution) {
+			return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", req.Institution)
+		}
 	}
 
 	// Check if course exists
@@ -120,6 +123,12 @@ func (k msgServer) CreateSubject(goCtx context.Context, req *types.MsgCreateSubj
 	}
 	*/
 
+	// The client uploads the extended content to IPFS beforehand; only its
+	// references are checked and stored here
+	if err := req.ExtendedContent().ValidateReference(req.ContentHash, req.IpfsLink); err != nil {
+		return nil, err
+	}
+
 	// Generate a new index for the subject
 	index := k.GetNextSubjectIndex(ctx)
 
@@ -137,6 +146,8 @@ func (k msgServer) CreateSubject(goCtx context.Context, req *types.MsgCreateSubj
 		Description:   req.Description,
 		SubjectType:   req.SubjectType,
 		KnowledgeArea: req.KnowledgeArea,
+		ContentHash:   req.ContentHash,
+		IpfsLink:      req.IpfsLink,
 	}
 
 	// Store the subject using SetSubjectWithoutIPFS for basic storage
@@ -152,36 +163,6 @@ func (k msgServer) CreateSubject(goCtx context.Context, req *types.MsgCreateSubj
 		k.Logger().Error("Failed to set institution index", "error", err)
 	}
 
-	// If objectives and topic units are provided, prepare extended content for IPFS
-	if len(req.Objectives) > 0 || len(req.TopicUnits) > 0 {
-		// Create extended content for IPFS storage
-		extendedContent := map[string]interface{}{
-			"objectives": req.Objectives,
-			"topicUnits": req.TopicUnits,
-		}
-
-		// Serialize extended content
-		extendedContentBytes, err := json.Marshal(extendedContent)
-		if err != nil {
-			k.Logger().Error("Failed to serialize extended content", "error", err)
-		} else {
-			// Store in IPFS and update subject with hash and link
-			contentHash, ipfsLink, err := k.StoreOnIPFS(ctx, extendedContentBytes)
-			if err != nil {
-				k.Logger().Error("Failed to store content in IPFS", "error", err)
-			} else {
-				// Update subject with IPFS references
-				subject.ContentHash = contentHash
-				subject.IpfsLink = ipfsLink
-
-				// Re-store the subject with IPFS references
-				if err := k.SetSubjectWithoutIPFS(ctx, subject); err != nil {
-					k.Logger().Error("Failed to update subject with IPFS references", "error", err)
-				}
-			}
-		}
-	}
-
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -262,6 +243,9 @@ func (k msgServer) CreateSubjectContent(goCtx context.Context, req *types.MsgCre
 		if !found {
 			return nil, fmt.Errorf("institution with ID '%s' not found", req.Institution)
 		}
+		if !k.institutionKeeper.IsInstitutionAuthorized(ctx, req.Institution) {
+			return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", req.Institution)
+		}
 	}
 
 	// Check if course exists
@@ -286,6 +270,12 @@ func (k msgServer) CreateSubjectContent(goCtx context.Context, req *types.MsgCre
 	}
 	*/
 
+	// The client uploads the extended content to IPFS beforehand; only its
+	// references are checked and stored here
+	if err := req.ExtendedContent().ValidateReference(req.ContentHash, req.IpfsLink); err != nil {
+		return nil, err
+	}
+
 	// Generate a new index for the subject
 	index := k.GetNextSubjectIndex(ctx)
 
@@ -303,6 +293,8 @@ func (k msgServer) CreateSubjectContent(goCtx context.Context, req *types.MsgCre
 		Description:   req.Description,
 		SubjectType:   req.SubjectType,
 		KnowledgeArea: req.KnowledgeArea,
+		ContentHash:   req.ContentHash,
+		IpfsLink:      req.IpfsLink,
 	}
 
 	// Store the subject using SetSubjectWithoutIPFS for basic storage
@@ -318,36 +310,6 @@ func (k msgServer) CreateSubjectContent(goCtx context.Context, req *types.MsgCre
 		k.Logger().Error("Failed to set institution index", "error", err)
 	}
 
-	// If objectives and topic units are provided, prepare extended content for IPFS
-	if len(req.Objectives) > 0 || len(req.TopicUnits) > 0 {
-		// Create extended content for IPFS storage
-		extendedContent := map[string]interface{}{
-			"objectives": req.Objectives,
-			"topicUnits": req.TopicUnits,
-		}
-
-		// Serialize extended content
-		extendedContentBytes, err := json.Marshal(extendedContent)
-		if err != nil {
-			k.Logger().Error("Failed to serialize extended content", "error", err)
-		} else {
-			// Store in IPFS and update subject with hash and link
-			contentHash, ipfsLink, err := k.StoreOnIPFS(ctx, extendedContentBytes)
-			if err != nil {
-				k.Logger().Error("Failed to store content in IPFS", "error", err)
-			} else {
-				// Update subject with IPFS references
-				subject.ContentHash = contentHash
-				subject.IpfsLink = ipfsLink
-
-				// Re-store the subject with IPFS references
-				if err := k.SetSubjectWithoutIPFS(ctx, subject); err != nil {
-					k.Logger().Error("Failed to update subject with IPFS references", "error", err)
-				}
-			}
-		}
-	}
-
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -398,24 +360,26 @@ func (k msgServer) AddPrerequisiteGroup(goCtx context.Context, req *types.MsgAdd
 	}
 
 	// Validate group type
-	validGroupTypes := []string{"ALL", "ANY", "CREDITS", "COMBINATION"}
-	validGroupType := false
-	for _, gtype := range validGroupTypes {
-		if req.GroupType == gtype {
-			validGroupType = true
-			break
-		}
-	}
-	if !validGroupType {
-		return nil, fmt.Errorf("invalid group type: must be one of %v, got '%s'", validGroupTypes, req.GroupType)
+	if !types.IsValidGroupType(req.GroupType) {
+		return nil, fmt.Errorf("invalid group type: must be one of %v, got '%s'", types.ValidGroupTypes, req.GroupType)
 	}
 
 	// Check if subject exists
-	_, found := k.GetSubject(ctx, req.SubjectId)
+	subject, found := k.GetSubject(ctx, req.SubjectId)
 	if !found {
 		return nil, fmt.Errorf("subject with ID '%s' not found", req.SubjectId)
 	}
 
+	// Check permissions (only creator, authority or curriculum managers can add prerequisites)
+	if !k.canManageSubject(ctx, subject, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("creator '%s' is not authorized to add prerequisites to subject '%s'", req.Creator, req.SubjectId)
+	}
+
+	// Subjects of unaccredited institutions cannot be changed
+	if k.institutionKeeper != nil && !k.institutionKeeper.IsInstitutionAuthorized(ctx, subject.Institution) {
+		return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", subject.Institution)
+	}
+
 	// Validate prerequisite subjects exist
 	for _, prereqId := range req.SubjectIds {
 		if prereqId != "" {
@@ -437,6 +401,11 @@ func (k msgServer) AddPrerequisiteGroup(goCtx context.Context, req *types.MsgAdd
 		MinimumCredits:           req.MinimumCredits,
 		MinimumCompletedSubjects: req.MinimumCompletedSubjects,
 		SubjectIds:               req.SubjectIds,
+		ParentGroupId:            req.ParentGroupId,
+		Corequisite:              req.Corequisite,
+	}
+	if err := k.validatePrerequisiteGroup(ctx, prerequisiteGroup); err != nil {
+		return nil, err
 	}
 
 	// Store prerequisite group
@@ -454,6 +423,8 @@ func (k msgServer) AddPrerequisiteGroup(goCtx context.Context, req *types.MsgAdd
 			sdk.NewAttribute("group_type", req.GroupType),
 			sdk.NewAttribute("minimum_credits", fmt.Sprintf("%d", req.MinimumCredits)),
 			sdk.NewAttribute("minimum_completed_subjects", fmt.Sprintf("%d", req.MinimumCompletedSubjects)),
+			sdk.NewAttribute("parent_group_id", req.ParentGroupId),
+			sdk.NewAttribute("corequisite", fmt.Sprintf("%t", req.Corequisite)),
 			sdk.NewAttribute("creator", req.Creator),
 		),
 	)
@@ -492,106 +463,28 @@ func (k msgServer) UpdateSubjectContent(goCtx context.Context, req *types.MsgUpd
 		return nil, fmt.Errorf("subject with ID '%s' not found", req.SubjectId)
 	}
 
-	// Check permissions (only creator or authority can update)
-	if req.Creator != subject.Creator && req.Creator != k.GetAuthority() {
+	// Check permissions (only creator, authority or curriculum managers can update)
+	if !k.canManageSubject(ctx, subject, req.Creator) {
 		return nil, fmt.Errorf("creator '%s' is not authorized to update subject '%s'", req.Creator, req.SubjectId)
 	}
 
-	// Get existing content or create new
-	subjectContent, found := k.GetSubject(ctx, req.SubjectId)
-	if !found {
-		// Create new content
-		subjectContent = types.SubjectContent{
-			Index:     req.SubjectId,
-			Creator:   req.Creator,
-			SubjectId: req.SubjectId,
-			Title:     subject.Title,
-			Code:      subject.Code,
-			Credits:   subject.Credits,
-		}
-	}
-
-	// Prepare extended content for IPFS storage
-	extendedContent := make(map[string]interface{})
-	updated := false
-
-	// Get existing content from IPFS if it exists
-	if subjectContent.IpfsLink != "" {
-		existingContentBytes, err := k.FetchFromIPFS(ctx, subjectContent.IpfsLink)
-		if err == nil {
-			json.Unmarshal(existingContentBytes, &extendedContent)
-		}
-	}
-
-	// Update fields if provided
-	if len(req.Objectives) > 0 {
-		extendedContent["objectives"] = req.Objectives
-		updated = true
-	}
-
-	if len(req.TopicUnits) > 0 {
-		extendedContent["topicUnits"] = req.TopicUnits
-		updated = true
+	// Subjects of unaccredited institutions cannot be changed
+	if k.institutionKeeper != nil && !k.institutionKeeper.IsInstitutionAuthorized(ctx, subject.Institution) {
+		return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", subject.Institution)
 	}
 
-	if len(req.Methodologies) > 0 {
-		extendedContent["methodologies"] = req.Methodologies
-		updated = true
+	// The message carries the whole extended content, which the client has
+	// merged with the previous version and uploaded to IPFS beforehand
+	if req.ContentHash == "" && req.IpfsLink == "" {
+		return nil, fmt.Errorf("content hash and IPFS link of the uploaded content are required")
 	}
-
-	if len(req.EvaluationMethods) > 0 {
-		extendedContent["evaluationMethods"] = req.EvaluationMethods
-		updated = true
+	if err := req.ExtendedContent().ValidateReference(req.ContentHash, req.IpfsLink); err != nil {
+		return nil, err
 	}
 
-	if len(req.BibliographyBasic) > 0 {
-		extendedContent["bibliographyBasic"] = req.BibliographyBasic
-		updated = true
-	}
-
-	if len(req.BibliographyComplementary) > 0 {
-		extendedContent["bibliographyComplementary"] = req.BibliographyComplementary
-		updated = true
-	}
-
-	if len(req.Keywords) > 0 {
-		extendedContent["keywords"] = req.Keywords
-		updated = true
-	}
-
-	// Handle direct hash and link updates
-	if req.ContentHash != "" {
-		subjectContent.ContentHash = req.ContentHash
-		updated = true
-	}
-
-	if req.IpfsLink != "" {
-		subjectContent.IpfsLink = req.IpfsLink
-		updated = true
-	}
-
-	if !updated {
-		return nil, fmt.Errorf("no valid updates provided")
-	}
-
-	// If we have extended content to store
-	if len(extendedContent) > 0 && (req.ContentHash == "" || req.IpfsLink == "") {
-		// Serialize and store in IPFS
-		extendedContentBytes, err := json.Marshal(extendedContent)
-		if err != nil {
-			return nil, fmt.Errorf("failed to serialize extended content: %w", err)
-		}
-
-		// Store in IPFS
-		contentHash, ipfsLink, err := k.StoreOnIPFS(ctx, extendedContentBytes)
-		if err != nil {
-			return nil, fmt.Errorf("failed to store content in IPFS: %w", err)
-		}
-
-		// Update subject with new hash and link
-		subjectContent.ContentHash = contentHash
-		subjectContent.IpfsLink = ipfsLink
-	}
+	subjectContent := subject
+	subjectContent.ContentHash = req.ContentHash
+	subjectContent.IpfsLink = req.IpfsLink
 
 	// Store updated content
 	err := k.SetSubjectWithoutIPFS(ctx, subjectContent)
@@ -622,3 +515,58 @@ func (k msgServer) UpdateSubjectContent(goCtx context.Context, req *types.MsgUpd
 		IpfsLink:    subjectContent.IpfsLink,
 	}, nil
 }
+
+// canManageSubject checks if an address can change a subject: its creator, the
+// module authority and the curriculum managers of its course
+func (k msgServer) canManageSubject(ctx sdk.Context, subject types.SubjectContent, address string) bool {
+	if address == subject.Creator || address == k.GetAuthority() {
+		return true
+	}
+	return k.institutionKeeper != nil && k.institutionKeeper.CanManageCurriculum(ctx, subject.Institution, address, subject.CourseId)
+}
+
+// AttestContentAvailability records whether a pinning verifier could retrieve
+// the current content of a subject from IPFS. Verifiers act for the subject's
+// institution, so only accounts that can manage the subject may attest.
+func (k msgServer) AttestContentAvailability(goCtx context.Context, req *types.MsgAttestContentAvailability) (*types.MsgAttestContentAvailabilityResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subject, found := k.GetSubject(ctx, req.SubjectId)
+	if !found {
+		return nil, fmt.Errorf("subject with ID '%s' not found", req.SubjectId)
+	}
+	if !k.canManageSubject(ctx, subject, req.Creator) {
+		return nil, types.ErrUnauthorized.Wrapf("'%s' is not authorized to attest the content of subject '%s'", req.Creator, req.SubjectId)
+	}
+	if subject.IpfsLink != req.IpfsLink {
+		return nil, types.ErrInvalidIPFS.Wrapf("attested link %s is not the current content %s of subject '%s'", req.IpfsLink, subject.IpfsLink, req.SubjectId)
+	}
+
+	attestation := types.ContentAttestation{
+		SubjectId:  req.SubjectId,
+		IpfsLink:   req.IpfsLink,
+		Attester:   req.Creator,
+		Available:  req.Available,
+		Details:    req.Details,
+		AttestedAt: ctx.BlockTime().UTC().Format(time.RFC3339),
+	}
+	if err := k.SetContentAttestation(ctx, attestation); err != nil {
+		return nil, fmt.Errorf("failed to store content attestation: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContentAttested,
+			sdk.NewAttribute(types.AttributeKeySubjectId, req.SubjectId),
+			sdk.NewAttribute(types.AttributeKeyIpfsLink, req.IpfsLink),
+			sdk.NewAttribute(types.AttributeKeyAttester, req.Creator),
+			sdk.NewAttribute(types.AttributeKeyAvailable, fmt.Sprintf("%t", req.Available)),
+		),
+	)
+
+	return &types.MsgAttestContentAvailabilityResponse{}, nil
+}
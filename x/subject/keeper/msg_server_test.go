@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/subject/ipfs"
 	"academictoken/x/subject/keeper"
 	"academictoken/x/subject/types"
 )
@@ -22,3 +24,28 @@ func TestMsgServer(t *testing.T) {
 	require.NotNil(t, ctx)
 	require.NotEmpty(t, k)
 }
+
+func TestLapsedInstitutionSubjectWrites(t *testing.T) {
+	k, ctx := keepertest.SubjectKeeperWithInstitutions(t, keepertest.MockSubjectInstitutionKeeper{Lapsed: []string{"institution-2"}})
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	require.NoError(t, k.SetSubjectWithoutIPFS(ctx, types.SubjectContent{Index: "subject-1", Institution: "institution-1", Creator: creator, Credits: 4}))
+	require.NoError(t, k.SetSubjectWithoutIPFS(ctx, types.SubjectContent{Index: "subject-2", Institution: "institution-2", Creator: creator, Credits: 4}))
+
+	content := types.ExtendedContent{Objectives: []string{"Limits"}}
+	update := func(subjectId string) *types.MsgUpdateSubjectContent {
+		msg := types.NewMsgUpdateSubjectContent(creator, subjectId, content.Objectives, nil, nil, nil, nil, nil, nil)
+		msg.ContentHash, msg.IpfsLink = ipfs.ContentHash(content.Bytes()), ipfs.LinkPrefix+ipfs.RawCID(content.Bytes())
+		return msg
+	}
+
+	_, err := ms.UpdateSubjectContent(ctx, update("subject-1"))
+	require.NoError(t, err)
+	_, err = ms.UpdateSubjectContent(ctx, update("subject-2"))
+	require.ErrorIs(t, err, types.ErrInstitutionNotAccredited)
+
+	_, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "subject-1", types.GroupTypeAll, 0, 0, nil, "", false))
+	require.NoError(t, err)
+	_, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "subject-2", types.GroupTypeAll, 0, 0, nil, "", false))
+	require.ErrorIs(t, err, types.ErrInstitutionNotAccredited)
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"academictoken/x/subject/types"
+)
+
+// prerequisiteRecord is the part of a student's academic record that
+// prerequisite groups are evaluated against
+type prerequisiteRecord struct {
+	completed  map[string]bool
+	inProgress map[string]bool
+	// children holds the nested groups by parent group ID
+	children map[string][]types.PrerequisiteGroup
+}
+
+// EvaluatePrerequisites evaluates the prerequisite groups of a subject against
+// the completed and in-progress subjects of a student. It reads state only and
+// is deterministic, so it serves both enrollment transactions and queries.
+func (k Keeper) EvaluatePrerequisites(ctx sdk.Context, studentId string, subjectId string) (types.PrerequisiteEvaluation, error) {
+	if _, found := k.GetSubject(ctx, subjectId); !found {
+		return types.PrerequisiteEvaluation{}, types.ErrSubjectNotFound.Wrapf("subject '%s' not found", subjectId)
+	}
+	if k.studentKeeper == nil {
+		return types.PrerequisiteEvaluation{}, fmt.Errorf("student keeper not set, prerequisites cannot be evaluated")
+	}
+
+	// Students without an academic tree have not completed anything yet
+	tree, _ := k.studentKeeper.GetAcademicTree(ctx, studentId)
+	record := prerequisiteRecord{
+		completed:  toSet(tree.CompletedTokens),
+		inProgress: toSet(tree.InProgressTokens),
+		children:   make(map[string][]types.PrerequisiteGroup),
+	}
+
+	groups := k.GetPrerequisiteGroupsBySubject(ctx, subjectId)
+	ids := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		ids[group.Id] = true
+	}
+
+	var topLevel []types.PrerequisiteGroup
+	for _, group := range groups {
+		// Groups whose parent is gone are evaluated on their own rather than ignored
+		if group.ParentGroupId != "" && ids[group.ParentGroupId] {
+			record.children[group.ParentGroupId] = append(record.children[group.ParentGroupId], group)
+		} else {
+			topLevel = append(topLevel, group)
+		}
+	}
+
+	evaluation := types.PrerequisiteEvaluation{Eligible: true, Missing: []string{}}
+	for _, group := range topLevel {
+		result := k.evaluatePrerequisiteGroup(ctx, record, group, 1)
+		evaluation.Groups = append(evaluation.Groups, result)
+		if !result.Satisfied {
+			evaluation.Eligible = false
+			evaluation.Missing = append(evaluation.Missing, fmt.Sprintf("%s: %s", group.Id, result.Explanation))
+		}
+	}
+
+	return evaluation, nil
+}
+
+// evaluatePrerequisiteGroup evaluates a group and its nested groups. Subjects
+// count when completed, or when in progress for co-requisite groups.
+func (k Keeper) evaluatePrerequisiteGroup(ctx sdk.Context, record prerequisiteRecord, group types.PrerequisiteGroup, depth int) types.PrerequisiteGroupResult {
+	result := types.PrerequisiteGroupResult{
+		GroupId:           group.Id,
+		GroupType:         group.GroupType,
+		Corequisite:       group.Corequisite,
+		SatisfiedSubjects: []string{},
+		MissingSubjects:   []string{},
+	}
+	if depth > types.MaxPrerequisiteDepth {
+		result.Explanation = fmt.Sprintf("nested deeper than %d groups", types.MaxPrerequisiteDepth)
+		return result
+	}
+
+	met := func(subjectId string) bool {
+		return record.completed[subjectId] || (group.Corequisite && record.inProgress[subjectId])
+	}
+
+	for _, subjectId := range group.SubjectIds {
+		if met(subjectId) {
+			result.SatisfiedSubjects = append(result.SatisfiedSubjects, subjectId)
+			result.EarnedCredits += k.subjectCredits(ctx, subjectId)
+		} else {
+			result.MissingSubjects = append(result.MissingSubjects, subjectId)
+		}
+	}
+	result.SatisfiedCount = uint64(len(result.SatisfiedSubjects))
+
+	allChildrenSatisfied := true
+	for _, child := range record.children[group.Id] {
+		childResult := k.evaluatePrerequisiteGroup(ctx, record, child, depth+1)
+		result.Children = append(result.Children, &childResult)
+		if childResult.Satisfied {
+			result.SatisfiedCount++
+		} else {
+			allChildrenSatisfied = false
+		}
+	}
+	items := uint64(len(group.SubjectIds) + len(result.Children))
+
+	switch group.GroupType {
+	case types.GroupTypeAll:
+		result.RequiredCount = items
+		result.Satisfied = result.SatisfiedCount == items
+	case types.GroupTypeAny:
+		result.RequiredCount = max(group.MinimumCompletedSubjects, 1)
+		result.Satisfied = result.SatisfiedCount >= result.RequiredCount
+	case types.GroupTypeCredits:
+		if len(group.SubjectIds) == 0 {
+			// Without a subject list every completed subject counts
+			for _, subjectId := range sortedKeys(record.completed) {
+				result.EarnedCredits += k.subjectCredits(ctx, subjectId)
+			}
+		}
+		result.RequiredCredits = group.MinimumCredits
+		result.Satisfied = result.EarnedCredits >= result.RequiredCredits && allChildrenSatisfied
+	case types.GroupTypeCombination:
+		result.RequiredCount = group.MinimumCompletedSubjects
+		result.RequiredCredits = group.MinimumCredits
+		result.Satisfied = result.SatisfiedCount >= result.RequiredCount && result.EarnedCredits >= result.RequiredCredits
+	default:
+		result.Explanation = fmt.Sprintf("unknown group type %q", group.GroupType)
+		return result
+	}
+
+	result.Explanation = explainPrerequisiteGroup(result, allChildrenSatisfied)
+	return result
+}
+
+// explainPrerequisiteGroup describes what a group requires and what is missing
+func explainPrerequisiteGroup(result types.PrerequisiteGroupResult, allChildrenSatisfied bool) string {
+	var parts []string
+	if result.GroupType == types.GroupTypeCredits || result.GroupType == types.GroupTypeCombination {
+		parts = append(parts, fmt.Sprintf("%d of %d credits", result.EarnedCredits, result.RequiredCredits))
+	}
+	if result.GroupType != types.GroupTypeCredits {
+		parts = append(parts, fmt.Sprintf("%d of %d required met", result.SatisfiedCount, result.RequiredCount))
+	}
+	if !result.Satisfied && len(result.MissingSubjects) > 0 {
+		parts = append(parts, "missing "+strings.Join(result.MissingSubjects, ", "))
+	}
+	if !result.Satisfied && !allChildrenSatisfied {
+		parts = append(parts, "nested groups unmet")
+	}
+	if result.Corequisite {
+		parts = append(parts, "subjects in progress count")
+	}
+	return fmt.Sprintf("%s: %s", result.GroupType, strings.Join(parts, "; "))
+}
+
+// validatePrerequisiteGroup checks a new group against the subject it belongs
+// to and, when nested, against its parent chain
+func (k Keeper) validatePrerequisiteGroup(ctx sdk.Context, group types.PrerequisiteGroup) error {
+	if err := group.ValidateBasic(); err != nil {
+		return types.ErrInvalidPrereq.Wrap(err.Error())
+	}
+
+	depth := 1
+	for parentId := group.ParentGroupId; parentId != ""; depth++ {
+		parent, found := k.GetPrerequisiteGroup(ctx, parentId)
+		if !found {
+			return types.ErrInvalidPrereq.Wrapf("parent group '%s' not found", parentId)
+		}
+		if parent.SubjectId != group.SubjectId {
+			return types.ErrInvalidPrereq.Wrapf("parent group '%s' belongs to subject '%s'", parentId, parent.SubjectId)
+		}
+		if depth >= types.MaxPrerequisiteDepth {
+			return types.ErrInvalidPrereq.Wrapf("prerequisite groups cannot be nested deeper than %d", types.MaxPrerequisiteDepth)
+		}
+		parentId = parent.ParentGroupId
+	}
+
+	if group.GroupType == types.GroupTypeCredits && group.MinimumCredits == 0 {
+		return types.ErrInvalidPrereq.Wrap("credit groups require minimum credits")
+	}
+
+	return nil
+}
+
+func (k Keeper) subjectCredits(ctx sdk.Context, subjectId string) uint64 {
+	subject, found := k.GetSubject(ctx, subjectId)
+	if !found {
+		return 0
+	}
+	return subject.Credits
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+// sortedKeys returns the keys of set in a deterministic order
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "academictoken/testutil/keeper"
+	"academictoken/testutil/sample"
+	"academictoken/x/subject/keeper"
+	"academictoken/x/subject/types"
+)
+
+func TestEvaluatePrerequisites(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	for _, subject := range []types.SubjectContent{
+		{Index: "calculus-1", Credits: 4},
+		{Index: "calculus-2", Credits: 4},
+		{Index: "physics-1", Credits: 6},
+		{Index: "physics-lab", Credits: 2},
+		{Index: "statistics", Credits: 2},
+		{Index: "mechanics", Institution: "institution-1", Creator: creator, Credits: 4},
+	} {
+		require.NoError(t, k.SetSubjectWithoutIPFS(sdkCtx, subject))
+	}
+	k.SetStudentKeeper(keepertest.MockSubjectStudentKeeper{Trees: map[string]types.StudentAcademicTree{
+		"student-1": {CompletedTokens: []string{"calculus-1", "physics-1"}, InProgressTokens: []string{"physics-lab"}},
+	}})
+
+	addGroup := func(groupType string, credits, count uint64, subjects []string, parent string, corequisite bool) (string, error) {
+		res, err := ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "mechanics", groupType, credits, count, subjects, parent, corequisite))
+		if err != nil {
+			return "", err
+		}
+		return res.GroupId, nil
+	}
+
+	// Subjects without prerequisites are open to everyone
+	evaluation, err := k.EvaluatePrerequisites(sdkCtx, "student-1", "mechanics")
+	require.NoError(t, err)
+	require.True(t, evaluation.Eligible)
+
+	// ALL of calculus-1 and a nested ANY of calculus-2 or physics-1
+	all, err := addGroup(types.GroupTypeAll, 0, 0, []string{"calculus-1"}, "", false)
+	require.NoError(t, err)
+	_, err = addGroup(types.GroupTypeAny, 0, 1, []string{"calculus-2", "physics-1"}, all, false)
+	require.NoError(t, err)
+	// The physics lab may be taken alongside mechanics
+	_, err = addGroup(types.GroupTypeAll, 0, 0, []string{"physics-lab"}, "", true)
+	require.NoError(t, err)
+
+	evaluation, err = k.EvaluatePrerequisites(sdkCtx, "student-1", "mechanics")
+	require.NoError(t, err)
+	require.True(t, evaluation.Eligible)
+	require.Len(t, evaluation.Groups, 2)
+	require.Len(t, evaluation.Groups[0].Children, 1)
+	require.Equal(t, []string{"physics-1"}, evaluation.Groups[0].Children[0].SatisfiedSubjects)
+	require.True(t, evaluation.Groups[1].Corequisite)
+
+	// Ten credits are earned from calculus-1 and physics-1
+	_, err = addGroup(types.GroupTypeCredits, 12, 0, nil, "", false)
+	require.NoError(t, err)
+	evaluation, err = k.EvaluatePrerequisites(sdkCtx, "student-1", "mechanics")
+	require.NoError(t, err)
+	require.False(t, evaluation.Eligible)
+	require.Len(t, evaluation.Missing, 1)
+	require.Equal(t, uint64(10), evaluation.Groups[2].EarnedCredits)
+	require.Contains(t, evaluation.Groups[2].Explanation, "10 of 12 credits")
+
+	// Students without an academic record meet nothing
+	evaluation, err = k.EvaluatePrerequisites(sdkCtx, "student-2", "mechanics")
+	require.NoError(t, err)
+	require.False(t, evaluation.Eligible)
+	require.Len(t, evaluation.Missing, 3)
+	require.Equal(t, []string{"calculus-1"}, evaluation.Groups[0].MissingSubjects)
+
+	_, err = k.EvaluatePrerequisites(sdkCtx, "student-1", "unknown")
+	require.ErrorIs(t, err, types.ErrSubjectNotFound)
+
+	// The query explains every group
+	res, err := keeper.NewQueryServerImpl(k).CheckPrerequisites(ctx, &types.QueryCheckPrerequisitesRequest{StudentId: "student-1", SubjectId: "mechanics"})
+	require.NoError(t, err)
+	require.False(t, res.IsEligible)
+	require.Len(t, res.Groups, 3)
+	require.True(t, res.Groups[0].Satisfied)
+	require.False(t, res.Groups[2].Satisfied)
+}
+
+func TestAddPrerequisiteGroupValidation(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := sample.AccAddress()
+	require.NoError(t, k.SetSubjectWithoutIPFS(sdkCtx, types.SubjectContent{Index: "calculus-1", Institution: "institution-1", Creator: creator, Credits: 4}))
+	require.NoError(t, k.SetSubjectWithoutIPFS(sdkCtx, types.SubjectContent{Index: "calculus-2", Institution: "institution-1", Creator: creator, Credits: 4}))
+
+	_, err := ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "calculus-2", "SOME", 0, 0, []string{"calculus-1"}, "", false))
+	require.Error(t, err)
+
+	_, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "calculus-2", types.GroupTypeAll, 0, 0, []string{"calculus-2"}, "", false))
+	require.ErrorIs(t, err, types.ErrInvalidPrereq)
+
+	_, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "calculus-2", types.GroupTypeCredits, 0, 0, nil, "", false))
+	require.ErrorIs(t, err, types.ErrInvalidPrereq)
+
+	_, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "calculus-2", types.GroupTypeAll, 0, 0, []string{"calculus-1"}, "missing", false))
+	require.ErrorIs(t, err, types.ErrInvalidPrereq)
+
+	// Groups cannot be nested under another subject's groups
+	res, err := ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "calculus-1", types.GroupTypeAll, 0, 0, nil, "", false))
+	require.NoError(t, err)
+	_, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "calculus-2", types.GroupTypeAll, 0, 0, []string{"calculus-1"}, res.GroupId, false))
+	require.ErrorIs(t, err, types.ErrInvalidPrereq)
+
+	// Nesting stops at the maximum depth
+	parent := ""
+	for depth := 1; depth <= types.MaxPrerequisiteDepth; depth++ {
+		res, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "calculus-2", types.GroupTypeAll, 0, 0, nil, parent, false))
+		require.NoError(t, err)
+		parent = res.GroupId
+	}
+	_, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(creator, "calculus-2", types.GroupTypeAll, 0, 0, nil, parent, false))
+	require.ErrorIs(t, err, types.ErrInvalidPrereq)
+}
+
+func TestAddPrerequisiteGroupAuthorization(t *testing.T) {
+	registrar := sample.AccAddress()
+	k, ctx := keepertest.SubjectKeeperWithInstitutions(t, keepertest.MockSubjectInstitutionKeeper{CurriculumManagers: map[string][]string{"institution-1": {registrar}}})
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	require.NoError(t, k.SetSubjectWithoutIPFS(ctx, types.SubjectContent{Index: "calculus-1", Institution: "institution-1", CourseId: "course-1", Creator: creator, Credits: 4}))
+	require.NoError(t, k.SetSubjectWithoutIPFS(ctx, types.SubjectContent{Index: "calculus-2", Institution: "institution-1", CourseId: "course-1", Creator: creator, Credits: 4}))
+
+	// Other accounts cannot block enrollment with an unsatisfiable group
+	_, err := ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(sample.AccAddress(), "calculus-2", types.GroupTypeCredits, 1000, 0, nil, "", false))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Empty(t, k.GetPrerequisiteGroupsBySubject(ctx, "calculus-2"))
+
+	for _, signer := range []string{creator, registrar, k.GetAuthority()} {
+		_, err = ms.AddPrerequisiteGroup(ctx, types.NewMsgAddPrerequisiteGroup(signer, "calculus-2", types.GroupTypeAll, 0, 0, []string{"calculus-1"}, "", false))
+		require.NoError(t, err)
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -185,14 +186,18 @@ func (q QueryServer) CheckPrerequisites(c context.Context, req *types.QueryCheck
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	isEligible, missingPrereqs, err := q.Keeper.CheckPrerequisitesViaContract(ctx, req.StudentId, req.SubjectId)
+	evaluation, err := q.Keeper.EvaluatePrerequisites(ctx, req.StudentId, req.SubjectId)
 	if err != nil {
+		if errors.Is(err, types.ErrSubjectNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &types.QueryCheckPrerequisitesResponse{
-		IsEligible:           isEligible,
-		MissingPrerequisites: missingPrereqs,
+		IsEligible:           evaluation.Eligible,
+		MissingPrerequisites: evaluation.Missing,
+		Groups:               evaluation.Groups,
 	}, nil
 }
 
@@ -322,3 +327,18 @@ func (q QueryServer) SubjectsByInstitution(c context.Context, req *types.QuerySu
 		Pagination: pageRes,
 	}, nil
 }
+
+// ContentAttestations implements the Query/ContentAttestations gRPC method
+func (q QueryServer) ContentAttestations(c context.Context, req *types.QueryContentAttestationsRequest) (*types.QueryContentAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	attestations, err := q.Keeper.GetContentAttestations(ctx, req.SubjectId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContentAttestationsResponse{Attestations: attestations}, nil
+}
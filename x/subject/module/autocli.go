@@ -46,6 +46,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a update-subject-content tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subjectId"}},
 				},
+				{
+					RpcMethod:      "AttestContentAvailability",
+					Use:            "attest-content-availability [subject-id] [ipfs-link] [available]",
+					Short:          "Attest whether the IPFS content of a subject is available",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subjectId"}, {ProtoField: "ipfsLink"}, {ProtoField: "available"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
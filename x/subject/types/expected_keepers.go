@@ -30,15 +30,15 @@ type ParamSubspace interface {
 
 // StudentKeeper defines the methods from the Student module that Subject needs
 type StudentKeeper interface {
-	GetStudent(ctx sdk.Context, index string) (Student, bool)
-	GetAcademicTree(ctx sdk.Context, index string) (StudentAcademicTree, bool)
-	GetCompletedSubjects(ctx sdk.Context, studentId string) []string
+	// GetAcademicTree returns the academic tree of the student with the given index
+	GetAcademicTree(ctx sdk.Context, studentId string) (StudentAcademicTree, bool)
 }
 
 // InstitutionKeeper defines the expected institution keeper
 type InstitutionKeeper interface {
 	GetInstitution(ctx sdk.Context, institutionID string) (institutiontypes.Institution, bool)
 	IsInstitutionAuthorized(ctx sdk.Context, institutionID string) bool
+	CanManageCurriculum(ctx sdk.Context, institution string, address string, courseId string) bool
 }
 
 // CourseKeeper defines the expected interface for the course keeper
@@ -21,6 +21,7 @@ var (
 	SubjectPrefix                  = []byte("Subject/")
 	PrerequisiteGroupPrefix        = []byte("PrereqGroup/")
 	SubjectPrerequisiteGroupPrefix = []byte("SubjectPrereq/")
+	ContentAttestationPrefix       = []byte("ContentAttestation/")
 )
 
 // Event types
@@ -29,6 +30,7 @@ const (
 	EventTypeUpdateSubjectContent = "update_subject_content"
 	EventTypeAddPrerequisiteGroup = "add_prerequisite_group"
 	EventTypeUpdateParams         = "update_params"
+	EventTypeContentAttested      = "content_attested"
 )
 
 // Event attribute keys
@@ -41,6 +43,8 @@ const (
 	AttributeKeySubjectId                     = "subject_id"
 	AttributeKeyContentHash                   = "content_hash"
 	AttributeKeyIpfsLink                      = "ipfs_link"
+	AttributeKeyAttester                      = "attester"
+	AttributeKeyAvailable                     = "available"
 	AttributeKeyGroupType                     = "group_type"
 	AttributeKeyGroupIndex                    = "group_index"
 	AttributeKeyIPFSEnabled                   = "ipfs_enabled"
@@ -103,3 +107,14 @@ func GetSubjectByInstitutionPrefix(institutionId string) []byte {
 func GetSubjectPrefix() []byte {
 	return SubjectPrefix // Fixed: was referencing undefined SubjectKeyPrefix
 }
+
+// ContentAttestationKey returns the key of the attestation of an attester for
+// the content of a subject
+func ContentAttestationKey(subjectId, attester string) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s", ContentAttestationPrefix, subjectId, attester))
+}
+
+// GetContentAttestationPrefix returns the prefix for the attestations of a subject
+func GetContentAttestationPrefix(subjectId string) []byte {
+	return []byte(fmt.Sprintf("%s%s/", ContentAttestationPrefix, subjectId))
+}
@@ -21,6 +21,8 @@ func NewMsgAddPrerequisiteGroup(
 	minimumCredits uint64,
 	minimumCompletedSubjects uint64,
 	subjectIds []string,
+	parentGroupId string,
+	corequisite bool,
 ) *MsgAddPrerequisiteGroup {
 	return &MsgAddPrerequisiteGroup{
 		Creator:                  creator,
@@ -29,6 +31,8 @@ func NewMsgAddPrerequisiteGroup(
 		MinimumCredits:           minimumCredits,
 		MinimumCompletedSubjects: minimumCompletedSubjects,
 		SubjectIds:               subjectIds,
+		ParentGroupId:            parentGroupId,
+		Corequisite:              corequisite,
 	}
 }
 
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"academictoken/x/subject/ipfs"
+)
+
+var _ sdk.Msg = &MsgAttestContentAvailability{}
+
+func NewMsgAttestContentAvailability(creator string, subjectId string, ipfsLink string, available bool, details string) *MsgAttestContentAvailability {
+	return &MsgAttestContentAvailability{
+		Creator:   creator,
+		SubjectId: subjectId,
+		IpfsLink:  ipfsLink,
+		Available: available,
+		Details:   details,
+	}
+}
+
+func (msg *MsgAttestContentAvailability) ValidateBasic() error {
+	// Validate creator address
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// Validate required fields
+	if msg.SubjectId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "subjectId cannot be empty")
+	}
+	if _, err := ipfs.ParseLink(msg.IpfsLink); err != nil {
+		return ErrInvalidIPFS.Wrap(err.Error())
+	}
+
+	return nil
+}
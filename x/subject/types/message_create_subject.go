@@ -27,6 +27,8 @@ func NewMsgCreateSubject(
 	knowledgeArea string,
 	objectives []string,
 	topicUnits []string,
+	contentHash string,
+	ipfsLink string,
 ) *MsgCreateSubject {
 	return &MsgCreateSubject{
 		Creator:       creator,
@@ -41,6 +43,8 @@ func NewMsgCreateSubject(
 		KnowledgeArea: knowledgeArea,
 		Objectives:    objectives,
 		TopicUnits:    topicUnits,
+		ContentHash:   contentHash,
+		IpfsLink:      ipfsLink,
 	}
 }
 
@@ -96,5 +100,17 @@ func (msg *MsgCreateSubject) ValidateBasic() error {
 		return fmt.Errorf("credits must be greater than 0")
 	}
 
+	if err := msg.ExtendedContent().ValidateReference(msg.ContentHash, msg.IpfsLink); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// ExtendedContent returns the IPFS document of the subject
+func (msg *MsgCreateSubject) ExtendedContent() ExtendedContent {
+	return ExtendedContent{
+		Objectives: msg.Objectives,
+		TopicUnits: msg.TopicUnits,
+	}
+}
@@ -27,6 +27,8 @@ func NewMsgCreateSubjectContent(
 	knowledgeArea string,
 	objectives []string,
 	topicUnits []string,
+	contentHash string,
+	ipfsLink string,
 ) *MsgCreateSubjectContent {
 	return &MsgCreateSubjectContent{
 		Creator:       creator,
@@ -41,6 +43,8 @@ func NewMsgCreateSubjectContent(
 		KnowledgeArea: knowledgeArea,
 		Objectives:    objectives,
 		TopicUnits:    topicUnits,
+		ContentHash:   contentHash,
+		IpfsLink:      ipfsLink,
 	}
 }
 
@@ -96,9 +100,21 @@ func (msg *MsgCreateSubjectContent) ValidateBasic() error {
 		return fmt.Errorf("credits must be greater than 0")
 	}
 
+	if err := msg.ExtendedContent().ValidateReference(msg.ContentHash, msg.IpfsLink); err != nil {
+		return err
+	}
+
 	if msg.WorkloadHours == 0 {
 		return fmt.Errorf("workload hours must be greater than 0")
 	}
 
 	return nil
 }
+
+// ExtendedContent returns the IPFS document of the subject
+func (msg *MsgCreateSubjectContent) ExtendedContent() ExtendedContent {
+	return ExtendedContent{
+		Objectives: msg.Objectives,
+		TopicUnits: msg.TopicUnits,
+	}
+}
@@ -68,5 +68,23 @@ func (msg *MsgUpdateSubjectContent) ValidateBasic() error {
 		return fmt.Errorf("subject_id cannot be empty")
 	}
 
+	if err := msg.ExtendedContent().ValidateReference(msg.ContentHash, msg.IpfsLink); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// ExtendedContent returns the IPFS document the message replaces the subject
+// content with
+func (msg *MsgUpdateSubjectContent) ExtendedContent() ExtendedContent {
+	return ExtendedContent{
+		BibliographyBasic:         msg.BibliographyBasic,
+		BibliographyComplementary: msg.BibliographyComplementary,
+		EvaluationMethods:         msg.EvaluationMethods,
+		Keywords:                  msg.Keywords,
+		Methodologies:             msg.Methodologies,
+		Objectives:                msg.Objectives,
+		TopicUnits:                msg.TopicUnits,
+	}
+}
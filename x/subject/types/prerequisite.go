@@ -6,6 +6,36 @@ var (
 	ErrInvalidGroupType = fmt.Errorf("invalid group type")
 )
 
+// Prerequisite group types
+const (
+	// GroupTypeAll requires every subject and nested group
+	GroupTypeAll = "ALL"
+	// GroupTypeAny requires minimumCompletedSubjects (at least one) of the
+	// subjects and nested groups
+	GroupTypeAny = "ANY"
+	// GroupTypeCredits requires minimumCredits from the listed subjects, or
+	// from any completed subject when none are listed
+	GroupTypeCredits = "CREDITS"
+	// GroupTypeCombination requires both minimumCompletedSubjects and
+	// minimumCredits from the listed subjects and nested groups
+	GroupTypeCombination = "COMBINATION"
+)
+
+// MaxPrerequisiteDepth bounds the nesting of prerequisite groups
+const MaxPrerequisiteDepth = 5
+
+// PrerequisiteEvaluation is the outcome of evaluating the prerequisite groups
+// of a subject for a student. Every top-level group must be satisfied.
+type PrerequisiteEvaluation struct {
+	Eligible bool
+	// Missing explains each unsatisfied top-level group
+	Missing []string
+	Groups  []PrerequisiteGroupResult
+}
+
+// ValidGroupTypes lists the accepted prerequisite group types
+var ValidGroupTypes = []string{GroupTypeAll, GroupTypeAny, GroupTypeCredits, GroupTypeCombination}
+
 // NewPrerequisiteGroup creates a new instance of PrerequisiteGroup
 func NewPrerequisiteGroup(
 	id string,
@@ -35,14 +65,30 @@ func (pg PrerequisiteGroup) ValidateBasic() error {
 		return fmt.Errorf("subject id cannot be empty")
 	}
 
-	if pg.GroupType == "" {
+	if !IsValidGroupType(pg.GroupType) {
 		return ErrInvalidGroupType
 	}
 
-	if pg.GroupType != "ALL" && pg.GroupType != "ANY" && pg.GroupType != "CREDITS" {
-		return ErrInvalidGroupType
+	if pg.ParentGroupId == pg.Id {
+		return fmt.Errorf("prerequisite group %s cannot be nested in itself", pg.Id)
+	}
+
+	for _, subjectId := range pg.SubjectIds {
+		if subjectId == pg.SubjectId {
+			return fmt.Errorf("subject %s cannot be its own prerequisite", pg.SubjectId)
+		}
 	}
 
 	// Add more validations as needed
 	return nil
 }
+
+// IsValidGroupType reports whether groupType is a known prerequisite group type
+func IsValidGroupType(groupType string) bool {
+	for _, valid := range ValidGroupTypes {
+		if groupType == valid {
+			return true
+		}
+	}
+	return false
+}
@@ -29,6 +29,10 @@ type PrerequisiteGroup struct {
 	MinimumCredits           uint64   `protobuf:"varint,4,opt,name=minimumCredits,proto3" json:"minimumCredits,omitempty"`
 	MinimumCompletedSubjects uint64   `protobuf:"varint,5,opt,name=minimumCompletedSubjects,proto3" json:"minimumCompletedSubjects,omitempty"`
 	SubjectIds               []string `protobuf:"bytes,6,rep,name=subjectIds,proto3" json:"subjectIds,omitempty"`
+	// parentGroupId nests the group inside another group of the same subject
+	ParentGroupId string `protobuf:"bytes,7,opt,name=parentGroupId,proto3" json:"parentGroupId,omitempty"`
+	// corequisite groups are also met by subjects the student is taking
+	Corequisite bool `protobuf:"varint,8,opt,name=corequisite,proto3" json:"corequisite,omitempty"`
 }
 
 func (m *PrerequisiteGroup) Reset()         { *m = PrerequisiteGroup{} }
@@ -106,8 +110,156 @@ func (m *PrerequisiteGroup) GetSubjectIds() []string {
 	return nil
 }
 
+func (m *PrerequisiteGroup) GetParentGroupId() string {
+	if m != nil {
+		return m.ParentGroupId
+	}
+	return ""
+}
+
+func (m *PrerequisiteGroup) GetCorequisite() bool {
+	if m != nil {
+		return m.Corequisite
+	}
+	return false
+}
+
+// PrerequisiteGroupResult explains how a student fares against a prerequisite group
+type PrerequisiteGroupResult struct {
+	GroupId           string                     `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupType         string                     `protobuf:"bytes,2,opt,name=groupType,proto3" json:"groupType,omitempty"`
+	Satisfied         bool                       `protobuf:"varint,3,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	Corequisite       bool                       `protobuf:"varint,4,opt,name=corequisite,proto3" json:"corequisite,omitempty"`
+	SatisfiedSubjects []string                   `protobuf:"bytes,5,rep,name=satisfiedSubjects,proto3" json:"satisfiedSubjects,omitempty"`
+	MissingSubjects   []string                   `protobuf:"bytes,6,rep,name=missingSubjects,proto3" json:"missingSubjects,omitempty"`
+	RequiredCredits   uint64                     `protobuf:"varint,7,opt,name=requiredCredits,proto3" json:"requiredCredits,omitempty"`
+	EarnedCredits     uint64                     `protobuf:"varint,8,opt,name=earnedCredits,proto3" json:"earnedCredits,omitempty"`
+	RequiredCount     uint64                     `protobuf:"varint,9,opt,name=requiredCount,proto3" json:"requiredCount,omitempty"`
+	SatisfiedCount    uint64                     `protobuf:"varint,10,opt,name=satisfiedCount,proto3" json:"satisfiedCount,omitempty"`
+	Explanation       string                     `protobuf:"bytes,11,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Children          []*PrerequisiteGroupResult `protobuf:"bytes,12,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (m *PrerequisiteGroupResult) Reset()         { *m = PrerequisiteGroupResult{} }
+func (m *PrerequisiteGroupResult) String() string { return proto.CompactTextString(m) }
+func (*PrerequisiteGroupResult) ProtoMessage()    {}
+func (*PrerequisiteGroupResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8ea8e768991ad4, []int{1}
+}
+func (m *PrerequisiteGroupResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrerequisiteGroupResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrerequisiteGroupResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrerequisiteGroupResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrerequisiteGroupResult.Merge(m, src)
+}
+func (m *PrerequisiteGroupResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrerequisiteGroupResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrerequisiteGroupResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrerequisiteGroupResult proto.InternalMessageInfo
+
+func (m *PrerequisiteGroupResult) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *PrerequisiteGroupResult) GetGroupType() string {
+	if m != nil {
+		return m.GroupType
+	}
+	return ""
+}
+
+func (m *PrerequisiteGroupResult) GetSatisfied() bool {
+	if m != nil {
+		return m.Satisfied
+	}
+	return false
+}
+
+func (m *PrerequisiteGroupResult) GetCorequisite() bool {
+	if m != nil {
+		return m.Corequisite
+	}
+	return false
+}
+
+func (m *PrerequisiteGroupResult) GetSatisfiedSubjects() []string {
+	if m != nil {
+		return m.SatisfiedSubjects
+	}
+	return nil
+}
+
+func (m *PrerequisiteGroupResult) GetMissingSubjects() []string {
+	if m != nil {
+		return m.MissingSubjects
+	}
+	return nil
+}
+
+func (m *PrerequisiteGroupResult) GetRequiredCredits() uint64 {
+	if m != nil {
+		return m.RequiredCredits
+	}
+	return 0
+}
+
+func (m *PrerequisiteGroupResult) GetEarnedCredits() uint64 {
+	if m != nil {
+		return m.EarnedCredits
+	}
+	return 0
+}
+
+func (m *PrerequisiteGroupResult) GetRequiredCount() uint64 {
+	if m != nil {
+		return m.RequiredCount
+	}
+	return 0
+}
+
+func (m *PrerequisiteGroupResult) GetSatisfiedCount() uint64 {
+	if m != nil {
+		return m.SatisfiedCount
+	}
+	return 0
+}
+
+func (m *PrerequisiteGroupResult) GetExplanation() string {
+	if m != nil {
+		return m.Explanation
+	}
+	return ""
+}
+
+func (m *PrerequisiteGroupResult) GetChildren() []*PrerequisiteGroupResult {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PrerequisiteGroup)(nil), "academictoken.subject.PrerequisiteGroup")
+	proto.RegisterType((*PrerequisiteGroupResult)(nil), "academictoken.subject.PrerequisiteGroupResult")
 }
 
 func init() {
@@ -115,23 +267,35 @@ func init() {
 }
 
 var fileDescriptor_9f8ea8e768991ad4 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0x4c, 0x4e, 0x4c,
-	0x49, 0xcd, 0xcd, 0x4c, 0x2e, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2f, 0x2e, 0x4d, 0xca, 0x4a, 0x4d,
-	0x2e, 0xd1, 0x2f, 0x28, 0x4a, 0x2d, 0x4a, 0x2d, 0x2c, 0xcd, 0x2c, 0xce, 0x2c, 0x49, 0x8d, 0x4f,
-	0x2f, 0xca, 0x2f, 0x2d, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x45, 0x51, 0xaf, 0x07,
-	0x55, 0xaf, 0xf4, 0x98, 0x91, 0x4b, 0x30, 0x00, 0x49, 0x8f, 0x3b, 0x48, 0x8b, 0x10, 0x1f, 0x17,
-	0x53, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x53, 0x66, 0x8a, 0x90, 0x0c, 0x17,
-	0x27, 0x54, 0x83, 0x67, 0x8a, 0x04, 0x13, 0x58, 0x18, 0x21, 0x00, 0x92, 0x05, 0xdb, 0x14, 0x52,
-	0x59, 0x90, 0x2a, 0xc1, 0x0c, 0x91, 0x85, 0x0b, 0x08, 0xa9, 0x71, 0xf1, 0xe5, 0x66, 0xe6, 0x65,
-	0xe6, 0x96, 0xe6, 0x3a, 0x17, 0xa5, 0xa6, 0x64, 0x96, 0x14, 0x4b, 0xb0, 0x28, 0x30, 0x6a, 0xb0,
-	0x04, 0xa1, 0x89, 0x0a, 0x59, 0x71, 0x49, 0xc0, 0x44, 0xf2, 0x73, 0x0b, 0x72, 0x52, 0x4b, 0x52,
-	0x53, 0x82, 0x21, 0x56, 0x14, 0x4b, 0xb0, 0x82, 0x75, 0xe0, 0x94, 0x17, 0x92, 0xe3, 0xe2, 0x82,
-	0x3b, 0xa7, 0x58, 0x82, 0x4d, 0x81, 0x59, 0x83, 0x33, 0x08, 0x49, 0xc4, 0xc9, 0xfc, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x64, 0x51, 0x83, 0xb1, 0x02, 0x1e, 0x90, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xc0, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xff,
-	0x9d, 0xec, 0xe4, 0x6e, 0x01, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb4, 0x5c, 0xd3, 0x57, 0x38, 0x74, 0x96, 0x10, 0x1e, 0x20, 0x8a, 0x4e, 0x08,
+	0x65, 0x40, 0x39, 0x09, 0x06, 0x24, 0x46, 0x18, 0xd0, 0x31, 0xa1, 0xc0, 0xc4, 0x82, 0x72, 0xf1,
+	0xa3, 0x18, 0x12, 0xc7, 0xd8, 0x8e, 0x74, 0xf7, 0x2d, 0xf8, 0x1e, 0x7c, 0x11, 0xc6, 0x1b, 0x19,
+	0x51, 0xfb, 0x19, 0xd8, 0x91, 0x9d, 0xd6, 0x4d, 0xd2, 0xbb, 0xb1, 0xbf, 0xf7, 0x7f, 0xf5, 0xcb,
+	0xcf, 0x7e, 0x90, 0x15, 0x65, 0xc1, 0xb0, 0xe6, 0xa5, 0x69, 0xbe, 0xa3, 0x38, 0xd3, 0xed, 0xc5,
+	0x37, 0x2c, 0xcd, 0x99, 0x54, 0xa8, 0xf0, 0x47, 0xcb, 0x35, 0x37, 0xf8, 0x79, 0xa5, 0x9a, 0x56,
+	0x66, 0x52, 0x35, 0xa6, 0x21, 0x0f, 0x06, 0xf9, 0x6c, 0x9b, 0x3f, 0xfd, 0x15, 0xc2, 0xc9, 0xfb,
+	0x5e, 0xcf, 0x5b, 0xdb, 0x42, 0x8e, 0x21, 0xe4, 0x8c, 0x06, 0x49, 0x90, 0x2e, 0xf2, 0x90, 0x33,
+	0xf2, 0x08, 0x16, 0xdb, 0x86, 0x73, 0x46, 0x43, 0x87, 0xf7, 0xc0, 0x56, 0xdd, 0x49, 0x1f, 0xaf,
+	0x24, 0xd2, 0x69, 0x57, 0xf5, 0x80, 0x3c, 0x85, 0xe3, 0x9a, 0x0b, 0x5e, 0xb7, 0xf5, 0x1b, 0x85,
+	0x8c, 0x1b, 0x4d, 0x67, 0x49, 0x90, 0xce, 0xf2, 0x11, 0x25, 0xaf, 0x80, 0xee, 0x48, 0x53, 0xcb,
+	0x0a, 0x0d, 0xb2, 0x0f, 0xdd, 0x11, 0x9a, 0xde, 0x71, 0x1d, 0xb7, 0xd6, 0x49, 0x0c, 0xe0, 0xc7,
+	0xd1, 0xf4, 0x28, 0x99, 0xa6, 0x8b, 0xbc, 0x47, 0xc8, 0x13, 0xb8, 0x27, 0x0b, 0x85, 0xc2, 0xb8,
+	0xcf, 0x3b, 0x67, 0x74, 0xee, 0xa6, 0x1c, 0x42, 0x92, 0xc0, 0xb2, 0x6c, 0xbc, 0x09, 0x1a, 0x25,
+	0x41, 0x1a, 0xe5, 0x7d, 0x74, 0xfa, 0x6f, 0x0a, 0x0f, 0x0f, 0x6c, 0xe5, 0xa8, 0xdb, 0xca, 0x10,
+	0x0a, 0xf3, 0xd5, 0xf6, 0xdf, 0x3b, 0x71, 0xbb, 0x9f, 0x43, 0x3f, 0xe1, 0xd8, 0x8f, 0x75, 0x5b,
+	0x18, 0xae, 0xbf, 0x70, 0x64, 0xce, 0x5e, 0x94, 0xef, 0xc1, 0x78, 0xa6, 0xd9, 0xc1, 0x4c, 0xe4,
+	0x19, 0x9c, 0xf8, 0x78, 0x4f, 0x98, 0x55, 0x70, 0x58, 0x20, 0x29, 0xdc, 0xaf, 0xb9, 0xd6, 0x5c,
+	0xac, 0x7c, 0xb6, 0xd3, 0x35, 0xc6, 0x36, 0xe9, 0x0e, 0x51, 0xc8, 0x76, 0x17, 0x37, 0x77, 0xd7,
+	0x30, 0xc6, 0xd6, 0x2e, 0x16, 0x4a, 0xec, 0x73, 0x91, 0xcb, 0x0d, 0xa1, 0x4d, 0xf9, 0xc6, 0xa6,
+	0x15, 0x86, 0x2e, 0xba, 0xd4, 0x00, 0xda, 0xd7, 0xe2, 0x87, 0xee, 0x62, 0xd0, 0xbd, 0x96, 0x21,
+	0xb5, 0x5e, 0xf0, 0x52, 0x56, 0x85, 0x28, 0x0c, 0x6f, 0x04, 0x5d, 0x3a, 0xab, 0x7d, 0x44, 0xde,
+	0x41, 0x54, 0x7e, 0xe5, 0x15, 0x53, 0x28, 0xe8, 0xdd, 0x64, 0x9a, 0x2e, 0x9f, 0x67, 0xd9, 0x8d,
+	0x3b, 0x90, 0xdd, 0x72, 0xa3, 0xb9, 0xef, 0x7f, 0xfd, 0xf2, 0xf7, 0x3a, 0x0e, 0xae, 0xd7, 0x71,
+	0xf0, 0x77, 0x1d, 0x07, 0x3f, 0x37, 0xf1, 0xe4, 0x7a, 0x13, 0x4f, 0xfe, 0x6c, 0xe2, 0xc9, 0xa7,
+	0xc7, 0xc3, 0x35, 0xbc, 0xf4, 0x8b, 0x68, 0xae, 0x24, 0xea, 0x8b, 0x23, 0xb7, 0x7c, 0x2f, 0xfe,
+	0x07, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x3a, 0x9a, 0x0a, 0xae, 0x03, 0x00, 0x00,
 }
 
 func (m *PrerequisiteGroup) Marshal() (dAtA []byte, err error) {
@@ -154,6 +318,23 @@ func (m *PrerequisiteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Corequisite {
+		i--
+		if m.Corequisite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ParentGroupId) > 0 {
+		i -= len(m.ParentGroupId)
+		copy(dAtA[i:], m.ParentGroupId)
+		i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(len(m.ParentGroupId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.SubjectIds) > 0 {
 		for iNdEx := len(m.SubjectIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SubjectIds[iNdEx])
@@ -197,6 +378,122 @@ func (m *PrerequisiteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PrerequisiteGroupResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrerequisiteGroupResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrerequisiteGroupResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Explanation) > 0 {
+		i -= len(m.Explanation)
+		copy(dAtA[i:], m.Explanation)
+		i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(len(m.Explanation)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SatisfiedCount != 0 {
+		i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(m.SatisfiedCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RequiredCount != 0 {
+		i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(m.RequiredCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EarnedCredits != 0 {
+		i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(m.EarnedCredits))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RequiredCredits != 0 {
+		i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(m.RequiredCredits))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MissingSubjects) > 0 {
+		for iNdEx := len(m.MissingSubjects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingSubjects[iNdEx])
+			copy(dAtA[i:], m.MissingSubjects[iNdEx])
+			i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(len(m.MissingSubjects[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SatisfiedSubjects) > 0 {
+		for iNdEx := len(m.SatisfiedSubjects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SatisfiedSubjects[iNdEx])
+			copy(dAtA[i:], m.SatisfiedSubjects[iNdEx])
+			i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(len(m.SatisfiedSubjects[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Corequisite {
+		i--
+		if m.Corequisite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Satisfied {
+		i--
+		if m.Satisfied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GroupType) > 0 {
+		i -= len(m.GroupType)
+		copy(dAtA[i:], m.GroupType)
+		i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(len(m.GroupType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintPrerequisiteGroup(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPrerequisiteGroup(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPrerequisiteGroup(v)
 	base := offset
@@ -238,6 +535,70 @@ func (m *PrerequisiteGroup) Size() (n int) {
 			n += 1 + l + sovPrerequisiteGroup(uint64(l))
 		}
 	}
+	l = len(m.ParentGroupId)
+	if l > 0 {
+		n += 1 + l + sovPrerequisiteGroup(uint64(l))
+	}
+	if m.Corequisite {
+		n += 2
+	}
+	return n
+}
+
+func (m *PrerequisiteGroupResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovPrerequisiteGroup(uint64(l))
+	}
+	l = len(m.GroupType)
+	if l > 0 {
+		n += 1 + l + sovPrerequisiteGroup(uint64(l))
+	}
+	if m.Satisfied {
+		n += 2
+	}
+	if m.Corequisite {
+		n += 2
+	}
+	if len(m.SatisfiedSubjects) > 0 {
+		for _, s := range m.SatisfiedSubjects {
+			l = len(s)
+			n += 1 + l + sovPrerequisiteGroup(uint64(l))
+		}
+	}
+	if len(m.MissingSubjects) > 0 {
+		for _, s := range m.MissingSubjects {
+			l = len(s)
+			n += 1 + l + sovPrerequisiteGroup(uint64(l))
+		}
+	}
+	if m.RequiredCredits != 0 {
+		n += 1 + sovPrerequisiteGroup(uint64(m.RequiredCredits))
+	}
+	if m.EarnedCredits != 0 {
+		n += 1 + sovPrerequisiteGroup(uint64(m.EarnedCredits))
+	}
+	if m.RequiredCount != 0 {
+		n += 1 + sovPrerequisiteGroup(uint64(m.RequiredCount))
+	}
+	if m.SatisfiedCount != 0 {
+		n += 1 + sovPrerequisiteGroup(uint64(m.SatisfiedCount))
+	}
+	l = len(m.Explanation)
+	if l > 0 {
+		n += 1 + l + sovPrerequisiteGroup(uint64(l))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovPrerequisiteGroup(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -442,6 +803,418 @@ func (m *PrerequisiteGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.SubjectIds = append(m.SubjectIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentGroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corequisite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Corequisite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrerequisiteGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrerequisiteGroupResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrerequisiteGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrerequisiteGroupResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrerequisiteGroupResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Satisfied = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corequisite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Corequisite = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SatisfiedSubjects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SatisfiedSubjects = append(m.SatisfiedSubjects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingSubjects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingSubjects = append(m.MissingSubjects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCredits", wireType)
+			}
+			m.RequiredCredits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredCredits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnedCredits", wireType)
+			}
+			m.EarnedCredits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarnedCredits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCount", wireType)
+			}
+			m.RequiredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SatisfiedCount", wireType)
+			}
+			m.SatisfiedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SatisfiedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explanation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Explanation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrerequisiteGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrerequisiteGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &PrerequisiteGroupResult{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPrerequisiteGroup(dAtA[iNdEx:])
@@ -763,6 +763,8 @@ func (m *QueryCheckPrerequisitesRequest) GetSubjectId() string {
 type QueryCheckPrerequisitesResponse struct {
 	IsEligible           bool     `protobuf:"varint,1,opt,name=is_eligible,json=isEligible,proto3" json:"is_eligible,omitempty"`
 	MissingPrerequisites []string `protobuf:"bytes,2,rep,name=missing_prerequisites,json=missingPrerequisites,proto3" json:"missing_prerequisites,omitempty"`
+	// groups explains every top-level prerequisite group of the subject
+	Groups []PrerequisiteGroupResult `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups"`
 }
 
 func (m *QueryCheckPrerequisitesResponse) Reset()         { *m = QueryCheckPrerequisitesResponse{} }
@@ -812,6 +814,13 @@ func (m *QueryCheckPrerequisitesResponse) GetMissingPrerequisites() []string {
 	return nil
 }
 
+func (m *QueryCheckPrerequisitesResponse) GetGroups() []PrerequisiteGroupResult {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
 // QueryCheckEquivalenceRequest is the request type for the Query/CheckEquivalence RPC method
 type QueryCheckEquivalenceRequest struct {
 	SourceSubjectId  string `protobuf:"bytes,1,opt,name=source_subject_id,json=sourceSubjectId,proto3" json:"source_subject_id,omitempty"`
@@ -926,6 +935,96 @@ func (m *QueryCheckEquivalenceResponse) GetStatus() string {
 	return ""
 }
 
+// QueryContentAttestationsRequest is the request type for the Query/ContentAttestations RPC method
+type QueryContentAttestationsRequest struct {
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+}
+
+func (m *QueryContentAttestationsRequest) Reset()         { *m = QueryContentAttestationsRequest{} }
+func (m *QueryContentAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContentAttestationsRequest) ProtoMessage()    {}
+func (*QueryContentAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d12f99a3432a379e, []int{18}
+}
+func (m *QueryContentAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContentAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContentAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContentAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContentAttestationsRequest.Merge(m, src)
+}
+func (m *QueryContentAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContentAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContentAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContentAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryContentAttestationsRequest) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
+}
+
+// QueryContentAttestationsResponse is the response type for the Query/ContentAttestations RPC method
+type QueryContentAttestationsResponse struct {
+	Attestations []ContentAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+}
+
+func (m *QueryContentAttestationsResponse) Reset()         { *m = QueryContentAttestationsResponse{} }
+func (m *QueryContentAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContentAttestationsResponse) ProtoMessage()    {}
+func (*QueryContentAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d12f99a3432a379e, []int{19}
+}
+func (m *QueryContentAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContentAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContentAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContentAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContentAttestationsResponse.Merge(m, src)
+}
+func (m *QueryContentAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContentAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContentAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContentAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryContentAttestationsResponse) GetAttestations() []ContentAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "academictoken.subject.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "academictoken.subject.QueryParamsResponse")
@@ -945,86 +1044,94 @@ func init() {
 	proto.RegisterType((*QueryCheckPrerequisitesResponse)(nil), "academictoken.subject.QueryCheckPrerequisitesResponse")
 	proto.RegisterType((*QueryCheckEquivalenceRequest)(nil), "academictoken.subject.QueryCheckEquivalenceRequest")
 	proto.RegisterType((*QueryCheckEquivalenceResponse)(nil), "academictoken.subject.QueryCheckEquivalenceResponse")
+	proto.RegisterType((*QueryContentAttestationsRequest)(nil), "academictoken.subject.QueryContentAttestationsRequest")
+	proto.RegisterType((*QueryContentAttestationsResponse)(nil), "academictoken.subject.QueryContentAttestationsResponse")
 }
 
 func init() { proto.RegisterFile("academictoken/subject/query.proto", fileDescriptor_d12f99a3432a379e) }
 
 var fileDescriptor_d12f99a3432a379e = []byte{
-	// 1170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x4e, 0x1b, 0x92, 0x57, 0xda, 0x26, 0x93, 0xa4, 0x84, 0x4d, 0xe3, 0x34, 0x2b,
-	0x85, 0xa4, 0x29, 0xec, 0x90, 0xa4, 0xe5, 0x37, 0x14, 0x25, 0x24, 0x21, 0xa8, 0x87, 0xe0, 0x1e,
-	0x8a, 0x38, 0x60, 0xd6, 0xeb, 0xe9, 0x66, 0x5a, 0x7b, 0xd7, 0xd9, 0x99, 0x6d, 0x13, 0x45, 0xbe,
-	0x80, 0xc4, 0x19, 0xc1, 0x05, 0x21, 0x24, 0x24, 0xb8, 0x70, 0xa9, 0x04, 0x17, 0x0e, 0xfc, 0x05,
-	0x3d, 0x16, 0xf5, 0x00, 0x27, 0x84, 0x12, 0x24, 0xfe, 0x8d, 0x6a, 0x67, 0x67, 0xbd, 0x6b, 0xaf,
-	0x77, 0xe3, 0x44, 0x3d, 0xf4, 0xd2, 0xc6, 0x6f, 0xde, 0x9b, 0xf7, 0xf9, 0x3e, 0xcf, 0xcc, 0x7b,
-	0x86, 0x19, 0xd3, 0x32, 0xab, 0xb4, 0xce, 0x2c, 0xe1, 0xde, 0xa5, 0x0e, 0xe1, 0x7e, 0xe5, 0x0e,
-	0xb5, 0x04, 0xd9, 0xf1, 0xa9, 0xb7, 0x67, 0x34, 0x3c, 0x57, 0xb8, 0x78, 0xbc, 0xcd, 0xc5, 0x50,
-	0x2e, 0xda, 0x88, 0x59, 0x67, 0x8e, 0x4b, 0xe4, 0xbf, 0xa1, 0xa7, 0x36, 0x66, 0xbb, 0xb6, 0x2b,
-	0xff, 0x24, 0xc1, 0x5f, 0xca, 0x7a, 0xd1, 0x76, 0x5d, 0xbb, 0x46, 0x89, 0xd9, 0x60, 0xc4, 0x74,
-	0x1c, 0x57, 0x98, 0x82, 0xb9, 0x0e, 0x57, 0xab, 0x0b, 0x96, 0xcb, 0xeb, 0x2e, 0x27, 0x15, 0x93,
-	0xd3, 0x30, 0x2d, 0xb9, 0xb7, 0x58, 0xa1, 0xc2, 0x5c, 0x24, 0x0d, 0xd3, 0x66, 0x8e, 0x74, 0x56,
-	0xbe, 0x7a, 0x77, 0xd8, 0x86, 0xe9, 0x99, 0xf5, 0x68, 0xbf, 0x2b, 0xdd, 0x7d, 0xd4, 0xff, 0x65,
-	0xcb, 0x75, 0x04, 0x75, 0x84, 0x72, 0x36, 0x32, 0x36, 0xf4, 0xa8, 0x47, 0x77, 0x7c, 0xc6, 0x99,
-	0xa0, 0x65, 0xdb, 0x73, 0xfd, 0x46, 0x04, 0xdb, 0xdd, 0xdf, 0xda, 0xa6, 0xd6, 0xdd, 0x72, 0x9d,
-	0x72, 0x6e, 0xda, 0x54, 0x81, 0xe8, 0x63, 0x80, 0x3f, 0x0e, 0xe4, 0x6c, 0x49, 0xba, 0x12, 0xdd,
-	0xf1, 0x29, 0x17, 0xfa, 0x2d, 0x18, 0x6d, 0xb3, 0xf2, 0x86, 0xeb, 0x70, 0x8a, 0xdf, 0x87, 0x81,
-	0x50, 0xc5, 0x04, 0xba, 0x84, 0xe6, 0xcf, 0x2c, 0x4d, 0x19, 0x5d, 0x8b, 0x6e, 0x84, 0x61, 0x2b,
-	0x43, 0x0f, 0xff, 0x99, 0xee, 0xfb, 0xe5, 0xff, 0x5f, 0x17, 0x50, 0x49, 0xc5, 0xe9, 0x06, 0x5c,
-	0x90, 0x1b, 0x6f, 0x50, 0x71, 0x33, 0x74, 0x56, 0x29, 0xf1, 0x18, 0x9c, 0x66, 0x4e, 0x95, 0xee,
-	0xca, 0xad, 0x87, 0x4a, 0xe1, 0x07, 0xfd, 0x73, 0x78, 0x21, 0xe5, 0xaf, 0x60, 0xd6, 0xe0, 0x39,
-	0x95, 0x4f, 0xd1, 0xcc, 0x66, 0xd0, 0xa8, 0xc0, 0xd5, 0xb0, 0xa6, 0x2b, 0xa7, 0x02, 0xaa, 0x52,
-	0x14, 0xab, 0x2f, 0x81, 0xd6, 0x91, 0x61, 0xdd, 0xaf, 0xd5, 0xf2, 0xa9, 0xbe, 0x43, 0x30, 0xd9,
-	0x35, 0xe8, 0xa9, 0xa2, 0xe1, 0x25, 0x18, 0xa7, 0xbb, 0x82, 0x3a, 0x55, 0x5a, 0x8d, 0x4e, 0x44,
-	0xf9, 0x0e, 0x77, 0x9d, 0x89, 0x82, 0x84, 0x19, 0x8d, 0x16, 0x55, 0xf8, 0x47, 0xdc, 0x75, 0xf4,
-	0x0f, 0x61, 0xae, 0x83, 0xec, 0x16, 0x13, 0xdb, 0x5b, 0x89, 0xa3, 0x12, 0x7d, 0xc9, 0x78, 0x0a,
-	0x20, 0x3a, 0x6f, 0xac, 0xaa, 0x04, 0x0e, 0x29, 0xcb, 0x66, 0x55, 0xff, 0x11, 0xc1, 0xfc, 0xd1,
-	0x5b, 0x29, 0xc5, 0x1c, 0xb4, 0x68, 0xaf, 0xfb, 0x4c, 0x6c, 0x97, 0x93, 0x67, 0x33, 0x3a, 0x2d,
-	0x24, 0xbf, 0x08, 0xa9, 0xcd, 0x55, 0x39, 0x26, 0x78, 0xc6, 0xba, 0x5e, 0x81, 0x09, 0x09, 0x78,
-	0x83, 0xf1, 0x88, 0xb0, 0x25, 0x6e, 0x1d, 0x20, 0xbe, 0x98, 0x0a, 0xe0, 0x25, 0x23, 0xbc, 0xc5,
-	0x46, 0x70, 0x8b, 0x8d, 0xf0, 0xf1, 0x50, 0xb7, 0xd8, 0xd8, 0x32, 0x6d, 0xaa, 0x62, 0x4b, 0x89,
-	0x48, 0xfd, 0x01, 0x82, 0x17, 0xbb, 0x24, 0x51, 0xb2, 0x37, 0x60, 0x50, 0xd1, 0x05, 0x22, 0xfb,
-	0x8f, 0xfb, 0x4d, 0xb7, 0x82, 0xf1, 0x46, 0x1b, 0x6e, 0x41, 0xe2, 0xce, 0x1d, 0x89, 0x1b, 0x52,
-	0xb4, 0xf1, 0x7e, 0x89, 0xe0, 0xa2, 0xe4, 0x8d, 0x58, 0x57, 0xf6, 0x56, 0x5d, 0xdf, 0xe3, 0x91,
-	0x38, 0x3c, 0x09, 0x43, 0x96, 0x34, 0xc4, 0x5f, 0xfa, 0x60, 0x68, 0xd8, 0xac, 0x76, 0x54, 0xad,
-	0x70, 0xe2, 0xaa, 0xfd, 0x86, 0x60, 0x2a, 0x83, 0xe2, 0x99, 0xad, 0xdc, 0x37, 0x08, 0x66, 0x3a,
-	0x98, 0x37, 0x1d, 0x2e, 0x98, 0xf0, 0x83, 0xe5, 0xa8, 0x7c, 0xb3, 0x70, 0x8e, 0xc5, 0xd6, 0xb8,
-	0x86, 0x67, 0x13, 0xd6, 0xa7, 0x58, 0xc8, 0xdf, 0x11, 0xe8, 0x79, 0x50, 0xcf, 0x6c, 0x35, 0x3f,
-	0x83, 0xa2, 0xe4, 0x5e, 0x0d, 0x9a, 0x4e, 0xe6, 0xf3, 0x23, 0xfc, 0x6a, 0xf0, 0xa8, 0x25, 0x9e,
-	0x9f, 0xd0, 0xb2, 0x59, 0xed, 0x78, 0x9d, 0x0a, 0x9d, 0xaf, 0xd3, 0x7d, 0x98, 0xce, 0xdc, 0x5f,
-	0x15, 0x65, 0x1a, 0xce, 0x30, 0x5e, 0xa6, 0x35, 0x66, 0xb3, 0x4a, 0x8d, 0xca, 0x0c, 0x83, 0x25,
-	0x60, 0x7c, 0x4d, 0x59, 0xf0, 0x32, 0x8c, 0xd7, 0x19, 0xe7, 0xcc, 0xb1, 0x3b, 0xde, 0xab, 0xc2,
-	0xa5, 0xfe, 0xf9, 0xa1, 0xd2, 0x98, 0x5a, 0x6c, 0x7f, 0x74, 0x7e, 0x8e, 0x2e, 0x98, 0xcc, 0xbc,
-	0xb6, 0xe3, 0xb3, 0x7b, 0x66, 0x8d, 0x3a, 0x56, 0xeb, 0x82, 0x2d, 0xc0, 0x08, 0x77, 0x7d, 0xcf,
-	0xa2, 0xe5, 0xd4, 0xeb, 0x7a, 0x3e, 0x5c, 0xb8, 0x19, 0xa9, 0x08, 0x7c, 0x85, 0xe9, 0xd9, 0x54,
-	0x94, 0x53, 0x5a, 0xcf, 0x87, 0x0b, 0xb1, 0xef, 0x15, 0x18, 0xb9, 0xed, 0x06, 0xdb, 0x7a, 0xd4,
-	0x32, 0x6b, 0x96, 0x5f, 0x33, 0x05, 0x9d, 0xe8, 0x97, 0xa2, 0x86, 0xe5, 0x42, 0x29, 0xb6, 0xeb,
-	0xdb, 0xea, 0xfe, 0xa5, 0x21, 0x55, 0x71, 0x08, 0x8c, 0xd2, 0xd8, 0x5c, 0x6e, 0x50, 0xcf, 0xa2,
-	0x4e, 0xd8, 0xae, 0x4e, 0x95, 0x70, 0x62, 0x69, 0x2b, 0x5c, 0xc1, 0x17, 0x60, 0x80, 0x0b, 0x53,
-	0xf8, 0x5c, 0xf1, 0xa9, 0x4f, 0x4b, 0x3f, 0x9d, 0x85, 0xd3, 0x32, 0x15, 0xfe, 0x0a, 0xc1, 0x40,
-	0xd8, 0xf9, 0xf1, 0xe5, 0x8c, 0xd3, 0x97, 0x1e, 0x35, 0xb4, 0x85, 0x5e, 0x5c, 0x43, 0x68, 0x7d,
-	0xf6, 0x8b, 0xc7, 0xff, 0x7d, 0x5b, 0x98, 0xc6, 0x53, 0x24, 0x6f, 0xc4, 0xc2, 0x3f, 0x20, 0x80,
-	0xb8, 0x69, 0xe1, 0x57, 0xf2, 0x32, 0xa4, 0x06, 0x11, 0xcd, 0xe8, 0xd5, 0x5d, 0x41, 0x11, 0x09,
-	0x75, 0x19, 0xcf, 0x91, 0xdc, 0x99, 0x8e, 0x93, 0x7d, 0x39, 0x3c, 0x34, 0xf1, 0x03, 0x04, 0xe7,
-	0xda, 0x07, 0x07, 0xbc, 0xd8, 0x5b, 0xce, 0xc4, 0x64, 0xa2, 0x2d, 0x1d, 0x27, 0x44, 0xa1, 0x5e,
-	0x95, 0xa8, 0x06, 0x7e, 0xb9, 0x47, 0x54, 0x72, 0x3b, 0x80, 0x3b, 0x40, 0x30, 0x99, 0x33, 0x03,
-	0xe0, 0xf7, 0x7a, 0x23, 0xc9, 0x9a, 0x43, 0xb4, 0xeb, 0x27, 0x8e, 0x57, 0xb2, 0x56, 0xa4, 0xac,
-	0x77, 0xf0, 0x5b, 0x47, 0xca, 0x8a, 0x6f, 0x59, 0xb3, 0x6d, 0x7c, 0xe6, 0xf8, 0x7b, 0x04, 0xcf,
-	0x27, 0x5b, 0x3c, 0x26, 0x79, 0x54, 0x5d, 0x26, 0x0e, 0xed, 0xd5, 0xde, 0x03, 0x14, 0xf7, 0x9c,
-	0xe4, 0x9e, 0xc1, 0xd3, 0x47, 0x70, 0xe3, 0x3f, 0x10, 0x0c, 0x77, 0x76, 0x52, 0xbc, 0x9c, 0x97,
-	0x2f, 0xa3, 0xfb, 0x6b, 0x57, 0x8f, 0x17, 0xa4, 0x40, 0xdf, 0x96, 0xa0, 0xd7, 0xf0, 0x72, 0x06,
-	0x68, 0x38, 0x3f, 0x70, 0xb2, 0xdf, 0x9a, 0x2c, 0x9a, 0x31, 0xfc, 0x63, 0x04, 0xe3, 0x5d, 0xbb,
-	0x17, 0x7e, 0xa3, 0x37, 0x98, 0x74, 0x17, 0xd6, 0xde, 0x3c, 0x41, 0xa4, 0xd2, 0xb2, 0x26, 0xb5,
-	0x5c, 0xc7, 0xef, 0x66, 0x68, 0x49, 0xf4, 0x71, 0x79, 0x0f, 0x92, 0xbd, 0x3e, 0xa1, 0xea, 0x4f,
-	0x04, 0x38, 0xdd, 0x7b, 0xf0, 0xb5, 0x3c, 0xb0, 0xcc, 0x5e, 0xa8, 0xbd, 0x76, 0xdc, 0x30, 0x25,
-	0xe6, 0x86, 0x14, 0xb3, 0x8e, 0x3f, 0x20, 0x79, 0x3f, 0xf9, 0xda, 0x4e, 0x3a, 0xd9, 0x8f, 0xbb,
-	0x6e, 0xb3, 0xed, 0x46, 0xe0, 0xbf, 0x10, 0x0c, 0x77, 0x36, 0x8c, 0xfc, 0x63, 0x96, 0xd1, 0x03,
-	0xf3, 0x8f, 0x59, 0x56, 0x4f, 0xd2, 0x3f, 0x91, 0x6a, 0x4a, 0x78, 0x2b, 0x57, 0x4d, 0xa2, 0x37,
-	0x91, 0xfd, 0x54, 0xa7, 0x6d, 0x92, 0xfd, 0x54, 0x47, 0x6d, 0xae, 0xbc, 0xfe, 0xf0, 0xa0, 0x88,
-	0x1e, 0x1d, 0x14, 0xd1, 0xbf, 0x07, 0x45, 0xf4, 0xf5, 0x61, 0xb1, 0xef, 0xd1, 0x61, 0xb1, 0xef,
-	0xef, 0xc3, 0x62, 0xdf, 0xa7, 0x53, 0xed, 0xa9, 0x76, 0x5b, 0xc9, 0xc4, 0x5e, 0x83, 0xf2, 0xca,
-	0x80, 0xfc, 0x95, 0xbc, 0xfc, 0x24, 0x00, 0x00, 0xff, 0xff, 0x42, 0x27, 0x4b, 0xc1, 0x81, 0x10,
-	0x00, 0x00,
+	// 1272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x1b, 0xc7, 0x33, 0x4e, 0x9b, 0xb7, 0x79, 0xda, 0x37, 0x4d, 0x26, 0x49, 0x31, 0x9b, 0xc6, 0x49,
+	0x56, 0x0a, 0x49, 0x53, 0xd8, 0x21, 0x49, 0x4b, 0x29, 0x05, 0xda, 0x26, 0x24, 0x21, 0x28, 0x87,
+	0xe0, 0x1c, 0x8a, 0x38, 0x60, 0xd6, 0xeb, 0xa9, 0xb3, 0xad, 0xbd, 0xeb, 0xec, 0xcc, 0xb6, 0x89,
+	0x22, 0x5f, 0x40, 0xe2, 0x8c, 0xe0, 0x82, 0x10, 0x12, 0x07, 0x2e, 0x5c, 0x2a, 0xc1, 0x85, 0x03,
+	0x77, 0xa4, 0x72, 0x2b, 0xea, 0x01, 0x4e, 0x08, 0x25, 0x48, 0xdc, 0xf8, 0x1b, 0xd0, 0xce, 0xce,
+	0x66, 0x77, 0xbd, 0xde, 0x8d, 0x1d, 0xf5, 0xd0, 0x4b, 0x6b, 0xcf, 0x3c, 0xcf, 0x33, 0x9f, 0xe7,
+	0x99, 0x1f, 0xcf, 0xd7, 0x81, 0x29, 0xdd, 0xd0, 0x2b, 0xb4, 0x6e, 0x1a, 0xdc, 0xbe, 0x4f, 0x2d,
+	0xc2, 0xdc, 0xf2, 0x3d, 0x6a, 0x70, 0xb2, 0xe3, 0x52, 0x67, 0x4f, 0x6b, 0x38, 0x36, 0xb7, 0xf1,
+	0x68, 0xcc, 0x44, 0x93, 0x26, 0xca, 0x90, 0x5e, 0x37, 0x2d, 0x9b, 0x88, 0x7f, 0x7d, 0x4b, 0x65,
+	0xa4, 0x6a, 0x57, 0x6d, 0xf1, 0x91, 0x78, 0x9f, 0xe4, 0xe8, 0xc5, 0xaa, 0x6d, 0x57, 0x6b, 0x94,
+	0xe8, 0x0d, 0x93, 0xe8, 0x96, 0x65, 0x73, 0x9d, 0x9b, 0xb6, 0xc5, 0xe4, 0xec, 0x9c, 0x61, 0xb3,
+	0xba, 0xcd, 0x48, 0x59, 0x67, 0xd4, 0x5f, 0x96, 0x3c, 0x98, 0x2f, 0x53, 0xae, 0xcf, 0x93, 0x86,
+	0x5e, 0x35, 0x2d, 0x61, 0x2c, 0x6d, 0xd5, 0xf6, 0xb0, 0x0d, 0xdd, 0xd1, 0xeb, 0x41, 0xbc, 0xcb,
+	0xed, 0x6d, 0xe4, 0xff, 0x25, 0xc3, 0xb6, 0x38, 0xb5, 0xb8, 0x34, 0xd6, 0x52, 0x02, 0x3a, 0xd4,
+	0xa1, 0x3b, 0xae, 0xc9, 0x4c, 0x4e, 0x4b, 0x55, 0xc7, 0x76, 0x1b, 0x01, 0x6c, 0x7b, 0x7b, 0x63,
+	0x9b, 0x1a, 0xf7, 0x4b, 0x75, 0xca, 0x98, 0x5e, 0xa5, 0x12, 0x44, 0x1d, 0x01, 0xfc, 0xbe, 0x97,
+	0xce, 0xa6, 0xa0, 0x2b, 0xd2, 0x1d, 0x97, 0x32, 0xae, 0xde, 0x81, 0xe1, 0xd8, 0x28, 0x6b, 0xd8,
+	0x16, 0xa3, 0xf8, 0x16, 0xf4, 0xf9, 0x59, 0xe4, 0xd1, 0x24, 0x9a, 0x3d, 0xbb, 0x30, 0xae, 0xb5,
+	0x2d, 0xba, 0xe6, 0xbb, 0x2d, 0xf5, 0x3f, 0xfe, 0x73, 0xa2, 0xe7, 0xfb, 0x7f, 0x7e, 0x98, 0x43,
+	0x45, 0xe9, 0xa7, 0x6a, 0x70, 0x41, 0x04, 0x5e, 0xa3, 0x7c, 0xcb, 0x37, 0x96, 0x4b, 0xe2, 0x11,
+	0x38, 0x6d, 0x5a, 0x15, 0xba, 0x2b, 0x42, 0xf7, 0x17, 0xfd, 0x2f, 0xea, 0xc7, 0xf0, 0x42, 0xc2,
+	0x5e, 0xc2, 0xac, 0xc0, 0xff, 0xe4, 0x7a, 0x92, 0x66, 0x3a, 0x85, 0x46, 0x3a, 0x2e, 0xfb, 0x35,
+	0x5d, 0x3a, 0xe5, 0x51, 0x15, 0x03, 0x5f, 0x75, 0x01, 0x94, 0x96, 0x15, 0x56, 0xdd, 0x5a, 0x2d,
+	0x9b, 0xea, 0x2b, 0x04, 0x63, 0x6d, 0x9d, 0x9e, 0x29, 0x1a, 0x5e, 0x80, 0x51, 0xba, 0xcb, 0xa9,
+	0x55, 0xa1, 0x95, 0xe0, 0x44, 0x94, 0xee, 0x31, 0xdb, 0xca, 0xe7, 0x04, 0xcc, 0x70, 0x30, 0x29,
+	0xdd, 0xdf, 0x63, 0xb6, 0xa5, 0xbe, 0x0b, 0x33, 0x2d, 0x64, 0x77, 0x4c, 0xbe, 0xbd, 0x19, 0x39,
+	0x2a, 0xc1, 0x26, 0xe3, 0x71, 0x80, 0xe0, 0xbc, 0x99, 0x15, 0x99, 0x60, 0xbf, 0x1c, 0x59, 0xaf,
+	0xa8, 0xdf, 0x22, 0x98, 0x3d, 0x3e, 0x94, 0xcc, 0x98, 0x81, 0x12, 0xc4, 0x7a, 0x68, 0xf2, 0xed,
+	0x52, 0xf4, 0x6c, 0x06, 0xa7, 0x85, 0x64, 0x17, 0x21, 0x11, 0x5c, 0x96, 0x23, 0xcf, 0x52, 0xe6,
+	0xd5, 0x32, 0xe4, 0x05, 0xe0, 0x86, 0xc9, 0x02, 0xc2, 0xa3, 0xe4, 0x56, 0x01, 0xc2, 0x8b, 0x29,
+	0x01, 0x5e, 0xd2, 0xfc, 0x5b, 0xac, 0x79, 0xb7, 0x58, 0xf3, 0x1f, 0x0f, 0x79, 0x8b, 0xb5, 0x4d,
+	0xbd, 0x4a, 0xa5, 0x6f, 0x31, 0xe2, 0xa9, 0x3e, 0x42, 0xf0, 0x62, 0x9b, 0x45, 0x64, 0xda, 0x6b,
+	0x70, 0x46, 0xd2, 0x79, 0x49, 0xf6, 0x76, 0xbb, 0xd3, 0x47, 0xce, 0x78, 0x2d, 0x86, 0x9b, 0x13,
+	0xb8, 0x33, 0xc7, 0xe2, 0xfa, 0x14, 0x31, 0xde, 0x4f, 0x11, 0x5c, 0x14, 0xbc, 0x01, 0xeb, 0xd2,
+	0xde, 0xb2, 0xed, 0x3a, 0x2c, 0x48, 0x0e, 0x8f, 0x41, 0xbf, 0x21, 0x06, 0xc2, 0x4d, 0x3f, 0xe3,
+	0x0f, 0xac, 0x57, 0x5a, 0xaa, 0x96, 0x3b, 0x71, 0xd5, 0x7e, 0x44, 0x30, 0x9e, 0x42, 0xf1, 0xdc,
+	0x56, 0xee, 0x0b, 0x04, 0x53, 0x2d, 0xcc, 0xeb, 0x16, 0xe3, 0x26, 0x77, 0xbd, 0xe9, 0xa0, 0x7c,
+	0xd3, 0x30, 0x60, 0x86, 0xa3, 0x61, 0x0d, 0xff, 0x1f, 0x19, 0x7d, 0x86, 0x85, 0xfc, 0x09, 0x81,
+	0x9a, 0x05, 0xf5, 0xdc, 0x56, 0xf3, 0x23, 0x28, 0x08, 0xee, 0x65, 0xaf, 0xe9, 0xa4, 0x3e, 0x3f,
+	0xdc, 0xad, 0x78, 0x8f, 0x5a, 0xe4, 0xf9, 0xf1, 0x47, 0xd6, 0x2b, 0x2d, 0xaf, 0x53, 0xae, 0xf5,
+	0x75, 0xfa, 0x05, 0xc1, 0x44, 0xea, 0x02, 0xb2, 0x2a, 0x13, 0x70, 0xd6, 0x64, 0x25, 0x5a, 0x33,
+	0xab, 0x66, 0xb9, 0x46, 0xc5, 0x12, 0x67, 0x8a, 0x60, 0xb2, 0x15, 0x39, 0x82, 0x17, 0x61, 0xb4,
+	0x6e, 0x32, 0x66, 0x5a, 0xd5, 0x96, 0x07, 0x2b, 0x37, 0xd9, 0x3b, 0xdb, 0x5f, 0x1c, 0x91, 0x93,
+	0xb1, 0xe8, 0x78, 0x03, 0xfa, 0x44, 0xb3, 0x65, 0xf9, 0x5e, 0x51, 0x69, 0x2d, 0xad, 0x09, 0x46,
+	0xbc, 0xd6, 0x3c, 0x87, 0x22, 0x65, 0x6e, 0x2d, 0x28, 0xb9, 0x8c, 0xa1, 0x7e, 0x17, 0xdc, 0x57,
+	0x91, 0xc7, 0xca, 0x8e, 0x6b, 0x3e, 0xd0, 0x6b, 0xd4, 0x32, 0x8e, 0xee, 0xeb, 0x1c, 0x0c, 0x31,
+	0xdb, 0x75, 0x0c, 0x5a, 0x4a, 0x3c, 0xd6, 0xe7, 0xfd, 0x89, 0xad, 0xa0, 0x28, 0x9e, 0x2d, 0xd7,
+	0x9d, 0x2a, 0xe5, 0xa5, 0x44, 0xe9, 0xce, 0xfb, 0x13, 0xa1, 0xed, 0x65, 0x18, 0xba, 0x6b, 0x7b,
+	0x61, 0x1d, 0x6a, 0xe8, 0x35, 0xc3, 0xad, 0xe9, 0x9c, 0xe6, 0x7b, 0x45, 0x89, 0x06, 0xc5, 0x44,
+	0x31, 0x1c, 0x57, 0xb7, 0xe5, 0x75, 0x4e, 0x42, 0xca, 0x52, 0x13, 0x18, 0xa6, 0xe1, 0x70, 0xa9,
+	0x41, 0x1d, 0x83, 0x5a, 0x7e, 0xf7, 0x3b, 0x55, 0xc4, 0x91, 0xa9, 0x4d, 0x7f, 0x06, 0x5f, 0x80,
+	0x3e, 0xc6, 0x75, 0xee, 0x32, 0xc9, 0x27, 0xbf, 0xa9, 0xb7, 0x82, 0x6d, 0xf5, 0x0f, 0xe8, 0x6d,
+	0xce, 0x29, 0x93, 0x52, 0xac, 0xc3, 0xbe, 0xf5, 0x10, 0x26, 0xd3, 0x23, 0x48, 0xdc, 0x2d, 0x38,
+	0xa7, 0x47, 0xc6, 0xe5, 0x9d, 0xb9, 0x94, 0xb2, 0x93, 0xc9, 0x48, 0x72, 0x13, 0x63, 0x41, 0x16,
+	0xfe, 0x1d, 0x80, 0xd3, 0x62, 0x65, 0xfc, 0x19, 0x82, 0x3e, 0x5f, 0x03, 0xe1, 0xb4, 0x98, 0x49,
+	0xd1, 0xa5, 0xcc, 0x75, 0x62, 0xea, 0x27, 0xa0, 0x4e, 0x7f, 0xf2, 0xf4, 0xef, 0x2f, 0x73, 0x13,
+	0x78, 0x9c, 0x64, 0x89, 0x4d, 0xfc, 0x0d, 0x02, 0x08, 0xdb, 0x37, 0x7e, 0x25, 0x6b, 0x85, 0x84,
+	0x24, 0x53, 0xb4, 0x4e, 0xcd, 0x25, 0x14, 0x11, 0x50, 0x97, 0xf0, 0x0c, 0xc9, 0x54, 0xb7, 0x8c,
+	0xec, 0x0b, 0x19, 0xd5, 0xc4, 0x8f, 0x10, 0x0c, 0xc4, 0x25, 0x14, 0x9e, 0xef, 0x6c, 0xcd, 0x88,
+	0x46, 0x53, 0x16, 0xba, 0x71, 0x91, 0xa8, 0x57, 0x04, 0xaa, 0x86, 0x5f, 0xee, 0x10, 0x95, 0xdc,
+	0xf5, 0xe0, 0x0e, 0x10, 0x8c, 0x65, 0xa8, 0x21, 0xfc, 0x76, 0x67, 0x24, 0x69, 0x8a, 0x4c, 0xb9,
+	0x79, 0x62, 0x7f, 0x99, 0xd6, 0x92, 0x48, 0xeb, 0x4d, 0xfc, 0xc6, 0xb1, 0x69, 0x85, 0x37, 0xa8,
+	0x19, 0xfb, 0x21, 0xc1, 0xf0, 0xd7, 0x08, 0xce, 0x45, 0xc5, 0x0e, 0x26, 0x59, 0x54, 0x6d, 0xb4,
+	0x97, 0xf2, 0x6a, 0xe7, 0x0e, 0x92, 0x7b, 0x46, 0x70, 0x4f, 0xe1, 0x89, 0x63, 0xb8, 0xf1, 0xcf,
+	0x08, 0x06, 0x5b, 0x35, 0x05, 0x5e, 0xcc, 0x5a, 0x2f, 0x45, 0x07, 0x29, 0x57, 0xba, 0x73, 0x92,
+	0xa0, 0x37, 0x04, 0xe8, 0x55, 0xbc, 0x98, 0x02, 0xea, 0x2b, 0x29, 0x46, 0xf6, 0x8f, 0x34, 0x56,
+	0x33, 0x84, 0x7f, 0x8a, 0x60, 0xb4, 0x6d, 0x1f, 0xc7, 0xaf, 0x77, 0x06, 0x93, 0xd4, 0x23, 0xca,
+	0xf5, 0x13, 0x78, 0xca, 0x5c, 0x56, 0x44, 0x2e, 0x37, 0xf1, 0x5b, 0x29, 0xb9, 0x44, 0x14, 0x8d,
+	0xb8, 0x07, 0x51, 0xd5, 0x13, 0xc9, 0xea, 0x37, 0x04, 0x38, 0xd9, 0x84, 0xf1, 0xd5, 0x2c, 0xb0,
+	0x54, 0x55, 0xa0, 0xbc, 0xd6, 0xad, 0x9b, 0x4c, 0x66, 0x43, 0x24, 0xb3, 0x8a, 0xdf, 0x21, 0x59,
+	0x3f, 0x7e, 0x63, 0x27, 0x9d, 0xec, 0x87, 0xfa, 0xa3, 0x19, 0xbb, 0x11, 0xf8, 0x77, 0x04, 0x83,
+	0xad, 0xbd, 0x2e, 0xfb, 0x98, 0xa5, 0xb4, 0xef, 0xec, 0x63, 0x96, 0xd6, 0x4e, 0xd5, 0x0f, 0x44,
+	0x36, 0x45, 0xbc, 0x99, 0x99, 0x4d, 0xa4, 0xad, 0x92, 0xfd, 0x84, 0x48, 0x68, 0x92, 0xfd, 0x84,
+	0x18, 0x68, 0xe2, 0x5f, 0x11, 0x0c, 0xb7, 0xe9, 0x8c, 0x38, 0xbb, 0xee, 0xa9, 0xcd, 0x58, 0xb9,
+	0xd6, 0xb5, 0x9f, 0x4c, 0xf1, 0xb6, 0x48, 0xf1, 0x06, 0xbe, 0xde, 0xd5, 0x53, 0x15, 0x6d, 0xb8,
+	0x4b, 0xd7, 0x1e, 0x1f, 0x14, 0xd0, 0x93, 0x83, 0x02, 0xfa, 0xeb, 0xa0, 0x80, 0x3e, 0x3f, 0x2c,
+	0xf4, 0x3c, 0x39, 0x2c, 0xf4, 0xfc, 0x71, 0x58, 0xe8, 0xf9, 0x70, 0x3c, 0x1e, 0x73, 0xf7, 0x28,
+	0x2a, 0xdf, 0x6b, 0x50, 0x56, 0xee, 0x13, 0x7f, 0xfb, 0x58, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff,
+	0x7b, 0xa0, 0x7d, 0x14, 0x57, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1053,10 +1160,12 @@ type QueryClient interface {
 	SubjectsByCourse(ctx context.Context, in *QuerySubjectsByCourseRequest, opts ...grpc.CallOption) (*QuerySubjectsByCourseResponse, error)
 	// SubjectsByInstitution lists all subjects for a specific institution
 	SubjectsByInstitution(ctx context.Context, in *QuerySubjectsByInstitutionRequest, opts ...grpc.CallOption) (*QuerySubjectsByInstitutionResponse, error)
-	// CheckPrerequisites checks if a student meets the prerequisites for a subject via CosmWasm contract
+	// CheckPrerequisites evaluates the prerequisite groups of a subject against the academic tree of a student
 	CheckPrerequisites(ctx context.Context, in *QueryCheckPrerequisitesRequest, opts ...grpc.CallOption) (*QueryCheckPrerequisitesResponse, error)
 	// CheckEquivalence checks equivalence between two subjects via CosmWasm contract
 	CheckEquivalence(ctx context.Context, in *QueryCheckEquivalenceRequest, opts ...grpc.CallOption) (*QueryCheckEquivalenceResponse, error)
+	// ContentAttestations lists the availability attestations of the content of a subject
+	ContentAttestations(ctx context.Context, in *QueryContentAttestationsRequest, opts ...grpc.CallOption) (*QueryContentAttestationsResponse, error)
 }
 
 type queryClient struct {
@@ -1148,6 +1257,15 @@ func (c *queryClient) CheckEquivalence(ctx context.Context, in *QueryCheckEquiva
 	return out, nil
 }
 
+func (c *queryClient) ContentAttestations(ctx context.Context, in *QueryContentAttestationsRequest, opts ...grpc.CallOption) (*QueryContentAttestationsResponse, error) {
+	out := new(QueryContentAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.subject.Query/ContentAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1164,10 +1282,12 @@ type QueryServer interface {
 	SubjectsByCourse(context.Context, *QuerySubjectsByCourseRequest) (*QuerySubjectsByCourseResponse, error)
 	// SubjectsByInstitution lists all subjects for a specific institution
 	SubjectsByInstitution(context.Context, *QuerySubjectsByInstitutionRequest) (*QuerySubjectsByInstitutionResponse, error)
-	// CheckPrerequisites checks if a student meets the prerequisites for a subject via CosmWasm contract
+	// CheckPrerequisites evaluates the prerequisite groups of a subject against the academic tree of a student
 	CheckPrerequisites(context.Context, *QueryCheckPrerequisitesRequest) (*QueryCheckPrerequisitesResponse, error)
 	// CheckEquivalence checks equivalence between two subjects via CosmWasm contract
 	CheckEquivalence(context.Context, *QueryCheckEquivalenceRequest) (*QueryCheckEquivalenceResponse, error)
+	// ContentAttestations lists the availability attestations of the content of a subject
+	ContentAttestations(context.Context, *QueryContentAttestationsRequest) (*QueryContentAttestationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1201,6 +1321,9 @@ func (*UnimplementedQueryServer) CheckPrerequisites(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) CheckEquivalence(ctx context.Context, req *QueryCheckEquivalenceRequest) (*QueryCheckEquivalenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckEquivalence not implemented")
 }
+func (*UnimplementedQueryServer) ContentAttestations(ctx context.Context, req *QueryContentAttestationsRequest) (*QueryContentAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContentAttestations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1368,6 +1491,24 @@ func _Query_CheckEquivalence_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContentAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContentAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContentAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.subject.Query/ContentAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContentAttestations(ctx, req.(*QueryContentAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "academictoken.subject.Query",
@@ -1409,6 +1550,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckEquivalence",
 			Handler:    _Query_CheckEquivalence_Handler,
 		},
+		{
+			MethodName: "ContentAttestations",
+			Handler:    _Query_ContentAttestations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/subject/query.proto",
@@ -1989,6 +2134,20 @@ func (m *QueryCheckPrerequisitesResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MissingPrerequisites) > 0 {
 		for iNdEx := len(m.MissingPrerequisites) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MissingPrerequisites[iNdEx])
@@ -2093,6 +2252,73 @@ func (m *QueryCheckEquivalenceResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryContentAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContentAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContentAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContentAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContentAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContentAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2336,6 +2562,12 @@ func (m *QueryCheckPrerequisitesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2375,6 +2607,34 @@ func (m *QueryCheckEquivalenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryContentAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContentAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3918,6 +4178,40 @@ func (m *QueryCheckPrerequisitesResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.MissingPrerequisites = append(m.MissingPrerequisites, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, PrerequisiteGroupResult{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4174,6 +4468,172 @@ func (m *QueryCheckEquivalenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryContentAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContentAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContentAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContentAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContentAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContentAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, ContentAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+
+	"academictoken/x/subject/ipfs"
+)
 
 var (
 	ErrInvalidIndex       = fmt.Errorf("invalid index")
@@ -78,3 +83,51 @@ func (s SubjectContent) IsComplete() bool {
 func (s SubjectContent) HasIPFSContent() bool {
 	return s.ContentHash != "" && s.IpfsLink != ""
 }
+
+// ExtendedContent is the document stored on IPFS that the content hash and
+// IPFS link of a subject refer to. Fields are kept in alphabetical order so
+// the encoding matches the JSON maps the module used to upload
+type ExtendedContent struct {
+	BibliographyBasic         []string `json:"bibliographyBasic,omitempty"`
+	BibliographyComplementary []string `json:"bibliographyComplementary,omitempty"`
+	EvaluationMethods         []string `json:"evaluationMethods,omitempty"`
+	Keywords                  []string `json:"keywords,omitempty"`
+	Methodologies             []string `json:"methodologies,omitempty"`
+	Objectives                []string `json:"objectives,omitempty"`
+	TopicUnits                []string `json:"topicUnits,omitempty"`
+}
+
+// IsEmpty reports whether the document has no content
+func (c ExtendedContent) IsEmpty() bool {
+	return len(c.BibliographyBasic) == 0 && len(c.BibliographyComplementary) == 0 &&
+		len(c.EvaluationMethods) == 0 && len(c.Keywords) == 0 && len(c.Methodologies) == 0 &&
+		len(c.Objectives) == 0 && len(c.TopicUnits) == 0
+}
+
+// Bytes returns the canonical encoding clients upload to IPFS
+func (c ExtendedContent) Bytes() []byte {
+	bz, err := json.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateReference checks the content hash and IPFS link a message carries
+// for the document. A document requires both and must hash to the content
+// hash; without one they are optional but must be well formed
+func (c ExtendedContent) ValidateReference(contentHash, ipfsLink string) error {
+	if c.IsEmpty() {
+		if contentHash == "" && ipfsLink == "" {
+			return nil
+		}
+		if err := ipfs.ValidateReference(contentHash, ipfsLink); err != nil {
+			return ErrInvalidIPFS.Wrap(err.Error())
+		}
+		return nil
+	}
+	if err := ipfs.VerifyContent(c.Bytes(), contentHash, ipfsLink); err != nil {
+		return ErrInvalidIPFS.Wrap(err.Error())
+	}
+	return nil
+}
@@ -170,8 +170,95 @@ func (m *SubjectContent) GetCreator() string {
 	return ""
 }
 
+// ContentAttestation is the latest availability check of a verifier for the
+// content of a subject
+type ContentAttestation struct {
+	SubjectId  string `protobuf:"bytes,1,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	IpfsLink   string `protobuf:"bytes,2,opt,name=ipfsLink,proto3" json:"ipfsLink,omitempty"`
+	Attester   string `protobuf:"bytes,3,opt,name=attester,proto3" json:"attester,omitempty"`
+	Available  bool   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	Details    string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	AttestedAt string `protobuf:"bytes,6,opt,name=attestedAt,proto3" json:"attestedAt,omitempty"`
+}
+
+func (m *ContentAttestation) Reset()         { *m = ContentAttestation{} }
+func (m *ContentAttestation) String() string { return proto.CompactTextString(m) }
+func (*ContentAttestation) ProtoMessage()    {}
+func (*ContentAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0bf936fb104efe4, []int{1}
+}
+func (m *ContentAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContentAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContentAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContentAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentAttestation.Merge(m, src)
+}
+func (m *ContentAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContentAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentAttestation proto.InternalMessageInfo
+
+func (m *ContentAttestation) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
+}
+
+func (m *ContentAttestation) GetIpfsLink() string {
+	if m != nil {
+		return m.IpfsLink
+	}
+	return ""
+}
+
+func (m *ContentAttestation) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
+
+func (m *ContentAttestation) GetAvailable() bool {
+	if m != nil {
+		return m.Available
+	}
+	return false
+}
+
+func (m *ContentAttestation) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *ContentAttestation) GetAttestedAt() string {
+	if m != nil {
+		return m.AttestedAt
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SubjectContent)(nil), "academictoken.subject.SubjectContent")
+	proto.RegisterType((*ContentAttestation)(nil), "academictoken.subject.ContentAttestation")
 }
 
 func init() {
@@ -179,30 +266,35 @@ func init() {
 }
 
 var fileDescriptor_a0bf936fb104efe4 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x1b, 0xfa, 0x37, 0x2e, 0xed, 0x60, 0x81, 0x64, 0x15, 0x88, 0x2a, 0xc4, 0x50, 0x09,
-	0xa9, 0x0c, 0x0c, 0xcc, 0xc0, 0xd2, 0x4a, 0x4c, 0x85, 0x89, 0xa5, 0x4a, 0xed, 0xa3, 0x98, 0x14,
-	0x3b, 0xd8, 0x8e, 0xda, 0xbe, 0x05, 0x6f, 0xc2, 0x6b, 0x30, 0x76, 0x64, 0x44, 0xed, 0x8b, 0xa0,
-	0xd8, 0x09, 0x24, 0x12, 0x53, 0xf2, 0xfd, 0xbe, 0xcf, 0xe7, 0xf3, 0x1d, 0x3a, 0x0f, 0x69, 0xc8,
-	0xe0, 0x95, 0x53, 0x23, 0x23, 0x10, 0x17, 0x3a, 0x99, 0xbd, 0x00, 0x35, 0xf9, 0x77, 0x4a, 0xa5,
-	0x30, 0x20, 0xcc, 0x30, 0x56, 0xd2, 0x48, 0x7c, 0x58, 0x0a, 0x0f, 0xb3, 0x50, 0x6f, 0xf8, 0x7f,
-	0x8d, 0x58, 0x81, 0x82, 0xb7, 0x84, 0x6b, 0x6e, 0x60, 0x3a, 0x57, 0x32, 0x89, 0x5d, 0x99, 0xd3,
-	0x8f, 0x2a, 0xea, 0xde, 0xbb, 0xd0, 0xad, 0xab, 0x8f, 0x0f, 0x50, 0x9d, 0x0b, 0x06, 0x2b, 0xe2,
-	0xf5, 0xbd, 0x81, 0x3f, 0x71, 0x02, 0x1f, 0x23, 0x3f, 0x2b, 0x36, 0x66, 0x64, 0xcf, 0x3a, 0x7f,
-	0x00, 0xf7, 0x51, 0x9b, 0x0b, 0x6d, 0xb8, 0x49, 0x0c, 0x97, 0x82, 0x54, 0xad, 0x5f, 0x44, 0xf8,
-	0x08, 0xf9, 0x54, 0x26, 0x4a, 0xc3, 0x94, 0x33, 0x52, 0xb3, 0x7e, 0xcb, 0x81, 0x31, 0x4b, 0xaf,
-	0x34, 0xdc, 0x2c, 0x80, 0xd4, 0xdd, 0x95, 0x56, 0x60, 0x8c, 0x6a, 0x54, 0x32, 0x20, 0x0d, 0x0b,
-	0xed, 0x3f, 0x3e, 0x43, 0x9d, 0xa5, 0x54, 0xd1, 0x42, 0x86, 0x6c, 0x94, 0x9e, 0x26, 0xcd, 0xbe,
-	0x37, 0xa8, 0x4d, 0xca, 0x10, 0x13, 0xd4, 0xa4, 0x0a, 0x18, 0x37, 0x9a, 0xb4, 0xac, 0x9f, 0xcb,
-	0xb4, 0x51, 0x06, 0x9a, 0x2a, 0x1e, 0xdb, 0x46, 0x7d, 0xd7, 0x68, 0x01, 0xa5, 0x89, 0x6c, 0xd2,
-	0xa3, 0x50, 0x3f, 0x13, 0xe4, 0x12, 0x05, 0x94, 0x26, 0xb2, 0x97, 0x3f, 0xac, 0x63, 0x20, 0x6d,
-	0x97, 0x28, 0xa0, 0xb4, 0xcb, 0x48, 0xc8, 0xe5, 0x02, 0xd8, 0x1c, 0xae, 0x15, 0x84, 0x64, 0xdf,
-	0x66, 0xca, 0x10, 0xf7, 0x50, 0x8b, 0xc7, 0x4f, 0xfa, 0x8e, 0x8b, 0x88, 0x74, 0xdc, 0x44, 0x72,
-	0x9d, 0xbd, 0x20, 0x34, 0x52, 0x91, 0xae, 0xb5, 0x72, 0x79, 0x73, 0xf5, 0xb9, 0x0d, 0xbc, 0xcd,
-	0x36, 0xf0, 0xbe, 0xb7, 0x81, 0xf7, 0xbe, 0x0b, 0x2a, 0x9b, 0x5d, 0x50, 0xf9, 0xda, 0x05, 0x95,
-	0xc7, 0x93, 0xf2, 0xee, 0x57, 0xbf, 0xdb, 0x37, 0xeb, 0x18, 0xf4, 0xac, 0x61, 0x37, 0x7e, 0xf9,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x87, 0xba, 0xd2, 0x12, 0x67, 0x02, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xbd, 0x6e, 0x14, 0x31,
+	0x18, 0x3c, 0x27, 0x97, 0x64, 0xcf, 0x21, 0x29, 0x2c, 0x90, 0xac, 0x03, 0x56, 0xa7, 0x88, 0x22,
+	0x12, 0xd2, 0x51, 0x50, 0x50, 0x1f, 0x34, 0x89, 0x44, 0x75, 0x50, 0xd1, 0x9c, 0x7c, 0xf6, 0x47,
+	0x30, 0xbb, 0xd8, 0x8b, 0xfd, 0x2d, 0x49, 0xde, 0x82, 0x37, 0xe1, 0x1d, 0xa8, 0x28, 0x53, 0x52,
+	0xa2, 0xbb, 0x17, 0x41, 0xfe, 0xd9, 0x64, 0x37, 0x4a, 0x75, 0x37, 0xf3, 0xcd, 0xce, 0x37, 0xf6,
+	0x98, 0xbe, 0x14, 0x52, 0x28, 0xf8, 0xa6, 0x25, 0xda, 0x0a, 0xcc, 0x2b, 0xdf, 0xae, 0xbf, 0x82,
+	0xc4, 0xee, 0x77, 0x25, 0xad, 0x41, 0x30, 0x38, 0x6f, 0x9c, 0x45, 0xcb, 0x9e, 0x0c, 0xc4, 0xf3,
+	0x2c, 0x9a, 0xce, 0x1f, 0xf6, 0x68, 0x1c, 0x38, 0xf8, 0xde, 0x6a, 0xaf, 0x11, 0x56, 0x17, 0xce,
+	0xb6, 0x4d, 0xb2, 0x39, 0xf9, 0xb5, 0x4b, 0x8f, 0x3f, 0x24, 0xd1, 0xbb, 0xe4, 0xcf, 0x1e, 0xd3,
+	0x3d, 0x6d, 0x14, 0x5c, 0x71, 0x32, 0x23, 0xa7, 0x93, 0x65, 0x02, 0xec, 0x19, 0x9d, 0x64, 0xb3,
+	0x73, 0xc5, 0x77, 0xe2, 0xe4, 0x8e, 0x60, 0x33, 0x7a, 0xa8, 0x8d, 0x47, 0x8d, 0x2d, 0x6a, 0x6b,
+	0xf8, 0x6e, 0x9c, 0xf7, 0x29, 0xf6, 0x94, 0x4e, 0xa4, 0x6d, 0x9d, 0x87, 0x95, 0x56, 0x7c, 0x1c,
+	0xe7, 0x45, 0x22, 0xce, 0x55, 0x58, 0x89, 0x1a, 0x6b, 0xe0, 0x7b, 0x69, 0x65, 0x04, 0x8c, 0xd1,
+	0xb1, 0xb4, 0x0a, 0xf8, 0x7e, 0x24, 0xe3, 0x7f, 0xf6, 0x82, 0x1e, 0x5d, 0x5a, 0x57, 0xd5, 0x56,
+	0xa8, 0xb3, 0xf0, 0x35, 0x3f, 0x98, 0x91, 0xd3, 0xf1, 0x72, 0x48, 0x32, 0x4e, 0x0f, 0xa4, 0x03,
+	0xa5, 0xd1, 0xf3, 0x22, 0xce, 0x3b, 0x18, 0x82, 0x2a, 0xf0, 0xd2, 0xe9, 0x26, 0x06, 0x9d, 0xa4,
+	0xa0, 0x3d, 0x2a, 0x28, 0xf2, 0x4d, 0x9f, 0x09, 0xff, 0x85, 0xd3, 0xa4, 0xe8, 0x51, 0x41, 0x91,
+	0x4f, 0xfe, 0xf1, 0xba, 0x01, 0x7e, 0x98, 0x14, 0x3d, 0x2a, 0xa4, 0xac, 0x8c, 0xbd, 0xac, 0x41,
+	0x5d, 0xc0, 0xc2, 0x81, 0xe0, 0x8f, 0xa2, 0x66, 0x48, 0xb2, 0x29, 0x2d, 0x74, 0xf3, 0xd9, 0xbf,
+	0xd7, 0xa6, 0xe2, 0x47, 0xe9, 0x46, 0x3a, 0x9c, 0x4f, 0x20, 0xd0, 0x3a, 0x7e, 0x1c, 0x47, 0x1d,
+	0x3c, 0xf9, 0x4d, 0x28, 0xcb, 0x55, 0x2d, 0x10, 0xc1, 0xa3, 0x88, 0xb1, 0x07, 0xfd, 0x90, 0xfb,
+	0xfd, 0xf4, 0x57, 0xed, 0xdc, 0x5b, 0x35, 0xa5, 0x85, 0x88, 0x46, 0xe0, 0x72, 0x71, 0xb7, 0x38,
+	0xb8, 0x8a, 0x1f, 0x42, 0xd7, 0x62, 0x5d, 0x43, 0x6c, 0xad, 0x58, 0xde, 0x11, 0x21, 0xa4, 0x02,
+	0x14, 0xba, 0xf6, 0xb9, 0xb8, 0x0e, 0xb2, 0x92, 0xd2, 0xec, 0xa1, 0x16, 0x98, 0x0b, 0xec, 0x31,
+	0x6f, 0xdf, 0xfc, 0xd9, 0x94, 0xe4, 0x66, 0x53, 0x92, 0x7f, 0x9b, 0x92, 0xfc, 0xdc, 0x96, 0xa3,
+	0x9b, 0x6d, 0x39, 0xfa, 0xbb, 0x2d, 0x47, 0x9f, 0x9e, 0x0f, 0x1f, 0xf0, 0xd5, 0xed, 0x13, 0xc6,
+	0xeb, 0x06, 0xfc, 0x7a, 0x3f, 0x3e, 0xdb, 0xd7, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xac,
+	0x5d, 0xda, 0x2c, 0x03, 0x00, 0x00,
 }
 
 func (m *SubjectContent) Marshal() (dAtA []byte, err error) {
@@ -322,6 +414,74 @@ func (m *SubjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContentAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestedAt) > 0 {
+		i -= len(m.AttestedAt)
+		copy(dAtA[i:], m.AttestedAt)
+		i = encodeVarintSubjectContent(dAtA, i, uint64(len(m.AttestedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintSubjectContent(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintSubjectContent(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IpfsLink) > 0 {
+		i -= len(m.IpfsLink)
+		copy(dAtA[i:], m.IpfsLink)
+		i = encodeVarintSubjectContent(dAtA, i, uint64(len(m.IpfsLink)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintSubjectContent(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSubjectContent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSubjectContent(v)
 	base := offset
@@ -396,6 +556,38 @@ func (m *SubjectContent) Size() (n int) {
 	return n
 }
 
+func (m *ContentAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovSubjectContent(uint64(l))
+	}
+	l = len(m.IpfsLink)
+	if l > 0 {
+		n += 1 + l + sovSubjectContent(uint64(l))
+	}
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovSubjectContent(uint64(l))
+	}
+	if m.Available {
+		n += 2
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovSubjectContent(uint64(l))
+	}
+	l = len(m.AttestedAt)
+	if l > 0 {
+		n += 1 + l + sovSubjectContent(uint64(l))
+	}
+	return n
+}
+
 func sovSubjectContent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -874,6 +1066,236 @@ func (m *SubjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContentAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubjectContent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectContent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsLink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectContent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsLink = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectContent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectContent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Available = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectContent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubjectContent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubjectContent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubjectContent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSubjectContent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -137,6 +137,10 @@ type MsgCreateSubject struct {
 	KnowledgeArea string   `protobuf:"bytes,10,opt,name=knowledgeArea,proto3" json:"knowledgeArea,omitempty"`
 	Objectives    []string `protobuf:"bytes,11,rep,name=objectives,proto3" json:"objectives,omitempty"`
 	TopicUnits    []string `protobuf:"bytes,12,rep,name=topicUnits,proto3" json:"topicUnits,omitempty"`
+	// contentHash and ipfsLink reference the extended content, which the client
+	// uploads to IPFS before submitting the message
+	ContentHash string `protobuf:"bytes,13,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	IpfsLink    string `protobuf:"bytes,14,opt,name=ipfsLink,proto3" json:"ipfsLink,omitempty"`
 }
 
 func (m *MsgCreateSubject) Reset()         { *m = MsgCreateSubject{} }
@@ -256,6 +260,20 @@ func (m *MsgCreateSubject) GetTopicUnits() []string {
 	return nil
 }
 
+func (m *MsgCreateSubject) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *MsgCreateSubject) GetIpfsLink() string {
+	if m != nil {
+		return m.IpfsLink
+	}
+	return ""
+}
+
 type MsgCreateSubjectResponse struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
@@ -313,6 +331,10 @@ type MsgCreateSubjectContent struct {
 	KnowledgeArea string   `protobuf:"bytes,10,opt,name=knowledgeArea,proto3" json:"knowledgeArea,omitempty"`
 	Objectives    []string `protobuf:"bytes,11,rep,name=objectives,proto3" json:"objectives,omitempty"`
 	TopicUnits    []string `protobuf:"bytes,12,rep,name=topicUnits,proto3" json:"topicUnits,omitempty"`
+	// contentHash and ipfsLink reference the extended content, which the client
+	// uploads to IPFS before submitting the message
+	ContentHash string `protobuf:"bytes,13,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	IpfsLink    string `protobuf:"bytes,14,opt,name=ipfsLink,proto3" json:"ipfsLink,omitempty"`
 }
 
 func (m *MsgCreateSubjectContent) Reset()         { *m = MsgCreateSubjectContent{} }
@@ -432,6 +454,20 @@ func (m *MsgCreateSubjectContent) GetTopicUnits() []string {
 	return nil
 }
 
+func (m *MsgCreateSubjectContent) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *MsgCreateSubjectContent) GetIpfsLink() string {
+	if m != nil {
+		return m.IpfsLink
+	}
+	return ""
+}
+
 type MsgCreateSubjectContentResponse struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
@@ -483,6 +519,8 @@ type MsgAddPrerequisiteGroup struct {
 	MinimumCredits           uint64   `protobuf:"varint,4,opt,name=minimumCredits,proto3" json:"minimumCredits,omitempty"`
 	MinimumCompletedSubjects uint64   `protobuf:"varint,5,opt,name=minimumCompletedSubjects,proto3" json:"minimumCompletedSubjects,omitempty"`
 	SubjectIds               []string `protobuf:"bytes,6,rep,name=subjectIds,proto3" json:"subjectIds,omitempty"`
+	ParentGroupId            string   `protobuf:"bytes,7,opt,name=parentGroupId,proto3" json:"parentGroupId,omitempty"`
+	Corequisite              bool     `protobuf:"varint,8,opt,name=corequisite,proto3" json:"corequisite,omitempty"`
 }
 
 func (m *MsgAddPrerequisiteGroup) Reset()         { *m = MsgAddPrerequisiteGroup{} }
@@ -560,6 +598,20 @@ func (m *MsgAddPrerequisiteGroup) GetSubjectIds() []string {
 	return nil
 }
 
+func (m *MsgAddPrerequisiteGroup) GetParentGroupId() string {
+	if m != nil {
+		return m.ParentGroupId
+	}
+	return ""
+}
+
+func (m *MsgAddPrerequisiteGroup) GetCorequisite() bool {
+	if m != nil {
+		return m.Corequisite
+	}
+	return false
+}
+
 type MsgAddPrerequisiteGroupResponse struct {
 	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
@@ -780,6 +832,120 @@ func (m *MsgUpdateSubjectContentResponse) GetIpfsLink() string {
 	return ""
 }
 
+// MsgAttestContentAvailability records whether a pinning verifier could
+// retrieve the content of a subject from IPFS
+type MsgAttestContentAvailability struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SubjectId string `protobuf:"bytes,2,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+	IpfsLink  string `protobuf:"bytes,3,opt,name=ipfsLink,proto3" json:"ipfsLink,omitempty"`
+	Available bool   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	Details   string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *MsgAttestContentAvailability) Reset()         { *m = MsgAttestContentAvailability{} }
+func (m *MsgAttestContentAvailability) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestContentAvailability) ProtoMessage()    {}
+func (*MsgAttestContentAvailability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c89020d5ee5d8c1e, []int{10}
+}
+func (m *MsgAttestContentAvailability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestContentAvailability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestContentAvailability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestContentAvailability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestContentAvailability.Merge(m, src)
+}
+func (m *MsgAttestContentAvailability) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestContentAvailability) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestContentAvailability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestContentAvailability proto.InternalMessageInfo
+
+func (m *MsgAttestContentAvailability) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAttestContentAvailability) GetSubjectId() string {
+	if m != nil {
+		return m.SubjectId
+	}
+	return ""
+}
+
+func (m *MsgAttestContentAvailability) GetIpfsLink() string {
+	if m != nil {
+		return m.IpfsLink
+	}
+	return ""
+}
+
+func (m *MsgAttestContentAvailability) GetAvailable() bool {
+	if m != nil {
+		return m.Available
+	}
+	return false
+}
+
+func (m *MsgAttestContentAvailability) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+type MsgAttestContentAvailabilityResponse struct {
+}
+
+func (m *MsgAttestContentAvailabilityResponse) Reset()         { *m = MsgAttestContentAvailabilityResponse{} }
+func (m *MsgAttestContentAvailabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestContentAvailabilityResponse) ProtoMessage()    {}
+func (*MsgAttestContentAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c89020d5ee5d8c1e, []int{11}
+}
+func (m *MsgAttestContentAvailabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestContentAvailabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestContentAvailabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestContentAvailabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestContentAvailabilityResponse.Merge(m, src)
+}
+func (m *MsgAttestContentAvailabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestContentAvailabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestContentAvailabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestContentAvailabilityResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "academictoken.subject.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "academictoken.subject.MsgUpdateParamsResponse")
@@ -791,71 +957,81 @@ func init() {
 	proto.RegisterType((*MsgAddPrerequisiteGroupResponse)(nil), "academictoken.subject.MsgAddPrerequisiteGroupResponse")
 	proto.RegisterType((*MsgUpdateSubjectContent)(nil), "academictoken.subject.MsgUpdateSubjectContent")
 	proto.RegisterType((*MsgUpdateSubjectContentResponse)(nil), "academictoken.subject.MsgUpdateSubjectContentResponse")
+	proto.RegisterType((*MsgAttestContentAvailability)(nil), "academictoken.subject.MsgAttestContentAvailability")
+	proto.RegisterType((*MsgAttestContentAvailabilityResponse)(nil), "academictoken.subject.MsgAttestContentAvailabilityResponse")
 }
 
 func init() { proto.RegisterFile("academictoken/subject/tx.proto", fileDescriptor_c89020d5ee5d8c1e) }
 
 var fileDescriptor_c89020d5ee5d8c1e = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x4e, 0xe2, 0x9d, 0xa4, 0x40, 0x47, 0x46, 0x9d, 0xb8, 0xd4, 0x35, 0x16, 0x6a,
-	0xa3, 0x08, 0x6c, 0x28, 0x52, 0x2b, 0x45, 0x3d, 0x34, 0xc9, 0x81, 0x46, 0x22, 0x52, 0xe5, 0xd2,
-	0x0b, 0x97, 0x68, 0xb2, 0x33, 0x5d, 0x0f, 0xf6, 0xee, 0x2c, 0x33, 0xe3, 0x24, 0xbe, 0x54, 0xc0,
-	0x11, 0x09, 0x89, 0x8f, 0xc1, 0x31, 0x07, 0x2e, 0x7c, 0x02, 0x72, 0x8c, 0x38, 0x71, 0x02, 0x94,
-	0x1c, 0xf2, 0x35, 0xd0, 0xfc, 0xb1, 0xbd, 0xbb, 0x59, 0x3b, 0xe6, 0xc2, 0xa9, 0x97, 0xc4, 0xef,
-	0xf7, 0x7b, 0x33, 0xef, 0xcd, 0xfb, 0xbd, 0x37, 0xb3, 0xa0, 0x8e, 0x03, 0x4c, 0x68, 0xc4, 0x02,
-	0xc5, 0x7b, 0x34, 0x6e, 0xcb, 0xc1, 0xe1, 0x37, 0x34, 0x50, 0x6d, 0x75, 0xd2, 0x4a, 0x04, 0x57,
-	0x1c, 0xbe, 0x9f, 0xe1, 0x5b, 0x8e, 0xaf, 0xdd, 0xc6, 0x11, 0x8b, 0x79, 0xdb, 0xfc, 0xb5, 0x9e,
-	0xb5, 0x3b, 0x01, 0x97, 0x11, 0x97, 0xed, 0x48, 0x86, 0xed, 0xa3, 0xcf, 0xf4, 0x3f, 0x47, 0xac,
-	0x5b, 0xe2, 0xc0, 0x58, 0x6d, 0x6b, 0x38, 0xaa, 0x1a, 0xf2, 0x90, 0x5b, 0x5c, 0xff, 0x72, 0x68,
-	0xb3, 0x38, 0xa7, 0x04, 0x0b, 0x1c, 0xb9, 0x95, 0xcd, 0xdf, 0x3d, 0xf0, 0xee, 0xbe, 0x0c, 0x5f,
-	0x25, 0x04, 0x2b, 0xfa, 0xc2, 0x30, 0xf0, 0x31, 0xf0, 0xf1, 0x40, 0x75, 0xb9, 0x60, 0x6a, 0x88,
-	0xbc, 0x86, 0xb7, 0xe1, 0xef, 0xa0, 0x3f, 0x7e, 0xfd, 0xa4, 0xea, 0x42, 0x6e, 0x13, 0x22, 0xa8,
-	0x94, 0x2f, 0x95, 0x60, 0x71, 0xd8, 0x99, 0xb8, 0xc2, 0x67, 0x60, 0xd9, 0xee, 0x8d, 0x16, 0x1b,
-	0xde, 0xc6, 0xea, 0xa3, 0x7b, 0xad, 0xc2, 0x43, 0xb7, 0x6c, 0x98, 0x1d, 0xff, 0xec, 0xaf, 0xfb,
-	0x0b, 0xbf, 0x5c, 0x9d, 0x6e, 0x7a, 0x1d, 0xb7, 0x6e, 0x6b, 0xeb, 0x87, 0xab, 0xd3, 0xcd, 0xc9,
-	0x8e, 0x3f, 0x5e, 0x9d, 0x6e, 0x3e, 0xcc, 0x1e, 0xe2, 0x64, 0x7c, 0x8c, 0x5c, 0xd6, 0xcd, 0x75,
-	0x70, 0x27, 0x07, 0x75, 0xa8, 0x4c, 0x78, 0x2c, 0x69, 0xf3, 0xa7, 0x12, 0x78, 0x6f, 0x5f, 0x86,
-	0xbb, 0x82, 0x62, 0x45, 0x5f, 0xda, 0xf5, 0x10, 0x81, 0x95, 0x40, 0x03, 0x5c, 0xd8, 0x33, 0x76,
-	0x46, 0x26, 0x6c, 0x80, 0x55, 0x16, 0x4b, 0xc5, 0xd4, 0x40, 0x31, 0x1e, 0x9b, 0xc3, 0xf8, 0x9d,
-	0x34, 0x04, 0xef, 0x02, 0x3f, 0xe0, 0x03, 0x21, 0xe9, 0x01, 0x23, 0xa8, 0x64, 0xf8, 0x8a, 0x05,
-	0xf6, 0x08, 0xac, 0x82, 0x25, 0xc5, 0x54, 0x9f, 0xa2, 0xb2, 0x21, 0xac, 0x01, 0x21, 0x28, 0x07,
-	0x9c, 0x50, 0xb4, 0x64, 0x40, 0xf3, 0x1b, 0x7e, 0x04, 0x6e, 0x1d, 0x73, 0xd1, 0xeb, 0x73, 0x4c,
-	0x9e, 0xeb, 0xd5, 0x68, 0xb9, 0xe1, 0x6d, 0x94, 0x3b, 0x59, 0xd0, 0x25, 0x4a, 0x98, 0x92, 0x68,
-	0xc5, 0xf0, 0x23, 0x53, 0x27, 0x4a, 0xa8, 0x0c, 0x04, 0x4b, 0x4c, 0xa2, 0x15, 0x9b, 0x68, 0x0a,
-	0xd2, 0x1e, 0xae, 0x5e, 0x5f, 0x0d, 0x13, 0x8a, 0x7c, 0xeb, 0x91, 0x82, 0x74, 0x0e, 0xbd, 0x98,
-	0x1f, 0xf7, 0x29, 0x09, 0xe9, 0xb6, 0xa0, 0x18, 0x01, 0xe3, 0x93, 0x05, 0x61, 0x1d, 0x00, 0x6e,
-	0xd6, 0xb0, 0x23, 0x2a, 0xd1, 0x6a, 0xa3, 0xb4, 0xe1, 0x77, 0x52, 0x88, 0xe6, 0x15, 0x4f, 0x58,
-	0xf0, 0x2a, 0xd6, 0x69, 0xae, 0x59, 0x7e, 0x82, 0x6c, 0xad, 0x69, 0x61, 0x47, 0x05, 0x6e, 0x7e,
-	0x0a, 0x50, 0x5e, 0x8e, 0x91, 0x56, 0xba, 0x7a, 0x2c, 0x26, 0xf4, 0xc4, 0x89, 0x62, 0x8d, 0xe6,
-	0x59, 0xc9, 0xa8, 0x9b, 0x59, 0xb2, 0xcb, 0x63, 0x45, 0xe3, 0xb7, 0x42, 0xfe, 0xaf, 0x42, 0x3e,
-	0x4b, 0x0b, 0xa9, 0xe7, 0xb3, 0x3d, 0x63, 0x3e, 0x8b, 0xe4, 0x6a, 0x3e, 0x01, 0xf7, 0xa7, 0x50,
-	0x37, 0xf4, 0xc0, 0xf7, 0x8b, 0xa6, 0x07, 0xb6, 0x09, 0x79, 0x21, 0xa8, 0xa0, 0xdf, 0x0e, 0x98,
-	0x64, 0x8a, 0x7e, 0x21, 0xf8, 0x20, 0x99, 0xd1, 0x03, 0x1f, 0x00, 0xdf, 0x65, 0xb4, 0x47, 0x5c,
-	0x07, 0x4c, 0x00, 0xcd, 0x86, 0x7a, 0x03, 0x53, 0x54, 0xab, 0xff, 0x04, 0x80, 0x0f, 0xc0, 0x3b,
-	0x11, 0x8b, 0x59, 0x34, 0x88, 0x76, 0x9d, 0x6e, 0x65, 0xa3, 0x5b, 0x0e, 0x85, 0x5b, 0x00, 0x8d,
-	0x10, 0x1e, 0x25, 0x7d, 0xaa, 0x28, 0x71, 0x27, 0x93, 0xa6, 0x4d, 0xca, 0x9d, 0xa9, 0xbc, 0x2e,
-	0xf8, 0x38, 0x1d, 0xdd, 0x37, 0xa6, 0xe0, 0x13, 0x24, 0x37, 0x39, 0x4f, 0x4d, 0xf1, 0x8a, 0x4a,
-	0x30, 0x2e, 0xde, 0x3a, 0xa8, 0x98, 0x13, 0xe8, 0x8e, 0x76, 0xb5, 0x30, 0xf6, 0x1e, 0x69, 0xfe,
-	0x56, 0x4a, 0xdd, 0x91, 0x73, 0x4f, 0xd1, 0xec, 0x0a, 0x66, 0x1b, 0xaa, 0x74, 0x43, 0x43, 0x95,
-	0xf3, 0x0d, 0xa5, 0xdb, 0x36, 0xa2, 0xaa, 0xcb, 0x09, 0xef, 0xf3, 0x90, 0x51, 0x5d, 0x30, 0xed,
-	0x92, 0x05, 0xe1, 0xc7, 0xe0, 0x36, 0x3d, 0xc2, 0xfd, 0x01, 0xd6, 0xc3, 0xb0, 0x6f, 0xa8, 0x51,
-	0xb1, 0xae, 0x13, 0xda, 0xfb, 0x90, 0x1d, 0xf6, 0x19, 0x0f, 0x05, 0x4e, 0xba, 0xc3, 0x1d, 0x2c,
-	0x59, 0x80, 0x56, 0xac, 0xf7, 0x35, 0x02, 0x3e, 0x05, 0xeb, 0x69, 0xd0, 0x4a, 0x14, 0xd1, 0x58,
-	0x61, 0x31, 0x44, 0x15, 0xb3, 0x6a, 0xba, 0x03, 0xac, 0x81, 0x4a, 0x8f, 0x0e, 0x8f, 0xb9, 0x20,
-	0x12, 0xf9, 0xc6, 0x79, 0x6c, 0xeb, 0xa1, 0x0d, 0x6c, 0x79, 0x9f, 0x63, 0xd9, 0x75, 0x03, 0x99,
-	0x86, 0xf4, 0x6a, 0x96, 0xbc, 0x96, 0x5f, 0xb2, 0xb8, 0x87, 0x56, 0xed, 0xf5, 0x33, 0xb2, 0x73,
-	0xca, 0x63, 0xa3, 0x7c, 0x91, 0x74, 0x63, 0xe5, 0x3f, 0x04, 0x6b, 0x6e, 0xef, 0x83, 0xae, 0x8e,
-	0xe7, 0x5d, 0x8f, 0x77, 0x17, 0xf8, 0x7a, 0xff, 0x83, 0xbe, 0x0e, 0xb8, 0x98, 0x0d, 0xf8, 0xe8,
-	0xef, 0x32, 0x28, 0xed, 0xcb, 0x10, 0xbe, 0x06, 0x6b, 0x99, 0xef, 0x81, 0x07, 0x53, 0xde, 0xf1,
-	0xdc, 0x73, 0x5b, 0x6b, 0xcd, 0xe7, 0x37, 0xce, 0x97, 0x81, 0x5b, 0xd9, 0x27, 0xf9, 0xe1, 0xf4,
-	0x0d, 0x32, 0x8e, 0xb5, 0xf6, 0x9c, 0x8e, 0xe3, 0x50, 0x6f, 0x40, 0xb5, 0xf0, 0xed, 0x68, 0xcd,
-	0xb9, 0x91, 0xf3, 0xaf, 0x3d, 0xfe, 0x6f, 0xfe, 0xe9, 0xf8, 0x85, 0xf7, 0xd6, 0x8c, 0xf8, 0x45,
-	0xfe, 0xb3, 0xe2, 0xcf, 0xbc, 0x14, 0xde, 0x80, 0x6a, 0xe1, 0xd4, 0xdf, 0x28, 0xd9, 0xfc, 0xe7,
-	0x9f, 0xd5, 0x9a, 0xb5, 0xa5, 0xef, 0xf4, 0x77, 0xde, 0xce, 0x93, 0xb3, 0x8b, 0xba, 0x77, 0x7e,
-	0x51, 0xf7, 0xfe, 0xb9, 0xa8, 0x7b, 0x3f, 0x5f, 0xd6, 0x17, 0xce, 0x2f, 0xeb, 0x0b, 0x7f, 0x5e,
-	0xd6, 0x17, 0xbe, 0xbe, 0x37, 0xed, 0x19, 0x51, 0xc3, 0x84, 0xca, 0xc3, 0x65, 0xf3, 0xb5, 0xfa,
-	0xf9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x44, 0x13, 0x45, 0x90, 0x67, 0x0b, 0x00, 0x00,
+	// 1061 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xc6, 0x4e, 0xe2, 0x9d, 0x24, 0x85, 0xae, 0x82, 0x3a, 0x76, 0x5b, 0xd7, 0x58, 0x51,
+	0x1a, 0x45, 0x60, 0x43, 0x2b, 0xb5, 0x52, 0xe8, 0xa1, 0x49, 0x0e, 0x34, 0x12, 0x91, 0xaa, 0x2d,
+	0xbd, 0x70, 0x89, 0xc6, 0x3b, 0xd3, 0xf5, 0xe0, 0xdd, 0x9d, 0x65, 0x67, 0x9c, 0xc4, 0x97, 0x0a,
+	0x71, 0xe0, 0x80, 0x84, 0xc4, 0xcf, 0xe0, 0x98, 0x03, 0x07, 0xf8, 0x05, 0x14, 0x71, 0xa9, 0x38,
+	0x71, 0x42, 0x28, 0x39, 0xe4, 0xc8, 0x5f, 0x40, 0xf3, 0xb1, 0xf6, 0xae, 0x63, 0x6f, 0x4c, 0x6f,
+	0x48, 0xbd, 0x24, 0x9e, 0xe7, 0x7d, 0xdf, 0x79, 0x3f, 0x9e, 0x67, 0xc6, 0x63, 0x50, 0x47, 0x1e,
+	0xc2, 0x24, 0xa4, 0x9e, 0x60, 0x3d, 0x12, 0xb5, 0x79, 0xbf, 0xf3, 0x25, 0xf1, 0x44, 0x5b, 0x9c,
+	0xb4, 0xe2, 0x84, 0x09, 0xe6, 0xbc, 0x97, 0xb3, 0xb7, 0x8c, 0xbd, 0x76, 0x1d, 0x85, 0x34, 0x62,
+	0x6d, 0xf5, 0x57, 0x7b, 0xd6, 0x6e, 0x78, 0x8c, 0x87, 0x8c, 0xb7, 0x43, 0xee, 0xb7, 0x8f, 0x3e,
+	0x96, 0xff, 0x8c, 0xa1, 0xaa, 0x0d, 0x87, 0x6a, 0xd5, 0xd6, 0x0b, 0x63, 0x5a, 0xf3, 0x99, 0xcf,
+	0x34, 0x2e, 0x3f, 0x19, 0xb4, 0x39, 0xb9, 0xa6, 0x18, 0x25, 0x28, 0x34, 0x91, 0xcd, 0x5f, 0x2d,
+	0xf0, 0xce, 0x01, 0xf7, 0x9f, 0xc7, 0x18, 0x09, 0xf2, 0x54, 0x59, 0x9c, 0x07, 0xc0, 0x46, 0x7d,
+	0xd1, 0x65, 0x09, 0x15, 0x03, 0x68, 0x35, 0xac, 0x4d, 0x7b, 0x17, 0xfe, 0xf1, 0xd3, 0x87, 0x6b,
+	0x26, 0xe5, 0x0e, 0xc6, 0x09, 0xe1, 0xfc, 0x99, 0x48, 0x68, 0xe4, 0xbb, 0x23, 0x57, 0xe7, 0x31,
+	0x58, 0xd4, 0x7b, 0xc3, 0xf9, 0x86, 0xb5, 0xb9, 0x7c, 0xef, 0x76, 0x6b, 0x62, 0xd3, 0x2d, 0x9d,
+	0x66, 0xd7, 0x7e, 0xf5, 0xd7, 0x9d, 0xb9, 0x1f, 0x2f, 0x4e, 0xb7, 0x2c, 0xd7, 0xc4, 0x6d, 0x6f,
+	0x7f, 0x73, 0x71, 0xba, 0x35, 0xda, 0xf1, 0xbb, 0x8b, 0xd3, 0xad, 0xbb, 0xf9, 0x26, 0x4e, 0x86,
+	0x6d, 0x8c, 0x55, 0xdd, 0xac, 0x82, 0x1b, 0x63, 0x90, 0x4b, 0x78, 0xcc, 0x22, 0x4e, 0x9a, 0xbf,
+	0x97, 0xc0, 0xbb, 0x07, 0xdc, 0xdf, 0x4b, 0x08, 0x12, 0xe4, 0x99, 0x8e, 0x77, 0x20, 0x58, 0xf2,
+	0x24, 0xc0, 0x12, 0xdd, 0xa3, 0x9b, 0x2e, 0x9d, 0x06, 0x58, 0xa6, 0x11, 0x17, 0x54, 0xf4, 0x05,
+	0x65, 0x91, 0x6a, 0xc6, 0x76, 0xb3, 0x90, 0x73, 0x13, 0xd8, 0x1e, 0xeb, 0x27, 0x9c, 0x1c, 0x52,
+	0x0c, 0x4b, 0xca, 0x5e, 0xd1, 0xc0, 0x3e, 0x76, 0xd6, 0xc0, 0x82, 0xa0, 0x22, 0x20, 0xb0, 0xac,
+	0x0c, 0x7a, 0xe1, 0x38, 0xa0, 0xec, 0x31, 0x4c, 0xe0, 0x82, 0x02, 0xd5, 0x67, 0x67, 0x1d, 0xac,
+	0x1e, 0xb3, 0xa4, 0x17, 0x30, 0x84, 0x9f, 0xc8, 0x68, 0xb8, 0xd8, 0xb0, 0x36, 0xcb, 0x6e, 0x1e,
+	0x34, 0x85, 0x62, 0x2a, 0x38, 0x5c, 0x52, 0xf6, 0x74, 0x29, 0x0b, 0xc5, 0x84, 0x7b, 0x09, 0x8d,
+	0x55, 0xa1, 0x15, 0x5d, 0x68, 0x06, 0x92, 0x1e, 0x66, 0x5e, 0x9f, 0x0f, 0x62, 0x02, 0x6d, 0xed,
+	0x91, 0x81, 0x64, 0x0d, 0xbd, 0x88, 0x1d, 0x07, 0x04, 0xfb, 0x64, 0x27, 0x21, 0x08, 0x02, 0xe5,
+	0x93, 0x07, 0x9d, 0x3a, 0x00, 0x4c, 0xc5, 0xd0, 0x23, 0xc2, 0xe1, 0x72, 0xa3, 0xb4, 0x69, 0xbb,
+	0x19, 0x44, 0xda, 0x05, 0x8b, 0xa9, 0xf7, 0x3c, 0x92, 0x65, 0xae, 0x68, 0xfb, 0x08, 0x91, 0x75,
+	0x78, 0x2c, 0x12, 0x24, 0x12, 0x4f, 0x10, 0xef, 0xc2, 0x55, 0x5d, 0x47, 0x06, 0x72, 0x6a, 0xa0,
+	0x42, 0xe3, 0x17, 0xfc, 0x33, 0x1a, 0xf5, 0xe0, 0x35, 0x3d, 0xd1, 0x74, 0xbd, 0xbd, 0x22, 0x65,
+	0x91, 0xd2, 0xd3, 0xfc, 0x08, 0xc0, 0x71, 0x32, 0x53, 0xa6, 0xe5, 0xec, 0x69, 0x84, 0xc9, 0x89,
+	0xa1, 0x54, 0x2f, 0x9a, 0xdf, 0x96, 0x95, 0x36, 0x72, 0x21, 0x7b, 0x3a, 0xf7, 0x5b, 0x19, 0xfc,
+	0x7f, 0x64, 0xf0, 0x38, 0x2b, 0x03, 0x79, 0x37, 0xb4, 0x0b, 0xee, 0x86, 0x49, 0x64, 0x37, 0x1f,
+	0x82, 0x3b, 0x53, 0x4c, 0x57, 0x28, 0xe8, 0xb7, 0x79, 0xa5, 0xa0, 0x1d, 0x8c, 0x9f, 0x26, 0x24,
+	0x21, 0x5f, 0xf5, 0x29, 0xa7, 0x82, 0x7c, 0x9a, 0xb0, 0x7e, 0x5c, 0xa0, 0xa0, 0x5b, 0xc0, 0x36,
+	0x15, 0xed, 0x63, 0xa3, 0x9f, 0x11, 0x20, 0xad, 0xbe, 0xdc, 0x40, 0x51, 0xa2, 0xd5, 0x33, 0x02,
+	0x9c, 0x0d, 0x70, 0x2d, 0xa4, 0x11, 0x0d, 0xfb, 0xe1, 0x9e, 0x61, 0xbd, 0xac, 0x58, 0x1f, 0x43,
+	0x9d, 0x6d, 0x00, 0x53, 0x84, 0x85, 0x71, 0x40, 0x04, 0xc1, 0xa6, 0x33, 0xae, 0x44, 0x56, 0x76,
+	0xa7, 0xda, 0x25, 0x5d, 0xc3, 0x72, 0xa4, 0xea, 0x14, 0x5d, 0x23, 0x44, 0x8a, 0x22, 0x46, 0x09,
+	0x89, 0x84, 0x6a, 0x74, 0x1f, 0x2b, 0xe1, 0xd9, 0x6e, 0x1e, 0xd4, 0xa4, 0x0e, 0x67, 0xa2, 0xe4,
+	0x57, 0x71, 0xb3, 0xd0, 0xd8, 0xf9, 0x7d, 0xa4, 0x48, 0x98, 0x34, 0xca, 0x21, 0x09, 0x55, 0x50,
+	0x51, 0x93, 0x90, 0xe7, 0xca, 0xcc, 0xd4, 0xd7, 0xd9, 0x9a, 0xbf, 0x94, 0x32, 0xf7, 0xfc, 0xcc,
+	0x67, 0xb9, 0x98, 0x89, 0xbc, 0xac, 0x4b, 0x57, 0xc8, 0xba, 0x7c, 0x49, 0xd6, 0xeb, 0x60, 0x35,
+	0x24, 0xa2, 0xcb, 0x30, 0x0b, 0x98, 0x4f, 0x89, 0x1c, 0xbc, 0x74, 0xc9, 0x83, 0xce, 0x07, 0xe0,
+	0x3a, 0x39, 0x42, 0x41, 0x1f, 0xc9, 0x23, 0x79, 0xa0, 0x4c, 0xe9, 0xd0, 0x2f, 0x1b, 0xa4, 0x77,
+	0x87, 0x76, 0x02, 0xca, 0xfc, 0x04, 0xc5, 0xdd, 0xc1, 0x2e, 0xe2, 0xd4, 0x83, 0x4b, 0xda, 0xfb,
+	0x92, 0xc1, 0x79, 0x04, 0xaa, 0x59, 0x50, 0x53, 0x1d, 0x92, 0x48, 0xa0, 0x64, 0x00, 0x2b, 0x2a,
+	0x6a, 0xba, 0x83, 0x3c, 0x74, 0x3d, 0x32, 0x38, 0x66, 0x09, 0xe6, 0xd0, 0x56, 0xce, 0xc3, 0xf5,
+	0xf8, 0x91, 0x05, 0xc5, 0x47, 0x76, 0xb9, 0xf0, 0xe6, 0x46, 0x8a, 0xf9, 0x49, 0xd4, 0x0d, 0x99,
+	0x7f, 0x1f, 0xac, 0x98, 0xbd, 0x0f, 0xbb, 0x32, 0x9f, 0x75, 0x39, 0xdf, 0x4d, 0x60, 0xcb, 0xfd,
+	0x0f, 0x03, 0x99, 0x70, 0x3e, 0x9f, 0xb0, 0xf9, 0xb3, 0x05, 0x6e, 0x49, 0x75, 0x09, 0x41, 0x78,
+	0xba, 0xf9, 0xce, 0x11, 0xa2, 0x01, 0xea, 0xd0, 0x40, 0x3e, 0x52, 0xde, 0x54, 0x23, 0xd9, 0x2e,
+	0x4b, 0xf9, 0xa4, 0x32, 0x12, 0xe9, 0x1c, 0xe6, 0xba, 0xaf, 0xb8, 0x23, 0x40, 0x66, 0xc4, 0x44,
+	0x20, 0x1a, 0x70, 0x73, 0xeb, 0xa7, 0xcb, 0xb1, 0xe9, 0x6c, 0x80, 0xf5, 0xa2, 0xca, 0xd3, 0x11,
+	0xdd, 0xfb, 0x67, 0x01, 0x94, 0x0e, 0xb8, 0xef, 0xbc, 0x00, 0x2b, 0xb9, 0x67, 0xdb, 0xc6, 0x94,
+	0xe7, 0xd6, 0xd8, 0xab, 0xa8, 0xd6, 0x9a, 0xcd, 0x6f, 0x48, 0x09, 0x05, 0xab, 0xf9, 0x97, 0xd3,
+	0xdd, 0xe9, 0x1b, 0xe4, 0x1c, 0x6b, 0xed, 0x19, 0x1d, 0x87, 0xa9, 0x5e, 0x82, 0xb5, 0x89, 0x5f,
+	0xd2, 0xad, 0x19, 0x37, 0x32, 0xfe, 0xb5, 0x07, 0xff, 0xcd, 0x3f, 0x9b, 0x7f, 0xe2, 0x15, 0x5f,
+	0x90, 0x7f, 0x92, 0x7f, 0x51, 0xfe, 0xc2, 0x7b, 0xef, 0x25, 0x58, 0x9b, 0x78, 0xb1, 0x5d, 0x49,
+	0xd9, 0xec, 0xfd, 0x17, 0x9e, 0xbe, 0xef, 0x2d, 0x50, 0x9d, 0x7e, 0x74, 0xee, 0x17, 0x74, 0x35,
+	0x2d, 0xa8, 0xf6, 0xc9, 0x1b, 0x04, 0xa5, 0xf5, 0xd4, 0x16, 0xbe, 0x96, 0x3f, 0x0f, 0x76, 0x1f,
+	0xbe, 0x3a, 0xab, 0x5b, 0xaf, 0xcf, 0xea, 0xd6, 0xdf, 0x67, 0x75, 0xeb, 0x87, 0xf3, 0xfa, 0xdc,
+	0xeb, 0xf3, 0xfa, 0xdc, 0x9f, 0xe7, 0xf5, 0xb9, 0x2f, 0x6e, 0x4f, 0x7b, 0x01, 0x88, 0x41, 0x4c,
+	0x78, 0x67, 0x51, 0xfd, 0xc8, 0xb9, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x68, 0x17,
+	0xa3, 0x9e, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -877,6 +1053,7 @@ type MsgClient interface {
 	CreateSubjectContent(ctx context.Context, in *MsgCreateSubjectContent, opts ...grpc.CallOption) (*MsgCreateSubjectContentResponse, error)
 	AddPrerequisiteGroup(ctx context.Context, in *MsgAddPrerequisiteGroup, opts ...grpc.CallOption) (*MsgAddPrerequisiteGroupResponse, error)
 	UpdateSubjectContent(ctx context.Context, in *MsgUpdateSubjectContent, opts ...grpc.CallOption) (*MsgUpdateSubjectContentResponse, error)
+	AttestContentAvailability(ctx context.Context, in *MsgAttestContentAvailability, opts ...grpc.CallOption) (*MsgAttestContentAvailabilityResponse, error)
 }
 
 type msgClient struct {
@@ -932,6 +1109,15 @@ func (c *msgClient) UpdateSubjectContent(ctx context.Context, in *MsgUpdateSubje
 	return out, nil
 }
 
+func (c *msgClient) AttestContentAvailability(ctx context.Context, in *MsgAttestContentAvailability, opts ...grpc.CallOption) (*MsgAttestContentAvailabilityResponse, error) {
+	out := new(MsgAttestContentAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/academictoken.subject.Msg/AttestContentAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -941,6 +1127,7 @@ type MsgServer interface {
 	CreateSubjectContent(context.Context, *MsgCreateSubjectContent) (*MsgCreateSubjectContentResponse, error)
 	AddPrerequisiteGroup(context.Context, *MsgAddPrerequisiteGroup) (*MsgAddPrerequisiteGroupResponse, error)
 	UpdateSubjectContent(context.Context, *MsgUpdateSubjectContent) (*MsgUpdateSubjectContentResponse, error)
+	AttestContentAvailability(context.Context, *MsgAttestContentAvailability) (*MsgAttestContentAvailabilityResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -962,6 +1149,9 @@ func (*UnimplementedMsgServer) AddPrerequisiteGroup(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) UpdateSubjectContent(ctx context.Context, req *MsgUpdateSubjectContent) (*MsgUpdateSubjectContentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubjectContent not implemented")
 }
+func (*UnimplementedMsgServer) AttestContentAvailability(ctx context.Context, req *MsgAttestContentAvailability) (*MsgAttestContentAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestContentAvailability not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1057,6 +1247,24 @@ func _Msg_UpdateSubjectContent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AttestContentAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestContentAvailability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestContentAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academictoken.subject.Msg/AttestContentAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestContentAvailability(ctx, req.(*MsgAttestContentAvailability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "academictoken.subject.Msg",
@@ -1082,6 +1290,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSubjectContent",
 			Handler:    _Msg_UpdateSubjectContent_Handler,
 		},
+		{
+			MethodName: "AttestContentAvailability",
+			Handler:    _Msg_AttestContentAvailability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "academictoken/subject/tx.proto",
@@ -1170,6 +1382,20 @@ func (m *MsgCreateSubject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IpfsLink) > 0 {
+		i -= len(m.IpfsLink)
+		copy(dAtA[i:], m.IpfsLink)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsLink)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.TopicUnits) > 0 {
 		for iNdEx := len(m.TopicUnits) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.TopicUnits[iNdEx])
@@ -1307,6 +1533,20 @@ func (m *MsgCreateSubjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.IpfsLink) > 0 {
+		i -= len(m.IpfsLink)
+		copy(dAtA[i:], m.IpfsLink)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsLink)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.TopicUnits) > 0 {
 		for iNdEx := len(m.TopicUnits) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.TopicUnits[iNdEx])
@@ -1444,6 +1684,23 @@ func (m *MsgAddPrerequisiteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Corequisite {
+		i--
+		if m.Corequisite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ParentGroupId) > 0 {
+		i -= len(m.ParentGroupId)
+		copy(dAtA[i:], m.ParentGroupId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentGroupId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.SubjectIds) > 0 {
 		for iNdEx := len(m.SubjectIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SubjectIds[iNdEx])
@@ -1668,6 +1925,90 @@ func (m *MsgUpdateSubjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAttestContentAvailability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestContentAvailability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestContentAvailability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IpfsLink) > 0 {
+		i -= len(m.IpfsLink)
+		copy(dAtA[i:], m.IpfsLink)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsLink)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubjectId) > 0 {
+		i -= len(m.SubjectId)
+		copy(dAtA[i:], m.SubjectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestContentAvailabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestContentAvailabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestContentAvailabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1759,6 +2100,14 @@ func (m *MsgCreateSubject) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsLink)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1831,6 +2180,14 @@ func (m *MsgCreateSubjectContent) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsLink)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1877,6 +2234,13 @@ func (m *MsgAddPrerequisiteGroup) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.ParentGroupId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Corequisite {
+		n += 2
+	}
 	return n
 }
 
@@ -1977,19 +2341,56 @@ func (m *MsgUpdateSubjectContentResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+func (m *MsgAttestContentAvailability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubjectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsLink)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Available {
+		n += 2
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestContentAvailabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
@@ -2535,6 +2936,70 @@ func (m *MsgCreateSubject) Unmarshal(dAtA []byte) error {
 			}
 			m.TopicUnits = append(m.TopicUnits, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsLink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsLink = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3025,6 +3490,70 @@ func (m *MsgCreateSubjectContent) Unmarshal(dAtA []byte) error {
 			}
 			m.TopicUnits = append(m.TopicUnits, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsLink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsLink = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3323,6 +3852,58 @@ func (m *MsgAddPrerequisiteGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.SubjectIds = append(m.SubjectIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentGroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corequisite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Corequisite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3942,6 +4523,254 @@ func (m *MsgUpdateSubjectContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAttestContentAvailability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestContentAvailability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestContentAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsLink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsLink = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Available = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestContentAvailabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestContentAvailabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestContentAvailabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
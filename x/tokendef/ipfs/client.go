@@ -7,6 +7,8 @@ import (
 )
 
 // TokenDefIPFSClient wraps the base IPFS client with tokendef-specific functionality
+// and performs network I/O, so it is for clients and off-chain services only;
+// keepers validate references with VerifyTokenDefData instead
 type TokenDefIPFSClient struct {
 	*subjectipfs.IPFSClient
 }
@@ -27,6 +29,16 @@ func (c *TokenDefIPFSClient) AddTokenDefData(data interface{}) (string, string,
 	return c.Add(jsonData)
 }
 
+// VerifyTokenDefData checks, without any I/O, that data matches the content hash
+// and IPFS link uploaded for it
+func VerifyTokenDefData(data interface{}, contentHash, ipfsLink string) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tokendef data: %w", err)
+	}
+	return subjectipfs.VerifyContent(jsonData, contentHash, ipfsLink)
+}
+
 // GetTokenDefData retrieves and unmarshals tokendef data from IPFS
 func (c *TokenDefIPFSClient) GetTokenDefData(ipfsLink string, target interface{}) error {
 	data, err := c.Get(ipfsLink)
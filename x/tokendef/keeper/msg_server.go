@@ -95,6 +95,11 @@ func (k msgServer) CreateTokenDefinition(goCtx context.Context, req *types.MsgCr
 		courseId = subject.CourseId
 	}
 
+	// Only accredited institutions may define tokens
+	if k.institutionKeeper != nil && institutionId != "" && !k.institutionKeeper.IsInstitutionAuthorized(ctx, institutionId) {
+		return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", institutionId)
+	}
+
 	// Check if token definition already exists for this subject
 	allTokenDefs := k.GetAllTokenDefinition(ctx)
 	for _, tokenDef := range allTokenDefs {
@@ -200,6 +205,11 @@ func (k msgServer) UpdateTokenDefinition(goCtx context.Context, req *types.MsgUp
 		return nil, fmt.Errorf("creator '%s' is not authorized to update token definition '%s'", req.Creator, req.TokenDefId)
 	}
 
+	// Only accredited institutions may change their token definitions
+	if k.institutionKeeper != nil && tokenDef.InstitutionId != "" && !k.institutionKeeper.IsInstitutionAuthorized(ctx, tokenDef.InstitutionId) {
+		return nil, types.ErrInstitutionNotAccredited.Wrapf("institution '%s' is unaccredited or its accreditation has lapsed", tokenDef.InstitutionId)
+	}
+
 	// Update fields if provided
 	updated := false
 
@@ -42,17 +42,18 @@ const (
 
 // x/tokendef module sentinel errors
 var (
-	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrTokenDefinitionNotFound = sdkerrors.Register(ModuleName, 1101, "token definition not found")
-	ErrInvalidSubjectId        = sdkerrors.Register(ModuleName, 1102, "invalid subject ID")
-	ErrInvalidTokenName        = sdkerrors.Register(ModuleName, 1103, "invalid token name")
-	ErrInvalidTokenSymbol      = sdkerrors.Register(ModuleName, 1104, "invalid token symbol")
-	ErrTokenDefinitionExists   = sdkerrors.Register(ModuleName, 1105, "token definition already exists")
-	ErrInvalidContentHash      = sdkerrors.Register(ModuleName, 1106, "invalid content hash")
-	ErrInvalidIpfsLink         = sdkerrors.Register(ModuleName, 1107, "invalid IPFS link")
-	ErrUnauthorized            = sdkerrors.Register(ModuleName, 1108, "unauthorized")
-	ErrInvalidMaxSupply        = sdkerrors.Register(ModuleName, 1109, "invalid max supply")
-	ErrInvalidMetadata         = sdkerrors.Register(ModuleName, 1110, "invalid metadata")
+	ErrInvalidSigner            = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrTokenDefinitionNotFound  = sdkerrors.Register(ModuleName, 1101, "token definition not found")
+	ErrInvalidSubjectId         = sdkerrors.Register(ModuleName, 1102, "invalid subject ID")
+	ErrInvalidTokenName         = sdkerrors.Register(ModuleName, 1103, "invalid token name")
+	ErrInvalidTokenSymbol       = sdkerrors.Register(ModuleName, 1104, "invalid token symbol")
+	ErrTokenDefinitionExists    = sdkerrors.Register(ModuleName, 1105, "token definition already exists")
+	ErrInvalidContentHash       = sdkerrors.Register(ModuleName, 1106, "invalid content hash")
+	ErrInvalidIpfsLink          = sdkerrors.Register(ModuleName, 1107, "invalid IPFS link")
+	ErrUnauthorized             = sdkerrors.Register(ModuleName, 1108, "unauthorized")
+	ErrInvalidMaxSupply         = sdkerrors.Register(ModuleName, 1109, "invalid max supply")
+	ErrInvalidMetadata          = sdkerrors.Register(ModuleName, 1110, "invalid metadata")
+	ErrInstitutionNotAccredited = sdkerrors.Register(ModuleName, 1111, "institution is not accredited")
 )
 
 // TokenDefinitionKey returns the store key to retrieve a TokenDefinition from the index fields